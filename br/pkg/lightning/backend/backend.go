@@ -29,6 +29,7 @@ import (
 	"github.com/pingcap/tidb/br/pkg/lightning/log"
 	"github.com/pingcap/tidb/br/pkg/lightning/metric"
 	"github.com/pingcap/tidb/br/pkg/lightning/mydump"
+	"github.com/pingcap/tidb/br/pkg/lightning/verification"
 	"github.com/pingcap/tidb/table"
 	"go.uber.org/zap"
 )
@@ -108,6 +109,28 @@ type EngineConfig struct {
 	Local *LocalEngineConfig
 }
 
+// DuplicateRow is a row which conflicts with other rows on the handle or a unique index.
+type DuplicateRow struct {
+	// IndexName is the name of the conflicting unique index, or "PRIMARY" if the rows share the same handle.
+	IndexName string
+	// Key is the conflicting KV key.
+	Key []byte
+	// RawRow is the encoded row value, empty if the row could not be found in TiKV.
+	RawRow []byte
+	// Row is the human-readable form of the decoded row.
+	Row string
+	// RowID is the row ID assigned to the row when it was read from the data source.
+	// It is zero if the row is read from TiKV instead.
+	RowID int64
+	// Offset is the offset of the row in its data file. It is only meaningful when RowID is not zero.
+	Offset int64
+	// Removed indicates whether the row has been removed by the duplicate resolution.
+	Removed bool
+}
+
+// DuplicateReporter receives the duplicate rows found by duplicate detection, in batches.
+type DuplicateReporter func(ctx context.Context, rows []DuplicateRow) error
+
 // LocalEngineConfig is the configuration used for local backend in OpenEngine.
 type LocalEngineConfig struct {
 	// compact small SSTs before ingest into pebble
@@ -197,12 +220,15 @@ type AbstractBackend interface {
 	LocalWriter(ctx context.Context, cfg *LocalWriterConfig, engineUUID uuid.UUID) (EngineWriter, error)
 
 	// CollectLocalDuplicateRows collect duplicate keys from local db. We will store the duplicate keys which
-	//  may be repeated with other keys in local data source.
-	CollectLocalDuplicateRows(ctx context.Context, tbl table.Table) error
+	//  may be repeated with other keys in local data source. The conflicting rows are passed to report and then
+	//  resolved by the configured duplicate resolution algorithm. It returns the checksum of the KV pairs of the
+	//  removed rows, which are counted in the local checksum but no longer in the table, or nil if none is removed.
+	CollectLocalDuplicateRows(ctx context.Context, tbl table.Table, opts *kv.SessionOptions, report DuplicateReporter) (*verification.KVChecksum, error)
 
-	// CollectLocalDuplicateRows collect duplicate keys from remote TiKV storage. This keys may be duplicate with
-	//  the data import by other lightning.
-	CollectRemoteDuplicateRows(ctx context.Context, tbl table.Table) error
+	// CollectRemoteDuplicateRows collect duplicate keys from remote TiKV storage. This keys may be duplicate with
+	//  the data import by other lightning. The conflicting rows are reported and resolved like
+	//  CollectLocalDuplicateRows.
+	CollectRemoteDuplicateRows(ctx context.Context, tbl table.Table, opts *kv.SessionOptions, report DuplicateReporter) (*verification.KVChecksum, error)
 }
 
 // Backend is the delivery target for Lightning
@@ -358,12 +384,12 @@ func (be Backend) OpenEngine(ctx context.Context, config *EngineConfig, tableNam
 	}, nil
 }
 
-func (be Backend) CollectLocalDuplicateRows(ctx context.Context, tbl table.Table) error {
-	return be.abstract.CollectLocalDuplicateRows(ctx, tbl)
+func (be Backend) CollectLocalDuplicateRows(ctx context.Context, tbl table.Table, opts *kv.SessionOptions, report DuplicateReporter) (*verification.KVChecksum, error) {
+	return be.abstract.CollectLocalDuplicateRows(ctx, tbl, opts, report)
 }
 
-func (be Backend) CollectRemoteDuplicateRows(ctx context.Context, tbl table.Table) error {
-	return be.abstract.CollectRemoteDuplicateRows(ctx, tbl)
+func (be Backend) CollectRemoteDuplicateRows(ctx context.Context, tbl table.Table, opts *kv.SessionOptions, report DuplicateReporter) (*verification.KVChecksum, error) {
+	return be.abstract.CollectRemoteDuplicateRows(ctx, tbl, opts, report)
 }
 
 // Close the opened engine to prepare it for importing.
@@ -29,6 +29,7 @@ import (
 	"github.com/pingcap/tidb/br/pkg/lightning/common"
 	"github.com/pingcap/tidb/br/pkg/lightning/log"
 	"github.com/pingcap/tidb/br/pkg/lightning/tikv"
+	"github.com/pingcap/tidb/br/pkg/lightning/verification"
 	"github.com/pingcap/tidb/br/pkg/version"
 	"github.com/pingcap/tidb/table"
 	"github.com/tikv/client-go/v2/oracle"
@@ -224,11 +225,11 @@ func (importer *importer) CleanupEngine(ctx context.Context, engineUUID uuid.UUI
 	return errors.Trace(err)
 }
 
-func (importer *importer) CollectLocalDuplicateRows(ctx context.Context, tbl table.Table) error {
+func (importer *importer) CollectLocalDuplicateRows(ctx context.Context, tbl table.Table, opts *kv.SessionOptions, report backend.DuplicateReporter) (*verification.KVChecksum, error) {
 	panic("Unsupported Operation")
 }
 
-func (importer *importer) CollectRemoteDuplicateRows(ctx context.Context, tbl table.Table) error {
+func (importer *importer) CollectRemoteDuplicateRows(ctx context.Context, tbl table.Table, opts *kv.SessionOptions, report backend.DuplicateReporter) (*verification.KVChecksum, error) {
 	panic("Unsupported Operation")
 }
 
@@ -310,6 +310,13 @@ func KvPairsFromRows(rows Rows) []common.KvPair {
 	return rows.(*KvPairs).pairs
 }
 
+// KvPairsFromRow converts a Row instance returned by an Encoder into a slice of
+// KvPair. This method panics if the Row is not constructed in such way.
+// nolint:golint // kv.KvPairsFromRow sounds good.
+func KvPairsFromRow(row Row) []common.KvPair {
+	return row.(*KvPairs).pairs
+}
+
 // Encode a row of data into KV pairs.
 //
 // See comments in `(*TableRestore).initializeColumns` for the meaning of the
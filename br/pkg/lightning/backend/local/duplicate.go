@@ -16,8 +16,8 @@ package local
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"io"
-	"sort"
 	"time"
 
 	"github.com/cockroachdb/pebble"
@@ -25,19 +25,25 @@ import (
 	"github.com/pingcap/kvproto/pkg/import_sstpb"
 	"github.com/pingcap/kvproto/pkg/kvrpcpb"
 	"github.com/pingcap/kvproto/pkg/metapb"
-	"github.com/pingcap/kvproto/pkg/tikvpb"
 	"github.com/pingcap/parser/model"
-	"github.com/pingcap/parser/mysql"
+	"github.com/pingcap/tidb/br/pkg/lightning/backend"
 	"github.com/pingcap/tidb/br/pkg/lightning/backend/kv"
 	"github.com/pingcap/tidb/br/pkg/lightning/common"
+	"github.com/pingcap/tidb/br/pkg/lightning/config"
 	"github.com/pingcap/tidb/br/pkg/lightning/log"
+	"github.com/pingcap/tidb/br/pkg/lightning/verification"
 	"github.com/pingcap/tidb/br/pkg/logutil"
 	"github.com/pingcap/tidb/br/pkg/restore"
 	"github.com/pingcap/tidb/distsql"
 	tidbkv "github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/codec"
 	"github.com/pingcap/tidb/util/ranger"
+	tikverr "github.com/tikv/client-go/v2/error"
+	tikvclient "github.com/tikv/client-go/v2/tikv"
+	"github.com/tikv/client-go/v2/txnkv/transaction"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
@@ -47,8 +53,8 @@ import (
 )
 
 const (
-	maxWriteBatchCount    = 128
-	maxGetRequestKeyCount = 1024
+	maxDuplicateReportBatch    = 256
+	maxDuplicateResolveTxnKeys = 4096
 )
 
 type DuplicateRequest struct {
@@ -87,23 +93,26 @@ func NewDuplicateManager(
 }
 
 func (manager *DuplicateManager) CollectDuplicateRowsFromTiKV(ctx context.Context, tbl table.Table) error {
-	log.L().Info("Begin collect duplicate data from remote TiKV")
-	reqs, err := buildDuplicateRequests(tbl.Meta())
+	// Collecting again after the resolution is planned would mix the repaired data in.
+	state, err := manager.loadResolutionState(tbl.Meta().ID)
 	if err != nil {
 		return err
 	}
-
-	decoder, err := kv.NewTableKVDecoder(tbl, &kv.SessionOptions{
-		SQLMode: mysql.ModeStrictAllTables,
-	})
+	if state != nil {
+		log.L().Info("skip collecting duplicate data from remote TiKV, the resolution is planned")
+		return nil
+	}
+	log.L().Info("Begin collect duplicate data from remote TiKV")
+	reqs, err := buildDuplicateRequests(tbl.Meta())
 	if err != nil {
 		return err
 	}
+
 	g, rpcctx := errgroup.WithContext(ctx)
 	for _, r := range reqs {
 		req := r
 		g.Go(func() error {
-			err := manager.sendRequestToTiKV(rpcctx, decoder, req)
+			err := manager.sendRequestToTiKV(rpcctx, req)
 			if err != nil {
 				log.L().Error("error occur when collect duplicate data from TiKV", zap.Error(err))
 			}
@@ -115,9 +124,7 @@ func (manager *DuplicateManager) CollectDuplicateRowsFromTiKV(ctx context.Contex
 	return err
 }
 
-func (manager *DuplicateManager) sendRequestToTiKV(ctx context.Context,
-	decoder *kv.TableKVDecoder,
-	req *DuplicateRequest) error {
+func (manager *DuplicateManager) sendRequestToTiKV(ctx context.Context, req *DuplicateRequest) error {
 	startKey := codec.EncodeBytes([]byte{}, req.start)
 	endKey := codec.EncodeBytes([]byte{}, req.end)
 
@@ -126,7 +133,6 @@ func (manager *DuplicateManager) sendRequestToTiKV(ctx context.Context,
 		return err
 	}
 	tryTimes := 0
-	indexHandles := make([][]byte, 0)
 	for {
 		if len(regions) == 0 {
 			break
@@ -166,15 +172,6 @@ func (manager *DuplicateManager) sendRequestToTiKV(ctx context.Context,
 			}
 		}
 
-		if len(indexHandles) > 0 {
-			handles := manager.getValues(ctx, indexHandles)
-			if len(handles) > 0 {
-				indexHandles = handles
-			} else {
-				indexHandles = indexHandles[:0]
-			}
-		}
-
 		for idx, cli := range waitingClients {
 			region := watingRegions[idx]
 			for {
@@ -221,13 +218,9 @@ func (manager *DuplicateManager) sendRequestToTiKV(ctx context.Context,
 					break
 				}
 
-				handles, err := manager.storeDuplicateData(ctx, resp, decoder, req)
-				if err != nil {
+				if err := manager.storeDuplicateData(resp); err != nil {
 					return err
 				}
-				if len(handles) > 0 {
-					indexHandles = append(indexHandles, handles...)
-				}
 			}
 		}
 
@@ -241,12 +234,9 @@ func (manager *DuplicateManager) sendRequestToTiKV(ctx context.Context,
 	return nil
 }
 
-func (manager *DuplicateManager) storeDuplicateData(
-	ctx context.Context,
-	resp *import_sstpb.DuplicateDetectResponse,
-	decoder *kv.TableKVDecoder,
-	req *DuplicateRequest,
-) ([][]byte, error) {
+// storeDuplicateData stores the pairs returned by TiKV into the duplicate db. The commit ts of each pair is
+// kept in the encoded key, so that the versions of the same key are ordered by the time they were written.
+func (manager *DuplicateManager) storeDuplicateData(resp *import_sstpb.DuplicateDetectResponse) error {
 	opts := &pebble.WriteOptions{Sync: false}
 	var err error
 	maxKeyLen := 0
@@ -259,239 +249,583 @@ func (manager *DuplicateManager) storeDuplicateData(
 	buf := make([]byte, maxKeyLen)
 	for i := 0; i < maxRetryTimes; i++ {
 		b := manager.db.NewBatch()
-		handles := make([][]byte, 0)
 		for _, kv := range resp.Pairs {
-			if req.indexInfo != nil {
-				h, err := decoder.DecodeHandleFromIndex(req.indexInfo, kv.Key, kv.Value)
-				if err != nil {
-					log.L().Error("decode handle error from index",
-						zap.Error(err), logutil.Key("key", kv.Key),
-						logutil.Key("value", kv.Value), zap.Uint64("commit-ts", kv.CommitTs))
-					continue
-				}
-				key := decoder.EncodeHandleKey(h)
-				handles = append(handles, key)
-			} else {
-				encodedKey := manager.keyAdapter.Encode(buf, kv.Key, 0, int64(kv.CommitTs))
-				b.Set(encodedKey, kv.Value, opts)
+			encodedKey := manager.keyAdapter.Encode(buf, kv.Key, 0, int64(kv.CommitTs))
+			if err = b.Set(encodedKey, kv.Value, opts); err != nil {
+				break
 			}
 		}
-		err = b.Commit(opts)
-		if err != nil {
-			continue
+		if err == nil {
+			err = b.Commit(opts)
 		}
 		b.Close()
-		if len(handles) == 0 {
-			return handles, nil
+		if err == nil {
+			return nil
 		}
-		return manager.getValues(ctx, handles), nil
 	}
-	return nil, err
+	return err
 }
 
-func (manager *DuplicateManager) ReportDuplicateData() error {
-	return nil
+// duplicateRow is a row involved in a conflict.
+type duplicateRow struct {
+	handle tidbkv.Handle
+	rawRow []byte
+	rowID  int64
+	offset int64
+	// pairs are all the KV pairs of the row, only filled when the row needs to be resolved.
+	pairs []common.KvPair
 }
 
-func (manager *DuplicateManager) RepairDuplicateData() error {
-	// TODO
-	return nil
+// duplicateGroup is a group of rows sharing the same record key or unique index key.
+type duplicateGroup struct {
+	key []byte
+	// indexInfo is nil if the rows share the same record key.
+	indexInfo *model.IndexInfo
+	rows      []*duplicateRow
+}
+
+// resolutionPair is a KV pair written into TiKV by the duplicate resolution.
+type resolutionPair struct {
+	Key []byte
+	Val []byte
+}
+
+// resolutionPlanEntry is the planned resolution of a duplicate group.
+type resolutionPlanEntry struct {
+	// Rows are the rows of the group to report.
+	Rows    []backend.DuplicateRow
+	Deletes [][]byte
+	Sets    []resolutionPair
+}
+
+// resolutionState is the progress of the duplicate resolution of a table.
+type resolutionState struct {
+	// Applied is the number of the plan entries which have been applied to TiKV and reported.
+	Applied  uint64
+	Resolved bool
+	// Bytes, KVs and Checksum are the checksum of the KV pairs of the removed rows.
+	Bytes    uint64
+	KVs      uint64
+	Checksum uint64
+}
+
+// The keys of the duplicate db collected from TiKV or the local data source are encoded TiDB keys, which never
+// start with 0xff, so they don't mix with the keys of the resolution plan and state.
+var (
+	resolutionPlanPrefix  = []byte("\xffresolution_plan")
+	resolutionStatePrefix = []byte("\xffresolution_state")
+)
+
+func resolutionPlanKey(tableID int64, seq uint64) []byte {
+	key := codec.EncodeInt(append([]byte{}, resolutionPlanPrefix...), tableID)
+	return codec.EncodeUint(key, seq)
+}
+
+func resolutionPlanRange(tableID int64) (start, end []byte) {
+	start = codec.EncodeInt(append([]byte{}, resolutionPlanPrefix...), tableID)
+	return start, tidbkv.Key(start).PrefixNext()
+}
+
+func resolutionStateKey(tableID int64) []byte {
+	return codec.EncodeInt(append([]byte{}, resolutionStatePrefix...), tableID)
 }
 
-// Collect rows by read the index in db.
-func (manager *DuplicateManager) CollectDuplicateRowsFromLocalIndex(
+// loadResolutionState returns the saved progress of the duplicate resolution of the table, or nil if the
+// resolution hasn't been planned.
+func (manager *DuplicateManager) loadResolutionState(tableID int64) (*resolutionState, error) {
+	value, closer, err := manager.db.Get(resolutionStateKey(tableID))
+	if err != nil {
+		if err == pebble.ErrNotFound {
+			return nil, nil
+		}
+		return nil, errors.Trace(err)
+	}
+	defer closer.Close()
+	state := &resolutionState{}
+	if err := json.Unmarshal(value, state); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return state, nil
+}
+
+func (manager *DuplicateManager) saveResolutionState(tableID int64, state *resolutionState) error {
+	value, err := json.Marshal(state)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(manager.db.Set(resolutionStateKey(tableID), value, pebble.Sync))
+}
+
+// ReportDuplicateData reports the duplicate rows of the table collected in the duplicate db, and repairs them
+// with the given duplicate resolution algorithm. Only the conflicts on the handle and the unique indexes are
+// reported, the conflicts on the other indexes are consequences of them. The rows are encoded with the session
+// options of the import. It returns the checksum of the KV pairs of the removed rows, or nil if none is removed.
+//
+// The resolution is planned before TiKV is changed, since the decisions are made upon the rows in TiKV. The plan
+// and the progress of applying it are saved in the duplicate db, so that the resolution resumes from the last
+// applied batch if it is interrupted. The rows of that batch may be reported again.
+func (manager *DuplicateManager) ReportDuplicateData(
 	ctx context.Context,
 	tbl table.Table,
-	db *pebble.DB,
-) error {
-	decoder, err := kv.NewTableKVDecoder(tbl, &kv.SessionOptions{
-		SQLMode: mysql.ModeStrictAllTables,
-	})
+	opts *kv.SessionOptions,
+	store *tikvclient.KVStore,
+	algorithm string,
+	report backend.DuplicateReporter,
+) (*verification.KVChecksum, error) {
+	tableID := tbl.Meta().ID
+	reqs, err := buildDuplicateRequests(tbl.Meta())
 	if err != nil {
-		return err
+		return nil, err
 	}
-	handles := make([][]byte, 0)
-	allRanges := make([]tidbkv.KeyRange, 0)
-	for _, indexInfo := range tbl.Meta().Indices {
-		if indexInfo.State != model.StatePublic {
-			continue
+	state, err := manager.loadResolutionState(tableID)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		if state, err = manager.planDuplicateResolution(ctx, tbl, opts, reqs, store, algorithm); err != nil {
+			return nil, err
+		}
+	} else {
+		log.L().Info("resume duplicate resolution", zap.Int64("tableID", tableID),
+			zap.Uint64("applied", state.Applied), zap.Bool("resolved", state.Resolved))
+	}
+	if !state.Resolved {
+		if err := manager.applyDuplicateResolution(ctx, tableID, store, state, report); err != nil {
+			return nil, err
 		}
-		ranges := ranger.FullRange()
-		keysRanges, err := distsql.IndexRangesToKVRanges(nil, tbl.Meta().ID, indexInfo.ID, ranges, nil)
+	}
+
+	// The collected data of the table is no longer needed once the resolution is planned.
+	for _, r := range reqs {
+		start := codec.EncodeBytes(nil, r.start)
+		end := codec.EncodeBytes(nil, r.end)
+		if err := manager.db.DeleteRange(start, end, &pebble.WriteOptions{Sync: false}); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	if state.KVs == 0 {
+		return nil, nil
+	}
+	removed := verification.MakeKVChecksum(state.Bytes, state.KVs, state.Checksum)
+	return &removed, nil
+}
+
+// planDuplicateResolution resolves the duplicate groups of the table one by one, and saves the reports and the
+// mutations of TiKV into the duplicate db instead of applying them. The later groups read the rows through the
+// mutations of the former ones, as if they were applied.
+func (manager *DuplicateManager) planDuplicateResolution(
+	ctx context.Context,
+	tbl table.Table,
+	opts *kv.SessionOptions,
+	reqs []*DuplicateRequest,
+	store *tikvclient.KVStore,
+	algorithm string,
+) (*resolutionState, error) {
+	decoder, err := kv.NewTableKVDecoder(tbl, opts)
+	if err != nil {
+		return nil, err
+	}
+	// The rows are encoded like they were imported, so that the pairs agree with the local checksum.
+	encoder, err := kv.NewTableKVEncoder(tbl, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer encoder.Close()
+
+	// TiKV isn't changed until the plan is complete, so an interrupted plan is simply made again.
+	tableID := tbl.Meta().ID
+	planStart, planEnd := resolutionPlanRange(tableID)
+	if err := manager.db.DeleteRange(planStart, planEnd, pebble.Sync); err != nil {
+		return nil, errors.Trace(err)
+	}
+	txn, err := store.Begin()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer func() {
+		_ = txn.Rollback()
+	}()
+
+	// planned holds the record keys changed by the planned mutations, the deleted ones are nil.
+	planned := make(map[string][]byte)
+	removed := verification.NewKVChecksum(0)
+	batch := manager.db.NewBatch()
+	seq := uint64(0)
+	err = manager.iterDuplicateGroups(ctx, decoder, reqs, func(group *duplicateGroup) error {
+		if group.indexInfo != nil {
+			if err := loadDuplicateRows(ctx, txn, planned, decoder, group); err != nil {
+				return err
+			}
+		}
+		entry, err := manager.RepairDuplicateData(decoder, encoder, group, algorithm)
 		if err != nil {
 			return err
 		}
-		allRanges = append(allRanges, keysRanges...)
-		for _, r := range keysRanges {
-			startKey := codec.EncodeBytes([]byte{}, r.StartKey)
-			endKey := codec.EncodeBytes([]byte{}, r.EndKey)
-			opts := &pebble.IterOptions{
-				LowerBound: startKey,
-				UpperBound: endKey,
+		for i, row := range group.rows {
+			if entry.Rows[i].Removed {
+				removed.Update(row.pairs)
 			}
-			log.L().Warn("collect index from db",
-				logutil.Key("start", startKey),
-				logutil.Key("end", endKey),
-			)
-
-			iter := db.NewIter(opts)
-			for iter.SeekGE(startKey); iter.Valid(); iter.Next() {
-				rawKey, _, _, err := manager.keyAdapter.Decode(nil, iter.Key())
-				if err != nil {
-					log.L().Warn(
-						"decode key error when query handle for duplicate index",
-						zap.Binary("key", iter.Key()),
-					)
-					continue
-				}
-				value := iter.Value()
-				h, err := decoder.DecodeHandleFromIndex(indexInfo, rawKey, value)
-				if err != nil {
-					log.L().Error("decode handle error from index for duplicatedb",
-						zap.Error(err), logutil.Key("rawKey", rawKey),
-						logutil.Key("value", value))
-					continue
-				}
-				key := decoder.EncodeHandleKey(h)
-				handles = append(handles, key)
-				if len(handles) > maxGetRequestKeyCount {
-					handles = manager.getValues(ctx, handles)
-				}
+		}
+		for _, key := range entry.Deletes {
+			if tablecodec.IsRecordKey(key) {
+				planned[string(key)] = nil
 			}
-			if len(handles) > 0 {
-				handles = manager.getValues(ctx, handles)
+		}
+		for _, pair := range entry.Sets {
+			if tablecodec.IsRecordKey(pair.Key) {
+				planned[string(pair.Key)] = pair.Val
 			}
-			if len(handles) == 0 {
-				db.DeleteRange(r.StartKey, r.EndKey, &pebble.WriteOptions{Sync: false})
+		}
+
+		value, err := json.Marshal(entry)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if err := batch.Set(resolutionPlanKey(tableID, seq), value, nil); err != nil {
+			return errors.Trace(err)
+		}
+		seq++
+		if batch.Count() >= maxDuplicateReportBatch {
+			if err := batch.Commit(pebble.NoSync); err != nil {
+				return errors.Trace(err)
 			}
-			iter.Close()
+			batch = manager.db.NewBatch()
 		}
-	}
-	if len(handles) == 0 {
 		return nil
+	})
+	if err == nil {
+		err = batch.Commit(pebble.Sync)
+	}
+	if closeErr := batch.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
 	}
 
-	for i := 0; i < maxRetryTimes; i++ {
-		handles = manager.getValues(ctx, handles)
-		if len(handles) == 0 {
-			for _, r := range allRanges {
-				db.DeleteRange(r.StartKey, r.EndKey, &pebble.WriteOptions{Sync: false})
-			}
+	state := &resolutionState{
+		Resolved: seq == 0,
+		Bytes:    removed.SumSize(),
+		KVs:      removed.SumKVS(),
+		Checksum: removed.Sum(),
+	}
+	if seq > 0 {
+		log.L().Info("duplicate resolution planned", zap.Int64("tableID", tableID), zap.Uint64("groups", seq),
+			zap.String("algorithm", algorithm), zap.Object("removed", removed))
+		if err := manager.saveResolutionState(tableID, state); err != nil {
+			return nil, err
 		}
 	}
-	return errors.Errorf("retry getValues time exceed limit")
+	return state, nil
 }
 
-func (manager *DuplicateManager) getValues(
+// applyDuplicateResolution applies the planned resolution of the table from the last applied entry. The entries
+// are applied in batches, each of which is committed into TiKV and reported before its progress is saved.
+func (manager *DuplicateManager) applyDuplicateResolution(
 	ctx context.Context,
-	handles [][]byte,
-) [][]byte {
-	retryHandles := make([][]byte, 0)
-	sort.Slice(handles, func(i, j int) bool {
-		return bytes.Compare(handles[i], handles[j]) < 0
+	tableID int64,
+	store *tikvclient.KVStore,
+	state *resolutionState,
+	report backend.DuplicateReporter,
+) error {
+	planStart, planEnd := resolutionPlanRange(tableID)
+	iter := manager.db.NewIter(&pebble.IterOptions{
+		LowerBound: resolutionPlanKey(tableID, state.Applied),
+		UpperBound: planEnd,
 	})
-	l := len(handles)
-	startKey := codec.EncodeBytes([]byte{}, handles[0])
-	endKey := codec.EncodeBytes([]byte{}, nextKey(handles[l-1]))
-	regions, err := paginateScanRegion(ctx, manager.splitCli, startKey, endKey, scanRegionLimit)
+	defer iter.Close()
+
+	txn, err := store.Begin()
 	if err != nil {
-		log.L().Error("scan regions errors", zap.Error(err))
-		return handles
-	}
-	startIdx := 0
-	endIdx := 0
-	batch := make([][]byte, 0)
-	for _, region := range regions {
-		if startIdx >= l {
-			break
+		return errors.Trace(err)
+	}
+	reports := make([]backend.DuplicateRow, 0, maxDuplicateReportBatch)
+	applied := state.Applied
+	flush := func() error {
+		var err error
+		if txn.Len() > 0 {
+			err = txn.Commit(ctx)
+		} else {
+			err = txn.Rollback()
 		}
-		handleKey := codec.EncodeBytes([]byte{}, handles[startIdx])
-		if bytes.Compare(handleKey, region.Region.EndKey) >= 0 {
-			continue
+		if err != nil {
+			return errors.Trace(err)
 		}
-		endIdx = startIdx
-		for endIdx < l {
-			handleKey := codec.EncodeBytes([]byte{}, handles[endIdx])
-			if bytes.Compare(handleKey, region.Region.EndKey) < 0 {
-				batch = append(batch, handles[endIdx])
-				endIdx++
-			} else {
-				break
+		if err := report(ctx, reports); err != nil {
+			return err
+		}
+		reports = reports[:0]
+		state.Applied = applied
+		if err := manager.saveResolutionState(tableID, state); err != nil {
+			return err
+		}
+		txn, err = store.Begin()
+		return errors.Trace(err)
+	}
+	for iter.First(); iter.Valid(); iter.Next() {
+		if err := ctx.Err(); err != nil {
+			_ = txn.Rollback()
+			return err
+		}
+		var entry resolutionPlanEntry
+		if err := json.Unmarshal(iter.Value(), &entry); err != nil {
+			_ = txn.Rollback()
+			return errors.Trace(err)
+		}
+		for _, key := range entry.Deletes {
+			if err := txn.Delete(key); err != nil {
+				_ = txn.Rollback()
+				return errors.Trace(err)
 			}
 		}
-		if err := manager.getValuesFromRegion(ctx, region, batch); err != nil {
-			log.L().Error("failed to collect values from TiKV by handle, we will retry it again", zap.Error(err))
-			retryHandles = append(retryHandles, batch...)
+		for _, pair := range entry.Sets {
+			if err := txn.Set(pair.Key, pair.Val); err != nil {
+				_ = txn.Rollback()
+				return errors.Trace(err)
+			}
+		}
+		reports = append(reports, entry.Rows...)
+		applied++
+		if len(reports) >= maxDuplicateReportBatch || txn.Len() >= maxDuplicateResolveTxnKeys {
+			if err := flush(); err != nil {
+				return err
+			}
 		}
-		startIdx = endIdx
 	}
-	return retryHandles
-}
+	if err := iter.Error(); err != nil {
+		_ = txn.Rollback()
+		return errors.Trace(err)
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	_ = txn.Rollback()
 
-func (manager *DuplicateManager) getValuesFromRegion(
-	ctx context.Context,
-	region *restore.RegionInfo,
-	handles [][]byte,
-) error {
-	kvclient, err := manager.getKvClient(ctx, region.Leader)
-	if err != nil {
+	state.Resolved = true
+	if err := manager.saveResolutionState(tableID, state); err != nil {
 		return err
 	}
-	reqCtx := &kvrpcpb.Context{
-		RegionId:    region.Region.GetId(),
-		RegionEpoch: region.Region.GetRegionEpoch(),
-		Peer:        region.Leader,
+	return errors.Trace(manager.db.DeleteRange(planStart, planEnd, &pebble.WriteOptions{Sync: false}))
+}
+
+// RepairDuplicateData resolves a group of duplicate rows with the given algorithm. The KV pairs of the rows to
+// remove are to be deleted, except those shared with the kept row, and the KV pairs of the kept row are to be
+// written again, since TiKV may hold another version of the conflicting key. Only the rows found in TiKV can be
+// kept. It returns the rows to report and the mutations of TiKV.
+func (manager *DuplicateManager) RepairDuplicateData(
+	decoder *kv.TableKVDecoder,
+	encoder kv.Encoder,
+	group *duplicateGroup,
+	algorithm string,
+) (*resolutionPlanEntry, error) {
+	entry := &resolutionPlanEntry{Rows: make([]backend.DuplicateRow, len(group.rows))}
+	indexName := "PRIMARY"
+	if group.indexInfo != nil {
+		indexName = group.indexInfo.Name.O
+	}
+	found := make([]int, 0, len(group.rows))
+	for i, row := range group.rows {
+		r := backend.DuplicateRow{
+			IndexName: indexName,
+			Key:       group.key,
+			RawRow:    row.rawRow,
+			RowID:     row.rowID,
+			Offset:    row.offset,
+		}
+		if len(row.rawRow) > 0 {
+			datums, _, err := decoder.DecodeRawRowData(row.handle, row.rawRow)
+			if err != nil {
+				log.L().Warn("decode duplicate row failed", zap.Error(err),
+					logutil.Key("key", group.key), zap.Stringer("handle", row.handle))
+			} else {
+				r.Row = types.DatumsToStrNoErr(datums)
+			}
+			found = append(found, i)
+		}
+		entry.Rows[i] = r
 	}
 
-	req := &kvrpcpb.BatchGetRequest{
-		Context: reqCtx,
-		Keys:    handles,
-		Version: manager.ts,
+	kept := -1
+	switch algorithm {
+	case config.DupeResAlgNone:
+		return entry, nil
+	case config.DupeResAlgKeepFirst:
+		if len(found) > 0 {
+			kept = found[0]
+		}
+	case config.DupeResAlgKeepLast:
+		if len(found) > 0 {
+			kept = found[len(found)-1]
+		}
+	case config.DupeResAlgDeleteAll:
+	default:
+		return nil, errors.Errorf("unknown duplicate resolution algorithm %s", algorithm)
 	}
-	resp, err := kvclient.KvBatchGet(ctx, req)
-	if err != nil {
-		return err
+
+	for _, i := range found {
+		row := group.rows[i]
+		pairs, err := encodeDuplicateRow(decoder, encoder, row)
+		if err != nil {
+			return nil, err
+		}
+		row.pairs = pairs
 	}
-	if resp.GetRegionError() != nil {
-		return errors.Errorf("region error because of %v", resp.GetRegionError().GetMessage())
+
+	keptKeys := make(map[string]struct{})
+	var keptRow *duplicateRow
+	if kept >= 0 {
+		keptRow = group.rows[kept]
+		for _, pair := range keptRow.pairs {
+			keptKeys[string(pair.Key)] = struct{}{}
+		}
 	}
-	if resp.Error != nil {
-		return errors.Errorf("key error")
+	for _, i := range found {
+		row := group.rows[i]
+		if i == kept {
+			continue
+		}
+		// The same row may be referred by several versions of a unique index key.
+		if keptRow != nil && row.handle.Equal(keptRow.handle) && bytes.Equal(row.rawRow, keptRow.rawRow) {
+			continue
+		}
+		for _, pair := range row.pairs {
+			if _, ok := keptKeys[string(pair.Key)]; ok {
+				continue
+			}
+			entry.Deletes = append(entry.Deletes, pair.Key)
+		}
+		entry.Rows[i].Removed = true
 	}
-
-	maxKeyLen := 0
-	for _, kv := range resp.Pairs {
-		l := manager.keyAdapter.EncodedLen(kv.Key)
-		if l > maxKeyLen {
-			maxKeyLen = l
+	if keptRow != nil {
+		for _, pair := range keptRow.pairs {
+			entry.Sets = append(entry.Sets, resolutionPair{Key: pair.Key, Val: pair.Val})
 		}
 	}
-	buf := make([]byte, maxKeyLen)
+	return entry, nil
+}
 
-	log.L().Error("get keys", zap.Int("key size", len(resp.Pairs)))
-	for i := 0; i < maxRetryTimes; i++ {
-		b := manager.db.NewBatch()
-		opts := &pebble.WriteOptions{Sync: false}
-		for _, kv := range resp.Pairs {
-			encodedKey := manager.keyAdapter.Encode(buf, kv.Key, 0, 0)
-			b.Set(encodedKey, kv.Value, opts)
-			if b.Count() > maxWriteBatchCount {
-				err = b.Commit(opts)
-				if err != nil {
-					break
-				} else {
-					b.Reset()
+// iterDuplicateGroups iterates the duplicate db within the given ranges, and calls fn on each group of rows
+// sharing the same key. The rows in a group are ordered by their row ID and offset, or by their commit ts if
+// they come from TiKV.
+func (manager *DuplicateManager) iterDuplicateGroups(
+	ctx context.Context,
+	decoder *kv.TableKVDecoder,
+	reqs []*DuplicateRequest,
+	fn func(group *duplicateGroup) error,
+) error {
+	for _, r := range reqs {
+		if r.indexInfo != nil && !r.indexInfo.Unique {
+			continue
+		}
+		opts := &pebble.IterOptions{
+			LowerBound: codec.EncodeBytes(nil, r.start),
+			UpperBound: codec.EncodeBytes(nil, r.end),
+		}
+		iter := manager.db.NewIter(opts)
+		var group *duplicateGroup
+		flush := func() error {
+			if group == nil || len(group.rows) < 2 {
+				return nil
+			}
+			return fn(group)
+		}
+		for iter.First(); iter.Valid(); iter.Next() {
+			if err := ctx.Err(); err != nil {
+				_ = iter.Close()
+				return err
+			}
+			key, rowID, offset, err := manager.keyAdapter.Decode(nil, iter.Key())
+			if err != nil {
+				log.L().Warn("decode key error when iterating duplicate db",
+					zap.Error(err), logutil.Key("key", iter.Key()))
+				continue
+			}
+			value := append([]byte{}, iter.Value()...)
+			row := &duplicateRow{rowID: rowID, offset: offset}
+			if rowID == 0 {
+				// the offset of a pair from TiKV is its commit ts.
+				row.offset = 0
+			}
+			if r.indexInfo == nil {
+				row.handle, err = decoder.DecodeHandleFromTable(key)
+				row.rawRow = value
+			} else {
+				row.handle, err = decoder.DecodeHandleFromIndex(r.indexInfo, key, value)
+			}
+			if err != nil {
+				log.L().Warn("decode handle error when iterating duplicate db",
+					zap.Error(err), logutil.Key("key", key), logutil.Key("value", value))
+				continue
+			}
+			if group == nil || !bytes.Equal(group.key, key) {
+				if err := flush(); err != nil {
+					_ = iter.Close()
+					return err
 				}
+				group = &duplicateGroup{key: key, indexInfo: r.indexInfo}
 			}
+			group.rows = append(group.rows, row)
 		}
-		if err == nil {
-			err = b.Commit(opts)
+		err := flush()
+		if closeErr := iter.Close(); err == nil {
+			err = closeErr
 		}
-		if err == nil {
-			return nil
+		if err != nil {
+			return errors.Trace(err)
 		}
 	}
-	return err
+	return nil
+}
+
+// loadDuplicateRows reads the rows referred by a group of conflicting index pairs from TiKV, or from the record
+// keys changed by the planned resolution.
+func loadDuplicateRows(
+	ctx context.Context,
+	txn *transaction.KVTxn,
+	planned map[string][]byte,
+	decoder *kv.TableKVDecoder,
+	group *duplicateGroup,
+) error {
+	for _, row := range group.rows {
+		key := decoder.EncodeHandleKey(row.handle)
+		if value, ok := planned[string(key)]; ok {
+			row.rawRow = value
+			continue
+		}
+		value, err := txn.Get(ctx, key)
+		if err != nil {
+			if tikverr.IsErrNotFound(err) {
+				continue
+			}
+			return errors.Trace(err)
+		}
+		row.rawRow = value
+	}
+	return nil
+}
+
+// encodeDuplicateRow encodes a row back to all of its KV pairs, including the index pairs.
+func encodeDuplicateRow(decoder *kv.TableKVDecoder, encoder kv.Encoder, row *duplicateRow) ([]common.KvPair, error) {
+	datums, _, err := decoder.DecodeRawRowData(row.handle, row.rawRow)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	colCount := len(datums)
+	permutation := make([]int, colCount+1)
+	for i := range permutation {
+		permutation[i] = i
+	}
+	if row.handle.IsInt() {
+		// Only used by tables with auto row ID, the datum is ignored otherwise.
+		datums = append(datums, types.NewIntDatum(row.handle.IntValue()))
+	} else {
+		permutation[colCount] = -1
+	}
+	encoded, err := encoder.Encode(log.L(), datums, row.rowID, permutation, row.offset)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return kv.KvPairsFromRow(encoded), nil
 }
 
 func (manager *DuplicateManager) getDuplicateStream(ctx context.Context,
@@ -522,16 +856,6 @@ func (manager *DuplicateManager) getDuplicateStream(ctx context.Context,
 	return stream, err
 }
 
-func (manager *DuplicateManager) getKvClient(ctx context.Context, peer *metapb.Peer) (tikvpb.TikvClient, error) {
-	conn, err := manager.connPool.GetGrpcConn(ctx, peer.GetStoreId(), 1, func(ctx context.Context) (*grpc.ClientConn, error) {
-		return manager.makeConn(ctx, peer.GetStoreId())
-	})
-	if err != nil {
-		return nil, err
-	}
-	return tikvpb.NewTikvClient(conn), nil
-}
-
 func (manager *DuplicateManager) getImportClient(ctx context.Context, peer *metapb.Peer) (import_sstpb.ImportSSTClient, error) {
 	conn, err := manager.connPool.GetGrpcConn(ctx, peer.GetStoreId(), 1, func(ctx context.Context) (*grpc.ClientConn, error) {
 		return manager.makeConn(ctx, peer.GetStoreId())
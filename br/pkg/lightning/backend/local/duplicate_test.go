@@ -0,0 +1,306 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/cockroachdb/pebble"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/parser"
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/model"
+	"github.com/pingcap/parser/mysql"
+	"github.com/pingcap/tidb/br/pkg/lightning/backend"
+	"github.com/pingcap/tidb/br/pkg/lightning/backend/kv"
+	"github.com/pingcap/tidb/br/pkg/lightning/common"
+	"github.com/pingcap/tidb/br/pkg/lightning/config"
+	"github.com/pingcap/tidb/br/pkg/lightning/log"
+	"github.com/pingcap/tidb/br/pkg/lightning/verification"
+	"github.com/pingcap/tidb/ddl"
+	tidbkv "github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/pingcap/tidb/store/mockstore/unistore"
+	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/table/tables"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/types"
+	tmock "github.com/pingcap/tidb/util/mock"
+	tikverr "github.com/tikv/client-go/v2/error"
+	tikvclient "github.com/tikv/client-go/v2/tikv"
+)
+
+type duplicateSuite struct{}
+
+// The mock TiKV stores the rows in the new row format, so they are encoded in it to be checksummed.
+var duplicateSessionOptions = &kv.SessionOptions{
+	SQLMode: mysql.ModeStrictAllTables,
+	SysVars: map[string]string{variable.TiDBRowFormatVersion: strconv.Itoa(variable.DefTiDBRowFormatV2)},
+}
+
+var _ = Suite(&duplicateSuite{})
+
+func (s *duplicateSuite) newTable(c *C) table.Table {
+	node, err := parser.New().ParseOneStmt(
+		"create table t (a int primary key, b int, c varchar(10), unique key uk(b), key idx(c))", "", "")
+	c.Assert(err, IsNil)
+	tblInfo, err := ddl.MockTableInfo(tmock.NewContext(), node.(*ast.CreateTableStmt), 1)
+	c.Assert(err, IsNil)
+	tblInfo.State = model.StatePublic
+	for _, idx := range tblInfo.Indices {
+		idx.State = model.StatePublic
+	}
+	tbl, err := tables.TableFromMeta(kv.NewPanickingAllocators(0), tblInfo)
+	c.Assert(err, IsNil)
+	return tbl
+}
+
+// prepareDuplicates imports the rows like the local backend with duplicate detection enabled: for each key only
+// the first pair is written into TiKV, and all the pairs of a duplicate key are saved into the duplicate db. It
+// returns the local checksum of the rows.
+func (s *duplicateSuite) prepareDuplicates(
+	c *C,
+	tbl table.Table,
+	store *tikvclient.KVStore,
+	db *pebble.DB,
+	rows [][]types.Datum,
+) *verification.KVChecksum {
+	encoder, err := kv.NewTableKVEncoder(tbl, duplicateSessionOptions)
+	c.Assert(err, IsNil)
+	defer encoder.Close()
+
+	var pairs []common.KvPair
+	for i, row := range rows {
+		encoded, err := encoder.Encode(log.L(), row, int64(i+1), []int{0, 1, 2, -1}, int64(i*100))
+		c.Assert(err, IsNil)
+		pairs = append(pairs, kv.KvPairsFromRow(encoded)...)
+	}
+	checksum := verification.NewKVChecksum(0)
+	checksum.Update(pairs)
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return bytes.Compare(pairs[i].Key, pairs[j].Key) < 0
+	})
+
+	txn, err := store.Begin()
+	c.Assert(err, IsNil)
+	keyAdapter := duplicateKeyAdapter{}
+	for i := 0; i < len(pairs); {
+		j := i + 1
+		for j < len(pairs) && bytes.Equal(pairs[i].Key, pairs[j].Key) {
+			j++
+		}
+		c.Assert(txn.Set(pairs[i].Key, pairs[i].Val), IsNil)
+		if j-i > 1 {
+			for _, p := range pairs[i:j] {
+				c.Assert(db.Set(keyAdapter.Encode(nil, p.Key, p.RowID, p.Offset), p.Val, nil), IsNil)
+			}
+		}
+		i = j
+	}
+	c.Assert(txn.Commit(context.Background()), IsNil)
+	return checksum
+}
+
+// tableChecksum returns the checksum of all the KV pairs of the table in TiKV.
+func (s *duplicateSuite) tableChecksum(c *C, tbl table.Table, store *tikvclient.KVStore) *verification.KVChecksum {
+	txn, err := store.Begin()
+	c.Assert(err, IsNil)
+	defer txn.Rollback()
+	prefix := tablecodec.GenTablePrefix(tbl.Meta().ID)
+	it, err := txn.Iter(prefix, prefix.PrefixNext())
+	c.Assert(err, IsNil)
+	defer it.Close()
+	checksum := verification.NewKVChecksum(0)
+	for ; it.Valid(); c.Assert(it.Next(), IsNil) {
+		checksum.UpdateOne(common.KvPair{Key: it.Key(), Val: it.Value()})
+	}
+	return checksum
+}
+
+func (s *duplicateSuite) TestReportAndRepairDuplicateData(c *C) {
+	ctx := context.Background()
+	client, pdClient, cluster, err := unistore.New("")
+	c.Assert(err, IsNil)
+	unistore.BootstrapWithSingleStore(cluster)
+	store, err := tikvclient.NewTestTiKVStore(client, pdClient, nil, nil, 0)
+	c.Assert(err, IsNil)
+	defer store.Close()
+
+	db, err := pebble.Open(filepath.Join(c.MkDir(), "duplicates"), &pebble.Options{})
+	c.Assert(err, IsNil)
+	defer db.Close()
+
+	tbl := s.newTable(c)
+	rows := [][]types.Datum{
+		types.MakeDatums(1, 10, "a"),
+		// conflicts with the first row on the unique key uk.
+		types.MakeDatums(2, 10, "b"),
+		types.MakeDatums(3, 30, "c"),
+		// conflicts with the third row on the primary key.
+		types.MakeDatums(3, 40, "d"),
+	}
+	localChecksum := s.prepareDuplicates(c, tbl, store, db, rows)
+
+	var reported []backend.DuplicateRow
+	report := func(ctx context.Context, rows []backend.DuplicateRow) error {
+		reported = append(reported, rows...)
+		return nil
+	}
+	manager := &DuplicateManager{db: db, keyAdapter: duplicateKeyAdapter{}}
+	removed, err := manager.ReportDuplicateData(ctx, tbl, duplicateSessionOptions, store, config.DupeResAlgKeepFirst, report)
+	c.Assert(err, IsNil)
+	c.Assert(removed, NotNil)
+
+	c.Assert(reported, HasLen, 4)
+	sort.Slice(reported, func(i, j int) bool {
+		return reported[i].RowID < reported[j].RowID
+	})
+	expected := []struct {
+		indexName string
+		row       string
+		removed   bool
+	}{
+		{"uk", "(1, 10, a)", false},
+		{"uk", "(2, 10, b)", true},
+		{"PRIMARY", "(3, 30, c)", false},
+		{"PRIMARY", "(3, 40, d)", true},
+	}
+	for i, e := range expected {
+		r := reported[i]
+		c.Assert(r.IndexName, Equals, e.indexName)
+		c.Assert(r.Row, Equals, e.row)
+		c.Assert(r.Removed, Equals, e.removed)
+		c.Assert(r.RowID, Equals, int64(i+1))
+		c.Assert(r.Offset, Equals, int64(i*100))
+	}
+
+	// Only the first row of each conflict remains, without any dangling index.
+	txn, err := store.Begin()
+	c.Assert(err, IsNil)
+	it, err := txn.Iter(tbl.RecordPrefix(), tbl.RecordPrefix().PrefixNext())
+	c.Assert(err, IsNil)
+	recordCount := 0
+	for ; it.Valid(); c.Assert(it.Next(), IsNil) {
+		recordCount++
+	}
+	it.Close()
+	c.Assert(recordCount, Equals, 2)
+	_, err = txn.Get(ctx, tablecodec.EncodeRecordKey(tbl.RecordPrefix(), tidbkv.IntHandle(2)))
+	c.Assert(tikverr.IsErrNotFound(err), IsTrue)
+	indexPrefix := tablecodec.GenTableIndexPrefix(tbl.Meta().ID)
+	it, err = txn.Iter(indexPrefix, indexPrefix.PrefixNext())
+	c.Assert(err, IsNil)
+	indexCount := 0
+	for ; it.Valid(); c.Assert(it.Next(), IsNil) {
+		indexCount++
+	}
+	it.Close()
+	// uk(10), uk(30), idx(a), idx(c)
+	c.Assert(indexCount, Equals, 4)
+	c.Assert(txn.Rollback(), IsNil)
+
+	// The local checksum agrees with the table once the removed rows are subtracted.
+	localChecksum.Sub(removed)
+	c.Assert(s.tableChecksum(c, tbl, store), DeepEquals, localChecksum)
+
+	// The collected data and the plan of the table are cleaned up, only the resolved state is kept.
+	iter := db.NewIter(&pebble.IterOptions{})
+	c.Assert(iter.First(), IsTrue)
+	c.Assert(iter.Key(), BytesEquals, resolutionStateKey(tbl.Meta().ID))
+	c.Assert(iter.Next(), IsFalse)
+	c.Assert(iter.Close(), IsNil)
+
+	// Reporting again returns the same checksum without reporting or repairing anything.
+	reported = reported[:0]
+	again, err := manager.ReportDuplicateData(ctx, tbl, duplicateSessionOptions, store, config.DupeResAlgKeepFirst, report)
+	c.Assert(err, IsNil)
+	c.Assert(again, DeepEquals, removed)
+	c.Assert(reported, HasLen, 0)
+}
+
+func (s *duplicateSuite) TestResumeDuplicateResolution(c *C) {
+	ctx := context.Background()
+	client, pdClient, cluster, err := unistore.New("")
+	c.Assert(err, IsNil)
+	unistore.BootstrapWithSingleStore(cluster)
+	store, err := tikvclient.NewTestTiKVStore(client, pdClient, nil, nil, 0)
+	c.Assert(err, IsNil)
+	defer store.Close()
+
+	dbPath := filepath.Join(c.MkDir(), "duplicates")
+	db, err := pebble.Open(dbPath, &pebble.Options{})
+	c.Assert(err, IsNil)
+
+	tbl := s.newTable(c)
+	groups := maxDuplicateReportBatch
+	rows := make([][]types.Datum, 0, groups*2+1)
+	for i := 0; i < groups; i++ {
+		rows = append(rows, types.MakeDatums(i, i, "a"))
+	}
+	for i := 0; i < groups; i++ {
+		// conflicts with the rows above on the primary key.
+		rows = append(rows, types.MakeDatums(i, groups+i, "b"))
+	}
+	// conflicts with the first row on the unique key uk.
+	rows = append(rows, types.MakeDatums(groups, 0, "c"))
+	localChecksum := s.prepareDuplicates(c, tbl, store, db, rows)
+
+	// The process crashes after the second batch is committed into TiKV, but before it is reported.
+	// the first row is reported in both the conflicts on the primary key and the unique key uk.
+	type reportedKey struct {
+		indexName string
+		rowID     int64
+	}
+	reported := make(map[reportedKey]int)
+	reportCalls := 0
+	report := func(ctx context.Context, rows []backend.DuplicateRow) error {
+		reportCalls++
+		if reportCalls == 2 {
+			return errors.New("mock crash")
+		}
+		for _, row := range rows {
+			reported[reportedKey{row.IndexName, row.RowID}]++
+		}
+		return nil
+	}
+	manager := &DuplicateManager{db: db, keyAdapter: duplicateKeyAdapter{}}
+	_, err = manager.ReportDuplicateData(ctx, tbl, duplicateSessionOptions, store, config.DupeResAlgKeepFirst, report)
+	c.Assert(err, ErrorMatches, "mock crash")
+	c.Assert(reported, HasLen, maxDuplicateReportBatch)
+	c.Assert(db.Close(), IsNil)
+
+	// Resume with the duplicate db reopened.
+	db, err = pebble.Open(dbPath, &pebble.Options{})
+	c.Assert(err, IsNil)
+	defer db.Close()
+	manager = &DuplicateManager{db: db, keyAdapter: duplicateKeyAdapter{}}
+	removed, err := manager.ReportDuplicateData(ctx, tbl, duplicateSessionOptions, store, config.DupeResAlgKeepFirst, report)
+	c.Assert(err, IsNil)
+	c.Assert(removed, NotNil)
+
+	// Every row is reported once.
+	c.Assert(reported, HasLen, len(rows)+1)
+	for key, count := range reported {
+		c.Assert(count, Equals, 1, Commentf("%v", key))
+	}
+	// The first row of each conflict is kept, and the checksum of the removed rows is not lost.
+	c.Assert(s.tableChecksum(c, tbl, store).SumKVS(), Equals, uint64(groups*3))
+	localChecksum.Sub(removed)
+	c.Assert(s.tableChecksum(c, tbl, store), DeepEquals, localChecksum)
+}
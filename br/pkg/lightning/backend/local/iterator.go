@@ -48,11 +48,13 @@ type duplicateIter struct {
 	curKey    []byte
 	curRawKey []byte
 	curVal    []byte
+	curValid  bool
 	nextKey   []byte
 	err       error
 
 	engineFile     *File
 	keyAdapter     KeyAdapter
+	keepLast       bool
 	writeBatch     *pebble.Batch
 	writeBatchSize int64
 }
@@ -60,6 +62,7 @@ type duplicateIter struct {
 func (d *duplicateIter) Seek(key []byte) bool {
 	encodedKey := d.keyAdapter.Encode(nil, key, 0, 0)
 	if d.err != nil || !d.iter.SeekGE(encodedKey) {
+		d.curValid = false
 		return false
 	}
 	d.fill()
@@ -68,6 +71,7 @@ func (d *duplicateIter) Seek(key []byte) bool {
 
 func (d *duplicateIter) First() bool {
 	if d.err != nil || !d.iter.First() {
+		d.curValid = false
 		return false
 	}
 	d.fill()
@@ -76,16 +80,46 @@ func (d *duplicateIter) First() bool {
 
 func (d *duplicateIter) Last() bool {
 	if d.err != nil || !d.iter.Last() {
+		d.curValid = false
 		return false
 	}
 	d.fill()
 	return d.err == nil
 }
 
+// fill takes the pair under the inner iterator as the current pair, then moves the inner iterator past all
+// the following pairs with the same key, recording them as duplicates. If keepLast is set, the last of these
+// pairs becomes the current pair instead of the first one.
 func (d *duplicateIter) fill() {
 	d.curKey, _, _, d.err = d.keyAdapter.Decode(d.curKey[:0], d.iter.Key())
+	if d.err != nil {
+		d.curValid = false
+		return
+	}
 	d.curRawKey = append(d.curRawKey[:0], d.iter.Key()...)
 	d.curVal = append(d.curVal[:0], d.iter.Value()...)
+	d.curValid = true
+
+	recordFirst := false
+	for d.err == nil && d.ctx.Err() == nil && d.iter.Next() {
+		d.nextKey, _, _, d.err = d.keyAdapter.Decode(d.nextKey[:0], d.iter.Key())
+		if d.err != nil || !bytes.Equal(d.nextKey, d.curKey) {
+			return
+		}
+		log.L().Debug("duplicate key detected", logutil.Key("key", d.curKey))
+		if !recordFirst {
+			d.record(d.curRawKey, d.curVal)
+			recordFirst = true
+		}
+		d.record(d.iter.Key(), d.iter.Value())
+		if d.keepLast {
+			d.curRawKey = append(d.curRawKey[:0], d.iter.Key()...)
+			d.curVal = append(d.curVal[:0], d.iter.Value()...)
+		}
+	}
+	if d.err == nil {
+		d.err = d.ctx.Err()
+	}
 }
 
 func (d *duplicateIter) flush() {
@@ -107,29 +141,13 @@ func (d *duplicateIter) record(key []byte, val []byte) {
 }
 
 func (d *duplicateIter) Next() bool {
-	recordFirst := false
-	for d.err == nil && d.ctx.Err() == nil && d.iter.Next() {
-		d.nextKey, _, _, d.err = d.keyAdapter.Decode(d.nextKey[:0], d.iter.Key())
-		if d.err != nil {
-			return false
-		}
-		if !bytes.Equal(d.nextKey, d.curKey) {
-			d.curKey, d.nextKey = d.nextKey, d.curKey[:0]
-			d.curRawKey = append(d.curRawKey[:0], d.iter.Key()...)
-			d.curVal = append(d.curVal[:0], d.iter.Value()...)
-			return true
-		}
-		log.L().Debug("duplicate key detected", logutil.Key("key", d.curKey))
-		if !recordFirst {
-			d.record(d.curRawKey, d.curVal)
-			recordFirst = true
-		}
-		d.record(d.iter.Key(), d.iter.Value())
-	}
-	if d.err == nil {
-		d.err = d.ctx.Err()
+	// fill has already moved the inner iterator to the first pair of the next key.
+	if d.err != nil || !d.iter.Valid() {
+		d.curValid = false
+		return false
 	}
-	return false
+	d.fill()
+	return d.err == nil
 }
 
 func (d *duplicateIter) Key() []byte {
@@ -141,7 +159,7 @@ func (d *duplicateIter) Value() []byte {
 }
 
 func (d *duplicateIter) Valid() bool {
-	return d.err == nil && d.iter.Valid()
+	return d.err == nil && d.curValid
 }
 
 func (d *duplicateIter) Error() error {
@@ -175,6 +193,7 @@ func newDuplicateIter(ctx context.Context, engineFile *File, opts *pebble.IterOp
 		iter:       engineFile.db.NewIter(newOpts),
 		engineFile: engineFile,
 		keyAdapter: engineFile.keyAdapter,
+		keepLast:   engineFile.keepLastDuplicate,
 		writeBatch: engineFile.duplicateDB.NewBatch(),
 	}
 }
@@ -251,3 +251,88 @@ func (s *iteratorSuite) TestDuplicateIterSeek(c *C) {
 	c.Assert(engineFile.Close(), IsNil)
 	c.Assert(duplicateDB.Close(), IsNil)
 }
+
+func (s *iteratorSuite) TestDuplicateIterKeepLast(c *C) {
+	pairs := []common.KvPair{
+		{
+			Key:    []byte{1, 2, 3, 0},
+			Val:    randBytes(128),
+			RowID:  1,
+			Offset: 0,
+		},
+		{
+			Key:    []byte{1, 2, 3, 1},
+			Val:    randBytes(128),
+			RowID:  2,
+			Offset: 100,
+		},
+		{
+			Key:    []byte{1, 2, 3, 1},
+			Val:    randBytes(128),
+			RowID:  3,
+			Offset: 200,
+		},
+		{
+			Key:    []byte{1, 2, 3, 1},
+			Val:    randBytes(128),
+			RowID:  4,
+			Offset: 300,
+		},
+		{
+			Key:    []byte{1, 2, 3, 2},
+			Val:    randBytes(128),
+			RowID:  5,
+			Offset: 400,
+		},
+	}
+
+	storeDir := c.MkDir()
+	db, err := pebble.Open(filepath.Join(storeDir, "kv"), &pebble.Options{})
+	c.Assert(err, IsNil)
+
+	keyAdapter := duplicateKeyAdapter{}
+	wb := db.NewBatch()
+	for _, p := range pairs {
+		key := keyAdapter.Encode(nil, p.Key, p.RowID, p.Offset)
+		c.Assert(wb.Set(key, p.Val, nil), IsNil)
+	}
+	c.Assert(wb.Commit(pebble.Sync), IsNil)
+
+	duplicateDB, err := pebble.Open(filepath.Join(storeDir, "duplicates"), &pebble.Options{})
+	c.Assert(err, IsNil)
+	engineFile := &File{
+		ctx:               context.Background(),
+		db:                db,
+		keyAdapter:        keyAdapter,
+		duplicateDB:       duplicateDB,
+		keepLastDuplicate: true,
+	}
+	iter := newDuplicateIter(context.Background(), engineFile, &pebble.IterOptions{})
+
+	c.Assert(iter.First(), IsTrue)
+	c.Assert(iter.Value(), BytesEquals, pairs[0].Val)
+	c.Assert(iter.Next(), IsTrue)
+	c.Assert(iter.Key(), BytesEquals, pairs[3].Key)
+	c.Assert(iter.Value(), BytesEquals, pairs[3].Val)
+	c.Assert(iter.Next(), IsTrue)
+	c.Assert(iter.Value(), BytesEquals, pairs[4].Val)
+	c.Assert(iter.Next(), IsFalse)
+	c.Assert(iter.Valid(), IsFalse)
+	c.Assert(iter.Error(), IsNil)
+	c.Assert(iter.Close(), IsNil)
+	c.Assert(engineFile.Close(), IsNil)
+
+	// All the pairs of the duplicate key are recorded.
+	dupIter := duplicateDB.NewIter(&pebble.IterOptions{})
+	count := 0
+	for dupIter.First(); dupIter.Valid(); dupIter.Next() {
+		key, rowID, _, err := keyAdapter.Decode(nil, dupIter.Key())
+		c.Assert(err, IsNil)
+		c.Assert(key, BytesEquals, pairs[1].Key)
+		c.Assert(dupIter.Value(), BytesEquals, pairs[rowID-1].Val)
+		count++
+	}
+	c.Assert(count, Equals, 3)
+	c.Assert(dupIter.Close(), IsNil)
+	c.Assert(duplicateDB.Close(), IsNil)
+}
@@ -42,7 +42,6 @@ import (
 	"github.com/pingcap/kvproto/pkg/kvrpcpb"
 	"github.com/pingcap/kvproto/pkg/metapb"
 	"github.com/pingcap/parser/model"
-	"github.com/pingcap/parser/mysql"
 	"github.com/pingcap/tidb/br/pkg/conn"
 	"github.com/pingcap/tidb/br/pkg/lightning/backend"
 	"github.com/pingcap/tidb/br/pkg/lightning/backend/kv"
@@ -54,6 +53,7 @@ import (
 	"github.com/pingcap/tidb/br/pkg/lightning/manual"
 	"github.com/pingcap/tidb/br/pkg/lightning/metric"
 	"github.com/pingcap/tidb/br/pkg/lightning/tikv"
+	"github.com/pingcap/tidb/br/pkg/lightning/verification"
 	"github.com/pingcap/tidb/br/pkg/lightning/worker"
 	"github.com/pingcap/tidb/br/pkg/logutil"
 	"github.com/pingcap/tidb/br/pkg/membuf"
@@ -61,13 +61,13 @@ import (
 	"github.com/pingcap/tidb/br/pkg/utils"
 	"github.com/pingcap/tidb/br/pkg/pdutil"
 	"github.com/pingcap/tidb/br/pkg/version"
-	"github.com/pingcap/tidb/distsql"
 	"github.com/pingcap/tidb/table"
 	"github.com/pingcap/tidb/tablecodec"
 	"github.com/pingcap/tidb/util/codec"
 	"github.com/pingcap/tidb/util/hack"
-	"github.com/pingcap/tidb/util/ranger"
 	"github.com/tikv/client-go/v2/oracle"
+	tikvclient "github.com/tikv/client-go/v2/tikv"
+	pd "github.com/tikv/pd/client"
 	"go.uber.org/atomic"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
@@ -205,6 +205,9 @@ type File struct {
 	keyAdapter         KeyAdapter
 	duplicateDetection bool
 	duplicateDB        *pebble.DB
+	// keepLastDuplicate makes the duplicate iterator import the last pair instead of the first one among the
+	// pairs sharing the same key, so that the imported data agrees with the "keep-last" duplicate resolution.
+	keepLastDuplicate bool
 }
 
 func (e *File) setError(err error) {
@@ -822,8 +825,9 @@ type local struct {
 	localWriterMemCacheSize int64
 	supportMultiIngest      bool
 
-	duplicateDetection bool
-	duplicateDB        *pebble.DB
+	duplicateDetection  bool
+	duplicateDB         *pebble.DB
+	duplicateResolution string
 }
 
 // connPool is a lazy pool of gRPC channels.
@@ -967,6 +971,7 @@ func NewLocalBackend(
 		localWriterMemCacheSize: int64(cfg.LocalWriterMemCacheSize),
 		duplicateDetection:      cfg.DuplicateDetection,
 		duplicateDB:             duplicateDB,
+		duplicateResolution:     cfg.DuplicateResolution,
 	}
 	local.conns = common.NewGRPCConns()
 	if err = local.checkMultiIngestSupport(ctx, pdCtl); err != nil {
@@ -1259,6 +1264,7 @@ func (local *local) OpenEngine(ctx context.Context, cfg *backend.EngineConfig, e
 		duplicateDetection: local.duplicateDetection,
 		duplicateDB:        local.duplicateDB,
 		keyAdapter:         keyAdapter,
+		keepLastDuplicate:  local.duplicateResolution == config.DupeResAlgKeepLast,
 	})
 	engine := e.(*File)
 	engine.db = db
@@ -1311,6 +1317,7 @@ func (local *local) CloseEngine(ctx context.Context, cfg *backend.EngineConfig,
 			tableInfo:          cfg.TableInfo,
 			duplicateDetection: local.duplicateDetection,
 			duplicateDB:        local.duplicateDB,
+			keepLastDuplicate:  local.duplicateResolution == config.DupeResAlgKeepLast,
 		}
 		engineFile.sstIngester = dbSSTIngester{e: engineFile}
 		if err = engineFile.loadEngineMeta(); err != nil {
@@ -2038,109 +2045,96 @@ func (local *local) ImportEngine(ctx context.Context, engineUUID uuid.UUID) erro
 	return nil
 }
 
-func (local *local) CollectLocalDuplicateRows(ctx context.Context, tbl table.Table) error {
+func (local *local) CollectLocalDuplicateRows(ctx context.Context, tbl table.Table, opts *kv.SessionOptions, report backend.DuplicateReporter) (*verification.KVChecksum, error) {
 	if local.duplicateDB == nil {
-		return nil
+		return nil, nil
 	}
 	log.L().Info("Begin collect duplicate local keys", zap.String("table", tbl.Meta().Name.String()))
 	physicalTS, logicalTS, err := local.pdCtl.GetPDClient().GetTS(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	ts := oracle.ComposeTS(physicalTS, logicalTS)
-	// TODO: Here we use this db to store the duplicate rows. We shall remove this parameter and store the result in
-	//  a TiDB table.
 	duplicateManager, err := NewDuplicateManager(local.duplicateDB, local.splitCli, ts, local.tls, local.tcpConcurrency)
 	if err != nil {
-		return errors.Annotate(err, "open duplicatemanager failed")
+		return nil, errors.Annotate(err, "open duplicatemanager failed")
 	}
-	if err := duplicateManager.CollectDuplicateRowsFromLocalIndex(ctx, tbl, local.duplicateDB); err != nil {
-		return errors.Annotate(err, "collect local duplicate rows failed")
-	}
-	return local.reportDuplicateRows(tbl, local.duplicateDB)
+	// The conflicting pairs of the local data source have been collected into the duplicate db when the engines
+	// were imported.
+	removed, err := local.reportDuplicateRows(ctx, tbl, duplicateManager, opts, report)
+	return removed, errors.Annotate(err, "report local duplicate rows failed")
 }
 
-func (local *local) CollectRemoteDuplicateRows(ctx context.Context, tbl table.Table) error {
+func (local *local) CollectRemoteDuplicateRows(ctx context.Context, tbl table.Table, opts *kv.SessionOptions, report backend.DuplicateReporter) (*verification.KVChecksum, error) {
 	log.L().Info("Begin collect remote duplicate keys", zap.String("table", tbl.Meta().Name.String()))
 	physicalTS, logicalTS, err := local.pdCtl.GetPDClient().GetTS(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	ts := oracle.ComposeTS(physicalTS, logicalTS)
 	dbPath := filepath.Join(local.localStoreDir, remoteDuplicateDBName)
 	// TODO: Optimize the opts for better write.
-	opts := &pebble.Options{}
-	duplicateDB, err := pebble.Open(dbPath, opts)
+	dbOpts := &pebble.Options{}
+	duplicateDB, err := pebble.Open(dbPath, dbOpts)
 	if err != nil {
-		return errors.Annotate(err, "open duplicate db failed")
+		return nil, errors.Annotate(err, "open duplicate db failed")
 	}
+	defer duplicateDB.Close()
 
-	// TODO: Here we use the temp created db to store the duplicate rows. We shall remove this parameter and store the
-	//  result in a TiDB table.
 	duplicateManager, err := NewDuplicateManager(duplicateDB, local.splitCli, ts, local.tls, local.tcpConcurrency)
 	if err != nil {
-		return errors.Annotate(err, "open duplicatemanager failed")
+		return nil, errors.Annotate(err, "open duplicatemanager failed")
 	}
 	if err = duplicateManager.CollectDuplicateRowsFromTiKV(ctx, tbl); err != nil {
-		return errors.Annotate(err, "collect remote duplicate rows failed")
+		return nil, errors.Annotate(err, "collect remote duplicate rows failed")
 	}
-	err = local.reportDuplicateRows(tbl, duplicateDB)
-	duplicateDB.Close()
-	return err
+	removed, err := local.reportDuplicateRows(ctx, tbl, duplicateManager, opts, report)
+	return removed, errors.Annotate(err, "report remote duplicate rows failed")
 }
 
-func (local *local) reportDuplicateRows(tbl table.Table, db *pebble.DB) error {
-	log.L().Info("Begin report duplicate rows", zap.String("table", tbl.Meta().Name.String()))
-	decoder, err := kv.NewTableKVDecoder(tbl, &kv.SessionOptions{
-		SQLMode: mysql.ModeStrictAllTables,
-	})
+func (local *local) reportDuplicateRows(
+	ctx context.Context,
+	tbl table.Table,
+	duplicateManager *DuplicateManager,
+	opts *kv.SessionOptions,
+	report backend.DuplicateReporter,
+) (*verification.KVChecksum, error) {
+	log.L().Info("Begin report duplicate rows", zap.String("table", tbl.Meta().Name.String()),
+		zap.String("resolution", local.duplicateResolution))
+	store, err := local.openTiKVStore(ctx)
 	if err != nil {
-		return errors.Annotate(err, "create decoder failed")
-	}
-
-	ranges := ranger.FullIntRange(false)
-	keysRanges := distsql.TableRangesToKVRanges(tbl.Meta().ID, ranges, nil)
-	keyAdapter := duplicateKeyAdapter{}
-	var nextUserKey []byte = nil
-	for _, r := range keysRanges {
-		startKey := codec.EncodeBytes([]byte{}, r.StartKey)
-		endKey := codec.EncodeBytes([]byte{}, r.EndKey)
-		opts := &pebble.IterOptions{
-			LowerBound: startKey,
-			UpperBound: endKey,
-		}
-		iter := db.NewIter(opts)
-		for iter.SeekGE(startKey); iter.Valid(); iter.Next() {
-			nextUserKey, _, _, err = keyAdapter.Decode(nextUserKey[:0], iter.Key())
-			if err != nil {
-				log.L().Error("decode key error from index for duplicatedb",
-					zap.Error(err), logutil.Key("key", iter.Key()))
-				continue
-			}
-
-			h, err := decoder.DecodeHandleFromTable(nextUserKey)
-			if err != nil {
-				log.L().Error("decode handle error from index for duplicatedb",
-					zap.Error(err), logutil.Key("key", iter.Key()))
-				continue
-			}
-			rows, _, err := decoder.DecodeRawRowData(h, iter.Value())
-			if err != nil {
-				log.L().Error("decode row error from index for duplicatedb",
-					zap.Error(err), logutil.Key("key", iter.Key()))
-				continue
-			}
-			// TODO: We need to output the duplicate rows into files or database.
-			//  Here I just output them for debug.
-			r := "row "
-			for _, row := range rows {
-				r += "," + row.String()
-			}
-			log.L().Info(r)
+		return nil, errors.Annotate(err, "open tikv store failed")
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			log.L().Warn("close tikv store failed", log.ShortError(err))
 		}
-		iter.Close()
+	}()
+	return duplicateManager.ReportDuplicateData(ctx, tbl, opts, store, local.duplicateResolution, report)
+}
+
+// openTiKVStore opens a transactional client of TiKV, which is used to read and repair the duplicate rows.
+// The client owns its PD client because closing the store closes the PD client too.
+func (local *local) openTiKVStore(ctx context.Context) (*tikvclient.KVStore, error) {
+	pdAddrs := strings.Split(local.pdAddr, ",")
+	pdCli, err := pd.NewClientWithContext(ctx, pdAddrs, local.tls.ToPDSecurityOption())
+	if err != nil {
+		return nil, errors.Trace(err)
 	}
-	return nil
+	spkv, err := tikvclient.NewEtcdSafePointKV(pdAddrs, local.tls.TLSConfig())
+	if err != nil {
+		pdCli.Close()
+		return nil, errors.Trace(err)
+	}
+	rpcCli := tikvclient.NewRPCClient(local.tls.ToTiKVSecurityConfig())
+	store, err := tikvclient.NewKVStore("lightning-local-backend", &tikvclient.CodecPDClient{Client: pdCli}, spkv, rpcCli)
+	if err != nil {
+		pdCli.Close()
+		_ = spkv.Close()
+		_ = rpcCli.Close()
+		return nil, errors.Trace(err)
+	}
+	return store, nil
 }
 
 func (e *File) unfinishedRanges(ranges []Range) []Range {
@@ -359,11 +359,11 @@ func (be *tidbBackend) CleanupEngine(context.Context, uuid.UUID) error {
 	return nil
 }
 
-func (be *tidbBackend) CollectLocalDuplicateRows(ctx context.Context, tbl table.Table) error {
+func (be *tidbBackend) CollectLocalDuplicateRows(ctx context.Context, tbl table.Table, opts *kv.SessionOptions, report backend.DuplicateReporter) (*verification.KVChecksum, error) {
 	panic("Unsupported Operation")
 }
 
-func (be *tidbBackend) CollectRemoteDuplicateRows(ctx context.Context, tbl table.Table) error {
+func (be *tidbBackend) CollectRemoteDuplicateRows(ctx context.Context, tbl table.Table, opts *kv.SessionOptions, report backend.DuplicateReporter) (*verification.KVChecksum, error) {
 	panic("Unsupported Operation")
 }
 
@@ -24,6 +24,7 @@ import (
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/br/pkg/httputil"
+	"github.com/tikv/client-go/v2/config"
 	pd "github.com/tikv/pd/client"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -156,6 +157,14 @@ func (tc *TLS) ToPDSecurityOption() pd.SecurityOption {
 	}
 }
 
+func (tc *TLS) ToTiKVSecurityConfig() config.Security {
+	return config.Security{
+		ClusterSSLCA:   tc.caPath,
+		ClusterSSLCert: tc.certPath,
+		ClusterSSLKey:  tc.keyPath,
+	}
+}
+
 func (tc *TLS) TLSConfig() *tls.Config {
 	return tc.inner
 }
@@ -67,6 +67,15 @@ const (
 	// ErrorOnDup indicates using INSERT INTO to insert data, which would violate PK or UNIQUE constraint
 	ErrorOnDup = "error"
 
+	// DupeResAlgNone records the duplicate rows found by duplicate detection without resolving them
+	DupeResAlgNone = "none"
+	// DupeResAlgKeepFirst keeps the first row (in source order) of each group of duplicate rows
+	DupeResAlgKeepFirst = "keep-first"
+	// DupeResAlgKeepLast keeps the last row (in source order) of each group of duplicate rows
+	DupeResAlgKeepLast = "keep-last"
+	// DupeResAlgDeleteAll deletes every row involved in a conflict
+	DupeResAlgDeleteAll = "delete-all"
+
 	defaultDistSQLScanConcurrency     = 15
 	distSQLScanConcurrencyPerStore    = 4
 	defaultBuildStatsConcurrency      = 20
@@ -77,6 +86,8 @@ const (
 
 	// defaultMetaSchemaName is the default database name used to store lightning metadata
 	defaultMetaSchemaName = "lightning_metadata"
+	// defaultTaskInfoSchemaName is the default database name used to store the errors found during import
+	defaultTaskInfoSchemaName = "lightning_task_info"
 
 	// autoDiskQuotaLocalReservedSpeed is the estimated size increase per
 	// millisecond per write thread the local backend may gain on all engines.
@@ -95,7 +106,7 @@ const (
 )
 
 var (
-	supportedStorageTypes = []string{"file", "local", "s3", "noop", "gcs"}
+	supportedStorageTypes = []string{"file", "local", "s3", "noop", "gcs", "gs", "azure", "azblob"}
 
 	DefaultFilter = []string{
 		"*.*",
@@ -159,12 +170,13 @@ func (cfg *Config) ToTLS() (*common.TLS, error) {
 }
 
 type Lightning struct {
-	TableConcurrency  int    `toml:"table-concurrency" json:"table-concurrency"`
-	IndexConcurrency  int    `toml:"index-concurrency" json:"index-concurrency"`
-	RegionConcurrency int    `toml:"region-concurrency" json:"region-concurrency"`
-	IOConcurrency     int    `toml:"io-concurrency" json:"io-concurrency"`
-	CheckRequirements bool   `toml:"check-requirements" json:"check-requirements"`
-	MetaSchemaName    string `toml:"meta-schema-name" json:"meta-schema-name"`
+	TableConcurrency   int    `toml:"table-concurrency" json:"table-concurrency"`
+	IndexConcurrency   int    `toml:"index-concurrency" json:"index-concurrency"`
+	RegionConcurrency  int    `toml:"region-concurrency" json:"region-concurrency"`
+	IOConcurrency      int    `toml:"io-concurrency" json:"io-concurrency"`
+	CheckRequirements  bool   `toml:"check-requirements" json:"check-requirements"`
+	MetaSchemaName     string `toml:"meta-schema-name" json:"meta-schema-name"`
+	TaskInfoSchemaName string `toml:"task-info-schema-name" json:"task-info-schema-name"`
 }
 
 type PostOpLevel int
@@ -311,16 +323,17 @@ type FileRouteRule struct {
 }
 
 type TikvImporter struct {
-	Addr               string   `toml:"addr" json:"addr"`
-	Backend            string   `toml:"backend" json:"backend"`
-	OnDuplicate        string   `toml:"on-duplicate" json:"on-duplicate"`
-	MaxKVPairs         int      `toml:"max-kv-pairs" json:"max-kv-pairs"`
-	SendKVPairs        int      `toml:"send-kv-pairs" json:"send-kv-pairs"`
-	RegionSplitSize    ByteSize `toml:"region-split-size" json:"region-split-size"`
-	SortedKVDir        string   `toml:"sorted-kv-dir" json:"sorted-kv-dir"`
-	DiskQuota          ByteSize `toml:"disk-quota" json:"disk-quota"`
-	RangeConcurrency   int      `toml:"range-concurrency" json:"range-concurrency"`
-	DuplicateDetection bool     `toml:"duplicate-detection" json:"duplicate-detection"`
+	Addr                string   `toml:"addr" json:"addr"`
+	Backend             string   `toml:"backend" json:"backend"`
+	OnDuplicate         string   `toml:"on-duplicate" json:"on-duplicate"`
+	MaxKVPairs          int      `toml:"max-kv-pairs" json:"max-kv-pairs"`
+	SendKVPairs         int      `toml:"send-kv-pairs" json:"send-kv-pairs"`
+	RegionSplitSize     ByteSize `toml:"region-split-size" json:"region-split-size"`
+	SortedKVDir         string   `toml:"sorted-kv-dir" json:"sorted-kv-dir"`
+	DiskQuota           ByteSize `toml:"disk-quota" json:"disk-quota"`
+	RangeConcurrency    int      `toml:"range-concurrency" json:"range-concurrency"`
+	DuplicateDetection  bool     `toml:"duplicate-detection" json:"duplicate-detection"`
+	DuplicateResolution string   `toml:"duplicate-resolution" json:"duplicate-resolution"`
 
 	EngineMemCacheSize      ByteSize `toml:"engine-mem-cache-size" json:"engine-mem-cache-size"`
 	LocalWriterMemCacheSize ByteSize `toml:"local-writer-mem-cache-size" json:"local-writer-mem-cache-size"`
@@ -431,12 +444,13 @@ func NewConfig() *Config {
 			Filter:        DefaultFilter,
 		},
 		TikvImporter: TikvImporter{
-			Backend:         "",
-			OnDuplicate:     ReplaceOnDup,
-			MaxKVPairs:      4096,
-			SendKVPairs:     32768,
-			RegionSplitSize: SplitRegionSize,
-			DiskQuota:       ByteSize(math.MaxInt64),
+			Backend:             "",
+			OnDuplicate:         ReplaceOnDup,
+			DuplicateResolution: DupeResAlgNone,
+			MaxKVPairs:          4096,
+			SendKVPairs:         32768,
+			RegionSplitSize:     SplitRegionSize,
+			DiskQuota:           ByteSize(math.MaxInt64),
 		},
 		PostRestore: PostRestore{
 			Checksum:          OpLevelRequired,
@@ -625,6 +639,17 @@ func (cfg *Config) Adjust(ctx context.Context) error {
 		}
 	}
 
+	cfg.TikvImporter.DuplicateResolution = strings.ToLower(cfg.TikvImporter.DuplicateResolution)
+	switch cfg.TikvImporter.DuplicateResolution {
+	case DupeResAlgNone:
+	case DupeResAlgKeepFirst, DupeResAlgKeepLast, DupeResAlgDeleteAll:
+		if !cfg.TikvImporter.DuplicateDetection {
+			return errors.Errorf("invalid config: `tikv-importer.duplicate-resolution` (%s) requires `tikv-importer.duplicate-detection` to be enabled", cfg.TikvImporter.DuplicateResolution)
+		}
+	default:
+		return errors.Errorf("invalid config: unsupported `tikv-importer.duplicate-resolution` (%s)", cfg.TikvImporter.DuplicateResolution)
+	}
+
 	var err error
 	cfg.TiDB.SQLMode, err = mysql.GetSQLMode(cfg.TiDB.StrSQLMode)
 	if err != nil {
@@ -736,6 +761,9 @@ func (cfg *Config) DefaultVarsForImporterAndLocalBackend(ctx context.Context) {
 	if len(cfg.App.MetaSchemaName) == 0 {
 		cfg.App.MetaSchemaName = defaultMetaSchemaName
 	}
+	if len(cfg.App.TaskInfoSchemaName) == 0 {
+		cfg.App.TaskInfoSchemaName = defaultTaskInfoSchemaName
+	}
 	if cfg.TikvImporter.RangeConcurrency == 0 {
 		cfg.TikvImporter.RangeConcurrency = 16
 	}
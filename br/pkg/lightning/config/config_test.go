@@ -709,3 +709,26 @@ func (s *configTestSuite) TestAdjustDiskQuota(c *C) {
 	c.Assert(cfg.Adjust(ctx), IsNil)
 	c.Assert(int64(cfg.TikvImporter.DiskQuota), Equals, int64(0))
 }
+
+func (s *configTestSuite) TestAdjustDuplicateResolution(c *C) {
+	cfg := config.NewConfig()
+	assignMinimalLegalValue(cfg)
+	cfg.TikvImporter.Backend = config.BackendLocal
+	cfg.TikvImporter.SortedKVDir = c.MkDir()
+	cfg.TiDB.DistSQLScanConcurrency = 1
+	c.Assert(cfg.Adjust(context.Background()), IsNil)
+	c.Assert(cfg.TikvImporter.DuplicateResolution, Equals, config.DupeResAlgNone)
+	c.Assert(cfg.App.TaskInfoSchemaName, Equals, "lightning_task_info")
+
+	cfg.TikvImporter.DuplicateResolution = "Keep-Last"
+	err := cfg.Adjust(context.Background())
+	c.Assert(err, ErrorMatches, "invalid config: `tikv-importer\\.duplicate-resolution` \\(keep-last\\) requires `tikv-importer\\.duplicate-detection` to be enabled")
+
+	cfg.TikvImporter.DuplicateDetection = true
+	c.Assert(cfg.Adjust(context.Background()), IsNil)
+	c.Assert(cfg.TikvImporter.DuplicateResolution, Equals, config.DupeResAlgKeepLast)
+
+	cfg.TikvImporter.DuplicateResolution = "keep-both"
+	err = cfg.Adjust(context.Background())
+	c.Assert(err, ErrorMatches, "invalid config: unsupported `tikv-importer\\.duplicate-resolution` \\(keep-both\\)")
+}
@@ -0,0 +1,145 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydump
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/ulikunitz/xz"
+)
+
+// OpenReader opens the source file for reading. Compressed files are decompressed transparently, and the offsets
+// of the returned reader are the offsets in the decompressed stream.
+func OpenReader(ctx context.Context, fileMeta SourceFileMeta, store storage.ExternalStorage) (storage.ReadSeekCloser, error) {
+	switch {
+	case fileMeta.Type == SourceTypeParquet:
+		if fileMeta.Compression != CompressionNone {
+			return nil, errors.Errorf("compressed parquet file '%s' is not supported", fileMeta.Path)
+		}
+		return OpenParquetReader(ctx, store, fileMeta.Path, fileMeta.FileSize)
+	case fileMeta.Compression != CompressionNone:
+		return newDecompressReader(ctx, store, fileMeta.Path, fileMeta.Compression)
+	default:
+		return store.Open(ctx, fileMeta.Path)
+	}
+}
+
+// EstimateDecompressedSize estimates the size of the source file after decompression. The exact size can't be
+// told without decompressing the whole file.
+func EstimateDecompressedSize(fileMeta SourceFileMeta) int64 {
+	if fileMeta.Compression == CompressionNone {
+		return fileMeta.FileSize
+	}
+	return fileMeta.FileSize * compressedSizeFactor
+}
+
+// ReadUntil reads the rows until the parser reaches the position pos. A compressed file can't be seeked, so the
+// rows before the position are read and discarded to resume the file.
+func ReadUntil(parser Parser, pos int64) error {
+	for {
+		if curPos, _ := parser.Pos(); curPos >= pos {
+			return nil
+		}
+		switch err := parser.ReadRow(); errors.Cause(err) {
+		case nil:
+			parser.RecycleRow(parser.LastRow())
+		case io.EOF:
+			return nil
+		default:
+			return errors.Trace(err)
+		}
+	}
+}
+
+func newDecompressor(compression Compression, r io.Reader) (io.ReadCloser, error) {
+	switch compression {
+	case CompressionGZ:
+		return gzip.NewReader(r)
+	case CompressionZStd:
+		d, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return d.IOReadCloser(), nil
+	case CompressionLZ4:
+		return io.NopCloser(lz4.NewReader(r)), nil
+	case CompressionXZ:
+		d, err := xz.NewReader(r)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return io.NopCloser(d), nil
+	default:
+		return nil, errors.Errorf("unknown compression type %d", compression)
+	}
+}
+
+// decompressReader reads a compressed file as a decompressed stream. Compressed streams can't be seeked, so the
+// reader can only tell its position in the decompressed stream.
+type decompressReader struct {
+	path   string
+	file   storage.ExternalFileReader
+	reader io.ReadCloser
+	pos    int64
+}
+
+func newDecompressReader(ctx context.Context, store storage.ExternalStorage, path string, compression Compression) (*decompressReader, error) {
+	file, err := store.Open(ctx, path)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	reader, err := newDecompressor(compression, file)
+	if err != nil {
+		file.Close()
+		return nil, errors.Annotatef(err, "failed to decompress file '%s'", path)
+	}
+	return &decompressReader{path: path, file: file, reader: reader}, nil
+}
+
+// Read implements io.Reader
+func (r *decompressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.pos += int64(n)
+	return n, err
+}
+
+// Seek implements io.Seeker. Only seeking to the current position is supported.
+func (r *decompressReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.pos
+	default:
+		return r.pos, errors.Errorf("unsupported whence %d when seeking compressed file '%s'", whence, r.path)
+	}
+	if offset != r.pos {
+		return r.pos, errors.Errorf("compressed file '%s' can't be seeked from %d to %d", r.path, r.pos, offset)
+	}
+	return r.pos, nil
+}
+
+// Close implements io.Closer
+func (r *decompressReader) Close() error {
+	err := r.reader.Close()
+	if err1 := r.file.Close(); err == nil {
+		err = err1
+	}
+	return errors.Trace(err)
+}
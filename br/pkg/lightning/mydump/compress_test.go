@@ -0,0 +1,140 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydump_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/br/pkg/lightning/config"
+	. "github.com/pingcap/tidb/br/pkg/lightning/mydump"
+	"github.com/pingcap/tidb/br/pkg/lightning/worker"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/ulikunitz/xz"
+)
+
+var _ = Suite(&testMydumpCompressSuite{})
+
+type testMydumpCompressSuite struct{}
+
+func compressData(c *C, compression Compression, data []byte) []byte {
+	var buf bytes.Buffer
+	var w io.WriteCloser
+	var err error
+	switch compression {
+	case CompressionGZ:
+		w = gzip.NewWriter(&buf)
+	case CompressionZStd:
+		w, err = zstd.NewWriter(&buf)
+	case CompressionLZ4:
+		w = lz4.NewWriter(&buf)
+	case CompressionXZ:
+		w, err = xz.NewWriter(&buf)
+	}
+	c.Assert(err, IsNil)
+	_, err = w.Write(data)
+	c.Assert(err, IsNil)
+	c.Assert(w.Close(), IsNil)
+	return buf.Bytes()
+}
+
+func (s *testMydumpCompressSuite) TestOpenCompressedReader(c *C) {
+	ctx := context.Background()
+	dir := c.MkDir()
+	store, err := storage.NewLocalStorage(dir)
+	c.Assert(err, IsNil)
+	data := []byte(strings.Repeat("0123456789", 1000))
+
+	for _, compression := range []Compression{CompressionGZ, CompressionZStd, CompressionLZ4, CompressionXZ} {
+		compressed := compressData(c, compression, data)
+		c.Assert(store.WriteFile(ctx, "data", compressed), IsNil)
+		fileMeta := SourceFileMeta{Path: "data", Type: SourceTypeCSV, Compression: compression, FileSize: int64(len(compressed))}
+
+		c.Assert(EstimateDecompressedSize(fileMeta), Equals, int64(len(compressed))*32)
+
+		r, err := OpenReader(ctx, fileMeta, store)
+		c.Assert(err, IsNil)
+		buf := make([]byte, 4)
+		_, err = io.ReadFull(r, buf)
+		c.Assert(err, IsNil)
+		c.Assert(string(buf), Equals, "0123")
+
+		// Only the current position of the decompressed stream can be told.
+		pos, err := r.Seek(0, io.SeekCurrent)
+		c.Assert(err, IsNil)
+		c.Assert(pos, Equals, int64(4))
+		pos, err = r.Seek(4, io.SeekStart)
+		c.Assert(err, IsNil)
+		c.Assert(pos, Equals, int64(4))
+		_, err = r.Seek(0, io.SeekStart)
+		c.Assert(err, ErrorMatches, ".*can't be seeked.*")
+		_, err = r.Seek(10, io.SeekCurrent)
+		c.Assert(err, ErrorMatches, ".*can't be seeked.*")
+		_, err = r.Seek(0, io.SeekEnd)
+		c.Assert(err, NotNil)
+
+		content, err := io.ReadAll(r)
+		c.Assert(err, IsNil)
+		c.Assert(content, DeepEquals, data[4:])
+		c.Assert(r.Close(), IsNil)
+	}
+}
+
+func (s *testMydumpCompressSuite) TestCompressedFileRegion(c *C) {
+	ctx := context.Background()
+	dir := c.MkDir()
+	data := []byte("a,b,c\n1,2,3\n4,5,6\n7,8,9\n")
+	compressed := compressData(c, CompressionGZ, data)
+	c.Assert(os.WriteFile(filepath.Join(dir, "db.tbl.001.csv.gz"), compressed, 0o644), IsNil)
+	store, err := storage.NewLocalStorage(dir)
+	c.Assert(err, IsNil)
+
+	cfg := newConfigWithSourceDir(dir)
+	cfg.Mydumper.CSV = config.CSVConfig{Separator: ",", Header: true}
+	cfg.Mydumper.ReadBlockSize = config.ReadBlockSize
+	// The compressed file isn't split even if it's larger than the max region size.
+	cfg.Mydumper.MaxRegionSize = 1
+	cfg.Mydumper.StrictFormat = true
+	fileMeta := SourceFileMeta{Path: "db.tbl.001.csv.gz", Type: SourceTypeCSV, Compression: CompressionGZ, FileSize: int64(len(compressed))}
+	meta := &MDTableMeta{DB: "db", Name: "tbl", DataFiles: []FileInfo{{FileMeta: fileMeta}}}
+	ioWorkers := worker.NewPool(ctx, 1, "io")
+	regions, err := MakeTableRegions(ctx, meta, 3, cfg, ioWorkers, store)
+	c.Assert(err, IsNil)
+	c.Assert(regions, HasLen, 1)
+	// The file isn't decompressed to plan the region, it's read until EOF.
+	c.Assert(regions[0].Chunk.Offset, Equals, int64(0))
+	c.Assert(regions[0].Chunk.EndOffset, Equals, TableFileSizeINF)
+	c.Assert(regions[0].Chunk.RowIDMax, Equals, int64(len(compressed))*32/3)
+
+	// The parser can be resumed from a decompressed offset by reading the rows before it.
+	r, err := OpenReader(ctx, fileMeta, store)
+	c.Assert(err, IsNil)
+	parser := NewCSVParser(&cfg.Mydumper.CSV, r, int64(cfg.Mydumper.ReadBlockSize), ioWorkers, false)
+	defer parser.Close()
+	c.Assert(ReadUntil(parser, 12), IsNil)
+	parser.SetRowID(1)
+	c.Assert(parser.ReadRow(), IsNil)
+	c.Assert(parser.LastRow().Row[0].GetString(), Equals, "4")
+	pos, rowID := parser.Pos()
+	c.Assert(pos, Equals, int64(18))
+	c.Assert(rowID, Equals, int64(2))
+}
@@ -323,6 +323,39 @@ func (s *testMydumpLoaderSuite) TestTablesWithDots(c *C) {
 	}})
 }
 
+func (s *testMydumpLoaderSuite) TestCompressedFiles(c *C) {
+	s.touch(c, "db-schema-create.sql.gz")
+	s.touch(c, "db.tbl-schema.sql.gz")
+	s.touch(c, "db.tbl.0001.sql.gz")
+	s.touch(c, "db.tbl.0002.csv.zst")
+	s.touch(c, "db.tbl.0003.csv.xz")
+	s.touch(c, "db.tbl-schema-trigger.sql.gz")
+	// files with unknown suffixes are ignored.
+	s.touch(c, "db.tbl.0004.sql.bak")
+
+	mdl, err := md.NewMyDumpLoader(context.Background(), s.cfg)
+	c.Assert(err, IsNil)
+	tableName := filter.Table{Schema: "db", Name: "tbl"}
+	c.Assert(mdl.GetDatabases(), DeepEquals, []*md.MDDatabaseMeta{{
+		Name:       "db",
+		SchemaFile: "db-schema-create.sql.gz",
+		Tables: []*md.MDTableMeta{
+			{
+				DB:         "db",
+				Name:       "tbl",
+				SchemaFile: md.FileInfo{TableName: tableName, FileMeta: md.SourceFileMeta{Path: "db.tbl-schema.sql.gz", Type: md.SourceTypeTableSchema, Compression: md.CompressionGZ}},
+				DataFiles: []md.FileInfo{
+					{TableName: tableName, FileMeta: md.SourceFileMeta{Path: "db.tbl.0001.sql.gz", Type: md.SourceTypeSQL, Compression: md.CompressionGZ, SortKey: "0001"}},
+					{TableName: tableName, FileMeta: md.SourceFileMeta{Path: "db.tbl.0002.csv.zst", Type: md.SourceTypeCSV, Compression: md.CompressionZStd, SortKey: "0002"}},
+					{TableName: tableName, FileMeta: md.SourceFileMeta{Path: "db.tbl.0003.csv.xz", Type: md.SourceTypeCSV, Compression: md.CompressionXZ, SortKey: "0003"}},
+				},
+				IsRowOrdered: true,
+				IndexRatio:   0.0,
+			},
+		},
+	}})
+}
+
 func (s *testMydumpLoaderSuite) TestRouter(c *C) {
 	s.cfg.Routes = []*router.TableRule{
 		{
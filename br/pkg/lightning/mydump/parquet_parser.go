@@ -311,6 +311,11 @@ func (pp *ParquetParser) Pos() (pos int64, rowID int64) {
 	return pp.curStart + int64(pp.curIndex), pp.lastRow.RowID
 }
 
+// SetRowID changes the reported row ID.
+func (pp *ParquetParser) SetRowID(rowID int64) {
+	pp.lastRow.RowID = rowID
+}
+
 func (pp *ParquetParser) SetPos(pos int64, rowID int64) error {
 	if pos < pp.curStart {
 		panic("don't support seek back yet")
@@ -115,6 +115,7 @@ const (
 type Parser interface {
 	Pos() (pos int64, rowID int64)
 	SetPos(pos int64, rowID int64) error
+	SetRowID(rowID int64)
 	Close() error
 	ReadRow() error
 	LastRow() Row
@@ -161,6 +162,11 @@ func (parser *blockParser) SetPos(pos int64, rowID int64) error {
 	return nil
 }
 
+// SetRowID changes the reported row ID.
+func (parser *blockParser) SetRowID(rowID int64) {
+	parser.lastRow.RowID = rowID
+}
+
 // Pos returns the current file offset.
 func (parser *blockParser) Pos() (int64, int64) {
 	return parser.pos, parser.lastRow.RowID
@@ -29,7 +29,14 @@ import (
 	"go.uber.org/zap"
 )
 
-const tableRegionSizeWarningThreshold int64 = 1024 * 1024 * 1024
+const (
+	tableRegionSizeWarningThreshold int64 = 1024 * 1024 * 1024
+	// compressedSizeFactor is used to estimate the decompressed size of a compressed file. The row IDs of the file
+	// are reserved for the estimated size, so it's a generous compression ratio of text files.
+	compressedSizeFactor = 32
+	// TableFileSizeINF is the end offset of the region of a compressed file, which is read until EOF.
+	TableFileSizeINF int64 = math.MaxInt64
+)
 
 type TableRegion struct {
 	EngineID int32
@@ -265,9 +272,16 @@ func makeSourceFileRegion(
 	if !isCsvFile {
 		divisor += 2
 	}
-	// If a csv file is overlarge, we need to split it into multiple regions.
-	// Note: We can only split a csv file whose format is strict.
-	if isCsvFile && dataFileSize > int64(cfg.Mydumper.MaxRegionSize) && cfg.Mydumper.StrictFormat {
+	// A compressed file can't be split since it can't be read from the middle, so it's restored as a whole until EOF,
+	// and the offsets of the region are the offsets in the decompressed stream. The row IDs are reserved for the
+	// estimated decompressed size.
+	endOffset := dataFileSize
+	if fi.FileMeta.Compression != CompressionNone {
+		dataFileSize = EstimateDecompressedSize(fi.FileMeta)
+		endOffset = TableFileSizeINF
+	} else if isCsvFile && dataFileSize > int64(cfg.Mydumper.MaxRegionSize) && cfg.Mydumper.StrictFormat {
+		// If a csv file is overlarge, we need to split it into multiple regions.
+		// Note: We can only split a csv file whose format is strict.
 		_, regions, subFileSizes, err := SplitLargeFile(ctx, meta, cfg, fi, divisor, 0, ioWorkers, store)
 		return regions, subFileSizes, err
 	}
@@ -278,19 +292,19 @@ func makeSourceFileRegion(
 		FileMeta: fi.FileMeta,
 		Chunk: Chunk{
 			Offset:       0,
-			EndOffset:    fi.FileMeta.FileSize,
+			EndOffset:    endOffset,
 			PrevRowIDMax: 0,
-			RowIDMax:     fi.FileMeta.FileSize / divisor,
+			RowIDMax:     dataFileSize / divisor,
 		},
 	}
 
-	if tableRegion.Size() > tableRegionSizeWarningThreshold {
+	if dataFileSize > tableRegionSizeWarningThreshold {
 		log.L().Warn(
 			"file is too big to be processed efficiently; we suggest splitting it at 256 MB each",
 			zap.String("file", fi.FileMeta.Path),
 			zap.Int64("size", dataFileSize))
 	}
-	return []*TableRegion{tableRegion}, []float64{float64(fi.FileMeta.FileSize)}, nil
+	return []*TableRegion{tableRegion}, []float64{float64(dataFileSize)}, nil
 }
 
 // because parquet files can't seek efficiently, there is no benefit in split.
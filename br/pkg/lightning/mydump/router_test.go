@@ -112,8 +112,11 @@ func (t *testFileRouterSuite) TestSingleRouteRule(c *C) {
 	r, err = NewFileRouter([]*config.FileRouteRule{rule})
 	c.Assert(err, IsNil)
 	c.Assert(r, NotNil)
+	res, err := r.Route("my_schema.my_table.sql.gz")
+	c.Assert(err, IsNil)
+	c.Assert(res, DeepEquals, &RouteResult{filter.Table{Schema: "my_schema", Name: "my_table"}, "", CompressionGZ, SourceTypeSQL})
+
 	invalidMatchPaths := []string{
-		"my_schema.my_table.sql.gz",
 		"my_schema.my_table.sql.rar",
 		"my_schema.my_table.txt",
 	}
@@ -144,7 +147,7 @@ func (t *testFileRouterSuite) TestMultiRouteRule(c *C) {
 		"/test/123/my_schema.my_table.sql": {"my_schema", "my_table", "", "", "sql"},
 		"my_dir/my_schema.my_table.csv":    {"my_schema", "my_table", "", "", "csv"},
 		"my_schema.my_table.0001.sql":      {"my_schema", "my_table", "0001", "", "sql"},
-		// "my_schema.my_table.0001.sql.gz":      {"my_schema", "my_table", "0001", "gz", "sql"},
+		"my_schema.my_table.0001.sql.gz":   {"my_schema", "my_table", "0001", "gz", "sql"},
 	}
 	for path, fields := range inputOutputMap {
 		res, err := r.Route(path)
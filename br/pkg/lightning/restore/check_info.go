@@ -339,12 +339,7 @@ func hasDefault(col *model.ColumnInfo) bool {
 }
 
 func (rc *Controller) readColumnsAndCount(ctx context.Context, dataFileMeta mydump.SourceFileMeta) (cols []string, colCnt int, err error) {
-	var reader storage.ReadSeekCloser
-	if dataFileMeta.Type == mydump.SourceTypeParquet {
-		reader, err = mydump.OpenParquetReader(ctx, rc.store, dataFileMeta.Path, dataFileMeta.FileSize)
-	} else {
-		reader, err = rc.store.Open(ctx, dataFileMeta.Path)
-	}
+	reader, err := mydump.OpenReader(ctx, dataFileMeta, rc.store)
 	if err != nil {
 		return nil, 0, errors.Trace(err)
 	}
@@ -497,13 +492,7 @@ func (rc *Controller) SampleDataFromTable(ctx context.Context, dbName string, ta
 		return nil
 	}
 	sampleFile := tableMeta.DataFiles[0].FileMeta
-	var reader storage.ReadSeekCloser
-	var err error
-	if sampleFile.Type == mydump.SourceTypeParquet {
-		reader, err = mydump.OpenParquetReader(ctx, rc.store, sampleFile.Path, sampleFile.FileSize)
-	} else {
-		reader, err = rc.store.Open(ctx, sampleFile.Path)
-	}
+	reader, err := mydump.OpenReader(ctx, sampleFile, rc.store)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -0,0 +1,118 @@
+// Copyright 2021 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/lightning/backend"
+	"github.com/pingcap/tidb/br/pkg/lightning/checkpoints"
+	"github.com/pingcap/tidb/br/pkg/lightning/common"
+	"github.com/pingcap/tidb/br/pkg/lightning/log"
+	"github.com/pingcap/tidb/br/pkg/redact"
+	"go.uber.org/zap"
+)
+
+// duplicateRecorder records the rows found by duplicate detection into the conflict error table of the task
+// info schema.
+type duplicateRecorder struct {
+	db     *sql.DB
+	taskID int64
+	schema string
+}
+
+func (r *duplicateRecorder) Init(ctx context.Context) error {
+	exec := common.SQLWithRetry{
+		DB:           r.db,
+		Logger:       log.L(),
+		HideQueryLog: redact.NeedRedact(),
+	}
+	schemaSQL := fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", common.EscapeIdentifier(r.schema))
+	if err := exec.Exec(ctx, "create task info schema", schemaSQL); err != nil {
+		return errors.Annotate(err, "create task info schema failed")
+	}
+	tableSQL := fmt.Sprintf(CreateConflictErrorTable, common.UniqueTable(r.schema, conflictErrorTableName))
+	if err := exec.Exec(ctx, "create conflict error table", tableSQL); err != nil {
+		return errors.Annotate(err, "create conflict error table failed")
+	}
+	return nil
+}
+
+// Reporter returns a backend.DuplicateReporter which records the duplicate rows of the given table. The data
+// file of a row is located by its row ID through the chunks of the table checkpoint.
+func (r *duplicateRecorder) Reporter(tableName string, cp *checkpoints.TableCheckpoint, logger log.Logger) backend.DuplicateReporter {
+	locator := newRowLocator(cp)
+	exec := common.SQLWithRetry{
+		DB:           r.db,
+		Logger:       logger,
+		HideQueryLog: redact.NeedRedact(),
+	}
+	return func(ctx context.Context, rows []backend.DuplicateRow) error {
+		if len(rows) == 0 {
+			return nil
+		}
+		logger.Warn("duplicate rows detected", zap.String("table", tableName), zap.Int("count", len(rows)))
+
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "INSERT INTO %s (task_id, table_name, index_name, raw_key, row_data, raw_row, "+
+			"raw_file, raw_offset, row_id, removed) VALUES ", common.UniqueTable(r.schema, conflictErrorTableName))
+		args := make([]interface{}, 0, len(rows)*10)
+		for i, row := range rows {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+			sb.WriteString("(?,?,?,?,?,?,?,?,?,?)")
+			rawFile := ""
+			if row.RowID > 0 {
+				rawFile = locator.locate(row.RowID)
+			}
+			args = append(args, r.taskID, tableName, row.IndexName, row.Key, row.Row, row.RawRow,
+				rawFile, row.Offset, row.RowID, row.Removed)
+		}
+		return exec.Exec(ctx, "record duplicate rows", sb.String(), args...)
+	}
+}
+
+// rowLocator finds the data file of a row by its row ID.
+type rowLocator struct {
+	// rowIDMaxes are the RowIDMax of the chunks in ascending order, paths are the data files of them.
+	rowIDMaxes []int64
+	paths      []string
+}
+
+func newRowLocator(cp *checkpoints.TableCheckpoint) *rowLocator {
+	chunks := make([]*checkpoints.ChunkCheckpoint, 0)
+	for _, engine := range cp.Engines {
+		chunks = append(chunks, engine.Chunks...)
+	}
+	sort.Slice(chunks, func(i, j int) bool {
+		return chunks[i].Chunk.RowIDMax < chunks[j].Chunk.RowIDMax
+	})
+	l := &rowLocator{
+		rowIDMaxes: make([]int64, 0, len(chunks)),
+		paths:      make([]string, 0, len(chunks)),
+	}
+	for _, chunk := range chunks {
+		l.rowIDMaxes = append(l.rowIDMaxes, chunk.Chunk.RowIDMax)
+		l.paths = append(l.paths, chunk.Key.Path)
+	}
+	return l
+}
+
+// locate returns the data file containing the row, or an empty string if it is not found.
+// The row ID ranges of the chunks are consecutive, so the chunk of a row is the first one whose RowIDMax is not
+// less than the row ID.
+func (l *rowLocator) locate(rowID int64) string {
+	i := sort.Search(len(l.rowIDMaxes), func(i int) bool {
+		return l.rowIDMaxes[i] >= rowID
+	})
+	if i >= len(l.rowIDMaxes) {
+		return ""
+	}
+	return l.paths[i]
+}
@@ -0,0 +1,74 @@
+// Copyright 2021 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"context"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/br/pkg/lightning/backend"
+	"github.com/pingcap/tidb/br/pkg/lightning/checkpoints"
+	"github.com/pingcap/tidb/br/pkg/lightning/log"
+	"github.com/pingcap/tidb/br/pkg/lightning/mydump"
+)
+
+var _ = Suite(&duplicateRecorderSuite{})
+
+type duplicateRecorderSuite struct{}
+
+func newTestChunk(path string, prevRowIDMax, rowIDMax int64) *checkpoints.ChunkCheckpoint {
+	return &checkpoints.ChunkCheckpoint{
+		Key:   checkpoints.ChunkCheckpointKey{Path: path},
+		Chunk: mydump.Chunk{PrevRowIDMax: prevRowIDMax, RowIDMax: rowIDMax},
+	}
+}
+
+func (s *duplicateRecorderSuite) TestRowLocator(c *C) {
+	cp := &checkpoints.TableCheckpoint{
+		Engines: map[int32]*checkpoints.EngineCheckpoint{
+			0: {Chunks: []*checkpoints.ChunkCheckpoint{
+				newTestChunk("db.t.2.sql", 20, 30),
+				newTestChunk("db.t.1.sql", 10, 20),
+			}},
+			1: {Chunks: []*checkpoints.ChunkCheckpoint{
+				newTestChunk("db.t.0.sql", 0, 10),
+			}},
+		},
+	}
+	locator := newRowLocator(cp)
+	c.Assert(locator.locate(1), Equals, "db.t.0.sql")
+	c.Assert(locator.locate(10), Equals, "db.t.0.sql")
+	c.Assert(locator.locate(11), Equals, "db.t.1.sql")
+	c.Assert(locator.locate(30), Equals, "db.t.2.sql")
+	c.Assert(locator.locate(31), Equals, "")
+}
+
+func (s *duplicateRecorderSuite) TestReporter(c *C) {
+	db, mock, err := sqlmock.New()
+	c.Assert(err, IsNil)
+	defer db.Close()
+
+	cp := &checkpoints.TableCheckpoint{
+		Engines: map[int32]*checkpoints.EngineCheckpoint{
+			0: {Chunks: []*checkpoints.ChunkCheckpoint{newTestChunk("db.t.0.sql", 0, 10)}},
+		},
+	}
+	recorder := &duplicateRecorder{db: db, taskID: 1, schema: "lightning_task_info"}
+	report := recorder.Reporter("`db`.`t`", cp, log.L())
+
+	mock.ExpectExec("\\QINSERT INTO `lightning_task_info`.`conflict_error_v1` (task_id, table_name, index_name, "+
+		"raw_key, row_data, raw_row, raw_file, raw_offset, row_id, removed) VALUES (?,?,?,?,?,?,?,?,?,?),(?,?,?,?,?,?,?,?,?,?)\\E").
+		WithArgs(
+			1, "`db`.`t`", "PRIMARY", []byte("k1"), "(1, 10)", []byte("r1"), "db.t.0.sql", 20, 2, false,
+			1, "`db`.`t`", "PRIMARY", []byte("k1"), "(1, 20)", []byte("r2"), "", 1000, 0, true,
+		).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	err = report(context.Background(), []backend.DuplicateRow{
+		{IndexName: "PRIMARY", Key: []byte("k1"), RawRow: []byte("r1"), Row: "(1, 10)", RowID: 2, Offset: 20},
+		{IndexName: "PRIMARY", Key: []byte("k1"), RawRow: []byte("r2"), Row: "(1, 20)", Offset: 1000, Removed: true},
+	})
+	c.Assert(err, IsNil)
+	c.Assert(report(context.Background(), nil), IsNil)
+	c.Assert(mock.ExpectationsWereMet(), IsNil)
+}
@@ -105,6 +105,24 @@ const (
 		PRIMARY KEY (task_id)
 	);`
 
+	conflictErrorTableName = "conflict_error_v1"
+	// CreateConflictErrorTable stores the rows found by duplicate detection which conflict on the primary key or
+	// a unique index
+	CreateConflictErrorTable = `CREATE TABLE IF NOT EXISTS %s (
+		task_id     BIGINT(20) NOT NULL,
+		create_time DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+		table_name  VARCHAR(261) NOT NULL,
+		index_name  VARCHAR(128) NOT NULL,
+		raw_key     MEDIUMBLOB NOT NULL COMMENT 'the conflicting key',
+		row_data    TEXT NOT NULL COMMENT 'the decoded row',
+		raw_row     MEDIUMBLOB NOT NULL,
+		raw_file    VARCHAR(1024) NOT NULL DEFAULT '' COMMENT 'empty if the row is read from TiKV',
+		raw_offset  BIGINT(20) NOT NULL DEFAULT 0,
+		row_id      BIGINT(20) NOT NULL DEFAULT 0,
+		removed     TINYINT(1) NOT NULL DEFAULT 0 COMMENT '1: removed by the duplicate resolution',
+		KEY (task_id, table_name)
+	);`
+
 	compactionLowerThreshold = 512 * units.MiB
 	compactionUpperThreshold = 32 * units.GiB
 )
@@ -250,6 +268,7 @@ type Controller struct {
 	store             storage.ExternalStorage
 	metaMgrBuilder    metaMgrBuilder
 	taskMgr           taskMetaMgr
+	dupeRecorder      *duplicateRecorder
 
 	diskQuotaLock  *diskQuotaLock
 	diskQuotaState atomic.Int32
@@ -353,6 +372,19 @@ func NewRestoreControllerWithPauser(
 		metaBuilder = noopMetaMgrBuilder{}
 	}
 
+	var dupeRecorder *duplicateRecorder
+	if cfg.TikvImporter.DuplicateDetection {
+		db, err := g.GetDB()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		dupeRecorder = &duplicateRecorder{
+			db:     db,
+			taskID: cfg.TaskID,
+			schema: cfg.App.TaskInfoSchemaName,
+		}
+	}
+
 	rc := &Controller{
 		cfg:           cfg,
 		dbMetas:       dbMetas,
@@ -375,6 +407,7 @@ func NewRestoreControllerWithPauser(
 
 		store:          s,
 		metaMgrBuilder: metaBuilder,
+		dupeRecorder:   dupeRecorder,
 		diskQuotaLock:  newDiskQuotaLock(),
 		taskMgr:        nil,
 	}
@@ -889,7 +922,8 @@ func (rc *Controller) estimateChunkCountIntoMetrics(ctx context.Context) error {
 				}
 				if fileMeta.FileMeta.Type == mydump.SourceTypeCSV {
 					cfg := rc.cfg.Mydumper
-					if fileMeta.FileMeta.FileSize > int64(cfg.MaxRegionSize) && cfg.StrictFormat && !cfg.CSV.Header {
+					if fileMeta.FileMeta.FileSize > int64(cfg.MaxRegionSize) && cfg.StrictFormat && !cfg.CSV.Header &&
+						fileMeta.FileMeta.Compression == mydump.CompressionNone {
 						estimatedChunkCount += math.Round(float64(fileMeta.FileMeta.FileSize) / float64(cfg.MaxRegionSize))
 					} else {
 						estimatedChunkCount++
@@ -1775,6 +1809,11 @@ func (rc *Controller) preCheckRequirements(ctx context.Context) error {
 	if err := rc.metaMgrBuilder.Init(ctx); err != nil {
 		return err
 	}
+	if rc.dupeRecorder != nil {
+		if err := rc.dupeRecorder.Init(ctx); err != nil {
+			return err
+		}
+	}
 	taskExist := false
 
 	if rc.isLocalBackend() {
@@ -1887,13 +1926,7 @@ func newChunkRestore(
 ) (*chunkRestore, error) {
 	blockBufSize := int64(cfg.Mydumper.ReadBlockSize)
 
-	var reader storage.ReadSeekCloser
-	var err error
-	if chunk.FileMeta.Type == mydump.SourceTypeParquet {
-		reader, err = mydump.OpenParquetReader(ctx, store, chunk.FileMeta.Path, chunk.FileMeta.FileSize)
-	} else {
-		reader, err = store.Open(ctx, chunk.FileMeta.Path)
-	}
+	reader, err := mydump.OpenReader(ctx, chunk.FileMeta, store)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -1914,8 +1947,15 @@ func newChunkRestore(
 		panic(fmt.Sprintf("file '%s' with unknown source type '%s'", chunk.Key.Path, chunk.FileMeta.Type.String()))
 	}
 
-	if err = parser.SetPos(chunk.Chunk.Offset, chunk.Chunk.PrevRowIDMax); err != nil {
-		return nil, errors.Trace(err)
+	if chunk.FileMeta.Compression == mydump.CompressionNone {
+		if err = parser.SetPos(chunk.Chunk.Offset, chunk.Chunk.PrevRowIDMax); err != nil {
+			return nil, errors.Trace(err)
+		}
+	} else {
+		if err = mydump.ReadUntil(parser, chunk.Chunk.Offset); err != nil {
+			return nil, errors.Trace(err)
+		}
+		parser.SetRowID(chunk.Chunk.PrevRowIDMax)
 	}
 	if len(chunk.ColumnPermutation) > 0 {
 		parser.SetColumns(getColumnNames(tableInfo.Core, chunk.ColumnPermutation))
@@ -2221,6 +2261,13 @@ func (cr *chunkRestore) encodeLoop(
 				err = errors.Annotatef(err, "in file %s at offset %d", &cr.chunk.Key, newOffset)
 				return
 			}
+			// The row IDs of a compressed file are reserved for its estimated size, they mustn't overlap the row IDs
+			// of the next region.
+			if cr.chunk.FileMeta.Compression != mydump.CompressionNone && rowID > cr.chunk.Chunk.RowIDMax {
+				err = errors.Errorf("the row ID %d exceeds the reserved row IDs of file %s, the file may be compressed with a too high ratio",
+					rowID, &cr.chunk.Key)
+				return
+			}
 			readDur += time.Since(readDurStart)
 			encodeDurStart := time.Now()
 			lastRow := cr.parser.LastRow()
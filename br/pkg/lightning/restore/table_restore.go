@@ -537,7 +537,9 @@ func (tr *TableRestore) restoreEngine(
 	totalSQLSize := int64(0)
 	for _, chunk := range cp.Chunks {
 		totalKVSize += chunk.Checksum.SumSize()
-		totalSQLSize += chunk.Chunk.EndOffset - chunk.Chunk.Offset
+		if chunk.FileMeta.Compression == mydump.CompressionNone {
+			totalSQLSize += chunk.Chunk.EndOffset - chunk.Chunk.Offset
+		}
 	}
 
 	err = chunkErr.Get()
@@ -694,10 +696,18 @@ func (tr *TableRestore) postProcess(
 		} else {
 			if forcePostProcess || !rc.cfg.PostRestore.PostProcessAtLast {
 				tr.logger.Info("local checksum", zap.Object("checksum", &localChecksum))
+				opts := &kv.SessionOptions{
+					SQLMode: rc.cfg.TiDB.SQLMode,
+					SysVars: rc.sysVars,
+				}
 				if rc.cfg.TikvImporter.DuplicateDetection {
-					if err := rc.backend.CollectLocalDuplicateRows(ctx, tr.encTable); err != nil {
+					report := rc.dupeRecorder.Reporter(tr.tableName, cp, tr.logger)
+					removed, err := rc.backend.CollectLocalDuplicateRows(ctx, tr.encTable, opts, report)
+					if err != nil {
 						tr.logger.Error("collect local duplicate keys failed", log.ShortError(err))
+						return false, errors.Trace(err)
 					}
+					tr.subtractRemovedChecksum(&localChecksum, removed)
 				}
 				needChecksum, baseTotalChecksum, err := metaMgr.CheckAndUpdateLocalChecksum(ctx, &localChecksum)
 				if err != nil {
@@ -706,10 +716,13 @@ func (tr *TableRestore) postProcess(
 				if !needChecksum {
 					return false, nil
 				}
+				var remoteRemoved *verify.KVChecksum
 				if rc.cfg.TikvImporter.DuplicateDetection {
-					if err := rc.backend.CollectRemoteDuplicateRows(ctx, tr.encTable); err != nil {
+					report := rc.dupeRecorder.Reporter(tr.tableName, cp, tr.logger)
+					remoteRemoved, err = rc.backend.CollectRemoteDuplicateRows(ctx, tr.encTable, opts, report)
+					if err != nil {
 						tr.logger.Error("collect remote duplicate keys failed", log.ShortError(err))
-						err = nil
+						return false, errors.Trace(err)
 					}
 				}
 				if cp.Checksum.SumKVS() > 0 || baseTotalChecksum.SumKVS() > 0 {
@@ -717,9 +730,9 @@ func (tr *TableRestore) postProcess(
 					localChecksum.Add(baseTotalChecksum)
 					tr.logger.Info("merged local checksum", zap.Object("checksum", &localChecksum))
 				}
+				tr.subtractRemovedChecksum(&localChecksum, remoteRemoved)
 
 				remoteChecksum, err := DoChecksum(ctx, tr.tableInfo)
-				// TODO: If there are duplicate keys, do not set the `ChecksumMismatch` error
 				err = tr.compareChecksum(remoteChecksum, localChecksum)
 				// with post restore level 'optional', we will skip checksum error
 				if rc.cfg.PostRestore.Checksum == config.OpLevelOptional {
@@ -870,6 +883,17 @@ func (tr *TableRestore) importKV(
 	return nil
 }
 
+// subtractRemovedChecksum removes the rows removed by the duplicate resolution from the local checksum, since they
+// are no longer in the table.
+func (tr *TableRestore) subtractRemovedChecksum(localChecksum *verify.KVChecksum, removed *verify.KVChecksum) {
+	if removed == nil {
+		return
+	}
+	localChecksum.Sub(removed)
+	tr.logger.Info("subtract the checksum of the removed duplicate rows", zap.Object("removed", removed),
+		zap.Object("checksum", localChecksum))
+}
+
 // do checksum for each table.
 func (tr *TableRestore) compareChecksum(remoteChecksum *RemoteChecksum, localChecksum verify.KVChecksum) error {
 	if remoteChecksum.Checksum != localChecksum.Sum() ||
@@ -910,6 +934,8 @@ func estimateCompactionThreshold(cp *checkpoints.TableCheckpoint, factor int64)
 			if chunk.FileMeta.Type == mydump.SourceTypeParquet {
 				// parquet file is compressed, thus estimates with a factor of 2
 				size *= 2
+			} else {
+				size = mydump.EstimateDecompressedSize(chunk.FileMeta)
 			}
 			totalRawFileSize += size
 			lastFile = chunk.FileMeta.Path
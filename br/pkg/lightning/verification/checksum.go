@@ -79,6 +79,13 @@ func (c *KVChecksum) Add(other *KVChecksum) {
 	c.checksum ^= other.checksum
 }
 
+// Sub removes the KV pairs summed in other from the checksum, they must have been summed in it too.
+func (c *KVChecksum) Sub(other *KVChecksum) {
+	c.bytes -= other.bytes
+	c.kvs -= other.kvs
+	c.checksum ^= other.checksum
+}
+
 func (c *KVChecksum) Sum() uint64 {
 	return c.checksum
 }
@@ -75,6 +75,14 @@ func (s *testKVChcksumSuite) TestChcksum(c *C) {
 	c.Assert(checksum.SumSize(), Equals, kvBytes<<1)
 	c.Assert(checksum.SumKVS(), Equals, uint64(len(kvs))<<1)
 	c.Assert(uint64NotEqual(checksum.Sum(), excpectChecksum), IsTrue)
+
+	// remove the recomputed key-value
+	other := verification.NewKVChecksum(0)
+	other.Update(kvs)
+	checksum.Sub(other)
+	c.Assert(checksum.SumSize(), Equals, kvBytes)
+	c.Assert(checksum.SumKVS(), Equals, uint64(len(kvs)))
+	c.Assert(checksum.Sum(), Equals, excpectChecksum)
 }
 
 func (s *testKVChcksumSuite) TestChecksumJSON(c *C) {
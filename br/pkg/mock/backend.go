@@ -16,6 +16,7 @@ import (
 	model "github.com/pingcap/parser/model"
 	backend "github.com/pingcap/tidb/br/pkg/lightning/backend"
 	kv "github.com/pingcap/tidb/br/pkg/lightning/backend/kv"
+	verification "github.com/pingcap/tidb/br/pkg/lightning/verification"
 	table "github.com/pingcap/tidb/table"
 )
 
@@ -97,31 +98,33 @@ func (mr *MockBackendMockRecorder) CloseEngine(arg0, arg1, arg2 interface{}) *go
 }
 
 // CollectLocalDuplicateRows mocks base method
-func (m *MockBackend) CollectLocalDuplicateRows(arg0 context.Context, arg1 table.Table) error {
+func (m *MockBackend) CollectLocalDuplicateRows(arg0 context.Context, arg1 table.Table, arg2 *kv.SessionOptions, arg3 backend.DuplicateReporter) (*verification.KVChecksum, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CollectLocalDuplicateRows", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CollectLocalDuplicateRows", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*verification.KVChecksum)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // CollectLocalDuplicateRows indicates an expected call of CollectLocalDuplicateRows
-func (mr *MockBackendMockRecorder) CollectLocalDuplicateRows(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockBackendMockRecorder) CollectLocalDuplicateRows(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectLocalDuplicateRows", reflect.TypeOf((*MockBackend)(nil).CollectLocalDuplicateRows), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectLocalDuplicateRows", reflect.TypeOf((*MockBackend)(nil).CollectLocalDuplicateRows), arg0, arg1, arg2, arg3)
 }
 
 // CollectRemoteDuplicateRows mocks base method
-func (m *MockBackend) CollectRemoteDuplicateRows(arg0 context.Context, arg1 table.Table) error {
+func (m *MockBackend) CollectRemoteDuplicateRows(arg0 context.Context, arg1 table.Table, arg2 *kv.SessionOptions, arg3 backend.DuplicateReporter) (*verification.KVChecksum, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CollectRemoteDuplicateRows", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CollectRemoteDuplicateRows", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*verification.KVChecksum)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // CollectRemoteDuplicateRows indicates an expected call of CollectRemoteDuplicateRows
-func (mr *MockBackendMockRecorder) CollectRemoteDuplicateRows(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockBackendMockRecorder) CollectRemoteDuplicateRows(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectRemoteDuplicateRows", reflect.TypeOf((*MockBackend)(nil).CollectRemoteDuplicateRows), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectRemoteDuplicateRows", reflect.TypeOf((*MockBackend)(nil).CollectRemoteDuplicateRows), arg0, arg1, arg2, arg3)
 }
 
 // EngineFileSizes mocks base method
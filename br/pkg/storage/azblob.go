@@ -0,0 +1,475 @@
+// Copyright 2021 PingCAP, Inc. Licensed under Apache-2.0.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	goerrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/streaming"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/pingcap/errors"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+const (
+	azblobEndpointOption    = "azblob.endpoint"
+	azblobAccessTierOption  = "azblob.access-tier"
+	azblobAccountNameOption = "azblob.account-name"
+
+	// The kvproto in use has no dedicated message for Azure Blob Storage, so the
+	// backend is carried by `CloudDynamic` with these provider name and attributes.
+	azblobProviderName   = "azure"
+	azblobAccountNameKey = "account-name"
+	azblobAccountKeyKey  = "account-key"
+	azblobSASTokenKey    = "sas-token"
+
+	// Environment variables used when the credentials are not given in the URL.
+	azblobAccountNameEnv = "AZURE_STORAGE_ACCOUNT"
+	azblobAccountKeyEnv  = "AZURE_STORAGE_KEY"
+	azblobSASTokenEnv    = "AZURE_STORAGE_SAS_TOKEN"
+)
+
+// AzblobBackendOptions are options for configuration the Azure Blob storage.
+type AzblobBackendOptions struct {
+	Endpoint    string `json:"endpoint" toml:"endpoint"`
+	AccountName string `json:"account-name" toml:"account-name"`
+	AccountKey  string `json:"account-key" toml:"account-key"`
+	SASToken    string `json:"sas-token" toml:"sas-token"`
+	AccessTier  string `json:"access-tier" toml:"access-tier"`
+}
+
+func (options *AzblobBackendOptions) apply(container, prefix string) (*backuppb.CloudDynamic, error) {
+	if options.AccessTier != "" && !isValidAccessTier(options.AccessTier) {
+		return nil, errors.Annotatef(berrors.ErrStorageInvalidConfig, "invalid azblob access tier '%s'", options.AccessTier)
+	}
+	attrs := make(map[string]string, 3)
+	if options.AccountName != "" {
+		attrs[azblobAccountNameKey] = options.AccountName
+	}
+	if options.AccountKey != "" {
+		attrs[azblobAccountKeyKey] = options.AccountKey
+	}
+	if options.SASToken != "" {
+		attrs[azblobSASTokenKey] = strings.TrimPrefix(options.SASToken, "?")
+	}
+	return &backuppb.CloudDynamic{
+		ProviderName: azblobProviderName,
+		Bucket: &backuppb.Bucket{
+			Endpoint:     options.Endpoint,
+			Bucket:       container,
+			Prefix:       prefix,
+			StorageClass: options.AccessTier,
+		},
+		Attrs: attrs,
+	}, nil
+}
+
+func isValidAccessTier(tier string) bool {
+	for _, t := range azblob.PossibleAccessTierValues() {
+		if strings.EqualFold(string(t), tier) {
+			return true
+		}
+	}
+	return false
+}
+
+func defineAzblobFlags(flags *pflag.FlagSet) {
+	// TODO: remove experimental tag if it's stable
+	flags.String(azblobEndpointOption, "", "(experimental) Set the Azure Blob Storage endpoint URL")
+	flags.String(azblobAccessTierOption, "", "(experimental) Specify the access tier of the uploaded blobs, e.g. Hot, Cool or Archive")
+	flags.String(azblobAccountNameOption, "", "(experimental) Set the Azure storage account name")
+}
+
+func (options *AzblobBackendOptions) parseFromFlags(flags *pflag.FlagSet) error {
+	var err error
+	options.Endpoint, err = flags.GetString(azblobEndpointOption)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	options.AccessTier, err = flags.GetString(azblobAccessTierOption)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	options.AccountName, err = flags.GetString(azblobAccountNameOption)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// isAzblobBackend checks whether the cloud backend describes an Azure Blob storage.
+func isAzblobBackend(cloud *backuppb.CloudDynamic) bool {
+	return cloud != nil && cloud.ProviderName == azblobProviderName
+}
+
+type azblobStorage struct {
+	options   *backuppb.CloudDynamic
+	container azblob.ContainerClient
+}
+
+func (s *azblobStorage) objectName(name string) string {
+	return path.Join(s.options.Bucket.Prefix, name)
+}
+
+func (s *azblobStorage) accessTier() *azblob.AccessTier {
+	if s.options.Bucket.StorageClass == "" {
+		return nil
+	}
+	for _, t := range azblob.PossibleAccessTierValues() {
+		if strings.EqualFold(string(t), s.options.Bucket.StorageClass) {
+			return &t
+		}
+	}
+	return nil
+}
+
+// WriteFile writes data to a file to storage.
+func (s *azblobStorage) WriteFile(ctx context.Context, name string, data []byte) error {
+	client := s.container.NewBlockBlobClient(s.objectName(name))
+	_, err := client.Upload(ctx, streaming.NopCloser(bytes.NewReader(data)), &azblob.UploadBlockBlobOptions{
+		Tier: s.accessTier(),
+	})
+	if err != nil {
+		return errors.Annotatef(err,
+			"failed to write azblob file, file info: container='%s', key='%s'",
+			s.options.Bucket.Bucket, s.objectName(name))
+	}
+	return nil
+}
+
+// ReadFile reads the file from the storage and returns the contents.
+func (s *azblobStorage) ReadFile(ctx context.Context, name string) ([]byte, error) {
+	client := s.container.NewBlobClient(s.objectName(name))
+	resp, err := client.Download(ctx, nil)
+	if err != nil {
+		return nil, errors.Annotatef(err,
+			"failed to read azblob file, file info: container='%s', key='%s'",
+			s.options.Bucket.Bucket, s.objectName(name))
+	}
+	body := resp.Body(azblob.RetryReaderOptions{MaxRetryRequests: maxRetries})
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	return data, errors.Trace(err)
+}
+
+// FileExists return true if file exists.
+func (s *azblobStorage) FileExists(ctx context.Context, name string) (bool, error) {
+	client := s.container.NewBlobClient(s.objectName(name))
+	_, err := client.GetProperties(ctx, nil)
+	if err != nil {
+		if isAzblobNotFound(err) {
+			return false, nil
+		}
+		return false, errors.Trace(err)
+	}
+	return true, nil
+}
+
+// Open a Reader by file path.
+func (s *azblobStorage) Open(ctx context.Context, name string) (ExternalFileReader, error) {
+	client := s.container.NewBlobClient(s.objectName(name))
+	props, err := client.GetProperties(ctx, nil)
+	if err != nil {
+		return nil, errors.Annotatef(err,
+			"failed to read azblob file, file info: container='%s', key='%s'",
+			s.options.Bucket.Bucket, s.objectName(name))
+	}
+	var totalSize int64
+	if props.ContentLength != nil {
+		totalSize = *props.ContentLength
+	}
+	return &azblobObjectReader{
+		storage:   s,
+		name:      name,
+		client:    client,
+		totalSize: totalSize,
+		ctx:       ctx,
+	}, nil
+}
+
+// WalkDir traverse all the files in a dir.
+//
+// fn is the function called for each regular file visited by WalkDir.
+// The first argument is the file path that can be used in `Open`
+// function; the second argument is the size in byte of the file determined
+// by path.
+func (s *azblobStorage) WalkDir(ctx context.Context, opt *WalkOption, fn func(string, int64) error) error {
+	if opt == nil {
+		opt = &WalkOption{}
+	}
+
+	prefix := path.Join(s.options.Bucket.Prefix, opt.SubDir)
+	if len(prefix) > 0 && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	listOption := &azblob.ContainerListBlobFlatSegmentOptions{Prefix: &prefix}
+	if opt.ListCount > 0 {
+		maxResults := int32(opt.ListCount)
+		listOption.Maxresults = &maxResults
+	}
+
+	for {
+		// the pager of the SDK takes the next marker as the URL of the next
+		// page, so each page is requested by a new pager with the marker.
+		pager := s.container.ListBlobsFlat(listOption)
+		if !pager.NextPage(ctx) {
+			return errors.Trace(pager.Err())
+		}
+		page := pager.PageResponse()
+		var blobs []*azblob.BlobItemInternal
+		if page.Segment != nil {
+			blobs = page.Segment.BlobItems
+		}
+		for _, blob := range blobs {
+			if blob.Name == nil {
+				continue
+			}
+			var size int64
+			if blob.Properties != nil && blob.Properties.ContentLength != nil {
+				size = *blob.Properties.ContentLength
+			}
+			// the listed names include the prefix of the storage, which should
+			// be trimmed so that the path can be used in `Open` directly.
+			path := strings.TrimPrefix(strings.TrimPrefix(*blob.Name, s.options.Bucket.Prefix), "/")
+			if err := fn(path, size); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		if page.NextMarker == nil || *page.NextMarker == "" {
+			return nil
+		}
+		listOption.Marker = page.NextMarker
+	}
+}
+
+func (s *azblobStorage) URI() string {
+	return "azure://" + s.options.Bucket.Bucket + "/" + s.options.Bucket.Prefix
+}
+
+// CreateUploader creates a block blob uploader which stages each written
+// chunk as a block, and commits the block list on close.
+func (s *azblobStorage) CreateUploader(_ context.Context, name string) (ExternalFileWriter, error) {
+	return &azblobUploader{
+		client:     s.container.NewBlockBlobClient(s.objectName(name)),
+		accessTier: s.accessTier(),
+		blockIDs:   make([]string, 0, 128),
+	}, nil
+}
+
+// Create implements ExternalStorage interface.
+func (s *azblobStorage) Create(ctx context.Context, name string) (ExternalFileWriter, error) {
+	uploader, err := s.CreateUploader(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return newBufferedWriter(uploader, hardcodedS3ChunkSize, NoCompression), nil
+}
+
+func newAzblobStorage(ctx context.Context, cloud *backuppb.CloudDynamic, opts *ExternalStorageOptions) (*azblobStorage, error) {
+	if cloud.Bucket == nil || cloud.Bucket.Bucket == "" {
+		return nil, errors.Annotate(berrors.ErrStorageInvalidConfig, "please specify the container for azblob")
+	}
+	if cloud.Attrs == nil {
+		cloud.Attrs = make(map[string]string)
+	}
+	accountName := cloud.Attrs[azblobAccountNameKey]
+	if accountName == "" {
+		accountName = os.Getenv(azblobAccountNameEnv)
+	}
+	endpoint := strings.TrimSuffix(cloud.Bucket.Endpoint, "/")
+	if endpoint == "" {
+		if accountName == "" {
+			return nil, errors.Annotatef(berrors.ErrStorageInvalidConfig,
+				"please specify the account name for azblob by `account-name` or $%s", azblobAccountNameEnv)
+		}
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", accountName)
+	}
+	containerURL := endpoint + "/" + cloud.Bucket.Bucket
+
+	clientOptions := &azblob.ClientOptions{}
+	if opts.HTTPClient != nil {
+		clientOptions.Transporter = opts.HTTPClient
+	}
+
+	var (
+		container azblob.ContainerClient
+		err       error
+	)
+	accountKey := cloud.Attrs[azblobAccountKeyKey]
+	sasToken := cloud.Attrs[azblobSASTokenKey]
+	if accountKey == "" && sasToken == "" && !opts.NoCredentials {
+		accountKey = os.Getenv(azblobAccountKeyEnv)
+		sasToken = strings.TrimPrefix(os.Getenv(azblobSASTokenEnv), "?")
+		if opts.SendCredentials {
+			if accountKey != "" {
+				cloud.Attrs[azblobAccountKeyKey] = accountKey
+			}
+			if sasToken != "" {
+				cloud.Attrs[azblobSASTokenKey] = sasToken
+			}
+		}
+	}
+	switch {
+	case opts.NoCredentials:
+		container, err = azblob.NewContainerClientWithNoCredential(containerURL, clientOptions)
+	case sasToken != "":
+		container, err = azblob.NewContainerClientWithNoCredential(containerURL+"?"+sasToken, clientOptions)
+	case accountKey != "":
+		if accountName == "" {
+			return nil, errors.Annotatef(berrors.ErrStorageInvalidConfig,
+				"please specify the account name for azblob by `account-name` or $%s", azblobAccountNameEnv)
+		}
+		var cred *azblob.SharedKeyCredential
+		cred, err = azblob.NewSharedKeyCredential(accountName, accountKey)
+		if err != nil {
+			return nil, errors.Annotate(berrors.ErrStorageInvalidConfig, err.Error())
+		}
+		container, err = azblob.NewContainerClientWithSharedKey(containerURL, cred, clientOptions)
+	default:
+		return nil, errors.Annotatef(berrors.ErrStorageInvalidConfig,
+			"please specify the `account-key` or `sas-token` for azblob, or set $%s or $%s", azblobAccountKeyEnv, azblobSASTokenEnv)
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	if !opts.SendCredentials {
+		// Clear the credentials if exists so that they will not be sent to TiKV
+		delete(cloud.Attrs, azblobAccountKeyKey)
+		delete(cloud.Attrs, azblobSASTokenKey)
+	}
+
+	// TODO remove it after BR remove cfg skip-check-path
+	if !opts.SkipCheckPath {
+		// check container exists
+		_, err = container.GetProperties(ctx, nil)
+		if err != nil {
+			return nil, errors.Annotatef(err, "azure://%s/%s", cloud.Bucket.Bucket, cloud.Bucket.Prefix)
+		}
+	}
+	return &azblobStorage{options: cloud, container: container}, nil
+}
+
+func isAzblobNotFound(err error) bool {
+	var storageErr *azblob.StorageError
+	if goerrors.As(err, &storageErr) {
+		return storageErr.StatusCode() == http.StatusNotFound
+	}
+	var respErr azcore.HTTPResponse
+	if goerrors.As(err, &respErr) {
+		return respErr.RawResponse().StatusCode == http.StatusNotFound
+	}
+	return false
+}
+
+// azblobUploader does the multi-part upload to azure blob storage by staging
+// the blocks and committing them.
+type azblobUploader struct {
+	client     azblob.BlockBlobClient
+	accessTier *azblob.AccessTier
+	blockIDs   []string
+}
+
+// Write stages the data as a new block of the blob.
+func (u *azblobUploader) Write(ctx context.Context, data []byte) (int, error) {
+	// all block IDs of a blob must be in the same length before encoded.
+	blockID := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%016d", len(u.blockIDs))))
+	_, err := u.client.StageBlock(ctx, blockID, streaming.NopCloser(bytes.NewReader(data)), nil)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	u.blockIDs = append(u.blockIDs, blockID)
+	return len(data), nil
+}
+
+// Close commits the staged blocks to complete the blob.
+func (u *azblobUploader) Close(ctx context.Context) error {
+	_, err := u.client.CommitBlockList(ctx, u.blockIDs, &azblob.CommitBlockListOptions{
+		Tier: u.accessTier,
+	})
+	return errors.Trace(err)
+}
+
+// azblobObjectReader wraps the blob downloading and adds the `Seek` method.
+type azblobObjectReader struct {
+	storage   *azblobStorage
+	name      string
+	client    azblob.BlobClient
+	reader    io.ReadCloser
+	pos       int64
+	totalSize int64
+	// reader context used for implement `io.Seek`
+	ctx context.Context
+}
+
+// Read implement the io.Reader interface.
+func (r *azblobObjectReader) Read(p []byte) (n int, err error) {
+	if r.pos >= r.totalSize {
+		return 0, io.EOF
+	}
+	if r.reader == nil {
+		offset := r.pos
+		resp, err := r.client.Download(r.ctx, &azblob.DownloadBlobOptions{Offset: &offset})
+		if err != nil {
+			return 0, errors.Annotatef(err,
+				"failed to read azblob file, file info: container='%s', key='%s'",
+				r.storage.options.Bucket.Bucket, r.name)
+		}
+		r.reader = resp.Body(azblob.RetryReaderOptions{MaxRetryRequests: maxRetries})
+	}
+	n, err = r.reader.Read(p)
+	r.pos += int64(n)
+	return n, err
+}
+
+// Close implement the io.Closer interface.
+func (r *azblobObjectReader) Close() error {
+	if r.reader == nil {
+		return nil
+	}
+	err := r.reader.Close()
+	r.reader = nil
+	return errors.Trace(err)
+}
+
+// Seek implement the io.Seeker interface.
+func (r *azblobObjectReader) Seek(offset int64, whence int) (int64, error) {
+	var realOffset int64
+	switch whence {
+	case io.SeekStart:
+		realOffset = offset
+	case io.SeekCurrent:
+		realOffset = r.pos + offset
+	case io.SeekEnd:
+		realOffset = r.totalSize + offset
+	default:
+		return 0, errors.Annotatef(berrors.ErrStorageUnknown, "Seek: invalid whence '%d'", whence)
+	}
+	if realOffset < 0 {
+		return 0, errors.Annotatef(berrors.ErrInvalidArgument, "Seek: offset '%v' out of range.", realOffset)
+	}
+
+	if realOffset == r.pos {
+		return realOffset, nil
+	}
+	// the new position is read lazily in the next `Read`.
+	if err := r.Close(); err != nil {
+		return 0, errors.Trace(err)
+	}
+	r.pos = realOffset
+	return realOffset, nil
+}
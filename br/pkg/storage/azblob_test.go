@@ -0,0 +1,375 @@
+// Copyright 2021 PingCAP, Inc. Licensed under Apache-2.0.
+
+package storage
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	. "github.com/pingcap/check"
+	backuppb "github.com/pingcap/kvproto/pkg/brpb"
+)
+
+const (
+	fakeAzblobAccount   = "devstoreaccount1"
+	fakeAzblobContainer = "container"
+)
+
+// fakeAzblobServer is a minimal in-memory stand-in of Azurite, which serves
+// the block blob APIs used by azblobStorage.
+type fakeAzblobServer struct {
+	mu            sync.Mutex
+	blobs         map[string][]byte
+	stagedBlocks  map[string]map[string][]byte
+	committedSize map[string]int
+	authHeaders   []string
+	sasQueries    []string
+}
+
+func newFakeAzblobServer() *fakeAzblobServer {
+	return &fakeAzblobServer{
+		blobs:         make(map[string][]byte),
+		stagedBlocks:  make(map[string]map[string][]byte),
+		committedSize: make(map[string]int),
+	}
+}
+
+func (s *fakeAzblobServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if auth := req.Header.Get("Authorization"); auth != "" {
+		s.authHeaders = append(s.authHeaders, auth)
+	}
+	query := req.URL.Query()
+	if query.Get("sig") != "" {
+		s.sasQueries = append(s.sasQueries, req.URL.RawQuery)
+	}
+
+	// the path is in the form of /{account}/{container}/{blob}
+	parts := strings.SplitN(strings.TrimPrefix(req.URL.Path, "/"), "/", 3)
+	if len(parts) < 2 || parts[0] != fakeAzblobAccount || parts[1] != fakeAzblobContainer {
+		s.writeError(w, http.StatusNotFound, "ContainerNotFound")
+		return
+	}
+	if len(parts) == 2 || parts[2] == "" {
+		s.serveContainer(w, req)
+		return
+	}
+	s.serveBlob(w, req, parts[2])
+}
+
+func (s *fakeAzblobServer) writeError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("x-ms-error-code", code)
+	w.WriteHeader(status)
+}
+
+func (s *fakeAzblobServer) serveContainer(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	if req.Method != http.MethodGet || query.Get("restype") != "container" {
+		s.writeError(w, http.StatusBadRequest, "UnsupportedHttpVerb")
+		return
+	}
+	if query.Get("comp") != "list" {
+		w.Header().Set("ETag", `"container"`)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	prefix := query.Get("prefix")
+	names := make([]string, 0, len(s.blobs))
+	for name := range s.blobs {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	start := 0
+	if marker := query.Get("marker"); marker != "" {
+		start, _ = strconv.Atoi(marker)
+	}
+	end := len(names)
+	if maxResults, err := strconv.Atoi(query.Get("maxresults")); err == nil && start+maxResults < end {
+		end = start + maxResults
+	}
+
+	var sb strings.Builder
+	sb.WriteString(xml.Header)
+	fmt.Fprintf(&sb, `<EnumerationResults ServiceEndpoint="http://%s/%s" ContainerName="%s">`, req.Host, fakeAzblobAccount, fakeAzblobContainer)
+	sb.WriteString("<Blobs>")
+	for _, name := range names[start:end] {
+		sb.WriteString("<Blob><Name>")
+		_ = xml.EscapeText(&sb, []byte(name))
+		fmt.Fprintf(&sb, "</Name><Properties><Content-Length>%d</Content-Length><BlobType>BlockBlob</BlobType></Properties></Blob>", len(s.blobs[name]))
+	}
+	sb.WriteString("</Blobs>")
+	if end < len(names) {
+		fmt.Fprintf(&sb, "<NextMarker>%d</NextMarker>", end)
+	} else {
+		sb.WriteString("<NextMarker/>")
+	}
+	sb.WriteString("</EnumerationResults>")
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	_, _ = io.WriteString(w, sb.String())
+}
+
+func (s *fakeAzblobServer) serveBlob(w http.ResponseWriter, req *http.Request, name string) {
+	query := req.URL.Query()
+	switch req.Method {
+	case http.MethodPut:
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			s.writeError(w, http.StatusBadRequest, "InvalidInput")
+			return
+		}
+		switch query.Get("comp") {
+		case "":
+			s.blobs[name] = body
+		case "block":
+			if s.stagedBlocks[name] == nil {
+				s.stagedBlocks[name] = make(map[string][]byte)
+			}
+			s.stagedBlocks[name][query.Get("blockid")] = body
+		case "blocklist":
+			var blockList struct {
+				Latest []string `xml:"Latest"`
+			}
+			if err := xml.Unmarshal(body, &blockList); err != nil {
+				s.writeError(w, http.StatusBadRequest, "InvalidXmlDocument")
+				return
+			}
+			var data []byte
+			for _, id := range blockList.Latest {
+				block, ok := s.stagedBlocks[name][id]
+				if !ok {
+					s.writeError(w, http.StatusBadRequest, "InvalidBlockList")
+					return
+				}
+				data = append(data, block...)
+			}
+			s.blobs[name] = data
+			s.committedSize[name] = len(blockList.Latest)
+			delete(s.stagedBlocks, name)
+		default:
+			s.writeError(w, http.StatusBadRequest, "UnsupportedQueryParameter")
+			return
+		}
+		w.Header().Set("ETag", `"blob"`)
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodHead, http.MethodGet:
+		data, ok := s.blobs[name]
+		if !ok {
+			s.writeError(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		w.Header().Set("ETag", `"blob"`)
+		w.Header().Set("x-ms-blob-type", "BlockBlob")
+		if req.Method == http.MethodHead {
+			w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		status := http.StatusOK
+		if r := req.Header.Get("x-ms-range"); r != "" {
+			offset, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(r, "bytes="), "-"))
+			if err != nil || offset >= len(data) {
+				s.writeError(w, http.StatusRequestedRangeNotSatisfiable, "InvalidRange")
+				return
+			}
+			data = data[offset:]
+			status = http.StatusPartialContent
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(status)
+		_, _ = w.Write(data)
+	default:
+		s.writeError(w, http.StatusBadRequest, "UnsupportedHttpVerb")
+	}
+}
+
+func newFakeAzblobStorage(c *C, server *httptest.Server, attrs map[string]string, prefix string) *azblobStorage {
+	cloud := &backuppb.CloudDynamic{
+		ProviderName: azblobProviderName,
+		Bucket: &backuppb.Bucket{
+			Endpoint: server.URL + "/" + fakeAzblobAccount,
+			Bucket:   fakeAzblobContainer,
+			Prefix:   prefix,
+		},
+		Attrs: attrs,
+	}
+	s, err := newAzblobStorage(context.Background(), cloud, &ExternalStorageOptions{
+		SendCredentials: false,
+		HTTPClient:      server.Client(),
+	})
+	c.Assert(err, IsNil)
+	return s
+}
+
+func (r *testStorageSuite) TestAzblob(c *C) {
+	ctx := context.Background()
+	fake := newFakeAzblobServer()
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	accountKey := base64.StdEncoding.EncodeToString([]byte("fake account key"))
+	stg := newFakeAzblobStorage(c, server, map[string]string{
+		azblobAccountNameKey: fakeAzblobAccount,
+		azblobAccountKeyKey:  accountKey,
+	}, "a/b")
+	// the credentials are not sent to TiKV.
+	c.Assert(stg.options.Attrs, HasLen, 1)
+	c.Assert(stg.URI(), Equals, "azure://container/a/b")
+
+	err := stg.WriteFile(ctx, "key", []byte("data"))
+	c.Assert(err, IsNil)
+	err = stg.WriteFile(ctx, "key1", []byte("data1"))
+	c.Assert(err, IsNil)
+	err = stg.WriteFile(ctx, "sub/key2", []byte("data22223346757222222222289722222"))
+	c.Assert(err, IsNil)
+	c.Assert(fake.blobs["a/b/key"], DeepEquals, []byte("data"))
+
+	d, err := stg.ReadFile(ctx, "key")
+	c.Assert(err, IsNil)
+	c.Assert(d, DeepEquals, []byte("data"))
+
+	exist, err := stg.FileExists(ctx, "key")
+	c.Assert(err, IsNil)
+	c.Assert(exist, IsTrue)
+	exist, err = stg.FileExists(ctx, "key_not_exist")
+	c.Assert(err, IsNil)
+	c.Assert(exist, IsFalse)
+	_, err = stg.ReadFile(ctx, "key_not_exist")
+	c.Assert(err, NotNil)
+
+	for _, listCount := range []int64{0, 1} {
+		files := make(map[string]int64)
+		err = stg.WalkDir(ctx, &WalkOption{ListCount: listCount}, func(name string, size int64) error {
+			files[name] = size
+			return nil
+		})
+		c.Assert(err, IsNil)
+		c.Assert(files, DeepEquals, map[string]int64{"key": 4, "key1": 5, "sub/key2": 33})
+	}
+	var subFiles []string
+	err = stg.WalkDir(ctx, &WalkOption{SubDir: "sub"}, func(name string, size int64) error {
+		subFiles = append(subFiles, name)
+		return nil
+	})
+	c.Assert(err, IsNil)
+	c.Assert(subFiles, DeepEquals, []string{"sub/key2"})
+
+	reader, err := stg.Open(ctx, "sub/key2")
+	c.Assert(err, IsNil)
+	buf := make([]byte, 5)
+	n, err := io.ReadFull(reader, buf)
+	c.Assert(err, IsNil)
+	c.Assert(string(buf[:n]), Equals, "data2")
+	offset, err := reader.Seek(10, io.SeekStart)
+	c.Assert(err, IsNil)
+	c.Assert(offset, Equals, int64(10))
+	n, err = io.ReadFull(reader, buf)
+	c.Assert(err, IsNil)
+	c.Assert(string(buf[:n]), Equals, "46757")
+	offset, err = reader.Seek(-3, io.SeekEnd)
+	c.Assert(err, IsNil)
+	c.Assert(offset, Equals, int64(30))
+	rest, err := io.ReadAll(reader)
+	c.Assert(err, IsNil)
+	c.Assert(string(rest), Equals, "222")
+	offset, err = reader.Seek(-5, io.SeekCurrent)
+	c.Assert(err, IsNil)
+	c.Assert(offset, Equals, int64(28))
+	_, err = reader.Seek(-30, io.SeekCurrent)
+	c.Assert(err, NotNil)
+	c.Assert(reader.Close(), IsNil)
+
+	_, err = stg.Open(ctx, "key_not_exist")
+	c.Assert(err, NotNil)
+
+	for _, auth := range fake.authHeaders {
+		c.Assert(strings.HasPrefix(auth, "SharedKey "+fakeAzblobAccount+":"), IsTrue, Commentf("%s", auth))
+	}
+	c.Assert(fake.authHeaders, Not(HasLen), 0)
+}
+
+func (r *testStorageSuite) TestAzblobMultipartUpload(c *C) {
+	ctx := context.Background()
+	fake := newFakeAzblobServer()
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	stg := newFakeAzblobStorage(c, server, map[string]string{
+		azblobSASTokenKey: "sv=2020-08-04&ss=b&srt=co&sp=rwl&sig=fakesignature",
+	}, "")
+
+	writer, err := stg.Create(ctx, "multipart")
+	c.Assert(err, IsNil)
+	chunk := []byte(strings.Repeat("0123456789", hardcodedS3ChunkSize/10+1))
+	for i := 0; i < 3; i++ {
+		_, err = writer.Write(ctx, chunk)
+		c.Assert(err, IsNil)
+	}
+	c.Assert(writer.Close(ctx), IsNil)
+	// every write fills a whole chunk, so the blob is uploaded in several blocks.
+	c.Assert(fake.committedSize["multipart"] > 1, IsTrue)
+	c.Assert(len(fake.blobs["multipart"]), Equals, 3*len(chunk))
+
+	data, err := stg.ReadFile(ctx, "multipart")
+	c.Assert(err, IsNil)
+	c.Assert(data, DeepEquals, []byte(strings.Repeat(string(chunk), 3)))
+
+	// an empty file is still created.
+	writer, err = stg.Create(ctx, "empty")
+	c.Assert(err, IsNil)
+	c.Assert(writer.Close(ctx), IsNil)
+	exist, err := stg.FileExists(ctx, "empty")
+	c.Assert(err, IsNil)
+	c.Assert(exist, IsTrue)
+
+	// the compressed writer uploads blocks too.
+	compressed := WithCompression(stg, Gzip)
+	writer, err = compressed.Create(ctx, "compressed.gz")
+	c.Assert(err, IsNil)
+	_, err = writer.Write(ctx, []byte("hello azure"))
+	c.Assert(err, IsNil)
+	c.Assert(writer.Close(ctx), IsNil)
+	data, err = compressed.ReadFile(ctx, "compressed.gz")
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, "hello azure")
+
+	c.Assert(fake.authHeaders, HasLen, 0)
+	c.Assert(fake.sasQueries, Not(HasLen), 0)
+}
+
+func (r *testStorageSuite) TestAzblobInvalidConfig(c *C) {
+	ctx := context.Background()
+	fake := newFakeAzblobServer()
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	cloud := &backuppb.CloudDynamic{
+		ProviderName: azblobProviderName,
+		Bucket: &backuppb.Bucket{
+			Endpoint: server.URL + "/" + fakeAzblobAccount,
+			Bucket:   "not-exist",
+		},
+		Attrs: map[string]string{azblobSASTokenKey: "sig=fake"},
+	}
+	_, err := newAzblobStorage(ctx, cloud, &ExternalStorageOptions{HTTPClient: server.Client()})
+	c.Assert(err, NotNil)
+
+	cloud.Bucket.Bucket = fakeAzblobContainer
+	cloud.Attrs = map[string]string{azblobAccountKeyKey: "key"}
+	_, err = newAzblobStorage(ctx, cloud, &ExternalStorageOptions{HTTPClient: server.Client()})
+	c.Assert(err, ErrorMatches, ".*account name.*")
+}
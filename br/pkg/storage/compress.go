@@ -6,6 +6,8 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"path/filepath"
+	"strings"
 
 	"github.com/pingcap/errors"
 	berrors "github.com/pingcap/tidb/br/pkg/errors"
@@ -16,7 +18,8 @@ type withCompression struct {
 	compressType CompressType
 }
 
-// WithCompression returns an ExternalStorage with compress option
+// WithCompression returns an ExternalStorage with compress option. The files are written with the given compress type,
+// and the compress type of the files to read is detected from the file extension or the magic bytes.
 func WithCompression(inner ExternalStorage, compressionType CompressType) ExternalStorage {
 	if compressionType == NoCompression {
 		return inner
@@ -29,9 +32,12 @@ func (w *withCompression) Create(ctx context.Context, name string) (ExternalFile
 		writer ExternalFileWriter
 		err    error
 	)
-	if s3Storage, ok := w.ExternalStorage.(*S3Storage); ok {
-		writer, err = s3Storage.CreateUploader(ctx, name)
-	} else {
+	switch s := w.ExternalStorage.(type) {
+	case *S3Storage:
+		writer, err = s.CreateUploader(ctx, name)
+	case *azblobStorage:
+		writer, err = s.CreateUploader(ctx, name)
+	default:
 		writer, err = w.ExternalStorage.Create(ctx, name)
 	}
 	if err != nil {
@@ -46,8 +52,17 @@ func (w *withCompression) Open(ctx context.Context, path string) (ExternalFileRe
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	uncompressReader, err := newInterceptReader(fileReader, w.compressType)
+	compressType := GetCompressTypeByFileName(path)
+	if compressType == NoCompression {
+		compressType, err = detectCompressTypeOfReader(fileReader)
+		if err != nil {
+			fileReader.Close()
+			return nil, errors.Trace(err)
+		}
+	}
+	uncompressReader, err := newInterceptReader(fileReader, compressType)
 	if err != nil {
+		fileReader.Close()
 		return nil, errors.Trace(err)
 	}
 	return uncompressReader, nil
@@ -72,16 +87,75 @@ func (w *withCompression) ReadFile(ctx context.Context, name string) ([]byte, er
 	if err != nil {
 		return data, errors.Trace(err)
 	}
-	bf := bytes.NewBuffer(data)
-	compressBf, err := newCompressReader(w.compressType, bf)
+	compressType := GetCompressTypeByFileName(name)
+	if compressType == NoCompression {
+		compressType = detectCompressType(data)
+	}
+	if compressType == NoCompression {
+		return data, nil
+	}
+	compressBf, err := newCompressReader(compressType, bytes.NewReader(data))
 	if err != nil {
 		return nil, err
 	}
+	defer compressBf.Close()
 	return io.ReadAll(compressBf)
 }
 
+// GetCompressTypeByFileName returns the compress type according to the extension of the file name,
+// it returns NoCompression if the extension is unknown.
+func GetCompressTypeByFileName(name string) CompressType {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".gz", ".gzip":
+		return Gzip
+	case ".snappy", ".sz":
+		return Snappy
+	case ".zst", ".zstd":
+		return Zstd
+	default:
+		return NoCompression
+	}
+}
+
+var (
+	gzipMagic   = []byte{0x1f, 0x8b}
+	zstdMagic   = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	snappyMagic = []byte("\xff\x06\x00\x00sNaPpY")
+)
+
+// maxMagicLen is the length of the longest magic bytes.
+const maxMagicLen = 10
+
+// detectCompressType returns the compress type according to the magic bytes at the beginning of the data.
+func detectCompressType(header []byte) CompressType {
+	switch {
+	case bytes.HasPrefix(header, gzipMagic):
+		return Gzip
+	case bytes.HasPrefix(header, zstdMagic):
+		return Zstd
+	case bytes.HasPrefix(header, snappyMagic):
+		return Snappy
+	default:
+		return NoCompression
+	}
+}
+
+// detectCompressTypeOfReader detects the compress type by the magic bytes and rewinds the reader.
+func detectCompressTypeOfReader(r ExternalFileReader) (CompressType, error) {
+	header := make([]byte, maxMagicLen)
+	n, err := io.ReadFull(r, header)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return NoCompression, errors.Trace(err)
+	}
+	if _, err = r.Seek(0, io.SeekStart); err != nil {
+		return NoCompression, errors.Trace(err)
+	}
+	return detectCompressType(header[:n]), nil
+}
+
 type compressReader struct {
 	io.ReadCloser
+	file io.Closer
 }
 
 // nolint:interfacer
@@ -95,9 +169,18 @@ func newInterceptReader(fileReader ExternalFileReader, compressType CompressType
 	}
 	return &compressReader{
 		ReadCloser: r,
+		file:       fileReader,
 	}, nil
 }
 
+func (r *compressReader) Close() error {
+	err := r.ReadCloser.Close()
+	if err1 := r.file.Close(); err == nil {
+		err = err1
+	}
+	return errors.Trace(err)
+}
+
 func (r *compressReader) Seek(_ int64, _ int) (int64, error) {
 	return int64(0), errors.Annotatef(berrors.ErrStorageInvalidConfig, "compressReader doesn't support Seek now")
 }
@@ -40,3 +40,49 @@ func (r *testStorageSuite) TestWithCompressReadWriteFile(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(string(newContent), Equals, content)
 }
+
+func (r *testStorageSuite) TestDetectCompressType(c *C) {
+	dir := c.MkDir()
+	ctx := context.Background()
+	backend, err := ParseBackend("local://"+filepath.ToSlash(dir), nil)
+	c.Assert(err, IsNil)
+	storage, err := Create(ctx, backend, true)
+	c.Assert(err, IsNil)
+	content := strings.Repeat("hello,world!", 100)
+
+	for _, compressType := range []CompressType{Gzip, Snappy, Zstd} {
+		c.Assert(GetCompressTypeByFileName("a.sql"+compressType.FileSuffix()), Equals, compressType)
+
+		// The file is written with the compress type, but read by a storage with another one.
+		writeStorage := WithCompression(storage, compressType)
+		readStorage := WithCompression(storage, Gzip)
+		if compressType == Gzip {
+			readStorage = WithCompression(storage, Zstd)
+		}
+		for _, fileName := range []string{"with-ext.txt" + compressType.FileSuffix(), "without-ext.txt"} {
+			c.Assert(writeStorage.WriteFile(ctx, fileName, []byte(content)), IsNil)
+			data, err := readStorage.ReadFile(ctx, fileName)
+			c.Assert(err, IsNil)
+			c.Assert(string(data), Equals, content)
+
+			reader, err := readStorage.Open(ctx, fileName)
+			c.Assert(err, IsNil)
+			data, err = io.ReadAll(reader)
+			c.Assert(err, IsNil)
+			c.Assert(string(data), Equals, content)
+			c.Assert(reader.Close(), IsNil)
+		}
+	}
+
+	// The plain file is read as it is.
+	c.Assert(storage.WriteFile(ctx, "plain.txt", []byte(content)), IsNil)
+	data, err := WithCompression(storage, Zstd).ReadFile(ctx, "plain.txt")
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, content)
+	reader, err := WithCompression(storage, Snappy).Open(ctx, "plain.txt")
+	c.Assert(err, IsNil)
+	data, err = io.ReadAll(reader)
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, content)
+	c.Assert(reader.Close(), IsNil)
+}
@@ -128,6 +128,30 @@ func (r *testStorageSuite) TestCreateStorage(c *C) {
 	c.Assert(gcs.Prefix, Equals, "backup")
 	c.Assert(gcs.CredentialsBlob, Equals, "fakeCreds2")
 
+	s, err = ParseBackend("azure://container/backup/?account-name=user&account-key=secret&access-tier=Cool", nil)
+	c.Assert(err, IsNil)
+	azblob := s.GetCloudDynamic()
+	c.Assert(azblob, NotNil)
+	c.Assert(azblob.ProviderName, Equals, "azure")
+	c.Assert(azblob.Bucket.Bucket, Equals, "container")
+	c.Assert(azblob.Bucket.Prefix, Equals, "backup")
+	c.Assert(azblob.Bucket.StorageClass, Equals, "Cool")
+	c.Assert(azblob.Attrs, DeepEquals, map[string]string{"account-name": "user", "account-key": "secret"})
+
+	azblobOpt := &BackendOptions{Azblob: AzblobBackendOptions{Endpoint: "http://127.0.0.1:10000/devstoreaccount1"}}
+	s, err = ParseBackend("azblob://container?sas-token="+url.QueryEscape("?sv=2020-08-04&sig=a+b"), azblobOpt)
+	c.Assert(err, IsNil)
+	azblob = s.GetCloudDynamic()
+	c.Assert(azblob, NotNil)
+	c.Assert(azblob.Bucket.Endpoint, Equals, "http://127.0.0.1:10000/devstoreaccount1")
+	c.Assert(azblob.Bucket.Prefix, Equals, "")
+	c.Assert(azblob.Attrs, DeepEquals, map[string]string{"sas-token": "sv=2020-08-04&sig=a+b"})
+
+	_, err = ParseBackend("azure:///backup", nil)
+	c.Assert(err, ErrorMatches, ".*please specify the container for azblob.*")
+	_, err = ParseBackend("azure://container/backup?access-tier=Unknown", nil)
+	c.Assert(err, ErrorMatches, ".*invalid azblob access tier.*")
+
 	s, err = ParseBackend("/test", nil)
 	c.Assert(err, IsNil)
 	local := s.GetLocal()
@@ -173,4 +197,19 @@ func (r *testStorageSuite) TestFormatBackendURL(c *C) {
 		},
 	})
 	c.Assert(url.String(), Equals, "gcs://bucket/some%20prefix/")
+
+	url = FormatBackendURL(&backuppb.StorageBackend{
+		Backend: &backuppb.StorageBackend_CloudDynamic{
+			CloudDynamic: &backuppb.CloudDynamic{
+				ProviderName: "azure",
+				Bucket: &backuppb.Bucket{
+					Bucket:   "container",
+					Prefix:   "/some prefix/",
+					Endpoint: "https://account.blob.core.windows.net/",
+				},
+				Attrs: map[string]string{"account-key": "secret"},
+			},
+		},
+	})
+	c.Assert(url.String(), Equals, "azure://container/some%20prefix/")
 }
@@ -171,6 +171,11 @@ func New(ctx context.Context, backend *backuppb.StorageBackend, opts *ExternalSt
 			return nil, errors.Annotate(berrors.ErrStorageInvalidConfig, "GCS config not found")
 		}
 		return newGCSStorage(ctx, backend.Gcs, opts)
+	case *backuppb.StorageBackend_CloudDynamic:
+		if !isAzblobBackend(backend.CloudDynamic) {
+			return nil, errors.Annotatef(berrors.ErrStorageInvalidConfig, "cloud provider %s is not supported yet", backend.CloudDynamic.GetProviderName())
+		}
+		return newAzblobStorage(ctx, backend.CloudDynamic, opts)
 	default:
 		return nil, errors.Annotatef(berrors.ErrStorageInvalidConfig, "storage %T is not supported yet", backend)
 	}
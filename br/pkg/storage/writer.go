@@ -6,6 +6,8 @@ import (
 	"context"
 	"io"
 
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
 	"github.com/pingcap/errors"
 )
 
@@ -17,8 +19,26 @@ const (
 	NoCompression CompressType = iota
 	// Gzip will compress given bytes in gzip format.
 	Gzip
+	// Snappy will compress given bytes in snappy framing format.
+	Snappy
+	// Zstd will compress given bytes in zstd format.
+	Zstd
 )
 
+// FileSuffix returns the conventional file extension of the compress type, it's empty for NoCompression.
+func (ct CompressType) FileSuffix() string {
+	switch ct {
+	case Gzip:
+		return ".gz"
+	case Snappy:
+		return ".snappy"
+	case Zstd:
+		return ".zst"
+	default:
+		return ""
+	}
+}
+
 type flusher interface {
 	Flush() error
 }
@@ -49,6 +69,12 @@ func newCompressWriter(compressType CompressType, w io.Writer) simpleCompressWri
 	switch compressType {
 	case Gzip:
 		return gzip.NewWriter(w)
+	case Snappy:
+		return snappy.NewBufferedWriter(w)
+	case Zstd:
+		// zstd.NewWriter only returns an error for invalid options.
+		zw, _ := zstd.NewWriter(w)
+		return zw
 	default:
 		return nil
 	}
@@ -58,6 +84,14 @@ func newCompressReader(compressType CompressType, r io.Reader) (io.ReadCloser, e
 	switch compressType {
 	case Gzip:
 		return gzip.NewReader(r)
+	case Snappy:
+		return io.NopCloser(snappy.NewReader(r)), nil
+	case Zstd:
+		zr, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return zr.IOReadCloser(), nil
 	default:
 		return nil, nil
 	}
@@ -101,8 +101,8 @@ func (r *testStorageSuite) TestCompressReaderWriter(c *C) {
 		ctx := context.Background()
 		storage, err := Create(ctx, backend, true)
 		c.Assert(err, IsNil)
-		storage = WithCompression(storage, Gzip)
-		fileName := strings.ReplaceAll(test.name, " ", "-") + ".txt.gz"
+		storage = WithCompression(storage, test.compressType)
+		fileName := strings.ReplaceAll(test.name, " ", "-") + ".txt" + test.compressType.FileSuffix()
 		writer, err := storage.Create(ctx, fileName)
 		c.Assert(err, IsNil)
 		for _, str := range test.content {
@@ -134,7 +134,7 @@ func (r *testStorageSuite) TestCompressReaderWriter(c *C) {
 
 		c.Assert(file.Close(), IsNil)
 	}
-	compressTypeArr := []CompressType{Gzip}
+	compressTypeArr := []CompressType{Gzip, Snappy, Zstd}
 	tests := []testcase{
 		{
 			name: "long text medium chunks",
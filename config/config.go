@@ -443,6 +443,10 @@ type PreparedPlanCache struct {
 	Enabled          bool    `toml:"enabled" json:"enabled"`
 	Capacity         uint    `toml:"capacity" json:"capacity"`
 	MemoryGuardRatio float64 `toml:"memory-guard-ratio" json:"memory-guard-ratio"`
+	// InstanceCacheEnabled indicates whether the prepared plans are shared by all the sessions of the instance.
+	InstanceCacheEnabled bool `toml:"instance-cache-enabled" json:"instance-cache-enabled"`
+	// InstanceCacheMaxMemory is the memory limit of the plans shared by all the sessions of the instance.
+	InstanceCacheMaxMemory uint64 `toml:"instance-cache-max-memory" json:"instance-cache-max-memory"`
 }
 
 // OpenTracing is the opentracing section of the config.
@@ -637,9 +641,11 @@ var defaultConf = Config{
 		HeaderTimeout: 5,
 	},
 	PreparedPlanCache: PreparedPlanCache{
-		Enabled:          false,
-		Capacity:         100,
-		MemoryGuardRatio: 0.1,
+		Enabled:                false,
+		Capacity:               100,
+		MemoryGuardRatio:       0.1,
+		InstanceCacheEnabled:   false,
+		InstanceCacheMaxMemory: 256 << 20,
 	},
 	OpenTracing: OpenTracing{
 		Enable: false,
@@ -905,6 +911,9 @@ func (c *Config) Valid() error {
 	if c.PreparedPlanCache.MemoryGuardRatio < 0 || c.PreparedPlanCache.MemoryGuardRatio > 1 {
 		return fmt.Errorf("memory-guard-ratio in [prepared-plan-cache] must be NOT less than 0 and more than 1")
 	}
+	if c.PreparedPlanCache.InstanceCacheEnabled && c.PreparedPlanCache.InstanceCacheMaxMemory == 0 {
+		return fmt.Errorf("instance-cache-max-memory in [prepared-plan-cache] should be greater than 0")
+	}
 	if len(c.IsolationRead.Engines) < 1 {
 		return fmt.Errorf("the number of [isolation-read]engines for isolation read should be at least 1")
 	}
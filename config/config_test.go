@@ -475,11 +475,13 @@ func (s *testConfigSuite) TestTxnTotalSizeLimitValid(c *C) {
 func (s *testConfigSuite) TestPreparePlanCacheValid(c *C) {
 	conf := NewConfig()
 	tests := map[PreparedPlanCache]bool{
-		{Enabled: true, Capacity: 0}:                        false,
-		{Enabled: true, Capacity: 2}:                        true,
-		{Enabled: true, MemoryGuardRatio: -0.1}:             false,
-		{Enabled: true, MemoryGuardRatio: 2.2}:              false,
-		{Enabled: true, Capacity: 2, MemoryGuardRatio: 0.5}: true,
+		{Enabled: true, Capacity: 0}:                                                           false,
+		{Enabled: true, Capacity: 2}:                                                           true,
+		{Enabled: true, MemoryGuardRatio: -0.1}:                                                false,
+		{Enabled: true, MemoryGuardRatio: 2.2}:                                                 false,
+		{Enabled: true, Capacity: 2, MemoryGuardRatio: 0.5}:                                    true,
+		{Enabled: true, Capacity: 2, InstanceCacheEnabled: true}:                               false,
+		{Enabled: true, Capacity: 2, InstanceCacheEnabled: true, InstanceCacheMaxMemory: 1024}: true,
 	}
 	for testCase, res := range tests {
 		conf.PreparedPlanCache = testCase
@@ -170,7 +170,7 @@ func checkAddColumn(t *meta.Meta, job *model.Job) (*model.TableInfo, *model.Colu
 	return tblInfo, columnInfo, col, pos, offset, nil
 }
 
-func onAddColumn(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+func (w *worker) onAddColumn(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
 	// Handle the rolling back job.
 	if job.IsRollingback() {
 		ver, err = onDropColumn(t, job)
@@ -190,6 +190,12 @@ func onAddColumn(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error)
 	if err != nil {
 		return ver, errors.Trace(err)
 	}
+	// The check constraints defined in the column are made public together with the column.
+	var constraints []*model.ConstraintInfo
+	if err = job.DecodeArgs(&model.ColumnInfo{}, &ast.ColumnPosition{}, new(int), &constraints); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
 	if columnInfo == nil {
 		columnInfo, _, offset, err = createColumnInfo(tblInfo, col, pos)
 		if err != nil {
@@ -199,7 +205,7 @@ func onAddColumn(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error)
 		logutil.BgLogger().Info("[ddl] run add column job", zap.String("job", job.String()), zap.Reflect("columnInfo", *columnInfo), zap.Int("offset", offset))
 		// Set offset arg to job.
 		if offset != 0 {
-			job.Args = []interface{}{columnInfo, pos, offset}
+			job.Args = []interface{}{columnInfo, pos, offset, constraints}
 		}
 		if err = checkAddColumnTooManyColumns(len(tblInfo.Columns)); err != nil {
 			job.State = model.JobStateCancelled
@@ -236,10 +242,37 @@ func onAddColumn(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error)
 		// Update the job state when all affairs done.
 		job.SchemaState = model.StateWriteReorganization
 	case model.StateWriteReorganization:
+		if len(constraints) > 0 {
+			dbInfo, err := checkSchemaExistAndCancelNotExistJob(t, job)
+			if err != nil {
+				return ver, errors.Trace(err)
+			}
+			err = w.verifyCheckConstraintsForAddingColumn(dbInfo, tblInfo, columnInfo, constraints)
+			if err != nil {
+				if !table.ErrCheckConstraintViolated.Equal(err) && !errCheckConstraintDupName.Equal(err) {
+					return ver, errors.Trace(err)
+				}
+				ver, err1 := rollingbackAddColumn(t, job)
+				if err1 != nil && !errCancelledDDLJob.Equal(err1) {
+					return ver, errors.Trace(err1)
+				}
+				return ver, errors.Trace(err)
+			}
+		}
+		if pauseRevertibleSubJob(job) {
+			return ver, nil
+		}
 		// reorganization -> public
 		// Adjust table column offset.
 		adjustColumnInfoInAddColumn(tblInfo, offset)
 		columnInfo.State = model.StatePublic
+		for _, constr := range constraints {
+			constrInfo := constr.Clone()
+			tblInfo.MaxConstraintID++
+			constrInfo.ID = tblInfo.MaxConstraintID
+			constrInfo.State = model.StatePublic
+			tblInfo.Constraints = append(tblInfo.Constraints, constrInfo)
+		}
 		ver, err = updateVersionAndTableInfo(t, job, tblInfo, originalState != columnInfo.State)
 		if err != nil {
 			return ver, errors.Trace(err)
@@ -382,6 +415,9 @@ func onAddColumns(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error
 		}
 		job.SchemaState = model.StateWriteReorganization
 	case model.StateWriteReorganization:
+		if pauseRevertibleSubJob(job) {
+			return ver, nil
+		}
 		// reorganization -> public
 		// Adjust table column offsets.
 		oldCols := tblInfo.Columns[:len(tblInfo.Columns)-len(offsets)]
@@ -418,8 +454,159 @@ func onAddColumns(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error
 	return ver, errors.Trace(err)
 }
 
+// onDropColumnsWithIndexes handles the drop column jobs. The composite indexes covering the dropped columns are
+// rebuilt without these columns before the columns leave the public state.
+func (w *worker) onDropColumnsWithIndexes(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+	var (
+		tblInfo           *model.TableInfo
+		colInfo           *model.ColumnInfo
+		colInfos          []*model.ColumnInfo
+		compositeIdxInfos []*model.IndexInfo
+	)
+	if job.Type != model.ActionDropColumn {
+		tblInfo, colInfos, _, _, compositeIdxInfos, err = checkDropColumns(t, job)
+	} else {
+		tblInfo, colInfo, _, compositeIdxInfos, err = checkDropColumn(t, job)
+		colInfos = []*model.ColumnInfo{colInfo}
+	}
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+
+	changingIdxs := findIndexesForDropColumns(tblInfo, compositeIdxInfos)
+	if job.IsRollingback() {
+		return rollbackDropColumnsWithIndexes(t, job, tblInfo, changingIdxs)
+	}
+	if len(colInfos) > 0 && colInfos[0].State == model.StatePublic {
+		colNames := make([]model.CIStr, 0, len(colInfos))
+		for _, col := range colInfos {
+			colNames = append(colNames, col.Name)
+		}
+		var done bool
+		done, ver, err = w.rebuildIndexesForDropColumns(d, t, job, tblInfo, colNames, compositeIdxInfos, changingIdxs)
+		if !done {
+			return ver, errors.Trace(err)
+		}
+		if pauseRevertibleSubJob(job) {
+			return ver, nil
+		}
+	}
+
+	if job.Type != model.ActionDropColumn {
+		return onDropColumns(t, job)
+	}
+	return onDropColumn(t, job)
+}
+
+// rebuildIndexesForDropColumns builds the indexes which replace the composite indexes covering the dropped columns.
+// It returns true if these indexes are backfilled, or there isn't any composite index to rebuild.
+func (w *worker) rebuildIndexesForDropColumns(d *ddlCtx, t *meta.Meta, job *model.Job, tblInfo *model.TableInfo,
+	colNames []model.CIStr, compositeIdxInfos, changingIdxs []*model.IndexInfo) (done bool, ver int64, err error) {
+	if len(compositeIdxInfos) == 0 {
+		return true, ver, nil
+	}
+	if len(changingIdxs) == 0 {
+		changingIdxs = buildIndexesForDropColumns(tblInfo, colNames, compositeIdxInfos)
+		tblInfo.Indices = append(tblInfo.Indices, changingIdxs...)
+	}
+
+	originalState := changingIdxs[0].State
+	switch changingIdxs[0].State {
+	case model.StateNone:
+		// none -> delete only
+		setIndicesState(changingIdxs, model.StateDeleteOnly)
+		ver, err = updateVersionAndTableInfoWithCheck(t, job, tblInfo, originalState != changingIdxs[0].State)
+	case model.StateDeleteOnly:
+		// delete only -> write only
+		setIndicesState(changingIdxs, model.StateWriteOnly)
+		ver, err = updateVersionAndTableInfo(t, job, tblInfo, originalState != changingIdxs[0].State)
+	case model.StateWriteOnly:
+		// write only -> reorganization
+		setIndicesState(changingIdxs, model.StateWriteReorganization)
+		ver, err = updateVersionAndTableInfo(t, job, tblInfo, originalState != changingIdxs[0].State)
+		// Initialize SnapshotVer to 0 for later reorganization check.
+		job.SnapshotVer = 0
+	case model.StateWriteReorganization:
+		tbl, err := getTable(d.store, job.SchemaID, tblInfo)
+		if err != nil {
+			return false, ver, errors.Trace(err)
+		}
+		return doReorgWorkInSubJob(job, func() (bool, int64, error) {
+			return doReorgWorkForDropColumns(w, d, t, job, tbl, changingIdxs)
+		})
+	default:
+		err = ErrInvalidDDLState.GenWithStackByArgs("index", changingIdxs[0].State)
+	}
+	return false, ver, errors.Trace(err)
+}
+
+// doReorgWorkForDropColumns backfills the rebuilt indexes. It returns true if the backfilling is done.
+func doReorgWorkForDropColumns(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job, tbl table.Table,
+	changingIdxs []*model.IndexInfo) (done bool, ver int64, err error) {
+	elements := make([]*meta.Element, 0, len(changingIdxs))
+	for _, idx := range changingIdxs {
+		elements = append(elements, &meta.Element{ID: idx.ID, TypeKey: meta.IndexElementKey})
+	}
+	reorgInfo, err := getReorgInfo(d, t, job, tbl, elements)
+	if err != nil || reorgInfo.first {
+		// If we run reorg firstly, we should update the job snapshot version
+		// and then run the reorg next time.
+		return false, ver, errors.Trace(err)
+	}
+
+	err = w.runReorgJob(t, reorgInfo, tbl.Meta(), d.lease, func() (addIndexErr error) {
+		defer util.Recover(metrics.LabelDDL, "onDropColumn",
+			func() {
+				addIndexErr = errCancelledDDLJob.GenWithStack("drop table `%v` column panic", tbl.Meta().Name)
+			}, false)
+		return w.addTableIndexes(tbl, changingIdxs, reorgInfo)
+	})
+	if err != nil {
+		if errWaitReorgTimeout.Equal(err) {
+			// If timeout, we should return, check for the owner and re-wait job done.
+			return false, ver, nil
+		}
+		if kv.IsTxnRetryableError(err) {
+			// Clean up the channel of notifyCancelReorgJob. Make sure it can't affect other jobs.
+			w.reorgCtx.cleanNotifyReorgCancel()
+			return false, ver, errors.Trace(err)
+		}
+		if err1 := t.RemoveDDLReorgHandle(job, reorgInfo.elements); err1 != nil {
+			logutil.BgLogger().Warn("[ddl] run drop column job failed, RemoveDDLReorgHandle failed, can't convert job to rollback",
+				zap.String("job", job.String()), zap.Error(err1))
+		}
+		logutil.BgLogger().Warn("[ddl] run drop column job failed, convert job to rollback", zap.String("job", job.String()), zap.Error(err))
+		job.State = model.JobStateRollingback
+		// Clean up the channel of notifyCancelReorgJob. Make sure it can't affect other jobs.
+		w.reorgCtx.cleanNotifyReorgCancel()
+		return false, ver, errors.Trace(err)
+	}
+	// Clean up the channel of notifyCancelReorgJob. Make sure it can't affect other jobs.
+	w.reorgCtx.cleanNotifyReorgCancel()
+	return true, ver, nil
+}
+
+// rollbackDropColumnsWithIndexes removes the indexes rebuilt for the drop column job, the columns are kept.
+func rollbackDropColumnsWithIndexes(t *meta.Meta, job *model.Job, tblInfo *model.TableInfo, changingIdxs []*model.IndexInfo) (ver int64, err error) {
+	newIndices := make([]*model.IndexInfo, 0, len(tblInfo.Indices))
+	for _, idx := range tblInfo.Indices {
+		if !indexInfoContains(idx.ID, changingIdxs) {
+			newIndices = append(newIndices, idx)
+		}
+	}
+	tblInfo.Indices = newIndices
+	ver, err = updateVersionAndTableInfo(t, job, tblInfo, len(changingIdxs) > 0)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishTableJob(model.JobStateRollbackDone, model.StateNone, ver, tblInfo)
+	// Refactor the job args to add the abandoned indexes into delete range table.
+	job.Args = append(job.Args, indexInfosToIDList(changingIdxs), getPartitionIDs(tblInfo))
+	return ver, nil
+}
+
 func onDropColumns(t *meta.Meta, job *model.Job) (ver int64, _ error) {
-	tblInfo, colInfos, delCount, idxInfos, err := checkDropColumns(t, job)
+	tblInfo, colInfos, delCount, idxInfos, compositeIdxInfos, err := checkDropColumns(t, job)
 	if err != nil {
 		return ver, errors.Trace(err)
 	}
@@ -435,6 +622,7 @@ func onDropColumns(t *meta.Meta, job *model.Job) (ver int64, _ error) {
 		setColumnsState(colInfos, model.StateWriteOnly)
 		setIndicesState(idxInfos, model.StateWriteOnly)
 		for _, colInfo := range colInfos {
+			removeCheckConstraintsOnColumn(tblInfo, colInfo.Name)
 			err = checkDropColumnForStatePublic(tblInfo, colInfo)
 			if err != nil {
 				return ver, errors.Trace(err)
@@ -467,13 +655,7 @@ func onDropColumns(t *meta.Meta, job *model.Job) (ver int64, _ error) {
 		// reorganization -> absent
 		// All reorganization jobs are done, drop this column.
 		if len(idxInfos) > 0 {
-			newIndices := make([]*model.IndexInfo, 0, len(tblInfo.Indices))
-			for _, idx := range tblInfo.Indices {
-				if !indexInfoContains(idx.ID, idxInfos) {
-					newIndices = append(newIndices, idx)
-				}
-			}
-			tblInfo.Indices = newIndices
+			removeIndexesForDropColumns(tblInfo, idxInfos, findIndexesForDropColumns(tblInfo, compositeIdxInfos))
 		}
 
 		indexIDs := indexInfosToIDList(idxInfos)
@@ -497,11 +679,11 @@ func onDropColumns(t *meta.Meta, job *model.Job) (ver int64, _ error) {
 	return ver, errors.Trace(err)
 }
 
-func checkDropColumns(t *meta.Meta, job *model.Job) (*model.TableInfo, []*model.ColumnInfo, int, []*model.IndexInfo, error) {
+func checkDropColumns(t *meta.Meta, job *model.Job) (*model.TableInfo, []*model.ColumnInfo, int, []*model.IndexInfo, []*model.IndexInfo, error) {
 	schemaID := job.SchemaID
 	tblInfo, err := getTableInfoAndCancelFaultJob(t, job, schemaID)
 	if err != nil {
-		return nil, nil, 0, nil, errors.Trace(err)
+		return nil, nil, 0, nil, nil, errors.Trace(err)
 	}
 
 	var colNames []model.CIStr
@@ -509,13 +691,12 @@ func checkDropColumns(t *meta.Meta, job *model.Job) (*model.TableInfo, []*model.
 	err = job.DecodeArgs(&colNames, &ifExists)
 	if err != nil {
 		job.State = model.JobStateCancelled
-		return nil, nil, 0, nil, errors.Trace(err)
+		return nil, nil, 0, nil, nil, errors.Trace(err)
 	}
 
 	newColNames := make([]model.CIStr, 0, len(colNames))
 	colInfos := make([]*model.ColumnInfo, 0, len(colNames))
 	newIfExists := make([]bool, 0, len(colNames))
-	indexInfos := make([]*model.IndexInfo, 0)
 	for i, colName := range colNames {
 		colInfo := model.FindColumnInfo(tblInfo.Columns, colName.L)
 		if colInfo == nil || colInfo.Hidden {
@@ -525,20 +706,19 @@ func checkDropColumns(t *meta.Meta, job *model.Job) (*model.TableInfo, []*model.
 				continue
 			}
 			job.State = model.JobStateCancelled
-			return nil, nil, 0, nil, ErrCantDropFieldOrKey.GenWithStack("column %s doesn't exist", colName)
+			return nil, nil, 0, nil, nil, ErrCantDropFieldOrKey.GenWithStack("column %s doesn't exist", colName)
 		}
 		if err = isDroppableColumn(tblInfo, colName); err != nil {
 			job.State = model.JobStateCancelled
-			return nil, nil, 0, nil, errors.Trace(err)
+			return nil, nil, 0, nil, nil, errors.Trace(err)
 		}
 		newColNames = append(newColNames, colName)
 		newIfExists = append(newIfExists, ifExists[i])
 		colInfos = append(colInfos, colInfo)
-		idxInfos := listIndicesWithColumn(colName.L, tblInfo.Indices)
-		indexInfos = append(indexInfos, idxInfos...)
 	}
+	indexInfos, compositeIdxInfos := listIndicesWithColumns(newColNames, tblInfo.Indices)
 	job.Args = []interface{}{newColNames, newIfExists}
-	return tblInfo, colInfos, len(colInfos), indexInfos, nil
+	return tblInfo, colInfos, len(colInfos), indexInfos, compositeIdxInfos, nil
 }
 
 func checkDropColumnForStatePublic(tblInfo *model.TableInfo, colInfo *model.ColumnInfo) (err error) {
@@ -564,7 +744,7 @@ func checkDropColumnForStatePublic(tblInfo *model.TableInfo, colInfo *model.Colu
 }
 
 func onDropColumn(t *meta.Meta, job *model.Job) (ver int64, _ error) {
-	tblInfo, colInfo, idxInfos, err := checkDropColumn(t, job)
+	tblInfo, colInfo, idxInfos, compositeIdxInfos, err := checkDropColumn(t, job)
 	if err != nil {
 		return ver, errors.Trace(err)
 	}
@@ -575,6 +755,7 @@ func onDropColumn(t *meta.Meta, job *model.Job) (ver int64, _ error) {
 		// public -> write only
 		colInfo.State = model.StateWriteOnly
 		setIndicesState(idxInfos, model.StateWriteOnly)
+		removeCheckConstraintsOnColumn(tblInfo, colInfo.Name)
 		err = checkDropColumnForStatePublic(tblInfo, colInfo)
 		if err != nil {
 			return ver, errors.Trace(err)
@@ -606,13 +787,7 @@ func onDropColumn(t *meta.Meta, job *model.Job) (ver int64, _ error) {
 		// reorganization -> absent
 		// All reorganization jobs are done, drop this column.
 		if len(idxInfos) > 0 {
-			newIndices := make([]*model.IndexInfo, 0, len(tblInfo.Indices))
-			for _, idx := range tblInfo.Indices {
-				if !indexInfoContains(idx.ID, idxInfos) {
-					newIndices = append(newIndices, idx)
-				}
-			}
-			tblInfo.Indices = newIndices
+			removeIndexesForDropColumns(tblInfo, idxInfos, findIndexesForDropColumns(tblInfo, compositeIdxInfos))
 		}
 
 		indexIDs := indexInfosToIDList(idxInfos)
@@ -637,40 +812,42 @@ func onDropColumn(t *meta.Meta, job *model.Job) (ver int64, _ error) {
 	return ver, errors.Trace(err)
 }
 
-func checkDropColumn(t *meta.Meta, job *model.Job) (*model.TableInfo, *model.ColumnInfo, []*model.IndexInfo, error) {
+func checkDropColumn(t *meta.Meta, job *model.Job) (*model.TableInfo, *model.ColumnInfo, []*model.IndexInfo, []*model.IndexInfo, error) {
 	schemaID := job.SchemaID
 	tblInfo, err := getTableInfoAndCancelFaultJob(t, job, schemaID)
 	if err != nil {
-		return nil, nil, nil, errors.Trace(err)
+		return nil, nil, nil, nil, errors.Trace(err)
 	}
 
 	var colName model.CIStr
 	err = job.DecodeArgs(&colName)
 	if err != nil {
 		job.State = model.JobStateCancelled
-		return nil, nil, nil, errors.Trace(err)
+		return nil, nil, nil, nil, errors.Trace(err)
 	}
 
 	colInfo := model.FindColumnInfo(tblInfo.Columns, colName.L)
 	if colInfo == nil || colInfo.Hidden {
 		job.State = model.JobStateCancelled
-		return nil, nil, nil, ErrCantDropFieldOrKey.GenWithStack("column %s doesn't exist", colName)
+		return nil, nil, nil, nil, ErrCantDropFieldOrKey.GenWithStack("column %s doesn't exist", colName)
 	}
 	if err = isDroppableColumn(tblInfo, colName); err != nil {
 		job.State = model.JobStateCancelled
-		return nil, nil, nil, errors.Trace(err)
+		return nil, nil, nil, nil, errors.Trace(err)
 	}
-	idxInfos := listIndicesWithColumn(colName.L, tblInfo.Indices)
-	if len(idxInfos) > 0 {
-		for _, idxInfo := range idxInfos {
-			err = checkDropIndexOnAutoIncrementColumn(tblInfo, idxInfo)
-			if err != nil {
-				job.State = model.JobStateCancelled
-				return nil, nil, nil, err
-			}
+	idxInfos, compositeIdxInfos := listIndicesWithColumns([]model.CIStr{colName}, tblInfo.Indices)
+	for _, idxInfo := range idxInfos {
+		if indexInfoContains(idxInfo.ID, compositeIdxInfos) {
+			// The rebuilt index still covers the other columns of the composite index.
+			continue
+		}
+		err = checkDropIndexOnAutoIncrementColumn(tblInfo, idxInfo)
+		if err != nil {
+			job.State = model.JobStateCancelled
+			return nil, nil, nil, nil, err
 		}
 	}
-	return tblInfo, colInfo, idxInfos, nil
+	return tblInfo, colInfo, idxInfos, compositeIdxInfos, nil
 }
 
 func onSetDefaultValue(t *meta.Meta, job *model.Job) (ver int64, _ error) {
@@ -1048,47 +1225,13 @@ func (w *worker) doModifyColumnTypeWithData(
 			return ver, errors.Trace(err)
 		}
 
-		reorgInfo, err := getReorgInfo(d, t, job, tbl, BuildElements(changingCol, changingIdxs))
-		if err != nil || reorgInfo.first {
-			// If we run reorg firstly, we should update the job snapshot version
-			// and then run the reorg next time.
-			return ver, errors.Trace(err)
-		}
-
-		// Inject a failpoint so that we can pause here and do verification on other components.
-		// With a failpoint-enabled version of TiDB, you can trigger this failpoint by the following command:
-		// enable: curl -X PUT -d "pause" "http://127.0.0.1:10080/fail/github.com/pingcap/tidb/ddl/mockDelayInModifyColumnTypeWithData".
-		// disable: curl -X DELETE "http://127.0.0.1:10080/fail/github.com/pingcap/tidb/ddl/mockDelayInModifyColumnTypeWithData"
-		failpoint.Inject("mockDelayInModifyColumnTypeWithData", func() {})
-		err = w.runReorgJob(t, reorgInfo, tbl.Meta(), d.lease, func() (addIndexErr error) {
-			defer util.Recover(metrics.LabelDDL, "onModifyColumn",
-				func() {
-					addIndexErr = errCancelledDDLJob.GenWithStack("modify table `%v` column `%v` panic", tblInfo.Name, oldCol.Name)
-				}, false)
-			return w.updateColumnAndIndexes(tbl, oldCol, changingCol, changingIdxs, reorgInfo)
+		var done bool
+		done, ver, err = doReorgWorkInSubJob(job, func() (bool, int64, error) {
+			return doReorgWorkForModifyColumn(w, d, t, job, tbl, oldCol, changingCol, changingIdxs)
 		})
-		if err != nil {
-			if errWaitReorgTimeout.Equal(err) {
-				// If timeout, we should return, check for the owner and re-wait job done.
-				return ver, nil
-			}
-			if kv.IsTxnRetryableError(err) {
-				// Clean up the channel of notifyCancelReorgJob. Make sure it can't affect other jobs.
-				w.reorgCtx.cleanNotifyReorgCancel()
-				return ver, errors.Trace(err)
-			}
-			if err1 := t.RemoveDDLReorgHandle(job, reorgInfo.elements); err1 != nil {
-				logutil.BgLogger().Warn("[ddl] run modify column job failed, RemoveDDLReorgHandle failed, can't convert job to rollback",
-					zap.String("job", job.String()), zap.Error(err1))
-			}
-			logutil.BgLogger().Warn("[ddl] run modify column job failed, convert job to rollback", zap.String("job", job.String()), zap.Error(err))
-			job.State = model.JobStateRollingback
-			// Clean up the channel of notifyCancelReorgJob. Make sure it can't affect other jobs.
-			w.reorgCtx.cleanNotifyReorgCancel()
-			return ver, errors.Trace(err)
+		if !done {
+			return ver, err
 		}
-		// Clean up the channel of notifyCancelReorgJob. Make sure it can't affect other jobs.
-		w.reorgCtx.cleanNotifyReorgCancel()
 
 		// Remove the old column and indexes. Update the relative column name and index names.
 		oldIdxIDs := make([]int64, 0, len(changingIdxs))
@@ -1138,6 +1281,53 @@ func (w *worker) doModifyColumnTypeWithData(
 	return ver, errors.Trace(err)
 }
 
+// doReorgWorkForModifyColumn backfills the changing column and indexes. It returns true if the backfilling is done.
+func doReorgWorkForModifyColumn(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job, tbl table.Table,
+	oldCol, changingCol *model.ColumnInfo, changingIdxs []*model.IndexInfo) (done bool, ver int64, err error) {
+	reorgInfo, err := getReorgInfo(d, t, job, tbl, BuildElements(changingCol, changingIdxs))
+	if err != nil || reorgInfo.first {
+		// If we run reorg firstly, we should update the job snapshot version
+		// and then run the reorg next time.
+		return false, ver, errors.Trace(err)
+	}
+
+	// Inject a failpoint so that we can pause here and do verification on other components.
+	// With a failpoint-enabled version of TiDB, you can trigger this failpoint by the following command:
+	// enable: curl -X PUT -d "pause" "http://127.0.0.1:10080/fail/github.com/pingcap/tidb/ddl/mockDelayInModifyColumnTypeWithData".
+	// disable: curl -X DELETE "http://127.0.0.1:10080/fail/github.com/pingcap/tidb/ddl/mockDelayInModifyColumnTypeWithData"
+	failpoint.Inject("mockDelayInModifyColumnTypeWithData", func() {})
+	err = w.runReorgJob(t, reorgInfo, tbl.Meta(), d.lease, func() (addIndexErr error) {
+		defer util.Recover(metrics.LabelDDL, "onModifyColumn",
+			func() {
+				addIndexErr = errCancelledDDLJob.GenWithStack("modify table `%v` column `%v` panic", tbl.Meta().Name, oldCol.Name)
+			}, false)
+		return w.updateColumnAndIndexes(tbl, oldCol, changingCol, changingIdxs, reorgInfo)
+	})
+	if err != nil {
+		if errWaitReorgTimeout.Equal(err) {
+			// If timeout, we should return, check for the owner and re-wait job done.
+			return false, ver, nil
+		}
+		if kv.IsTxnRetryableError(err) {
+			// Clean up the channel of notifyCancelReorgJob. Make sure it can't affect other jobs.
+			w.reorgCtx.cleanNotifyReorgCancel()
+			return false, ver, errors.Trace(err)
+		}
+		if err1 := t.RemoveDDLReorgHandle(job, reorgInfo.elements); err1 != nil {
+			logutil.BgLogger().Warn("[ddl] run modify column job failed, RemoveDDLReorgHandle failed, can't convert job to rollback",
+				zap.String("job", job.String()), zap.Error(err1))
+		}
+		logutil.BgLogger().Warn("[ddl] run modify column job failed, convert job to rollback", zap.String("job", job.String()), zap.Error(err))
+		job.State = model.JobStateRollingback
+		// Clean up the channel of notifyCancelReorgJob. Make sure it can't affect other jobs.
+		w.reorgCtx.cleanNotifyReorgCancel()
+		return false, ver, errors.Trace(err)
+	}
+	// Clean up the channel of notifyCancelReorgJob. Make sure it can't affect other jobs.
+	w.reorgCtx.cleanNotifyReorgCancel()
+	return true, ver, nil
+}
+
 // BuildElements is exported for testing.
 func BuildElements(changingCol *model.ColumnInfo, changingIdxs []*model.IndexInfo) []*meta.Element {
 	elements := make([]*meta.Element, 0, len(changingIdxs)+1)
@@ -1483,6 +1673,10 @@ func (w *worker) doModifyColumn(
 		}
 	}
 
+	if pauseRevertibleSubJob(job) {
+		return ver, nil
+	}
+
 	if err := adjustColumnInfoInModifyColumn(job, tblInfo, newCol, oldCol, pos, ""); err != nil {
 		return ver, errors.Trace(err)
 	}
@@ -1720,27 +1914,107 @@ func isColumnWithIndex(colName string, indices []*model.IndexInfo) bool {
 	return false
 }
 
-func isColumnCanDropWithIndex(colName string, indices []*model.IndexInfo) bool {
+func isColumnWithClusteredIndex(colName string, tblInfo *model.TableInfo) bool {
+	if !tblInfo.IsCommonHandle {
+		return false
+	}
+	for _, indexInfo := range tblInfo.Indices {
+		if indexInfo.Primary {
+			return isColumnWithIndex(colName, []*model.IndexInfo{indexInfo})
+		}
+	}
+	return false
+}
+
+// listIndicesWithColumns returns the indexes covering the columns, which are removed together with the columns.
+// It also returns the composite ones among them which still have other columns after the columns are dropped,
+// these indexes are replaced by the ones rebuilt without the dropped columns.
+func listIndicesWithColumns(colNames []model.CIStr, indices []*model.IndexInfo) (idxInfos, compositeIdxInfos []*model.IndexInfo) {
+	idxInfos = make([]*model.IndexInfo, 0)
 	for _, indexInfo := range indices {
-		if indexInfo.Primary || len(indexInfo.Columns) > 1 {
-			for _, col := range indexInfo.Columns {
-				if col.Name.L == colName {
-					return false
-				}
+		droppedCnt := 0
+		for _, col := range indexInfo.Columns {
+			if findColumnName(col.Name, colNames) {
+				droppedCnt++
 			}
 		}
+		if droppedCnt == 0 {
+			continue
+		}
+		idxInfos = append(idxInfos, indexInfo)
+		if droppedCnt < len(indexInfo.Columns) {
+			compositeIdxInfos = append(compositeIdxInfos, indexInfo)
+		}
 	}
-	return true
+	return idxInfos, compositeIdxInfos
 }
 
-func listIndicesWithColumn(colName string, indices []*model.IndexInfo) []*model.IndexInfo {
-	ret := make([]*model.IndexInfo, 0)
-	for _, indexInfo := range indices {
-		if len(indexInfo.Columns) == 1 && colName == indexInfo.Columns[0].Name.L {
-			ret = append(ret, indexInfo)
+func findColumnName(name model.CIStr, colNames []model.CIStr) bool {
+	for _, colName := range colNames {
+		if colName.L == name.L {
+			return true
+		}
+	}
+	return false
+}
+
+// buildIndexesForDropColumns builds the indexes which replace the composite indexes after the columns are dropped.
+func buildIndexesForDropColumns(tblInfo *model.TableInfo, colNames []model.CIStr, compositeIdxInfos []*model.IndexInfo) []*model.IndexInfo {
+	changingIdxs := make([]*model.IndexInfo, 0, len(compositeIdxInfos))
+	for _, idxInfo := range compositeIdxInfos {
+		newIdxInfo := idxInfo.Clone()
+		newIdxInfo.Name = model.NewCIStr(genChangingIndexUniqueName(tblInfo, idxInfo))
+		newIdxInfo.ID = allocateIndexID(tblInfo)
+		newIdxInfo.State = model.StateNone
+		idxCols := newIdxInfo.Columns[:0]
+		for _, col := range newIdxInfo.Columns {
+			if !findColumnName(col.Name, colNames) {
+				idxCols = append(idxCols, col)
+			}
+		}
+		newIdxInfo.Columns = idxCols
+		changingIdxs = append(changingIdxs, newIdxInfo)
+	}
+	return changingIdxs
+}
+
+// findIndexesForDropColumns returns the indexes built by buildIndexesForDropColumns, or nil if they aren't built yet.
+func findIndexesForDropColumns(tblInfo *model.TableInfo, compositeIdxInfos []*model.IndexInfo) []*model.IndexInfo {
+	var changingIdxs []*model.IndexInfo
+	for _, idxInfo := range compositeIdxInfos {
+		for _, idx := range tblInfo.Indices {
+			if idx.State != model.StatePublic && strings.HasPrefix(idx.Name.O, changingIndexPrefix) &&
+				strings.EqualFold(getChangingIndexOriginName(idx), idxInfo.Name.O) {
+				changingIdxs = append(changingIdxs, idx)
+				break
+			}
+		}
+	}
+	return changingIdxs
+}
+
+// removeIndexesForDropColumns removes the indexes covering the dropped columns. The composite indexes are replaced by
+// the indexes rebuilt without the dropped columns, which are public since now.
+func removeIndexesForDropColumns(tblInfo *model.TableInfo, idxInfos, changingIdxs []*model.IndexInfo) {
+	newIndices := make([]*model.IndexInfo, 0, len(tblInfo.Indices))
+	for _, idx := range tblInfo.Indices {
+		if indexInfoContains(idx.ID, changingIdxs) {
+			continue
+		}
+		if !indexInfoContains(idx.ID, idxInfos) {
+			newIndices = append(newIndices, idx)
+			continue
+		}
+		for _, cIdx := range changingIdxs {
+			if strings.EqualFold(getChangingIndexOriginName(cIdx), idx.Name.O) {
+				cIdx.Name = idx.Name
+				cIdx.State = model.StatePublic
+				newIndices = append(newIndices, cIdx)
+				break
+			}
 		}
 	}
-	return ret
+	tblInfo.Indices = newIndices
 }
 
 func getColumnForeignKeyInfo(colName string, fkInfos []*model.FKInfo) *model.FKInfo {
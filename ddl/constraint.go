@@ -0,0 +1,501 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/format"
+	"github.com/pingcap/parser/model"
+	"github.com/pingcap/parser/mysql"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/generatedexpr"
+	"github.com/pingcap/tidb/util/sqlexec"
+)
+
+func (w *worker) onAddCheckConstraint(t *meta.Meta, job *model.Job) (ver int64, err error) {
+	dbInfo, err := checkSchemaExistAndCancelNotExistJob(t, job)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	tblInfo, err := getTableInfoAndCancelFaultJob(t, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+
+	constrInfoInJob := &model.ConstraintInfo{}
+	err = job.DecodeArgs(constrInfoInJob)
+	if err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	constrInfo := tblInfo.FindConstraintInfoByName(constrInfoInJob.Name.L)
+	if job.SchemaState == model.StateNone {
+		if constrInfo != nil {
+			job.State = model.JobStateCancelled
+			return ver, errCheckConstraintDupName.GenWithStackByArgs(constrInfo.Name.O)
+		}
+		constrInfo = constrInfoInJob.Clone()
+		tblInfo.MaxConstraintID++
+		constrInfo.ID = tblInfo.MaxConstraintID
+		tblInfo.Constraints = append(tblInfo.Constraints, constrInfo)
+	}
+	if constrInfo == nil {
+		job.State = model.JobStateCancelled
+		return ver, errConstraintNotFound.GenWithStackByArgs(constrInfoInJob.Name.O)
+	}
+
+	originalState := constrInfo.State
+	switch constrInfo.State {
+	case model.StateNone:
+		// The existing records don't need to be checked if the constraint is not enforced.
+		if !constrInfo.Enforced {
+			constrInfo.State = model.StatePublic
+			ver, err = updateVersionAndTableInfoWithCheck(t, job, tblInfo, originalState != constrInfo.State)
+			if err != nil {
+				return ver, errors.Trace(err)
+			}
+			job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+			return ver, nil
+		}
+		// none -> write only
+		// The new records are checked in the write only state, so only the existing records need to be verified later.
+		constrInfo.State = model.StateWriteOnly
+		ver, err = updateVersionAndTableInfoWithCheck(t, job, tblInfo, originalState != constrInfo.State)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		job.SchemaState = model.StateWriteOnly
+	case model.StateWriteOnly:
+		// write only -> public
+		err = w.verifyRemainRecordsForCheckConstraint(dbInfo, tblInfo, constrInfo)
+		if err != nil {
+			if !table.ErrCheckConstraintViolated.Equal(err) {
+				return ver, errors.Trace(err)
+			}
+			removeConstraintInfo(tblInfo, constrInfo.Name)
+			ver, err1 := updateVersionAndTableInfo(t, job, tblInfo, true)
+			if err1 != nil {
+				return ver, errors.Trace(err1)
+			}
+			job.FinishTableJob(model.JobStateRollbackDone, model.StateNone, ver, tblInfo)
+			return ver, errors.Trace(err)
+		}
+		constrInfo.State = model.StatePublic
+		ver, err = updateVersionAndTableInfo(t, job, tblInfo, originalState != constrInfo.State)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+	default:
+		err = ErrInvalidDDLState.GenWithStackByArgs("constraint", constrInfo.State)
+	}
+	return ver, errors.Trace(err)
+}
+
+func onDropCheckConstraint(t *meta.Meta, job *model.Job) (ver int64, _ error) {
+	tblInfo, err := getTableInfoAndCancelFaultJob(t, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+
+	var constrName model.CIStr
+	err = job.DecodeArgs(&constrName)
+	if err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	constrInfo := tblInfo.FindConstraintInfoByName(constrName.L)
+	if constrInfo == nil {
+		job.State = model.JobStateCancelled
+		return ver, errConstraintNotFound.GenWithStackByArgs(constrName.O)
+	}
+
+	originalState := constrInfo.State
+	switch constrInfo.State {
+	case model.StatePublic:
+		// Removing a check constraint only makes the write paths check less, so it can be done in one step.
+		// public -> none
+		removeConstraintInfo(tblInfo, constrName)
+		constrInfo.State = model.StateNone
+		ver, err = updateVersionAndTableInfo(t, job, tblInfo, originalState != constrInfo.State)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		job.FinishTableJob(model.JobStateDone, model.StateNone, ver, tblInfo)
+		return ver, nil
+	default:
+		return ver, ErrInvalidDDLState.GenWithStackByArgs("constraint", constrInfo.State)
+	}
+}
+
+func (w *worker) onAlterCheckConstraint(t *meta.Meta, job *model.Job) (ver int64, err error) {
+	dbInfo, err := checkSchemaExistAndCancelNotExistJob(t, job)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	tblInfo, err := getTableInfoAndCancelFaultJob(t, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+
+	var (
+		constrName model.CIStr
+		enforced   bool
+	)
+	err = job.DecodeArgs(&constrName, &enforced)
+	if err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	constrInfo := tblInfo.FindConstraintInfoByName(constrName.L)
+	if constrInfo == nil {
+		job.State = model.JobStateCancelled
+		return ver, errConstraintNotFound.GenWithStackByArgs(constrName.O)
+	}
+
+	switch constrInfo.State {
+	case model.StatePublic:
+		if constrInfo.Enforced == enforced {
+			job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+			return ver, nil
+		}
+		constrInfo.Enforced = enforced
+		if !enforced {
+			ver, err = updateVersionAndTableInfo(t, job, tblInfo, true)
+			if err != nil {
+				return ver, errors.Trace(err)
+			}
+			job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+			return ver, nil
+		}
+		// public -> write only
+		// Like adding a constraint, the existing records are verified after the new records are checked.
+		constrInfo.State = model.StateWriteOnly
+		ver, err = updateVersionAndTableInfoWithCheck(t, job, tblInfo, true)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		job.SchemaState = model.StateWriteOnly
+	case model.StateWriteOnly:
+		// write only -> public
+		err = w.verifyRemainRecordsForCheckConstraint(dbInfo, tblInfo, constrInfo)
+		if err != nil {
+			if !table.ErrCheckConstraintViolated.Equal(err) {
+				return ver, errors.Trace(err)
+			}
+			constrInfo.Enforced = false
+			constrInfo.State = model.StatePublic
+			ver, err1 := updateVersionAndTableInfo(t, job, tblInfo, true)
+			if err1 != nil {
+				return ver, errors.Trace(err1)
+			}
+			job.FinishTableJob(model.JobStateRollbackDone, model.StatePublic, ver, tblInfo)
+			return ver, errors.Trace(err)
+		}
+		constrInfo.State = model.StatePublic
+		ver, err = updateVersionAndTableInfo(t, job, tblInfo, true)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+	default:
+		err = ErrInvalidDDLState.GenWithStackByArgs("constraint", constrInfo.State)
+	}
+	return ver, errors.Trace(err)
+}
+
+// verifyRemainRecordsForCheckConstraint checks whether the existing records of the table satisfy the constraint.
+func (w *worker) verifyRemainRecordsForCheckConstraint(dbInfo *model.DBInfo, tblInfo *model.TableInfo, constr *model.ConstraintInfo) error {
+	var sctx sessionctx.Context
+	sctx, err := w.sessPool.get()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer w.sessPool.put(sctx)
+
+	// The constraint expression is written to the sql directly, so the '%' in it should be escaped for ParseWithParams.
+	var buf strings.Builder
+	buf.WriteString("select 1 from %n.%n where not (")
+	buf.WriteString(strings.ReplaceAll(constr.ExprString, "%", "%%"))
+	buf.WriteString(") limit 1")
+	stmt, err := sctx.(sqlexec.RestrictedSQLExecutor).ParseWithParams(w.ddlJobCtx, buf.String(), dbInfo.Name.L, tblInfo.Name.L)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	rows, _, err := sctx.(sqlexec.RestrictedSQLExecutor).ExecRestrictedStmt(w.ddlJobCtx, stmt)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(rows) != 0 {
+		return table.ErrCheckConstraintViolated.GenWithStackByArgs(constr.Name.O)
+	}
+	return nil
+}
+
+// verifyCheckConstraintsForAddingColumn checks whether the existing records satisfy the check constraints defined in
+// the column being added. The existing records and the records written before the column is public both have the
+// default value of the column, so only the default value is checked when the table isn't empty.
+func (w *worker) verifyCheckConstraintsForAddingColumn(dbInfo *model.DBInfo, tblInfo *model.TableInfo, colInfo *model.ColumnInfo, constraints []*model.ConstraintInfo) error {
+	for _, constr := range constraints {
+		if tblInfo.FindConstraintInfoByName(constr.Name.L) != nil {
+			return errCheckConstraintDupName.GenWithStackByArgs(constr.Name.O)
+		}
+	}
+
+	var sctx sessionctx.Context
+	sctx, err := w.sessPool.get()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer w.sessPool.put(sctx)
+
+	defVal, err := table.GetColOriginDefaultValue(sctx, colInfo)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	// The column constraints only refer to the column itself, so they are evaluated on a table with the column only.
+	col := colInfo.Clone()
+	col.State = model.StatePublic
+	col.Offset = 0
+	colTblInfo := &model.TableInfo{Name: tblInfo.Name, Columns: []*model.ColumnInfo{col}}
+	row := chunk.MutRowFromDatums([]types.Datum{defVal}).ToRow()
+	var violated *model.ConstraintInfo
+	for _, constr := range constraints {
+		if !constr.Enforced {
+			continue
+		}
+		node, err := generatedexpr.ParseExpression(constr.ExprString)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		node, err = generatedexpr.SimpleResolveName(node, colTblInfo)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		expr, err := expression.RewriteSimpleExprWithTableInfo(sctx, colTblInfo, node)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		val, err := expr.Eval(row)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if val.IsNull() {
+			continue
+		}
+		b, err := val.ToBool(sctx.GetSessionVars().StmtCtx)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if b == 0 {
+			violated = constr
+			break
+		}
+	}
+	if violated == nil {
+		return nil
+	}
+
+	stmt, err := sctx.(sqlexec.RestrictedSQLExecutor).ParseWithParams(w.ddlJobCtx, "select 1 from %n.%n limit 1", dbInfo.Name.L, tblInfo.Name.L)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	rows, _, err := sctx.(sqlexec.RestrictedSQLExecutor).ExecRestrictedStmt(w.ddlJobCtx, stmt)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(rows) != 0 {
+		return table.ErrCheckConstraintViolated.GenWithStackByArgs(violated.Name.O)
+	}
+	return nil
+}
+
+func removeConstraintInfo(tblInfo *model.TableInfo, constrName model.CIStr) {
+	constraints := make([]*model.ConstraintInfo, 0, len(tblInfo.Constraints))
+	for _, constr := range tblInfo.Constraints {
+		if constr.Name.L != constrName.L {
+			constraints = append(constraints, constr)
+		}
+	}
+	tblInfo.Constraints = constraints
+}
+
+// buildConstraintInfo builds the check constraint info from the CHECK clause of a table or a column.
+func buildConstraintInfo(ctx sessionctx.Context, tblInfo *model.TableInfo, constr *ast.Constraint) (*model.ConstraintInfo, error) {
+	name := constr.Name
+	if err := checkIllegalFn4Generated(name, typeCheckConstraint, constr.Expr); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if _, ok := constr.Expr.(*ast.ColumnNameExpr); ok {
+		return nil, errNonBooleanExprForCheckConstraint.GenWithStackByArgs(name)
+	}
+
+	dependedCols := make([]model.CIStr, 0, 1)
+	cols := tblInfo.Cols()
+	for _, colName := range findColumnNamesInExpr(constr.Expr) {
+		col := model.FindColumnInfo(cols, colName.Name.L)
+		if col == nil {
+			return nil, errTableCheckConstraintReferUnknown.GenWithStackByArgs(name, colName.Name.O)
+		}
+		if constr.InColumn && !strings.EqualFold(col.Name.L, constr.InColumnName) {
+			return nil, errColumnCheckConstraintReferencesOtherColumn.GenWithStackByArgs(name)
+		}
+		if mysql.HasAutoIncrementFlag(col.Flag) {
+			return nil, errCheckConstraintRefersAutoIncrementColumn.GenWithStackByArgs(name)
+		}
+		found := false
+		for _, dependedCol := range dependedCols {
+			if dependedCol.L == col.Name.L {
+				found = true
+				break
+			}
+		}
+		if !found {
+			dependedCols = append(dependedCols, col.Name)
+		}
+	}
+
+	// Build the expression to report the invalid function calls and arguments.
+	expr, err := expression.RewriteSimpleExprWithTableInfo(ctx, tblInfo, constr.Expr)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	switch expr.GetType().EvalType() {
+	case types.ETString, types.ETJson, types.ETDatetime, types.ETTimestamp, types.ETDuration:
+		return nil, errNonBooleanExprForCheckConstraint.GenWithStackByArgs(name)
+	}
+
+	var sb strings.Builder
+	restoreFlags := format.RestoreStringSingleQuotes | format.RestoreKeyWordLowercase | format.RestoreNameBackQuotes |
+		format.RestoreSpacesAroundBinaryOperation
+	if err = constr.Expr.Restore(format.NewRestoreCtx(restoreFlags, &sb)); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &model.ConstraintInfo{
+		Name:           model.NewCIStr(name),
+		Table:          tblInfo.Name,
+		ConstraintCols: dependedCols,
+		Enforced:       constr.Enforced,
+		InColumn:       constr.InColumn,
+		ExprString:     sb.String(),
+		State:          model.StateNone,
+	}, nil
+}
+
+// buildColumnCheckConstraints builds the check constraints defined in the column being added or modified. The new
+// column replaces the column named oldColName in the table to resolve the constraints.
+func buildColumnCheckConstraints(ctx sessionctx.Context, tblInfo *model.TableInfo, colDef *ast.ColumnDef, colInfo *model.ColumnInfo, oldColName model.CIStr) ([]*model.ConstraintInfo, error) {
+	var constraints []*model.ConstraintInfo
+	checkNames := make(map[string]bool)
+	var newTblInfo *model.TableInfo
+	for _, option := range colDef.Options {
+		if option.Tp != ast.ColumnOptionCheck {
+			continue
+		}
+		if newTblInfo == nil {
+			newTblInfo = tblInfo.Clone()
+			col := colInfo.Clone()
+			col.State = model.StatePublic
+			col.Offset = len(newTblInfo.Cols())
+			replaced := false
+			for i, oldCol := range newTblInfo.Columns {
+				if oldCol.Name.L == oldColName.L {
+					col.Offset = oldCol.Offset
+					newTblInfo.Columns[i] = col
+					replaced = true
+					break
+				}
+			}
+			if !replaced {
+				newTblInfo.Columns = append(newTblInfo.Columns, col)
+			}
+		}
+		constr := &ast.Constraint{Tp: ast.ConstraintCheck, Name: option.ConstraintName, Expr: option.Expr,
+			Enforced: option.Enforced, InColumn: true, InColumnName: colDef.Name.Name.O}
+		if constr.Name == "" {
+			constr.Name = genCheckConstraintName(tblInfo, checkNames)
+		} else if checkNames[strings.ToLower(constr.Name)] || tblInfo.FindConstraintInfoByName(constr.Name) != nil {
+			return nil, errCheckConstraintDupName.GenWithStackByArgs(constr.Name)
+		}
+		checkNames[strings.ToLower(constr.Name)] = true
+		constrInfo, err := buildConstraintInfo(ctx, newTblInfo, constr)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		constraints = append(constraints, constrInfo)
+	}
+	return constraints, nil
+}
+
+// genCheckConstraintName generates a name like `t_chk_1` for the unnamed check constraint of table t.
+func genCheckConstraintName(tblInfo *model.TableInfo, usedNames map[string]bool) string {
+	for i := 1; ; i++ {
+		name := fmt.Sprintf("%s_chk_%d", tblInfo.Name.O, i)
+		if !usedNames[strings.ToLower(name)] && tblInfo.FindConstraintInfoByName(name) == nil {
+			return name
+		}
+	}
+}
+
+// findDependentCheckConstraint finds the check constraint which depends on the column and other columns.
+// The check constraint depending on this column only is dropped together with the column.
+func findDependentCheckConstraint(tblInfo *model.TableInfo, colName model.CIStr) *model.ConstraintInfo {
+	for _, constr := range tblInfo.Constraints {
+		if len(constr.ConstraintCols) <= 1 {
+			continue
+		}
+		for _, col := range constr.ConstraintCols {
+			if col.L == colName.L {
+				return constr
+			}
+		}
+	}
+	return nil
+}
+
+// removeCheckConstraintsOnColumn removes the check constraints depending on the column only.
+func removeCheckConstraintsOnColumn(tblInfo *model.TableInfo, colName model.CIStr) {
+	constraints := make([]*model.ConstraintInfo, 0, len(tblInfo.Constraints))
+	for _, constr := range tblInfo.Constraints {
+		if len(constr.ConstraintCols) == 1 && constr.ConstraintCols[0].L == colName.L {
+			continue
+		}
+		constraints = append(constraints, constr)
+	}
+	tblInfo.Constraints = constraints
+}
+
+// checkColumnRefByCheckConstraint returns an error if the column is referenced by a check constraint.
+func checkColumnRefByCheckConstraint(tblInfo *model.TableInfo, colName model.CIStr) error {
+	for _, constr := range tblInfo.Constraints {
+		for _, col := range constr.ConstraintCols {
+			if col.L == colName.L {
+				return errDependentByCheckConstraint.GenWithStackByArgs(constr.Name.O, colName.O)
+			}
+		}
+	}
+	return nil
+}
@@ -608,7 +608,7 @@ func (s *testIntegrationSuite5) TestErrnoErrorCode(c *C) {
 	sql = "alter table test_drop_columns drop column c1, drop column c2, drop column c3;"
 	tk.MustGetErrCode(sql, errno.ErrCantRemoveAllFields)
 	sql = "alter table test_drop_columns drop column c1, add column c2 int;"
-	tk.MustGetErrCode(sql, errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode(sql, errno.ErrDupFieldName)
 	sql = "alter table test_drop_columns drop column c1, drop column c1;"
 	tk.MustGetErrCode(sql, errno.ErrCantDropFieldOrKey)
 	// add index
@@ -813,7 +813,7 @@ func (s *testIntegrationSuite4) TestChangingTableCharset(c *C) {
 	tk.MustExec("USE test")
 	tk.MustExec("create table t(a char(10)) charset latin1 collate latin1_bin")
 
-	tk.MustGetErrCode("alter table t charset gbk", errno.ErrUnknownCharacterSet)
+	tk.MustGetErrCode("alter table t charset gb18030", errno.ErrUnknownCharacterSet)
 	tk.MustGetErrCode("alter table t charset ''", errno.ErrUnknownCharacterSet)
 
 	tk.MustGetErrCode("alter table t charset utf8mb4 collate '' collate utf8mb4_bin;", errno.ErrUnknownCollation)
@@ -823,6 +823,7 @@ func (s *testIntegrationSuite4) TestChangingTableCharset(c *C) {
 	tk.MustGetErrCode("alter table t charset utf8 collate utf8_bin collate utf8mb4_bin collate utf8_bin;", errno.ErrCollationCharsetMismatch)
 
 	tk.MustGetErrCode("alter table t charset utf8", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("alter table t charset gbk", errno.ErrUnsupportedDDLOperation)
 	tk.MustGetErrCode("alter table t charset utf8mb4", errno.ErrUnsupportedDDLOperation)
 	tk.MustGetErrCode("alter table t charset utf8mb4 collate utf8mb4_bin", errno.ErrUnsupportedDDLOperation)
 
@@ -2544,12 +2545,47 @@ func (s *testIntegrationSuite5) TestDropColumnWithCompositeIndex(c *C) {
 	defer tk.MustExec("drop table if exists t_drop_column_with_comp_idx")
 	tk.MustExec("create index idx_bc on t_drop_column_with_comp_idx(b, c)")
 	tk.MustExec("create index idx_b on t_drop_column_with_comp_idx(b)")
-	tk.MustGetErrMsg("alter table t_drop_column_with_comp_idx drop column b", "[ddl:8200]can't drop column b with composite index covered or Primary Key covered now")
-	tk.MustQuery(query).Check(testkit.Rows("idx_b YES", "idx_bc YES"))
+	tk.MustExec("insert into t_drop_column_with_comp_idx values (1, 1, 1), (2, 2, 2)")
 	tk.MustExec("alter table t_drop_column_with_comp_idx alter index idx_bc invisible")
-	tk.MustExec("alter table t_drop_column_with_comp_idx alter index idx_b invisible")
-	tk.MustGetErrMsg("alter table t_drop_column_with_comp_idx drop column b", "[ddl:8200]can't drop column b with composite index covered or Primary Key covered now")
-	tk.MustQuery(query).Check(testkit.Rows("idx_b NO", "idx_bc NO"))
+	tk.MustExec("alter table t_drop_column_with_comp_idx drop column b")
+	// The job has its own type, which the DDL owners not supporting rebuilding the indexes reject.
+	tk.MustQuery("admin show ddl jobs 1").CheckAt([]int{3}, [][]interface{}{{"drop columns with composite indexes"}})
+	// The composite index is rebuilt without the dropped column, and the single column index is dropped.
+	tk.MustQuery(query).Check(testkit.Rows("idx_bc NO"))
+	tk.MustQuery("show create table t_drop_column_with_comp_idx").Check(testkit.Rows("t_drop_column_with_comp_idx CREATE TABLE `t_drop_column_with_comp_idx` (\n" +
+		"  `a` int(11) DEFAULT NULL,\n" +
+		"  `c` int(11) DEFAULT NULL,\n" +
+		"  KEY `idx_bc` (`c`) /*!80000 INVISIBLE */\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+	tk.MustExec("admin check table t_drop_column_with_comp_idx")
+
+	// Dropping the column fails if the rebuilt unique index has duplicate values, the table isn't changed.
+	tk.MustExec("drop table t_drop_column_with_comp_idx")
+	tk.MustExec("create table t_drop_column_with_comp_idx(a int, b int, c int, d int, unique index idx_ab(a, b), index idx_bcd(b, c, d))")
+	tk.MustExec("insert into t_drop_column_with_comp_idx values (1, 1, 1, 1), (1, 2, 2, 2)")
+	tk.MustGetErrCode("alter table t_drop_column_with_comp_idx drop column b", errno.ErrDupEntry)
+	tk.MustQuery("select count(*) from information_schema.statistics where table_schema = 'drop_composite_index_test' and table_name = 't_drop_column_with_comp_idx'").Check(testkit.Rows("5"))
+	tk.MustExec("admin check table t_drop_column_with_comp_idx")
+
+	// Multiple columns of an index are dropped together.
+	tk.MustExec("update t_drop_column_with_comp_idx set a = 2 where b = 2")
+	tk.MustExec("alter table t_drop_column_with_comp_idx drop column b, drop column c")
+	tk.MustQuery("admin show ddl jobs 1").CheckAt([]int{3}, [][]interface{}{{"drop columns with composite indexes"}})
+	tk.MustQuery(query).Check(testkit.Rows("idx_ab YES", "idx_bcd YES"))
+	tk.MustQuery("select * from t_drop_column_with_comp_idx use index(idx_ab) where a = 1").Check(testkit.Rows("1 1"))
+	tk.MustQuery("select * from t_drop_column_with_comp_idx use index(idx_bcd) where d = 2").Check(testkit.Rows("2 2"))
+	tk.MustExec("admin check table t_drop_column_with_comp_idx")
+
+	// The non-clustered primary key is rebuilt too, but the columns of the clustered primary key can't be dropped.
+	tk.MustExec("drop table t_drop_column_with_comp_idx")
+	tk.MustExec("create table t_drop_column_with_comp_idx(a int, b int, c int, primary key(a, b) nonclustered)")
+	tk.MustExec("insert into t_drop_column_with_comp_idx values (1, 1, 1), (2, 2, 2)")
+	tk.MustExec("alter table t_drop_column_with_comp_idx drop column b")
+	tk.MustGetErrCode("insert into t_drop_column_with_comp_idx values (1, 3)", errno.ErrDupEntry)
+	tk.MustExec("admin check table t_drop_column_with_comp_idx")
+	tk.MustExec("drop table t_drop_column_with_comp_idx")
+	tk.MustExec("create table t_drop_column_with_comp_idx(a int, b int, c int, primary key(a, b) clustered)")
+	tk.MustGetErrMsg("alter table t_drop_column_with_comp_idx drop column b", "[ddl:8200]can't drop column b with clustered Primary Key covered now")
 }
 
 func (s *testIntegrationSuite5) TestDropColumnWithIndex(c *C) {
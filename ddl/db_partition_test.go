@@ -1148,6 +1148,96 @@ func (s *testIntegrationSuite5) TestAlterTableAddPartitionByListColumns(c *C) {
 	}
 }
 
+func (s *testIntegrationSuite5) TestReorganizeRangePartition(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test;")
+	tk.MustExec("drop table if exists t;")
+	tk.MustExec(`create table t (a int, b varchar(10), key idx_b(b)) partition by range (a) (
+		partition p0 values less than (10),
+		partition p1 values less than (20),
+		partition p2 values less than (30)
+	);`)
+	tk.MustExec(`insert into t values (1, "1"), (11, "11"), (15, "15"), (19, "19"), (21, "21")`)
+
+	// Split a partition.
+	tk.MustExec(`alter table t reorganize partition p1 into (
+		partition p1a values less than (15),
+		partition p1b values less than (20))`)
+	tk.MustQuery("select * from t partition (p1a)").Check(testkit.Rows("11 11"))
+	tk.MustQuery("select * from t partition (p1b) order by a").Check(testkit.Rows("15 15", "19 19"))
+	tk.MustQuery("select a from t use index(idx_b) where b = '15'").Check(testkit.Rows("15"))
+	tk.MustExec("admin check table t")
+	tbl := testGetTableByName(c, s.ctx, "test", "t")
+	part := tbl.Meta().Partition
+	c.Assert(part.Definitions, HasLen, 4)
+	c.Assert(part.Definitions[1].Name.L, Equals, "p1a")
+	c.Assert(part.Definitions[2].Name.L, Equals, "p1b")
+	c.Assert(part.AddingDefinitions, HasLen, 0)
+	c.Assert(part.DroppingDefinitions, HasLen, 0)
+
+	// Merge partitions.
+	tk.MustExec(`alter table t reorganize partition p1a, p1b into (partition p1 values less than (20))`)
+	tk.MustQuery("select * from t partition (p1) order by a").Check(testkit.Rows("11 11", "15 15", "19 19"))
+	tk.MustExec("admin check table t")
+	tk.MustQuery("admin show ddl jobs 1").CheckAt([]int{3}, [][]interface{}{{"alter table reorganize partition"}})
+
+	// Only the range of the last partition can be extended.
+	tk.MustExec(`alter table t reorganize partition p2 into (
+		partition p2 values less than (30),
+		partition p3 values less than (maxvalue))`)
+	tk.MustExec(`insert into t values (100, "100")`)
+	tk.MustQuery("select * from t partition (p3)").Check(testkit.Rows("100 100"))
+	tk.MustExec("admin check table t")
+	tk.MustQuery("select * from t order by a").Check(testkit.Rows("1 1", "11 11", "15 15", "19 19", "21 21", "100 100"))
+
+	tk.MustGetErrCode(`alter table t reorganize partition p0, p2 into (partition p0 values less than (30))`, tmysql.ErrConsecutiveReorgPartitions)
+	tk.MustGetErrCode(`alter table t reorganize partition p0, p10 into (partition p0 values less than (20))`, tmysql.ErrDropPartitionNonExistent)
+	tk.MustGetErrCode(`alter table t reorganize partition p0 into (partition p0 values less than (5))`, tmysql.ErrReorgOutsideRange)
+	tk.MustGetErrCode(`alter table t reorganize partition p0 into (partition p0 values less than (15))`, tmysql.ErrReorgOutsideRange)
+	tk.MustGetErrCode(`alter table t reorganize partition p0 into (
+		partition p0 values less than (5),
+		partition p1 values less than (10))`, tmysql.ErrSameNamePartition)
+	tk.MustGetErrCode(`alter table t reorganize partition`, tmysql.ErrReorgNoParam)
+	tk.MustQuery("select count(*) from t").Check(testkit.Rows("6"))
+
+	tk.MustExec("drop table if exists t2")
+	tk.MustExec("create table t2 (a int)")
+	tk.MustGetErrCode(`alter table t2 reorganize partition p0 into (partition p0 values less than (5))`, tmysql.ErrPartitionMgmtOnNonpartitioned)
+}
+
+func (s *testIntegrationSuite5) TestReorganizeListPartition(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test;")
+	tk.MustExec("drop table if exists t;")
+	tk.MustExec("set @@session.tidb_enable_list_partition = ON")
+	tk.MustExec(`create table t (id int primary key, v int) partition by list (id) (
+		partition p0 values in (1, 2),
+		partition p1 values in (3, 4),
+		partition p2 values in (5, 6)
+	);`)
+	tk.MustExec(`insert into t values (1, 1), (3, 3), (4, 4), (5, 5)`)
+
+	// The partitions don't need to be consecutive for list partitioning.
+	tk.MustExec(`alter table t reorganize partition p0, p2 into (
+		partition p02 values in (1, 2, 5, 6, 7))`)
+	tk.MustQuery("select * from t partition (p02) order by id").Check(testkit.Rows("1 1", "5 5"))
+	tk.MustExec("insert into t values (7, 7)")
+	tk.MustQuery("select * from t partition (p02) order by id").Check(testkit.Rows("1 1", "5 5", "7 7"))
+	tk.MustExec("admin check table t")
+
+	// The job is rolled back if a row doesn't fit into the new partitions.
+	err := tk.ExecToErr(`alter table t reorganize partition p1 into (partition p1 values in (3))`)
+	c.Assert(err, NotNil)
+	tbl := testGetTableByName(c, s.ctx, "test", "t")
+	part := tbl.Meta().Partition
+	c.Assert(part.Definitions, HasLen, 2)
+	c.Assert(part.Definitions[1].InValues, DeepEquals, [][]string{{"3"}, {"4"}})
+	c.Assert(part.AddingDefinitions, HasLen, 0)
+	c.Assert(part.DroppingDefinitions, HasLen, 0)
+	tk.MustQuery("select * from t partition (p1) order by id").Check(testkit.Rows("3 3", "4 4"))
+	tk.MustExec("admin check table t")
+}
+
 func (s *testIntegrationSuite5) TestAlterTableDropPartitionByList(c *C) {
 	tk := testkit.NewTestKit(c, s.store)
 	tk.MustExec("use test;")
@@ -3045,7 +3135,7 @@ func (s *testIntegrationSuite3) TestPartitionErrorCode(c *C) {
 	_, err = tk.Exec("alter table t_part coalesce partition 4;")
 	c.Assert(ddl.ErrCoalesceOnlyOnHashPartition.Equal(err), IsTrue)
 
-	tk.MustGetErrCode(`alter table t_part reorganize partition p0, p1 into (
+	tk.MustGetErrCode(`alter table clients reorganize partition p0, p1 into (
 			partition p0 values less than (1980));`, tmysql.ErrUnsupportedDDLOperation)
 
 	tk.MustGetErrCode("alter table t_part check partition p0, p1;", tmysql.ErrUnsupportedDDLOperation)
@@ -3449,3 +3539,51 @@ func (s *testSerialDBSuite1) TestTruncatePartitionMultipleTimes(c *C) {
 	<-done2
 	c.Assert(errCount, LessEqual, int32(1))
 }
+
+func (s *testSerialDBSuite1) TestReorganizePartitionWithDML(c *C) {
+	tk := testkit.NewTestKitWithInit(c, s.store)
+	tk.MustExec("drop table if exists t;")
+	tk.MustExec(`create table t (a int primary key, b int, key idx_b(b)) partition by range (a) (
+		partition p0 values less than (100),
+		partition p1 values less than (200));`)
+	tk.MustExec("insert into t values (1, 1), (50, 50), (99, 99), (150, 150)")
+	dom := domain.GetDomain(tk.Se)
+	originHook := dom.DDL().GetHook()
+	defer dom.DDL().SetHook(originHook)
+	hook := &ddl.TestDDLCallback{}
+	dom.DDL().SetHook(hook)
+	tk1 := testkit.NewTestKitWithInit(c, s.store)
+	var checkErr error
+	// The job may be updated several times in the same state.
+	handled := make(map[model.SchemaState]bool)
+	hook.OnJobUpdatedExported = func(job *model.Job) {
+		if job.Type != model.ActionReorganizePartition || checkErr != nil || handled[job.SchemaState] {
+			return
+		}
+		handled[job.SchemaState] = true
+		var sqls []string
+		switch job.SchemaState {
+		case model.StateDeleteOnly:
+			sqls = []string{"insert into t values (2, 2)", "delete from t where a = 1"}
+		case model.StateWriteOnly:
+			sqls = []string{"insert into t values (60, 60)", "update t set b = 51 where a = 50"}
+		case model.StateWriteReorganization:
+			sqls = []string{"insert into t values (3, 3)", "update t set a = 61 where a = 99"}
+		case model.StateDeleteReorganization:
+			sqls = []string{"insert into t values (4, 4)", "update t set a = 98 where a = 60"}
+		}
+		for _, sql := range sqls {
+			if _, checkErr = tk1.Exec(sql); checkErr != nil {
+				return
+			}
+		}
+	}
+	tk.MustExec(`alter table t reorganize partition p0 into (
+		partition p0a values less than (50),
+		partition p0b values less than (100))`)
+	c.Assert(checkErr, IsNil)
+	tk.MustQuery("select * from t partition (p0a) order by a").Check(testkit.Rows("2 2", "3 3", "4 4"))
+	tk.MustQuery("select * from t partition (p0b) order by a").Check(testkit.Rows("50 51", "61 99", "98 60"))
+	tk.MustQuery("select a from t use index(idx_b) where b > 50 order by a").Check(testkit.Rows("50", "61", "98", "150"))
+	tk.MustExec("admin check table t")
+}
@@ -6419,32 +6419,65 @@ func (s *testDBSuite4) TestColumnCheck(c *C) {
 	tk := testkit.NewTestKit(c, s.store)
 	tk.MustExec("use " + s.schemaName)
 	tk.MustExec("drop table if exists column_check")
-	tk.MustExec("create table column_check (pk int primary key, a int check (a > 1))")
+	tk.MustExec("create table column_check (pk int primary key, a int check (a > 1), b int constraint b_pos check (b > 0) not enforced)")
 	defer tk.MustExec("drop table if exists column_check")
-	c.Assert(tk.Se.GetSessionVars().StmtCtx.WarningCount(), Equals, uint16(1))
-	tk.MustQuery("show warnings").Check(testutil.RowsWithSep("|", "Warning|8231|CONSTRAINT CHECK is not supported"))
+	c.Assert(tk.Se.GetSessionVars().StmtCtx.WarningCount(), Equals, uint16(0))
+	tk.MustQuery("show create table column_check").Check(testutil.RowsWithSep("|", ""+
+		"column_check CREATE TABLE `column_check` (\n"+
+		"  `pk` int(11) NOT NULL,\n"+
+		"  `a` int(11) DEFAULT NULL,\n"+
+		"  `b` int(11) DEFAULT NULL,\n"+
+		"  PRIMARY KEY (`pk`) /*T![clustered_index] CLUSTERED */,\n"+
+		"  CONSTRAINT `column_check_chk_1` CHECK ((`a` > 1)),\n"+
+		"  CONSTRAINT `b_pos` CHECK ((`b` > 0)) /*!80016 NOT ENFORCED */\n"+
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+	tk.MustExec("insert into column_check values (1, 2, -1), (2, null, null)")
+	tk.MustGetErrCode("insert into column_check values (3, 1, 1)", errno.ErrCheckConstraintViolated)
+
+	// A column check constraint can only refer to its own column.
+	tk.MustGetErrCode("create table t_column_check (a int check (b > 0), b int)", errno.ErrColumnCheckConstraintReferencesOtherColumn)
+	tk.MustGetErrCode("create table t_column_check (a int check (a), b int)", errno.ErrNonBooleanExprForCheckConstraint)
+	tk.MustGetErrCode("create table t_column_check (a int auto_increment primary key check (a > 0))", errno.ErrCheckConstraintRefersAutoIncrementColumn)
+	tk.MustGetErrCode("create table t_column_check (a int check (a > rand()))", errno.ErrCheckConstraintFunctionIsNotAllowed)
+	tk.MustGetErrCode("create table t_column_check (a int, check (c > 0))", errno.ErrTableCheckConstraintReferUnknown)
+	tk.MustGetErrCode("create table t_column_check (a int, constraint c1 check (a > 0), constraint c1 check (a < 10))", errno.ErrCheckConstraintDupName)
 }
 
 func (s *testDBSuite5) TestAlterCheck(c *C) {
 	tk := testkit.NewTestKit(c, s.store)
 	tk.MustExec("use " + s.schemaName)
 	tk.MustExec("drop table if exists alter_check")
-	tk.MustExec("create table alter_check (pk int primary key)")
+	tk.MustExec("create table alter_check (pk int primary key, a int, constraint crcn check (a > 1))")
 	defer tk.MustExec("drop table if exists alter_check")
-	tk.MustExec("alter table alter_check alter check crcn ENFORCED")
-	c.Assert(tk.Se.GetSessionVars().StmtCtx.WarningCount(), Equals, uint16(1))
-	tk.MustQuery("show warnings").Check(testutil.RowsWithSep("|", "Warning|8231|ALTER CHECK is not supported"))
+	tk.MustGetErrCode("alter table alter_check alter check unknown_crcn enforced", errno.ErrConstraintNotFound)
+
+	tk.MustExec("alter table alter_check alter check crcn not enforced")
+	tk.MustExec("insert into alter_check values (1, 1), (2, 2)")
+	tbl := testGetTableByName(c, tk.Se, s.schemaName, "alter_check")
+	c.Assert(tbl.Meta().Constraints[0].Enforced, IsFalse)
+
+	// The existing rows are verified when the constraint becomes enforced.
+	tk.MustGetErrCode("alter table alter_check alter check crcn enforced", errno.ErrCheckConstraintViolated)
+	tbl = testGetTableByName(c, tk.Se, s.schemaName, "alter_check")
+	c.Assert(tbl.Meta().Constraints[0].Enforced, IsFalse)
+	c.Assert(tbl.Meta().Constraints[0].State, Equals, model.StatePublic)
+	tk.MustExec("delete from alter_check where a = 1")
+	tk.MustExec("alter table alter_check alter check crcn enforced")
+	tk.MustGetErrCode("insert into alter_check values (3, 1)", errno.ErrCheckConstraintViolated)
 }
 
 func (s *testDBSuite6) TestDropCheck(c *C) {
 	tk := testkit.NewTestKit(c, s.store)
 	tk.MustExec("use " + s.schemaName)
 	tk.MustExec("drop table if exists drop_check")
-	tk.MustExec("create table drop_check (pk int primary key)")
+	tk.MustExec("create table drop_check (pk int primary key, a int, constraint crcn check (a > 1))")
 	defer tk.MustExec("drop table if exists drop_check")
+	tk.MustGetErrCode("alter table drop_check drop check unknown_crcn", errno.ErrConstraintNotFound)
+	tk.MustGetErrCode("insert into drop_check values (1, 1)", errno.ErrCheckConstraintViolated)
 	tk.MustExec("alter table drop_check drop check crcn")
-	c.Assert(tk.Se.GetSessionVars().StmtCtx.WarningCount(), Equals, uint16(1))
-	tk.MustQuery("show warnings").Check(testutil.RowsWithSep("|", "Warning|8231|DROP CHECK is not supported"))
+	tk.MustExec("insert into drop_check values (1, 1)")
+	tbl := testGetTableByName(c, tk.Se, s.schemaName, "drop_check")
+	c.Assert(tbl.Meta().Constraints, HasLen, 0)
 }
 
 func (s *testDBSuite7) TestAddConstraintCheck(c *C) {
@@ -6453,22 +6486,118 @@ func (s *testDBSuite7) TestAddConstraintCheck(c *C) {
 	tk.MustExec("drop table if exists add_constraint_check")
 	tk.MustExec("create table add_constraint_check (pk int primary key, a int)")
 	defer tk.MustExec("drop table if exists add_constraint_check")
-	tk.MustExec("alter table add_constraint_check add constraint crn check (a > 1)")
-	c.Assert(tk.Se.GetSessionVars().StmtCtx.WarningCount(), Equals, uint16(1))
-	tk.MustQuery("show warnings").Check(testutil.RowsWithSep("|", "Warning|8231|ADD CONSTRAINT CHECK is not supported"))
-}
-
-func (s *testDBSuite7) TestCreateTableIngoreCheckConstraint(c *C) {
+	tk.MustExec("insert into add_constraint_check values (1, 1), (2, 2)")
+
+	// The constraint isn't added if the existing rows violate it.
+	tk.MustGetErrCode("alter table add_constraint_check add constraint crn check (a > 1)", errno.ErrCheckConstraintViolated)
+	tbl := testGetTableByName(c, tk.Se, s.schemaName, "add_constraint_check")
+	c.Assert(tbl.Meta().Constraints, HasLen, 0)
+
+	// A not enforced constraint doesn't verify the existing rows.
+	tk.MustExec("alter table add_constraint_check add constraint crn check (a > 1) not enforced")
+	tk.MustGetErrCode("alter table add_constraint_check add constraint crn check (a > 0)", errno.ErrCheckConstraintDupName)
+	tk.MustExec("alter table add_constraint_check add check (a < 10)")
+	tbl = testGetTableByName(c, tk.Se, s.schemaName, "add_constraint_check")
+	c.Assert(tbl.Meta().Constraints, HasLen, 2)
+	c.Assert(tbl.Meta().Constraints[1].Name.L, Equals, "add_constraint_check_chk_1")
+	tk.MustGetErrCode("insert into add_constraint_check values (3, 10)", errno.ErrCheckConstraintViolated)
+	tk.MustGetErrCode("update add_constraint_check set a = 10 where pk = 1", errno.ErrCheckConstraintViolated)
+	tk.MustExec("insert into add_constraint_check values (3, 0)")
+
+	tk.MustQuery("select constraint_name, check_clause from information_schema.check_constraints " +
+		"where constraint_schema = '" + s.schemaName + "' order by constraint_name").Check(testkit.Rows(
+		"add_constraint_check_chk_1 (`a` < 10)", "crn (`a` > 1)"))
+	tk.MustQuery("select constraint_name from information_schema.table_constraints " +
+		"where table_name = 'add_constraint_check' and constraint_type = 'CHECK' order by constraint_name").Check(testkit.Rows(
+		"add_constraint_check_chk_1", "crn"))
+}
+
+func (s *testDBSuite7) TestColumnCheckConstraintInAlterColumn(c *C) {
 	tk := testkit.NewTestKit(c, s.store)
 	tk.MustExec("use " + s.schemaName)
-	tk.MustExec("drop table if exists table_constraint_check")
+	tk.MustExec("drop table if exists alter_column_check, alter_column_check_empty")
+	tk.MustExec("create table alter_column_check (pk int primary key, a int)")
+	tk.MustExec("create table alter_column_check_empty (pk int primary key)")
+	defer tk.MustExec("drop table if exists alter_column_check, alter_column_check_empty")
+	tk.MustExec("insert into alter_column_check values (1, 1), (2, 2)")
+
+	// The existing records have the default value of the added column.
+	tk.MustGetErrCode("alter table alter_column_check add column b int default 0 check (b > 0)", errno.ErrCheckConstraintViolated)
+	tbl := testGetTableByName(c, tk.Se, s.schemaName, "alter_column_check")
+	c.Assert(tbl.Meta().Constraints, HasLen, 0)
+	c.Assert(tbl.Meta().Columns, HasLen, 2)
+	tk.MustExec("alter table alter_column_check add column b int default 1 check (b > 0)")
+	tk.MustExec("alter table alter_column_check add column c int check (c > 0) not enforced")
+	tk.MustGetErrCode("alter table alter_column_check add column d int check (a > 0)", errno.ErrColumnCheckConstraintReferencesOtherColumn)
+	tk.MustGetErrCode("alter table alter_column_check add column d int constraint alter_column_check_chk_1 check (d > 0)", errno.ErrCheckConstraintDupName)
+	tk.MustGetErrCode("insert into alter_column_check values (3, 3, 0, 0)", errno.ErrCheckConstraintViolated)
+	tk.MustExec("insert into alter_column_check values (3, 3, 1, 0)")
+	tk.MustQuery("select constraint_name, check_clause from information_schema.check_constraints " +
+		"where constraint_schema = '" + s.schemaName + "' and constraint_name like 'alter_column_check%' order by constraint_name").Check(testkit.Rows(
+		"alter_column_check_chk_1 (`b` > 0)", "alter_column_check_chk_2 (`c` > 0)"))
+
+	// The default value isn't checked if the table is empty.
+	tk.MustExec("alter table alter_column_check_empty add column a int default 0 constraint a_chk check (a > 0)")
+	tk.MustGetErrCode("insert into alter_column_check_empty (pk) values (1)", errno.ErrCheckConstraintViolated)
+
+	// The constraints of the modified column are added after the column is modified.
+	tk.MustExec("alter table alter_column_check modify column a bigint check (a < 10)")
+	tk.MustGetErrCode("insert into alter_column_check values (4, 10, 1, 1)", errno.ErrCheckConstraintViolated)
+	tk.MustExec("alter table alter_column_check change column c c bigint constraint c_chk check (c < 10)")
+	tk.MustGetErrCode("alter table alter_column_check modify column b int check (b > 1)", errno.ErrCheckConstraintViolated)
+	tbl = testGetTableByName(c, tk.Se, s.schemaName, "alter_column_check")
+	c.Assert(tbl.Meta().Constraints, HasLen, 4)
+	tk.MustQuery("select constraint_name from information_schema.table_constraints " +
+		"where table_schema = '" + s.schemaName + "' and table_name = 'alter_column_check' and constraint_type = 'CHECK' order by constraint_name").Check(testkit.Rows(
+		"alter_column_check_chk_1", "alter_column_check_chk_2", "alter_column_check_chk_3", "c_chk"))
+}
+
+func (s *testDBSuite7) TestCheckConstraintOnColumnChange(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use " + s.schemaName)
+	tk.MustExec("drop table if exists column_change_check")
+	tk.MustExec("create table column_change_check (a int check (a > 0), b int, c int, check (b < c))")
+	defer tk.MustExec("drop table if exists column_change_check")
+
+	// The columns referred by a multi-column constraint can't be dropped or renamed.
+	tk.MustGetErrCode("alter table column_change_check drop column b", errno.ErrDependentByCheckConstraint)
+	tk.MustGetErrCode("alter table column_change_check rename column c to d", errno.ErrDependentByCheckConstraint)
+	tk.MustGetErrCode("alter table column_change_check change a a1 int", errno.ErrDependentByCheckConstraint)
+
+	// The constraint referring to the dropped column only is dropped together.
+	tk.MustExec("alter table column_change_check drop column a")
+	tbl := testGetTableByName(c, tk.Se, s.schemaName, "column_change_check")
+	c.Assert(tbl.Meta().Constraints, HasLen, 1)
+	c.Assert(tbl.Meta().Constraints[0].Name.L, Equals, "column_change_check_chk_1")
+	tk.MustGetErrCode("insert into column_change_check values (2, 1)", errno.ErrCheckConstraintViolated)
+
+	// INSERT IGNORE and UPDATE IGNORE skip the rows violating the constraints.
+	tk.MustExec("insert ignore into column_change_check values (2, 1), (1, 2)")
+	tk.MustQuery("show warnings").Check(testutil.RowsWithSep("|", "Warning|3819|Check constraint 'column_change_check_chk_1' is violated."))
+	tk.MustExec("update ignore column_change_check set b = 3")
+	tk.MustQuery("show warnings").Check(testutil.RowsWithSep("|", "Warning|3819|Check constraint 'column_change_check_chk_1' is violated."))
+	tk.MustQuery("select * from column_change_check").Check(testkit.Rows("1 2"))
+}
+
+func (s *testDBSuite7) TestCreateTableWithCheckConstraint(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use " + s.schemaName)
+	tk.MustExec("drop table if exists admin_user")
 	tk.MustExec("CREATE TABLE admin_user (enable bool, CHECK (enable IN (0, 1)));")
-	c.Assert(tk.Se.GetSessionVars().StmtCtx.WarningCount(), Equals, uint16(1))
-	tk.MustQuery("show warnings").Check(testutil.RowsWithSep("|", "Warning|8231|CONSTRAINT CHECK is not supported"))
+	defer tk.MustExec("drop table if exists admin_user")
+	c.Assert(tk.Se.GetSessionVars().StmtCtx.WarningCount(), Equals, uint16(0))
 	tk.MustQuery("show create table admin_user").Check(testutil.RowsWithSep("|", ""+
 		"admin_user CREATE TABLE `admin_user` (\n"+
-		"  `enable` tinyint(1) DEFAULT NULL\n"+
+		"  `enable` tinyint(1) DEFAULT NULL,\n"+
+		"  CONSTRAINT `admin_user_chk_1` CHECK ((`enable` in (0,1)))\n"+
 		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+	tk.MustExec("insert into admin_user values (1), (0), (null)")
+	tk.MustGetErrCode("insert into admin_user values (2)", errno.ErrCheckConstraintViolated)
+
+	// The table created by the output of SHOW CREATE TABLE has the same constraint.
+	tk.MustExec("drop table admin_user")
+	tk.MustExec("CREATE TABLE `admin_user` (`enable` tinyint(1) DEFAULT NULL, CONSTRAINT `admin_user_chk_1` CHECK ((`enable` IN (0,1))) /*!80016 NOT ENFORCED */)")
+	tk.MustExec("insert into admin_user values (2)")
 }
 
 func (s *testDBSuite6) TestAlterOrderBy(c *C) {
@@ -19,8 +19,10 @@ package ddl
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"sync/atomic"
@@ -681,7 +683,9 @@ func columnDefToCol(ctx sessionctx.Context, offset int, colDef *ast.ColumnDef, o
 			case ast.ColumnOptionFulltext:
 				ctx.GetSessionVars().StmtCtx.AppendWarning(ErrTableCantHandleFt.GenWithStackByArgs())
 			case ast.ColumnOptionCheck:
-				ctx.GetSessionVars().StmtCtx.AppendWarning(ErrUnsupportedConstraintCheck.GenWithStackByArgs("CONSTRAINT CHECK"))
+				constraint := &ast.Constraint{Tp: ast.ConstraintCheck, Name: v.ConstraintName, Expr: v.Expr,
+					Enforced: v.Enforced, InColumn: true, InColumnName: colDef.Name.Name.O}
+				constraints = append(constraints, constraint)
 			}
 		}
 	}
@@ -985,7 +989,7 @@ func checkColumnValueConstraint(col *table.Column, collation string) error {
 	valueMap := make(map[string]bool, len(col.Elems))
 	ctor := collate.GetCollator(collation)
 	enumLengthLimit := config.GetGlobalConfig().EnableEnumLengthLimit
-	desc, err := charset.GetCharsetDesc(col.Charset)
+	desc, err := collate.GetCharsetDesc(col.Charset)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -1132,7 +1136,7 @@ func checkColumnFieldLength(col *table.Column) error {
 
 // IsTooBigFieldLength check if the varchar type column exceeds the maximum length limit.
 func IsTooBigFieldLength(colDefTpFlen int, colDefName, setCharset string) error {
-	desc, err := charset.GetCharsetDesc(setCharset)
+	desc, err := collate.GetCharsetDesc(setCharset)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -1208,6 +1212,7 @@ func setEmptyConstraintName(namesMap map[string]bool, constr *ast.Constraint, fo
 func checkConstraintNames(constraints []*ast.Constraint) error {
 	constrNames := map[string]bool{}
 	fkNames := map[string]bool{}
+	checkNames := map[string]bool{}
 
 	// Check not empty constraint name whether is duplicated.
 	for _, constr := range constraints {
@@ -1216,6 +1221,15 @@ func checkConstraintNames(constraints []*ast.Constraint) error {
 			if err != nil {
 				return errors.Trace(err)
 			}
+		} else if constr.Tp == ast.ConstraintCheck {
+			if constr.Name == "" {
+				continue
+			}
+			name := strings.ToLower(constr.Name)
+			if checkNames[name] {
+				return errCheckConstraintDupName.GenWithStackByArgs(constr.Name)
+			}
+			checkNames[name] = true
 		} else {
 			err := checkDuplicateConstraint(constrNames, constr.Name, false)
 			if err != nil {
@@ -1224,8 +1238,11 @@ func checkConstraintNames(constraints []*ast.Constraint) error {
 		}
 	}
 
-	// Set empty constraint names.
+	// Set empty constraint names, the names of check constraints are set when building the table info.
 	for _, constr := range constraints {
+		if constr.Tp == ast.ConstraintCheck {
+			continue
+		}
 		if constr.Tp == ast.ConstraintForeignKey {
 			setEmptyConstraintName(fkNames, constr, true)
 		} else {
@@ -1377,6 +1394,13 @@ func buildTableInfo(
 		tbInfo.Columns = append(tbInfo.Columns, v.ToInfo())
 		tblColumns = append(tblColumns, table.ToColumn(v.ToInfo()))
 	}
+	// checkNames records the explicit names of the check constraints, they can't be used by the generated names.
+	checkNames := make(map[string]bool)
+	for _, constr := range constraints {
+		if constr.Tp == ast.ConstraintCheck && constr.Name != "" {
+			checkNames[strings.ToLower(constr.Name)] = true
+		}
+	}
 	for _, constr := range constraints {
 		// Build hidden columns if necessary.
 		hiddenCols, err := buildHiddenColumnInfo(ctx, constr.Keys, model.NewCIStr(constr.Name), tbInfo, tblColumns)
@@ -1440,7 +1464,18 @@ func buildTableInfo(
 			continue
 		}
 		if constr.Tp == ast.ConstraintCheck {
-			ctx.GetSessionVars().StmtCtx.AppendWarning(ErrUnsupportedConstraintCheck.GenWithStackByArgs("CONSTRAINT CHECK"))
+			if constr.Name == "" {
+				constr.Name = genCheckConstraintName(tbInfo, checkNames)
+				checkNames[strings.ToLower(constr.Name)] = true
+			}
+			constrInfo, err := buildConstraintInfo(ctx, tbInfo, constr)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			tbInfo.MaxConstraintID++
+			constrInfo.ID = tbInfo.MaxConstraintID
+			constrInfo.State = model.StatePublic
+			tbInfo.Constraints = append(tbInfo.Constraints, constrInfo)
 			continue
 		}
 		// build index info.
@@ -2308,7 +2343,7 @@ func getCharsetAndCollateInTableOption(startIdx int, options []*ast.TableOption)
 		// the charset will be utf8, collate will be utf8_bin
 		switch opt.Tp {
 		case ast.TableOptionCharset:
-			info, err := charset.GetCharsetDesc(opt.StrValue)
+			info, err := collate.GetCharsetDesc(opt.StrValue)
 			if err != nil {
 				return "", "", err
 			}
@@ -2414,14 +2449,14 @@ func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, ident ast
 			case ast.AlterTableDropColumn:
 				err = d.DropColumns(sctx, ident, validSpecs)
 			default:
-				return errRunMultiSchemaChanges
+				err = d.multiSchemaChange(ctx, sctx, ident, validSpecs)
 			}
 			if err != nil {
 				return errors.Trace(err)
 			}
 			return nil
 		}
-		return errRunMultiSchemaChanges
+		return d.multiSchemaChange(ctx, sctx, ident, validSpecs)
 	}
 
 	for _, spec := range validSpecs {
@@ -2438,7 +2473,7 @@ func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, ident ast
 		case ast.AlterTableCoalescePartitions:
 			err = d.CoalescePartitions(sctx, ident, spec)
 		case ast.AlterTableReorganizePartition:
-			err = errors.Trace(errUnsupportedReorganizePartition)
+			err = d.ReorganizePartitions(sctx, ident, spec)
 		case ast.AlterTableCheckPartitions:
 			err = errors.Trace(errUnsupportedCheckPartition)
 		case ast.AlterTableRebuildPartition:
@@ -2499,7 +2534,7 @@ func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, ident ast
 			case ast.ConstraintFulltext:
 				sctx.GetSessionVars().StmtCtx.AppendWarning(ErrTableCantHandleFt)
 			case ast.ConstraintCheck:
-				sctx.GetSessionVars().StmtCtx.AppendWarning(ErrUnsupportedConstraintCheck.GenWithStackByArgs("ADD CONSTRAINT CHECK"))
+				err = d.CreateCheckConstraint(sctx, ident, constr)
 			default:
 				// Nothing to do now.
 			}
@@ -2577,9 +2612,9 @@ func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, ident ast
 		case ast.AlterTableIndexInvisible:
 			err = d.AlterIndexVisibility(sctx, ident, spec.IndexName, spec.Visibility)
 		case ast.AlterTableAlterCheck:
-			sctx.GetSessionVars().StmtCtx.AppendWarning(ErrUnsupportedConstraintCheck.GenWithStackByArgs("ALTER CHECK"))
+			err = d.AlterCheckConstraint(sctx, ident, model.NewCIStr(spec.Constraint.Name), spec.Constraint.Enforced)
 		case ast.AlterTableDropCheck:
-			sctx.GetSessionVars().StmtCtx.AppendWarning(ErrUnsupportedConstraintCheck.GenWithStackByArgs("DROP CHECK"))
+			err = d.DropCheckConstraint(sctx, ident, model.NewCIStr(spec.Constraint.Name))
 		case ast.AlterTableWithValidation:
 			sctx.GetSessionVars().StmtCtx.AppendWarning(errUnsupportedAlterTableWithValidation)
 		case ast.AlterTableWithoutValidation:
@@ -2602,6 +2637,151 @@ func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, ident ast
 	return nil
 }
 
+// multiSchemaChange runs the specs of different types in one ALTER TABLE statement as a single job.
+// The job becomes visible atomically, and all the schema changes are rolled back if any of them fails.
+func (d *ddl) multiSchemaChange(ctx context.Context, sctx sessionctx.Context, ident ast.Ident, specs []*ast.AlterTableSpec) error {
+	schema, t, err := d.getSchemaAndTableByIdent(sctx, ident)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err = checkMultiSchemaSpecs(t.Meta(), specs); err != nil {
+		return errors.Trace(err)
+	}
+	mc, err := newMultiSchemaChangeCollector(t)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err = d.collectMultiSchemaChangeJobs(ctx, sctx, ident, schema, t, mc, specs); err != nil {
+		return errors.Trace(err)
+	}
+
+	switch len(mc.jobs) {
+	case 0:
+		return nil
+	case 1:
+		err = d.doDDLJob(sctx, mc.jobs[0])
+		err = d.callHookOnChanged(err)
+		return errors.Trace(err)
+	}
+
+	jobs := mc.jobs
+	sort.SliceStable(jobs, func(i, j int) bool {
+		return subJobOrder(jobs[i].Type) < subJobOrder(jobs[j].Type)
+	})
+	info := &MultiSchemaInfo{Revertible: true}
+	for _, job := range jobs {
+		rawArgs, err := json.Marshal(job.Args)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		info.SubJobs = append(info.SubJobs, &SubJob{
+			Type:       job.Type,
+			RawArgs:    rawArgs,
+			Revertible: isRevertibleSubJobType(job.Type),
+		})
+	}
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		TableID:    t.Meta().ID,
+		SchemaName: schema.Name.L,
+		Type:       model.ActionMultiSchemaChange,
+		BinlogInfo: &model.HistoryInfo{},
+		ReorgMeta: &model.DDLReorgMeta{
+			SQLMode:       sctx.GetSessionVars().SQLMode,
+			Warnings:      make(map[errors.ErrorID]*terror.Error),
+			WarningsCount: make(map[errors.ErrorID]int64),
+		},
+		Priority: sctx.GetSessionVars().DDLReorgPriority,
+		Args:     []interface{}{info},
+	}
+	err = d.doDDLJob(sctx, job)
+	err = d.callHookOnChanged(err)
+	return errors.Trace(err)
+}
+
+// collectMultiSchemaChangeJobs builds the jobs of the specs. The added columns are handled first, so
+// the new indices can refer to them.
+func (d *ddl) collectMultiSchemaChangeJobs(ctx context.Context, sctx sessionctx.Context, ident ast.Ident, schema *model.DBInfo,
+	t table.Table, mc *multiSchemaChangeCollector, specs []*ast.AlterTableSpec) (err error) {
+	var addColumnSpecs, dropColumnSpecs, otherSpecs []*ast.AlterTableSpec
+	for _, spec := range specs {
+		switch spec.Tp {
+		case ast.AlterTableAddColumns:
+			addColumnSpecs = append(addColumnSpecs, spec)
+		case ast.AlterTableDropColumn:
+			dropColumnSpecs = append(dropColumnSpecs, spec)
+		default:
+			otherSpecs = append(otherSpecs, spec)
+		}
+	}
+
+	if len(addColumnSpecs) == 1 && len(addColumnSpecs[0].NewColumns) == 1 {
+		err = mc.addJob(newAddColumnJob(sctx, ident, schema, mc.table, addColumnSpecs[0]))
+	} else if len(addColumnSpecs) > 0 {
+		err = mc.addJob(newAddColumnsJob(sctx, ident, schema, mc.table, addColumnSpecs))
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(dropColumnSpecs) == 1 {
+		err = mc.addJob(newDropColumnJob(sctx, schema, mc.table, dropColumnSpecs[0]))
+	} else if len(dropColumnSpecs) > 0 {
+		err = mc.addJob(newDropColumnsJob(sctx, schema, mc.table, dropColumnSpecs))
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	// The specs checked against the original table can't refer to the columns and indices added by
+	// the others, checkMultiSchemaSpecs rejects such specs.
+	for _, spec := range otherSpecs {
+		switch spec.Tp {
+		case ast.AlterTableModifyColumn, ast.AlterTableChangeColumn:
+			originalColName := spec.NewColumns[0].Name.Name
+			if spec.Tp == ast.AlterTableChangeColumn {
+				originalColName = spec.OldColumnName.Name
+			}
+			if err = checkModifyColumnNames(ident, spec); err != nil {
+				return errors.Trace(err)
+			}
+			job, buildErr := d.newModifiableColumnJob(ctx, sctx, ident, schema, t, originalColName, spec)
+			if infoschema.ErrColumnNotExists.Equal(buildErr) && spec.IfExists {
+				sctx.GetSessionVars().StmtCtx.AppendNote(infoschema.ErrColumnNotExists.GenWithStackByArgs(originalColName, ident.Name))
+				continue
+			}
+			err = mc.addJob(job, buildErr)
+		case ast.AlterTableRenameColumn:
+			err = mc.addJob(newRenameColumnJob(sctx, ident, schema, mc.table, spec))
+		case ast.AlterTableAlterColumn:
+			err = mc.addJob(newAlterColumnJob(sctx, ident, schema, t, spec))
+		case ast.AlterTableAddConstraint:
+			constr := spec.Constraint
+			switch constr.Tp {
+			case ast.ConstraintKey, ast.ConstraintIndex:
+				err = mc.addJob(newCreateIndexJob(sctx, schema, mc.table, ast.IndexKeyTypeNone, model.NewCIStr(constr.Name),
+					constr.Keys, constr.Option, constr.IfNotExists))
+			case ast.ConstraintUniq, ast.ConstraintUniqIndex, ast.ConstraintUniqKey:
+				err = mc.addJob(newCreateIndexJob(sctx, schema, mc.table, ast.IndexKeyTypeUnique, model.NewCIStr(constr.Name),
+					constr.Keys, constr.Option, false))
+			case ast.ConstraintPrimaryKey:
+				err = mc.addJob(newCreatePrimaryKeyJob(sctx, schema, mc.table, model.NewCIStr(constr.Name), constr.Keys, constr.Option))
+			}
+		case ast.AlterTableDropIndex:
+			err = mc.addJob(newDropIndexJob(sctx, schema, t, model.NewCIStr(spec.Name), spec.IfExists))
+		case ast.AlterTableDropPrimaryKey:
+			err = mc.addJob(newDropIndexJob(sctx, schema, t, model.NewCIStr(mysql.PrimaryKeyName), spec.IfExists))
+		case ast.AlterTableRenameIndex:
+			err = mc.addJob(newRenameIndexJob(schema, t, spec))
+		case ast.AlterTableIndexInvisible:
+			err = mc.addJob(newAlterIndexVisibilityJob(schema, mc.table, spec.IndexName, spec.Visibility))
+		}
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
 func (d *ddl) RebaseAutoID(ctx sessionctx.Context, ident ast.Ident, newBase int64, tp autoid.AllocatorType, force bool) error {
 	schema, t, err := d.getSchemaAndTableByIdent(ctx, ident)
 	if err != nil {
@@ -2835,21 +3015,48 @@ func checkAndCreateNewColumn(ctx sessionctx.Context, ti ast.Ident, schema *model
 
 // AddColumn will add a new column to the table.
 func (d *ddl) AddColumn(ctx sessionctx.Context, ti ast.Ident, spec *ast.AlterTableSpec) error {
-	specNewColumn := spec.NewColumns[0]
 	schema, t, err := d.getSchemaAndTableByIdent(ctx, ti)
 	if err != nil {
 		return errors.Trace(err)
 	}
-	if err = checkAddColumnTooManyColumns(len(t.Cols()) + 1); err != nil {
+	job, err := newAddColumnJob(ctx, ti, schema, t, spec)
+	if err != nil || job == nil {
 		return errors.Trace(err)
 	}
+
+	err = d.doDDLJob(ctx, job)
+	// column exists, but if_not_exists flags is true, so we ignore this error.
+	if infoschema.ErrColumnExists.Equal(err) && spec.IfNotExists {
+		ctx.GetSessionVars().StmtCtx.AppendNote(err)
+		return nil
+	}
+	err = d.callHookOnChanged(err)
+	return errors.Trace(err)
+}
+
+// newAddColumnJob builds the job adding the column of the spec. It returns nil if the column exists and
+// the if_not_exists flag is true.
+func newAddColumnJob(ctx sessionctx.Context, ti ast.Ident, schema *model.DBInfo, t table.Table, spec *ast.AlterTableSpec) (*model.Job, error) {
+	specNewColumn := spec.NewColumns[0]
+	if err := checkAddColumnTooManyColumns(len(t.Cols()) + 1); err != nil {
+		return nil, errors.Trace(err)
+	}
 	col, err := checkAndCreateNewColumn(ctx, ti, schema, spec, t, specNewColumn)
 	if err != nil {
-		return errors.Trace(err)
+		return nil, errors.Trace(err)
 	}
 	// Added column has existed and if_not_exists flag is true.
 	if col == nil {
-		return nil
+		return nil, nil
+	}
+	constraints, err := buildColumnCheckConstraints(ctx, t.Meta(), specNewColumn, col.ColumnInfo, model.CIStr{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	// The existing records are verified against the default value of the new column for its check constraints,
+	// the value of a generated column can't be verified that way.
+	if len(constraints) > 0 && col.IsGenerated() {
+		return nil, ErrUnsupportedConstraintCheck.GenWithStackByArgs("ADD generated COLUMN ... CHECK")
 	}
 
 	job := &model.Job{
@@ -2858,17 +3065,9 @@ func (d *ddl) AddColumn(ctx sessionctx.Context, ti ast.Ident, spec *ast.AlterTab
 		SchemaName: schema.Name.L,
 		Type:       model.ActionAddColumn,
 		BinlogInfo: &model.HistoryInfo{},
-		Args:       []interface{}{col, spec.Position, 0},
-	}
-
-	err = d.doDDLJob(ctx, job)
-	// column exists, but if_not_exists flags is true, so we ignore this error.
-	if infoschema.ErrColumnExists.Equal(err) && spec.IfNotExists {
-		ctx.GetSessionVars().StmtCtx.AppendNote(err)
-		return nil
+		Args:       []interface{}{col, spec.Position, 0, constraints},
 	}
-	err = d.callHookOnChanged(err)
-	return errors.Trace(err)
+	return job, nil
 }
 
 // AddColumns will add multi new columns to the table.
@@ -2877,7 +3076,22 @@ func (d *ddl) AddColumns(ctx sessionctx.Context, ti ast.Ident, specs []*ast.Alte
 	if err != nil {
 		return errors.Trace(err)
 	}
+	job, err := newAddColumnsJob(ctx, ti, schema, t, specs)
+	if err != nil || job == nil {
+		return errors.Trace(err)
+	}
+
+	err = d.doDDLJob(ctx, job)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	err = d.callHookOnChanged(err)
+	return errors.Trace(err)
+}
 
+// newAddColumnsJob builds the job adding the columns of the specs. It returns nil if all the columns exist
+// and the if_not_exists flags are true.
+func newAddColumnsJob(ctx sessionctx.Context, ti ast.Ident, schema *model.DBInfo, t table.Table, specs []*ast.AlterTableSpec) (*model.Job, error) {
 	// Check all the columns at once.
 	addingColumnNames := make(map[string]bool)
 	dupColumnNames := make(map[string]bool)
@@ -2888,7 +3102,7 @@ func (d *ddl) AddColumns(ctx sessionctx.Context, ti ast.Ident, specs []*ast.Alte
 				continue
 			}
 			if !spec.IfNotExists {
-				return errors.Trace(infoschema.ErrColumnExists.GenWithStackByArgs(specNewColumn.Name.Name.O))
+				return nil, errors.Trace(infoschema.ErrColumnExists.GenWithStackByArgs(specNewColumn.Name.Name.O))
 			}
 			dupColumnNames[specNewColumn.Name.Name.L] = true
 		}
@@ -2902,13 +3116,16 @@ func (d *ddl) AddColumns(ctx sessionctx.Context, ti ast.Ident, specs []*ast.Alte
 	for _, spec := range specs {
 		for _, specNewColumn := range spec.NewColumns {
 			if spec.IfNotExists && dupColumnNames[specNewColumn.Name.Name.L] {
-				err = infoschema.ErrColumnExists.GenWithStackByArgs(specNewColumn.Name.Name.O)
+				err := infoschema.ErrColumnExists.GenWithStackByArgs(specNewColumn.Name.Name.O)
 				ctx.GetSessionVars().StmtCtx.AppendNote(err)
 				continue
 			}
+			if containsColumnOption(specNewColumn, ast.ColumnOptionCheck) {
+				return nil, ErrUnsupportedConstraintCheck.GenWithStackByArgs("ADD COLUMNS ... CHECK")
+			}
 			col, err := checkAndCreateNewColumn(ctx, ti, schema, spec, t, specNewColumn)
 			if err != nil {
-				return errors.Trace(err)
+				return nil, errors.Trace(err)
 			}
 			// Added column has existed and if_not_exists flag is true.
 			if col == nil && spec.IfNotExists {
@@ -2922,10 +3139,10 @@ func (d *ddl) AddColumns(ctx sessionctx.Context, ti ast.Ident, specs []*ast.Alte
 		}
 	}
 	if newColumnsCount == 0 {
-		return nil
+		return nil, nil
 	}
-	if err = checkAddColumnTooManyColumns(len(t.Cols()) + newColumnsCount); err != nil {
-		return errors.Trace(err)
+	if err := checkAddColumnTooManyColumns(len(t.Cols()) + newColumnsCount); err != nil {
+		return nil, errors.Trace(err)
 	}
 
 	job := &model.Job{
@@ -2936,13 +3153,7 @@ func (d *ddl) AddColumns(ctx sessionctx.Context, ti ast.Ident, specs []*ast.Alte
 		BinlogInfo: &model.HistoryInfo{},
 		Args:       []interface{}{columns, positions, offsets, ifNotExists},
 	}
-
-	err = d.doDDLJob(ctx, job)
-	if err != nil {
-		return errors.Trace(err)
-	}
-	err = d.callHookOnChanged(err)
-	return errors.Trace(err)
+	return job, nil
 }
 
 // AddTablePartitions will add a new partition to the table.
@@ -3006,6 +3217,83 @@ func (d *ddl) AddTablePartitions(ctx sessionctx.Context, ident ast.Ident, spec *
 	return errors.Trace(err)
 }
 
+// ReorganizePartitions reorganizes the given partitions of a range or list partitioned table into the new partitions,
+// the rows of the old partitions are moved into the new partitions online.
+func (d *ddl) ReorganizePartitions(ctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec) error {
+	is := d.infoCache.GetLatest()
+	schema, ok := is.SchemaByName(ident.Schema)
+	if !ok {
+		return errors.Trace(infoschema.ErrDatabaseNotExists.GenWithStackByArgs(schema))
+	}
+	t, err := is.TableByName(ident.Schema, ident.Name)
+	if err != nil {
+		return errors.Trace(infoschema.ErrTableNotExists.GenWithStackByArgs(ident.Schema, ident.Name))
+	}
+
+	meta := t.Meta()
+	pi := meta.GetPartitionInfo()
+	if pi == nil {
+		return errors.Trace(ErrPartitionMgmtOnNonpartitioned)
+	}
+	switch pi.Type {
+	case model.PartitionTypeRange, model.PartitionTypeList:
+	default:
+		return errors.Trace(errUnsupportedReorganizePartition)
+	}
+	if spec.OnAllPartitions || len(spec.PartitionNames) == 0 {
+		return errors.Trace(ErrReorgNoParam)
+	}
+	if hasGlobalIndex(meta) || meta.TiFlashReplica != nil {
+		return errors.Trace(errUnsupportedReorganizePartition)
+	}
+
+	partNames := make([]string, 0, len(spec.PartitionNames))
+	for _, name := range spec.PartitionNames {
+		partNames = append(partNames, name.L)
+	}
+	droppingDefs, err := checkReorganizePartition(meta, partNames)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	partInfo, err := buildAddedPartitionInfo(ctx, meta, spec)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := d.assignPartitionIDs(partInfo.Definitions); err != nil {
+		return errors.Trace(err)
+	}
+
+	// Check the partitions after reorganizing, the new partitions are put at the position of the old ones.
+	clonedMeta := meta.Clone()
+	tmp := *partInfo
+	tmp.Definitions = replacePartitionDefinitions(pi.Definitions, droppingDefs, partInfo.Definitions)
+	clonedMeta.Partition = &tmp
+	if err := checkPartitionDefinitionConstraints(ctx, clonedMeta); err != nil {
+		return errors.Trace(err)
+	}
+	if pi.Type == model.PartitionTypeRange {
+		lastDroppingDef := &droppingDefs[len(droppingDefs)-1]
+		isLast := lastDroppingDef.ID == pi.Definitions[len(pi.Definitions)-1].ID
+		err = checkReorgPartitionRange(ctx, clonedMeta, lastDroppingDef, &partInfo.Definitions[len(partInfo.Definitions)-1], isLast)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		TableID:    meta.ID,
+		SchemaName: schema.Name.L,
+		Type:       model.ActionReorganizePartition,
+		BinlogInfo: &model.HistoryInfo{},
+		Args:       []interface{}{partNames, partInfo},
+	}
+
+	err = d.doDDLJob(ctx, job)
+	err = d.callHookOnChanged(err)
+	return errors.Trace(err)
+}
+
 // CoalescePartitions coalesce partitions can be used with a table that is partitioned by hash or key to reduce the number of partitions by number.
 func (d *ddl) CoalescePartitions(ctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec) error {
 	is := d.infoCache.GetLatest()
@@ -3327,24 +3615,41 @@ func (d *ddl) ExchangeTablePartition(ctx sessionctx.Context, ident ast.Ident, sp
 	return errors.Trace(err)
 }
 
-// DropColumn will drop a column from the table, now we don't support drop the column with index covered.
+// DropColumn will drop a column from the table, now we don't support drop the column with clustered index covered.
 func (d *ddl) DropColumn(ctx sessionctx.Context, ti ast.Ident, spec *ast.AlterTableSpec) error {
 	schema, t, err := d.getSchemaAndTableByIdent(ctx, ti)
 	if err != nil {
 		return errors.Trace(err)
 	}
+	job, err := newDropColumnJob(ctx, schema, t, spec)
+	if err != nil || job == nil {
+		return err
+	}
+
+	err = d.doDDLJob(ctx, job)
+	// column not exists, but if_exists flags is true, so we ignore this error.
+	if ErrCantDropFieldOrKey.Equal(err) && spec.IfExists {
+		ctx.GetSessionVars().StmtCtx.AppendNote(err)
+		return nil
+	}
+	err = d.callHookOnChanged(err)
+	return errors.Trace(err)
+}
 
+// newDropColumnJob builds the job dropping the column of the spec. It returns nil if the column doesn't exist
+// and the if_exists flag is true.
+func newDropColumnJob(ctx sessionctx.Context, schema *model.DBInfo, t table.Table, spec *ast.AlterTableSpec) (*model.Job, error) {
 	isDropable, err := checkIsDroppableColumn(ctx, t, spec)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if !isDropable {
-		return nil
+		return nil, nil
 	}
 	colName := spec.OldColumnName.Name
 	err = checkDropVisibleColumnCnt(t, 1)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	job := &model.Job{
@@ -3355,23 +3660,35 @@ func (d *ddl) DropColumn(ctx sessionctx.Context, ti ast.Ident, spec *ast.AlterTa
 		BinlogInfo: &model.HistoryInfo{},
 		Args:       []interface{}{colName},
 	}
-
-	err = d.doDDLJob(ctx, job)
-	// column not exists, but if_exists flags is true, so we ignore this error.
-	if ErrCantDropFieldOrKey.Equal(err) && spec.IfExists {
-		ctx.GetSessionVars().StmtCtx.AppendNote(err)
-		return nil
+	if isColumnsWithCompositeIndex(t.Meta(), []model.CIStr{colName}) {
+		job.Type = model.ActionDropColumnsWithIndexes
+		job.Args = []interface{}{[]model.CIStr{colName}, []bool{spec.IfExists}}
 	}
-	err = d.callHookOnChanged(err)
-	return errors.Trace(err)
+	return job, nil
 }
 
-// DropColumns will drop multi-columns from the table, now we don't support drop the column with index covered.
+// DropColumns will drop multi-columns from the table, now we don't support drop the column with clustered index covered.
 func (d *ddl) DropColumns(ctx sessionctx.Context, ti ast.Ident, specs []*ast.AlterTableSpec) error {
 	schema, t, err := d.getSchemaAndTableByIdent(ctx, ti)
 	if err != nil {
 		return errors.Trace(err)
 	}
+	job, err := newDropColumnsJob(ctx, schema, t, specs)
+	if err != nil || job == nil {
+		return err
+	}
+
+	err = d.doDDLJob(ctx, job)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	err = d.callHookOnChanged(err)
+	return errors.Trace(err)
+}
+
+// newDropColumnsJob builds the job dropping the columns of the specs. It returns nil if none of the columns
+// exists and the if_exists flags are true.
+func newDropColumnsJob(ctx sessionctx.Context, schema *model.DBInfo, t table.Table, specs []*ast.AlterTableSpec) (*model.Job, error) {
 	tblInfo := t.Meta()
 
 	dropingColumnNames := make(map[string]bool)
@@ -3384,7 +3701,7 @@ func (d *ddl) DropColumns(ctx sessionctx.Context, ti ast.Ident, specs []*ast.Alt
 				dupColumnNames[spec.OldColumnName.Name.L] = true
 				continue
 			}
-			return errors.Trace(ErrCantDropFieldOrKey.GenWithStack("column %s doesn't exist", spec.OldColumnName.Name.O))
+			return nil, errors.Trace(ErrCantDropFieldOrKey.GenWithStack("column %s doesn't exist", spec.OldColumnName.Name.O))
 		}
 	}
 
@@ -3392,13 +3709,13 @@ func (d *ddl) DropColumns(ctx sessionctx.Context, ti ast.Ident, specs []*ast.Alt
 	colNames := make([]model.CIStr, 0, len(specs))
 	for _, spec := range specs {
 		if spec.IfExists && dupColumnNames[spec.OldColumnName.Name.L] {
-			err = ErrCantDropFieldOrKey.GenWithStack("column %s doesn't exist", spec.OldColumnName.Name.L)
+			err := ErrCantDropFieldOrKey.GenWithStack("column %s doesn't exist", spec.OldColumnName.Name.L)
 			ctx.GetSessionVars().StmtCtx.AppendNote(err)
 			continue
 		}
 		isDropable, err := checkIsDroppableColumn(ctx, t, spec)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		// Column can't drop and if_exists flag is true.
 		if !isDropable && spec.IfExists {
@@ -3408,15 +3725,14 @@ func (d *ddl) DropColumns(ctx sessionctx.Context, ti ast.Ident, specs []*ast.Alt
 		ifExists = append(ifExists, spec.IfExists)
 	}
 	if len(colNames) == 0 {
-		return nil
+		return nil, nil
 	}
 	if len(tblInfo.Columns) == len(colNames) {
-		return ErrCantRemoveAllFields.GenWithStack("can't drop all columns in table %s",
+		return nil, ErrCantRemoveAllFields.GenWithStack("can't drop all columns in table %s",
 			tblInfo.Name)
 	}
-	err = checkDropVisibleColumnCnt(t, len(colNames))
-	if err != nil {
-		return err
+	if err := checkDropVisibleColumnCnt(t, len(colNames)); err != nil {
+		return nil, err
 	}
 
 	job := &model.Job{
@@ -3427,16 +3743,21 @@ func (d *ddl) DropColumns(ctx sessionctx.Context, ti ast.Ident, specs []*ast.Alt
 		BinlogInfo: &model.HistoryInfo{},
 		Args:       []interface{}{colNames, ifExists},
 	}
-
-	err = d.doDDLJob(ctx, job)
-	if err != nil {
-		return errors.Trace(err)
+	if isColumnsWithCompositeIndex(tblInfo, colNames) {
+		job.Type = model.ActionDropColumnsWithIndexes
 	}
-	err = d.callHookOnChanged(err)
-	return errors.Trace(err)
+	return job, nil
 }
 
-func checkIsDroppableColumn(ctx sessionctx.Context, t table.Table, spec *ast.AlterTableSpec) (isDrapable bool, err error) {
+// isColumnsWithCompositeIndex returns true if the dropped columns are covered by the composite indexes, which are
+// rebuilt without these columns. Such a job has its own type, so the DDL owner of an older version, which can't
+// rebuild the indexes, cancels the job as an unknown one instead of dropping the columns.
+func isColumnsWithCompositeIndex(tblInfo *model.TableInfo, colNames []model.CIStr) bool {
+	_, compositeIdxInfos := listIndicesWithColumns(colNames, tblInfo.Indices)
+	return len(compositeIdxInfos) > 0
+}
+
+func checkIsDroppableColumn(ctx sessionctx.Context, t table.Table, spec *ast.AlterTableSpec) (isDrapable bool, err error) {
 	tblInfo := t.Meta()
 	// Check whether dropped column has existed.
 	colName := spec.OldColumnName.Name
@@ -3769,8 +4090,8 @@ func processColumnOptions(ctx sessionctx.Context, col *table.Column, options []*
 			return errors.Trace(errUnsupportedModifyColumn.GenWithStackByArgs("can't modify with references"))
 		case ast.ColumnOptionFulltext:
 			return errors.Trace(errUnsupportedModifyColumn.GenWithStackByArgs("can't modify with full text"))
+		// Ignore ColumnOptionCheck. The check constraints are added after the column is modified.
 		case ast.ColumnOptionCheck:
-			return errors.Trace(errUnsupportedModifyColumn.GenWithStackByArgs("can't modify with check"))
 		// Ignore ColumnOptionAutoRandom. It will be handled later.
 		case ast.ColumnOptionAutoRandom:
 		default:
@@ -3807,7 +4128,6 @@ func processAndCheckDefaultValueAndColumn(ctx sessionctx.Context, col *table.Col
 
 func (d *ddl) getModifiableColumnJob(ctx context.Context, sctx sessionctx.Context, ident ast.Ident, originalColName model.CIStr,
 	spec *ast.AlterTableSpec) (*model.Job, error) {
-	specNewColumn := spec.NewColumns[0]
 	is := d.infoCache.GetLatest()
 	schema, ok := is.SchemaByName(ident.Schema)
 	if !ok {
@@ -3817,7 +4137,14 @@ func (d *ddl) getModifiableColumnJob(ctx context.Context, sctx sessionctx.Contex
 	if err != nil {
 		return nil, errors.Trace(infoschema.ErrTableNotExists.GenWithStackByArgs(ident.Schema, ident.Name))
 	}
+	return d.newModifiableColumnJob(ctx, sctx, ident, schema, t, originalColName, spec)
+}
 
+// newModifiableColumnJob builds the job modifying the column of the table.
+func (d *ddl) newModifiableColumnJob(ctx context.Context, sctx sessionctx.Context, ident ast.Ident, schema *model.DBInfo, t table.Table,
+	originalColName model.CIStr, spec *ast.AlterTableSpec) (*model.Job, error) {
+	var err error
+	specNewColumn := spec.NewColumns[0]
 	col := table.FindCol(t.Cols(), originalColName.L)
 	if col == nil {
 		return nil, infoschema.ErrColumnNotExists.GenWithStackByArgs(originalColName, ident.Name)
@@ -3832,6 +4159,9 @@ func (d *ddl) getModifiableColumnJob(ctx context.Context, sctx sessionctx.Contex
 		if c != nil {
 			return nil, infoschema.ErrColumnExists.GenWithStackByArgs(newColName)
 		}
+		if err = checkColumnRefByCheckConstraint(t.Meta(), originalColName); err != nil {
+			return nil, errors.Trace(err)
+		}
 	}
 
 	// Constraints in the new column means adding new constraints. Errors should thrown,
@@ -4136,17 +4466,8 @@ func checkAutoRandom(tableInfo *model.TableInfo, originCol *table.Column, specNe
 // that do not need to change or check data on the table.
 func (d *ddl) ChangeColumn(ctx context.Context, sctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec) error {
 	specNewColumn := spec.NewColumns[0]
-	if len(specNewColumn.Name.Schema.O) != 0 && ident.Schema.L != specNewColumn.Name.Schema.L {
-		return ErrWrongDBName.GenWithStackByArgs(specNewColumn.Name.Schema.O)
-	}
-	if len(spec.OldColumnName.Schema.O) != 0 && ident.Schema.L != spec.OldColumnName.Schema.L {
-		return ErrWrongDBName.GenWithStackByArgs(spec.OldColumnName.Schema.O)
-	}
-	if len(specNewColumn.Name.Table.O) != 0 && ident.Name.L != specNewColumn.Name.Table.L {
-		return ErrWrongTableName.GenWithStackByArgs(specNewColumn.Name.Table.O)
-	}
-	if len(spec.OldColumnName.Table.O) != 0 && ident.Name.L != spec.OldColumnName.Table.L {
-		return ErrWrongTableName.GenWithStackByArgs(spec.OldColumnName.Table.O)
+	if err := checkModifyColumnNames(ident, spec); err != nil {
+		return err
 	}
 
 	job, err := d.getModifiableColumnJob(ctx, sctx, ident, spec.OldColumnName.Name, spec)
@@ -4157,6 +4478,10 @@ func (d *ddl) ChangeColumn(ctx context.Context, sctx sessionctx.Context, ident a
 		}
 		return errors.Trace(err)
 	}
+	constraints, err := d.buildModifiedColumnCheckConstraints(sctx, ident, job, specNewColumn, spec.OldColumnName.Name)
+	if err != nil {
+		return errors.Trace(err)
+	}
 
 	err = d.doDDLJob(sctx, job)
 	// column not exists, but if_exists flags is true, so we ignore this error.
@@ -4165,38 +4490,87 @@ func (d *ddl) ChangeColumn(ctx context.Context, sctx sessionctx.Context, ident a
 		return nil
 	}
 	err = d.callHookOnChanged(err)
-	return errors.Trace(err)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(d.createCheckConstraints(sctx, ident, constraints))
+}
+
+// checkModifyColumnNames checks the column names in the spec of CHANGE/MODIFY COLUMN belong to the altered table.
+func checkModifyColumnNames(ident ast.Ident, spec *ast.AlterTableSpec) error {
+	specNewColumn := spec.NewColumns[0]
+	if len(specNewColumn.Name.Schema.O) != 0 && ident.Schema.L != specNewColumn.Name.Schema.L {
+		return ErrWrongDBName.GenWithStackByArgs(specNewColumn.Name.Schema.O)
+	}
+	if spec.OldColumnName != nil && len(spec.OldColumnName.Schema.O) != 0 && ident.Schema.L != spec.OldColumnName.Schema.L {
+		return ErrWrongDBName.GenWithStackByArgs(spec.OldColumnName.Schema.O)
+	}
+	if len(specNewColumn.Name.Table.O) != 0 && ident.Name.L != specNewColumn.Name.Table.L {
+		return ErrWrongTableName.GenWithStackByArgs(specNewColumn.Name.Table.O)
+	}
+	if spec.OldColumnName != nil && len(spec.OldColumnName.Table.O) != 0 && ident.Name.L != spec.OldColumnName.Table.L {
+		return ErrWrongTableName.GenWithStackByArgs(spec.OldColumnName.Table.O)
+	}
+	return nil
+}
+
+// buildModifiedColumnCheckConstraints builds the check constraints defined in the column modified by the job.
+func (d *ddl) buildModifiedColumnCheckConstraints(sctx sessionctx.Context, ident ast.Ident, job *model.Job, specNewColumn *ast.ColumnDef, originalColName model.CIStr) ([]*model.ConstraintInfo, error) {
+	if !containsColumnOption(specNewColumn, ast.ColumnOptionCheck) {
+		return nil, nil
+	}
+	_, t, err := d.getSchemaAndTableByIdent(sctx, ident)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	// The new column is the first argument of the modify column job.
+	newCol := *job.Args[0].(**table.Column)
+	return buildColumnCheckConstraints(sctx, t.Meta(), specNewColumn, newCol.ColumnInfo, originalColName)
 }
 
 // RenameColumn renames an existing column.
 func (d *ddl) RenameColumn(ctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec) error {
+	schema, tbl, err := d.getSchemaAndTableByIdent(ctx, ident)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	job, err := newRenameColumnJob(ctx, ident, schema, tbl, spec)
+	if err != nil || job == nil {
+		return errors.Trace(err)
+	}
+
+	err = d.doDDLJob(ctx, job)
+	err = d.callHookOnChanged(err)
+	return errors.Trace(err)
+}
+
+// newRenameColumnJob builds the job renaming the column of the spec. It returns nil if the name isn't changed.
+func newRenameColumnJob(ctx sessionctx.Context, ident ast.Ident, schema *model.DBInfo, tbl table.Table, spec *ast.AlterTableSpec) (*model.Job, error) {
 	oldColName := spec.OldColumnName.Name
 	newColName := spec.NewColumnName.Name
 	if oldColName.L == newColName.L {
-		return nil
+		return nil, nil
 	}
 	if newColName.L == model.ExtraHandleName.L {
-		return ErrWrongColumnName.GenWithStackByArgs(newColName.L)
-	}
-
-	schema, tbl, err := d.getSchemaAndTableByIdent(ctx, ident)
-	if err != nil {
-		return errors.Trace(err)
+		return nil, ErrWrongColumnName.GenWithStackByArgs(newColName.L)
 	}
 
 	oldCol := table.FindCol(tbl.VisibleCols(), oldColName.L)
 	if oldCol == nil {
-		return infoschema.ErrColumnNotExists.GenWithStackByArgs(oldColName, ident.Name)
+		return nil, infoschema.ErrColumnNotExists.GenWithStackByArgs(oldColName, ident.Name)
 	}
 
 	allCols := tbl.Cols()
 	colWithNewNameAlreadyExist := table.FindCol(allCols, newColName.L) != nil
 	if colWithNewNameAlreadyExist {
-		return infoschema.ErrColumnExists.GenWithStackByArgs(newColName)
+		return nil, infoschema.ErrColumnExists.GenWithStackByArgs(newColName)
 	}
 
 	if fkInfo := getColumnForeignKeyInfo(oldColName.L, tbl.Meta().ForeignKeys); fkInfo != nil {
-		return errFKIncompatibleColumns.GenWithStackByArgs(oldColName, fkInfo.Name)
+		return nil, errFKIncompatibleColumns.GenWithStackByArgs(oldColName, fkInfo.Name)
+	}
+	if err := checkColumnRefByCheckConstraint(tbl.Meta(), oldColName); err != nil {
+		return nil, errors.Trace(err)
 	}
 
 	// Check generated expression.
@@ -4208,9 +4582,9 @@ func (d *ddl) RenameColumn(ctx sessionctx.Context, ident ast.Ident, spec *ast.Al
 		for _, name := range dependedColNames {
 			if name.Name.L == oldColName.L {
 				if col.Hidden {
-					return errDependentByFunctionalIndex.GenWithStackByArgs(oldColName.O)
+					return nil, errDependentByFunctionalIndex.GenWithStackByArgs(oldColName.O)
 				}
-				return errDependentByGeneratedColumn.GenWithStackByArgs(oldColName.O)
+				return nil, errDependentByGeneratedColumn.GenWithStackByArgs(oldColName.O)
 			}
 		}
 	}
@@ -4230,20 +4604,15 @@ func (d *ddl) RenameColumn(ctx sessionctx.Context, ident ast.Ident, spec *ast.Al
 		},
 		Args: []interface{}{&newCol, oldColName, spec.Position, 0},
 	}
-	err = d.doDDLJob(ctx, job)
-	err = d.callHookOnChanged(err)
-	return errors.Trace(err)
+	return job, nil
 }
 
 // ModifyColumn does modification on an existing column, currently we only support limited kind of changes
 // that do not need to change or check data on the table.
 func (d *ddl) ModifyColumn(ctx context.Context, sctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec) error {
 	specNewColumn := spec.NewColumns[0]
-	if len(specNewColumn.Name.Schema.O) != 0 && ident.Schema.L != specNewColumn.Name.Schema.L {
-		return ErrWrongDBName.GenWithStackByArgs(specNewColumn.Name.Schema.O)
-	}
-	if len(specNewColumn.Name.Table.O) != 0 && ident.Name.L != specNewColumn.Name.Table.L {
-		return ErrWrongTableName.GenWithStackByArgs(specNewColumn.Name.Table.O)
+	if err := checkModifyColumnNames(ident, spec); err != nil {
+		return err
 	}
 
 	originalColName := specNewColumn.Name.Name
@@ -4255,6 +4624,10 @@ func (d *ddl) ModifyColumn(ctx context.Context, sctx sessionctx.Context, ident a
 		}
 		return errors.Trace(err)
 	}
+	constraints, err := d.buildModifiedColumnCheckConstraints(sctx, ident, job, specNewColumn, originalColName)
+	if err != nil {
+		return errors.Trace(err)
+	}
 
 	err = d.doDDLJob(sctx, job)
 	// column not exists, but if_exists flags is true, so we ignore this error.
@@ -4263,11 +4636,13 @@ func (d *ddl) ModifyColumn(ctx context.Context, sctx sessionctx.Context, ident a
 		return nil
 	}
 	err = d.callHookOnChanged(err)
-	return errors.Trace(err)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(d.createCheckConstraints(sctx, ident, constraints))
 }
 
 func (d *ddl) AlterColumn(ctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec) error {
-	specNewColumn := spec.NewColumns[0]
 	is := d.infoCache.GetLatest()
 	schema, ok := is.SchemaByName(ident.Schema)
 	if !ok {
@@ -4277,32 +4652,43 @@ func (d *ddl) AlterColumn(ctx sessionctx.Context, ident ast.Ident, spec *ast.Alt
 	if err != nil {
 		return infoschema.ErrTableNotExists.GenWithStackByArgs(ident.Schema, ident.Name)
 	}
+	job, err := newAlterColumnJob(ctx, ident, schema, t, spec)
+	if err != nil || job == nil {
+		return errors.Trace(err)
+	}
 
+	err = d.doDDLJob(ctx, job)
+	err = d.callHookOnChanged(err)
+	return errors.Trace(err)
+}
+
+// newAlterColumnJob builds the job setting or dropping the default value of the column.
+func newAlterColumnJob(ctx sessionctx.Context, ident ast.Ident, schema *model.DBInfo, t table.Table, spec *ast.AlterTableSpec) (*model.Job, error) {
+	specNewColumn := spec.NewColumns[0]
 	colName := specNewColumn.Name.Name
 	// Check whether alter column has existed.
 	col := table.FindCol(t.Cols(), colName.L)
 	if col == nil {
-		return ErrBadField.GenWithStackByArgs(colName, ident.Name)
+		return nil, ErrBadField.GenWithStackByArgs(colName, ident.Name)
 	}
 
 	// Clean the NoDefaultValueFlag value.
 	col.Flag &= ^mysql.NoDefaultValueFlag
 	if len(specNewColumn.Options) == 0 {
-		err = col.SetDefaultValue(nil)
-		if err != nil {
-			return errors.Trace(err)
+		if err := col.SetDefaultValue(nil); err != nil {
+			return nil, errors.Trace(err)
 		}
 		setNoDefaultValueFlag(col, false)
 	} else {
 		if IsAutoRandomColumnID(t.Meta(), col.ID) {
-			return ErrInvalidAutoRandom.GenWithStackByArgs(autoid.AutoRandomIncompatibleWithDefaultValueErrMsg)
+			return nil, ErrInvalidAutoRandom.GenWithStackByArgs(autoid.AutoRandomIncompatibleWithDefaultValueErrMsg)
 		}
 		hasDefaultValue, err := setDefaultValue(ctx, col, specNewColumn.Options[0])
 		if err != nil {
-			return errors.Trace(err)
+			return nil, errors.Trace(err)
 		}
 		if err = checkDefaultValue(ctx, col, hasDefaultValue); err != nil {
-			return errors.Trace(err)
+			return nil, errors.Trace(err)
 		}
 	}
 
@@ -4314,10 +4700,7 @@ func (d *ddl) AlterColumn(ctx sessionctx.Context, ident ast.Ident, spec *ast.Alt
 		BinlogInfo: &model.HistoryInfo{},
 		Args:       []interface{}{col},
 	}
-
-	err = d.doDDLJob(ctx, job)
-	err = d.callHookOnChanged(err)
-	return errors.Trace(err)
+	return job, nil
 }
 
 // AlterTableComment updates the table comment information.
@@ -4655,12 +5038,24 @@ func (d *ddl) RenameIndex(ctx sessionctx.Context, ident ast.Ident, spec *ast.Alt
 	if err != nil {
 		return errors.Trace(infoschema.ErrTableNotExists.GenWithStackByArgs(ident.Schema, ident.Name))
 	}
+	job, err := newRenameIndexJob(schema, tb, spec)
+	if err != nil || job == nil {
+		return errors.Trace(err)
+	}
+
+	err = d.doDDLJob(ctx, job)
+	err = d.callHookOnChanged(err)
+	return errors.Trace(err)
+}
+
+// newRenameIndexJob builds the job renaming the index of the spec. It returns nil if the name isn't changed.
+func newRenameIndexJob(schema *model.DBInfo, tb table.Table, spec *ast.AlterTableSpec) (*model.Job, error) {
 	duplicate, err := validateRenameIndex(spec.FromKey, spec.ToKey, tb.Meta())
 	if duplicate {
-		return nil
+		return nil, nil
 	}
 	if err != nil {
-		return errors.Trace(err)
+		return nil, errors.Trace(err)
 	}
 
 	job := &model.Job{
@@ -4671,10 +5066,7 @@ func (d *ddl) RenameIndex(ctx sessionctx.Context, ident ast.Ident, spec *ast.Alt
 		BinlogInfo: &model.HistoryInfo{},
 		Args:       []interface{}{spec.FromKey, spec.ToKey},
 	}
-
-	err = d.doDDLJob(ctx, job)
-	err = d.callHookOnChanged(err)
-	return errors.Trace(err)
+	return job, nil
 }
 
 // DropTable will proceed even if some table in the list does not exists.
@@ -4950,31 +5342,44 @@ func getAnonymousIndex(t table.Table, colName model.CIStr, idxName model.CIStr)
 
 func (d *ddl) CreatePrimaryKey(ctx sessionctx.Context, ti ast.Ident, indexName model.CIStr,
 	indexPartSpecifications []*ast.IndexPartSpecification, indexOption *ast.IndexOption) error {
-	if indexOption != nil && indexOption.PrimaryKeyTp == model.PrimaryKeyTypeClustered {
-		return ErrUnsupportedModifyPrimaryKey.GenWithStack("Adding clustered primary key is not supported. " +
-			"Please consider adding NONCLUSTERED primary key instead")
-	}
 	schema, t, err := d.getSchemaAndTableByIdent(ctx, ti)
 	if err != nil {
 		return errors.Trace(err)
 	}
+	job, err := newCreatePrimaryKeyJob(ctx, schema, t, indexName, indexPartSpecifications, indexOption)
+	if err != nil || job == nil {
+		return errors.Trace(err)
+	}
+
+	err = d.doDDLJob(ctx, job)
+	err = d.callHookOnChanged(err)
+	return errors.Trace(err)
+}
+
+// newCreatePrimaryKeyJob builds the job adding the primary key.
+func newCreatePrimaryKeyJob(ctx sessionctx.Context, schema *model.DBInfo, t table.Table, indexName model.CIStr,
+	indexPartSpecifications []*ast.IndexPartSpecification, indexOption *ast.IndexOption) (*model.Job, error) {
+	if indexOption != nil && indexOption.PrimaryKeyTp == model.PrimaryKeyTypeClustered {
+		return nil, ErrUnsupportedModifyPrimaryKey.GenWithStack("Adding clustered primary key is not supported. " +
+			"Please consider adding NONCLUSTERED primary key instead")
+	}
 
-	if err = checkTooLongIndex(indexName); err != nil {
-		return ErrTooLongIdent.GenWithStackByArgs(mysql.PrimaryKeyName)
+	if err := checkTooLongIndex(indexName); err != nil {
+		return nil, ErrTooLongIdent.GenWithStackByArgs(mysql.PrimaryKeyName)
 	}
 
 	indexName = model.NewCIStr(mysql.PrimaryKeyName)
 	if indexInfo := t.Meta().FindIndexByName(indexName.L); indexInfo != nil ||
 		// If the table's PKIsHandle is true, it also means that this table has a primary key.
 		t.Meta().PKIsHandle {
-		return infoschema.ErrMultiplePriKey
+		return nil, infoschema.ErrMultiplePriKey
 	}
 
 	// Primary keys cannot include expression index parts. A primary key requires the generated column to be stored,
 	// but expression index parts are implemented as virtual generated columns, not stored generated columns.
 	for _, idxPart := range indexPartSpecifications {
 		if idxPart.Expr != nil {
-			return ErrFunctionalIndexPrimaryKey
+			return nil, ErrFunctionalIndexPrimaryKey
 		}
 	}
 
@@ -4987,21 +5392,21 @@ func (d *ddl) CreatePrimaryKey(ctx sessionctx.Context, ti ast.Ident, indexName m
 	// For same reason, decide whether index is global here.
 	indexColumns, err := buildIndexColumns(tblInfo.Columns, indexPartSpecifications)
 	if err != nil {
-		return errors.Trace(err)
+		return nil, errors.Trace(err)
 	}
 	if _, err = checkPKOnGeneratedColumn(tblInfo, indexPartSpecifications); err != nil {
-		return err
+		return nil, err
 	}
 
 	global := false
 	if tblInfo.GetPartitionInfo() != nil {
 		ck, err := checkPartitionKeysConstraint(tblInfo.GetPartitionInfo(), indexColumns, tblInfo)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if !ck {
 			if !config.GetGlobalConfig().EnableGlobalIndex {
-				return ErrUniqueKeyNeedAllFieldsInPf.GenWithStackByArgs("PRIMARY")
+				return nil, ErrUniqueKeyNeedAllFieldsInPf.GenWithStackByArgs("PRIMARY")
 			}
 			// index columns does not contain all partition columns, must set global
 			global = true
@@ -5010,7 +5415,7 @@ func (d *ddl) CreatePrimaryKey(ctx sessionctx.Context, ti ast.Ident, indexName m
 
 	// May be truncate comment here, when index comment too long and sql_mode is't strict.
 	if _, err = validateCommentLength(ctx.GetSessionVars(), indexName.String(), indexOption); err != nil {
-		return errors.Trace(err)
+		return nil, errors.Trace(err)
 	}
 
 	unique := true
@@ -5029,10 +5434,7 @@ func (d *ddl) CreatePrimaryKey(ctx sessionctx.Context, ti ast.Ident, indexName m
 		Args:     []interface{}{unique, indexName, indexPartSpecifications, indexOption, sqlMode, nil, global},
 		Priority: ctx.GetSessionVars().DDLReorgPriority,
 	}
-
-	err = d.doDDLJob(ctx, job)
-	err = d.callHookOnChanged(err)
-	return errors.Trace(err)
+	return job, nil
 }
 
 func buildHiddenColumnInfo(ctx sessionctx.Context, indexPartSpecifications []*ast.IndexPartSpecification, indexName model.CIStr, tblInfo *model.TableInfo, existCols []*table.Column) ([]*model.ColumnInfo, error) {
@@ -5107,15 +5509,33 @@ func buildHiddenColumnInfo(ctx sessionctx.Context, indexPartSpecifications []*as
 
 func (d *ddl) CreateIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.IndexKeyType, indexName model.CIStr,
 	indexPartSpecifications []*ast.IndexPartSpecification, indexOption *ast.IndexOption, ifNotExists bool) error {
-	// not support Spatial and FullText index
-	if keyType == ast.IndexKeyTypeFullText || keyType == ast.IndexKeyTypeSpatial {
-		return errUnsupportedIndexType.GenWithStack("FULLTEXT and SPATIAL index is not supported")
-	}
-	unique := keyType == ast.IndexKeyTypeUnique
 	schema, t, err := d.getSchemaAndTableByIdent(ctx, ti)
 	if err != nil {
 		return errors.Trace(err)
 	}
+	job, err := newCreateIndexJob(ctx, schema, t, keyType, indexName, indexPartSpecifications, indexOption, ifNotExists)
+	if err != nil || job == nil {
+		return errors.Trace(err)
+	}
+
+	err = d.doDDLJob(ctx, job)
+	// key exists, but if_not_exists flags is true, so we ignore this error.
+	if ErrDupKeyName.Equal(err) && ifNotExists {
+		ctx.GetSessionVars().StmtCtx.AppendNote(err)
+		return nil
+	}
+	err = d.callHookOnChanged(err)
+	return errors.Trace(err)
+}
+
+// newCreateIndexJob builds the job adding the index. It returns nil if the index exists and the if_not_exists flag is true.
+func newCreateIndexJob(ctx sessionctx.Context, schema *model.DBInfo, t table.Table, keyType ast.IndexKeyType, indexName model.CIStr,
+	indexPartSpecifications []*ast.IndexPartSpecification, indexOption *ast.IndexOption, ifNotExists bool) (*model.Job, error) {
+	// not support Spatial and FullText index
+	if keyType == ast.IndexKeyTypeFullText || keyType == ast.IndexKeyTypeSpatial {
+		return nil, errUnsupportedIndexType.GenWithStack("FULLTEXT and SPATIAL index is not supported")
+	}
+	unique := keyType == ast.IndexKeyTypeUnique
 
 	// Deal with anonymous index.
 	if len(indexName.L) == 0 {
@@ -5127,6 +5547,7 @@ func (d *ddl) CreateIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.Inde
 	}
 
 	if indexInfo := t.Meta().FindIndexByName(indexName.L); indexInfo != nil {
+		var err error
 		if indexInfo.State != model.StatePublic {
 			// NOTE: explicit error message. See issue #18363.
 			err = ErrDupKeyName.GenWithStack("index already exist %s; "+
@@ -5137,13 +5558,13 @@ func (d *ddl) CreateIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.Inde
 		}
 		if ifNotExists {
 			ctx.GetSessionVars().StmtCtx.AppendNote(err)
-			return nil
+			return nil, nil
 		}
-		return err
+		return nil, err
 	}
 
-	if err = checkTooLongIndex(indexName); err != nil {
-		return errors.Trace(err)
+	if err := checkTooLongIndex(indexName); err != nil {
+		return nil, errors.Trace(err)
 	}
 
 	tblInfo := t.Meta()
@@ -5151,10 +5572,10 @@ func (d *ddl) CreateIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.Inde
 	// Build hidden columns if necessary.
 	hiddenCols, err := buildHiddenColumnInfo(ctx, indexPartSpecifications, indexName, t.Meta(), t.Cols())
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if err = checkAddColumnTooManyColumns(len(t.Cols()) + len(hiddenCols)); err != nil {
-		return errors.Trace(err)
+		return nil, errors.Trace(err)
 	}
 
 	finalColumns := make([]*model.ColumnInfo, len(tblInfo.Columns), len(tblInfo.Columns)+len(hiddenCols))
@@ -5168,7 +5589,7 @@ func (d *ddl) CreateIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.Inde
 	// For same reason, decide whether index is global here.
 	indexColumns, err := buildIndexColumns(finalColumns, indexPartSpecifications)
 	if err != nil {
-		return errors.Trace(err)
+		return nil, errors.Trace(err)
 	}
 
 	if !unique && tblInfo.IsCommonHandle {
@@ -5176,14 +5597,14 @@ func (d *ddl) CreateIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.Inde
 		var pkLen, idxLen int
 		pkLen, err = indexColumnsLen(tblInfo.Columns, tables.FindPrimaryIndex(tblInfo).Columns)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		idxLen, err = indexColumnsLen(finalColumns, indexColumns)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if pkLen+idxLen > config.GetGlobalConfig().MaxIndexLength {
-			return errTooLongKey.GenWithStackByArgs(config.GetGlobalConfig().MaxIndexLength)
+			return nil, errTooLongKey.GenWithStackByArgs(config.GetGlobalConfig().MaxIndexLength)
 		}
 	}
 
@@ -5191,11 +5612,11 @@ func (d *ddl) CreateIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.Inde
 	if unique && tblInfo.GetPartitionInfo() != nil {
 		ck, err := checkPartitionKeysConstraint(tblInfo.GetPartitionInfo(), indexColumns, tblInfo)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if !ck {
 			if !config.GetGlobalConfig().EnableGlobalIndex {
-				return ErrUniqueKeyNeedAllFieldsInPf.GenWithStackByArgs("UNIQUE INDEX")
+				return nil, ErrUniqueKeyNeedAllFieldsInPf.GenWithStackByArgs("UNIQUE INDEX")
 			}
 			// index columns does not contain all partition columns, must set global
 			global = true
@@ -5203,7 +5624,7 @@ func (d *ddl) CreateIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.Inde
 	}
 	// May be truncate comment here, when index comment too long and sql_mode is't strict.
 	if _, err = validateCommentLength(ctx.GetSessionVars(), indexName.String(), indexOption); err != nil {
-		return errors.Trace(err)
+		return nil, errors.Trace(err)
 	}
 	job := &model.Job{
 		SchemaID:   schema.ID,
@@ -5219,15 +5640,7 @@ func (d *ddl) CreateIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.Inde
 		Args:     []interface{}{unique, indexName, indexPartSpecifications, indexOption, hiddenCols, global},
 		Priority: ctx.GetSessionVars().DDLReorgPriority,
 	}
-
-	err = d.doDDLJob(ctx, job)
-	// key exists, but if_not_exists flags is true, so we ignore this error.
-	if ErrDupKeyName.Equal(err) && ifNotExists {
-		ctx.GetSessionVars().StmtCtx.AppendNote(err)
-		return nil
-	}
-	err = d.callHookOnChanged(err)
-	return errors.Trace(err)
+	return job, nil
 }
 
 func buildFKInfo(fkName model.CIStr, keys []*ast.IndexPartSpecification, refer *ast.ReferenceDef, cols []*table.Column, tbInfo *model.TableInfo) (*model.FKInfo, error) {
@@ -5372,6 +5785,103 @@ func (d *ddl) DropForeignKey(ctx sessionctx.Context, ti ast.Ident, fkName model.
 	return errors.Trace(err)
 }
 
+func (d *ddl) CreateCheckConstraint(ctx sessionctx.Context, ti ast.Ident, constr *ast.Constraint) error {
+	_, t, err := d.getSchemaAndTableByIdent(ctx, ti)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	tblInfo := t.Meta()
+	if constr.Name == "" {
+		constr.Name = genCheckConstraintName(tblInfo, nil)
+	} else if tblInfo.FindConstraintInfoByName(constr.Name) != nil {
+		return errCheckConstraintDupName.GenWithStackByArgs(constr.Name)
+	}
+
+	constrInfo, err := buildConstraintInfo(ctx, tblInfo, constr)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return d.createCheckConstraints(ctx, ti, []*model.ConstraintInfo{constrInfo})
+}
+
+// createCheckConstraints adds the built check constraints one by one, the existing records are verified before
+// each constraint becomes public.
+func (d *ddl) createCheckConstraints(ctx sessionctx.Context, ti ast.Ident, constraints []*model.ConstraintInfo) error {
+	if len(constraints) == 0 {
+		return nil
+	}
+	schema, t, err := d.getSchemaAndTableByIdent(ctx, ti)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, constrInfo := range constraints {
+		job := &model.Job{
+			SchemaID:   schema.ID,
+			TableID:    t.Meta().ID,
+			SchemaName: schema.Name.L,
+			Type:       model.ActionAddCheckConstraint,
+			BinlogInfo: &model.HistoryInfo{},
+			Args:       []interface{}{constrInfo},
+		}
+		err = d.doDDLJob(ctx, job)
+		err = d.callHookOnChanged(err)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+func (d *ddl) DropCheckConstraint(ctx sessionctx.Context, ti ast.Ident, constrName model.CIStr) error {
+	schema, t, err := d.getSchemaAndTableByIdent(ctx, ti)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if t.Meta().FindConstraintInfoByName(constrName.L) == nil {
+		return errConstraintNotFound.GenWithStackByArgs(constrName.O)
+	}
+
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		TableID:    t.Meta().ID,
+		SchemaName: schema.Name.L,
+		Type:       model.ActionDropCheckConstraint,
+		BinlogInfo: &model.HistoryInfo{},
+		Args:       []interface{}{constrName},
+	}
+
+	err = d.doDDLJob(ctx, job)
+	err = d.callHookOnChanged(err)
+	return errors.Trace(err)
+}
+
+func (d *ddl) AlterCheckConstraint(ctx sessionctx.Context, ti ast.Ident, constrName model.CIStr, enforced bool) error {
+	schema, t, err := d.getSchemaAndTableByIdent(ctx, ti)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	constrInfo := t.Meta().FindConstraintInfoByName(constrName.L)
+	if constrInfo == nil {
+		return errConstraintNotFound.GenWithStackByArgs(constrName.O)
+	}
+	if constrInfo.Enforced == enforced {
+		return nil
+	}
+
+	job := &model.Job{
+		SchemaID:   schema.ID,
+		TableID:    t.Meta().ID,
+		SchemaName: schema.Name.L,
+		Type:       model.ActionAlterCheckConstraint,
+		BinlogInfo: &model.HistoryInfo{},
+		Args:       []interface{}{constrName, enforced},
+	}
+
+	err = d.doDDLJob(ctx, job)
+	err = d.callHookOnChanged(err)
+	return errors.Trace(err)
+}
+
 func (d *ddl) DropIndex(ctx sessionctx.Context, ti ast.Ident, indexName model.CIStr, ifExists bool) error {
 	is := d.infoCache.GetLatest()
 	schema, ok := is.SchemaByName(ti.Schema)
@@ -5382,7 +5892,23 @@ func (d *ddl) DropIndex(ctx sessionctx.Context, ti ast.Ident, indexName model.CI
 	if err != nil {
 		return errors.Trace(infoschema.ErrTableNotExists.GenWithStackByArgs(ti.Schema, ti.Name))
 	}
+	job, err := newDropIndexJob(ctx, schema, t, indexName, ifExists)
+	if err != nil || job == nil {
+		return errors.Trace(err)
+	}
+
+	err = d.doDDLJob(ctx, job)
+	// index not exists, but if_exists flags is true, so we ignore this error.
+	if ErrCantDropFieldOrKey.Equal(err) && ifExists {
+		ctx.GetSessionVars().StmtCtx.AppendNote(err)
+		return nil
+	}
+	err = d.callHookOnChanged(err)
+	return errors.Trace(err)
+}
 
+// newDropIndexJob builds the job dropping the index. It returns nil if the index doesn't exist and the if_exists flag is true.
+func newDropIndexJob(ctx sessionctx.Context, schema *model.DBInfo, t table.Table, indexName model.CIStr, ifExists bool) (*model.Job, error) {
 	indexInfo := t.Meta().FindIndexByName(indexName.L)
 	var isPK bool
 	if indexName.L == strings.ToLower(mysql.PrimaryKeyName) &&
@@ -5393,28 +5919,28 @@ func (d *ddl) DropIndex(ctx sessionctx.Context, ti ast.Ident, indexName model.CI
 	if isPK {
 		// If the table's PKIsHandle is true, we can't find the index from the table. So we check the value of PKIsHandle.
 		if indexInfo == nil && !t.Meta().PKIsHandle {
-			return ErrCantDropFieldOrKey.GenWithStack("Can't DROP 'PRIMARY'; check that column/key exists")
+			return nil, ErrCantDropFieldOrKey.GenWithStack("Can't DROP 'PRIMARY'; check that column/key exists")
 		}
 		if t.Meta().PKIsHandle {
-			return ErrUnsupportedModifyPrimaryKey.GenWithStack("Unsupported drop primary key when the table's pkIsHandle is true")
+			return nil, ErrUnsupportedModifyPrimaryKey.GenWithStack("Unsupported drop primary key when the table's pkIsHandle is true")
 		}
 		if t.Meta().IsCommonHandle {
-			return ErrUnsupportedModifyPrimaryKey.GenWithStack("Unsupported drop primary key when the table is using clustered index")
+			return nil, ErrUnsupportedModifyPrimaryKey.GenWithStack("Unsupported drop primary key when the table is using clustered index")
 		}
 	}
 	if indexInfo == nil {
-		err = ErrCantDropFieldOrKey.GenWithStack("index %s doesn't exist", indexName)
+		err := ErrCantDropFieldOrKey.GenWithStack("index %s doesn't exist", indexName)
 		if ifExists {
 			ctx.GetSessionVars().StmtCtx.AppendNote(err)
-			return nil
+			return nil, nil
 		}
-		return err
+		return nil, err
 	}
 
 	// Check for drop index on auto_increment column.
-	err = checkDropIndexOnAutoIncrementColumn(t.Meta(), indexInfo)
+	err := checkDropIndexOnAutoIncrementColumn(t.Meta(), indexInfo)
 	if err != nil {
-		return errors.Trace(err)
+		return nil, errors.Trace(err)
 	}
 
 	jobTp := model.ActionDropIndex
@@ -5430,15 +5956,7 @@ func (d *ddl) DropIndex(ctx sessionctx.Context, ti ast.Ident, indexName model.CI
 		BinlogInfo: &model.HistoryInfo{},
 		Args:       []interface{}{indexName},
 	}
-
-	err = d.doDDLJob(ctx, job)
-	// index not exists, but if_exists flags is true, so we ignore this error.
-	if ErrCantDropFieldOrKey.Equal(err) && ifExists {
-		ctx.GetSessionVars().StmtCtx.AppendNote(err)
-		return nil
-	}
-	err = d.callHookOnChanged(err)
-	return errors.Trace(err)
+	return job, nil
 }
 
 func isDroppableColumn(tblInfo *model.TableInfo, colName model.CIStr) error {
@@ -5449,13 +5967,17 @@ func isDroppableColumn(tblInfo *model.TableInfo, colName model.CIStr) error {
 		return errDependentByGeneratedColumn.GenWithStackByArgs(dep)
 	}
 
+	if constr := findDependentCheckConstraint(tblInfo, colName); constr != nil {
+		return errDependentByCheckConstraint.GenWithStackByArgs(constr.Name.O, colName.O)
+	}
+
 	if len(tblInfo.Columns) == 1 {
 		return ErrCantRemoveAllFields.GenWithStack("can't drop only column %s in table %s",
 			colName, tblInfo.Name)
 	}
-	// We only support dropping column with single-value none Primary Key index covered now.
-	if !isColumnCanDropWithIndex(colName.L, tblInfo.Indices) {
-		return errCantDropColWithIndex.GenWithStack("can't drop column %s with composite index covered or Primary Key covered now", colName)
+	// The composite indexes covering the column are rebuilt without it, but the row handles can't be changed.
+	if isColumnWithClusteredIndex(colName.L, tblInfo) {
+		return errCantDropColWithIndex.GenWithStack("can't drop column %s with clustered Primary Key covered now", colName)
 	}
 	// Check the column with foreign key.
 	if fkInfo := getColumnForeignKeyInfo(colName.L, tblInfo.ForeignKeys); fkInfo != nil {
@@ -5934,7 +6456,18 @@ func (d *ddl) AlterIndexVisibility(ctx sessionctx.Context, ident ast.Ident, inde
 	if err != nil {
 		return err
 	}
+	job, err := newAlterIndexVisibilityJob(schema, tb, indexName, visibility)
+	if err != nil || job == nil {
+		return errors.Trace(err)
+	}
 
+	err = d.doDDLJob(ctx, job)
+	err = d.callHookOnChanged(err)
+	return errors.Trace(err)
+}
+
+// newAlterIndexVisibilityJob builds the job changing the visibility of the index. It returns nil if the visibility isn't changed.
+func newAlterIndexVisibilityJob(schema *model.DBInfo, tb table.Table, indexName model.CIStr, visibility ast.IndexVisibility) (*model.Job, error) {
 	invisible := false
 	if visibility == ast.IndexVisibilityInvisible {
 		invisible = true
@@ -5942,10 +6475,10 @@ func (d *ddl) AlterIndexVisibility(ctx sessionctx.Context, ident ast.Ident, inde
 
 	skip, err := validateAlterIndexVisibility(indexName, invisible, tb.Meta())
 	if err != nil {
-		return errors.Trace(err)
+		return nil, errors.Trace(err)
 	}
 	if skip {
-		return nil
+		return nil, nil
 	}
 
 	job := &model.Job{
@@ -5956,10 +6489,7 @@ func (d *ddl) AlterIndexVisibility(ctx sessionctx.Context, ident ast.Ident, inde
 		BinlogInfo: &model.HistoryInfo{},
 		Args:       []interface{}{indexName, invisible},
 	}
-
-	err = d.doDDLJob(ctx, job)
-	err = d.callHookOnChanged(err)
-	return errors.Trace(err)
+	return job, nil
 }
 
 func (d *ddl) AlterTableAlterPartition(ctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec) (err error) {
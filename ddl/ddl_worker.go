@@ -403,7 +403,8 @@ func (w *worker) finishDDLJob(t *meta.Meta, job *model.Job) (err error) {
 			// After rolling back an AddIndex operation, we need to use delete-range to delete the half-done index data.
 			err = w.deleteRange(w.ddlJobCtx, job)
 		case model.ActionDropSchema, model.ActionDropTable, model.ActionTruncateTable, model.ActionDropIndex, model.ActionDropPrimaryKey,
-			model.ActionDropTablePartition, model.ActionTruncateTablePartition, model.ActionDropColumn, model.ActionDropColumns, model.ActionModifyColumn:
+			model.ActionDropTablePartition, model.ActionTruncateTablePartition, model.ActionDropColumn, model.ActionDropColumns, model.ActionDropColumnsWithIndexes, model.ActionModifyColumn,
+			model.ActionReorganizePartition, model.ActionMultiSchemaChange:
 			err = w.deleteRange(w.ddlJobCtx, job)
 		}
 	}
@@ -633,7 +634,8 @@ func writeBinlog(binlogCli *pumpcli.PumpsClient, txn kv.Transaction, job *model.
 		// When this column is in the "delete only" and "delete reorg" states, the binlog of "drop column" has not been written yet,
 		// but the column has been removed from the binlog of the write operation.
 		// So we add this binlog to enable downstream components to handle DML correctly in this schema state.
-		((job.Type == model.ActionDropColumn || job.Type == model.ActionDropColumns) && job.SchemaState == model.StateDeleteOnly) {
+		((job.Type == model.ActionDropColumn || job.Type == model.ActionDropColumns || job.Type == model.ActionDropColumnsWithIndexes) &&
+			job.SchemaState == model.StateDeleteOnly) {
 		if skipWriteBinlog(job) {
 			return
 		}
@@ -767,14 +769,16 @@ func (w *worker) runDDLJob(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64,
 		ver, err = onTruncateTablePartition(d, t, job)
 	case model.ActionExchangeTablePartition:
 		ver, err = w.onExchangeTablePartition(d, t, job)
+	case model.ActionReorganizePartition:
+		ver, err = w.onReorganizePartition(d, t, job)
+	case model.ActionMultiSchemaChange:
+		ver, err = w.onMultiSchemaChange(d, t, job)
 	case model.ActionAddColumn:
-		ver, err = onAddColumn(d, t, job)
+		ver, err = w.onAddColumn(d, t, job)
 	case model.ActionAddColumns:
 		ver, err = onAddColumns(d, t, job)
-	case model.ActionDropColumn:
-		ver, err = onDropColumn(t, job)
-	case model.ActionDropColumns:
-		ver, err = onDropColumns(t, job)
+	case model.ActionDropColumn, model.ActionDropColumns, model.ActionDropColumnsWithIndexes:
+		ver, err = w.onDropColumnsWithIndexes(d, t, job)
 	case model.ActionModifyColumn:
 		ver, err = w.onModifyColumn(d, t, job)
 	case model.ActionSetDefaultValue:
@@ -831,6 +835,12 @@ func (w *worker) runDDLJob(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64,
 		ver, err = onRenameTables(d, t, job)
 	case model.ActionAlterTableAttributes:
 		ver, err = onAlterTableAttributes(t, job)
+	case model.ActionAddCheckConstraint:
+		ver, err = w.onAddCheckConstraint(t, job)
+	case model.ActionDropCheckConstraint:
+		ver, err = onDropCheckConstraint(t, job)
+	case model.ActionAlterCheckConstraint:
+		ver, err = w.onAlterCheckConstraint(t, job)
 	default:
 		// Invalid job, cancel it.
 		job.State = model.JobStateCancelled
@@ -953,6 +963,9 @@ func buildPlacementAffects(oldIDs []int64, newIDs []int64) []*model.AffectedOpti
 
 // updateSchemaVersion increments the schema version by 1 and sets SchemaDiff.
 func updateSchemaVersion(t *meta.Meta, job *model.Job) (int64, error) {
+	if ver, ok, err := genSubJobSchemaVersion(t, job); ok {
+		return ver, errors.Trace(err)
+	}
 	schemaVersion, err := t.GenSchemaVersion()
 	if err != nil {
 		return 0, errors.Trace(err)
@@ -291,7 +291,7 @@ func insertJobIntoDeleteRangeTable(ctx context.Context, sctx sessionctx.Context,
 		startKey = tablecodec.EncodeTablePrefix(tableID)
 		endKey := tablecodec.EncodeTablePrefix(tableID + 1)
 		return doInsert(ctx, s, job.ID, tableID, startKey, endKey, now)
-	case model.ActionDropTablePartition, model.ActionTruncateTablePartition:
+	case model.ActionDropTablePartition, model.ActionTruncateTablePartition, model.ActionReorganizePartition:
 		var physicalTableIDs []int64
 		if err := job.DecodeArgs(&physicalTableIDs); err != nil {
 			return errors.Trace(err)
@@ -363,7 +363,7 @@ func insertJobIntoDeleteRangeTable(ctx context.Context, sctx sessionctx.Context,
 				return doBatchDeleteIndiceRange(ctx, s, job.ID, job.TableID, indexIDs, now)
 			}
 		}
-	case model.ActionDropColumns:
+	case model.ActionDropColumns, model.ActionDropColumnsWithIndexes:
 		var colNames []model.CIStr
 		var ifExists []bool
 		var indexIDs []int64
@@ -399,6 +399,8 @@ func insertJobIntoDeleteRangeTable(ctx context.Context, sctx sessionctx.Context,
 				return errors.Trace(err)
 			}
 		}
+	case model.ActionMultiSchemaChange:
+		return insertSubJobsIntoDeleteRangeTable(ctx, sctx, job)
 	}
 	return nil
 }
@@ -31,6 +31,8 @@ var (
 	errCancelledDDLJob       = dbterror.ClassDDL.NewStd(mysql.ErrCancelledDDLJob)
 	errFileNotFound          = dbterror.ClassDDL.NewStd(mysql.ErrFileNotFound)
 	errRunMultiSchemaChanges = dbterror.ClassDDL.NewStdErr(mysql.ErrUnsupportedDDLOperation, parser_mysql.Message(fmt.Sprintf(mysql.MySQLErrName[mysql.ErrUnsupportedDDLOperation].Raw, "multi schema change"), nil))
+	errOperateSameColumn     = dbterror.ClassDDL.NewStdErr(mysql.ErrUnsupportedDDLOperation, parser_mysql.Message(fmt.Sprintf(mysql.MySQLErrName[mysql.ErrUnsupportedDDLOperation].Raw, "operate same column '%s'"), nil))
+	errOperateSameIndex      = dbterror.ClassDDL.NewStdErr(mysql.ErrUnsupportedDDLOperation, parser_mysql.Message(fmt.Sprintf(mysql.MySQLErrName[mysql.ErrUnsupportedDDLOperation].Raw, "operate same index '%s'"), nil))
 	errWaitReorgTimeout      = dbterror.ClassDDL.NewStdErr(mysql.ErrLockWaitTimeout, mysql.MySQLErrName[mysql.ErrWaitReorgTimeout])
 	errInvalidStoreVer       = dbterror.ClassDDL.NewStd(mysql.ErrInvalidStoreVersion)
 	// ErrRepairTableFail is used to repair tableInfo in repair mode.
@@ -177,6 +179,12 @@ var (
 	ErrPartitionMaxvalue = dbterror.ClassDDL.NewStd(mysql.ErrPartitionMaxvalue)
 	// ErrDropLastPartition returns cannot remove all partitions, use drop table instead.
 	ErrDropLastPartition = dbterror.ClassDDL.NewStd(mysql.ErrDropLastPartition)
+	// ErrReorgNoParam returns reorganize partition without parameters can only be used on hash partitions.
+	ErrReorgNoParam = dbterror.ClassDDL.NewStd(mysql.ErrReorgNoParam)
+	// ErrConsecutiveReorgPartitions returns the reorganized range partitions are not consecutive.
+	ErrConsecutiveReorgPartitions = dbterror.ClassDDL.NewStd(mysql.ErrConsecutiveReorgPartitions)
+	// ErrReorgOutsideRange returns the reorganized range partitions change the total range.
+	ErrReorgOutsideRange = dbterror.ClassDDL.NewStd(mysql.ErrReorgOutsideRange)
 	// ErrTooManyPartitions returns too many partitions were defined.
 	ErrTooManyPartitions = dbterror.ClassDDL.NewStd(mysql.ErrTooManyPartitions)
 	// ErrPartitionConstDomain returns partition constant is out of partition function domain.
@@ -294,4 +302,23 @@ var (
 	errFunctionalIndexOnJSONOrGeometryFunction = dbterror.ClassDDL.NewStd(mysql.ErrFunctionalIndexOnJSONOrGeometryFunction)
 	// errDependentByFunctionalIndex returns when the dropped column depends by expression index.
 	errDependentByFunctionalIndex = dbterror.ClassDDL.NewStd(mysql.ErrDependentByFunctionalIndex)
+
+	// errNonBooleanExprForCheckConstraint returns when the check constraint expression is not a boolean expression.
+	errNonBooleanExprForCheckConstraint = dbterror.ClassDDL.NewStd(mysql.ErrNonBooleanExprForCheckConstraint)
+	// errColumnCheckConstraintReferencesOtherColumn returns when the column check constraint refers to other columns.
+	errColumnCheckConstraintReferencesOtherColumn = dbterror.ClassDDL.NewStd(mysql.ErrColumnCheckConstraintReferencesOtherColumn)
+	// errCheckConstraintFunctionIsNotAllowed returns for the disallowed functions, variables and subqueries in check constraints.
+	errCheckConstraintFunctionIsNotAllowed = dbterror.ClassDDL.NewStd(mysql.ErrCheckConstraintFunctionIsNotAllowed)
+	// errCheckConstraintRowValue returns when the check constraint refers to a row value.
+	errCheckConstraintRowValue = dbterror.ClassDDL.NewStd(mysql.ErrCheckConstraintRowValue)
+	// errCheckConstraintRefersAutoIncrementColumn returns when the check constraint refers to an auto-increment column.
+	errCheckConstraintRefersAutoIncrementColumn = dbterror.ClassDDL.NewStd(mysql.ErrCheckConstraintRefersAutoIncrementColumn)
+	// errTableCheckConstraintReferUnknown returns when the check constraint refers to a non-existing column.
+	errTableCheckConstraintReferUnknown = dbterror.ClassDDL.NewStd(mysql.ErrTableCheckConstraintReferUnknown)
+	// errConstraintNotFound returns when the check constraint to be dropped or altered doesn't exist.
+	errConstraintNotFound = dbterror.ClassDDL.NewStd(mysql.ErrConstraintNotFound)
+	// errCheckConstraintDupName returns when the check constraint name is duplicated in the table.
+	errCheckConstraintDupName = dbterror.ClassDDL.NewStd(mysql.ErrCheckConstraintDupName)
+	// errDependentByCheckConstraint returns when the dropped or renamed column is used by a check constraint.
+	errDependentByCheckConstraint = dbterror.ClassDDL.NewStd(mysql.ErrDependentByCheckConstraint)
 )
@@ -310,6 +310,7 @@ func (c *illegalFunctionChecker) Leave(inNode ast.Node) (node ast.Node, ok bool)
 const (
 	typeColumn = iota
 	typeIndex
+	typeCheckConstraint
 )
 
 func checkIllegalFn4Generated(name string, genType int, expr ast.ExprNode) error {
@@ -324,6 +325,8 @@ func checkIllegalFn4Generated(name string, genType int, expr ast.ExprNode) error
 			return ErrGeneratedColumnFunctionIsNotAllowed.GenWithStackByArgs(name)
 		case typeIndex:
 			return ErrFunctionalIndexFunctionIsNotAllowed.GenWithStackByArgs(name)
+		case typeCheckConstraint:
+			return errCheckConstraintFunctionIsNotAllowed.GenWithStackByArgs(name)
 		}
 	}
 	if c.hasAggFunc {
@@ -335,6 +338,8 @@ func checkIllegalFn4Generated(name string, genType int, expr ast.ExprNode) error
 			return ErrGeneratedColumnRowValueIsNotAllowed.GenWithStackByArgs(name)
 		case typeIndex:
 			return ErrFunctionalIndexRowValueIsNotAllowed.GenWithStackByArgs(name)
+		case typeCheckConstraint:
+			return errCheckConstraintRowValue.GenWithStackByArgs(name)
 		}
 	}
 	if c.hasWindowFunc {
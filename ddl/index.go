@@ -23,7 +23,6 @@ import (
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/kvproto/pkg/kvrpcpb"
 	"github.com/pingcap/parser/ast"
-	"github.com/pingcap/parser/charset"
 	"github.com/pingcap/parser/model"
 	"github.com/pingcap/parser/mysql"
 	"github.com/pingcap/tidb/config"
@@ -38,6 +37,7 @@ import (
 	"github.com/pingcap/tidb/tablecodec"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util"
+	"github.com/pingcap/tidb/util/collate"
 	"github.com/pingcap/tidb/util/logutil"
 	decoder "github.com/pingcap/tidb/util/rowDecoder"
 	"github.com/pingcap/tidb/util/timeutil"
@@ -164,7 +164,7 @@ func checkIndexColumn(col *model.ColumnInfo, indexColumnLen int) error {
 	}
 
 	if types.IsString(col.FieldType.Tp) {
-		desc, err := charset.GetCharsetDesc(col.Charset)
+		desc, err := collate.GetCharsetDesc(col.Charset)
 		if err != nil {
 			return err
 		}
@@ -191,7 +191,7 @@ func getIndexColumnLength(col *model.ColumnInfo, colLen int) (int, error) {
 		return (length + 7) >> 3, nil
 	case mysql.TypeVarchar, mysql.TypeString, mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeBlob, mysql.TypeLongBlob:
 		// Different charsets occupy different numbers of bytes on each character.
-		desc, err := charset.GetCharsetDesc(col.Charset)
+		desc, err := collate.GetCharsetDesc(col.Charset)
 		if err != nil {
 			return 0, errUnsupportedCharset.GenWithStackByArgs(col.Charset, col.Collate)
 		}
@@ -547,39 +547,13 @@ func (w *worker) onCreateIndex(d *ddlCtx, t *meta.Meta, job *model.Job, isPK boo
 			return ver, errors.Trace(err)
 		}
 
-		elements := []*meta.Element{{ID: indexInfo.ID, TypeKey: meta.IndexElementKey}}
-		reorgInfo, err := getReorgInfo(d, t, job, tbl, elements)
-		if err != nil || reorgInfo.first {
-			// If we run reorg firstly, we should update the job snapshot version
-			// and then run the reorg next time.
-			return ver, errors.Trace(err)
-		}
-
-		err = w.runReorgJob(t, reorgInfo, tbl.Meta(), d.lease, func() (addIndexErr error) {
-			defer util.Recover(metrics.LabelDDL, "onCreateIndex",
-				func() {
-					addIndexErr = errCancelledDDLJob.GenWithStack("add table `%v` index `%v` panic", tblInfo.Name, indexInfo.Name)
-				}, false)
-			return w.addTableIndex(tbl, indexInfo, reorgInfo)
+		var done bool
+		done, ver, err = doReorgWorkInSubJob(job, func() (bool, int64, error) {
+			return doReorgWorkForCreateIndex(w, d, t, job, tbl, indexInfo)
 		})
-		if err != nil {
-			if errWaitReorgTimeout.Equal(err) {
-				// if timeout, we should return, check for the owner and re-wait job done.
-				return ver, nil
-			}
-			if kv.ErrKeyExists.Equal(err) || errCancelledDDLJob.Equal(err) || errCantDecodeRecord.Equal(err) {
-				logutil.BgLogger().Warn("[ddl] run add index job failed, convert job to rollback", zap.String("job", job.String()), zap.Error(err))
-				ver, err = convertAddIdxJob2RollbackJob(t, job, tblInfo, indexInfo, err)
-				if err1 := t.RemoveDDLReorgHandle(job, reorgInfo.elements); err1 != nil {
-					logutil.BgLogger().Warn("[ddl] run add index job failed, convert job to rollback, RemoveDDLReorgHandle failed", zap.String("job", job.String()), zap.Error(err1))
-				}
-			}
-			// Clean up the channel of notifyCancelReorgJob. Make sure it can't affect other jobs.
-			w.reorgCtx.cleanNotifyReorgCancel()
-			return ver, errors.Trace(err)
+		if !done {
+			return ver, err
 		}
-		// Clean up the channel of notifyCancelReorgJob. Make sure it can't affect other jobs.
-		w.reorgCtx.cleanNotifyReorgCancel()
 
 		indexInfo.State = model.StatePublic
 		// Set column index flag.
@@ -602,6 +576,45 @@ func (w *worker) onCreateIndex(d *ddlCtx, t *meta.Meta, job *model.Job, isPK boo
 	return ver, errors.Trace(err)
 }
 
+// doReorgWorkForCreateIndex backfills the index. It returns true if the backfilling is done.
+func doReorgWorkForCreateIndex(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job,
+	tbl table.Table, indexInfo *model.IndexInfo) (done bool, ver int64, err error) {
+	elements := []*meta.Element{{ID: indexInfo.ID, TypeKey: meta.IndexElementKey}}
+	reorgInfo, err := getReorgInfo(d, t, job, tbl, elements)
+	if err != nil || reorgInfo.first {
+		// If we run reorg firstly, we should update the job snapshot version
+		// and then run the reorg next time.
+		return false, ver, errors.Trace(err)
+	}
+
+	err = w.runReorgJob(t, reorgInfo, tbl.Meta(), d.lease, func() (addIndexErr error) {
+		defer util.Recover(metrics.LabelDDL, "onCreateIndex",
+			func() {
+				addIndexErr = errCancelledDDLJob.GenWithStack("add table `%v` index `%v` panic", tbl.Meta().Name, indexInfo.Name)
+			}, false)
+		return w.addTableIndex(tbl, indexInfo, reorgInfo)
+	})
+	if err != nil {
+		if errWaitReorgTimeout.Equal(err) {
+			// if timeout, we should return, check for the owner and re-wait job done.
+			return false, ver, nil
+		}
+		if kv.ErrKeyExists.Equal(err) || errCancelledDDLJob.Equal(err) || errCantDecodeRecord.Equal(err) {
+			logutil.BgLogger().Warn("[ddl] run add index job failed, convert job to rollback", zap.String("job", job.String()), zap.Error(err))
+			ver, err = convertAddIdxJob2RollbackJob(t, job, tbl.Meta(), indexInfo, err)
+			if err1 := t.RemoveDDLReorgHandle(job, reorgInfo.elements); err1 != nil {
+				logutil.BgLogger().Warn("[ddl] run add index job failed, convert job to rollback, RemoveDDLReorgHandle failed", zap.String("job", job.String()), zap.Error(err1))
+			}
+		}
+		// Clean up the channel of notifyCancelReorgJob. Make sure it can't affect other jobs.
+		w.reorgCtx.cleanNotifyReorgCancel()
+		return false, ver, errors.Trace(err)
+	}
+	// Clean up the channel of notifyCancelReorgJob. Make sure it can't affect other jobs.
+	w.reorgCtx.cleanNotifyReorgCancel()
+	return true, ver, nil
+}
+
 func onDropIndex(t *meta.Meta, job *model.Job) (ver int64, _ error) {
 	tblInfo, indexInfo, err := checkDropIndex(t, job)
 	if err != nil {
@@ -903,7 +916,12 @@ func (w *baseIndexWorker) getIndexRecord(idxInfo *model.IndexInfo, handle kv.Han
 			idxVal[j] = idxColumnVal
 			continue
 		}
-		idxColumnVal, err = tables.GetColDefaultValue(w.sessCtx, col, w.defaultVals)
+		if col.State != model.StatePublic {
+			// The column is added in the same multi-schema change, the row doesn't store it yet.
+			idxColumnVal, err = table.GetColOriginDefaultValue(w.sessCtx, col.ToInfo())
+		} else {
+			idxColumnVal, err = tables.GetColDefaultValue(w.sessCtx, col, w.defaultVals)
+		}
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
@@ -1201,6 +1219,66 @@ func (w *worker) addTableIndex(t table.Table, idx *model.IndexInfo, reorgInfo *r
 	return errors.Trace(err)
 }
 
+// addTableIndexes handles the add index reorganization state for several indexes of a table.
+// The indexes are backfilled one by one, each of them is an element of the reorgInfo.
+func (w *worker) addTableIndexes(t table.Table, idxes []*model.IndexInfo, reorgInfo *reorgInfo) error {
+	startElementOffset := 0
+	for i, idx := range idxes {
+		if reorgInfo.currElement.ID == idx.ID {
+			startElementOffset = i
+			break
+		}
+	}
+
+	for i := startElementOffset; i < len(idxes); i++ {
+		if i > startElementOffset {
+			// The rest indexes are backfilled from the first physical table.
+			if err := w.resetReorgInfoForElement(t, reorgInfo, reorgInfo.elements[i]); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		if err := w.addTableIndex(t, idxes[i], reorgInfo); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// resetReorgInfoForElement makes the reorgInfo start to handle the element from the first physical table.
+func (w *worker) resetReorgInfoForElement(t table.Table, reorgInfo *reorgInfo, element *meta.Element) error {
+	currentVer, err := getValidCurrentVersion(reorgInfo.d.store)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	pid := t.Meta().ID
+	var tb table.PhysicalTable
+	if pi := t.Meta().GetPartitionInfo(); pi != nil {
+		pid = pi.Definitions[0].ID
+		tb = t.(table.PartitionedTable).GetPartition(pid)
+	} else {
+		tb = t.(table.PhysicalTable)
+	}
+	start, end, err := getTableRange(reorgInfo.d, tb, currentVer.Ver, reorgInfo.Job.Priority)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	reorgInfo.StartKey, reorgInfo.EndKey, reorgInfo.PhysicalTableID = start, end, pid
+
+	// Update the element in the reorgCtx to keep the atomic access for daemon-worker.
+	w.reorgCtx.setCurrentElement(element)
+	reorgInfo.currElement = element
+	// Write the reorg info to store so the whole reorganize process can recover from panic.
+	err = reorgInfo.UpdateReorgMeta(start)
+	logutil.BgLogger().Info("[ddl] update reorg element",
+		zap.Int64("jobID", reorgInfo.Job.ID),
+		zap.ByteString("elementType", element.TypeKey),
+		zap.Int64("elementID", element.ID),
+		zap.Int64("physicalTableID", pid),
+		zap.String("startHandle", tryDecodeToHandleString(start)),
+		zap.String("endHandle", tryDecodeToHandleString(end)))
+	return errors.Trace(err)
+}
+
 // updateReorgInfo will find the next partition according to current reorgInfo.
 // If no more partitions, or table t is not a partitioned table, returns true to
 // indicate that the reorganize work is finished.
@@ -1406,8 +1484,9 @@ func (w *worker) updateReorgInfoForPartitions(t table.PartitionedTable, reorg *r
 			if i == len(partitionIDs)-1 {
 				return true, nil
 			}
+			pid = partitionIDs[i+1]
+			break
 		}
-		pid = partitionIDs[i+1]
 	}
 
 	currentVer, err := getValidCurrentVersion(reorg.d.store)
@@ -0,0 +1,705 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/model"
+	"github.com/pingcap/parser/mysql"
+	"github.com/pingcap/tidb/meta"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/table/tables"
+	"github.com/pingcap/tidb/util/logutil"
+	"go.uber.org/zap"
+)
+
+// SubJob is a schema change of a multi-schema change job.
+type SubJob struct {
+	Type        model.ActionType  `json:"type"`
+	RawArgs     json.RawMessage   `json:"raw_args"`
+	SchemaState model.SchemaState `json:"schema_state"`
+	SnapshotVer uint64            `json:"snapshot_ver"`
+	RowCount    int64             `json:"row_count"`
+	State       model.JobState    `json:"state"`
+	// Revertible is false once the sub-job can't be rolled back anymore. A revertible sub-job
+	// becomes non-revertible when it is ready for its final state change.
+	Revertible bool `json:"revertible"`
+}
+
+// MultiSchemaInfo is the argument of a multi-schema change job.
+type MultiSchemaInfo struct {
+	SubJobs []*SubJob `json:"sub_jobs"`
+	// Revertible is false once all the sub-jobs are non-revertible. After that, the sub-jobs
+	// change their states together, and the job can't be cancelled.
+	Revertible bool `json:"revertible"`
+	// schemaVer is the schema version generated in the current step. All the sub-jobs running in
+	// the step share it, so their schema changes become visible at the same time.
+	schemaVer int64
+}
+
+// toProxyJob builds a job which runs the sub-job by the handler of its type.
+func (sub *SubJob) toProxyJob(job *model.Job, info *MultiSchemaInfo) *model.Job {
+	return &model.Job{
+		ID:          job.ID,
+		Type:        sub.Type,
+		SchemaID:    job.SchemaID,
+		TableID:     job.TableID,
+		SchemaName:  job.SchemaName,
+		State:       sub.State,
+		Error:       job.Error,
+		RowCount:    sub.RowCount,
+		RawArgs:     sub.RawArgs,
+		SchemaState: sub.SchemaState,
+		SnapshotVer: sub.SnapshotVer,
+		RealStartTS: job.RealStartTS,
+		StartTS:     job.StartTS,
+		Query:       job.Query,
+		BinlogInfo:  &model.HistoryInfo{},
+		Version:     job.Version,
+		ReorgMeta:   job.ReorgMeta,
+		Priority:    job.Priority,
+		CtxVars:     []interface{}{sub, info},
+	}
+}
+
+// updateByProxyJob saves the progress of the proxy job into the sub-job.
+func (sub *SubJob) updateByProxyJob(proxy *model.Job) error {
+	if proxy.Args != nil {
+		rawArgs, err := json.Marshal(proxy.Args)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		sub.RawArgs = rawArgs
+	}
+	sub.SchemaState = proxy.SchemaState
+	sub.SnapshotVer = proxy.SnapshotVer
+	sub.RowCount = proxy.GetRowCount()
+	sub.State = proxy.State
+	return nil
+}
+
+// isStarted returns true if the sub-job has been run.
+func (sub *SubJob) isStarted() bool {
+	return sub.State != model.JobStateNone
+}
+
+// isFinished returns true if the sub-job won't change its state anymore.
+func (sub *SubJob) isFinished() bool {
+	return sub.State == model.JobStateDone || sub.State == model.JobStateRollbackDone ||
+		sub.State == model.JobStateCancelled
+}
+
+// subJobOf returns the sub-job the job runs for, or nil if the job isn't a proxy job.
+func subJobOf(job *model.Job) *SubJob {
+	if len(job.CtxVars) == 0 {
+		return nil
+	}
+	sub, _ := job.CtxVars[0].(*SubJob)
+	return sub
+}
+
+// genSubJobSchemaVersion returns the schema version of the current step if the job is a sub-job.
+// The version and its schema diff are generated for the multi-schema change job by the first
+// sub-job which changes the schema in the step.
+func genSubJobSchemaVersion(t *meta.Meta, job *model.Job) (ver int64, ok bool, err error) {
+	if subJobOf(job) == nil {
+		return 0, false, nil
+	}
+	info := job.CtxVars[1].(*MultiSchemaInfo)
+	if info.schemaVer != 0 {
+		return info.schemaVer, true, nil
+	}
+	ver, err = t.GenSchemaVersion()
+	if err != nil {
+		return 0, true, errors.Trace(err)
+	}
+	diff := &model.SchemaDiff{
+		Version:  ver,
+		Type:     model.ActionMultiSchemaChange,
+		SchemaID: job.SchemaID,
+		TableID:  job.TableID,
+	}
+	if err = t.SetSchemaDiff(diff); err != nil {
+		return 0, true, errors.Trace(err)
+	}
+	info.schemaVer = ver
+	return ver, true, nil
+}
+
+// pauseRevertibleSubJob returns true if the job is a revertible sub-job which has to stop before
+// its final state change. The sub-job becomes non-revertible, and its final state change is done
+// together with the other sub-jobs.
+func pauseRevertibleSubJob(job *model.Job) bool {
+	sub := subJobOf(job)
+	if sub == nil || !sub.Revertible {
+		return false
+	}
+	sub.Revertible = false
+	return true
+}
+
+// doReorgWorkInSubJob runs the reorganization of a sub-job. The reorganization is done while the
+// sub-job is revertible, then the sub-job pauses until the others are ready.
+func doReorgWorkInSubJob(job *model.Job, reorg func() (bool, int64, error)) (done bool, ver int64, err error) {
+	sub := subJobOf(job)
+	if sub == nil {
+		return reorg()
+	}
+	if !sub.Revertible {
+		// The reorganization has been done before the sub-job paused.
+		return true, ver, nil
+	}
+	done, ver, err = reorg()
+	if done {
+		sub.Revertible = false
+		done = false
+	}
+	return done, ver, err
+}
+
+func isRevertibleSubJobType(tp model.ActionType) bool {
+	switch tp {
+	case model.ActionAddColumn, model.ActionAddColumns, model.ActionAddIndex, model.ActionAddPrimaryKey,
+		model.ActionModifyColumn, model.ActionDropColumn, model.ActionDropColumns, model.ActionDropColumnsWithIndexes:
+		return true
+	}
+	return false
+}
+
+// subJobOrder returns the order of the sub-job type in the multi-schema change job. The revertible
+// sub-jobs run one by one in this order, and the sub-jobs change to their final states in the
+// reverse order. So the columns and indices appended by a later sub-job are always removed or
+// adjusted before the ones appended by an earlier sub-job.
+func subJobOrder(tp model.ActionType) int {
+	switch tp {
+	case model.ActionAddColumn, model.ActionAddColumns:
+		return 1
+	case model.ActionAddIndex, model.ActionAddPrimaryKey:
+		return 2
+	case model.ActionModifyColumn:
+		return 3
+	}
+	return 0
+}
+
+// multiSchemaChangeCollector collects the jobs built for the specs of an ALTER TABLE statement,
+// instead of running them one by one.
+type multiSchemaChangeCollector struct {
+	// table is a copy of the altered table, with the columns and indices added by the collected jobs.
+	// So the later specs can refer to them.
+	table table.Table
+	jobs  []*model.Job
+}
+
+func newMultiSchemaChangeCollector(t table.Table) (*multiSchemaChangeCollector, error) {
+	working, err := tables.TableFromMeta(t.Allocators(nil), t.Meta().Clone())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &multiSchemaChangeCollector{table: working}, nil
+}
+
+// addJob collects the job built for a spec, or returns the error of building it. The job is nil if the
+// spec doesn't change anything.
+func (mc *multiSchemaChangeCollector) addJob(job *model.Job, err error) error {
+	if err != nil || job == nil {
+		return err
+	}
+	tblInfo := mc.table.Meta()
+	switch job.Type {
+	case model.ActionAddColumn:
+		col := job.Args[0].(*table.Column)
+		mc.addColumn(col.ColumnInfo)
+	case model.ActionAddColumns:
+		for _, col := range job.Args[0].([]*table.Column) {
+			mc.addColumn(col.ColumnInfo)
+		}
+	case model.ActionAddIndex, model.ActionAddPrimaryKey:
+		indexName := job.Args[1].(model.CIStr)
+		indexPartSpecifications := job.Args[2].([]*ast.IndexPartSpecification)
+		indexInfo, err := buildIndexInfo(tblInfo, indexName, indexPartSpecifications, model.StatePublic)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		indexInfo.ID = allocateIndexID(tblInfo)
+		indexInfo.Unique = job.Args[0].(bool)
+		indexInfo.Primary = job.Type == model.ActionAddPrimaryKey
+		tblInfo.Indices = append(tblInfo.Indices, indexInfo)
+	}
+	t, err := tables.TableFromMeta(mc.table.Allocators(nil), tblInfo)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	mc.table = t
+	mc.jobs = append(mc.jobs, job)
+	return nil
+}
+
+func (mc *multiSchemaChangeCollector) addColumn(col *model.ColumnInfo) {
+	tblInfo := mc.table.Meta()
+	colInfo := col.Clone()
+	colInfo.ID = allocateColumnID(tblInfo)
+	colInfo.Offset = len(tblInfo.Columns)
+	colInfo.State = model.StatePublic
+	tblInfo.Columns = append(tblInfo.Columns, colInfo)
+}
+
+// multiSchemaChangeChecker checks the specs of a multi-schema change. Each column or index can be
+// changed by one spec only, and a spec can't depend on the column changed by another spec, except
+// that an index can be built on a new column.
+type multiSchemaChangeChecker struct {
+	tblInfo        *model.TableInfo
+	changedColumns map[string]struct{}
+	// newColumns contains the added columns which keep the position at the end of the table.
+	newColumns     map[string]struct{}
+	changedIndices map[string]struct{}
+	indexColumns   []*ast.ColumnName
+	droppedColumns map[string]struct{}
+	// rebuiltIndices contains the composite indexes covering the dropped columns.
+	rebuiltIndices map[string]*model.IndexInfo
+	positions      []*ast.ColumnPosition
+	// appendColumns is the number of the specs which may append columns to the table.
+	appendColumns int
+	moveColumn    bool
+}
+
+func (c *multiSchemaChangeChecker) changeColumn(name model.CIStr) error {
+	if _, ok := c.changedColumns[name.L]; ok {
+		return errOperateSameColumn.GenWithStackByArgs(name.O)
+	}
+	c.changedColumns[name.L] = struct{}{}
+	return nil
+}
+
+func (c *multiSchemaChangeChecker) changeIndex(name string) error {
+	if len(name) == 0 {
+		// The anonymous index gets a name which isn't used by others.
+		return nil
+	}
+	lowerName := model.NewCIStr(name).L
+	if _, ok := c.changedIndices[lowerName]; ok {
+		return errOperateSameIndex.GenWithStackByArgs(name)
+	}
+	c.changedIndices[lowerName] = struct{}{}
+	return nil
+}
+
+func (c *multiSchemaChangeChecker) addPosition(pos *ast.ColumnPosition) {
+	if pos != nil && pos.Tp != ast.ColumnPositionNone {
+		c.positions = append(c.positions, pos)
+	}
+}
+
+func (c *multiSchemaChangeChecker) checkSpec(spec *ast.AlterTableSpec) error {
+	switch spec.Tp {
+	case ast.AlterTableAddColumns:
+		c.appendColumns++
+		for _, col := range spec.NewColumns {
+			if err := c.changeColumn(col.Name.Name); err != nil {
+				return err
+			}
+			if spec.Position == nil || spec.Position.Tp == ast.ColumnPositionNone {
+				c.newColumns[col.Name.Name.L] = struct{}{}
+			}
+		}
+		c.addPosition(spec.Position)
+	case ast.AlterTableDropColumn:
+		if err := c.changeColumn(spec.OldColumnName.Name); err != nil {
+			return err
+		}
+		return c.checkDropColumn(spec.OldColumnName.Name)
+	case ast.AlterTableModifyColumn, ast.AlterTableChangeColumn:
+		// The check constraints of a modified column are added after the column is modified, which
+		// can't be done within the job.
+		if containsColumnOption(spec.NewColumns[0], ast.ColumnOptionCheck) {
+			return errRunMultiSchemaChanges
+		}
+		c.appendColumns++
+		oldName := spec.NewColumns[0].Name.Name
+		if spec.Tp == ast.AlterTableChangeColumn {
+			oldName = spec.OldColumnName.Name
+		}
+		if err := c.changeColumn(oldName); err != nil {
+			return err
+		}
+		if newName := spec.NewColumns[0].Name.Name; newName.L != oldName.L {
+			if err := c.changeColumn(newName); err != nil {
+				return err
+			}
+		}
+		if spec.Position != nil && spec.Position.Tp != ast.ColumnPositionNone {
+			c.moveColumn = true
+		}
+		c.addPosition(spec.Position)
+	case ast.AlterTableRenameColumn:
+		if err := c.changeColumn(spec.OldColumnName.Name); err != nil {
+			return err
+		}
+		return c.changeColumn(spec.NewColumnName.Name)
+	case ast.AlterTableAlterColumn:
+		return c.changeColumn(spec.NewColumns[0].Name.Name)
+	case ast.AlterTableAddConstraint:
+		switch spec.Constraint.Tp {
+		case ast.ConstraintKey, ast.ConstraintIndex, ast.ConstraintUniq, ast.ConstraintUniqIndex, ast.ConstraintUniqKey:
+			if err := c.changeIndex(spec.Constraint.Name); err != nil {
+				return err
+			}
+		case ast.ConstraintPrimaryKey:
+			if err := c.changeIndex(mysql.PrimaryKeyName); err != nil {
+				return err
+			}
+		default:
+			return errRunMultiSchemaChanges
+		}
+		for _, key := range spec.Constraint.Keys {
+			if key.Expr != nil {
+				// The expression index adds hidden columns, which isn't supported together with other changes.
+				return errRunMultiSchemaChanges
+			}
+			c.indexColumns = append(c.indexColumns, key.Column)
+		}
+	case ast.AlterTableDropIndex:
+		if err := c.changeIndex(spec.Name); err != nil {
+			return err
+		}
+		return c.checkDropIndex(spec.Name)
+	case ast.AlterTableDropPrimaryKey:
+		if err := c.changeIndex(mysql.PrimaryKeyName); err != nil {
+			return err
+		}
+		return c.checkDropIndex(mysql.PrimaryKeyName)
+	case ast.AlterTableRenameIndex:
+		if err := c.changeIndex(spec.FromKey.O); err != nil {
+			return err
+		}
+		return c.changeIndex(spec.ToKey.O)
+	case ast.AlterTableIndexInvisible:
+		return c.changeIndex(spec.IndexName.O)
+	default:
+		return errRunMultiSchemaChanges
+	}
+	return nil
+}
+
+// checkDropColumn checks the composite indexes rebuilt without the dropped column aren't changed by other specs.
+// The dropped columns share the rebuilt indexes, since they are dropped by one job.
+func (c *multiSchemaChangeChecker) checkDropColumn(name model.CIStr) error {
+	c.droppedColumns[name.L] = struct{}{}
+	for _, indexInfo := range c.tblInfo.Indices {
+		if len(indexInfo.Columns) < 2 || !isColumnWithIndex(name.L, []*model.IndexInfo{indexInfo}) {
+			continue
+		}
+		if _, ok := c.rebuiltIndices[indexInfo.Name.L]; ok {
+			continue
+		}
+		if err := c.changeIndex(indexInfo.Name.O); err != nil {
+			return err
+		}
+		c.rebuiltIndices[indexInfo.Name.L] = indexInfo
+	}
+	return nil
+}
+
+// checkDropIndex checks the dropped index doesn't contain the changed columns. For example, dropping
+// a column may drop the index too.
+func (c *multiSchemaChangeChecker) checkDropIndex(name string) error {
+	indexInfo := c.tblInfo.FindIndexByName(model.NewCIStr(name).L)
+	if indexInfo == nil {
+		return nil
+	}
+	for _, col := range indexInfo.Columns {
+		c.indexColumns = append(c.indexColumns, &ast.ColumnName{Name: col.Name})
+	}
+	return nil
+}
+
+func (c *multiSchemaChangeChecker) check() error {
+	for _, col := range c.indexColumns {
+		if _, ok := c.changedColumns[col.Name.L]; !ok {
+			continue
+		}
+		if _, ok := c.newColumns[col.Name.L]; !ok {
+			return errOperateSameColumn.GenWithStackByArgs(col.Name.O)
+		}
+	}
+	for _, indexInfo := range c.rebuiltIndices {
+		for _, col := range indexInfo.Columns {
+			if _, ok := c.droppedColumns[col.Name.L]; ok {
+				continue
+			}
+			if _, ok := c.changedColumns[col.Name.L]; ok {
+				return errOperateSameColumn.GenWithStackByArgs(col.Name.O)
+			}
+		}
+	}
+	for _, pos := range c.positions {
+		if pos.Tp != ast.ColumnPositionAfter {
+			continue
+		}
+		if _, ok := c.changedColumns[pos.RelativeColumn.Name.L]; ok {
+			return errOperateSameColumn.GenWithStackByArgs(pos.RelativeColumn.Name.O)
+		}
+	}
+	// A modified column may be appended to the table before it's moved to the specified position,
+	// which can't be done correctly together with the other appended columns.
+	if c.moveColumn && c.appendColumns > 1 {
+		return errRunMultiSchemaChanges
+	}
+	return nil
+}
+
+func checkMultiSchemaSpecs(tblInfo *model.TableInfo, specs []*ast.AlterTableSpec) error {
+	c := &multiSchemaChangeChecker{
+		tblInfo:        tblInfo,
+		changedColumns: make(map[string]struct{}),
+		newColumns:     make(map[string]struct{}),
+		changedIndices: make(map[string]struct{}),
+		droppedColumns: make(map[string]struct{}),
+		rebuiltIndices: make(map[string]*model.IndexInfo),
+	}
+	for _, spec := range specs {
+		if err := c.checkSpec(spec); err != nil {
+			return err
+		}
+	}
+	return c.check()
+}
+
+func isAbnormalJobState(state model.JobState) bool {
+	switch state {
+	case model.JobStateCancelling, model.JobStateCancelled, model.JobStateRollingback, model.JobStateRollbackDone:
+		return true
+	}
+	return false
+}
+
+func (w *worker) onMultiSchemaChange(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+	info := &MultiSchemaInfo{}
+	if err = job.DecodeArgs(info); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	if job.IsRollingback() {
+		return w.rollbackMultiSchemaChange(d, t, job, info)
+	}
+
+	if info.Revertible {
+		// Run the revertible sub-jobs one by one, until each of them is ready for the final state change.
+		for _, sub := range info.SubJobs {
+			if !sub.Revertible || sub.isFinished() {
+				continue
+			}
+			started := false
+			for _, s := range info.SubJobs {
+				if s.isStarted() {
+					started = true
+					break
+				}
+			}
+			proxy := sub.toProxyJob(job, info)
+			ver, err = w.runDDLJob(d, t, proxy)
+			if isAbnormalJobState(proxy.State) {
+				if err1 := sub.updateByProxyJob(proxy); err1 != nil {
+					return ver, errors.Trace(err1)
+				}
+				if proxy.State == model.JobStateCancelled && !started {
+					job.State = model.JobStateCancelled
+				} else {
+					job.State = model.JobStateRollingback
+				}
+				if err == nil {
+					err = errCancelledDDLJob
+					if proxy.Error != nil {
+						err = proxy.Error
+					}
+				}
+				logutil.Logger(w.logCtx).Info("[ddl] multi-schema change sub-job failed, roll back the job",
+					zap.String("job", job.String()), zap.String("subJob", proxy.String()), zap.Error(err))
+				return ver, errors.Trace(err)
+			}
+			if err != nil {
+				return ver, errors.Trace(err)
+			}
+			if err = sub.updateByProxyJob(proxy); err != nil {
+				return ver, errors.Trace(err)
+			}
+			job.SchemaState = proxy.SchemaState
+			job.SetRowCount(proxy.GetRowCount())
+			return ver, nil
+		}
+		info.Revertible = false
+	}
+
+	// All the sub-jobs are non-revertible. Each of them changes to its next state in this step,
+	// so the final states become visible at the same time.
+	proxies := make([]*model.Job, len(info.SubJobs))
+	for i := len(info.SubJobs) - 1; i >= 0; i-- {
+		sub := info.SubJobs[i]
+		if sub.isFinished() {
+			continue
+		}
+		proxy := sub.toProxyJob(job, info)
+		var subVer int64
+		subVer, err = w.runDDLJob(d, t, proxy)
+		if err == nil && isAbnormalJobState(proxy.State) {
+			err = errors.Errorf("the non-revertible sub-job is in the state %s", proxy.State)
+		}
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		if subVer > ver {
+			ver = subVer
+		}
+		proxies[i] = proxy
+	}
+	done := true
+	for i, proxy := range proxies {
+		if proxy == nil {
+			continue
+		}
+		sub := info.SubJobs[i]
+		if err = sub.updateByProxyJob(proxy); err != nil {
+			return ver, errors.Trace(err)
+		}
+		if !sub.isFinished() {
+			done = false
+		}
+	}
+	if done {
+		tblInfo, err := getTableInfo(t, job.TableID, job.SchemaID)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+	}
+	return ver, nil
+}
+
+// rollbackMultiSchemaChange rolls back the sub-jobs in the reverse order, one at a time.
+func (w *worker) rollbackMultiSchemaChange(d *ddlCtx, t *meta.Meta, job *model.Job, info *MultiSchemaInfo) (ver int64, err error) {
+	for i := len(info.SubJobs) - 1; i >= 0; i-- {
+		sub := info.SubJobs[i]
+		if sub.isFinished() {
+			continue
+		}
+		if !sub.isStarted() {
+			sub.State = model.JobStateCancelled
+			continue
+		}
+		proxy := sub.toProxyJob(job, info)
+		converted := false
+		if sub.State == model.JobStateRunning {
+			converted, ver, err = w.convertSubJob2RollbackJob(t, proxy, sub)
+		}
+		if !converted && err == nil {
+			ver, err = w.runDDLJob(d, t, proxy)
+		}
+		if err1 := sub.updateByProxyJob(proxy); err1 != nil {
+			return ver, errors.Trace(err1)
+		}
+		return ver, errors.Trace(err)
+	}
+
+	for _, sub := range info.SubJobs {
+		if sub.State == model.JobStateRollbackDone {
+			tblInfo, err := getTableInfo(t, job.TableID, job.SchemaID)
+			if err != nil {
+				return ver, errors.Trace(err)
+			}
+			job.FinishTableJob(model.JobStateRollbackDone, model.StateNone, ver, tblInfo)
+			return ver, nil
+		}
+	}
+	job.State = model.JobStateCancelled
+	job.SchemaState = model.StateNone
+	return ver, nil
+}
+
+// convertSubJob2RollbackJob prepares a running sub-job for rolling back. It returns true if the
+// sub-job has been converted to a rolling back job in this step.
+func (w *worker) convertSubJob2RollbackJob(t *meta.Meta, proxy *model.Job, sub *SubJob) (converted bool, ver int64, err error) {
+	if !sub.Revertible {
+		// The sub-job has paused before its final state change.
+		switch sub.Type {
+		case model.ActionAddIndex, model.ActionAddPrimaryKey:
+			ver, err = convertNotStartAddIdxJob2RollbackJob(t, proxy, errCancelledDDLJob)
+			if errCancelledDDLJob.Equal(err) {
+				err = nil
+			}
+			return true, ver, errors.Trace(err)
+		case model.ActionModifyColumn:
+			if proxy.SchemaState != model.StateNone {
+				// The data has been reorganized, remove the changing column and indices.
+				proxy.State = model.JobStateRollingback
+				return false, ver, nil
+			}
+		}
+	}
+	proxy.State = model.JobStateCancelling
+	return false, ver, nil
+}
+
+// rollingbackMultiSchemaChange cancels the multi-schema change job if it's still revertible.
+func rollingbackMultiSchemaChange(job *model.Job) (ver int64, err error) {
+	info := &MultiSchemaInfo{}
+	if err = job.DecodeArgs(info); err != nil {
+		return ver, errors.Trace(err)
+	}
+	if !info.Revertible {
+		// The sub-jobs are changing to their final states, the job can't be cancelled now.
+		job.State = model.JobStateRunning
+		return ver, nil
+	}
+	for _, sub := range info.SubJobs {
+		if sub.isStarted() {
+			job.State = model.JobStateRollingback
+			return ver, errCancelledDDLJob
+		}
+	}
+	job.State = model.JobStateCancelled
+	return ver, errCancelledDDLJob
+}
+
+// insertSubJobsIntoDeleteRangeTable inserts the delete ranges of the finished sub-jobs.
+func insertSubJobsIntoDeleteRangeTable(ctx context.Context, sctx sessionctx.Context, job *model.Job) error {
+	info := &MultiSchemaInfo{}
+	if err := job.DecodeArgs(info); err != nil {
+		return errors.Trace(err)
+	}
+	for _, sub := range info.SubJobs {
+		switch sub.Type {
+		case model.ActionAddIndex, model.ActionAddPrimaryKey:
+			if sub.State != model.JobStateRollbackDone {
+				continue
+			}
+		case model.ActionDropIndex, model.ActionDropPrimaryKey, model.ActionDropColumn, model.ActionDropColumns, model.ActionDropColumnsWithIndexes,
+			model.ActionModifyColumn:
+			if sub.State != model.JobStateDone && sub.State != model.JobStateRollbackDone {
+				continue
+			}
+		default:
+			continue
+		}
+		if err := insertJobIntoDeleteRangeTable(ctx, sctx, sub.toProxyJob(job, info)); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
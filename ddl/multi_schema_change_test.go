@@ -0,0 +1,197 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl_test
+
+import (
+	"time"
+
+	. "github.com/pingcap/check"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/parser/model"
+	"github.com/pingcap/tidb/ddl"
+	"github.com/pingcap/tidb/domain"
+	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/session"
+	"github.com/pingcap/tidb/store/mockstore"
+	"github.com/pingcap/tidb/util/testkit"
+)
+
+var _ = Suite(&testMultiSchemaChangeSuite{})
+
+type testMultiSchemaChangeSuite struct {
+	store kv.Storage
+	dom   *domain.Domain
+}
+
+func (s *testMultiSchemaChangeSuite) SetUpSuite(c *C) {
+	var err error
+	ddl.SetWaitTimeWhenErrorOccurred(1 * time.Microsecond)
+	s.store, err = mockstore.NewMockStore()
+	c.Assert(err, IsNil)
+	s.dom, err = session.BootstrapSession(s.store)
+	c.Assert(err, IsNil)
+}
+
+func (s *testMultiSchemaChangeSuite) TearDownSuite(c *C) {
+	s.dom.Close()
+	c.Assert(s.store.Close(), IsNil)
+}
+
+func (s *testMultiSchemaChangeSuite) TestMultiSchemaChange(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (a int, b int, c int, index ia(a))")
+	tk.MustExec("insert into t values (1, 1, 1), (2, 2, 2)")
+
+	tk.MustExec("alter table t add column d int default 5, add index id(d), modify column b bigint, drop index ia, rename column c to c1")
+	tk.MustQuery("show create table t").Check(testkit.Rows("t CREATE TABLE `t` (\n" +
+		"  `a` int(11) DEFAULT NULL,\n" +
+		"  `b` bigint(20) DEFAULT NULL,\n" +
+		"  `c1` int(11) DEFAULT NULL,\n" +
+		"  `d` int(11) DEFAULT '5',\n" +
+		"  KEY `id` (`d`)\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+	tk.MustQuery("select * from t use index(id) where d = 5 order by a").Check(testkit.Rows("1 1 1 5", "2 2 2 5"))
+	tk.MustExec("admin check table t")
+
+	// Modify a column with reorganizing the data together with other changes.
+	tk.MustExec("alter table t modify column c1 varchar(10), add unique index ua(a), alter column d set default 6, modify column b int")
+	tk.MustExec("insert into t(a, b, c1) values (3, 3, 'x')")
+	tk.MustQuery("select * from t order by a").Check(testkit.Rows("1 1 1 5", "2 2 2 5", "3 3 x 6"))
+	tk.MustGetErrCode("insert into t(a) values (3)", errno.ErrDupEntry)
+	tk.MustExec("admin check table t")
+
+	// Drop columns covered by the composite indexes together with other changes.
+	tk.MustExec("alter table t add index ibd(b, d), add index ic1d(c1, d)")
+	tk.MustExec("alter table t drop column b, add column e int default 7, add index ie(e), drop column c1")
+	tk.MustQuery("show create table t").Check(testkit.Rows("t CREATE TABLE `t` (\n" +
+		"  `a` int(11) DEFAULT NULL,\n" +
+		"  `d` int(11) DEFAULT '6',\n" +
+		"  `e` int(11) DEFAULT '7',\n" +
+		"  KEY `id` (`d`),\n" +
+		"  UNIQUE KEY `ua` (`a`),\n" +
+		"  KEY `ibd` (`d`),\n" +
+		"  KEY `ic1d` (`d`),\n" +
+		"  KEY `ie` (`e`)\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+	tk.MustQuery("select * from t use index(ibd) where d = 6").Check(testkit.Rows("3 6 7"))
+	tk.MustExec("admin check table t")
+}
+
+func (s *testMultiSchemaChangeSuite) TestMultiSchemaChangeRollback(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (a int, b int, c int, index ic(c))")
+	tk.MustExec("insert into t values (1, 1, 1), (2, 2, 2)")
+
+	// The unique index on the new column fails, so all the sub-jobs are rolled back.
+	tk.MustGetErrCode("alter table t add column d int default 1, add unique index ud(d), modify column b bigint, drop index ic",
+		errno.ErrDupEntry)
+	tk.MustQuery("show create table t").Check(testkit.Rows("t CREATE TABLE `t` (\n" +
+		"  `a` int(11) DEFAULT NULL,\n" +
+		"  `b` int(11) DEFAULT NULL,\n" +
+		"  `c` int(11) DEFAULT NULL,\n" +
+		"  KEY `ic` (`c`)\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+	tk.MustExec("admin check table t")
+
+	// The modified column fails after the others are done, the others are rolled back as well.
+	tk.MustExec("insert into t values (3, 300, 3)")
+	tk.MustGetErrCode("alter table t add column d int, add index ia(a), modify column b tinyint", errno.ErrDataOutOfRange)
+	tk.MustQuery("select * from t order by a").Check(testkit.Rows("1 1 1", "2 2 2", "3 300 3"))
+	tk.MustQuery("select count(*) from information_schema.statistics where table_schema = 'test' and table_name = 't'").Check(testkit.Rows("1"))
+	tk.MustExec("admin check table t")
+}
+
+func (s *testMultiSchemaChangeSuite) TestMultiSchemaChangeConflict(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (a int, b int, c int, index ia(a), index ibc(b, c))")
+
+	tk.MustGetErrCode("alter table t add column d int, modify column d bigint", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("alter table t modify column a bigint, add index ia2(a)", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("alter table t add index ia2(a), rename index ia2 to ia3", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("alter table t drop index ia, add index ia(b)", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("alter table t drop column c, drop index ibc", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("alter table t drop column b, modify column c bigint", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("alter table t add column d int first, add index id(d)", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("alter table t add column d int after a, modify column a bigint", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("alter table t add column d int, add index ie((a + 1))", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("alter table t add column d int, comment 'x'", errno.ErrUnsupportedDDLOperation)
+	// The errors of the sub-jobs are reported before any of them runs.
+	tk.MustGetErrCode("alter table t add column d int, add index ie(e)", errno.ErrKeyColumnDoesNotExits)
+	tk.MustQuery("select count(*) from information_schema.columns where table_schema = 'test' and table_name = 't'").Check(testkit.Rows("3"))
+
+	// The anonymous indexes get different names.
+	tk.MustExec("alter table t add column d int, add index(d), add index(d)")
+	tk.MustQuery("select distinct index_name from information_schema.statistics where table_schema = 'test' and table_name = 't' and column_name = 'd' order by index_name").
+		Check(testkit.Rows("d", "d_2"))
+	tk.MustExec("admin check table t")
+}
+
+func (s *testMultiSchemaChangeSuite) TestMultiSchemaChangeVisibility(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (a int, b int, index ia(a))")
+	internalTK := testkit.NewTestKit(c, s.store)
+	internalTK.MustExec("use test")
+
+	originalHook := s.dom.DDL().GetHook()
+	defer s.dom.DDL().(ddl.DDLForTest).SetHook(originalHook)
+
+	// The added column and index become public in the same schema version as the dropped index
+	// stops being public, and each step of the job generates one schema version.
+	hook := &ddl.TestDDLCallback{}
+	var checkErr error
+	var lastVer int64
+	hook.OnJobRunBeforeExported = func(job *model.Job) {
+		if checkErr != nil || job.Type != model.ActionMultiSchemaChange {
+			return
+		}
+		ver := s.dom.InfoSchema().SchemaMetaVersion()
+		if lastVer != 0 && ver > lastVer+1 {
+			checkErr = errors.Errorf("schema version changed from %d to %d in one step", lastVer, ver)
+			return
+		}
+		lastVer = ver
+		tblInfo := testGetTableByName(c, internalTK.Se, "test", "t").Meta()
+		colPublic, idxPublic, droppedPublic := false, false, false
+		for _, col := range tblInfo.Columns {
+			if col.Name.L == "c" {
+				colPublic = col.State == model.StatePublic
+			}
+		}
+		for _, idx := range tblInfo.Indices {
+			switch idx.Name.L {
+			case "ic":
+				idxPublic = idx.State == model.StatePublic
+			case "ia":
+				droppedPublic = idx.State == model.StatePublic
+			}
+		}
+		if colPublic != idxPublic || colPublic == droppedPublic {
+			checkErr = errors.Errorf("column c public: %v, index ic public: %v, index ia public: %v",
+				colPublic, idxPublic, droppedPublic)
+		}
+	}
+	s.dom.DDL().(ddl.DDLForTest).SetHook(hook)
+	tk.MustExec("alter table t add column c int, add index ic(c), drop index ia")
+	c.Assert(checkErr, IsNil)
+	tk.MustQuery("admin show ddl jobs 1").CheckAt([]int{3}, [][]interface{}{{"alter table multi-schema change"}})
+}
@@ -37,10 +37,12 @@ import (
 	"github.com/pingcap/tidb/domain/infosync"
 	"github.com/pingcap/tidb/expression"
 	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/meta"
 	"github.com/pingcap/tidb/metrics"
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/table/tables"
 	"github.com/pingcap/tidb/tablecodec"
 	"github.com/pingcap/tidb/types"
 	driver "github.com/pingcap/tidb/types/parser_driver"
@@ -49,8 +51,11 @@ import (
 	"github.com/pingcap/tidb/util/collate"
 	"github.com/pingcap/tidb/util/hack"
 	"github.com/pingcap/tidb/util/logutil"
+	decoder "github.com/pingcap/tidb/util/rowDecoder"
 	"github.com/pingcap/tidb/util/slice"
 	"github.com/pingcap/tidb/util/sqlexec"
+	"github.com/pingcap/tidb/util/timeutil"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/tikv/client-go/v2/tikv"
 	"go.uber.org/zap"
 )
@@ -1040,6 +1045,464 @@ func (w *worker) onDropTablePartition(d *ddlCtx, t *meta.Meta, job *model.Job) (
 	return ver, errors.Trace(err)
 }
 
+// checkReorganizePartition checks the partitions to reorganize exist, and they are consecutive for range partitioning.
+// It returns the definitions of these partitions in the order of the table definitions.
+func checkReorganizePartition(tblInfo *model.TableInfo, partLowerNames []string) ([]model.PartitionDefinition, error) {
+	pi := tblInfo.Partition
+	names := make(map[string]struct{}, len(partLowerNames))
+	for _, name := range partLowerNames {
+		names[name] = struct{}{}
+	}
+	defs := make([]model.PartitionDefinition, 0, len(partLowerNames))
+	firstIdx := -1
+	for i, def := range pi.Definitions {
+		if _, ok := names[def.Name.L]; !ok {
+			continue
+		}
+		if firstIdx < 0 {
+			firstIdx = i
+		} else if pi.Type == model.PartitionTypeRange && i != firstIdx+len(defs) {
+			return nil, errors.Trace(ErrConsecutiveReorgPartitions)
+		}
+		defs = append(defs, def)
+	}
+	if len(defs) != len(partLowerNames) {
+		return nil, errors.Trace(ErrDropPartitionNonExistent.GenWithStackByArgs("REORGANIZE"))
+	}
+	return defs, nil
+}
+
+// replacePartitionDefinitions returns the definitions that the replaced ones are substituted by newDefs, newDefs are
+// put at the position of the first replaced one.
+func replacePartitionDefinitions(defs, replacedDefs, newDefs []model.PartitionDefinition) []model.PartitionDefinition {
+	replaced := make(map[int64]struct{}, len(replacedDefs))
+	for _, def := range replacedDefs {
+		replaced[def.ID] = struct{}{}
+	}
+	result := make([]model.PartitionDefinition, 0, len(defs)-len(replacedDefs)+len(newDefs))
+	for _, def := range defs {
+		if _, ok := replaced[def.ID]; !ok {
+			result = append(result, def)
+			continue
+		}
+		result = append(result, newDefs...)
+		newDefs = nil
+	}
+	return result
+}
+
+// checkReorgPartitionRange checks the reorganized range partitions cover the same range as the replaced ones,
+// only the range of the last partition of the table can be extended.
+func checkReorgPartitionRange(ctx sessionctx.Context, tblInfo *model.TableInfo, replacedDef, newDef *model.PartitionDefinition, isLast bool) error {
+	cmp, err := compareRangePartitionBound(ctx, tblInfo, newDef, replacedDef)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if cmp < 0 || (cmp > 0 && !isLast) {
+		return errors.Trace(ErrReorgOutsideRange)
+	}
+	return nil
+}
+
+// compareRangePartitionBound compares the `less than` values of two range partitions.
+func compareRangePartitionBound(ctx sessionctx.Context, tblInfo *model.TableInfo, a, b *model.PartitionDefinition) (int, error) {
+	pi := tblInfo.Partition
+	if len(pi.Columns) > 0 {
+		if len(a.LessThan) == len(b.LessThan) &&
+			slice.AllOf(a.LessThan, func(i int) bool { return strings.EqualFold(a.LessThan[i], b.LessThan[i]) }) {
+			return 0, nil
+		}
+		greater, err := checkTwoRangeColumns(ctx, a, b, pi, tblInfo)
+		if err != nil || greater {
+			return 1, err
+		}
+		return -1, nil
+	}
+
+	aIsMax, bIsMax := strings.EqualFold(a.LessThan[0], partitionMaxValue), strings.EqualFold(b.LessThan[0], partitionMaxValue)
+	switch {
+	case aIsMax && bIsMax:
+		return 0, nil
+	case aIsMax:
+		return 1, nil
+	case bIsMax:
+		return -1, nil
+	}
+	isUnsigned := isColUnsigned(tblInfo.Columns, pi)
+	aValue, _, err := getRangeValue(ctx, a.LessThan[0], isUnsigned)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	bValue, _, err := getRangeValue(ctx, b.LessThan[0], isUnsigned)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if isUnsigned {
+		return types.CompareUint64(aValue.(uint64), bValue.(uint64)), nil
+	}
+	return types.CompareInt64(aValue.(int64), bValue.(int64)), nil
+}
+
+// setReorgPartitionStates records the state of the partitions which only exist in the reorganized layout.
+func setReorgPartitionStates(pi *model.PartitionInfo, defs []model.PartitionDefinition, state model.SchemaState) {
+	pi.States = make([]model.PartitionState, 0, len(defs))
+	for _, def := range defs {
+		pi.States = append(pi.States, model.PartitionState{ID: def.ID, State: state})
+	}
+}
+
+// onReorganizePartition reorganizes partitions of a range or list partitioned table.
+// The adding partitions are written by DML and backfilled with the rows of the dropping partitions, then they replace
+// the dropping partitions in the definitions, and the data of the dropping partitions is deleted at last.
+func (w *worker) onReorganizePartition(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, _ error) {
+	var partNames []string
+	partInfo := &model.PartitionInfo{}
+	if err := job.DecodeArgs(&partNames, &partInfo); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+	tblInfo, err := getTableInfoAndCancelFaultJob(t, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	if job.IsRollingback() {
+		return rollbackReorganizePartition(d, t, job, tblInfo)
+	}
+
+	pi := tblInfo.Partition
+	switch job.SchemaState {
+	case model.StateNone:
+		droppingDefs, err := checkReorganizePartition(tblInfo, partNames)
+		if err != nil {
+			job.State = model.JobStateCancelled
+			return ver, errors.Trace(err)
+		}
+		reorgPI := *pi
+		reorgPI.Definitions = replacePartitionDefinitions(pi.Definitions, droppingDefs, partInfo.Definitions)
+		if err = checkPartitionNameUnique(&reorgPI); err != nil {
+			job.State = model.JobStateCancelled
+			return ver, errors.Trace(err)
+		}
+		if err = checkAddPartitionTooManyPartitions(uint64(len(reorgPI.Definitions))); err != nil {
+			job.State = model.JobStateCancelled
+			return ver, errors.Trace(err)
+		}
+		pi.AddingDefinitions = partInfo.Definitions
+		pi.DroppingDefinitions = droppingDefs
+		// none -> delete only
+		setReorgPartitionStates(pi, pi.AddingDefinitions, model.StateDeleteOnly)
+		job.SchemaState = model.StateDeleteOnly
+		ver, err = updateVersionAndTableInfoWithCheck(t, job, tblInfo, true)
+	case model.StateDeleteOnly:
+		// delete only -> write only
+		setReorgPartitionStates(pi, pi.AddingDefinitions, model.StateWriteOnly)
+		job.SchemaState = model.StateWriteOnly
+		ver, err = updateVersionAndTableInfo(t, job, tblInfo, true)
+	case model.StateWriteOnly:
+		// write only -> reorganization
+		setReorgPartitionStates(pi, pi.AddingDefinitions, model.StateWriteReorganization)
+		// Initialize SnapshotVer to 0 for later reorganization check.
+		job.SnapshotVer = 0
+		job.SchemaState = model.StateWriteReorganization
+		ver, err = updateVersionAndTableInfo(t, job, tblInfo, true)
+	case model.StateWriteReorganization:
+		tbl, err := getTable(d.store, job.SchemaID, tblInfo)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		physicalTableIDs := getPartitionIDsFromDefinitions(pi.DroppingDefinitions)
+		// Build elements for compatible with the reorg handle, elements will not be used when reorganizing.
+		elements := []*meta.Element{{ID: tblInfo.ID, TypeKey: meta.ColumnElementKey}}
+		reorgInfo, err := getReorgInfoFromPartitions(d, t, job, tbl, physicalTableIDs, elements)
+		if err != nil || reorgInfo.first {
+			// If we run reorg firstly, we should update the job snapshot version
+			// and then run the reorg next time.
+			return ver, errors.Trace(err)
+		}
+		err = w.runReorgJob(t, reorgInfo, tbl.Meta(), d.lease, func() (reorgErr error) {
+			defer tidbutil.Recover(metrics.LabelDDL, "onReorganizePartition",
+				func() {
+					reorgErr = errCancelledDDLJob.GenWithStack("reorganize partition panic")
+				}, false)
+			return w.reorgPartitionData(tbl.(table.PartitionedTable), physicalTableIDs, reorgInfo)
+		})
+		if err != nil {
+			if errWaitReorgTimeout.Equal(err) {
+				// If timeout, we should return, check for the owner and re-wait job done.
+				return ver, nil
+			}
+			if kv.IsTxnRetryableError(err) {
+				// Clean up the channel of notifyCancelReorgJob. Make sure it can't affect other jobs.
+				w.reorgCtx.cleanNotifyReorgCancel()
+				return ver, errors.Trace(err)
+			}
+			if err1 := t.RemoveDDLReorgHandle(job, reorgInfo.elements); err1 != nil {
+				logutil.BgLogger().Warn("[ddl] run reorganize partition job failed, RemoveDDLReorgHandle failed, can't convert job to rollback",
+					zap.String("job", job.String()), zap.Error(err1))
+			}
+			logutil.BgLogger().Warn("[ddl] run reorganize partition job failed, convert job to rollback", zap.String("job", job.String()), zap.Error(err))
+			job.State = model.JobStateRollingback
+			// Clean up the channel of notifyCancelReorgJob. Make sure it can't affect other jobs.
+			w.reorgCtx.cleanNotifyReorgCancel()
+			return ver, errors.Trace(err)
+		}
+		// Clean up the channel of notifyCancelReorgJob. Make sure it can't affect other jobs.
+		w.reorgCtx.cleanNotifyReorgCancel()
+
+		// The adding partitions replace the dropping partitions, but the dropping partitions are still written,
+		// since there may be servers reading them until the next schema version.
+		pi.Definitions = replacePartitionDefinitions(pi.Definitions, pi.DroppingDefinitions, pi.AddingDefinitions)
+		setReorgPartitionStates(pi, pi.DroppingDefinitions, model.StateDeleteReorganization)
+		job.SchemaState = model.StateDeleteReorganization
+		ver, err = updateVersionAndTableInfo(t, job, tblInfo, true)
+	case model.StateDeleteReorganization:
+		physicalTableIDs := getPartitionIDsFromDefinitions(pi.DroppingDefinitions)
+		addedDefs := pi.AddingDefinitions
+		pi.AddingDefinitions = nil
+		pi.DroppingDefinitions = nil
+		pi.States = nil
+		if err = dropRuleBundles(d, physicalTableIDs); err != nil {
+			return ver, errors.Wrapf(err, "failed to notify PD the placement rules")
+		}
+		ver, err = updateVersionAndTableInfo(t, job, tblInfo, true)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+		// Initialize the statistics of the added partitions like adding partitions.
+		asyncNotifyEvent(d, &util.Event{Tp: model.ActionAddTablePartition, TableInfo: tblInfo, PartInfo: &model.PartitionInfo{Definitions: addedDefs}})
+		// A background job will be created to delete the data of the dropped partitions.
+		job.Args = []interface{}{physicalTableIDs}
+	default:
+		err = ErrInvalidDDLState.GenWithStackByArgs("partition", job.SchemaState)
+	}
+	return ver, errors.Trace(err)
+}
+
+// rollbackReorganizePartition removes the adding partitions, the dropping partitions are still the partitions of the table.
+func rollbackReorganizePartition(d *ddlCtx, t *meta.Meta, job *model.Job, tblInfo *model.TableInfo) (ver int64, err error) {
+	pi := tblInfo.Partition
+	physicalTableIDs := getPartitionIDsFromDefinitions(pi.AddingDefinitions)
+	pi.AddingDefinitions = nil
+	pi.DroppingDefinitions = nil
+	pi.States = nil
+	if err = dropRuleBundles(d, physicalTableIDs); err != nil {
+		return ver, errors.Wrapf(err, "failed to notify PD the placement rules")
+	}
+	ver, err = updateVersionAndTableInfo(t, job, tblInfo, true)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishTableJob(model.JobStateRollbackDone, model.StateNone, ver, tblInfo)
+	// A background job will be created to delete the data of the adding partitions.
+	job.Args = []interface{}{physicalTableIDs}
+	return ver, nil
+}
+
+// reorgPartitionData backfills the rows of the dropping partitions into the adding partitions.
+func (w *worker) reorgPartitionData(tbl table.PartitionedTable, partitionIDs []int64, reorgInfo *reorgInfo) error {
+	var err error
+	var finish bool
+	for !finish {
+		p := tbl.GetPartition(reorgInfo.PhysicalTableID)
+		if p == nil {
+			return errCancelledDDLJob.GenWithStack("Can not find partition id %d for table %d", reorgInfo.PhysicalTableID, tbl.Meta().ID)
+		}
+		logutil.BgLogger().Info("[ddl] start to reorganize partition", zap.String("job", reorgInfo.Job.String()), zap.String("reorgInfo", reorgInfo.String()))
+		err = w.writePhysicalTableRecord(p, typeReorgPartitionWorker, nil, nil, nil, reorgInfo)
+		if err != nil {
+			break
+		}
+		finish, err = w.updateReorgInfoForPartitions(tbl, reorgInfo, partitionIDs)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return errors.Trace(err)
+}
+
+type reorgPartitionWorker struct {
+	*backfillWorker
+	metricCounter prometheus.Counter
+	// reorgedTbl is the partitioned table whose partitions are reorganized, it's used to locate the adding partition
+	// of a row.
+	reorgedTbl table.PartitionedTable
+
+	// The following attributes are used to reduce memory allocation.
+	rowRecords  []*reorgPartitionRecord
+	rowDecoder  *decoder.RowDecoder
+	rowMap      map[int64]types.Datum
+	defaultVals []types.Datum
+}
+
+type reorgPartitionRecord struct {
+	key    []byte // It's the key of the row in the dropping partition, which is locked when backfilling the row.
+	newKey []byte // It's the key of the row in the adding partition.
+	vals   []byte // It's the raw value of the row.
+	handle kv.Handle
+	row    []types.Datum
+	target table.PhysicalTable
+}
+
+func newReorgPartitionWorker(sessCtx sessionctx.Context, worker *worker, id int, t table.PhysicalTable, decodeColMap map[int64]decoder.Column, reorgInfo *reorgInfo) (*reorgPartitionWorker, error) {
+	reorgedTbl, err := getTable(reorgInfo.d.store, reorgInfo.Job.SchemaID, t.Meta())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &reorgPartitionWorker{
+		backfillWorker: newBackfillWorker(sessCtx, worker, id, t),
+		metricCounter:  metrics.BackfillTotalCounter.WithLabelValues("reorg_partition_speed"),
+		reorgedTbl:     reorgedTbl.(table.PartitionedTable),
+		rowDecoder:     decoder.NewRowDecoder(t, t.WritableCols(), decodeColMap),
+		rowMap:         make(map[int64]types.Datum, len(decodeColMap)),
+		defaultVals:    make([]types.Datum, len(t.WritableCols())),
+	}, nil
+}
+
+func (w *reorgPartitionWorker) AddMetricInfo(cnt float64) {
+	w.metricCounter.Add(cnt)
+}
+
+func (w *reorgPartitionWorker) fetchRowColVals(txn kv.Transaction, taskRange reorgBackfillTask) ([]*reorgPartitionRecord, kv.Key, bool, error) {
+	w.rowRecords = w.rowRecords[:0]
+	startTime := time.Now()
+
+	// taskDone means that the reorganized handle is out of taskRange.endHandle.
+	taskDone := false
+	var lastAccessedHandle kv.Key
+	oprStartTime := startTime
+	err := iterateSnapshotRows(w.sessCtx.GetStore(), w.priority, w.table, txn.StartTS(), taskRange.startKey, taskRange.endKey,
+		func(handle kv.Handle, recordKey kv.Key, rawRow []byte) (bool, error) {
+			oprEndTime := time.Now()
+			logSlowOperations(oprEndTime.Sub(oprStartTime), "iterateSnapshotRows in reorgPartitionWorker fetchRowColVals", 0)
+			oprStartTime = oprEndTime
+
+			taskDone = recordKey.Cmp(taskRange.endKey) > 0
+
+			if taskDone || len(w.rowRecords) >= w.batchCnt {
+				return false, nil
+			}
+
+			if err1 := w.getRowRecord(handle, recordKey, rawRow); err1 != nil {
+				return false, errors.Trace(err1)
+			}
+			lastAccessedHandle = recordKey
+			if recordKey.Cmp(taskRange.endKey) == 0 {
+				// If taskRange.endIncluded == false, we will not reach here when handle == taskRange.endHandle.
+				taskDone = true
+				return false, nil
+			}
+			return true, nil
+		})
+
+	if len(w.rowRecords) == 0 {
+		taskDone = true
+	}
+
+	logutil.BgLogger().Debug("[ddl] txn fetches handle info", zap.Uint64("txnStartTS", txn.StartTS()), zap.String("taskRange", taskRange.String()), zap.Duration("takeTime", time.Since(startTime)))
+	nextKey := taskRange.endKey.Next()
+	if !taskDone {
+		// The task is not done. So we need to pick the last processed entry's handle and add one.
+		nextKey = lastAccessedHandle.Next()
+	}
+	return w.rowRecords, nextKey, taskDone, errors.Trace(err)
+}
+
+func (w *reorgPartitionWorker) getRowRecord(handle kv.Handle, recordKey []byte, rawRow []byte) error {
+	defer func() {
+		for id := range w.rowMap {
+			delete(w.rowMap, id)
+		}
+	}()
+	_, err := w.rowDecoder.DecodeAndEvalRowWithMap(w.sessCtx, handle, rawRow, time.UTC, timeutil.SystemLocation(), w.rowMap)
+	if err != nil {
+		return errors.Trace(errCantDecodeRecord.GenWithStackByArgs("row", err))
+	}
+	cols := w.table.WritableCols()
+	row := make([]types.Datum, len(cols))
+	for i, col := range cols {
+		val, ok := w.rowMap[col.ID]
+		if !ok {
+			val, err = tables.GetColDefaultValue(w.sessCtx, col, w.defaultVals)
+			if err != nil {
+				return errors.Trace(err)
+			}
+		}
+		row[i] = val
+	}
+	target, err := w.reorgedTbl.GetReorganizedPartitionByRow(w.sessCtx, row)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if target == nil {
+		return errors.Errorf("can not find the reorganized partition of the row with handle %s in partition %d", handle, w.table.(table.PhysicalTable).GetPhysicalID())
+	}
+	w.rowRecords = append(w.rowRecords, &reorgPartitionRecord{
+		key:    recordKey,
+		newKey: tablecodec.EncodeRecordKey(target.RecordPrefix(), handle),
+		vals:   rawRow,
+		handle: handle,
+		row:    row,
+		target: target,
+	})
+	return nil
+}
+
+// BackfillDataInTxn backfills the rows of a dropping partition into the adding partitions in a transaction.
+// The rows which have been written into the adding partitions by DML are skipped.
+func (w *reorgPartitionWorker) BackfillDataInTxn(handleRange reorgBackfillTask) (taskCtx backfillTaskContext, errInTxn error) {
+	oprStartTime := time.Now()
+	errInTxn = kv.RunInNewTxn(context.Background(), w.sessCtx.GetStore(), true, func(ctx context.Context, txn kv.Transaction) error {
+		taskCtx.addedCount = 0
+		taskCtx.scanCount = 0
+		txn.SetOption(kv.Priority, w.priority)
+
+		rowRecords, nextKey, taskDone, err := w.fetchRowColVals(txn, handleRange)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		taskCtx.nextKey = nextKey
+		taskCtx.done = taskDone
+
+		for _, record := range rowRecords {
+			taskCtx.scanCount++
+			_, err = txn.Get(ctx, record.newKey)
+			if err == nil {
+				continue
+			}
+			if !kv.ErrNotExist.Equal(err) {
+				return errors.Trace(err)
+			}
+			// Lock the row key to notify us that someone delete or update the row,
+			// then we should not backfill the row, otherwise the old row is written into the adding partition.
+			if err = txn.LockKeys(context.Background(), new(kv.LockCtx), record.key); err != nil {
+				return errors.Trace(err)
+			}
+			if err = txn.Set(record.newKey, record.vals); err != nil {
+				return errors.Trace(err)
+			}
+			for _, idx := range record.target.Indices() {
+				if record.target.Meta().IsCommonHandle && idx.Meta().Primary {
+					continue
+				}
+				idxVals, err := idx.FetchValues(record.row, nil)
+				if err != nil {
+					return errors.Trace(err)
+				}
+				rsData := tables.TryGetHandleRestoredDataWrapper(record.target, record.row, nil, idx.Meta())
+				if _, err = idx.Create(w.sessCtx, txn, idxVals, record.handle, rsData); err != nil {
+					return errors.Trace(err)
+				}
+			}
+			taskCtx.addedCount++
+		}
+		return nil
+	})
+	logSlowOperations(time.Since(oprStartTime), "ReorgPartitionBackfillDataInTxn", 3000)
+
+	return
+}
+
 // onTruncateTablePartition truncates old partition meta.
 func onTruncateTablePartition(d *ddlCtx, t *meta.Meta, job *model.Job) (int64, error) {
 	var ver int64
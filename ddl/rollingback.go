@@ -149,6 +149,55 @@ func rollingbackModifyColumn(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job)
 	return ver, errCancelledDDLJob
 }
 
+// rollingbackRebuildIndexesForDropColumns changes the drop column job, whose columns are still public, into rolling back
+// state. The indexes rebuilt without the dropped columns are removed when rolling back.
+func rollingbackRebuildIndexesForDropColumns(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job, tblInfo *model.TableInfo,
+	compositeIdxInfos []*model.IndexInfo) (ver int64, err error) {
+	changingIdxs := findIndexesForDropColumns(tblInfo, compositeIdxInfos)
+	if len(changingIdxs) == 0 {
+		// The job hasn't been handled and we cancel it directly.
+		job.State = model.JobStateCancelled
+		return ver, errCancelledDDLJob
+	}
+	// If the value of SnapshotVer isn't zero, it means the reorg workers have been started. The workers of a paused
+	// sub-job of the multi-schema change have finished the backfilling.
+	sub := subJobOf(job)
+	if changingIdxs[0].State == model.StateWriteReorganization && job.SnapshotVer != 0 && (sub == nil || sub.Revertible) {
+		// The backfilling workers are started. we have to ask them to exit.
+		logutil.Logger(w.logCtx).Info("[ddl] run the cancelling DDL job", zap.String("job", job.String()))
+		w.reorgCtx.notifyReorgCancel()
+		// Give the this kind of ddl one more round to run, the errCancelledDDLJob should be fetched from the bottom up.
+		return w.onDropColumnsWithIndexes(d, t, job)
+	}
+	// The job has been in its middle state (but the reorg worker hasn't started) and we roll it back here.
+	job.State = model.JobStateRollingback
+	return ver, errCancelledDDLJob
+}
+
+func rollingbackReorganizePartition(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+	switch job.SchemaState {
+	case model.StateNone:
+		// The job hasn't been handled and we cancel it directly.
+		job.State = model.JobStateCancelled
+		return ver, errCancelledDDLJob
+	case model.StateDeleteReorganization:
+		// The new partitions have replaced the old ones, the job couldn't be cancelled.
+		job.State = model.JobStateRunning
+		return ver, nil
+	case model.StateWriteReorganization:
+		// If the value of SnapshotVer isn't zero, it means the reorg workers have been started.
+		if job.SnapshotVer != 0 {
+			logutil.Logger(w.logCtx).Info("[ddl] run the cancelling DDL job", zap.String("job", job.String()))
+			w.reorgCtx.notifyReorgCancel()
+			// Give the this kind of ddl one more round to run, the errCancelledDDLJob should be fetched from the bottom up.
+			return w.onReorganizePartition(d, t, job)
+		}
+	}
+	// The job has been in its middle state and we roll it back here.
+	job.State = model.JobStateRollingback
+	return ver, errCancelledDDLJob
+}
+
 func rollingbackAddColumn(t *meta.Meta, job *model.Job) (ver int64, err error) {
 	tblInfo, columnInfo, col, _, _, err := checkAddColumn(t, job)
 	if err != nil {
@@ -201,8 +250,8 @@ func rollingbackAddColumns(t *meta.Meta, job *model.Job) (ver int64, err error)
 	return ver, errCancelledDDLJob
 }
 
-func rollingbackDropColumn(t *meta.Meta, job *model.Job) (ver int64, err error) {
-	_, colInfo, idxInfos, err := checkDropColumn(t, job)
+func rollingbackDropColumn(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+	tblInfo, colInfo, idxInfos, compositeIdxInfos, err := checkDropColumn(t, job)
 	if err != nil {
 		return ver, errors.Trace(err)
 	}
@@ -222,10 +271,9 @@ func rollingbackDropColumn(t *meta.Meta, job *model.Job) (ver int64, err error)
 		}
 	}
 
-	// StatePublic means when the job is not running yet.
+	// StatePublic means when the job is not running yet, or it's rebuilding the composite indexes.
 	if colInfo.State == model.StatePublic {
-		job.State = model.JobStateCancelled
-		return ver, errCancelledDDLJob
+		return rollingbackRebuildIndexesForDropColumns(w, d, t, job, tblInfo, compositeIdxInfos)
 	}
 	// In the state of drop column `write only -> delete only -> reorganization`,
 	// We can not rollback now, so just continue to drop column.
@@ -233,8 +281,8 @@ func rollingbackDropColumn(t *meta.Meta, job *model.Job) (ver int64, err error)
 	return ver, nil
 }
 
-func rollingbackDropColumns(t *meta.Meta, job *model.Job) (ver int64, err error) {
-	_, colInfos, _, idxInfos, err := checkDropColumns(t, job)
+func rollingbackDropColumns(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+	tblInfo, colInfos, _, idxInfos, compositeIdxInfos, err := checkDropColumns(t, job)
 	if err != nil {
 		return ver, errors.Trace(err)
 	}
@@ -254,10 +302,9 @@ func rollingbackDropColumns(t *meta.Meta, job *model.Job) (ver int64, err error)
 		}
 	}
 
-	// StatePublic means when the job is not running yet.
+	// StatePublic means when the job is not running yet, or it's rebuilding the composite indexes.
 	if colInfos[0].State == model.StatePublic {
-		job.State = model.JobStateCancelled
-		return ver, errCancelledDDLJob
+		return rollingbackRebuildIndexesForDropColumns(w, d, t, job, tblInfo, compositeIdxInfos)
 	}
 	// In the state of drop columns `write only -> delete only -> reorganization`,
 	// We can not rollback now, so just continue to drop columns.
@@ -414,9 +461,9 @@ func convertJob2RollbackJob(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job)
 	case model.ActionAddTablePartition:
 		ver, err = rollingbackAddTablePartition(t, job)
 	case model.ActionDropColumn:
-		ver, err = rollingbackDropColumn(t, job)
-	case model.ActionDropColumns:
-		ver, err = rollingbackDropColumns(t, job)
+		ver, err = rollingbackDropColumn(w, d, t, job)
+	case model.ActionDropColumns, model.ActionDropColumnsWithIndexes:
+		ver, err = rollingbackDropColumns(w, d, t, job)
 	case model.ActionDropIndex, model.ActionDropPrimaryKey:
 		ver, err = rollingbackDropIndex(t, job)
 	case model.ActionDropTable, model.ActionDropView, model.ActionDropSequence:
@@ -431,12 +478,17 @@ func convertJob2RollbackJob(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job)
 		ver, err = rollingbackTruncateTable(t, job)
 	case model.ActionModifyColumn:
 		ver, err = rollingbackModifyColumn(w, d, t, job)
+	case model.ActionReorganizePartition:
+		ver, err = rollingbackReorganizePartition(w, d, t, job)
+	case model.ActionMultiSchemaChange:
+		ver, err = rollingbackMultiSchemaChange(job)
 	case model.ActionRebaseAutoID, model.ActionShardRowID, model.ActionAddForeignKey,
 		model.ActionDropForeignKey, model.ActionRenameTable, model.ActionRenameTables,
 		model.ActionModifyTableCharsetAndCollate, model.ActionTruncateTablePartition,
 		model.ActionModifySchemaCharsetAndCollate, model.ActionRepairTable,
 		model.ActionModifyTableAutoIdCache, model.ActionAlterIndexVisibility,
-		model.ActionExchangeTablePartition:
+		model.ActionExchangeTablePartition, model.ActionAddCheckConstraint,
+		model.ActionDropCheckConstraint, model.ActionAlterCheckConstraint:
 		ver, err = cancelOnlyNotHandledJob(job)
 	default:
 		job.State = model.JobStateCancelled
@@ -821,9 +821,11 @@ const (
 	ErrRowInWrongPartition                                   = 1863
 	ErrErrorLast                                             = 1863
 	ErrMaxExecTimeExceeded                                   = 1907
+	ErrFkDepthExceeded                                       = 3008
 	ErrInvalidFieldSize                                      = 3013
 	ErrInvalidArgumentForLogarithm                           = 3020
 	ErrAggregateOrderNonAggQuery                             = 3029
+	ErrUserLockWrongName                                     = 3057
 	ErrIncorrectType                                         = 3064
 	ErrFieldInOrderNotSelect                                 = 3065
 	ErrAggregateInOrderNotSelect                             = 3066
@@ -884,6 +886,10 @@ const (
 	ErrIllegalPrivilegeLevel                                 = 3619
 	ErrCTEMaxRecursionDepth                                  = 3636
 	ErrNotHintUpdatable                                      = 3637
+	ErrCredentialsContradictToHistory                        = 3638
+	ErrMissingJSONTableValue                                 = 3665
+	ErrWrongJSONTableValue                                   = 3666
+	ErrRegexpIndexOutOfBounds                                = 3686
 	ErrDataTruncatedFunctionalIndex                          = 3751
 	ErrDataOutOfRangeFunctionalIndex                         = 3752
 	ErrFunctionalIndexOnJSONOrGeometryFunction               = 3753
@@ -899,12 +905,25 @@ const (
 	ErrGeneratedColumnRowValueIsNotAllowed                   = 3764
 	ErrFKIncompatibleColumns                                 = 3780
 	ErrFunctionalIndexRowValueIsNotAllowed                   = 3800
+	ErrNonBooleanExprForCheckConstraint                      = 3812
+	ErrColumnCheckConstraintReferencesOtherColumn            = 3813
+	ErrCheckConstraintFunctionIsNotAllowed                   = 3815
+	ErrCheckConstraintRowValue                               = 3817
+	ErrCheckConstraintRefersAutoIncrementColumn              = 3818
+	ErrCheckConstraintViolated                               = 3819
+	ErrTableCheckConstraintReferUnknown                      = 3820
+	ErrConstraintNotFound                                    = 3821
+	ErrCheckConstraintDupName                                = 3822
 	ErrDependentByFunctionalIndex                            = 3837
 	ErrInvalidJSONValueForFuncIndex                          = 3903
 	ErrJSONValueOutOfRangeForFuncIndex                       = 3904
 	ErrFunctionalIndexDataIsTooLong                          = 3907
 	ErrFunctionalIndexNotApplicable                          = 3909
+	ErrWrongCompressionAlgorithmClient                       = 3922
+	ErrWrongCompressionLevelClient                           = 3923
 	ErrDynamicPrivilegeNotRegistered                         = 3929
+	ErrUserAccessDeniedForUserAccountBlockedByPasswordLock   = 3955
+	ErrDependentByCheckConstraint                            = 3959
 	// MariaDB errors.
 	ErrOnlyOneDefaultPartionAllowed         = 4030
 	ErrWrongPartitionTypeExpectedSystemTime = 4113
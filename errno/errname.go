@@ -834,9 +834,11 @@ var MySQLErrName = map[uint16]*mysql.ErrMessage{
 	ErrGeneratedColumnRefAutoInc:                             mysql.Message("Generated column '%s' cannot refer to auto-increment column.", nil),
 	ErrWarnConflictingHint:                                   mysql.Message("Hint %s is ignored as conflicting/duplicated.", nil),
 	ErrUnresolvedHintName:                                    mysql.Message("Unresolved name '%s' for %s hint", nil),
+	ErrFkDepthExceeded:                                       mysql.Message("Foreign key cascade delete/update exceeds max depth of %v.", nil),
 	ErrInvalidFieldSize:                                      mysql.Message("Invalid size for column '%s'.", nil),
 	ErrInvalidArgumentForLogarithm:                           mysql.Message("Invalid argument for logarithm", nil),
 	ErrAggregateOrderNonAggQuery:                             mysql.Message("Expression #%d of ORDER BY contains aggregate function and applies to the result of a non-aggregated query", nil),
+	ErrUserLockWrongName:                                     mysql.Message("Incorrect user-level lock name '%-.192s'.", nil),
 	ErrIncorrectType:                                         mysql.Message("Incorrect type for argument %s in function %s.", nil),
 	ErrFieldInOrderNotSelect:                                 mysql.Message("Expression #%d of ORDER BY clause is not in SELECT list, references column '%s' which is not in SELECT list; this is incompatible with %s", nil),
 	ErrAggregateInOrderNotSelect:                             mysql.Message("Expression #%d of ORDER BY clause is not in SELECT list, contains aggregate function; this is incompatible with %s", nil),
@@ -880,6 +882,10 @@ var MySQLErrName = map[uint16]*mysql.ErrMessage{
 	ErrMaxExecTimeExceeded:                                   mysql.Message("Query execution was interrupted, max_execution_time exceeded.", nil),
 	ErrLockAcquireFailAndNoWaitSet:                           mysql.Message("Statement aborted because lock(s) could not be acquired immediately and NOWAIT is set.", nil),
 	ErrNotHintUpdatable:                                      mysql.Message("Variable '%s' cannot be set using SET_VAR hint.", nil),
+	ErrCredentialsContradictToHistory:                        mysql.Message("Cannot use these credentials for '%s@%s' because they contradict the password history policy", nil),
+	ErrMissingJSONTableValue:                                 mysql.Message("Missing value for JSON_TABLE column '%-.192s'", nil),
+	ErrWrongJSONTableValue:                                   mysql.Message("Can't store an array or an object in the scalar JSON_TABLE column '%-.192s'", nil),
+	ErrRegexpIndexOutOfBounds:                                mysql.Message("Index out of bounds in regular expression search.", nil),
 	ErrDataTruncatedFunctionalIndex:                          mysql.Message("Data truncated for expression index '%s' at row %d", nil),
 	ErrDataOutOfRangeFunctionalIndex:                         mysql.Message("Value is out of range for expression index '%s' at row %d", nil),
 	ErrFunctionalIndexOnJSONOrGeometryFunction:               mysql.Message("Cannot create an expression index on a function that returns a JSON or GEOMETRY value", nil),
@@ -894,13 +900,26 @@ var MySQLErrName = map[uint16]*mysql.ErrMessage{
 	ErrFunctionalIndexOnField:                                mysql.Message("Expression index on a column is not supported. Consider using a regular index instead", nil),
 	ErrFKIncompatibleColumns:                                 mysql.Message("Referencing column '%s' in foreign key constraint '%s' are incompatible", nil),
 	ErrFunctionalIndexRowValueIsNotAllowed:                   mysql.Message("Expression of expression index '%s' cannot refer to a row value", nil),
+	ErrNonBooleanExprForCheckConstraint:                      mysql.Message("An expression of non-boolean type specified to a check constraint '%s'.", nil),
+	ErrColumnCheckConstraintReferencesOtherColumn:            mysql.Message("Column check constraint '%s' references other column.", nil),
+	ErrCheckConstraintFunctionIsNotAllowed:                   mysql.Message("An expression of a check constraint '%s' contains disallowed function.", nil),
+	ErrCheckConstraintRowValue:                               mysql.Message("Check constraint '%s' cannot refer to a row value.", nil),
+	ErrCheckConstraintRefersAutoIncrementColumn:              mysql.Message("Check constraint '%s' cannot refer to an auto-increment column.", nil),
+	ErrCheckConstraintViolated:                               mysql.Message("Check constraint '%s' is violated.", nil),
+	ErrTableCheckConstraintReferUnknown:                      mysql.Message("Check constraint '%s' refers to non-existing column '%s'.", nil),
+	ErrConstraintNotFound:                                    mysql.Message("Check constraint '%s' is not found in the table.", nil),
+	ErrCheckConstraintDupName:                                mysql.Message("Duplicate check constraint name '%s'.", nil),
 	ErrDependentByFunctionalIndex:                            mysql.Message("Column '%s' has an expression index dependency and cannot be dropped or renamed", nil),
 	ErrInvalidJSONValueForFuncIndex:                          mysql.Message("Invalid JSON value for CAST for expression index '%s'", nil),
 	ErrJSONValueOutOfRangeForFuncIndex:                       mysql.Message("Out of range JSON value for CAST for expression index '%s'", nil),
 	ErrFunctionalIndexDataIsTooLong:                          mysql.Message("Data too long for expression index '%s'", nil),
 	ErrFunctionalIndexNotApplicable:                          mysql.Message("Cannot use expression index '%s' due to type or collation conversion", nil),
+	ErrWrongCompressionAlgorithmClient:                       mysql.Message("Compression algorithm '%-.32s' requested by client is not supported by the server.", nil),
+	ErrWrongCompressionLevelClient:                           mysql.Message("Compression algorithm '%-.32s' requested by client uses level '%d' which is not supported by the server.", nil),
 	ErrUnsupportedConstraintCheck:                            mysql.Message("%s is not supported", nil),
 	ErrDynamicPrivilegeNotRegistered:                         mysql.Message("Dynamic privilege '%s' is not registered with the server.", nil),
+	ErrUserAccessDeniedForUserAccountBlockedByPasswordLock:   mysql.Message("Access denied for user '%s'@'%s'. Account is blocked for %s day(s) (%s day(s) remaining) due to %d consecutive failed logins.", nil),
+	ErrDependentByCheckConstraint:                            mysql.Message("Check constraint '%s' uses column '%s', hence column cannot be dropped or renamed.", nil),
 	ErrIllegalPrivilegeLevel:                                 mysql.Message("Illegal privilege level specified for %s", nil),
 	ErrCTERecursiveRequiresUnion:                             mysql.Message("Recursive Common Table Expression '%s' should contain a UNION", nil),
 	ErrCTERecursiveRequiresNonRecursiveFirst:                 mysql.Message("Recursive Common Table Expression '%s' should have one or more non-recursive query blocks followed by one or more recursive ones", nil),
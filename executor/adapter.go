@@ -45,6 +45,7 @@ import (
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/types"
+	tidbutil "github.com/pingcap/tidb/util"
 	"github.com/pingcap/tidb/util/chunk"
 	"github.com/pingcap/tidb/util/execdetails"
 	"github.com/pingcap/tidb/util/hint"
@@ -899,6 +900,7 @@ func (a *ExecStmt) FinishExecuteStmt(txnTS uint64, err error, hasMoreResults boo
 		}
 	}
 	sessVars.PrevStmt = FormatSQL(a.GetTextToLog())
+	a.recordOptimizerTrace()
 
 	executeDuration := time.Since(sessVars.StartTime) - sessVars.DurationCompile
 	if sessVars.InRestrictedSQL {
@@ -927,6 +929,43 @@ func (a *ExecStmt) CloseRecordSet(txnStartTS uint64, lastErr error) {
 	}
 }
 
+// recordOptimizerTrace keeps the optimizer trace of the statement for information_schema.OPTIMIZER_TRACE.
+func (a *ExecStmt) recordOptimizerTrace() {
+	sessVars := a.Ctx.GetSessionVars()
+	tracer := sessVars.StmtCtx.OptimizeTracer
+	if tracer == nil {
+		return
+	}
+	sessVars.StmtCtx.OptimizeTracer = nil
+	// Like MySQL, the statements reading the traces aren't traced, so they don't evict the traces they read.
+	if readsOptimizerTrace(a.Plan) {
+		return
+	}
+	trace, err := tracer.Marshal(sessVars.OptimizerTraceOneLine)
+	if err != nil {
+		logutil.BgLogger().Warn("marshal optimizer trace failed", zap.Error(err))
+		return
+	}
+	sessVars.AppendOptimizerTrace(a.Text, trace)
+}
+
+// readsOptimizerTrace returns true if the plan reads information_schema.OPTIMIZER_TRACE.
+func readsOptimizerTrace(p plannercore.Plan) bool {
+	switch x := p.(type) {
+	case *plannercore.PhysicalMemTable:
+		return x.DBName.L == tidbutil.InformationSchemaName.L && x.Table.Name.L == strings.ToLower(infoschema.TableOptimizerTrace)
+	case *plannercore.Insert:
+		return x.SelectPlan != nil && readsOptimizerTrace(x.SelectPlan)
+	case plannercore.PhysicalPlan:
+		for _, child := range x.Children() {
+			if readsOptimizerTrace(child) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // LogSlowQuery is used to print the slow query in the log files.
 func (a *ExecStmt) LogSlowQuery(txnTS uint64, succ bool, hasMoreResults bool) {
 	sessVars := a.Ctx.GetSessionVars()
@@ -1200,6 +1239,7 @@ func (a *ExecStmt) SummaryStmt(succ bool) {
 		IsInternal:      sessVars.InRestrictedSQL,
 		Succeed:         succ,
 		PlanInCache:     sessVars.FoundInPlanCache,
+		PlanCacheMiss:   stmtCtx.UseCache && !sessVars.FoundInPlanCache,
 		PlanInBinding:   sessVars.FoundInBinding,
 		ExecRetryCount:  a.retryCount,
 		StmtExecDetails: stmtDetail,
@@ -919,6 +919,26 @@ func (s *testSuiteAgg) TestHaving(c *C) {
 	tk.MustQuery("select 1 from t group by c1 having sum(abs(c2 + c3)) = c1").Check(testkit.Rows("1"))
 }
 
+func (s *testSuiteAgg) TestRollup(c *C) {
+	tk := testkit.NewTestKitWithInit(c, s.store)
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (a int, b varchar(10), c int)")
+	tk.MustExec("insert into t values (1, 'x', 1), (1, 'y', 2), (2, 'x', 3), (2, null, 4)")
+
+	tk.MustQuery("select a, b, sum(c), grouping(a), grouping(b), grouping(a, b) from t group by a, b with rollup order by grouping(a), a, grouping(b), b").Check(testkit.Rows(
+		"1 x 1 0 0 0", "1 y 2 0 0 0", "1 <nil> 3 0 1 1",
+		"2 <nil> 4 0 0 0", "2 x 3 0 0 0", "2 <nil> 7 0 1 1",
+		"<nil> <nil> 10 1 1 3"))
+	tk.MustQuery("select a, count(*) from t group by a with rollup having a is null").Check(testkit.Rows("<nil> 4"))
+	tk.MustQuery("select a, count(*) from t where c > 10 group by a with rollup").Check(testkit.Rows())
+	tk.MustQuery("select b, max(c) from t group by b with rollup having grouping(b) = 0 order by b").Check(testkit.Rows("<nil> 4", "x 3", "y 2"))
+	tk.MustExec("prepare stmt from 'select a, sum(c) from t where c > ? group by a with rollup order by grouping(a), a'")
+	tk.MustExec("set @c = 1")
+	tk.MustQuery("execute stmt using @c").Check(testkit.Rows("1 2", "2 7", "<nil> 9"))
+	tk.MustGetErrMsg("select a + 1 from t group by a + 1 with rollup", "[planner:1235]This version of TiDB doesn't yet support 'GROUP BY expressions with ROLLUP'")
+	tk.MustGetErrMsg("select a, grouping(a) from t group by a", "[planner:1111]Invalid use of group function")
+}
+
 func (s *testSuiteAgg) TestAggEliminator(c *C) {
 	tk := testkit.NewTestKitWithInit(c, s.store)
 
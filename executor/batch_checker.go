@@ -275,3 +275,52 @@ func getOldRow(ctx context.Context, sctx sessionctx.Context, kvGetter kv.Getter,
 	}
 	return oldRow, nil
 }
+
+// getForeignKeyParentKeys gets the record keys or unique index keys of the parent rows referenced by the to-be-inserted rows,
+// which can be got by point get. The keys are used to fill the cache by BatchGet before checking the foreign keys row by row.
+func getForeignKeyParentKeys(fkChecker *foreignKeyChecker, t table.Table, rows [][]types.Datum) ([]kv.Key, error) {
+	if fkChecker == nil || !hasForeignKeys(t.Meta()) {
+		return nil, nil
+	}
+	dbName, err := fkChecker.schemaName(t.Meta())
+	if err != nil {
+		return nil, err
+	}
+	var keys []kv.Key
+	for _, fk := range t.Meta().ForeignKeys {
+		if fk.State != model.StatePublic {
+			continue
+		}
+		// The violations are reported by the checks later, skip them here.
+		parent, err := fkChecker.is.TableByName(dbName, fk.RefTable)
+		if err != nil || parent.Meta().GetPartitionInfo() != nil {
+			continue
+		}
+		cols, err := findForeignKeyColumns(t, fk.Cols)
+		if err != nil {
+			return nil, err
+		}
+		refCols, err := findForeignKeyColumns(parent, fk.RefCols)
+		if err != nil {
+			continue
+		}
+		for _, row := range rows {
+			vals := fetchColumnValues(row, cols)
+			if hasNullValue(vals) {
+				continue
+			}
+			refVals, err := fkChecker.castValues(vals, refCols)
+			if err != nil {
+				continue
+			}
+			key, _, err := getForeignKeyPointKey(fkChecker.sctx, parent, refCols, refVals)
+			if err != nil {
+				return nil, err
+			}
+			if key != nil {
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys, nil
+}
@@ -233,6 +233,8 @@ func (b *executorBuilder) buildBRIE(s *ast.BRIEStmt, schema *expression.Schema)
 		storage.ExtractQueryParameters(storageURL, &cfg.S3)
 	case "gs", "gcs":
 		storage.ExtractQueryParameters(storageURL, &cfg.GCS)
+	case "azure", "azblob":
+		storage.ExtractQueryParameters(storageURL, &cfg.Azblob)
 	default:
 		break
 	}
@@ -47,6 +47,7 @@ import (
 	"github.com/pingcap/tidb/table"
 	"github.com/pingcap/tidb/table/tables"
 	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/types/json"
 	"github.com/pingcap/tidb/util"
 	"github.com/pingcap/tidb/util/admin"
 	"github.com/pingcap/tidb/util/chunk"
@@ -223,10 +224,14 @@ func (b *executorBuilder) build(p plannercore.Plan) Executor {
 		return b.buildMemTable(v)
 	case *plannercore.PhysicalTableDual:
 		return b.buildTableDual(v)
+	case *plannercore.PhysicalJSONTable:
+		return b.buildJSONTable(v)
 	case *plannercore.PhysicalApply:
 		return b.buildApply(v)
 	case *plannercore.PhysicalMaxOneRow:
 		return b.buildMaxOneRow(v)
+	case *plannercore.PhysicalExpand:
+		return b.buildExpand(v)
 	case *plannercore.Analyze:
 		return b.buildAnalyze(v)
 	case *plannercore.PhysicalTableReader:
@@ -826,6 +831,8 @@ func (b *executorBuilder) buildInsert(v *plannercore.Insert) Executor {
 		hasRefCols:                v.NeedFillDefaultValue,
 		SelectExec:                selectExec,
 		rowLen:                    v.RowLen,
+		fkChecker:                 newForeignKeyChecker(b.ctx, b.is),
+		ckChecker:                 newCheckConstraintChecker(b.ctx),
 	}
 	err := ivs.initInsertColumns()
 	if err != nil {
@@ -856,6 +863,8 @@ func (b *executorBuilder) buildLoadData(v *plannercore.LoadData) Executor {
 		GenExprs:     v.GenCols.Exprs,
 		isLoadData:   true,
 		txnInUse:     sync.Mutex{},
+		fkChecker:    newForeignKeyChecker(b.ctx, b.is),
+		ckChecker:    newCheckConstraintChecker(b.ctx),
 	}
 	loadDataInfo := &LoadDataInfo{
 		row:                make([]types.Datum, 0, len(insertVal.insertColumns)),
@@ -1427,6 +1436,29 @@ func (b *executorBuilder) buildTableDual(v *plannercore.PhysicalTableDual) Execu
 	return e
 }
 
+func (b *executorBuilder) buildJSONTable(v *plannercore.PhysicalJSONTable) Executor {
+	path, err := json.ParseJSONPathExpr(v.Path)
+	if err != nil {
+		b.err = err
+		return nil
+	}
+	e := &JSONTableExec{
+		baseExecutor: newBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		doc:          v.Expr,
+	}
+	columns, _, err := buildJSONTableColumns(v.Columns, retTypes(e))
+	if err != nil {
+		b.err = err
+		return nil
+	}
+	rowPath, numCols := newJSONTableNestedPath(path, columns)
+	// The values are converted in the strict mode, so the invalid ones are handled
+	// by the ON ERROR clauses instead of being truncated with warnings.
+	sc := &stmtctx.StatementContext{TimeZone: b.ctx.GetSessionVars().Location()}
+	e.generator = newJSONTableRowGenerator(sc, rowPath, numCols)
+	return e
+}
+
 // `getSnapshotTS` returns the timestamp of the snapshot that a reader should read.
 func (b *executorBuilder) getSnapshotTS() (uint64, error) {
 	// `refreshForUpdateTSForRC` should always be invoked before returning the cached value to
@@ -1582,6 +1614,7 @@ func (b *executorBuilder) buildMemTable(v *plannercore.PhysicalMemTable) Executo
 			strings.ToLower(infoschema.TableAnalyzeStatus),
 			strings.ToLower(infoschema.TableClusterInfo),
 			strings.ToLower(infoschema.TableProfiling),
+			strings.ToLower(infoschema.TableOptimizerTrace),
 			strings.ToLower(infoschema.TableCharacterSets),
 			strings.ToLower(infoschema.TableKeyColumn),
 			strings.ToLower(infoschema.TableUserPrivileges),
@@ -1603,7 +1636,10 @@ func (b *executorBuilder) buildMemTable(v *plannercore.PhysicalMemTable) Executo
 			strings.ToLower(infoschema.TableClientErrorsSummaryGlobal),
 			strings.ToLower(infoschema.TableClientErrorsSummaryByUser),
 			strings.ToLower(infoschema.TableClientErrorsSummaryByHost),
-			strings.ToLower(infoschema.TableRegionLabel):
+			strings.ToLower(infoschema.TableRegionLabel),
+			strings.ToLower(infoschema.TableCheckConstraints),
+			strings.ToLower(infoschema.TableTiDBIndexUsage),
+			strings.ToLower(infoschema.ClusterTableTiDBIndexUsage):
 			return &MemTableReaderExec{
 				baseExecutor: newBaseExecutor(b.ctx, v.Schema(), v.ID()),
 				table:        v.Table,
@@ -1843,6 +1879,23 @@ func (b *executorBuilder) buildMaxOneRow(v *plannercore.PhysicalMaxOneRow) Execu
 	return e
 }
 
+func (b *executorBuilder) buildExpand(v *plannercore.PhysicalExpand) Executor {
+	childExec := b.build(v.Children()[0])
+	if b.err != nil {
+		return nil
+	}
+	e := &ExpandExec{
+		baseExecutor:    newBaseExecutor(b.ctx, v.Schema(), v.ID(), childExec),
+		levelEvaluators: make([]*expression.EvaluatorSuite, 0, len(v.LevelExprs)),
+	}
+	for _, exprs := range v.LevelExprs {
+		// The child chunk is evaluated once for each grouping set, so its columns can
+		// not be swapped into the result.
+		e.levelEvaluators = append(e.levelEvaluators, expression.NewEvaluatorSuite(exprs, true))
+	}
+	return e
+}
+
 func (b *executorBuilder) buildUnionAll(v *plannercore.PhysicalUnionAll) Executor {
 	childExecs := make([]Executor, len(v.Children()))
 	for i, child := range v.Children() {
@@ -1966,6 +2019,8 @@ func (b *executorBuilder) buildUpdate(v *plannercore.Update) Executor {
 		tblID2table:               tblID2table,
 		tblColPosInfos:            v.TblColPosInfos,
 		assignFlag:                assignFlag,
+		fkChecker:                 newForeignKeyChecker(b.ctx, b.is),
+		ckChecker:                 newCheckConstraintChecker(b.ctx),
 	}
 	return updateExec
 }
@@ -2008,6 +2063,7 @@ func (b *executorBuilder) buildDelete(v *plannercore.Delete) Executor {
 		tblID2Table:    tblID2table,
 		IsMultiTable:   v.IsMultiTable,
 		tblColPosInfos: v.TblColPosInfos,
+		fkChecker:      newForeignKeyChecker(b.ctx, b.is),
 	}
 	return deleteExec
 }
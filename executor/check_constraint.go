@@ -0,0 +1,105 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"github.com/pingcap/parser/model"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/generatedexpr"
+)
+
+// checkConstraintChecker evaluates the enforced CHECK constraints on the rows written by the DML executors.
+type checkConstraintChecker struct {
+	sctx sessionctx.Context
+	// constraints caches the compiled constraints of the tables, the key is the table ID.
+	constraints map[int64][]*compiledCheckConstraint
+}
+
+type compiledCheckConstraint struct {
+	name string
+	expr expression.Expression
+}
+
+func newCheckConstraintChecker(sctx sessionctx.Context) *checkConstraintChecker {
+	return &checkConstraintChecker{
+		sctx:        sctx,
+		constraints: make(map[int64][]*compiledCheckConstraint),
+	}
+}
+
+// checkRow checks the row to be written into t satisfies the check constraints of t.
+// Like MySQL, a constraint is satisfied if it's evaluated to TRUE or NULL.
+func (c *checkConstraintChecker) checkRow(t table.Table, row []types.Datum) error {
+	if c == nil {
+		return nil
+	}
+	constraints, err := c.getConstraints(t.Meta())
+	if err != nil || len(constraints) == 0 {
+		return err
+	}
+	r := chunk.MutRowFromDatums(row).ToRow()
+	sc := c.sctx.GetSessionVars().StmtCtx
+	for _, constr := range constraints {
+		val, err := constr.expr.Eval(r)
+		if err != nil {
+			return err
+		}
+		if val.IsNull() {
+			continue
+		}
+		b, err := val.ToBool(sc)
+		if err != nil {
+			return err
+		}
+		if b == 0 {
+			return table.ErrCheckConstraintViolated.GenWithStackByArgs(constr.name)
+		}
+	}
+	return nil
+}
+
+// getConstraints compiles the constraints which should be checked for the writing, the constraints being added
+// are checked in the write only state.
+// The columns of the compiled expressions refer to the public columns of the table, which are always in front of
+// the non-public columns of the row.
+func (c *checkConstraintChecker) getConstraints(tblInfo *model.TableInfo) ([]*compiledCheckConstraint, error) {
+	if constraints, ok := c.constraints[tblInfo.ID]; ok {
+		return constraints, nil
+	}
+	var constraints []*compiledCheckConstraint
+	for _, constrInfo := range tblInfo.Constraints {
+		if !constrInfo.Enforced || (constrInfo.State != model.StateWriteOnly && constrInfo.State != model.StatePublic) {
+			continue
+		}
+		node, err := generatedexpr.ParseExpression(constrInfo.ExprString)
+		if err != nil {
+			return nil, err
+		}
+		node, err = generatedexpr.SimpleResolveName(node, tblInfo)
+		if err != nil {
+			return nil, err
+		}
+		expr, err := expression.RewriteSimpleExprWithTableInfo(c.sctx, tblInfo, node)
+		if err != nil {
+			return nil, err
+		}
+		constraints = append(constraints, &compiledCheckConstraint{name: constrInfo.Name.O, expr: expr})
+	}
+	c.constraints[tblInfo.ID] = constraints
+	return constraints, nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/util/testkit"
+)
+
+func (s *testSuite8) TestCheckConstraintOnWrite(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t_check")
+	tk.MustExec("create table t_check(id int primary key, a int check (a >= 0), b int, c int as (a + b), " +
+		"constraint b_lt_a check (b < a), constraint c_lt_10 check (c < 10))")
+	tk.MustExec("insert into t_check(id, a, b) values (1, 2, 1), (2, null, 1)")
+	err := tk.ExecToErr("insert into t_check(id, a, b) values (3, 1, 2)")
+	c.Assert(err, NotNil)
+	c.Assert(err.Error(), Equals, "[table:3819]Check constraint 'b_lt_a' is violated.")
+	// The constraints on the generated columns are checked with the generated values.
+	tk.MustGetErrCode("insert into t_check(id, a, b) values (3, 8, 2)", errno.ErrCheckConstraintViolated)
+	tk.MustGetErrCode("update t_check set b = 3 where id = 1", errno.ErrCheckConstraintViolated)
+	tk.MustGetErrCode("insert into t_check(id, a, b) values (1, 1, 0) on duplicate key update a = -1", errno.ErrCheckConstraintViolated)
+	tk.MustGetErrCode("replace into t_check(id, a, b) values (1, -1, -2)", errno.ErrCheckConstraintViolated)
+	tk.MustExec("insert into t_check(id, a, b) values (1, 1, 0) on duplicate key update a = 3")
+	tk.MustExec("replace into t_check(id, a, b) values (2, 4, 3)")
+	tk.MustQuery("select * from t_check order by id").Check(testkit.Rows("1 3 1 4", "2 4 3 7"))
+
+	tk.MustExec("insert ignore into t_check(id, a, b) values (3, -1, -2), (4, 1, 0)")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 3819 Check constraint 't_check_chk_1' is violated."))
+	tk.MustExec("update ignore t_check set b = b + 3")
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 3819 Check constraint 'b_lt_a' is violated.",
+		"Warning 3819 Check constraint 'b_lt_a' is violated.",
+		"Warning 3819 Check constraint 'b_lt_a' is violated."))
+	tk.MustQuery("select * from t_check order by id").Check(testkit.Rows("1 3 1 4", "2 4 3 7", "4 1 0 1"))
+
+	// The not enforced constraints are ignored.
+	tk.MustExec("alter table t_check alter check b_lt_a not enforced")
+	tk.MustExec("update t_check set b = a + 1 where id = 4")
+	tk.MustQuery("select * from t_check where id = 4").Check(testkit.Rows("4 1 2 3"))
+	tk.MustExec("drop table t_check")
+}
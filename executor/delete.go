@@ -20,7 +20,6 @@ import (
 	"github.com/pingcap/tidb/config"
 	"github.com/pingcap/tidb/kv"
 	plannercore "github.com/pingcap/tidb/planner/core"
-	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/table"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/chunk"
@@ -39,6 +38,7 @@ type DeleteExec struct {
 	// the columns ordinals is present in ordinal range format, @see plannercore.TblColPosInfos
 	tblColPosInfos plannercore.TblColPosInfoSlice
 	memTracker     *memory.Tracker
+	fkChecker      *foreignKeyChecker
 }
 
 // Next implements the Executor Next interface.
@@ -50,7 +50,7 @@ func (e *DeleteExec) Next(ctx context.Context, req *chunk.Chunk) error {
 	return e.deleteSingleTableByChunk(ctx)
 }
 
-func (e *DeleteExec) deleteOneRow(tbl table.Table, handleCols plannercore.HandleCols, isExtraHandle bool, row []types.Datum) error {
+func (e *DeleteExec) deleteOneRow(ctx context.Context, tbl table.Table, handleCols plannercore.HandleCols, isExtraHandle bool, row []types.Datum) error {
 	end := len(row)
 	if isExtraHandle {
 		end--
@@ -59,7 +59,7 @@ func (e *DeleteExec) deleteOneRow(tbl table.Table, handleCols plannercore.Handle
 	if err != nil {
 		return err
 	}
-	err = e.removeRow(e.ctx, tbl, handle, row[:end])
+	err = e.removeRow(ctx, tbl, handle, row[:end])
 	if err != nil {
 		return err
 	}
@@ -109,7 +109,7 @@ func (e *DeleteExec) deleteSingleTableByChunk(ctx context.Context) error {
 			}
 
 			datumRow := chunkRow.GetDatumRow(fields)
-			err = e.deleteOneRow(tbl, handleCols, isExtrahandle, datumRow)
+			err = e.deleteOneRow(ctx, tbl, handleCols, isExtrahandle, datumRow)
 			if err != nil {
 				return err
 			}
@@ -180,14 +180,14 @@ func (e *DeleteExec) deleteMultiTablesByChunk(ctx context.Context) error {
 		chk = chunk.Renew(chk, e.maxChunkSize)
 	}
 
-	return e.removeRowsInTblRowMap(tblRowMap)
+	return e.removeRowsInTblRowMap(ctx, tblRowMap)
 }
 
-func (e *DeleteExec) removeRowsInTblRowMap(tblRowMap tableRowMapType) error {
+func (e *DeleteExec) removeRowsInTblRowMap(ctx context.Context, tblRowMap tableRowMapType) error {
 	for id, rowMap := range tblRowMap {
 		var err error
 		rowMap.Range(func(h kv.Handle, val interface{}) bool {
-			err = e.removeRow(e.ctx, e.tblID2Table[id], h, val.([]types.Datum))
+			err = e.removeRow(ctx, e.tblID2Table[id], h, val.([]types.Datum))
 			return err == nil
 		})
 		if err != nil {
@@ -198,18 +198,22 @@ func (e *DeleteExec) removeRowsInTblRowMap(tblRowMap tableRowMapType) error {
 	return nil
 }
 
-func (e *DeleteExec) removeRow(ctx sessionctx.Context, t table.Table, h kv.Handle, data []types.Datum) error {
+func (e *DeleteExec) removeRow(ctx context.Context, t table.Table, h kv.Handle, data []types.Datum) error {
 	txnState, err := e.ctx.Txn(false)
 	if err != nil {
 		return err
 	}
 	memUsageOfTxnState := txnState.Size()
-	err = t.RemoveRecord(ctx, h, data)
+	err = t.RemoveRecord(e.ctx, h, data)
+	if err != nil {
+		return err
+	}
+	err = e.fkChecker.onParentRowRemoved(ctx, t, data)
 	if err != nil {
 		return err
 	}
 	e.memTracker.Consume(int64(txnState.Size() - memUsageOfTxnState))
-	ctx.GetSessionVars().StmtCtx.AddAffectedRows(1)
+	e.ctx.GetSessionVars().StmtCtx.AddAffectedRows(1)
 	return nil
 }
 
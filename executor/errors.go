@@ -60,7 +60,15 @@ var (
 	ErrCTEMaxRecursionDepth          = dbterror.ClassExecutor.NewStd(mysql.ErrCTEMaxRecursionDepth)
 	ErrDataInConsistentExtraIndex    = dbterror.ClassExecutor.NewStd(mysql.ErrDataInConsistentExtraIndex)
 	ErrDataInConsistentMisMatchIndex = dbterror.ClassExecutor.NewStd(mysql.ErrDataInConsistentMisMatchIndex)
+	ErrRowIsReferenced2              = dbterror.ClassExecutor.NewStd(mysql.ErrRowIsReferenced2)
+	ErrNoReferencedRow2              = dbterror.ClassExecutor.NewStd(mysql.ErrNoReferencedRow2)
+	ErrFkDepthExceeded               = dbterror.ClassExecutor.NewStd(mysql.ErrFkDepthExceeded)
+
+	ErrCredentialsContradictToHistory = dbterror.ClassExecutor.NewStd(mysql.ErrCredentialsContradictToHistory)
+	ErrNotValidPassword               = dbterror.ClassExecutor.NewStd(mysql.ErrNotValidPassword)
 
 	errUnsupportedFlashbackTmpTable = dbterror.ClassDDL.NewStdErr(mysql.ErrUnsupportedDDLOperation, parser_mysql.Message("Recover/flashback table is not supported on temporary tables", nil))
 	errTruncateWrongInsertValue     = dbterror.ClassTable.NewStdErr(mysql.ErrTruncatedWrongValue, parser_mysql.Message("Incorrect %-.32s value: '%-.128s' for column '%.192s' at row %d", nil))
+	errMissingJSONTableValue        = dbterror.ClassExecutor.NewStd(mysql.ErrMissingJSONTableValue)
+	errWrongJSONTableValue          = dbterror.ClassExecutor.NewStd(mysql.ErrWrongJSONTableValue)
 )
@@ -320,7 +320,8 @@ func (s *testSuiteP1) TestShow(c *C) {
 		"utf8mb4 UTF-8 Unicode utf8mb4_bin 4",
 		"ascii US ASCII ascii_bin 1",
 		"latin1 Latin1 latin1_bin 1",
-		"binary binary binary 1"))
+		"binary binary binary 1",
+		"gbk GBK Simplified Chinese gbk_chinese_ci 2"))
 	c.Assert(len(tk.MustQuery("show master status").Rows()), Equals, 1)
 	tk.MustQuery("show create database test_show").Check(testkit.Rows("test_show CREATE DATABASE `test_show` /*!40100 DEFAULT CHARACTER SET utf8mb4 */"))
 	tk.MustQuery("show privileges").Check(testkit.Rows("Alter Tables To alter the table",
@@ -2138,6 +2139,41 @@ func (s *testSuiteP1) TestGeneratedColumnRead(c *C) {
 	}
 }
 
+func (s *testSuiteP1) TestJSONTable(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustQuery(`select * from json_table('[{"a": 1, "b": "x"}, {"a": 2}]', '$[*]' columns(id for ordinality, a int path '$.a', b varchar(10) path '$.b' default '"y"' on empty)) as jt`).
+		Check(testkit.Rows("1 1 x", "2 2 y"))
+	tk.MustQuery(`select * from json_table('{"a": [1, 2], "b": {}}', '$' columns(has_a int exists path '$.a', nested path '$.a[*]' columns(v int path '$'))) as jt`).
+		Check(testkit.Rows("1 1", "1 2"))
+	tk.MustQuery(`select * from json_table(null, '$[*]' columns(a int path '$')) as jt`).Check(testkit.Rows())
+	tk.MustQuery(`select * from json_table('[1, "x"]', '$[*]' columns(a int path '$' null on error)) as jt`).Check(testkit.Rows("1", "<nil>"))
+
+	// JSON_TABLE can refer to the columns of the preceding tables.
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t(id int, doc json)")
+	tk.MustExec(`insert into t values (1, '[1, 2]'), (2, '[3]'), (3, '[]')`)
+	tk.MustQuery(`select t.id, jt.v from t, json_table(t.doc, '$[*]' columns(v int path '$')) as jt order by t.id, jt.v`).
+		Check(testkit.Rows("1 1", "1 2", "2 3"))
+	tk.MustQuery(`select t.id, jt.v from t left join json_table(t.doc, '$[*]' columns(v int path '$')) as jt on true order by t.id, jt.v`).
+		Check(testkit.Rows("1 1", "1 2", "2 3", "3 <nil>"))
+	tk.MustQuery(`select jt.v, t.id from json_table('[1, 2]', '$[*]' columns(v int path '$')) as jt join t on jt.v = t.id order by jt.v`).
+		Check(testkit.Rows("1 1", "2 2"))
+	tk.MustQuery(`select id from t where exists (select 1 from json_table(t.doc, '$[*]' columns(v int path '$')) as jt where jt.v > 1) order by id`).
+		Check(testkit.Rows("1", "2"))
+	rows := tk.MustQuery(`explain select t.id, jt.v from t, json_table(t.doc, '$[*]' columns(v int path '$')) as jt`).Rows()
+	c.Assert(fmt.Sprintf("%v", rows), Matches, ".*Apply.*JSONTable.*")
+
+	err := tk.ExecToErr(`select * from json_table('[1, "x"]', '$[*]' columns(a int path '$' error on error)) as jt`)
+	c.Assert(err, NotNil)
+	err = tk.ExecToErr(`select * from json_table('{}', '$' columns(a int path '$.a' error on empty)) as jt`)
+	c.Assert(err, NotNil)
+	err = tk.ExecToErr(`select * from json_table(1, '$' columns(a int path '$')) as jt`)
+	c.Assert(err, NotNil)
+	err = tk.ExecToErr(`select * from json_table('[]', '$[' columns(a int path '$')) as jt`)
+	c.Assert(err, NotNil)
+}
+
 // TestGeneratedColumnRead tests generated columns using point get and batch point get
 func (s *testSuiteP1) TestGeneratedColumnPointGet(c *C) {
 	tk := testkit.NewTestKit(c, s.store)
@@ -0,0 +1,67 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/util/chunk"
+)
+
+// ExpandExec outputs every row of its child once for each grouping set. It is
+// built under the aggregation of GROUP BY ... WITH ROLLUP.
+type ExpandExec struct {
+	baseExecutor
+
+	// levelEvaluators evaluates the projection of each grouping set.
+	levelEvaluators []*expression.EvaluatorSuite
+	// childResult stores the child chunk which is being expanded.
+	childResult *chunk.Chunk
+	// level is the index of the grouping set to output for childResult next.
+	level int
+}
+
+// Open implements the Executor Open interface.
+func (e *ExpandExec) Open(ctx context.Context) error {
+	if err := e.baseExecutor.Open(ctx); err != nil {
+		return err
+	}
+	e.childResult = newFirstChunk(e.children[0])
+	e.level = len(e.levelEvaluators)
+	return nil
+}
+
+// Next implements the Executor Next interface.
+func (e *ExpandExec) Next(ctx context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	if e.level >= len(e.levelEvaluators) {
+		if err := Next(ctx, e.children[0], e.childResult); err != nil {
+			return err
+		}
+		if e.childResult.NumRows() == 0 {
+			return nil
+		}
+		e.level = 0
+	}
+	err := e.levelEvaluators[e.level].Run(e.ctx, e.childResult, req)
+	e.level++
+	return err
+}
+
+// Close implements the Executor Close interface.
+func (e *ExpandExec) Close() error {
+	e.childResult = nil
+	return e.baseExecutor.Close()
+}
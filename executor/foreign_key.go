@@ -0,0 +1,698 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/model"
+	"github.com/pingcap/parser/mysql"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/table"
+	"github.com/pingcap/tidb/table/tables"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/generatedexpr"
+)
+
+// maxForeignKeyCascadeDepth is the max depth of the cascading referential actions, it's the same as MySQL.
+const maxForeignKeyCascadeDepth = 15
+
+// foreignKeyChecker enforces the foreign key constraints for the DML executors.
+// For a child row, it checks the referenced parent row exists and locks it.
+// For a parent row, it applies the ON DELETE/ON UPDATE referential actions on the child rows referencing it.
+// A nil *foreignKeyChecker means `foreign_key_checks` is disabled, all of its methods do nothing then.
+type foreignKeyChecker struct {
+	sctx sessionctx.Context
+	is   infoschema.InfoSchema
+	// referredBy caches the foreign keys which reference a table, the key is the parent table ID.
+	referredBy map[int64][]*referringForeignKey
+	// genExprs caches the generated column expressions of the child tables, the key is the child table ID.
+	genExprs map[int64][]expression.Expression
+	// depth is the depth of the current cascading referential action.
+	depth int
+}
+
+// referringForeignKey is a foreign key of the child table which references the parent table.
+type referringForeignKey struct {
+	dbName  model.CIStr
+	child   table.Table
+	fk      *model.FKInfo
+	cols    []*table.Column
+	refCols []*table.Column
+}
+
+// fkRow is a row found by the foreign key lookup, t is the table or partition the row is stored in.
+type fkRow struct {
+	t      table.Table
+	handle kv.Handle
+}
+
+func newForeignKeyChecker(sctx sessionctx.Context, is infoschema.InfoSchema) *foreignKeyChecker {
+	if !sctx.GetSessionVars().ForeignKeyChecks {
+		return nil
+	}
+	return &foreignKeyChecker{
+		sctx:       sctx,
+		is:         is,
+		referredBy: make(map[int64][]*referringForeignKey),
+		genExprs:   make(map[int64][]expression.Expression),
+	}
+}
+
+// checkParentRows checks the parent rows referenced by the row of t exist and locks them.
+// If modified is not nil, only the foreign keys containing the modified columns are checked.
+func (c *foreignKeyChecker) checkParentRows(ctx context.Context, t table.Table, row []types.Datum, modified []bool) error {
+	if c == nil || !hasForeignKeys(t.Meta()) {
+		return nil
+	}
+	dbName, err := c.schemaName(t.Meta())
+	if err != nil {
+		return err
+	}
+	for _, fk := range t.Meta().ForeignKeys {
+		if fk.State != model.StatePublic {
+			continue
+		}
+		cols, err := findForeignKeyColumns(t, fk.Cols)
+		if err != nil {
+			return err
+		}
+		if modified != nil && !anyColumnModified(cols, modified) {
+			continue
+		}
+		vals := fetchColumnValues(row, cols)
+		if hasNullValue(vals) {
+			continue
+		}
+		violated := ErrNoReferencedRow2.GenWithStackByArgs(foreignKeyDesc(dbName, t.Meta(), fk))
+		parent, err := c.is.TableByName(dbName, fk.RefTable)
+		if err != nil {
+			if infoschema.ErrTableNotExists.Equal(err) {
+				return violated
+			}
+			return err
+		}
+		refCols, err := findForeignKeyColumns(parent, fk.RefCols)
+		if err != nil {
+			return violated
+		}
+		// A row referencing itself is always valid.
+		if parent.Meta().ID == t.Meta().ID && c.datumsEqual(vals, fetchColumnValues(row, refCols)) {
+			continue
+		}
+		refVals, err := c.castValues(vals, refCols)
+		if err != nil {
+			return violated
+		}
+		rows, err := c.lookupRows(ctx, parent, refCols, refVals, 1)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return violated
+		}
+		// Lock the parent row to prevent it from being deleted or updated by the concurrent transactions.
+		recordKey := tablecodec.EncodeRecordKey(rows[0].t.RecordPrefix(), rows[0].handle)
+		vars := c.sctx.GetSessionVars()
+		if err = doLockKeys(ctx, c.sctx, newLockCtx(vars, vars.LockWaitTimeout), recordKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// onParentRowRemoved applies the ON DELETE referential actions on the child rows referencing the removed row of t.
+func (c *foreignKeyChecker) onParentRowRemoved(ctx context.Context, t table.Table, oldRow []types.Datum) error {
+	return c.onParentRowChanged(ctx, t, oldRow, nil, nil)
+}
+
+// onParentRowUpdated applies the ON UPDATE referential actions on the child rows referencing the updated row of t.
+func (c *foreignKeyChecker) onParentRowUpdated(ctx context.Context, t table.Table, oldRow, newRow []types.Datum, modified []bool) error {
+	return c.onParentRowChanged(ctx, t, oldRow, newRow, modified)
+}
+
+func (c *foreignKeyChecker) onParentRowChanged(ctx context.Context, t table.Table, oldRow, newRow []types.Datum, modified []bool) error {
+	if c == nil || t.Meta().TempTableType != model.TempTableNone {
+		return nil
+	}
+	referringFKs, err := c.getReferringForeignKeys(t)
+	if err != nil {
+		return err
+	}
+	for _, rf := range referringFKs {
+		if modified != nil && !anyColumnModified(rf.refCols, modified) {
+			continue
+		}
+		oldVals := fetchColumnValues(oldRow, rf.refCols)
+		if hasNullValue(oldVals) {
+			continue
+		}
+		var newVals []types.Datum
+		if newRow != nil {
+			newVals = fetchColumnValues(newRow, rf.refCols)
+			if c.datumsEqual(oldVals, newVals) {
+				continue
+			}
+		}
+		childVals, err := c.castValues(oldVals, rf.cols)
+		if err != nil {
+			// The value can't be stored in the child table, so no child row references it.
+			continue
+		}
+		action := ast.ReferOptionType(rf.fk.OnDelete)
+		if newRow != nil {
+			action = ast.ReferOptionType(rf.fk.OnUpdate)
+		}
+		limit := 0
+		if action != ast.ReferOptionCascade && action != ast.ReferOptionSetNull {
+			limit = 1
+		}
+		rows, err := c.lookupRows(ctx, rf.child, rf.cols, childVals, limit)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			continue
+		}
+		violated := ErrRowIsReferenced2.GenWithStackByArgs(foreignKeyDesc(rf.dbName, rf.child.Meta(), rf.fk))
+		switch action {
+		case ast.ReferOptionCascade:
+			if newRow == nil {
+				err = c.cascadeDelete(ctx, rf, rows)
+			} else {
+				err = c.cascadeUpdate(ctx, rf, rows, newVals, violated)
+			}
+		case ast.ReferOptionSetNull:
+			for _, col := range rf.cols {
+				if mysql.HasNotNullFlag(col.Flag) {
+					return violated
+				}
+			}
+			nulls := make([]types.Datum, len(rf.cols))
+			err = c.cascadeUpdate(ctx, rf, rows, nulls, violated)
+		default:
+			// RESTRICT, NO ACTION and the unsupported SET DEFAULT reject the change.
+			return violated
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *foreignKeyChecker) cascadeDelete(ctx context.Context, rf *referringForeignKey, rows []fkRow) error {
+	if c.depth >= maxForeignKeyCascadeDepth {
+		return ErrFkDepthExceeded.GenWithStackByArgs(maxForeignKeyCascadeDepth)
+	}
+	c.depth++
+	defer func() { c.depth-- }()
+	for _, r := range rows {
+		oldRow, err := c.getRow(ctx, rf, r)
+		if err != nil {
+			return err
+		}
+		if err = rf.child.RemoveRecord(c.sctx, r.handle, oldRow); err != nil {
+			return err
+		}
+		if err = c.onParentRowRemoved(ctx, rf.child, oldRow); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cascadeUpdate sets the foreign key columns of the child rows to vals, it's used by both CASCADE and SET NULL.
+func (c *foreignKeyChecker) cascadeUpdate(ctx context.Context, rf *referringForeignKey, rows []fkRow, vals []types.Datum, violated error) error {
+	if c.depth >= maxForeignKeyCascadeDepth {
+		return ErrFkDepthExceeded.GenWithStackByArgs(maxForeignKeyCascadeDepth)
+	}
+	c.depth++
+	defer func() { c.depth-- }()
+	newVals, err := c.castValues(vals, rf.cols)
+	if err != nil {
+		return violated
+	}
+	child := rf.child
+	genExprs, err := c.getGenExprs(rf)
+	if err != nil {
+		return err
+	}
+	for _, r := range rows {
+		oldRow, err := c.getRow(ctx, rf, r)
+		if err != nil {
+			return err
+		}
+		newRow := make([]types.Datum, len(oldRow))
+		copy(newRow, oldRow)
+		modified := make([]bool, len(oldRow))
+		handleChanged := false
+		for i, col := range rf.cols {
+			newRow[col.Offset] = newVals[i]
+			modified[col.Offset] = true
+			if col.IsPKHandleColumn(child.Meta()) || col.IsCommonHandleColumn(child.Meta()) {
+				handleChanged = true
+			}
+		}
+		if err = c.fillGeneratedColumns(child, genExprs, newRow, modified); err != nil {
+			return err
+		}
+		if handleChanged {
+			if err = child.RemoveRecord(c.sctx, r.handle, oldRow); err != nil {
+				return err
+			}
+			_, err = child.AddRecord(c.sctx, newRow, table.IsUpdate, table.WithCtx(ctx))
+		} else {
+			err = child.UpdateRecord(ctx, c.sctx, r.handle, oldRow, newRow, modified)
+		}
+		if err != nil {
+			return err
+		}
+		if err = c.checkParentRows(ctx, child, newRow, modified); err != nil {
+			return err
+		}
+		if err = c.onParentRowUpdated(ctx, child, oldRow, newRow, modified); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fillGeneratedColumns evaluates the generated columns of row again after the columns they depend on are modified.
+func (c *foreignKeyChecker) fillGeneratedColumns(t table.Table, genExprs []expression.Expression, row []types.Datum, modified []bool) error {
+	gIdx := 0
+	for _, col := range t.WritableCols() {
+		if !col.IsGenerated() {
+			continue
+		}
+		val, err := genExprs[gIdx].Eval(chunk.MutRowFromDatums(row).ToRow())
+		if err != nil {
+			return err
+		}
+		row[col.Offset], err = table.CastValue(c.sctx, val, col.ToInfo(), false, false)
+		if err != nil {
+			return err
+		}
+		modified[col.Offset] = true
+		gIdx++
+	}
+	return nil
+}
+
+// getRow reads the whole row of the child table, including the values of the virtual generated columns.
+func (c *foreignKeyChecker) getRow(ctx context.Context, rf *referringForeignKey, r fkRow) ([]types.Datum, error) {
+	txn, err := c.sctx.Txn(true)
+	if err != nil {
+		return nil, err
+	}
+	genExprs, err := c.getGenExprs(rf)
+	if err != nil {
+		return nil, err
+	}
+	return getOldRow(ctx, c.sctx, txn, r.t, r.handle, genExprs)
+}
+
+// getGenExprs builds the expressions of the generated columns of the child table, in the order of `WritableCols`.
+func (c *foreignKeyChecker) getGenExprs(rf *referringForeignKey) ([]expression.Expression, error) {
+	tblInfo := rf.child.Meta()
+	if exprs, ok := c.genExprs[tblInfo.ID]; ok {
+		return exprs, nil
+	}
+	cols := rf.child.WritableCols()
+	colInfos := make([]*model.ColumnInfo, 0, len(cols))
+	for _, col := range cols {
+		colInfos = append(colInfos, col.ToInfo())
+	}
+	columns, names, err := expression.ColumnInfos2ColumnsAndNames(c.sctx, rf.dbName, tblInfo.Name, colInfos, tblInfo)
+	if err != nil {
+		return nil, err
+	}
+	schema := expression.NewSchema(columns...)
+	var exprs []expression.Expression
+	for _, col := range cols {
+		if !col.IsGenerated() {
+			continue
+		}
+		node, err := generatedexpr.ParseExpression(col.GeneratedExprString)
+		if err != nil {
+			return nil, err
+		}
+		node, err = generatedexpr.SimpleResolveName(node, tblInfo)
+		if err != nil {
+			return nil, err
+		}
+		expr, err := expression.RewriteAstExpr(c.sctx, node, schema, names)
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+	}
+	c.genExprs[tblInfo.ID] = exprs
+	return exprs, nil
+}
+
+// getReferringForeignKeys gets the foreign keys which reference t.
+// The referenced table of a foreign key is always in the same schema as the child table.
+func (c *foreignKeyChecker) getReferringForeignKeys(t table.Table) ([]*referringForeignKey, error) {
+	tblInfo := t.Meta()
+	if fks, ok := c.referredBy[tblInfo.ID]; ok {
+		return fks, nil
+	}
+	dbName, err := c.schemaName(tblInfo)
+	if err != nil {
+		return nil, err
+	}
+	var result []*referringForeignKey
+	for _, child := range c.is.SchemaTables(dbName) {
+		if !hasForeignKeys(child.Meta()) {
+			continue
+		}
+		for _, fk := range child.Meta().ForeignKeys {
+			if fk.State != model.StatePublic || fk.RefTable.L != tblInfo.Name.L {
+				continue
+			}
+			cols, err := findForeignKeyColumns(child, fk.Cols)
+			if err != nil {
+				return nil, err
+			}
+			refCols, err := findForeignKeyColumns(t, fk.RefCols)
+			if err != nil {
+				// The referenced columns don't exist, so no row can be referenced.
+				continue
+			}
+			result = append(result, &referringForeignKey{
+				dbName:  dbName,
+				child:   child,
+				fk:      fk,
+				cols:    cols,
+				refCols: refCols,
+			})
+		}
+	}
+	c.referredBy[tblInfo.ID] = result
+	return result, nil
+}
+
+func (c *foreignKeyChecker) schemaName(tblInfo *model.TableInfo) (model.CIStr, error) {
+	dbInfo, ok := c.is.SchemaByTable(tblInfo)
+	if !ok {
+		return model.CIStr{}, infoschema.ErrTableNotExists.GenWithStackByArgs("", tblInfo.Name.O)
+	}
+	return dbInfo.Name, nil
+}
+
+// lookupRows finds at most limit rows of t whose cols equal to vals, there is no limit if limit is 0.
+func (c *foreignKeyChecker) lookupRows(ctx context.Context, t table.Table, cols []*table.Column, vals []types.Datum, limit int) ([]fkRow, error) {
+	txn, err := c.sctx.Txn(true)
+	if err != nil {
+		return nil, err
+	}
+	pt, ok := t.(table.PartitionedTable)
+	if !ok {
+		return c.lookupRowsInTable(ctx, txn, t, cols, vals, limit)
+	}
+	var result []fkRow
+	for _, def := range t.Meta().GetPartitionInfo().Definitions {
+		partLimit := 0
+		if limit > 0 {
+			partLimit = limit - len(result)
+		}
+		rows, err := c.lookupRowsInTable(ctx, txn, pt.GetPartition(def.ID), cols, vals, partLimit)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, rows...)
+		if limit > 0 && len(result) >= limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+// lookupRowsInTable finds the rows in a table or a partition. It gets the row by the handle or a unique index
+// if possible, otherwise scans an index prefixed by cols, and it scans the whole table at last.
+func (c *foreignKeyChecker) lookupRowsInTable(ctx context.Context, txn kv.Transaction, t table.Table, cols []*table.Column,
+	vals []types.Datum, limit int) ([]fkRow, error) {
+	key, isRecordKey, err := getForeignKeyPointKey(c.sctx, t, cols, vals)
+	if err != nil {
+		return nil, err
+	}
+	if key != nil {
+		val, err := txn.Get(ctx, key)
+		if kv.IsErrNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var handle kv.Handle
+		if isRecordKey {
+			handle, err = tablecodec.DecodeRowKey(key)
+		} else {
+			handle, err = tablecodec.DecodeHandleInUniqueIndexValue(val, t.Meta().IsCommonHandle)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return []fkRow{{t: t, handle: handle}}, nil
+	}
+
+	sc := c.sctx.GetSessionVars().StmtCtx
+	if idx := findForeignKeyIndex(t, cols); idx != nil {
+		idxVals := make([]types.Datum, len(cols))
+		for i, idxCol := range idx.Meta().Columns[:len(cols)] {
+			for j, col := range cols {
+				if col.Offset == idxCol.Offset {
+					idxVals[i] = vals[j]
+				}
+			}
+		}
+		prefix, _, err := idx.GenIndexKey(sc, idxVals, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		it, err := txn.Iter(prefix, kv.Key(prefix).PrefixNext())
+		if err != nil {
+			return nil, err
+		}
+		defer it.Close()
+		var result []fkRow
+		for it.Valid() && it.Key().HasPrefix(prefix) {
+			handle, err := tablecodec.DecodeIndexHandle(it.Key(), it.Value(), len(idx.Meta().Columns))
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, fkRow{t: t, handle: handle})
+			if limit > 0 && len(result) >= limit {
+				break
+			}
+			if err = it.Next(); err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
+	}
+
+	var result []fkRow
+	err = tables.IterRecords(t, c.sctx, t.Cols(), func(h kv.Handle, rec []types.Datum, _ []*table.Column) (bool, error) {
+		for i, col := range cols {
+			cmp, err := vals[i].CompareDatum(sc, &rec[col.Offset])
+			if err != nil || cmp != 0 {
+				return true, err
+			}
+		}
+		result = append(result, fkRow{t: t, handle: h})
+		return limit <= 0 || len(result) < limit, nil
+	})
+	return result, err
+}
+
+// getForeignKeyPointKey gets the record key or the unique index key to get the row of t whose cols equal to vals.
+// It returns nil if the row can't be got by a point get.
+func getForeignKeyPointKey(sctx sessionctx.Context, t table.Table, cols []*table.Column, vals []types.Datum) (key kv.Key, isRecordKey bool, err error) {
+	tblInfo := t.Meta()
+	sc := sctx.GetSessionVars().StmtCtx
+	if tblInfo.PKIsHandle {
+		if len(cols) == 1 && cols[0].IsPKHandleColumn(tblInfo) {
+			return tablecodec.EncodeRecordKey(t.RecordPrefix(), kv.IntHandle(vals[0].GetInt64())), true, nil
+		}
+	}
+	row := make([]types.Datum, len(t.WritableCols()))
+	for i, col := range cols {
+		row[col.Offset] = vals[i]
+	}
+	if tblInfo.IsCommonHandle {
+		pkIdx := tables.FindPrimaryIndex(tblInfo)
+		if isForeignKeyIndex(pkIdx, cols) && len(pkIdx.Columns) == len(cols) {
+			handleCols := make([]*table.Column, 0, len(pkIdx.Columns))
+			for _, idxCol := range pkIdx.Columns {
+				handleCols = append(handleCols, t.Cols()[idxCol.Offset])
+			}
+			handle, err := buildHandleFromDatumRow(sc, row, handleCols, pkIdx)
+			if err != nil {
+				return nil, false, err
+			}
+			return tablecodec.EncodeRecordKey(t.RecordPrefix(), handle), true, nil
+		}
+	}
+	for _, idx := range t.Indices() {
+		idxInfo := idx.Meta()
+		if !idxInfo.Unique || (idxInfo.Primary && tblInfo.IsCommonHandle) || len(idxInfo.Columns) != len(cols) || !isForeignKeyIndex(idxInfo, cols) {
+			continue
+		}
+		idxVals, err := idx.FetchValues(row, nil)
+		if err != nil {
+			return nil, false, err
+		}
+		key, distinct, err := idx.GenIndexKey(sc, idxVals, nil, nil)
+		if err != nil {
+			return nil, false, err
+		}
+		if distinct {
+			return key, false, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// findForeignKeyIndex finds an index of t whose leading columns are cols.
+func findForeignKeyIndex(t table.Table, cols []*table.Column) table.Index {
+	for _, idx := range t.Indices() {
+		if isForeignKeyIndex(idx.Meta(), cols) {
+			return idx
+		}
+	}
+	return nil
+}
+
+// isForeignKeyIndex checks whether the leading columns of the index are cols in any order,
+// and the index can be used to look up the rows by the values of cols.
+func isForeignKeyIndex(idxInfo *model.IndexInfo, cols []*table.Column) bool {
+	if idxInfo == nil || idxInfo.State != model.StatePublic || idxInfo.Global || len(idxInfo.Columns) < len(cols) {
+		return false
+	}
+	for _, idxCol := range idxInfo.Columns[:len(cols)] {
+		if idxCol.Length != types.UnspecifiedLength {
+			return false
+		}
+		found := false
+		for _, col := range cols {
+			if col.Offset == idxCol.Offset {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// castValues casts vals to the types of cols, an error is returned if any value can't be stored in the column.
+func (c *foreignKeyChecker) castValues(vals []types.Datum, cols []*table.Column) ([]types.Datum, error) {
+	casted := make([]types.Datum, len(vals))
+	for i, col := range cols {
+		if vals[i].IsNull() {
+			continue
+		}
+		v, err := table.CastValue(c.sctx, vals[i], col.ToInfo(), true, false)
+		if err != nil {
+			return nil, err
+		}
+		casted[i] = v
+	}
+	return casted, nil
+}
+
+func (c *foreignKeyChecker) datumsEqual(a, b []types.Datum) bool {
+	sc := c.sctx.GetSessionVars().StmtCtx
+	for i := range a {
+		cmp, err := a[i].CompareDatum(sc, &b[i])
+		if err != nil || cmp != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func hasForeignKeys(tblInfo *model.TableInfo) bool {
+	return len(tblInfo.ForeignKeys) > 0 && tblInfo.TempTableType == model.TempTableNone
+}
+
+func findForeignKeyColumns(t table.Table, names []model.CIStr) ([]*table.Column, error) {
+	cols := make([]*table.Column, 0, len(names))
+	for _, name := range names {
+		col := table.FindCol(t.Cols(), name.L)
+		if col == nil {
+			return nil, table.ErrUnknownColumn.GenWithStackByArgs(name.O)
+		}
+		cols = append(cols, col)
+	}
+	return cols, nil
+}
+
+func fetchColumnValues(row []types.Datum, cols []*table.Column) []types.Datum {
+	vals := make([]types.Datum, 0, len(cols))
+	for _, col := range cols {
+		vals = append(vals, row[col.Offset])
+	}
+	return vals
+}
+
+func anyColumnModified(cols []*table.Column, modified []bool) bool {
+	for _, col := range cols {
+		if modified[col.Offset] {
+			return true
+		}
+	}
+	return false
+}
+
+func hasNullValue(vals []types.Datum) bool {
+	for _, v := range vals {
+		if v.IsNull() {
+			return true
+		}
+	}
+	return false
+}
+
+// foreignKeyDesc describes the foreign key in the error message, like
+// "`test`.`child`, CONSTRAINT `fk_1` FOREIGN KEY (`pid`) REFERENCES `parent` (`id`)".
+func foreignKeyDesc(dbName model.CIStr, tblInfo *model.TableInfo, fk *model.FKInfo) string {
+	var sb strings.Builder
+	sb.WriteString("`" + dbName.O + "`.`" + tblInfo.Name.O + "`, CONSTRAINT `" + fk.Name.O + "` FOREIGN KEY (")
+	writeColumnNames(&sb, fk.Cols)
+	sb.WriteString(") REFERENCES `" + fk.RefTable.O + "` (")
+	writeColumnNames(&sb, fk.RefCols)
+	sb.WriteString(")")
+	return sb.String()
+}
+
+func writeColumnNames(sb *strings.Builder, names []model.CIStr) {
+	for i, name := range names {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString("`" + name.O + "`")
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/errno"
+	"github.com/pingcap/tidb/util/testkit"
+)
+
+func (s *testSuite8) TestForeignKeyCheckOnChild(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t_child, t_parent")
+	tk.MustExec("create table t_parent(id int primary key, code varchar(10), unique key(code))")
+	tk.MustExec("create table t_child(id int primary key, pid int, pcode varchar(10), index(pid), index(pcode)," +
+		"constraint fk_pid foreign key (pid) references t_parent(id)," +
+		"constraint fk_code foreign key (pcode) references t_parent(code))")
+	tk.MustExec("insert into t_parent values (1, 'a'), (2, 'b')")
+
+	// The constraints are not checked when foreign_key_checks is disabled.
+	tk.MustExec("set @@foreign_key_checks = 0")
+	tk.MustExec("insert into t_child values (100, 100, 'z')")
+	tk.MustExec("delete from t_child")
+
+	tk.MustExec("set @@foreign_key_checks = 1")
+	tk.MustExec("insert into t_child values (1, 1, 'a'), (2, 2, 'b'), (3, null, null)")
+	err := tk.ExecToErr("insert into t_child values (4, 3, 'a')")
+	c.Assert(err, NotNil)
+	c.Assert(err.Error(), Equals, "[executor:1452]Cannot add or update a child row: a foreign key constraint fails "+
+		"(`test`.`t_child`, CONSTRAINT `fk_pid` FOREIGN KEY (`pid`) REFERENCES `t_parent` (`id`))")
+	tk.MustGetErrCode("insert into t_child values (4, 1, 'c')", errno.ErrNoReferencedRow2)
+	tk.MustGetErrCode("update t_child set pid = 3 where id = 1", errno.ErrNoReferencedRow2)
+	tk.MustGetErrCode("insert into t_child values (1, 1, 'a') on duplicate key update pcode = 'c'", errno.ErrNoReferencedRow2)
+	tk.MustGetErrCode("replace into t_child values (1, 3, 'a')", errno.ErrNoReferencedRow2)
+	tk.MustExec("update t_child set pid = 2 where id = 1")
+	tk.MustExec("insert ignore into t_child values (5, 5, 'a'), (6, 1, 'a')")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 1452 Cannot add or update a child row: a foreign key constraint fails " +
+		"(`test`.`t_child`, CONSTRAINT `fk_pid` FOREIGN KEY (`pid`) REFERENCES `t_parent` (`id`))"))
+	tk.MustQuery("select * from t_child order by id").Check(testkit.Rows("1 2 a", "2 2 b", "3 <nil> <nil>", "6 1 a"))
+
+	// The parent rows can't be deleted or updated when they are referenced.
+	tk.MustGetErrCode("delete from t_parent where id = 1", errno.ErrRowIsReferenced2)
+	tk.MustGetErrCode("update t_parent set code = 'c' where id = 2", errno.ErrRowIsReferenced2)
+	tk.MustExec("delete from t_child where id = 6")
+	tk.MustExec("update t_parent set id = 10 where id = 1")
+	tk.MustGetErrCode("delete from t_parent where id = 10", errno.ErrRowIsReferenced2)
+	tk.MustExec("update t_child set pcode = 'b' where pcode = 'a'")
+	tk.MustExec("delete from t_parent where id = 10")
+	tk.MustQuery("select * from t_parent").Check(testkit.Rows("2 b"))
+
+	// The referenced rows are found in the uncommitted data of the transaction.
+	tk.MustExec("begin")
+	tk.MustExec("insert into t_parent values (3, 'c')")
+	tk.MustExec("insert into t_child values (7, 3, 'c')")
+	tk.MustGetErrCode("delete from t_parent where id = 3", errno.ErrRowIsReferenced2)
+	tk.MustExec("commit")
+	tk.MustQuery("select * from t_child where id = 7").Check(testkit.Rows("7 3 c"))
+}
+
+func (s *testSuite8) TestForeignKeyReferentialActions(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@foreign_key_checks = 1")
+	tk.MustExec("drop table if exists t_grandchild, t_child, t_parent")
+	tk.MustExec("create table t_parent(id int primary key, a int, key(a))")
+	tk.MustExec("create table t_child(id int primary key, pid int, pa int, b int as (pid + 1)," +
+		"foreign key (pid) references t_parent(id) on delete cascade on update cascade," +
+		"foreign key (pa) references t_parent(a) on delete set null on update set null)")
+	tk.MustExec("create table t_grandchild(id int primary key, cid int, foreign key (cid) references t_child(id) on delete cascade)")
+	tk.MustExec("insert into t_parent values (1, 10), (2, 20)")
+	tk.MustExec("insert into t_child(id, pid, pa) values (1, 1, 10), (2, 1, 20), (3, 2, 20)")
+	tk.MustExec("insert into t_grandchild values (1, 1), (2, 3)")
+
+	tk.MustExec("update t_parent set id = 3 where id = 1")
+	tk.MustQuery("select * from t_child order by id").Check(testkit.Rows("1 3 10 4", "2 3 20 4", "3 2 20 3"))
+	tk.MustExec("update t_parent set a = 30 where id = 2")
+	tk.MustQuery("select * from t_child order by id").Check(testkit.Rows("1 3 10 4", "2 3 <nil> 4", "3 2 <nil> 3"))
+	tk.MustExec("delete from t_parent where id = 3")
+	tk.MustQuery("select * from t_child order by id").Check(testkit.Rows("3 2 <nil> 3"))
+	tk.MustQuery("select * from t_grandchild").Check(testkit.Rows("2 3"))
+	tk.MustExec("delete from t_parent")
+	tk.MustQuery("select count(*) from t_child").Check(testkit.Rows("0"))
+	tk.MustQuery("select count(*) from t_grandchild").Check(testkit.Rows("0"))
+
+	// A self-referencing table.
+	tk.MustExec("drop table if exists t_tree")
+	tk.MustExec("create table t_tree(id int primary key, pid int, foreign key (pid) references t_tree(id) on delete cascade)")
+	tk.MustExec("insert into t_tree values (1, 1), (2, 1), (3, 2), (4, 3)")
+	tk.MustGetErrCode("insert into t_tree values (5, 6)", errno.ErrNoReferencedRow2)
+	tk.MustExec("delete from t_tree where id = 2")
+	tk.MustQuery("select * from t_tree").Check(testkit.Rows("1 1"))
+	tk.MustExec("drop table t_tree")
+	tk.MustExec("drop table t_grandchild, t_child, t_parent")
+}
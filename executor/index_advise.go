@@ -15,14 +15,25 @@ package executor
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/parser"
 	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/model"
+	"github.com/pingcap/parser/mysql"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/planner"
+	plannercore "github.com/pingcap/tidb/planner/core"
 	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util"
 	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/stringutil"
 )
 
 // IndexAdviseExec represents a index advise executor.
@@ -122,13 +133,304 @@ func (e *IndexAdviseInfo) GetIndexAdvice(ctx context.Context, data []byte) error
 	if err := e.prepareInfo(data); err != nil {
 		return err
 	}
-	// TODO: Finish the index advise process. It will be done in another PR.
+	advisor := &indexAdvisor{
+		info:       e,
+		is:         e.Ctx.GetInfoSchema().(infoschema.InfoSchema),
+		candidates: make(map[string]*indexAdviseCandidate),
+	}
+	if e.MaxMinutes < uint64(math.MaxInt64/int64(time.Minute)) {
+		advisor.deadline = time.Now().Add(time.Duration(e.MaxMinutes) * time.Minute)
+	}
+	sc := e.Ctx.GetSessionVars().StmtCtx
+	for _, stmtNodes := range e.StmtNodes {
+		for _, stmtNode := range stmtNodes {
+			if advisor.timeout() {
+				break
+			}
+			if err := advisor.evaluateQuery(ctx, stmtNode); err != nil {
+				sc.AppendWarning(errors.Errorf("Index Advise: skip the query '%s': %v", strings.TrimSpace(stmtNode.Text()), err))
+			}
+		}
+	}
+	if advisor.timeout() {
+		sc.AppendWarning(errors.New("Index Advise: the max_minutes limit is reached, the advice may be incomplete"))
+	}
+	e.Result = &IndexAdvice{Items: e.rankCandidates(advisor.orderedCandidates)}
+	return nil
+}
+
+// indexAdvisor evaluates the index candidates of the workload.
+type indexAdvisor struct {
+	info     *IndexAdviseInfo
+	is       infoschema.InfoSchema
+	deadline time.Time
+
+	candidates        map[string]*indexAdviseCandidate
+	orderedCandidates []*indexAdviseCandidate
+}
+
+// indexAdviseQuery is a query of the workload which is used to evaluate the index candidates.
+type indexAdviseQuery struct {
+	sql      string
+	baseCost float64
+}
+
+// indexAdviseCandidate is an index candidate with the estimated costs of the queries it speeds up.
+type indexAdviseCandidate struct {
+	*plannercore.IndexCandidate
+	name      string
+	queries   []*indexAdviseQuery
+	costs     []float64
+	reduction float64
+}
+
+func (a *indexAdvisor) timeout() bool {
+	return !a.deadline.IsZero() && time.Now().After(a.deadline)
+}
+
+// evaluateQuery enumerates the index candidates of the statement and estimates the cost of the
+// statement with each candidate as a hypothetical index.
+func (a *indexAdvisor) evaluateQuery(ctx context.Context, stmtNode ast.StmtNode) error {
+	node := buildIndexAdviseSelect(stmtNode)
+	if node == nil {
+		return nil
+	}
+	sctx := a.info.Ctx
+	sc := sctx.GetSessionVars().StmtCtx
+	warnings := sc.GetWarnings()
+	defer func() {
+		sc.SetWarnings(warnings)
+		sctx.ClearValue(plannercore.HypoIndexesKey)
+	}()
+	if err := plannercore.Preprocess(sctx, node); err != nil {
+		return err
+	}
+	queryCandidates, err := plannercore.ExtractIndexCandidates(ctx, sctx, node, a.is)
+	if err != nil || len(queryCandidates) == 0 {
+		return err
+	}
+	query := &indexAdviseQuery{sql: strings.TrimSpace(stmtNode.Text())}
+	if _, query.baseCost, err = planner.OptimizeWithCost(ctx, sctx, node, a.is); err != nil {
+		return err
+	}
+	for _, qc := range queryCandidates {
+		if a.timeout() {
+			return nil
+		}
+		candidate, ok := a.candidates[qc.Key()]
+		if !ok {
+			candidate = &indexAdviseCandidate{IndexCandidate: qc, name: indexAdviseName(qc)}
+			a.candidates[qc.Key()] = candidate
+			a.orderedCandidates = append(a.orderedCandidates, candidate)
+		}
+		sctx.SetValue(plannercore.HypoIndexesKey, plannercore.HypoIndexes{
+			qc.Table.ID: {qc.NewHypoIndex(candidate.name)},
+		})
+		_, cost, err := planner.OptimizeWithCost(ctx, sctx, node, a.is)
+		if err != nil {
+			return err
+		}
+		if cost < query.baseCost {
+			candidate.queries = append(candidate.queries, query)
+			candidate.costs = append(candidate.costs, cost)
+			candidate.reduction += query.baseCost - cost
+		}
+	}
+	return nil
+}
+
+// rankCandidates orders the candidates by the total cost reduction of the workload, and picks
+// the top ones within the limits of the max index numbers.
+func (e *IndexAdviseInfo) rankCandidates(candidates []*indexAdviseCandidate) []*IndexAdviceItem {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].reduction > candidates[j].reduction
+	})
+	perTable, perDB := uint64(math.MaxUint64), uint64(math.MaxUint64)
+	if e.MaxIndexNum != nil {
+		perTable, perDB = e.MaxIndexNum.PerTable, e.MaxIndexNum.PerDB
+	}
+	tableCnt := make(map[int64]uint64)
+	dbCnt := make(map[string]uint64)
+	chosen := make(map[int64][]*indexAdviseCandidate)
+	var items []*IndexAdviceItem
+	for _, candidate := range candidates {
+		if len(candidate.queries) == 0 {
+			break
+		}
+		tblID, dbName := candidate.Table.ID, candidate.DBName.L
+		if tableCnt[tblID] >= perTable || dbCnt[dbName] >= perDB || isRedundantCandidate(candidate, chosen[tblID]) {
+			continue
+		}
+		tableCnt[tblID]++
+		dbCnt[dbName]++
+		chosen[tblID] = append(chosen[tblID], candidate)
+		colNames := make([]string, 0, len(candidate.Columns))
+		for _, col := range candidate.Columns {
+			colNames = append(colNames, stringutil.Escape(col.Name.O, mysql.ModeNone))
+		}
+		createStmt := fmt.Sprintf("CREATE INDEX %s ON %s.%s(%s)",
+			stringutil.Escape(candidate.name, mysql.ModeNone),
+			stringutil.Escape(candidate.DBName.O, mysql.ModeNone),
+			stringutil.Escape(candidate.Table.Name.O, mysql.ModeNone),
+			strings.Join(colNames, ", "))
+		for i, query := range candidate.queries {
+			items = append(items, &IndexAdviceItem{
+				Database:     candidate.DBName.O,
+				Table:        candidate.Table.Name.O,
+				IndexName:    candidate.name,
+				IndexColumns: strings.Join(colNames, ", "),
+				CreateStmt:   createStmt,
+				Query:        query.sql,
+				OriginalCost: query.baseCost,
+				NewCost:      candidate.costs[i],
+			})
+		}
+	}
+	return items
+}
+
+// isRedundantCandidate checks whether the candidate columns are the prefix of a chosen candidate.
+func isRedundantCandidate(candidate *indexAdviseCandidate, chosen []*indexAdviseCandidate) bool {
+	for _, c := range chosen {
+		if len(c.Columns) < len(candidate.Columns) {
+			continue
+		}
+		redundant := true
+		for i, col := range candidate.Columns {
+			if c.Columns[i].ID != col.ID {
+				redundant = false
+				break
+			}
+		}
+		if redundant {
+			return true
+		}
+	}
+	return false
+}
+
+func indexAdviseName(candidate *plannercore.IndexCandidate) string {
+	var sb strings.Builder
+	sb.WriteString("idx")
+	for _, col := range candidate.Columns {
+		sb.WriteString("_")
+		sb.WriteString(col.Name.L)
+	}
+	name := sb.String()
+	for i := 1; candidate.Table.FindIndexByName(name) != nil; i++ {
+		name = fmt.Sprintf("%s_%d", sb.String(), i)
+	}
+	return name
+}
+
+// buildIndexAdviseSelect builds the statement whose cost is used to evaluate the index candidates.
+// Indexes only speed up the reading part of the DML statements, so it's converted to the SELECT
+// statement which reads the same rows.
+func buildIndexAdviseSelect(stmtNode ast.StmtNode) ast.StmtNode {
+	wildCard := &ast.FieldList{Fields: []*ast.SelectField{{WildCard: &ast.WildCardField{}}}}
+	switch x := stmtNode.(type) {
+	case *ast.SelectStmt, *ast.SetOprStmt:
+		return x
+	case *ast.UpdateStmt:
+		return &ast.SelectStmt{Kind: ast.SelectStmtKindSelect, Fields: wildCard, From: x.TableRefs, Where: x.Where, OrderBy: x.Order, Limit: x.Limit}
+	case *ast.DeleteStmt:
+		return &ast.SelectStmt{Kind: ast.SelectStmtKindSelect, Fields: wildCard, From: x.TableRefs, Where: x.Where, OrderBy: x.Order, Limit: x.Limit}
+	case *ast.InsertStmt:
+		if sel, ok := x.Select.(ast.StmtNode); ok {
+			return buildIndexAdviseSelect(sel)
+		}
+	}
 	return nil
 }
 
-// IndexAdvice represents the index advice.
+// IndexAdviceItem is a recommended index with the estimated cost of a query it speeds up.
+type IndexAdviceItem struct {
+	Database     string
+	Table        string
+	IndexName    string
+	IndexColumns string
+	CreateStmt   string
+	Query        string
+	OriginalCost float64
+	NewCost      float64
+}
+
+// IndexAdvice represents the index advice, it implements the sqlexec.RecordSet interface.
 type IndexAdvice struct {
-	// TODO: Define index advice data structure and implements the ResultSet interface. It will be done in another PR
+	Items []*IndexAdviceItem
+
+	fields []*ast.ResultField
+	cursor int
+}
+
+var indexAdviceColumns = []struct {
+	name string
+	tp   byte
+}{
+	{"Database", mysql.TypeVarchar},
+	{"Table", mysql.TypeVarchar},
+	{"Index_name", mysql.TypeVarchar},
+	{"Index_columns", mysql.TypeVarchar},
+	{"Create_statement", mysql.TypeVarchar},
+	{"Query", mysql.TypeVarchar},
+	{"Original_cost", mysql.TypeDouble},
+	{"New_cost", mysql.TypeDouble},
+	{"Cost_reduction", mysql.TypeDouble},
+}
+
+// Fields implements the sqlexec.RecordSet Fields interface.
+func (a *IndexAdvice) Fields() []*ast.ResultField {
+	if a.fields == nil {
+		a.fields = make([]*ast.ResultField, 0, len(indexAdviceColumns))
+		for _, col := range indexAdviceColumns {
+			tp := types.NewFieldType(col.tp)
+			if col.tp == mysql.TypeVarchar {
+				tp.Flen = mysql.MaxBlobWidth
+				tp.Charset, tp.Collate = mysql.DefaultCharset, mysql.DefaultCollationName
+			} else {
+				tp.Flen, tp.Decimal = mysql.GetDefaultFieldLengthAndDecimal(col.tp)
+			}
+			a.fields = append(a.fields, &ast.ResultField{
+				Column:       &model.ColumnInfo{Name: model.NewCIStr(col.name), FieldType: *tp},
+				ColumnAsName: model.NewCIStr(col.name),
+			})
+		}
+	}
+	return a.fields
+}
+
+// Next implements the sqlexec.RecordSet Next interface.
+func (a *IndexAdvice) Next(ctx context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	for ; a.cursor < len(a.Items) && !req.IsFull(); a.cursor++ {
+		item := a.Items[a.cursor]
+		req.AppendString(0, item.Database)
+		req.AppendString(1, item.Table)
+		req.AppendString(2, item.IndexName)
+		req.AppendString(3, item.IndexColumns)
+		req.AppendString(4, item.CreateStmt)
+		req.AppendString(5, item.Query)
+		req.AppendFloat64(6, item.OriginalCost)
+		req.AppendFloat64(7, item.NewCost)
+		req.AppendFloat64(8, item.OriginalCost-item.NewCost)
+	}
+	return nil
+}
+
+// NewChunk implements the sqlexec.RecordSet NewChunk interface.
+func (a *IndexAdvice) NewChunk() *chunk.Chunk {
+	fields := a.Fields()
+	tps := make([]*types.FieldType, 0, len(fields))
+	for _, field := range fields {
+		tps = append(tps, &field.Column.FieldType)
+	}
+	return chunk.New(tps, len(a.Items), len(a.Items))
+}
+
+// Close implements the sqlexec.RecordSet Close interface.
+func (a *IndexAdvice) Close() error {
+	a.cursor = 0
+	return nil
 }
 
 // IndexAdviseVarKeyType is a dummy type to avoid naming collision in context.
@@ -14,6 +14,7 @@
 package executor_test
 
 import (
+	"context"
 	"os"
 
 	. "github.com/pingcap/check"
@@ -54,7 +55,6 @@ func (s *testSuite1) TestIndexAdvise(c *C) {
 		"\n")
 	c.Assert(err, IsNil)
 
-	// TODO: Using "tastCase" to do more test when we finish the index advisor completely.
 	tk.MustExec("index advise local infile '/tmp/index_advise.sql' max_minutes 3 max_idxnum per_table 4 per_db 5")
 	ctx := tk.Se.(sessionctx.Context)
 	ia, ok := ctx.Value(executor.IndexAdviseVarKey).(*executor.IndexAdviseInfo)
@@ -63,5 +63,54 @@ func (s *testSuite1) TestIndexAdvise(c *C) {
 	c.Assert(ia.MaxMinutes, Equals, uint64(3))
 	c.Assert(ia.MaxIndexNum.PerTable, Equals, uint64(4))
 	c.Assert(ia.MaxIndexNum.PerDB, Equals, uint64(5))
+}
+
+func (s *testSuite1) TestIndexAdviseResult(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t, t1, t2")
+	tk.MustExec("create table t(a int, b int, c int, index idx_c(c))")
+	tk.MustExec("create table t1(a int, b int)")
+	tk.MustExec("create table t2(a int, b int)")
+
+	workload := []byte("select a from t where a > 1 and a < 100;\n" +
+		"select * from t where c = 1;\n" +
+		"update t set c = 2 where b = 10;\n" +
+		"select * from t_not_exist where a = 1;\n" +
+		"select t1.a, t2.b from t1, t2 where t1.a = t2.b and t1.b = 1;\n")
+	tk.MustExec("index advise local infile '/tmp/index_advise.sql' max_minutes 3")
+	ctx := tk.Se.(sessionctx.Context)
+	ia, ok := ctx.Value(executor.IndexAdviseVarKey).(*executor.IndexAdviseInfo)
+	c.Assert(ok, IsTrue)
+	ctx.SetValue(executor.IndexAdviseVarKey, nil)
+	c.Assert(ia.GetIndexAdvice(context.Background(), workload), IsNil)
+	c.Assert(ia.Result.Fields(), HasLen, 9)
+
+	indexes := make(map[string]string)
+	for _, item := range ia.Result.Items {
+		c.Assert(item.NewCost < item.OriginalCost, IsTrue)
+		indexes[item.Table+"."+item.IndexColumns] = item.CreateStmt
+	}
+	c.Assert(indexes["t.`a`"], Equals, "CREATE INDEX `idx_a` ON `test`.`t`(`a`)")
+	c.Assert(indexes["t.`b`"], Equals, "CREATE INDEX `idx_b` ON `test`.`t`(`b`)")
+	// The existing index idx_c covers the column c.
+	c.Assert(indexes, Not(HasKey), "t.`c`")
+	// The query on the non-existing table is skipped with a warning.
+	c.Assert(ctx.GetSessionVars().StmtCtx.WarningCount(), Equals, uint16(1))
 
+	chk := ia.Result.NewChunk()
+	c.Assert(ia.Result.Next(context.Background(), chk), IsNil)
+	c.Assert(chk.NumRows(), Equals, len(ia.Result.Items))
+	c.Assert(ia.Result.Close(), IsNil)
+
+	// The number of the recommended indexes is limited by max_idxnum.
+	tk.MustExec("index advise local infile '/tmp/index_advise.sql' max_minutes 3 max_idxnum per_table 1 per_db 1")
+	ia, ok = ctx.Value(executor.IndexAdviseVarKey).(*executor.IndexAdviseInfo)
+	c.Assert(ok, IsTrue)
+	ctx.SetValue(executor.IndexAdviseVarKey, nil)
+	c.Assert(ia.GetIndexAdvice(context.Background(), workload), IsNil)
+	c.Assert(len(ia.Result.Items) > 0, IsTrue)
+	for _, item := range ia.Result.Items {
+		c.Assert(item.IndexName, Equals, ia.Result.Items[0].IndexName)
+	}
 }
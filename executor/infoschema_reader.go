@@ -51,6 +51,7 @@ import (
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/statistics"
+	"github.com/pingcap/tidb/statistics/handle"
 	"github.com/pingcap/tidb/store/helper"
 	"github.com/pingcap/tidb/table"
 	"github.com/pingcap/tidb/types"
@@ -130,6 +131,8 @@ func (e *memtableRetriever) retrieve(ctx context.Context, sctx sessionctx.Contex
 			e.setDataForMetricTables(sctx)
 		case infoschema.TableProfiling:
 			e.setDataForPseudoProfiling(sctx)
+		case infoschema.TableOptimizerTrace:
+			e.setDataForOptimizerTrace(sctx)
 		case infoschema.TableCollationCharacterSetApplicability:
 			e.dataForCollationCharacterSetApplicability()
 		case infoschema.TableProcesslist:
@@ -165,6 +168,12 @@ func (e *memtableRetriever) retrieve(ctx context.Context, sctx sessionctx.Contex
 			err = e.setDataForClientErrorsSummary(sctx, e.table.Name.O)
 		case infoschema.TableRegionLabel:
 			err = e.setDataForRegionLabel(sctx)
+		case infoschema.TableCheckConstraints:
+			e.setDataFromCheckConstraints(sctx, dbs)
+		case infoschema.TableTiDBIndexUsage:
+			err = e.setDataFromIndexUsage(sctx, dbs)
+		case infoschema.ClusterTableTiDBIndexUsage:
+			err = e.setDataFromClusterIndexUsage(sctx, dbs)
 		}
 		if err != nil {
 			return nil, err
@@ -747,7 +756,7 @@ func (e *hugeMemTableRetriever) dataForColumnsInTable(ctx context.Context, sctx
 
 func calcCharOctLength(lenInChar int, cs string) int {
 	lenInBytes := lenInChar
-	if desc, err := charset.GetCharsetDesc(cs); err == nil {
+	if desc, err := collate.GetCharsetDesc(cs); err == nil {
 		lenInBytes = desc.Maxlen * lenInChar
 	}
 	return lenInBytes
@@ -1610,11 +1619,121 @@ func (e *memtableRetriever) setDataFromTableConstraints(ctx sessionctx.Context,
 				)
 				rows = append(rows, record)
 			}
+
+			for _, constr := range tbl.Constraints {
+				if constr.State != model.StatePublic {
+					continue
+				}
+				record := types.MakeDatums(
+					infoschema.CatalogVal,          // CONSTRAINT_CATALOG
+					schema.Name.O,                  // CONSTRAINT_SCHEMA
+					constr.Name.O,                  // CONSTRAINT_NAME
+					schema.Name.O,                  // TABLE_SCHEMA
+					tbl.Name.O,                     // TABLE_NAME
+					infoschema.CheckConstraintType, // CONSTRAINT_TYPE
+				)
+				rows = append(rows, record)
+			}
 		}
 	}
 	e.rows = rows
 }
 
+// setDataFromCheckConstraints constructs data for table information_schema.check_constraints.
+// See https://dev.mysql.com/doc/refman/8.0/en/information-schema-check-constraints-table.html
+func (e *memtableRetriever) setDataFromCheckConstraints(ctx sessionctx.Context, schemas []*model.DBInfo) {
+	checker := privilege.GetPrivilegeManager(ctx)
+	var rows [][]types.Datum
+	for _, schema := range schemas {
+		for _, tbl := range schema.Tables {
+			if checker != nil && !checker.RequestVerification(ctx.GetSessionVars().ActiveRoles, schema.Name.L, tbl.Name.L, "", mysql.AllPrivMask) {
+				continue
+			}
+			for _, constr := range tbl.Constraints {
+				if constr.State != model.StatePublic {
+					continue
+				}
+				record := types.MakeDatums(
+					infoschema.CatalogVal,                  // CONSTRAINT_CATALOG
+					schema.Name.O,                          // CONSTRAINT_SCHEMA
+					constr.Name.O,                          // CONSTRAINT_NAME
+					fmt.Sprintf("(%s)", constr.ExprString), // CHECK_CLAUSE
+				)
+				rows = append(rows, record)
+			}
+		}
+	}
+	e.rows = rows
+}
+
+// setDataFromIndexUsage constructs data for table information_schema.tidb_index_usage from the index usage
+// collected by this instance. The unused indexes are listed as well, so the indexes never used can be found.
+func (e *memtableRetriever) setDataFromIndexUsage(ctx sessionctx.Context, schemas []*model.DBInfo) error {
+	statsHandle := domain.GetDomain(ctx).StatsHandle()
+	if statsHandle == nil {
+		return nil
+	}
+	usage, since := statsHandle.GetIndexUsage()
+	var neverUsedSince interface{}
+	if since.IsZero() {
+		ctx.GetSessionVars().StmtCtx.AppendWarning(errors.New("the index usage is not collected, set performance.index-usage-sync-lease to collect it"))
+	} else {
+		neverUsedSince = types.NewTime(types.FromGoTime(since), mysql.TypeDatetime, types.MaxFsp)
+	}
+	sc := ctx.GetSessionVars().StmtCtx
+	checker := privilege.GetPrivilegeManager(ctx)
+	var rows [][]types.Datum
+	for _, schema := range schemas {
+		for _, tbl := range schema.Tables {
+			if checker != nil && !checker.RequestVerification(ctx.GetSessionVars().ActiveRoles, schema.Name.L, tbl.Name.L, "", mysql.AllPrivMask) {
+				continue
+			}
+			for _, idx := range tbl.Indices {
+				if idx.State != model.StatePublic {
+					continue
+				}
+				item := usage[handle.GlobalIndexID{TableID: tbl.ID, IndexID: idx.ID}]
+				var lastAccessTime, unusedSince interface{}
+				if item.QueryCount > 0 {
+					t, err := types.ParseTime(sc, item.LastUsedAt, mysql.TypeDatetime, types.MaxFsp)
+					if err != nil {
+						return err
+					}
+					lastAccessTime = t
+				} else {
+					unusedSince = neverUsedSince
+				}
+				record := types.MakeDatums(
+					schema.Name.O,     // TABLE_SCHEMA
+					tbl.Name.O,        // TABLE_NAME
+					idx.Name.O,        // INDEX_NAME
+					tbl.ID,            // TABLE_ID
+					idx.ID,            // INDEX_ID
+					item.QueryCount,   // QUERY_COUNT
+					item.RowsSelected, // ROWS_SELECTED
+					lastAccessTime,    // LAST_ACCESS_TIME
+					unusedSince,       // NEVER_USED_SINCE
+				)
+				rows = append(rows, record)
+			}
+		}
+	}
+	e.rows = rows
+	return nil
+}
+
+func (e *memtableRetriever) setDataFromClusterIndexUsage(ctx sessionctx.Context, schemas []*model.DBInfo) error {
+	if err := e.setDataFromIndexUsage(ctx, schemas); err != nil {
+		return err
+	}
+	rows, err := infoschema.AppendHostInfoToRows(ctx, e.rows)
+	if err != nil {
+		return err
+	}
+	e.rows = rows
+	return nil
+}
+
 // tableStorageStatsRetriever is used to read slow log data.
 type tableStorageStatsRetriever struct {
 	dummyCloser
@@ -1815,6 +1934,19 @@ func (e *memtableRetriever) setDataForAnalyzeStatus(sctx sessionctx.Context) {
 	e.rows = dataForAnalyzeStatusHelper(sctx)
 }
 
+// setDataForOptimizerTrace returns the optimizer traces kept in the session.
+func (e *memtableRetriever) setDataForOptimizerTrace(sctx sessionctx.Context) {
+	for _, trace := range sctx.GetSessionVars().OptimizerTraces() {
+		row := types.MakeDatums(
+			trace.Query,                        // QUERY
+			trace.Trace,                        // TRACE
+			trace.MissingBytesBeyondMaxMemSize, // MISSING_BYTES_BEYOND_MAX_MEM_SIZE
+			0,                                  // INSUFFICIENT_PRIVILEGES
+		)
+		e.rows = append(e.rows, row)
+	}
+}
+
 // setDataForPseudoProfiling returns pseudo data for table profiling when system variable `profiling` is set to `ON`.
 func (e *memtableRetriever) setDataForPseudoProfiling(sctx sessionctx.Context) {
 	if v, ok := sctx.GetSessionVars().GetSystemVar("profiling"); ok && variable.TiDBOptOn(v) {
@@ -15,6 +15,7 @@ package executor_test
 
 import (
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/http/httptest"
@@ -48,6 +49,7 @@ import (
 	"github.com/pingcap/tidb/util/testkit"
 	"github.com/pingcap/tidb/util/testleak"
 	"github.com/pingcap/tidb/util/testutil"
+	"github.com/pingcap/tidb/util/tracing"
 	"google.golang.org/grpc"
 )
 
@@ -156,6 +158,78 @@ func (s *testInfoschemaTableSuite) TestProfiling(c *C) {
 	tk.MustQuery("select * from information_schema.profiling").Check(testkit.Rows("0 0  0 0 0 0 0 0 0 0 0 0 0 0   0"))
 }
 
+func (s *testInfoschemaTableSuite) TestOptimizerTrace(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t1, t2")
+	tk.MustExec("create table t1(a int primary key, b int, index idx_b(b))")
+	tk.MustExec("create table t2(a int, b int)")
+	tk.MustQuery("select @@optimizer_trace").Check(testkit.Rows("enabled=off,one_line=off"))
+	tk.MustQuery("select * from t1 join t2 on t1.b = t2.b where t1.b > 1")
+	tk.MustQuery("select count(*) from information_schema.optimizer_trace").Check(testkit.Rows("0"))
+
+	tk.MustExec("set @@optimizer_trace='enabled=on'")
+	tk.MustQuery("select @@optimizer_trace").Check(testkit.Rows("enabled=on,one_line=off"))
+	tk.MustQuery("select * from t1 join t2 on t1.b = t2.b where t1.b > 1")
+	rows := tk.MustQuery("select query, trace, missing_bytes_beyond_max_mem_size, insufficient_privileges from information_schema.optimizer_trace").Rows()
+	c.Assert(rows, HasLen, 1)
+	c.Assert(rows[0][0], Equals, "select * from t1 join t2 on t1.b = t2.b where t1.b > 1")
+	c.Assert(rows[0][2], Equals, "0")
+	c.Assert(rows[0][3], Equals, "0")
+	var trace tracing.OptimizeTracer
+	c.Assert(json.Unmarshal([]byte(rows[0][1].(string)), &trace), IsNil)
+	c.Assert(trace.LogicalRules, Not(HasLen), 0)
+	c.Assert(trace.JoinReorders, HasLen, 1)
+	c.Assert(trace.JoinReorders[0].Algorithm, Equals, "greedy")
+	c.Assert(trace.JoinReorders[0].Nodes, HasLen, 2)
+	c.Assert(trace.PhysicalPlans, Not(HasLen), 0)
+	c.Assert(trace.FinalPlan, Not(Equals), "")
+	c.Assert(strings.Contains(rows[0][1].(string), "\n  \""), IsTrue)
+
+	// The queries on optimizer_trace aren't traced.
+	tk.MustQuery("select query from information_schema.optimizer_trace").Check(testkit.Rows(
+		"select * from t1 join t2 on t1.b = t2.b where t1.b > 1"))
+	tk.MustQuery("select count(*) from (select query from information_schema.optimizer_trace) t").Check(testkit.Rows("1"))
+	tk.MustQuery("select query from information_schema.optimizer_trace").Check(testkit.Rows(
+		"select * from t1 join t2 on t1.b = t2.b where t1.b > 1"))
+
+	tk.MustExec("set @@optimizer_trace='one_line=on'")
+	tk.MustQuery("select @@optimizer_trace").Check(testkit.Rows("enabled=on,one_line=on"))
+	tk.MustQuery("select * from t1 where a = 1")
+	rows = tk.MustQuery("select trace from information_schema.optimizer_trace").Rows()
+	c.Assert(rows, HasLen, 1)
+	c.Assert(strings.Contains(rows[0][0].(string), "\n"), IsFalse)
+	c.Assert(json.Unmarshal([]byte(rows[0][0].(string)), &trace), IsNil)
+	c.Assert(trace.FinalPlan, Equals, "PointGet(Handle(t1.a)1)")
+
+	// Keep the traces of the 2nd and 3rd statements.
+	tk.MustExec("set @@optimizer_trace_offset=1, @@optimizer_trace_limit=2")
+	tk.MustQuery("select * from t1 where b = 1")
+	tk.MustQuery("select * from t1 where b = 2")
+	tk.MustQuery("select * from t1 where b = 3")
+	tk.MustQuery("select query from information_schema.optimizer_trace").Check(testkit.Rows(
+		"select * from t1 where b = 2", "select * from t1 where b = 3"))
+	// Keep the traces of the last 2 statements.
+	tk.MustExec("set @@optimizer_trace_offset=-2, @@optimizer_trace_limit=2")
+	tk.MustQuery("select * from t1 where b = 1")
+	tk.MustQuery("select * from t1 where b = 2")
+	tk.MustQuery("select * from t1 where b = 3")
+	tk.MustQuery("select query from information_schema.optimizer_trace").Check(testkit.Rows(
+		"select * from t1 where b = 2", "select * from t1 where b = 3"))
+
+	tk.MustExec("set @@optimizer_trace_offset=-1, @@optimizer_trace_limit=1, @@optimizer_trace_max_mem_size=10")
+	tk.MustQuery("select * from t1 where b = 1")
+	rows = tk.MustQuery("select trace, missing_bytes_beyond_max_mem_size from information_schema.optimizer_trace").Rows()
+	c.Assert(rows, HasLen, 1)
+	c.Assert(rows[0][0], HasLen, 10)
+	c.Assert(rows[0][1], Not(Equals), "0")
+
+	tk.MustExec("set @@optimizer_trace='enabled=off'")
+	tk.MustQuery("select count(*) from information_schema.optimizer_trace").Check(testkit.Rows("0"))
+	tk.MustGetErrCode("set @@optimizer_trace='enabled=yes'", mysql.ErrWrongValueForVar)
+	tk.MustGetErrCode("set @@optimizer_trace='unknown=on'", mysql.ErrWrongValueForVar)
+}
+
 func (s *testInfoschemaTableSuite) TestSchemataTables(c *C) {
 	tk := testkit.NewTestKit(c, s.store)
 
@@ -221,7 +295,7 @@ func (s *testInfoschemaTableSuite) TestCharacterSetCollations(c *C) {
 
 	// The description column is not important
 	tk.MustQuery("SELECT default_collate_name, maxlen FROM information_schema.character_sets ORDER BY character_set_name").Check(
-		testkit.Rows("ascii_bin 1", "binary 1", "latin1_bin 1", "utf8_bin 3", "utf8mb4_bin 4"))
+		testkit.Rows("ascii_bin 1", "binary 1", "gbk_chinese_ci 2", "latin1_bin 1", "utf8_bin 3", "utf8mb4_bin 4"))
 
 	// The is_default column is not important
 	// but the id's are used by client libraries and must be stable
@@ -68,6 +68,9 @@ func (e *InsertExec) exec(ctx context.Context, rows [][]types.Datum) error {
 	setResourceGroupTagForTxn(sessVars.StmtCtx, txn)
 	txnSize := txn.Size()
 	sessVars.StmtCtx.AddRecordRows(uint64(len(rows)))
+	if err = e.prefetchForeignKeyParents(ctx, txn, rows); err != nil {
+		return err
+	}
 	// If you use the IGNORE keyword, duplicate-key error that occurs while executing the INSERT statement are ignored.
 	// For example, without IGNORE, a row that duplicates an existing UNIQUE index or PRIMARY KEY value in
 	// the table causes a duplicate-key error and the statement is aborted. With IGNORE, the row is discarded and no error occurs.
@@ -186,6 +189,22 @@ func (e *InsertValues) prefetchDataCache(ctx context.Context, txn kv.Transaction
 	return prefetchConflictedOldRows(ctx, txn, rows, values)
 }
 
+// prefetchForeignKeyParents uses BatchGet to fill the cache with the parent rows referenced by the to-be-inserted rows.
+// It's an optimization and could be removed without affecting correctness.
+func (e *InsertValues) prefetchForeignKeyParents(ctx context.Context, txn kv.Transaction, rows [][]types.Datum) error {
+	keys, err := getForeignKeyParentKeys(e.fkChecker, e.Table, rows)
+	if err != nil || len(keys) == 0 {
+		return err
+	}
+	if span := opentracing.SpanFromContext(ctx); span != nil && span.Tracer() != nil {
+		span1 := span.Tracer().StartSpan("prefetchForeignKeyParents", opentracing.ChildOf(span.Context()))
+		defer span1.Finish()
+		ctx = opentracing.ContextWithSpan(ctx, span1)
+	}
+	_, err = txn.BatchGet(ctx, keys)
+	return err
+}
+
 // updateDupRow updates a duplicate row to a new row.
 func (e *InsertExec) updateDupRow(ctx context.Context, idxInBatch int, kvGetter kv.Getter, row toBeCheckedRow, handle kv.Handle, onDuplicate []*expression.Assignment) error {
 	oldRow, err := getOldRow(ctx, e.ctx, kvGetter, row.t, handle, e.GenExprs)
@@ -398,7 +417,7 @@ func (e *InsertExec) doDupRowUpdate(ctx context.Context, handle kv.Handle, oldRo
 	}
 
 	newData := e.row4Update[:len(oldRow)]
-	_, err := updateRecord(ctx, e.ctx, handle, oldRow, newData, assignFlag, e.Table, true, e.memTracker)
+	_, err := updateRecord(ctx, e.ctx, handle, oldRow, newData, assignFlag, e.Table, true, e.memTracker, e.fkChecker, e.ckChecker)
 	if err != nil {
 		return err
 	}
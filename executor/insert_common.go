@@ -86,6 +86,11 @@ type InsertValues struct {
 
 	stats *InsertRuntimeStat
 
+	// fkChecker is used to check the foreign key constraints, it's nil when foreign_key_checks is disabled.
+	fkChecker *foreignKeyChecker
+	// ckChecker is used to check the CHECK constraints.
+	ckChecker *checkConstraintChecker
+
 	// isLoadData indicates whatever current goroutine is use for generating batch data. LoadData use two goroutines. One for generate batch data,
 	// The other one for commit task, which will invalid txn.
 	// We use mutex to protect routine from using invalid txn.
@@ -1124,6 +1129,22 @@ func (e *InsertValues) addRecord(ctx context.Context, row []types.Datum) error {
 
 func (e *InsertValues) addRecordWithAutoIDHint(ctx context.Context, row []types.Datum, reserveAutoIDCount int) (err error) {
 	vars := e.ctx.GetSessionVars()
+	if err = e.ckChecker.checkRow(e.Table, row); err != nil {
+		// INSERT IGNORE discards the row which violates the check constraints with a warning.
+		if vars.StmtCtx.DupKeyAsWarning && table.ErrCheckConstraintViolated.Equal(err) {
+			vars.StmtCtx.AppendWarning(err)
+			return nil
+		}
+		return err
+	}
+	if err = e.fkChecker.checkParentRows(ctx, e.Table, row, nil); err != nil {
+		// INSERT IGNORE discards the row which violates the foreign key constraints with a warning.
+		if vars.StmtCtx.DupKeyAsWarning && ErrNoReferencedRow2.Equal(err) {
+			vars.StmtCtx.AppendWarning(err)
+			return nil
+		}
+		return err
+	}
 	if !vars.ConstraintCheckInPlace {
 		vars.PresumeKeyNotExists = true
 	}
@@ -0,0 +1,403 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/mysql"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/types/json"
+	"github.com/pingcap/tidb/util/chunk"
+)
+
+// JSONTableExec generates the rows of JSON_TABLE. The JSON document is evaluated
+// once it's opened, so it's reopened for every outer row when it refers to the
+// columns of the preceding tables.
+type JSONTableExec struct {
+	baseExecutor
+
+	doc       expression.Expression
+	generator *jsonTableRowGenerator
+
+	rows   *chunk.Chunk
+	cursor int
+}
+
+// Open implements the Executor Open interface.
+func (e *JSONTableExec) Open(ctx context.Context) error {
+	if err := e.baseExecutor.Open(ctx); err != nil {
+		return err
+	}
+	doc, err := e.doc.Eval(chunk.Row{})
+	if err != nil {
+		return err
+	}
+	if e.rows == nil {
+		e.rows = chunk.New(e.generator.fieldTps, e.initCap, e.maxChunkSize)
+	}
+	e.rows.Reset()
+	e.cursor = 0
+	return e.generator.generate(doc, e.rows)
+}
+
+// Next implements the Executor Next interface.
+func (e *JSONTableExec) Next(ctx context.Context, req *chunk.Chunk) error {
+	req.GrowAndReset(e.maxChunkSize)
+	if e.cursor >= e.rows.NumRows() {
+		return nil
+	}
+	end := e.cursor + req.RequiredRows()
+	if end > e.rows.NumRows() {
+		end = e.rows.NumRows()
+	}
+	req.Append(e.rows, e.cursor, end)
+	e.cursor = end
+	return nil
+}
+
+// buildJSONTableColumns converts the columns of JSON_TABLE to the ones of the
+// row generator, whose types are the ones in the output schema, in the same
+// order as newJSONTableNestedPath assigns the output offsets.
+func buildJSONTableColumns(columns []*ast.JSONTableColumn, tps []*types.FieldType) ([]*jsonTableColumn, []*types.FieldType, error) {
+	res := make([]*jsonTableColumn, 0, len(columns))
+	for _, col := range columns {
+		c := &jsonTableColumn{name: col.Name.O}
+		var err error
+		if col.Tp != ast.JSONTableColumnOrdinality {
+			if c.path, err = json.ParseJSONPathExpr(col.Path); err != nil {
+				return nil, nil, err
+			}
+		}
+		switch col.Tp {
+		case ast.JSONTableColumnNested:
+			c.kind = jsonTableColumnNested
+			var nested []*jsonTableColumn
+			if nested, tps, err = buildJSONTableColumns(col.Columns, tps); err != nil {
+				return nil, nil, err
+			}
+			c.nested = &jsonTableNestedPath{path: c.path, columns: nested}
+			res = append(res, c)
+			continue
+		case ast.JSONTableColumnOrdinality:
+			c.kind = jsonTableColumnOrdinality
+		case ast.JSONTableColumnExists:
+			c.kind = jsonTableColumnExists
+		case ast.JSONTableColumnPath:
+			c.kind = jsonTableColumnPath
+			if c.onEmpty, err = buildJSONTableResponse(col.OnEmpty); err != nil {
+				return nil, nil, err
+			}
+			if c.onError, err = buildJSONTableResponse(col.OnError); err != nil {
+				return nil, nil, err
+			}
+		}
+		c.tp, tps = tps[0], tps[1:]
+		res = append(res, c)
+	}
+	return res, tps, nil
+}
+
+func buildJSONTableResponse(resp *ast.JSONTableResponse) (jsonTableResponse, error) {
+	if resp == nil {
+		return jsonTableResponse{tp: jsonTableResponseNull}, nil
+	}
+	switch resp.Tp {
+	case ast.JSONTableResponseError:
+		return jsonTableResponse{tp: jsonTableResponseError}, nil
+	case ast.JSONTableResponseDefault:
+		val, err := json.ParseBinaryFromString(resp.Default)
+		if err != nil {
+			return jsonTableResponse{}, err
+		}
+		return jsonTableResponse{tp: jsonTableResponseDefault, defaultValue: val}, nil
+	}
+	return jsonTableResponse{tp: jsonTableResponseNull}, nil
+}
+
+// jsonTableColumnKind is the kind of a column in the COLUMNS clause of JSON_TABLE.
+type jsonTableColumnKind int
+
+const (
+	// jsonTableColumnPath is defined as `name type PATH path [on_empty] [on_error]`.
+	jsonTableColumnPath jsonTableColumnKind = iota
+	// jsonTableColumnExists is defined as `name type EXISTS PATH path`.
+	jsonTableColumnExists
+	// jsonTableColumnOrdinality is defined as `name FOR ORDINALITY`.
+	jsonTableColumnOrdinality
+	// jsonTableColumnNested is defined as `NESTED [PATH] path COLUMNS (...)`,
+	// which is not an output column itself but the columns under it are.
+	jsonTableColumnNested
+)
+
+// jsonTableResponseType is the action of the ON EMPTY or ON ERROR clause.
+type jsonTableResponseType int
+
+const (
+	// jsonTableResponseNull sets the column to NULL, which is the default.
+	jsonTableResponseNull jsonTableResponseType = iota
+	// jsonTableResponseError reports the error.
+	jsonTableResponseError
+	// jsonTableResponseDefault sets the column to the default value.
+	jsonTableResponseDefault
+)
+
+// jsonTableResponse is the ON EMPTY or ON ERROR clause of a PATH column.
+type jsonTableResponse struct {
+	tp jsonTableResponseType
+	// defaultValue is the JSON value in the DEFAULT clause.
+	defaultValue json.BinaryJSON
+}
+
+// jsonTableColumn is a column defined in the COLUMNS clause of JSON_TABLE.
+type jsonTableColumn struct {
+	name    string
+	kind    jsonTableColumnKind
+	tp      *types.FieldType
+	path    json.PathExpression
+	onEmpty jsonTableResponse
+	onError jsonTableResponse
+	// nested is set for jsonTableColumnNested.
+	nested *jsonTableNestedPath
+	// offset is the offset of the column in the output rows.
+	offset int
+}
+
+// jsonTableNestedPath is a row path with the columns under it. The row path
+// of JSON_TABLE itself is the outermost one.
+type jsonTableNestedPath struct {
+	path    json.PathExpression
+	columns []*jsonTableColumn
+}
+
+// newJSONTableNestedPath creates the jsonTableNestedPath and assigns the output
+// offsets of its columns, which are in the order they are defined with the
+// columns of the nested paths in place. It returns the number of output columns.
+func newJSONTableNestedPath(path json.PathExpression, columns []*jsonTableColumn) (*jsonTableNestedPath, int) {
+	p := &jsonTableNestedPath{path: path, columns: columns}
+	return p, p.initOffsets(0)
+}
+
+func (p *jsonTableNestedPath) initOffsets(offset int) int {
+	for _, col := range p.columns {
+		if col.kind == jsonTableColumnNested {
+			offset = col.nested.initOffsets(offset)
+			continue
+		}
+		col.offset = offset
+		offset++
+	}
+	return offset
+}
+
+// jsonTableRowGenerator generates the rows of JSON_TABLE for JSON documents.
+type jsonTableRowGenerator struct {
+	sc       *stmtctx.StatementContext
+	rowPath  *jsonTableNestedPath
+	fieldTps []*types.FieldType
+
+	row  []types.Datum
+	rows [][]types.Datum
+}
+
+func newJSONTableRowGenerator(sc *stmtctx.StatementContext, rowPath *jsonTableNestedPath, numCols int) *jsonTableRowGenerator {
+	g := &jsonTableRowGenerator{
+		sc:       sc,
+		rowPath:  rowPath,
+		fieldTps: make([]*types.FieldType, numCols),
+		row:      make([]types.Datum, numCols),
+	}
+	g.initFieldTypes(rowPath)
+	return g
+}
+
+func (g *jsonTableRowGenerator) initFieldTypes(p *jsonTableNestedPath) {
+	for _, col := range p.columns {
+		if col.kind == jsonTableColumnNested {
+			g.initFieldTypes(col.nested)
+			continue
+		}
+		g.fieldTps[col.offset] = col.tp
+	}
+}
+
+// generate appends the rows of the document to the chunk. A NULL document
+// generates no rows.
+func (g *jsonTableRowGenerator) generate(doc types.Datum, chk *chunk.Chunk) error {
+	if doc.IsNull() {
+		return nil
+	}
+	g.rows = g.rows[:0]
+	for i := range g.row {
+		g.row[i].SetNull()
+	}
+	if _, err := g.generatePath(g.rowPath, doc.GetMysqlJSON()); err != nil {
+		return err
+	}
+	for _, row := range g.rows {
+		for i := range row {
+			chk.AppendDatum(i, &row[i])
+		}
+	}
+	return nil
+}
+
+// generatePath generates the rows of the nested path for every value matched in
+// the context value. It returns whether any value is matched.
+func (g *jsonTableRowGenerator) generatePath(p *jsonTableNestedPath, ctxVal json.BinaryJSON) (bool, error) {
+	matched := ctxVal.ExtractAll(p.path)
+	for i, val := range matched {
+		if err := g.evalColumns(p, val, i+1); err != nil {
+			return false, err
+		}
+		if err := g.generateNested(p, val); err != nil {
+			return false, err
+		}
+	}
+	g.resetColumns(p)
+	return len(matched) > 0, nil
+}
+
+// generateNested generates the rows of the sibling nested paths one after
+// another, and the columns of a nested path are NULL in the rows generated by
+// the others. Like LEFT JOIN, a single row is generated with all the nested
+// columns being NULL if no nested path matches.
+func (g *jsonTableRowGenerator) generateNested(p *jsonTableNestedPath, val json.BinaryJSON) error {
+	matched := false
+	for _, col := range p.columns {
+		if col.kind != jsonTableColumnNested {
+			continue
+		}
+		ok, err := g.generatePath(col.nested, val)
+		if err != nil {
+			return err
+		}
+		matched = matched || ok
+	}
+	if !matched {
+		g.rows = append(g.rows, types.CloneRow(g.row))
+	}
+	return nil
+}
+
+func (g *jsonTableRowGenerator) resetColumns(p *jsonTableNestedPath) {
+	for _, col := range p.columns {
+		if col.kind != jsonTableColumnNested {
+			g.row[col.offset].SetNull()
+		}
+	}
+}
+
+func (g *jsonTableRowGenerator) evalColumns(p *jsonTableNestedPath, val json.BinaryJSON, ordinality int) error {
+	for _, col := range p.columns {
+		var (
+			d   types.Datum
+			err error
+		)
+		switch col.kind {
+		case jsonTableColumnNested:
+			continue
+		case jsonTableColumnOrdinality:
+			d = types.NewIntDatum(int64(ordinality))
+		case jsonTableColumnExists:
+			d.SetInt64(boolToInt64(len(val.ExtractAll(col.path)) > 0))
+			d, err = d.ConvertTo(g.sc, col.tp)
+		case jsonTableColumnPath:
+			d, err = g.evalPathColumn(col, val)
+		}
+		if err != nil {
+			return err
+		}
+		g.row[col.offset] = d
+	}
+	return nil
+}
+
+func (g *jsonTableRowGenerator) evalPathColumn(col *jsonTableColumn, val json.BinaryJSON) (types.Datum, error) {
+	matched := val.ExtractAll(col.path)
+	if len(matched) == 0 {
+		return g.respond(col, col.onEmpty, errMissingJSONTableValue.GenWithStackByArgs(col.name))
+	}
+	var d types.Datum
+	var err error
+	if len(matched) > 1 {
+		// Multiple values are matched as an array, which is an error for the scalar columns.
+		d, err = convertJSONTableValue(g.sc, col, json.CreateBinary(jsonValuesToInterfaces(matched)))
+	} else {
+		d, err = convertJSONTableValue(g.sc, col, matched[0])
+	}
+	if err != nil {
+		return g.respond(col, col.onError, err)
+	}
+	return d, nil
+}
+
+func (g *jsonTableRowGenerator) respond(col *jsonTableColumn, resp jsonTableResponse, err error) (types.Datum, error) {
+	switch resp.tp {
+	case jsonTableResponseError:
+		return types.Datum{}, err
+	case jsonTableResponseDefault:
+		return convertJSONTableValue(g.sc, col, resp.defaultValue)
+	}
+	return types.Datum{}, nil
+}
+
+// convertJSONTableValue converts the JSON value to the datum of the column type.
+func convertJSONTableValue(sc *stmtctx.StatementContext, col *jsonTableColumn, val json.BinaryJSON) (types.Datum, error) {
+	if col.tp.Tp == mysql.TypeJSON {
+		return types.NewJSONDatum(val), nil
+	}
+	var d types.Datum
+	switch val.TypeCode {
+	case json.TypeCodeObject, json.TypeCodeArray:
+		return d, errWrongJSONTableValue.GenWithStackByArgs(col.name)
+	case json.TypeCodeLiteral:
+		if val.Value[0] == json.LiteralNil {
+			return d, nil
+		}
+		d.SetInt64(boolToInt64(val.Value[0] == json.LiteralTrue))
+	case json.TypeCodeInt64:
+		d.SetInt64(val.GetInt64())
+	case json.TypeCodeUint64:
+		d.SetUint64(val.GetUint64())
+	case json.TypeCodeFloat64:
+		d.SetFloat64(val.GetFloat64())
+	case json.TypeCodeString:
+		d.SetString(string(val.GetString()), mysql.DefaultCollationName)
+	}
+	res, err := d.ConvertTo(sc, col.tp)
+	if err != nil {
+		return types.Datum{}, errors.Trace(err)
+	}
+	return res, nil
+}
+
+func jsonValuesToInterfaces(vals []json.BinaryJSON) []interface{} {
+	res := make([]interface{}, 0, len(vals))
+	for _, val := range vals {
+		res = append(res, val)
+	}
+	return res
+}
+
+func boolToInt64(v bool) int64 {
+	if v {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,138 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"strings"
+
+	. "github.com/pingcap/check"
+	"github.com/pingcap/parser/mysql"
+	"github.com/pingcap/parser/terror"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/types/json"
+	"github.com/pingcap/tidb/util/chunk"
+)
+
+func mustParseJSONPath(c *C, path string) json.PathExpression {
+	pe, err := json.ParseJSONPathExpr(path)
+	c.Assert(err, IsNil)
+	return pe
+}
+
+func jsonTablePathColumn(c *C, name string, tp byte, path string) *jsonTableColumn {
+	return &jsonTableColumn{name: name, kind: jsonTableColumnPath, tp: types.NewFieldType(tp), path: mustParseJSONPath(c, path)}
+}
+
+func jsonTableNestedColumn(c *C, path string, columns ...*jsonTableColumn) *jsonTableColumn {
+	return &jsonTableColumn{kind: jsonTableColumnNested, nested: &jsonTableNestedPath{path: mustParseJSONPath(c, path), columns: columns}}
+}
+
+func generateJSONTableRows(c *C, doc string, rowPath string, columns ...*jsonTableColumn) ([]string, error) {
+	p, numCols := newJSONTableNestedPath(mustParseJSONPath(c, rowPath), columns)
+	g := newJSONTableRowGenerator(&stmtctx.StatementContext{}, p, numCols)
+	chk := chunk.New(g.fieldTps, 4, 4)
+	bj, err := json.ParseBinaryFromString(doc)
+	c.Assert(err, IsNil)
+	if err := g.generate(types.NewJSONDatum(bj), chk); err != nil {
+		return nil, err
+	}
+	rows := make([]string, 0, chk.NumRows())
+	for i := 0; i < chk.NumRows(); i++ {
+		vals := make([]string, 0, numCols)
+		for _, d := range chk.GetRow(i).GetDatumRow(g.fieldTps) {
+			if d.IsNull() {
+				vals = append(vals, "<nil>")
+				continue
+			}
+			s, err := d.ToString()
+			c.Assert(err, IsNil)
+			vals = append(vals, s)
+		}
+		rows = append(rows, strings.Join(vals, " "))
+	}
+	return rows, nil
+}
+
+func (s *testExecSuite) TestJSONTableRowGenerator(c *C) {
+	ordinality := &jsonTableColumn{name: "id", kind: jsonTableColumnOrdinality, tp: types.NewFieldType(mysql.TypeLonglong)}
+	rows, err := generateJSONTableRows(c, `[{"a": 1, "b": "x"}, {"a": "2"}, {"b": [1]}]`, "$[*]",
+		ordinality,
+		jsonTablePathColumn(c, "a", mysql.TypeLonglong, "$.a"),
+		jsonTablePathColumn(c, "b", mysql.TypeVarchar, "$.b"),
+		&jsonTableColumn{name: "has_b", kind: jsonTableColumnExists, tp: types.NewFieldType(mysql.TypeLonglong), path: mustParseJSONPath(c, "$.b")},
+		jsonTablePathColumn(c, "j", mysql.TypeJSON, "$.b"),
+	)
+	c.Assert(err, IsNil)
+	c.Assert(rows, DeepEquals, []string{"1 1 x 1 \"x\"", "2 2 <nil> 0 <nil>", "3 <nil> <nil> 1 [1]"})
+
+	// No row is generated if the row path matches nothing.
+	rows, err = generateJSONTableRows(c, `{"a": 1}`, "$.b[*]", jsonTablePathColumn(c, "a", mysql.TypeLonglong, "$"))
+	c.Assert(err, IsNil)
+	c.Assert(rows, HasLen, 0)
+}
+
+func (s *testExecSuite) TestJSONTableNestedPath(c *C) {
+	doc := `[{"a": 1, "b": [11, 111], "c": ["x"]}, {"a": 2, "b": [22]}, {"a": 3}]`
+	rows, err := generateJSONTableRows(c, doc, "$[*]",
+		jsonTablePathColumn(c, "a", mysql.TypeLonglong, "$.a"),
+		jsonTableNestedColumn(c, "$.b[*]",
+			&jsonTableColumn{name: "b_id", kind: jsonTableColumnOrdinality, tp: types.NewFieldType(mysql.TypeLonglong)},
+			jsonTablePathColumn(c, "b", mysql.TypeLonglong, "$")),
+		jsonTableNestedColumn(c, "$.c[*]", jsonTablePathColumn(c, "c", mysql.TypeVarchar, "$")),
+	)
+	c.Assert(err, IsNil)
+	c.Assert(rows, DeepEquals, []string{
+		"1 1 11 <nil>",
+		"1 2 111 <nil>",
+		"1 <nil> <nil> x",
+		"2 1 22 <nil>",
+		"3 <nil> <nil> <nil>",
+	})
+
+	rows, err = generateJSONTableRows(c, `{"a": [{"b": [1, 2]}, {"b": [3]}]}`, "$",
+		jsonTableNestedColumn(c, "$.a[*]",
+			jsonTableNestedColumn(c, "$.b[*]", jsonTablePathColumn(c, "b", mysql.TypeLonglong, "$"))),
+	)
+	c.Assert(err, IsNil)
+	c.Assert(rows, DeepEquals, []string{"1", "2", "3"})
+}
+
+func (s *testExecSuite) TestJSONTableOnEmptyOnError(c *C) {
+	onEmptyDefault := jsonTablePathColumn(c, "a", mysql.TypeLonglong, "$.a")
+	onEmptyDefault.onEmpty = jsonTableResponse{tp: jsonTableResponseDefault, defaultValue: json.CreateBinary(int64(-1))}
+	onErrorDefault := jsonTablePathColumn(c, "b", mysql.TypeLonglong, "$.b")
+	onErrorDefault.onError = jsonTableResponse{tp: jsonTableResponseDefault, defaultValue: json.CreateBinary(int64(-2))}
+	rows, err := generateJSONTableRows(c, `[{"a": 1, "b": 2}, {"b": [1, 2]}, {"a": 3, "b": {"x": 1}}]`, "$[*]", onEmptyDefault, onErrorDefault)
+	c.Assert(err, IsNil)
+	c.Assert(rows, DeepEquals, []string{"1 2", "-1 -2", "3 -2"})
+
+	// Multiple matched values are an error for the scalar columns.
+	rows, err = generateJSONTableRows(c, `[{"a": [1, 2]}]`, "$[*]", jsonTablePathColumn(c, "a", mysql.TypeLonglong, "$.a[*]"))
+	c.Assert(err, IsNil)
+	c.Assert(rows, DeepEquals, []string{"<nil>"})
+	rows, err = generateJSONTableRows(c, `[{"a": [1, 2]}]`, "$[*]", jsonTablePathColumn(c, "a", mysql.TypeJSON, "$.a[*]"))
+	c.Assert(err, IsNil)
+	c.Assert(rows, DeepEquals, []string{"[1, 2]"})
+
+	onEmptyError := jsonTablePathColumn(c, "a", mysql.TypeLonglong, "$.a")
+	onEmptyError.onEmpty = jsonTableResponse{tp: jsonTableResponseError}
+	_, err = generateJSONTableRows(c, `[{"b": 1}]`, "$[*]", onEmptyError)
+	c.Assert(terror.ErrorEqual(err, errMissingJSONTableValue), IsTrue, Commentf("%v", err))
+
+	onErrorError := jsonTablePathColumn(c, "a", mysql.TypeLonglong, "$.a")
+	onErrorError.onError = jsonTableResponse{tp: jsonTableResponseError}
+	_, err = generateJSONTableRows(c, `[{"a": [1]}]`, "$[*]", onErrorError)
+	c.Assert(terror.ErrorEqual(err, errWrongJSONTableValue), IsTrue, Commentf("%v", err))
+}
@@ -87,6 +87,61 @@ func (s *pkgTestSuite) TestNestedLoopApply(c *C) {
 	}
 }
 
+func (s *pkgTestSuite) TestExpandExec(c *C) {
+	ctx := context.Background()
+	sctx := mock.NewContext()
+	tp := types.NewFieldType(mysql.TypeLonglong)
+	col0 := &expression.Column{Index: 0, RetType: tp}
+	col1 := &expression.Column{Index: 1, RetType: tp}
+	dataSource := buildMockDataSource(mockDataSourceParameters{
+		schema: expression.NewSchema(col0, col1),
+		rows:   3,
+		ctx:    sctx,
+		genDataFunc: func(row int, typ *types.FieldType) interface{} {
+			return int64(row + 1)
+		},
+	})
+	dataSource.prepareChunks()
+
+	// The grouping sets of GROUP BY col0, col1 WITH ROLLUP.
+	null := &expression.Constant{Value: types.NewDatum(nil), RetType: tp}
+	gid := func(id int64) expression.Expression {
+		return &expression.Constant{Value: types.NewIntDatum(id), RetType: tp}
+	}
+	levelExprs := [][]expression.Expression{
+		{col0, col1, gid(0)},
+		{col0, null, gid(1)},
+		{null, null, gid(2)},
+	}
+	outSchema := expression.NewSchema(
+		&expression.Column{Index: 0, RetType: tp},
+		&expression.Column{Index: 1, RetType: tp},
+		&expression.Column{Index: 2, RetType: tp},
+	)
+	expand := &ExpandExec{baseExecutor: newBaseExecutor(sctx, outSchema, 0, dataSource)}
+	for _, exprs := range levelExprs {
+		expand.levelEvaluators = append(expand.levelEvaluators, expression.NewEvaluatorSuite(exprs, true))
+	}
+	c.Assert(expand.Open(ctx), IsNil)
+	var result []string
+	chk := newFirstChunk(expand)
+	for {
+		c.Assert(expand.Next(ctx, chk), IsNil)
+		if chk.NumRows() == 0 {
+			break
+		}
+		for i := 0; i < chk.NumRows(); i++ {
+			result = append(result, chk.GetRow(i).ToString(retTypes(expand)))
+		}
+	}
+	c.Assert(expand.Close(), IsNil)
+	c.Assert(result, DeepEquals, []string{
+		"1, 1, 0", "2, 2, 0", "3, 3, 0",
+		"1, NULL, 1", "2, NULL, 1", "3, NULL, 1",
+		"NULL, NULL, 2", "NULL, NULL, 2", "NULL, NULL, 2",
+	})
+}
+
 func (s *pkgTestSuite) TestMoveInfoSchemaToFront(c *C) {
 	dbss := [][]string{
 		{},
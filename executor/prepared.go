@@ -87,6 +87,11 @@ type PrepareExec struct {
 	ID         uint32
 	ParamCount int
 	Fields     []*ast.ResultField
+	Stmt       *plannercore.CachedPrepareStmt
+
+	// Unregistered indicates the prepared statement is only returned in Stmt
+	// instead of being added to the session, so it's invisible to the users.
+	Unregistered bool
 
 	// If it's generated from executing "prepare stmt from '...'", the process is parse -> plan -> executor
 	// If it's generated from the prepare protocol, the process is session.PrepareStmt -> NewPrepareExec
@@ -235,6 +240,10 @@ func (e *PrepareExec) Next(ctx context.Context, req *chunk.Chunk) error {
 		ForUpdateRead:       destBuilder.GetIsForUpdateRead(),
 		SnapshotTSEvaluator: ret.SnapshotTSEvaluator,
 	}
+	e.Stmt = preparedObj
+	if e.Unregistered {
+		return nil
+	}
 	return vars.AddPreparedStmt(e.ID, preparedObj)
 }
 
@@ -323,12 +332,12 @@ func (e *DeallocateExec) Next(ctx context.Context, req *chunk.Chunk) error {
 
 // CompileExecutePreparedStmt compiles a session Execute command to a stmt.Statement.
 func CompileExecutePreparedStmt(ctx context.Context, sctx sessionctx.Context,
-	ID uint32, is infoschema.InfoSchema, snapshotTS uint64, args []types.Datum) (*ExecStmt, bool, bool, error) {
+	ID uint32, preparedObj *plannercore.CachedPrepareStmt, is infoschema.InfoSchema, snapshotTS uint64, args []types.Datum) (*ExecStmt, bool, bool, error) {
 	startTime := time.Now()
 	defer func() {
 		sctx.GetSessionVars().DurationCompile = time.Since(startTime)
 	}()
-	execStmt := &ast.ExecuteStmt{ExecID: ID}
+	execStmt := &ast.ExecuteStmt{ExecID: ID, PrepStmt: preparedObj}
 	if err := ResetContextOfStmt(sctx, execStmt); err != nil {
 		return nil, false, false, err
 	}
@@ -348,16 +357,10 @@ func CompileExecutePreparedStmt(ctx context.Context, sctx sessionctx.Context,
 		Ti:          &TelemetryInfo{},
 		SnapshotTS:  snapshotTS,
 	}
-	if preparedPointer, ok := sctx.GetSessionVars().PreparedStmts[ID]; ok {
-		preparedObj, ok := preparedPointer.(*plannercore.CachedPrepareStmt)
-		if !ok {
-			return nil, false, false, errors.Errorf("invalid CachedPrepareStmt type")
-		}
-		stmtCtx := sctx.GetSessionVars().StmtCtx
-		stmt.Text = preparedObj.PreparedAst.Stmt.Text()
-		stmtCtx.OriginalSQL = stmt.Text
-		stmtCtx.InitSQLDigest(preparedObj.NormalizedSQL, preparedObj.SQLDigest)
-	}
+	stmtCtx := sctx.GetSessionVars().StmtCtx
+	stmt.Text = preparedObj.PreparedAst.Stmt.Text()
+	stmtCtx.OriginalSQL = stmt.Text
+	stmtCtx.InitSQLDigest(preparedObj.NormalizedSQL, preparedObj.SQLDigest)
 	tiFlashPushDown, tiFlashExchangePushDown := plannercore.IsTiFlashContained(stmt.Plan)
 	return stmt, tiFlashPushDown, tiFlashExchangePushDown, nil
 }
@@ -106,6 +106,10 @@ var funcName2Alias = map[string]string{
 	"like":                       ast.Like,
 	"case":                       ast.Case,
 	"regexp":                     ast.Regexp,
+	"regexp_like":                expression.RegexpLike,
+	"regexp_instr":               expression.RegexpInStr,
+	"regexp_substr":              expression.RegexpSubstr,
+	"regexp_replace":             expression.RegexpReplace,
 	"is null":                    ast.IsNull,
 	"is true":                    ast.IsTruthWithoutNull,
 	"is false":                   ast.IsFalsity,
@@ -87,6 +87,10 @@ func (e *ReplaceExec) removeRow(ctx context.Context, kvGetter kv.Getter, handle
 	if err != nil {
 		return false, err
 	}
+	err = e.fkChecker.onParentRowRemoved(ctx, r.t, oldRow)
+	if err != nil {
+		return false, err
+	}
 	e.ctx.GetSessionVars().StmtCtx.AddAffectedRows(1)
 	return false, nil
 }
@@ -237,6 +241,9 @@ func (e *ReplaceExec) exec(ctx context.Context, newRows [][]types.Datum) error {
 		e.stats.Prefetch = time.Since(prefetchStart)
 	}
 	e.ctx.GetSessionVars().StmtCtx.AddRecordRows(uint64(len(newRows)))
+	if err = e.prefetchForeignKeyParents(ctx, txn, newRows); err != nil {
+		return err
+	}
 	for _, r := range toBeCheckedRows {
 		err = e.replaceRow(ctx, r)
 		if err != nil {
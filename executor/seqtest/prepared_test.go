@@ -151,6 +151,7 @@ func (s *seqTestSuite) TestPrepared(c *C) {
 
 		// Check that ast.Statement created by executor.CompileExecutePreparedStmt has query text.
 		stmt, _, _, err := executor.CompileExecutePreparedStmt(context.TODO(), tk.Se, stmtID,
+			tk.Se.GetSessionVars().PreparedStmts[stmtID].(*plannercore.CachedPrepareStmt),
 			tk.Se.GetInfoSchema().(infoschema.InfoSchema), 0, []types.Datum{types.NewDatum(1)})
 		c.Assert(err, IsNil)
 		c.Assert(stmt.OriginText(), Equals, query)
@@ -992,6 +992,16 @@ func ConstructResultOfShowCreateTable(ctx sessionctx.Context, tableInfo *model.T
 		}
 	}
 
+	for _, constr := range tableInfo.Constraints {
+		if constr.State != model.StatePublic {
+			continue
+		}
+		buf.WriteString(fmt.Sprintf(",\n  CONSTRAINT %s CHECK ((%s))", stringutil.Escape(constr.Name.O, sqlMode), constr.ExprString))
+		if !constr.Enforced {
+			buf.WriteString(" /*!80016 NOT ENFORCED */")
+		}
+	}
+
 	buf.WriteString("\n")
 
 	switch tableInfo.TempTableType {
@@ -1367,7 +1377,7 @@ func (e *ShowExec) fetchShowCreateUser(ctx context.Context) error {
 
 	exec := e.ctx.(sqlexec.RestrictedSQLExecutor)
 
-	stmt, err := exec.ParseWithParams(ctx, `SELECT plugin FROM %n.%n WHERE User=%? AND Host=%?`, mysql.SystemDB, mysql.UserTable, userName, hostName)
+	stmt, err := exec.ParseWithParams(ctx, `SELECT plugin, Account_locked, Password_expired, Password_lifetime, Password_reuse_history, Password_reuse_time, User_attributes FROM %n.%n WHERE User=%? AND Host=%?`, mysql.SystemDB, mysql.UserTable, userName, hostName)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -1386,6 +1396,42 @@ func (e *ShowExec) fetchShowCreateUser(ctx context.Context) error {
 	if len(rows) == 1 && rows[0].GetString(0) != "" {
 		authplugin = rows[0].GetString(0)
 	}
+	passwordExpire := "PASSWORD EXPIRE DEFAULT"
+	switch {
+	case rows[0].GetEnum(2).String() == "Y":
+		passwordExpire = "PASSWORD EXPIRE"
+	case rows[0].IsNull(3):
+	case rows[0].GetInt64(3) == 0:
+		passwordExpire = "PASSWORD EXPIRE NEVER"
+	default:
+		passwordExpire = fmt.Sprintf("PASSWORD EXPIRE INTERVAL %d DAY", rows[0].GetInt64(3))
+	}
+	accountLock := "UNLOCK"
+	if rows[0].GetEnum(1).String() == "Y" {
+		accountLock = "LOCK"
+	}
+	// The password reuse and locking options are only shown if they are not default.
+	var passwordOptions strings.Builder
+	if !rows[0].IsNull(4) {
+		fmt.Fprintf(&passwordOptions, " PASSWORD HISTORY %d", rows[0].GetInt64(4))
+	}
+	if !rows[0].IsNull(5) {
+		fmt.Fprintf(&passwordOptions, " PASSWORD REUSE INTERVAL %d DAY", rows[0].GetInt64(5))
+	}
+	if !rows[0].IsNull(6) {
+		var attributes privileges.UserAttributes
+		if err := gjson.Unmarshal(hack.Slice(rows[0].GetJSON(6).String()), &attributes); err != nil {
+			return errors.Trace(err)
+		}
+		if locking := attributes.PasswordLocking; locking != nil && (locking.FailedLoginAttempts != 0 || locking.PasswordLockTimeDays != 0) {
+			fmt.Fprintf(&passwordOptions, " FAILED_LOGIN_ATTEMPTS %d", locking.FailedLoginAttempts)
+			if locking.PasswordLockTimeDays < 0 {
+				passwordOptions.WriteString(" PASSWORD_LOCK_TIME UNBOUNDED")
+			} else {
+				fmt.Fprintf(&passwordOptions, " PASSWORD_LOCK_TIME %d", locking.PasswordLockTimeDays)
+			}
+		}
+	}
 
 	stmt, err = exec.ParseWithParams(ctx, `SELECT Priv FROM %n.%n WHERE User=%? AND Host=%?`, mysql.SystemDB, mysql.GlobalPrivTable, userName, hostName)
 	if err != nil {
@@ -1407,8 +1453,8 @@ func (e *ShowExec) fetchShowCreateUser(ctx context.Context) error {
 		require = privValue.RequireStr()
 	}
 	// FIXME: the returned string is not escaped safely
-	showStr := fmt.Sprintf("CREATE USER '%s'@'%s' IDENTIFIED WITH '%s' AS '%s' REQUIRE %s PASSWORD EXPIRE DEFAULT ACCOUNT UNLOCK",
-		e.User.Username, e.User.Hostname, authplugin, checker.GetEncodedPassword(e.User.Username, e.User.Hostname), require)
+	showStr := fmt.Sprintf("CREATE USER '%s'@'%s' IDENTIFIED WITH '%s' AS '%s' REQUIRE %s %s ACCOUNT %s%s",
+		e.User.Username, e.User.Hostname, authplugin, checker.GetEncodedPassword(e.User.Username, e.User.Hostname), require, passwordExpire, accountLock, passwordOptions.String())
 	e.appendRow([]interface{}{showStr})
 	return nil
 }
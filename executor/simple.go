@@ -15,8 +15,11 @@ package executor
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"math"
 	"os"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -39,13 +42,16 @@ import (
 	plannercore "github.com/pingcap/tidb/planner/core"
 	"github.com/pingcap/tidb/plugin"
 	"github.com/pingcap/tidb/privilege"
+	"github.com/pingcap/tidb/privilege/privileges"
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util"
 	"github.com/pingcap/tidb/util/chunk"
 	"github.com/pingcap/tidb/util/collate"
 	"github.com/pingcap/tidb/util/hack"
 	"github.com/pingcap/tidb/util/logutil"
+	"github.com/pingcap/tidb/util/passwordvalidation"
 	"github.com/pingcap/tidb/util/sem"
 	"github.com/pingcap/tidb/util/sqlexec"
 	"github.com/pingcap/tidb/util/timeutil"
@@ -747,15 +753,42 @@ func (e *SimpleExec) executeCreateUser(ctx context.Context, s *ast.CreateUserStm
 	if err != nil {
 		return err
 	}
+	passwordOptions, err := parsePasswordOrLockOptions(s.PasswordOrLockOptions)
+	if err != nil {
+		return err
+	}
+	lockAccount := "N"
+	if s.IsCreateRole || passwordOptions.lockAccount == "Y" {
+		lockAccount = "Y"
+	}
+	passwordExpired := "N"
+	if passwordOptions.passwordExpired {
+		passwordExpired = "Y"
+	}
+	historyLen, reuseDays, err := e.globalPasswordReusePolicy()
+	if err != nil {
+		return err
+	}
+	if history, ok := passwordOptions.passwordReuseHistory.(int64); ok {
+		historyLen = history
+	}
+	if reuse, ok := passwordOptions.passwordReuseTime.(int64); ok {
+		reuseDays = reuse
+	}
+	var userAttributes interface{}
+	if passwordOptions.hasPasswordLocking() {
+		value, err := json.Marshal(&privileges.UserAttributes{PasswordLocking: passwordOptions.passwordLocking(nil)})
+		if err != nil {
+			return errors.Trace(err)
+		}
+		userAttributes = string(value)
+	}
 
 	sql := new(strings.Builder)
-	if s.IsCreateRole {
-		sqlexec.MustFormatSQL(sql, `INSERT INTO %n.%n (Host, User, authentication_string, plugin, Account_locked) VALUES `, mysql.SystemDB, mysql.UserTable)
-	} else {
-		sqlexec.MustFormatSQL(sql, `INSERT INTO %n.%n (Host, User, authentication_string, plugin) VALUES `, mysql.SystemDB, mysql.UserTable)
-	}
+	sqlexec.MustFormatSQL(sql, `INSERT INTO %n.%n (Host, User, authentication_string, plugin, Account_locked, Password_expired, Password_lifetime, Password_reuse_history, Password_reuse_time, User_attributes) VALUES `, mysql.SystemDB, mysql.UserTable)
 
 	users := make([]*auth.UserIdentity, 0, len(s.Specs))
+	passwords := make([]string, 0, len(s.Specs))
 	for _, spec := range s.Specs {
 		if len(users) > 0 {
 			sqlexec.MustFormatSQL(sql, ",")
@@ -776,6 +809,11 @@ func (e *SimpleExec) executeCreateUser(ctx context.Context, s *ast.CreateUserStm
 			e.ctx.GetSessionVars().StmtCtx.AppendNote(err)
 			continue
 		}
+		if plainPwd, ok := plaintextPassword(spec); ok && !s.IsCreateRole {
+			if err := e.validatePassword(spec.User, plainPwd); err != nil {
+				return err
+			}
+		}
 		pwd, ok := spec.EncodedPassword()
 
 		if !ok {
@@ -785,12 +823,10 @@ func (e *SimpleExec) executeCreateUser(ctx context.Context, s *ast.CreateUserStm
 		if spec.AuthOpt != nil && spec.AuthOpt.AuthPlugin != "" {
 			authPlugin = spec.AuthOpt.AuthPlugin
 		}
-		if s.IsCreateRole {
-			sqlexec.MustFormatSQL(sql, `(%?, %?, %?, %?, %?)`, spec.User.Hostname, spec.User.Username, pwd, authPlugin, "Y")
-		} else {
-			sqlexec.MustFormatSQL(sql, `(%?, %?, %?, %?)`, spec.User.Hostname, spec.User.Username, pwd, authPlugin)
-		}
+		sqlexec.MustFormatSQL(sql, `(%?, %?, %?, %?, %?, %?, %?, %?, %?, %?)`, spec.User.Hostname, spec.User.Username, pwd, authPlugin, lockAccount, passwordExpired,
+			passwordOptions.passwordLifetime, passwordOptions.passwordReuseHistory, passwordOptions.passwordReuseTime, userAttributes)
 		users = append(users, spec.User)
+		passwords = append(passwords, pwd)
 	}
 	if len(users) == 0 {
 		return nil
@@ -830,6 +866,24 @@ func (e *SimpleExec) executeCreateUser(ctx context.Context, s *ast.CreateUserStm
 			return err
 		}
 	}
+	// Keep the initial passwords in the history if the passwords can not be reused.
+	if !s.IsCreateRole && (historyLen > 0 || reuseDays > 0) {
+		sql.Reset()
+		sqlexec.MustFormatSQL(sql, "INSERT INTO %n.%n (Host, User, Password) VALUES ", mysql.SystemDB, passwordHistoryTable)
+		for i, user := range users {
+			if i > 0 {
+				sqlexec.MustFormatSQL(sql, ",")
+			}
+			sqlexec.MustFormatSQL(sql, `(%?, %?, %?)`, user.Hostname, user.Username, passwords[i])
+		}
+		_, err = sqlExecutor.ExecuteInternal(context.TODO(), sql.String())
+		if err != nil {
+			if _, rollbackErr := sqlExecutor.ExecuteInternal(context.TODO(), "rollback"); rollbackErr != nil {
+				return rollbackErr
+			}
+			return err
+		}
+	}
 	if _, err := sqlExecutor.ExecuteInternal(context.TODO(), "commit"); err != nil {
 		return errors.Trace(err)
 	}
@@ -869,9 +923,15 @@ func (e *SimpleExec) executeAlterUser(ctx context.Context, s *ast.AlterUserStmt)
 	hasRestrictedUserPriv := checker.RequestDynamicVerification(activeRoles, "RESTRICTED_USER_ADMIN", false)
 	hasSystemSchemaPriv := checker.RequestVerification(activeRoles, mysql.SystemDB, mysql.UserTable, "", mysql.UpdatePriv)
 
+	passwordOptions, err := parsePasswordOrLockOptions(s.PasswordOrLockOptions)
+	if err != nil {
+		return err
+	}
+
 	for _, spec := range s.Specs {
 		user := e.ctx.GetSessionVars().User
-		if spec.User.CurrentUser || ((user != nil) && (user.Username == spec.User.Username) && (user.AuthHostname == spec.User.Hostname)) {
+		isCurrentUser := spec.User.CurrentUser || ((user != nil) && (user.Username == spec.User.Username) && (user.AuthHostname == spec.User.Hostname))
+		if isCurrentUser {
 			spec.User.Username = user.Username
 			spec.User.Hostname = user.AuthHostname
 		} else {
@@ -921,18 +981,76 @@ func (e *SimpleExec) executeAlterUser(ctx context.Context, s *ast.AlterUserStmt)
 			spec.AuthOpt.AuthPlugin = authplugin
 		}
 		exec := e.ctx.(sqlexec.RestrictedSQLExecutor)
+		fields := make([]string, 0, 5)
+		args := []interface{}{mysql.SystemDB, mysql.UserTable}
+		passwordExpired := ""
 		if spec.AuthOpt != nil {
 			pwd, ok := spec.EncodedPassword()
 			if !ok {
 				return errors.Trace(ErrPasswordFormat)
 			}
-			stmt, err := exec.ParseWithParams(ctx, `UPDATE %n.%n SET authentication_string=%? WHERE Host=%? and User=%?;`, mysql.SystemDB, mysql.UserTable, pwd, spec.User.Hostname, spec.User.Username)
+			plainPwd, isPlaintext := plaintextPassword(spec)
+			if isPlaintext {
+				if err := e.validatePassword(spec.User, plainPwd); err != nil {
+					return err
+				}
+			}
+			if err := e.updatePasswordHistory(ctx, spec.User, authplugin, plainPwd, pwd); err != nil {
+				return err
+			}
+			fields = append(fields, "authentication_string=%?", "Password_last_changed=CURRENT_TIMESTAMP()")
+			args = append(args, pwd)
+			passwordExpired = "N"
+		}
+		if passwordOptions.passwordExpired {
+			passwordExpired = "Y"
+		}
+		if passwordExpired != "" {
+			fields = append(fields, "Password_expired=%?")
+			args = append(args, passwordExpired)
+		}
+		if passwordOptions.passwordLifetimeSet {
+			fields = append(fields, "Password_lifetime=%?")
+			args = append(args, passwordOptions.passwordLifetime)
+		}
+		if passwordOptions.passwordReuseHistorySet {
+			fields = append(fields, "Password_reuse_history=%?")
+			args = append(args, passwordOptions.passwordReuseHistory)
+		}
+		if passwordOptions.passwordReuseTimeSet {
+			fields = append(fields, "Password_reuse_time=%?")
+			args = append(args, passwordOptions.passwordReuseTime)
+		}
+		if passwordOptions.lockAccount != "" {
+			fields = append(fields, "Account_locked=%?")
+			args = append(args, passwordOptions.lockAccount)
+		}
+		if passwordOptions.hasPasswordLocking() {
+			current, err := e.currentPasswordLocking(ctx, spec.User)
+			if err != nil {
+				return err
+			}
+			locking, err := json.Marshal(passwordOptions.passwordLocking(current))
+			if err != nil {
+				return errors.Trace(err)
+			}
+			fields = append(fields, "User_attributes=JSON_SET(COALESCE(User_attributes, JSON_OBJECT()), '$.Password_locking', CAST(%? AS JSON))")
+			args = append(args, string(locking))
+		} else if passwordOptions.lockAccount == "N" {
+			// ACCOUNT UNLOCK also unblocks the account blocked by the consecutive failed logins.
+			fields = append(fields, "User_attributes=JSON_REMOVE(User_attributes, '$.Password_locking.failed_login_count', '$.Password_locking.auto_locked_at')")
+		}
+		if len(fields) > 0 {
+			args = append(args, spec.User.Hostname, spec.User.Username)
+			stmt, err := exec.ParseWithParams(ctx, "UPDATE %n.%n SET "+strings.Join(fields, ", ")+" WHERE Host=%? and User=%?;", args...)
 			if err != nil {
 				return err
 			}
 			_, _, err = exec.ExecRestrictedStmt(ctx, stmt)
 			if err != nil {
 				failedUsers = append(failedUsers, spec.User.String())
+			} else if isCurrentUser && passwordExpired == "N" {
+				e.ctx.GetSessionVars().InSandBoxMode = false
 			}
 		}
 
@@ -1118,6 +1236,12 @@ func (e *SimpleExec) executeRenameUser(s *ast.RenameUserStmt) error {
 			break
 		}
 
+		// rename the previously used passwords from mysql.password_history
+		if err = renameUserHostInSystemTable(sqlExecutor, passwordHistoryTable, "User", "Host", userToUser); err != nil {
+			failedUser = oldUser.String() + " TO " + newUser.String() + " mysql.password_history error"
+			break
+		}
+
 		//TODO: need update columns_priv once we implement columns_priv functionality.
 		// When that is added, please refactor both executeRenameUser and executeDropUser to use an array of tables
 		// to loop over, so it is easier to maintain.
@@ -1280,6 +1404,14 @@ func (e *SimpleExec) executeDropUser(ctx context.Context, s *ast.DropUserStmt) e
 			break
 		}
 
+		// delete the previously used passwords from mysql.password_history
+		sql.Reset()
+		sqlexec.MustFormatSQL(sql, `DELETE FROM %n.%n WHERE Host = %? and User = %?;`, mysql.SystemDB, passwordHistoryTable, user.Hostname, user.Username)
+		if _, err = sqlExecutor.ExecuteInternal(context.TODO(), sql.String()); err != nil {
+			failedUsers = append(failedUsers, user.String())
+			break
+		}
+
 		//TODO: need delete columns_priv once we implement columns_priv functionality.
 	}
 
@@ -1372,6 +1504,9 @@ func (e *SimpleExec) executeSetPwd(ctx context.Context, s *ast.SetPwdStmt) error
 	if err != nil {
 		return err
 	}
+	if err := e.validatePassword(&auth.UserIdentity{Username: u, Hostname: h}, s.Password); err != nil {
+		return err
+	}
 	var pwd string
 	if authplugin == mysql.AuthCachingSha2Password {
 		pwd = auth.NewSha2Password(s.Password)
@@ -1379,17 +1514,284 @@ func (e *SimpleExec) executeSetPwd(ctx context.Context, s *ast.SetPwdStmt) error
 		pwd = auth.EncodePassword(s.Password)
 	}
 
+	if err := e.updatePasswordHistory(ctx, &auth.UserIdentity{Username: u, Hostname: h}, authplugin, s.Password, pwd); err != nil {
+		return err
+	}
+
 	// update mysql.user
 	exec := e.ctx.(sqlexec.RestrictedSQLExecutor)
-	stmt, err := exec.ParseWithParams(ctx, `UPDATE %n.%n SET authentication_string=%? WHERE User=%? AND Host=%?;`, mysql.SystemDB, mysql.UserTable, pwd, u, h)
+	stmt, err := exec.ParseWithParams(ctx, `UPDATE %n.%n SET authentication_string=%?, Password_expired='N', Password_last_changed=CURRENT_TIMESTAMP() WHERE User=%? AND Host=%?;`, mysql.SystemDB, mysql.UserTable, pwd, u, h)
 	if err != nil {
 		return err
 	}
 	_, _, err = exec.ExecRestrictedStmt(ctx, stmt)
+	if err == nil && s.User == nil {
+		e.ctx.GetSessionVars().InSandBoxMode = false
+	}
 	domain.GetDomain(e.ctx).NotifyUpdatePrivilege(e.ctx)
 	return err
 }
 
+// passwordHistoryTable keeps the previously used passwords of the accounts.
+const passwordHistoryTable = "password_history"
+
+// passwordOrLockOptions is the account settings specified by the password and lock
+// options of CREATE USER and ALTER USER.
+type passwordOrLockOptions struct {
+	// lockAccount is "Y" or "N", or empty if the option is not specified.
+	lockAccount     string
+	passwordExpired bool
+	// passwordLifetime is nil for PASSWORD EXPIRE DEFAULT.
+	passwordLifetime    interface{}
+	passwordLifetimeSet bool
+	// passwordReuseHistory and passwordReuseTime are nil for PASSWORD HISTORY DEFAULT
+	// and PASSWORD REUSE INTERVAL DEFAULT.
+	passwordReuseHistory    interface{}
+	passwordReuseHistorySet bool
+	passwordReuseTime       interface{}
+	passwordReuseTimeSet    bool
+	// failedLoginAttempts and passwordLockTime are nil if the options are not specified,
+	// passwordLockTime is -1 for PASSWORD_LOCK_TIME UNBOUNDED.
+	failedLoginAttempts *int64
+	passwordLockTime    *int64
+}
+
+// maxPasswordLockingOption is the max value of FAILED_LOGIN_ATTEMPTS and PASSWORD_LOCK_TIME.
+const maxPasswordLockingOption = math.MaxInt16
+
+func parsePasswordOrLockOptions(options []*ast.PasswordOrLockOption) (*passwordOrLockOptions, error) {
+	result := &passwordOrLockOptions{}
+	for _, option := range options {
+		switch option.Type {
+		case ast.Lock:
+			result.lockAccount = "Y"
+		case ast.Unlock:
+			result.lockAccount = "N"
+		case ast.PasswordExpire:
+			result.passwordExpired = true
+		case ast.PasswordExpireDefault:
+			result.passwordLifetime, result.passwordLifetimeSet = nil, true
+		case ast.PasswordExpireNever:
+			result.passwordLifetime, result.passwordLifetimeSet = 0, true
+		case ast.PasswordExpireInterval:
+			if option.Count <= 0 || option.Count > math.MaxUint16 {
+				return nil, types.ErrWrongValue.GenWithStackByArgs("DAY", strconv.FormatInt(option.Count, 10))
+			}
+			result.passwordLifetime, result.passwordLifetimeSet = option.Count, true
+		case ast.PasswordHistory:
+			if option.Count > math.MaxUint16 {
+				return nil, types.ErrWrongValue.GenWithStackByArgs("PASSWORD HISTORY", strconv.FormatInt(option.Count, 10))
+			}
+			result.passwordReuseHistory, result.passwordReuseHistorySet = option.Count, true
+		case ast.PasswordHistoryDefault:
+			result.passwordReuseHistory, result.passwordReuseHistorySet = nil, true
+		case ast.PasswordReuseInterval:
+			if option.Count > math.MaxUint16 {
+				return nil, types.ErrWrongValue.GenWithStackByArgs("DAY", strconv.FormatInt(option.Count, 10))
+			}
+			result.passwordReuseTime, result.passwordReuseTimeSet = option.Count, true
+		case ast.PasswordReuseDefault:
+			result.passwordReuseTime, result.passwordReuseTimeSet = nil, true
+		case ast.FailedLoginAttempts:
+			if option.Count > maxPasswordLockingOption {
+				return nil, types.ErrWrongValue.GenWithStackByArgs("FAILED_LOGIN_ATTEMPTS", strconv.FormatInt(option.Count, 10))
+			}
+			count := option.Count
+			result.failedLoginAttempts = &count
+		case ast.PasswordLockTime:
+			if option.Count > maxPasswordLockingOption {
+				return nil, types.ErrWrongValue.GenWithStackByArgs("PASSWORD_LOCK_TIME", strconv.FormatInt(option.Count, 10))
+			}
+			days := option.Count
+			result.passwordLockTime = &days
+		case ast.PasswordLockTimeUnbounded:
+			days := int64(-1)
+			result.passwordLockTime = &days
+		}
+	}
+	return result, nil
+}
+
+// hasPasswordLocking returns whether FAILED_LOGIN_ATTEMPTS or PASSWORD_LOCK_TIME is specified.
+func (o *passwordOrLockOptions) hasPasswordLocking() bool {
+	return o.failedLoginAttempts != nil || o.passwordLockTime != nil
+}
+
+// passwordLocking returns the Password_locking attribute with the specified options applied
+// to the current one, which may be nil. The consecutive failed logins are cleared.
+func (o *passwordOrLockOptions) passwordLocking(current *privileges.PasswordLocking) *privileges.PasswordLocking {
+	locking := &privileges.PasswordLocking{}
+	if current != nil {
+		locking.FailedLoginAttempts = current.FailedLoginAttempts
+		locking.PasswordLockTimeDays = current.PasswordLockTimeDays
+	}
+	if o.failedLoginAttempts != nil {
+		locking.FailedLoginAttempts = *o.failedLoginAttempts
+	}
+	if o.passwordLockTime != nil {
+		locking.PasswordLockTimeDays = *o.passwordLockTime
+	}
+	return locking
+}
+
+// currentPasswordLocking returns the Password_locking attribute of the account, or nil if it is not set.
+func (e *SimpleExec) currentPasswordLocking(ctx context.Context, user *auth.UserIdentity) (*privileges.PasswordLocking, error) {
+	exec := e.ctx.(sqlexec.RestrictedSQLExecutor)
+	stmt, err := exec.ParseWithParams(ctx, `SELECT User_attributes FROM %n.%n WHERE Host=%? AND User=%?`, mysql.SystemDB, mysql.UserTable, user.Hostname, user.Username)
+	if err != nil {
+		return nil, err
+	}
+	rows, _, err := exec.ExecRestrictedStmt(ctx, stmt)
+	if err != nil || len(rows) == 0 || rows[0].IsNull(0) {
+		return nil, err
+	}
+	var attributes privileges.UserAttributes
+	if err := json.Unmarshal(hack.Slice(rows[0].GetJSON(0).String()), &attributes); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return attributes.PasswordLocking, nil
+}
+
+// globalPasswordReusePolicy returns the values of password_history and password_reuse_interval.
+func (e *SimpleExec) globalPasswordReusePolicy() (historyLen, reuseDays int64, err error) {
+	sessionVars := e.ctx.GetSessionVars()
+	val, err := variable.GetGlobalSystemVar(sessionVars, variable.PasswordHistory)
+	if err != nil {
+		return 0, 0, err
+	}
+	if historyLen, err = strconv.ParseInt(val, 10, 64); err != nil {
+		return 0, 0, err
+	}
+	val, err = variable.GetGlobalSystemVar(sessionVars, variable.PasswordReuseInterval)
+	if err != nil {
+		return 0, 0, err
+	}
+	if reuseDays, err = strconv.ParseInt(val, 10, 64); err != nil {
+		return 0, 0, err
+	}
+	return historyLen, reuseDays, nil
+}
+
+// updatePasswordHistory checks the new password against the password history of the account,
+// then records it and removes the history entries which no longer restrict the reuse.
+// The Password_reuse_history and Password_reuse_time of mysql.user override the global
+// password_history and password_reuse_interval if they are not NULL.
+// plainPwd is empty if the new password is given as a hash.
+// plaintextPassword returns the plaintext password of spec, ok is false if only the hash of the password is given.
+func plaintextPassword(spec *ast.UserSpec) (pwd string, ok bool) {
+	if spec.AuthOpt == nil {
+		return "", true
+	}
+	if spec.AuthOpt.ByAuthString {
+		return spec.AuthOpt.AuthString, true
+	}
+	return "", spec.AuthOpt.HashString == ""
+}
+
+// validatePassword checks the plaintext password of user against the policy of validate_password_*.
+func (e *SimpleExec) validatePassword(user *auth.UserIdentity, pwd string) error {
+	sessionVars := e.ctx.GetSessionVars()
+	userNames := []string{user.Username}
+	if sessionVars.User != nil {
+		userNames = append(userNames, sessionVars.User.Username)
+	}
+	violation, err := passwordvalidation.ValidatePassword(sessionVars, pwd, userNames...)
+	if err != nil {
+		return err
+	}
+	if violation != "" {
+		sessionVars.StmtCtx.AppendWarning(errors.New(violation))
+		return ErrNotValidPassword.GenWithStackByArgs()
+	}
+	return nil
+}
+
+func (e *SimpleExec) updatePasswordHistory(ctx context.Context, user *auth.UserIdentity, authPlugin, plainPwd, pwd string) error {
+	historyLen, reuseDays, err := e.globalPasswordReusePolicy()
+	if err != nil {
+		return err
+	}
+	exec := e.ctx.(sqlexec.RestrictedSQLExecutor)
+	stmt, err := exec.ParseWithParams(ctx, `SELECT Password_reuse_history, Password_reuse_time FROM %n.%n WHERE Host=%? AND User=%?`, mysql.SystemDB, mysql.UserTable, user.Hostname, user.Username)
+	if err != nil {
+		return err
+	}
+	rows, _, err := exec.ExecRestrictedStmt(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 1 {
+		if !rows[0].IsNull(0) {
+			historyLen = rows[0].GetInt64(0)
+		}
+		if !rows[0].IsNull(1) {
+			reuseDays = rows[0].GetInt64(1)
+		}
+	}
+	if historyLen == 0 && reuseDays == 0 {
+		stmt, err = exec.ParseWithParams(ctx, `DELETE FROM %n.%n WHERE Host=%? AND User=%?`, mysql.SystemDB, passwordHistoryTable, user.Hostname, user.Username)
+		if err != nil {
+			return err
+		}
+		_, _, err = exec.ExecRestrictedStmt(ctx, stmt)
+		return err
+	}
+
+	stmt, err = exec.ParseWithParams(ctx, `SELECT Password, CAST(Password_timestamp AS CHAR), Password_timestamp > DATE_SUB(NOW(6), INTERVAL %? DAY) FROM %n.%n WHERE Host=%? AND User=%? ORDER BY Password_timestamp DESC`,
+		reuseDays, mysql.SystemDB, passwordHistoryTable, user.Hostname, user.Username)
+	if err != nil {
+		return err
+	}
+	rows, _, err = exec.ExecRestrictedStmt(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	obsoleteSince := ""
+	for i, row := range rows {
+		inInterval := row.GetInt64(2) == 1
+		if int64(i) < historyLen || inInterval {
+			if passwordMatchesHistory(authPlugin, row.GetString(0), plainPwd, pwd) {
+				return ErrCredentialsContradictToHistory.GenWithStackByArgs(user.Username, user.Hostname)
+			}
+		}
+		// The new password takes a place of the history.
+		if int64(i)+1 >= historyLen && !inInterval {
+			obsoleteSince = row.GetString(1)
+			break
+		}
+	}
+
+	stmt, err = exec.ParseWithParams(ctx, `INSERT INTO %n.%n (Host, User, Password) VALUES (%?, %?, %?)`, mysql.SystemDB, passwordHistoryTable, user.Hostname, user.Username, pwd)
+	if err != nil {
+		return err
+	}
+	if _, _, err = exec.ExecRestrictedStmt(ctx, stmt); err != nil {
+		return err
+	}
+	if obsoleteSince == "" {
+		return nil
+	}
+	stmt, err = exec.ParseWithParams(ctx, `DELETE FROM %n.%n WHERE Host=%? AND User=%? AND Password_timestamp <= %?`, mysql.SystemDB, passwordHistoryTable, user.Hostname, user.Username, obsoleteSince)
+	if err != nil {
+		return err
+	}
+	_, _, err = exec.ExecRestrictedStmt(ctx, stmt)
+	return err
+}
+
+// passwordMatchesHistory checks whether the new password is the same as the one in the history.
+// The caching_sha2_password hashes are salted, so they are compared with the plain password.
+func passwordMatchesHistory(authPlugin, history, plainPwd, pwd string) bool {
+	if history == pwd {
+		return true
+	}
+	if authPlugin != mysql.AuthCachingSha2Password || plainPwd == "" || len(history) != mysql.SHAPWDHashLen {
+		return false
+	}
+	match, err := auth.CheckShaPassword([]byte(history), plainPwd)
+	return err == nil && match
+}
+
 func (e *SimpleExec) executeKillStmt(ctx context.Context, s *ast.KillStmt) error {
 	if !config.GetGlobalConfig().Experimental.EnableGlobalKill {
 		conf := config.GetGlobalConfig()
@@ -513,6 +513,77 @@ func (s *testSuite3) TestSetPwd(c *C) {
 
 }
 
+func (s *testSuite3) TestPasswordHistory(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("SET GLOBAL password_history = 2")
+	defer tk.MustExec("SET GLOBAL password_history = DEFAULT")
+	tk.MustExec("CREATE USER 'testhistory'@'localhost' IDENTIFIED BY 'pwd1'")
+	tk.MustQuery("SELECT Password FROM mysql.password_history WHERE User = 'testhistory'").Check(testkit.Rows(auth.EncodePassword("pwd1")))
+
+	// The latest 2 passwords can not be reused.
+	_, err := tk.Exec("ALTER USER 'testhistory'@'localhost' IDENTIFIED BY 'pwd1'")
+	c.Assert(terror.ErrorEqual(err, executor.ErrCredentialsContradictToHistory), IsTrue, Commentf("err %v", err))
+	tk.MustExec("SET PASSWORD FOR 'testhistory'@'localhost' = 'pwd2'")
+	_, err = tk.Exec("SET PASSWORD FOR 'testhistory'@'localhost' = 'pwd1'")
+	c.Assert(terror.ErrorEqual(err, executor.ErrCredentialsContradictToHistory), IsTrue, Commentf("err %v", err))
+	tk.MustExec("ALTER USER 'testhistory'@'localhost' IDENTIFIED BY 'pwd3'")
+	tk.MustQuery("SELECT COUNT(*) FROM mysql.password_history WHERE User = 'testhistory'").Check(testkit.Rows("2"))
+	tk.MustExec("ALTER USER 'testhistory'@'localhost' IDENTIFIED BY 'pwd1'")
+
+	// The account settings override the global variables.
+	tk.MustExec("ALTER USER 'testhistory'@'localhost' PASSWORD HISTORY 0")
+	tk.MustExec("ALTER USER 'testhistory'@'localhost' IDENTIFIED BY 'pwd1'")
+	tk.MustQuery("SELECT COUNT(*) FROM mysql.password_history WHERE User = 'testhistory'").Check(testkit.Rows("0"))
+
+	tk.MustExec("ALTER USER 'testhistory'@'localhost' PASSWORD REUSE INTERVAL 1 DAY")
+	tk.MustQuery("SHOW CREATE USER 'testhistory'@'localhost'").Check(testkit.Rows(
+		"CREATE USER 'testhistory'@'localhost' IDENTIFIED WITH 'mysql_native_password' AS '" + auth.EncodePassword("pwd1") + "' REQUIRE NONE PASSWORD EXPIRE DEFAULT ACCOUNT UNLOCK PASSWORD HISTORY 0 PASSWORD REUSE INTERVAL 1 DAY"))
+	tk.MustExec("ALTER USER 'testhistory'@'localhost' IDENTIFIED BY 'pwd2'")
+	tk.MustExec("ALTER USER 'testhistory'@'localhost' IDENTIFIED BY 'pwd3'")
+	_, err = tk.Exec("ALTER USER 'testhistory'@'localhost' IDENTIFIED BY 'pwd2'")
+	c.Assert(terror.ErrorEqual(err, executor.ErrCredentialsContradictToHistory), IsTrue, Commentf("err %v", err))
+	tk.MustExec("UPDATE mysql.password_history SET Password_timestamp = DATE_SUB(Password_timestamp, INTERVAL 2 DAY) WHERE User = 'testhistory'")
+	tk.MustExec("ALTER USER 'testhistory'@'localhost' IDENTIFIED BY 'pwd2'")
+	tk.MustQuery("SELECT COUNT(*) FROM mysql.password_history WHERE User = 'testhistory'").Check(testkit.Rows("1"))
+
+	tk.MustExec("DROP USER 'testhistory'@'localhost'")
+	tk.MustQuery("SELECT COUNT(*) FROM mysql.password_history WHERE User = 'testhistory'").Check(testkit.Rows("0"))
+}
+
+func (s *testSuite3) TestValidatePassword(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("SET GLOBAL validate_password_enable = ON")
+	defer tk.MustExec("SET GLOBAL validate_password_enable = DEFAULT")
+	defer tk.MustExec("SET GLOBAL validate_password_policy = DEFAULT")
+	defer tk.MustExec("SET GLOBAL validate_password_check_user_name = DEFAULT")
+
+	_, err := tk.Exec("CREATE USER 'testvalidate'@'localhost' IDENTIFIED BY 'abcdefgh'")
+	c.Assert(terror.ErrorEqual(err, executor.ErrNotValidPassword), IsTrue, Commentf("err %v", err))
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 1105 Require Password Uppercase Count: 1", "Error 1819 Your password does not satisfy the current policy requirements"))
+	_, err = tk.Exec("CREATE USER 'testvalidate'@'localhost'")
+	c.Assert(terror.ErrorEqual(err, executor.ErrNotValidPassword), IsTrue, Commentf("err %v", err))
+	// The hashed passwords and roles are not validated.
+	tk.MustExec("CREATE USER 'testvalidate'@'localhost' IDENTIFIED BY PASSWORD '*0D3CED9BEC10A777AEC23CCC353A8C08A633045E'")
+	tk.MustExec("CREATE ROLE 'testvalidaterole'")
+
+	_, err = tk.Exec("ALTER USER 'testvalidate'@'localhost' IDENTIFIED BY 'Abc#1'")
+	c.Assert(terror.ErrorEqual(err, executor.ErrNotValidPassword), IsTrue, Commentf("err %v", err))
+	tk.MustExec("ALTER USER 'testvalidate'@'localhost' IDENTIFIED BY 'Abcdef#1'")
+	_, err = tk.Exec("SET PASSWORD FOR 'testvalidate'@'localhost' = 'abc'")
+	c.Assert(terror.ErrorEqual(err, executor.ErrNotValidPassword), IsTrue, Commentf("err %v", err))
+	tk.MustExec("SET GLOBAL validate_password_policy = LOW")
+	tk.MustExec("SET PASSWORD FOR 'testvalidate'@'localhost' = 'abcdefgh'")
+
+	tk.MustExec("SET GLOBAL validate_password_check_user_name = ON")
+	_, err = tk.Exec("SET PASSWORD FOR 'testvalidate'@'localhost' = 'etadilavtset'")
+	c.Assert(terror.ErrorEqual(err, executor.ErrNotValidPassword), IsTrue, Commentf("err %v", err))
+	tk.MustQuery("SELECT VALIDATE_PASSWORD_STRENGTH('abc'), VALIDATE_PASSWORD_STRENGTH('abcdefgh'), VALIDATE_PASSWORD_STRENGTH('Abcdef#1')").Check(testkit.Rows("0 50 100"))
+
+	tk.MustExec("SET GLOBAL validate_password_enable = OFF")
+	tk.MustExec("SET PASSWORD FOR 'testvalidate'@'localhost' = 'abc'")
+	tk.MustExec("DROP USER 'testvalidate'@'localhost', 'testvalidaterole'")
+}
+
 func (s *testSuite3) TestKillStmt(c *C) {
 	tk := testkit.NewTestKit(c, s.store)
 	tk.MustExec("use test")
@@ -49,7 +49,7 @@ var (
 //     1. changed (bool) : does the update really change the row values. e.g. update set i = 1 where i = 1;
 //     2. err (error) : error in the update.
 func updateRecord(ctx context.Context, sctx sessionctx.Context, h kv.Handle, oldData, newData []types.Datum, modified []bool, t table.Table,
-	onDup bool, memTracker *memory.Tracker) (bool, error) {
+	onDup bool, memTracker *memory.Tracker, fkChecker *foreignKeyChecker, ckChecker *checkConstraintChecker) (bool, error) {
 	if span := opentracing.SpanFromContext(ctx); span != nil && span.Tracer() != nil {
 		span1 := span.Tracer().StartSpan("executor.updateRecord", opentracing.ChildOf(span.Context()))
 		defer span1.Finish()
@@ -170,7 +170,12 @@ func updateRecord(ctx context.Context, sctx sessionctx.Context, h kv.Handle, old
 		}
 	}
 
-	// 5. If handle changed, remove the old then add the new record, otherwise update the record.
+	// 5. Check the CHECK constraints of the new row.
+	if err = ckChecker.checkRow(t, newData); err != nil {
+		return false, err
+	}
+
+	// 6. If handle changed, remove the old then add the new record, otherwise update the record.
 	if handleChanged {
 		// For `UPDATE IGNORE`/`INSERT IGNORE ON DUPLICATE KEY UPDATE`
 		// we use the staging buffer so that we don't need to precheck the existence of handle or unique keys by sending
@@ -210,6 +215,15 @@ func updateRecord(ctx context.Context, sctx sessionctx.Context, h kv.Handle, old
 		}
 
 	}
+
+	// 7. Check the foreign key constraints of the new row, and apply the referential actions on the child rows.
+	if err = fkChecker.checkParentRows(ctx, t, newData, modified); err != nil {
+		return false, err
+	}
+	if err = fkChecker.onParentRowUpdated(ctx, t, oldData, newData, modified); err != nil {
+		return false, err
+	}
+
 	if onDup {
 		sc.AddAffectedRows(2)
 	} else {
@@ -411,6 +411,10 @@ func (b *baseBuiltinFunc) getCtx() sessionctx.Context {
 	return b.ctx
 }
 
+func (b *baseBuiltinFunc) setCtx(ctx sessionctx.Context) {
+	b.ctx = ctx
+}
+
 func (b *baseBuiltinFunc) cloneFrom(from *baseBuiltinFunc) {
 	b.args = make([]Expression, 0, len(b.args))
 	for _, arg := range from.args {
@@ -524,6 +528,8 @@ type builtinFunc interface {
 	equal(builtinFunc) bool
 	// getCtx returns this function's context.
 	getCtx() sessionctx.Context
+	// setCtx sets this function's context.
+	setCtx(ctx sessionctx.Context)
 	// getRetTp returns the return type of the built-in function.
 	getRetTp() *types.FieldType
 	// setPbCode sets pbCode for signature.
@@ -806,8 +812,8 @@ var funcs = map[string]functionClass{
 	ast.UUIDToBin:       &uuidToBinFunctionClass{baseFunctionClass{ast.UUIDToBin, 1, 2}},
 	ast.BinToUUID:       &binToUUIDFunctionClass{baseFunctionClass{ast.BinToUUID, 1, 2}},
 
-	// get_lock() and release_lock() are parsed but do nothing.
-	// It is used for preventing error in Ruby's activerecord migrations.
+	// get_lock() and release_lock() acquire and release the user-level locks.
+	// They are also used by Ruby's activerecord migrations.
 	ast.GetLock:     &lockFunctionClass{baseFunctionClass{ast.GetLock, 2, 2}},
 	ast.ReleaseLock: &releaseLockFunctionClass{baseFunctionClass{ast.ReleaseLock, 1, 1}},
 
@@ -841,6 +847,11 @@ var funcs = map[string]functionClass{
 	ast.IsFalsity:          &isTrueOrFalseFunctionClass{baseFunctionClass{ast.IsFalsity, 1, 1}, opcode.IsFalsity, false},
 	ast.Like:               &likeFunctionClass{baseFunctionClass{ast.Like, 3, 3}},
 	ast.Regexp:             &regexpFunctionClass{baseFunctionClass{ast.Regexp, 2, 2}},
+	RegexpLike:             &regexpLikeFunctionClass{baseFunctionClass{RegexpLike, 2, 3}},
+	RegexpInStr:            &regexpInStrFunctionClass{baseFunctionClass{RegexpInStr, 2, 6}},
+	RegexpSubstr:           &regexpSubstrFunctionClass{baseFunctionClass{RegexpSubstr, 2, 5}},
+	RegexpReplace:          &regexpReplaceFunctionClass{baseFunctionClass{RegexpReplace, 3, 6}},
+	Grouping:               &groupingFunctionClass{baseFunctionClass{Grouping, 2, -1}},
 	ast.Case:               &caseWhenFunctionClass{baseFunctionClass{ast.Case, 1, -1}},
 	ast.RowFunc:            &rowFunctionClass{baseFunctionClass{ast.RowFunc, 2, -1}},
 	ast.SetVar:             &setVarFunctionClass{baseFunctionClass{ast.SetVar, 2, 2}},
@@ -457,6 +457,31 @@ func (s *testEvaluatorSuite) TestUncompressLength(c *C) {
 	}
 }
 
+func (s *testEvaluatorSuite) TestValidatePasswordStrength(c *C) {
+	tests := []struct {
+		in     interface{}
+		expect interface{}
+	}{
+		{nil, nil},
+		{"", int64(0)},
+		{"abc", int64(0)},
+		{"abcd", int64(25)},
+		{"abcdefgh", int64(50)},
+		{"Abcdefg1", int64(50)},
+		{"Abcdef#1", int64(100)},
+	}
+
+	fc := funcs[ast.ValidatePasswordStrength]
+	for _, test := range tests {
+		arg := types.NewDatum(test.in)
+		f, err := fc.getFunction(s.ctx, s.datumsToConstants([]types.Datum{arg}))
+		c.Assert(err, IsNil, Commentf("%v", test))
+		out, err := evalBuiltinFunc(f, chunk.Row{})
+		c.Assert(err, IsNil, Commentf("%v", test))
+		c.Assert(out, DeepEquals, types.NewDatum(test.expect), Commentf("%v", test))
+	}
+}
+
 func (s *testEvaluatorSuite) TestPassword(c *C) {
 	cases := []struct {
 		args     interface{}
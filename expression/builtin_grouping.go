@@ -0,0 +1,90 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"github.com/pingcap/parser/mysql"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+)
+
+// Grouping is the name of the GROUPING function used with GROUP BY ... WITH ROLLUP,
+// which is not defined in the parser yet.
+const Grouping = "grouping"
+
+var (
+	_ functionClass = &groupingFunctionClass{}
+)
+
+var (
+	_ builtinFunc = &builtinGroupingSig{}
+)
+
+// groupingFunctionClass is the class of the GROUPING function. The planner rewrites
+// GROUPING(col1, col2, ...) to grouping(gid, level1, level2, ...), where gid is the
+// grouping id generated by the Expand operator, and level_i is the smallest grouping
+// id of the grouping sets in which col_i is aggregated to the super-aggregate NULL.
+type groupingFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *groupingFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	argTps := make([]types.EvalType, len(args))
+	for i := range args {
+		argTps[i] = types.ETInt
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETInt, argTps...)
+	if err != nil {
+		return nil, err
+	}
+	bf.tp.Flen = mysql.MaxIntWidth
+	bf.tp.Flag |= mysql.NotNullFlag
+	sig := &builtinGroupingSig{bf}
+	return sig, nil
+}
+
+type builtinGroupingSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinGroupingSig) Clone() builtinFunc {
+	newSig := &builtinGroupingSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalInt evals a builtinGroupingSig.
+// See https://dev.mysql.com/doc/refman/8.0/en/miscellaneous-functions.html#function_grouping
+func (b *builtinGroupingSig) evalInt(row chunk.Row) (int64, bool, error) {
+	gid, isNull, err := b.args[0].EvalInt(b.ctx, row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	var res int64
+	for _, arg := range b.args[1:] {
+		level, isNull, err := arg.EvalInt(b.ctx, row)
+		if isNull || err != nil {
+			return 0, isNull, err
+		}
+		res <<= 1
+		if gid >= level {
+			res |= 1
+		}
+	}
+	return res, false, nil
+}
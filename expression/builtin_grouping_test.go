@@ -0,0 +1,51 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/testutil"
+)
+
+func (s *testEvaluatorSuite) TestGrouping(c *C) {
+	// GROUP BY a, b WITH ROLLUP has 3 grouping sets: (a, b), (a) and (), so b is
+	// aggregated since the grouping id 1, and a is aggregated since 2.
+	tests := []struct {
+		args   []interface{}
+		expect interface{}
+	}{
+		{[]interface{}{0, 2}, int64(0)},
+		{[]interface{}{1, 2}, int64(0)},
+		{[]interface{}{2, 2}, int64(1)},
+		{[]interface{}{0, 1}, int64(0)},
+		{[]interface{}{1, 1}, int64(1)},
+		{[]interface{}{0, 2, 1}, int64(0)},
+		{[]interface{}{1, 2, 1}, int64(1)},
+		{[]interface{}{2, 2, 1}, int64(3)},
+		{[]interface{}{2, 1, 2}, int64(3)},
+		{[]interface{}{1, 1, 2}, int64(2)},
+	}
+	fc := funcs[Grouping]
+	for _, tt := range tests {
+		f, err := fc.getFunction(s.ctx, s.datumsToConstants(types.MakeDatums(tt.args...)))
+		c.Assert(err, IsNil)
+		res, err := evalBuiltinFunc(f, chunk.Row{})
+		c.Assert(err, IsNil)
+		c.Assert(res, testutil.DatumEquals, types.NewDatum(tt.expect), Commentf("%v", tt.args))
+	}
+	_, err := fc.getFunction(s.ctx, s.datumsToConstants(types.MakeDatums(1)))
+	c.Assert(err, NotNil)
+}
@@ -20,6 +20,7 @@ import (
 	"net"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/pingcap/parser/mysql"
@@ -61,6 +62,9 @@ var (
 	_ builtinFunc = &builtinSleepSig{}
 	_ builtinFunc = &builtinLockSig{}
 	_ builtinFunc = &builtinReleaseLockSig{}
+	_ builtinFunc = &builtinIsFreeLockSig{}
+	_ builtinFunc = &builtinIsUsedLockSig{}
+	_ builtinFunc = &builtinReleaseAllLocksSig{}
 	_ builtinFunc = &builtinDecimalAnyValueSig{}
 	_ builtinFunc = &builtinDurationAnyValueSig{}
 	_ builtinFunc = &builtinIntAnyValueSig{}
@@ -173,11 +177,46 @@ func (b *builtinLockSig) Clone() builtinFunc {
 	return newSig
 }
 
+// maxUserLockNameLength is the max length of the name of a user-level lock.
+const maxUserLockNameLength = 64
+
+// evalUserLockName evaluates the name of a user-level lock. The lock names are
+// case-insensitive, so the name is returned in lower case.
+func evalUserLockName(ctx sessionctx.Context, arg Expression, row chunk.Row) (string, error) {
+	lockName, isNull, err := arg.EvalString(ctx, row)
+	if err != nil {
+		return "", err
+	}
+	if isNull || len(lockName) == 0 || utf8.RuneCountInString(lockName) > maxUserLockNameLength {
+		if isNull {
+			lockName = "NULL"
+		}
+		return "", errUserLockWrongName.GenWithStackByArgs(lockName)
+	}
+	return strings.ToLower(lockName), nil
+}
+
 // evalInt evals a builtinLockSig.
-// See https://dev.mysql.com/doc/refman/5.7/en/miscellaneous-functions.html#function_get-lock
-// The lock function will do nothing.
-// Warning: get_lock() function is parsed but ignored.
-func (b *builtinLockSig) evalInt(_ chunk.Row) (int64, bool, error) {
+// See https://dev.mysql.com/doc/refman/8.0/en/locking-functions.html#function_get-lock
+func (b *builtinLockSig) evalInt(row chunk.Row) (int64, bool, error) {
+	lockName, err := evalUserLockName(b.ctx, b.args[0], row)
+	if err != nil {
+		return 0, false, err
+	}
+	timeout, isNull, err := b.args[1].EvalInt(b.ctx, row)
+	if err != nil {
+		return 0, false, err
+	}
+	if isNull {
+		timeout = 0
+	}
+	acquired, err := b.ctx.GetAdvisoryLock(lockName, timeout)
+	if err != nil {
+		return 0, false, err
+	}
+	if !acquired {
+		return 0, false, nil
+	}
 	return 1, false, nil
 }
 
@@ -209,11 +248,25 @@ func (b *builtinReleaseLockSig) Clone() builtinFunc {
 }
 
 // evalInt evals a builtinReleaseLockSig.
-// See https://dev.mysql.com/doc/refman/5.7/en/miscellaneous-functions.html#function_release-lock
-// The release lock function will do nothing.
-// Warning: release_lock() function is parsed but ignored.
-func (b *builtinReleaseLockSig) evalInt(_ chunk.Row) (int64, bool, error) {
-	return 1, false, nil
+// See https://dev.mysql.com/doc/refman/8.0/en/locking-functions.html#function_release-lock
+func (b *builtinReleaseLockSig) evalInt(row chunk.Row) (int64, bool, error) {
+	lockName, err := evalUserLockName(b.ctx, b.args[0], row)
+	if err != nil {
+		return 0, false, err
+	}
+	if b.ctx.ReleaseAdvisoryLock(lockName) {
+		return 1, false, nil
+	}
+	// The lock is not held by this session, returns 0 if it is held by
+	// another session, or NULL if it does not exist.
+	connID, err := b.ctx.IsUsedAdvisoryLock(lockName)
+	if err != nil {
+		return 0, false, err
+	}
+	if connID == 0 {
+		return 0, true, nil
+	}
+	return 0, false, nil
 }
 
 type anyValueFunctionClass struct {
@@ -641,7 +694,43 @@ type isFreeLockFunctionClass struct {
 }
 
 func (c *isFreeLockFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
-	return nil, errFunctionNotExists.GenWithStackByArgs("FUNCTION", "IS_FREE_LOCK")
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETInt, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinIsFreeLockSig{bf}
+	bf.tp.Flen = 1
+	return sig, nil
+}
+
+type builtinIsFreeLockSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinIsFreeLockSig) Clone() builtinFunc {
+	newSig := &builtinIsFreeLockSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalInt evals a builtinIsFreeLockSig.
+// See https://dev.mysql.com/doc/refman/8.0/en/locking-functions.html#function_is-free-lock
+func (b *builtinIsFreeLockSig) evalInt(row chunk.Row) (int64, bool, error) {
+	lockName, err := evalUserLockName(b.ctx, b.args[0], row)
+	if err != nil {
+		return 0, false, err
+	}
+	connID, err := b.ctx.IsUsedAdvisoryLock(lockName)
+	if err != nil {
+		return 0, false, err
+	}
+	if connID == 0 {
+		return 1, false, nil
+	}
+	return 0, false, nil
 }
 
 type isIPv4FunctionClass struct {
@@ -857,7 +946,43 @@ type isUsedLockFunctionClass struct {
 }
 
 func (c *isUsedLockFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
-	return nil, errFunctionNotExists.GenWithStackByArgs("FUNCTION", "IS_USED_LOCK")
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETInt, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinIsUsedLockSig{bf}
+	bf.tp.Flag |= mysql.UnsignedFlag
+	return sig, nil
+}
+
+type builtinIsUsedLockSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinIsUsedLockSig) Clone() builtinFunc {
+	newSig := &builtinIsUsedLockSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalInt evals a builtinIsUsedLockSig.
+// See https://dev.mysql.com/doc/refman/8.0/en/locking-functions.html#function_is-used-lock
+func (b *builtinIsUsedLockSig) evalInt(row chunk.Row) (int64, bool, error) {
+	lockName, err := evalUserLockName(b.ctx, b.args[0], row)
+	if err != nil {
+		return 0, false, err
+	}
+	connID, err := b.ctx.IsUsedAdvisoryLock(lockName)
+	if err != nil {
+		return 0, false, err
+	}
+	if connID == 0 {
+		return 0, true, nil
+	}
+	return int64(connID), false, nil
 }
 
 type masterPosWaitFunctionClass struct {
@@ -1010,7 +1135,31 @@ type releaseAllLocksFunctionClass struct {
 }
 
 func (c *releaseAllLocksFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
-	return nil, errFunctionNotExists.GenWithStackByArgs("FUNCTION", "RELEASE_ALL_LOCKS")
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETInt)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinReleaseAllLocksSig{bf}
+	return sig, nil
+}
+
+type builtinReleaseAllLocksSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinReleaseAllLocksSig) Clone() builtinFunc {
+	newSig := &builtinReleaseAllLocksSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalInt evals a builtinReleaseAllLocksSig.
+// See https://dev.mysql.com/doc/refman/8.0/en/locking-functions.html#function_release-all-locks
+func (b *builtinReleaseAllLocksSig) evalInt(_ chunk.Row) (int64, bool, error) {
+	return int64(b.ctx.ReleaseAllAdvisoryLocks()), false, nil
 }
 
 type uuidFunctionClass struct {
@@ -0,0 +1,556 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/pingcap/parser/charset"
+	"github.com/pingcap/parser/mysql"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/collate"
+	"github.com/pingcap/tipb/go-tipb"
+)
+
+// The names of the regular expression functions introduced in MySQL 8.0,
+// which are not defined in the parser yet.
+const (
+	RegexpLike    = "regexp_like"
+	RegexpInStr   = "regexp_instr"
+	RegexpSubstr  = "regexp_substr"
+	RegexpReplace = "regexp_replace"
+)
+
+var (
+	_ functionClass = &regexpLikeFunctionClass{}
+	_ functionClass = &regexpInStrFunctionClass{}
+	_ functionClass = &regexpSubstrFunctionClass{}
+	_ functionClass = &regexpReplaceFunctionClass{}
+)
+
+var (
+	_ builtinFunc = &builtinRegexpLikeFuncSig{}
+	_ builtinFunc = &builtinRegexpInStrFuncSig{}
+	_ builtinFunc = &builtinRegexpSubstrFuncSig{}
+	_ builtinFunc = &builtinRegexpReplaceFuncSig{}
+)
+
+// regexpArgKind is the kind of an argument of the REGEXP_* functions.
+type regexpArgKind int
+
+const (
+	regexpArgExpr regexpArgKind = iota
+	regexpArgPattern
+	regexpArgReplacement
+	regexpArgPosition
+	regexpArgOccurrence
+	regexpArgReturnOption
+	regexpArgMatchType
+)
+
+func (k regexpArgKind) evalType() types.EvalType {
+	switch k {
+	case regexpArgPosition, regexpArgOccurrence, regexpArgReturnOption:
+		return types.ETInt
+	default:
+		return types.ETString
+	}
+}
+
+// The kinds of the arguments of each function in order, the trailing ones are optional.
+var (
+	regexpLikeArgKinds    = []regexpArgKind{regexpArgExpr, regexpArgPattern, regexpArgMatchType}
+	regexpInStrArgKinds   = []regexpArgKind{regexpArgExpr, regexpArgPattern, regexpArgPosition, regexpArgOccurrence, regexpArgReturnOption, regexpArgMatchType}
+	regexpSubstrArgKinds  = []regexpArgKind{regexpArgExpr, regexpArgPattern, regexpArgPosition, regexpArgOccurrence, regexpArgMatchType}
+	regexpReplaceArgKinds = []regexpArgKind{regexpArgExpr, regexpArgPattern, regexpArgReplacement, regexpArgPosition, regexpArgOccurrence, regexpArgMatchType}
+)
+
+func regexpArgTypes(kinds []regexpArgKind, argCount int) []types.EvalType {
+	argTps := make([]types.EvalType, argCount)
+	for i := range argTps {
+		argTps[i] = kinds[i].evalType()
+	}
+	return argTps
+}
+
+// regexpParams is the arguments of a REGEXP_* function for a row. The absent
+// optional arguments take the default values.
+type regexpParams struct {
+	expr         string
+	pattern      string
+	replacement  string
+	matchType    string
+	position     int64
+	occurrence   int64
+	returnOption int64
+}
+
+func (p *regexpParams) setString(kind regexpArgKind, val string) {
+	switch kind {
+	case regexpArgExpr:
+		p.expr = val
+	case regexpArgPattern:
+		p.pattern = val
+	case regexpArgReplacement:
+		p.replacement = val
+	case regexpArgMatchType:
+		p.matchType = val
+	}
+}
+
+func (p *regexpParams) setInt(kind regexpArgKind, val int64) {
+	switch kind {
+	case regexpArgPosition:
+		p.position = val
+	case regexpArgOccurrence:
+		p.occurrence = val
+	case regexpArgReturnOption:
+		p.returnOption = val
+	}
+}
+
+// regexpBaseFuncSig is the common part of the REGEXP_* function signatures.
+type regexpBaseFuncSig struct {
+	baseBuiltinFunc
+	funcName string
+	argKinds []regexpArgKind
+	defaults regexpParams
+	// isBinary indicates the arguments are matched as binary strings, so the
+	// matching is always case-sensitive and the positions are counted in bytes.
+	isBinary bool
+
+	memorizedRegexp *regexp.Regexp
+	memorizedErr    error
+}
+
+func newRegexpBaseFuncSig(bf baseBuiltinFunc, funcName string, argKinds []regexpArgKind, isBinary bool) regexpBaseFuncSig {
+	return regexpBaseFuncSig{
+		baseBuiltinFunc: bf,
+		funcName:        funcName,
+		argKinds:        argKinds,
+		defaults:        regexpParams{position: 1, occurrence: 1},
+		isBinary:        isBinary,
+	}
+}
+
+func (b *regexpBaseFuncSig) clone(from *regexpBaseFuncSig) {
+	b.cloneFrom(&from.baseBuiltinFunc)
+	b.funcName = from.funcName
+	b.argKinds = from.argKinds
+	b.defaults = from.defaults
+	b.isBinary = from.isBinary
+	if from.memorizedRegexp != nil {
+		b.memorizedRegexp = from.memorizedRegexp.Copy()
+	}
+	b.memorizedErr = from.memorizedErr
+}
+
+// evalParams evaluates the arguments of the row, isNull is true if any of them is NULL.
+func (b *regexpBaseFuncSig) evalParams(row chunk.Row) (params regexpParams, isNull bool, err error) {
+	params = b.defaults
+	for i, arg := range b.args {
+		kind := b.argKinds[i]
+		if kind.evalType() == types.ETInt {
+			val, isNull, err := arg.EvalInt(b.ctx, row)
+			if isNull || err != nil {
+				return params, isNull, err
+			}
+			params.setInt(kind, val)
+		} else {
+			val, isNull, err := arg.EvalString(b.ctx, row)
+			if isNull || err != nil {
+				return params, isNull, err
+			}
+			params.setString(kind, val)
+		}
+	}
+	return params, false, nil
+}
+
+// isConstRegexp checks whether the pattern and the match type are both constant,
+// in which case the compiled regexp can be memorized.
+func (b *regexpBaseFuncSig) isConstRegexp() bool {
+	sc := b.ctx.GetSessionVars().StmtCtx
+	for i, arg := range b.args {
+		kind := b.argKinds[i]
+		if (kind == regexpArgPattern || kind == regexpArgMatchType) && !arg.ConstItem(sc) {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *regexpBaseFuncSig) getRegexp(params *regexpParams) (*regexp.Regexp, error) {
+	if !b.isConstRegexp() {
+		return b.compile(params.pattern, params.matchType)
+	}
+	if b.memorizedRegexp == nil && b.memorizedErr == nil {
+		b.memorizedRegexp, b.memorizedErr = b.compile(params.pattern, params.matchType)
+	}
+	return b.memorizedRegexp, b.memorizedErr
+}
+
+// compile compiles the pattern with the flags specified by the match type.
+// See https://dev.mysql.com/doc/refman/8.0/en/regexp.html#function_regexp-like for the match types.
+func (b *regexpBaseFuncSig) compile(pattern, matchType string) (*regexp.Regexp, error) {
+	// The case sensitivity is decided by the collation by default, and it
+	// can be overridden by 'c' and 'i', of which the last one wins.
+	caseInsensitive := collate.IsCICollation(b.collation)
+	multiLine, dotAll := false, false
+	for _, c := range matchType {
+		switch c {
+		case 'c':
+			caseInsensitive = false
+		case 'i':
+			caseInsensitive = true
+		case 'm':
+			multiLine = true
+		case 'n':
+			dotAll = true
+		case 'u':
+			// Unix-only line endings, '\n' is the only line terminator recognized by Go anyway.
+		default:
+			return nil, errIncorrectArgs.GenWithStackByArgs(b.funcName)
+		}
+	}
+	flags := ""
+	if caseInsensitive && !b.isBinary {
+		flags += "i"
+	}
+	if multiLine {
+		flags += "m"
+	}
+	if dotAll {
+		flags += "s"
+	}
+	if len(flags) > 0 {
+		pattern = "(?" + flags + ")" + pattern
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, ErrRegexp.GenWithStackByArgs(err.Error())
+	}
+	return re, nil
+}
+
+// byteOffset returns the byte offset of the 1-based position in the string.
+// The position right after the end of the string is also valid.
+func (b *regexpBaseFuncSig) byteOffset(s string, pos int64) (int, error) {
+	if pos < 1 {
+		return 0, errRegexpIndexOutOfBounds
+	}
+	if b.isBinary {
+		if pos > int64(len(s))+1 {
+			return 0, errRegexpIndexOutOfBounds
+		}
+		return int(pos - 1), nil
+	}
+	offset := 0
+	for i := int64(1); i < pos; i++ {
+		if offset >= len(s) {
+			return 0, errRegexpIndexOutOfBounds
+		}
+		_, size := utf8.DecodeRuneInString(s[offset:])
+		offset += size
+	}
+	return offset, nil
+}
+
+// position returns the 1-based position of the byte offset in the string.
+func (b *regexpBaseFuncSig) position(s string, offset int) int64 {
+	if b.isBinary {
+		return int64(offset) + 1
+	}
+	return int64(utf8.RuneCountInString(s[:offset])) + 1
+}
+
+// findOccurrence returns the submatch indexes of the n-th match in s, or nil if
+// there are less than n matches.
+func findOccurrence(re *regexp.Regexp, s string, n int64) []int {
+	if n < 1 {
+		n = 1
+	}
+	if n > int64(len(s))+1 {
+		// There are at most len(s)+1 non-overlapping matches.
+		return nil
+	}
+	matches := re.FindAllStringSubmatchIndex(s, int(n))
+	if int64(len(matches)) < n {
+		return nil
+	}
+	return matches[n-1]
+}
+
+type regexpLikeFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *regexpLikeFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETInt, regexpArgTypes(regexpLikeArgKinds, len(args))...)
+	if err != nil {
+		return nil, err
+	}
+	bf.tp.Flen = 1
+	isBinary := bf.collation == charset.CollationBin
+	sig := newBuiltinRegexpLikeFuncSig(bf, isBinary)
+	if isBinary {
+		sig.setPbCode(tipb.ScalarFuncSig_RegexpLikeSig)
+	} else {
+		sig.setPbCode(tipb.ScalarFuncSig_RegexpLikeUTF8Sig)
+	}
+	return sig, nil
+}
+
+type builtinRegexpLikeFuncSig struct {
+	regexpBaseFuncSig
+}
+
+func newBuiltinRegexpLikeFuncSig(bf baseBuiltinFunc, isBinary bool) *builtinRegexpLikeFuncSig {
+	return &builtinRegexpLikeFuncSig{newRegexpBaseFuncSig(bf, RegexpLike, regexpLikeArgKinds, isBinary)}
+}
+
+func (b *builtinRegexpLikeFuncSig) Clone() builtinFunc {
+	newSig := &builtinRegexpLikeFuncSig{}
+	newSig.clone(&b.regexpBaseFuncSig)
+	return newSig
+}
+
+// evalInt evals a builtinRegexpLikeFuncSig.
+// See https://dev.mysql.com/doc/refman/8.0/en/regexp.html#function_regexp-like
+func (b *builtinRegexpLikeFuncSig) evalInt(row chunk.Row) (int64, bool, error) {
+	params, isNull, err := b.evalParams(row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	res, err := b.like(&params)
+	return res, false, err
+}
+
+func (b *builtinRegexpLikeFuncSig) like(params *regexpParams) (int64, error) {
+	re, err := b.getRegexp(params)
+	if err != nil {
+		return 0, err
+	}
+	return boolToInt64(re.MatchString(params.expr)), nil
+}
+
+type regexpInStrFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *regexpInStrFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETInt, regexpArgTypes(regexpInStrArgKinds, len(args))...)
+	if err != nil {
+		return nil, err
+	}
+	isBinary := bf.collation == charset.CollationBin
+	sig := newBuiltinRegexpInStrFuncSig(bf, isBinary)
+	if isBinary {
+		sig.setPbCode(tipb.ScalarFuncSig_RegexpInStrSig)
+	} else {
+		sig.setPbCode(tipb.ScalarFuncSig_RegexpInStrUTF8Sig)
+	}
+	return sig, nil
+}
+
+type builtinRegexpInStrFuncSig struct {
+	regexpBaseFuncSig
+}
+
+func newBuiltinRegexpInStrFuncSig(bf baseBuiltinFunc, isBinary bool) *builtinRegexpInStrFuncSig {
+	return &builtinRegexpInStrFuncSig{newRegexpBaseFuncSig(bf, RegexpInStr, regexpInStrArgKinds, isBinary)}
+}
+
+func (b *builtinRegexpInStrFuncSig) Clone() builtinFunc {
+	newSig := &builtinRegexpInStrFuncSig{}
+	newSig.clone(&b.regexpBaseFuncSig)
+	return newSig
+}
+
+// evalInt evals a builtinRegexpInStrFuncSig.
+// See https://dev.mysql.com/doc/refman/8.0/en/regexp.html#function_regexp-instr
+func (b *builtinRegexpInStrFuncSig) evalInt(row chunk.Row) (int64, bool, error) {
+	params, isNull, err := b.evalParams(row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	res, err := b.instr(&params)
+	return res, false, err
+}
+
+func (b *builtinRegexpInStrFuncSig) instr(params *regexpParams) (int64, error) {
+	if params.returnOption != 0 && params.returnOption != 1 {
+		return 0, errIncorrectArgs.GenWithStackByArgs(b.funcName)
+	}
+	re, err := b.getRegexp(params)
+	if err != nil {
+		return 0, err
+	}
+	offset, err := b.byteOffset(params.expr, params.position)
+	if err != nil {
+		return 0, err
+	}
+	loc := findOccurrence(re, params.expr[offset:], params.occurrence)
+	if loc == nil {
+		return 0, nil
+	}
+	// Returns the position of the first character of the match, or the
+	// position following the match if return_option is 1.
+	return b.position(params.expr, offset+loc[params.returnOption]), nil
+}
+
+type regexpSubstrFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *regexpSubstrFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, regexpArgTypes(regexpSubstrArgKinds, len(args))...)
+	if err != nil {
+		return nil, err
+	}
+	bf.tp.Flen = args[0].GetType().Flen
+	isBinary := bf.collation == charset.CollationBin
+	sig := newBuiltinRegexpSubstrFuncSig(bf, isBinary)
+	if isBinary {
+		sig.setPbCode(tipb.ScalarFuncSig_RegexpSubstrSig)
+	} else {
+		sig.setPbCode(tipb.ScalarFuncSig_RegexpSubstrUTF8Sig)
+	}
+	return sig, nil
+}
+
+type builtinRegexpSubstrFuncSig struct {
+	regexpBaseFuncSig
+}
+
+func newBuiltinRegexpSubstrFuncSig(bf baseBuiltinFunc, isBinary bool) *builtinRegexpSubstrFuncSig {
+	return &builtinRegexpSubstrFuncSig{newRegexpBaseFuncSig(bf, RegexpSubstr, regexpSubstrArgKinds, isBinary)}
+}
+
+func (b *builtinRegexpSubstrFuncSig) Clone() builtinFunc {
+	newSig := &builtinRegexpSubstrFuncSig{}
+	newSig.clone(&b.regexpBaseFuncSig)
+	return newSig
+}
+
+// evalString evals a builtinRegexpSubstrFuncSig.
+// See https://dev.mysql.com/doc/refman/8.0/en/regexp.html#function_regexp-substr
+func (b *builtinRegexpSubstrFuncSig) evalString(row chunk.Row) (string, bool, error) {
+	params, isNull, err := b.evalParams(row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	return b.substr(&params)
+}
+
+func (b *builtinRegexpSubstrFuncSig) substr(params *regexpParams) (string, bool, error) {
+	re, err := b.getRegexp(params)
+	if err != nil {
+		return "", false, err
+	}
+	offset, err := b.byteOffset(params.expr, params.position)
+	if err != nil {
+		return "", false, err
+	}
+	expr := params.expr[offset:]
+	loc := findOccurrence(re, expr, params.occurrence)
+	if loc == nil {
+		return "", true, nil
+	}
+	return expr[loc[0]:loc[1]], false, nil
+}
+
+type regexpReplaceFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *regexpReplaceFunctionClass) getFunction(ctx sessionctx.Context, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, regexpArgTypes(regexpReplaceArgKinds, len(args))...)
+	if err != nil {
+		return nil, err
+	}
+	bf.tp.Flen = mysql.MaxBlobWidth
+	isBinary := bf.collation == charset.CollationBin
+	sig := newBuiltinRegexpReplaceFuncSig(bf, isBinary)
+	if isBinary {
+		sig.setPbCode(tipb.ScalarFuncSig_RegexpReplaceSig)
+	} else {
+		sig.setPbCode(tipb.ScalarFuncSig_RegexpReplaceUTF8Sig)
+	}
+	return sig, nil
+}
+
+type builtinRegexpReplaceFuncSig struct {
+	regexpBaseFuncSig
+}
+
+func newBuiltinRegexpReplaceFuncSig(bf baseBuiltinFunc, isBinary bool) *builtinRegexpReplaceFuncSig {
+	sig := &builtinRegexpReplaceFuncSig{newRegexpBaseFuncSig(bf, RegexpReplace, regexpReplaceArgKinds, isBinary)}
+	// All the occurrences are replaced by default.
+	sig.defaults.occurrence = 0
+	return sig
+}
+
+func (b *builtinRegexpReplaceFuncSig) Clone() builtinFunc {
+	newSig := &builtinRegexpReplaceFuncSig{}
+	newSig.clone(&b.regexpBaseFuncSig)
+	return newSig
+}
+
+// evalString evals a builtinRegexpReplaceFuncSig.
+// See https://dev.mysql.com/doc/refman/8.0/en/regexp.html#function_regexp-replace
+func (b *builtinRegexpReplaceFuncSig) evalString(row chunk.Row) (string, bool, error) {
+	params, isNull, err := b.evalParams(row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	res, err := b.replace(&params)
+	return res, false, err
+}
+
+func (b *builtinRegexpReplaceFuncSig) replace(params *regexpParams) (string, error) {
+	re, err := b.getRegexp(params)
+	if err != nil {
+		return "", err
+	}
+	offset, err := b.byteOffset(params.expr, params.position)
+	if err != nil {
+		return "", err
+	}
+	prefix, expr := params.expr[:offset], params.expr[offset:]
+	if params.occurrence < 1 {
+		return prefix + re.ReplaceAllString(expr, params.replacement), nil
+	}
+	loc := findOccurrence(re, expr, params.occurrence)
+	if loc == nil {
+		return params.expr, nil
+	}
+	res := re.ExpandString([]byte(params.expr[:offset+loc[0]]), params.replacement, expr, loc)
+	return string(res) + expr[loc[1]:], nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	. "github.com/pingcap/check"
+	"github.com/pingcap/parser/terror"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/collate"
+	"github.com/pingcap/tidb/util/testutil"
+)
+
+type regexpTestCase struct {
+	args   []interface{}
+	expect interface{}
+	err    error
+}
+
+func (s *testEvaluatorSuite) checkRegexpFunc(c *C, funcName string, tests []regexpTestCase) {
+	fc := funcs[funcName]
+	for _, tt := range tests {
+		f, err := fc.getFunction(s.ctx, s.datumsToConstants(types.MakeDatums(tt.args...)))
+		c.Assert(err, IsNil)
+		res, err := evalBuiltinFunc(f, chunk.Row{})
+		if tt.err != nil {
+			c.Assert(terror.ErrorEqual(err, tt.err), IsTrue, Commentf("%s%v: %v", funcName, tt.args, err))
+			continue
+		}
+		c.Assert(err, IsNil, Commentf("%s%v", funcName, tt.args))
+		c.Assert(res, testutil.DatumEquals, types.NewDatum(tt.expect), Commentf("%s%v", funcName, tt.args))
+	}
+}
+
+func (s *testEvaluatorSuite) TestRegexpLike(c *C) {
+	s.checkRegexpFunc(c, RegexpLike, []regexpTestCase{
+		{[]interface{}{"abc", "b"}, int64(1), nil},
+		{[]interface{}{"abc", "^b"}, int64(0), nil},
+		{[]interface{}{"abc", "ABC"}, int64(0), nil},
+		{[]interface{}{"abc", "ABC", "i"}, int64(1), nil},
+		{[]interface{}{"abc", "ABC", "ic"}, int64(0), nil},
+		{[]interface{}{"abc", "ABC", "ci"}, int64(1), nil},
+		{[]interface{}{"a\nb", "a.b"}, int64(0), nil},
+		{[]interface{}{"a\nb", "a.b", "n"}, int64(1), nil},
+		{[]interface{}{"a\nb", "^b$"}, int64(0), nil},
+		{[]interface{}{"a\nb", "^b$", "m"}, int64(1), nil},
+		{[]interface{}{"你好", "^..$"}, int64(1), nil},
+		{[]interface{}{[]byte("abc"), []byte("ABC"), "i"}, int64(0), nil},
+		{[]interface{}{nil, "a"}, nil, nil},
+		{[]interface{}{"a", nil}, nil, nil},
+		{[]interface{}{"a", "a", nil}, nil, nil},
+		{[]interface{}{"a", "a", "x"}, nil, errIncorrectArgs},
+		{[]interface{}{"a", "("}, nil, ErrRegexp},
+	})
+}
+
+func (s *testEvaluatorSuite) TestRegexpInStr(c *C) {
+	s.checkRegexpFunc(c, RegexpInStr, []regexpTestCase{
+		{[]interface{}{"dog cat dog", "dog"}, int64(1), nil},
+		{[]interface{}{"dog cat dog", "dog", 2}, int64(9), nil},
+		{[]interface{}{"dog cat dog", "dog", 1, 2}, int64(9), nil},
+		{[]interface{}{"dog cat dog", "dog", 1, 3}, int64(0), nil},
+		{[]interface{}{"dog cat dog", "dog", 1, 1, 1}, int64(4), nil},
+		{[]interface{}{"dog cat dog", "DOG", 1, 1, 0, "i"}, int64(1), nil},
+		{[]interface{}{"aa aaa aaaa", "a{4}"}, int64(8), nil},
+		{[]interface{}{"你好世界", "世"}, int64(3), nil},
+		{[]interface{}{"你好世界", "世", 1, 1, 1}, int64(4), nil},
+		{[]interface{}{[]byte("你好世界"), []byte("世")}, int64(7), nil},
+		{[]interface{}{"abc", "c", 4}, int64(0), nil},
+		{[]interface{}{"abc", "c", 5}, nil, errRegexpIndexOutOfBounds},
+		{[]interface{}{"abc", "c", 0}, nil, errRegexpIndexOutOfBounds},
+		{[]interface{}{"abc", "c", 1, 1, 2}, nil, errIncorrectArgs},
+		{[]interface{}{"abc", "c", nil}, nil, nil},
+	})
+}
+
+func (s *testEvaluatorSuite) TestRegexpSubstr(c *C) {
+	s.checkRegexpFunc(c, RegexpSubstr, []regexpTestCase{
+		{[]interface{}{"abc def ghi", "[a-z]+"}, "abc", nil},
+		{[]interface{}{"abc def ghi", "[a-z]+", 1, 3}, "ghi", nil},
+		{[]interface{}{"abc def ghi", "[a-z]+", 2, 4}, nil, nil},
+		{[]interface{}{"abc def ghi", "[a-z]+", 6}, "ef", nil},
+		{[]interface{}{"abc def ghi", "[A-Z]+", 1, 2, "i"}, "def", nil},
+		{[]interface{}{"你好世界", "好.", 2}, "好世", nil},
+		{[]interface{}{"abc", "x"}, nil, nil},
+		{[]interface{}{"abc", "b", 5}, nil, errRegexpIndexOutOfBounds},
+		{[]interface{}{nil, "b"}, nil, nil},
+	})
+}
+
+func (s *testEvaluatorSuite) TestRegexpReplace(c *C) {
+	s.checkRegexpFunc(c, RegexpReplace, []regexpTestCase{
+		{[]interface{}{"a b c", "b", "X"}, "a X c", nil},
+		{[]interface{}{"abc def ghi", "[a-z]+", "X"}, "X X X", nil},
+		{[]interface{}{"abc def ghi", "[a-z]+", "X", 1, 3}, "abc def X", nil},
+		{[]interface{}{"abc def ghi", "[a-z]+", "X", 2}, "aX X X", nil},
+		{[]interface{}{"abc def ghi", "[a-z]+", "X", 1, 4}, "abc def ghi", nil},
+		{[]interface{}{"abc def ghi", "[A-Z]+", "X", 1, 2, "i"}, "abc X ghi", nil},
+		{[]interface{}{"abc def", "([a-z]+) ([a-z]+)", "$2 $1"}, "def abc", nil},
+		{[]interface{}{"你好世界", "好.", "X", 2, 1}, "你X界", nil},
+		{[]interface{}{"abc", "b", "X", 0}, nil, errRegexpIndexOutOfBounds},
+		{[]interface{}{"abc", "b", nil}, nil, nil},
+	})
+}
+
+func (s *testEvaluatorSerialSuites) TestRegexpCollation(c *C) {
+	collate.SetNewCollationEnabledForTest(true)
+	defer collate.SetNewCollationEnabledForTest(false)
+
+	tests := []struct {
+		collation string
+		matchType string
+		match     int64
+	}{
+		{"utf8mb4_bin", "", 0},
+		{"utf8mb4_bin", "i", 1},
+		{"utf8mb4_general_ci", "", 1},
+		{"utf8mb4_general_ci", "c", 0},
+		{"utf8mb4_unicode_ci", "", 1},
+		{"binary", "i", 0},
+	}
+	fc := funcs[RegexpLike]
+	for _, tt := range tests {
+		expr := types.NewCollationStringDatum("ABC", tt.collation, 3)
+		pattern := types.NewCollationStringDatum("abc", tt.collation, 3)
+		matchType := types.NewCollationStringDatum(tt.matchType, tt.collation, len(tt.matchType))
+		f, err := fc.getFunction(s.ctx, s.datumsToConstants([]types.Datum{expr, pattern, matchType}))
+		c.Assert(err, IsNil)
+		res, err := evalBuiltinFunc(f, chunk.Row{})
+		c.Assert(err, IsNil)
+		c.Assert(res, testutil.DatumEquals, types.NewDatum(tt.match), Commentf("%v", tt))
+	}
+}
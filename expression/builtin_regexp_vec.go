@@ -0,0 +1,146 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+)
+
+// vecEvalParams evaluates the arguments in vectors, and calls fn with the arguments
+// of each row in order, params is nil if any of the arguments is NULL.
+func (b *regexpBaseFuncSig) vecEvalParams(input *chunk.Chunk, fn func(row int, params *regexpParams) error) error {
+	n := input.NumRows()
+	bufs := make([]*chunk.Column, len(b.args))
+	for i, arg := range b.args {
+		buf, err := b.bufAllocator.get()
+		if err != nil {
+			return err
+		}
+		defer b.bufAllocator.put(buf)
+		if b.argKinds[i].evalType() == types.ETInt {
+			err = arg.VecEvalInt(b.ctx, input, buf)
+		} else {
+			err = arg.VecEvalString(b.ctx, input, buf)
+		}
+		if err != nil {
+			return err
+		}
+		bufs[i] = buf
+	}
+
+	for row := 0; row < n; row++ {
+		params, isNull := b.defaults, false
+		for i, buf := range bufs {
+			if buf.IsNull(row) {
+				isNull = true
+				break
+			}
+			kind := b.argKinds[i]
+			if kind.evalType() == types.ETInt {
+				params.setInt(kind, buf.GetInt64(row))
+			} else {
+				params.setString(kind, buf.GetString(row))
+			}
+		}
+		var err error
+		if isNull {
+			err = fn(row, nil)
+		} else {
+			err = fn(row, &params)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *builtinRegexpLikeFuncSig) vectorized() bool {
+	return true
+}
+
+func (b *builtinRegexpLikeFuncSig) vecEvalInt(input *chunk.Chunk, result *chunk.Column) error {
+	result.ResizeInt64(input.NumRows(), false)
+	i64s := result.Int64s()
+	return b.vecEvalParams(input, func(row int, params *regexpParams) (err error) {
+		if params == nil {
+			result.SetNull(row, true)
+			return nil
+		}
+		i64s[row], err = b.like(params)
+		return err
+	})
+}
+
+func (b *builtinRegexpInStrFuncSig) vectorized() bool {
+	return true
+}
+
+func (b *builtinRegexpInStrFuncSig) vecEvalInt(input *chunk.Chunk, result *chunk.Column) error {
+	result.ResizeInt64(input.NumRows(), false)
+	i64s := result.Int64s()
+	return b.vecEvalParams(input, func(row int, params *regexpParams) (err error) {
+		if params == nil {
+			result.SetNull(row, true)
+			return nil
+		}
+		i64s[row], err = b.instr(params)
+		return err
+	})
+}
+
+func (b *builtinRegexpSubstrFuncSig) vectorized() bool {
+	return true
+}
+
+func (b *builtinRegexpSubstrFuncSig) vecEvalString(input *chunk.Chunk, result *chunk.Column) error {
+	result.ReserveString(input.NumRows())
+	return b.vecEvalParams(input, func(row int, params *regexpParams) error {
+		if params == nil {
+			result.AppendNull()
+			return nil
+		}
+		res, isNull, err := b.substr(params)
+		if err != nil {
+			return err
+		}
+		if isNull {
+			result.AppendNull()
+		} else {
+			result.AppendString(res)
+		}
+		return nil
+	})
+}
+
+func (b *builtinRegexpReplaceFuncSig) vectorized() bool {
+	return true
+}
+
+func (b *builtinRegexpReplaceFuncSig) vecEvalString(input *chunk.Chunk, result *chunk.Column) error {
+	result.ReserveString(input.NumRows())
+	return b.vecEvalParams(input, func(row int, params *regexpParams) error {
+		if params == nil {
+			result.AppendNull()
+			return nil
+		}
+		res, err := b.replace(params)
+		if err != nil {
+			return err
+		}
+		result.AppendString(res)
+		return nil
+	})
+}
@@ -0,0 +1,62 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"testing"
+
+	. "github.com/pingcap/check"
+	"github.com/pingcap/tidb/types"
+)
+
+var (
+	regexpExprGener      = newSelectStringGener([]string{"abc def ghi", "ABC abc", "a\nb\nc", "你好世界", ""})
+	regexpPatternGener   = newSelectStringGener([]string{"[a-z]+", "^a", "b$", "c.", "(a)(b)", "世"})
+	regexpMatchTypeGener = newSelectStringGener([]string{"", "c", "i", "m", "n", "imn"})
+)
+
+var vecBuiltinRegexpCases = map[string][]vecExprBenchCase{
+	RegexpLike: {
+		{retEvalType: types.ETInt, childrenTypes: []types.EvalType{types.ETString, types.ETString},
+			geners: []dataGenerator{regexpExprGener, regexpPatternGener}},
+		{retEvalType: types.ETInt, childrenTypes: []types.EvalType{types.ETString, types.ETString, types.ETString},
+			geners: []dataGenerator{regexpExprGener, regexpPatternGener, regexpMatchTypeGener}},
+	},
+	RegexpInStr: {
+		{retEvalType: types.ETInt, childrenTypes: []types.EvalType{types.ETString, types.ETString, types.ETInt, types.ETInt},
+			geners: []dataGenerator{regexpExprGener, regexpPatternGener, newRangeInt64Gener(1, 2), newRangeInt64Gener(1, 3)}},
+		{retEvalType: types.ETInt, childrenTypes: []types.EvalType{types.ETString, types.ETString, types.ETInt, types.ETInt, types.ETInt, types.ETString},
+			geners: []dataGenerator{regexpExprGener, regexpPatternGener, newRangeInt64Gener(1, 2), newRangeInt64Gener(1, 3), newRangeInt64Gener(0, 2), regexpMatchTypeGener}},
+	},
+	RegexpSubstr: {
+		{retEvalType: types.ETString, childrenTypes: []types.EvalType{types.ETString, types.ETString},
+			geners: []dataGenerator{regexpExprGener, regexpPatternGener}},
+		{retEvalType: types.ETString, childrenTypes: []types.EvalType{types.ETString, types.ETString, types.ETInt, types.ETInt, types.ETString},
+			geners: []dataGenerator{regexpExprGener, regexpPatternGener, newRangeInt64Gener(1, 2), newRangeInt64Gener(1, 3), regexpMatchTypeGener}},
+	},
+	RegexpReplace: {
+		{retEvalType: types.ETString, childrenTypes: []types.EvalType{types.ETString, types.ETString, types.ETString},
+			geners: []dataGenerator{regexpExprGener, regexpPatternGener, newSelectStringGener([]string{"X", "$1", ""})}},
+		{retEvalType: types.ETString, childrenTypes: []types.EvalType{types.ETString, types.ETString, types.ETString, types.ETInt, types.ETInt, types.ETString},
+			geners: []dataGenerator{regexpExprGener, regexpPatternGener, newSelectStringGener([]string{"X", "$1", ""}), newRangeInt64Gener(1, 2), newRangeInt64Gener(0, 3), regexpMatchTypeGener}},
+	},
+}
+
+func (s *testEvaluatorSuite) TestVectorizedBuiltinRegexpFunc(c *C) {
+	testVectorizedBuiltinFunc(c, vecBuiltinRegexpCases)
+}
+
+func BenchmarkVectorizedBuiltinRegexpFunc(b *testing.B) {
+	benchmarkVectorizedBuiltinFunc(b, vecBuiltinRegexpCases)
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/chunk"
 	"github.com/pingcap/tidb/util/collate"
+	"github.com/pingcap/tidb/util/encoding"
 	"github.com/pingcap/tidb/util/hack"
 	"github.com/pingcap/tidb/util/logutil"
 	"github.com/pingcap/tipb/go-tipb"
@@ -220,6 +221,10 @@ func (b *builtinLengthSig) evalInt(row chunk.Row) (int64, bool, error) {
 	if isNull || err != nil {
 		return 0, isNull, err
 	}
+	if enc := encoding.GetEncoding(b.args[0].GetType().Charset); enc != nil {
+		encoded, _ := enc.Encode(nil, val)
+		return int64(len(encoded)), false, nil
+	}
 	return int64(len([]byte(val))), false, nil
 }
 
@@ -1101,18 +1106,42 @@ func (b *builtinConvertSig) evalString(row chunk.Row) (string, bool, error) {
 	if isNull || err != nil {
 		return "", true, err
 	}
+	target, err := b.convert(expr)
+	return target, err != nil, err
+}
+
+// convert converts the string into the charset of the result. The strings in the charsets which have an Encoding,
+// e.g. gbk, are still kept in UTF-8, so the binary strings are decoded from the charset, and the other strings get
+// the characters not in the charset replaced by '?'. They are encoded when they are converted into binary.
+func (b *builtinConvertSig) convert(expr string) (string, error) {
+	if enc := encoding.GetEncoding(b.tp.Charset); enc != nil {
+		if types.IsBinaryStr(b.args[0].GetType()) {
+			target, _ := enc.DecodeString(expr)
+			return target, nil
+		}
+		encoded, ok := enc.EncodeString(expr)
+		if ok {
+			return expr, nil
+		}
+		target, _ := enc.DecodeString(encoded)
+		return target, nil
+	}
+	if enc := encoding.GetEncoding(b.args[0].GetType().Charset); enc != nil && types.IsBinaryStr(b.tp) {
+		target, _ := enc.EncodeString(expr)
+		return target, nil
+	}
 
 	// Since charset is already validated and set from getFunction(), there's no
 	// need to get charset from args again.
-	encoding, _ := charset.Lookup(b.tp.Charset)
+	decoder, _ := charset.Lookup(b.tp.Charset)
 	// However, if `b.tp.Charset` is abnormally set to a wrong charset, we still
 	// return with error.
-	if encoding == nil {
-		return "", true, errUnknownCharacterSet.GenWithStackByArgs(b.tp.Charset)
+	if decoder == nil {
+		return "", errUnknownCharacterSet.GenWithStackByArgs(b.tp.Charset)
 	}
 
-	target, _, err := transform.String(encoding.NewDecoder(), expr)
-	return target, err != nil, err
+	target, _, err := transform.String(decoder.NewDecoder(), expr)
+	return target, err
 }
 
 type substringFunctionClass struct {
@@ -1624,6 +1653,10 @@ func (b *builtinHexStrArgSig) evalString(row chunk.Row) (string, bool, error) {
 	if isNull || err != nil {
 		return d, isNull, err
 	}
+	if enc := encoding.GetEncoding(b.args[0].GetType().Charset); enc != nil {
+		encoded, _ := enc.Encode(nil, d)
+		return strings.ToUpper(hex.EncodeToString(encoded)), false, nil
+	}
 	return strings.ToUpper(hex.EncodeToString(hack.Slice(d))), false, nil
 }
 
@@ -2284,6 +2317,10 @@ func (b *builtinBitLengthSig) evalInt(row chunk.Row) (int64, bool, error) {
 	if isNull || err != nil {
 		return 0, isNull, err
 	}
+	if enc := encoding.GetEncoding(b.args[0].GetType().Charset); enc != nil {
+		encoded, _ := enc.Encode(nil, val)
+		return int64(len(encoded) * 8), false, nil
+	}
 
 	return int64(len(val) * 8), false, nil
 }
@@ -2852,7 +2889,7 @@ func chooseOrdFunc(charSet string) (func(string) int64, error) {
 	if charSet == "" {
 		charSet = charset.CharsetUTF8
 	}
-	desc, err := charset.GetCharsetDesc(charSet)
+	desc, err := collate.GetCharsetDesc(charSet)
 	if err != nil {
 		return nil, err
 	}
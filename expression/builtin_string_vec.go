@@ -23,13 +23,12 @@ import (
 	"unicode/utf8"
 
 	"github.com/pingcap/parser/ast"
-	"github.com/pingcap/parser/charset"
 	"github.com/pingcap/parser/mysql"
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/chunk"
 	"github.com/pingcap/tidb/util/collate"
-	"golang.org/x/text/transform"
+	"github.com/pingcap/tidb/util/encoding"
 )
 
 func (b *builtinLowerSig) vecEvalString(input *chunk.Chunk, result *chunk.Column) error {
@@ -442,12 +441,19 @@ func (b *builtinHexStrArgSig) vecEvalString(input *chunk.Chunk, result *chunk.Co
 	if err := b.args[0].VecEvalString(b.ctx, input, buf0); err != nil {
 		return err
 	}
+	enc := encoding.GetEncoding(b.args[0].GetType().Charset)
+	var encoded []byte
 	result.ReserveString(n)
 	for i := 0; i < n; i++ {
 		if buf0.IsNull(i) {
 			result.AppendNull()
 			continue
 		}
+		if enc != nil {
+			encoded, _ = enc.Encode(encoded[:0], buf0.GetString(i))
+			result.AppendString(strings.ToUpper(hex.EncodeToString(encoded)))
+			continue
+		}
 		result.AppendString(strings.ToUpper(hex.EncodeToString(buf0.GetBytes(i))))
 	}
 	return nil
@@ -669,22 +675,13 @@ func (b *builtinConvertSig) vecEvalString(input *chunk.Chunk, result *chunk.Colu
 	if err := b.args[0].VecEvalString(b.ctx, input, expr); err != nil {
 		return err
 	}
-	// Since charset is already validated and set from getFunction(), there's no
-	// need to get charset from args again.
-	encoding, _ := charset.Lookup(b.tp.Charset)
-	// However, if `b.tp.Charset` is abnormally set to a wrong charset, we still
-	// return with error.
-	if encoding == nil {
-		return errUnknownCharacterSet.GenWithStackByArgs(b.tp.Charset)
-	}
 	result.ReserveString(n)
 	for i := 0; i < n; i++ {
 		if expr.IsNull(i) {
 			result.AppendNull()
 			continue
 		}
-		exprI := expr.GetString(i)
-		target, _, err := transform.String(encoding.NewDecoder(), exprI)
+		target, err := b.convert(expr.GetString(i))
 		if err != nil {
 			return err
 		}
@@ -2118,6 +2115,8 @@ func (b *builtinLengthSig) vecEvalInt(input *chunk.Chunk, result *chunk.Column)
 		return err
 	}
 
+	enc := encoding.GetEncoding(b.args[0].GetType().Charset)
+	var encoded []byte
 	result.ResizeInt64(n, false)
 	result.MergeNulls(buf)
 	i64s := result.Int64s()
@@ -2126,6 +2125,10 @@ func (b *builtinLengthSig) vecEvalInt(input *chunk.Chunk, result *chunk.Column)
 			continue
 		}
 		str := buf.GetBytes(i)
+		if enc != nil {
+			encoded, _ = enc.Encode(encoded[:0], buf.GetString(i))
+			str = encoded
+		}
 		i64s[i] = int64(len(str))
 	}
 	return nil
@@ -2201,6 +2204,8 @@ func (b *builtinBitLengthSig) vecEvalInt(input *chunk.Chunk, result *chunk.Colum
 		return err
 	}
 
+	enc := encoding.GetEncoding(b.args[0].GetType().Charset)
+	var encoded []byte
 	result.ResizeInt64(n, false)
 	result.MergeNulls(buf)
 	i64s := result.Int64s()
@@ -2209,6 +2214,10 @@ func (b *builtinBitLengthSig) vecEvalInt(input *chunk.Chunk, result *chunk.Colum
 			continue
 		}
 		str := buf.GetBytes(i)
+		if enc != nil {
+			encoded, _ = enc.Encode(encoded[:0], buf.GetString(i))
+			str = encoded
+		}
 		i64s[i] = int64(len(str) * 8)
 	}
 	return nil
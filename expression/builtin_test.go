@@ -15,6 +15,7 @@ package expression
 
 import (
 	"reflect"
+	"strings"
 	"sync"
 
 	. "github.com/pingcap/check"
@@ -22,6 +23,7 @@ import (
 	"github.com/pingcap/parser/charset"
 	"github.com/pingcap/parser/model"
 	"github.com/pingcap/parser/mysql"
+	"github.com/pingcap/parser/terror"
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/chunk"
@@ -136,18 +138,45 @@ func (s *testEvaluatorSuite) TestIsNullFunc(c *C) {
 
 func (s *testEvaluatorSuite) TestLock(c *C) {
 	lock := funcs[ast.GetLock]
-	f, err := lock.getFunction(s.ctx, s.datumsToConstants(types.MakeDatums(nil, 1)))
+	f, err := lock.getFunction(s.ctx, s.datumsToConstants(types.MakeDatums("mylock", 1)))
 	c.Assert(err, IsNil)
 	v, err := evalBuiltinFunc(f, chunk.Row{})
 	c.Assert(err, IsNil)
 	c.Assert(v.GetInt64(), Equals, int64(1))
 
+	isFreeLock := funcs[ast.IsFreeLock]
+	f, err = isFreeLock.getFunction(s.ctx, s.datumsToConstants(types.MakeDatums("MyLock")))
+	c.Assert(err, IsNil)
+	v, err = evalBuiltinFunc(f, chunk.Row{})
+	c.Assert(err, IsNil)
+	c.Assert(v.GetInt64(), Equals, int64(0))
+
 	releaseLock := funcs[ast.ReleaseLock]
-	f, err = releaseLock.getFunction(s.ctx, s.datumsToConstants(types.MakeDatums(1)))
+	f, err = releaseLock.getFunction(s.ctx, s.datumsToConstants(types.MakeDatums("mylock")))
 	c.Assert(err, IsNil)
 	v, err = evalBuiltinFunc(f, chunk.Row{})
 	c.Assert(err, IsNil)
 	c.Assert(v.GetInt64(), Equals, int64(1))
+
+	// The lock is released, so releasing it again returns NULL.
+	v, err = evalBuiltinFunc(f, chunk.Row{})
+	c.Assert(err, IsNil)
+	c.Assert(v.IsNull(), IsTrue)
+
+	isUsedLock := funcs[ast.IsUsedLock]
+	f, err = isUsedLock.getFunction(s.ctx, s.datumsToConstants(types.MakeDatums("mylock")))
+	c.Assert(err, IsNil)
+	v, err = evalBuiltinFunc(f, chunk.Row{})
+	c.Assert(err, IsNil)
+	c.Assert(v.IsNull(), IsTrue)
+
+	// Invalid lock names.
+	for _, name := range []interface{}{nil, "", strings.Repeat("a", 65)} {
+		f, err = lock.getFunction(s.ctx, s.datumsToConstants(types.MakeDatums(name, 1)))
+		c.Assert(err, IsNil)
+		_, err = evalBuiltinFunc(f, chunk.Row{})
+		c.Assert(terror.ErrorEqual(err, errUserLockWrongName), IsTrue, Commentf("err %v", err))
+	}
 }
 
 func (s *testEvaluatorSuite) TestDisplayName(c *C) {
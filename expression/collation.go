@@ -19,6 +19,7 @@ import (
 	"github.com/pingcap/parser/mysql"
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/collate"
 	"github.com/pingcap/tidb/util/logutil"
 )
 
@@ -117,11 +118,15 @@ var (
 	collationPriority = map[string]int{
 		charset.CollationASCII:   1,
 		charset.CollationLatin1:  2,
+		"gbk_chinese_ci":         2,
+		"gbk_bin":                2,
 		"utf8_general_ci":        3,
 		"utf8_unicode_ci":        3,
 		charset.CollationUTF8:    4,
 		"utf8mb4_general_ci":     5,
 		"utf8mb4_unicode_ci":     5,
+		"utf8mb4_0900_ai_ci":     5,
+		"utf8mb4_0900_as_cs":     5,
 		charset.CollationUTF8MB4: 6,
 		charset.CollationBin:     7,
 	}
@@ -137,6 +142,10 @@ var (
 		charset.CollationUTF8:    3,
 		charset.CollationUTF8MB4: 3,
 		charset.CollationBin:     4,
+		"utf8mb4_0900_ai_ci":     5,
+		"utf8mb4_0900_as_cs":     6,
+		"gbk_chinese_ci":         7,
+		"gbk_bin":                8,
 	}
 
 	// CollationStrictness indicates the strictness of comparison of the collation. The unequal order in a weak collation also holds in a strict collation.
@@ -147,9 +156,31 @@ var (
 		2: {3, 4},
 		3: {4},
 		4: {},
+		5: {4, 6},
+		6: {4},
+		7: {3, 4, 8},
+		8: {3, 4},
+	}
+
+	// tidbOnlyCollations are the collations only implemented in TiDB. TiKV and TiFlash can't compare, sort or match
+	// the strings in them, so the expressions using them must not be pushed down.
+	tidbOnlyCollations = map[string]struct{}{
+		"utf8mb4_0900_ai_ci": {},
+		"utf8mb4_0900_as_cs": {},
+		"gbk_chinese_ci":     {},
+		"gbk_bin":            {},
 	}
 )
 
+// hasTiDBOnlyCollation checks whether the field type is a string type in a collation only implemented in TiDB.
+func hasTiDBOnlyCollation(tp *types.FieldType) bool {
+	if !collate.NewCollationEnabled() || !types.IsString(tp.Tp) {
+		return false
+	}
+	_, ok := tidbOnlyCollations[tp.Collate]
+	return ok
+}
+
 func deriveCoercibilityForScarlarFunc(sf *ScalarFunction) Coercibility {
 	if _, ok := sysConstFuncs[sf.FuncName.L]; ok {
 		return CoercibilitySysconst
@@ -232,6 +263,8 @@ func getBinCollation(cs string) string {
 		return charset.CollationUTF8
 	case charset.CharsetUTF8MB4:
 		return charset.CollationUTF8MB4
+	case charset.CharsetGBK:
+		return "gbk_bin"
 	}
 
 	logutil.BgLogger().Error("unexpected charset " + cs)
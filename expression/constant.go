@@ -82,6 +82,31 @@ func (d *ParamMarker) GetUserVar() types.Datum {
 	return sessionVars.PreparedParams[d.order]
 }
 
+// ResetCtxForExprs binds the expressions to the session context. The expressions
+// must be cloned before, it is used to share the cached plans between sessions.
+func ResetCtxForExprs(ctx sessionctx.Context, exprs []Expression) {
+	for _, expr := range exprs {
+		resetCtxForExpr(ctx, expr)
+	}
+}
+
+func resetCtxForExpr(ctx sessionctx.Context, expr Expression) {
+	switch x := expr.(type) {
+	case *ScalarFunction:
+		x.Function.setCtx(ctx)
+		ResetCtxForExprs(ctx, x.GetArgs())
+	case *Constant:
+		if x.ParamMarker != nil {
+			x.ParamMarker = &ParamMarker{ctx: ctx, order: x.ParamMarker.order}
+		}
+		if x.DeferredExpr != nil {
+			// The deferred expression is shared by the cloned constants.
+			x.DeferredExpr = x.DeferredExpr.Clone()
+			resetCtxForExpr(ctx, x.DeferredExpr)
+		}
+	}
+}
+
 // String implements fmt.Stringer interface.
 func (c *Constant) String() string {
 	if c.ParamMarker != nil {
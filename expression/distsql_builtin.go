@@ -652,6 +652,22 @@ func getSignatureByPB(ctx sessionctx.Context, sigCode tipb.ScalarFuncSig, tp *ti
 	// 	f = &builtinRegexpSig{base}
 	// case tipb.ScalarFuncSig_RegexpUTF8Sig:
 	// 	f = &builtinRegexpUTF8Sig{base}
+	case tipb.ScalarFuncSig_RegexpLikeSig:
+		f = newBuiltinRegexpLikeFuncSig(base, true)
+	case tipb.ScalarFuncSig_RegexpLikeUTF8Sig:
+		f = newBuiltinRegexpLikeFuncSig(base, false)
+	case tipb.ScalarFuncSig_RegexpInStrSig:
+		f = newBuiltinRegexpInStrFuncSig(base, true)
+	case tipb.ScalarFuncSig_RegexpInStrUTF8Sig:
+		f = newBuiltinRegexpInStrFuncSig(base, false)
+	case tipb.ScalarFuncSig_RegexpSubstrSig:
+		f = newBuiltinRegexpSubstrFuncSig(base, true)
+	case tipb.ScalarFuncSig_RegexpSubstrUTF8Sig:
+		f = newBuiltinRegexpSubstrFuncSig(base, false)
+	case tipb.ScalarFuncSig_RegexpReplaceSig:
+		f = newBuiltinRegexpReplaceFuncSig(base, true)
+	case tipb.ScalarFuncSig_RegexpReplaceUTF8Sig:
+		f = newBuiltinRegexpReplaceFuncSig(base, false)
 	case tipb.ScalarFuncSig_JsonExtractSig:
 		f = &builtinJSONExtractSig{base}
 	case tipb.ScalarFuncSig_JsonUnquoteSig:
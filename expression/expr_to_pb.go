@@ -216,6 +216,9 @@ func (pc PbConverter) columnToPBExpr(column *Column) *tipb.Expr {
 			return nil
 		}
 	}
+	if hasTiDBOnlyCollation(column.GetType()) {
+		return nil
+	}
 
 	if pc.client.IsRequestTypeSupported(kv.ReqTypeDAG, kv.ReqSubTypeBasic) {
 		return &tipb.Expr{
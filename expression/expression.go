@@ -34,6 +34,7 @@ import (
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/types/json"
 	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/encoding"
 	"github.com/pingcap/tidb/util/generatedexpr"
 	"github.com/pingcap/tidb/util/logutil"
 	"github.com/pingcap/tipb/go-tipb"
@@ -1127,6 +1128,25 @@ func canFuncBePushed(sf *ScalarFunction, storeType kv.StoreType) bool {
 		ret = scalarExprSupportedByTiDB(sf) || scalarExprSupportedByTiKV(sf) || scalarExprSupportedByFlash(sf)
 	}
 
+	if ret {
+		switch sf.FuncName.L {
+		case ast.Length, ast.BitLength, ast.Hex:
+			// The stores evaluate them on the UTF-8 bytes, while they need the encoded bytes of the charsets like gbk.
+			ret = encoding.GetEncoding(sf.GetArgs()[0].GetType().Charset) == nil
+		}
+	}
+
+	if ret {
+		// The stores don't implement the collations only TiDB knows, so they can't compare or match the strings in them.
+		ret = !hasTiDBOnlyCollation(sf.GetType())
+		for _, arg := range sf.GetArgs() {
+			if !ret {
+				break
+			}
+			ret = !hasTiDBOnlyCollation(arg.GetType())
+		}
+	}
+
 	if ret {
 		ret = IsPushDownEnabled(sf.FuncName.L, storeType)
 	}
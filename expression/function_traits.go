@@ -29,6 +29,8 @@ var UnCacheableFunctions = map[string]struct{}{
 	ast.RowCount:     {},
 	ast.Version:      {},
 	ast.Like:         {},
+
+	ast.ValidatePasswordStrength: {},
 }
 
 // unFoldableFunctions stores functions which can not be folded duration constant folding stage.
@@ -48,6 +50,12 @@ var unFoldableFunctions = map[string]struct{}{
 	ast.NextVal:   {},
 	ast.LastVal:   {},
 	ast.SetVal:    {},
+
+	ast.GetLock:         {},
+	ast.ReleaseLock:     {},
+	ast.ReleaseAllLocks: {},
+	ast.IsFreeLock:      {},
+	ast.IsUsedLock:      {},
 }
 
 // DisableFoldFunctions stores functions which prevent child scope functions from being constant folded.
@@ -222,15 +230,18 @@ var mutableEffectsFunctions = map[string]struct{}{
 	ast.SetVar:      {},
 	ast.GetVar:      {},
 	ast.AnyValue:    {},
+
+	ast.GetLock:         {},
+	ast.ReleaseLock:     {},
+	ast.ReleaseAllLocks: {},
+	ast.IsFreeLock:      {},
+	ast.IsUsedLock:      {},
 }
 
-// some functions like "get_lock" and "release_lock" currently do NOT have
-// right implementations, but may have noop ones(like with any inputs, always return 1)
+// noopFuncs stores the functions that do NOT have right implementations, but may
+// have noop ones(like with any inputs, always return 1)
 // if apps really need these "funcs" to run, we offer sys var(tidb_enable_noop_functions) to enable noop usage
-var noopFuncs = map[string]struct{}{
-	ast.GetLock:     {},
-	ast.ReleaseLock: {},
-}
+var noopFuncs = map[string]struct{}{}
 
 // booleanFunctions stores boolean functions
 var booleanFunctions = map[string]struct{}{
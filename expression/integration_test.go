@@ -5148,7 +5148,9 @@ func (s *testIntegrationSuite) TestForeignKeyVar(c *C) {
 	tk := testkit.NewTestKit(c, s.store)
 
 	tk.MustExec("SET FOREIGN_KEY_CHECKS=1")
-	tk.MustQuery("SHOW WARNINGS").Check(testkit.Rows("Warning 8047 variable 'foreign_key_checks' does not yet support value: 1"))
+	tk.MustQuery("SHOW WARNINGS").Check(testkit.Rows())
+	tk.MustQuery("SELECT @@foreign_key_checks").Check(testkit.Rows("1"))
+	tk.MustExec("SET FOREIGN_KEY_CHECKS=0")
 }
 
 func (s *testIntegrationSuite) TestUserVarMockWindFunc(c *C) {
@@ -6244,6 +6246,43 @@ func (s *testIntegrationSerialSuite) TestCacheRegexpr(c *C) {
 	tk.MustQuery("execute stmt1 using @a").Check(testkit.Rows("R1"))
 }
 
+func (s *testIntegrationSerialSuite) TestRegexpFunctions(c *C) {
+	collate.SetNewCollationEnabledForTest(true)
+	defer collate.SetNewCollationEnabledForTest(false)
+
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (a varchar(40) collate utf8mb4_general_ci, b varchar(40) collate utf8mb4_bin, c varbinary(40))")
+	tk.MustExec("insert into t values ('Dog cat dog', 'Dog cat dog', 'Dog cat dog'), ('你好世界', '你好世界', '你好世界'), (null, null, null)")
+
+	tk.MustQuery("select regexp_like(a, 'dog'), regexp_like(b, 'dog'), regexp_like(c, 'dog'), regexp_like(b, 'DOG', 'i') from t").Check(
+		testkit.Rows("1 1 1 1", "0 0 0 0", "<nil> <nil> <nil> <nil>"))
+	tk.MustQuery("select regexp_like(a, '^dog'), regexp_like(b, '^dog'), regexp_like(c, '^dog', 'i') from t").Check(
+		testkit.Rows("1 0 0", "0 0 0", "<nil> <nil> <nil>"))
+	tk.MustQuery("select regexp_instr(a, 'dog', 1, 2), regexp_instr(b, '世'), regexp_instr(c, '世'), regexp_instr(b, '世', 1, 1, 1) from t").Check(
+		testkit.Rows("9 0 0 0", "0 3 7 4", "<nil> <nil> <nil> <nil>"))
+	tk.MustQuery("select regexp_substr(a, 'd[a-z]+', 1, 2), regexp_substr(b, '好.'), regexp_substr(b, '[a-z]+', 5) from t").Check(
+		testkit.Rows("dog <nil> cat", "<nil> 好世 <nil>", "<nil> <nil> <nil>"))
+	tk.MustQuery("select regexp_replace(a, 'dog', 'X'), regexp_replace(b, 'dog', 'X'), regexp_replace(b, '(\\\\w+) (\\\\w+)', '$2 $1', 1, 1) from t").Check(
+		testkit.Rows("X cat X Dog cat X cat Dog dog", "你好世界 你好世界 你好世界", "<nil> <nil> <nil>"))
+
+	// The functions are not pushed down, since TiKV doesn't implement them.
+	rows := tk.MustQuery("explain format = 'brief' select * from t where regexp_like(a, 'dog') and regexp_instr(b, 'cat') > 0 and regexp_substr(a, 'd[a-z]+') = 'dog' and regexp_replace(b, 'd', 'x') = 'x'").Rows()
+	c.Assert(fmt.Sprintf("%v", rows[0][0]), Matches, "Selection.*")
+	c.Assert(fmt.Sprintf("%v", rows[0][2]), Equals, "root")
+	tk.MustQuery("select a from t where regexp_like(a, 'DOG') and regexp_instr(b, 'cat') > 0").Check(testkit.Rows("Dog cat dog"))
+	tk.MustQuery("select b from t where regexp_like(b, 'DOG')").Check(testkit.Rows())
+	tk.MustQuery("select b from t where regexp_substr(b, '[a-z]+', 2) = 'og'").Check(testkit.Rows("Dog cat dog"))
+
+	err := tk.QueryToErr("select regexp_like('a', 'a', 'x')")
+	c.Assert(err.Error(), Equals, "[expression:1210]Incorrect arguments to regexp_like")
+	err = tk.QueryToErr("select regexp_instr('a', 'a', 3)")
+	c.Assert(err.Error(), Equals, "[expression:3686]Index out of bounds in regular expression search.")
+	err = tk.QueryToErr("select regexp_like('a', '(')")
+	c.Assert(err, NotNil)
+}
+
 func (s *testIntegrationSerialSuite) TestCacheRefineArgs(c *C) {
 	tk := testkit.NewTestKit(c, s.store)
 	orgEnable := plannercore.PreparedPlanCacheEnabled()
@@ -6640,6 +6679,118 @@ func (s *testIntegrationSerialSuite) TestCollationCreateIndex(c *C) {
 	tk.MustQuery("select * from t order by a").Check(testkit.Rows("a", "A", "a", "A", "b", "B", "sa", "ß"))
 }
 
+func (s *testIntegrationSerialSuite) TestCollation0900(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	collate.SetNewCollationEnabledForTest(true)
+	defer collate.SetNewCollationEnabledForTest(false)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (a varchar(10) collate utf8mb4_0900_ai_ci, unique index idx(a));")
+	tk.MustExec("insert into t values ('a'), ('b'), ('ß'), ('sa'), ('a ');")
+	tk.MustGetErrCode("insert into t values ('À');", mysql.ErrDupEntry)
+	tk.MustGetErrCode("insert into t values ('ss');", mysql.ErrDupEntry)
+	tk.MustQuery("select * from t use index(idx) order by a").Check(testkit.Rows("a", "a ", "b", "sa", "ß"))
+	tk.MustQuery("select * from t ignore index(idx) order by a").Check(testkit.Rows("a", "a ", "b", "sa", "ß"))
+	tk.MustQuery("select * from t where a = 'A'").Check(testkit.Rows("a"))
+	tk.MustQuery("select * from t where a like 'A%' order by a").Check(testkit.Rows("a", "a "))
+	tk.MustExec("admin check table t")
+	c.Assert(tk.MustQuery("select weight_string('a ' collate utf8mb4_0900_ai_ci);").Rows()[0][0], Equals, "\x1C\x47\x02\x09")
+
+	// The stores don't implement the 0900 collations, so the comparisons, sorts and LIKE on them stay in TiDB.
+	tk.MustExec("drop table if exists t1")
+	tk.MustExec("create table t1 (id int, a varchar(10) collate utf8mb4_0900_ai_ci)")
+	tk.MustExec("insert into t1 values (1, 'ß'), (2, 'ss'), (3, 'Strasse'), (4, 'sa')")
+	tk.MustQuery("explain format = 'brief' select id from t1 where a = 'SS'").Check(testkit.Rows(
+		"Projection 8000.00 root  test.t1.id",
+		"└─Selection 8000.00 root  eq(test.t1.a, \"SS\")",
+		"  └─TableReader 10000.00 root  data:TableFullScan",
+		"    └─TableFullScan 10000.00 cop[tikv] table:t1 keep order:false, stats:pseudo"))
+	tk.MustQuery("explain format = 'brief' select id from t1 where a like '%ss%'").Check(testkit.Rows(
+		"Projection 8000.00 root  test.t1.id",
+		"└─Selection 8000.00 root  like(test.t1.a, \"%ss%\", 92)",
+		"  └─TableReader 10000.00 root  data:TableFullScan",
+		"    └─TableFullScan 10000.00 cop[tikv] table:t1 keep order:false, stats:pseudo"))
+	tk.MustQuery("explain format = 'brief' select id from t1 order by a limit 2").Check(testkit.Rows(
+		"Projection 2.00 root  test.t1.id",
+		"└─TopN 2.00 root  test.t1.a, offset:0, count:2",
+		"  └─TableReader 10000.00 root  data:TableFullScan",
+		"    └─TableFullScan 10000.00 cop[tikv] table:t1 keep order:false, stats:pseudo"))
+	tk.MustQuery("explain format = 'brief' select id from t1 where id > 1").Check(testkit.Rows(
+		"TableReader 3333.33 root  data:Selection",
+		"└─Selection 3333.33 cop[tikv]  gt(test.t1.id, 1)",
+		"  └─TableFullScan 10000.00 cop[tikv] table:t1 keep order:false, stats:pseudo"))
+	tk.MustQuery("select id from t1 where a = 'SS' order by id").Check(testkit.Rows("1", "2"))
+	tk.MustQuery("select id from t1 where a like '%ss%' order by id").Check(testkit.Rows("1", "2", "3"))
+	tk.MustQuery("select id from t1 order by a, id limit 2").Check(testkit.Rows("4", "1"))
+	tk.MustExec("drop table t1")
+
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (a varchar(10) collate utf8mb4_0900_as_cs, unique index idx(a));")
+	tk.MustExec("insert into t values ('a'), ('A'), ('à'), ('À'), ('b');")
+	tk.MustGetErrCode("insert into t values ('a');", mysql.ErrDupEntry)
+	tk.MustQuery("select * from t use index(idx) order by a").Check(testkit.Rows("a", "A", "à", "À", "b"))
+	tk.MustQuery("select * from t where a = 'À'").Check(testkit.Rows("À"))
+	tk.MustQuery("select * from t use index(idx) where a like 'a%'").Check(testkit.Rows("a"))
+	tk.MustExec("admin check table t")
+	tk.MustQuery("select collation(a), coercibility(a) from t where a = 'b'").Check(testkit.Rows("utf8mb4_0900_as_cs 2"))
+}
+
+func (s *testIntegrationSerialSuite) TestCollationGBK(c *C) {
+	tk := testkit.NewTestKit(c, s.store)
+	collate.SetNewCollationEnabledForTest(true)
+	defer collate.SetNewCollationEnabledForTest(false)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (a varchar(10) charset gbk, unique index idx(a));")
+	tk.MustExec("insert into t values ('a'), ('b'), ('中文'), ('啊'), ('吧');")
+	tk.MustGetErrCode("insert into t values ('A ');", mysql.ErrDupEntry)
+	tk.MustGetErrCode("insert into t values ('😜');", mysql.ErrTruncatedWrongValueForField)
+	tk.MustQuery("select * from t use index(idx) order by a").Check(testkit.Rows("a", "b", "啊", "吧", "中文"))
+	tk.MustQuery("select * from t ignore index(idx) order by a").Check(testkit.Rows("a", "b", "啊", "吧", "中文"))
+	tk.MustQuery("select * from t where a = 'A '").Check(testkit.Rows("a"))
+	tk.MustQuery("select * from t use index(idx) where a like '中%'").Check(testkit.Rows("中文"))
+	tk.MustQuery("select length(a), bit_length(a), hex(a), char_length(a) from t where a = '中文'").Check(testkit.Rows("4 32 D6D0CEC4 2"))
+	tk.MustQuery("select collation(a) from t where a = 'b'").Check(testkit.Rows("gbk_chinese_ci"))
+	tk.MustExec("admin check table t")
+
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (a varchar(10) charset gbk collate gbk_bin, unique index idx(a));")
+	tk.MustExec("insert into t values ('a'), ('A'), ('中文'), ('啊'), ('吧');")
+	tk.MustGetErrCode("insert into t values ('a ');", mysql.ErrDupEntry)
+	tk.MustQuery("select * from t use index(idx) order by a").Check(testkit.Rows("A", "a", "啊", "吧", "中文"))
+	tk.MustQuery("select * from t ignore index(idx) order by a").Check(testkit.Rows("A", "a", "啊", "吧", "中文"))
+	tk.MustQuery("select * from t use index(idx) where a like 'a%'").Check(testkit.Rows("a"))
+	tk.MustExec("admin check table t")
+
+	// The stores don't implement the gbk collations, so the comparisons, sorts and LIKE on them stay in TiDB.
+	tk.MustExec("drop table if exists t1")
+	tk.MustExec("create table t1 (id int, a varchar(10) charset gbk, b varchar(10) charset gbk collate gbk_bin)")
+	tk.MustExec("insert into t1 values (1, '中文', '中文'), (2, 'A', 'A'), (3, '啊', 'a')")
+	tk.MustQuery("explain format = 'brief' select id from t1 where a = 'a'").Check(testkit.Rows(
+		"Projection 8000.00 root  test.t1.id",
+		"└─Selection 8000.00 root  eq(test.t1.a, \"a\")",
+		"  └─TableReader 10000.00 root  data:TableFullScan",
+		"    └─TableFullScan 10000.00 cop[tikv] table:t1 keep order:false, stats:pseudo"))
+	tk.MustQuery("explain format = 'brief' select id from t1 where b like '中%'").Check(testkit.Rows(
+		"Projection 8000.00 root  test.t1.id",
+		"└─Selection 8000.00 root  like(test.t1.b, \"中%\", 92)",
+		"  └─TableReader 10000.00 root  data:TableFullScan",
+		"    └─TableFullScan 10000.00 cop[tikv] table:t1 keep order:false, stats:pseudo"))
+	tk.MustQuery("explain format = 'brief' select id from t1 order by b limit 2").Check(testkit.Rows(
+		"Projection 2.00 root  test.t1.id",
+		"└─TopN 2.00 root  test.t1.b, offset:0, count:2",
+		"  └─TableReader 10000.00 root  data:TableFullScan",
+		"    └─TableFullScan 10000.00 cop[tikv] table:t1 keep order:false, stats:pseudo"))
+	tk.MustQuery("select id from t1 where a = 'a'").Check(testkit.Rows("2"))
+	tk.MustQuery("select id from t1 where b like '中%'").Check(testkit.Rows("1"))
+	tk.MustQuery("select id from t1 order by b limit 2").Check(testkit.Rows("2", "3"))
+	tk.MustExec("drop table t1")
+
+	tk.MustQuery("select length('中文'), length(convert('中文' using gbk)), hex(convert('中文' using gbk))").Check(testkit.Rows("6 4 D6D0CEC4"))
+	tk.MustQuery("select convert(0xD6D0CEC4 using gbk), convert('😜' using gbk), convert(convert('中文' using gbk) using binary)").
+		Check(testkit.Rows("中文 ? \xd6\xd0\xce\xc4"))
+}
+
 func (s *testIntegrationSerialSuite) TestCollateConstantPropagation(c *C) {
 	tk := testkit.NewTestKit(c, s.store)
 	collate.SetNewCollationEnabledForTest(true)
@@ -8942,6 +9093,15 @@ func (s *testIntegrationSerialSuite) TestLikeWithCollation(c *C) {
 	tk.MustQuery(`select '😛' collate utf8mb4_general_ci = '😋';`).Check(testkit.Rows("1"))
 	tk.MustQuery(`select '😛' collate utf8mb4_unicode_ci like '😋';`).Check(testkit.Rows("0"))
 	tk.MustQuery(`select '😛' collate utf8mb4_unicode_ci = '😋';`).Check(testkit.Rows("1"))
+	tk.MustQuery(`select '😛' collate utf8mb4_0900_ai_ci like '😋';`).Check(testkit.Rows("0"))
+	tk.MustQuery(`select '😛' collate utf8mb4_0900_ai_ci = '😋';`).Check(testkit.Rows("0"))
+	tk.MustQuery(`select 'À' collate utf8mb4_0900_ai_ci like 'a';`).Check(testkit.Rows("1"))
+	tk.MustQuery(`select 'À' collate utf8mb4_0900_as_cs like 'a';`).Check(testkit.Rows("0"))
+	tk.MustQuery(`select 'ß' collate utf8mb4_0900_ai_ci like 'ss';`).Check(testkit.Rows("1"))
+	tk.MustQuery(`select 'ss' collate utf8mb4_0900_ai_ci like 'ß';`).Check(testkit.Rows("1"))
+	tk.MustQuery(`select 'æ' collate utf8mb4_0900_ai_ci like 'ae';`).Check(testkit.Rows("1"))
+	tk.MustQuery(`select 'æ' collate utf8mb4_0900_ai_ci like 'a_';`).Check(testkit.Rows("0"))
+	tk.MustQuery(`select 'æ' collate utf8mb4_0900_as_cs like 'ae';`).Check(testkit.Rows("0"))
 }
 
 func (s *testIntegrationSuite) TestIssue11333(c *C) {
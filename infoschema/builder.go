@@ -226,6 +226,13 @@ func appendAffectedIDs(affected []int64, tblInfo *model.TableInfo) []int64 {
 		for _, def := range pi.Definitions {
 			affected = append(affected, def.ID)
 		}
+		// The partitions being reorganized are written by DML too.
+		for _, def := range pi.AddingDefinitions {
+			affected = append(affected, def.ID)
+		}
+		for _, def := range pi.DroppingDefinitions {
+			affected = append(affected, def.ID)
+		}
 	}
 	return affected
 }
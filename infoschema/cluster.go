@@ -43,6 +43,8 @@ const (
 	ClusterTableTiDBTrx = "CLUSTER_TIDB_TRX"
 	// ClusterTableDeadlocks is the string constant of cluster dead lock table.
 	ClusterTableDeadlocks = "CLUSTER_DEADLOCKS"
+	// ClusterTableTiDBIndexUsage is the string constant of cluster index usage table.
+	ClusterTableTiDBIndexUsage = "CLUSTER_TIDB_INDEX_USAGE"
 )
 
 // memTableToClusterTables means add memory table to cluster table.
@@ -54,6 +56,7 @@ var memTableToClusterTables = map[string]string{
 	TableStatementsSummaryEvicted: ClusterTableStatementsSummaryEvicted,
 	TableTiDBTrx:                  ClusterTableTiDBTrx,
 	TableDeadlocks:                ClusterTableDeadlocks,
+	TableTiDBIndexUsage:           ClusterTableTiDBIndexUsage,
 }
 
 func init() {
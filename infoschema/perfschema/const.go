@@ -415,6 +415,7 @@ const tableEventsStatementsSummaryByDigest = "CREATE TABLE if not exists perform
 	"LAST_SEEN timestamp(6) NOT NULL DEFAULT '0000-00-00 00:00:00.000000'," +
 	"PLAN_IN_CACHE bool NOT NULL," +
 	"PLAN_CACHE_HITS bigint unsigned NOT NULL," +
+	"PLAN_CACHE_MISSES bigint unsigned NOT NULL," +
 	"PLAN_IN_BINDING bool NOT NULL," +
 	"QUANTILE_95 bigint unsigned NOT NULL," +
 	"QUANTILE_99 bigint unsigned NOT NULL," +
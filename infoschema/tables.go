@@ -96,8 +96,9 @@ const (
 	tableGlobalStatus    = "GLOBAL_STATUS"
 	tableGlobalVariables = "GLOBAL_VARIABLES"
 	tableSessionStatus   = "SESSION_STATUS"
-	tableOptimizerTrace  = "OPTIMIZER_TRACE"
-	tableTableSpaces     = "TABLESPACES"
+	// TableOptimizerTrace is the string constant of infoschema table.
+	TableOptimizerTrace = "OPTIMIZER_TRACE"
+	tableTableSpaces    = "TABLESPACES"
 	// TableCollationCharacterSetApplicability is the string constant of infoschema memory table.
 	TableCollationCharacterSetApplicability = "COLLATION_CHARACTER_SET_APPLICABILITY"
 	// TableProcesslist is the string constant of infoschema table.
@@ -176,6 +177,10 @@ const (
 	TableDataLockWaits = "DATA_LOCK_WAITS"
 	// TableRegionLabel is the string constant of region label table.
 	TableRegionLabel = "REGION_LABEL"
+	// TableCheckConstraints is the string constant of CHECK_CONSTRAINTS.
+	TableCheckConstraints = "CHECK_CONSTRAINTS"
+	// TableTiDBIndexUsage is the string constant of the index usage table.
+	TableTiDBIndexUsage = "TIDB_INDEX_USAGE"
 )
 
 const (
@@ -223,7 +228,7 @@ var tableIDMap = map[string]int64{
 	tableGlobalStatus:                       autoid.InformationSchemaDBID + 27,
 	tableGlobalVariables:                    autoid.InformationSchemaDBID + 28,
 	tableSessionStatus:                      autoid.InformationSchemaDBID + 29,
-	tableOptimizerTrace:                     autoid.InformationSchemaDBID + 30,
+	TableOptimizerTrace:                     autoid.InformationSchemaDBID + 30,
 	tableTableSpaces:                        autoid.InformationSchemaDBID + 31,
 	TableCollationCharacterSetApplicability: autoid.InformationSchemaDBID + 32,
 	TableProcesslist:                        autoid.InformationSchemaDBID + 33,
@@ -271,6 +276,9 @@ var tableIDMap = map[string]int64{
 	TableStatementsSummaryEvicted:           autoid.InformationSchemaDBID + 75,
 	ClusterTableStatementsSummaryEvicted:    autoid.InformationSchemaDBID + 76,
 	TableRegionLabel:                        autoid.InformationSchemaDBID + 77,
+	TableCheckConstraints:                   autoid.InformationSchemaDBID + 78,
+	TableTiDBIndexUsage:                     autoid.InformationSchemaDBID + 79,
+	ClusterTableTiDBIndexUsage:              autoid.InformationSchemaDBID + 80,
 }
 
 type columnInfo struct {
@@ -563,6 +571,13 @@ var tableConstraintsCols = []columnInfo{
 	{name: "CONSTRAINT_TYPE", tp: mysql.TypeVarchar, size: 64},
 }
 
+var tableCheckConstraintsCols = []columnInfo{
+	{name: "CONSTRAINT_CATALOG", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "CONSTRAINT_SCHEMA", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "CONSTRAINT_NAME", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "CHECK_CLAUSE", tp: mysql.TypeLongBlob, size: types.UnspecifiedLength, flag: mysql.NotNullFlag},
+}
+
 var tableTriggersCols = []columnInfo{
 	{name: "TRIGGER_CATALOG", tp: mysql.TypeVarchar, size: 512},
 	{name: "TRIGGER_SCHEMA", tp: mysql.TypeVarchar, size: 64},
@@ -742,7 +757,7 @@ var tableSessionStatusCols = []columnInfo{
 var tableOptimizerTraceCols = []columnInfo{
 	{name: "QUERY", tp: mysql.TypeLongBlob, flag: mysql.NotNullFlag, deflt: ""},
 	{name: "TRACE", tp: mysql.TypeLongBlob, flag: mysql.NotNullFlag, deflt: ""},
-	{name: "MISSING_BYTES_BEYOND_MAX_MEM_SIZE", tp: mysql.TypeShort, size: 20, flag: mysql.NotNullFlag, deflt: 0},
+	{name: "MISSING_BYTES_BEYOND_MAX_MEM_SIZE", tp: mysql.TypeLong, size: 11, flag: mysql.NotNullFlag, deflt: 0},
 	{name: "INSUFFICIENT_PRIVILEGES", tp: mysql.TypeTiny, size: 1, flag: mysql.NotNullFlag, deflt: 0},
 }
 
@@ -1233,6 +1248,7 @@ var tableStatementsSummaryCols = []columnInfo{
 	{name: stmtsummary.LastSeenStr, tp: mysql.TypeTimestamp, size: 26, flag: mysql.NotNullFlag, comment: "The time these statements are seen for the last time"},
 	{name: stmtsummary.PlanInCacheStr, tp: mysql.TypeTiny, size: 1, flag: mysql.NotNullFlag, comment: "Whether the last statement hit plan cache"},
 	{name: stmtsummary.PlanCacheHitsStr, tp: mysql.TypeLonglong, size: 20, flag: mysql.NotNullFlag, comment: "The number of times these statements hit plan cache"},
+	{name: stmtsummary.PlanCacheMissesStr, tp: mysql.TypeLonglong, size: 20, flag: mysql.NotNullFlag, comment: "The number of times these statements use plan cache but miss it"},
 	{name: stmtsummary.PlanInBindingStr, tp: mysql.TypeTiny, size: 1, flag: mysql.NotNullFlag, comment: "Whether the last statement is matched with the hints in the binding"},
 	{name: stmtsummary.QuerySampleTextStr, tp: mysql.TypeBlob, size: types.UnspecifiedLength, comment: "Sampled original statement"},
 	{name: stmtsummary.PrevSampleTextStr, tp: mysql.TypeBlob, size: types.UnspecifiedLength, comment: "The previous statement before commit"},
@@ -1428,6 +1444,18 @@ var tableRegionLabelCols = []columnInfo{
 	{name: "END_KEY", tp: mysql.TypeBlob, size: types.UnspecifiedLength},
 }
 
+var tableTiDBIndexUsageCols = []columnInfo{
+	{name: "TABLE_SCHEMA", tp: mysql.TypeVarchar, size: 64},
+	{name: "TABLE_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "INDEX_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "TABLE_ID", tp: mysql.TypeLonglong, size: 21},
+	{name: "INDEX_ID", tp: mysql.TypeLonglong, size: 21},
+	{name: "QUERY_COUNT", tp: mysql.TypeLonglong, size: 21, comment: "The number of queries using the index"},
+	{name: "ROWS_SELECTED", tp: mysql.TypeLonglong, size: 21, comment: "The number of rows read from the index"},
+	{name: "LAST_ACCESS_TIME", tp: mysql.TypeDatetime, size: 26, decimal: 6, comment: "The last time the index is used"},
+	{name: "NEVER_USED_SINCE", tp: mysql.TypeDatetime, size: 26, decimal: 6, comment: "The index is not used since this time, NULL if it's used"},
+}
+
 // GetShardingInfo returns a nil or description string for the sharding information of given TableInfo.
 // The returned description string may be:
 //  - "NOT_SHARDED": for tables that SHARD_ROW_ID_BITS is not specified.
@@ -1460,6 +1488,8 @@ const (
 	PrimaryConstraint = "PRIMARY"
 	// UniqueKeyType is the string constant of UNIQUE.
 	UniqueKeyType = "UNIQUE"
+	// CheckConstraintType is the string constant of CHECK.
+	CheckConstraintType = "CHECK"
 )
 
 // ServerInfo represents the basic server information of single cluster component
@@ -1770,7 +1800,7 @@ var tableNameToColumns = map[string][]columnInfo{
 	tableGlobalStatus:                       tableGlobalStatusCols,
 	tableGlobalVariables:                    tableGlobalVariablesCols,
 	tableSessionStatus:                      tableSessionStatusCols,
-	tableOptimizerTrace:                     tableOptimizerTraceCols,
+	TableOptimizerTrace:                     tableOptimizerTraceCols,
 	tableTableSpaces:                        tableTableSpacesCols,
 	TableCollationCharacterSetApplicability: tableCollationCharacterSetApplicabilityCols,
 	TableProcesslist:                        tableProcesslistCols,
@@ -1811,6 +1841,8 @@ var tableNameToColumns = map[string][]columnInfo{
 	TableDeadlocks:                          tableDeadlocksCols,
 	TableDataLockWaits:                      tableDataLockWaitsCols,
 	TableRegionLabel:                        tableRegionLabelCols,
+	TableCheckConstraints:                   tableCheckConstraintsCols,
+	TableTiDBIndexUsage:                     tableTiDBIndexUsageCols,
 }
 
 func createInfoSchemaTable(_ autoid.Allocators, meta *model.TableInfo) (table.Table, error) {
@@ -1863,7 +1895,6 @@ func (it *infoschemaTable) getRows(ctx sessionctx.Context, cols []*table.Column)
 	case tableGlobalStatus:
 	case tableGlobalVariables:
 	case tableSessionStatus:
-	case tableOptimizerTrace:
 	case tableTableSpaces:
 	}
 	if err != nil {
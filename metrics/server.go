@@ -35,6 +35,14 @@ var (
 			Buckets:   prometheus.ExponentialBuckets(4, 4, 21), // 4Bytes ~ 4TB
 		}, []string{LblType})
 
+	PacketIOCompressedBytesCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "tidb",
+			Subsystem: "server",
+			Name:      "packet_io_compression_bytes",
+			Help:      "Counter of packet IO bytes of the connections using protocol compression.",
+		}, []string{LblType, "format"})
+
 	QueryDurationHistogram = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: "tidb",
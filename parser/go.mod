@@ -0,0 +1,19 @@
+module github.com/pingcap/parser
+
+require (
+	github.com/cznic/golex v0.0.0-20181122101858-9c343928389c // indirect
+	github.com/cznic/mathutil v0.0.0-20181122101859-297441e03548
+	github.com/cznic/parser v0.0.0-20160622100904-31edd927e5b1
+	github.com/cznic/sortutil v0.0.0-20181122101858-f5f958428db8
+	github.com/cznic/strutil v0.0.0-20171016134553-529a34b1c186
+	github.com/cznic/y v0.0.0-20170802143616-045f81c6662a
+	github.com/go-sql-driver/mysql v1.3.0
+	github.com/pingcap/check v0.0.0-20190102082844-67f458068fc8
+	github.com/pingcap/errors v0.11.5-0.20210425183316-da1aaba5fb63
+	github.com/pingcap/log v0.0.0-20210625125904-98ed8e2eb1c7
+	github.com/remyoudompheng/bigfft v0.0.0-20200410134404-eec4a21b6bb0 // indirect
+	go.uber.org/zap v1.18.1
+	golang.org/x/text v0.3.6
+)
+
+go 1.13
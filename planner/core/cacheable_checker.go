@@ -18,8 +18,6 @@ import (
 	"github.com/pingcap/tidb/expression"
 	"github.com/pingcap/tidb/infoschema"
 	driver "github.com/pingcap/tidb/types/parser_driver"
-	"github.com/pingcap/tidb/util/logutil"
-	"go.uber.org/zap"
 )
 
 // Cacheable checks whether the input ast is cacheable.
@@ -116,29 +114,10 @@ func (checker *cacheableChecker) Enter(in ast.Node) (out ast.Node, skipChildren
 			checker.cacheable = false
 			return in, true
 		}
-	case *ast.TableName:
-		if checker.schema != nil {
-			if checker.isPartitionTable(node) {
-				checker.cacheable = false
-				return in, true
-			}
-		}
 	}
 	return in, false
 }
 
-func (checker *cacheableChecker) isPartitionTable(tn *ast.TableName) bool {
-	tb, err := checker.schema.TableByName(tn.Schema, tn.Name)
-	if err != nil {
-		logutil.BgLogger().Error("Error occur in checking cacheable", zap.Error(err))
-		return false
-	}
-	if tb.Meta().GetPartitionInfo() != nil {
-		return true
-	}
-	return false
-}
-
 // Leave implements Visitor interface.
 func (checker *cacheableChecker) Leave(in ast.Node) (out ast.Node, ok bool) {
 	return in, checker.cacheable
@@ -14,8 +14,13 @@
 package core_test
 
 import (
+	"fmt"
+	"strings"
+
 	. "github.com/pingcap/check"
+	"github.com/pingcap/parser"
 	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/format"
 	"github.com/pingcap/parser/model"
 	"github.com/pingcap/tidb/expression"
 	"github.com/pingcap/tidb/infoschema"
@@ -227,7 +232,7 @@ func (s *testCacheableSuite) TestCacheable(c *C) {
 	boundExpr := &ast.FrameBound{Expr: &driver.ParamMarkerExpr{}}
 	c.Assert(core.Cacheable(boundExpr, is), IsFalse)
 
-	// Partition table can not be cached.
+	// Partition table can be cached, the partitions are pruned again when the plan is executed.
 	join := &ast.Join{
 		Left:  &ast.TableName{Schema: model.NewCIStr("test"), Name: model.NewCIStr("t1")},
 		Right: &ast.TableName{Schema: model.NewCIStr("test"), Name: model.NewCIStr("t2")},
@@ -237,7 +242,7 @@ func (s *testCacheableSuite) TestCacheable(c *C) {
 			TableRefs: join,
 		},
 	}
-	c.Assert(core.Cacheable(stmt, is), IsFalse)
+	c.Assert(core.Cacheable(stmt, is), IsTrue)
 
 	join = &ast.Join{
 		Left: &ast.TableName{Schema: model.NewCIStr("test"), Name: model.NewCIStr("t3")},
@@ -249,3 +254,51 @@ func (s *testCacheableSuite) TestCacheable(c *C) {
 	}
 	c.Assert(core.Cacheable(stmt, is), IsTrue)
 }
+
+func (s *testCacheableSuite) TestParameterizeAST(c *C) {
+	tests := []struct {
+		sql      string
+		paramSQL string
+		params   []interface{}
+	}{
+		{"select * from t where a = 1 and b > 'x'", "SELECT * FROM `t` WHERE `a`=? AND `b`>?", []interface{}{int64(1), "x"}},
+		{"select a + 1 from t where a in (1, 2) order by 1 limit 10", "SELECT `a`+1 FROM `t` WHERE `a` IN (?,?) ORDER BY 1 LIMIT 10", []interface{}{int64(1), int64(2)}},
+		{"select * from t where a = date_format(b, '%Y') and c = null", "SELECT * FROM `t` WHERE `a`=DATE_FORMAT(`b`, _UTF8MB4'%Y') AND `c`=NULL", nil},
+		{"insert into t values (1, 'a'), (2, null)", "INSERT INTO `t` VALUES (?,?),(?,NULL)", []interface{}{int64(1), "a", int64(2)}},
+		{"update t set a = 2 where b = 3", "UPDATE `t` SET `a`=? WHERE `b`=?", []interface{}{int64(2), int64(3)}},
+		{"delete from t where a between 1 and 2.5", "DELETE FROM `t` WHERE `a` BETWEEN ? AND ?", []interface{}{int64(1), "2.5"}},
+		// The strings with charset introducers are kept.
+		{"select * from t where a = _latin1'x' and b = _binary'y' and c = 'z'", "SELECT * FROM `t` WHERE `a`=_LATIN1'x' AND `b`=_BINARY'y' AND `c`=?", []interface{}{"z"}},
+	}
+	p := parser.New()
+	for _, tt := range tests {
+		stmt, err := p.ParseOneStmt(tt.sql, "", "")
+		c.Assert(err, IsNil)
+		c.Assert(core.NonPreparedPlanCacheable(stmt, nil), IsTrue, Commentf("%s", tt.sql))
+		paramSQL, params, err := core.ParameterizeAST(stmt)
+		c.Assert(err, IsNil)
+		c.Assert(paramSQL, Equals, tt.paramSQL)
+		c.Assert(params, HasLen, len(tt.params), Commentf("%s", tt.sql))
+		for i, param := range params {
+			str, err := param.ToString()
+			c.Assert(err, IsNil)
+			c.Assert(str, Equals, fmt.Sprint(tt.params[i]))
+		}
+		// The statement is restored after parameterized.
+		var sb strings.Builder
+		c.Assert(stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)), IsNil)
+		c.Assert(strings.Contains(sb.String(), "?"), IsFalse, Commentf("%s", sb.String()))
+	}
+
+	for _, sql := range []string{
+		"insert into t select * from t1",
+		"select * from t where a = @a",
+		"select * from t1 union select * from t2",
+		"with cte as (select 1) select * from cte",
+		"set @a = 1",
+	} {
+		stmt, err := p.ParseOneStmt(sql, "", "")
+		c.Assert(err, IsNil)
+		c.Assert(core.NonPreparedPlanCacheable(stmt, nil), IsFalse, Commentf("%s", sql))
+	}
+}
@@ -186,6 +186,7 @@ type Execute struct {
 	UsingVars     []expression.Expression
 	PrepareParams []types.Datum
 	ExecID        uint32
+	PrepStmt      *CachedPrepareStmt
 	SnapshotTS    uint64
 	IsStaleness   bool
 	TxnScope      string
@@ -212,16 +213,19 @@ func isGetVarBinaryLiteral(sctx sessionctx.Context, expr expression.Expression)
 // OptimizePreparedPlan optimizes the prepared statement.
 func (e *Execute) OptimizePreparedPlan(ctx context.Context, sctx sessionctx.Context, is infoschema.InfoSchema) error {
 	vars := sctx.GetSessionVars()
-	if e.Name != "" {
-		e.ExecID = vars.PreparedStmtNameToID[e.Name]
-	}
-	preparedPointer, ok := vars.PreparedStmts[e.ExecID]
-	if !ok {
-		return errors.Trace(ErrStmtNotFound)
-	}
-	preparedObj, ok := preparedPointer.(*CachedPrepareStmt)
-	if !ok {
-		return errors.Errorf("invalid CachedPrepareStmt type")
+	preparedObj := e.PrepStmt
+	if preparedObj == nil {
+		if e.Name != "" {
+			e.ExecID = vars.PreparedStmtNameToID[e.Name]
+		}
+		preparedPointer, ok := vars.PreparedStmts[e.ExecID]
+		if !ok {
+			return errors.Trace(ErrStmtNotFound)
+		}
+		preparedObj, ok = preparedPointer.(*CachedPrepareStmt)
+		if !ok {
+			return errors.Errorf("invalid CachedPrepareStmt type")
+		}
 	}
 	prepared := preparedObj.PreparedAst
 	vars.StmtCtx.StmtType = prepared.StmtType
@@ -464,6 +468,9 @@ func (e *Execute) getPhysicalPlan(ctx context.Context, sctx sessionctx.Context,
 				break
 			}
 		}
+		if ok, err := e.getPlanFromInstanceCache(ctx, sctx, is, preparedStmt, cacheKey, tps); err != nil || ok {
+			return err
+		}
 	}
 
 REBUILD:
@@ -489,27 +496,75 @@ REBUILD:
 		cached := NewPSTMTPlanCacheValue(p, names, stmtCtx.TblInfo2UnionScan, tps)
 		preparedStmt.NormalizedPlan, preparedStmt.PlanDigest = NormalizePlan(p)
 		stmtCtx.SetPlanDigest(preparedStmt.NormalizedPlan, preparedStmt.PlanDigest)
-		if cacheVals, exists := sctx.PreparedPlanCache().Get(cacheKey); exists {
-			hitVal := false
-			for i, cacheVal := range cacheVals.([]*PSTMTPlanCacheValue) {
-				if cacheVal.UserVarTypes.Equal(tps) {
-					hitVal = true
-					cacheVals.([]*PSTMTPlanCacheValue)[i] = cached
-					break
-				}
-			}
-			if !hitVal {
-				cacheVals = append(cacheVals.([]*PSTMTPlanCacheValue), cached)
-			}
-			sctx.PreparedPlanCache().Put(cacheKey, cacheVals)
-		} else {
-			sctx.PreparedPlanCache().Put(cacheKey, []*PSTMTPlanCacheValue{cached})
+		putPlanIntoSessionCache(sctx, cacheKey, cached, tps)
+		if c := GetInstancePlanCache(); c != nil && instancePlanCacheable(sctx, prepared.Stmt) {
+			c.put(newInstancePlanCacheKey(sctx, prepared), cached, preparedStmt.NormalizedPlan, preparedStmt.PlanDigest)
 		}
 	}
 	err = e.setFoundInPlanCache(sctx, false)
 	return err
 }
 
+func putPlanIntoSessionCache(sctx sessionctx.Context, cacheKey kvcache.Key, cached *PSTMTPlanCacheValue, tps []*types.FieldType) {
+	if cacheVals, exists := sctx.PreparedPlanCache().Get(cacheKey); exists {
+		hitVal := false
+		for i, cacheVal := range cacheVals.([]*PSTMTPlanCacheValue) {
+			if cacheVal.UserVarTypes.Equal(tps) {
+				hitVal = true
+				cacheVals.([]*PSTMTPlanCacheValue)[i] = cached
+				break
+			}
+		}
+		if !hitVal {
+			cacheVals = append(cacheVals.([]*PSTMTPlanCacheValue), cached)
+		}
+		sctx.PreparedPlanCache().Put(cacheKey, cacheVals)
+	} else {
+		sctx.PreparedPlanCache().Put(cacheKey, []*PSTMTPlanCacheValue{cached})
+	}
+}
+
+// getPlanFromInstanceCache gets the plan from the instance plan cache, the plan
+// found is put into the session plan cache as well to avoid cloning it again.
+func (e *Execute) getPlanFromInstanceCache(ctx context.Context, sctx sessionctx.Context, is infoschema.InfoSchema,
+	preparedStmt *CachedPrepareStmt, cacheKey kvcache.Key, tps []*types.FieldType) (bool, error) {
+	c := GetInstancePlanCache()
+	prepared := preparedStmt.PreparedAst
+	if c == nil || !instancePlanCacheable(sctx, prepared.Stmt) {
+		return false, nil
+	}
+	entry, ok := c.get(sctx, newInstancePlanCacheKey(sctx, prepared))
+	if !ok {
+		return false, nil
+	}
+	if err := e.checkPreparedPriv(ctx, sctx, preparedStmt, is); err != nil {
+		return false, err
+	}
+	for tblInfo, unionScan := range entry.value.TblInfo2UnionScan {
+		if !unionScan && tableHasDirtyContent(sctx, tblInfo) {
+			return false, nil
+		}
+	}
+	if err := e.rebuildRange(entry.value.Plan); err != nil {
+		logutil.BgLogger().Debug("rebuild range failed", zap.Error(err))
+		return false, nil
+	}
+	if err := e.setFoundInPlanCache(sctx, true); err != nil {
+		return false, err
+	}
+	if metrics.ResettablePlanCacheCounterFortTest {
+		metrics.PlanCacheCounter.WithLabelValues("prepare").Inc()
+	} else {
+		planCacheCounter.Inc()
+	}
+	e.names = entry.value.OutPutNames
+	e.Plan = entry.value.Plan
+	preparedStmt.NormalizedPlan, preparedStmt.PlanDigest = entry.normalizedPlan, entry.planDigest
+	sctx.GetSessionVars().StmtCtx.SetPlanDigest(preparedStmt.NormalizedPlan, preparedStmt.PlanDigest)
+	putPlanIntoSessionCache(sctx, cacheKey, NewPSTMTPlanCacheValue(entry.value.Plan, entry.value.OutPutNames, entry.value.TblInfo2UnionScan, tps), tps)
+	return true, nil
+}
+
 // tryCachePointPlan will try to cache point execution plan, there may be some
 // short paths for these executions, currently "point select" and "point update"
 func (e *Execute) tryCachePointPlan(ctx context.Context, sctx sessionctx.Context,
@@ -563,6 +618,9 @@ func (e *Execute) rebuildRange(p Plan) error {
 				if err != nil {
 					return err
 				}
+				if len(res.AccessConds) != len(ts.AccessCondition) {
+					return errors.New("rebuild range: some access conditions are not used to build the ranges")
+				}
 				ts.Ranges = res.Ranges
 			} else {
 				ts.Ranges = ranger.FullRange()
@@ -622,12 +680,6 @@ func (e *Execute) rebuildRange(p Plan) error {
 				}
 			}
 		}
-		// The code should never run here as long as we're not using point get for partition table.
-		// And if we change the logic one day, here work as defensive programming to cache the error.
-		if x.PartitionInfo != nil {
-			// TODO: relocate the partition after rebuilding range to make PlanCache support PointGet
-			return errors.New("point get for partition table can not use plan cache")
-		}
 		if x.HandleParam != nil {
 			var iv int64
 			iv, err = x.HandleParam.Datum.ToInt64(sc)
@@ -635,13 +687,17 @@ func (e *Execute) rebuildRange(p Plan) error {
 				return err
 			}
 			x.Handle = kv.IntHandle(iv)
-			return nil
 		}
 		for i, param := range x.IndexValueParams {
 			if param != nil {
 				x.IndexValues[i] = param.Datum
 			}
 		}
+		// The partition of the point get built by cbo is pruned by the optimizer, the plan isn't
+		// cached if the partition is pruned by the parameters.
+		if x.PartitionInfo != nil && x.AccessConditions == nil {
+			return x.relocatePartition()
+		}
 		return nil
 	case *BatchPointGetPlan:
 		// if access condition is not nil, which means it's a point get generated by cbo.
@@ -725,6 +781,11 @@ func (e *Execute) buildRangeForIndexScan(sctx sessionctx.Context, is *PhysicalIn
 	if err != nil {
 		return nil, err
 	}
+	// The access conditions which can't be used to build the ranges with the current
+	// parameters have no filters in the cached plan, so the plan is rebuilt.
+	if len(res.AccessConds) != len(is.AccessCondition) {
+		return nil, errors.New("rebuild range: some access conditions are not used to build the ranges")
+	}
 	return res.Ranges, nil
 }
 
@@ -2729,3 +2729,13 @@ func (p *LogicalMaxOneRow) exhaustPhysicalPlans(prop *property.PhysicalProperty)
 	mor := PhysicalMaxOneRow{}.Init(p.ctx, p.stats, p.blockOffset, &property.PhysicalProperty{ExpectedCnt: 2})
 	return []PhysicalPlan{mor}, true, nil
 }
+
+func (p *LogicalExpand) exhaustPhysicalPlans(prop *property.PhysicalProperty) ([]PhysicalPlan, bool, error) {
+	if !prop.IsEmpty() || prop.IsFlashProp() {
+		p.SCtx().GetSessionVars().RaiseWarningWhenMPPEnforced("MPP mode may be blocked because operator `Expand` is not supported now.")
+		return nil, true, nil
+	}
+	expand := PhysicalExpand{LevelExprs: p.LevelExprs}.Init(p.ctx, p.stats.ScaleByExpectCnt(prop.ExpectedCnt), p.blockOffset, &property.PhysicalProperty{ExpectedCnt: math.MaxFloat64})
+	expand.SetSchema(p.schema)
+	return []PhysicalPlan{expand}, true, nil
+}
@@ -500,6 +500,25 @@ func (p *PhysicalProjection) ExplainNormalizedInfo() string {
 	return string(expression.SortedExplainNormalizedExpressionList(p.Exprs))
 }
 
+// ExplainInfo implements Plan interface.
+func (p *PhysicalExpand) ExplainInfo() string {
+	buffer := bytes.NewBufferString("level:")
+	for i, exprs := range p.LevelExprs {
+		if i > 0 {
+			buffer.WriteString(", ")
+		}
+		buffer.WriteString("[")
+		for j, expr := range exprs {
+			if j > 0 {
+				buffer.WriteString(", ")
+			}
+			buffer.WriteString(expr.ExplainInfo())
+		}
+		buffer.WriteString("]")
+	}
+	return buffer.String()
+}
+
 // ExplainInfo implements Plan interface.
 func (p *PhysicalTableDual) ExplainInfo() string {
 	var str strings.Builder
@@ -508,6 +527,16 @@ func (p *PhysicalTableDual) ExplainInfo() string {
 	return str.String()
 }
 
+// ExplainInfo implements Plan interface.
+func (p *PhysicalJSONTable) ExplainInfo() string {
+	return fmt.Sprintf("doc:%s, path:%s", p.Expr.ExplainInfo(), p.Path)
+}
+
+// ExplainNormalizedInfo implements Plan interface.
+func (p *PhysicalJSONTable) ExplainNormalizedInfo() string {
+	return fmt.Sprintf("doc:%s, path:%s", p.Expr.ExplainNormalizedInfo(), p.Path)
+}
+
 // ExplainInfo implements Plan interface.
 func (p *PhysicalSort) ExplainInfo() string {
 	buffer := bytes.NewBufferString("")
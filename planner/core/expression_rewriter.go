@@ -427,6 +427,10 @@ func (er *expressionRewriter) Enter(inNode ast.Node) (ast.Node, bool) {
 		er.ctxStackAppend(er.schema.Columns[index], er.names[index])
 		return inNode, true
 	case *ast.FuncCallExpr:
+		if index, ok := er.b.groupingMapper[v]; ok {
+			er.ctxStackAppend(er.schema.Columns[index], er.names[index])
+			return inNode, true
+		}
 		er.asScalar = true
 		if _, ok := expression.DisableFoldFunctions[v.FnName.L]; ok {
 			er.disableFoldCounter++
@@ -1058,6 +1062,9 @@ func (er *expressionRewriter) Leave(originInNode ast.Node) (retNode ast.Node, ok
 	case *ast.VariableExpr:
 		er.rewriteVariable(v)
 	case *ast.FuncCallExpr:
+		if _, ok := er.b.groupingMapper[v]; ok {
+			break
+		}
 		if _, ok := expression.TryFoldFunctions[v.FnName.L]; ok {
 			er.tryFoldCounter--
 		}
@@ -1664,6 +1671,9 @@ func (er *expressionRewriter) betweenToExpression(v *ast.BetweenExpr) {
 // Otherwise it should return false to indicate (the caller) that original behavior needs to be performed.
 func (er *expressionRewriter) rewriteFuncCall(v *ast.FuncCallExpr) bool {
 	switch v.FnName.L {
+	case expression.Grouping:
+		er.rewriteGrouping(v)
+		return true
 	// when column is not null, ifnull on such column is not necessary.
 	case ast.Ifnull:
 		if len(v.Args) != 2 {
@@ -1754,6 +1764,49 @@ func (er *expressionRewriter) funcCallToExpression(v *ast.FuncCallExpr) {
 	}
 }
 
+// rewriteGrouping rewrites GROUPING(col1, col2, ...) to grouping(gid, level1, level2, ...). The
+// gid is the grouping id generated by Expand, and level_i is the grouping id of the first grouping
+// set in which col_i is rolled up.
+func (er *expressionRewriter) rewriteGrouping(v *ast.FuncCallExpr) {
+	stackLen := len(er.ctxStack)
+	args := er.ctxStack[stackLen-len(v.Args):]
+	if er.b == nil || er.b.rollupExpand == nil {
+		er.err = ErrInvalidGroupFuncUse
+		return
+	}
+	expand := er.b.rollupExpand
+	gid := er.schema.RetrieveColumn(expand.GroupingID)
+	if gid == nil {
+		er.err = ErrInvalidGroupFuncUse
+		return
+	}
+	newArgs := make([]expression.Expression, 0, len(args)+1)
+	newArgs = append(newArgs, gid)
+	for _, arg := range args {
+		level := -1
+		if col, ok := arg.(*expression.Column); ok {
+			for i, groupingCol := range expand.GroupingCols {
+				if groupingCol.UniqueID == col.UniqueID {
+					level = len(expand.GroupingCols) - i
+					break
+				}
+			}
+		}
+		if level < 0 {
+			er.err = ErrInvalidGroupFuncUse
+			return
+		}
+		newArgs = append(newArgs, &expression.Constant{Value: types.NewIntDatum(int64(level)), RetType: types.NewFieldType(mysql.TypeLonglong)})
+	}
+	er.ctxStackPop(len(v.Args))
+	function, err := er.newFunction(expression.Grouping, &v.Type, newArgs...)
+	if err != nil {
+		er.err = err
+		return
+	}
+	er.ctxStackAppend(function, types.EmptyName)
+}
+
 // Now TableName in expression only used by sequence function like nextval(seq).
 // The function arg should be evaluated as a table name rather than normal column name like mysql does.
 func (er *expressionRewriter) toTable(v *ast.TableName) {
@@ -35,6 +35,7 @@ import (
 	"github.com/pingcap/tidb/util/logutil"
 	"github.com/pingcap/tidb/util/ranger"
 	"github.com/pingcap/tidb/util/set"
+	"github.com/pingcap/tidb/util/tracing"
 	"go.uber.org/zap"
 	"golang.org/x/tools/container/intsets"
 )
@@ -160,6 +161,16 @@ func (p *LogicalShow) findBestTask(prop *property.PhysicalProperty, planCounter
 	return &rootTask{p: pShow}, 1, nil
 }
 
+func (p *LogicalJSONTable) findBestTask(prop *property.PhysicalProperty, planCounter *PlanCounterTp) (task, int64, error) {
+	if !prop.IsEmpty() || planCounter.Empty() {
+		return invalidTask, 0, nil
+	}
+	jt := PhysicalJSONTable{Expr: p.Expr, Path: p.Path, Columns: p.Columns}.Init(p.ctx, p.stats, p.blockOffset)
+	jt.SetSchema(p.schema)
+	planCounter.Dec(1)
+	return &rootTask{p: jt}, 1, nil
+}
+
 func (p *LogicalShowDDLJobs) findBestTask(prop *property.PhysicalProperty, planCounter *PlanCounterTp) (task, int64, error) {
 	if !prop.IsEmpty() || planCounter.Empty() {
 		return invalidTask, 0, nil
@@ -202,7 +213,7 @@ func (p *baseLogicalPlan) rebuildChildTasks(childTasks *[]task, pp PhysicalPlan,
 	return nil
 }
 
-func (p *baseLogicalPlan) enumeratePhysicalPlans4Task(physicalPlans []PhysicalPlan, prop *property.PhysicalProperty, addEnforcer bool, planCounter *PlanCounterTp) (task, int64, error) {
+func (p *baseLogicalPlan) enumeratePhysicalPlans4Task(physicalPlans []PhysicalPlan, prop *property.PhysicalProperty, addEnforcer bool, planCounter *PlanCounterTp, trace *tracing.PhysicalPlanTrace) (task, int64, error) {
 	var bestTask task = invalidTask
 	var curCntPlan, cntPlan int64
 	childTasks := make([]task, 0, len(p.children))
@@ -265,6 +276,7 @@ func (p *baseLogicalPlan) enumeratePhysicalPlans4Task(physicalPlans []PhysicalPl
 			// Currently, we do not regard shuffled plan as a new plan.
 			curTask = optimizeByShuffle(curTask, p.basePlan.ctx)
 		}
+		appendCandidateTask(trace, curTask)
 
 		cntPlan += curCntPlan
 		planCounter.Dec(curCntPlan)
@@ -350,9 +362,19 @@ func (p *baseLogicalPlan) findBestTask(prop *property.PhysicalProperty, planCoun
 		newProp = prop
 	}
 
+	var trace *tracing.PhysicalPlanTrace
+	if tracer := p.ctx.GetSessionVars().StmtCtx.OptimizeTracer; tracer != nil {
+		trace = &tracing.PhysicalPlanTrace{LogicalPlan: p.self.ExplainID().String(), Property: prop.String()}
+		defer func() {
+			if err == nil && !bestTask.invalid() {
+				trace.Best = &tracing.CandidateTrace{Plan: ToString(bestTask.plan()), Cost: bestTask.cost()}
+			}
+			tracer.AppendPhysicalPlan(trace)
+		}()
+	}
 	var cnt int64
 	var curTask task
-	if bestTask, cnt, err = p.enumeratePhysicalPlans4Task(plansFitsProp, newProp, false, planCounter); err != nil {
+	if bestTask, cnt, err = p.enumeratePhysicalPlans4Task(plansFitsProp, newProp, false, planCounter, trace); err != nil {
 		return nil, 0, err
 	}
 	cntPlan += cnt
@@ -360,7 +382,7 @@ func (p *baseLogicalPlan) findBestTask(prop *property.PhysicalProperty, planCoun
 		goto END
 	}
 
-	curTask, cnt, err = p.enumeratePhysicalPlans4Task(plansNeedEnforce, newProp, true, planCounter)
+	curTask, cnt, err = p.enumeratePhysicalPlans4Task(plansNeedEnforce, newProp, true, planCounter, trace)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -647,38 +669,15 @@ func (ds *DataSource) getPruningInfo(candidates []*candidatePath, prop *property
 		return ""
 	}
 	names := make([]string, 0, len(candidates))
-	var tableName string
-	if ds.TableAsName.O == "" {
-		tableName = ds.tableInfo.Name.O
-	} else {
-		tableName = ds.TableAsName.O
-	}
-	getSimplePathName := func(path *util.AccessPath) string {
-		if path.IsTablePath() {
-			if path.StoreType == kv.TiFlash {
-				return tableName + "(tiflash)"
-			}
-			return tableName
-		}
-		return path.Index.Name.O
-	}
 	for _, cand := range candidates {
-		if cand.path.PartialIndexPaths != nil {
-			partialNames := make([]string, 0, len(cand.path.PartialIndexPaths))
-			for _, partialPath := range cand.path.PartialIndexPaths {
-				partialNames = append(partialNames, getSimplePathName(partialPath))
-			}
-			names = append(names, fmt.Sprintf("IndexMerge{%s}", strings.Join(partialNames, ",")))
-		} else {
-			names = append(names, getSimplePathName(cand.path))
-		}
+		names = append(names, ds.accessPathName(cand.path))
 	}
 	items := make([]string, 0, len(prop.SortItems))
 	for _, item := range prop.SortItems {
 		items = append(items, item.String())
 	}
 	return fmt.Sprintf("[%s] remain after pruning paths for %s given Prop{SortItems: [%s], TaskTp: %s}",
-		strings.Join(names, ","), tableName, strings.Join(items, " "), prop.TaskTp)
+		strings.Join(names, ","), ds.tableAsName(), strings.Join(items, " "), prop.TaskTp)
 }
 
 func (ds *DataSource) isPointGetConvertableSchema() bool {
@@ -691,6 +690,56 @@ func (ds *DataSource) isPointGetConvertableSchema() bool {
 	return true
 }
 
+func (ds *DataSource) tableAsName() string {
+	if ds.TableAsName.O == "" {
+		return ds.tableInfo.Name.O
+	}
+	return ds.TableAsName.O
+}
+
+// accessPathName returns the simple name of the access path used in the pruning info and the optimizer trace.
+func (ds *DataSource) accessPathName(path *util.AccessPath) string {
+	if path.PartialIndexPaths != nil {
+		partialNames := make([]string, 0, len(path.PartialIndexPaths))
+		for _, partialPath := range path.PartialIndexPaths {
+			partialNames = append(partialNames, ds.accessPathName(partialPath))
+		}
+		return fmt.Sprintf("IndexMerge{%s}", strings.Join(partialNames, ","))
+	}
+	if path.IsTablePath() {
+		if path.StoreType == kv.TiFlash {
+			return ds.tableAsName() + "(tiflash)"
+		}
+		return ds.tableAsName()
+	}
+	return path.Index.Name.O
+}
+
+// prunedPathNames returns the names of the access paths pruned by skylinePruning.
+func (ds *DataSource) prunedPathNames(candidates []*candidatePath) []string {
+	var names []string
+	for _, path := range ds.possibleAccessPaths {
+		pruned := true
+		for _, cand := range candidates {
+			if cand.path == path {
+				pruned = false
+				break
+			}
+		}
+		if pruned {
+			names = append(names, ds.accessPathName(path))
+		}
+	}
+	return names
+}
+
+// appendCandidateTask records a valid candidate task in the optimizer trace, trace may be nil.
+func appendCandidateTask(trace *tracing.PhysicalPlanTrace, t task) {
+	if trace != nil && !t.invalid() {
+		trace.AppendCandidate(ToString(t.plan()), t.cost())
+	}
+}
+
 // findBestTask implements the PhysicalPlan interface.
 // It will enumerate all the available indices and choose a plan with least cost.
 func (ds *DataSource) findBestTask(prop *property.PhysicalProperty, planCounter *PlanCounterTp) (t task, cntPlan int64, err error) {
@@ -757,6 +806,20 @@ func (ds *DataSource) findBestTask(prop *property.PhysicalProperty, planCounter
 	t = invalidTask
 	candidates := ds.skylinePruning(prop)
 	pruningInfo := ds.getPruningInfo(candidates, prop)
+	var trace *tracing.PhysicalPlanTrace
+	if tracer := ds.ctx.GetSessionVars().StmtCtx.OptimizeTracer; tracer != nil {
+		trace = &tracing.PhysicalPlanTrace{
+			LogicalPlan: ds.ExplainID().String(),
+			Property:    prop.String(),
+			PrunedPaths: ds.prunedPathNames(candidates),
+		}
+		defer func() {
+			if err == nil && t != nil && !t.invalid() {
+				trace.Best = &tracing.CandidateTrace{Plan: ToString(t.plan()), Cost: t.cost()}
+			}
+			tracer.AppendPhysicalPlan(trace)
+		}()
+	}
 	defer func() {
 		if err == nil && t != nil && !t.invalid() && pruningInfo != "" {
 			if ds.ctx.GetSessionVars().StmtCtx.OptimInfo == nil {
@@ -774,6 +837,7 @@ func (ds *DataSource) findBestTask(prop *property.PhysicalProperty, planCounter
 			if err != nil {
 				return nil, 0, err
 			}
+			appendCandidateTask(trace, idxMergeTask)
 			if !idxMergeTask.invalid() {
 				cntPlan += 1
 				planCounter.Dec(1)
@@ -851,6 +915,7 @@ func (ds *DataSource) findBestTask(prop *property.PhysicalProperty, planCounter
 				} else {
 					pointGetTask = ds.convertToBatchPointGet(prop, candidate, hashPartColName)
 				}
+				appendCandidateTask(trace, pointGetTask)
 				if !pointGetTask.invalid() {
 					cntPlan += 1
 					planCounter.Dec(1)
@@ -880,6 +945,7 @@ func (ds *DataSource) findBestTask(prop *property.PhysicalProperty, planCounter
 			if err != nil {
 				return nil, 0, err
 			}
+			appendCandidateTask(trace, tblTask)
 			if !tblTask.invalid() {
 				cntPlan += 1
 				planCounter.Dec(1)
@@ -900,6 +966,7 @@ func (ds *DataSource) findBestTask(prop *property.PhysicalProperty, planCounter
 		if err != nil {
 			return nil, 0, err
 		}
+		appendCandidateTask(trace, idxTask)
 		if !idxTask.invalid() {
 			cntPlan += 1
 			planCounter.Dec(1)
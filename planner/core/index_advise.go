@@ -0,0 +1,382 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/model"
+	"github.com/pingcap/parser/mysql"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/planner/util"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
+	utilhint "github.com/pingcap/tidb/util/hint"
+	"github.com/pingcap/tidb/util/stringutil"
+)
+
+// HypoIndexesKey is used to get the hypothetical indexes which are only visible to the optimizer.
+const HypoIndexesKey = stringutil.StringerStr("hypoIndexesKey")
+
+// HypoIndexes maps the table ID to the hypothetical indexes of the table. The hypothetical
+// indexes are used as access path candidates, but no index data exists for them, so a plan
+// using them must never be executed.
+type HypoIndexes map[int64][]*model.IndexInfo
+
+// maxIndexCandidateColumns is the max number of columns of a composite index candidate.
+const maxIndexCandidateColumns = 3
+
+func getHypoIndexes(ctx sessionctx.Context, tblID int64) []*model.IndexInfo {
+	hypoIndexes, ok := ctx.Value(HypoIndexesKey).(HypoIndexes)
+	if !ok {
+		return nil
+	}
+	return hypoIndexes[tblID]
+}
+
+func isHypoIndex(ctx sessionctx.Context, tblID int64, idx *model.IndexInfo) bool {
+	for _, hypoIdx := range getHypoIndexes(ctx, tblID) {
+		if hypoIdx == idx {
+			return true
+		}
+	}
+	return false
+}
+
+// getHypoIndexRowCount estimates the row count of the access conditions of the hypothetical index path.
+// There are no statistics for the hypothetical index, so the column statistics are used instead.
+func (ds *DataSource) getHypoIndexRowCount(path *util.AccessPath) (float64, error) {
+	selectivity, _, err := ds.tableStats.HistColl.Selectivity(ds.ctx, path.AccessConds, nil)
+	if err != nil {
+		return 0, err
+	}
+	return float64(ds.statisticTable.Count) * selectivity, nil
+}
+
+// IndexCandidate is an index which may speed up a statement if it's created.
+type IndexCandidate struct {
+	DBName  model.CIStr
+	Table   *model.TableInfo
+	Columns []*model.ColumnInfo
+}
+
+// Key returns the string which identifies the candidate.
+func (c *IndexCandidate) Key() string {
+	names := make([]string, 0, len(c.Columns))
+	for _, col := range c.Columns {
+		names = append(names, col.Name.L)
+	}
+	return c.DBName.L + "." + c.Table.Name.L + "(" + strings.Join(names, ",") + ")"
+}
+
+// NewHypoIndex builds a hypothetical index of the candidate with the given name.
+func (c *IndexCandidate) NewHypoIndex(name string) *model.IndexInfo {
+	idxCols := make([]*model.IndexColumn, 0, len(c.Columns))
+	for _, col := range c.Columns {
+		idxCols = append(idxCols, &model.IndexColumn{
+			Name:   col.Name,
+			Offset: col.Offset,
+			Length: types.UnspecifiedLength,
+		})
+	}
+	return &model.IndexInfo{
+		// The ID is not allocated yet, so no statistics could be found for the hypothetical index.
+		ID:      c.Table.MaxIndexID + 1,
+		Name:    model.NewCIStr(name),
+		Table:   c.Table.Name,
+		Columns: idxCols,
+		State:   model.StatePublic,
+		Tp:      model.IndexTypeBtree,
+	}
+}
+
+// coveredByExistingIndex checks whether the candidate columns are a prefix of an existing index.
+func (c *IndexCandidate) coveredByExistingIndex() bool {
+	if c.Table.PKIsHandle && mysql.HasPriKeyFlag(c.Columns[0].Flag) {
+		return true
+	}
+	for _, idx := range c.Table.Indices {
+		if idx.State != model.StatePublic || len(idx.Columns) < len(c.Columns) {
+			continue
+		}
+		covered := true
+		for i, col := range c.Columns {
+			if idx.Columns[i].Name.L != col.Name.L || idx.Columns[i].Length != types.UnspecifiedLength {
+				covered = false
+				break
+			}
+		}
+		if covered {
+			return true
+		}
+	}
+	return false
+}
+
+// dsCandidateInfo collects the columns of a DataSource which may benefit from an index.
+type dsCandidateInfo struct {
+	ds        *DataSource
+	eqCols    []*model.ColumnInfo
+	rangeCols []*model.ColumnInfo
+}
+
+type indexCandidateExtractor struct {
+	dsInfos []*dsCandidateInfo
+	// colMap maps the unique ID of a column to its DataSource and column info.
+	colMap map[int64]*candidateColumn
+	// projMap maps the unique ID of a projected column to the column it's projected from.
+	projMap    map[int64]*expression.Column
+	candidates []*IndexCandidate
+	keys       map[string]struct{}
+}
+
+type candidateColumn struct {
+	info *dsCandidateInfo
+	col  *model.ColumnInfo
+}
+
+// ExtractIndexCandidates builds the logical plan of the node and enumerates the index candidates
+// from the predicates, the join keys and the ORDER BY items of the statement.
+func ExtractIndexCandidates(ctx context.Context, sctx sessionctx.Context, node ast.Node, is infoschema.InfoSchema) ([]*IndexCandidate, error) {
+	sctx.GetSessionVars().PlanID = 0
+	sctx.GetSessionVars().PlanColumnID = 0
+	builder, _ := NewPlanBuilder().Init(sctx, is, &utilhint.BlockHintProcessor{})
+	p, err := builder.Build(ctx, node)
+	if err != nil {
+		return nil, err
+	}
+	logic, ok := p.(LogicalPlan)
+	if !ok {
+		return nil, nil
+	}
+	logic, err = logicalOptimize(ctx, builder.GetOptFlag()|flagPredicatePushDown, logic)
+	if err != nil {
+		return nil, err
+	}
+	e := &indexCandidateExtractor{
+		colMap:  make(map[int64]*candidateColumn),
+		projMap: make(map[int64]*expression.Column),
+		keys:    make(map[string]struct{}),
+	}
+	e.collectDataSources(logic)
+	for _, info := range e.dsInfos {
+		e.addPredicateCandidates(info)
+	}
+	e.collectJoinAndOrderCandidates(logic)
+	return e.candidates, nil
+}
+
+func (e *indexCandidateExtractor) collectDataSources(p LogicalPlan) {
+	switch x := p.(type) {
+	case *DataSource:
+		info := &dsCandidateInfo{ds: x}
+		for i, col := range x.schema.Columns {
+			e.colMap[col.UniqueID] = &candidateColumn{info: info, col: x.Columns[i]}
+		}
+		for _, cond := range x.pushedDownConds {
+			e.classifyCondition(info, cond)
+		}
+		e.dsInfos = append(e.dsInfos, info)
+	case *LogicalProjection:
+		for i, expr := range x.Exprs {
+			if col, ok := expr.(*expression.Column); ok {
+				e.projMap[x.schema.Columns[i].UniqueID] = col
+			}
+		}
+	}
+	for _, child := range p.Children() {
+		e.collectDataSources(child)
+	}
+}
+
+// resolveColumn finds the table column which the column of the plan is derived from.
+func (e *indexCandidateExtractor) resolveColumn(expr expression.Expression) *candidateColumn {
+	col, ok := expr.(*expression.Column)
+	if !ok {
+		return nil
+	}
+	for {
+		if cc, ok := e.colMap[col.UniqueID]; ok {
+			return cc
+		}
+		projected, ok := e.projMap[col.UniqueID]
+		if !ok || projected.UniqueID == col.UniqueID {
+			return nil
+		}
+		col = projected
+	}
+}
+
+// classifyCondition records the column of the condition if the condition can be used to build
+// the access ranges of an index.
+func (e *indexCandidateExtractor) classifyCondition(info *dsCandidateInfo, cond expression.Expression) {
+	sf, ok := cond.(*expression.ScalarFunction)
+	if !ok {
+		return
+	}
+	args := sf.GetArgs()
+	switch sf.FuncName.L {
+	case ast.EQ, ast.NullEQ, ast.LT, ast.LE, ast.GT, ast.GE:
+		col, isCol := args[0].(*expression.Column)
+		other := args[1]
+		if !isCol {
+			col, isCol = args[1].(*expression.Column)
+			other = args[0]
+		}
+		if !isCol || len(expression.ExtractColumns(other)) > 0 {
+			return
+		}
+		if sf.FuncName.L == ast.EQ || sf.FuncName.L == ast.NullEQ {
+			info.eqCols = e.appendColumn(info, info.eqCols, col)
+		} else {
+			info.rangeCols = e.appendColumn(info, info.rangeCols, col)
+		}
+	case ast.In:
+		col, isCol := args[0].(*expression.Column)
+		if !isCol {
+			return
+		}
+		for _, arg := range args[1:] {
+			if len(expression.ExtractColumns(arg)) > 0 {
+				return
+			}
+		}
+		info.eqCols = e.appendColumn(info, info.eqCols, col)
+	case ast.IsNull:
+		if col, isCol := args[0].(*expression.Column); isCol {
+			info.eqCols = e.appendColumn(info, info.eqCols, col)
+		}
+	case ast.Like:
+		col, isCol := args[0].(*expression.Column)
+		pattern, isConst := args[1].(*expression.Constant)
+		if !isCol || !isConst || pattern.Value.IsNull() {
+			return
+		}
+		if str := pattern.Value.GetString(); len(str) == 0 || str[0] == '%' || str[0] == '_' {
+			return
+		}
+		info.rangeCols = e.appendColumn(info, info.rangeCols, col)
+	}
+}
+
+func (e *indexCandidateExtractor) appendColumn(info *dsCandidateInfo, cols []*model.ColumnInfo, col *expression.Column) []*model.ColumnInfo {
+	cc, ok := e.colMap[col.UniqueID]
+	if !ok || cc.info != info {
+		return cols
+	}
+	for _, c := range cols {
+		if c.ID == cc.col.ID {
+			return cols
+		}
+	}
+	return append(cols, cc.col)
+}
+
+func (e *indexCandidateExtractor) addPredicateCandidates(info *dsCandidateInfo) {
+	for _, col := range info.eqCols {
+		e.addCandidate(info, []*model.ColumnInfo{col})
+	}
+	for _, col := range info.rangeCols {
+		e.addCandidate(info, []*model.ColumnInfo{col})
+	}
+	eqCols := info.eqCols
+	if len(eqCols) >= maxIndexCandidateColumns {
+		eqCols = eqCols[:maxIndexCandidateColumns-1]
+	}
+	if len(eqCols) > 1 {
+		e.addCandidate(info, eqCols)
+	}
+	if len(eqCols) > 0 {
+		for _, col := range info.rangeCols {
+			e.addCandidate(info, appendCandidateColumns(eqCols, col))
+		}
+	}
+}
+
+func (e *indexCandidateExtractor) collectJoinAndOrderCandidates(p LogicalPlan) {
+	switch x := p.(type) {
+	case *LogicalJoin:
+		for _, cond := range x.EqualConditions {
+			for _, arg := range cond.GetArgs() {
+				if cc := e.resolveColumn(arg); cc != nil {
+					e.addCandidate(cc.info, []*model.ColumnInfo{cc.col})
+					if len(cc.info.eqCols) > 0 && len(cc.info.eqCols) < maxIndexCandidateColumns {
+						e.addCandidate(cc.info, appendCandidateColumns(cc.info.eqCols, cc.col))
+					}
+				}
+			}
+		}
+	case *LogicalSort:
+		e.addOrderCandidates(x.ByItems)
+	case *LogicalTopN:
+		e.addOrderCandidates(x.ByItems)
+	}
+	for _, child := range p.Children() {
+		e.collectJoinAndOrderCandidates(child)
+	}
+}
+
+// addOrderCandidates adds the index candidates which keep the order of the items, it only works
+// when all the items are columns of the same table and have the same direction.
+func (e *indexCandidateExtractor) addOrderCandidates(byItems []*util.ByItems) {
+	if len(byItems) == 0 || len(byItems) > maxIndexCandidateColumns {
+		return
+	}
+	var info *dsCandidateInfo
+	cols := make([]*model.ColumnInfo, 0, len(byItems))
+	for _, item := range byItems {
+		cc := e.resolveColumn(item.Expr)
+		if cc == nil || (info != nil && cc.info != info) || item.Desc != byItems[0].Desc {
+			return
+		}
+		info = cc.info
+		cols = append(cols, cc.col)
+	}
+	e.addCandidate(info, cols)
+	if len(info.eqCols) > 0 && len(info.eqCols)+len(cols) <= maxIndexCandidateColumns {
+		e.addCandidate(info, appendCandidateColumns(info.eqCols, cols...))
+	}
+}
+
+func (e *indexCandidateExtractor) addCandidate(info *dsCandidateInfo, cols []*model.ColumnInfo) {
+	seen := make(map[int64]struct{}, len(cols))
+	for _, col := range cols {
+		if _, ok := seen[col.ID]; ok || col.ID == model.ExtraHandleID {
+			return
+		}
+		if col.Tp == mysql.TypeJSON || types.IsTypeBlob(col.Tp) {
+			return
+		}
+		seen[col.ID] = struct{}{}
+	}
+	candidate := &IndexCandidate{DBName: info.ds.DBName, Table: info.ds.tableInfo, Columns: cols}
+	if candidate.coveredByExistingIndex() {
+		return
+	}
+	key := candidate.Key()
+	if _, ok := e.keys[key]; ok {
+		return
+	}
+	e.keys[key] = struct{}{}
+	e.candidates = append(e.candidates, candidate)
+}
+
+func appendCandidateColumns(prefix []*model.ColumnInfo, cols ...*model.ColumnInfo) []*model.ColumnInfo {
+	result := make([]*model.ColumnInfo, 0, len(prefix)+len(cols))
+	result = append(result, prefix...)
+	return append(result, cols...)
+}
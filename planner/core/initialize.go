@@ -195,6 +195,20 @@ func (p PhysicalMaxOneRow) Init(ctx sessionctx.Context, stats *property.StatsInf
 	return &p
 }
 
+// Init initializes LogicalExpand.
+func (p LogicalExpand) Init(ctx sessionctx.Context, offset int) *LogicalExpand {
+	p.baseLogicalPlan = newBaseLogicalPlan(ctx, plancodec.TypeExpand, &p, offset)
+	return &p
+}
+
+// Init initializes PhysicalExpand.
+func (p PhysicalExpand) Init(ctx sessionctx.Context, stats *property.StatsInfo, offset int, props ...*property.PhysicalProperty) *PhysicalExpand {
+	p.basePhysicalPlan = newBasePhysicalPlan(ctx, plancodec.TypeExpand, &p, offset)
+	p.childrenReqProps = props
+	p.stats = stats
+	return &p
+}
+
 // Init initializes LogicalWindow.
 func (p LogicalWindow) Init(ctx sessionctx.Context, offset int) *LogicalWindow {
 	p.baseLogicalPlan = newBaseLogicalPlan(ctx, plancodec.TypeWindow, &p, offset)
@@ -255,6 +269,19 @@ func (p LogicalShow) Init(ctx sessionctx.Context) *LogicalShow {
 	return &p
 }
 
+// Init initializes LogicalJSONTable.
+func (p LogicalJSONTable) Init(ctx sessionctx.Context, offset int) *LogicalJSONTable {
+	p.baseLogicalPlan = newBaseLogicalPlan(ctx, plancodec.TypeJSONTable, &p, offset)
+	return &p
+}
+
+// Init initializes PhysicalJSONTable.
+func (p PhysicalJSONTable) Init(ctx sessionctx.Context, stats *property.StatsInfo, offset int) *PhysicalJSONTable {
+	p.basePhysicalPlan = newBasePhysicalPlan(ctx, plancodec.TypeJSONTable, &p, offset)
+	p.stats = stats
+	return &p
+}
+
 // Init initializes LogicalShowDDLJobs.
 func (p LogicalShowDDLJobs) Init(ctx sessionctx.Context) *LogicalShowDDLJobs {
 	p.baseLogicalPlan = newBaseLogicalPlan(ctx, plancodec.TypeShowDDLJobs, &p, 0)
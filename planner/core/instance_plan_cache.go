@@ -0,0 +1,469 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"container/list"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/parser"
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/tidb/bindinfo"
+	"github.com/pingcap/tidb/domain"
+	"github.com/pingcap/tidb/expression"
+	"github.com/pingcap/tidb/planner/util"
+	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/codec"
+	"github.com/pingcap/tidb/util/hack"
+	"github.com/pingcap/tidb/util/memory"
+	"github.com/pingcap/tidb/util/mock"
+	utilparser "github.com/pingcap/tidb/util/parser"
+	"github.com/pingcap/tidb/util/ranger"
+)
+
+// instancePlanCache stores the *InstancePlanCache, it's nil unless the instance
+// plan cache is enabled.
+var instancePlanCache atomic.Value
+
+// SetInstancePlanCache sets the plan cache shared by the sessions of the instance,
+// nil disables it.
+func SetInstancePlanCache(c *InstancePlanCache) {
+	instancePlanCache.Store(c)
+}
+
+// GetInstancePlanCache returns the plan cache shared by the sessions of the
+// instance, it returns nil if the instance plan cache is disabled.
+func GetInstancePlanCache() *InstancePlanCache {
+	c, _ := instancePlanCache.Load().(*InstancePlanCache)
+	return c
+}
+
+// InstancePlanCache is the prepared plan cache shared by all the sessions of the
+// instance. The cached plans are never executed, the sessions execute the clones
+// of them instead. The least recently used plans are evicted once the memory
+// usage exceeds the limit of its memory tracker.
+type InstancePlanCache struct {
+	mu         sync.Mutex
+	elements   map[string]*list.Element
+	cache      *list.List
+	memTracker *memory.Tracker
+	// sctx is bound to the cached plans, which aren't bound to any session.
+	sctx sessionctx.Context
+}
+
+type instancePlanCacheEntry struct {
+	key      string
+	value    *PSTMTPlanCacheValue
+	memUsage int64
+
+	normalizedPlan string
+	planDigest     *parser.Digest
+}
+
+// NewInstancePlanCache creates an InstancePlanCache whose memory usage is limited to maxMemory.
+func NewInstancePlanCache(maxMemory int64) *InstancePlanCache {
+	return &InstancePlanCache{
+		elements:   make(map[string]*list.Element),
+		cache:      list.New(),
+		memTracker: memory.NewTracker(memory.LabelForInstancePlanCache, maxMemory),
+		sctx:       mock.NewContext(),
+	}
+}
+
+// Size returns the number of the cached plans.
+func (c *InstancePlanCache) Size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Len()
+}
+
+// MemTracker returns the memory tracker of the cache.
+func (c *InstancePlanCache) MemTracker() *memory.Tracker {
+	return c.memTracker
+}
+
+// get returns a clone of the cached plan bound to the session.
+func (c *InstancePlanCache) get(sctx sessionctx.Context, key string) (*instancePlanCacheEntry, bool) {
+	c.mu.Lock()
+	element, ok := c.elements[key]
+	if ok {
+		c.cache.MoveToFront(element)
+	}
+	c.mu.Unlock()
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*instancePlanCacheEntry)
+	plan, err := clonePlanForSession(entry.value.Plan.(PhysicalPlan), sctx)
+	if err != nil {
+		return nil, false
+	}
+	cloned := *entry
+	cloned.value = NewPSTMTPlanCacheValue(plan, entry.value.OutPutNames, entry.value.TblInfo2UnionScan, nil)
+	return &cloned, true
+}
+
+// put caches a clone of the plan, the plans which can't be cloned are ignored.
+func (c *InstancePlanCache) put(key string, value *PSTMTPlanCacheValue, normalizedPlan string, planDigest *parser.Digest) {
+	p, ok := value.Plan.(PhysicalPlan)
+	if !ok {
+		return
+	}
+	plan, err := clonePlanForSession(p, c.sctx)
+	if err != nil {
+		return
+	}
+	entry := &instancePlanCacheEntry{
+		key:            key,
+		value:          NewPSTMTPlanCacheValue(plan, value.OutPutNames, value.TblInfo2UnionScan, nil),
+		memUsage:       int64(len(key)) + planMemUsage(plan),
+		normalizedPlan: normalizedPlan,
+		planDigest:     planDigest,
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.elements[key]; ok {
+		c.removeElement(element)
+	}
+	c.elements[key] = c.cache.PushFront(entry)
+	c.memTracker.Consume(entry.memUsage)
+	for c.memTracker.CheckExceed() && c.cache.Len() > 0 {
+		c.removeElement(c.cache.Back())
+	}
+}
+
+func (c *InstancePlanCache) removeElement(element *list.Element) {
+	entry := element.Value.(*instancePlanCacheEntry)
+	c.cache.Remove(element)
+	delete(c.elements, entry.key)
+	c.memTracker.Consume(-entry.memUsage)
+}
+
+// instancePlanCacheable checks whether the plans of the session can be shared
+// with the other sessions. The plans may refer to the local temporary tables or
+// be affected by the session bindings otherwise.
+func instancePlanCacheable(sctx sessionctx.Context, stmt ast.StmtNode) bool {
+	vars := sctx.GetSessionVars()
+	if vars.InRestrictedSQL || vars.LocalTemporaryTables != nil || !IsReadOnly(stmt, vars) {
+		return false
+	}
+	if h, ok := sctx.Value(bindinfo.SessionBindInfoKeyType).(*bindinfo.SessionHandle); ok && len(h.GetAllBindRecord()) > 0 {
+		return false
+	}
+	return true
+}
+
+// newInstancePlanCacheKey creates the key of the prepared statement in the
+// instance plan cache. Besides the SQL text and the schema version, the key
+// contains the session variables and the global bindings which affect the plans.
+func newInstancePlanCacheKey(sctx sessionctx.Context, prepared *ast.Prepared) string {
+	vars := sctx.GetSessionVars()
+	timezoneOffset := 0
+	if vars.TimeZone != nil {
+		_, timezoneOffset = time.Now().In(vars.TimeZone).Zone()
+	}
+	engines := make([]string, 0, len(vars.IsolationReadEngines))
+	for engine := range vars.IsolationReadEngines {
+		engines = append(engines, engine.Name())
+	}
+	sort.Strings(engines)
+	charset, collation := vars.GetCharsetInfo()
+
+	key := codec.EncodeCompactBytes(nil, hack.Slice(vars.CurrentDB))
+	key = codec.EncodeCompactBytes(key, hack.Slice(prepared.Stmt.Text()))
+	key = codec.EncodeInt(key, prepared.SchemaVersion)
+	key = codec.EncodeInt(key, int64(vars.SQLMode))
+	key = codec.EncodeInt(key, int64(timezoneOffset))
+	for _, engine := range engines {
+		key = codec.EncodeCompactBytes(key, hack.Slice(engine))
+	}
+	key = codec.EncodeInt(key, int64(vars.SelectLimit))
+	key = codec.EncodeCompactBytes(key, hack.Slice(charset))
+	key = codec.EncodeCompactBytes(key, hack.Slice(collation))
+	for _, opt := range []bool{
+		vars.AllowAggPushDown,
+		vars.AllowDistinctAggPushDown,
+		vars.GetEnableIndexMerge(),
+		vars.IsMPPAllowed(),
+		vars.IsMPPEnforced(),
+		vars.EnableStableResultMode,
+		vars.AllowBCJ,
+		vars.MPPOuterJoinFixedBuildSide,
+		vars.GetAllowPreferRangeScan(),
+		vars.GetAllowInSubqToJoinAndAgg(),
+		vars.EnableIndexMergeJoin,
+		vars.EnableParallelApply,
+		vars.EnableCorrelationAdjustment,
+		vars.OptimizerUseInvisibleIndexes,
+		vars.EnableCascadesPlanner,
+		vars.EnableExtendedStats,
+		vars.HashExchangeWithNewCollation,
+		vars.UsePlanBaselines,
+	} {
+		key = codec.EncodeInt(key, boolToInt64(opt))
+	}
+	key = codec.EncodeCompactBytes(key, hack.Slice(vars.PartitionPruneMode.Load()))
+	for _, opt := range []int64{
+		int64(vars.OptimizerSelectivityLevel),
+		int64(vars.TiDBOptJoinReorderThreshold),
+		int64(vars.AllowCartesianBCJ),
+		int64(vars.AllowBatchCop),
+		vars.BroadcastJoinThresholdSize,
+		vars.BroadcastJoinThresholdCount,
+		vars.LimitPushDownThreshold,
+		int64(vars.CorrelationExpFactor),
+	} {
+		key = codec.EncodeInt(key, opt)
+	}
+	for _, factor := range []float64{
+		vars.CPUFactor,
+		vars.CopCPUFactor,
+		vars.CopTiFlashConcurrencyFactor,
+		vars.GetNetworkFactor(nil),
+		vars.GetScanFactor(nil),
+		vars.GetDescScanFactor(nil),
+		vars.GetSeekFactor(nil),
+		vars.MemoryFactor,
+		vars.DiskFactor,
+		vars.ConcurrencyFactor,
+		vars.CorrelationThreshold,
+	} {
+		key = codec.EncodeFloat(key, factor)
+	}
+	if vars.UsePlanBaselines {
+		key = appendGlobalBindings(key, sctx, prepared.Stmt)
+	}
+	for _, param := range vars.PreparedParams {
+		key = append(key, param.Kind())
+	}
+	return string(key)
+}
+
+// appendGlobalBindings appends the global bindings of the statement to the key,
+// so the plans are rebuilt once the bindings are created, dropped or updated.
+func appendGlobalBindings(key []byte, sctx sessionctx.Context, stmt ast.StmtNode) []byte {
+	dom := domain.GetDomain(sctx)
+	if dom == nil || dom.BindHandle() == nil || len(stmt.Text()) == 0 {
+		return key
+	}
+	normalizedSQL, hash := parser.NormalizeDigest(utilparser.RestoreWithDefaultDB(stmt, sctx.GetSessionVars().CurrentDB, stmt.Text()))
+	bindRecord := dom.BindHandle().GetBindRecord(hash.String(), normalizedSQL, "")
+	if bindRecord == nil {
+		return key
+	}
+	for _, binding := range bindRecord.Bindings {
+		key = codec.EncodeCompactBytes(key, hack.Slice(binding.BindSQL))
+		key = codec.EncodeCompactBytes(key, hack.Slice(binding.Status))
+		key = codec.EncodeUint(key, uint64(binding.UpdateTime.CoreTime()))
+	}
+	return key
+}
+
+// clonePlanForSession clones the plan and binds the clone to the session. It
+// returns an error if the plan can't be shared by the sessions.
+func clonePlanForSession(p PhysicalPlan, sctx sessionctx.Context) (PhysicalPlan, error) {
+	cloned, err := p.Clone()
+	if err != nil {
+		return nil, err
+	}
+	if err = resetCtxForPlan(cloned, sctx); err != nil {
+		return nil, err
+	}
+	return cloned, nil
+}
+
+func resetCtxForPlan(p PhysicalPlan, sctx sessionctx.Context) error {
+	return forEachPlanNode(p, func(node PhysicalPlan) error {
+		exprs, ok := sharedPlanExprs(node)
+		if !ok {
+			return errors.Errorf("%T can't be shared by the sessions", node)
+		}
+		node.(interface{ setSCtx(sessionctx.Context) }).setSCtx(sctx)
+		expression.ResetCtxForExprs(sctx, exprs)
+		return nil
+	})
+}
+
+// forEachPlanNode calls f for every node of the plan, including the ones pushed
+// down to the storage, until f returns an error.
+func forEachPlanNode(p PhysicalPlan, f func(PhysicalPlan) error) error {
+	if err := f(p); err != nil {
+		return err
+	}
+	// The pushed down plans are cloned separately from the flattened ones, so
+	// both of them are visited.
+	var children []PhysicalPlan
+	switch x := p.(type) {
+	case *PhysicalTableReader:
+		children = append([]PhysicalPlan{x.tablePlan}, x.TablePlans...)
+	case *PhysicalIndexReader:
+		children = append([]PhysicalPlan{x.indexPlan}, x.IndexPlans...)
+	case *PhysicalIndexLookUpReader:
+		children = append([]PhysicalPlan{x.indexPlan, x.tablePlan}, x.IndexPlans...)
+		children = append(children, x.TablePlans...)
+	}
+	children = append(children, p.Children()...)
+	for _, child := range children {
+		if err := forEachPlanNode(child, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sharedPlanExprs returns the expressions of the plan node, which are bound to
+// the session. It returns false if the node can't be shared by the sessions.
+func sharedPlanExprs(p PhysicalPlan) ([]expression.Expression, bool) {
+	switch x := p.(type) {
+	case *PhysicalTableReader:
+		return x.PartitionInfo.PruningConds, true
+	case *PhysicalIndexReader:
+		return x.PartitionInfo.PruningConds, true
+	case *PhysicalIndexLookUpReader:
+		return x.PartitionInfo.PruningConds, true
+	case *PhysicalTableScan:
+		exprs := make([]expression.Expression, 0, len(x.AccessCondition)+len(x.filterCondition)+len(x.PartitionInfo.PruningConds))
+		exprs = append(exprs, x.AccessCondition...)
+		exprs = append(exprs, x.filterCondition...)
+		return append(exprs, x.PartitionInfo.PruningConds...), true
+	case *PhysicalIndexScan:
+		exprs := make([]expression.Expression, 0, len(x.AccessCondition)+len(x.GenExprs))
+		exprs = append(exprs, x.AccessCondition...)
+		for _, expr := range x.GenExprs {
+			exprs = append(exprs, expr)
+		}
+		return exprs, true
+	case *PhysicalSelection:
+		return x.Conditions, true
+	case *PhysicalProjection:
+		return x.Exprs, true
+	case *PhysicalTopN:
+		return byItemsToExprs(x.ByItems), true
+	case *PhysicalSort:
+		return byItemsToExprs(x.ByItems), true
+	case *PhysicalHashJoin:
+		return append(joinExprs(&x.basePhysicalJoin), scalarFuncsToExprs(x.EqualConditions)...), true
+	case *PhysicalMergeJoin:
+		return joinExprs(&x.basePhysicalJoin), true
+	case *PhysicalHashAgg:
+		return aggExprs(&x.basePhysicalAgg), true
+	case *PhysicalStreamAgg:
+		return aggExprs(&x.basePhysicalAgg), true
+	case *PhysicalExpand:
+		var exprs []expression.Expression
+		for _, levelExprs := range x.LevelExprs {
+			exprs = append(exprs, levelExprs...)
+		}
+		return exprs, true
+	case *PhysicalJSONTable:
+		return []expression.Expression{x.Expr}, true
+	case *PhysicalLimit, *PhysicalUnionAll, *PhysicalExchangeReceiver, *PhysicalExchangeSender:
+		return nil, true
+	}
+	// The inner plan of the cloned PhysicalApply doesn't share the correlated
+	// columns with it, and the point get plans refer to the parameter markers in
+	// the AST of the session.
+	return nil, false
+}
+
+func joinExprs(p *basePhysicalJoin) []expression.Expression {
+	exprs := make([]expression.Expression, 0, len(p.LeftConditions)+len(p.RightConditions)+len(p.OtherConditions))
+	exprs = append(exprs, p.LeftConditions...)
+	exprs = append(exprs, p.RightConditions...)
+	return append(exprs, p.OtherConditions...)
+}
+
+func aggExprs(p *basePhysicalAgg) []expression.Expression {
+	exprs := make([]expression.Expression, 0, len(p.GroupByItems))
+	for _, aggFunc := range p.AggFuncs {
+		exprs = append(exprs, aggFunc.Args...)
+		exprs = append(exprs, byItemsToExprs(aggFunc.OrderByItems)...)
+	}
+	return append(exprs, p.GroupByItems...)
+}
+
+func byItemsToExprs(items []*util.ByItems) []expression.Expression {
+	exprs := make([]expression.Expression, 0, len(items))
+	for _, item := range items {
+		exprs = append(exprs, item.Expr)
+	}
+	return exprs
+}
+
+func scalarFuncsToExprs(funcs []*expression.ScalarFunction) []expression.Expression {
+	exprs := make([]expression.Expression, 0, len(funcs))
+	for _, f := range funcs {
+		exprs = append(exprs, f)
+	}
+	return exprs
+}
+
+// planMemUsage estimates the memory usage of the plan by the sizes of its nodes
+// and their schemas, expressions and ranges.
+func planMemUsage(p PhysicalPlan) int64 {
+	var memUsage int64
+	_ = forEachPlanNode(p, func(node PhysicalPlan) error {
+		memUsage += int64(reflect.TypeOf(node).Elem().Size())
+		if schema := node.Schema(); schema != nil {
+			memUsage += int64(len(schema.Columns)) * int64(unsafe.Sizeof(expression.Column{}))
+		}
+		exprs, _ := sharedPlanExprs(node)
+		for _, expr := range exprs {
+			memUsage += exprMemUsage(expr)
+		}
+		var ranges []*ranger.Range
+		switch x := node.(type) {
+		case *PhysicalTableScan:
+			ranges = x.Ranges
+		case *PhysicalIndexScan:
+			ranges = x.Ranges
+		}
+		for _, ran := range ranges {
+			memUsage += int64(unsafe.Sizeof(*ran)) + types.EstimatedMemUsage(ran.LowVal, 1) + types.EstimatedMemUsage(ran.HighVal, 1)
+		}
+		return nil
+	})
+	return memUsage
+}
+
+func exprMemUsage(expr expression.Expression) int64 {
+	switch x := expr.(type) {
+	case *expression.ScalarFunction:
+		memUsage := int64(unsafe.Sizeof(*x)) + int64(reflect.TypeOf(x.Function).Elem().Size())
+		for _, arg := range x.GetArgs() {
+			memUsage += exprMemUsage(arg)
+		}
+		return memUsage
+	case *expression.Constant:
+		return int64(unsafe.Sizeof(*x)) + types.EstimatedMemUsage([]types.Datum{x.Value}, 1)
+	case *expression.CorrelatedColumn:
+		return int64(unsafe.Sizeof(*x))
+	}
+	return int64(unsafe.Sizeof(expression.Column{}))
+}
+
+func boolToInt64(v bool) int64 {
+	if v {
+		return 1
+	}
+	return 0
+}
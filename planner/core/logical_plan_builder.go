@@ -28,6 +28,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/parser"
 	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/charset"
 	"github.com/pingcap/parser/format"
 	"github.com/pingcap/parser/model"
 	"github.com/pingcap/parser/mysql"
@@ -49,6 +50,7 @@ import (
 	"github.com/pingcap/tidb/table"
 	"github.com/pingcap/tidb/table/tables"
 	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/types/json"
 	driver "github.com/pingcap/tidb/types/parser_driver"
 	util2 "github.com/pingcap/tidb/util"
 	"github.com/pingcap/tidb/util/chunk"
@@ -166,7 +168,7 @@ func (a *aggOrderByResolver) Leave(inNode ast.Node) (ast.Node, bool) {
 }
 
 func (b *PlanBuilder) buildAggregation(ctx context.Context, p LogicalPlan, aggFuncList []*ast.AggregateFuncExpr, gbyItems []expression.Expression,
-	correlatedAggMap map[*ast.AggregateFuncExpr]int) (LogicalPlan, map[int]int, error) {
+	correlatedAggMap map[*ast.AggregateFuncExpr]int, withRollup bool) (LogicalPlan, map[int]int, error) {
 	b.optFlag |= flagBuildKeyInfo
 	b.optFlag |= flagPushDownAgg
 	// We may apply aggregation eliminate optimization.
@@ -262,6 +264,15 @@ func (b *PlanBuilder) buildAggregation(ctx context.Context, p LogicalPlan, aggFu
 			}
 		}
 	}
+	if withRollup {
+		// The arguments of the aggregate functions are rewritten above, so they refer to the
+		// original columns rather than the nullable grouping columns of Expand.
+		var err error
+		p, gbyItems, err = b.buildExpand(p, gbyItems)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
 	for i, col := range p.Schema().Columns {
 		newFunc, err := aggregation.NewAggFuncDesc(b.ctx, ast.AggFuncFirstRow, []expression.Expression{col}, false)
 		if err != nil {
@@ -304,6 +315,76 @@ func (b *PlanBuilder) buildAggregation(ctx context.Context, p LogicalPlan, aggFu
 	return plan4Agg, aggIndexMap, nil
 }
 
+// buildExpand builds the Expand operator for GROUP BY ... WITH ROLLUP. For n group by items,
+// there are n+1 grouping sets, the i-th one groups by the first n-i items and its grouping id
+// is i. The returned group by items are the grouping columns and the grouping id of Expand.
+func (b *PlanBuilder) buildExpand(p LogicalPlan, gbyItems []expression.Expression) (LogicalPlan, []expression.Expression, error) {
+	gbyCols := make([]*expression.Column, 0, len(gbyItems))
+	for _, item := range gbyItems {
+		col, ok := item.(*expression.Column)
+		if !ok {
+			return nil, nil, ErrNotSupportedYet.GenWithStackByArgs("GROUP BY expressions with ROLLUP")
+		}
+		gbyCols = append(gbyCols, col)
+	}
+	childSchema, childNames := p.Schema(), p.OutputNames()
+	gbySchema := expression.NewSchema(gbyCols...)
+	schemaLen := childSchema.Len() + len(gbyCols) + 1
+	schema := expression.NewSchema(make([]*expression.Column, 0, schemaLen)...)
+	names := make(types.NameSlice, 0, schemaLen)
+	for i, col := range childSchema.Columns {
+		schema.Append(col.Clone().(*expression.Column))
+		// The group by items are referred by the nullable grouping columns from now on.
+		if gbySchema.Contains(col) {
+			names = append(names, types.EmptyName)
+		} else {
+			names = append(names, childNames[i])
+		}
+	}
+	groupingCols := make([]*expression.Column, 0, len(gbyCols))
+	for _, col := range gbyCols {
+		tp := col.RetType.Clone()
+		tp.Flag &= ^mysql.NotNullFlag
+		groupingCol := &expression.Column{
+			UniqueID: b.ctx.GetSessionVars().AllocPlanColumnID(),
+			RetType:  tp,
+		}
+		groupingCols = append(groupingCols, groupingCol)
+		schema.Append(groupingCol)
+		names = append(names, childNames[childSchema.ColumnIndex(col)])
+	}
+	gidTp := types.NewFieldType(mysql.TypeLonglong)
+	gidTp.Flag |= mysql.NotNullFlag
+	gid := &expression.Column{
+		UniqueID: b.ctx.GetSessionVars().AllocPlanColumnID(),
+		RetType:  gidTp,
+	}
+	schema.Append(gid)
+	names = append(names, types.EmptyName)
+
+	levelExprs := make([][]expression.Expression, 0, len(gbyCols)+1)
+	for i := 0; i <= len(gbyCols); i++ {
+		exprs := make([]expression.Expression, 0, schemaLen)
+		for _, col := range childSchema.Columns {
+			exprs = append(exprs, col)
+		}
+		for j, col := range gbyCols {
+			if j < len(gbyCols)-i {
+				exprs = append(exprs, col)
+			} else {
+				exprs = append(exprs, &expression.Constant{Value: types.NewDatum(nil), RetType: groupingCols[j].RetType})
+			}
+		}
+		exprs = append(exprs, &expression.Constant{Value: types.NewIntDatum(int64(i)), RetType: gidTp})
+		levelExprs = append(levelExprs, exprs)
+	}
+	expand := LogicalExpand{LevelExprs: levelExprs, GroupingCols: groupingCols, GroupingID: gid}.Init(b.ctx, b.getSelectOffset())
+	expand.SetChildren(p)
+	expand.setSchemaAndNames(schema, names)
+	b.rollupExpand = expand
+	return expand, append(expression.Column2Exprs(groupingCols), gid), nil
+}
+
 func (b *PlanBuilder) buildTableRefs(ctx context.Context, from *ast.TableRefsClause) (p LogicalPlan, err error) {
 	if from == nil {
 		p = b.buildTableDual()
@@ -336,6 +417,10 @@ func (b *PlanBuilder) buildResultSetNode(ctx context.Context, node ast.ResultSet
 		case *ast.TableName:
 			p, err = b.buildDataSource(ctx, v, &x.AsName)
 			isTableName = true
+		case *ast.JSONTable:
+			p, err = b.buildJSONTable(ctx, v, x.AsName)
+			// JSON_TABLE is not a select block either.
+			isTableName = true
 		default:
 			err = ErrUnsupportedType.GenWithStackByArgs(v)
 		}
@@ -680,10 +765,22 @@ func (b *PlanBuilder) buildJoin(ctx context.Context, joinNode *ast.Join) (Logica
 		return nil, err
 	}
 
+	// JSON_TABLE can refer to the columns of the preceding tables, except the
+	// ones on the other side of a RIGHT JOIN, which are correlated columns then.
+	lateral := isJSONTableSource(joinNode.Right) && joinNode.Tp != ast.RightJoin
+	if lateral {
+		b.outerSchemas = append(b.outerSchemas, leftPlan.Schema())
+		b.outerNames = append(b.outerNames, leftPlan.OutputNames())
+	}
 	rightPlan, err := b.buildResultSetNode(ctx, joinNode.Right)
+	if lateral {
+		b.outerSchemas = b.outerSchemas[:len(b.outerSchemas)-1]
+		b.outerNames = b.outerNames[:len(b.outerNames)-1]
+	}
 	if err != nil {
 		return nil, err
 	}
+	lateral = lateral && len(extractCorColumnsBySchema4LogicalPlan(rightPlan, leftPlan.Schema())) > 0
 
 	// The recursive part in CTE must not be on the right side of a LEFT JOIN.
 	if lc, ok := rightPlan.(*LogicalCTETable); ok && joinNode.Tp == ast.LeftJoin {
@@ -771,7 +868,7 @@ func (b *PlanBuilder) buildJoin(ctx context.Context, joinNode *ast.Join) (Logica
 		// possible decorrelate optimizations. The ON clause is actually treated as a WHERE clause now.
 		if joinPlan.JoinType == InnerJoin {
 			sel := LogicalSelection{Conditions: onCondition}.Init(b.ctx, b.getSelectOffset())
-			sel.SetChildren(joinPlan)
+			sel.SetChildren(b.buildLateralJoin(joinPlan, lateral))
 			return sel, nil
 		}
 		joinPlan.AttachOnConds(onCondition)
@@ -781,7 +878,31 @@ func (b *PlanBuilder) buildJoin(ctx context.Context, joinNode *ast.Join) (Logica
 		joinPlan.cartesianJoin = true
 	}
 
-	return joinPlan, nil
+	return b.buildLateralJoin(joinPlan, lateral), nil
+}
+
+// isJSONTableSource checks whether the node is a JSON_TABLE table source.
+func isJSONTableSource(node ast.ResultSetNode) bool {
+	ts, ok := node.(*ast.TableSource)
+	if !ok {
+		return false
+	}
+	_, ok = ts.Source.(*ast.JSONTable)
+	return ok
+}
+
+// buildLateralJoin turns the join into an apply if the inner side refers to the
+// columns of the outer side, which only happens for JSON_TABLE.
+func (b *PlanBuilder) buildLateralJoin(joinPlan *LogicalJoin, lateral bool) LogicalPlan {
+	if !lateral {
+		return joinPlan
+	}
+	b.optFlag = b.optFlag | flagBuildKeyInfo | flagDecorrelate
+	joinPlan.cartesianJoin = false
+	ap := &LogicalApply{LogicalJoin: *joinPlan}
+	ap.tp = plancodec.TypeApply
+	ap.self = ap
+	return ap
 }
 
 // buildUsingClause eliminate the redundant columns and ordering columns based
@@ -1916,20 +2037,22 @@ func resolveFromSelectFields(v *ast.ColumnNameExpr, fields []*ast.SelectField, i
 // havingWindowAndOrderbyExprResolver visits Expr tree.
 // It converts ColunmNameExpr to AggregateFuncExpr and collects AggregateFuncExpr.
 type havingWindowAndOrderbyExprResolver struct {
-	inAggFunc    bool
-	inWindowFunc bool
-	inWindowSpec bool
-	inExpr       bool
-	err          error
-	p            LogicalPlan
-	selectFields []*ast.SelectField
-	aggMapper    map[*ast.AggregateFuncExpr]int
-	colMapper    map[*ast.ColumnNameExpr]int
-	gbyItems     []*ast.ByItem
-	outerSchemas []*expression.Schema
-	outerNames   [][]*types.FieldName
-	curClause    clauseCode
-	prevClause   []clauseCode
+	inAggFunc      bool
+	inGroupingFunc bool
+	inWindowFunc   bool
+	inWindowSpec   bool
+	inExpr         bool
+	err            error
+	p              LogicalPlan
+	selectFields   []*ast.SelectField
+	aggMapper      map[*ast.AggregateFuncExpr]int
+	colMapper      map[*ast.ColumnNameExpr]int
+	groupingMapper map[*ast.FuncCallExpr]int
+	gbyItems       []*ast.ByItem
+	outerSchemas   []*expression.Schema
+	outerNames     [][]*types.FieldName
+	curClause      clauseCode
+	prevClause     []clauseCode
 }
 
 func (a *havingWindowAndOrderbyExprResolver) pushCurClause(newClause clauseCode) {
@@ -1944,9 +2067,12 @@ func (a *havingWindowAndOrderbyExprResolver) popCurClause() {
 
 // Enter implements Visitor interface.
 func (a *havingWindowAndOrderbyExprResolver) Enter(n ast.Node) (node ast.Node, skipChildren bool) {
-	switch n.(type) {
+	switch v := n.(type) {
 	case *ast.AggregateFuncExpr:
 		a.inAggFunc = true
+	case *ast.FuncCallExpr:
+		a.inGroupingFunc = v.FnName.L == expression.Grouping
+		a.inExpr = true
 	case *ast.WindowFuncExpr:
 		a.inWindowFunc = true
 	case *ast.WindowSpec:
@@ -2027,6 +2153,18 @@ func (a *havingWindowAndOrderbyExprResolver) Leave(n ast.Node) (node ast.Node, o
 			Expr:      v,
 			AsName:    model.NewCIStr(fmt.Sprintf("sel_agg_%d", len(a.selectFields))),
 		})
+	case *ast.FuncCallExpr:
+		if v.FnName.L != expression.Grouping {
+			break
+		}
+		// The GROUPING function needs the grouping id, which is only visible below the projection.
+		a.inGroupingFunc = false
+		a.groupingMapper[v] = len(a.selectFields)
+		a.selectFields = append(a.selectFields, &ast.SelectField{
+			Auxiliary: true,
+			Expr:      &ast.FuncCallExpr{FnName: v.FnName, Args: v.Args},
+			AsName:    model.NewCIStr(fmt.Sprintf("sel_grouping_%d", len(a.selectFields))),
+		})
 	case *ast.WindowFuncExpr:
 		a.inWindowFunc = false
 		if a.curClause == havingClause {
@@ -2050,7 +2188,7 @@ func (a *havingWindowAndOrderbyExprResolver) Leave(n ast.Node) (node ast.Node, o
 		}
 	case *ast.ColumnNameExpr:
 		resolveFieldsFirst := true
-		if a.inAggFunc || a.inWindowFunc || a.inWindowSpec || (a.curClause == orderByClause && a.inExpr) || a.curClause == fieldList {
+		if a.inAggFunc || a.inGroupingFunc || a.inWindowFunc || a.inWindowSpec || (a.curClause == orderByClause && a.inExpr) || a.curClause == fieldList {
 			resolveFieldsFirst = false
 		}
 		if !a.inAggFunc && a.curClause != orderByClause {
@@ -2127,7 +2265,7 @@ func (a *havingWindowAndOrderbyExprResolver) Leave(n ast.Node) (node ast.Node, o
 			a.err = ErrUnknownColumn.GenWithStackByArgs(v.Name.OrigColName(), clauseMsg[a.curClause])
 			return node, false
 		}
-		if a.inAggFunc {
+		if a.inAggFunc || a.inGroupingFunc {
 			return a.selectFields[index].Expr, true
 		}
 		a.colMapper[v] = index
@@ -2141,12 +2279,13 @@ func (a *havingWindowAndOrderbyExprResolver) Leave(n ast.Node) (node ast.Node, o
 func (b *PlanBuilder) resolveHavingAndOrderBy(sel *ast.SelectStmt, p LogicalPlan) (
 	map[*ast.AggregateFuncExpr]int, map[*ast.AggregateFuncExpr]int, error) {
 	extractor := &havingWindowAndOrderbyExprResolver{
-		p:            p,
-		selectFields: sel.Fields.Fields,
-		aggMapper:    make(map[*ast.AggregateFuncExpr]int),
-		colMapper:    b.colMapper,
-		outerSchemas: b.outerSchemas,
-		outerNames:   b.outerNames,
+		p:              p,
+		selectFields:   sel.Fields.Fields,
+		aggMapper:      make(map[*ast.AggregateFuncExpr]int),
+		colMapper:      b.colMapper,
+		groupingMapper: b.groupingMapper,
+		outerSchemas:   b.outerSchemas,
+		outerNames:     b.outerNames,
 	}
 	if sel.GroupBy != nil {
 		extractor.gbyItems = sel.GroupBy.Items
@@ -3412,10 +3551,14 @@ func (b *PlanBuilder) TableHints() *tableHintInfo {
 func (b *PlanBuilder) buildSelect(ctx context.Context, sel *ast.SelectStmt) (p LogicalPlan, err error) {
 	b.pushSelectOffset(sel.QueryBlockOffset)
 	b.pushTableHints(sel.TableHints, sel.QueryBlockOffset)
+	oldRollupExpand := b.rollupExpand
+	b.rollupExpand = nil
 	defer func() {
 		b.popSelectOffset()
 		// table hints are only visible in the current SELECT statement.
 		b.popTableHints()
+		// the GROUPING function can only refer to the rollup of the current SELECT statement.
+		b.rollupExpand = oldRollupExpand
 	}()
 	if b.buildingRecursivePartForCTE {
 		if sel.Distinct || sel.OrderBy != nil || sel.Limit != nil {
@@ -3568,7 +3711,8 @@ func (b *PlanBuilder) buildSelect(ctx context.Context, sel *ast.SelectStmt) (p L
 	}
 	if needBuildAgg {
 		var aggIndexMap map[int]int
-		p, aggIndexMap, err = b.buildAggregation(ctx, p, aggFuncs, gbyCols, correlatedAggMap)
+		withRollup := sel.GroupBy != nil && sel.GroupBy.Rollup
+		p, aggIndexMap, err = b.buildAggregation(ctx, p, aggFuncs, gbyCols, correlatedAggMap, withRollup)
 		if err != nil {
 			return nil, err
 		}
@@ -3682,6 +3826,108 @@ func (b *PlanBuilder) buildTableDual() *LogicalTableDual {
 	return LogicalTableDual{RowCount: 1}.Init(b.ctx, b.getSelectOffset())
 }
 
+// buildJSONTable builds the LogicalJSONTable for JSON_TABLE. The columns of the
+// nested paths are placed where the nested paths are defined.
+func (b *PlanBuilder) buildJSONTable(ctx context.Context, jt *ast.JSONTable, asName model.CIStr) (LogicalPlan, error) {
+	b.handleHelper.pushMap(nil)
+	if _, err := json.ParseJSONPathExpr(jt.Path); err != nil {
+		return nil, err
+	}
+	// The JSON document is evaluated without any input row, so only constants
+	// and the correlated columns are allowed.
+	docExpr, _, err := b.rewrite(ctx, jt.Expr, b.buildTableDual(), nil, true)
+	b.handleHelper.popMap()
+	if err != nil {
+		return nil, err
+	}
+	if docExpr.GetType().EvalType() != types.ETJson && !types.IsString(docExpr.GetType().Tp) && docExpr.GetType().Tp != mysql.TypeNull {
+		return nil, ErrWrongArguments.GenWithStackByArgs("JSON_TABLE")
+	}
+	docExpr = expression.BuildCastFunction(b.ctx, docExpr, types.NewFieldType(mysql.TypeJSON))
+
+	p := LogicalJSONTable{Expr: docExpr, Path: jt.Path, Columns: jt.Columns}.Init(b.ctx, b.getSelectOffset())
+	schema := expression.NewSchema()
+	var names types.NameSlice
+	if err = b.buildJSONTableColumns(jt.Columns, asName, schema, &names); err != nil {
+		return nil, err
+	}
+	p.SetSchema(schema)
+	p.names = names
+	return p, nil
+}
+
+func (b *PlanBuilder) buildJSONTableColumns(columns []*ast.JSONTableColumn, asName model.CIStr, schema *expression.Schema, names *types.NameSlice) error {
+	for _, col := range columns {
+		if col.Tp != ast.JSONTableColumnOrdinality {
+			if _, err := json.ParseJSONPathExpr(col.Path); err != nil {
+				return err
+			}
+		}
+		if col.Tp == ast.JSONTableColumnNested {
+			if err := b.buildJSONTableColumns(col.Columns, asName, schema, names); err != nil {
+				return err
+			}
+			continue
+		}
+		var tp *types.FieldType
+		if col.Tp == ast.JSONTableColumnOrdinality {
+			tp = types.NewFieldType(mysql.TypeLong)
+			tp.Flag |= mysql.UnsignedFlag
+		} else {
+			tp = col.FieldType.Clone()
+		}
+		if err := setJSONTableColumnType(tp); err != nil {
+			return err
+		}
+		for _, resp := range []*ast.JSONTableResponse{col.OnEmpty, col.OnError} {
+			if resp != nil && resp.Tp == ast.JSONTableResponseDefault {
+				if _, err := json.ParseBinaryFromString(resp.Default); err != nil {
+					return err
+				}
+			}
+		}
+		schema.Append(&expression.Column{
+			UniqueID: b.ctx.GetSessionVars().AllocPlanColumnID(),
+			RetType:  tp,
+			OrigName: asName.L + "." + col.Name.L,
+		})
+		*names = append(*names, &types.FieldName{
+			TblName:     asName,
+			OrigTblName: asName,
+			ColName:     col.Name,
+			OrigColName: col.Name,
+		})
+	}
+	return nil
+}
+
+// setJSONTableColumnType fills in the charset, collation, length and decimal
+// of the column type if they are not specified.
+func setJSONTableColumnType(tp *types.FieldType) error {
+	if types.IsString(tp.Tp) && !types.IsBinaryStr(tp) {
+		if tp.Charset == "" {
+			tp.Charset = mysql.DefaultCharset
+		}
+		if tp.Collate == "" {
+			coll, err := charset.GetDefaultCollation(tp.Charset)
+			if err != nil {
+				return err
+			}
+			tp.Collate = coll
+		}
+	} else {
+		tp.Charset, tp.Collate = charset.CharsetBin, charset.CollationBin
+	}
+	defaultFlen, defaultDecimal := mysql.GetDefaultFieldLengthAndDecimal(tp.Tp)
+	if tp.Flen == types.UnspecifiedLength {
+		tp.Flen = defaultFlen
+	}
+	if tp.Decimal == types.UnspecifiedLength {
+		tp.Decimal = defaultDecimal
+	}
+	return nil
+}
+
 func (ds *DataSource) newExtraHandleSchemaCol() *expression.Column {
 	tp := types.NewFieldType(mysql.TypeLonglong)
 	tp.Flag = mysql.NotNullFlag | mysql.PriKeyFlag
@@ -1985,3 +1985,56 @@ func (s *testPlanSuite) TestWindowLogicalPlanAmbiguous(c *C) {
 		}
 	}
 }
+
+func (s *testPlanSuite) TestRollup(c *C) {
+	defer testleak.AfterTest(c)()
+	tests := []struct {
+		sql      string
+		logical  string
+		physical string
+		err      string
+	}{
+		{
+			sql:      "select a, b, sum(c), grouping(a), grouping(a, b) from t group by a, b with rollup",
+			logical:  "DataScan(t)->Expand->Aggr(sum(test.t.c),firstrow(Column#14),firstrow(Column#15),firstrow(Column#16))->Projection",
+			physical: "TableReader(Table(t))->Expand->HashAgg->Projection",
+		},
+		{
+			// The condition on the grouping column can not be pushed through Expand.
+			sql:      "select b, count(*) from t where c > 1 group by b with rollup having b is null",
+			logical:  "DataScan(t)->Expand->Sel([isnull(Column#14)])->Aggr(count(1),firstrow(Column#14))->Projection",
+			physical: "IndexLookUp(Index(t.c_d_e)[(1,+inf]], Table(t))->Expand->Sel([isnull(Column#14)])->HashAgg->Projection",
+		},
+		{
+			sql: "select b, grouping(c) from t group by b with rollup",
+			err: ".*Invalid use of group function",
+		},
+		{
+			sql: "select b + 1 from t group by b + 1 with rollup",
+			err: ".*GROUP BY expressions with ROLLUP.*",
+		},
+	}
+	ctx := context.Background()
+	for i, tt := range tests {
+		comment := Commentf("case:%v sql:%s", i, tt.sql)
+		stmt, err := s.ParseOneStmt(tt.sql, "", "")
+		c.Assert(err, IsNil, comment)
+		builder, _ := NewPlanBuilder().Init(MockContext(), s.is, &hint.BlockHintProcessor{})
+		p, err := builder.Build(ctx, stmt)
+		if tt.err != "" {
+			c.Assert(err, ErrorMatches, tt.err, comment)
+			continue
+		}
+		c.Assert(err, IsNil, comment)
+		lp, err := logicalOptimize(ctx, builder.optFlag, p.(LogicalPlan))
+		c.Assert(err, IsNil, comment)
+		c.Assert(ToString(lp), Equals, tt.logical, comment)
+		pp, _, err := physicalOptimize(lp, &PlanCounterDisabled)
+		c.Assert(err, IsNil, comment)
+		c.Assert(ToString(pp), Equals, tt.physical, comment)
+	}
+	stmt, err := s.ParseOneStmt("select a, grouping(a) from t group by a", "", "")
+	c.Assert(err, IsNil)
+	_, _, err = BuildLogicalPlan(ctx, s.ctx, stmt, s.is)
+	c.Assert(err, ErrorMatches, ".*Invalid use of group function")
+}
@@ -50,6 +50,7 @@ var (
 	_ LogicalPlan = &LogicalLock{}
 	_ LogicalPlan = &LogicalLimit{}
 	_ LogicalPlan = &LogicalWindow{}
+	_ LogicalPlan = &LogicalExpand{}
 )
 
 // JoinType contains CrossJoin, InnerJoin, LeftOuterJoin, RightOuterJoin, FullOuterJoin, SemiJoin.
@@ -456,6 +457,22 @@ type LogicalMaxOneRow struct {
 	baseLogicalPlan
 }
 
+// LogicalExpand duplicates every input row once for each grouping set. It is
+// built under the aggregation of GROUP BY ... WITH ROLLUP, so that all the
+// grouping sets can be aggregated by a single aggregation.
+type LogicalExpand struct {
+	logicalSchemaProducer
+
+	// LevelExprs contains one projection for each grouping set. The grouping
+	// columns which are not grouped in the set are projected to NULL.
+	LevelExprs [][]expression.Expression
+
+	// GroupingCols are the nullable copies of the rollup items in the output schema.
+	GroupingCols []*expression.Column
+	// GroupingID is the output column identifying the grouping set of a row.
+	GroupingID *expression.Column
+}
+
 // LogicalTableDual represents a dual table plan.
 type LogicalTableDual struct {
 	logicalSchemaProducer
@@ -712,6 +729,12 @@ func (ds *DataSource) deriveCommonHandleTablePathStats(path *util.AccessPath, co
 		if err != nil {
 			return err
 		}
+		if isHypoIndex(ds.ctx, ds.tableInfo.ID, path.Index) {
+			path.CountAfterAccess, err = ds.getHypoIndexRowCount(path)
+			if err != nil {
+				return err
+			}
+		}
 	} else {
 		path.TableFilters = conds
 	}
@@ -868,6 +891,12 @@ func (ds *DataSource) fillIndexPath(path *util.AccessPath, conds []expression.Ex
 		if err != nil {
 			return err
 		}
+		if isHypoIndex(ds.ctx, ds.tableInfo.ID, path.Index) {
+			path.CountAfterAccess, err = ds.getHypoIndexRowCount(path)
+			if err != nil {
+				return err
+			}
+		}
 	} else {
 		path.TableFilters = conds
 	}
@@ -1167,6 +1196,22 @@ type LogicalShow struct {
 	ShowContents
 }
 
+// LogicalJSONTable is the logical plan of JSON_TABLE, which generates the rows
+// from a JSON document.
+type LogicalJSONTable struct {
+	logicalSchemaProducer
+
+	// Expr is the JSON document, which can only refer to the correlated columns.
+	Expr    expression.Expression
+	Path    string
+	Columns []*ast.JSONTableColumn
+}
+
+// ExtractCorrelatedCols implements LogicalPlan interface.
+func (p *LogicalJSONTable) ExtractCorrelatedCols() []*expression.CorrelatedColumn {
+	return expression.ExtractCorColumns(p.Expr)
+}
+
 // LogicalShowDDLJobs is for showing DDL job list.
 type LogicalShowDDLJobs struct {
 	logicalSchemaProducer
@@ -165,6 +165,9 @@ func DoOptimize(ctx context.Context, sctx sessionctx.Context, flag uint64, logic
 		return nil, 0, err
 	}
 	finalPlan := postOptimize(sctx, physical)
+	if tracer := sctx.GetSessionVars().StmtCtx.OptimizeTracer; tracer != nil {
+		tracer.SetFinalPlan(ToString(finalPlan), cost)
+	}
 	return finalPlan, cost, nil
 }
 
@@ -233,6 +236,7 @@ func enableParallelApply(sctx sessionctx.Context, plan PhysicalPlan) PhysicalPla
 
 func logicalOptimize(ctx context.Context, flag uint64, logic LogicalPlan) (LogicalPlan, error) {
 	var err error
+	tracer := logic.SCtx().GetSessionVars().StmtCtx.OptimizeTracer
 	for i, rule := range optRuleList {
 		// The order of flags is same as the order of optRule in the list.
 		// We use a bitmask to record which opt rules should be used. If the i-th bit is 1, it means we should
@@ -240,10 +244,17 @@ func logicalOptimize(ctx context.Context, flag uint64, logic LogicalPlan) (Logic
 		if flag&(1<<uint(i)) == 0 || isLogicalRuleDisabled(rule) {
 			continue
 		}
+		var before string
+		if tracer != nil {
+			before = ToString(logic)
+		}
 		logic, err = rule.optimize(ctx, logic)
 		if err != nil {
 			return nil, err
 		}
+		if tracer != nil {
+			tracer.AppendLogicalRule(rule.name(), before, ToString(logic))
+		}
 	}
 	return logic, err
 }
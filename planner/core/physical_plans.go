@@ -42,6 +42,7 @@ var (
 	_ PhysicalPlan = &PhysicalProjection{}
 	_ PhysicalPlan = &PhysicalTopN{}
 	_ PhysicalPlan = &PhysicalMaxOneRow{}
+	_ PhysicalPlan = &PhysicalExpand{}
 	_ PhysicalPlan = &PhysicalTableDual{}
 	_ PhysicalPlan = &PhysicalUnionAll{}
 	_ PhysicalPlan = &PhysicalSort{}
@@ -96,6 +97,16 @@ type PartitionInfo struct {
 	ColumnNames    types.NameSlice
 }
 
+// Clone clones the PartitionInfo.
+func (pi *PartitionInfo) Clone() PartitionInfo {
+	return PartitionInfo{
+		PruningConds:   cloneExprs(pi.PruningConds),
+		PartitionNames: append([]model.CIStr(nil), pi.PartitionNames...),
+		Columns:        cloneCols(pi.Columns),
+		ColumnNames:    append(types.NameSlice(nil), pi.ColumnNames...),
+	}
+}
+
 // GetTablePlan exports the tablePlan.
 func (p *PhysicalTableReader) GetTablePlan() PhysicalPlan {
 	return p.tablePlan
@@ -161,6 +172,7 @@ func (p *PhysicalTableReader) Clone() (PhysicalPlan, error) {
 	if cloned.TablePlans, err = clonePhysicalPlan(p.TablePlans); err != nil {
 		return nil, err
 	}
+	cloned.PartitionInfo = p.PartitionInfo.Clone()
 	return cloned, nil
 }
 
@@ -208,6 +220,7 @@ func (p *PhysicalIndexReader) Clone() (PhysicalPlan, error) {
 		return nil, err
 	}
 	cloned.OutputColumns = cloneCols(p.OutputColumns)
+	cloned.PartitionInfo = p.PartitionInfo.Clone()
 	return cloned, err
 }
 
@@ -300,6 +313,8 @@ func (p *PhysicalIndexLookUpReader) Clone() (PhysicalPlan, error) {
 	if p.PushedLimit != nil {
 		cloned.PushedLimit = p.PushedLimit.Clone()
 	}
+	cloned.CommonHandleCols = cloneCols(p.CommonHandleCols)
+	cloned.PartitionInfo = p.PartitionInfo.Clone()
 	return cloned, nil
 }
 
@@ -416,6 +431,12 @@ func (p *PhysicalIndexScan) Clone() (PhysicalPlan, error) {
 	if p.Hist != nil {
 		cloned.Hist = p.Hist.Copy()
 	}
+	if p.GenExprs != nil {
+		cloned.GenExprs = make(map[model.TableColumnID]expression.Expression, len(p.GenExprs))
+		for id, expr := range p.GenExprs {
+			cloned.GenExprs[id] = expr.Clone()
+		}
+	}
 	return cloned, nil
 }
 
@@ -508,6 +529,7 @@ func (ts *PhysicalTableScan) Clone() (PhysicalPlan, error) {
 		clonedScan.Hist = ts.Hist.Copy()
 	}
 	clonedScan.rangeDecidedBy = cloneCols(ts.rangeDecidedBy)
+	clonedScan.PartitionInfo = ts.PartitionInfo.Clone()
 	return clonedScan, nil
 }
 
@@ -1237,6 +1259,28 @@ type PhysicalMaxOneRow struct {
 	basePhysicalPlan
 }
 
+// PhysicalExpand is the physical operator of expand.
+type PhysicalExpand struct {
+	physicalSchemaProducer
+
+	LevelExprs [][]expression.Expression
+}
+
+// Clone implements PhysicalPlan interface.
+func (p *PhysicalExpand) Clone() (PhysicalPlan, error) {
+	cloned := new(PhysicalExpand)
+	base, err := p.physicalSchemaProducer.cloneWithSelf(cloned)
+	if err != nil {
+		return nil, err
+	}
+	cloned.physicalSchemaProducer = *base
+	cloned.LevelExprs = make([][]expression.Expression, 0, len(p.LevelExprs))
+	for _, exprs := range p.LevelExprs {
+		cloned.LevelExprs = append(cloned.LevelExprs, cloneExprs(exprs))
+	}
+	return cloned, nil
+}
+
 // PhysicalTableDual is the physical operator of dual.
 type PhysicalTableDual struct {
 	physicalSchemaProducer
@@ -1359,6 +1403,34 @@ type PhysicalShow struct {
 	ShowContents
 }
 
+// PhysicalJSONTable is the physical plan of JSON_TABLE.
+type PhysicalJSONTable struct {
+	physicalSchemaProducer
+
+	Expr    expression.Expression
+	Path    string
+	Columns []*ast.JSONTableColumn
+}
+
+// Clone implements PhysicalPlan interface.
+func (p *PhysicalJSONTable) Clone() (PhysicalPlan, error) {
+	cloned := new(PhysicalJSONTable)
+	base, err := p.physicalSchemaProducer.cloneWithSelf(cloned)
+	if err != nil {
+		return nil, err
+	}
+	cloned.physicalSchemaProducer = *base
+	cloned.Expr = p.Expr.Clone()
+	cloned.Path = p.Path
+	cloned.Columns = p.Columns
+	return cloned, nil
+}
+
+// ExtractCorrelatedCols implements PhysicalPlan interface.
+func (p *PhysicalJSONTable) ExtractCorrelatedCols() []*expression.CorrelatedColumn {
+	return expression.ExtractCorColumns(p.Expr)
+}
+
 // PhysicalShowDDLJobs is for showing DDL job list.
 type PhysicalShowDDLJobs struct {
 	physicalSchemaProducer
@@ -697,3 +697,7 @@ func (p *basePhysicalPlan) SetChild(i int, child PhysicalPlan) {
 func (p *basePlan) SCtx() sessionctx.Context {
 	return p.ctx
 }
+
+func (p *basePlan) setSCtx(ctx sessionctx.Context) {
+	p.ctx = ctx
+}
@@ -0,0 +1,184 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/format"
+	"github.com/pingcap/tidb/infoschema"
+	"github.com/pingcap/tidb/types"
+	driver "github.com/pingcap/tidb/types/parser_driver"
+	"github.com/pingcap/tidb/util/codec"
+	"github.com/pingcap/tidb/util/hack"
+	"github.com/pingcap/tidb/util/kvcache"
+)
+
+// NonPreparedPlanCacheable checks whether the plan of the text query can be cached
+// after its literals are parameterized by ParameterizeAST. Only the simple
+// SELECT, UPDATE, DELETE and INSERT ... VALUES statements are supported.
+func NonPreparedPlanCacheable(node ast.Node, is infoschema.InfoSchema) bool {
+	switch x := node.(type) {
+	case *ast.SelectStmt:
+		if x.SelectIntoOpt != nil || x.With != nil {
+			return false
+		}
+	case *ast.InsertStmt:
+		if x.Select != nil {
+			return false
+		}
+	case *ast.UpdateStmt, *ast.DeleteStmt:
+	default:
+		return false
+	}
+	return Cacheable(node, is)
+}
+
+// ParameterizeAST replaces the literals of the statement with parameter markers,
+// it returns the SQL text with the markers and the values of the parameters in
+// the order they appear. The statement is left unchanged after it returns.
+func ParameterizeAST(node ast.StmtNode) (paramSQL string, params []types.Datum, err error) {
+	r := &paramReplacer{params: &params}
+	node.Accept(r)
+	var sb strings.Builder
+	err = node.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb))
+	node.Accept(&paramRestorer{})
+	if err != nil {
+		return "", nil, errors.Trace(err)
+	}
+	return sb.String(), params, nil
+}
+
+// paramMarker is the parameter marker that replaces a literal. It is restored
+// as "?" and collects the literal when restored, so the parameters are always
+// in the order they appear in the SQL text.
+type paramMarker struct {
+	*driver.ValueExpr
+	params *[]types.Datum
+}
+
+// Restore implements Node interface.
+func (m *paramMarker) Restore(ctx *format.RestoreCtx) error {
+	ctx.WritePlain("?")
+	*m.params = append(*m.params, m.Datum)
+	return nil
+}
+
+// Accept implements Node interface.
+func (m *paramMarker) Accept(v ast.Visitor) (ast.Node, bool) {
+	newNode, _ := v.Enter(m)
+	return v.Leave(newNode)
+}
+
+// nonParameterizableFuncs are the functions whose arguments are restored
+// depending on their values, or whose plans depend on the literal arguments.
+var nonParameterizableFuncs = map[string]struct{}{
+	ast.Convert:          {},
+	ast.Trim:             {},
+	ast.WeightString:     {},
+	ast.GetFormat:        {},
+	ast.Extract:          {},
+	ast.Position:         {},
+	ast.DateFormat:       {},
+	ast.StrToDate:        {},
+	ast.TimeFormat:       {},
+	ast.FromUnixTime:     {},
+	ast.DateLiteral:      {},
+	ast.TimeLiteral:      {},
+	ast.TimestampLiteral: {},
+}
+
+// paramReplacer replaces the literals with paramMarkers.
+type paramReplacer struct {
+	params *[]types.Datum
+}
+
+// Enter implements Visitor interface.
+func (r *paramReplacer) Enter(in ast.Node) (out ast.Node, skipChildren bool) {
+	switch x := in.(type) {
+	case *ast.SelectField, *ast.GroupByClause, *ast.OrderByClause, *ast.Limit, *ast.WindowSpec,
+		*ast.AggregateFuncExpr, *ast.WindowFuncExpr, *ast.MatchAgainst, *ast.TableOptimizerHint:
+		// The literals in the fields are kept to keep the output names, the
+		// others are kept to keep the plans the same as the text queries.
+		return in, true
+	case *ast.FuncCallExpr:
+		if _, ok := nonParameterizableFuncs[x.FnName.L]; ok {
+			return in, true
+		}
+	}
+	return in, false
+}
+
+// Leave implements Visitor interface.
+func (r *paramReplacer) Leave(in ast.Node) (out ast.Node, ok bool) {
+	v, ok := in.(*driver.ValueExpr)
+	if !ok {
+		return in, true
+	}
+	switch v.Kind() {
+	case types.KindNull, types.KindBinaryLiteral, types.KindMysqlBit:
+		// The type of the parameter can't be inferred from these values.
+		return in, true
+	case types.KindString:
+		// The strings with charset introducers are kept, since the parameters
+		// are always in the charset and collation of the connection.
+		if v.Type.Collate != v.Datum.Collation() {
+			return in, true
+		}
+	}
+	return &paramMarker{ValueExpr: v, params: r.params}, true
+}
+
+// paramRestorer restores the paramMarkers to the literals they replaced.
+type paramRestorer struct{}
+
+// Enter implements Visitor interface.
+func (r *paramRestorer) Enter(in ast.Node) (out ast.Node, skipChildren bool) {
+	return in, false
+}
+
+// Leave implements Visitor interface.
+func (r *paramRestorer) Leave(in ast.Node) (out ast.Node, ok bool) {
+	if m, ok := in.(*paramMarker); ok {
+		return m.ValueExpr, true
+	}
+	return in, true
+}
+
+// nonPreparedPlanCacheKey is the key of the statements prepared for the text
+// queries. The kinds of the parameters are a part of the key since the cached
+// plans depend on the types of the parameters.
+type nonPreparedPlanCacheKey struct {
+	database   string
+	paramSQL   string
+	paramKinds []byte
+}
+
+// Hash implements Key interface.
+func (key *nonPreparedPlanCacheKey) Hash() []byte {
+	hash := codec.EncodeCompactBytes(nil, hack.Slice(key.database))
+	hash = codec.EncodeCompactBytes(hash, key.paramKinds)
+	return append(hash, key.paramSQL...)
+}
+
+// NewNonPreparedPlanCacheKey creates the key of the statement prepared for the parameterized text query.
+func NewNonPreparedPlanCacheKey(database, paramSQL string, params []types.Datum) kvcache.Key {
+	kinds := make([]byte, 0, len(params))
+	for _, param := range params {
+		kinds = append(kinds, param.Kind())
+	}
+	return &nonPreparedPlanCacheKey{database: database, paramSQL: paramSQL, paramKinds: kinds}
+}
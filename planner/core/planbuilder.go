@@ -449,6 +449,9 @@ type PlanBuilder struct {
 	outerCTEs    []*cteInfo
 	// colMapper stores the column that must be pre-resolved.
 	colMapper map[*ast.ColumnNameExpr]int
+	// groupingMapper stores the GROUPING functions in the HAVING and ORDER BY clauses,
+	// which are evaluated as auxiliary select fields.
+	groupingMapper map[*ast.FuncCallExpr]int
 	// visitInfo is used for privilege check.
 	visitInfo     []visitInfo
 	tableHintInfo []tableHintInfo
@@ -510,6 +513,10 @@ type PlanBuilder struct {
 	isForUpdateRead             bool
 	allocIDForCTEStorage        int
 	buildingRecursivePartForCTE bool
+
+	// rollupExpand is the Expand built for the rollup of the SELECT being built,
+	// it is used to rewrite the GROUPING function.
+	rollupExpand *LogicalExpand
 }
 
 type handleColHelper struct {
@@ -616,6 +623,7 @@ func NewPlanBuilder() *PlanBuilder {
 	return &PlanBuilder{
 		outerCTEs:           make([]*cteInfo, 0),
 		colMapper:           make(map[*ast.ColumnNameExpr]int),
+		groupingMapper:      make(map[*ast.FuncCallExpr]int),
 		handleHelper:        &handleColHelper{id2HandleMapStack: make([]map[int64][]HandleCols, 0)},
 		correlatedAggMapper: make(map[*ast.AggregateFuncExpr]*expression.CorrelatedColumn),
 	}
@@ -650,6 +658,10 @@ func (b *PlanBuilder) ResetForReuse() *PlanBuilder {
 	for k := range saveColMapper {
 		delete(saveColMapper, k)
 	}
+	saveGroupingMapper := b.groupingMapper
+	for k := range saveGroupingMapper {
+		delete(saveGroupingMapper, k)
+	}
 	saveHandleHelper := b.handleHelper
 	saveHandleHelper.resetForReuse()
 
@@ -665,6 +677,7 @@ func (b *PlanBuilder) ResetForReuse() *PlanBuilder {
 	// It's a bit conservative but easier to get right.
 	b.outerCTEs = saveOuterCTEs
 	b.colMapper = saveColMapper
+	b.groupingMapper = saveGroupingMapper
 	b.handleHelper = saveHandleHelper
 	b.correlatedAggMapper = saveCorrelateAggMapper
 
@@ -767,6 +780,9 @@ func (b *PlanBuilder) buildExecute(ctx context.Context, v *ast.ExecuteStmt) (Pla
 		vars = append(vars, newExpr)
 	}
 	exe := &Execute{Name: v.Name, UsingVars: vars, ExecID: v.ExecID}
+	if v.PrepStmt != nil {
+		exe.PrepStmt = v.PrepStmt.(*CachedPrepareStmt)
+	}
 	if v.BinaryArgs != nil {
 		exe.PrepareParams = v.BinaryArgs.([]types.Datum)
 	}
@@ -1079,6 +1095,9 @@ func getPossibleAccessPaths(ctx sessionctx.Context, tableHints *tableHintInfo, i
 			publicPaths = append(publicPaths, &util.AccessPath{Index: index})
 		}
 	}
+	for _, index := range getHypoIndexes(ctx, tblInfo.ID) {
+		publicPaths = append(publicPaths, &util.AccessPath{Index: index})
+	}
 
 	hasScanHint, hasUseOrForce := false, false
 	available := make([]*util.AccessPath, 0, len(publicPaths))
@@ -72,6 +72,7 @@ type PointGetPlan struct {
 	outputNames        []*types.FieldName
 	LockWaitTime       int64
 	partitionColumnPos int
+	partitionNames     []model.CIStr
 	Columns            []*model.ColumnInfo
 	cost               float64
 }
@@ -911,6 +912,7 @@ func tryPointGetPlan(ctx sessionctx.Context, selStmt *ast.SelectStmt, check bool
 		p.UnsignedHandle = mysql.HasUnsignedFlag(fieldType.Flag)
 		p.HandleParam = handlePair.param
 		p.PartitionInfo = partitionInfo
+		p.partitionNames = tblName.PartitionNames
 		return p
 	} else if handlePair.value.Kind() != types.KindNull {
 		return nil
@@ -965,6 +967,7 @@ func tryPointGetPlan(ctx sessionctx.Context, selStmt *ast.SelectStmt, check bool
 		p.PartitionInfo = partitionInfo
 		if p.PartitionInfo != nil {
 			p.partitionColumnPos = findPartitionIdx(idxInfo, pos, pairs)
+			p.partitionNames = tblName.PartitionNames
 		}
 		return p
 	}
@@ -1523,69 +1526,102 @@ func getPartitionInfo(ctx sessionctx.Context, tbl *model.TableInfo, pairs []name
 		return nil, 0, false
 	}
 
-	switch pi.Type {
-	case model.PartitionTypeHash:
-		expr := partitionExpr.OrigExpr
-		col, ok := expr.(*ast.ColumnNameExpr)
-		if !ok {
-			return nil, 0, false
-		}
-
-		partitionColName := col.Name
-		if partitionColName == nil {
-			return nil, 0, false
+	col := getPointGetPartitionColumn(tbl, pi, partitionExpr)
+	if col == nil {
+		return nil, 0, false
+	}
+	for i, pair := range pairs {
+		if col.Name.L == pair.colName {
+			val := pair.value.GetInt64() // val cannot be Null, we've check this in func getNameValuePairs
+			partitionDef, isTableDual := locatePointGetPartition(pi, partitionExpr, val, mysql.HasUnsignedFlag(col.Flag))
+			return partitionDef, i, isTableDual
 		}
+	}
+	return nil, 0, false
+}
 
-		for i, pair := range pairs {
-			if partitionColName.Name.L == pair.colName {
-				val := pair.value.GetInt64()
-				pos := math.Abs(val % int64(pi.Num))
-				return &pi.Definitions[pos], i, false
-			}
+// getPointGetPartitionColumn gets the column which the partition of the point get is located by,
+// nil is returned if the partition can't be located by a single column.
+func getPointGetPartitionColumn(tbl *model.TableInfo, pi *model.PartitionInfo, partitionExpr *tables.PartitionExpr) *model.ColumnInfo {
+	switch pi.Type {
+	case model.PartitionTypeHash:
+		col, ok := partitionExpr.OrigExpr.(*ast.ColumnNameExpr)
+		if !ok || col.Name == nil {
+			return nil
 		}
+		return model.FindColumnInfo(tbl.Columns, col.Name.Name.L)
 	case model.PartitionTypeRange:
 		// left range columns partition for future development
-		if len(pi.Columns) == 0 {
-			if col, ok := partitionExpr.Expr.(*expression.Column); ok {
-				colInfo := findColNameByColID(tbl.Columns, col)
-				for i, pair := range pairs {
-					if colInfo.Name.L == pair.colName {
-						val := pair.value.GetInt64() // val cannot be Null, we've check this in func getNameValuePairs
-						unsigned := mysql.HasUnsignedFlag(col.GetType().Flag)
-						ranges := partitionExpr.ForRangePruning
-						length := len(ranges.LessThan)
-						pos := sort.Search(length, func(i int) bool {
-							return ranges.Compare(i, val, unsigned) > 0
-						})
-						if pos >= 0 && pos < length {
-							return &pi.Definitions[pos], i, false
-						}
-						return nil, 0, true
-					}
-				}
-			}
+		if col, ok := partitionExpr.Expr.(*expression.Column); ok && len(pi.Columns) == 0 {
+			return findColNameByColID(tbl.Columns, col)
 		}
 	case model.PartitionTypeList:
 		// left list columns partition for future development
-		if partitionExpr.ForListPruning.ColPrunes == nil {
-			locateExpr := partitionExpr.ForListPruning.LocateExpr
-			if locateExpr, ok := locateExpr.(*expression.Column); ok {
-				colInfo := findColNameByColID(tbl.Columns, locateExpr)
-				for i, pair := range pairs {
-					if colInfo.Name.L == pair.colName {
-						val := pair.value.GetInt64() // val cannot be Null, we've check this in func getNameValuePairs
-						isNull := false
-						pos := partitionExpr.ForListPruning.LocatePartition(val, isNull)
-						if pos >= 0 {
-							return &pi.Definitions[pos], i, false
-						}
-						return nil, 0, true
-					}
-				}
-			}
+		if col, ok := partitionExpr.ForListPruning.LocateExpr.(*expression.Column); ok && partitionExpr.ForListPruning.ColPrunes == nil {
+			return findColNameByColID(tbl.Columns, col)
 		}
 	}
-	return nil, 0, false
+	return nil
+}
+
+// locatePointGetPartition locates the partition by the value of the partition column,
+// isTableDual is true if no partition contains the value.
+func locatePointGetPartition(pi *model.PartitionInfo, partitionExpr *tables.PartitionExpr, val int64, unsigned bool) (*model.PartitionDefinition, bool) {
+	switch pi.Type {
+	case model.PartitionTypeHash:
+		pos := math.Abs(val % int64(pi.Num))
+		return &pi.Definitions[pos], false
+	case model.PartitionTypeRange:
+		ranges := partitionExpr.ForRangePruning
+		length := len(ranges.LessThan)
+		pos := sort.Search(length, func(i int) bool {
+			return ranges.Compare(i, val, unsigned) > 0
+		})
+		if pos >= 0 && pos < length {
+			return &pi.Definitions[pos], false
+		}
+	case model.PartitionTypeList:
+		isNull := false
+		pos := partitionExpr.ForListPruning.LocatePartition(val, isNull)
+		if pos >= 0 {
+			return &pi.Definitions[pos], false
+		}
+	}
+	return nil, true
+}
+
+// relocatePartition locates the partition again after the handle or the index values
+// are rebuilt from the parameters of the prepared statement.
+func (p *PointGetPlan) relocatePartition() error {
+	partitionExpr := getPartitionExpr(p.SCtx(), p.TblInfo)
+	if partitionExpr == nil {
+		return errors.New("point get for partition table can not use plan cache")
+	}
+	pi := p.TblInfo.GetPartitionInfo()
+	col := getPointGetPartitionColumn(p.TblInfo, pi, partitionExpr)
+	if col == nil {
+		return errors.New("point get for partition table can not use plan cache")
+	}
+	var val int64
+	if p.IndexInfo == nil {
+		val = p.Handle.IntValue()
+	} else {
+		d, err := p.IndexValues[p.partitionColumnPos].ConvertTo(p.SCtx().GetSessionVars().StmtCtx, &col.FieldType)
+		if err != nil {
+			return err
+		}
+		if d.IsNull() {
+			return errors.New("point get for partition table can not use plan cache")
+		}
+		val = d.GetInt64()
+	}
+	partitionDef, isTableDual := locatePointGetPartition(pi, partitionExpr, val, mysql.HasUnsignedFlag(col.Flag))
+	if isTableDual || (len(p.partitionNames) > 0 && !partitionNameInSet(partitionDef.Name, p.partitionNames)) {
+		// The cached plan can't be a table dual, the plan is rebuilt instead.
+		return errors.New("no partition is found for the point get")
+	}
+	p.PartitionInfo = partitionDef
+	return nil
 }
 
 func findPartitionIdx(idxInfo *model.IndexInfo, pos int, pairs []nameValuePair) int {
@@ -20,6 +20,7 @@ import (
 	"math/rand"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	. "github.com/pingcap/check"
@@ -590,6 +591,86 @@ func (s *testPrepareSerialSuite) TestPrepareCacheForPartition(c *C) {
 	}
 }
 
+func (s *testPrepareSerialSuite) TestPrepareCacheForPartitionPruning(c *C) {
+	defer testleak.AfterTest(c)()
+	store, dom, err := newStoreWithBootstrap()
+	c.Assert(err, IsNil)
+	tk := testkit.NewTestKit(c, store)
+	orgEnable := core.PreparedPlanCacheEnabled()
+	defer func() {
+		dom.Close()
+		err = store.Close()
+		c.Assert(err, IsNil)
+		core.SetPreparedPlanCache(orgEnable)
+	}()
+	core.SetPreparedPlanCache(true)
+
+	tk.Se, err = session.CreateSession4TestWithOpt(store, &session.Opt{
+		PreparedPlanCache: kvcache.NewSimpleLRUCache(100, 0.1, math.MaxUint64),
+	})
+	c.Assert(err, IsNil)
+
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t_range, t_hash_a, t_hash")
+	tk.MustExec("create table t_range (a int, b int) partition by range(a) (partition p0 values less than (10), partition p1 values less than (20), partition p2 values less than (30))")
+	tk.MustExec("insert into t_range values (1, 1), (15, 15), (25, 25)")
+	tk.MustExec("create table t_hash_a (a int, b int) partition by hash(a) partitions 3")
+	tk.MustExec("insert into t_hash_a values (1, 1), (2, 2), (3, 3)")
+	tk.MustExec("create table t_hash (id int primary key, c int) partition by hash(id) partitions 4")
+	tk.MustExec("insert into t_hash values (1, 1), (2, 2), (5, 5)")
+
+	for _, mode := range []string{string(variable.Static), string(variable.Dynamic)} {
+		tk.MustExec("set @@tidb_partition_prune_mode = '" + mode + "'")
+		fromCache := "1"
+		if mode == string(variable.Static) {
+			// The partitions pruned by the parameters are a part of the plan in the static mode.
+			fromCache = "0"
+		}
+		tk.MustExec("prepare stmt from 'select b from t_hash_a where a = ?'")
+		tk.MustExec("set @a = 1")
+		tk.MustQuery("execute stmt using @a").Check(testkit.Rows("1"))
+		tk.MustExec("set @a = 2")
+		tk.MustQuery("execute stmt using @a").Check(testkit.Rows("2"))
+		tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows(fromCache))
+		tk.MustExec("set @a = 4")
+		tk.MustQuery("execute stmt using @a").Check(testkit.Rows())
+		tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows(fromCache))
+
+		// The plans which read all the partitions can be cached in both modes.
+		tk.MustExec("prepare stmt from 'select b from t_range where a = ?'")
+		tk.MustExec("set @a = 1")
+		tk.MustQuery("execute stmt using @a").Check(testkit.Rows("1"))
+		tk.MustExec("set @a = 15")
+		tk.MustQuery("execute stmt using @a").Check(testkit.Rows("15"))
+		tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+		tk.MustExec("prepare stmt from 'select a from t_range where b = ?'")
+		tk.MustExec("set @b = 1")
+		tk.MustQuery("execute stmt using @b").Check(testkit.Rows("1"))
+		tk.MustExec("set @b = 25")
+		tk.MustQuery("execute stmt using @b").Check(testkit.Rows("25"))
+		tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+
+		// The partition of the point get is located again by the parameters.
+		tk.MustExec("prepare stmt from 'select c from t_hash where id = ?'")
+		tk.MustExec("set @id = 1")
+		tk.MustQuery("execute stmt using @id").Check(testkit.Rows("1"))
+		tk.MustExec("set @id = 2")
+		tk.MustQuery("execute stmt using @id").Check(testkit.Rows("2"))
+		tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+		tk.MustExec("set @id = 5")
+		tk.MustQuery("execute stmt using @id").Check(testkit.Rows("5"))
+		tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+
+		tk.MustExec("prepare stmt from 'select c from t_hash partition(p1) where id = ?'")
+		tk.MustExec("set @id = 1")
+		tk.MustQuery("execute stmt using @id").Check(testkit.Rows("1"))
+		tk.MustExec("set @id = 2")
+		tk.MustQuery("execute stmt using @id").Check(testkit.Rows())
+		tk.MustExec("set @id = 5")
+		tk.MustQuery("execute stmt using @id").Check(testkit.Rows("5"))
+	}
+}
+
 func newSession(c *C, store kv.Storage, dbName string) session.Session {
 	se, err := session.CreateSession4Test(store)
 	c.Assert(err, IsNil)
@@ -1515,3 +1596,223 @@ func (s *testPlanSerialSuite) TestPartitionWithVariedDatasources(c *C) {
 		}
 	}
 }
+
+func (s *testPrepareSerialSuite) TestNonPreparedPlanCache(c *C) {
+	defer testleak.AfterTest(c)()
+	store, dom, err := newStoreWithBootstrap()
+	c.Assert(err, IsNil)
+	tk := testkit.NewTestKit(c, store)
+	orgEnable := core.PreparedPlanCacheEnabled()
+	defer func() {
+		dom.Close()
+		err = store.Close()
+		c.Assert(err, IsNil)
+		core.SetPreparedPlanCache(orgEnable)
+	}()
+	core.SetPreparedPlanCache(true)
+	tk.Se, err = session.CreateSession4TestWithOpt(store, &session.Opt{
+		PreparedPlanCache: kvcache.NewSimpleLRUCache(100, 0.1, math.MaxUint64),
+	})
+	c.Assert(err, IsNil)
+
+	c.Assert(tk.Se.Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil), IsTrue)
+	tk.MustExec("set global tidb_enable_stmt_summary = 0")
+	tk.MustExec("set global tidb_enable_stmt_summary = 1")
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t(a int, b int, c int)")
+	tk.MustExec("set @@tidb_enable_non_prepared_plan_cache = 1")
+	tk.MustExec("insert into t values (1, 1, 1), (2, 2, 2)")
+	tk.MustExec("insert into t values (3, 3, 3), (4, 4, 4)")
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+
+	tk.MustQuery("select * from t where b > 1 and b < 3").Check(testkit.Rows("2 2 2"))
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("0"))
+	tk.MustQuery("select * from t where b > 2 and b < 5").Sort().Check(testkit.Rows("3 3 3", "4 4 4"))
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+	tk.MustQuery("select * from t where b > 'x' and b < 2").Check(testkit.Rows("1 1 1"))
+	c.Assert(tk.Se.ShowProcess().Info, Equals, "select * from t where b > 'x' and b < 2")
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("0"))
+	tk.MustQuery("select sum(plan_cache_hits), sum(plan_cache_misses) from information_schema.statements_summary where digest_text = 'select * from `t` where `b` > ? and `b` < ?'").
+		Check(testkit.Rows("1 2"))
+	// The statements prepared for the text queries are invisible to the users.
+	c.Assert(tk.Se.GetSessionVars().PreparedStmts, HasLen, 0)
+	tk.MustQuery("select * from t where b = _binary'1'").Check(testkit.Rows("1 1 1"))
+	tk.MustQuery("select * from t where b = _binary'2'").Check(testkit.Rows("2 2 2"))
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("0"))
+
+	tk.MustExec("update t set b = 10 where b = 1")
+	tk.MustExec("update t set b = 20 where b = 2")
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+	tk.MustExec("delete from t where b = 3")
+	tk.MustExec("delete from t where b = 4")
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 10 1", "2 20 2"))
+
+	// The output names are kept.
+	rs, err := tk.Exec("select a, 1 from t where a = 1")
+	c.Assert(err, IsNil)
+	c.Assert(rs.Fields()[1].Column.Name.O, Equals, "1")
+	c.Assert(rs.Close(), IsNil)
+
+	// The plan is not cached if the statement isn't supported.
+	tk.MustQuery("select a, b from t where a = 1 union all select a, b from t where a = 2").Sort().Check(testkit.Rows("1 10", "2 20"))
+	tk.MustQuery("select a, b from t where a = 1 union all select a, b from t where a = 2").Sort().Check(testkit.Rows("1 10", "2 20"))
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("0"))
+
+	tk.MustExec("set @@tidb_enable_non_prepared_plan_cache = 0")
+	tk.MustQuery("select * from t where b > 1 and b < 3").Check(testkit.Rows())
+	tk.MustQuery("select * from t where b > 1 and b < 30").Check(testkit.Rows("1 10 1", "2 20 2"))
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("0"))
+}
+
+func (s *testPrepareSerialSuite) TestInstancePlanCache(c *C) {
+	defer testleak.AfterTest(c)()
+	store, dom, err := newStoreWithBootstrap()
+	c.Assert(err, IsNil)
+	orgEnable := core.PreparedPlanCacheEnabled()
+	defer func() {
+		core.SetInstancePlanCache(nil)
+		dom.Close()
+		err = store.Close()
+		c.Assert(err, IsNil)
+		core.SetPreparedPlanCache(orgEnable)
+	}()
+	core.SetPreparedPlanCache(true)
+	planCache := core.NewInstancePlanCache(1 << 30)
+	core.SetInstancePlanCache(planCache)
+
+	newTestKit := func() *testkit.TestKit {
+		tk := testkit.NewTestKit(c, store)
+		tk.Se, err = session.CreateSession4TestWithOpt(store, &session.Opt{
+			PreparedPlanCache: kvcache.NewSimpleLRUCache(100, 0.1, math.MaxUint64),
+		})
+		c.Assert(err, IsNil)
+		tk.MustExec("use test")
+		return tk
+	}
+	tk1, tk2 := newTestKit(), newTestKit()
+	tk1.MustExec("drop table if exists t")
+	tk1.MustExec("create table t(a int, b int, key(a))")
+	tk1.MustExec("insert into t values (1, 1), (2, 2), (3, 3)")
+
+	tk1.MustExec("prepare stmt from 'select b from t where a > ? and b < 3'")
+	tk1.MustExec("set @a = 1")
+	tk1.MustQuery("execute stmt using @a").Check(testkit.Rows("2"))
+	tk1.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("0"))
+	c.Assert(planCache.Size(), Equals, 1)
+	c.Assert(planCache.MemTracker().BytesConsumed() > 0, IsTrue)
+
+	// The plan built by the other session is used at the first execution.
+	tk2.MustExec("prepare stmt from 'select b from t where a > ? and b < 3'")
+	tk2.MustExec("set @a = 0")
+	tk2.MustQuery("execute stmt using @a").Sort().Check(testkit.Rows("1", "2"))
+	tk2.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+	tk2.MustQuery("execute stmt using @a").Sort().Check(testkit.Rows("1", "2"))
+	tk2.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+	tk1.MustQuery("execute stmt using @a").Check(testkit.Rows("2"))
+
+	// The plan isn't shared if the session variables affecting the plans are different.
+	tk3 := newTestKit()
+	tk3.MustExec("set @@tidb_enable_index_merge = 1")
+	tk3.MustExec("prepare stmt from 'select b from t where a > ? and b < 3'")
+	tk3.MustExec("set @a = 0")
+	tk3.MustQuery("execute stmt using @a").Sort().Check(testkit.Rows("1", "2"))
+	tk3.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("0"))
+	c.Assert(planCache.Size(), Equals, 2)
+	tk4 := newTestKit()
+	tk4.MustExec("set @@tidb_opt_cpu_factor = 10")
+	tk4.MustExec("prepare stmt from 'select b from t where a > ? and b < 3'")
+	tk4.MustExec("set @a = 0")
+	tk4.MustQuery("execute stmt using @a").Sort().Check(testkit.Rows("1", "2"))
+	tk4.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("0"))
+	c.Assert(planCache.Size(), Equals, 3)
+	tk4 = newTestKit()
+	tk4.MustExec("set @@tidb_partition_prune_mode = 'static'")
+	tk4.MustExec("prepare stmt from 'select b from t where a > ? and b < 3'")
+	tk4.MustExec("set @a = 0")
+	tk4.MustQuery("execute stmt using @a").Sort().Check(testkit.Rows("1", "2"))
+	tk4.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("0"))
+	c.Assert(planCache.Size(), Equals, 4)
+
+	// The plan isn't shared once the global binding of the statement is changed.
+	tk1.MustExec("create global binding for select b from t where a > 1 and b < 3 using select b from t ignore index(a) where a > 1 and b < 3")
+	tk4 = newTestKit()
+	tk4.MustExec("prepare stmt from 'select b from t where a > ? and b < 3'")
+	tk4.MustExec("set @a = 0")
+	tk4.MustQuery("execute stmt using @a").Sort().Check(testkit.Rows("1", "2"))
+	tk4.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("0"))
+	c.Assert(planCache.Size(), Equals, 5)
+	tk1.MustExec("drop global binding for select b from t where a > 1 and b < 3")
+
+	// The plans which can't be bound to the other sessions aren't shared.
+	tk1.MustExec("prepare stmt_apply from 'select a from t t1 where b > (select count(*) from t t2 where t2.a > t1.a and t2.b > ?)'")
+	tk1.MustQuery("execute stmt_apply using @a").Sort().Check(testkit.Rows("2", "3"))
+	c.Assert(planCache.Size(), Equals, 5)
+
+	// The partitions are pruned by the shared plan.
+	tk1.MustExec("drop table if exists tp")
+	tk1.MustExec("create table tp(a int, b int, key(a)) partition by hash(b) partitions 4")
+	tk1.MustExec("insert into tp values (1, 1), (2, 2), (3, 3)")
+	tk1.MustExec("set @@tidb_partition_prune_mode = 'dynamic'")
+	tk4.MustExec("set @@tidb_partition_prune_mode = 'dynamic'")
+	tk1.MustExec("prepare stmt_part from 'select a from tp where a > ? and b = 2'")
+	tk1.MustQuery("execute stmt_part using @a").Check(testkit.Rows("2"))
+	tk4.MustExec("prepare stmt_part from 'select a from tp where a > ? and b = 2'")
+	tk4.MustQuery("execute stmt_part using @a").Check(testkit.Rows("2"))
+	tk4.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+
+	// The memory usage grows with the size of the cached plan.
+	consumed := planCache.MemTracker().BytesConsumed()
+	tk1.MustExec("prepare stmt_small from 'select b from t where a = ?'")
+	tk1.MustQuery("execute stmt_small using @a")
+	smallUsage := planCache.MemTracker().BytesConsumed() - consumed
+	consumed = planCache.MemTracker().BytesConsumed()
+	tk1.MustExec("prepare stmt_large from 'select b from t where a = ? and b in (1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16)'")
+	tk1.MustQuery("execute stmt_large using @a")
+	largeUsage := planCache.MemTracker().BytesConsumed() - consumed
+	c.Assert(largeUsage-smallUsage > 16*100, IsTrue, Commentf("%v %v", smallUsage, largeUsage))
+	tk1.MustExec("set @@tidb_partition_prune_mode = default")
+
+	// The plan isn't shared if the parameter types are different.
+	tk2.MustExec("set @a = '1'")
+	tk2.MustQuery("execute stmt using @a").Check(testkit.Rows("2"))
+	tk2.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("0"))
+
+	// The privileges are checked when the plan is shared.
+	tk1.MustExec("create user 'u_ipc'@'localhost'")
+	defer tk1.MustExec("drop user 'u_ipc'@'localhost'")
+	userSess := newSession(c, store, "test")
+	c.Assert(userSess.Auth(&auth.UserIdentity{Username: "u_ipc", Hostname: "localhost"}, nil, nil), IsTrue)
+	mustExec(c, userSess, "prepare stmt from 'select b from t where a > ? and b < 3'")
+	mustExec(c, userSess, "set @a = 0")
+	_, err = userSess.Execute(context.Background(), "execute stmt using @a")
+	c.Assert(err, NotNil)
+	c.Assert(strings.Contains(err.Error(), "SELECT command denied"), IsTrue, Commentf("%v", err))
+
+	// The sessions execute their own clones of the shared plan concurrently.
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		tk := newTestKit()
+		tk.MustExec("prepare stmt from 'select b from t where a > ? and b < 3'")
+		wg.Add(1)
+		go func(tk *testkit.TestKit) {
+			defer wg.Done()
+			tk.MustExec("set @a = 1")
+			for j := 0; j < 20; j++ {
+				tk.MustQuery("execute stmt using @a").Check(testkit.Rows("2"))
+			}
+		}(tk)
+	}
+	wg.Wait()
+
+	// The least recently used plans are evicted once the memory limit is exceeded.
+	planCache = core.NewInstancePlanCache(planCache.MemTracker().BytesConsumed())
+	core.SetInstancePlanCache(planCache)
+	for i := 0; i < 10; i++ {
+		tk1.MustExec(fmt.Sprintf("prepare stmt%d from 'select b from t where a > ? and b < %d'", i, i))
+		tk1.MustQuery(fmt.Sprintf("execute stmt%d using @a", i))
+		c.Assert(planCache.MemTracker().BytesConsumed() <= planCache.MemTracker().GetBytesLimit(), IsTrue)
+	}
+	c.Assert(planCache.Size() < 10, IsTrue)
+}
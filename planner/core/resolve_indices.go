@@ -38,6 +38,23 @@ func (p *PhysicalProjection) ResolveIndices() (err error) {
 	return
 }
 
+// ResolveIndices implements Plan interface.
+func (p *PhysicalExpand) ResolveIndices() (err error) {
+	err = p.physicalSchemaProducer.ResolveIndices()
+	if err != nil {
+		return err
+	}
+	for _, exprs := range p.LevelExprs {
+		for i, expr := range exprs {
+			exprs[i], err = expr.ResolveIndices(p.children[0].Schema())
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return
+}
+
 // refine4NeighbourProj refines the index for p.Exprs whose type is *Column when
 // there is two neighbouring Projections.
 // This function is introduced because that different childProj.Expr may refer
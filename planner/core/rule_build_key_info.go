@@ -89,6 +89,14 @@ func (p *LogicalSelection) checkMaxOneRowCond(eqColIDs map[int64]struct{}, child
 	return false
 }
 
+// BuildKeyInfo implements LogicalPlan BuildKeyInfo interface.
+func (p *LogicalExpand) BuildKeyInfo(selfSchema *expression.Schema, childSchema []*expression.Schema) {
+	// Every row of the child is output once for each grouping set, so the keys of the child
+	// no longer hold.
+	selfSchema.Keys = nil
+	p.baseLogicalPlan.BuildKeyInfo(selfSchema, childSchema)
+}
+
 // BuildKeyInfo implements LogicalPlan BuildKeyInfo interface.
 func (p *LogicalSelection) BuildKeyInfo(selfSchema *expression.Schema, childSchema []*expression.Schema) {
 	p.baseLogicalPlan.BuildKeyInfo(selfSchema, childSchema)
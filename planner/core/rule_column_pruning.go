@@ -77,6 +77,26 @@ func (p *LogicalProjection) PruneColumns(parentUsedCols []*expression.Column) er
 	return child.PruneColumns(selfUsedCols)
 }
 
+// PruneColumns implements LogicalPlan interface.
+func (p *LogicalExpand) PruneColumns(parentUsedCols []*expression.Column) error {
+	child := p.children[0]
+	used := expression.GetUsedList(parentUsedCols, p.schema)
+	for i := len(used) - 1; i >= 0; i-- {
+		if !used[i] {
+			p.schema.Columns = append(p.schema.Columns[:i], p.schema.Columns[i+1:]...)
+			p.names = append(p.names[:i], p.names[i+1:]...)
+			for j, exprs := range p.LevelExprs {
+				p.LevelExprs[j] = append(exprs[:i], exprs[i+1:]...)
+			}
+		}
+	}
+	selfUsedCols := make([]*expression.Column, 0, len(p.schema.Columns))
+	for _, exprs := range p.LevelExprs {
+		selfUsedCols = expression.ExtractColumnsFromExpressions(selfUsedCols, exprs, nil)
+	}
+	return child.PruneColumns(selfUsedCols)
+}
+
 // PruneColumns implements LogicalPlan interface.
 func (p *LogicalSelection) PruneColumns(parentUsedCols []*expression.Column) error {
 	child := p.children[0]
@@ -241,6 +241,14 @@ func (la *LogicalAggregation) replaceExprColumns(replace map[string]*expression.
 	}
 }
 
+func (p *LogicalExpand) replaceExprColumns(replace map[string]*expression.Column) {
+	for _, exprs := range p.LevelExprs {
+		for _, expr := range exprs {
+			ResolveExprAndReplace(expr, replace)
+		}
+	}
+}
+
 func (p *LogicalSelection) replaceExprColumns(replace map[string]*expression.Column) {
 	for _, expr := range p.Conditions {
 		ResolveExprAndReplace(expr, replace)
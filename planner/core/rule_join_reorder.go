@@ -18,6 +18,7 @@ import (
 
 	"github.com/pingcap/tidb/expression"
 	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/util/tracing"
 )
 
 // extractJoinGroup extracts all the join nodes connected with continuous
@@ -73,8 +74,18 @@ func (s *joinReOrderSolver) optimizeRecursive(ctx sessionctx.Context, p LogicalP
 			ctx:        ctx,
 			otherConds: otherConds,
 		}
+		tracer := ctx.GetSessionVars().StmtCtx.OptimizeTracer
+		if tracer != nil {
+			baseGroupSolver.trace = &tracing.JoinReorderTrace{Nodes: make([]string, 0, len(curJoinGroup))}
+			for _, node := range curJoinGroup {
+				baseGroupSolver.trace.Nodes = append(baseGroupSolver.trace.Nodes, ToString(node))
+			}
+		}
 		originalSchema := p.Schema()
 		if len(curJoinGroup) > ctx.GetSessionVars().TiDBOptJoinReorderThreshold {
+			if tracer != nil {
+				baseGroupSolver.trace.Algorithm = "greedy"
+			}
 			groupSolver := &joinReorderGreedySolver{
 				baseSingleGroupJoinOrderSolver: baseGroupSolver,
 				eqEdges:                        eqEdges,
@@ -85,11 +96,18 @@ func (s *joinReOrderSolver) optimizeRecursive(ctx sessionctx.Context, p LogicalP
 				baseSingleGroupJoinOrderSolver: baseGroupSolver,
 			}
 			dpSolver.newJoin = dpSolver.newJoinWithEdges
+			if tracer != nil {
+				baseGroupSolver.trace.Algorithm = "dp"
+			}
 			p, err = dpSolver.solve(curJoinGroup, expression.ScalarFuncs2Exprs(eqEdges))
 		}
 		if err != nil {
 			return nil, err
 		}
+		if tracer != nil {
+			baseGroupSolver.trace.Result = ToString(p)
+			tracer.AppendJoinReorder(baseGroupSolver.trace)
+		}
 		schemaChanged := false
 		if len(p.Schema().Columns) != len(originalSchema.Columns) {
 			schemaChanged = true
@@ -128,6 +146,8 @@ type baseSingleGroupJoinOrderSolver struct {
 	ctx          sessionctx.Context
 	curJoinGroup []*jrNode
 	otherConds   []expression.Expression
+	// trace records the join orders considered, it's nil unless optimizer_trace is enabled.
+	trace *tracing.JoinReorderTrace
 }
 
 // baseNodeCumCost calculate the cumulative cost of the node in the join group.
@@ -187,7 +207,11 @@ func (s *baseSingleGroupJoinOrderSolver) newJoinWithEdges(lChild, rChild Logical
 
 // calcJoinCumCost calculates the cumulative cost of the join node.
 func (s *baseSingleGroupJoinOrderSolver) calcJoinCumCost(join LogicalPlan, lNode, rNode *jrNode) float64 {
-	return join.statsInfo().RowCount + lNode.cumCost + rNode.cumCost
+	cost := join.statsInfo().RowCount + lNode.cumCost + rNode.cumCost
+	if s.trace != nil {
+		s.trace.AppendCandidate(ToString(join), cost)
+	}
+	return cost
 }
 
 func (*joinReOrderSolver) name() string {
@@ -620,18 +620,43 @@ func (s *partitionProcessor) prune(ds *DataSource) (LogicalPlan, error) {
 	for i, cond := range ds.allConds {
 		ds.allConds[i] = expression.PushDownNot(ds.ctx, cond)
 	}
+	var (
+		p   LogicalPlan
+		err error
+	)
 	// Try to locate partition directly for hash partition.
 	switch pi.Type {
 	case model.PartitionTypeRange:
-		return s.processRangePartition(ds, pi)
+		p, err = s.processRangePartition(ds, pi)
 	case model.PartitionTypeHash:
-		return s.processHashPartition(ds, pi)
+		p, err = s.processHashPartition(ds, pi)
 	case model.PartitionTypeList:
-		return s.processListPartition(ds, pi)
+		p, err = s.processListPartition(ds, pi)
+	default:
+		// We haven't implement partition by list and so on.
+		p, err = s.makeUnionAllChildren(ds, pi, fullRange(len(pi.Definitions)))
+	}
+	if err != nil {
+		return nil, err
 	}
+	// The partitions pruned by the parameters of the prepared statement may be used
+	// by the next execution, so the plan can't be cached. In the dynamic prune mode
+	// the partitions are pruned when the plan is executed instead.
+	if usedPartitionCount(p) < len(pi.Definitions) && expression.ContainMutableConst(ds.ctx, ds.allConds) {
+		ds.ctx.GetSessionVars().StmtCtx.OptimDependOnMutableConst = true
+	}
+	return p, nil
+}
 
-	// We haven't implement partition by list and so on.
-	return s.makeUnionAllChildren(ds, pi, fullRange(len(pi.Definitions)))
+// usedPartitionCount returns the number of partitions read by the pruned plan.
+func usedPartitionCount(p LogicalPlan) int {
+	switch x := p.(type) {
+	case *LogicalPartitionUnionAll:
+		return len(x.Children())
+	case *LogicalTableDual:
+		return 0
+	}
+	return 1
 }
 
 // findByName checks whether object name exists in list.
@@ -452,6 +452,13 @@ func (p *LogicalMaxOneRow) PredicatePushDown(predicates []expression.Expression)
 	return predicates, p
 }
 
+// PredicatePushDown implements LogicalPlan PredicatePushDown interface.
+func (p *LogicalExpand) PredicatePushDown(predicates []expression.Expression) ([]expression.Expression, LogicalPlan) {
+	// The grouping columns are NULL in some grouping sets, so the conditions can not be pushed down.
+	p.baseLogicalPlan.PredicatePushDown(nil)
+	return predicates, p
+}
+
 // DeriveOtherConditions given a LogicalJoin, check the OtherConditions to see if we can derive more
 // conditions for left/right child pushdown.
 func DeriveOtherConditions(
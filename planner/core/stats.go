@@ -95,6 +95,16 @@ func getFakeStats(schema *expression.Schema) *property.StatsInfo {
 	return profile
 }
 
+// DeriveStats implement LogicalPlan DeriveStats interface.
+func (p *LogicalJSONTable) DeriveStats(childStats []*property.StatsInfo, selfSchema *expression.Schema, childSchema []*expression.Schema, _ [][]*expression.Column) (*property.StatsInfo, error) {
+	if p.stats != nil {
+		return p.stats, nil
+	}
+	// The number of the rows is unknown until the document is evaluated.
+	p.stats = getFakeStats(selfSchema)
+	return p.stats, nil
+}
+
 // DeriveStats implement LogicalPlan DeriveStats interface.
 func (p *LogicalShowDDLJobs) DeriveStats(childStats []*property.StatsInfo, selfSchema *expression.Schema, childSchema []*expression.Schema, _ [][]*expression.Column) (*property.StatsInfo, error) {
 	if p.stats != nil {
@@ -1155,6 +1165,29 @@ func (p *LogicalMaxOneRow) DeriveStats(childStats []*property.StatsInfo, selfSch
 	return p.stats, nil
 }
 
+// DeriveStats implement LogicalPlan DeriveStats interface.
+func (p *LogicalExpand) DeriveStats(childStats []*property.StatsInfo, selfSchema *expression.Schema, childSchema []*expression.Schema, _ [][]*expression.Column) (*property.StatsInfo, error) {
+	if p.stats != nil {
+		return p.stats, nil
+	}
+	childProfile := childStats[0]
+	levels := float64(len(p.LevelExprs))
+	p.stats = &property.StatsInfo{
+		RowCount: childProfile.RowCount * levels,
+		ColNDVs:  make(map[int64]float64, selfSchema.Len()),
+	}
+	// The first grouping set contains all the grouping columns, so its projection has the
+	// largest NDV for each column.
+	for i, expr := range p.LevelExprs[0] {
+		cols := expression.ExtractColumns(expr)
+		p.stats.ColNDVs[selfSchema.Columns[i].UniqueID] = getColsNDV(cols, childSchema[0], childProfile)
+	}
+	if p.GroupingID != nil {
+		p.stats.ColNDVs[p.GroupingID.UniqueID] = levels
+	}
+	return p.stats, nil
+}
+
 func (p *LogicalWindow) getGroupNDVs(colGroups [][]*expression.Column, childStats []*property.StatsInfo) []property.GroupNDV {
 	if len(colGroups) > 0 {
 		return childStats[0].GroupNDVs
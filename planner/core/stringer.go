@@ -110,6 +110,8 @@ func toString(in Plan, strs []string, idxs []int) ([]string, []int) {
 		str = "Apply{" + strings.Join(children, "->") + "}"
 	case *LogicalMaxOneRow, *PhysicalMaxOneRow:
 		str = "MaxOneRow"
+	case *LogicalExpand, *PhysicalExpand:
+		str = "Expand"
 	case *LogicalLimit, *PhysicalLimit:
 		str = "Limit"
 	case *PhysicalLock, *LogicalLock:
@@ -167,6 +169,8 @@ func toString(in Plan, strs []string, idxs []int) ([]string, []int) {
 		str = fmt.Sprintf("TopN(%v,%d,%d)", x.ByItems, x.Offset, x.Count)
 	case *LogicalTableDual, *PhysicalTableDual:
 		str = "Dual"
+	case *LogicalJSONTable, *PhysicalJSONTable:
+		str = "JSONTable"
 	case *PhysicalHashAgg:
 		str = "HashAgg"
 	case *PhysicalStreamAgg:
@@ -1293,6 +1293,18 @@ func (p *PhysicalTopN) attach2Task(tasks ...task) task {
 	return attachPlan2Task(p, rootTask)
 }
 
+// GetCost computes the cost of expand operator itself.
+func (p *PhysicalExpand) GetCost(count float64) float64 {
+	return count * float64(len(p.LevelExprs)) * p.ctx.GetSessionVars().CPUFactor
+}
+
+func (p *PhysicalExpand) attach2Task(tasks ...task) task {
+	t := tasks[0].convertToRootTask(p.ctx)
+	t.addCost(p.GetCost(t.count()))
+	p.cost = t.cost()
+	return attachPlan2Task(p, t)
+}
+
 // GetCost computes the cost of projection operator itself.
 func (p *PhysicalProjection) GetCost(count float64) float64 {
 	sessVars := p.ctx.GetSessionVars()
@@ -42,11 +42,15 @@ import (
 	"github.com/pingcap/tidb/util/hint"
 	"github.com/pingcap/tidb/util/logutil"
 	utilparser "github.com/pingcap/tidb/util/parser"
+	"github.com/pingcap/tidb/util/tracing"
 	"go.uber.org/zap"
 )
 
 // GetPreparedStmt extract the prepared statement from the execute statement.
 func GetPreparedStmt(stmt *ast.ExecuteStmt, vars *variable.SessionVars) (*plannercore.CachedPrepareStmt, error) {
+	if stmt.PrepStmt != nil {
+		return stmt.PrepStmt.(*plannercore.CachedPrepareStmt), nil
+	}
 	var ok bool
 	execID := stmt.ExecID
 	if stmt.Name != "" {
@@ -81,15 +85,8 @@ func IsReadOnly(node ast.Node, vars *variable.SessionVars) bool {
 // If so, we will return the latest information schema.
 func GetExecuteForUpdateReadIS(node ast.Node, sctx sessionctx.Context) infoschema.InfoSchema {
 	if execStmt, isExecStmt := node.(*ast.ExecuteStmt); isExecStmt {
-		vars := sctx.GetSessionVars()
-		execID := execStmt.ExecID
-		if execStmt.Name != "" {
-			execID = vars.PreparedStmtNameToID[execStmt.Name]
-		}
-		if preparedPointer, ok := vars.PreparedStmts[execID]; ok {
-			if preparedObj, ok := preparedPointer.(*core.CachedPrepareStmt); ok && preparedObj.ForUpdateRead {
-				return domain.GetDomain(sctx).InfoSchema()
-			}
+		if preparedObj, err := GetPreparedStmt(execStmt, sctx.GetSessionVars()); err == nil && preparedObj.ForUpdateRead {
+			return domain.GetDomain(sctx).InfoSchema()
 		}
 	}
 	return nil
@@ -135,6 +132,11 @@ func Optimize(ctx context.Context, sctx sessionctx.Context, node ast.Node, is in
 			if !useMaxTS(sctx, fp) {
 				sctx.PrepareTSFuture(ctx)
 			}
+			if needOptimizeTrace(sessVars) {
+				// The fast plan skips the optimizer, only the final plan is traced.
+				sessVars.StmtCtx.OptimizeTracer = &tracing.OptimizeTracer{}
+				sessVars.StmtCtx.OptimizeTracer.SetFinalPlan(plannercore.ToString(fp), 0)
+			}
 			return fp, fp.OutputNames(), nil
 		}
 	}
@@ -168,6 +170,7 @@ func Optimize(ctx context.Context, sctx sessionctx.Context, node ast.Node, is in
 		var (
 			bindStmtHints stmtctx.StmtHints
 			chosenBinding bindinfo.Binding
+			bindTracer    *tracing.OptimizeTracer
 		)
 		originHints := hint.CollectHint(stmtNode)
 		// bindRecord must be not nil when coming here, try to find the best binding.
@@ -191,6 +194,7 @@ func Optimize(ctx context.Context, sctx sessionctx.Context, node ast.Node, is in
 			}
 			if cost < minCost {
 				bindStmtHints, warns, minCost, names, bestPlanFromBind, chosenBinding = curStmtHints, curWarns, cost, curNames, plan, binding
+				bindTracer = sessVars.StmtCtx.OptimizeTracer
 			}
 		}
 		if bestPlanFromBind == nil {
@@ -198,6 +202,7 @@ func Optimize(ctx context.Context, sctx sessionctx.Context, node ast.Node, is in
 		} else {
 			bestPlan = bestPlanFromBind
 			sessVars.StmtCtx.StmtHints = bindStmtHints
+			sessVars.StmtCtx.OptimizeTracer = bindTracer
 			for _, warn := range warns {
 				sessVars.StmtCtx.AppendWarning(warn)
 			}
@@ -226,9 +231,10 @@ func Optimize(ctx context.Context, sctx sessionctx.Context, node ast.Node, is in
 	// 3. the original binding contains no read_from_storage hint;
 	// 4. the plan when ignoring bindings contains no tiflash hint;
 	// 5. the pending verified binding has not been added already;
-	savedStmtHints := sessVars.StmtCtx.StmtHints
+	savedStmtHints, savedTracer := sessVars.StmtCtx.StmtHints, sessVars.StmtCtx.OptimizeTracer
 	defer func() {
 		sessVars.StmtCtx.StmtHints = savedStmtHints
+		sessVars.StmtCtx.OptimizeTracer = savedTracer
 	}()
 	if sessVars.EvolvePlanBaselines && bestPlanFromBind != nil {
 		// Check bestPlanFromBind firstly to avoid nil stmtNode.
@@ -381,12 +387,29 @@ func optimize(ctx context.Context, sctx sessionctx.Context, node ast.Node, is in
 		return finalPlan, names, cost, err
 	}
 
+	if needOptimizeTrace(sctx.GetSessionVars()) {
+		sctx.GetSessionVars().StmtCtx.OptimizeTracer = &tracing.OptimizeTracer{}
+	}
 	beginOpt := time.Now()
 	finalPlan, cost, err := plannercore.DoOptimize(ctx, sctx, builder.GetOptFlag(), logic)
 	sctx.GetSessionVars().DurationOptimization = time.Since(beginOpt)
 	return finalPlan, names, cost, err
 }
 
+// needOptimizeTrace checks whether the optimizer decisions of the statement should be traced,
+// the internal SQLs are never traced.
+func needOptimizeTrace(sessVars *variable.SessionVars) bool {
+	return sessVars.EnableOptimizerTrace && !sessVars.InRestrictedSQL
+}
+
+// OptimizeWithCost optimizes the node without the plan bindings and returns the physical plan
+// with its estimated cost. It's used to compare the plans built with different hypothetical
+// indexes, so the plan it returns is not supposed to be executed.
+func OptimizeWithCost(ctx context.Context, sctx sessionctx.Context, node ast.Node, is infoschema.InfoSchema) (plannercore.Plan, float64, error) {
+	p, _, cost, err := optimize(ctx, sctx, node, is)
+	return p, cost, err
+}
+
 func extractSelectAndNormalizeDigest(stmtNode ast.StmtNode, specifiledDB string) (ast.StmtNode, string, string, error) {
 	switch x := stmtNode.(type) {
 	case *ast.ExplainStmt:
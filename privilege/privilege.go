@@ -14,11 +14,10 @@
 package privilege
 
 import (
-	"crypto/tls"
-
 	"github.com/pingcap/parser/auth"
 	"github.com/pingcap/parser/mysql"
 	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/types"
 )
 
@@ -58,7 +57,8 @@ type Manager interface {
 	RequestDynamicVerificationWithUser(privName string, grantable bool, user *auth.UserIdentity) bool
 
 	// ConnectionVerification verifies user privilege for connection.
-	ConnectionVerification(user, host string, auth, salt []byte, tlsState *tls.ConnectionState) (string, string, bool)
+	// It returns an error if the login is refused by the account or its password policies.
+	ConnectionVerification(user, host string, auth, salt []byte, sessionVars *variable.SessionVars) (VerificationInfo, error)
 
 	// GetAuthWithoutVerification uses to get auth name without verification.
 	GetAuthWithoutVerification(user, host string) (string, string, bool)
@@ -89,6 +89,22 @@ type Manager interface {
 	GetAuthPlugin(user, host string) (string, error)
 }
 
+// ClientCanHandleExpiredPasswords is the capability flag of the clients that can handle
+// the sandbox mode of expired passwords, it is not defined in the parser yet.
+const ClientCanHandleExpiredPasswords uint32 = 1 << 22
+
+// VerificationInfo records the information returned by ConnectionVerification.
+type VerificationInfo struct {
+	AuthUsername string
+	AuthHostname string
+	// InSandBoxMode indicates that the password has expired, so the session is only
+	// allowed to reset the password.
+	InSandBoxMode bool
+	// TrackFailedLogins indicates that the account is blocked after too many consecutive
+	// failed logins, so the result of the password check has to be recorded.
+	TrackFailedLogins bool
+}
+
 const key keyType = 0
 
 // BindPrivilegeManager binds Manager to context.
@@ -18,8 +18,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"net"
 	"sort"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -63,8 +65,11 @@ const (
 	References_priv,Alter_priv,Execute_priv,Index_priv,Create_view_priv,Show_view_priv,
 	Create_role_priv,Drop_role_priv,Create_tmp_table_priv,Lock_tables_priv,Create_routine_priv,
 	Alter_routine_priv,Event_priv,Shutdown_priv,Reload_priv,File_priv,Config_priv,Repl_client_priv,Repl_slave_priv,
-	account_locked,plugin FROM mysql.user`
-	sqlLoadGlobalGrantsTable = `SELECT HIGH_PRIORITY Host,User,Priv,With_Grant_Option FROM mysql.global_grants`
+	account_locked,plugin%s FROM mysql.user`
+	// sqlLoadUserPasswordColumns are the columns of the password policies, they are absent
+	// from the user tables created by MySQL or the old versions.
+	sqlLoadUserPasswordColumns = ",Password_expired,Password_last_changed,Password_lifetime,User_attributes"
+	sqlLoadGlobalGrantsTable   = `SELECT HIGH_PRIORITY Host,User,Priv,With_Grant_Option FROM mysql.global_grants`
 )
 
 func computePrivMask(privs []mysql.PrivilegeType) mysql.PrivilegeType {
@@ -97,6 +102,39 @@ type UserRecord struct {
 	Privileges           mysql.PrivilegeType
 	AccountLocked        bool // A role record when this field is true
 	AuthPlugin           string
+
+	PasswordExpired     bool
+	PasswordLastChanged time.Time
+	// PasswordLifeTime is the number of days the password is valid for,
+	// -1 means that default_password_lifetime is used.
+	PasswordLifeTime int64
+	// FailedLoginAttempts and PasswordLockTimeDays are read from the Password_locking
+	// attribute, PasswordLockTimeDays is -1 if the account is blocked until unlocked.
+	FailedLoginAttempts  int64
+	PasswordLockTimeDays int64
+	// AutoLockedAt is when the account was blocked because of the consecutive failed logins,
+	// it is zero if the account is not blocked.
+	AutoLockedAt time.Time
+}
+
+// UserAttributes is the value of mysql.user.User_attributes.
+type UserAttributes struct {
+	PasswordLocking *PasswordLocking `json:"Password_locking,omitempty"`
+}
+
+// PasswordLocking is the FAILED_LOGIN_ATTEMPTS and PASSWORD_LOCK_TIME options of an account,
+// with the consecutive failed logins of the account, which are shared by all the TiDB instances.
+type PasswordLocking struct {
+	FailedLoginAttempts int64 `json:"failed_login_attempts"`
+	// PasswordLockTimeDays is -1 for PASSWORD_LOCK_TIME UNBOUNDED.
+	PasswordLockTimeDays int64      `json:"password_lock_time_days"`
+	FailedLoginCount     int64      `json:"failed_login_count,omitempty"`
+	AutoLockedAt         *time.Time `json:"auto_locked_at,omitempty"`
+}
+
+// enabled returns whether the consecutive failed logins block the account.
+func (l *PasswordLocking) enabled() bool {
+	return l != nil && l.FailedLoginAttempts > 0 && l.PasswordLockTimeDays != 0
 }
 
 // NewUserRecord return a UserRecord, only use for unit test.
@@ -390,7 +428,11 @@ func (p *MySQLPrivilege) LoadRoleGraph(ctx sessionctx.Context) error {
 
 // LoadUserTable loads the mysql.user table from database.
 func (p *MySQLPrivilege) LoadUserTable(ctx sessionctx.Context) error {
-	err := p.loadTable(ctx, sqlLoadUserTable, p.decodeUserTableRow)
+	err := p.loadTable(ctx, fmt.Sprintf(sqlLoadUserTable, sqlLoadUserPasswordColumns), p.decodeUserTableRow)
+	if terror.ErrorEqual(err, errUnknownColumn) {
+		p.User = nil
+		err = p.loadTable(ctx, fmt.Sprintf(sqlLoadUserTable, ""), p.decodeUserTableRow)
+	}
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -639,6 +681,41 @@ func (p *MySQLPrivilege) decodeUserTableRow(row chunk.Row, fs []*ast.ResultField
 			} else {
 				value.AuthPlugin = mysql.AuthNativePassword
 			}
+		case f.ColumnAsName.L == "password_expired":
+			if row.GetEnum(i).String() == "Y" {
+				value.PasswordExpired = true
+			}
+		case f.ColumnAsName.L == "password_last_changed":
+			if !row.IsNull(i) {
+				t, err := row.GetTime(i).GoTime(time.Local)
+				if err != nil {
+					return errors.Trace(err)
+				}
+				value.PasswordLastChanged = t
+			}
+		case f.ColumnAsName.L == "password_lifetime":
+			if row.IsNull(i) {
+				value.PasswordLifeTime = -1
+			} else {
+				value.PasswordLifeTime = row.GetInt64(i)
+			}
+		case f.ColumnAsName.L == "user_attributes":
+			if row.IsNull(i) {
+				continue
+			}
+			var attributes UserAttributes
+			if err := json.Unmarshal(hack.Slice(row.GetJSON(i).String()), &attributes); err != nil {
+				logutil.BgLogger().Warn("the user attributes is broken, ignore it",
+					zap.String("user", value.User), zap.String("host", value.Host), zap.Error(err))
+				continue
+			}
+			if locking := attributes.PasswordLocking; locking.enabled() {
+				value.FailedLoginAttempts = locking.FailedLoginAttempts
+				value.PasswordLockTimeDays = locking.PasswordLockTimeDays
+				if locking.AutoLockedAt != nil {
+					value.AutoLockedAt = *locking.AutoLockedAt
+				}
+			}
 		case f.Column.Tp == mysql.TypeEnum:
 			if row.GetEnum(i).String() != "Y" {
 				continue
@@ -890,6 +967,22 @@ func patternMatch(str string, patChars, patTypes []byte) bool {
 }
 
 // connectionVerification verifies the connection have access to TiDB server.
+// isPasswordExpired checks whether the password has expired at now, defaultLifetime is
+// the value of default_password_lifetime.
+func (record *UserRecord) isPasswordExpired(defaultLifetime int64, now time.Time) bool {
+	if record.PasswordExpired {
+		return true
+	}
+	lifetime := record.PasswordLifeTime
+	if lifetime < 0 {
+		lifetime = defaultLifetime
+	}
+	if lifetime == 0 || record.PasswordLastChanged.IsZero() {
+		return false
+	}
+	return now.After(record.PasswordLastChanged.AddDate(0, 0, int(lifetime)))
+}
+
 func (p *MySQLPrivilege) connectionVerification(user, host string) *UserRecord {
 	for i := 0; i < len(p.User); i++ {
 		record := &p.User[i]
@@ -1496,3 +1589,124 @@ func (h *Handle) Update(ctx sessionctx.Context) error {
 	h.priv.Store(&priv)
 	return nil
 }
+
+// isAutoLocked returns whether the account is still blocked because of the consecutive failed logins.
+func (record *UserRecord) isAutoLocked(now time.Time) bool {
+	return isAutoLocked(record.AutoLockedAt, record.PasswordLockTimeDays, now)
+}
+
+func isAutoLocked(lockedAt time.Time, lockTimeDays int64, now time.Time) bool {
+	if lockedAt.IsZero() {
+		return false
+	}
+	return lockTimeDays < 0 || now.Before(lockedAt.AddDate(0, 0, int(lockTimeDays)))
+}
+
+// RecordFailedLogin counts a failed login of the account in mysql.user, and blocks the account when the
+// consecutive failed logins reach FAILED_LOGIN_ATTEMPTS. The count is kept in the User_attributes, so it is
+// shared by all the TiDB instances and survives restarts. If the account gets blocked, it returns true with
+// the error to report, and the privileges have to be reloaded.
+func RecordFailedLogin(sctx sessionctx.Context, user, host, authUser, authHost string) (blocked bool, err error) {
+	now := time.Now()
+	var record *UserRecord
+	err = updatePasswordLocking(sctx, authUser, authHost, func(locking *PasswordLocking) bool {
+		if !locking.enabled() {
+			return false
+		}
+		if locking.AutoLockedAt != nil && !isAutoLocked(*locking.AutoLockedAt, locking.PasswordLockTimeDays, now) {
+			// The lock has expired, so the failed logins are counted again.
+			locking.FailedLoginCount, locking.AutoLockedAt = 0, nil
+		}
+		locking.FailedLoginCount++
+		if locking.FailedLoginCount >= locking.FailedLoginAttempts && locking.AutoLockedAt == nil {
+			locking.AutoLockedAt = &now
+			record = &UserRecord{
+				FailedLoginAttempts:  locking.FailedLoginAttempts,
+				PasswordLockTimeDays: locking.PasswordLockTimeDays,
+				AutoLockedAt:         now,
+			}
+		}
+		return true
+	})
+	if err != nil || record == nil {
+		return false, err
+	}
+	logutil.BgLogger().Warn("the account is blocked because of too many consecutive failed logins",
+		zap.String("user", authUser), zap.String("host", authHost), zap.Int64("attempts", record.FailedLoginAttempts))
+	return true, passwordLockError(record, user, host, now)
+}
+
+// ResetFailedLogins clears the consecutive failed logins of the account in mysql.user after a successful login.
+// It returns true if the account was blocked, then the privileges have to be reloaded.
+func ResetFailedLogins(sctx sessionctx.Context, authUser, authHost string) (unblocked bool, err error) {
+	err = updatePasswordLocking(sctx, authUser, authHost, func(locking *PasswordLocking) bool {
+		if locking == nil || (locking.FailedLoginCount == 0 && locking.AutoLockedAt == nil) {
+			return false
+		}
+		unblocked = locking.AutoLockedAt != nil
+		locking.FailedLoginCount, locking.AutoLockedAt = 0, nil
+		return true
+	})
+	return unblocked, err
+}
+
+// updatePasswordLocking reads the Password_locking attribute of the account in a pessimistic transaction,
+// and writes it back if update returns true, the other attributes are kept. update is called with nil if
+// the attribute is missing or broken.
+func updatePasswordLocking(sctx sessionctx.Context, user, host string, update func(*PasswordLocking) bool) (err error) {
+	ctx := context.Background()
+	exec := sctx.(sqlexec.SQLExecutor)
+	if _, err = exec.ExecuteInternal(ctx, "BEGIN PESSIMISTIC"); err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		if err == nil {
+			_, err = exec.ExecuteInternal(ctx, "COMMIT")
+		} else {
+			_, rollbackErr := exec.ExecuteInternal(ctx, "ROLLBACK")
+			terror.Log(errors.Trace(rollbackErr))
+		}
+		err = errors.Trace(err)
+	}()
+
+	rs, err := exec.ExecuteInternal(ctx, "SELECT User_attributes FROM %n.%n WHERE User=%? AND Host=%? FOR UPDATE",
+		mysql.SystemDB, mysql.UserTable, user, host)
+	if err != nil {
+		return err
+	}
+	rows, err := sqlexec.DrainRecordSet(ctx, rs, sctx.GetSessionVars().MaxChunkSize)
+	terror.Call(rs.Close)
+	if err != nil {
+		return err
+	}
+	var locking *PasswordLocking
+	if len(rows) == 1 && !rows[0].IsNull(0) {
+		var attributes UserAttributes
+		if err := json.Unmarshal(hack.Slice(rows[0].GetJSON(0).String()), &attributes); err != nil {
+			logutil.BgLogger().Warn("the user attributes is broken, ignore it",
+				zap.String("user", user), zap.String("host", host), zap.Error(err))
+		} else {
+			locking = attributes.PasswordLocking
+		}
+	}
+	if !update(locking) {
+		return nil
+	}
+	value, err := json.Marshal(locking)
+	if err != nil {
+		return err
+	}
+	_, err = exec.ExecuteInternal(ctx, "UPDATE %n.%n SET User_attributes=JSON_SET(User_attributes, '$.Password_locking', CAST(%? AS JSON)) WHERE User=%? AND Host=%?",
+		mysql.SystemDB, mysql.UserTable, string(value), user, host)
+	return err
+}
+
+func passwordLockError(record *UserRecord, user, host string, now time.Time) error {
+	lockDays, remainingDays := "unlimited", "unlimited"
+	if record.PasswordLockTimeDays > 0 {
+		lockDays = strconv.FormatInt(record.PasswordLockTimeDays, 10)
+		remaining := record.AutoLockedAt.AddDate(0, 0, int(record.PasswordLockTimeDays)).Sub(now)
+		remainingDays = strconv.FormatInt(int64(math.Ceil(remaining.Hours()/24)), 10)
+	}
+	return errAccountBlockedByPasswordLock.FastGenByArgs(user, host, lockDays, remainingDays, record.FailedLoginAttempts)
+}
@@ -23,4 +23,11 @@ var (
 	errInvalidPrivilegeType = dbterror.ClassPrivilege.NewStd(mysql.ErrInvalidPrivilegeType)
 	ErrNonexistingGrant     = dbterror.ClassPrivilege.NewStd(mysql.ErrNonexistingGrant)
 	errLoadPrivilege        = dbterror.ClassPrivilege.NewStd(mysql.ErrLoadPrivilege)
+	ErrAccessDenied         = dbterror.ClassPrivilege.NewStd(mysql.ErrAccessDenied)
+
+	errMustChangePasswordLogin      = dbterror.ClassPrivilege.NewStd(mysql.ErrMustChangePasswordLogin)
+	errAccountBlockedByPasswordLock = dbterror.ClassPrivilege.NewStd(mysql.ErrUserAccessDeniedForUserAccountBlockedByPasswordLock)
+
+	// errUnknownColumn is the error reported by the planner when the privilege tables lack some columns.
+	errUnknownColumn = dbterror.ClassOptimizer.NewStd(mysql.ErrBadField)
 )
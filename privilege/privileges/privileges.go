@@ -18,8 +18,10 @@ import (
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/pingcap/parser/auth"
 	"github.com/pingcap/parser/mysql"
@@ -27,6 +29,7 @@ import (
 	"github.com/pingcap/tidb/infoschema/perfschema"
 	"github.com/pingcap/tidb/privilege"
 	"github.com/pingcap/tidb/sessionctx"
+	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util"
 	"github.com/pingcap/tidb/util/logutil"
@@ -261,11 +264,14 @@ func (p *UserPrivileges) GetAuthWithoutVerification(user, host string) (u string
 }
 
 // ConnectionVerification implements the Manager interface.
-func (p *UserPrivileges) ConnectionVerification(user, host string, authentication, salt []byte, tlsState *tls.ConnectionState) (u string, h string, success bool) {
+func (p *UserPrivileges) ConnectionVerification(user, host string, authentication, salt []byte, sessionVars *variable.SessionVars) (info privilege.VerificationInfo, err error) {
+	hasPassword := "YES"
+	if len(authentication) == 0 {
+		hasPassword = "NO"
+	}
 	if SkipWithGrant {
 		p.user = user
 		p.host = host
-		success = true
 		return
 	}
 
@@ -274,19 +280,18 @@ func (p *UserPrivileges) ConnectionVerification(user, host string, authenticatio
 	if record == nil {
 		logutil.BgLogger().Error("get user privilege record fail",
 			zap.String("user", user), zap.String("host", host))
-		return
+		return info, ErrAccessDenied.FastGenByArgs(user, host, hasPassword)
 	}
 
-	u = record.User
-	h = record.Host
+	info.AuthUsername = record.User
+	info.AuthHostname = record.Host
 
 	globalPriv := mysqlPriv.matchGlobalPriv(user, host)
 	if globalPriv != nil {
-		if !p.checkSSL(globalPriv, tlsState) {
+		if !p.checkSSL(globalPriv, sessionVars.TLSConnectionState) {
 			logutil.BgLogger().Error("global priv check ssl fail",
 				zap.String("user", user), zap.String("host", host))
-			success = false
-			return
+			return info, ErrAccessDenied.FastGenByArgs(user, host, hasPassword)
 		}
 	}
 
@@ -295,55 +300,92 @@ func (p *UserPrivileges) ConnectionVerification(user, host string, authenticatio
 	if locked {
 		logutil.BgLogger().Error("try to login a locked account",
 			zap.String("user", user), zap.String("host", host))
-		success = false
-		return
+		return info, ErrAccessDenied.FastGenByArgs(user, host, hasPassword)
+	}
+
+	now := time.Now()
+	if record.isAutoLocked(now) {
+		logutil.BgLogger().Error("try to login an account blocked by the failed logins",
+			zap.String("user", user), zap.String("host", host))
+		return info, passwordLockError(record, user, host, now)
 	}
 
-	pwd := record.AuthenticationString
 	if !p.isValidHash(record) {
-		return
+		return info, ErrAccessDenied.FastGenByArgs(user, host, hasPassword)
 	}
 
+	info.TrackFailedLogins = record.FailedLoginAttempts > 0
+	if !p.checkPassword(record, user, authentication, salt) {
+		return info, ErrAccessDenied.FastGenByArgs(user, host, hasPassword)
+	}
+
+	if record.isPasswordExpired(p.defaultPasswordLifetime(sessionVars), now) {
+		// The clients which can not handle the sandbox mode are disconnected, unless
+		// disconnect_on_expired_password is disabled.
+		if sessionVars.ClientCapability&privilege.ClientCanHandleExpiredPasswords == 0 && p.disconnectOnExpiredPassword(sessionVars) {
+			logutil.BgLogger().Info("try to login with an expired password",
+				zap.String("user", user), zap.String("host", host))
+			return info, errMustChangePasswordLogin.FastGenByArgs()
+		}
+		info.InSandBoxMode = true
+	}
+
+	p.user = user
+	p.host = record.Host
+	return
+}
+
+// checkPassword checks the authentication data sent by the client against the password of the record.
+func (p *UserPrivileges) checkPassword(record *UserRecord, user string, authentication, salt []byte) bool {
+	pwd := record.AuthenticationString
+
 	// empty password
 	if len(pwd) == 0 && len(authentication) == 0 {
-		p.user = user
-		p.host = h
-		success = true
-		return
+		return true
 	}
 
 	if len(pwd) == 0 || len(authentication) == 0 {
-		return
+		return false
 	}
 
 	if record.AuthPlugin == mysql.AuthNativePassword {
 		hpwd, err := auth.DecodePassword(pwd)
 		if err != nil {
 			logutil.BgLogger().Error("decode password string failed", zap.Error(err))
-			return
+			return false
 		}
 
-		if !auth.CheckScrambledPassword(salt, hpwd, authentication) {
-			return
-		}
+		return auth.CheckScrambledPassword(salt, hpwd, authentication)
 	} else if record.AuthPlugin == mysql.AuthCachingSha2Password {
 		authok, err := auth.CheckShaPassword([]byte(pwd), string(authentication))
 		if err != nil {
 			logutil.BgLogger().Error("Failed to check caching_sha2_password", zap.Error(err))
 		}
+		return authok
+	}
+	logutil.BgLogger().Error("unknown authentication plugin", zap.String("user", user), zap.String("plugin", record.AuthPlugin))
+	return false
+}
 
-		if !authok {
-			return
-		}
-	} else {
-		logutil.BgLogger().Error("unknown authentication plugin", zap.String("user", user), zap.String("plugin", record.AuthPlugin))
-		return
+func (p *UserPrivileges) defaultPasswordLifetime(sessionVars *variable.SessionVars) int64 {
+	val, err := variable.GetGlobalSystemVar(sessionVars, variable.DefaultPasswordLifetime)
+	if err != nil {
+		logutil.BgLogger().Warn("get default_password_lifetime failed", zap.Error(err))
+		return 0
+	}
+	lifetime, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return 0
 	}
+	return lifetime
+}
 
-	p.user = user
-	p.host = h
-	success = true
-	return
+func (p *UserPrivileges) disconnectOnExpiredPassword(sessionVars *variable.SessionVars) bool {
+	val, err := variable.GetSessionOrGlobalSystemVar(sessionVars, variable.DisconnectOnExpiredPassword)
+	if err != nil {
+		return true
+	}
+	return variable.TiDBOptOn(val)
 }
 
 type checkResult int
@@ -2058,3 +2058,102 @@ func tearDownTest(t *testing.T, store kv.Storage, dbName string) {
 	dropDBSQL := fmt.Sprintf("drop database if exists %s;", dbName)
 	mustExec(t, se, dropDBSQL)
 }
+
+func TestPasswordExpiration(t *testing.T) {
+	store, clean := testkit.CreateMockStore(t)
+	defer clean()
+	rootTk := testkit.NewTestKit(t, store)
+	rootTk.MustExec("CREATE USER expired PASSWORD EXPIRE")
+	rootTk.MustExec("CREATE USER lifetime PASSWORD EXPIRE INTERVAL 3 DAY")
+	rootTk.MustExec("CREATE USER usedefault")
+	rootTk.MustQuery("SELECT User, Password_expired, Password_lifetime FROM mysql.user WHERE User IN ('expired', 'lifetime', 'usedefault') ORDER BY User").
+		Check(testkit.Rows("expired Y <nil>", "lifetime N 3", "usedefault N <nil>"))
+	rootTk.MustQuery("SHOW CREATE USER lifetime").Check(testkit.Rows("CREATE USER 'lifetime'@'%' IDENTIFIED WITH 'mysql_native_password' AS '' REQUIRE NONE PASSWORD EXPIRE INTERVAL 3 DAY ACCOUNT UNLOCK"))
+
+	// The clients which can not handle the sandbox mode are disconnected.
+	tk := testkit.NewTestKit(t, store)
+	err := tk.Session().AuthWithError(&auth.UserIdentity{Username: "expired", Hostname: "localhost"}, nil, nil)
+	require.EqualError(t, err, "[privilege:1862]Your password has expired. To log in you must change it using a client that supports expired passwords.")
+
+	// Otherwise the session can only reset the password.
+	tk.Session().SetClientCapability(privilege.ClientCanHandleExpiredPasswords)
+	require.NoError(t, tk.Session().AuthWithError(&auth.UserIdentity{Username: "expired", Hostname: "localhost"}, nil, nil))
+	require.True(t, tk.Session().GetSessionVars().InSandBoxMode)
+	_, err = tk.Exec("SELECT 1")
+	require.EqualError(t, err, "[session:1820]You must SET PASSWORD before executing this statement")
+	tk.MustExec("SET @a = 1")
+	tk.MustExec("ALTER USER USER() IDENTIFIED BY 'newpass'")
+	require.False(t, tk.Session().GetSessionVars().InSandBoxMode)
+	tk.MustQuery("SELECT 1").Check(testkit.Rows("1"))
+	rootTk.MustQuery("SELECT Password_expired FROM mysql.user WHERE User = 'expired'").Check(testkit.Rows("N"))
+
+	// The password expires after its lifetime.
+	tk = testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().AuthWithError(&auth.UserIdentity{Username: "lifetime", Hostname: "localhost"}, nil, nil))
+	rootTk.MustExec("UPDATE mysql.user SET Password_last_changed = DATE_SUB(NOW(), INTERVAL 4 DAY) WHERE User IN ('lifetime', 'usedefault')")
+	rootTk.MustExec("FLUSH PRIVILEGES")
+	err = tk.Session().AuthWithError(&auth.UserIdentity{Username: "lifetime", Hostname: "localhost"}, nil, nil)
+	require.EqualError(t, err, "[privilege:1862]Your password has expired. To log in you must change it using a client that supports expired passwords.")
+	rootTk.MustExec("ALTER USER lifetime PASSWORD EXPIRE NEVER")
+	require.NoError(t, tk.Session().AuthWithError(&auth.UserIdentity{Username: "lifetime", Hostname: "localhost"}, nil, nil))
+
+	// default_password_lifetime is used if the account does not specify the lifetime.
+	require.NoError(t, tk.Session().AuthWithError(&auth.UserIdentity{Username: "usedefault", Hostname: "localhost"}, nil, nil))
+	rootTk.MustExec("SET GLOBAL default_password_lifetime = 2")
+	defer rootTk.MustExec("SET GLOBAL default_password_lifetime = DEFAULT")
+	err = tk.Session().AuthWithError(&auth.UserIdentity{Username: "usedefault", Hostname: "localhost"}, nil, nil)
+	require.EqualError(t, err, "[privilege:1862]Your password has expired. To log in you must change it using a client that supports expired passwords.")
+	require.NoError(t, tk.Session().AuthWithError(&auth.UserIdentity{Username: "lifetime", Hostname: "localhost"}, nil, nil))
+}
+
+func TestFailedLoginLock(t *testing.T) {
+	store, clean := testkit.CreateMockStore(t)
+	defer clean()
+	rootTk := testkit.NewTestKit(t, store)
+	rootTk.MustExec("CREATE USER locking FAILED_LOGIN_ATTEMPTS 2 PASSWORD_LOCK_TIME 3")
+	rootTk.MustQuery("SHOW CREATE USER locking").Check(testkit.Rows("CREATE USER 'locking'@'%' IDENTIFIED WITH 'mysql_native_password' AS '' REQUIRE NONE PASSWORD EXPIRE DEFAULT ACCOUNT UNLOCK FAILED_LOGIN_ATTEMPTS 2 PASSWORD_LOCK_TIME 3"))
+
+	user := &auth.UserIdentity{Username: "locking", Hostname: "localhost"}
+	tk := testkit.NewTestKit(t, store)
+	err := tk.Session().AuthWithError(user, []byte("wrong"), nil)
+	require.True(t, terror.ErrorEqual(err, privileges.ErrAccessDenied), "%v", err)
+	// A successful login resets the consecutive failed logins.
+	require.NoError(t, tk.Session().AuthWithError(user, nil, nil))
+	err = tk.Session().AuthWithError(user, []byte("wrong"), nil)
+	require.True(t, terror.ErrorEqual(err, privileges.ErrAccessDenied), "%v", err)
+	// The failed logins are persisted.
+	rootTk.MustQuery("SELECT JSON_EXTRACT(User_attributes, '$.Password_locking.failed_login_count') FROM mysql.user WHERE User = 'locking'").Check(testkit.Rows("1"))
+	err = tk.Session().AuthWithError(user, []byte("wrong"), nil)
+	require.EqualError(t, err, "[privilege:3955]Access denied for user 'locking'@'localhost'. Account is blocked for 3 day(s) (3 day(s) remaining) due to 2 consecutive failed logins.")
+	// The account is still blocked after the privileges are reloaded.
+	rootTk.MustExec("FLUSH PRIVILEGES")
+	err = tk.Session().AuthWithError(user, nil, nil)
+	require.EqualError(t, err, "[privilege:3955]Access denied for user 'locking'@'localhost'. Account is blocked for 3 day(s) (3 day(s) remaining) due to 2 consecutive failed logins.")
+
+	rootTk.MustExec("ALTER USER locking ACCOUNT UNLOCK")
+	require.NoError(t, tk.Session().AuthWithError(user, nil, nil))
+	rootTk.MustQuery("SELECT JSON_EXTRACT(User_attributes, '$.Password_locking') FROM mysql.user WHERE User = 'locking'").
+		Check(testkit.Rows(`{"failed_login_attempts": 2, "password_lock_time_days": 3}`))
+
+	// The account is unblocked once the lock time is over.
+	for i := 0; i < 2; i++ {
+		err = tk.Session().AuthWithError(user, []byte("wrong"), nil)
+		require.Error(t, err)
+	}
+	rootTk.MustExec(`UPDATE mysql.user SET User_attributes = JSON_SET(User_attributes, '$.Password_locking.auto_locked_at', '2020-01-01T00:00:00Z') WHERE User = 'locking'`)
+	rootTk.MustExec("FLUSH PRIVILEGES")
+	require.NoError(t, tk.Session().AuthWithError(user, nil, nil))
+	rootTk.MustQuery("SELECT JSON_EXTRACT(User_attributes, '$.Password_locking.failed_login_count') FROM mysql.user WHERE User = 'locking'").Check(testkit.Rows("<nil>"))
+
+	// PASSWORD_LOCK_TIME UNBOUNDED blocks the account until it is unlocked.
+	rootTk.MustExec("ALTER USER locking FAILED_LOGIN_ATTEMPTS 1 PASSWORD_LOCK_TIME UNBOUNDED")
+	err = tk.Session().AuthWithError(user, []byte("wrong"), nil)
+	require.EqualError(t, err, "[privilege:3955]Access denied for user 'locking'@'localhost'. Account is blocked for unlimited day(s) (unlimited day(s) remaining) due to 1 consecutive failed logins.")
+	rootTk.MustExec("ALTER USER locking PASSWORD_LOCK_TIME 0")
+	require.NoError(t, tk.Session().AuthWithError(user, nil, nil))
+
+	rootTk.MustExec("ALTER USER locking ACCOUNT LOCK")
+	rootTk.MustQuery("SHOW CREATE USER locking").Check(testkit.Rows("CREATE USER 'locking'@'%' IDENTIFIED WITH 'mysql_native_password' AS '' REQUIRE NONE PASSWORD EXPIRE DEFAULT ACCOUNT LOCK FAILED_LOGIN_ATTEMPTS 1 PASSWORD_LOCK_TIME 0"))
+	err = tk.Session().AuthWithError(user, nil, nil)
+	require.True(t, terror.ErrorEqual(err, privileges.ErrAccessDenied), "%v", err)
+}
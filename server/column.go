@@ -36,7 +36,7 @@ type ColumnInfo struct {
 }
 
 // Dump dumps ColumnInfo to bytes.
-func (column *ColumnInfo) Dump(buffer []byte) []byte {
+func (column *ColumnInfo) Dump(buffer []byte, d *resultEncoder) []byte {
 	nameDump, orgnameDump := []byte(column.Name), []byte(column.OrgName)
 	if len(nameDump) > maxColumnNameSize {
 		nameDump = nameDump[0:maxColumnNameSize]
@@ -53,7 +53,7 @@ func (column *ColumnInfo) Dump(buffer []byte) []byte {
 
 	buffer = append(buffer, 0x0c)
 
-	buffer = dumpUint16(buffer, column.Charset)
+	buffer = dumpUint16(buffer, d.columnCharset(column.Charset))
 	buffer = dumpUint32(buffer, column.ColumnLength)
 	buffer = append(buffer, dumpType(column.Type))
 	buffer = dumpUint16(buffer, dumpFlag(column.Type, column.Flag))
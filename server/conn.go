@@ -70,6 +70,7 @@ import (
 	plannercore "github.com/pingcap/tidb/planner/core"
 	"github.com/pingcap/tidb/plugin"
 	"github.com/pingcap/tidb/privilege"
+	"github.com/pingcap/tidb/privilege/privileges"
 	"github.com/pingcap/tidb/session"
 	"github.com/pingcap/tidb/sessionctx"
 	"github.com/pingcap/tidb/sessionctx/stmtctx"
@@ -79,6 +80,7 @@ import (
 	tidbutil "github.com/pingcap/tidb/util"
 	"github.com/pingcap/tidb/util/arena"
 	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/encoding"
 	"github.com/pingcap/tidb/util/execdetails"
 	"github.com/pingcap/tidb/util/hack"
 	"github.com/pingcap/tidb/util/logutil"
@@ -187,6 +189,9 @@ type clientConn struct {
 	authPlugin   string            // default authentication plugin
 	isUnixSocket bool              // connection is Unix Socket file
 
+	compressAlgorithm string // protocol compression algorithm negotiated in handshake, empty if uncompressed.
+	compressLevel     int    // protocol compression level negotiated in handshake.
+
 	// mu is used for cancelling the execution of current transaction.
 	mu struct {
 		sync.RWMutex
@@ -279,7 +284,7 @@ func (cc *clientConn) handshake(ctx context.Context) error {
 	}
 
 	err := cc.writePacket(data)
-	cc.pkt.sequence = 0
+	cc.pkt.resetSequence()
 	if err != nil {
 		err = errors.SuspendStack(err)
 		logutil.Logger(ctx).Debug("write response to client failed", zap.Error(err))
@@ -292,6 +297,10 @@ func (cc *clientConn) handshake(ctx context.Context) error {
 		logutil.Logger(ctx).Debug("flush response to client failed", zap.Error(err))
 		return err
 	}
+	// The packets are compressed since the OK packet of handshake is sent.
+	if cc.compressAlgorithm != "" {
+		cc.pkt.setCompression(cc.compressAlgorithm, cc.compressLevel)
+	}
 	return err
 }
 
@@ -396,6 +405,33 @@ func (cc *clientConn) writePacket(data []byte) error {
 	return cc.pkt.writePacket(data)
 }
 
+// getResultEncoder returns the resultEncoder of the session variable character_set_results.
+func (cc *clientConn) getResultEncoder() *resultEncoder {
+	chs, _ := cc.ctx.GetSessionVars().GetSystemVar(variable.CharacterSetResults)
+	return newResultEncoder(chs)
+}
+
+// getInputEncoding returns the Encoding of the session variable character_set_client, nil if the statements
+// sent by the client are in UTF-8 already.
+func (cc *clientConn) getInputEncoding() *encoding.Encoding {
+	chs, _ := cc.ctx.GetSessionVars().GetSystemVar(variable.CharacterSetClient)
+	return encoding.GetEncoding(chs)
+}
+
+// decodeInput decodes the data sent by the client into UTF-8, in which the statements are parsed. It returns
+// ER_INVALID_CHARACTER_STRING if the data isn't valid in character_set_client.
+func (cc *clientConn) decodeInput(data []byte) (string, error) {
+	enc := cc.getInputEncoding()
+	if enc == nil {
+		return string(hack.String(data)), nil
+	}
+	decoded, ok := enc.Decode(nil, data)
+	if !ok {
+		return "", errInvalidCharacterString.GenWithStackByArgs(enc.Name(), fmt.Sprintf("%X", data))
+	}
+	return string(hack.String(decoded)), nil
+}
+
 // getSessionVarsWaitTimeout get session variable wait_timeout
 func (cc *clientConn) getSessionVarsWaitTimeout(ctx context.Context) uint64 {
 	valStr, exists := cc.ctx.GetSessionVars().GetSystemVar(variable.WaitTimeout)
@@ -419,6 +455,7 @@ type handshakeResponse41 struct {
 	Auth       []byte
 	AuthPlugin string
 	Attrs      map[string]string
+	ZstdLevel  uint8
 }
 
 // parseOldHandshakeResponseHeader parses the old version handshake header HandshakeResponse320
@@ -565,15 +602,19 @@ func parseHandshakeResponseBody(ctx context.Context, packet *handshakeResponse41
 		if num, null, off := parseLengthEncodedInt(data[offset:]); !null {
 			offset += off
 			row := data[offset : offset+int(num)]
+			offset += int(num)
 			attrs, err := parseAttrs(row)
 			if err != nil {
 				logutil.Logger(ctx).Warn("parse attrs failed", zap.Error(err))
-				return nil
+			} else {
+				packet.Attrs = attrs
 			}
-			packet.Attrs = attrs
 		}
 	}
 
+	if packet.Capability&clientZstdCompressionAlgorithm > 0 && len(data[offset:]) > 0 {
+		packet.ZstdLevel = data[offset]
+	}
 	return nil
 }
 
@@ -701,8 +742,37 @@ func (cc *clientConn) readOptionalSSLRequestAndHandshakeResponse(ctx context.Con
 	err = cc.openSessionAndDoAuth(resp.Auth)
 	if err != nil {
 		logutil.Logger(ctx).Warn("open new session or authentication failure", zap.Error(err))
+		return err
 	}
-	return err
+	return cc.negotiateCompression(resp.ZstdLevel)
+}
+
+// negotiateCompression decides the protocol compression algorithm by the client capability,
+// and checks whether it is allowed by protocol_compression_algorithms.
+func (cc *clientConn) negotiateCompression(zstdLevel uint8) error {
+	algorithm, level := compressionUncompressed, 0
+	if cc.capability&clientZstdCompressionAlgorithm > 0 {
+		algorithm, level = compressionZstd, int(zstdLevel)
+		if level < 1 || level > 22 {
+			return errWrongCompressionLevelClient.FastGenByArgs(algorithm, level)
+		}
+	} else if cc.capability&mysql.ClientCompress > 0 {
+		algorithm, level = compressionZlib, defaultZlibCompressionLevel
+	}
+	allowed, err := variable.GetGlobalSystemVar(cc.ctx.GetSessionVars(), variable.ProtocolCompressionAlgorithms)
+	if err != nil {
+		return err
+	}
+	for _, a := range strings.Split(allowed, ",") {
+		if a != algorithm {
+			continue
+		}
+		if algorithm != compressionUncompressed {
+			cc.compressAlgorithm, cc.compressLevel = algorithm, level
+		}
+		return nil
+	}
+	return errWrongCompressionAlgorithmClient.FastGenByArgs(algorithm)
 }
 
 func (cc *clientConn) authSha(ctx context.Context) ([]byte, error) {
@@ -783,8 +853,11 @@ func (cc *clientConn) openSessionAndDoAuth(authData []byte) error {
 	if err != nil {
 		return err
 	}
-	if !cc.ctx.Auth(&auth.UserIdentity{Username: cc.user, Hostname: host}, authData, cc.salt) {
-		return errAccessDenied.FastGenByArgs(cc.user, host, hasPassword)
+	if err = cc.ctx.AuthWithError(&auth.UserIdentity{Username: cc.user, Hostname: host}, authData, cc.salt); err != nil {
+		if privileges.ErrAccessDenied.Equal(err) {
+			return errAccessDenied.FastGenByArgs(cc.user, host, hasPassword)
+		}
+		return err
 	}
 	cc.ctx.SetPort(port)
 	if cc.dbname != "" {
@@ -1004,7 +1077,7 @@ func (cc *clientConn) Run(ctx context.Context) {
 			terror.Log(err1)
 		}
 		cc.addMetrics(data[0], startTime, err)
-		cc.pkt.sequence = 0
+		cc.pkt.resetSequence()
 	}
 }
 
@@ -1181,7 +1254,21 @@ func (cc *clientConn) dispatch(ctx context.Context, data []byte) error {
 		cc.ctx.SetCommandValue(cmd)
 	}
 
-	dataStr := string(hack.String(data))
+	var dataStr string
+	switch cmd {
+	case mysql.ComInitDB, mysql.ComQuery, mysql.ComFieldList, mysql.ComStmtPrepare:
+		// For issue 1989
+		// Input payload may end with byte '\0', we didn't find related mysql document about it, but mysql
+		// implementation accept that case. So trim the last '\0' here as if the payload an EOF string.
+		// See http://dev.mysql.com/doc/internals/en/com-query.html
+		if cmd == mysql.ComQuery && len(data) > 0 && data[len(data)-1] == 0 {
+			data = data[:len(data)-1]
+		}
+		var err error
+		if dataStr, err = cc.decodeInput(data); err != nil {
+			return err
+		}
+	}
 	switch cmd {
 	case mysql.ComPing, mysql.ComStmtClose, mysql.ComStmtSendLongData, mysql.ComStmtReset,
 		mysql.ComSetOption, mysql.ComChangeUser:
@@ -1204,14 +1291,6 @@ func (cc *clientConn) dispatch(ctx context.Context, data []byte) error {
 		}
 		return cc.writeOK(ctx)
 	case mysql.ComQuery: // Most frequently used command.
-		// For issue 1989
-		// Input payload may end with byte '\0', we didn't find related mysql document about it, but mysql
-		// implementation accept that case. So trim the last '\0' here as if the payload an EOF string.
-		// See http://dev.mysql.com/doc/internals/en/com-query.html
-		if len(data) > 0 && data[len(data)-1] == 0 {
-			data = data[:len(data)-1]
-			dataStr = string(hack.String(data))
-		}
 		return cc.handleQuery(ctx, dataStr)
 	case mysql.ComFieldList:
 		return cc.handleFieldList(ctx, dataStr)
@@ -1593,7 +1672,7 @@ func (cc *clientConn) handleLoadStats(ctx context.Context, loadStatsInfo *execut
 }
 
 // handleIndexAdvise does the index advise work and returns the advise result for index.
-func (cc *clientConn) handleIndexAdvise(ctx context.Context, indexAdviseInfo *executor.IndexAdviseInfo) error {
+func (cc *clientConn) handleIndexAdvise(ctx context.Context, indexAdviseInfo *executor.IndexAdviseInfo, status uint16) error {
 	if cc.capability&mysql.ClientLocalFiles == 0 {
 		return errNotAllowedCommand
 	}
@@ -1613,8 +1692,10 @@ func (cc *clientConn) handleIndexAdvise(ctx context.Context, indexAdviseInfo *ex
 		return err
 	}
 
-	// TODO: Write the rss []ResultSet. It will be done in another PR.
-	return nil
+	rs := &tidbResultSet{recordSet: indexAdviseInfo.Result}
+	defer terror.Call(rs.Close)
+	_, err = cc.writeResultset(ctx, rs, false, status, 0)
+	return err
 }
 
 // handlePlanRecreator dose the export/import work for reproducing sql queries.
@@ -1891,9 +1972,8 @@ func (cc *clientConn) handleQuerySpecial(ctx context.Context, status uint16) (bo
 	if indexAdvise != nil {
 		handled = true
 		defer cc.ctx.SetValue(executor.IndexAdviseVarKey, nil)
-		if err := cc.handleIndexAdvise(ctx, indexAdvise.(*executor.IndexAdviseInfo)); err != nil {
-			return handled, err
-		}
+		// The advice is written as a result set, so no OK packet is needed.
+		return handled, cc.handleIndexAdvise(ctx, indexAdvise.(*executor.IndexAdviseInfo), status)
 	}
 
 	planRecreator := cc.ctx.Value(executor.PlanRecreatorVarKey)
@@ -1921,6 +2001,7 @@ func (cc *clientConn) handleFieldList(ctx context.Context, sql string) (err erro
 		return err
 	}
 	data := cc.alloc.AllocWithLen(4, 1024)
+	d := cc.getResultEncoder()
 	for _, column := range columns {
 		// Current we doesn't output defaultValue but reserve defaultValue length byte to make mariadb client happy.
 		// https://dev.mysql.com/doc/internals/en/com-query-response.html#column-definition
@@ -1929,7 +2010,7 @@ func (cc *clientConn) handleFieldList(ctx context.Context, sql string) (err erro
 		column.DefaultValue = []byte{}
 
 		data = data[0:4]
-		data = column.Dump(data)
+		data = column.Dump(data, d)
 		if err := cc.writePacket(data); err != nil {
 			return err
 		}
@@ -1983,9 +2064,10 @@ func (cc *clientConn) writeColumnInfo(columns []*ColumnInfo, serverStatus uint16
 	if err := cc.writePacket(data); err != nil {
 		return err
 	}
+	d := cc.getResultEncoder()
 	for _, v := range columns {
 		data = data[0:4]
-		data = v.Dump(data)
+		data = v.Dump(data, d)
 		if err := cc.writePacket(data); err != nil {
 			return err
 		}
@@ -1999,6 +2081,7 @@ func (cc *clientConn) writeColumnInfo(columns []*ColumnInfo, serverStatus uint16
 // The first return value indicates whether error occurs at the first call of ResultSet.Next.
 func (cc *clientConn) writeChunks(ctx context.Context, rs ResultSet, binary bool, serverStatus uint16) (bool, error) {
 	data := cc.alloc.AllocWithLen(4, 1024)
+	d := cc.getResultEncoder()
 	req := rs.NewChunk()
 	gotColumnInfo := false
 	firstNext := true
@@ -2044,9 +2127,9 @@ func (cc *clientConn) writeChunks(ctx context.Context, rs ResultSet, binary bool
 		for i := 0; i < rowCount; i++ {
 			data = data[0:4]
 			if binary {
-				data, err = dumpBinaryRow(data, rs.Columns(), req.GetRow(i))
+				data, err = dumpBinaryRow(data, rs.Columns(), req.GetRow(i), d)
 			} else {
-				data, err = dumpTextRow(data, rs.Columns(), req.GetRow(i))
+				data, err = dumpTextRow(data, rs.Columns(), req.GetRow(i), d)
 			}
 			if err != nil {
 				reg.End()
@@ -2119,9 +2202,10 @@ func (cc *clientConn) writeChunksWithFetchSize(ctx context.Context, rs ResultSet
 	}
 	start := time.Now()
 	var err error
+	d := cc.getResultEncoder()
 	for _, row := range curRows {
 		data = data[0:4]
-		data, err = dumpBinaryRow(data, rs.Columns(), row)
+		data, err = dumpBinaryRow(data, rs.Columns(), row, d)
 		if err != nil {
 			return err
 		}
@@ -65,6 +65,7 @@ func (cc *clientConn) handleStmtPrepare(ctx context.Context, sql string) error {
 		return err
 	}
 	data := make([]byte, 4, 128)
+	d := cc.getResultEncoder()
 
 	// status ok
 	data = append(data, 0)
@@ -86,7 +87,7 @@ func (cc *clientConn) handleStmtPrepare(ctx context.Context, sql string) error {
 	if len(params) > 0 {
 		for i := 0; i < len(params); i++ {
 			data = data[0:4]
-			data = params[i].Dump(data)
+			data = params[i].Dump(data, d)
 
 			if err := cc.writePacket(data); err != nil {
 				return err
@@ -101,7 +102,7 @@ func (cc *clientConn) handleStmtPrepare(ctx context.Context, sql string) error {
 	if len(columns) > 0 {
 		for i := 0; i < len(columns); i++ {
 			data = data[0:4]
-			data = columns[i].Dump(data)
+			data = columns[i].Dump(data, d)
 
 			if err := cc.writePacket(data); err != nil {
 				return err
@@ -202,6 +203,18 @@ func (cc *clientConn) handleStmtExecute(ctx context.Context, data []byte) (err e
 		if err != nil {
 			return errors.Annotate(err, cc.preparedStmt2String(stmtID))
 		}
+		if enc := cc.getInputEncoding(); enc != nil {
+			// The string parameters are sent in character_set_client as the statements.
+			for i := range args {
+				if args[i].Kind() == types.KindString {
+					str, ok := enc.DecodeString(args[i].GetString())
+					if !ok {
+						return errInvalidCharacterString.GenWithStackByArgs(enc.Name(), fmt.Sprintf("%X", args[i].GetString()))
+					}
+					args[i] = types.NewStringDatum(str)
+				}
+			}
+		}
 	}
 	ctx = context.WithValue(ctx, execdetails.StmtExecDetailKey, &execdetails.StmtExecDetails{})
 	ctx = context.WithValue(ctx, util.ExecDetailsKey, &util.ExecDetails{})
@@ -570,6 +570,52 @@ func (ts *ConnTestSuite) TestGetSessionVarsWaitTimeout(c *C) {
 	c.Assert(cc.getSessionVarsWaitTimeout(context.Background()), Equals, uint64(0))
 }
 
+func (ts *ConnTestSuite) TestNegotiateCompression(c *C) {
+	se, err := session.CreateSession4Test(ts.store)
+	c.Assert(err, IsNil)
+	tk := testkit.NewTestKitWithSession(c, ts.store, se)
+	defer tk.MustExec("set global protocol_compression_algorithms = default")
+	cc := &clientConn{
+		connectionID: 1,
+		server: &Server{
+			capability: defaultCapability,
+		},
+		ctx: &TiDBContext{Session: se, stmts: make(map[int]*TiDBStatement)},
+	}
+	negotiate := func(capability uint32, zstdLevel uint8) (string, int, error) {
+		cc.capability = capability
+		cc.compressAlgorithm, cc.compressLevel = "", 0
+		err := cc.negotiateCompression(zstdLevel)
+		return cc.compressAlgorithm, cc.compressLevel, err
+	}
+
+	algorithm, level, err := negotiate(mysql.ClientProtocol41, 0)
+	c.Assert(err, IsNil)
+	c.Assert(algorithm, Equals, "")
+	algorithm, level, err = negotiate(mysql.ClientProtocol41|mysql.ClientCompress, 0)
+	c.Assert(err, IsNil)
+	c.Assert(algorithm, Equals, compressionZlib)
+	c.Assert(level, Equals, defaultZlibCompressionLevel)
+	algorithm, level, err = negotiate(mysql.ClientProtocol41|clientZstdCompressionAlgorithm, 7)
+	c.Assert(err, IsNil)
+	c.Assert(algorithm, Equals, compressionZstd)
+	c.Assert(level, Equals, 7)
+	_, _, err = negotiate(mysql.ClientProtocol41|clientZstdCompressionAlgorithm, 0)
+	c.Assert(errWrongCompressionLevelClient.Equal(err), IsTrue, Commentf("%v", err))
+
+	tk.MustExec("set global protocol_compression_algorithms = 'ZLIB'")
+	tk.MustQuery("select @@global.protocol_compression_algorithms").Check(testkit.Rows("zlib"))
+	_, _, err = negotiate(mysql.ClientProtocol41|clientZstdCompressionAlgorithm, 3)
+	c.Assert(errWrongCompressionAlgorithmClient.Equal(err), IsTrue, Commentf("%v", err))
+	_, _, err = negotiate(mysql.ClientProtocol41, 0)
+	c.Assert(errWrongCompressionAlgorithmClient.Equal(err), IsTrue, Commentf("%v", err))
+	algorithm, _, err = negotiate(mysql.ClientProtocol41|mysql.ClientCompress, 0)
+	c.Assert(err, IsNil)
+	c.Assert(algorithm, Equals, compressionZlib)
+	_, err = tk.Exec("set global protocol_compression_algorithms = 'zlib,lz4'")
+	c.Assert(err, NotNil)
+}
+
 func mapIdentical(m1, m2 map[string]string) bool {
 	return mapBelong(m1, m2) && mapBelong(m2, m1)
 }
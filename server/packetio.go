@@ -36,9 +36,14 @@ package server
 
 import (
 	"bufio"
+	"bytes"
+	"compress/zlib"
 	"io"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/parser/mysql"
 	"github.com/pingcap/parser/terror"
@@ -47,17 +52,89 @@ import (
 
 const defaultWriterSize = 16 * 1024
 
+const (
+	// clientZstdCompressionAlgorithm is the CLIENT_ZSTD_COMPRESSION_ALGORITHM capability flag,
+	// which is not defined in the parser.
+	clientZstdCompressionAlgorithm uint32 = 1 << 26
+
+	compressionUncompressed = "uncompressed"
+	compressionZlib         = "zlib"
+	compressionZstd         = "zstd"
+
+	// defaultZlibCompressionLevel is the compression level MySQL uses for zlib.
+	defaultZlibCompressionLevel = 6
+	// minCompressLength is the minimum length of the payload worth compressing, the shorter
+	// payloads are sent uncompressed, the same as MySQL.
+	minCompressLength = 50
+	// compressedHeaderLen is the length of the header of compressed packets.
+	compressedHeaderLen = 7
+)
+
 var (
 	readPacketBytes  = metrics.PacketIOHistogram.WithLabelValues("read")
 	writePacketBytes = metrics.PacketIOHistogram.WithLabelValues("write")
+
+	readCompressedBytes    = metrics.PacketIOCompressedBytesCounter.WithLabelValues("read", "compressed")
+	readUncompressedBytes  = metrics.PacketIOCompressedBytesCounter.WithLabelValues("read", "uncompressed")
+	writeCompressedBytes   = metrics.PacketIOCompressedBytesCounter.WithLabelValues("write", "compressed")
+	writeUncompressedBytes = metrics.PacketIOCompressedBytesCounter.WithLabelValues("write", "uncompressed")
+)
+
+var (
+	zstdDecoder     *zstd.Decoder
+	zstdDecoderErr  error
+	zstdDecoderOnce sync.Once
+	zstdEncoders    sync.Map // level -> *zstd.Encoder
 )
 
+// getZstdDecoder returns the zstd decoder shared by all the connections, DecodeAll is safe for
+// concurrent use.
+func getZstdDecoder() (*zstd.Decoder, error) {
+	zstdDecoderOnce.Do(func() {
+		zstdDecoder, zstdDecoderErr = zstd.NewReader(nil)
+	})
+	return zstdDecoder, errors.Trace(zstdDecoderErr)
+}
+
+// getZstdEncoder returns the zstd encoder of the compression level shared by all the connections,
+// EncodeAll is safe for concurrent use.
+func getZstdEncoder(level int) (*zstd.Encoder, error) {
+	if encoder, ok := zstdEncoders.Load(level); ok {
+		return encoder.(*zstd.Encoder), nil
+	}
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)), zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	actual, _ := zstdEncoders.LoadOrStore(level, encoder)
+	return actual.(*zstd.Encoder), nil
+}
+
+// compressionStats records the bytes transferred by a connection using protocol compression.
+type compressionStats struct {
+	// compressedBytesRead and compressedBytesWritten are the bytes on the wire.
+	compressedBytesRead    uint64
+	compressedBytesWritten uint64
+	// uncompressedBytesRead and uncompressedBytesWritten are the bytes of the packets.
+	uncompressedBytesRead    uint64
+	uncompressedBytesWritten uint64
+}
+
 // packetIO is a helper to read and write data in packet format.
 type packetIO struct {
 	bufReadConn *bufferedReadConn
 	bufWriter   *bufio.Writer
 	sequence    uint8
 	readTimeout time.Duration
+
+	// compressAlgorithm is the protocol compression algorithm negotiated in the handshake,
+	// empty means the packets are not compressed.
+	compressAlgorithm  string
+	compressLevel      int
+	compressedSequence uint8
+	// compressedReader reads the packets from the payload of compressed packets.
+	compressedReader *compressedReader
+	compressionStats compressionStats
 }
 
 func newPacketIO(bufReadConn *bufferedReadConn) *packetIO {
@@ -75,6 +152,27 @@ func (p *packetIO) setReadTimeout(timeout time.Duration) {
 	p.readTimeout = timeout
 }
 
+// setCompression makes the following packets use the compressed packet format.
+func (p *packetIO) setCompression(algorithm string, level int) {
+	p.compressAlgorithm = algorithm
+	p.compressLevel = level
+	p.compressedSequence = p.sequence
+	p.compressedReader = &compressedReader{p: p}
+	p.bufWriter = bufio.NewWriterSize(&compressedWriter{p: p}, defaultWriterSize)
+}
+
+func (p *packetIO) resetSequence() {
+	p.sequence = 0
+	p.compressedSequence = 0
+}
+
+func (p *packetIO) reader() io.Reader {
+	if p.compressedReader != nil {
+		return p.compressedReader
+	}
+	return p.bufReadConn
+}
+
 func (p *packetIO) readOnePacket() ([]byte, error) {
 	var header [4]byte
 	if p.readTimeout > 0 {
@@ -82,16 +180,18 @@ func (p *packetIO) readOnePacket() ([]byte, error) {
 			return nil, err
 		}
 	}
-	if _, err := io.ReadFull(p.bufReadConn, header[:]); err != nil {
+	if _, err := io.ReadFull(p.reader(), header[:]); err != nil {
 		return nil, errors.Trace(err)
 	}
 
 	sequence := header[3]
-	if sequence != p.sequence {
+	// Like MySQL, the sequence of the packets inside compressed packets is not checked, the
+	// sequence of the compressed packets is checked instead.
+	if sequence != p.sequence && p.compressedReader == nil {
 		return nil, errInvalidSequence.GenWithStack("invalid sequence %d != %d", sequence, p.sequence)
 	}
 
-	p.sequence++
+	p.sequence = sequence + 1
 
 	length := int(uint32(header[0]) | uint32(header[1])<<8 | uint32(header[2])<<16)
 
@@ -101,7 +201,7 @@ func (p *packetIO) readOnePacket() ([]byte, error) {
 			return nil, err
 		}
 	}
-	if _, err := io.ReadFull(p.bufReadConn, data); err != nil {
+	if _, err := io.ReadFull(p.reader(), data); err != nil {
 		return nil, errors.Trace(err)
 	}
 	return data, nil
@@ -185,5 +285,174 @@ func (p *packetIO) flush() error {
 	if err != nil {
 		return errors.Trace(err)
 	}
+	if p.compressedReader != nil {
+		// MySQL syncs the sequence to the sequence of compressed packets when flushing,
+		// the clients rely on it when they reply.
+		p.sequence = p.compressedSequence
+	}
 	return err
 }
+
+// compressedReader reads the payload of compressed packets. A compressed packet has a 7 bytes
+// header: 3 bytes of the compressed payload length, 1 byte of sequence, and 3 bytes of the
+// uncompressed payload length, which is 0 if the payload is not compressed.
+type compressedReader struct {
+	p *packetIO
+	// buf is the remaining uncompressed payload of the last compressed packet.
+	buf []byte
+}
+
+// Read implements the io.Reader interface.
+func (r *compressedReader) Read(b []byte) (int, error) {
+	if len(r.buf) == 0 {
+		if err := r.readCompressedPacket(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(b, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (r *compressedReader) readCompressedPacket() error {
+	p := r.p
+	var header [compressedHeaderLen]byte
+	if _, err := io.ReadFull(p.bufReadConn, header[:]); err != nil {
+		return errors.Trace(err)
+	}
+	sequence := header[3]
+	if sequence != p.compressedSequence {
+		return errInvalidSequence.GenWithStack("invalid compressed sequence %d != %d", sequence, p.compressedSequence)
+	}
+	p.compressedSequence++
+
+	compressedLength := int(uint32(header[0]) | uint32(header[1])<<8 | uint32(header[2])<<16)
+	uncompressedLength := int(uint32(header[4]) | uint32(header[5])<<8 | uint32(header[6])<<16)
+	payload := make([]byte, compressedLength)
+	if _, err := io.ReadFull(p.bufReadConn, payload); err != nil {
+		return errors.Trace(err)
+	}
+	atomic.AddUint64(&p.compressionStats.compressedBytesRead, uint64(compressedHeaderLen+compressedLength))
+	readCompressedBytes.Add(float64(compressedHeaderLen + compressedLength))
+	if uncompressedLength == 0 {
+		r.buf = payload
+	} else {
+		data, err := decompress(p.compressAlgorithm, payload, uncompressedLength)
+		if err != nil {
+			return err
+		}
+		r.buf = data
+	}
+	atomic.AddUint64(&p.compressionStats.uncompressedBytesRead, uint64(len(r.buf)))
+	readUncompressedBytes.Add(float64(len(r.buf)))
+	return nil
+}
+
+// compressedWriter writes the data as the payload of compressed packets.
+type compressedWriter struct {
+	p *packetIO
+}
+
+// Write implements the io.Writer interface.
+func (w *compressedWriter) Write(data []byte) (int, error) {
+	written := 0
+	for len(data) > 0 {
+		payload := data
+		if len(payload) > mysql.MaxPayloadLen {
+			payload = payload[:mysql.MaxPayloadLen]
+		}
+		if err := w.writeCompressedPacket(payload); err != nil {
+			return written, err
+		}
+		written += len(payload)
+		data = data[len(payload):]
+	}
+	return written, nil
+}
+
+func (w *compressedWriter) writeCompressedPacket(payload []byte) error {
+	p := w.p
+	uncompressedLength := 0
+	compressed := payload
+	if len(payload) >= minCompressLength {
+		data, err := compress(p.compressAlgorithm, p.compressLevel, payload)
+		if err != nil {
+			return err
+		}
+		// Send the payload uncompressed if it can not be compressed.
+		if len(data) < len(payload) {
+			compressed = data
+			uncompressedLength = len(payload)
+		}
+	}
+	header := [compressedHeaderLen]byte{
+		byte(len(compressed)), byte(len(compressed) >> 8), byte(len(compressed) >> 16),
+		p.compressedSequence,
+		byte(uncompressedLength), byte(uncompressedLength >> 8), byte(uncompressedLength >> 16),
+	}
+	if _, err := p.bufReadConn.Write(header[:]); err != nil {
+		terror.Log(errors.Trace(err))
+		return errors.Trace(mysql.ErrBadConn)
+	}
+	if _, err := p.bufReadConn.Write(compressed); err != nil {
+		terror.Log(errors.Trace(err))
+		return errors.Trace(mysql.ErrBadConn)
+	}
+	p.compressedSequence++
+	atomic.AddUint64(&p.compressionStats.compressedBytesWritten, uint64(compressedHeaderLen+len(compressed)))
+	atomic.AddUint64(&p.compressionStats.uncompressedBytesWritten, uint64(len(payload)))
+	writeCompressedBytes.Add(float64(compressedHeaderLen + len(compressed)))
+	writeUncompressedBytes.Add(float64(len(payload)))
+	return nil
+}
+
+func compress(algorithm string, level int, data []byte) ([]byte, error) {
+	switch algorithm {
+	case compressionZlib:
+		var buf bytes.Buffer
+		w, err := zlib.NewWriterLevel(&buf, level)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if _, err = w.Write(data); err != nil {
+			return nil, errors.Trace(err)
+		}
+		if err = w.Close(); err != nil {
+			return nil, errors.Trace(err)
+		}
+		return buf.Bytes(), nil
+	case compressionZstd:
+		encoder, err := getZstdEncoder(level)
+		if err != nil {
+			return nil, err
+		}
+		return encoder.EncodeAll(data, nil), nil
+	}
+	return nil, errors.Errorf("unknown compression algorithm %s", algorithm)
+}
+
+func decompress(algorithm string, data []byte, uncompressedLength int) ([]byte, error) {
+	switch algorithm {
+	case compressionZlib:
+		r, err := zlib.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, errNetUncompress.GenWithStackByArgs()
+		}
+		buf := make([]byte, uncompressedLength)
+		if _, err = io.ReadFull(r, buf); err != nil {
+			return nil, errNetUncompress.GenWithStackByArgs()
+		}
+		return buf, errors.Trace(r.Close())
+	case compressionZstd:
+		decoder, err := getZstdDecoder()
+		if err != nil {
+			return nil, err
+		}
+		buf, err := decoder.DecodeAll(data, make([]byte, 0, uncompressedLength))
+		if err != nil || len(buf) != uncompressedLength {
+			return nil, errNetUncompress.GenWithStackByArgs()
+		}
+		return buf, nil
+	}
+	return nil, errors.Errorf("unknown compression algorithm %s", algorithm)
+}
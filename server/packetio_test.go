@@ -89,6 +89,43 @@ func (s *PacketIOTestSuite) TestRead(c *C) {
 	c.Assert(bytes[mysql.MaxPayloadLen], DeepEquals, byte(0x0a))
 }
 
+func (s *PacketIOTestSuite) TestCompressedReadWrite(c *C) {
+	small := []byte{0x00, 0x00, 0x00, 0x00, 0x01, 0x02, 0x03}
+	large := append(make([]byte, 4), bytes.Repeat([]byte("compressed packet "), 10000)...)
+	for _, tt := range []struct {
+		algorithm string
+		level     int
+	}{
+		{compressionZlib, defaultZlibCompressionLevel},
+		{compressionZstd, 3},
+	} {
+		comment := Commentf("algorithm %s", tt.algorithm)
+		conn := &bytesConn{}
+		pkt := newPacketIO(newBufferedReadConn(conn))
+		pkt.setCompression(tt.algorithm, tt.level)
+		c.Assert(pkt.writePacket(append([]byte(nil), small...)), IsNil, comment)
+		c.Assert(pkt.flush(), IsNil, comment)
+		// The payload is too short to compress, so the uncompressed length is 0.
+		c.Assert(conn.b.Bytes(), DeepEquals, []byte{0x07, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01, 0x02, 0x03}, comment)
+		c.Assert(pkt.writePacket(append([]byte(nil), large...)), IsNil, comment)
+		c.Assert(pkt.flush(), IsNil, comment)
+		stats := pkt.compressionStats
+		c.Assert(stats.uncompressedBytesWritten, Equals, uint64(len(small)+len(large)), comment)
+		c.Assert(stats.compressedBytesWritten < stats.uncompressedBytesWritten/10, IsTrue, comment)
+
+		pkt = newPacketIO(newBufferedReadConn(&bytesConn{conn.b}))
+		pkt.setCompression(tt.algorithm, tt.level)
+		data, err := pkt.readPacket()
+		c.Assert(err, IsNil, comment)
+		c.Assert(data, DeepEquals, small[4:], comment)
+		data, err = pkt.readPacket()
+		c.Assert(err, IsNil, comment)
+		c.Assert(data, DeepEquals, large[4:], comment)
+		c.Assert(pkt.compressionStats.compressedBytesRead, Equals, stats.compressedBytesWritten, comment)
+		c.Assert(pkt.compressionStats.uncompressedBytesRead, Equals, stats.uncompressedBytesWritten, comment)
+	}
+}
+
 type bytesConn struct {
 	b bytes.Buffer
 }
@@ -98,7 +135,7 @@ func (c *bytesConn) Read(b []byte) (n int, err error) {
 }
 
 func (c *bytesConn) Write(b []byte) (n int, err error) {
-	return 0, nil
+	return c.b.Write(b)
 }
 
 func (c *bytesConn) Close() error {
@@ -56,6 +56,7 @@ import (
 	"github.com/pingcap/tidb/kv"
 	"github.com/pingcap/tidb/metrics"
 	"github.com/pingcap/tidb/plugin"
+	"github.com/pingcap/tidb/privilege"
 	"github.com/pingcap/tidb/session/txninfo"
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/util"
@@ -100,6 +101,11 @@ var (
 	errSecureTransportRequired = dbterror.ClassServer.NewStd(errno.ErrSecureTransportRequired)
 	errMultiStatementDisabled  = dbterror.ClassServer.NewStd(errno.ErrMultiStatementDisabled)
 	errNewAbortingConnection   = dbterror.ClassServer.NewStd(errno.ErrNewAbortingConnection)
+	errNetUncompress           = dbterror.ClassServer.NewStd(errno.ErrNetUncompress)
+	errInvalidCharacterString  = dbterror.ClassServer.NewStd(errno.ErrInvalidCharacterString)
+
+	errWrongCompressionAlgorithmClient = dbterror.ClassServer.NewStd(errno.ErrWrongCompressionAlgorithmClient)
+	errWrongCompressionLevelClient     = dbterror.ClassServer.NewStd(errno.ErrWrongCompressionLevelClient)
 )
 
 // DefaultCapability is the capability of the server when it is created using the default configuration.
@@ -108,7 +114,8 @@ const defaultCapability = mysql.ClientLongPassword | mysql.ClientLongFlag |
 	mysql.ClientConnectWithDB | mysql.ClientProtocol41 |
 	mysql.ClientTransactions | mysql.ClientSecureConnection | mysql.ClientFoundRows |
 	mysql.ClientMultiStatements | mysql.ClientMultiResults | mysql.ClientLocalFiles |
-	mysql.ClientConnectAtts | mysql.ClientPluginAuth | mysql.ClientInteractive
+	mysql.ClientConnectAtts | mysql.ClientPluginAuth | mysql.ClientInteractive |
+	mysql.ClientCompress | clientZstdCompressionAlgorithm | privilege.ClientCanHandleExpiredPasswords
 
 // Server is the MySQL protocol server
 type Server struct {
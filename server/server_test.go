@@ -371,6 +371,39 @@ func (cli *testServerClient) runTestClientWithCollation(t *C) {
 	})
 }
 
+func (cli *testServerClient) runTestClientWithGBK(t *C) {
+	cli.runTestsOnNewDB(t, func(config *mysql.Config) {
+		config.Params["charset"] = "gbk"
+	}, "ClientWithGBK", func(dbt *DBTest) {
+		// The statements, the parameters and the results are all in gbk.
+		dbt.mustExec("create table t (a varchar(10) charset gbk, b varchar(10))")
+		dbt.mustExec("insert into t values ('\xd6\xd0\xce\xc4', '\xd6\xd0\xce\xc4')")
+		dbt.mustExec("insert into t values (?, ?)", "\xba\xba\xd7\xd6", "\xba\xba\xd7\xd6")
+		rows := dbt.mustQuery("select a, b, hex(a), length(b) from t where a like ? order by hex(a)", "%")
+		var a, b, hex string
+		var length int
+		for _, expected := range [][]string{{"\xba\xba\xd7\xd6", "BABAD7D6"}, {"\xd6\xd0\xce\xc4", "D6D0CEC4"}} {
+			t.Assert(rows.Next(), IsTrue)
+			t.Assert(rows.Scan(&a, &b, &hex, &length), IsNil)
+			t.Assert(a, Equals, expected[0])
+			t.Assert(b, Equals, expected[0])
+			t.Assert(hex, Equals, expected[1])
+			t.Assert(length, Equals, 6)
+		}
+		t.Assert(rows.Next(), IsFalse)
+		t.Assert(rows.Close(), IsNil)
+
+		// The statements and the parameters which aren't valid in gbk are rejected.
+		_, err := dbt.db.Exec("insert into t values ('\x81', 'a')")
+		checkErrorCode(t, err, errno.ErrInvalidCharacterString)
+		_, err = dbt.db.Exec("insert into t values (?, 'a')", "\x81")
+		checkErrorCode(t, err, errno.ErrInvalidCharacterString)
+		var count int
+		t.Assert(dbt.db.QueryRow("select count(*) from t").Scan(&count), IsNil)
+		t.Assert(count, Equals, 2)
+	})
+}
+
 func (cli *testServerClient) runTestPreparedString(t *C) {
 	cli.runTestsOnNewDB(t, nil, "PreparedString", func(dbt *DBTest) {
 		dbt.mustExec("create table test (a char(10), b char(10))")
@@ -15,6 +15,7 @@ package server
 
 import (
 	"crypto/x509"
+	"sync/atomic"
 
 	"github.com/pingcap/tidb/sessionctx/variable"
 	"github.com/pingcap/tidb/util/logutil"
@@ -24,11 +25,27 @@ import (
 var (
 	serverNotAfter  = "Ssl_server_not_after"
 	serverNotBefore = "Ssl_server_not_before"
+
+	compression               = "Compression"
+	compressionAlgorithm      = "Compression_algorithm"
+	compressionLevel          = "Compression_level"
+	compressedBytesReceived   = "Compressed_bytes_received"
+	compressedBytesSent       = "Compressed_bytes_sent"
+	uncompressedBytesReceived = "Uncompressed_bytes_received"
+	uncompressedBytesSent     = "Uncompressed_bytes_sent"
 )
 
 var defaultStatus = map[string]*variable.StatusVal{
 	serverNotAfter:  {Scope: variable.ScopeGlobal | variable.ScopeSession, Value: ""},
 	serverNotBefore: {Scope: variable.ScopeGlobal | variable.ScopeSession, Value: ""},
+
+	compression:               {Scope: variable.ScopeSession, Value: variable.Off},
+	compressionAlgorithm:      {Scope: variable.ScopeSession, Value: ""},
+	compressionLevel:          {Scope: variable.ScopeSession, Value: 0},
+	compressedBytesReceived:   {Scope: variable.ScopeSession, Value: uint64(0)},
+	compressedBytesSent:       {Scope: variable.ScopeSession, Value: uint64(0)},
+	uncompressedBytesReceived: {Scope: variable.ScopeSession, Value: uint64(0)},
+	uncompressedBytesSent:     {Scope: variable.ScopeSession, Value: uint64(0)},
 }
 
 // GetScope gets the status variables scope.
@@ -56,5 +73,22 @@ func (s *Server) Stats(vars *variable.SessionVars) (map[string]interface{}, erro
 			}
 		}
 	}
+
+	if vars == nil {
+		return m, nil
+	}
+	s.rwlock.RLock()
+	cc, ok := s.clients[vars.ConnectionID]
+	s.rwlock.RUnlock()
+	if ok && cc.pkt.compressAlgorithm != "" {
+		stats := &cc.pkt.compressionStats
+		m[compression] = variable.On
+		m[compressionAlgorithm] = cc.pkt.compressAlgorithm
+		m[compressionLevel] = cc.pkt.compressLevel
+		m[compressedBytesReceived] = atomic.LoadUint64(&stats.compressedBytesRead)
+		m[compressedBytesSent] = atomic.LoadUint64(&stats.compressedBytesWritten)
+		m[uncompressedBytesReceived] = atomic.LoadUint64(&stats.uncompressedBytesRead)
+		m[uncompressedBytesSent] = atomic.LoadUint64(&stats.uncompressedBytesWritten)
+	}
 	return m, nil
 }
@@ -1173,6 +1173,11 @@ func (ts *tidbTestSuite) TestClientWithCollation(c *C) {
 	ts.runTestClientWithCollation(c)
 }
 
+func (ts *tidbTestSuite) TestClientWithGBK(c *C) {
+	c.Parallel()
+	ts.runTestClientWithGBK(c)
+}
+
 func (ts *tidbTestSuite) TestCreateTableFlen(c *C) {
 	// issue #4540
 	qctx, err := ts.tidbdrv.OpenCtx(uint64(0), 0, uint8(tmysql.DefaultCollationID), "test", nil)
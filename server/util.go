@@ -43,10 +43,12 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/pingcap/parser/charset"
 	"github.com/pingcap/parser/mysql"
 	"github.com/pingcap/tidb/config"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/encoding"
 	"github.com/pingcap/tidb/util/hack"
 )
 
@@ -230,7 +232,49 @@ func dumpBinaryDateTime(data []byte, t types.Time) []byte {
 	return data
 }
 
-func dumpBinaryRow(buffer []byte, columns []*ColumnInfo, row chunk.Row) ([]byte, error) {
+// resultEncoder encodes the strings in the results, which are kept in UTF-8, into character_set_results.
+// The methods of a nil resultEncoder leave the columns and the strings as they are.
+type resultEncoder struct {
+	// enc is nil if the strings are sent in UTF-8.
+	enc *encoding.Encoding
+	// chsID is the ID of the default collation of character_set_results.
+	chsID  uint16
+	buffer []byte
+}
+
+// newResultEncoder returns the resultEncoder of character_set_results, nil if it's NULL.
+func newResultEncoder(chs string) *resultEncoder {
+	if chs == "" {
+		return nil
+	}
+	return &resultEncoder{enc: encoding.GetEncoding(chs), chsID: uint16(mysql.CharsetNameToID(chs))}
+}
+
+// columnCharset returns the charset of the column sent to the client. The strings of the column are in
+// character_set_results if they are encoded, or in UTF-8 if the column is in a charset like gbk.
+func (d *resultEncoder) columnCharset(chsID uint16) uint16 {
+	if d == nil || chsID == mysql.BinaryDefaultCollationID {
+		return chsID
+	}
+	if d.enc != nil {
+		return d.chsID
+	}
+	if chs, _, err := charset.GetCharsetInfoByID(int(chsID)); err == nil && encoding.GetEncoding(chs) != nil {
+		return d.chsID
+	}
+	return chsID
+}
+
+// encodeData encodes the string of the column, the returned slice is only valid until the next call.
+func (d *resultEncoder) encodeData(chsID uint16, data []byte) []byte {
+	if d == nil || d.enc == nil || chsID == mysql.BinaryDefaultCollationID {
+		return data
+	}
+	d.buffer, _ = d.enc.Encode(d.buffer[:0], string(hack.String(data)))
+	return d.buffer
+}
+
+func dumpBinaryRow(buffer []byte, columns []*ColumnInfo, row chunk.Row, d *resultEncoder) ([]byte, error) {
 	buffer = append(buffer, mysql.OKHeader)
 	nullBitmapOff := len(buffer)
 	numBytes4Null := (len(columns) + 7 + 2) / 8
@@ -261,15 +305,15 @@ func dumpBinaryRow(buffer []byte, columns []*ColumnInfo, row chunk.Row) ([]byte,
 			buffer = dumpLengthEncodedString(buffer, hack.Slice(row.GetMyDecimal(i).String()))
 		case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar, mysql.TypeBit,
 			mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
-			buffer = dumpLengthEncodedString(buffer, row.GetBytes(i))
+			buffer = dumpLengthEncodedString(buffer, d.encodeData(columns[i].Charset, row.GetBytes(i)))
 		case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp:
 			buffer = dumpBinaryDateTime(buffer, row.GetTime(i))
 		case mysql.TypeDuration:
 			buffer = append(buffer, dumpBinaryTime(row.GetDuration(i, 0).Duration)...)
 		case mysql.TypeEnum:
-			buffer = dumpLengthEncodedString(buffer, hack.Slice(row.GetEnum(i).String()))
+			buffer = dumpLengthEncodedString(buffer, d.encodeData(columns[i].Charset, hack.Slice(row.GetEnum(i).String())))
 		case mysql.TypeSet:
-			buffer = dumpLengthEncodedString(buffer, hack.Slice(row.GetSet(i).String()))
+			buffer = dumpLengthEncodedString(buffer, d.encodeData(columns[i].Charset, hack.Slice(row.GetSet(i).String())))
 		case mysql.TypeJSON:
 			buffer = dumpLengthEncodedString(buffer, hack.Slice(row.GetJSON(i).String()))
 		default:
@@ -279,7 +323,7 @@ func dumpBinaryRow(buffer []byte, columns []*ColumnInfo, row chunk.Row) ([]byte,
 	return buffer, nil
 }
 
-func dumpTextRow(buffer []byte, columns []*ColumnInfo, row chunk.Row) ([]byte, error) {
+func dumpTextRow(buffer []byte, columns []*ColumnInfo, row chunk.Row, d *resultEncoder) ([]byte, error) {
 	tmp := make([]byte, 0, 20)
 	for i, col := range columns {
 		if row.IsNull(i) {
@@ -324,16 +368,16 @@ func dumpTextRow(buffer []byte, columns []*ColumnInfo, row chunk.Row) ([]byte, e
 			buffer = dumpLengthEncodedString(buffer, hack.Slice(row.GetMyDecimal(i).String()))
 		case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar, mysql.TypeBit,
 			mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
-			buffer = dumpLengthEncodedString(buffer, row.GetBytes(i))
+			buffer = dumpLengthEncodedString(buffer, d.encodeData(columns[i].Charset, row.GetBytes(i)))
 		case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp:
 			buffer = dumpLengthEncodedString(buffer, hack.Slice(row.GetTime(i).String()))
 		case mysql.TypeDuration:
 			dur := row.GetDuration(i, int(col.Decimal))
 			buffer = dumpLengthEncodedString(buffer, hack.Slice(dur.String()))
 		case mysql.TypeEnum:
-			buffer = dumpLengthEncodedString(buffer, hack.Slice(row.GetEnum(i).String()))
+			buffer = dumpLengthEncodedString(buffer, d.encodeData(columns[i].Charset, hack.Slice(row.GetEnum(i).String())))
 		case mysql.TypeSet:
-			buffer = dumpLengthEncodedString(buffer, hack.Slice(row.GetSet(i).String()))
+			buffer = dumpLengthEncodedString(buffer, d.encodeData(columns[i].Charset, hack.Slice(row.GetSet(i).String())))
 		case mysql.TypeJSON:
 			buffer = dumpLengthEncodedString(buffer, hack.Slice(row.GetJSON(i).String()))
 		default:
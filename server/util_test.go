@@ -127,56 +127,56 @@ func (s *testUtilSuite) TestDumpTextValue(c *C) {
 
 	null := types.NewIntDatum(0)
 	null.SetNull()
-	bs, err := dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{null}).ToRow())
+	bs, err := dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{null}).ToRow(), nil)
 	c.Assert(err, IsNil)
 	_, isNull, _, err := parseLengthEncodedBytes(bs)
 	c.Assert(err, IsNil)
 	c.Assert(isNull, IsTrue)
 
-	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{types.NewIntDatum(10)}).ToRow())
+	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{types.NewIntDatum(10)}).ToRow(), nil)
 	c.Assert(err, IsNil)
 	c.Assert(mustDecodeStr(c, bs), Equals, "10")
 
-	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{types.NewUintDatum(11)}).ToRow())
+	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{types.NewUintDatum(11)}).ToRow(), nil)
 	c.Assert(err, IsNil)
 	c.Assert(mustDecodeStr(c, bs), Equals, "11")
 
 	columns[0].Flag |= uint16(mysql.UnsignedFlag)
-	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{types.NewUintDatum(11)}).ToRow())
+	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{types.NewUintDatum(11)}).ToRow(), nil)
 	c.Assert(err, IsNil)
 	c.Assert(mustDecodeStr(c, bs), Equals, "11")
 
 	columns[0].Type = mysql.TypeFloat
 	columns[0].Decimal = 1
 	f32 := types.NewFloat32Datum(1.2)
-	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{f32}).ToRow())
+	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{f32}).ToRow(), nil)
 	c.Assert(err, IsNil)
 	c.Assert(mustDecodeStr(c, bs), Equals, "1.2")
 
 	columns[0].Decimal = 2
-	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{f32}).ToRow())
+	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{f32}).ToRow(), nil)
 	c.Assert(err, IsNil)
 	c.Assert(mustDecodeStr(c, bs), Equals, "1.20")
 
 	f64 := types.NewFloat64Datum(2.2)
 	columns[0].Type = mysql.TypeDouble
 	columns[0].Decimal = 1
-	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{f64}).ToRow())
+	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{f64}).ToRow(), nil)
 	c.Assert(err, IsNil)
 	c.Assert(mustDecodeStr(c, bs), Equals, "2.2")
 
 	columns[0].Decimal = 2
-	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{f64}).ToRow())
+	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{f64}).ToRow(), nil)
 	c.Assert(err, IsNil)
 	c.Assert(mustDecodeStr(c, bs), Equals, "2.20")
 
 	columns[0].Type = mysql.TypeBlob
-	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{types.NewBytesDatum([]byte("foo"))}).ToRow())
+	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{types.NewBytesDatum([]byte("foo"))}).ToRow(), nil)
 	c.Assert(err, IsNil)
 	c.Assert(mustDecodeStr(c, bs), Equals, "foo")
 
 	columns[0].Type = mysql.TypeVarchar
-	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{types.NewStringDatum("bar")}).ToRow())
+	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{types.NewStringDatum("bar")}).ToRow(), nil)
 	c.Assert(err, IsNil)
 	c.Assert(mustDecodeStr(c, bs), Equals, "bar")
 
@@ -192,7 +192,7 @@ func (s *testUtilSuite) TestDumpTextValue(c *C) {
 	c.Assert(err, IsNil)
 	d.SetMysqlTime(time)
 	columns[0].Type = mysql.TypeDatetime
-	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{d}).ToRow())
+	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{d}).ToRow(), nil)
 	c.Assert(err, IsNil)
 	c.Assert(mustDecodeStr(c, bs), Equals, "2017-01-06 00:00:00")
 
@@ -201,38 +201,38 @@ func (s *testUtilSuite) TestDumpTextValue(c *C) {
 	d.SetMysqlDuration(duration)
 	columns[0].Type = mysql.TypeDuration
 	columns[0].Decimal = 0
-	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{d}).ToRow())
+	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{d}).ToRow(), nil)
 	c.Assert(err, IsNil)
 	c.Assert(mustDecodeStr(c, bs), Equals, "11:30:45")
 
 	d.SetMysqlDecimal(types.NewDecFromStringForTest("1.23"))
 	columns[0].Type = mysql.TypeNewDecimal
-	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{d}).ToRow())
+	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{d}).ToRow(), nil)
 	c.Assert(err, IsNil)
 	c.Assert(mustDecodeStr(c, bs), Equals, "1.23")
 
 	year := types.NewIntDatum(0)
 	columns[0].Type = mysql.TypeYear
-	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{year}).ToRow())
+	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{year}).ToRow(), nil)
 	c.Assert(err, IsNil)
 	c.Assert(mustDecodeStr(c, bs), Equals, "0000")
 
 	year.SetInt64(1984)
 	columns[0].Type = mysql.TypeYear
-	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{year}).ToRow())
+	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{year}).ToRow(), nil)
 	c.Assert(err, IsNil)
 	c.Assert(mustDecodeStr(c, bs), Equals, "1984")
 
 	enum := types.NewMysqlEnumDatum(types.Enum{Name: "ename", Value: 0})
 	columns[0].Type = mysql.TypeEnum
-	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{enum}).ToRow())
+	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{enum}).ToRow(), nil)
 	c.Assert(err, IsNil)
 	c.Assert(mustDecodeStr(c, bs), Equals, "ename")
 
 	set := types.Datum{}
 	set.SetMysqlSet(types.Set{Name: "sname", Value: 0}, mysql.DefaultCollationName)
 	columns[0].Type = mysql.TypeSet
-	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{set}).ToRow())
+	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{set}).ToRow(), nil)
 	c.Assert(err, IsNil)
 	c.Assert(mustDecodeStr(c, bs), Equals, "sname")
 
@@ -241,11 +241,63 @@ func (s *testUtilSuite) TestDumpTextValue(c *C) {
 	c.Assert(err, IsNil)
 	js.SetMysqlJSON(binaryJSON)
 	columns[0].Type = mysql.TypeJSON
-	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{js}).ToRow())
+	bs, err = dumpTextRow(nil, columns, chunk.MutRowFromDatums([]types.Datum{js}).ToRow(), nil)
 	c.Assert(err, IsNil)
 	c.Assert(mustDecodeStr(c, bs), Equals, `{"a": 1, "b": 2}`)
 }
 
+func (s *testUtilSuite) TestDumpWithResultEncoder(c *C) {
+	columns := []*ColumnInfo{{
+		Type:    mysql.TypeVarchar,
+		Charset: uint16(mysql.CharsetNameToID("gbk")),
+	}, {
+		Type:    mysql.TypeBlob,
+		Charset: mysql.BinaryDefaultCollationID,
+	}, {
+		Type:    mysql.TypeEnum,
+		Charset: mysql.DefaultCollationID,
+	}}
+	row := chunk.MutRowFromDatums([]types.Datum{types.NewStringDatum("中文"), types.NewBytesDatum([]byte("中文")),
+		types.NewMysqlEnumDatum(types.Enum{Name: "汉字", Value: 1})}).ToRow()
+
+	// The strings are sent in UTF-8 if character_set_results is utf8mb4, the gbk column is labeled as utf8mb4.
+	d := newResultEncoder("utf8mb4")
+	bs, err := dumpTextRow(nil, columns, row, d)
+	c.Assert(err, IsNil)
+	c.Assert(mustDecodeStr(c, bs), Equals, "中文")
+	c.Assert(d.columnCharset(columns[0].Charset), Equals, uint16(mysql.DefaultCollationID))
+	c.Assert(d.columnCharset(columns[1].Charset), Equals, uint16(mysql.BinaryDefaultCollationID))
+	c.Assert(d.columnCharset(columns[2].Charset), Equals, uint16(mysql.DefaultCollationID))
+
+	// The non-binary strings are encoded if character_set_results is gbk.
+	d = newResultEncoder("gbk")
+	bs, err = dumpTextRow(nil, columns, row, d)
+	c.Assert(err, IsNil)
+	str, _, n, err := parseLengthEncodedBytes(bs)
+	c.Assert(err, IsNil)
+	c.Assert(str, DeepEquals, []byte{0xD6, 0xD0, 0xCE, 0xC4})
+	bs = bs[n:]
+	str, _, n, err = parseLengthEncodedBytes(bs)
+	c.Assert(err, IsNil)
+	c.Assert(string(str), Equals, "中文")
+	c.Assert(mustDecodeStr(c, bs[n:]), Equals, "\xba\xba\xd7\xd6")
+	bs, err = dumpBinaryRow(nil, columns, row, d)
+	c.Assert(err, IsNil)
+	c.Assert(mustDecodeStr(c, bs[2:]), Equals, "\xd6\xd0\xce\xc4")
+	gbkID := uint16(mysql.CharsetNameToID("gbk"))
+	c.Assert(d.columnCharset(columns[0].Charset), Equals, gbkID)
+	c.Assert(d.columnCharset(columns[1].Charset), Equals, uint16(mysql.BinaryDefaultCollationID))
+	c.Assert(d.columnCharset(columns[2].Charset), Equals, gbkID)
+
+	// Nothing is converted if character_set_results is NULL.
+	d = newResultEncoder("")
+	c.Assert(d, IsNil)
+	bs, err = dumpTextRow(nil, columns, row, d)
+	c.Assert(err, IsNil)
+	c.Assert(mustDecodeStr(c, bs), Equals, "中文")
+	c.Assert(d.columnCharset(columns[0].Charset), Equals, columns[0].Charset)
+}
+
 func mustDecodeStr(c *C, b []byte) string {
 	str, _, _, err := parseLengthEncodedBytes(b)
 	c.Assert(err, IsNil)
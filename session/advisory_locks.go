@@ -0,0 +1,324 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/parser/model"
+	"github.com/pingcap/parser/mysql"
+	"github.com/pingcap/tidb/domain"
+	"github.com/pingcap/tidb/kv"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
+	storeerr "github.com/pingcap/tidb/store/driver/error"
+	"github.com/pingcap/tidb/table/tables"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/codec"
+	tikverr "github.com/tikv/client-go/v2/error"
+	tikvstore "github.com/tikv/client-go/v2/kv"
+	"github.com/tikv/client-go/v2/oracle"
+	"github.com/tikv/client-go/v2/tikv"
+	"github.com/tikv/client-go/v2/tikvrpc"
+)
+
+// advisoryLock is a user-level lock acquired by GET_LOCK().
+//
+// Each lock is held by a pessimistic transaction of a dedicated internal session,
+// which inserts the lock name into mysql.advisory_locks and never commits. The
+// lock is thus the pessimistic lock on the key of the lock name, whose TTL is kept
+// alive by the transaction until it is rolled back. Since the lock lives in TiKV,
+// it is exclusive across all the TiDB instances.
+type advisoryLock struct {
+	session        *session
+	referenceCount int
+}
+
+// advisoryLockHolders records the connection ID of the session holding each
+// advisory lock in this instance, which is reported by IS_USED_LOCK().
+var advisoryLockHolders = struct {
+	sync.Mutex
+	holders map[string]uint64
+}{holders: make(map[string]uint64)}
+
+// advisoryLockKillCheckInterval is the interval to check whether the session
+// waiting for an advisory lock is killed.
+const advisoryLockKillCheckInterval = 100 * time.Millisecond
+
+// maxAdvisoryLockWaitTimeout is the max timeout of GET_LOCK() in seconds, which is
+// the same as the max value of innodb_lock_wait_timeout.
+const maxAdvisoryLockWaitTimeout = 1073741824
+
+func newAdvisoryLock(store kv.Storage) (*advisoryLock, error) {
+	se, err := createSession(store)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &advisoryLock{session: se}, nil
+}
+
+// lockWaitTimeout converts the timeout of GET_LOCK() in seconds to the pessimistic
+// lock wait timeout in milliseconds. Like innodb_lock_wait_timeout, the timeout is
+// clamped to at most 1073741824 seconds, so a negative timeout waits (almost)
+// forever. A zero timeout doesn't wait at all, i.e. the lock is acquired by a
+// NOWAIT pessimistic lock request.
+func lockWaitTimeout(timeout int64) int64 {
+	switch {
+	case timeout < 0 || timeout > maxAdvisoryLockWaitTimeout:
+		timeout = maxAdvisoryLockWaitTimeout
+	case timeout == 0:
+		return tikvstore.LockNoWait
+	}
+	return timeout * 1000
+}
+
+// tryLock tries to acquire the lock within the timeout. The lock wait is
+// interrupted once the killed flag is set.
+func (a *advisoryLock) tryLock(ctx context.Context, lockName string, timeout int64, killed *uint32) error {
+	if _, err := a.session.ExecuteInternal(ctx, "BEGIN PESSIMISTIC"); err != nil {
+		return errors.Trace(err)
+	}
+	// The global variables are loaded by the first statement of the session,
+	// so the lock wait timeout is set after BEGIN to avoid being overwritten.
+	vars := a.session.GetSessionVars()
+	vars.LockWaitTimeout = lockWaitTimeout(timeout)
+
+	// The lock wait happens in the internal session, so the kill of the
+	// session calling GET_LOCK() is passed on to it.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(advisoryLockKillCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if atomic.LoadUint32(killed) == 1 {
+					atomic.StoreUint32(&vars.Killed, 1)
+					return
+				}
+			}
+		}
+	}()
+	_, err := a.session.ExecuteInternal(ctx, "INSERT INTO mysql.advisory_locks (lock_name) VALUES (%?)", lockName)
+	return errors.Trace(err)
+}
+
+func (a *advisoryLock) close() {
+	a.session.RollbackTxn(context.Background())
+	a.session.Close()
+}
+
+// isLockWaitFailed checks whether the error is caused by failing to acquire
+// the pessimistic lock within the lock wait timeout.
+func isLockWaitFailed(err error) bool {
+	return storeerr.ErrLockWaitTimeout.Equal(err) || storeerr.ErrLockAcquireFailAndNoWaitSet.Equal(err)
+}
+
+// GetAdvisoryLock acquires the advisory lock of the name, waiting for at most
+// timeout seconds. The lock is reentrant for the session holding it. It returns
+// false if the lock is not acquired within the timeout.
+func (s *session) GetAdvisoryLock(lockName string, timeout int64) (bool, error) {
+	if lock, ok := s.advisoryLocks[lockName]; ok {
+		lock.referenceCount++
+		return true, nil
+	}
+	lock, err := newAdvisoryLock(s.store)
+	if err != nil {
+		return false, err
+	}
+	if err = lock.tryLock(context.Background(), lockName, timeout, &s.sessionVars.Killed); err != nil {
+		lock.close()
+		if isLockWaitFailed(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	lock.referenceCount = 1
+	s.advisoryLocks[lockName] = lock
+
+	advisoryLockHolders.Lock()
+	advisoryLockHolders.holders[lockName] = s.sessionVars.ConnectionID
+	advisoryLockHolders.Unlock()
+	return true, nil
+}
+
+// ReleaseAdvisoryLock releases the advisory lock of the name once, and the lock
+// is freed after it is released as many times as it is acquired. It returns
+// false if the lock is not held by the session.
+func (s *session) ReleaseAdvisoryLock(lockName string) bool {
+	lock, ok := s.advisoryLocks[lockName]
+	if !ok {
+		return false
+	}
+	lock.referenceCount--
+	if lock.referenceCount == 0 {
+		s.freeAdvisoryLock(lockName, lock)
+	}
+	return true
+}
+
+// ReleaseAllAdvisoryLocks frees all the advisory locks held by the session, and
+// returns the number of the released locks counting the reentrant ones.
+func (s *session) ReleaseAllAdvisoryLocks() int {
+	count := 0
+	for lockName, lock := range s.advisoryLocks {
+		count += lock.referenceCount
+		s.freeAdvisoryLock(lockName, lock)
+	}
+	return count
+}
+
+func (s *session) freeAdvisoryLock(lockName string, lock *advisoryLock) {
+	advisoryLockHolders.Lock()
+	delete(advisoryLockHolders.holders, lockName)
+	advisoryLockHolders.Unlock()
+	lock.close()
+	delete(s.advisoryLocks, lockName)
+}
+
+// unknownAdvisoryLockHolder is reported as the connection ID of the holder when
+// the real one is unknown, e.g. the lock is held by a session of another TiDB
+// instance, or by a session without connection.
+const unknownAdvisoryLockHolder = 1
+
+// IsUsedAdvisoryLock returns the connection ID of the session holding the
+// advisory lock of the name, or 0 if the lock is free. The lock state is only
+// read, i.e. the lock is never acquired by the check.
+func (s *session) IsUsedAdvisoryLock(lockName string) (uint64, error) {
+	if _, ok := s.advisoryLocks[lockName]; ok {
+		return advisoryLockHolderID(s.sessionVars.ConnectionID), nil
+	}
+	advisoryLockHolders.Lock()
+	connID, ok := advisoryLockHolders.holders[lockName]
+	advisoryLockHolders.Unlock()
+	if ok {
+		return advisoryLockHolderID(connID), nil
+	}
+
+	// The lock may be held by a session of another TiDB instance, so look for
+	// the pessimistic lock on the key of the lock name in the store.
+	key, err := s.advisoryLockKey(lockName)
+	if err != nil {
+		return 0, err
+	}
+	locked, err := s.isKeyLocked(context.Background(), key)
+	if err != nil {
+		return 0, err
+	}
+	if locked {
+		return unknownAdvisoryLockHolder, nil
+	}
+	return 0, nil
+}
+
+func advisoryLockHolderID(connID uint64) uint64 {
+	if connID == 0 {
+		return unknownAdvisoryLockHolder
+	}
+	return connID
+}
+
+// advisoryLockKey returns the key locked by the holder of the advisory lock of
+// the name, which is the key of the lock name in the primary key of
+// mysql.advisory_locks.
+func (s *session) advisoryLockKey(lockName string) (kv.Key, error) {
+	tbl, err := domain.GetDomain(s).InfoSchema().TableByName(model.NewCIStr(mysql.SystemDB), model.NewCIStr("advisory_locks"))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	tblInfo := tbl.Meta()
+	pkIdx := tables.FindPrimaryIndex(tblInfo)
+	col := tblInfo.Columns[pkIdx.Columns[0].Offset]
+	values := []types.Datum{types.NewCollationStringDatum(lockName, col.Collate, int(col.Flen))}
+	tablecodec.TruncateIndexValues(tblInfo, pkIdx, values)
+	sc := &stmtctx.StatementContext{TimeZone: time.UTC}
+	if tblInfo.IsCommonHandle {
+		handleBytes, err := codec.EncodeKey(sc, nil, values...)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		handle, err := kv.NewCommonHandle(handleBytes)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return tablecodec.EncodeRecordKey(tbl.RecordPrefix(), handle), nil
+	}
+	key, _, err := tablecodec.GenIndexKey(sc, tblInfo, pkIdx, tblInfo.ID, values, nil, nil)
+	return key, errors.Trace(err)
+}
+
+// advisoryLockScanMaxBackoff is the max backoff time in milliseconds to scan the
+// lock of an advisory lock.
+const advisoryLockScanMaxBackoff = 20000
+
+// isKeyLocked checks whether there is an alive lock on the key by scanning the
+// locks in the store, which doesn't block or acquire the lock. The lock whose
+// TTL has expired is left by a crashed holder, so it's ignored.
+func (s *session) isKeyLocked(ctx context.Context, key kv.Key) (bool, error) {
+	store, ok := s.store.(tikv.Storage)
+	if !ok {
+		return false, errors.New("advisory locks are only supported by TiKV storage")
+	}
+	req := tikvrpc.NewRequest(tikvrpc.CmdScanLock, &kvrpcpb.ScanLockRequest{
+		MaxVersion: math.MaxUint64,
+		StartKey:   key,
+		EndKey:     key.Next(),
+		Limit:      1,
+	})
+	bo := tikv.NewBackofferWithVars(ctx, advisoryLockScanMaxBackoff, nil)
+	for {
+		loc, err := store.GetRegionCache().LocateKey(bo, key)
+		if err != nil {
+			return false, errors.Trace(err)
+		}
+		resp, err := store.SendReq(bo, req, loc.Region, tikv.ReadTimeoutShort)
+		if err != nil {
+			return false, errors.Trace(err)
+		}
+		regionErr, err := resp.GetRegionError()
+		if err != nil {
+			return false, errors.Trace(err)
+		}
+		if regionErr != nil {
+			if err = bo.Backoff(tikv.BoRegionMiss(), errors.New(regionErr.String())); err != nil {
+				return false, errors.Trace(err)
+			}
+			continue
+		}
+		if resp.Resp == nil {
+			return false, errors.Trace(tikverr.ErrBodyMissing)
+		}
+		locksResp := resp.Resp.(*kvrpcpb.ScanLockResponse)
+		if locksResp.GetError() != nil {
+			return false, errors.Errorf("unexpected scanlock error: %s", locksResp)
+		}
+		now := oracle.GetPhysical(time.Now())
+		for _, lock := range locksResp.GetLocks() {
+			if bytes.Equal(lock.Key, key) && oracle.ExtractPhysical(lock.LockVersion)+int64(lock.LockTtl) > now {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
@@ -89,6 +89,12 @@ const (
 		Create_Tablespace_Priv  ENUM('N','Y') NOT NULL DEFAULT 'N',
 		Repl_slave_priv	    	ENUM('N','Y') NOT NULL DEFAULT 'N',
 		Repl_client_priv		ENUM('N','Y') NOT NULL DEFAULT 'N',
+		Password_expired		ENUM('N','Y') NOT NULL DEFAULT 'N',
+		Password_last_changed	TIMESTAMP DEFAULT CURRENT_TIMESTAMP(),
+		Password_lifetime		SMALLINT UNSIGNED DEFAULT NULL,
+		Password_reuse_history	SMALLINT UNSIGNED DEFAULT NULL,
+		Password_reuse_time		SMALLINT UNSIGNED DEFAULT NULL,
+		User_attributes			JSON,
 		PRIMARY KEY (Host, User));`
 	// CreateGlobalPrivTable is the SQL statement creates Global scope privilege table in system db.
 	CreateGlobalPrivTable = "CREATE TABLE IF NOT EXISTS mysql.global_priv (" +
@@ -339,6 +345,19 @@ const (
 		WITH_GRANT_OPTION enum('N','Y') NOT NULL DEFAULT 'N',
 		PRIMARY KEY (USER,HOST,PRIV)
 	  );`
+	// CreateAdvisoryLocks stores the names of the advisory locks acquired by GET_LOCK().
+	// The rows are never committed, only their pessimistic locks are held.
+	CreateAdvisoryLocks = `CREATE TABLE IF NOT EXISTS mysql.advisory_locks (
+		lock_name VARCHAR(64) NOT NULL PRIMARY KEY
+	);`
+	// CreatePasswordHistory is the SQL statement creates the table storing the previously used passwords.
+	CreatePasswordHistory = `CREATE TABLE IF NOT EXISTS mysql.password_history (
+		Host CHAR(255) NOT NULL DEFAULT '',
+		User CHAR(32) NOT NULL DEFAULT '',
+		Password_timestamp TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+		Password TEXT,
+		PRIMARY KEY (Host, User, Password_timestamp)
+	);`
 )
 
 // bootstrap initiates system DB for a store.
@@ -498,11 +517,15 @@ const (
 	version71 = 71
 	// version72 adds snapshot column for mysql.stats_meta
 	version72 = 72
+	// version73 adds mysql.advisory_locks for GET_LOCK()
+	version73 = 73
+	// version74 adds the password expiration and reuse columns to mysql.user, and mysql.password_history
+	version74 = 74
 )
 
 // currentBootstrapVersion is defined as a variable, so we can modify its value for testing.
 // please make sure this is the largest version
-var currentBootstrapVersion int64 = version72
+var currentBootstrapVersion int64 = version74
 
 var (
 	bootstrapVersion = []func(Session, int64){
@@ -578,6 +601,8 @@ var (
 		upgradeToVer70,
 		upgradeToVer71,
 		upgradeToVer72,
+		upgradeToVer73,
+		upgradeToVer74,
 	}
 )
 
@@ -1527,6 +1552,26 @@ func upgradeToVer72(s Session, ver int64) {
 	doReentrantDDL(s, "ALTER TABLE mysql.stats_meta ADD COLUMN snapshot BIGINT(64) UNSIGNED NOT NULL DEFAULT 0", infoschema.ErrColumnExists)
 }
 
+func upgradeToVer73(s Session, ver int64) {
+	if ver >= version73 {
+		return
+	}
+	doReentrantDDL(s, CreateAdvisoryLocks)
+}
+
+func upgradeToVer74(s Session, ver int64) {
+	if ver >= version74 {
+		return
+	}
+	doReentrantDDL(s, "ALTER TABLE mysql.user ADD COLUMN `Password_expired` ENUM('N','Y') NOT NULL DEFAULT 'N'", infoschema.ErrColumnExists)
+	doReentrantDDL(s, "ALTER TABLE mysql.user ADD COLUMN `Password_last_changed` TIMESTAMP DEFAULT CURRENT_TIMESTAMP()", infoschema.ErrColumnExists)
+	doReentrantDDL(s, "ALTER TABLE mysql.user ADD COLUMN `Password_lifetime` SMALLINT UNSIGNED DEFAULT NULL", infoschema.ErrColumnExists)
+	doReentrantDDL(s, "ALTER TABLE mysql.user ADD COLUMN `Password_reuse_history` SMALLINT UNSIGNED DEFAULT NULL", infoschema.ErrColumnExists)
+	doReentrantDDL(s, "ALTER TABLE mysql.user ADD COLUMN `Password_reuse_time` SMALLINT UNSIGNED DEFAULT NULL", infoschema.ErrColumnExists)
+	doReentrantDDL(s, "ALTER TABLE mysql.user ADD COLUMN `User_attributes` JSON", infoschema.ErrColumnExists)
+	doReentrantDDL(s, CreatePasswordHistory)
+}
+
 func writeOOMAction(s Session) {
 	comment := "oom-action is `log` by default in v3.0.x, `cancel` by default in v4.0.11+"
 	mustExecute(s, `INSERT HIGH_PRIORITY INTO %n.%n VALUES (%?, %?, %?) ON DUPLICATE KEY UPDATE VARIABLE_VALUE= %?`,
@@ -1605,6 +1650,10 @@ func doDDLWorks(s Session) {
 	mustExecute(s, CreateStatsFMSketchTable)
 	// Create global_grants
 	mustExecute(s, CreateGlobalGrantsTable)
+	// Create advisory_locks
+	mustExecute(s, CreateAdvisoryLocks)
+	// Create password_history
+	mustExecute(s, CreatePasswordHistory)
 }
 
 // doDMLWorks executes DML statements in bootstrap stage.
@@ -1615,7 +1664,7 @@ func doDMLWorks(s Session) {
 
 	// Insert a default user with empty password.
 	mustExecute(s, `INSERT HIGH_PRIORITY INTO mysql.user VALUES
-		("%", "root", "", "mysql_native_password", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "N", "Y", "Y", "Y", "Y", "Y", "Y", "Y")`)
+		("%", "root", "", "mysql_native_password", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "N", "Y", "Y", "Y", "Y", "Y", "Y", "Y", "N", CURRENT_TIMESTAMP(), NULL, NULL, NULL, NULL)`)
 
 	// Init global system variables table.
 	values := make([]string, 0, len(variable.GetSysVars()))
@@ -30,6 +30,7 @@ import (
 	"github.com/pingcap/parser/mysql"
 	"github.com/pingcap/parser/terror"
 	"github.com/pingcap/tidb/config"
+	"github.com/pingcap/tidb/errno"
 	"github.com/pingcap/tidb/kv"
 	plannercore "github.com/pingcap/tidb/planner/core"
 	"github.com/pingcap/tidb/session"
@@ -2841,3 +2842,80 @@ func (s *testPessimisticSuite) TestAmendForColumnChange(c *C) {
 
 	tk2.MustExec("drop database test_db")
 }
+
+func (s *testPessimisticSuite) TestAdvisoryLock(c *C) {
+	tk := testkit.NewTestKitWithInit(c, s.store)
+	tk.Se.SetConnectionID(1001)
+	tk2 := testkit.NewTestKitWithInit(c, s.store)
+	tk2.Se.SetConnectionID(1002)
+
+	tk.MustQuery("select get_lock('test_lock', 1)").Check(testkit.Rows("1"))
+	// The lock name is case-insensitive, and the lock is reentrant.
+	tk.MustQuery("select get_lock('TEST_LOCK', 1)").Check(testkit.Rows("1"))
+	tk.MustQuery("select is_free_lock('test_lock'), is_used_lock('test_lock')").Check(testkit.Rows("0 1001"))
+	tk2.MustQuery("select is_free_lock('test_lock'), is_used_lock('test_lock')").Check(testkit.Rows("0 1001"))
+
+	// The lock outlives the TTL of the pessimistic lock.
+	time.Sleep(500 * time.Millisecond)
+	start := time.Now()
+	tk2.MustQuery("select get_lock('test_lock', 1)").Check(testkit.Rows("0"))
+	c.Assert(time.Since(start), GreaterEqual, time.Second)
+	// A zero timeout fails at once without waiting.
+	start = time.Now()
+	tk2.MustQuery("select get_lock('test_lock', 0)").Check(testkit.Rows("0"))
+	c.Assert(time.Since(start), Less, 500*time.Millisecond)
+	tk2.MustQuery("select release_lock('test_lock')").Check(testkit.Rows("0"))
+	tk2.MustQuery("select release_lock('no_such_lock')").Check(testkit.Rows("<nil>"))
+
+	// The lock is freed after it is released as many times as it is acquired.
+	tk.MustQuery("select release_lock('test_lock')").Check(testkit.Rows("1"))
+	tk2.MustQuery("select is_used_lock('test_lock')").Check(testkit.Rows("1001"))
+	tk.MustQuery("select release_lock('test_lock')").Check(testkit.Rows("1"))
+	tk.MustQuery("select release_lock('test_lock')").Check(testkit.Rows("<nil>"))
+	tk.MustQuery("select is_free_lock('test_lock'), is_used_lock('test_lock')").Check(testkit.Rows("1 <nil>"))
+
+	// The waiting session acquires the lock once it is released.
+	tk.MustQuery("select get_lock('test_lock', 1)").Check(testkit.Rows("1"))
+	ch := make(chan struct{})
+	go func() {
+		tk2.MustQuery("select get_lock('test_lock', 10)").Check(testkit.Rows("1"))
+		close(ch)
+	}()
+	time.Sleep(200 * time.Millisecond)
+	tk.MustQuery("select release_lock('test_lock')").Check(testkit.Rows("1"))
+	<-ch
+	tk.MustQuery("select is_used_lock('test_lock')").Check(testkit.Rows("1002"))
+
+	// release_all_locks() counts the reentrant locks.
+	tk2.MustQuery("select get_lock('test_lock', 1), get_lock('test_lock2', 1)").Check(testkit.Rows("1 1"))
+	tk2.MustQuery("select release_all_locks()").Check(testkit.Rows("3"))
+	tk2.MustQuery("select release_all_locks()").Check(testkit.Rows("0"))
+
+	// The locks are released when the session is closed.
+	tk2.MustQuery("select get_lock('test_lock', 1)").Check(testkit.Rows("1"))
+	tk2.Se.Close()
+	tk.MustQuery("select get_lock('test_lock', 0)").Check(testkit.Rows("1"))
+	tk.MustQuery("select release_all_locks()").Check(testkit.Rows("1"))
+
+	// The lock held by the session of another instance is found in the store
+	// without acquiring it.
+	tk3 := testkit.NewTestKitWithInit(c, s.store)
+	tk3.MustExec("begin pessimistic")
+	tk3.MustExec("insert into mysql.advisory_locks values ('remote_lock')")
+	start = time.Now()
+	tk.MustQuery("select is_free_lock('remote_lock'), is_used_lock('remote_lock')").Check(testkit.Rows("0 1"))
+	c.Assert(time.Since(start), Less, 500*time.Millisecond)
+	tk.MustQuery("select get_lock('remote_lock', 0)").Check(testkit.Rows("0"))
+	tk3.MustExec("rollback")
+	tk.MustQuery("select is_free_lock('remote_lock'), is_used_lock('remote_lock')").Check(testkit.Rows("1 <nil>"))
+	tk.MustQuery("select get_lock('remote_lock', 0)").Check(testkit.Rows("1"))
+	tk.MustQuery("select release_lock('remote_lock')").Check(testkit.Rows("1"))
+
+	// Invalid lock names.
+	for _, name := range []string{"''", "NULL", fmt.Sprintf("'%s'", strings.Repeat("a", 65))} {
+		err := tk.QueryToErr(fmt.Sprintf("select get_lock(%s, 1)", name))
+		c.Assert(err, NotNil)
+		sqlErr := terror.ToSQLError(errors.Cause(err).(*terror.Error))
+		c.Assert(int(sqlErr.Code), Equals, errno.ErrUserLockWrongName)
+	}
+}
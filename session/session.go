@@ -151,6 +151,8 @@ type Session interface {
 	SetSessionManager(util.SessionManager)
 	Close()
 	Auth(user *auth.UserIdentity, auth []byte, salt []byte) bool
+	// AuthWithError is the same as Auth, but returns the error why the login is refused.
+	AuthWithError(user *auth.UserIdentity, auth []byte, salt []byte) error
 	AuthWithoutVerification(user *auth.UserIdentity) bool
 	AuthPluginForUser(user *auth.UserIdentity) (string, error)
 	ShowProcess() *util.ProcessInfo
@@ -212,6 +214,9 @@ type session struct {
 	store kv.Storage
 
 	preparedPlanCache *kvcache.SimpleLRUCache
+	// nonPreparedStmts maps the parameterized text queries to the statements
+	// prepared for them, see execWithNonPreparedPlanCache.
+	nonPreparedStmts *kvcache.SimpleLRUCache
 
 	sessionVars    *variable.SessionVars
 	sessionManager util.SessionManager
@@ -221,6 +226,8 @@ type session struct {
 	ddlOwnerChecker owner.DDLOwnerChecker
 	// lockedTables use to record the table locks hold by the session.
 	lockedTables map[int64]model.TableLockTpInfo
+	// advisoryLocks records the advisory locks acquired by GET_LOCK() in the session.
+	advisoryLocks map[string]*advisoryLock
 
 	// client shared coprocessor client per session
 	client kv.Client
@@ -1621,6 +1628,10 @@ func (s *session) ExecuteStmt(ctx context.Context, stmtNode ast.StmtNode) (sqlex
 		ctx = opentracing.ContextWithSpan(ctx, span1)
 	}
 
+	if err := s.checkSandBoxMode(stmtNode); err != nil {
+		return nil, err
+	}
+
 	s.PrepareTxnCtx(ctx)
 	err := s.loadCommonGlobalVariablesIfNeeded()
 	if err != nil {
@@ -1645,6 +1656,14 @@ func (s *session) ExecuteStmt(ctx context.Context, stmtNode ast.StmtNode) (sqlex
 	// Uncorrelated subqueries will execute once when building plan, so we reset process info before building plan.
 	cmd32 := atomic.LoadUint32(&s.GetSessionVars().CommandValue)
 	s.SetProcessInfo(stmtNode.Text(), time.Now(), byte(cmd32), 0)
+
+	// The cached plans skip the optimizer, so the plan cache is not used when the optimizer is traced.
+	if s.sessionVars.EnableNonPreparedPlanCache && !s.sessionVars.EnableOptimizerTrace && s.preparedPlanCache != nil && !s.isInternal() {
+		if rs, ok, err := s.execWithNonPreparedPlanCache(ctx, stmtNode); ok {
+			return rs, err
+		}
+	}
+
 	s.txn.onStmtStart(digest.String())
 	defer s.txn.onStmtEnd()
 
@@ -1871,14 +1890,40 @@ func (s *session) rollbackOnError(ctx context.Context) {
 }
 
 // PrepareStmt is used for executing prepare statement in binary protocol
+// checkSandBoxMode only allows the statements that reset the password or set variables
+// when the session is logged in with an expired password.
+func (s *session) checkSandBoxMode(stmtNode ast.StmtNode) error {
+	if !s.sessionVars.InSandBoxMode || s.isInternal() {
+		return nil
+	}
+	switch stmtNode.(type) {
+	case *ast.SetPwdStmt, *ast.AlterUserStmt, *ast.SetStmt:
+		return nil
+	}
+	return ErrMustChangePassword.GenWithStackByArgs()
+}
+
 func (s *session) PrepareStmt(sql string) (stmtID uint32, paramCount int, fields []*ast.ResultField, err error) {
+	prepareExec, err := s.prepareStmt(sql, false)
+	if err != nil {
+		return
+	}
+	return prepareExec.ID, prepareExec.ParamCount, prepareExec.Fields, nil
+}
+
+// prepareStmt prepares the statement. The prepared statement is only returned
+// in the executor instead of being added to the session if unregistered is set.
+func (s *session) prepareStmt(sql string, unregistered bool) (*executor.PrepareExec, error) {
+	if s.sessionVars.InSandBoxMode {
+		return nil, ErrMustChangePassword.GenWithStackByArgs()
+	}
 	if s.sessionVars.TxnCtx.InfoSchema == nil {
 		// We don't need to create a transaction for prepare statement, just get information schema will do.
 		s.sessionVars.TxnCtx.InfoSchema = domain.GetDomain(s).InfoSchema()
 	}
-	err = s.loadCommonGlobalVariablesIfNeeded()
+	err := s.loadCommonGlobalVariablesIfNeeded()
 	if err != nil {
-		return
+		return nil, err
 	}
 
 	ctx := context.Background()
@@ -1888,24 +1933,27 @@ func (s *session) PrepareStmt(sql string) (stmtID uint32, paramCount int, fields
 	s.PrepareTxnCtx(ctx)
 	s.PrepareTSFuture(ctx)
 	prepareExec := executor.NewPrepareExec(s, sql)
+	prepareExec.Unregistered = unregistered
 	err = prepareExec.Next(ctx, nil)
 	if err != nil {
-		return
+		return nil, err
 	}
 	if !inTxn {
 		// We could start a transaction to build the prepare executor before, we should rollback it here.
 		s.RollbackTxn(ctx)
 	}
-	return prepareExec.ID, prepareExec.ParamCount, prepareExec.Fields, nil
+	return prepareExec, nil
 }
 
 func (s *session) preparedStmtExec(ctx context.Context,
-	is infoschema.InfoSchema, snapshotTS uint64,
+	is infoschema.InfoSchema, snapshotTS uint64, sqlText string,
 	stmtID uint32, prepareStmt *plannercore.CachedPrepareStmt, args []types.Datum) (sqlexec.RecordSet, error) {
-	st, tiFlashPushDown, tiFlashExchangePushDown, err := executor.CompileExecutePreparedStmt(ctx, s, stmtID, is, snapshotTS, args)
+	st, tiFlashPushDown, tiFlashExchangePushDown, err := executor.CompileExecutePreparedStmt(ctx, s, stmtID, prepareStmt, is, snapshotTS, args)
 	if err != nil {
 		return nil, err
 	}
+	st.Text = sqlText
+	s.sessionVars.StmtCtx.OriginalSQL = sqlText
 	if !s.isInternal() && config.GetGlobalConfig().EnableTelemetry {
 		telemetry.CurrentExecuteCount.Inc()
 		if tiFlashPushDown {
@@ -1922,11 +1970,11 @@ func (s *session) preparedStmtExec(ctx context.Context,
 
 // cachedPlanExec short path currently ONLY for cached "point select plan" execution
 func (s *session) cachedPlanExec(ctx context.Context,
-	is infoschema.InfoSchema, snapshotTS uint64,
+	is infoschema.InfoSchema, snapshotTS uint64, sqlText string,
 	stmtID uint32, prepareStmt *plannercore.CachedPrepareStmt, args []types.Datum) (sqlexec.RecordSet, error) {
 	prepared := prepareStmt.PreparedAst
 	// compile ExecStmt
-	execAst := &ast.ExecuteStmt{ExecID: stmtID}
+	execAst := &ast.ExecuteStmt{ExecID: stmtID, PrepStmt: prepareStmt}
 	if err := executor.ResetContextOfStmt(s, execAst); err != nil {
 		return nil, err
 	}
@@ -1952,7 +2000,7 @@ func (s *session) cachedPlanExec(ctx context.Context,
 	sessionExecuteCompileDurationGeneral.Observe(compileDuration.Seconds())
 	s.GetSessionVars().DurationCompile = compileDuration
 
-	stmt.Text = prepared.Stmt.Text()
+	stmt.Text = sqlText
 	stmtCtx.OriginalSQL = stmt.Text
 	stmtCtx.InitSQLDigest(prepareStmt.NormalizedSQL, prepareStmt.SQLDigest)
 	stmtCtx.SetPlanDigest(prepareStmt.NormalizedPlan, prepareStmt.PlanDigest)
@@ -2052,8 +2100,14 @@ func (s *session) ExecutePreparedStmt(ctx context.Context, stmtID uint32, args [
 	if !ok {
 		return nil, errors.Errorf("invalid CachedPrepareStmt type")
 	}
+	return s.executePreparedStmt(ctx, preparedStmt.PreparedAst.Stmt.Text(), stmtID, preparedStmt, args)
+}
+
+// executePreparedStmt executes the prepared statement, sqlText is the text of
+// the statement shown in the process list, the slow log and the statements summary.
+func (s *session) executePreparedStmt(ctx context.Context, sqlText string, stmtID uint32, preparedStmt *plannercore.CachedPrepareStmt, args []types.Datum) (sqlexec.RecordSet, error) {
 	executor.CountStmtNode(preparedStmt.PreparedAst.Stmt, s.sessionVars.InRestrictedSQL)
-	ok, err = s.IsCachedExecOk(ctx, preparedStmt)
+	ok, err := s.IsCachedExecOk(ctx, preparedStmt)
 	if err != nil {
 		return nil, err
 	}
@@ -2076,9 +2130,58 @@ func (s *session) ExecutePreparedStmt(ctx context.Context, stmtID uint32, args [
 		is = s.GetInfoSchema().(infoschema.InfoSchema)
 	}
 	if ok {
-		return s.cachedPlanExec(ctx, is, snapshotTS, stmtID, preparedStmt, args)
+		return s.cachedPlanExec(ctx, is, snapshotTS, sqlText, stmtID, preparedStmt, args)
 	}
-	return s.preparedStmtExec(ctx, is, snapshotTS, stmtID, preparedStmt, args)
+	return s.preparedStmtExec(ctx, is, snapshotTS, sqlText, stmtID, preparedStmt, args)
+}
+
+// nonPreparedStmt is the statement prepared for a parameterized text query,
+// which isn't added to the session, so it's invisible to the users.
+type nonPreparedStmt struct {
+	id   uint32
+	stmt *plannercore.CachedPrepareStmt
+}
+
+// execWithNonPreparedPlanCache parameterizes the text query and executes it as a
+// prepared statement, so its plan is cached like the prepared ones. It returns
+// false if the query isn't supported, then it should be executed as usual.
+func (s *session) execWithNonPreparedPlanCache(ctx context.Context, stmtNode ast.StmtNode) (sqlexec.RecordSet, bool, error) {
+	if !plannercore.NonPreparedPlanCacheable(stmtNode, s.GetInfoSchema().(infoschema.InfoSchema)) {
+		return nil, false, nil
+	}
+	paramSQL, params, err := plannercore.ParameterizeAST(stmtNode)
+	if err != nil {
+		return nil, false, nil
+	}
+	if s.nonPreparedStmts == nil {
+		s.nonPreparedStmts = kvcache.NewSimpleLRUCache(plannercore.PreparedPlanCacheCapacity, 0, 0)
+		s.nonPreparedStmts.SetOnEvict(func(_ kvcache.Key, v kvcache.Value) {
+			// Its cached plans can't be hit anymore.
+			st := v.(*nonPreparedStmt)
+			s.PreparedPlanCache().Delete(plannercore.NewPSTMTPlanCacheKey(s.sessionVars, st.id, st.stmt.PreparedAst.SchemaVersion))
+		})
+	}
+	key := plannercore.NewNonPreparedPlanCacheKey(s.sessionVars.CurrentDB, paramSQL, params)
+	var st *nonPreparedStmt
+	if v, ok := s.nonPreparedStmts.Get(key); ok {
+		st = v.(*nonPreparedStmt)
+	} else {
+		prepareExec, err := s.prepareStmt(paramSQL, true)
+		if err != nil {
+			logutil.Logger(ctx).Debug("prepare parameterized SQL failed", zap.Error(err), zap.String("SQL", paramSQL))
+			// The transaction and the statement context are reset by the preparation.
+			s.PrepareTxnCtx(ctx)
+			if err := executor.ResetContextOfStmt(s, stmtNode); err != nil {
+				return nil, true, err
+			}
+			return nil, false, nil
+		}
+		st = &nonPreparedStmt{id: prepareExec.ID, stmt: prepareExec.Stmt}
+		s.nonPreparedStmts.Put(key, st)
+	}
+	s.PrepareTxnCtx(ctx)
+	rs, err := s.executePreparedStmt(ctx, stmtNode.Text(), st.id, st.stmt, params)
+	return rs, true, err
 }
 
 func (s *session) DropPreparedStmt(stmtID uint32) error {
@@ -2270,6 +2373,7 @@ func (s *session) Close() {
 			logutil.BgLogger().Error("release table lock failed", zap.Uint64("conn", s.sessionVars.ConnectionID))
 		}
 	}
+	s.ReleaseAllAdvisoryLocks()
 	if s.statsCollector != nil {
 		s.statsCollector.Delete()
 	}
@@ -2304,34 +2408,75 @@ func (s *session) AuthPluginForUser(user *auth.UserIdentity) (string, error) {
 }
 
 func (s *session) Auth(user *auth.UserIdentity, authentication []byte, salt []byte) bool {
+	return s.AuthWithError(user, authentication, salt) == nil
+}
+
+func (s *session) AuthWithError(user *auth.UserIdentity, authentication []byte, salt []byte) error {
 	pm := privilege.GetPrivilegeManager(s)
 
 	// Check IP or localhost.
-	var success bool
-	user.AuthUsername, user.AuthHostname, success = pm.ConnectionVerification(user.Username, user.Hostname, authentication, salt, s.sessionVars.TLSConnectionState)
-	if success {
+	info, err := pm.ConnectionVerification(user.Username, user.Hostname, authentication, salt, s.sessionVars)
+	err = s.trackFailedLogins(info, user.Username, user.Hostname, err)
+	user.AuthUsername, user.AuthHostname = info.AuthUsername, info.AuthHostname
+	if err == nil {
 		s.sessionVars.User = user
 		s.sessionVars.ActiveRoles = pm.GetDefaultRoles(user.AuthUsername, user.AuthHostname)
-		return true
-	} else if user.Hostname == variable.DefHostname {
-		return false
+		s.sessionVars.InSandBoxMode = info.InSandBoxMode
+		return nil
+	} else if user.Hostname == variable.DefHostname || !privileges.ErrAccessDenied.Equal(err) {
+		return err
 	}
 
 	// Check Hostname.
 	for _, addr := range s.getHostByIP(user.Hostname) {
-		u, h, success := pm.ConnectionVerification(user.Username, addr, authentication, salt, s.sessionVars.TLSConnectionState)
-		if success {
+		info, err1 := pm.ConnectionVerification(user.Username, addr, authentication, salt, s.sessionVars)
+		err1 = s.trackFailedLogins(info, user.Username, addr, err1)
+		if err1 == nil {
 			s.sessionVars.User = &auth.UserIdentity{
 				Username:     user.Username,
 				Hostname:     addr,
-				AuthUsername: u,
-				AuthHostname: h,
+				AuthUsername: info.AuthUsername,
+				AuthHostname: info.AuthHostname,
 			}
-			s.sessionVars.ActiveRoles = pm.GetDefaultRoles(u, h)
-			return true
+			s.sessionVars.ActiveRoles = pm.GetDefaultRoles(info.AuthUsername, info.AuthHostname)
+			s.sessionVars.InSandBoxMode = info.InSandBoxMode
+			return nil
+		} else if !privileges.ErrAccessDenied.Equal(err1) {
+			return err1
 		}
 	}
-	return false
+	return err
+}
+
+// trackFailedLogins records the result of the password check for the accounts which are blocked after too many
+// consecutive failed logins, and returns the error of the login.
+func (s *session) trackFailedLogins(info privilege.VerificationInfo, user, host string, loginErr error) error {
+	if !info.TrackFailedLogins {
+		return loginErr
+	}
+	tmp, err := s.sysSessionPool().Get()
+	if err != nil {
+		logutil.BgLogger().Warn("track failed logins failed", zap.Error(err))
+		return loginErr
+	}
+	defer s.sysSessionPool().Put(tmp)
+	se := tmp.(*session)
+
+	var changed bool
+	if loginErr == nil {
+		changed, err = privileges.ResetFailedLogins(se, info.AuthUsername, info.AuthHostname)
+	} else if changed, err = privileges.RecordFailedLogin(se, user, host, info.AuthUsername, info.AuthHostname); changed {
+		// The account is blocked by this login.
+		loginErr, err = err, nil
+	}
+	if err != nil {
+		logutil.BgLogger().Warn("track failed logins failed", zap.String("user", info.AuthUsername),
+			zap.String("host", info.AuthHostname), zap.Error(err))
+	}
+	if changed {
+		domain.GetDomain(s).NotifyUpdatePrivilege(se)
+	}
+	return loginErr
 }
 
 // AuthWithoutVerification is required by the ResetConnection RPC
@@ -2709,6 +2854,7 @@ func createSessionWithOpt(store kv.Storage, opt *Opt) (*session, error) {
 	}
 	s.mu.values = make(map[fmt.Stringer]interface{})
 	s.lockedTables = make(map[int64]model.TableLockTpInfo)
+	s.advisoryLocks = make(map[string]*advisoryLock)
 	domain.BindDomain(s, dom)
 	// session implements variable.GlobalVarAccessor. Bind it to ctx.
 	s.sessionVars.GlobalVarsAccessor = s
@@ -2738,6 +2884,7 @@ func CreateSessionWithDomain(store kv.Storage, dom *domain.Domain) (*session, er
 	}
 	s.mu.values = make(map[fmt.Stringer]interface{})
 	s.lockedTables = make(map[int64]model.TableLockTpInfo)
+	s.advisoryLocks = make(map[string]*advisoryLock)
 	domain.BindDomain(s, dom)
 	// session implements variable.GlobalVarAccessor. Bind it to ctx.
 	s.sessionVars.GlobalVarsAccessor = s
@@ -353,4 +353,5 @@ func ResultSetToStringSlice(ctx context.Context, s Session, rs sqlexec.RecordSet
 // Session errors.
 var (
 	ErrForUpdateCantRetry = dbterror.ClassSession.NewStd(errno.ErrForUpdateCantRetry)
+	ErrMustChangePassword = dbterror.ClassSession.NewStd(errno.ErrMustChangePassword)
 )
@@ -122,6 +122,15 @@ type Context interface {
 	ReleaseAllTableLocks()
 	// HasLockedTables uses to check whether this session locked any tables.
 	HasLockedTables() bool
+	// GetAdvisoryLock acquires an advisory lock of lockName within timeout seconds,
+	// and returns false if the lock is not acquired in time.
+	GetAdvisoryLock(lockName string, timeout int64) (bool, error)
+	// ReleaseAdvisoryLock releases an advisory lock held by the session.
+	ReleaseAdvisoryLock(lockName string) bool
+	// ReleaseAllAdvisoryLocks releases all the advisory locks held by the session.
+	ReleaseAllAdvisoryLocks() int
+	// IsUsedAdvisoryLock returns the connection ID of the session holding the advisory lock, or 0 if it is free.
+	IsUsedAdvisoryLock(lockName string) (uint64, error)
 	// PrepareTSFuture uses to prepare timestamp by future.
 	PrepareTSFuture(ctx context.Context)
 	// StoreIndexUsage stores the index usage information.
@@ -28,6 +28,7 @@ import (
 	"github.com/pingcap/tidb/util/execdetails"
 	"github.com/pingcap/tidb/util/memory"
 	"github.com/pingcap/tidb/util/resourcegrouptag"
+	"github.com/pingcap/tidb/util/tracing"
 	"github.com/tikv/client-go/v2/util"
 	atomic2 "go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -185,6 +186,8 @@ type StatementContext struct {
 	OptimInfo map[int]string
 	// InVerboseExplain indicates the statement is "explain format='verbose' ...".
 	InVerboseExplain bool
+	// OptimizeTracer records the decisions of the optimizer, it's nil unless optimizer_trace is enabled.
+	OptimizeTracer *tracing.OptimizeTracer
 }
 
 // StmtHints are SessionVars related sql hints.
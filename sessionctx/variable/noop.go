@@ -58,8 +58,6 @@ var noopSysVars = []*SysVar{
 	{Scope: ScopeNone, Name: "skip_external_locking", Value: "1"},
 	{Scope: ScopeNone, Name: "innodb_sync_array_size", Value: "1"},
 	{Scope: ScopeSession, Name: "rand_seed2", Value: ""},
-	{Scope: ScopeGlobal, Name: ValidatePasswordCheckUserName, Value: Off, Type: TypeBool},
-	{Scope: ScopeGlobal, Name: ValidatePasswordNumberCount, Value: "1", Type: TypeUnsigned, MinValue: 0, MaxValue: math.MaxUint64, AutoConvertOutOfRange: true},
 	{Scope: ScopeSession, Name: "gtid_next", Value: ""},
 	{Scope: ScopeGlobal, Name: "ndb_show_foreign_key_mock_tables", Value: ""},
 	{Scope: ScopeNone, Name: "multi_range_count", Value: "256"},
@@ -103,7 +101,6 @@ var noopSysVars = []*SysVar{
 	{Scope: ScopeNone, Name: "protocol_version", Value: "10"},
 	{Scope: ScopeGlobal | ScopeSession, Name: "new", Value: Off},
 	{Scope: ScopeGlobal | ScopeSession, Name: "myisam_sort_buffer_size", Value: "8388608"},
-	{Scope: ScopeGlobal | ScopeSession, Name: "optimizer_trace_offset", Value: "-1"},
 	{Scope: ScopeGlobal, Name: InnodbBufferPoolDumpAtShutdown, Value: "0"},
 	{Scope: ScopeGlobal | ScopeSession, Name: SQLNotes, Value: "1"},
 	{Scope: ScopeGlobal, Name: InnodbCmpPerIndexEnabled, Value: Off, Type: TypeBool, AutoConvertNegativeBool: true},
@@ -117,7 +114,6 @@ var noopSysVars = []*SysVar{
 	{Scope: ScopeNone, Name: "innodb_log_group_home_dir", Value: "./"},
 	{Scope: ScopeNone, Name: "performance_schema_events_statements_history_size", Value: "10"},
 	{Scope: ScopeGlobal, Name: GeneralLog, Value: Off, Type: TypeBool},
-	{Scope: ScopeGlobal, Name: "validate_password_dictionary_file", Value: ""},
 	{Scope: ScopeGlobal, Name: BinlogOrderCommits, Value: On, Type: TypeBool},
 	{Scope: ScopeGlobal, Name: "key_cache_division_limit", Value: "100"},
 	{Scope: ScopeGlobal | ScopeSession, Name: "max_insert_delayed_threads", Value: "20"},
@@ -170,11 +166,9 @@ var noopSysVars = []*SysVar{
 	{Scope: ScopeGlobal | ScopeSession, Name: MaxUserConnections, Value: "0", Type: TypeUnsigned, MinValue: 0, MaxValue: 4294967295, AutoConvertOutOfRange: true},
 	{Scope: ScopeNone, Name: "performance_schema_max_thread_classes", Value: "50"},
 	{Scope: ScopeGlobal, Name: "innodb_api_trx_level", Value: "0"},
-	{Scope: ScopeNone, Name: "disconnect_on_expired_password", Value: "1"},
 	{Scope: ScopeNone, Name: "performance_schema_max_file_classes", Value: "50"},
 	{Scope: ScopeGlobal, Name: "expire_logs_days", Value: "0"},
 	{Scope: ScopeGlobal | ScopeSession, Name: BinlogRowQueryLogEvents, Value: Off, Type: TypeBool},
-	{Scope: ScopeGlobal, Name: "default_password_lifetime", Value: ""},
 	{Scope: ScopeNone, Name: "pid_file", Value: "/usr/local/mysql/data/localhost.pid"},
 	{Scope: ScopeNone, Name: "innodb_undo_tablespaces", Value: "0"},
 	{Scope: ScopeGlobal, Name: InnodbStatusOutputLocks, Value: Off, Type: TypeBool, AutoConvertNegativeBool: true},
@@ -281,7 +275,6 @@ var noopSysVars = []*SysVar{
 	{Scope: ScopeGlobal, Name: "innodb_io_capacity_max", Value: "2000"},
 	{Scope: ScopeGlobal, Name: "innodb_autoextend_increment", Value: "64"},
 	{Scope: ScopeGlobal | ScopeSession, Name: "binlog_format", Value: "STATEMENT"},
-	{Scope: ScopeGlobal | ScopeSession, Name: "optimizer_trace", Value: "enabled=off,one_line=off"},
 	{Scope: ScopeGlobal | ScopeSession, Name: "read_rnd_buffer_size", Value: "262144", IsHintUpdatable: true},
 	{Scope: ScopeGlobal | ScopeSession, Name: NetWriteTimeout, Value: "60"},
 	{Scope: ScopeGlobal, Name: InnodbBufferPoolLoadAbort, Value: Off, Type: TypeBool, AutoConvertNegativeBool: true},
@@ -386,7 +379,6 @@ var noopSysVars = []*SysVar{
 	{Scope: ScopeGlobal, Name: "gtid_executed_compression_period", Value: ""},
 	{Scope: ScopeGlobal, Name: "ndb_log_empty_epochs", Value: ""},
 	{Scope: ScopeNone, Name: "have_geometry", Value: "YES"},
-	{Scope: ScopeGlobal | ScopeSession, Name: "optimizer_trace_max_mem_size", Value: "16384"},
 	{Scope: ScopeGlobal | ScopeSession, Name: "net_retry_count", Value: "10"},
 	{Scope: ScopeSession, Name: "ndb_table_no_logging", Value: ""},
 	{Scope: ScopeGlobal | ScopeSession, Name: "optimizer_trace_features", Value: "greedy_search=on,range_optimizer=on,dynamic_range=on,repeated_subselect=on"},
@@ -439,16 +431,13 @@ var noopSysVars = []*SysVar{
 	{Scope: ScopeGlobal | ScopeSession, Name: "eq_range_index_dive_limit", Value: "200", IsHintUpdatable: true},
 	{Scope: ScopeNone, Name: "performance_schema_events_stages_history_size", Value: "10"},
 	{Scope: ScopeGlobal | ScopeSession, Name: "ndb_join_pushdown", Value: ""},
-	{Scope: ScopeGlobal, Name: "validate_password_special_char_count", Value: "1"},
 	{Scope: ScopeNone, Name: "performance_schema_max_thread_instances", Value: "402"},
 	{Scope: ScopeGlobal | ScopeSession, Name: "ndbinfo_show_hidden", Value: ""},
 	{Scope: ScopeGlobal | ScopeSession, Name: "net_read_timeout", Value: "30"},
 	{Scope: ScopeNone, Name: "innodb_page_size", Value: "16384"},
 	{Scope: ScopeNone, Name: "innodb_log_file_size", Value: "50331648"},
 	{Scope: ScopeGlobal, Name: "sync_relay_log_info", Value: "10000"},
-	{Scope: ScopeGlobal | ScopeSession, Name: "optimizer_trace_limit", Value: "1"},
 	{Scope: ScopeNone, Name: "innodb_ft_max_token_size", Value: "84"},
-	{Scope: ScopeGlobal, Name: ValidatePasswordLength, Value: "8", Type: TypeUnsigned, MinValue: 0, MaxValue: math.MaxUint64, AutoConvertOutOfRange: true},
 	{Scope: ScopeGlobal, Name: "ndb_log_binlog_index", Value: ""},
 	{Scope: ScopeGlobal, Name: "innodb_api_bk_commit_interval", Value: "5"},
 	{Scope: ScopeNone, Name: "innodb_undo_directory", Value: "."},
@@ -430,6 +430,10 @@ type SessionVars struct {
 	// TLSConnectionState is the TLS connection state (nil if not using TLS).
 	TLSConnectionState *tls.ConnectionState
 
+	// InSandBoxMode indicates that the user logged in with an expired password, so the
+	// session can only run the statements that reset the password.
+	InSandBoxMode bool
+
 	// ConnectionID is the connection id of the current session.
 	ConnectionID uint64
 
@@ -589,6 +593,9 @@ type SessionVars struct {
 
 	AutoIncrementOffset int
 
+	// ForeignKeyChecks indicates whether the foreign key constraints are enforced by DML statements.
+	ForeignKeyChecks bool
+
 	/* TiDB system variables */
 
 	// SkipASCIICheck check on input value.
@@ -870,6 +877,23 @@ type SessionVars struct {
 	// EnableStableResultMode if stabilize query results.
 	EnableStableResultMode bool
 
+	// EnableNonPreparedPlanCache indicates whether the text queries are parameterized to use the plan cache.
+	EnableNonPreparedPlanCache bool
+
+	// EnableOptimizerTrace indicates whether the decisions of the optimizer are traced, it's the `enabled` flag of optimizer_trace.
+	EnableOptimizerTrace bool
+	// OptimizerTraceOneLine indicates whether the traces are shown without indentation, it's the `one_line` flag of optimizer_trace.
+	OptimizerTraceOneLine bool
+	// OptimizerTraceOffset and OptimizerTraceLimit select the traces to keep, like optimizer_trace_offset and optimizer_trace_limit in MySQL.
+	OptimizerTraceOffset int64
+	OptimizerTraceLimit  int64
+	// OptimizerTraceMaxMemSize is the max size of a trace, the exceeding part of the trace is truncated.
+	OptimizerTraceMaxMemSize int64
+	// optimizerTraces are the optimizer traces kept for information_schema.OPTIMIZER_TRACE.
+	optimizerTraces []*OptimizerTraceInfo
+	// optimizerTraceCount is the number of statements traced since the tracing settings were changed.
+	optimizerTraceCount int64
+
 	// LocalTemporaryTables is *infoschema.LocalTemporaryTables, use interface to avoid circle dependency.
 	// It's nil if there is no local temporary table.
 	LocalTemporaryTables interface{}
@@ -1119,6 +1143,9 @@ func NewSessionVars() *SessionVars {
 		EnableGlobalTemporaryTable:  DefTiDBEnableGlobalTemporaryTable,
 		MPPStoreLastFailTime:        make(map[string]time.Time),
 		MPPStoreFailTTL:             DefTiDBMPPStoreFailTTL,
+		OptimizerTraceOffset:        -1,
+		OptimizerTraceLimit:         1,
+		OptimizerTraceMaxMemSize:    1048576,
 	}
 	vars.KVVars = tikvstore.NewVariables(&vars.Killed)
 	vars.Concurrency = Concurrency{
@@ -2310,3 +2337,45 @@ func (s *SessionVars) TemporaryTableTxnReader(txn kv.Transaction, tblInfo *model
 		snapshot:  s.TemporaryTableSnapshotReader(tblInfo),
 	}
 }
+
+// OptimizerTraceInfo is a row of information_schema.OPTIMIZER_TRACE.
+type OptimizerTraceInfo struct {
+	Query                        string
+	Trace                        string
+	MissingBytesBeyondMaxMemSize int64
+}
+
+// AppendOptimizerTrace keeps the trace of a statement if it's selected by optimizer_trace_offset and optimizer_trace_limit.
+// The trace is truncated to optimizer_trace_max_mem_size.
+func (s *SessionVars) AppendOptimizerTrace(query string, trace []byte) {
+	idx := s.optimizerTraceCount
+	s.optimizerTraceCount++
+	// A non-negative offset keeps the traces from the offset-th statement, the later statements are not kept.
+	if s.OptimizerTraceOffset >= 0 && (idx < s.OptimizerTraceOffset || idx-s.OptimizerTraceOffset >= s.OptimizerTraceLimit) {
+		return
+	}
+	t := &OptimizerTraceInfo{Query: query, Trace: string(trace)}
+	if int64(len(trace)) > s.OptimizerTraceMaxMemSize {
+		t.Trace = string(trace[:s.OptimizerTraceMaxMemSize])
+		t.MissingBytesBeyondMaxMemSize = int64(len(trace)) - s.OptimizerTraceMaxMemSize
+	}
+	s.optimizerTraces = append(s.optimizerTraces, t)
+	// A negative offset keeps the traces of the last -offset statements.
+	if s.OptimizerTraceOffset < 0 && int64(len(s.optimizerTraces)) > -s.OptimizerTraceOffset {
+		s.optimizerTraces = s.optimizerTraces[1:]
+	}
+}
+
+// OptimizerTraces returns the traces shown in information_schema.OPTIMIZER_TRACE.
+func (s *SessionVars) OptimizerTraces() []*OptimizerTraceInfo {
+	if int64(len(s.optimizerTraces)) > s.OptimizerTraceLimit {
+		return s.optimizerTraces[:s.OptimizerTraceLimit]
+	}
+	return s.optimizerTraces
+}
+
+// ResetOptimizerTraces discards the kept traces, it's called when the tracing settings are changed.
+func (s *SessionVars) ResetOptimizerTraces() {
+	s.optimizerTraces = nil
+	s.optimizerTraceCount = 0
+}
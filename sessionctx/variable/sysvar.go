@@ -659,16 +659,9 @@ var defaultSysVars = []*SysVar{
 		return nil
 	}},
 	{Scope: ScopeNone, Name: SystemTimeZone, Value: "CST"},
-	{Scope: ScopeGlobal | ScopeSession, Name: ForeignKeyChecks, Value: Off, Type: TypeBool, skipInit: true, Validation: func(vars *SessionVars, normalizedValue string, originalValue string, scope ScopeFlag) (string, error) {
-		if TiDBOptOn(normalizedValue) {
-			// TiDB does not yet support foreign keys.
-			// Return the original value in the warning, so that users are not confused.
-			vars.StmtCtx.AppendWarning(ErrUnsupportedValueForVar.GenWithStackByArgs(ForeignKeyChecks, originalValue))
-			return Off, nil
-		} else if !TiDBOptOn(normalizedValue) {
-			return Off, nil
-		}
-		return normalizedValue, ErrWrongValueForVar.GenWithStackByArgs(ForeignKeyChecks, originalValue)
+	{Scope: ScopeGlobal | ScopeSession, Name: ForeignKeyChecks, Value: Off, Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.ForeignKeyChecks = TiDBOptOn(val)
+		return nil
 	}},
 	{Scope: ScopeNone, Name: Hostname, Value: DefHostname},
 	{Scope: ScopeSession, Name: Timestamp, Value: "", skipInit: true},
@@ -1783,10 +1776,72 @@ var defaultSysVars = []*SysVar{
 	}},
 	{Scope: ScopeGlobal, Name: SkipNameResolve, Value: Off, Type: TypeBool},
 	{Scope: ScopeGlobal, Name: DefaultAuthPlugin, Value: mysql.AuthNativePassword, Type: TypeEnum, PossibleValues: []string{mysql.AuthNativePassword, mysql.AuthCachingSha2Password}},
+	{Scope: ScopeGlobal, Name: ProtocolCompressionAlgorithms, Value: "zlib,zstd,uncompressed", Validation: func(vars *SessionVars, normalizedValue string, originalValue string, scope ScopeFlag) (string, error) {
+		algorithms := strings.Split(strings.ToLower(normalizedValue), ",")
+		for i, algorithm := range algorithms {
+			algorithms[i] = strings.TrimSpace(algorithm)
+			switch algorithms[i] {
+			case "zlib", "zstd", "uncompressed":
+			default:
+				return normalizedValue, ErrWrongValueForVar.GenWithStackByArgs(ProtocolCompressionAlgorithms, originalValue)
+			}
+		}
+		return strings.Join(algorithms, ","), nil
+	}},
+	{Scope: ScopeGlobal, Name: DefaultPasswordLifetime, Value: "0", Type: TypeUnsigned, MinValue: 0, MaxValue: math.MaxUint16, AutoConvertOutOfRange: true},
+	{Scope: ScopeNone, Name: DisconnectOnExpiredPassword, Value: On, Type: TypeBool},
+	{Scope: ScopeGlobal, Name: PasswordHistory, Value: "0", Type: TypeUnsigned, MinValue: 0, MaxValue: math.MaxUint32, AutoConvertOutOfRange: true},
+	{Scope: ScopeGlobal, Name: PasswordReuseInterval, Value: "0", Type: TypeUnsigned, MinValue: 0, MaxValue: math.MaxUint32, AutoConvertOutOfRange: true},
+	{Scope: ScopeGlobal, Name: ValidatePasswordEnable, Value: Off, Type: TypeBool},
+	{Scope: ScopeGlobal, Name: ValidatePasswordPolicy, Value: "MEDIUM", Type: TypeEnum, PossibleValues: []string{"LOW", "MEDIUM", "STRONG"}},
+	{Scope: ScopeGlobal, Name: ValidatePasswordCheckUserName, Value: Off, Type: TypeBool},
+	{Scope: ScopeGlobal, Name: ValidatePasswordLength, Value: "8", Type: TypeUnsigned, MinValue: 0, MaxValue: math.MaxUint64, AutoConvertOutOfRange: true},
+	{Scope: ScopeGlobal, Name: ValidatePasswordMixedCaseCount, Value: "1", Type: TypeUnsigned, MinValue: 0, MaxValue: math.MaxUint64, AutoConvertOutOfRange: true},
+	{Scope: ScopeGlobal, Name: ValidatePasswordNumberCount, Value: "1", Type: TypeUnsigned, MinValue: 0, MaxValue: math.MaxUint64, AutoConvertOutOfRange: true},
+	{Scope: ScopeGlobal, Name: ValidatePasswordSpecialCharCount, Value: "1", Type: TypeUnsigned, MinValue: 0, MaxValue: math.MaxUint64, AutoConvertOutOfRange: true},
+	{Scope: ScopeGlobal, Name: ValidatePasswordDictionaryFile, Value: ""},
+	{Scope: ScopeGlobal | ScopeSession, Name: OptimizerTrace, Value: "enabled=off,one_line=off", Validation: func(vars *SessionVars, normalizedValue string, originalValue string, scope ScopeFlag) (string, error) {
+		// The flags not mentioned keep their current values in the session.
+		enabled, oneLine := false, false
+		if scope == ScopeSession {
+			enabled, oneLine = vars.EnableOptimizerTrace, vars.OptimizerTraceOneLine
+		}
+		enabled, oneLine, err := parseOptimizerTrace(normalizedValue, enabled, oneLine)
+		if err != nil {
+			return normalizedValue, ErrWrongValueForVar.GenWithStackByArgs(OptimizerTrace, originalValue)
+		}
+		return fmt.Sprintf("enabled=%s,one_line=%s", strings.ToLower(BoolToOnOff(enabled)), strings.ToLower(BoolToOnOff(oneLine))), nil
+	}, SetSession: func(s *SessionVars, val string) error {
+		enabled, oneLine, err := parseOptimizerTrace(val, false, false)
+		if err != nil {
+			return err
+		}
+		s.EnableOptimizerTrace, s.OptimizerTraceOneLine = enabled, oneLine
+		s.ResetOptimizerTraces()
+		return nil
+	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: OptimizerTraceOffset, Value: "-1", Type: TypeInt, MinValue: math.MinInt64, MaxValue: math.MaxInt64, SetSession: func(s *SessionVars, val string) error {
+		s.OptimizerTraceOffset = tidbOptInt64(val, -1)
+		s.ResetOptimizerTraces()
+		return nil
+	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: OptimizerTraceLimit, Value: "1", Type: TypeUnsigned, MinValue: 0, MaxValue: math.MaxInt64, AutoConvertOutOfRange: true, SetSession: func(s *SessionVars, val string) error {
+		s.OptimizerTraceLimit = tidbOptInt64(val, 1)
+		s.ResetOptimizerTraces()
+		return nil
+	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: OptimizerTraceMaxMemSize, Value: "1048576", Type: TypeUnsigned, MinValue: 0, MaxValue: math.MaxInt64, AutoConvertOutOfRange: true, SetSession: func(s *SessionVars, val string) error {
+		s.OptimizerTraceMaxMemSize = tidbOptInt64(val, 1048576)
+		return nil
+	}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBEnableOrderedResultMode, Value: BoolToOnOff(DefTiDBEnableOrderedResultMode), Hidden: true, Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
 		s.EnableStableResultMode = TiDBOptOn(val)
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBEnableNonPreparedPlanCache, Value: BoolToOnOff(DefTiDBEnableNonPreparedPlanCache), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.EnableNonPreparedPlanCache = TiDBOptOn(val)
+		return nil
+	}},
 }
 
 // FeedbackProbability points to the FeedbackProbability in statistics package.
@@ -2067,6 +2122,34 @@ const (
 	CTEMaxRecursionDepth = "cte_max_recursion_depth"
 	// DefaultAuthPlugin is the name of 'default_authentication_plugin' system variable.
 	DefaultAuthPlugin = "default_authentication_plugin"
+	// ProtocolCompressionAlgorithms is the name of 'protocol_compression_algorithms' system variable.
+	ProtocolCompressionAlgorithms = "protocol_compression_algorithms"
+	// DefaultPasswordLifetime is the name of 'default_password_lifetime' system variable.
+	DefaultPasswordLifetime = "default_password_lifetime"
+	// DisconnectOnExpiredPassword is the name of 'disconnect_on_expired_password' system variable.
+	DisconnectOnExpiredPassword = "disconnect_on_expired_password"
+	// PasswordHistory is the name of 'password_history' system variable.
+	PasswordHistory = "password_history"
+	// PasswordReuseInterval is the name of 'password_reuse_interval' system variable.
+	PasswordReuseInterval = "password_reuse_interval"
+	// ValidatePasswordEnable is the name of 'validate_password_enable' system variable.
+	ValidatePasswordEnable = "validate_password_enable"
+	// ValidatePasswordPolicy is the name of 'validate_password_policy' system variable.
+	ValidatePasswordPolicy = "validate_password_policy"
+	// ValidatePasswordMixedCaseCount is the name of 'validate_password_mixed_case_count' system variable.
+	ValidatePasswordMixedCaseCount = "validate_password_mixed_case_count"
+	// ValidatePasswordSpecialCharCount is the name of 'validate_password_special_char_count' system variable.
+	ValidatePasswordSpecialCharCount = "validate_password_special_char_count"
+	// ValidatePasswordDictionaryFile is the name of 'validate_password_dictionary_file' system variable.
+	ValidatePasswordDictionaryFile = "validate_password_dictionary_file"
+	// OptimizerTrace is the name of 'optimizer_trace' system variable.
+	OptimizerTrace = "optimizer_trace"
+	// OptimizerTraceOffset is the name of 'optimizer_trace_offset' system variable.
+	OptimizerTraceOffset = "optimizer_trace_offset"
+	// OptimizerTraceLimit is the name of 'optimizer_trace_limit' system variable.
+	OptimizerTraceLimit = "optimizer_trace_limit"
+	// OptimizerTraceMaxMemSize is the name of 'optimizer_trace_max_mem_size' system variable.
+	OptimizerTraceMaxMemSize = "optimizer_trace_max_mem_size"
 )
 
 // GlobalVarAccessor is the interface for accessing global scope system and status variables.
@@ -583,6 +583,9 @@ const (
 
 	// TiDBEnableOrderedResultMode indicates if stabilize query results.
 	TiDBEnableOrderedResultMode = "tidb_enable_ordered_result_mode"
+
+	// TiDBEnableNonPreparedPlanCache indicates whether to enable the plan cache for the text queries.
+	TiDBEnableNonPreparedPlanCache = "tidb_enable_non_prepared_plan_cache"
 )
 
 // TiDB vars that have only global scope
@@ -744,6 +747,7 @@ const (
 	DefTMPTableSize                       = 16777216
 	DefTiDBEnableLocalTxn                 = false
 	DefTiDBEnableOrderedResultMode        = false
+	DefTiDBEnableNonPreparedPlanCache     = false
 )
 
 // Process global variables.
@@ -320,6 +320,38 @@ func tidbOptFloat64(opt string, defaultVal float64) float64 {
 	return val
 }
 
+// parseOptimizerTrace parses the flags of optimizer_trace like "enabled=on,one_line=off",
+// the flags not mentioned in val keep the values of enabled and oneLine.
+func parseOptimizerTrace(val string, enabled, oneLine bool) (bool, bool, error) {
+	for _, item := range strings.Split(val, ",") {
+		kv := strings.SplitN(item, "=", 2)
+		if len(kv) == 1 && strings.EqualFold(strings.TrimSpace(kv[0]), "default") {
+			enabled, oneLine = false, false
+			continue
+		}
+		if len(kv) != 2 {
+			return false, false, errors.Errorf("invalid optimizer_trace flag %s", item)
+		}
+		var flag bool
+		switch strings.ToLower(strings.TrimSpace(kv[1])) {
+		case "on":
+			flag = true
+		case "off", "default":
+		default:
+			return false, false, errors.Errorf("invalid optimizer_trace flag %s", item)
+		}
+		switch strings.ToLower(strings.TrimSpace(kv[0])) {
+		case "enabled":
+			enabled = flag
+		case "one_line":
+			oneLine = flag
+		default:
+			return false, false, errors.Errorf("invalid optimizer_trace flag %s", item)
+		}
+	}
+	return enabled, oneLine, nil
+}
+
 func parseTimeZone(s string) (*time.Location, error) {
 	if strings.EqualFold(s, "SYSTEM") {
 		return timeutil.SystemLocation(), nil
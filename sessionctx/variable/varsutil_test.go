@@ -136,12 +136,13 @@ func (s *testVarsutilSuite) TestVarsutil(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(val, Equals, "OFF")
 
-	// 1/ON is not supported (generates a warning and sets to OFF)
+	// 1 converts to ON
 	err = SetSessionSystemVar(v, "foreign_key_checks", "1")
 	c.Assert(err, IsNil)
 	val, err = GetSessionOrGlobalSystemVar(v, "foreign_key_checks")
 	c.Assert(err, IsNil)
-	c.Assert(val, Equals, "OFF")
+	c.Assert(val, Equals, "ON")
+	c.Assert(v.ForeignKeyChecks, IsTrue)
 
 	err = SetSessionSystemVar(v, "sql_mode", "strict_trans_tables")
 	c.Assert(err, IsNil)
@@ -751,6 +752,54 @@ func (s *testVarsutilSuite) TestHelperFuncs(c *C) {
 	c.Assert(tidbOptInt("bogus", 5), Equals, 5)
 }
 
+func (s *testVarsutilSuite) TestParseOptimizerTrace(c *C) {
+	tests := []struct {
+		val     string
+		enabled bool
+		oneLine bool
+		expectE bool
+		expectO bool
+		err     bool
+	}{
+		{"enabled=on,one_line=on", false, false, true, true, false},
+		{"enabled=on", false, true, true, true, false},
+		{" ONE_LINE = on ", true, false, true, true, false},
+		{"enabled=default,one_line=off", true, true, false, false, false},
+		{"default", true, true, false, false, false},
+		{"default,enabled=on", false, false, true, false, false},
+		{"enabled=1", false, false, false, false, true},
+		{"enabled", false, false, false, false, true},
+		{"unknown=on", false, false, false, false, true},
+	}
+	for _, t := range tests {
+		enabled, oneLine, err := parseOptimizerTrace(t.val, t.enabled, t.oneLine)
+		if t.err {
+			c.Assert(err, NotNil, Commentf("%s", t.val))
+			continue
+		}
+		c.Assert(err, IsNil, Commentf("%s", t.val))
+		c.Assert(enabled, Equals, t.expectE, Commentf("%s", t.val))
+		c.Assert(oneLine, Equals, t.expectO, Commentf("%s", t.val))
+	}
+
+	vars := NewSessionVars()
+	vars.OptimizerTraceOffset, vars.OptimizerTraceLimit, vars.OptimizerTraceMaxMemSize = -2, 1, 5
+	vars.AppendOptimizerTrace("q1", []byte("trace1"))
+	vars.AppendOptimizerTrace("q2", []byte("t2"))
+	vars.AppendOptimizerTrace("q3", []byte("t3"))
+	// The last 2 traces are kept but only the first one of them is shown.
+	traces := vars.OptimizerTraces()
+	c.Assert(traces, HasLen, 1)
+	c.Assert(*traces[0], Equals, OptimizerTraceInfo{Query: "q2", Trace: "t2"})
+	vars.ResetOptimizerTraces()
+	vars.OptimizerTraceOffset = 0
+	vars.AppendOptimizerTrace("q1", []byte("trace1"))
+	vars.AppendOptimizerTrace("q2", []byte("t2"))
+	traces = vars.OptimizerTraces()
+	c.Assert(traces, HasLen, 1)
+	c.Assert(*traces[0], Equals, OptimizerTraceInfo{Query: "q1", Trace: "trace", MissingBytesBeyondMaxMemSize: 1})
+}
+
 func (s *testVarsutilSuite) TestStmtVars(c *C) {
 	vars := NewSessionVars()
 	err := SetStmtVar(vars, "bogussysvar", "1")
@@ -106,6 +106,13 @@ type Handle struct {
 
 	// idxUsageListHead contains all the index usage collectors required by session.
 	idxUsageListHead *SessionIndexUsageCollector
+	// idxUsage accumulates the index usage collected by this instance, it's shown in information_schema.tidb_index_usage.
+	idxUsage struct {
+		sync.Mutex
+		mapper indexUsageMap
+		// since is the time when this instance starts to collect the index usage.
+		since time.Time
+	}
 }
 
 func (h *Handle) withRestrictedSQLExecutor(ctx context.Context, fn func(context.Context, sqlexec.RestrictedSQLExecutor) ([]chunk.Row, []*ast.ResultField, error)) ([]chunk.Row, []*ast.ResultField, error) {
@@ -194,6 +201,7 @@ func NewHandle(ctx sessionctx.Context, lease time.Duration, pool sessionPool) (*
 	}
 	handle.lease.Store(lease)
 	handle.pool = pool
+	handle.idxUsage.mapper = make(indexUsageMap)
 	handle.statsCache.memTracker = memory.NewTracker(memory.LabelForStatsCache, -1)
 	handle.mu.ctx = ctx
 	handle.mu.rateMap = make(errorRateDeltaMap)
@@ -2226,6 +2226,35 @@ func (s *statsSerialSuite) TestIndexUsageInformation(c *C) {
 	))
 }
 
+func (s *statsSerialSuite) TestIndexUsageTable(c *C) {
+	defer cleanEnv(c, s.store, s.do)
+	session.SetIndexUsageSyncLease(1)
+	defer session.SetIndexUsageSyncLease(0)
+	tk := testkit.NewTestKit(c, s.store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t_idx(a int, b int, c int, unique index idx_a(a), unique index idx_b(b), index idx_c(c))")
+	tk.MustExec("insert into t_idx values(1, 1, 1), (2, 2, 2)")
+	tk.MustQuery("select a from t_idx where a = 1")
+	tk.MustQuery("select b from t_idx where b = 2")
+	tk.MustQuery("select b from t_idx where b = 3")
+	querySQL := `select table_schema, table_name, index_name, query_count, rows_selected, last_access_time is null, never_used_since is null
+					from information_schema.tidb_index_usage where table_name = 't_idx'`
+	// The usage not dumped to KV is shown.
+	tk.MustQuery(querySQL).Check(testkit.Rows(
+		"test t_idx idx_a 1 1 0 1",
+		"test t_idx idx_b 2 1 0 1",
+		"test t_idx idx_c 0 0 1 0",
+	))
+	// The usage dumped to KV is still shown.
+	c.Assert(s.do.StatsHandle().DumpIndexUsageToKV(), IsNil)
+	tk.MustQuery("select a from t_idx where a = 2")
+	tk.MustQuery(querySQL).Check(testkit.Rows(
+		"test t_idx idx_a 2 2 0 1",
+		"test t_idx idx_b 2 1 0 1",
+		"test t_idx idx_c 0 0 1 0",
+	))
+}
+
 func (s *statsSerialSuite) TestGCIndexUsageInformation(c *C) {
 	defer cleanEnv(c, s.store, s.do)
 	session.SetIndexUsageSyncLease(1)
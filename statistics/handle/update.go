@@ -275,6 +275,11 @@ func (s *SessionIndexUsageCollector) Delete() {
 // idxUsageListHead always points to an empty SessionIndexUsageCollector as a sentinel node. So we let idxUsageListHead.next
 // points to new item. It's helpful to sweepIdxUsageList.
 func (h *Handle) NewSessionIndexUsageCollector() *SessionIndexUsageCollector {
+	h.idxUsage.Lock()
+	if h.idxUsage.since.IsZero() {
+		h.idxUsage.since = time.Now()
+	}
+	h.idxUsage.Unlock()
 	h.idxUsageListHead.Lock()
 	defer h.idxUsageListHead.Unlock()
 	newCollector := &SessionIndexUsageCollector{
@@ -289,6 +294,9 @@ func (h *Handle) NewSessionIndexUsageCollector() *SessionIndexUsageCollector {
 // and remove closed session's collector.
 // For convenience, we keep idxUsageListHead always points to sentinel node. So that we don't need to consider corner case.
 func (h *Handle) sweepIdxUsageList() indexUsageMap {
+	// Hold idxUsage until the swept usage is accumulated, so GetIndexUsage never counts it twice.
+	h.idxUsage.Lock()
+	defer h.idxUsage.Unlock()
 	prev := h.idxUsageListHead
 	prev.Lock()
 	mapper := make(indexUsageMap)
@@ -305,9 +313,29 @@ func (h *Handle) sweepIdxUsageList() indexUsageMap {
 		}
 	}
 	prev.Unlock()
+	h.idxUsage.mapper.merge(mapper)
 	return mapper
 }
 
+// GetIndexUsage returns the index usage collected by this instance, including the usage not dumped to KV yet,
+// and the time when the collection started. The time is zero if the index usage is not collected.
+func (h *Handle) GetIndexUsage() (map[GlobalIndexID]IndexUsageInformation, time.Time) {
+	h.idxUsage.Lock()
+	defer h.idxUsage.Unlock()
+	mapper := make(indexUsageMap, len(h.idxUsage.mapper))
+	mapper.merge(h.idxUsage.mapper)
+	prev := h.idxUsageListHead
+	prev.Lock()
+	for curr := prev.next; curr != nil; curr = curr.next {
+		curr.Lock()
+		mapper.merge(curr.mapper)
+		prev.Unlock()
+		prev = curr
+	}
+	prev.Unlock()
+	return mapper, h.idxUsage.since
+}
+
 // DumpIndexUsageToKV will dump in-memory index usage information to KV.
 func (h *Handle) DumpIndexUsageToKV() error {
 	ctx := context.Background()
@@ -341,6 +341,13 @@ func (store *MVCCStore) PessimisticRollback(reqCtx *requestCtx, req *kvrpcpb.Pes
 	defer regCtx.ReleaseLatches(hashVals)
 	startTS := req.StartVersion
 	var batch mvcc.WriteBatch
+	// Only the waiters of the released locks are woken up. The lock waiter manager
+	// finds the waiters by key hash only, so waking up all the keys would also
+	// dequeue the oldest waiter of a key locked by another transaction, e.g. when a
+	// transaction rolls back a key it failed to lock after its lock wait timeout.
+	// The woken waiter then retries its statement and restarts its lock wait,
+	// which makes it wait longer than innodb_lock_wait_timeout.
+	releasedHashVals := make([]uint64, 0, len(keys))
 	for _, k := range keys {
 		lock := store.getLock(reqCtx, k)
 		if lock != nil &&
@@ -351,13 +358,14 @@ func (store *MVCCStore) PessimisticRollback(reqCtx *requestCtx, req *kvrpcpb.Pes
 				batch = store.dbWriter.NewWriteBatch(startTS, 0, reqCtx.rpcCtx)
 			}
 			batch.PessimisticRollback(k)
+			releasedHashVals = append(releasedHashVals, farm.Fingerprint64(k))
 		}
 	}
 	var err error
 	if batch != nil {
 		err = store.dbWriter.Write(batch)
 	}
-	store.lockWaiterManager.WakeUp(startTS, 0, hashVals)
+	store.lockWaiterManager.WakeUp(startTS, 0, releasedHashVals)
 	store.DeadlockDetectCli.CleanUp(startTS)
 	return err
 }
@@ -537,7 +545,7 @@ func (store *MVCCStore) CheckSecondaryLocks(reqCtx *requestCtx, keys [][]byte, s
 func (store *MVCCStore) normalizeWaitTime(lockWaitTime int64) time.Duration {
 	if lockWaitTime > store.conf.PessimisticTxn.WaitForLockTimeout {
 		lockWaitTime = store.conf.PessimisticTxn.WaitForLockTimeout
-	} else if lockWaitTime == 0 {
+	} else if lockWaitTime < 0 {
 		lockWaitTime = store.conf.PessimisticTxn.WaitForLockTimeout
 	}
 	return time.Duration(lockWaitTime) * time.Millisecond
@@ -1185,11 +1193,14 @@ func (store *MVCCStore) appendScannedLock(locks []*kvrpcpb.LockInfo, it *locksto
 }
 
 // ScanLock implements the MVCCStore interface.
-func (store *MVCCStore) ScanLock(reqCtx *requestCtx, maxTS uint64, limit int) ([]*kvrpcpb.LockInfo, error) {
+func (store *MVCCStore) ScanLock(reqCtx *requestCtx, startKey, endKey []byte, maxTS uint64, limit int) ([]*kvrpcpb.LockInfo, error) {
 	var locks []*kvrpcpb.LockInfo
+	if bytes.Compare(startKey, reqCtx.regCtx.RawStart()) < 0 {
+		startKey = reqCtx.regCtx.RawStart()
+	}
 	it := store.lockStore.NewIterator()
-	for it.Seek(reqCtx.regCtx.RawStart()); it.Valid(); it.Next() {
-		if exceedEndKey(it.Key(), reqCtx.regCtx.RawEnd()) {
+	for it.Seek(startKey); it.Valid(); it.Next() {
+		if exceedEndKey(it.Key(), reqCtx.regCtx.RawEnd()) || exceedEndKey(it.Key(), endKey) {
 			return locks, nil
 		}
 		if len(locks) == limit {
@@ -442,7 +442,7 @@ func (svr *Server) KvScanLock(ctx context.Context, req *kvrpcpb.ScanLockRequest)
 		return &kvrpcpb.ScanLockResponse{RegionError: reqCtx.regErr}, nil
 	}
 	log.Debug("kv scan lock")
-	locks, err := svr.mvccStore.ScanLock(reqCtx, req.MaxVersion, int(req.Limit))
+	locks, err := svr.mvccStore.ScanLock(reqCtx, req.StartKey, req.EndKey, req.MaxVersion, int(req.Limit))
 	return &kvrpcpb.ScanLockResponse{Error: convertToKeyError(err), Locks: locks}, nil
 }
 
@@ -26,8 +26,8 @@ import (
 
 // LockNoWait is used for pessimistic lock wait time
 // these two constants are special for lock protocol with tikv
-// -1 means nowait, others meaning lock wait in milliseconds
-var LockNoWait = int64(-1)
+// 0 means nowait, negative means the default wait time, others meaning lock wait in milliseconds
+var LockNoWait = int64(0)
 
 // Manager represents a waiters manager.
 type Manager struct {
@@ -37,6 +37,7 @@ import (
 	"github.com/pingcap/tidb/sessionctx/stmtctx"
 	"github.com/pingcap/tidb/types"
 	"github.com/pingcap/tidb/types/json"
+	"github.com/pingcap/tidb/util/encoding"
 	"github.com/pingcap/tidb/util/hack"
 	"github.com/pingcap/tidb/util/logutil"
 	"github.com/pingcap/tidb/util/timeutil"
@@ -336,6 +337,22 @@ func CastValue(ctx sessionctx.Context, val types.Datum, col *model.ColumnInfo, r
 		return casted, err
 	}
 
+	if enc := encoding.GetEncoding(col.Charset); enc != nil {
+		// The strings are kept in UTF-8, check whether all the characters can be represented in the charset.
+		str := casted.GetString()
+		var buf [utf8.UTFMax]byte
+		for i, r := range str {
+			if _, ok := enc.EncodeRune(buf[:0], r); !ok {
+				casted, err = handleWrongCharsetValue(ctx, col, &casted, str, i)
+				break
+			}
+		}
+		if forceIgnoreTruncate {
+			err = nil
+		}
+		return casted, err
+	}
+
 	if ctx.GetSessionVars().SkipUTF8Check {
 		return casted, nil
 	}
@@ -99,6 +99,8 @@ var (
 	ErrRowDoesNotMatchGivenPartitionSet = dbterror.ClassTable.NewStd(mysql.ErrRowDoesNotMatchGivenPartitionSet)
 	// ErrTempTableFull returns a table is full error, it's used by temporary table now.
 	ErrTempTableFull = dbterror.ClassTable.NewStd(mysql.ErrRecordFileFull)
+	// ErrCheckConstraintViolated returns when the row doesn't satisfy a check constraint.
+	ErrCheckConstraintViolated = dbterror.ClassTable.NewStd(mysql.ErrCheckConstraintViolated)
 )
 
 // RecordIterFunc is used for low-level record iteration.
@@ -236,10 +238,13 @@ type PhysicalTable interface {
 
 // PartitionedTable is a Table, and it has a GetPartition() method.
 // GetPartition() gets the partition from a partition table by a physical table ID,
+// GetReorganizedPartitionByRow() gets the partition being reorganized that the row should be written to, it returns
+// nil if no partition is being reorganized for the row.
 type PartitionedTable interface {
 	Table
 	GetPartition(physicalID int64) PhysicalTable
 	GetPartitionByRow(sessionctx.Context, []types.Datum) (PhysicalTable, error)
+	GetReorganizedPartitionByRow(sessionctx.Context, []types.Datum) (PhysicalTable, error)
 	GetAllPartitionIDs() []int64
 }
 
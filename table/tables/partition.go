@@ -80,6 +80,11 @@ type partitionedTable struct {
 	partitions      map[int64]*partition
 	evalBufferTypes []*types.FieldType
 	evalBufferPool  sync.Pool
+	// reorgTable is the partitioned table in the layout that the partitions being reorganized are replaced,
+	// it's nil if no partition is being reorganized.
+	reorgTable *partitionedTable
+	// reorgPartitions are the states of the partitions which only exist in the layout of reorgTable.
+	reorgPartitions map[int64]model.SchemaState
 }
 
 func newPartitionedTable(tbl *TableCommon, tblInfo *model.TableInfo) (table.Table, error) {
@@ -109,9 +114,59 @@ func newPartitionedTable(tbl *TableCommon, tblInfo *model.TableInfo) (table.Tabl
 		partitions[p.ID] = &t
 	}
 	ret.partitions = partitions
+	if reorgPI := reorganizedPartitionInfo(pi); reorgPI != nil {
+		reorgTblInfo := *tblInfo
+		reorgTblInfo.Partition = reorgPI
+		reorgTbl := *tbl
+		reorgTbl.meta = &reorgTblInfo
+		reorgTable, err := newPartitionedTable(&reorgTbl, &reorgTblInfo)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		ret.reorgTable = reorgTable.(*partitionedTable)
+		ret.reorgPartitions = make(map[int64]model.SchemaState, len(pi.States))
+		for _, s := range pi.States {
+			ret.reorgPartitions[s.ID] = s.State
+		}
+	}
 	return ret, nil
 }
 
+// reorganizedPartitionInfo returns the partition info in the layout that the partitions being reorganized are
+// replaced by the partitions in pi.States, it returns nil if no partition is being reorganized.
+// Before the reorganization is done, pi.States are the adding partitions; after that, the definitions are replaced
+// by the adding partitions, and pi.States are the dropping partitions which still need to be written.
+func reorganizedPartitionInfo(pi *model.PartitionInfo) *model.PartitionInfo {
+	if len(pi.States) == 0 || len(pi.AddingDefinitions) == 0 || len(pi.DroppingDefinitions) == 0 {
+		return nil
+	}
+	replacedDefs, reorgDefs := pi.DroppingDefinitions, pi.AddingDefinitions
+	if pi.States[0].ID != pi.AddingDefinitions[0].ID {
+		replacedDefs, reorgDefs = pi.AddingDefinitions, pi.DroppingDefinitions
+	}
+	replaced := make(map[int64]struct{}, len(replacedDefs))
+	for _, def := range replacedDefs {
+		replaced[def.ID] = struct{}{}
+	}
+	defs := make([]model.PartitionDefinition, 0, len(pi.Definitions)-len(replacedDefs)+len(reorgDefs))
+	for _, def := range pi.Definitions {
+		if _, ok := replaced[def.ID]; !ok {
+			defs = append(defs, def)
+			continue
+		}
+		// The replaced partitions are consecutive for range partitioning, and the order doesn't matter for list
+		// partitioning, so put all the reorganized partitions at the position of the first replaced one.
+		defs = append(defs, reorgDefs...)
+		reorgDefs = nil
+	}
+	reorgPI := *pi
+	reorgPI.Definitions = defs
+	reorgPI.AddingDefinitions = nil
+	reorgPI.DroppingDefinitions = nil
+	reorgPI.States = nil
+	return &reorgPI
+}
+
 func newPartitionExpr(tblInfo *model.TableInfo) (*PartitionExpr, error) {
 	ctx := mock.NewContext()
 	dbName := model.NewCIStr(ctx.GetSessionVars().CurrentDB)
@@ -1107,6 +1162,92 @@ func (t *partitionTableWithGivenSets) GetPartitionByRow(ctx sessionctx.Context,
 	return t.partitions[pid], nil
 }
 
+// GetReorganizedPartitionByRow implements table.PartitionedTable GetReorganizedPartitionByRow interface.
+func (t *partitionedTable) GetReorganizedPartitionByRow(ctx sessionctx.Context, r []types.Datum) (table.PhysicalTable, error) {
+	p, _, err := t.locateReorgPartition(ctx, r)
+	if err != nil || p == nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// locateReorgPartition returns the partition being reorganized which the row belongs to and its state,
+// it returns nil if the row doesn't belong to any of them.
+func (t *partitionedTable) locateReorgPartition(ctx sessionctx.Context, r []types.Datum) (*partition, model.SchemaState, error) {
+	if t.reorgTable == nil {
+		return nil, model.StateNone, nil
+	}
+	pid, err := t.reorgTable.locatePartition(ctx, t.reorgTable.meta.GetPartitionInfo(), r)
+	if err != nil {
+		return nil, model.StateNone, errors.Trace(err)
+	}
+	state, ok := t.reorgPartitions[pid]
+	if !ok {
+		return nil, model.StateNone, nil
+	}
+	return t.reorgTable.partitions[pid], state, nil
+}
+
+// locateReorgPartitionForRemove is like locateReorgPartition, but a row not belonging to any partition of the
+// reorganized layout is ignored, because it can't be written into the partitions being reorganized.
+func (t *partitionedTable) locateReorgPartitionForRemove(ctx sessionctx.Context, r []types.Datum) (*partition, error) {
+	p, _, err := t.locateReorgPartition(ctx, r)
+	if err != nil && table.ErrNoPartitionForGivenValue.Equal(err) {
+		return nil, nil
+	}
+	return p, err
+}
+
+// rowWithHandle appends the handle to the row if the handle can't be derived from the row, so that the row is
+// written into the partitions being reorganized with the same handle.
+func (t *partitionedTable) rowWithHandle(r []types.Datum, h kv.Handle) []types.Datum {
+	if t.meta.PKIsHandle || t.meta.IsCommonHandle {
+		return r
+	}
+	cols := t.Cols()
+	row := make([]types.Datum, 0, len(cols)+1)
+	row = append(row, r[:len(cols)]...)
+	return append(row, types.NewIntDatum(h.IntValue()))
+}
+
+// addReorgRecord writes the added row into the partition being reorganized.
+func (t *partitionedTable) addReorgRecord(ctx sessionctx.Context, h kv.Handle, r []types.Datum) error {
+	p, state, err := t.locateReorgPartition(ctx, r)
+	if err != nil || p == nil || state == model.StateDeleteOnly {
+		return err
+	}
+	_, err = p.AddRecord(ctx, t.rowWithHandle(r, h))
+	return err
+}
+
+// updateReorgRecord applies the update to the partitions being reorganized, newHandle is the handle of the updated
+// row, it's different from h if the row is moved to another partition.
+func (t *partitionedTable) updateReorgRecord(gctx context.Context, ctx sessionctx.Context, h, newHandle kv.Handle, currData, newData []types.Datum, touched []bool) error {
+	from, err := t.locateReorgPartitionForRemove(ctx, currData)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	to, state, err := t.locateReorgPartition(ctx, newData)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if state == model.StateDeleteOnly {
+		to = nil
+	}
+	if from != nil && from == to && h.Equal(newHandle) {
+		return from.UpdateRecord(gctx, ctx, h, currData, newData, touched)
+	}
+	if to != nil {
+		if _, err = to.AddRecord(ctx, t.rowWithHandle(newData, newHandle)); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if from != nil {
+		return from.RemoveRecord(ctx, h, currData)
+	}
+	return nil
+}
+
 // AddRecord implements the AddRecord method for the table.Table interface.
 func (t *partitionedTable) AddRecord(ctx sessionctx.Context, r []types.Datum, opts ...table.AddRecordOption) (recordID kv.Handle, err error) {
 	return partitionedTableAddRecord(ctx, t, r, nil, opts)
@@ -1125,7 +1266,11 @@ func partitionedTableAddRecord(ctx sessionctx.Context, t *partitionedTable, r []
 		}
 	}
 	tbl := t.GetPartition(pid)
-	return tbl.AddRecord(ctx, r, opts...)
+	recordID, err = tbl.AddRecord(ctx, r, opts...)
+	if err != nil || t.reorgTable == nil {
+		return recordID, err
+	}
+	return recordID, t.addReorgRecord(ctx, recordID, r)
 }
 
 // partitionTableWithGivenSets is used for this kind of grammar: partition (p0,p1)
@@ -1169,7 +1314,15 @@ func (t *partitionedTable) RemoveRecord(ctx sessionctx.Context, h kv.Handle, r [
 	}
 
 	tbl := t.GetPartition(pid)
-	return tbl.RemoveRecord(ctx, h, r)
+	err = tbl.RemoveRecord(ctx, h, r)
+	if err != nil || t.reorgTable == nil {
+		return err
+	}
+	p, err := t.locateReorgPartitionForRemove(ctx, r)
+	if err != nil || p == nil {
+		return err
+	}
+	return p.RemoveRecord(ctx, h, r)
 }
 
 func (t *partitionedTable) GetAllPartitionIDs() []int64 {
@@ -1214,7 +1367,7 @@ func partitionedTableUpdateRecord(gctx context.Context, ctx sessionctx.Context,
 	// The old and new data locate in different partitions.
 	// Remove record from old partition and add record to new partition.
 	if from != to {
-		_, err = t.GetPartition(to).AddRecord(ctx, newData)
+		newHandle, err := t.GetPartition(to).AddRecord(ctx, newData)
 		if err != nil {
 			return errors.Trace(err)
 		}
@@ -1229,11 +1382,18 @@ func partitionedTableUpdateRecord(gctx context.Context, ctx sessionctx.Context,
 			logutil.BgLogger().Error("update partition record fails", zap.String("message", "new record inserted while old record is not removed"), zap.Error(err))
 			return errors.Trace(err)
 		}
-		return nil
+		if t.reorgTable == nil {
+			return nil
+		}
+		return t.updateReorgRecord(gctx, ctx, h, newHandle, currData, newData, touched)
 	}
 
 	tbl := t.GetPartition(to)
-	return tbl.UpdateRecord(gctx, ctx, h, currData, newData, touched)
+	err = tbl.UpdateRecord(gctx, ctx, h, currData, newData, touched)
+	if err != nil || t.reorgTable == nil {
+		return err
+	}
+	return t.updateReorgRecord(gctx, ctx, h, h, currData, newData, touched)
 }
 
 // FindPartitionByName finds partition in table meta by name.
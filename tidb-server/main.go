@@ -575,6 +575,9 @@ func setGlobalVars() {
 		if plannercore.PreparedPlanCacheMaxMemory.Load() > total || plannercore.PreparedPlanCacheMaxMemory.Load() <= 0 {
 			plannercore.PreparedPlanCacheMaxMemory.Store(total)
 		}
+		if cfg.PreparedPlanCache.InstanceCacheEnabled {
+			plannercore.SetInstancePlanCache(plannercore.NewInstancePlanCache(int64(cfg.PreparedPlanCache.InstanceCacheMaxMemory)))
+		}
 	}
 
 	atomic.StoreUint64(&transaction.CommitMaxBackoff, uint64(parseDuration(cfg.TiKVClient.CommitTimeout).Seconds()*1000))
@@ -589,6 +592,9 @@ func setGlobalVars() {
 		executor.GlobalMemoryUsageTracker.SetBytesLimit(int64(cfg.Performance.ServerMemoryQuota))
 	}
 	kvcache.GlobalLRUMemUsageTracker.AttachToGlobalTracker(executor.GlobalMemoryUsageTracker)
+	if c := plannercore.GetInstancePlanCache(); c != nil {
+		c.MemTracker().AttachToGlobalTracker(executor.GlobalMemoryUsageTracker)
+	}
 
 	t, err := time.ParseDuration(cfg.TiKVClient.StoreLivenessTimeout)
 	if err != nil || t < 0 {
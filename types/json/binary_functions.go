@@ -227,6 +227,12 @@ func (bj BinaryJSON) Extract(pathExprList []PathExpression) (ret BinaryJSON, fou
 	return
 }
 
+// ExtractAll returns all the values matched by the path expression in bj in
+// document order. Unlike Extract, the matched values are never wrapped as an array.
+func (bj BinaryJSON) ExtractAll(pathExpr PathExpression) []BinaryJSON {
+	return bj.extractTo(nil, pathExpr)
+}
+
 func (bj BinaryJSON) extractTo(buf []BinaryJSON, pathExpr PathExpression) []BinaryJSON {
 	if len(pathExpr.legs) == 0 {
 		return append(buf, bj)
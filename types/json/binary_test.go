@@ -84,6 +84,31 @@ func TestBinaryJSONExtract(t *testing.T) {
 	}
 }
 
+func TestBinaryJSONExtractAll(t *testing.T) {
+	t.Parallel()
+
+	bj := mustParseBinaryFromString(t, `[{"a": 1, "b": [2, 3]}, {"a": [4]}, 5]`)
+	var tests = []struct {
+		pathExpr string
+		expected []string
+	}{
+		{"$", []string{`[{"a": 1, "b": [2, 3]}, {"a": [4]}, 5]`}},
+		{"$[*].a", []string{"1", "[4]"}},
+		{"$[0].b[*]", []string{"2", "3"}},
+		{"$[1].a", []string{"[4]"}},
+		{"$[3]", []string{}},
+	}
+	for _, test := range tests {
+		pe, err := ParseJSONPathExpr(test.pathExpr)
+		require.NoError(t, err)
+		result := make([]string, 0, len(test.expected))
+		for _, val := range bj.ExtractAll(pe) {
+			result = append(result, val.String())
+		}
+		require.Equal(t, test.expected, result)
+	}
+}
+
 func TestBinaryJSONType(t *testing.T) {
 	t.Parallel()
 
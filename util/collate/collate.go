@@ -15,6 +15,7 @@ package collate
 
 import (
 	"sort"
+	"strings"
 	"sync/atomic"
 
 	"github.com/pingcap/errors"
@@ -22,6 +23,7 @@ import (
 	"github.com/pingcap/parser/mysql"
 	"github.com/pingcap/parser/terror"
 	"github.com/pingcap/tidb/util/dbterror"
+	"github.com/pingcap/tidb/util/encoding"
 	"github.com/pingcap/tidb/util/logutil"
 	"go.uber.org/zap"
 )
@@ -221,6 +223,16 @@ func GetCollationByName(name string) (coll *charset.Collation, err error) {
 	return
 }
 
+// GetCharsetDesc wraps charset.GetCharsetDesc, it also finds the charsets added by TiDB, e.g. gbk.
+func GetCharsetDesc(cs string) (*charset.Desc, error) {
+	for _, desc := range charset.GetSupportedCharsets() {
+		if strings.EqualFold(desc.Name, cs) {
+			return desc, nil
+		}
+	}
+	return charset.GetCharsetDesc(cs)
+}
+
 // GetSupportedCollations gets information for all collations supported so far.
 func GetSupportedCollations() []*charset.Collation {
 	if atomic.LoadInt32(&newCollationEnabled) == 1 {
@@ -294,7 +306,8 @@ func decodeRune(s string, si int) (r rune, newIndex int) {
 // IsCICollation returns if the collation is case-sensitive
 func IsCICollation(collate string) bool {
 	return collate == "utf8_general_ci" || collate == "utf8mb4_general_ci" ||
-		collate == "utf8_unicode_ci" || collate == "utf8mb4_unicode_ci"
+		collate == "utf8_unicode_ci" || collate == "utf8mb4_unicode_ci" || collate == "utf8mb4_0900_ai_ci" ||
+		collate == "gbk_chinese_ci"
 }
 
 // IsBinCollation returns if the collation is 'xx_bin'
@@ -304,6 +317,16 @@ func IsBinCollation(collate string) bool {
 }
 
 func init() {
+	// utf8mb4_0900_as_cs is missing in the parser, use the same ID as MySQL.
+	charset.AddCollation(&charset.Collation{ID: 278, CharsetName: charset.CharsetUTF8MB4, Name: "utf8mb4_0900_as_cs"})
+	// gbk is missing in the parser's charsets while its collations are there, add them to the charset again.
+	charset.AddCharset(&charset.Charset{Name: charset.CharsetGBK, DefaultCollation: "gbk_chinese_ci", Collations: make(map[string]*charset.Collation), Desc: "GBK Simplified Chinese", Maxlen: 2})
+	for _, name := range []string{"gbk_chinese_ci", "gbk_bin"} {
+		coll, err := charset.GetCollationByName(name)
+		terror.MustNil(err)
+		charset.AddCollation(coll)
+	}
+
 	newCollatorMap = make(map[string]Collator)
 	newCollatorIDMap = make(map[int]Collator)
 
@@ -325,6 +348,14 @@ func init() {
 	newCollatorIDMap[CollationName2ID("utf8mb4_unicode_ci")] = &unicodeCICollator{}
 	newCollatorMap["utf8_unicode_ci"] = &unicodeCICollator{}
 	newCollatorIDMap[CollationName2ID("utf8_unicode_ci")] = &unicodeCICollator{}
+	newCollatorMap["utf8mb4_0900_ai_ci"] = &unicode0900AICICollator{}
+	newCollatorIDMap[CollationName2ID("utf8mb4_0900_ai_ci")] = &unicode0900AICICollator{}
+	newCollatorMap["utf8mb4_0900_as_cs"] = &unicode0900ASCSCollator{}
+	newCollatorIDMap[CollationName2ID("utf8mb4_0900_as_cs")] = &unicode0900ASCSCollator{}
+	newCollatorMap["gbk_chinese_ci"] = &gbkChineseCICollator{}
+	newCollatorIDMap[CollationName2ID("gbk_chinese_ci")] = &gbkChineseCICollator{}
+	newCollatorMap["gbk_bin"] = &gbkBinCollator{encoding.GetEncoding(charset.CharsetGBK)}
+	newCollatorIDMap[CollationName2ID("gbk_bin")] = &gbkBinCollator{encoding.GetEncoding(charset.CharsetGBK)}
 	newCollatorMap["utf8mb4_zh_pinyin_tidb_as_cs"] = &zhPinyinTiDBASCSCollator{}
 	newCollatorIDMap[CollationName2ID("utf8mb4_zh_pinyin_tidb_as_cs")] = &zhPinyinTiDBASCSCollator{}
 }
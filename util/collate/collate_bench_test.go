@@ -52,6 +52,10 @@ func BenchmarkUtf8mb4UnicodeCI_CompareShort(b *testing.B) {
 	compare(b, &unicodeCICollator{}, short)
 }
 
+func BenchmarkUtf8mb40900AICI_CompareShort(b *testing.B) {
+	compare(b, &unicode0900AICICollator{}, short)
+}
+
 func BenchmarkUtf8mb4Bin_CompareMid(b *testing.B) {
 	compare(b, &binCollator{}, middle)
 }
@@ -64,6 +68,10 @@ func BenchmarkUtf8mb4UnicodeCI_CompareMid(b *testing.B) {
 	compare(b, &unicodeCICollator{}, middle)
 }
 
+func BenchmarkUtf8mb40900AICI_CompareMid(b *testing.B) {
+	compare(b, &unicode0900AICICollator{}, middle)
+}
+
 func BenchmarkUtf8mb4Bin_CompareLong(b *testing.B) {
 	compare(b, &binCollator{}, long)
 }
@@ -76,6 +84,10 @@ func BenchmarkUtf8mb4UnicodeCI_CompareLong(b *testing.B) {
 	compare(b, &unicodeCICollator{}, long)
 }
 
+func BenchmarkUtf8mb40900AICI_CompareLong(b *testing.B) {
+	compare(b, &unicode0900AICICollator{}, long)
+}
+
 func BenchmarkUtf8mb4Bin_KeyShort(b *testing.B) {
 	key(b, &binCollator{}, short)
 }
@@ -88,6 +100,10 @@ func BenchmarkUtf8mb4UnicodeCI_KeyShort(b *testing.B) {
 	key(b, &unicodeCICollator{}, short)
 }
 
+func BenchmarkUtf8mb40900AICI_KeyShort(b *testing.B) {
+	key(b, &unicode0900AICICollator{}, short)
+}
+
 func BenchmarkUtf8mb4Bin_KeyMid(b *testing.B) {
 	key(b, &binCollator{}, middle)
 }
@@ -100,6 +116,10 @@ func BenchmarkUtf8mb4UnicodeCI_KeyMid(b *testing.B) {
 	key(b, &unicodeCICollator{}, middle)
 }
 
+func BenchmarkUtf8mb40900AICI_KeyMid(b *testing.B) {
+	key(b, &unicode0900AICICollator{}, middle)
+}
+
 func BenchmarkUtf8mb4Bin_KeyLong(b *testing.B) {
 	key(b, &binCollator{}, long)
 }
@@ -111,3 +131,7 @@ func BenchmarkUtf8mb4GeneralCI_KeyLong(b *testing.B) {
 func BenchmarkUtf8mb4UnicodeCI_KeyLong(b *testing.B) {
 	key(b, &unicodeCICollator{}, long)
 }
+
+func BenchmarkUtf8mb40900AICI_KeyLong(b *testing.B) {
+	key(b, &unicode0900AICICollator{}, long)
+}
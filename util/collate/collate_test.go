@@ -151,6 +151,189 @@ func TestUnicodeCICollator(t *testing.T) {
 	testKeyTable(keyTable, "utf8mb4_unicode_ci", t)
 }
 
+func TestUnicode0900AICICollator(t *testing.T) {
+	SetNewCollationEnabledForTest(true)
+	defer SetNewCollationEnabledForTest(false)
+
+	compareTable := []compareTable{
+		{"a", "b", -1},
+		{"a", "A", 0},
+		{"À", "A", 0},
+		{"abc", "abc", 0},
+		{"abc", "ab", 1},
+		{"a", "a ", -1},
+		{"a ", "a  ", -1},
+		{"😜", "😃", 1},
+		{"a\t", "a", 1},
+		{"ß", "s", 1},
+		{"ß", "ss", 0},
+		{"æ", "ae", 0},
+		{"啊", "吧", 1},
+		{"中文", "汉字", -1},
+		{"\U0001730F", "啊", -1},
+		{"가", "㉡", -1},
+		{"갟", "감1", 1},
+		{"\U000FFFFE", "\U000FFFFF", -1},
+	}
+	keyTable := []keyTable{
+		{"a", []byte{0x1C, 0x47}},
+		{"A", []byte{0x1C, 0x47}},
+		{"Foo © bar 𝌆 baz ☃ qux", []byte{0x1C, 0xE5, 0x1D, 0xDD, 0x1D, 0xDD, 0x02, 0x09, 0x05, 0x84, 0x02, 0x09, 0x1C,
+			0x60, 0x1C, 0x47, 0x1E, 0x33, 0x02, 0x09, 0x0E, 0xF0, 0x02, 0x09, 0x1C, 0x60, 0x1C, 0x47, 0x1F, 0x21, 0x02,
+			0x09, 0x09, 0x1B, 0x02, 0x09, 0x1E, 0x21, 0x1E, 0xB5, 0x1E, 0xFF}},
+		{"a ", []byte{0x1C, 0x47, 0x02, 0x09}},
+		{"ﷻ", []byte{0x23, 0x25, 0x23, 0x9C, 0x02, 0x09, 0x23, 0x25, 0x23, 0x9C, 0x23, 0x0B, 0x23, 0x9C, 0x23, 0xB1}},
+		{"中文", []byte{0xFB, 0x40, 0xCE, 0x2D, 0xFB, 0x40, 0xE5, 0x87}},
+		{"갟감1", []byte{0x3B, 0xF5, 0x3C, 0x74, 0x3C, 0xD3, 0x3B, 0xF5, 0x3C, 0x73, 0x3C, 0xE0, 0x1C, 0x3E}},
+	}
+
+	testCompareTable(compareTable, "utf8mb4_0900_ai_ci", t)
+	testKeyTable(keyTable, "utf8mb4_0900_ai_ci", t)
+}
+
+func TestUnicode0900ASCSCollator(t *testing.T) {
+	SetNewCollationEnabledForTest(true)
+	defer SetNewCollationEnabledForTest(false)
+
+	compareTable := []compareTable{
+		{"a", "b", -1},
+		{"a", "A", -1},
+		{"À", "A", 1},
+		{"à", "À", -1},
+		{"abc", "abc", 0},
+		{"abc", "ab", 1},
+		{"a", "a ", -1},
+		{"ß", "ss", 1},
+		{"Ab", "aC", -1},
+		{"中文", "中文", 0},
+		{"\u0000a", "a", 0},
+	}
+	keyTable := []keyTable{
+		{"a", []byte{0x1C, 0x47, 0x00, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0x02}},
+		{"A", []byte{0x1C, 0x47, 0x00, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0x08}},
+		{"aÀ", []byte{0x1C, 0x47, 0x1C, 0x47, 0x00, 0x00, 0x00, 0x20, 0x00, 0x20, 0x00, 0x25, 0x00, 0x00, 0x00, 0x02,
+			0x00, 0x08, 0x00, 0x02}},
+		{"中", []byte{0xFB, 0x40, 0xCE, 0x2D, 0x00, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0x02}},
+	}
+
+	testCompareTable(compareTable, "utf8mb4_0900_as_cs", t)
+	testKeyTable(keyTable, "utf8mb4_0900_as_cs", t)
+}
+
+func TestUnicode0900Pattern(t *testing.T) {
+	SetNewCollationEnabledForTest(true)
+	defer SetNewCollationEnabledForTest(false)
+
+	tests := []struct {
+		collate string
+		pattern string
+		str     string
+		match   bool
+	}{
+		{"utf8mb4_0900_ai_ci", "a%", "Àbc", true},
+		{"utf8mb4_0900_ai_ci", "_b_", "ABC", true},
+		{"utf8mb4_0900_ai_ci", "a", "a ", false},
+		{"utf8mb4_0900_ai_ci", "😃", "😜", false},
+		{"utf8mb4_0900_as_cs", "a%", "Àbc", false},
+		{"utf8mb4_0900_as_cs", "À_c", "Àbc", true},
+		{"utf8mb4_0900_as_cs", "_B_", "abc", false},
+		// The expansions match the characters they expand to, but '_' matches one character only.
+		{"utf8mb4_0900_ai_ci", "ss", "ß", true},
+		{"utf8mb4_0900_ai_ci", "ß", "ss", true},
+		{"utf8mb4_0900_ai_ci", "s_", "ß", false},
+		{"utf8mb4_0900_ai_ci", "_", "ss", false},
+		{"utf8mb4_0900_ai_ci", "%ß%", "Strasse", true},
+		{"utf8mb4_0900_ai_ci", "ae", "æ", true},
+		{"utf8mb4_0900_ai_ci", "Æ", "ae", true},
+		{"utf8mb4_0900_ai_ci", "a_", "æ", false},
+		{"utf8mb4_0900_as_cs", "ae", "æ", false},
+	}
+	for i, tt := range tests {
+		p := GetCollator(tt.collate).Pattern()
+		p.Compile(tt.pattern, '\\')
+		require.Equal(t, tt.match, p.DoMatch(tt.str), fmt.Sprintf("%d %s %s", i, tt.pattern, tt.str))
+	}
+}
+
+func TestGBKBinCollator(t *testing.T) {
+	SetNewCollationEnabledForTest(true)
+	defer SetNewCollationEnabledForTest(false)
+
+	compareTable := []compareTable{
+		{"a", "b", -1},
+		{"a", "A", 1},
+		{"abc", "abc", 0},
+		{"abc", "ab", 1},
+		{"a", "a ", 0},
+		{"a", "a\t", -1},
+		{"啊", "吧", -1},
+		{"中文", "汉字", 1},
+		{"中文", "a", 1},
+		{"😜", "😃", 0},
+		{"😜", "?", 0},
+	}
+	keyTable := []keyTable{
+		{"a", []byte{0x61}},
+		{"a  ", []byte{0x61}},
+		{"中文", []byte{0xD6, 0xD0, 0xCE, 0xC4}},
+		{"a😜", []byte{0x61, 0x3F}},
+	}
+
+	testCompareTable(compareTable, "gbk_bin", t)
+	testKeyTable(keyTable, "gbk_bin", t)
+}
+
+func TestGBKChineseCICollator(t *testing.T) {
+	SetNewCollationEnabledForTest(true)
+	defer SetNewCollationEnabledForTest(false)
+
+	compareTable := []compareTable{
+		{"a", "b", -1},
+		{"a", "A", 0},
+		{"abc", "abc", 0},
+		{"abc", "ab", 1},
+		{"a", "a ", 0},
+		{"啊", "吧", -1},
+		{"中文", "汉字", 1},
+		{"中文", "a", 1},
+		{"😜", "😃", 0},
+		{"😜", "?", 0},
+	}
+	keyTable := []keyTable{
+		{"a", []byte{0x41}},
+		{"A  ", []byte{0x41}},
+		{"中文", []byte{0xD3, 0x21, 0xC1, 0xAD}},
+		{"a😜", []byte{0x41, 0x3F}},
+	}
+
+	testCompareTable(compareTable, "gbk_chinese_ci", t)
+	testKeyTable(keyTable, "gbk_chinese_ci", t)
+}
+
+func TestGBKPattern(t *testing.T) {
+	SetNewCollationEnabledForTest(true)
+	defer SetNewCollationEnabledForTest(false)
+
+	tests := []struct {
+		collate string
+		pattern string
+		str     string
+		match   bool
+	}{
+		{"gbk_chinese_ci", "a%", "Abc", true},
+		{"gbk_chinese_ci", "中_", "中文", true},
+		{"gbk_chinese_ci", "😃", "😜", true},
+		{"gbk_bin", "a%", "Abc", false},
+		{"gbk_bin", "中_", "中文", true},
+		{"gbk_bin", "a", "a ", false},
+	}
+	for i, tt := range tests {
+		p := GetCollator(tt.collate).Pattern()
+		p.Compile(tt.pattern, '\\')
+		require.Equal(t, tt.match, p.DoMatch(tt.str), fmt.Sprintf("%d %s %s", i, tt.pattern, tt.str))
+	}
+}
+
 func TestSetNewCollateEnabled(t *testing.T) {
 	defer SetNewCollationEnabledForTest(false)
 
@@ -182,7 +365,11 @@ func TestGetCollator(t *testing.T) {
 	require.IsType(t, &generalCICollator{}, GetCollator("utf8_general_ci"))
 	require.IsType(t, &unicodeCICollator{}, GetCollator("utf8mb4_unicode_ci"))
 	require.IsType(t, &unicodeCICollator{}, GetCollator("utf8_unicode_ci"))
+	require.IsType(t, &unicode0900AICICollator{}, GetCollator("utf8mb4_0900_ai_ci"))
+	require.IsType(t, &unicode0900ASCSCollator{}, GetCollator("utf8mb4_0900_as_cs"))
 	require.IsType(t, &zhPinyinTiDBASCSCollator{}, GetCollator("utf8mb4_zh_pinyin_tidb_as_cs"))
+	require.IsType(t, &gbkChineseCICollator{}, GetCollator("gbk_chinese_ci"))
+	require.IsType(t, &gbkBinCollator{}, GetCollator("gbk_bin"))
 	require.IsType(t, &binPaddingCollator{}, GetCollator("default_test"))
 	require.IsType(t, &binCollator{}, GetCollatorByID(63))
 	require.IsType(t, &binPaddingCollator{}, GetCollatorByID(46))
@@ -191,7 +378,11 @@ func TestGetCollator(t *testing.T) {
 	require.IsType(t, &generalCICollator{}, GetCollatorByID(33))
 	require.IsType(t, &unicodeCICollator{}, GetCollatorByID(224))
 	require.IsType(t, &unicodeCICollator{}, GetCollatorByID(192))
+	require.IsType(t, &unicode0900AICICollator{}, GetCollatorByID(255))
+	require.IsType(t, &unicode0900ASCSCollator{}, GetCollatorByID(278))
 	require.IsType(t, &zhPinyinTiDBASCSCollator{}, GetCollatorByID(2048))
+	require.IsType(t, &gbkChineseCICollator{}, GetCollatorByID(28))
+	require.IsType(t, &gbkBinCollator{}, GetCollatorByID(87))
 	require.IsType(t, &binPaddingCollator{}, GetCollatorByID(9999))
 
 	SetNewCollationEnabledForTest(false)
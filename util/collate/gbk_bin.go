@@ -0,0 +1,61 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collate
+
+import (
+	"bytes"
+
+	"github.com/pingcap/tidb/util/encoding"
+)
+
+// gbkBinCollator implements gbk_bin, which compares the gbk encoding of the strings, the runes not in gbk are
+// compared as '?'.
+type gbkBinCollator struct {
+	enc *encoding.Encoding
+}
+
+// Compare implements Collator interface.
+func (gc *gbkBinCollator) Compare(a, b string) int {
+	a = truncateTailingSpace(a)
+	b = truncateTailingSpace(b)
+	var buf1, buf2 [4]byte
+	r1, r2 := rune(0), rune(0)
+	ai, bi := 0, 0
+	for ai < len(a) && bi < len(b) {
+		r1, ai = decodeRune(a, ai)
+		r2, bi = decodeRune(b, bi)
+		if r1 == r2 {
+			continue
+		}
+
+		e1, _ := gc.enc.EncodeRune(buf1[:0], r1)
+		e2, _ := gc.enc.EncodeRune(buf2[:0], r2)
+		if cmp := bytes.Compare(e1, e2); cmp != 0 {
+			return cmp
+		}
+	}
+	return sign((len(a) - ai) - (len(b) - bi))
+}
+
+// Key implements Collator interface.
+func (gc *gbkBinCollator) Key(str string) []byte {
+	str = truncateTailingSpace(str)
+	buf, _ := gc.enc.Encode(make([]byte, 0, len(str)), str)
+	return buf
+}
+
+// Pattern implements Collator interface.
+func (gc *gbkBinCollator) Pattern() WildcardPattern {
+	return &binPattern{}
+}
@@ -0,0 +1,88 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collate
+
+import (
+	"github.com/pingcap/tidb/util/stringutil"
+)
+
+// gbkChineseCICollator implements gbk_chinese_ci, which compares the weights of the characters in MySQL's order of
+// gbk, the runes not in gbk are compared as '?'.
+type gbkChineseCICollator struct {
+}
+
+// Compare implements Collator interface.
+func (gc *gbkChineseCICollator) Compare(a, b string) int {
+	a = truncateTailingSpace(a)
+	b = truncateTailingSpace(b)
+	r1, r2 := rune(0), rune(0)
+	ai, bi := 0, 0
+	for ai < len(a) && bi < len(b) {
+		r1, ai = decodeRune(a, ai)
+		r2, bi = decodeRune(b, bi)
+
+		cmp := int(gbkChineseCIWeight(r1)) - int(gbkChineseCIWeight(r2))
+		if cmp != 0 {
+			return sign(cmp)
+		}
+	}
+	return sign((len(a) - ai) - (len(b) - bi))
+}
+
+// Key implements Collator interface. The weights of the single byte characters are one byte as MySQL does, they
+// are less than the ones of the double byte characters, which are not less than 0x8100.
+func (gc *gbkChineseCICollator) Key(str string) []byte {
+	str = truncateTailingSpace(str)
+	buf := make([]byte, 0, len(str))
+	i := 0
+	r := rune(0)
+	for i < len(str) {
+		r, i = decodeRune(str, i)
+		u16 := gbkChineseCIWeight(r)
+		if u16 > 0xFF {
+			buf = append(buf, byte(u16>>8))
+		}
+		buf = append(buf, byte(u16))
+	}
+	return buf
+}
+
+// Pattern implements Collator interface.
+func (gc *gbkChineseCICollator) Pattern() WildcardPattern {
+	return &gbkChineseCIPattern{}
+}
+
+type gbkChineseCIPattern struct {
+	patChars []rune
+	patTypes []byte
+}
+
+// Compile implements WildcardPattern interface.
+func (p *gbkChineseCIPattern) Compile(patternStr string, escape byte) {
+	p.patChars, p.patTypes = stringutil.CompilePatternInner(patternStr, escape)
+}
+
+// DoMatch implements WildcardPattern interface.
+func (p *gbkChineseCIPattern) DoMatch(str string) bool {
+	return stringutil.DoMatchInner(str, p.patChars, p.patTypes, func(a, b rune) bool {
+		return gbkChineseCIWeight(a) == gbkChineseCIWeight(b)
+	})
+}
+
+func gbkChineseCIWeight(r rune) uint16 {
+	if r > 0xFFFF {
+		return '?'
+	}
+	return gbkChineseCISortKeyTable[r]
+}
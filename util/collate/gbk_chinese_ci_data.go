@@ -0,0 +1,278 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collate
+
+/* The weights of the runes in gbk_chinese_ci, they are derived from sort_order_gbk and gbk_order in strings/ctype-gbk.cc of MySQL. The runes not in gbk are weighted as '?'. Do not EDIT */
+var (
+	gbkChineseCISortKeyTable = [0xFFFF + 1]uint16{
+		0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1A, 0x1B, 0x1C, 0x1D, 0x1E, 0x1F, 0x20, 0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F, 0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37, 0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F, 0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47, 0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F, 0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x59, 0x5A, 0x5C, 0x5C, 0x5B, 0x5E, 0x5F, 0x60, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47, 0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F, 0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x59, 0x5A, 0x7B, 0x7C, 0x7D, 0x59, 0x7F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8243, 0x3F, 0x3F, 0x8245, 0x8144, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8247, 0x8183, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8248, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8184, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x82B3, 0x82B2, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x82C4, 0x82C3, 0x82C5, 0x3F, 0x82D3, 0x82D2, 0x3F, 0x3F, 0x3F, 0x3F, 0x82EF, 0x82EE, 0x3F, 0x3F, 0x3F, 0x8185, 0x3F, 0x8300, 0x82FF, 0x3F, 0x8301, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x82B5, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x82C7, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x82C6, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x82D5, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x82E9, 0x3F, 0x3F, 0x3F, 0x82EA, 0x3F, 0x3F, 0x3F, 0x3F, 0x82F1, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8303, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x82B4, 0x3F, 0x82D4, 0x3F, 0x82F0, 0x3F, 0x8302, 0x3F, 0x8307, 0x3F, 0x8304, 0x3F, 0x8306, 0x3F, 0x8305, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x82B6, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x82CD, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8148, 0x3F, 0x8146, 0x8147, 0x813A, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8149, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8312, 0x8314, 0x8316, 0x8318, 0x831A, 0x831C, 0x831E, 0x8320, 0x8322, 0x8324, 0x8326, 0x8328, 0x832A, 0x832C, 0x832E, 0x8330, 0x8332, 0x3F, 0x8334, 0x8336, 0x8338, 0x833A, 0x833C, 0x833E, 0x8340, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8313, 0x8315, 0x8317, 0x8319, 0x831B, 0x831D, 0x831F, 0x8321, 0x8323, 0x8325, 0x8327, 0x8329, 0x832B, 0x832D, 0x832F, 0x8331, 0x8333, 0x3F, 0x8335, 0x8337, 0x8339, 0x833B, 0x833D, 0x833F, 0x8341, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x834E, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8342, 0x8344, 0x8346, 0x8348, 0x834A, 0x834C, 0x8350, 0x8352, 0x8354, 0x8356, 0x8358, 0x835A, 0x835C, 0x835E, 0x8360, 0x8362, 0x8364, 0x8366, 0x8368, 0x836A, 0x836C, 0x836E, 0x8370, 0x8372, 0x8374, 0x8376, 0x8378, 0x837A, 0x837C, 0x837E, 0x8380, 0x8382, 0x8343, 0x8345, 0x8347, 0x8349, 0x834B, 0x834D, 0x8351, 0x8353, 0x8355, 0x8357, 0x8359, 0x835B, 0x835D, 0x835F, 0x8361, 0x8363, 0x8365, 0x8367, 0x8369, 0x836B, 0x836D, 0x836F, 0x8371, 0x8373, 0x8375, 0x8377, 0x8379, 0x837B, 0x837D, 0x837F, 0x8381, 0x8383, 0x3F, 0x834F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8104, 0x3F, 0x3F, 0x8105, 0x8106, 0x8107, 0x814A, 0x3F, 0x814B, 0x814C, 0x3F, 0x3F, 0x814D, 0x814E, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8249, 0x824A, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x824B, 0x3F, 0x814F, 0x8150, 0x3F, 0x8151, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x824C, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x82BB, 0x3F, 0x82BE, 0x3F, 0x3F, 0x3F, 0x82CA, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x82EB, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x82FC, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x825A, 0x8261, 0x8268, 0x826F, 0x8276, 0x827D, 0x8284, 0x828B, 0x8292, 0x8298, 0x829B, 0x829E, 0x3F, 0x3F, 0x3F, 0x3F, 0x8259, 0x8260, 0x8267, 0x826E, 0x8275, 0x827C, 0x8283, 0x828A, 0x8291, 0x8297, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x81BD, 0x81B7, 0x81B9, 0x81BB, 0x3F, 0x3F, 0x81BE, 0x81B8, 0x81BA, 0x81BC, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8186, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8187, 0x3F, 0x8188, 0x3F, 0x3F, 0x3F, 0x817B, 0x3F, 0x3F, 0x3F, 0x3F, 0x8189, 0x3F, 0x3F, 0x818A, 0x82AF, 0x818B, 0x818C, 0x3F, 0x3F, 0x818D, 0x3F, 0x818E, 0x3F, 0x818F, 0x8190, 0x8191, 0x8192, 0x8193, 0x3F, 0x3F, 0x8194, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8195, 0x8196, 0x8197, 0x8198, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8199, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x819A, 0x3F, 0x3F, 0x3F, 0x819B, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x819C, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x819D, 0x819E, 0x3F, 0x3F, 0x819F, 0x81A0, 0x81A1, 0x81A2, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x81A3, 0x81A4, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x817A, 0x3F, 0x3F, 0x3F, 0x817C, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x81A5, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x81A6, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x81A7, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8256, 0x825D, 0x8264, 0x826B, 0x8272, 0x8279, 0x8280, 0x8287, 0x828E, 0x8294, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8257, 0x825E, 0x8265, 0x826C, 0x8273, 0x827A, 0x8281, 0x8288, 0x828F, 0x8295, 0x8299, 0x829C, 0x829F, 0x82A1, 0x82A3, 0x82A5, 0x82A7, 0x82A9, 0x82AB, 0x82AD, 0x8258, 0x825F, 0x8266, 0x826D, 0x8274, 0x827B, 0x8282, 0x8289, 0x8290, 0x8296, 0x829A, 0x829D, 0x82A0, 0x82A2, 0x82A4, 0x82A6, 0x82A8, 0x82AA, 0x82AC, 0x82AE, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x81BF, 0x81C0, 0x81C6, 0x81C7, 0x81C1, 0x81C2, 0x81C8, 0x81C9, 0x81C3, 0x81C4, 0x81CA, 0x81CB, 0x81CD, 0x81CE, 0x81CF, 0x81D0, 0x81D5, 0x81D6, 0x81D7, 0x81D8, 0x81DD, 0x81DE, 0x81DF, 0x81E0, 0x81E5, 0x81E6, 0x81E7, 0x81E8, 0x81ED, 0x81EE, 0x81EF, 0x81F0, 0x81F1, 0x81F2, 0x81F3, 0x81F4, 0x81F8, 0x81F9, 0x81FA, 0x81FB, 0x81FC, 0x81FD, 0x81FE, 0x81FF, 0x8203, 0x8204, 0x8205, 0x8206, 0x8207, 0x8208, 0x8209, 0x820A, 0x820E, 0x820F, 0x8210, 0x8211, 0x8212, 0x8213, 0x8214, 0x8215, 0x8219, 0x821A, 0x821B, 0x821C, 0x821D, 0x821E, 0x821F, 0x8220, 0x8221, 0x8222, 0x8223, 0x8224, 0x8225, 0x8226, 0x8227, 0x8228, 0x3F, 0x3F, 0x3F, 0x3F, 0x81C5, 0x81CC, 0x81D1, 0x81D2, 0x81D3, 0x81D9, 0x81DA, 0x81DB, 0x81E1, 0x81E2, 0x81E3, 0x81E9, 0x81EA, 0x81EB, 0x81F5, 0x81F6, 0x81F7, 0x8200, 0x8201, 0x8202, 0x820B, 0x820C, 0x820D, 0x8216, 0x8217, 0x8218, 0x8229, 0x822A, 0x822B, 0x81D4, 0x81DC, 0x81EC, 0x81E4, 0x822C, 0x822D, 0x822E, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x822F, 0x8233, 0x8235, 0x8237, 0x8239, 0x823B, 0x823D, 0x823F, 0x823E, 0x823C, 0x823A, 0x8238, 0x8236, 0x8234, 0x8230, 0x3F, 0x3F, 0x3F, 0x8240, 0x8231, 0x8232, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x81A8, 0x81A9, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x81AA, 0x81AB, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x81AC, 0x81AD, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x81AE, 0x81AF, 0x3F, 0x3F, 0x3F, 0x81B0, 0x3F, 0x3F, 0x81B1, 0x81B2, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x81B3, 0x81B4, 0x81B5, 0x81B6, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8250, 0x824F, 0x3F, 0x3F, 0x8251, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8252, 0x3F, 0x8253, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x810B, 0x8122, 0x8125, 0x8176, 0x3F, 0xDE7E, 0x8177, 0x8100, 0x8158, 0x815A, 0x815C, 0x815E, 0x8160, 0x8162, 0x8164, 0x8166, 0x8168, 0x816A, 0x824D, 0x824E, 0x816D, 0x8170, 0x8172, 0x8173, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8174, 0x8175, 0x3F, 0x3F, 0x825B, 0x8262, 0x8269, 0x8270, 0x8277, 0x827E, 0x8285, 0x828C, 0x8293, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8385, 0x8387, 0x8389, 0x838B, 0x838D, 0x838F, 0x8392, 0x8394, 0x8396, 0x8398, 0x839B, 0x839D, 0x839F, 0x83A1, 0x83A3, 0x83A5, 0x83A8, 0x83AA, 0x83AC, 0x83AE, 0x83B0, 0x83B2, 0x83B4, 0x83B6, 0x83B8, 0x83BA, 0x83BC, 0x83BE, 0x83C0, 0x83C2, 0x83C4, 0x83C6, 0x83C8, 0x83CA, 0x83CC, 0x83CE, 0x83D0, 0x83D2, 0x83D4, 0x83D6, 0x83D8, 0x83DA, 0x83DC, 0x83DE, 0x83E0, 0x83E2, 0x83E4, 0x83E6, 0x83E8, 0x83EA, 0x83EC, 0x83EE, 0x83F0, 0x83F2, 0x83F4, 0x83F6, 0x83F8, 0x83FA, 0x83FC, 0x83FE, 0x8400, 0x8402, 0x8404, 0x8406, 0x8408, 0x840A, 0x840C, 0x840E, 0x8410, 0x8412, 0x8414, 0x8416, 0x8418, 0x841A, 0x841C, 0x841E, 0x8420, 0x8422, 0x8424, 0x8426, 0x8428, 0x842A, 0x842C, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8109, 0x810A, 0xDE82, 0xDE83, 0x3F, 0x3F, 0x8384, 0x8386, 0x8388, 0x838A, 0x838C, 0x838E, 0x8391, 0x8393, 0x8395, 0x8397, 0x839A, 0x839C, 0x839E, 0x83A0, 0x83A2, 0x83A4, 0x83A7, 0x83A9, 0x83AB, 0x83AD, 0x83AF, 0x83B1, 0x83B3, 0x83B5, 0x83B7, 0x83B9, 0x83BB, 0x83BD, 0x83BF, 0x83C1, 0x83C3, 0x83C5, 0x83C7, 0x83C9, 0x83CB, 0x83CD, 0x83CF, 0x83D1, 0x83D3, 0x83D5, 0x83D7, 0x83D9, 0x83DB, 0x83DD, 0x83DF,
+		0x83E1, 0x83E3, 0x83E5, 0x83E7, 0x83E9, 0x83EB, 0x83ED, 0x83EF, 0x83F1, 0x83F3, 0x83F5, 0x83F7, 0x83F9, 0x83FB, 0x83FD, 0x83FF, 0x8401, 0x8403, 0x8405, 0x8407, 0x8409, 0x840B, 0x840D, 0x840F, 0x8411, 0x8413, 0x8415, 0x8417, 0x8419, 0x841B, 0x841D, 0x841F, 0x8421, 0x8423, 0x8425, 0x8427, 0x8429, 0x842B, 0x8390, 0x8399, 0x83A6, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0xDE7F, 0xDE80, 0xDE81, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x842D, 0x842E, 0x842F, 0x8430, 0x8431, 0x8432, 0x8433, 0x8434, 0x8435, 0x8436, 0x8437, 0x8438, 0x8439, 0x843A, 0x843B, 0x843C, 0x843D, 0x843E, 0x843F, 0x8440, 0x8441, 0x8442, 0x8443, 0x8444, 0x8445, 0x8446, 0x8447, 0x8448, 0x8449, 0x844A, 0x844B, 0x844C, 0x844D, 0x844E, 0x844F, 0x8450, 0x8451, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0xCA27, 0x923C, 0xB7BF, 0xBB7C, 0xC25A, 0xA8CC, 0xB248, 0x8518, 0xA0BF, 0xB9D9, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0xD3AF, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0xD225, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x82E4, 0x82DA, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x82E6, 0x82BD, 0x82DB, 0x3F, 0x3F, 0x82E3, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x82BC, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x82DC, 0x3F, 0x3F, 0x82DF, 0x82E0, 0x3F, 0x3F, 0x82E5, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0xCA26, 0x9021, 0xA2CD, 0xB247, 0xB8C5, 0xC3DC, 0xADDA, 0xC0A1, 0xD114, 0xB7BE, 0xB8C6, 0xC3DD, 0x9C0F, 0x8870, 0xCDC5, 0xAC98, 0x94D1, 0x8BBE, 0x8BBF, 0xD435, 0xB457, 0xB0D4, 0xBA14, 0xBA15, 0xB518, 0x87C7, 0xC9EA, 0x8D15, 0x904E, 0xBB49, 0x8ABC, 0x9049, 0xB519, 0xA763, 0x904A, 0xCD28, 0xA764, 0xC845, 0x87DF, 0xB7E2, 0x97A2, 0xA0A9, 0x95BC, 0xC7D9, 0xD469, 0xD321, 0x9CBF, 0x9F9C, 0x9371, 0x9706, 0x8C42, 0x89AF, 0xA7E1, 0xD4B9, 0xD3E5, 0x97F6, 0xC072, 0x8E24, 0xC0FA, 0xD3E6, 0xA046, 0xA66F, 0xA13D, 0xB184, 0x93F3, 0xCA4E, 0xCABF, 0xADFE, 0xC258, 0xA0C0, 0xA0C1, 0xBFEB, 0xAB8A, 0xCAC0, 0x9938, 0xD232, 0xC220, 0xD084, 0x99AE, 0x9250, 0xA599, 0xCBBD, 0xB1A2, 0xB027, 0xB41E, 0x99F5, 0x96DC, 0x8AC4, 0x8ACB, 0xCA9B, 0xCB8C, 0xC064, 0xACCC, 0xA0BE, 0xB2D4, 0xC9E1, 0xC334, 0xC4A6, 0x94D2, 0xA0C2, 0x980C, 0x9939, 0xBA82, 0x9091, 0xBA05, 0x9C15, 0xAE2E, 0xA28C, 0xA341, 0xBF0D, 0xAD93, 0xAFBD, 0xAADE, 0xAA3E, 0x88A9, 0xB744, 0xC75C, 0xC87F, 0xB0D2, 0xB826, 0xADE2, 0xB36F, 0xBBA7, 0x920F, 0x8D70, 0x892D, 0xB377, 0xD2C9, 0x974E, 0x94F4, 0xAA40, 0xA809, 0xCB26, 0xA1C9, 0xA7AE, 0xAAC9, 0xCD67, 0xD1F6, 0xBA1C, 0xBA1F, 0x923B, 0x8C01, 0xCD65, 0xA3F6, 0xCD66, 0xCF1F, 0x9A01, 0xB272, 0xC259, 0xA045, 0xBB7A, 0xBC59, 0x95CA, 0x95EF, 0xC809, 0xC562, 0xC80D, 0xB27A, 0xC810, 0x9C7A, 0xBF50, 0xC0B5, 0xA2BF, 0x8DEE, 0x9EB6, 0x9802, 0xCACB, 0x89AC, 0x9921, 0xADAA, 0xC9E3, 0xC4CD, 0xA021, 0xBEEA, 0xA76F, 0xC4CE,
+		0xA025, 0xC9F1, 0xB474, 0x881B, 0xCD51, 0xC5AF, 0x8E4C, 0xA6FB, 0x917D, 0xC166, 0xB691, 0xB692, 0x9C6F, 0xB650, 0xC0B6, 0xCAC1, 0xB9DB, 0xB693, 0xA597, 0x9022, 0xD02C, 0x9FD7, 0xB20B, 0x8B9C, 0x8519, 0xD10B, 0x9FBE, 0x9F9D, 0x87BE, 0xB6CD, 0x8CF1, 0x93BF, 0xBDAE, 0xAA4D, 0x920E, 0x88D0, 0xD4E4, 0xBA16, 0xBCBC, 0xD115, 0x9474, 0xC3EC, 0xC3ED, 0x9160, 0x994B, 0xBF16, 0xB6AB, 0xB32B, 0x94FE, 0xCAC6, 0x880C, 0x8DF7, 0xA883, 0xCA9D, 0x8A38, 0x89E7, 0xB798, 0xB8C7, 0xCA4F, 0xADBB, 0xABEE, 0xB6AC, 0x929F, 0x8A39, 0xC93E, 0xB36A, 0xD34B, 0xB129, 0xC20A, 0xC25C, 0x9E23, 0x9D88, 0xC99E, 0x9373, 0x88D1, 0xB6AE, 0xC0B9, 0x9360, 0x8F0A, 0x92D0, 0xD322, 0xB2D6, 0xB072, 0xCD69, 0x8FD8, 0x9141, 0xC286, 0xCACC, 0xC5F9, 0xA2C0, 0xCA2B, 0x9C71, 0x847C, 0xC25D, 0x9CD6, 0x93F6, 0x9251, 0xC65B, 0x9FED, 0xB0D5, 0x8E42, 0x93CD, 0xAF7E, 0xD34C, 0xCCE8, 0x9BDB, 0x9B58, 0xCDC6, 0x8D75, 0xCF22, 0xB7C9, 0xC127, 0x8C30, 0x8A3D, 0xC7EB, 0xB3A4, 0xB8AD, 0x89D4, 0xAA4E, 0x88D2, 0xC7C3, 0xC5FC, 0xC128, 0xD3F8, 0xD003, 0xC41E, 0xAF83, 0x880D, 0x9655, 0xAE97, 0xAE98, 0xC59A, 0x8593, 0xC6C8, 0xA82C, 0xD38B, 0xB91E, 0xB56F, 0xBB80, 0x8651, 0xBB81, 0x94AF, 0xB0D6, 0xCAD1, 0xBB82, 0xCAA0, 0xD1F7, 0x8FA2, 0x980E, 0xAAE6, 0x8E53, 0xD3F9, 0x8872, 0xB570, 0x8680, 0xD127, 0x8CDE, 0xC16A, 0x8F0B, 0xD3FA, 0xD604, 0xCD40, 0xC93F, 0xBE36, 0xD0DE, 0x98BC, 0x8697, 0xC005, 0xB8FA, 0xCD6A, 0xCAD2, 0x93C0, 0xD606, 0x9625, 0xAF43, 0xBF18, 0xAE99, 0xC3F2, 0xB598, 0xCCA0, 0xC051, 0xB334, 0xB9DE, 0xA263, 0x85C5, 0xB074, 0x9B40, 0x98F9, 0xA57D, 0xC4C4, 0x9594, 0xC91B, 0x8559, 0x9260, 0xAD31, 0x9D40, 0x923F, 0x87E0, 0x9C7B, 0x991C, 0x9BD6, 0x9770, 0xB5E5, 0xBE95, 0x9EE1, 0x8CE4, 0xCADE, 0xBA07, 0xC625, 0xB920, 0xBFF2, 0xA297, 0xD25F, 0x94BB, 0xA4B6, 0xCA58, 0x8B3A, 0xA393, 0x9725, 0xA676, 0xCB91, 0xBA20, 0xAC50, 0xD3A9, 0xC6CB, 0xCD41, 0x849D, 0xA99B, 0xAD96, 0x9214, 0xAA52, 0x906E, 0x895A, 0x8AFA, 0xC7C6, 0x95FB, 0xD35F, 0xCA30, 0xB726, 0x9E25, 0xC3B2, 0xBB87, 0x8DFA, 0xA9F8, 0xBCC9, 0x9EE2, 0xD18C, 0x8903, 0xB41F, 0xA3A2, 0x8965, 0xAF44, 0xAF5B, 0x9FF4, 0xC267,
+		0x998C, 0xA097, 0x8AEE, 0xD1DD, 0xD60C, 0x8BC2, 0xB475, 0xA9FA, 0xA11A, 0xBAD0, 0xBEFD, 0xB964, 0xBFF7, 0x8816, 0xAE17, 0xC4F6, 0x8736, 0xBFC5, 0xCDCB, 0xC37C, 0x8D3A, 0x919F, 0xB541, 0xC6B4, 0x973E, 0xA381, 0xC268, 0xA243, 0xCAED, 0x945D, 0xA754, 0xD5B4, 0xB43F, 0xA67E, 0xCCCA, 0x9BC4, 0xA064, 0xB3A6, 0xB7D6, 0xB071, 0xBBFD, 0x940C, 0xC2C1, 0xA64F, 0xAC9D, 0xB1A4, 0x85D8, 0xCD76, 0xBB8C, 0xC3B4, 0xC600, 0xC65C, 0xCDCC, 0x8F59, 0x8A3F, 0x8B9D, 0xD2B4, 0xC882, 0xA6EE, 0xA67F, 0xA4B7, 0xBB4F, 0x9DE7, 0xC65D, 0x945F, 0x9BE5, 0xA162, 0xC54E, 0xAFEF, 0x9E2D, 0x877D, 0x8C0B, 0x9313, 0x93B3, 0xC816, 0x84BB, 0x861B, 0xCDFF, 0xC5E5, 0x8688, 0x8B23, 0x89D6, 0xD247, 0x87E4, 0xA0D6, 0xC973, 0x8D78, 0xA6EF, 0xC086, 0xA4B8, 0x88D6, 0xD57D, 0x95BF, 0x96EA, 0x861C, 0xBE7F, 0xBA8C, 0xBA8D, 0xABEF, 0x8EBB, 0xBD2A, 0xA1DD, 0x8C64, 0xC63C, 0xB0A3, 0xBD9C, 0x99A6, 0xCAA9, 0xB24E, 0xBE40, 0x9515, 0xA067, 0x9FAE, 0xBC41, 0x8A0F, 0x9F64, 0x92D8, 0xD263, 0xA346, 0xA1AE, 0xD547, 0xA163, 0xB3A8, 0xAE80, 0xAA54, 0xD4A4, 0xC1F7, 0xAA8D, 0xBBAE, 0xA5FE, 0x9BC5, 0x9056, 0xD53B, 0x863E, 0xC269, 0xA0F5, 0xAE06, 0x889B, 0x9DEA, 0xD0A2, 0xC9D1, 0xD264, 0xB841, 0xB4C0, 0xB45D, 0xCC16, 0x8AA4, 0xB378, 0xC887, 0xB75C, 0xD355, 0x8C98, 0x9D8B, 0x9CEF, 0xC136, 0xCDD3, 0x87E8, 0xB789, 0xBE10, 0xC0DA, 0xB148, 0xC8C6, 0x9382, 0xBD9D, 0xC20F, 0x91D5, 0xC578, 0x8A46, 0xB99C, 0xA29A, 0x8F5F, 0xD613, 0x8937, 0xBEED, 0x8620, 0xC9F9, 0x9AD5, 0xC9AC, 0xD0E3, 0x8BC3, 0xC82A, 0xCD11, 0x9E2E, 0xC6BE, 0xD05C, 0x8CBB, 0x9486, 0x866F, 0xD2CA, 0xD56B, 0xACA1, 0x9C89, 0xCAAD, 0xC5A9, 0xC7AB, 0x8891, 0x90F5, 0x890B, 0xD199, 0xAFD3, 0xBF4B, 0xBF4C, 0x8621, 0xCFAA, 0xA922, 0x9F69, 0xC137, 0x9366, 0x89EE, 0xA420, 0xBBE9, 0xD2DB, 0xBC0D, 0xC3A9, 0x948C, 0xCED4, 0xB707, 0xA69E, 0xAF89, 0xCF5E, 0x9E90, 0xAACF, 0x85B4, 0x8F85, 0xBD78, 0x989F, 0x9F6E, 0xC2E3, 0xB88F, 0xB3AB, 0xA1ED, 0x88D8, 0x8C11, 0xB7CA, 0x8625, 0xC555, 0xCCD3, 0xC977, 0xBD5C, 0xBC99, 0xC948, 0x9259, 0x87E9, 0x9D51, 0x8DF6, 0xCFA0, 0xBD9F, 0x9685, 0x8798, 0x8BF5, 0xAFA2, 0x88B2, 0xA5CC, 0x8D63, 0xCCA5,
+		0xCFDE, 0xD571, 0xB0AA, 0xBBC1, 0x8507, 0x8C37, 0xCDDC, 0xD0A4, 0xD5AA, 0xB8B2, 0x8C55, 0xA07A, 0x8B5F, 0xB83E, 0x9852, 0xD0F8, 0xB4C9, 0xC832, 0x8F6E, 0xC5B7, 0xA927, 0x8626, 0xB179, 0x9FDD, 0xA742, 0xA9B0, 0xAB11, 0xB34C, 0xC402, 0xBD5F, 0xCC62, 0x9082, 0xD44F, 0xC4E9, 0xB894, 0xB427, 0xA0A0, 0xBFC8, 0xD5F4, 0xB225, 0xC2F9, 0xA56E, 0x8A07, 0x9733, 0xA780, 0xB261, 0x8EFF, 0x8988, 0xC150, 0x9C39, 0x8803, 0x9B7A, 0x8C40, 0xBECB, 0x8E69, 0x9EFA, 0xA0DE, 0xB81F, 0x9369, 0xC491, 0xA127, 0x91ED, 0x9EC5, 0x9E48, 0xBF2F, 0xA80B, 0x8831, 0x96B6, 0xC403, 0xBC1B, 0xC492, 0x9E7C, 0xAD13, 0xCA06, 0xA00A, 0x9D8F, 0xB449, 0xB13E, 0x9391, 0xD39D, 0x8489, 0xB7BA, 0xCA85, 0xA254, 0xAF61, 0x898E, 0xCB3E, 0x8E80, 0xA052, 0xC714, 0xA3AA, 0x9E09, 0x8C19, 0x8E3A, 0x9F00, 0xB83F, 0xCFA2, 0x88C9, 0x87A3, 0x84D0, 0xB731, 0xBD01, 0x8BB0, 0x896A, 0xA4F2, 0xAEA5, 0xA00F, 0xB3B6, 0xAC00, 0xC27B, 0xAF35, 0xB50F, 0xAEA6, 0x89FB, 0xA7D4, 0xA5D2, 0xAA0B, 0xA3D5, 0x85FD, 0xCE4E, 0x8764, 0xCFAF, 0xD30A, 0xBBA3, 0xCCF3, 0x988A, 0xB4F3, 0x8A97, 0xA6C3, 0xBDFC, 0xC15D, 0xA915, 0x8BFB, 0x899A, 0xB66A, 0xBAA8, 0x9BA2, 0xA6DB, 0xAA80, 0xCFB1, 0xAFA4, 0xBDA4, 0xC8AD, 0xA5DD, 0xAE3A, 0x9211, 0xC280, 0xCF43, 0xCFA4, 0xCE8E, 0xC647, 0x8B73, 0xD13A, 0xC648, 0xC3EF, 0x9723, 0x9119, 0xA30B, 0x911A, 0xAC9A, 0xBF97, 0x89E8, 0x9213, 0x911B, 0x9215, 0x9FC1, 0xBF99, 0xBB83, 0xC880, 0xC883, 0xBA0A, 0xBA67, 0x8E89, 0xB3FE, 0x908A, 0x9622, 0x98B1, 0xB935, 0x908B, 0x8571, 0xA038, 0x9623, 0x9B10, 0xB747, 0xC0B7, 0xAE70, 0xB5E3, 0xA765, 0xCD77, 0x8517, 0x95F6, 0xA8CB, 0xC2B2, 0x980D, 0xA4E4, 0x961B, 0xBE5C, 0x96E5, 0xC60A, 0x87C1, 0xB27B, 0xA15A, 0x8F96, 0xD4E8, 0xB209, 0xC943, 0x9DA1, 0xBA74, 0x9CFD, 0xCB27, 0x9D13, 0x89C2, 0xA08A, 0xAB6C, 0xB660, 0xADE5, 0xC842, 0xAB60, 0x9521, 0xB661, 0x8901, 0xA08B, 0x8902, 0xCF9C, 0x96CB, 0xA093, 0xAB6F, 0xD390, 0xAB71, 0x9645, 0xC6BB, 0xACA2, 0xAC62, 0xB705, 0xCBBC, 0xC596, 0xA295, 0xA227, 0xAF5A, 0xCA53, 0xAC2E, 0xBA27, 0x96E8, 0xABF5, 0xD341, 0xA164, 0xCE72, 0xAD21, 0xA363, 0xACEE, 0x9487, 0xC597, 0xAC7E, 0x87BD, 0x904F,
+		0xBD09, 0x9523, 0x93A0, 0x87C0, 0x9A06, 0x8B74, 0xA1CC, 0x9A07, 0xA3DA, 0xC9E4, 0xA5FD, 0xB016, 0x93F9, 0xAD01, 0x906D, 0xC44E, 0xA7BC, 0xB322, 0x9D9A, 0xA060, 0xBBD1, 0xABB9, 0xBF70, 0xB24F, 0x96A8, 0xD49A, 0xBBAF, 0xA068, 0xA755, 0xB4EB, 0x8FC3, 0xA83E, 0x9079, 0x9516, 0x9DEE, 0xCB9D, 0x8D2D, 0x8465, 0xA69F, 0x88D9, 0xAD33, 0xD49C, 0x8D64, 0xBB5D, 0x916C, 0xA00B, 0xA80C, 0xA80D, 0xAF36, 0xC30A, 0x90C3, 0x9CBD, 0x927B, 0x927C, 0x927D, 0x93B0, 0xA0EB, 0x8C02, 0xD1F5, 0x9374, 0xADBD, 0xD284, 0x93F7, 0x9375, 0xB1AC, 0x9379, 0xA26F, 0x9AD6, 0xA275, 0x94F5, 0x8F00, 0xB1CA, 0xB392, 0xC646, 0xA39E, 0xBF5E, 0x84DB, 0x8BCC, 0x9C11, 0x8E93, 0x981D, 0x9820, 0xCFE3, 0x8EAC, 0x8FBE, 0x8EAD, 0xB6A8, 0xB6A9, 0x8C48, 0x9326, 0xB452, 0xCAC3, 0x9C12, 0xA28D, 0xB3A3, 0x8D97, 0x8BD3, 0xC1C9, 0x9C16, 0x8E43, 0xC620, 0x9A4A, 0xC073, 0xA1CD, 0xA603, 0xCEE9, 0xA7BA, 0xA889, 0xD004, 0x9524, 0x8C59, 0x93FA, 0x8BCE, 0xB5CB, 0x8FC1, 0xB858, 0xAD02, 0xA82D, 0xD324, 0xB017, 0x8787, 0x9F55, 0x9F56, 0xB046, 0xA670, 0xB859, 0x8788, 0x89AD, 0xA048, 0x96BC, 0x95D0, 0x8ECE, 0x8C5B, 0xA3F7, 0xA370, 0x9181, 0x9240, 0xD2AB, 0xBAEF, 0xB61D, 0xB827, 0x8CE5, 0xA30C, 0x9F5A, 0x9785, 0x8CE6, 0x9786, 0xA270, 0x9182, 0x9CDF, 0xBE3E, 0xA04E, 0x9087, 0xAA8C, 0xD00C, 0xCE71, 0x8DB0, 0xC4F7, 0xA30D, 0xA49E, 0xB370, 0x895C, 0x8C5C, 0x96CE, 0x9E28, 0x8DB1, 0xA605, 0xBE07, 0x9314, 0xB1FC, 0x89B1, 0xB284, 0x8C5D, 0xD53C, 0x952F, 0xC066, 0x85C9, 0x9C21, 0x915A, 0xB4D2, 0xB88B, 0x90B1, 0x9E2F, 0x9CEA, 0x87F4, 0xC826, 0xA165, 0x9BEA, 0xB9A3, 0x9DEF, 0x9163, 0xBFA5, 0xC230, 0x96CF, 0x9488, 0xB9A1, 0x9E34, 0x9586, 0x8DD6, 0xA276, 0x8C5E, 0x8C2E, 0x89B8, 0xBFA6, 0xA9B1, 0xA614, 0xB0CA, 0xB86E, 0xB161, 0xA359, 0x9EF3, 0x96C5, 0xB408, 0xA1F4, 0x9A56, 0xD062, 0xD4CF, 0xA706, 0xA17D, 0xB0F0, 0xA89F, 0x9795, 0x9F01, 0x9796, 0x9E4C, 0x9E4D, 0xBD6A, 0x9BD1, 0x9D14, 0x9E57, 0xCB4B, 0x9E58, 0xD30B, 0x899B, 0xD5BD, 0xAD56, 0xA644, 0xD3DC, 0xA668, 0xC807, 0xB61C, 0x8591, 0x95F8, 0x9D3B, 0xC283, 0xAAE4, 0xA7BB, 0x9FEE, 0xA332, 0xC567, 0xD285, 0x906C, 0xD3FB, 0xAF84, 0x9F57,
+		0xB599, 0xB8E7, 0xCAD3, 0xD3A8, 0xAD5C, 0xA671, 0x9FF0, 0xA562, 0xA563, 0xA1AC, 0xA35E, 0xC91C, 0xC03D, 0xC54B, 0xAD97, 0xA3C1, 0x9F5B, 0xA7BD, 0x98BD, 0xBA21, 0xA30E, 0x9FF6, 0x956C, 0x8817, 0xAD06, 0x8B54, 0xA535, 0xCCCB, 0xCCCC, 0xAC9E, 0xA30F, 0xC781, 0xA1AF, 0xB4D3, 0xA9A0, 0x887A, 0xAC19, 0x896D, 0xA5A3, 0xA288, 0xACA3, 0x907C, 0xC6D8, 0xC6D9, 0xA28F, 0xC291, 0xCB07, 0xC784, 0xC1E8, 0xB9A4, 0xA56B, 0xADC8, 0xA9B2, 0xB17A, 0xBA45, 0x9C33, 0xB494, 0x9E92, 0x9EF4, 0xB621, 0xC4EA, 0xCB33, 0xA1F5, 0x926C, 0xA19A, 0xBF30, 0xA17E, 0x8E3B, 0xC585, 0xAAEE, 0xC789, 0xC78A, 0xAA1D, 0xA6B6, 0x8A56, 0xB66B, 0xB623, 0x85C3, 0xB8DF, 0xCF20, 0xA0AA, 0x85EC, 0x9624, 0xC282, 0xCF21, 0xAB48, 0x8692, 0x94D3, 0x94D4, 0x85C4, 0x8CF2, 0xCAC7, 0xC649, 0xB08F, 0xA0EE, 0xBDC6, 0x9595, 0xB21E, 0x91D0, 0xB04F, 0x9423, 0x9604, 0x8DD7, 0xA0DC, 0x960A, 0x867C, 0x9A49, 0x860F, 0xAE55, 0x8B25, 0x92BA, 0xA0CE, 0xCA50, 0xCF78, 0x9E9C, 0xA2C1, 0x9E9D, 0xA3C0, 0x99AF, 0xC3B1, 0xB571, 0x927E, 0x9771, 0xB45B, 0x88E3, 0xA3C4, 0x9300, 0x99BB, 0xCDD4, 0x977C, 0xA427, 0x9B71, 0x8E35, 0xA431, 0xA702, 0xA707, 0xBC38, 0x90C4, 0xA0E7, 0xB5B1, 0xC371, 0xB127, 0xB56D, 0xCA2D, 0xA2DB, 0xC884, 0x8723, 0xAEB2, 0xB57F, 0xB9D8, 0xC7C0, 0xB32A, 0xAED7, 0xB7A0, 0xD5A3, 0xB976, 0xC25B, 0x9B55, 0x8592, 0xBA19, 0xC372, 0xC0A2, 0x9A36, 0xC568, 0xC0A3, 0x8604, 0xD5A5, 0xD4A1, 0xC56B, 0x8E26, 0xAAE9, 0xAE18, 0x8E28, 0x9C8A, 0x8825, 0xBAF9, 0x8866, 0x9704, 0x872C, 0x8867, 0xD0DD, 0xA262, 0xA951, 0xCD29, 0xA97D, 0xC2BA, 0x96D2, 0xC20D, 0xC5A5, 0x9F4F, 0x9F50, 0xC168, 0xC93D, 0xB4FA, 0xD234, 0xAB61, 0xCC03, 0xC0D7, 0xB8E8, 0x9C78, 0xB628, 0xAA3D, 0x8B3B, 0xA1A2, 0xC5A1, 0xC6CC, 0x9FD8, 0xB629, 0xA41F, 0x9C7E, 0x91D6, 0xB4C1, 0xC2F1, 0xB7C4, 0x8A01, 0xC126, 0x91BE, 0xBED6, 0xA669, 0xD155, 0x9835, 0xA66A, 0xC7FE, 0xC7DA, 0xC8B8, 0xB900, 0x8F3A, 0xD07A, 0xB030, 0x8458, 0xB458, 0xC7EE, 0xD2AC, 0x8904, 0xAB30, 0xBE0E, 0xA604, 0xB904, 0x99A1, 0xBEDD, 0xD5CB, 0x8DB2, 0x9315, 0xCE98, 0x890C, 0xCE9E, 0xC4AB, 0xC888, 0xA6A0, 0xA1EE, 0xC3E3, 0x8F86, 0x8BD6, 0xA0D8, 0x9FDE,
+		0x84E2, 0x9782, 0xC8D7, 0xBB5E, 0xA6AF, 0x8A08, 0xA4EF, 0xA6B0, 0xC869, 0xC8A6, 0xCEC7, 0xBB47, 0x95F7, 0xA7DE, 0xB70A, 0xB5C9, 0xB5CA, 0xA343, 0xA5C6, 0x90D9, 0xC472, 0xD436, 0xB7C1, 0x88AA, 0x88AB, 0x88AC, 0x88AF, 0x8486, 0x8E15, 0xCD3D, 0x892E, 0x9C70, 0xCD26, 0xBB00, 0x929E, 0xBA69, 0x96E2, 0x852F, 0x9248, 0xB787, 0xBA1D, 0xBA86, 0xD4B4, 0xB5C0, 0xBA72, 0x8737, 0xC6CE, 0x9D78, 0xB01B, 0xBBE7, 0x9C7F, 0xC16F, 0xBBE8, 0x9008, 0xB77D, 0x8D27, 0xA35D, 0x9677, 0xA150, 0xA884, 0x96CC, 0x8EAE, 0xA35F, 0xD283, 0x9F15, 0xD139, 0x851B, 0x9023, 0xA304, 0xBCF3, 0x8B4D, 0xBA03, 0xCD3E, 0xB535, 0xB1E6, 0xC9EB, 0x9894, 0xBB4A, 0xBD58, 0x8B37, 0xA59A, 0x8FBF, 0x9C13, 0x910D, 0x993A, 0xACCD, 0xCDF0, 0xAB28, 0x8AF7, 0x95BD, 0xC6FE, 0xC960, 0xD522, 0x98BB, 0x9C72, 0x8FD9, 0x8D9A, 0xBF17, 0xAD1B, 0x999F, 0xA66D, 0xBF93, 0xC4E0, 0xD055, 0xC3DE, 0xC9E2, 0xA9F6, 0x8452, 0xAAD8, 0xAFD1, 0x9BCE, 0xCA2E, 0xA228, 0x8BC0, 0xA819, 0xBFD2, 0xCBBE, 0x930B, 0x8681, 0xB4B0, 0xB4B1, 0x9F9E, 0x8873, 0x93C6, 0x851F, 0x912D, 0x9327, 0x919C, 0x981B, 0xBEDA, 0xA333, 0xBB26, 0xB2DA, 0x994F, 0xD237, 0xCBEC, 0xC23F, 0xC240, 0x8A32, 0xADE7, 0xC775, 0xC2B7, 0x8C60, 0x9086, 0xC1CA, 0x999C, 0x999D, 0xC241, 0x956A, 0xC7DB, 0xA242, 0xA9F7, 0x91C4, 0x9593, 0xAB8C, 0x8DF1, 0xB2DB, 0x8ABF, 0xC242, 0x956B, 0x93CF, 0x9F16, 0xCF23, 0x8B38, 0xB979, 0xAE65, 0xBFD3, 0x9456, 0xCAD4, 0x8DF2, 0xAFD2, 0xA672, 0x8563, 0xCE91, 0x97BA, 0xC1CB, 0xB3BE, 0xC227, 0x91C8, 0xB9B1, 0xA1A3, 0xB08B, 0xC1CE, 0xAE69, 0xAAAE, 0xA885, 0xB653, 0xCCEB, 0x8F3E, 0xD360, 0xBA22, 0xD38C, 0xBED2, 0xC377, 0xCADF, 0xB2F8, 0xB1AD, 0xD529, 0x96CD, 0x8CB7, 0xC16B, 0xC6B8, 0x98B3, 0xAE3E, 0xC3A5, 0xB05F, 0xCAE0, 0xC4F4, 0xB921, 0x99B1, 0xAD36, 0x8DCD, 0xB5CC, 0xA13A, 0x981E, 0xCF7A, 0xBFF3, 0x9157, 0xB204, 0xB047, 0x8789, 0x9400, 0xC906, 0x98BE, 0xD057, 0x98BF, 0x97F8, 0xA0D1, 0xCCC7, 0x9478, 0x8DBF, 0xD38D, 0xC053, 0xA25F, 0x9656, 0xA260, 0xD618, 0x8875, 0xA8DF, 0x9050, 0xAF2C, 0xBCC0, 0xBB4D, 0xC41F, 0x9BE4, 0xB2FC, 0x9241, 0x91CB, 0x9729, 0xD087, 0xC37D, 0xCA60, 0xA7B6, 0xD4E9, 0xACCE,
+		0xAC2C, 0xD293, 0xC9A6, 0x9C1F, 0xD391, 0xA8DC, 0xBAD1, 0xCFA9, 0xC54C, 0xA2FF, 0x9B19, 0xA394, 0x9A70, 0xBDC8, 0xC424, 0x91CC, 0xC701, 0xC65E, 0x97BB, 0xC824, 0xA57E, 0xCA32, 0x845A, 0xB19B, 0xB951, 0xBF1C, 0x993D, 0xC64E, 0x9158, 0xC03E, 0x97F7, 0xCF90, 0xCD45, 0x901D, 0xAFF9, 0xC4CF, 0x8459, 0x95C9, 0xA3C5, 0xC800, 0x8DC0, 0xC4F8, 0x86A2, 0x9B5B, 0xAEC3, 0x9A37, 0xC627, 0xA3A4, 0x9172, 0xB20A, 0x9CE1, 0xAF5C, 0xAD94, 0xCC9D, 0x9898, 0xCE99, 0xA91B, 0xB205, 0xAB34, 0x9582, 0xAFB9, 0x8AFB, 0xB8EB, 0xA651, 0xADE3, 0xD5A6, 0x98CB, 0xA374, 0xC54F, 0xC47D, 0xA567, 0xB1F3, 0xD15C, 0xD05B, 0xA770, 0x8524, 0xACCF, 0xAA12, 0xBC42, 0x941C, 0x8869, 0x9810, 0x9922, 0x95E3, 0xBB32, 0x95BA, 0xCD4E, 0xC8C0, 0x965D, 0x965E, 0x8568, 0x9822, 0xBC96, 0x8C86, 0xCAF9, 0x845B, 0x9D66, 0xBF63, 0xC425, 0xC087, 0xA602, 0xC2C7, 0xBD76, 0xD610, 0xB546, 0x8A41, 0xC245, 0xCFF2, 0xC801, 0x9088, 0xB2DF, 0x8F22, 0xB4B4, 0xAACE, 0xAB85, 0x9615, 0xBE05, 0xA342, 0xA568, 0xA766, 0xBCAA, 0xCFF3, 0x9AAF, 0xA55E, 0xB829, 0x9C22, 0xD545, 0xC1FA, 0x93AE, 0xCBCC, 0x99F9, 0xB303, 0xBA75, 0xC109, 0xBAF0, 0x8A11, 0x921D, 0xA695, 0xB3FB, 0x84BC, 0xD00D, 0xCC9E, 0x8FAB, 0xCD5F, 0xBE80, 0xA4D1, 0xB842, 0xC2D4, 0xC035, 0x99BC, 0x8462, 0xD130, 0xAF75, 0xA329, 0xD4BE, 0xD4BF, 0xB8AF, 0x8F60, 0x9935, 0xA7E2, 0x8454, 0xC504, 0xC4AC, 0xBFD6, 0xC26B, 0xC1DA, 0x8D7D, 0x8FFA, 0x9686, 0xB2E2, 0xB2E3, 0xBDCD, 0x8E5B, 0x8E5C, 0xC046, 0xD530, 0x868D, 0x8D7E, 0x8C27, 0x98D1, 0xC802, 0xB2E4, 0xD160, 0x92E4, 0xA767, 0xC430, 0xB10B, 0xB843, 0xA492, 0xD00E, 0xCC1A, 0x96D0, 0xAFE1, 0xD002, 0xB7FE, 0xD44B, 0xAEFB, 0x97BE, 0xAA71, 0xAE79, 0x8F0F, 0xB5EE, 0xBD1B, 0x87FB, 0x903D, 0xA534, 0xC554, 0x95F1, 0xBD79, 0x8B5C, 0xBE12, 0x84B8, 0xA0B5, 0x8E62, 0xA261, 0xCCC1, 0xC175, 0xAE1E, 0xB890, 0xCE13, 0xD165, 0xA49B, 0x9F3E, 0x998E, 0x9838, 0x8FFD, 0xD36F, 0x8969, 0xC05F, 0xAFA7, 0xCE07, 0xCBA3, 0xCFAB, 0xC965, 0xC1FF, 0xACA4, 0x99D4, 0xCF4C, 0x8C3F, 0x9B6A, 0x9AB8, 0x9AB9, 0xC357, 0x98B6, 0x9C2A, 0xA429, 0xD342, 0xC10D, 0xB846, 0xC6E0, 0x9ADA, 0x9167, 0xAEFF, 0xC707, 0xA773,
+		0xCE14, 0xB7E3, 0x8B04, 0xB425, 0xC8CB, 0x8E31, 0xB08E, 0x88B0, 0xA610, 0xCC9F, 0xD05D, 0xC0DE, 0xACB3, 0xCC5E, 0xB083, 0xB0D3, 0xA409, 0x860E, 0xCE15, 0x95F2, 0xA924, 0xA387, 0xCFF6, 0x9BF2, 0xBE1D, 0xC97D, 0x9902, 0x8457, 0xC6E7, 0xB3CD, 0xB804, 0xCCA6, 0xBC11, 0x9980, 0xC57E, 0x8481, 0xBB3C, 0xAAD9, 0x8938, 0x9806, 0xA31A, 0x8DC4, 0xB7DB, 0x8A5D, 0xB74C, 0xBBD4, 0xC048, 0x9C34, 0xB03D, 0xC234, 0xB3AF, 0xBA46, 0x95A4, 0xD4F8, 0x9F3F, 0xA58E, 0xC1E3, 0xC058, 0xBB9B, 0x8B06, 0x9884, 0xBC9E, 0x9D92, 0x97F9, 0xBCB0, 0xB495, 0xAF01, 0x98B8, 0x8CF0, 0xB7B8, 0xAE76, 0x95F3, 0xADF6, 0x8F82, 0x8470, 0xB3C9, 0xBF12, 0x86C2, 0x84E4, 0x84E5, 0xA6FC, 0xD5CD, 0xD184, 0xAD77, 0xBB3F, 0xBBEA, 0xBD51, 0x8F15, 0xB262, 0x9F23, 0x8B7C, 0x9EC6, 0xA289, 0xBD60, 0xB870, 0x88EE, 0x9D59, 0x8468, 0xC559, 0xB17B, 0xA929, 0x94B4, 0x9696, 0xC514, 0x99C2, 0x9B7B, 0x97C7, 0xAFD5, 0xC404, 0xD015, 0x89F5, 0xC6A2, 0xB1E0, 0x8EE8, 0xAADA, 0xAAD1, 0x99DF, 0xA5F4, 0x90B3, 0x94B0, 0xBD69, 0xC9E7, 0x865A, 0xCC26, 0xB7B3, 0x9F24, 0xAC76, 0xC55A, 0x9A3E, 0xAAE1, 0xB65C, 0x8C28, 0xB09C, 0xA56F, 0xC55D, 0x9C3D, 0xD3F1, 0x8A2A, 0xA432, 0xD5D2, 0xC519, 0xBB65, 0x9888, 0xAAAD, 0xA787, 0xB42A, 0xC303, 0x8C1A, 0xBD21, 0x8E6C, 0x9916, 0xC7D5, 0x91BB, 0xD5F5, 0x926D, 0x8B0B, 0x9B33, 0x88C2, 0x8C54, 0x8D42, 0x8E6A, 0xCE3B, 0xA482, 0x8922, 0x9F28, 0xC9D4, 0xC304, 0xB310, 0x9886, 0xA708, 0xC6A6, 0x8EF3, 0x9B34, 0xCBDF, 0xB218, 0xA1C4, 0xB499, 0xC7B3, 0xAF05, 0xA94D, 0xBB66, 0xC8A1, 0xCC31, 0x8DC6, 0xD0B4, 0xAFB8, 0xD3A0, 0xA010, 0xAF63, 0x9B87, 0xC5BC, 0xB312, 0x91F6, 0xCFFA, 0xCA42, 0xBA57, 0x9F2B, 0xCEDD, 0x8474, 0xCCB0, 0xA205, 0xA3AD, 0xCDE7, 0xB084, 0x8ED8, 0x94B5, 0xC5EF, 0x9136, 0x8E81, 0xC5F0, 0xB7B4, 0xB0F4, 0xB132, 0xCBB7, 0xD5D3, 0xAF37, 0x8F33, 0xA52B, 0xBCE1, 0x9BF5, 0xB733, 0x987D, 0xC3E7, 0xCA16, 0x915F, 0xB140, 0xD378, 0x9D1B, 0xA014, 0x988C, 0xBE53, 0x89FC, 0xC78D, 0xAB87, 0x888A, 0xBE55, 0xA94F, 0x9B98, 0x8843, 0xCCF5, 0xAF12, 0xCBE2, 0x9A24, 0xABD9, 0x9948, 0xD172, 0xA632, 0xA8AD, 0x980A, 0xAE2F, 0xC52B, 0xAD3E, 0xC8EA, 0xA6CA, 0xA964,
+		0xA8F5, 0xAD51, 0x8E77, 0x8A98, 0xB197, 0xB134, 0xC4D8, 0x9C01, 0xAB89, 0xC3A1, 0x9180, 0xA38D, 0xC86D, 0x899F, 0xCC3F, 0xB675, 0x9020, 0xA49D, 0xBCE3, 0xC530, 0xA214, 0x8CB3, 0x9A7B, 0x9C07, 0xD459, 0xAF1B, 0xC531, 0x8890, 0xA63D, 0x89C8, 0x8971, 0xA6E1, 0xCB80, 0xAA6F, 0xAE30, 0xCFC3, 0xBBFC, 0x9937, 0xD03F, 0x9DE0, 0xCF8B, 0xD3F6, 0xA509, 0xAF21, 0xAE2D, 0xB652, 0xA8DB, 0xC0F9, 0x9B3D, 0xCB8D, 0xB536, 0xBB7B, 0xAF28, 0x9DE4, 0x9B3E, 0xC5FD, 0xCB8E, 0xAE12, 0xBFA2, 0xBFA3, 0x9142, 0xA2C3, 0xCE6F, 0xA094, 0xB147, 0xCF24, 0x8CF3, 0x99CA, 0x9B3F, 0xCE92, 0x919D, 0x97CE, 0xA473, 0x8C49, 0xBF0E, 0xBF6E, 0xC0FD, 0xAA4F, 0x97CF, 0xB646, 0xB6D4, 0xA82F, 0x96A5, 0x97D0, 0xBCED, 0x97D1, 0xBF6F, 0xCD46, 0x97D2, 0xCBC4, 0x9BC6, 0xB231, 0xCDD0, 0x9823, 0xCE9A, 0xAA56, 0xB5DD, 0xCDD5, 0xB4C3, 0x97D3, 0x8C34, 0xC10E, 0xCEA0, 0xB5DE, 0xA378, 0xB236, 0xCEA7, 0xCEA8, 0xC820, 0xBFB3, 0xBF84, 0xBF85, 0xBFA7, 0xAA47, 0x9B7F, 0xCB4C, 0xCEB9, 0xAA2B, 0xAA36, 0xBF91, 0xC808, 0xBF92, 0xBEFC, 0xB99E, 0xB21D, 0xA998, 0xA39F, 0xA0EC, 0xCF9D, 0xC0FC, 0x9579, 0xCDF1, 0xC221, 0x9743, 0xB12A, 0xCA51, 0x8F4F, 0xB32D, 0xB32E, 0xD1DA, 0xD4B0, 0x8E94, 0xB321, 0xC3DF, 0xB857, 0xA3D8, 0x8A02, 0xB275, 0xAEF4, 0xAD5D, 0x9C17, 0x9D60, 0xD288, 0xD289, 0x8584, 0xC780, 0xCAD5, 0xB4A4, 0x933B, 0xA229, 0xA334, 0xBFEA, 0x92BD, 0x9361, 0x864A, 0xBD18, 0xA296, 0x9A6F, 0xD607, 0xA335, 0x8698, 0xA049, 0x8F51, 0xA01E, 0x9CD8, 0xA3A0, 0x8B1B, 0xA01F, 0x9D97, 0xBD28, 0xA673, 0x8546, 0xC25E, 0x933C, 0xD47D, 0xB1D4, 0xB015, 0xBD75, 0xA444, 0xB576, 0xBD4B, 0xD260, 0xC008, 0x94EC, 0xB1AE, 0x8FA5, 0x96D3, 0xAE7C, 0xBCF6, 0xB0DA, 0xA08C, 0xC940, 0x93C1, 0x84DC, 0xA99C, 0xB51B, 0xADC0, 0xA2DC, 0x963E, 0xC75D, 0x8532, 0x8F3F, 0x8A4B, 0xA830, 0xD3FF, 0x9479, 0x99B2, 0xD2AD, 0x8C63, 0xA48F, 0xA912, 0xA913, 0xA954, 0x84DD, 0x8DFB, 0xB048, 0xACE3, 0xC628, 0x906F, 0x9CE2, 0x98FA, 0xAA10, 0x8CC0, 0x8B3D, 0xA5C9, 0x94BF, 0xCB92, 0x99A2, 0x910E, 0xD13D, 0x940D, 0x972A, 0xC96F, 0x9173, 0x9187, 0x9774, 0x8944, 0xC921, 0xCBC2, 0x9253, 0x9642, 0xCE95, 0x8FF1, 0xC56D, 0xA327,
+		0xB8BF, 0xBA6E, 0x91CD, 0x87E2, 0x8FA7, 0x9959, 0xC812, 0xA395, 0x8DD1, 0xA264, 0x8E97, 0xA271, 0x9870, 0xAE51, 0x84BA, 0xC60D, 0xC47E, 0xCED2, 0x85A2, 0x93C3, 0x8549, 0xCAFA, 0xCC07, 0x9842, 0xC6D2, 0x8C65, 0xB487, 0x95E4, 0x845C, 0x865E, 0x92CD, 0xB62A, 0xCCCE, 0xA246, 0x9D48, 0x8F5B, 0xAADB, 0xA55A, 0xA1A5, 0x8AC5, 0xB861, 0x9FCA, 0xD15D, 0xA7C4, 0xA7B9, 0xB232, 0x8ACC, 0x9A2E, 0x887B, 0xB9ED, 0xC782, 0x97BC, 0xA08E, 0xC9E5, 0xAEDB, 0x8F10, 0xCE08, 0x8880, 0xC818, 0xB5EF, 0xBC5F, 0xB10C, 0xB4C4, 0xC08C, 0xA16A, 0xAA6A, 0xD203, 0xA347, 0x8B90, 0x9058, 0x8E0A, 0xBD5A, 0x84BD, 0x889C, 0x8C0D, 0x865F, 0xA29B, 0xD268, 0x9176, 0xCB08, 0xD269, 0xCB09, 0xB069, 0x9C28, 0xD49B, 0xB28D, 0xB7F5, 0xA16B, 0xAE85, 0xA376, 0xA316, 0xBD77, 0xA447, 0xAEB5, 0x9DA3, 0x910F, 0x9FDB, 0x9531, 0xCE09, 0x91D7, 0xB0A5, 0x96AA, 0xBF9B, 0xA5FF, 0x92C4, 0xC7F3, 0xB3AA, 0xA448, 0x84CC, 0xB932, 0x918B, 0xAE57, 0xBF66, 0x8AD5, 0xCBA4, 0x9A8C, 0x86B6, 0xA73D, 0x97BF, 0x8FFE, 0xD44C, 0x99A8, 0x85DB, 0x85DC, 0xCD8B, 0x8F13, 0xAB56, 0x9F40, 0xB755, 0xC9FD, 0x95F0, 0xA291, 0xD54A, 0xCD8C, 0x9ADB, 0x91DE, 0xC978, 0xC8CC, 0x85F4, 0x8CC5, 0xAB9A, 0x8A05, 0x90DC, 0xC014, 0xB207, 0x93A5, 0xD357, 0x9FB2, 0x9FCF, 0x9936, 0x9536, 0x8C77, 0xA29D, 0xB1BF, 0xA5CA, 0xC641, 0x9AD2, 0xA5F6, 0x90FD, 0xC069, 0xC708, 0xC386, 0x9C96, 0xA3A7, 0xCC63, 0xBCC3, 0x8ADD, 0xCCD9, 0xA279, 0xBC12, 0xBC13, 0xB9F3, 0xAC73, 0xBCCA, 0xC1EA, 0x8ADE, 0xBF7D, 0xBD7C, 0xB632, 0xD344, 0xA6A7, 0xB0AB, 0x85B9, 0xB7B0, 0xCFD9, 0x9110, 0xBE70, 0xC26F, 0xD21F, 0xC785, 0x95A5, 0xD1E7, 0x8482, 0x9605, 0xC860, 0xA29F, 0xBE71, 0xCEA9, 0xC19C, 0xC5B8, 0xA8CE, 0x97FF, 0xA554, 0x89F6, 0xB0AC, 0x8668, 0x8A7A, 0xA9BB, 0xA986, 0xAFC4, 0xB3B2, 0xABA8, 0xAD78, 0xBFA8, 0xBB11, 0xBAB1, 0xA93D, 0x8B30, 0xAB14, 0x8756, 0xA07C, 0x8918, 0xBAE2, 0xD2EF, 0xD120, 0xA2A9, 0xCCA9, 0x8FB5, 0x8A87, 0xD271, 0x9D04, 0x97C5, 0xB3F5, 0xA007, 0x8F74, 0xB8B3, 0xADCB, 0x8D66, 0xC8D8, 0xBCCB, 0xD042, 0xB380, 0xB3E2, 0xA75D, 0xC17A, 0xD487, 0xB40C, 0xD044, 0xC6A7, 0xB896, 0xB897, 0x8540, 0xB227, 0xA3AB, 0x9066,
+		0x926E, 0xB63A, 0xAD7F, 0x9131, 0x9132, 0x8D91, 0x8F75, 0xB9A9, 0x9192, 0x9195, 0xBD35, 0x8F03, 0xADA8, 0x934C, 0x9AE7, 0xBD36, 0x8DC7, 0xCA02, 0xD422, 0x9E4E, 0x850F, 0xB3E8, 0x9C48, 0xB410, 0xA32C, 0xCB4D, 0xB11C, 0x86E7, 0x8FB6, 0x9E7F, 0xC9E9, 0xCCB1, 0x883C, 0xBD3B, 0xA51B, 0xA182, 0x9A71, 0x8EA4, 0xB674, 0xB3BB, 0xC78E, 0xA52C, 0xC367, 0x990A, 0x848A, 0xC7E7, 0x8EC9, 0x988D, 0xB757, 0xA015, 0xA5D3, 0xA3EC, 0xA965, 0xC86E, 0xBD43, 0xC161, 0x9A72, 0xA917, 0xA918, 0xB77E, 0xA6CB, 0xA801, 0xB676, 0x89A0, 0xC796, 0xC873, 0xA5BE, 0x8552, 0xC070, 0xBA11, 0xB694, 0xB7C8, 0xD46A, 0xD46B, 0xB97A, 0xCA2F, 0xAAE7, 0xA2FE, 0xD406, 0xD46E, 0x99D0, 0x99D1, 0xA468, 0xCA38, 0x99D5, 0xC6E1, 0xA46E, 0xBA7B, 0xAB44, 0x9133, 0xBA7E, 0xCA43, 0xD281, 0x9678, 0x8C03, 0x9E9E, 0x93A1, 0x8615, 0xD095, 0x8738, 0xBC3E, 0xB645, 0xA831, 0x947D, 0x8DB3, 0xC3E1, 0xC659, 0xC5CD, 0xAE4C, 0xC3EA, 0xA41A, 0xC2B1, 0xC062, 0xCECC, 0xAB62, 0xBBFF, 0x9155, 0x9156, 0xC9EF, 0xB4D0, 0xAFBC, 0x9646, 0x9647, 0xB305, 0xABFB, 0xAC27, 0xCBD9, 0x9BE0, 0x8A88, 0x8DED, 0xD02D, 0xBE5B, 0xBD08, 0x93CC, 0x96E1, 0xC95F, 0xC905, 0x9869, 0x9559, 0xB9AF, 0xBDAF, 0xBD0A, 0xBF51, 0xC87C, 0x867E, 0xCA52, 0xA38E, 0x9D3C, 0x9161, 0x9C0E, 0xA3D4, 0xCF45, 0x9D3D, 0x8520, 0x9207, 0xA6F1, 0x9AAB, 0x8F0C, 0xC881, 0xB056, 0xA1AB, 0xB27C, 0xAE09, 0x93B1, 0xC56E, 0x9363, 0x8F97, 0xC941, 0xA403, 0xD59C, 0x9AAD, 0xB2FD, 0xA269, 0xD088, 0x863B, 0xCAEE, 0x9E8E, 0xBDE1, 0xCFD6, 0x8647, 0xC2C8, 0x9B06, 0x9302, 0x8FC4, 0xC7CF, 0x8653, 0x8FAF, 0x8508, 0xB8F1, 0xC1E9, 0xB1F8, 0x8509, 0xC2A0, 0x850D, 0x9E93, 0xA703, 0x916B, 0xCF19, 0x9E94, 0xBA55, 0x936B, 0x9BF6, 0x86F7, 0xAA2C, 0x8A48, 0xAF8B, 0xAF7D, 0x902E, 0xADFF, 0xB32F, 0x9D94, 0x9F94, 0xA0C3, 0xAF96, 0x8957, 0x9892, 0xC3F0, 0x92A5, 0x9CC0, 0xBB35, 0xB725, 0x92E1, 0xC0D0, 0x994D, 0xD45B, 0x9475, 0xAAAF, 0x8E25, 0xB6B2, 0x93D0, 0xA05F, 0xC846, 0x97F3, 0xC1D7, 0xD325, 0xAFDD, 0x90E5, 0x9CD9, 0x986B, 0xD34D, 0xC961, 0x9FF1, 0xCF25, 0xACC6, 0x93C7, 0x8AF8, 0xA1D0, 0xD45D, 0xAF4A, 0xC8B9, 0xADE8, 0xC5DD, 0x933D, 0x8682, 0xCD6B,
+		0xC028, 0x9376, 0xC0A4, 0x92CA, 0xC25F, 0xCDF5, 0x9744, 0x90E8, 0x8533, 0xAE7A, 0xD37E, 0xD4B5, 0xD129, 0x8DCE, 0xAE03, 0xCECE, 0xBF56, 0xC421, 0xCAE1, 0x9197, 0xABC7, 0xAD5E, 0xB24B, 0x869C, 0xB922, 0xB459, 0x9198, 0x98C0, 0xC6B9, 0x9252, 0xD1F8, 0xACE4, 0x8595, 0xADAC, 0x93D1, 0xA832, 0xD523, 0xD524, 0xBA08, 0xB662, 0xB85D, 0xC907, 0xAAF5, 0x9F95, 0x9657, 0xBB84, 0xC638, 0xC12D, 0xD4EA, 0xA153, 0xB85E, 0xB188, 0xB6BA, 0xC970, 0x9070, 0x9E73, 0xBA8A, 0x9C80, 0x94C0, 0xC4E1, 0x9A38, 0xA193, 0x9EB8, 0x9643, 0xA57F, 0x9D9F, 0x9DA0, 0xCA61, 0xAEDA, 0xD262, 0xD1C1, 0x9C23, 0xC479, 0x9926, 0x972B, 0xA22B, 0xA38F, 0xC8BC, 0xAD32, 0xA7BE, 0xB076, 0x91CE, 0xCD47, 0xC84F, 0x895D, 0xB927, 0xCB93, 0xB9E6, 0x9775, 0xB5E7, 0xD4EB, 0xBBAC, 0xC0D8, 0x995A, 0xC03F, 0xA921, 0xC813, 0xB687, 0x9EB9, 0xA738, 0xB1A0, 0xC47F, 0xB8EC, 0xA652, 0x8AC6, 0xC5A6, 0xAB35, 0x93DB, 0xBC97, 0xADB3, 0xC131, 0xA313, 0x8CA3, 0x8CA4, 0xBEFE, 0xAEDD, 0xC62C, 0xAE1A, 0xCD7D, 0xADEB, 0xB1D7, 0xAE6A, 0xA194, 0xB92D, 0xD2BE, 0x9824, 0x8F5C, 0xD460, 0x91A0, 0xB1B8, 0xBFCC, 0xC480, 0xACA0, 0xC246, 0xC850, 0xC26C, 0xC2C9, 0xC854, 0xCD7E, 0xBB8D, 0xCD7F, 0xC043, 0xA689, 0xC429, 0xA0FE, 0xB5C2, 0xD47F, 0xB254, 0xC42A, 0xD4C0, 0x9059, 0x89D7, 0xA9A4, 0x846F, 0x9199, 0x91B9, 0xA923, 0xAC8A, 0x8D17, 0xB206, 0xA0FF, 0xB1DF, 0x889D, 0xA846, 0xC08D, 0x8777, 0xC505, 0xBAAE, 0xB2E5, 0x9B24, 0x9489, 0xC207, 0xC208, 0xBD2D, 0x92E5, 0x92E6, 0x9F6A, 0xBE5D, 0xAE86, 0xB5F0, 0xA06E, 0x9BAF, 0xA02B, 0xB344, 0x8FAC, 0xC63E, 0x9A15, 0xC067, 0xA4BE, 0x86AE, 0xCBA5, 0xD369, 0xAE5E, 0x948A, 0xA06F, 0xAA57, 0x84CD, 0xA4E8, 0xA449, 0xCBCD, 0xC819, 0xA100, 0xA696, 0x8F99, 0xC42B, 0x9A2F, 0x9A50, 0xCC1B, 0x897E, 0xB956, 0xBEEE, 0x8E9B, 0xC9AD, 0xC29A, 0xAE2C, 0xB78C, 0x9D7E, 0xBF4D, 0xC6E2, 0xCE16, 0xC0DF, 0x8F69, 0xB70E, 0xABBD, 0x8E32, 0xB75E, 0xB47A, 0x9B25, 0xC211, 0xB37C, 0x8C78, 0xACB5, 0x948D, 0x9F97, 0x90F6, 0xCA76, 0xD358, 0xAB9B, 0x9ADC, 0xAC8C, 0x84A4, 0xCC1C, 0xC709, 0x9F41, 0xC0E0, 0xABCE, 0xCED5, 0xD20B, 0xB521, 0xBE13, 0xC5B0, 0xC02B, 0xA73E, 0xAB77, 0xB666,
+		0xBB54, 0xB149, 0xC176, 0xC047, 0x8D3C, 0x99D6, 0x8500, 0x9F6F, 0x85DD, 0xC699, 0xBF4E, 0x9753, 0xD59E, 0xC97E, 0xB139, 0xC33A, 0xCEAA, 0xCC98, 0xB6E4, 0xB74D, 0x8B07, 0xA894, 0xABC0, 0xB007, 0x8502, 0xAAB0, 0x9649, 0xA42A, 0xB496, 0x9D8C, 0xB7F4, 0xD1D1, 0xCEAB, 0x9F42, 0xB6E8, 0xAD26, 0xCC23, 0x9C97, 0xBC14, 0xAEE8, 0xC436, 0xBDB6, 0xB035, 0xA53C, 0xAE59, 0x85D3, 0x8483, 0xB13A, 0xB192, 0xCB34, 0xB17C, 0xCE2F, 0xA5AD, 0xC72E, 0xAB15, 0xCA3D, 0xD0FA, 0xA2B3, 0xCCAA, 0xAEB7, 0xA61C, 0x8F2C, 0x9757, 0xC835, 0x9FE1, 0xD43B, 0x89F7, 0xD016, 0x9814, 0xAE73, 0xA591, 0xAD41, 0xD153, 0x9A1A, 0x9A1B, 0x850A, 0xB762, 0xB3E3, 0xAAB5, 0xB187, 0x966F, 0xC276, 0xB42B, 0xC02E, 0xD0D6, 0xACB9, 0xC43A, 0xC43B, 0xAD80, 0xA788, 0xA6F0, 0x9A5A, 0x975B, 0x8EF4, 0xD274, 0xC6A8, 0xCA41, 0x9A52, 0xC305, 0xA433, 0xB689, 0xC306, 0xC8DC, 0x8985, 0x9EC9, 0xABC2, 0x92B6, 0x926F, 0xC409, 0xCB4E, 0x9918, 0x9F2C, 0x92B8, 0xBA58, 0x86E8, 0xB899, 0xBC69, 0xB3E9, 0xA72E, 0xC719, 0xC5F1, 0xAEEB, 0x9067, 0xCB4F, 0x88B3, 0x848B, 0xAEDE, 0xAF38, 0xAAB1, 0xBEB8, 0x8BB2, 0xA016, 0x8CD4, 0xCDB0, 0xB195, 0xB6FE, 0xB734, 0xAE08, 0xC83C, 0xBD03, 0xCC37, 0xB3BC, 0xAEED, 0xCF04, 0xCC6C, 0xAC92, 0x867B, 0xAAB2, 0xB95F, 0xC644, 0xAEBF, 0x90C5, 0xA8CA, 0xCE89, 0xA51F, 0xC8EB, 0xBB05, 0xA874, 0x9F10, 0xAEDF, 0xA521, 0xC414, 0xCC40, 0xBB07, 0x9BA6, 0xB60B, 0xAC60, 0xA642, 0xAA2D, 0xC874, 0xD3DD, 0xA523, 0xD521, 0x9F51, 0xA1CA, 0xA1CB, 0xA351, 0xCF56, 0xD4E5, 0xD537, 0x8D94, 0xBC7D, 0x93FB, 0x880E, 0xD4E6, 0xC549, 0xC5FE, 0xAC25, 0xBB88, 0xBCEE, 0x85C6, 0x9CE0, 0x9658, 0xAF7F, 0xC75E, 0xCD42, 0xD446, 0x97FA, 0xAA22, 0xBC80, 0xAE3C, 0xACD0, 0x8D18, 0xB345, 0xBAAF, 0x8980, 0xC7E1, 0xD4F9, 0xAEA2, 0x93E9, 0xD502, 0xA61D, 0xC766, 0x8855, 0xB735, 0xADFD, 0xAF16, 0xAF17, 0xCC41, 0xAA2E, 0xAC87, 0xAF2B, 0xB706, 0xBCBD, 0x976C, 0xD09E, 0xB4F7, 0xCDC7, 0xBA6D, 0x849C, 0x9D3E, 0xBBC8, 0xC077, 0xB722, 0xC99F, 0x9950, 0xCA54, 0xA04A, 0xD493, 0xAC66, 0xD3E7, 0x8E95, 0x9955, 0xD546, 0x96E7, 0xD38E, 0x903A, 0xC085, 0xCA59, 0x85D5, 0xB9E2, 0xB9E3, 0x8B8F, 0xB94F, 0xA310,
+		0xC702, 0xBA28, 0xCD48, 0x9AAE, 0xCA6A, 0xBEB1, 0xBA0B, 0xC47A, 0x95FE, 0x8AC7, 0xB64A, 0x95FF, 0xC4FB, 0xCF98, 0xD08C, 0x8EBC, 0x9804, 0xC8C1, 0xC4FC, 0x9D49, 0xB953, 0x8A6B, 0xB6E0, 0x9B03, 0xAC6B, 0xA364, 0xA3B4, 0x8796, 0xBC08, 0x889E, 0xCFAD, 0x9CF0, 0xCE77, 0x9CF1, 0xCBCE, 0xAC6D, 0xA365, 0xB51F, 0x98FE, 0xD19D, 0x9E3A, 0x948E, 0xAF31, 0x87EA, 0x9A8D, 0xABCF, 0xB4AA, 0x982B, 0xCE17, 0xB9F0, 0xAF32, 0xA002, 0xAF47, 0xD2E3, 0xCDDD, 0x85DF, 0xA3B5, 0xAF33, 0xB4AC, 0xAD79, 0x894F, 0xA178, 0x96D1, 0xB4AD, 0x99C3, 0xC2A4, 0xA781, 0xB9F8, 0xAF34, 0xD0A5, 0xB959, 0xC155, 0xC598, 0xA3B6, 0x9B80, 0xA789, 0xA255, 0x9A95, 0xCB5F, 0xCA8E, 0x85E9, 0xB483, 0x8B91, 0x85EA, 0x939A, 0x8D99, 0x9118, 0xBB7E, 0xC79B, 0x8EB8, 0xAA0F, 0x911C, 0xBA71, 0xB1E7, 0x937B, 0xD437, 0x93DC, 0xB905, 0xA314, 0x9E74, 0x9EA4, 0xD439, 0xC171, 0xD5E9, 0xC7AC, 0xBADC, 0x9123, 0x8EC7, 0xC53F, 0x9F52, 0xB8E6, 0x9229, 0x922A, 0x922B, 0x94B7, 0x9D95, 0xBAAC, 0x8A63, 0xB8C8, 0xB8C9, 0xAD3F, 0x94B2, 0x89ED, 0xA7B3, 0xC45A, 0xC45B, 0xA456, 0xCCFB, 0xC0B0, 0xCCFC, 0xA7AF, 0xA7B0, 0xC96C, 0xAB2C, 0xC0B1, 0xC0B2, 0xC0B3, 0x94B9, 0xC96D, 0x9188, 0xA426, 0xD343, 0xA0DA, 0x94F6, 0x968B, 0x94F7, 0xBFBD, 0x94FB, 0x94FC, 0xB9AE, 0xCBEA, 0x8B36, 0xA2CB, 0xAE7B, 0x9FEF, 0xC129, 0xAEEE, 0xA118, 0xB135, 0x8923, 0xC374, 0x866E, 0xA0EF, 0x9FA4, 0xBE64, 0xB577, 0xBE3D, 0x9FA5, 0xC22A, 0x8FD6, 0xB9B7, 0xBA0C, 0xB1B2, 0x9C24, 0xC5A7, 0xD1BD, 0xC5A8, 0xAE81, 0xD0CA, 0xC2CA, 0xC2CB, 0xAB06, 0x919A, 0xA945, 0xB1BA, 0xBE44, 0x9317, 0xD3ED, 0xC5B1, 0xBF79, 0xAA01, 0xAA04, 0xC35C, 0x8924, 0xAA09, 0xA17F, 0xC5BD, 0xA189, 0xA1C7, 0xA79B, 0xA1C8, 0xD3F5, 0xC5D8, 0x8A49, 0xBFDB, 0xAEAC, 0xB854, 0xC03C, 0xC3EE, 0xA66B, 0x91C0, 0x8EAF, 0x9B56, 0xA8DE, 0xCACD, 0xB2D7, 0xB6AF, 0xC287, 0x983D, 0xB91C, 0xCDC8, 0x8BCD, 0xBC5A, 0xB2D8, 0xCA25, 0xCEEB, 0x8585, 0xC9A0, 0x84D5, 0xC7EC, 0xC289, 0x9F58, 0x91C2, 0x9C73, 0xB335, 0x933E, 0xC078, 0xB276, 0x891E, 0xB36B, 0xB277, 0x8959, 0x9F9F, 0xB572, 0x9526, 0xC473, 0x8504, 0xA4E5, 0x8EB9, 0x8521, 0xD609, 0xD60A, 0xC942, 0xA154, 0x9529,
+		0xA305, 0x9633, 0xC75F, 0xB1D5, 0xA677, 0xBE9D, 0xB578, 0xC84A, 0x9401, 0xC679, 0x9D62, 0xA833, 0xC009, 0xB100, 0x8506, 0x8DFC, 0xA3DC, 0xCEEE, 0xB579, 0x9A0D, 0xB1ED, 0xACE5, 0x84C5, 0xBE9E, 0xA834, 0x8B1D, 0xB1AF, 0x9051, 0x892B, 0xA3F8, 0x85A1, 0xAB64, 0xBF1A, 0xC760, 0xCAE2, 0x8734, 0x98C3, 0xA311, 0xAA99, 0x91CF, 0x947E, 0xC7A1, 0x8FF2, 0xA99F, 0x920B, 0x9217, 0x94C1, 0xB5DA, 0x9071, 0xCA62, 0xADAE, 0xB9E7, 0x849E, 0xC102, 0x9A85, 0xD2B5, 0xAC69, 0xA650, 0x9CE3, 0x9072, 0xC103, 0xCD49, 0x967E, 0xC3B5, 0xA7BF, 0xC971, 0x9F17, 0xD1FC, 0xAA23, 0x9EBA, 0x91A1, 0x91A2, 0xCE00, 0xC56F, 0x885C, 0xB442, 0xB647, 0x9380, 0x9381, 0xAE41, 0xA653, 0xCD08, 0xC481, 0xB6E1, 0x8EBD, 0xB92E, 0x8AC8, 0xBF71, 0x95E5, 0xA247, 0x956F, 0xC3BA, 0xCBC5, 0xCDD1, 0xA55B, 0xA2A8, 0xA569, 0xA4B9, 0xC452, 0xB62B, 0xA348, 0x8B86, 0x8B87, 0xBCD4, 0xA7E3, 0x9A4E, 0xA101, 0xA4BF, 0xB28E, 0xACF0, 0xA44A, 0xA44B, 0xD5A7, 0x96AB, 0x8D62, 0xC7F4, 0xC7F5, 0x953B, 0xAA58, 0xAA59, 0xA847, 0xA1E1, 0x915B, 0xD204, 0x97C0, 0xCBCF, 0x907D, 0x9826, 0xD205, 0xC138, 0xC53B, 0xB10D, 0xC82B, 0xBBB1, 0x9F6B, 0x8654, 0xD5A8, 0xA377, 0x905A, 0xD0CC, 0x96AC, 0xCBD0, 0xD4F2, 0xD033, 0x9ADD, 0xCD8D, 0xC061, 0xC92A, 0x9386, 0xB553, 0xC92B, 0xBE14, 0xCAAE, 0xD2DC, 0xBA3E, 0xCF9A, 0xC9AE, 0x91DF, 0xD417, 0xA292, 0xAA16, 0xC88E, 0xABBE, 0x982C, 0x9C2B, 0x9C2C, 0x9ABA, 0xBEEF, 0xB9A7, 0xAB9C, 0xB3AC, 0xC29B, 0xCD8E, 0xD54B, 0xA4E9, 0xA308, 0xC85B, 0xC85C, 0xC13F, 0xD54C, 0x8949, 0xBC60, 0xB6E5, 0xA2E7, 0xB47B, 0xCD8F, 0xB29B, 0xA93B, 0xBF7E, 0x9111, 0xC2F2, 0xC1EB, 0x88DA, 0xBD7D, 0xB6E9, 0x9F73, 0x8466, 0xA895, 0xC2A1, 0xBBB5, 0xB404, 0xD4FA, 0xC10F, 0x8658, 0x8F87, 0x8DA5, 0xB396, 0xCCDA, 0xAF02, 0x8DA6, 0x9CCA, 0xB9F4, 0xB790, 0xBBC3, 0xD554, 0x9EAC, 0xA782, 0xA2B4, 0x89B9, 0x900D, 0x891C, 0x9032, 0xBF6A, 0xA93E, 0xD121, 0xD0D1, 0xD0D2, 0x84E6, 0x88EF, 0xB587, 0xB3D1, 0x8D67, 0xD5D1, 0x8EC4, 0x8EC5, 0xC33E, 0xCE30, 0xB080, 0xA8ED, 0xC4EB, 0x8925, 0x8804, 0xB47E, 0x9ECA, 0xC838, 0xA570, 0xD0EA, 0xA7F0, 0xA78A, 0xA78B, 0x9FD2, 0x8F04, 0x9193, 0xD5EA, 0x9F29,
+		0xA1F9, 0xC98E, 0x9ECB, 0xC98F, 0xA1FA, 0xD0B5, 0xCB50, 0xC767, 0xAE49, 0xCA11, 0xCA12, 0xCA88, 0xAF0C, 0xC463, 0x9CA2, 0xC5C1, 0xA303, 0x975C, 0x8F7E, 0x8510, 0xD5E2, 0xC0F1, 0xCA8F, 0xB6FF, 0x8ECA, 0xA882, 0x9F8A, 0xCDE8, 0xCF07, 0xCBFC, 0xB723, 0x9F8F, 0xA6BC, 0x9764, 0xA8F6, 0xA8F7, 0x8F8D, 0xCC77, 0xC329, 0xA134, 0x89A1, 0xCC91, 0xA401, 0xC86F, 0xC0F6, 0xAE4F, 0xB60C, 0x8A37, 0x8D57, 0xAA2F, 0x8F91, 0x8F92, 0xAF1D, 0xC875, 0xC876, 0xC8B2, 0xA41D, 0xC877, 0x8C2A, 0xA39D, 0x8C2B, 0xD35C, 0x9ACD, 0xA020, 0xC79C, 0x8A24, 0x8A25, 0xA7D2, 0x95F4, 0xD603, 0xB43B, 0xA151, 0x9612, 0xA340, 0xC229, 0xB211, 0xB212, 0x895E, 0xB554, 0xB55E, 0x9CBE, 0xCA9C, 0xBB79, 0x851A, 0xD238, 0xD12A, 0xC4E2, 0xCA6B, 0x9FD9, 0xBC94, 0xB61E, 0xB0D0, 0xC7D0, 0x9FBD, 0x93F4, 0xCF77, 0x8693, 0xBA17, 0x8871, 0x9024, 0xBAF6, 0x9268, 0xAEF3, 0xB9B0, 0x9328, 0xAFEA, 0xD28A, 0xC2B8, 0x9A09, 0x8E54, 0xC0FE, 0xD117, 0xBD9B, 0x8DFD, 0xAD5F, 0xB075, 0xAFEB, 0xBED3, 0x9402, 0xA6F3, 0xD2AE, 0xD383, 0x8811, 0xD2AF, 0x8F5A, 0xAD67, 0xCAEF, 0xCAF0, 0xB1B3, 0xB323, 0xA1A4, 0xB727, 0xBAF7, 0x8E01, 0xD229, 0xBB1E, 0xB443, 0xD191, 0xB9BB, 0xB64B, 0xC336, 0x85A3, 0x8E08, 0x974B, 0x8B9E, 0xB1BB, 0xD11C, 0x9DF0, 0xC068, 0x8E0B, 0xC10A, 0x89EF, 0xB844, 0xB28F, 0xD00F, 0x97D4, 0xAB78, 0x90DD, 0x998F, 0xD22C, 0xC69A, 0xAC71, 0xC110, 0xC212, 0x9431, 0xCB19, 0x85A8, 0xB1C0, 0x8FFF, 0x9606, 0xB008, 0x9B08, 0xBDB7, 0xAC74, 0x9D8D, 0xBDF2, 0x9B30, 0xD334, 0xB871, 0xAB16, 0xADCC, 0x8757, 0x97D7, 0xD017, 0xADCD, 0x85A9, 0xD122, 0xA053, 0x89BC, 0x9446, 0xD2F9, 0x99C6, 0x9270, 0xD471, 0x86D4, 0x86E0, 0xD110, 0xAC80, 0xB411, 0x897B, 0x934D, 0xAC07, 0x85AB, 0x8BB3, 0xACD8, 0x8BE9, 0x9F88, 0xC46A, 0xA4FE, 0x94E3, 0xB1AA, 0xAEC1, 0x9D96, 0x87DE, 0x87E1, 0xC639, 0x951B, 0xC95E, 0x9AAA, 0xCD3F, 0xCCEE, 0x9CC8, 0x9738, 0xB128, 0xBED7, 0xD02E, 0x9739, 0xD45C, 0xAB86, 0xB4EA, 0x8699, 0xB484, 0x9143, 0x8C52, 0x976F, 0xC7FF, 0x8564, 0x9FA0, 0xC6C9, 0xA952, 0xC260, 0xD45E, 0xA380, 0xCC13, 0x8F40, 0xB049, 0x8FA6, 0xC7DC, 0xACC7, 0x95D1, 0x8CE7, 0x9459, 0xBF1B, 0xB031, 0x930C,
+		0xC4C6, 0xCAA5, 0xD2B6, 0xBE96, 0xD2B7, 0xC65F, 0x90E9, 0xD611, 0xC4FD, 0xBF72, 0x977A, 0xA383, 0xABF6, 0xBEEB, 0xCD2D, 0x885D, 0x87E3, 0x8AF3, 0xA4C0, 0x86AF, 0x9C8B, 0x84A1, 0xBAD7, 0xA2B2, 0xCCA4, 0xC02A, 0xBBB2, 0xBAD8, 0xB4E4, 0xCE18, 0xCDD6, 0xACCA, 0xBBD5, 0x8910, 0xC4AF, 0x9319, 0xA0DB, 0x91E7, 0xC140, 0xA8CF, 0xC3E4, 0xA6FD, 0xA53D, 0xBBD6, 0xD2E4, 0x8883, 0xB4CA, 0xA0DF, 0xA0E0, 0x9FE2, 0x84E7, 0xA481, 0xA92A, 0xCC0A, 0xA783, 0x8E14, 0xA9BC, 0xCB35, 0x8BE4, 0x898F, 0xBF86, 0xBB67, 0xC5ED, 0xACCB, 0x8A09, 0xC277, 0x931D, 0x973B, 0xA355, 0xA3AE, 0x86E9, 0xB3EA, 0xC5C2, 0xA80F, 0xA810, 0xA79C, 0xA966, 0x9D28, 0xCC92, 0xC415, 0xBEE5, 0xCCBB, 0xA643, 0xBEE7, 0xCBE9, 0xC79A, 0xC843, 0xBEE9, 0x8F1C, 0xB1EC, 0x9E26, 0x9B41, 0xAE04, 0x9B42, 0x9611, 0xAED8, 0xA268, 0x872D, 0xCACE, 0xB2F4, 0xAF6E, 0x9362, 0xA13B, 0xC885, 0xCAF1, 0xCFD7, 0x86D5, 0xCAC2, 0xCA28, 0x923D, 0xB7C0, 0xBA1A, 0x923E, 0xBA3F, 0xBA47, 0x95F5, 0x8FD7, 0xCBEB, 0x9A02, 0x93F5, 0x994C, 0xC222, 0x8F50, 0x8B18, 0x9E9F, 0x8547, 0xB94C, 0x8F52, 0xD0F4, 0xD0F5, 0xBDB0, 0x945A, 0x8F41, 0xAC2F, 0xC422, 0x99CC, 0x8A19, 0xAF85, 0xA061, 0xD1C2, 0xCA63, 0xAC52, 0xA1AD, 0xC065, 0xB8CD, 0xB78A, 0xCE73, 0xA069, 0x8FC5, 0xD0F6, 0x9EA5, 0xB3E0, 0xB0A6, 0x8E5D, 0xB3E1, 0x86B7, 0x86B8, 0xB90B, 0xBD2F, 0x9DFE, 0x964A, 0x9588, 0x924C, 0x86C3, 0xA35A, 0x9E05, 0x8792, 0xC51F, 0x8E6D, 0x97C8, 0x9E80, 0x9978, 0xAC39, 0x97CC, 0xC06F, 0xA21C, 0x9CD1, 0x9CD2, 0x9742, 0x8E7B, 0xA99D, 0xA99E, 0xBFB5, 0x9B67, 0xD2DD, 0x9B72, 0x9B73, 0xCA89, 0xCA8A, 0xCA93, 0xCA94, 0xCEE5, 0xCEE6, 0xB855, 0xC623, 0xC1AE, 0xBF19, 0xC8BD, 0xC8BE, 0xCDFA, 0x8AFC, 0x889F, 0x874E, 0x8FC6, 0x8797, 0xB0A7, 0xCCDB, 0xB162, 0xD0FB, 0xCC8C, 0x8B16, 0x8B4C, 0xD4B1, 0xC027, 0x9C74, 0x92D2, 0xD326, 0xCAD6, 0xC0BA, 0x8A4A, 0x8685, 0x8F0D, 0xA835, 0x9403, 0xC0BF, 0xD1F9, 0x8D38, 0xC0C0, 0xA062, 0x8E02, 0xC2C2, 0xC7C7, 0x991D, 0xC922, 0x9A63, 0xAA11, 0x99A3, 0xC040, 0x8AEF, 0xD2BF, 0xC6B5, 0xA06A, 0xBF73, 0x8D16, 0xD23E, 0xA4BA, 0x8D19, 0x8EE3, 0xAFF0, 0xC355, 0xC358, 0x9CF2, 0x89F0, 0xD2CB, 0x8CF9,
+		0xD36A, 0xA4C1, 0xCE19, 0xC5AA, 0x9FB1, 0x9E35, 0xBA40, 0x9D7F, 0x873B, 0x9ADE, 0x948F, 0xC7AD, 0xC141, 0x85BA, 0xC97F, 0xC0E8, 0xC2F3, 0xD20E, 0xB17D, 0xBE21, 0x8EE9, 0xD212, 0xD214, 0x878C, 0x8EEA, 0x8B7E, 0x8A53, 0xC990, 0x9B88, 0x9F04, 0x9B39, 0xABAF, 0xA91F, 0xC4BA, 0x8600, 0xB5B2, 0xC5DB, 0xC5DA, 0x8694, 0xCAC4, 0xA59B, 0xB695, 0x8EB0, 0x9037, 0x94CD, 0x9CDA, 0xB69E, 0xB697, 0x89CB, 0xBD4A, 0xBDE4, 0xBDE5, 0x94E5, 0xCACF, 0xBA1B, 0x8D98, 0xD2A7, 0xC0D1, 0xAB29, 0xC2B3, 0x9269, 0xCC14, 0xBE7D, 0xACE6, 0xC1CC, 0xD328, 0x8B75, 0xC28C, 0x9C75, 0xC261, 0xC378, 0x9FA1, 0xCCE9, 0xC079, 0x8CF5, 0xBBA8, 0xA3A1, 0xCDF6, 0x872E, 0xD2A8, 0xB278, 0x8D76, 0x8A65, 0xBD0B, 0xBFDC, 0xB36C, 0xAED9, 0x9BBA, 0xC64C, 0xAF4E, 0xC0BE, 0xC3F3, 0xC5DE, 0xA2AF, 0x99B3, 0xA283, 0x9364, 0x9A62, 0xBD0D, 0xBBBC, 0xC262, 0xAFD9, 0x8A0C, 0x8C5A, 0xA155, 0xCAE3, 0x85D6, 0x8A1A, 0xACE7, 0xB060, 0xD60B, 0xD03B, 0xC955, 0xA361, 0x8596, 0xAF88, 0xAE3F, 0xD1FA, 0xAFEC, 0x8876, 0xBEC2, 0x96A6, 0x9A0E, 0xA15B, 0x8DCF, 0xA6F4, 0xBB50, 0xCD01, 0x8F56, 0x8E03, 0xCA5A, 0xBF61, 0xCD04, 0x93D5, 0x9C81, 0xB090, 0xC63A, 0xCED0, 0xAE7D, 0x96E3, 0x9404, 0xC379, 0x869D, 0xCCEC, 0xB45A, 0xC73B, 0x8CF8, 0x87CA, 0x9B00, 0xC776, 0x8C05, 0xB0DB, 0xBAD2, 0xC2C3, 0xBD1A, 0xCCC8, 0xD56A, 0x911E, 0xAD60, 0xA344, 0xCAE4, 0xBA29, 0xB6BD, 0xC7A2, 0xBA2A, 0xC37E, 0xA580, 0x9927, 0xA3C6, 0xAD98, 0xD294, 0xC570, 0xA73A, 0xBE97, 0x9B01, 0x8FF3, 0x9899, 0xA352, 0x9776, 0x9928, 0xB24C, 0xC54D, 0xBAD6, 0xBB51, 0x99B8, 0xB51C, 0xC957, 0x9B61, 0x9B43, 0x8B55, 0x9D67, 0xCA64, 0xC64F, 0x96E4, 0xA81A, 0x9B1A, 0xD53D, 0xC6CF, 0x8B40, 0xB8CA, 0xAF90, 0x9920, 0x9208, 0xA312, 0x9073, 0xBE66, 0x9600, 0xB5DB, 0xC2CC, 0xB324, 0xCEF1, 0xB093, 0xA328, 0x8EE2, 0x9B62, 0x91D1, 0xC4FE, 0xBF47, 0xC825, 0xA272, 0x8905, 0xAE52, 0xCF5A, 0xAB31, 0xCCD2, 0xCCCF, 0xCE74, 0xB0E2, 0xA465, 0xB400, 0xCEF3, 0xCE0A, 0xBF74, 0x9FAF, 0xC2D5, 0xD161, 0xA81B, 0xBE41, 0x9843, 0x989A, 0xB45E, 0xBE45, 0x887C, 0xCAFB, 0xB3A9, 0x9B4F, 0xC2CD, 0x861D, 0xAAF6, 0xCB0A, 0x9923, 0xBBBD, 0xB5DC, 0x8AF0, 0xA3F9,
+		0xC292, 0xC293, 0xCCEF, 0xA60C, 0xA54F, 0x9AB4, 0x8CFA, 0xCB0B, 0xCEF4, 0xA68A, 0xAF29, 0xAE53, 0x91D8, 0xB62C, 0xC72A, 0xB340, 0xC294, 0xAD09, 0x8D1A, 0x9303, 0x8608, 0x8EE5, 0x8D7F, 0x8A12, 0xABE9, 0xA697, 0x9CF3, 0x970E, 0x9709, 0xC63F, 0x8ECF, 0xB255, 0xA349, 0xBE81, 0xAA5A, 0xC2E4, 0xA29C, 0x97A4, 0xAEB6, 0xB4D4, 0x8BA0, 0x912E, 0x97E1, 0xD0AE, 0xA02C, 0xC08E, 0xCE7C, 0x9FCD, 0x9CF9, 0xA7E4, 0xCE0B, 0x9BEE, 0x98DA, 0xB5F1, 0xBD2E, 0xBE46, 0xBE49, 0xAEFC, 0xC0C3, 0x8CA5, 0x99BD, 0x9BB0, 0xC2D6, 0x8A04, 0xC5E7, 0xC10B, 0x9B6B, 0x91BA, 0xBCAE, 0xD56D, 0x9DA4, 0xCCD4, 0x8FAD, 0xA16C, 0x88BF, 0x8AD6, 0x8EE6, 0x8627, 0xB461, 0x88B8, 0x8E5E, 0x970A, 0x918D, 0xAE58, 0xCF5F, 0xC4D4, 0xD481, 0x9000, 0x9ADF, 0x8C9A, 0xB503, 0xB68E, 0xC60F, 0x8911, 0x8725, 0x9BB3, 0xD54D, 0xBE15, 0xB43D, 0x8BA6, 0x862C, 0xC70A, 0xC0E1, 0x959D, 0xB34D, 0xC142, 0xCE25, 0xCD90, 0x86B9, 0xC70B, 0x9ABB, 0xAD0F, 0x86BA, 0xCB28, 0xACA5, 0xCCD5, 0xB306, 0xB8B0, 0xCBA6, 0x91E0, 0x8A75, 0xAB7B, 0xB328, 0xA31B, 0xCD9A, 0x8484, 0xB464, 0xC88F, 0xB75F, 0x9508, 0xCF60, 0xD56E, 0xB7AE, 0xA600, 0x9367, 0xCC1D, 0xA42B, 0xA42C, 0xB638, 0x9607, 0xCF35, 0xBC15, 0xBC1C, 0xB2A3, 0xC980, 0xBBC2, 0x9B09, 0x9C98, 0x968C, 0xA179, 0x8C5F, 0xAEB8, 0xC57F, 0xA27A, 0xD221, 0xCCDC, 0x88FA, 0xC7D3, 0xB96E, 0x882A, 0xA28A, 0xCED9, 0xC38B, 0x9BCB, 0xCCDE, 0xC94B, 0xA6A8, 0xB7E5, 0xBDB8, 0xCBAE, 0x8CC9, 0xC6E8, 0xB397, 0xBD16, 0x9AD3, 0xCF63, 0xB96F, 0xAD34, 0x961F, 0xB90C, 0x8D1D, 0xB17E, 0xADCE, 0xADD2, 0x97D8, 0x8B69, 0x88C1, 0x88BC, 0x88BA, 0x8D68, 0xAD14, 0xBDEB, 0xD0FC, 0xBF49, 0x850B, 0xBB12, 0xAB17, 0x9710, 0xB63B, 0xCFF7, 0xA0E1, 0x9B81, 0xA277, 0xA6FE, 0xAFDB, 0xBBC4, 0xA008, 0xCC0C, 0xAA1B, 0xB8B4, 0xC17C, 0xBFA9, 0xAAF8, 0xB352, 0xB910, 0xCCAB, 0xB4F1, 0xA2B5, 0x8F7B, 0xD275, 0xA92B, 0xA1BF, 0xB268, 0xB263, 0xCE3C, 0xB1CB, 0xA784, 0x8D2B, 0xCCF2, 0x8B7F, 0xD2FA, 0xBF4A, 0x8AAA, 0xB311, 0xB589, 0xB0B6, 0x8637, 0x8781, 0xB510, 0x9ECC, 0xD045, 0x8B65, 0xA709, 0xB1CE, 0xA434, 0x9B82, 0xB42C, 0x8AE5, 0xCC0E, 0xCC0F, 0xC360, 0xC361, 0x8E6E, 0xBD37, 0x9194,
+		0x9124, 0x9151, 0xBC23, 0xA206, 0x891A, 0xC51A, 0x9271, 0x936A, 0xA571, 0xA592, 0x8B80, 0x9816, 0xB313, 0xC43C, 0xAD15, 0xA054, 0xA78C, 0xC278, 0x88C3, 0xA1FB, 0x8D43, 0xC498, 0xBD52, 0xB995, 0xB0F8, 0xCB51, 0x8C1C, 0xC40A, 0xAF64, 0x8E6F, 0xBD53, 0xA056, 0xBBB8, 0x985D, 0x9C49, 0xC183, 0x9A78, 0x9068, 0xB4A2, 0xB49D, 0xA183, 0x88FB, 0xA32E, 0xC5C3, 0xCC38, 0x8512, 0xAB84, 0xCB52, 0xA811, 0xB809, 0xA256, 0x9A68, 0xABEC, 0xA51C, 0x848C, 0xA812, 0xC83E, 0xA48A, 0xC3E8, 0x8B6D, 0xCDB1, 0xCC11, 0x8DF4, 0xAC28, 0x848E, 0xAC08, 0x9128, 0xB2BB, 0xAD59, 0xA4F5, 0xABED, 0x8BB4, 0xD30C, 0xAFB2, 0xAFB3, 0x8BE8, 0xC950, 0x8844, 0xD312, 0xA3EE, 0xA3D6, 0xCCF6, 0x93F2, 0xA8AE, 0xACD9, 0x8AEC, 0x9B99, 0x89CE, 0xAC21, 0xA520, 0x9A6A, 0xC734, 0xB67B, 0x89D0, 0x9D29, 0xA190, 0x9A7C, 0xB916, 0xCB84, 0xA750, 0xAE2B, 0xAC44, 0xBDA5, 0xA21D, 0xD473, 0x9541, 0x9542, 0x9578, 0xCEE8, 0xC284, 0x9D93, 0xC68C, 0xBACC, 0xB6D8, 0xC373, 0x8ABD, 0xC206, 0x9FA2, 0x957C, 0x9D9B, 0xB3C0, 0x9BE2, 0xB3C1, 0xD0DF, 0x907A, 0xB256, 0x9D69, 0x8FFB, 0x8897, 0x9D6C, 0x9CC9, 0xD24E, 0xA293, 0x9C90, 0xA40D, 0x94DC, 0x8EFD, 0xD0E8, 0xB3D2, 0x958B, 0x9E06, 0x9F7D, 0xCE31, 0x9E07, 0xC89E, 0xA9C2, 0xC394, 0xD0ED, 0xC39A, 0xC39C, 0x8CA0, 0x8E1A, 0xB5B4, 0x9A03, 0x9A04, 0x9A05, 0x91C1, 0xBA1E, 0xBE3C, 0xAB63, 0x9A0F, 0xA678, 0x92CB, 0xBCA9, 0x8721, 0x8FA8, 0xA08D, 0xB8C0, 0xCA6C, 0xCAAA, 0xB88C, 0x9A16, 0x92EA, 0xC890, 0xBA6B, 0xBA6C, 0x8893, 0xD06C, 0xB537, 0xA59C, 0xB20E, 0x851C, 0x8DEC, 0xB6CC, 0x92A0, 0xB748, 0xCF9E, 0xBFED, 0xD116, 0x8FD5, 0xA2BD, 0xCD57, 0xA36F, 0x983E, 0xB91D, 0x892F, 0xBFEE, 0x9679, 0xA360, 0xC288, 0x8EEE, 0xB330, 0xD25E, 0xB6B0, 0xA476, 0xABDC, 0xB7F2, 0xC914, 0xAF4F, 0x8594, 0x8A45, 0xB686, 0x8930, 0xB36D, 0x8573, 0x9D61, 0xCD6C, 0x93FC, 0x8505, 0xC2B9, 0xB0D7, 0xD28B, 0xD2A9, 0x91C5, 0x8EEF, 0xD136, 0x8AC0, 0x9CDB, 0xC87D, 0xA3CC, 0x872F, 0x8A18, 0xA0ED, 0xC1CD, 0x99CB, 0xCEEC, 0xA1D1, 0x8542, 0xB4B2, 0xB94D, 0xD21B, 0xCF46, 0xC07A, 0xAE66, 0xCAD7, 0xBA84, 0xD42D, 0xB1FE, 0xBF52, 0x9093, 0xA2C4, 0xD156, 0xB1FF, 0x9457, 0xB043,
+		0x8531, 0x84FC, 0xD005, 0xBFA4, 0xA356, 0xAA4B, 0xB3F1, 0xCF26, 0x9A0A, 0x85ED, 0x87CB, 0xD290, 0xB091, 0xAE14, 0xB20F, 0xB0DC, 0xBCF7, 0xC9A1, 0xD1BF, 0xD058, 0xC908, 0x85EE, 0x98B4, 0xAE9B, 0xC9F0, 0x8F42, 0x8B52, 0xB0DD, 0x9D3F, 0xAD58, 0xABC8, 0x8A59, 0xC7DD, 0x8B95, 0xB57A, 0xAD04, 0x8C06, 0x9D41, 0x9405, 0xD0C7, 0xD3E8, 0x8E27, 0x8961, 0xADAD, 0xAEC0, 0xA490, 0x945B, 0xB044, 0x8597, 0xAFEE, 0xA82B, 0xADDC, 0x96DD, 0xB36E, 0xA156, 0xC033, 0x8534, 0xBFF4, 0xBFF5, 0x84FE, 0xA0F0, 0xD4A2, 0x8735, 0xD12B, 0x8567, 0x8565, 0x8F43, 0xAE9A, 0xA15C, 0xA477, 0xA914, 0x9DE5, 0xB31D, 0xCCA1, 0xA4E6, 0xAF2D, 0x87F0, 0xD007, 0xB33D, 0x9919, 0xA478, 0xBA2B, 0x9F60, 0xD21D, 0xAF2A, 0x9614, 0x9616, 0xB5EB, 0xBAFB, 0x8D95, 0xCF7B, 0xA2D0, 0x8B3E, 0xC571, 0x8906, 0x9B1B, 0xB189, 0xD433, 0xB9E8, 0xADDD, 0x8555, 0x8B20, 0x96D5, 0xD2B8, 0xA479, 0x9174, 0x9175, 0xD295, 0xB45F, 0x84C6, 0xAF6F, 0xD1DE, 0x9596, 0x9F18, 0xA397, 0x9074, 0xADDE, 0xBE98, 0xA7C0, 0xD059, 0xA97F, 0x8FF4, 0xC041, 0xA1D7, 0xA7B8, 0xA140, 0xD2C0, 0xAA24, 0xC814, 0xC1F6, 0xBCD1, 0xC572, 0xAE40, 0x8E88, 0x9EE3, 0xD1FD, 0x9CC4, 0x9B1C, 0xC426, 0xCDCD, 0x845D, 0xBFF8, 0xAFA0, 0x8DB4, 0x881C, 0x95E6, 0xBE37, 0xD1E1, 0x8ACD, 0xB82A, 0xBC9A, 0xA336, 0xABBB, 0xAF70, 0xA0F6, 0xB0A1, 0x9DEB, 0xCAFC, 0xBEFF, 0xB862, 0xB785, 0xC088, 0xC573, 0x8935, 0x93A2, 0x9EE7, 0xC26A, 0xA248, 0xA0F7, 0xBF41, 0xA466, 0x9BE6, 0xBF75, 0xD4A5, 0xB200, 0xAA6D, 0x8525, 0x9844, 0xB8CE, 0xAEF0, 0xA195, 0xD032, 0xBBBE, 0xC9D9, 0xA1DE, 0x886A, 0xC07E, 0x887D, 0xD5F8, 0xC9F5, 0xD097, 0xA980, 0xBBD2, 0xBFF9, 0xA55F, 0xBC8A, 0x85A4, 0x9DEC, 0x9AB0, 0x8EBE, 0xC132, 0xC0A6, 0xB48E, 0xB0C7, 0xB8FF, 0xA7C8, 0xACF1, 0xABE1, 0x9462, 0x855C, 0xA16D, 0x8EB7, 0xC209, 0x8463, 0xA1A6, 0xCEFA, 0xD56C, 0xBE82, 0x8C68, 0x9F6C, 0xBF65, 0x864B, 0xADF0, 0xAECC, 0xB786, 0xD5FD, 0xC210, 0xB257, 0xC3FD, 0x8AD0, 0x8F84, 0xB7F3, 0xAA4C, 0xB4EC, 0x9532, 0x9164, 0xBA76, 0x8FDE, 0xB201, 0x8F47, 0xD10E, 0x9BC7, 0x9CC6, 0xBDB3, 0xB31B, 0xB290, 0xAFF1, 0xBA91, 0xB348, 0xA848, 0xC9FA, 0xC81A, 0xA1E2, 0xD206, 0xA768,
+		0x96D6, 0xAE9F, 0x9BEB, 0xC8C7, 0xD21E, 0xAA41, 0x88A0, 0xBD5B, 0x8A50, 0x87C3, 0x9F38, 0xBE47, 0xA353, 0xBFB8, 0xC889, 0x8DB7, 0xD58B, 0xA102, 0xBE90, 0xB379, 0xA32F, 0x8556, 0xAFE6, 0x9F39, 0xA981, 0x97D5, 0xAD37, 0x95D5, 0xD2CC, 0x8E4B, 0xABF0, 0x8975, 0xB7E4, 0x970B, 0xB0CB, 0xCED6, 0xAFA8, 0x9DF5, 0xD20C, 0xA0B6, 0x9DF6, 0xCD91, 0xC861, 0xA40A, 0xAE26, 0x9941, 0xB70F, 0xB138, 0xC0E2, 0xB7AF, 0xD59D, 0xC70C, 0xACB6, 0xBE16, 0xAEF1, 0x8939, 0xBA41, 0xD56F, 0xD1E8, 0xCA39, 0xC7AE, 0x9AE0, 0x873C, 0xC92C, 0x9ABC, 0xC891, 0xD5C9, 0x84C0, 0xC69B, 0xC81C, 0xC213, 0xA301, 0x8C26, 0x9C86, 0xBE4A, 0xA499, 0xA4A1, 0x8A76, 0xA26A, 0xA0B7, 0xA0B8, 0xBF7B, 0x9F43, 0x9B26, 0x95CD, 0x8B93, 0xC512, 0x9001, 0xC5B2, 0xCEA1, 0xB37A, 0xC9DA, 0x893A, 0xD05E, 0x8609, 0xC979, 0xC0E3, 0x8EED, 0xA515, 0xC1DD, 0xB4B6, 0x8976, 0x9587, 0xA93C, 0xD570, 0x95CE, 0x9EEF, 0x964B, 0xB4B8, 0xB6EA, 0xB633, 0x8B97, 0x8C24, 0xD0CF, 0xBC8E, 0xBC82, 0x882B, 0x8C14, 0xB6EB, 0xB0AD, 0x8D9C, 0xB7E6, 0xA2ED, 0xC981, 0x8EC2, 0xD251, 0xAF8A, 0xA495, 0x9DB2, 0xBBD7, 0xB56B, 0x955D, 0xC45C, 0xBB3D, 0xB7DC, 0xA003, 0xACD3, 0x91E8, 0x8C6A, 0xAFAA, 0xB86F, 0xBCD6, 0xD07E, 0xBD7E, 0xB00A, 0x857A, 0x8DC3, 0xA6A9, 0xBDB9, 0x99D7, 0xD2E5, 0xC04A, 0xC3C7, 0xB353, 0xC1DE, 0xB3F3, 0xBE72, 0xD1A1, 0x91E9, 0xC580, 0xAFA3, 0xB5FB, 0x894A, 0xD090, 0x95A8, 0xC270, 0x920C, 0xB90D, 0xA2BE, 0xB90E, 0xBA9B, 0x8560, 0xC982, 0x87B1, 0xB6EC, 0xBD1F, 0xB7A6, 0x89BA, 0xBCA1, 0xA0BA, 0x8B7D, 0x8C4E, 0x97D9, 0x87EB, 0x93A7, 0xBAF4, 0x8F76, 0xB30C, 0xBBDE, 0xD09B, 0xA72A, 0x8AAB, 0x8B08, 0x9711, 0xA9BD, 0xAAA3, 0xA93F, 0xD573, 0x94D8, 0x9A1C, 0xD091, 0xB3F6, 0xBDAB, 0x9A57, 0x8D69, 0xADFC, 0xAD48, 0x9E7A, 0x9758, 0xCC8B, 0xD272, 0x84EC, 0xD2FB, 0xAF06, 0xAAF9, 0x89CD, 0xA35B, 0x8BCF, 0xB911, 0xBFAA, 0x9EFB, 0xAD3D, 0xAD43, 0xD16A, 0x8979, 0xB354, 0x877E, 0x9EAA, 0xC986, 0x9652, 0xB355, 0xA7B4, 0x9C3E, 0xCC27, 0xA1C5, 0xB181, 0xB180, 0xA561, 0x9137, 0xC495, 0xB756, 0x9797, 0xCFAE, 0xCB3F, 0xC43D, 0x8AAD, 0x8AAE, 0xB79A, 0xAE48, 0x9984, 0xBB68, 0x9856, 0x9741, 0x8DC8, 0xD5F6,
+		0xAECF, 0xA80E, 0xD222, 0x9B35, 0xD472, 0x9F02, 0x9CCD, 0x88E9, 0xBD63, 0x8E4E, 0x8A54, 0x8805, 0x8A47, 0xA1C6, 0xC51B, 0xA78D, 0x864E, 0x946D, 0xB44A, 0x8806, 0x8DA0, 0xD4CB, 0xD451, 0xC02F, 0xB219, 0xB4B9, 0x9134, 0xAED0, 0x9A3F, 0xC586, 0xA94E, 0x9F03, 0x8D4F, 0xBCDD, 0x985E, 0xB44B, 0xC203, 0x9E0B, 0x950C, 0xCCB2, 0xA5EB, 0xAE36, 0xA98C, 0xB89A, 0xD4CC, 0xD01E, 0xB21B, 0x8CB0, 0x9C51, 0x8E8B, 0xB80A, 0x88EA, 0xB4DE, 0xB4DC, 0x9AC6, 0x9F4C, 0xB49A, 0xA39C, 0x8E3C, 0xC587, 0xCA0B, 0xB131, 0x8856, 0x8513, 0xA184, 0xCA0C, 0x91F7, 0xABF1, 0xBBEC, 0xAC3A, 0x9CCE, 0xBD04, 0xD4D5, 0x8ECB, 0xC636, 0xA51E, 0x888B, 0xA14B, 0xC9E0, 0xB754, 0xCA1B, 0xCA17, 0xAEA8, 0x9BF7, 0x9F8B, 0x87B5, 0xAF39, 0x958F, 0xD30D, 0x9F89, 0xA485, 0xAD4F, 0x9E64, 0xC58E, 0xA7D7, 0xBD24, 0x8561, 0xBBEE, 0xA950, 0xAA4A, 0xB68A, 0xD30E, 0xB002, 0xC951, 0xA5ED, 0x888C, 0xBAA9, 0x8D55, 0xAED4, 0xC46B, 0xA25E, 0x9BD4, 0xA6CC, 0xA4AA, 0x9AA9, 0xCC78, 0xA967, 0xA919, 0xB35D, 0xB35E, 0xCFB0, 0xB363, 0xA4FF, 0xC416, 0xCC42, 0xABB2, 0xB677, 0x897D, 0xCC79, 0x8D52, 0xC592, 0xB917, 0xAA95, 0xABB5, 0xAC45, 0x8B14, 0xCFB2, 0xAA30, 0xBD25, 0xD5CA, 0xA6E2, 0x8F93, 0xC04F, 0x8E8E, 0x9F12, 0xA21E, 0xA524, 0xA6E8, 0xAE38, 0xD233, 0x9787, 0x978B, 0x9C2D, 0xC7B5, 0xB20C, 0xB20D, 0xBA6A, 0xA2CE, 0xCCEA, 0x94CE, 0xCAA1, 0x95F9, 0x94E8, 0x8574, 0x92DF, 0xD22A, 0xB1EF, 0x8F83, 0xA362, 0xAD07, 0xC28F, 0x96A7, 0x98CC, 0x8909, 0xC550, 0xAC54, 0x8C0C, 0x959A, 0x8F23, 0xC6DA, 0x9F1E, 0xAD0A, 0x8A70, 0xA0D9, 0xD19A, 0x9165, 0xCDD7, 0x8B59, 0x84E1, 0x8569, 0xC6DB, 0x9F1F, 0x9166, 0xA726, 0xAF00, 0x86B0, 0x8A06, 0x8F9A, 0x915C, 0xCB1A, 0x9504, 0xB7CB, 0xA309, 0xC8CD, 0x912F, 0x9C2E, 0xBF58, 0xC556, 0x9168, 0x9EF5, 0xA076, 0xC92E, 0xC3AB, 0xAD10, 0xBADE, 0x8469, 0xB409, 0x846A, 0xD223, 0x8F31, 0xD1EB, 0x93EB, 0xBAE5, 0xA78E, 0xB58A, 0xC65A, 0xCAB6, 0x9F05, 0xB89B, 0x9F06, 0xD4D6, 0xCB60, 0xA72F, 0x86F1, 0xA638, 0xC560, 0xC561, 0xC1AD, 0xC75B, 0xB27D, 0xB285, 0xD098, 0x8799, 0xA1E3, 0xD099, 0xD09A, 0x9304, 0x8579, 0x857B, 0xA4F3, 0xCDEA, 0xA505, 0xC167, 0x9090, 0xB97B,
+		0xA7B2, 0x9D7B, 0x99D2, 0xC579, 0x9D80, 0xCDDE, 0xD1A2, 0x9F25, 0xC219, 0xBF5A, 0x90AB, 0x9FBF, 0x8B4E, 0xCBC1, 0x945C, 0xB3C2, 0xD0C8, 0xB59A, 0xD4B7, 0xD0CD, 0x90FC, 0xD4C3, 0xBB55, 0xC5EB, 0xD4C7, 0xD4CD, 0xB49B, 0xA7F4, 0xD4D7, 0x8C1E, 0x910A, 0xD3DF, 0x92BB, 0x9FA6, 0x986E, 0xCD71, 0xB9B8, 0xB077, 0xCD05, 0xADD9, 0xB033, 0xB286, 0xD0A9, 0xAB52, 0xA9FB, 0xB078, 0xB0E7, 0xA88F, 0x93DE, 0x92D9, 0xC72B, 0xA02D, 0xA02E, 0xAEA0, 0xD5A9, 0xD142, 0xCAAF, 0xA896, 0xB8D5, 0x9E3B, 0x9210, 0xCAB4, 0xB2AB, 0xD304, 0x9272, 0xB167, 0x9273, 0xD0BE, 0xA3B1, 0xBC77, 0xCDBE, 0xC23D, 0x9CD3, 0x9CE4, 0x9CF4, 0x9BEF, 0xB6D2, 0x8E52, 0xA0CF, 0xD286, 0xCFE5, 0xC569, 0xBE94, 0xC79D, 0xC6C7, 0x94AE, 0xA497, 0x9510, 0x9841, 0xBCF4, 0x8F53, 0xC68D, 0x89AE, 0xB9DF, 0xA3DB, 0xC918, 0xB9E4, 0xC0D3, 0xACE8, 0xACE9, 0xBFD4, 0x8C73, 0xC264, 0xCF2A, 0x8616, 0x84D6, 0xD030, 0x8586, 0x9F59, 0xA445, 0xB97C, 0x9A10, 0x92D4, 0x9895, 0x9788, 0x89D5, 0xC700, 0xAD1C, 0x9BAC, 0x932B, 0xB4A5, 0x99B4, 0xCAE5, 0xC2BB, 0xC5E1, 0xC84B, 0xD031, 0x92D5, 0xBD29, 0xB965, 0xA15D, 0xC923, 0xCFAC, 0x87CE, 0xC60C, 0xCC95, 0xC73D, 0xB1F0, 0xD1C3, 0xA839, 0x8C75, 0x9896, 0xABCA, 0xD5FA, 0xAD68, 0x8739, 0xC6D0, 0x9BAD, 0xD12C, 0xD57B, 0xBA2C, 0xBA2D, 0xCDFB, 0x9311, 0x901E, 0xAB67, 0xAEAF, 0x8A03, 0xC19B, 0x9054, 0x846E, 0x87CF, 0x84D7, 0xD392, 0xA8E2, 0xC450, 0xA3DF, 0xBEB2, 0x8A23, 0xB9EE, 0x9B04, 0x9B05, 0xC703, 0xA404, 0xA390, 0x9EE8, 0x9FF9, 0xD2C1, 0x9FFA, 0xB8C1, 0xBF1F, 0x997F, 0xC8C2, 0x94C3, 0xC4D2, 0xB852, 0xC540, 0xC9F6, 0xCF5B, 0x9B20, 0x9827, 0x9845, 0xA249, 0xC089, 0xC482, 0xA446, 0xD396, 0xC2CE, 0xB99F, 0xB9A0, 0x8860, 0xD162, 0xD163, 0xC297, 0x9849, 0x9B68, 0x989D, 0x8A71, 0xC08F, 0xBE84, 0xD4C4, 0xD5DA, 0xD386, 0xB235, 0xA051, 0xC2E5, 0xB882, 0xAEA1, 0xC2E6, 0xB4D7, 0xB2E7, 0xA032, 0x977D, 0xD220, 0xCB1B, 0xD2DE, 0x84C1, 0xC095, 0xA7E6, 0xA774, 0x89DC, 0xC0C5, 0xC541, 0xCFB5, 0x92FA, 0xC70F, 0xC737, 0xCA7D, 0xC3C8, 0xCF64, 0x9B2A, 0xC6C1, 0xACF5, 0xA40E, 0xC9D3, 0xCC96, 0xBAB5, 0xC148, 0xBAB6, 0xB4DB, 0xAB7C, 0xAE20, 0x9DFF, 0xAF98, 0x84CE,
+		0xC92F, 0x8C79, 0xC987, 0xBCB1, 0xB238, 0xAD2A, 0x9EFC, 0xA27D, 0x9574, 0xC1EE, 0x8A14, 0xB30D, 0x98A4, 0xC8D9, 0xA6B1, 0x8487, 0x9D05, 0x9D0F, 0xABEA, 0xCFB6, 0xC5BE, 0x98A5, 0xADD3, 0xAD81, 0x8D04, 0xAEBC, 0xD102, 0x9B83, 0x85F9, 0x9859, 0xC730, 0x8C3A, 0xA792, 0xC407, 0xBD54, 0xA057, 0xB182, 0xA7F5, 0xBFD9, 0xC362, 0xCB53, 0x9D15, 0x9B13, 0x8E16, 0xCA07, 0xCA08, 0xA6B7, 0xBD3C, 0xBF37, 0xC545, 0x931F, 0xB95D, 0xD14C, 0x988B, 0xCB61, 0xC4EC, 0xC61B, 0xB876, 0x9F08, 0x85FE, 0xA083, 0xC8E5, 0x848F, 0xCA18, 0xB739, 0xBABF, 0xAC0B, 0xC791, 0xC9C6, 0xB246, 0xA6C4, 0x8A82, 0xA3EF, 0x9017, 0x9018, 0xC9CB, 0x9C08, 0xA9F3, 0xC32A, 0xB703, 0xA8FC, 0xAE37, 0xAA96, 0xAA31, 0xB853, 0xBDA6, 0xC8B3, 0xD3E0, 0xCEDF, 0xCEE0, 0xB56E, 0xC9EC, 0x95CF, 0xCAD8, 0x99B5, 0x98C4, 0xBA8E, 0x88ED, 0x88EC, 0xB986, 0xAB10, 0xD040, 0xD041, 0xBE4B, 0xD5DB, 0x88C0, 0xC6E3, 0x9B74, 0xCBF7, 0xB466, 0x9338, 0xB124, 0xCEE7, 0xCD27, 0xB759, 0xB0A0, 0x8575, 0x9406, 0xA83A, 0x92FE, 0xB59B, 0xB59C, 0xAF91, 0xBEB3, 0xBB37, 0xD1E2, 0xA552, 0xA550, 0xD5CC, 0xAD22, 0x9AD1, 0xC0D5, 0xBFD7, 0x8A26, 0xB25B, 0xB25C, 0xCC20, 0xD552, 0xC0D6, 0xBF38, 0xA559, 0xA572, 0xAC0C, 0xA8FD, 0xADBA, 0xB19D, 0xC169, 0xAD5B, 0x8645, 0xD06D, 0xBACA, 0xBACB, 0xBE06, 0xD3A7, 0xB696, 0x851D, 0xB230, 0x9170, 0x9171, 0x8EB1, 0xA66E, 0xB53A, 0x9C18, 0xA0AC, 0x867F, 0xC674, 0x8ABE, 0x8CE2, 0xB823, 0xB74A, 0xCF84, 0xB5E4, 0xB336, 0xCD6D, 0x94E9, 0xC228, 0x8932, 0xB85A, 0xC79F, 0x927F, 0xC28A, 0xD525, 0xA64D, 0xC637, 0x8894, 0x8D8F, 0xB6B3, 0x874D, 0xBFF1, 0x8F54, 0xD118, 0xAB2D, 0x8AF9, 0xCAD9, 0x94D5, 0x95FA, 0x90E6, 0xCA55, 0xB2DC, 0xBACD, 0x953D, 0xBE9C, 0x9EA0, 0xB85B, 0xC0A5, 0xA4B5, 0xA0C5, 0xAB2E, 0xC919, 0xAACA, 0xACBC, 0xC351, 0xCE94, 0x986F, 0x930D, 0x8605, 0x9F5C, 0x9052, 0x955A, 0xC9A2, 0xC3F5, 0x8BF2, 0x8C74, 0xAFE3, 0xBA87, 0x9A4B, 0xC5E2, 0xAF53, 0xD400, 0x8BC1, 0xBBA9, 0x8587, 0xBBAA, 0x9C79, 0xCEEF, 0x9FF5, 0x963F, 0x9C1E, 0xAB4F, 0xB101, 0x869E, 0xC0C1, 0x84D8, 0x92BF, 0x9340, 0xCAE6, 0x93D2, 0xAE15, 0xC2BC, 0x9A11, 0xC7EF, 0x9094, 0xC5F6, 0xD1C0, 0xC962,
+		0xA7E0, 0xB775, 0x91B7, 0xAB8F, 0xD13C, 0x97E0, 0xD23A, 0x8CF6, 0xCF58, 0xD5D6, 0xB97D, 0xBA88, 0xCFE6, 0x8F57, 0xA679, 0xA955, 0x9DE6, 0x8AC1, 0xBBAB, 0xB3C3, 0x937A, 0xAE16, 0xC4F5, 0xC3F9, 0xA373, 0xB1B4, 0xBB8E, 0xC352, 0xD296, 0x96DE, 0xC4F9, 0x9D8A, 0x9D44, 0x9636, 0x940E, 0xAD69, 0xCAF2, 0xC9F2, 0xC9F7, 0xBA2E, 0xAEF6, 0x8686, 0xC00E, 0xCA65, 0xA83B, 0x87D0, 0xAE9E, 0xA491, 0x98C5, 0xB004, 0x9280, 0xD32C, 0x8E04, 0x8CC1, 0xC956, 0x93D6, 0x855A, 0xADA4, 0x94EF, 0xB24D, 0xB664, 0xB70C, 0xBA8B, 0xB8E2, 0xBBAD, 0xD180, 0xC3B6, 0xCD06, 0xB928, 0x9789, 0xC034, 0xD60D, 0xAE19, 0xAF2F, 0xCCD0, 0x8F44, 0xD2B9, 0xD05A, 0x8945, 0x8E58, 0x965B, 0xB213, 0xA0D4, 0x84DE, 0x940F, 0x9DE8, 0x8818, 0x9189, 0xA2DE, 0xAE0A, 0xD407, 0x8678, 0xA8BD, 0x8966, 0xD089, 0xBB8F, 0xD408, 0xB062, 0xBA23, 0x96DF, 0x8946, 0xC9A7, 0x8AA0, 0xA0D5, 0xBA2F, 0xD23F, 0xA8BE, 0xAB92, 0xA680, 0xB6DA, 0xD08A, 0xCFE7, 0x874F, 0xD0E0, 0xD2BA, 0xA8E3, 0x9075, 0xA958, 0xB7AC, 0xA681, 0xA4E7, 0xCCCD, 0xBAD3, 0xC7A7, 0xBAFD, 0xB300, 0xD192, 0xB250, 0xA68B, 0xCA6D, 0xC4C7, 0xD1E3, 0xA68C, 0xB7FC, 0xA47A, 0xA28E, 0x87C2, 0xB6A0, 0xC551, 0x855B, 0xB6A1, 0x87E5, 0xD4ED, 0x8B9F, 0xCAFD, 0x8CE9, 0xC6BC, 0xD3AE, 0x9E30, 0xD5D7, 0x921B, 0x9230, 0xCE01, 0x9258, 0x9617, 0xA2D2, 0xA582, 0xD0AA, 0xA68D, 0xCB99, 0xC958, 0x98CD, 0x95C7, 0xCAFE, 0xBA33, 0x959B, 0xCF91, 0xAA25, 0x941D, 0x9F65, 0x9929, 0x978D, 0xBDCB, 0x972E, 0xC107, 0xA3E1, 0xB729, 0x84C9, 0x849F, 0xA1B0, 0xCA6E, 0xD4A6, 0xA375, 0xD2C2, 0xB4FF, 0xBF20, 0xB7D9, 0xB7D8, 0x9A89, 0xA11C, 0xA0D7, 0xC77A, 0x918A, 0x8C66, 0xAD9A, 0xCF7D, 0xAFB7, 0xCC17, 0x9F66, 0xA8BF, 0xD0E1, 0xC7DF, 0xB680, 0xD193, 0x8E99, 0xB251, 0xB422, 0x9A4F, 0x9B63, 0x9E8F, 0xD461, 0xC7A8, 0xBC9B, 0xB799, 0xD19B, 0x8607, 0xBEDF, 0xA47B, 0xA070, 0x8822, 0x864C, 0x9424, 0xB769, 0xBF42, 0xA1E4, 0xC2D7, 0xA53E, 0xA8C1, 0x9383, 0xB258, 0xC1D1, 0xA231, 0x9505, 0xBC09, 0xA757, 0xB54D, 0xBF01, 0xCD30, 0xAB96, 0x85A5, 0xA91C, 0xB096, 0xD46F, 0x8F61, 0xC705, 0xBF7A, 0xCFF5, 0xCD4F, 0x96AD, 0x86B1, 0x8F26, 0x9828, 0xD52E, 0xD24D, 0xB6C1,
+		0x8622, 0x95EA, 0x9DF1, 0x9AB5, 0xC090, 0xAFA1, 0x9D4C, 0xBEA1, 0x9CF5, 0xC506, 0xA9FC, 0xA3BA, 0xB8CF, 0x891F, 0x9343, 0xBBB0, 0xAC26, 0xC247, 0xA606, 0xBB91, 0x90A1, 0xB4A7, 0xCC87, 0xBC9C, 0xA103, 0xBE08, 0xC5AB, 0xA469, 0xD4C1, 0xBA92, 0x8974, 0x92AE, 0xC139, 0xA071, 0xA60D, 0x87C4, 0xC3E2, 0x93C2, 0xBDCE, 0xD2CD, 0xA4C2, 0xA6F8, 0x9DF2, 0xD4A7, 0xA849, 0xA611, 0xB307, 0x87D6, 0xAA5D, 0x8CFB, 0xB3AD, 0xAC8D, 0xB29C, 0xB29D, 0x88A6, 0x97B4, 0x8981, 0xD166, 0x9305, 0xAFF3, 0x85B5, 0x85B6, 0x9BB4, 0xD54E, 0x8AD7, 0xCFE9, 0x9C91, 0xA6A1, 0xB0A8, 0xCE1A, 0xCE1B, 0x96AF, 0x97B5, 0x907F, 0xBD7A, 0x9534, 0xC0C6, 0x8F6A, 0x8DB9, 0x9289, 0x8AA2, 0xD0E6, 0xB2E8, 0xCE7F, 0xC892, 0xCE1C, 0xB5DF, 0xCB1C, 0xB81B, 0xB6A4, 0x8C6B, 0xA5F7, 0xB25D, 0xD4A9, 0x9432, 0xA2E8, 0xA4C7, 0xD58D, 0xD58E, 0xD143, 0x96EC, 0x9332, 0x9347, 0x8A5B, 0xB4D8, 0xAE8A, 0xC096, 0x97E6, 0xA9AC, 0x9883, 0x9F44, 0xCAB0, 0x8BA3, 0xA141, 0xA122, 0x8AD8, 0xD5FE, 0xA759, 0xB3CA, 0xD26B, 0xD477, 0xC7E3, 0xA105, 0xB110, 0x9EC0, 0xD4C5, 0xD4FB, 0x879A, 0xB0A9, 0x903F, 0x8BF4, 0x89DD, 0xABDA, 0x9A30, 0x9DF7, 0x9754, 0xC387, 0x90BB, 0xB3AE, 0x8ED3, 0x978E, 0x8F9B, 0xAA76, 0xD24F, 0xB620, 0xADDB, 0x9465, 0x95D7, 0xB0CC, 0xB891, 0xCA77, 0xC02C, 0xB936, 0x9178, 0xC9D2, 0x9496, 0xC149, 0xC0E9, 0x9100, 0x9D81, 0xD553, 0x9DB3, 0xCA7E, 0xB970, 0xC33B, 0xC8D4, 0xC898, 0x8C38, 0x9DB4, 0x8C7A, 0xCDDF, 0x98DE, 0xD061, 0xC217, 0xB151, 0x86C4, 0xC967, 0x97C1, 0xC69F, 0xB78F, 0xC930, 0xA4A2, 0xC862, 0x8649, 0x9B2B, 0xA40F, 0x9FB3, 0xA410, 0xBB59, 0x938C, 0xC563, 0xC02D, 0x9C8C, 0x9E3C, 0xADCF, 0xAB7D, 0x8BF8, 0xA37F, 0x99DC, 0xA743, 0xA5F8, 0xBEF4, 0xAE21, 0xCD9B, 0xCD19, 0xABA2, 0x8CFE, 0xC748, 0xC749, 0xC949, 0xD1A3, 0xB152, 0xCA03, 0x9C99, 0x9F74, 0xCA04, 0x8BF6, 0xBB27, 0xCD92, 0x8D2F, 0xC0EA, 0xABA3, 0xBE4C, 0x9C92, 0x9F75, 0xA27B, 0xB523, 0xCC64, 0xB71E, 0x9AE4, 0xA928, 0xA5A4, 0xB5FC, 0xC4B4, 0xB19C, 0xBA0E, 0x94DD, 0xBD31, 0xA517, 0xC19E, 0xCD9C, 0x8A93, 0xA9EC, 0xA143, 0xB948, 0x8BDB, 0xB115, 0xC5B9, 0x9D84, 0xCB36, 0xD0D3, 0x9441, 0xAFAC, 0xAC77, 0xA541,
+		0xB6F0, 0x9697, 0x9E49, 0xA144, 0xBCC4, 0xC9B1, 0xD1AB, 0x85AF, 0xB835, 0xCEB1, 0xD535, 0xAD2B, 0xBC1D, 0x9D8E, 0xC988, 0x9F7E, 0x9B12, 0x951C, 0x9306, 0xD092, 0xB381, 0xAAD2, 0xBC8F, 0xCEB2, 0xC5BA, 0xB6F1, 0xB9F9, 0xD254, 0x8D6A, 0xC1A3, 0xBEF5, 0xA89C, 0xB6ED, 0xBD82, 0xB639, 0xD09C, 0xBB5F, 0xB9AA, 0xBCDA, 0xA2F2, 0xC2FA, 0x9692, 0xB264, 0x955F, 0x9560, 0xBC86, 0xB00B, 0xBDBB, 0x95A9, 0x8C7B, 0xD1AC, 0xAF76, 0x9C9E, 0xBB40, 0x964F, 0x8C6D, 0xB3D3, 0x894D, 0xB399, 0x9A65, 0xABA9, 0xC6ED, 0x9540, 0x954C, 0xD4AC, 0xC01A, 0xB428, 0xC95B, 0x8F89, 0x9D85, 0x9E4A, 0xD2F0, 0x8EC6, 0xA8EE, 0x879D, 0xD3BA, 0xB7DA, 0xC342, 0x9C3F, 0xA72B, 0x9B84, 0xCCAD, 0xB3B7, 0x97E8, 0x94DF, 0x94E0, 0xBFAB, 0x9A58, 0xB265, 0xB81C, 0x8D6B, 0xB0B3, 0xCD39, 0x99E2, 0x9E96, 0x9A1F, 0x9AC4, 0x9798, 0xAF07, 0xCB40, 0x954F, 0xA2B7, 0x975D, 0x975E, 0x88F2, 0xAAFB, 0x9FE8, 0xD16C, 0xD467, 0xA5A6, 0xA556, 0x8A7B, 0x8D05, 0xA61E, 0xC669, 0xB4DD, 0xBB13, 0x928C, 0xBF14, 0x9714, 0xD01A, 0xBC24, 0xA5B0, 0xA989, 0xA760, 0xAC7F, 0xA92E, 0x8A2B, 0xBC25, 0xA2F5, 0x8BD0, 0xBD88, 0x875C, 0xA9C3, 0xA0BB, 0xD186, 0xD065, 0xBAA3, 0xD103, 0xAAFC, 0xAD44, 0xADD4, 0xC95D, 0xBEA6, 0xB0B4, 0xD425, 0xB836, 0xC30B, 0xB604, 0x992E, 0x9DBE, 0x8D06, 0x9C40, 0xC839, 0xB3E5, 0xC76E, 0xCC2F, 0x9247, 0xC7B6, 0xD278, 0xB42F, 0xD5CE, 0x8D25, 0xB23B, 0xBAE8, 0x9A59, 0xA43A, 0xD1B3, 0xD5EC, 0xCF05, 0xB89C, 0xC30C, 0x8C7F, 0x8FB7, 0x924E, 0x950B, 0xAD4A, 0xC27C, 0xB412, 0xB682, 0xA820, 0xA8A6, 0xB430, 0xC499, 0xB784, 0x928E, 0xD0D8, 0xC01F, 0xA589, 0xCF3F, 0xBB2C, 0x9138, 0x8AB0, 0xBD8C, 0xAC01, 0xA12E, 0x8AE8, 0xBC2C, 0xA207, 0xA208, 0xBD3D, 0x9B89, 0x9C4A, 0xAFA5, 0xC4ED, 0xC030, 0xAF41, 0xB76F, 0xD3BE, 0xBF39, 0xD047, 0x9351, 0xB511, 0xB668, 0x992F, 0xB387, 0x9671, 0xA8C8, 0xA594, 0x9550, 0x8BE7, 0xC363, 0xB9AC, 0xD536, 0xB7CF, 0x9CAB, 0x908D, 0xA03B, 0xA98D, 0x9E59, 0x8C1D, 0xCEBA, 0x8DF0, 0xBAA6, 0x9E83, 0xBD3F, 0xA814, 0xAE3D, 0xCBF9, 0xC346, 0x9B91, 0xB877, 0xD5E3, 0xC732, 0x8AB4, 0x951D, 0xA12F, 0xD5E6, 0xCB62, 0xB49E, 0xB23E, 0xC86A, 0xA5B2, 0x9395, 0x9B52,
+		0x8EA5, 0x9D1C, 0xBC6E, 0x8857, 0xB1D0, 0x8AE9, 0x8BFC, 0xD42E, 0x9B92, 0x9CAC, 0x9F9B, 0x9D87, 0xB4DF, 0xBA5E, 0x9E0C, 0xB3EC, 0x8EDB, 0xCAB8, 0x877C, 0xBBB9, 0xB8F8, 0xA815, 0xA6BD, 0x8952, 0xAC0D, 0xCBE3, 0xBDDC, 0xBD06, 0xAC93, 0xB2BE, 0xBFAE, 0x87C6, 0x9BFB, 0x9D22, 0xB35C, 0xAEAA, 0xAF3C, 0xCA47, 0x9567, 0x9E65, 0xCC12, 0xAF79, 0xB4E8, 0xC8A4, 0xB2BF, 0xAC84, 0xD14F, 0x979C, 0x8C81, 0x9C58, 0xA415, 0xB1E5, 0x8F08, 0x8BEA, 0x95B3, 0xAC94, 0xCCF8, 0xD314, 0x9B0E, 0xB35F, 0xA5D6, 0xA5B5, 0xB7AB, 0xA991, 0xA6C5, 0x8D56, 0xAA1F, 0xACDC, 0x9B9E, 0xAFCB, 0xA9F1, 0xD30F, 0x9554, 0x90C7, 0xCEC2, 0xA6CD, 0x9323, 0xD4D9, 0xBBF0, 0xA716, 0x9EB2, 0x8BEE, 0xB4F6, 0xD3C6, 0xA96C, 0xC871, 0xA6D3, 0xD3C7, 0x8A9A, 0x9F90, 0x9200, 0xBBFB, 0x9A6C, 0xAF18, 0xCE5A, 0xA8FE, 0xA4AE, 0x9EDE, 0xC46C, 0x976A, 0xA14C, 0xC532, 0xA877, 0xCC46, 0x9DD8, 0xCC00, 0xCD24, 0xCC7E, 0xC4BD, 0xAF6C, 0x884D, 0x89A3, 0xA506, 0xA14E, 0xBB09, 0xB91A, 0xD5E8, 0x8D29, 0xB60E, 0xB5B5, 0x88E6, 0xA0EA, 0xCE61, 0xAA86, 0xA6E5, 0x8D58, 0xAA32, 0x8E90, 0xA221, 0xC878, 0xA525, 0xA50C, 0xD3E1, 0xA5C3, 0xA667, 0x8554, 0xAE34, 0xCE68, 0xA87A, 0x9737, 0xB3A2, 0x8CE3, 0x9A74, 0xC5E3, 0xCD6E, 0x9A75, 0xB339, 0xAFC0, 0xC690, 0x8A1B, 0x8C09, 0xB304, 0xA286, 0xCAFF, 0xA1DF, 0xC381, 0xC6D3, 0x98B5, 0xCE0C, 0xA428, 0xA539, 0xA3BB, 0xBB39, 0xC2D8, 0x845E, 0xCA3A, 0xB25E, 0xC69C, 0x8B45, 0xB4B7, 0xA3BC, 0xA29E, 0xA3BD, 0xA2A0, 0x8C39, 0xB847, 0x96C0, 0xCBA9, 0xC5EC, 0xC564, 0xCD9D, 0xB3B3, 0xC515, 0xCA09, 0x9589, 0xC236, 0xBD64, 0xA00C, 0xAFC5, 0x99C7, 0xBE4F, 0x9A76, 0xC6A9, 0xB086, 0xC364, 0xC55E, 0xC6AD, 0xC30D, 0xC30E, 0xA730, 0x8C1F, 0xCB63, 0x91FE, 0xCDB5, 0x8CB2, 0x9A7E, 0xD282, 0xD224, 0x8CDD, 0x8874, 0xC265, 0xB27E, 0x8877, 0x8878, 0xC05E, 0xA15E, 0xB373, 0x8B24, 0xB802, 0x8B46, 0xB806, 0xD345, 0xBC63, 0xBC64, 0xA6B2, 0xD01B, 0xCDA8, 0xA6B8, 0x9765, 0x8DF5, 0x91BF, 0xBB78, 0x9D98, 0xD157, 0xAD61, 0xAD62, 0xC9A3, 0xAD63, 0x8D39, 0xC90A, 0xBE7E, 0xB980, 0x8E05, 0xB8AE, 0xC6D4, 0xC7CB, 0xBA8F, 0x88B6, 0xA1E5, 0xB172, 0xB326, 0xB54E, 0xBC0A, 0xB4D5, 0xCF4B,
+		0xA73C, 0xCB1D, 0xBF59, 0xD26C, 0xC9FE, 0x88B9, 0x9BB5, 0x8E33, 0x9C93, 0x9009, 0xD1AD, 0xCF4F, 0xC19F, 0x8BCB, 0x87B2, 0xBE50, 0xA00D, 0xB8B8, 0xCBE0, 0x8FCC, 0xA0E2, 0xA43B, 0x8D5D, 0xCB54, 0x8E3D, 0x90F0, 0x9E84, 0xA74B, 0x87B7, 0x90C8, 0x9DD2, 0x9DD9, 0xBA83, 0xAFC1, 0x90FB, 0xD409, 0xCB9A, 0xB4ED, 0xCB0C, 0xB82B, 0xA300, 0xB445, 0xC97A, 0xC7D1, 0x8FB1, 0x9B75, 0x9B76, 0x966B, 0xB63C, 0x9C3A, 0xCB41, 0xAFC6, 0x9B51, 0x9107, 0xCA48, 0xC537, 0xC23E, 0x9705, 0xADA5, 0xABB7, 0xABB8, 0x846D, 0x9F96, 0x90B7, 0xCE32, 0x867D, 0x8695, 0x86A3, 0xB104, 0xB105, 0x86A9, 0x8995, 0xAB4B, 0xAB4D, 0xAB4E, 0xB102, 0xA7B7, 0x9D45, 0xD0A8, 0xB7AD, 0xADC4, 0xC029, 0xC7A9, 0x9231, 0xB6E2, 0xC453, 0xA11D, 0xADA6, 0x9882, 0xB54F, 0x90A2, 0xAFBB, 0xBD4F, 0xB06A, 0xA123, 0x915D, 0x8D82, 0x8670, 0xB7C2, 0xB7C3, 0xAB7E, 0xB7B1, 0xBA9C, 0xBA9D, 0xC038, 0x98DF, 0x9E3D, 0xBCDB, 0xB7C5, 0xA9EF, 0xADA9, 0xA623, 0xBF3A, 0xB6F8, 0x8A0A, 0xB23C, 0xA9E9, 0xD0BA, 0xB7F7, 0xD0BB, 0xAC0E, 0xA992, 0xB5B6, 0x901C, 0xBA12, 0x8F39, 0xACE2, 0xA1CE, 0xABF2, 0xB2EF, 0xB17F, 0xAE01, 0xB2F1, 0x8EB2, 0xC3F4, 0x8C2C, 0x932C, 0xC91D, 0xAE71, 0x87B0, 0x9410, 0xB929, 0x9055, 0xB4BE, 0xB301, 0xCB9B, 0xC2CF, 0x9805, 0xC945, 0x84A0, 0xC817, 0xA317, 0xB4C5, 0xC81D, 0x905E, 0x8E63, 0xAA17, 0xB4D9, 0xC94C, 0xCF36, 0xCF3A, 0xBB1B, 0xBB1A, 0xD21A, 0x87BF, 0xCCC5, 0x8E92, 0xBB1C, 0xA59D, 0xAEAD, 0xBFE8, 0x92A3, 0x976D, 0xBED8, 0xD235, 0xB53D, 0x8793, 0xD02F, 0xAC99, 0x8D4E, 0x9B57, 0x8FC0, 0x983C, 0x8958, 0xCEEA, 0x8C41, 0xC074, 0x92A6, 0x8DF8, 0xC2B4, 0xBFEF, 0xAB2A, 0xB53B, 0xB2F2, 0xB888, 0xB19F, 0x983F, 0xB331, 0xC223, 0xC224, 0xC7C4, 0xBB7F, 0xB742, 0x9613, 0x9E72, 0x8B19, 0xC225, 0xBF94, 0xA0C4, 0xBD66, 0xD239, 0xD28C, 0xB337, 0xAC64, 0x967A, 0xC0B4, 0xA04B, 0xA04C, 0xB773, 0xA22A, 0x9951, 0xBD0C, 0xB615, 0x9C76, 0x8730, 0x8731, 0x9511, 0xC1D8, 0xD323, 0xB028, 0xC64D, 0xA1D2, 0x99F6, 0xAF4D, 0xB2F5, 0x933F, 0xC6CA, 0xC6CD, 0xB4B3, 0xCA56, 0xC20B, 0xCF27, 0xCE93, 0x987A, 0xC87E, 0xB94E, 0x8A66, 0x8E55, 0xCCFE, 0x9144, 0x9A0B, 0x9BE1, 0xB24A, 0xADBE, 0xAF50,
+		0xAB8D, 0x8DD0, 0xAC9B, 0xAC65, 0x8B76, 0xB029, 0x8683, 0xB824, 0xD28D, 0xB073, 0xB018, 0xD47C, 0xCF79, 0x9626, 0xA88A, 0xAB8E, 0xD006, 0x9377, 0xAFDA, 0xA674, 0xAA50, 0x88D3, 0x9378, 0xC0FF, 0x9A0C, 0xAD64, 0xABC9, 0xBACE, 0xA13C, 0xCF7C, 0xBFF6, 0xC00A, 0x9183, 0x98C1, 0xA67A, 0xAC51, 0xCA5B, 0x9249, 0x930E, 0xCD02, 0xBE65, 0xD2B0, 0xD137, 0x9659, 0xD0A7, 0xC84C, 0xBB4E, 0xA3DD, 0xA095, 0xA0F1, 0xC59C, 0xB53F, 0xCAE7, 0x9D42, 0xD329, 0xB5E8, 0x8812, 0x9B5A, 0xAC67, 0x863C, 0xD008, 0xD3CC, 0xA5A0, 0xCCED, 0x965A, 0x9956, 0x94ED, 0x9261, 0xAB65, 0xBB89, 0x99B6, 0xB092, 0x8CDF, 0x92A7, 0x8B1E, 0xBC01, 0xAF45, 0x8A9E, 0xA836, 0xB057, 0x87F1, 0xB2F9, 0xBB85, 0xAE7E, 0xA119, 0xC777, 0xD401, 0xB97E, 0xA5E1, 0xC73C, 0xA1D4, 0x9407, 0xB019, 0xAD05, 0xBD10, 0xC909, 0x9CC2, 0xCCC9, 0x9708, 0x8664, 0xC762, 0xA8E0, 0xA956, 0x8E56, 0xAA98, 0xC59D, 0xB1D6, 0xD009, 0xA022, 0xCBC3, 0xD361, 0x9F61, 0xC9F3, 0x9B1D, 0x9B44, 0xCF9F, 0x8AC9, 0xCB94, 0xC104, 0x99A4, 0x9E29, 0xC924, 0xA7C1, 0xBB92, 0x9CE5, 0x9218, 0xC629, 0x9411, 0xB79B, 0xB7FB, 0xD297, 0xCC05, 0xC248, 0xC353, 0xA2D1, 0xD3AC, 0x9EA2, 0xAA9A, 0xAA9E, 0x84C7, 0x9076, 0xBE3F, 0xAD99, 0xA5E2, 0xCA33, 0xAC53, 0xB5E9, 0x9FC4, 0xB1F1, 0xC130, 0xC539, 0xC5A2, 0x995B, 0xC6D1, 0xBC03, 0xA3C7, 0xBDC9, 0xB460, 0xA157, 0x922E, 0xD362, 0xB74B, 0xB1B5, 0xC7A3, 0xC650, 0xD2BB, 0x972C, 0x9A86, 0xAD1E, 0x9BD7, 0xC042, 0xB325, 0xAFFA, 0xC22B, 0xB57B, 0xA88D, 0xCAF3, 0x9D46, 0xA065, 0xB393, 0x9E76, 0x9EBB, 0xD18D, 0xB9B9, 0xD4B8, 0x8907, 0xB0CF, 0x9B5C, 0x9CE6, 0xA88C, 0x89B0, 0x9BBB, 0x99F8, 0xAF5D, 0xC7A4, 0x9FF7, 0xA7C5, 0xB547, 0xC133, 0xD181, 0xA24A, 0x9825, 0x85A6, 0xAB36, 0xD4BA, 0xCD09, 0xC2D0, 0x881D, 0x909C, 0x9AB1, 0x9960, 0xCB00, 0xB233, 0xCC86, 0xA514, 0x989B, 0xA55C, 0x9837, 0xA654, 0x95D3, 0x941E, 0xC249, 0xA73B, 0x8C87, 0x93A3, 0xCB01, 0xCE02, 0xBF21, 0xA56A, 0x97FC, 0x9FFB, 0x9D4A, 0x8B78, 0xA09A, 0xABBC, 0xBC43, 0x8AA3, 0xB079, 0xC483, 0xB968, 0xBF76, 0xA474, 0xB1A5, 0xAEF8, 0x9846, 0xA029, 0xC4FF, 0xB906, 0xAECB, 0xBF64, 0xCCD1, 0xC552, 0xC427, 0xBF00, 0x91A3,
+		0xBC04, 0xBFD5, 0xA196, 0x8920, 0xBE42, 0xA68E, 0xBB1F, 0xBB93, 0xA5E4, 0xBB20, 0xBDB1, 0x90B8, 0xA58C, 0xA4BB, 0xA6F5, 0xC108, 0xC1F8, 0xCF2F, 0x9AB2, 0x8F24, 0x9924, 0xB780, 0x9E31, 0xD10C, 0xB7FD, 0x9425, 0x970C, 0xC640, 0xBA77, 0xBAFE, 0xC7F6, 0x8CA6, 0xD10D, 0xC9FB, 0xA34A, 0xC091, 0x9829, 0xBFFD, 0x905B, 0x98D2, 0xC1FB, 0xA104, 0xB907, 0xA758, 0x9BB1, 0xBCD5, 0xD4A8, 0x8FAE, 0xB462, 0x8EE4, 0xA1B4, 0xD4F3, 0xC2D9, 0xC53C, 0xB291, 0x9687, 0x97E2, 0x984A, 0xA7E5, 0xBD9E, 0xD36B, 0xB0C8, 0x989E, 0x89D8, 0xBA93, 0xB259, 0x92C2, 0x8B26, 0xA9A5, 0xAE5F, 0xA11E, 0xBDCF, 0x8D1B, 0xA5E5, 0xD183, 0xB1BC, 0x92F9, 0xBBB3, 0xBE83, 0xB136, 0x8E5F, 0xCE0D, 0xAE87, 0xCD60, 0xA9A6, 0x9517, 0xAC6E, 0xA072, 0xA84A, 0xAA5B, 0xCBD1, 0x8D80, 0xB5A1, 0x9A64, 0xCE0E, 0xAECD, 0xB933, 0x8753, 0x8C8A, 0x99BE, 0xCE7D, 0xA4C3, 0x9BC8, 0xB4C6, 0xC82C, 0xB395, 0xBE5E, 0xACBF, 0xD29A, 0xCBF0, 0x8823, 0x863F, 0xCE78, 0xC1DB, 0xB78D, 0x92E7, 0xB4C7, 0xCE79, 0xA307, 0x9CF6, 0xB908, 0xCE7A, 0xB7FF, 0xA9A7, 0xD53F, 0x90BA, 0xB292, 0x9E36, 0xB992, 0xB137, 0xC2DA, 0xCD84, 0xCE7B, 0xB957, 0xB96A, 0xB710, 0x9ABD, 0xD3EC, 0x9DF8, 0xAF97, 0xCD62, 0xB555, 0xBEF0, 0xB5A2, 0x90EC, 0x9387, 0xD05F, 0x8826, 0xC214, 0xC1FC, 0xBE17, 0xC0E4, 0xC19D, 0xB72C, 0xC5B3, 0x8912, 0xC177, 0x98D3, 0x953C, 0xC893, 0x9968, 0xC746, 0xAC59, 0xA30A, 0xAB53, 0xCC1E, 0xC894, 0xCD15, 0x9942, 0xACC0, 0xB99D, 0xABBF, 0xCF94, 0x9BBD, 0xAE0D, 0x977E, 0x8B5D, 0x91E1, 0xAFFB, 0xAB9D, 0xA73F, 0xB308, 0xB309, 0xAB9E, 0xBE6F, 0x8D2E, 0xC111, 0x88B1, 0xBF9E, 0xACA6, 0x9B6C, 0x8827, 0xC6C0, 0x9C94, 0xB089, 0x9DAA, 0x9DF3, 0x99D8, 0x93B4, 0xC4B0, 0xCB1E, 0xCC08, 0xD0E7, 0xB9F1, 0x9F45, 0xD19E, 0x9AE1, 0xBD5D, 0xCD93, 0x86BB, 0xAD0D, 0xB9BE, 0xBF67, 0xB98A, 0xCCD6, 0xA124, 0x9080, 0xBFB6, 0x9EF0, 0x9EF6, 0xB556, 0xC830, 0xBD68, 0xA8EC, 0x9BF0, 0xCEA2, 0xAE27, 0x859A, 0xCD34, 0xB5F5, 0xD463, 0xA775, 0x8982, 0xC431, 0x8C8C, 0x9900, 0xD4FC, 0xC06A, 0xB9BF, 0xAB07, 0xCC5F, 0xA49F, 0xA42D, 0x9388, 0x9E3E, 0xC6E4, 0xA925, 0xC112, 0x94D9, 0xC3C4, 0xCC60, 0xB1DA, 0xA004, 0x9791, 0xBD7F,
+		0xCEAC, 0xBCB2, 0xCEAD, 0xA6FF, 0xC9AF, 0xABFC, 0xD49D, 0x8ADF, 0xA31C, 0xBD15, 0x8DDA, 0xC04B, 0xA887, 0x962B, 0xB7E7, 0xAD27, 0xD060, 0xB9F5, 0xCB29, 0xAA6B, 0xAAC0, 0xB237, 0xC0EB, 0xA6AA, 0xCF95, 0xC2A2, 0xC2F4, 0xC1A0, 0xB3CE, 0xD013, 0xB9C1, 0xBC16, 0x8467, 0xB497, 0xBBD8, 0xCF37, 0xC67F, 0xCBAA, 0xB6EE, 0x9BCA, 0xBC17, 0xBCBA, 0xAEB9, 0xBCC5, 0xB9C2, 0xB74E, 0x845F, 0xB021, 0x8C15, 0x8BD7, 0xB02D, 0xC1EC, 0x88DB, 0xACD4, 0x95A6, 0x8F88, 0x98A1, 0x9B11, 0xC38C, 0xD4FD, 0x8F2A, 0xD2E6, 0xCC28, 0x9469, 0x9F76, 0x9A3C, 0x958A, 0xD532, 0xBDBA, 0xBDF6, 0xBC48, 0x86C5, 0x9F21, 0x9B77, 0x97A8, 0xCBD6, 0x954A, 0xBB01, 0xD2DF, 0xC8D5, 0xB90F, 0xAB09, 0xCC65, 0x8C8E, 0xA9B3, 0xA529, 0xAA28, 0xC557, 0x879B, 0xBD20, 0xCE26, 0xC676, 0x9A1D, 0x86C6, 0x8758, 0xD2F1, 0x9EAB, 0xA369, 0xB973, 0xB8B5, 0x8F16, 0xAC78, 0x84E8, 0xA987, 0x99FD, 0x99C4, 0xCCF1, 0x89BB, 0x92B5, 0xCCAC, 0x97AB, 0xAB0A, 0xB4E6, 0xCDA3, 0xB163, 0x9D06, 0xC7FB, 0x8A29, 0xB266, 0xC35D, 0x9D07, 0xA9BE, 0xA940, 0xA8EF, 0x9FE3, 0x97DA, 0x8D1E, 0xA946, 0xD276, 0x94E1, 0xB3E6, 0xA615, 0xC89C, 0x88F0, 0x9F26, 0x8CFF, 0x8C91, 0xBFAC, 0xAFDC, 0xBDF5, 0xC9E8, 0xC33F, 0xAC79, 0xBD83, 0xAD7A, 0xB8B6, 0x9857, 0xA700, 0xA518, 0xC04C, 0x8B31, 0x94F9, 0xB0AE, 0xC72F, 0xCA3E, 0xAB18, 0xD544, 0xAB45, 0xA2B6, 0xAAA4, 0xB09B, 0xBAE3, 0xD10F, 0xD0FD, 0x8B89, 0xC6EE, 0x9AC2, 0x9BDF, 0x9E4B, 0xC836, 0x8C56, 0xA785, 0x8D71, 0xBE22, 0xC95C, 0x9E7D, 0x8D1F, 0xCC8D, 0x9972, 0xC5FA, 0xBAE4, 0x9712, 0xCC67, 0xC516, 0x8D20, 0xA457, 0xC6EF, 0xA746, 0xD2F2, 0xC116, 0xB13D, 0xA1FC, 0x9F2A, 0xB1DC, 0x8E9F, 0x9B85, 0x9F83, 0xC279, 0xAFE9, 0x9CA3, 0xB001, 0xC11A, 0xBC2A, 0xB39C, 0xB384, 0xC395, 0xA9CC, 0xC396, 0xBC95, 0x9152, 0x9AE8, 0xAD16, 0xB783, 0xBC26, 0xA588, 0xD1B1, 0x8D21, 0xCB37, 0xD2FC, 0xC06D, 0xBD22, 0xBD38, 0x8A2C, 0xC7B4, 0xA435, 0xC9D5, 0xB8F0, 0xBF87, 0xD3BB, 0xB79E, 0x9917, 0x86E1, 0xB874, 0x8990, 0x8991, 0xBABC, 0xBF35, 0xB23A, 0xA7F1, 0xC11B, 0xB80F, 0xB808, 0x8AE6, 0xA0A3, 0x8AE7, 0x9A5B, 0x9ECD, 0xA593, 0x8A55, 0x9509, 0x8D92, 0x9985, 0xBB69, 0xBAE6, 0xB0B7,
+		0x9832, 0xCF3C, 0xA8D0, 0x9986, 0x9447, 0x98A6, 0x98EA, 0xC43E, 0x9E4F, 0xB875, 0xC397, 0x850E, 0xA98A, 0xA4F0, 0xAF48, 0xCDB2, 0xA813, 0xB996, 0xCFEB, 0x8E82, 0x9AC7, 0xD01F, 0xC5C4, 0xCE44, 0xA65F, 0xBA59, 0xC768, 0xA865, 0xAB1E, 0xD510, 0xCCB3, 0x9B8A, 0x88CA, 0xA749, 0x8FB8, 0xCA13, 0x8511, 0x9A96, 0xD1B4, 0xD0B6, 0xAB1F, 0x8E4F, 0x8E70, 0xCB55, 0xBC6A, 0xB13F, 0xA185, 0xBCDE, 0xB49F, 0x9C4B, 0xD4D3, 0xA70F, 0xAF65, 0x97C9, 0xA011, 0xB085, 0xB80B, 0x9CAD, 0xBC4B, 0xC184, 0x8BFA, 0xBCE4, 0xBBBA, 0xBF0C, 0xB80C, 0xD3F2, 0xA4D7, 0x87A4, 0xA710, 0xAC5E, 0xB9D0, 0xBAE9, 0xAC81, 0xAF49, 0xCC70, 0xCC71, 0xAC09, 0xA017, 0xB2BC, 0x86F2, 0x9D1D, 0x988E, 0xB736, 0xD5D4, 0xC21B, 0xBDC1, 0xCBFD, 0xCBB8, 0x9125, 0x8CD5, 0x9BF8, 0xB4F4, 0xA52D, 0xA259, 0x8475, 0xB229, 0xD4D8, 0xC11D, 0x87A5, 0x969D, 0xB38D, 0xCC72, 0x87A6, 0xA486, 0x9322, 0x88DE, 0xAB88, 0x9E66, 0xC15E, 0xAAAA, 0xCFBB, 0xA9D6, 0xA6BE, 0xCCF7, 0xC952, 0xA990, 0xBBA5, 0xD305, 0xCC9B, 0x90C6, 0xC0CE, 0x9B3B, 0xC5CE, 0xB010, 0xB960, 0x8766, 0x8998, 0xACDA, 0xA8AF, 0x9DCF, 0xB245, 0xB811, 0x8AEB, 0x96A0, 0x87AA, 0x9C02, 0xC49F, 0xA968, 0xB4BA, 0x9866, 0xCC7A, 0xB702, 0xA6CE, 0xA086, 0xC52C, 0xCC7B, 0xBC56, 0xC164, 0xC5D0, 0x9A6B, 0xC77F, 0xD3C4, 0xA8F8, 0xA4DD, 0x9129, 0x92B9, 0x99EF, 0xA4DE, 0xBAAA, 0xA871, 0xCC7C, 0xAC5F, 0x9D31, 0xA74E, 0x9E68, 0xCC43, 0x936F, 0xA802, 0xCB79, 0x9DD6, 0xCF0A, 0x89A2, 0x8E1F, 0xB66C, 0x9E1A, 0xA500, 0x9298, 0xBB16, 0xCE8B, 0xD4DE, 0x939B, 0xB918, 0xA5D9, 0xA501, 0x8D28, 0xB5B3, 0xCCBC, 0xB38F, 0x9264, 0x9719, 0xA219, 0xC8FD, 0x98AD, 0xCC7F, 0xB79F, 0xCFC4, 0xAA33, 0xC8F3, 0xA63E, 0xAC61, 0xB8AA, 0xBD26, 0x8E8F, 0x9F13, 0x89C9, 0xCC81, 0x98B0, 0x8553, 0xD3DE, 0xA532, 0xA50A, 0xAE39, 0xC071, 0xAA37, 0xC7BF, 0xC471, 0xC900, 0x9520, 0xC904, 0xCE6C, 0x9BDA, 0x874C, 0xACD1, 0x9724, 0x8EF0, 0x9B16, 0xC4F2, 0xC4F3, 0x9B17, 0x993B, 0xA82E, 0xCFEF, 0xD44A, 0xA0C6, 0xD085, 0xC59B, 0x8B4F, 0xD4B3, 0xCF8D, 0xCF8E, 0x88C7, 0xC91A, 0xB2FA, 0xD32A, 0x9341, 0xAF54, 0x978A, 0xC1B1, 0xB210, 0xCAE8, 0xA957, 0x8C61, 0xB0DE, 0xA284, 0xB01A,
+		0xC84D, 0xA285, 0xB041, 0xADC1, 0x8A33, 0xA7B1, 0xB624, 0xA2C7, 0x9145, 0x9726, 0xC5FF, 0xD2B1, 0x9727, 0x9728, 0xC12E, 0xB3FA, 0x871F, 0x8DD2, 0xC3B7, 0xD1FE, 0xD3CE, 0xA306, 0xD12D, 0x9412, 0x8536, 0xC5A3, 0xC5A4, 0xA886, 0xD4A3, 0xC73E, 0xA15F, 0xBD59, 0xB04B, 0xA098, 0xB04C, 0xBCF9, 0xBCFA, 0x87D1, 0xC944, 0xBF0F, 0xB87F, 0xD40A, 0xD08B, 0x8F98, 0xC105, 0xB9E9, 0xA739, 0x8B56, 0x9B02, 0xD363, 0x99B9, 0xBB36, 0xA528, 0xBEDE, 0x9EE9, 0xC6D5, 0x992A, 0xB618, 0xA7C6, 0x9AB3, 0xC926, 0xC660, 0xC661, 0xC454, 0xCBC6, 0xC22E, 0xD365, 0xC974, 0xBA34, 0xC0D9, 0xBF22, 0xC77B, 0xCF92, 0xA287, 0x993F, 0xA58D, 0xC3BB, 0xD3D1, 0xC736, 0xD200, 0xB1F4, 0xC827, 0x9B47, 0x972F, 0x8A4D, 0x9B21, 0xA2D3, 0x8A6C, 0x9285, 0xB8D0, 0xC9F8, 0x9B64, 0x9B65, 0xBDAA, 0x9FFC, 0xB68F, 0xA7C7, 0xC2DB, 0x9426, 0xA09B, 0x8A4E, 0xB234, 0xBEE0, 0xD4BB, 0xBF02, 0xC080, 0x97FE, 0xB097, 0xA553, 0xB866, 0xC6DC, 0x9384, 0x8B5A, 0xB6E3, 0x99D3, 0xC2DC, 0xBA94, 0x98FF, 0xC783, 0xA385, 0xA1E6, 0xC507, 0xC2DD, 0xC82D, 0x984B, 0xD470, 0xA24D, 0x8F62, 0xC5AC, 0x9C8D, 0xC298, 0xC299, 0xA9FD, 0x982A, 0xC8C8, 0x9AB6, 0xABE8, 0xA11F, 0x8ED0, 0x8628, 0x9348, 0xA81D, 0xA44F, 0x9BC9, 0xBFD8, 0xC2E7, 0x8D83, 0xC24F, 0x9943, 0xA170, 0x9466, 0xC215, 0x9EC1, 0x8CFC, 0x93B5, 0xB1C1, 0xB504, 0xB78E, 0xC2E8, 0xB505, 0xC605, 0x8A1E, 0xC8CE, 0xC8CF, 0xCB1F, 0x9EC2, 0xCE1D, 0x953E, 0xB65A, 0xB111, 0x968D, 0xC0C7, 0xB98B, 0x96C1, 0xB8D2, 0xB8D3, 0xAE72, 0x95D8, 0xC0E5, 0x8A78, 0x9903, 0xA421, 0xD346, 0x9101, 0xC3AC, 0x9B2C, 0x93B6, 0xA744, 0xC710, 0xC611, 0x9AE5, 0x9EF7, 0x9DB5, 0x86C7, 0xCC21, 0xD3EE, 0xC14A, 0xBFA0, 0xB883, 0xC2F5, 0xAF99, 0xAF9A, 0x8986, 0xC833, 0xA09C, 0xA0A1, 0xCE27, 0xABD3, 0xB848, 0xC179, 0xD074, 0xA000, 0xB50A, 0xB71F, 0xABA4, 0x9ABF, 0xC6E9, 0xD146, 0xC0EC, 0x928A, 0xB55A, 0xBC65, 0xC931, 0xA7CE, 0xD3EF, 0x9F48, 0xCFF8, 0x96C2, 0x85CB, 0x99DD, 0xCF16, 0xAE22, 0xBA48, 0xA777, 0x8710, 0x9650, 0xBFCD, 0xBD84, 0x8A35, 0xB872, 0x920A, 0x8832, 0x9B0B, 0xC583, 0xC391, 0xC2A5, 0xC2FB, 0xCF18, 0x98E6, 0x9905, 0xC2FC, 0xCF3B, 0xC656, 0xADFB, 0xB885,
+		0xB50B, 0xC9BF, 0xC786, 0xAC7A, 0xA704, 0xCC68, 0xC271, 0xB6F2, 0x9620, 0xC8DA, 0xB3FC, 0xA888, 0xC2FD, 0x86D6, 0x875D, 0x8D07, 0xA9C4, 0x9DBF, 0xBAB2, 0xCB42, 0xA92F, 0xB0B5, 0xBC4A, 0xCB43, 0xBF15, 0xA1FD, 0xD558, 0xCF68, 0x9A20, 0xCA86, 0xD2FD, 0x84ED, 0xC17D, 0xA8C5, 0x985A, 0xAFC7, 0xB690, 0xA0A2, 0xAB1D, 0xA45B, 0xB8B9, 0x8D5B, 0xD048, 0x9DC6, 0xC30F, 0xC310, 0xC311, 0xCB44, 0xC55F, 0x8B6C, 0x9AEB, 0x89C0, 0xCA0A, 0xC7B7, 0xB65E, 0xC8E0, 0xC7B8, 0xB413, 0xA258, 0x8EF5, 0x9153, 0xB93F, 0x9ED0, 0x9352, 0xBB6E, 0xA793, 0xCE45, 0xA7F6, 0xA0A4, 0xB8DB, 0x9353, 0x928F, 0xC8E1, 0xC7B9, 0xA52A, 0xABC3, 0xBDAD, 0xCB56, 0xA0A5, 0xABEB, 0xA058, 0xA059, 0xCC6D, 0xCE46, 0xCB64, 0xC769, 0xA4F6, 0xBD17, 0xCFFC, 0x88CC, 0xBC6F, 0xC317, 0xB63F, 0xD576, 0xA717, 0x9B53, 0xD3D8, 0xC5CA, 0xA86D, 0xC0F3, 0xCB65, 0xC58D, 0xD14D, 0x9B93, 0x8DE3, 0xAF9E, 0x87DD, 0xB73A, 0xC468, 0x990C, 0xC792, 0xA019, 0x8BB6, 0x8EDD, 0xC9C7, 0x990D, 0xA52E, 0x8769, 0xB700, 0xA6C6, 0xAD8E, 0x85FF, 0xB794, 0xAA20, 0xA4AB, 0x84E0, 0xC797, 0xA3F0, 0xBB45, 0xA7A3, 0xA6D4, 0xA96D, 0xA20F, 0xA7AC, 0xC8EF, 0xC32B, 0xC5D1, 0xA8FF, 0xCA1C, 0x88CF, 0xB678, 0xCF0D, 0xA52F, 0x8D2A, 0xA21A, 0x8B8E, 0x971A, 0xA192, 0x8A58, 0xAC43, 0xBDA7, 0xA530, 0xD3E2, 0xA526, 0xA87B, 0x8D61, 0xCE6E, 0xD432, 0xD135, 0xAFE4, 0xD1FB, 0xB04D, 0x8AA1, 0xCE96, 0x847D, 0xC113, 0x9830, 0xA1FE, 0xA20A, 0x9472, 0xC9D7, 0x8548, 0x8FEC, 0xC9DC, 0xC96B, 0xD5B5, 0xBB10, 0x9234, 0xB003, 0x8C53, 0xA2DF, 0xCFCB, 0x900A, 0xB3D4, 0xCCAE, 0xB3ED, 0xB146, 0x8588, 0xB01C, 0x8A22, 0x9DAB, 0xAFF4, 0x90BC, 0x8C4D, 0xCD9E, 0xD075, 0x8711, 0x900B, 0x85B0, 0x8833, 0x8C4F, 0xCD3A, 0xCD3B, 0x90C9, 0xC7EA, 0x8AA7, 0xAF4B, 0xAF4C, 0xB19E, 0xA0AD, 0xAD95, 0xBCBE, 0xADAB, 0xA564, 0xB6B4, 0xAB26, 0x92C0, 0xAB50, 0xADC2, 0x952A, 0xC28D, 0xC8BB, 0x957E, 0x8617, 0xBB90, 0x9E2A, 0x967F, 0xCD4A, 0x9580, 0xB981, 0xADAF, 0x8F45, 0xB33E, 0xB61F, 0xB5EC, 0xD53E, 0xBDE6, 0xC2D1, 0xAB38, 0xA338, 0xB346, 0xC26D, 0x96AE, 0xC2E9, 0xA60E, 0xA612, 0xB208, 0x9C2F, 0x9535, 0xBDE9, 0x8641, 0xB5F6, 0x8C8D, 0x90BD, 0xA171,
+		0x9D56, 0x9DAC, 0x938D, 0xB14A, 0xA2EE, 0xA125, 0xA2D5, 0x8BE2, 0xC392, 0x8631, 0xAAA5, 0x9FB7, 0xAAB7, 0xB7C6, 0xC17E, 0xA624, 0x9139, 0xBF3B, 0xB431, 0x9EAD, 0xC318, 0xA6C7, 0x90CA, 0xA7D9, 0xAFF8, 0xB16B, 0x884A, 0xC32C, 0x8B9A, 0xC123, 0xB685, 0x8B9B, 0xB614, 0xB613, 0x8530, 0x92A4, 0xB538, 0x9CC1, 0x8964, 0xD4B2, 0x84C4, 0x957A, 0xD46C, 0x973A, 0xAABB, 0xCCFF, 0xA2C5, 0x880F, 0x999E, 0xC80E, 0xCBBF, 0x926A, 0xD46D, 0xCF47, 0xA3CD, 0xAF51, 0x8F1D, 0xA3CE, 0xD34E, 0xADBF, 0x8612, 0xB0DF, 0xA11B, 0xCA5C, 0xC60B, 0xB04A, 0xC3B3, 0xC00B, 0x99CD, 0xA837, 0x930F, 0xB0E0, 0xAE9C, 0xC9A4, 0xCD43, 0x9634, 0xC778, 0xA0F2, 0x8E44, 0x8813, 0xA37D, 0xC44F, 0xAF2E, 0x9A87, 0x991E, 0x9EE4, 0x98C6, 0xD13E, 0x9C82, 0xC7C8, 0xB863, 0xBCD2, 0xB6DB, 0xBA73, 0xBF1D, 0xA565, 0x90DA, 0xC3B8, 0xB9BA, 0xA3A5, 0xD1FF, 0xCDFC, 0xBC7E, 0xCD80, 0x86AA, 0xAB37, 0xC2D2, 0xA1B1, 0xA607, 0xC3BC, 0xCBC7, 0xBC35, 0xA53A, 0x861E, 0xD2C3, 0xC851, 0xB82C, 0xA68F, 0x9847, 0xC455, 0xA02F, 0xAFF2, 0x92EB, 0xC508, 0x856A, 0xB293, 0xAE88, 0x8754, 0xCC09, 0xA4C4, 0xA7C9, 0x9DA5, 0xB3C6, 0xA44C, 0xC8D0, 0x97E3, 0xD57E, 0xAC33, 0x89D9, 0xCA34, 0xD2CE, 0xD207, 0xC7F7, 0xAC1A, 0x8892, 0x8D3B, 0xB8F2, 0xA7CA, 0x892C, 0xAA72, 0x99D9, 0xD54F, 0x94AD, 0xC143, 0x9389, 0xC1FD, 0xCEA3, 0xC610, 0xD3B2, 0xAB49, 0xC178, 0x8C35, 0xC48D, 0xBF9F, 0xC81E, 0xAE44, 0xC57B, 0x9D52, 0x9990, 0x870D, 0xCD16, 0xCD1A, 0xAB9F, 0x894B, 0xC983, 0xBC83, 0x882C, 0xAD28, 0x9A3D, 0xCEAE, 0xBBD9, 0xAAC1, 0xCEAF, 0x8DF3, 0xCE33, 0xB9C3, 0x9885, 0xB3CB, 0xCB2A, 0xD1A4, 0x88DC, 0x9889, 0xAB19, 0xA07D, 0x9E97, 0xADA7, 0xD0FE, 0x8989, 0x84E9, 0x84EA, 0x9561, 0x8D6C, 0x864F, 0xA1FF, 0x86E2, 0x86D7, 0x9AE9, 0x886D, 0xA7F2, 0xC6F5, 0xBF36, 0xC9C2, 0xA78F, 0xBB42, 0xC51C, 0xBA7F, 0x9135, 0x9F2D, 0x95C4, 0xA1C2, 0x90C1, 0x9B8B, 0xA3AF, 0xC464, 0xC5C5, 0xBCCC, 0xC465, 0xC78F, 0xAF3A, 0x8716, 0x9BF9, 0xAF78, 0xAC0A, 0xA7D8, 0xAE4A, 0x973C, 0xBA80, 0xA969, 0xBCCF, 0xC4A1, 0xAC41, 0xB66D, 0x9A7D, 0xAE50, 0xAA83, 0xC46E, 0xB2D2, 0xA21F, 0xC727, 0xACC8, 0xD4EE, 0xAA14, 0xA95D, 0xCDEE, 0xBBFE,
+		0xC0B8, 0xB539, 0x94B8, 0x9025, 0xA59F, 0x851E, 0x9C19, 0x9952, 0x8F55, 0x8C43, 0x94EA, 0xA0C7, 0xCD6F, 0xB2DD, 0xCD70, 0x8A0D, 0xAABC, 0x9957, 0xC266, 0x93D3, 0xACEA, 0x9FA7, 0xC811, 0x932D, 0xABDD, 0x85B2, 0xCEF0, 0xA1D5, 0xCF48, 0xA1D6, 0xC07B, 0x9D9C, 0xAB90, 0x8E45, 0xB18E, 0xC12F, 0x9A83, 0xC475, 0xB3C4, 0xA83C, 0x8E06, 0xCAF4, 0x84B7, 0xB1B6, 0x8FA9, 0x9413, 0xC728, 0xC354, 0x87F3, 0x8CB8, 0x9637, 0x9D79, 0xB8E3, 0xB1F2, 0x8CBF, 0xA2E0, 0xB665, 0xB982, 0xB92A, 0xBCF0, 0xD5B6, 0x9D47, 0xACEC, 0xB864, 0xA8C0, 0x86A4, 0xD18E, 0xD18F, 0xA1D8, 0x9265, 0xA8E4, 0x9FCB, 0x9F1A, 0x9E32, 0xA690, 0x9730, 0xC3FB, 0xD366, 0x9618, 0xC828, 0xC67A, 0xC927, 0xC6BD, 0xAA9F, 0xBC05, 0xD3B0, 0xB488, 0xCBC8, 0xC7AA, 0x85DA, 0x9232, 0xC4E4, 0xC975, 0xC3BD, 0x992B, 0x974C, 0x8B79, 0xC6D6, 0x8576, 0xB07A, 0xA583, 0x8E7C, 0xCC18, 0x9B22, 0xC1B7, 0x91A5, 0x8AD1, 0xBE11, 0xC26E, 0xC24C, 0x8ACE, 0xA24E, 0xAB97, 0x8623, 0xBF03, 0xC486, 0x8C0E, 0x984C, 0xC72C, 0xC856, 0xB550, 0xC743, 0xA53F, 0xA656, 0xC67D, 0x9427, 0xA890, 0xC7F0, 0xC2DE, 0xA84B, 0xA698, 0x9FCE, 0xA727, 0xBCAC, 0xBCAD, 0x9385, 0xC081, 0x8FB0, 0xB191, 0xD0CE, 0xB803, 0xACF2, 0xCE1E, 0xA106, 0x8A5C, 0xA4C8, 0xACF3, 0xB9A5, 0xC114, 0xBE85, 0x8C12, 0xD4C6, 0x8661, 0x8AA8, 0x99FB, 0xB29E, 0x91EA, 0xA450, 0x89DE, 0xB29F, 0x8662, 0xC097, 0xA9AD, 0x8D1C, 0x96FA, 0xC895, 0x8FC8, 0x8629, 0xA7E7, 0xB48F, 0xB112, 0xAFE7, 0xB3C7, 0xD4AA, 0xB490, 0x9266, 0x9FD0, 0xB506, 0x90DE, 0x9FB4, 0x9B2D, 0xCDE0, 0xAB7F, 0xABA5, 0x8C7C, 0xC711, 0xBE1E, 0xC612, 0x8E13, 0xB712, 0xACF6, 0x9DB6, 0xC14B, 0xB760, 0x9AC0, 0xC581, 0x8C2F, 0x9E00, 0xD44E, 0x8A13, 0xA745, 0xB5FD, 0xC3C9, 0x9102, 0xCED7, 0xC7F8, 0xAE5A, 0x99DE, 0xCC22, 0xCD9F, 0x9AE6, 0xB778, 0xB805, 0xA89D, 0xB9C8, 0xB6F3, 0xBCB3, 0xC989, 0xC1A1, 0xC274, 0xD1AE, 0xA009, 0xCC6A, 0xAAC3, 0xBDBC, 0xA8A0, 0xBD85, 0xA6B3, 0xA542, 0x9755, 0xBE91, 0xB3D5, 0x8D9D, 0xA1F6, 0xD138, 0xC98A, 0x8488, 0x879E, 0xBAA0, 0x89F8, 0xA45C, 0xD43C, 0x8D08, 0x9FE9, 0xCA44, 0x8D72, 0x8D09, 0xB2B4, 0xA61F, 0xA055, 0xCFEA, 0xB561, 0xC731, 0x84EE, 0xA72C,
+		0xABE3, 0xD104, 0xCBE1, 0x9A40, 0xCC30, 0xC185, 0xA9D2, 0xC253, 0x8EF6, 0xC681, 0xD049, 0xC7BA, 0xB5A8, 0x8EA6, 0xA7F7, 0xA794, 0xB512, 0xBC2D, 0x9AEC, 0x975F, 0xB228, 0xA05A, 0x9290, 0x9FD3, 0xA8A7, 0x9C4C, 0x9B8C, 0xA05B, 0x8490, 0x86F8, 0x88CD, 0xB5AC, 0xCFEC, 0x8E83, 0x9F09, 0x97B8, 0xBD56, 0x9B94, 0x9A99, 0xB810, 0xBC70, 0xBE79, 0x8BFD, 0xCDB6, 0xA01A, 0xA96A, 0x87A9, 0xBAB3, 0xC1E1, 0xD5D5, 0xA4FB, 0xC36C, 0x9D23, 0xC733, 0xB767, 0xC21C, 0x94E2, 0xA5B6, 0x90CB, 0xA6CF, 0xD315, 0xB71A, 0xA6ED, 0xCFBE, 0xB514, 0xBE56, 0x9768, 0xBC54, 0xA4AF, 0xA900, 0xA96E, 0xA6D5, 0xCFC1, 0xA531, 0xCC47, 0xAC46, 0xC4BE, 0xB517, 0x971B, 0x8EE0, 0xCFC6, 0x9AA4, 0x96BB, 0x8814, 0x8FF7, 0x881E, 0x9A17, 0xD270, 0xB16F, 0x859F, 0xB670, 0xA6E9, 0xC050, 0xBA68, 0xC4C3, 0xB3FF, 0x8589, 0xB087, 0x92D6, 0x8E46, 0xC1F1, 0xAFC2, 0x93BD, 0xACE1, 0xC054, 0x99CE, 0xA83D, 0xCA66, 0xB1B9, 0x8CC6, 0x8572, 0xA1B5, 0x89F1, 0x8B00, 0xA8D9, 0x8E9C, 0xAC1B, 0x8881, 0xD482, 0xB1C2, 0x8712, 0xD39C, 0xD1A5, 0xA8DA, 0x8CCA, 0xCC29, 0xB30E, 0xC438, 0xA941, 0x8F7C, 0xAFC8, 0xABFF, 0xD43D, 0x8669, 0xA821, 0xD052, 0xC27D, 0xB141, 0x8E75, 0xC1F3, 0xCC3B, 0xC8A7, 0x94E4, 0x8DFE, 0xB966, 0xBE6C, 0xBE6D, 0x982E, 0x89F3, 0xB977, 0xB4D1, 0xB92F, 0x89B3, 0x89B4, 0xB76A, 0xB98C, 0xBBF3, 0xB93D, 0xCCE4, 0xBAF5, 0xA999, 0x9458, 0xCCC6, 0x865D, 0x93B2, 0xAF46, 0xBE63, 0xCCFD, 0x9D77, 0xB91B, 0xD06E, 0x8FA1, 0x93F8, 0xAE13, 0x8FA3, 0xB1A3, 0xBEDB, 0x9A4C, 0x9026, 0xB616, 0xCF8F, 0xABF3, 0x869F, 0x86A0, 0xA0D2, 0xBC89, 0xA88B, 0x8A0E, 0xADB0, 0xCF2B, 0x92AB, 0x9414, 0x95D2, 0xBE67, 0x9FA8, 0x9FA9, 0xB617, 0xC16D, 0x9415, 0xBE68, 0xADB1, 0xBDE3, 0xB01E, 0x9E77, 0xC044, 0x941F, 0xAE1B, 0xA88E, 0x8648, 0xD1C6, 0xC6D7, 0xADB4, 0xADB5, 0x890A, 0xBE6B, 0x94C5, 0x86AB, 0x8DC2, 0xD2CF, 0xAA42, 0xB294, 0xAA43, 0xB000, 0xCA35, 0x926B, 0x9A51, 0xCD94, 0xCD95, 0xADB7, 0xA24F, 0xCB0D, 0xA891, 0xB8F4, 0x9002, 0x8BA4, 0x9A53, 0x8E7F, 0xD484, 0x9C35, 0xC099, 0x9EA8, 0x8AE2, 0x8A15, 0xBFE9, 0xA5B1, 0x9C41, 0x893F, 0xA8A8, 0x9013, 0xBFB4, 0xA824, 0x9E87, 0x9E89, 0x8BB8, 0xB142,
+		0x901A, 0x901B, 0xC7FD, 0x9F5D, 0x8E59, 0xBA98, 0xBA99, 0xD2F3, 0xCA84, 0xAE63, 0xAE02, 0x9027, 0x8684, 0x9F36, 0xA77E, 0x952B, 0x957F, 0xA0D3, 0xD384, 0xC3E0, 0xB889, 0xC68E, 0xAF93, 0xA67B, 0xC91E, 0x8A8E, 0xCD07, 0x8523, 0x9FAA, 0xC779, 0xB282, 0xC3A6, 0x8D77, 0x86A5, 0xCAF5, 0xA682, 0xD57C, 0x8C4A, 0x937C, 0xD40C, 0xB058, 0xB106, 0x94F1, 0xA2E4, 0x8CB9, 0xC754, 0xD248, 0x8E49, 0xD1C7, 0x9254, 0xD29B, 0xBDF0, 0xA0F8, 0x9C87, 0x9316, 0xA0F9, 0xB867, 0x9D4B, 0xC729, 0xD08D, 0x87E6, 0xAEB3, 0xD22B, 0xCCA2, 0xA06B, 0xB5F2, 0xBDF1, 0xBF11, 0xCA73, 0x9F3A, 0xC13A, 0x9B4A, 0xBD1C, 0xC946, 0x8B5B, 0xD2D0, 0x991A, 0xC803, 0xABD0, 0x90A5, 0xA077, 0xC50A, 0xBF48, 0xBF68, 0xAB3C, 0xB12D, 0xC50B, 0xBC36, 0xB215, 0xA6A2, 0xD2E0, 0x8DA7, 0x9169, 0xC29C, 0xB831, 0xA56C, 0xBA79, 0x9ABE, 0xC432, 0xCB2B, 0x8659, 0xD11E, 0x96FB, 0xBD32, 0x931B, 0xAAB4, 0xA7E9, 0x8B09, 0x9CFE, 0xBE88, 0x84A7, 0x8B60, 0x86C8, 0x86C9, 0xACF7, 0x96B2, 0x9112, 0xA2E9, 0xC14C, 0xCD63, 0x8D84, 0xC805, 0xD3D6, 0x8D3E, 0x8E34, 0xB971, 0xD348, 0xD2F4, 0xCE34, 0x9992, 0x938F, 0xA4A3, 0xC933, 0x8A7C, 0xBF7F, 0xCDE1, 0x97C6, 0xC1BB, 0x9AC3, 0xA38A, 0x9D86, 0xCBAF, 0xCB38, 0xA947, 0xB7F6, 0xA200, 0x8B6A, 0xC307, 0x96F0, 0xCB45, 0xC1A4, 0x9CA4, 0x8C50, 0x857D, 0x9B7C, 0xA8A1, 0x896F, 0xBA7C, 0xAF95, 0x8F8A, 0x8DDF, 0x8790, 0xBD23, 0xD124, 0x8760, 0xB975, 0x8D46, 0xAA92, 0xCB57, 0xD582, 0x8B98, 0xD125, 0xD0A6, 0xBBF1, 0xB80D, 0xB627, 0x8FE7, 0xA949, 0xA94A, 0xAD82, 0xB4A0, 0xCBFA, 0xCC8E, 0x9AF0, 0x944F, 0xA797, 0xA8F1, 0xB432, 0xA8AA, 0xA573, 0xC444, 0x9320, 0x8E3E, 0xCC0D, 0x990B, 0x846C, 0x8581, 0xC445, 0x96F1, 0xC0F5, 0xAF71, 0xCE53, 0xC121, 0xCB6B, 0xCCB7, 0xB133, 0xA5D4, 0xA6BF, 0xBAC0, 0x8E76, 0xA816, 0x8FBB, 0xA817, 0xA4DB, 0x8791, 0x9D2A, 0x8B11, 0xC953, 0xC739, 0x9F4D, 0xD219, 0xAB8B, 0xA6DC, 0x9C0A, 0xA4E1, 0x9C61, 0x8F90, 0xC73A, 0xCC94, 0xCC01, 0xB5B9, 0xCCC0, 0xBD27, 0x8F94, 0xAA97, 0xAA3A, 0xAA3B, 0x87EF, 0x87F2, 0x9777, 0x8537, 0x924A, 0x8EF1, 0x924B, 0x8557, 0x8558, 0xB454, 0x866D, 0xCFF0, 0xCFF1, 0xAB6D, 0x8EEC, 0xAFDE, 0x9F37, 0x9AD4,
+		0x9748, 0x8CE0, 0xA83F, 0x9543, 0xAD6A, 0x9C83, 0x9EEB, 0xB0C9, 0x9547, 0x8464, 0x91A7, 0x98A0, 0x9850, 0x86BC, 0xC098, 0x8BA7, 0xB3B0, 0xC2F6, 0x846B, 0xC544, 0x98A7, 0x9B14, 0x98A8, 0xD020, 0x8D74, 0x98A9, 0xC548, 0xCA0D, 0xB1E3, 0x98AB, 0x9F0B, 0x8494, 0xC61E, 0x9B0F, 0xA6D6, 0xB176, 0x98F6, 0x9F33, 0xB0FD, 0x94FF, 0xB059, 0xD397, 0xA232, 0xB557, 0x8D90, 0xB634, 0xD063, 0x9698, 0xA237, 0xA238, 0xD39E, 0xD067, 0xD068, 0xD0DB, 0x90CC, 0xAD00, 0xB2DE, 0xCC53, 0xCD72, 0x8606, 0xD12E, 0xD32D, 0xB088, 0x98C7, 0xCC56, 0x98CE, 0xCB02, 0x87F5, 0xC08A, 0x98CF, 0x84D9, 0xD0CB, 0xC855, 0x9DA2, 0x98D4, 0xCD61, 0xA3FA, 0x92AF, 0x94D7, 0x8ED1, 0xB005, 0x9467, 0xB558, 0xB9A2, 0x8ED4, 0xA9B4, 0xD0D0, 0xABFD, 0xA620, 0x9FE4, 0xC6F0, 0x9DBC, 0xB00C, 0x9715, 0x84AE, 0xA963, 0xC6C6, 0xD379, 0x8EA7, 0x84B3, 0x96A1, 0xA6D7, 0xADBC, 0x9028, 0x9512, 0xC68F, 0xAB2F, 0xC0D4, 0xD261, 0xB2FB, 0xCEC9, 0xBE69, 0xC4A8, 0x913E, 0xC5E4, 0xC37F, 0xB01D, 0x937D, 0x9146, 0xACED, 0xAD1F, 0xB99A, 0xBA30, 0xCF2C, 0xAC9F, 0xAFFF, 0x92D7, 0xACBD, 0x8E29, 0xAB93, 0xAB72, 0xA2A6, 0xC47B, 0xA358, 0xBA35, 0xC90C, 0xD201, 0xC9A8, 0xB930, 0x9BE7, 0x8DEF, 0xD1C8, 0xA3E2, 0xA0FA, 0xB969, 0xCA6F, 0xB99B, 0xABCD, 0xAD6B, 0xD40D, 0xD194, 0xD195, 0xAC89, 0xBA36, 0xCE75, 0x8FF8, 0xAEB4, 0xD540, 0xD541, 0x8A34, 0xD07C, 0xC740, 0x87D3, 0xAC55, 0xA8E6, 0xBC46, 0xBF25, 0xAC58, 0x901F, 0x8F63, 0xAE67, 0xAD23, 0xC744, 0x8B01, 0xA3E4, 0xA1B6, 0xAD9B, 0xD1E5, 0xBEB9, 0xC928, 0xC88A, 0xAD71, 0xD356, 0xAD6C, 0xD4C2, 0xD208, 0xABA0, 0xBC9D, 0xB426, 0x9851, 0x9AA5, 0x8F6B, 0x8AF4, 0x8DA8, 0xA1B8, 0x91A8, 0xAB08, 0xC489, 0xC2EA, 0xA475, 0xA4D3, 0x9DF9, 0xB884, 0xBE89, 0x97B6, 0xC06B, 0xA601, 0xBA49, 0xB50C, 0xA7CF, 0xC7F9, 0xA035, 0xD20F, 0xA616, 0xA4D4, 0xBC66, 0xA1BC, 0xBB25, 0xBC49, 0x90B4, 0xB13B, 0xB13C, 0xADD0, 0x9BB7, 0xAEBA, 0xA9B5, 0x954B, 0x9F77, 0x88A1, 0xD387, 0xCDA4, 0x9BB8, 0xAAD0, 0xC3E5, 0xC634, 0x9B31, 0x97B7, 0xCF96, 0x8C9B, 0x9DB7, 0xABD4, 0x90E0, 0x9993, 0xC713, 0xBE92, 0xA412, 0x954D, 0xB77A, 0xAB80, 0xC6F1, 0x925B, 0xC21A, 0xACB7, 0x8BC4, 0x9794,
+		0xAC2D, 0xC1EF, 0xA36C, 0x8EA1, 0x8A5F, 0xA2F6, 0xBBEB, 0xC3AE, 0xB50D, 0xAD83, 0xAD2E, 0xAAFD, 0x935E, 0xD021, 0xD126, 0xCB58, 0x8FCD, 0xA35C, 0xAD84, 0xBB2D, 0x8D0D, 0xA932, 0x8B0C, 0xAAFE, 0xB173, 0x8AB1, 0x9760, 0xAC02, 0x9AC8, 0xBB2E, 0xB183, 0xC319, 0xB433, 0xB22A, 0xD3F4, 0x8F05, 0xB95E, 0xBB2F, 0xA7B5, 0x8A57, 0x9E5E, 0xA2AB, 0xCA0E, 0xC6F9, 0xBF3D, 0xAD9F, 0xA7FA, 0x9799, 0x9E5F, 0xCA19, 0x8495, 0x9B9A, 0xD0BC, 0x9E12, 0x96A2, 0xD150, 0xA18B, 0xABB1, 0x8BC6, 0xB7F8, 0xAF42, 0xC794, 0xC9CA, 0x9BFE, 0xAC0F, 0xAC95, 0xB198, 0xAC96, 0xA5D7, 0xA3F1, 0xA215, 0xC71F, 0xAC97, 0x9C0B, 0xA970, 0xAC14, 0xA901, 0x971C, 0xAB0F, 0xC36E, 0x8C23, 0xBDA8, 0xA2AE, 0xD3F7, 0xAB4C, 0x9FC5, 0xB489, 0xCE1F, 0xBB3B, 0xD014, 0xA222, 0xBA04, 0xCAA2, 0xB950, 0xD23B, 0x998D, 0xB952, 0xCC84, 0xA13E, 0xD36C, 0x9EEC, 0x8DA9, 0x90FA, 0x8471, 0x9F0A, 0xD04A, 0xCEE4, 0x8550, 0xB9DC, 0x9039, 0xB2F6, 0x9C1C, 0xD526, 0x9513, 0xC28E, 0xD158, 0xA371, 0x952C, 0xC2BD, 0x9281, 0xA3DE, 0x8E96, 0xAABD, 0xB828, 0x8E2A, 0xA1D9, 0xA683, 0x93D7, 0xC1B2, 0x91B8, 0xC691, 0xA2B0, 0xD298, 0xB2FE, 0xA298, 0x9FAB, 0x8795, 0x91C9, 0xC815, 0xB0E1, 0xD159, 0xC852, 0xBC5B, 0xD438, 0x8A40, 0x9147, 0xC055, 0xC8BF, 0x9FC6, 0x937E, 0x9262, 0xAD6D, 0xD07D, 0xA0FB, 0xCE03, 0xAA8E, 0xC00F, 0xC010, 0x8F46, 0xD0A3, 0xD196, 0x91D2, 0x9420, 0xADB6, 0xD3E9, 0xA498, 0x870B, 0xAF86, 0xB1A6, 0xB095, 0xA840, 0xB05A, 0xA5A2, 0xB1F5, 0x87F6, 0xB1F6, 0xB931, 0xCF85, 0x847E, 0xA691, 0xA8E7, 0xBF23, 0xCCE6, 0xA692, 0xA3E3, 0x8BF3, 0xA337, 0xB5F3, 0xD3B3, 0x9732, 0x974F, 0x91D9, 0xAE42, 0xB327, 0xA9A8, 0xC13B, 0x847F, 0x95C0, 0xC487, 0xC62D, 0xC857, 0x907E, 0xB098, 0xC2DF, 0xA584, 0x9964, 0xBB3A, 0xC3BF, 0xB401, 0xB4D6, 0xC172, 0xB423, 0x8929, 0xA339, 0xC50C, 0xB62E, 0x89CC, 0xA543, 0x9944, 0xCD96, 0xC50D, 0xC3C5, 0x85B7, 0xA91D, 0xCCD7, 0x8A43, 0x8A51, 0xC216, 0xA892, 0xCC97, 0xAB39, 0xB62F, 0xC8D1, 0xB832, 0xA46B, 0x968E, 0x892A, 0x9A31, 0xA983, 0x8A85, 0x9DFA, 0xAF9C, 0xBBB6, 0xD4C8, 0xA33B, 0xB0AF, 0xC899, 0xD485, 0xA34B, 0x8AE0, 0xB2A4, 0xD57F, 0xB4EE, 0xA7EA, 0xA235,
+		0x8801, 0x9041, 0xACF8, 0x8FCA, 0x9DB8, 0x9904, 0xA9B6, 0x8485, 0xBC67, 0xB635, 0xA5F9, 0x860A, 0xCBD7, 0x9121, 0xC272, 0xB2A5, 0xAA5F, 0xC09A, 0x8F9C, 0xAE47, 0x862D, 0xB30B, 0x8A86, 0xB763, 0xC864, 0x900E, 0x9043, 0xD381, 0xC018, 0x9F7F, 0xCC24, 0x8726, 0xA31E, 0x86D8, 0xC151, 0xBB41, 0xD1AF, 0x9104, 0xC3CB, 0x8EA0, 0xBE20, 0xAE5C, 0xB0CD, 0x9E08, 0x8F77, 0xBD61, 0x8950, 0xBE74, 0xB30F, 0x914F, 0x9390, 0xC74B, 0xB63D, 0xB40D, 0xAAC4, 0x960B, 0xAED1, 0xBC27, 0x91AD, 0x8CCD, 0xA8A2, 0xBB6A, 0xBD89, 0x85BE, 0x9A41, 0xB0F1, 0xA424, 0xB7DD, 0xA5CE, 0x8D9F, 0xBE76, 0xC3CE, 0xB269, 0xA70A, 0xB00D, 0xC17F, 0xCF52, 0x9113, 0xD16D, 0xA2F7, 0xA49A, 0xAFFE, 0xC991, 0x97AE, 0xD440, 0x8993, 0xB314, 0x84F0, 0xB09E, 0xA8D1, 0xA98E, 0xA2AC, 0x8C57, 0x8A89, 0xCBB1, 0xA5D0, 0xB166, 0xB315, 0xAD4B, 0xB316, 0x8D6D, 0xD55F, 0xB4F2, 0x8CB1, 0xAA64, 0x9C52, 0xB89E, 0xA574, 0xB5A9, 0xD04B, 0x8F06, 0x9E60, 0xC39D, 0xA7FB, 0x9047, 0x8FBA, 0x9AF1, 0x8808, 0xCF88, 0xB415, 0x8F18, 0xA6C0, 0x9E61, 0x9ED2, 0xC31A, 0xD111, 0xB434, 0x913A, 0x9E13, 0xCE4F, 0xD48F, 0x98F0, 0xA322, 0xD024, 0xA5EE, 0x9F8C, 0x8BFE, 0xCA1D, 0xB641, 0x8EA8, 0xCAB9, 0x9E88, 0xB0F9, 0xB0FA, 0xCDBA, 0xB18A, 0xB318, 0x8497, 0xA2FD, 0x9DD3, 0xCE54, 0xB768, 0xAC10, 0xB05D, 0x8CD8, 0x8845, 0xC954, 0xAAD6, 0x888D, 0xC448, 0xA3F2, 0xA5B8, 0xA5D8, 0xD319, 0xA6D0, 0xA6D8, 0x9299, 0xB644, 0xB05E, 0xCC48, 0xA6DD, 0xA902, 0xA903, 0xAD90, 0x884F, 0xBB0A, 0x971D, 0xA507, 0x888E, 0xC879, 0xBA18, 0xBA13, 0xA64B, 0xB6CF, 0xB902, 0xCEED, 0xBB86, 0xB273, 0xBCBF, 0xAACB, 0xC59E, 0xC963, 0xC3F6, 0xB27F, 0xB280, 0xD291, 0x8652, 0x911D, 0xD34F, 0xD350, 0xCA31, 0xB9EA, 0xCD4B, 0xD2BC, 0xBEA0, 0x9416, 0x947F, 0xAC6A, 0xD5B7, 0xD240, 0xBC39, 0xABCB, 0xD60E, 0xB57C, 0x9A12, 0xD40B, 0xB947, 0xBC5C, 0x8CC2, 0x8967, 0xAC30, 0xA9FE, 0xCDD2, 0xC4C8, 0xC24D, 0xBE99, 0xB178, 0xD40E, 0x977B, 0xC3BE, 0xD249, 0x9CF7, 0x9570, 0xD197, 0x9571, 0xBB21, 0x9FFE, 0xB96B, 0x94C6, 0xA46A, 0x8F64, 0x8EBF, 0x9BEC, 0xBDD2, 0xB2A0, 0x96B0, 0x970F, 0xD5DC, 0xA856, 0xA9AE, 0x87D7, 0xA005, 0x8EC0, 0xD26D, 0xA9AF,
+		0x8983, 0x86BD, 0x8BF7, 0x9B2E, 0xCD36, 0xC38D, 0xCBAB, 0xD503, 0x9BF1, 0xD1A6, 0x9437, 0xCED8, 0xC250, 0xC45D, 0xC932, 0xD252, 0xCA3C, 0xABA6, 0xBB5A, 0x8F6F, 0x862E, 0xD4CE, 0xD1B0, 0xCCE0, 0x9D08, 0x9575, 0xBD86, 0xBB60, 0xAAD3, 0xBCDC, 0x949A, 0xC715, 0xB2B5, 0xCE50, 0xC365, 0x9C4D, 0xBBA0, 0x8994, 0x8E71, 0x979A, 0xBC71, 0xA660, 0xAF67, 0xAC3F, 0x8ECD, 0xA6C8, 0xB66F, 0xCF0E, 0xD25D, 0xCFC7, 0xA5F3, 0xB70B, 0xCDCE, 0xCD78, 0xA608, 0xC5AD, 0xB491, 0x98BA, 0xBF5F, 0xC678, 0xBB4C, 0xB698, 0xBF60, 0xD527, 0x8943, 0x9500, 0xCAE9, 0xC3F7, 0x87CC, 0xAEC2, 0xB51D, 0xB51E, 0xD340, 0x9365, 0x9897, 0xCF2D, 0xA2E1, 0xACBE, 0xD241, 0xA026, 0x8687, 0xD242, 0xCDFD, 0xAC6C, 0xA384, 0x8599, 0xB0E3, 0xAE82, 0xA693, 0xCD0A, 0xD59F, 0xB0E4, 0x881F, 0xA841, 0xAD6E, 0x8AF6, 0xAEC4, 0xB48A, 0xC90D, 0xD5FB, 0xD2C4, 0xD24A, 0xBAAD, 0xA160, 0xD52A, 0x9BD8, 0x9C25, 0x8A8F, 0xBF26, 0xD2D1, 0x9BD9, 0x98D5, 0xCB9E, 0xD4F4, 0xD2D2, 0x9F3B, 0xB6A3, 0x90EB, 0xCA74, 0xD411, 0x9B69, 0xAF5F, 0x9490, 0xC2EB, 0x955C, 0xA544, 0x93E3, 0xD034, 0xBB22, 0xA9FF, 0xA46C, 0x9507, 0xA033, 0xBE18, 0x8AD9, 0xBF7C, 0xB8D4, 0xBB23, 0xC821, 0xAA67, 0xA9B7, 0x96B3, 0xD600, 0xB6A5, 0xD4A0, 0x85BB, 0x856B, 0x9C36, 0xD2E7, 0xD2E8, 0xA46F, 0xA5FA, 0xB0B0, 0xA2EF, 0xA80A, 0x8BA8, 0xD5AB, 0xCE28, 0xBBF5, 0xAA45, 0xAA46, 0xCA3F, 0xC393, 0x8727, 0x9D09, 0x9442, 0x86D9, 0xAFAD, 0x9F49, 0xD349, 0xD555, 0xC6A3, 0x8A94, 0x8ED6, 0xC1D3, 0xA747, 0xD509, 0xCE3D, 0x9D10, 0xC6F6, 0xD1D3, 0xD2FE, 0x8ED9, 0x9D90, 0x9C42, 0x9562, 0x9563, 0x9699, 0xB6F4, 0xBC6B, 0xB709, 0x9D16, 0xA2B8, 0xADD7, 0x88C4, 0xABE4, 0xD310, 0x9D0A, 0xA9CD, 0xBBF7, 0x9C4E, 0xCC8F, 0xC1D4, 0xB529, 0xB80E, 0xA48E, 0xCB59, 0x9AF2, 0xB46D, 0x9CCF, 0xBC72, 0xC524, 0xB22B, 0x9ED3, 0xD4AD, 0xBF3E, 0xD5E7, 0xAA0C, 0xBC73, 0xAF69, 0xB812, 0x9B9B, 0xB669, 0xAFB5, 0xCE55, 0xB18B, 0x9D2B, 0xBFC2, 0xC1D5, 0x8AB6, 0x9BFF, 0xA3F3, 0xAA0E, 0x876D, 0xB814, 0xB671, 0xD4AE, 0xA646, 0x8D59, 0xC75A, 0xC80A, 0xA0AE, 0xB4FC, 0xC2BE, 0xB4FD, 0xA345, 0xCD5B, 0xB92B, 0xA04F, 0xC9B7, 0x8C2D, 0xD496, 0xBF62, 0xA566, 0xB45C,
+		0xD0A0, 0xC9A9, 0x8722, 0x85D2, 0xC9AA, 0x87D4, 0xC045, 0xD3D2, 0x9F1B, 0xB444, 0x8FDB, 0xC231, 0x9750, 0xC976, 0xD2D3, 0x8C4B, 0xC9BB, 0xBEB5, 0x9F20, 0x8C4C, 0xA09D, 0xC50E, 0x8AD2, 0xA367, 0x8D5A, 0xC200, 0x8E67, 0xA37A, 0xA2F0, 0xD4C9, 0xC6A0, 0xBBF6, 0x96EE, 0xA3FB, 0x90A7, 0xD4AB, 0xC787, 0xC201, 0xC04D, 0xC822, 0xCDA5, 0xA17A, 0xB50E, 0xC992, 0xC993, 0xBEB7, 0x8A2D, 0xCDE4, 0xBE77, 0x8FE8, 0xA186, 0xA795, 0xC312, 0xC2A9, 0xA3FD, 0x8C51, 0xD133, 0xA3BE, 0xA3BF, 0xA8F2, 0x8AB5, 0x8D8A, 0xA798, 0xCFFD, 0x8D60, 0xB44E, 0xB515, 0x90AD, 0xD001, 0xA91A, 0xB472, 0xA66C, 0x8C04, 0xB9E0, 0x947A, 0xB33A, 0x8C0A, 0x995C, 0xB283, 0x9880, 0xB983, 0x932E, 0xBAD4, 0xACC9, 0xB5C1, 0xD0E2, 0xD40F, 0xA842, 0xA8E8, 0x87E7, 0xA06C, 0xA073, 0xD0F7, 0x855D, 0xBB99, 0xA250, 0x9969, 0xBF28, 0xBBC0, 0xA078, 0x8FE2, 0xCB2C, 0xBADF, 0xA074, 0xB5C5, 0x9F80, 0xB1A8, 0x90F7, 0xA621, 0xD448, 0x8926, 0x8EF7, 0x8D93, 0xC060, 0xA088, 0xA2A4, 0xA089, 0xD3CB, 0xD3CD, 0xA5A1, 0xB0A2, 0xCD79, 0x8B21, 0x94F0, 0xAB32, 0xD3CF, 0xC07C, 0x90DB, 0x9C26, 0x9EEA, 0x8526, 0xBC3A, 0x9C84, 0xB4A6, 0xD140, 0xBC8B, 0xC7F1, 0xD47E, 0xCE9B, 0x9A13, 0x9872, 0xC553, 0x8921, 0xB107, 0x8689, 0x9DED, 0xCAAB, 0x905C, 0xB86A, 0xB988, 0xC3C0, 0x8F27, 0xD3D4, 0xADF1, 0x8B02, 0x9661, 0xA699, 0xB463, 0xAD0B, 0x85CA, 0xBEA2, 0xBB96, 0x9428, 0x890D, 0x864D, 0xB07C, 0x8DD8, 0xD52D, 0x8F65, 0xA84C, 0xD010, 0xAF81, 0x9429, 0x963A, 0x9287, 0x9D4D, 0x9506, 0x92B0, 0xBA0D, 0xAB69, 0xB1E9, 0xBA3B, 0x9DA6, 0xB500, 0xA8E9, 0xAD0C, 0x870C, 0xAAA0, 0x978F, 0xB582, 0x8B2A, 0xCBA7, 0xC9BC, 0xC459, 0x868E, 0xB501, 0xA47C, 0x8EFC, 0x9EF1, 0x9FD1, 0xB5F7, 0xBC8D, 0xB72D, 0x925A, 0xA3C8, 0xD418, 0xBF43, 0x9C30, 0x8DDB, 0x9877, 0x8913, 0xD359, 0xA368, 0xA4C9, 0x86BE, 0xB84E, 0x8E7E, 0xD20D, 0x8915, 0x93E6, 0xA236, 0xBF80, 0xAFE8, 0xA617, 0xA546, 0xA142, 0x96FC, 0x9E01, 0x982F, 0xBF45, 0xC3CA, 0xD2E9, 0x8ADA, 0xBC3B, 0xBA4A, 0xD41E, 0xD601, 0xC542, 0xB8D6, 0xBEF1, 0x8916, 0xC85D, 0x9573, 0xA3A8, 0x94F8, 0x8BA9, 0xA3C9, 0x953F, 0xCF38, 0xAFBA, 0xB34E, 0xC543, 0x9E02, 0xB203, 0xA4CB,
+		0xD590, 0xAFF5, 0x86DA, 0x86DB, 0x95C2, 0xBCFE, 0x96E0, 0xCD64, 0x9DBD, 0xD148, 0x966D, 0x99FE, 0xD213, 0xB4EF, 0xB84A, 0xD388, 0xA9BF, 0x8834, 0x9C3B, 0xA7EB, 0xBC3D, 0xA251, 0x9443, 0xD064, 0x966E, 0xA34C, 0xB382, 0xB356, 0xA252, 0x8C6F, 0x96FD, 0xCE83, 0x8919, 0xD5AC, 0x8853, 0xD018, 0xB467, 0xC039, 0xAA79, 0x8E36, 0xC517, 0xB792, 0x9E50, 0xC716, 0x8713, 0xBC90, 0xC4B6, 0xC460, 0xB1CC, 0xD1B2, 0xC616, 0x99E3, 0xB9CB, 0xD423, 0xCEE3, 0x8C7D, 0xAA1C, 0xC238, 0x9069, 0xBB43, 0x9CA5, 0x9F78, 0x9AED, 0xC617, 0xABD7, 0x92B7, 0x8C3B, 0xD452, 0xB14B, 0x9392, 0xD428, 0x9976, 0xB46B, 0x9995, 0xB526, 0xACC2, 0xB3B8, 0x9670, 0xA436, 0xB9FC, 0xA942, 0xCF40, 0x98EC, 0xBD8D, 0xCF06, 0x8B99, 0x9551, 0x930A, 0xB793, 0xD216, 0x962F, 0xAF0D, 0xB3BA, 0xC546, 0x8D5C, 0x960C, 0xB036, 0x90E3, 0xA6B9, 0x86EA, 0xD4D4, 0x8BE5, 0xB84F, 0x8B34, 0xD429, 0xB3D8, 0xA8F3, 0xA4F4, 0x9DC7, 0x8887, 0xA62B, 0x9B95, 0x86EB, 0x8F32, 0x8D0E, 0xC83D, 0xB0B9, 0xB81E, 0x8D5E, 0xB11E, 0xB174, 0x908E, 0xCE56, 0xACDB, 0xBFAD, 0xD022, 0xB850, 0x979B, 0xCA90, 0x9A22, 0x89C1, 0xA36D, 0x8D47, 0xB1D1, 0xCFFB, 0x9C53, 0x9783, 0xBC2E, 0xA943, 0x891B, 0xA9D3, 0xAED3, 0xBCA4, 0x8D5F, 0x8FD1, 0xBCA5, 0xA5A7, 0x9108, 0xA752, 0xC525, 0x8846, 0xAC85, 0xB851, 0x8EA9, 0xA79D, 0x8E40, 0x8FBC, 0x946F, 0x9E14, 0xAD17, 0xA43E, 0x8E1D, 0x9ED7, 0x8EF8, 0x9AF4, 0xBC91, 0xA575, 0xCFA6, 0xC52D, 0xA9DC, 0xBA61, 0xCFA7, 0xB2ED, 0xAFF7, 0xB2C0, 0xB121, 0x950D, 0xA18D, 0xA9DD, 0xA9D7, 0xC870, 0x8854, 0x8E85, 0xB7BC, 0xD430, 0x9630, 0xB360, 0xA71E, 0x8889, 0xD3A3, 0xA4DF, 0xBA62, 0xA502, 0xA440, 0xCDBF, 0xCF09, 0x988F, 0xD1BB, 0xBD07, 0xBE58, 0xAF19, 0x8BB9, 0x9CB4, 0xCA97, 0xB2CA, 0xBDFF, 0xD45A, 0xD3A5, 0x9278, 0xBAC5, 0xD3A4, 0xB390, 0xD4E1, 0xBE01, 0xA9E5, 0xA971, 0x9DDD, 0xC03B, 0xCC80, 0xCE64, 0xA4E3, 0xA906, 0xB473, 0xA724, 0xA50B, 0xB368, 0xCF10, 0xD33E, 0xB5B7, 0xA725, 0x871E, 0x910C, 0xD5C6, 0xA647, 0xB9D6, 0xAA88, 0xCC83, 0xCF12, 0xD4E3, 0xCE6D, 0xAC4D, 0x8F1F, 0x9282, 0xB923, 0xD15A, 0xB92C, 0xAF8D, 0x98C8, 0xA5E3, 0xC3FA, 0xD520, 0xAE7F, 0x8D9B, 0xD11B, 0xB33F,
+		0xD096, 0x868A, 0x858B, 0xC295, 0xB82D, 0xA2B1, 0xB70D, 0x935D, 0x868B, 0xBC5D, 0xCBC9, 0xD15E, 0xAC56, 0xBD11, 0x9A14, 0x8527, 0xA69A, 0x94F3, 0xA166, 0xB1F7, 0xAD72, 0x8D33, 0xD0AB, 0xD399, 0x8B03, 0xBC0B, 0xBEBA, 0xA69B, 0xC2EC, 0xBC0E, 0x996A, 0xBF29, 0xD4FE, 0x890E, 0xCEFD, 0xD370, 0xA7E8, 0xD464, 0x855E, 0xA560, 0x9368, 0x9220, 0xB583, 0x98DB, 0xA75B, 0xC48E, 0x9438, 0xA75C, 0x88C8, 0xA036, 0xA659, 0xCF00, 0xA9C0, 0xA128, 0xB2AC, 0x8D85, 0x856C, 0xD0FF, 0xA7EC, 0xD580, 0xA039, 0x97E9, 0x9A32, 0xB7CC, 0xB7CD, 0xBD8A, 0x8729, 0xB714, 0xACAE, 0x9996, 0xC6C4, 0xD581, 0x99E4, 0x9E51, 0xCFA5, 0x8CD0, 0xA625, 0xC5C6, 0x93EF, 0xAFB0, 0x8638, 0x969B, 0xC677, 0x9552, 0xBD8E, 0xB56C, 0x9D5B, 0x88EB, 0xD468, 0xBD91, 0xAC3B, 0xB7D0, 0x936D, 0xCFE0, 0xA2B9, 0x9EAE, 0xAD4C, 0xB7D1, 0xB7D2, 0xAFB4, 0x8B6E, 0xA762, 0x9EB0, 0xA3B2, 0x8858, 0x9A9E, 0xBAC3, 0x9D2C, 0xC4A2, 0xAFB6, 0xBFB0, 0xAF1E, 0xA6D9, 0xD617, 0x8F36, 0xAF1F, 0xBEC1, 0xA533, 0xAC63, 0xBB4B, 0xA0AF, 0xC375, 0x95FC, 0xD21C, 0xA0B1, 0xCD44, 0x9CE7, 0x895F, 0xD393, 0xC7A5, 0xCEE2, 0x995D, 0xCD5D, 0x9581, 0xC07D, 0xB6BB, 0xC1D0, 0xC1B4, 0xB548, 0xADEE, 0xD4EF, 0xBF57, 0xAF56, 0x93C4, 0x9CEB, 0xBA90, 0x8C88, 0xB0E5, 0xD1E4, 0xB82E, 0x9961, 0xD29C, 0x9C85, 0x9330, 0xCF30, 0xB6BE, 0x8E4A, 0x9FCC, 0xBC06, 0x92DA, 0xBCAB, 0x8D79, 0xA0CA, 0xCF7E, 0x8528, 0x9FDA, 0x93DF, 0xD2D4, 0xB25F, 0xD531, 0x8B96, 0x9965, 0xCF7F, 0xA5E6, 0xC383, 0x942A, 0xC5AE, 0xB934, 0x87FC, 0xD412, 0xB5C3, 0xA84D, 0xD413, 0xB8EE, 0x9518, 0xC947, 0x942B, 0xC013, 0xD1CA, 0x8E0C, 0x8C0F, 0xB9BC, 0xD332, 0xC42C, 0xD5B8, 0xA08F, 0x859B, 0xB5A3, 0xAD73, 0xBAD9, 0xD5D8, 0xA3E5, 0xA030, 0xB6C3, 0x9878, 0xC5B4, 0x9F70, 0xD3B6, 0x8BA5, 0x96D8, 0x855F, 0xA1EF, 0xA3E6, 0x99DA, 0x8CEB, 0x9A8E, 0x95D9, 0xBDB4, 0xC57C, 0xA388, 0x9EF2, 0xB5F8, 0x94CF, 0xAAA1, 0xC747, 0x8656, 0xC48A, 0x9433, 0x95C6, 0xBF2A, 0xB6E6, 0xBEBC, 0xCBA8, 0xA5CB, 0xC5B5, 0xA1B9, 0xC6E5, 0x94C7, 0x9003, 0xBF44, 0xBB56, 0x9EA7, 0xC4C9, 0x9B6D, 0xA1F0, 0xD26E, 0x9E03, 0xA1BD, 0x8B0A, 0xC1DF, 0xD1E9, 0xAA02, 0x8ADB, 0xB55B, 0xBA9E,
+		0x85AE, 0xBF46, 0xC513, 0x9A90, 0xB47C, 0x95EB, 0xC675, 0xBE1F, 0xC680, 0xC582, 0x996F, 0xC38E, 0x9439, 0xBEE1, 0xBC52, 0x9122, 0xA470, 0x93E7, 0xA037, 0x9A18, 0xD253, 0xC85E, 0xA09F, 0x93A8, 0x9CFF, 0xC6EA, 0xB6A6, 0xD556, 0x8A5E, 0x917C, 0xA6B4, 0xAA19, 0xA75E, 0x8BAE, 0xB61A, 0xB8EF, 0xB2AD, 0xB2AE, 0xD49E, 0xB2AF, 0xC09B, 0xB3B4, 0xC493, 0xBA7D, 0xC117, 0xB2EA, 0xBDD4, 0xC09C, 0x9537, 0xC0CA, 0x865B, 0xD488, 0x88A3, 0x97EA, 0x8D86, 0xAA60, 0xA8C4, 0xB2EB, 0xD0E9, 0x86DC, 0x8CAC, 0xA860, 0xAC8E, 0xB267, 0xB468, 0xBE75, 0xD557, 0x97AC, 0xD591, 0xC2FE, 0xD50A, 0xC642, 0xA76B, 0x9FE5, 0x92EE, 0xB76B, 0xACFA, 0xCE35, 0xD574, 0x928B, 0xAA1A, 0xC6F2, 0xCC2A, 0xB8CC, 0xB2B0, 0xC6F7, 0xC4B7, 0x9DC0, 0xA31F, 0xC496, 0xB764, 0xAC8F, 0x9C43, 0x9106, 0x8B8B, 0x8F7D, 0xACFB, 0xAB59, 0xCEB4, 0xC5BF, 0x85E5, 0xBB6B, 0xB527, 0x8714, 0x9AA8, 0x95DC, 0x8D0A, 0xACAA, 0xC180, 0x949D, 0xC156, 0xBF54, 0x962D, 0xACC3, 0xC588, 0xA748, 0xD559, 0x8741, 0x97AF, 0xCBB2, 0xBE23, 0x96C6, 0xD306, 0xCF69, 0x8AAF, 0x8992, 0x8E17, 0xC3CF, 0xCEB5, 0xD575, 0xC6AA, 0xB994, 0xC0EE, 0x95DD, 0xB822, 0xCC6E, 0xA012, 0xCB5A, 0xD48A, 0xAEBD, 0x85AA, 0x969A, 0xB00E, 0xD3A1, 0x9DC8, 0x8CBC, 0xB606, 0xBB14, 0xCF6C, 0xC3D1, 0x8D6E, 0x9D17, 0xB6F9, 0xBDBF, 0x94A3, 0xCF41, 0xD1D5, 0x9565, 0xB753, 0x99E8, 0xCFA3, 0xBDF8, 0xC49A, 0xBC2B, 0xD1D6, 0xD583, 0xBDC0, 0x9B0C, 0x88A8, 0x86EC, 0x93AB, 0x8D48, 0xA62C, 0xBCA6, 0xC8A3, 0xC368, 0xD585, 0xA5B3, 0xD454, 0xB3BD, 0xAB21, 0xD27A, 0xAA0D, 0xAD88, 0xB168, 0xA711, 0xAC3C, 0xC750, 0xD577, 0x9C54, 0xB878, 0xBC74, 0x9293, 0xAA1E, 0x865C, 0xCA46, 0xB7EA, 0xAD3B, 0xC995, 0xB3F7, 0x9BC0, 0xC40E, 0x9D1E, 0xB840, 0xC687, 0xB65F, 0xC751, 0xBC78, 0xB418, 0xD04D, 0xD605, 0xD25A, 0xB8A1, 0xB7D3, 0xA7FD, 0xCE57, 0x9295, 0xA79E, 0x8A21, 0xD5ED, 0x9E67, 0xB68D, 0x89C3, 0xB770, 0xC688, 0x9B9C, 0x9A5F, 0xD5C2, 0xC320, 0xB3F9, 0xCF42, 0x8DE4, 0xB997, 0x9B9F, 0x9D2D, 0xB813, 0x9E15, 0x9E8A, 0x9A9F, 0xB419, 0x8D11, 0x9FBC, 0x9F0E, 0x86FB, 0x899C, 0xCB71, 0xAF6D, 0xBC79, 0xCB72, 0xB83D, 0xB73C, 0x9D32, 0x87AC, 0xB39F, 0xA503,
+		0xB22E, 0xC798, 0xD5C4, 0xB2CB, 0xB0C1, 0xC9CD, 0xAD91, 0xA5F1, 0xC593, 0xD5C5, 0xA3F4, 0xCCF9, 0xC6FB, 0xA5B9, 0xC418, 0x89A4, 0x9F11, 0xA972, 0x89A5, 0xCC4C, 0x8899, 0xB679, 0xC41C, 0xD5D0, 0xD5C7, 0xAAAB, 0xC36F, 0x8EE1, 0xA527, 0xA5C4, 0xA751, 0xBB48, 0xA0AB, 0xCD58, 0x994E, 0xD38A, 0xC3F1, 0x957B, 0xCEE1, 0x9C77, 0xC075, 0xA3D9, 0x9CD7, 0xB6B1, 0xC12A, 0xCF28, 0x9953, 0x8C84, 0xB0D8, 0xB825, 0x9527, 0xADE9, 0xB6B5, 0xD57A, 0xAA51, 0x9329, 0xD28E, 0xC1AF, 0x92D3, 0xD3FC, 0xD1DC, 0xAF52, 0xBA85, 0xC476, 0x9514, 0xC59F, 0x9408, 0xA737, 0xD5B3, 0xB924, 0xC37A, 0xD23C, 0xD32B, 0xD38F, 0x8598, 0x9409, 0x8C07, 0xB8EA, 0xCAEA, 0xA023, 0x8DFF, 0x85AD, 0xB6DC, 0x9F62, 0xA382, 0xB68B, 0x8FF5, 0x9871, 0x9B5D, 0x95C5, 0xC73F, 0x9EA3, 0xAA9B, 0xA1DA, 0x9EE5, 0xBF40, 0x95E7, 0xC500, 0xA1B2, 0xC67B, 0xC382, 0xBC4E, 0xBDB2, 0x9CEC, 0xBE0F, 0x9C29, 0xC6DD, 0xA84E, 0xCC1F, 0xC6DE, 0xB2E6, 0x92E8, 0x8CA7, 0xB8CB, 0x97A5, 0xB993, 0xC10C, 0xAC8B, 0xBA78, 0x8655, 0x8BA1, 0xBDD0, 0xA8C2, 0xB619, 0xD548, 0xD0E4, 0xC092, 0xAA15, 0xD480, 0xD4F5, 0xA319, 0xC4B1, 0x9DAD, 0xACA7, 0xA516, 0xBE19, 0xACC1, 0x9C31, 0xCF61, 0x9B6E, 0xBB57, 0x9177, 0x90FE, 0x873D, 0xC48B, 0x9629, 0xD483, 0x9AA6, 0x8F6C, 0xAA03, 0x870E, 0xACF4, 0xCEA4, 0xA001, 0x9497, 0xB74F, 0xD1D2, 0x93A9, 0x8D65, 0x955E, 0x8987, 0xA618, 0xCB2D, 0x9DB9, 0x879C, 0xB164, 0xAB1A, 0xA5AE, 0xCC2B, 0xBCA2, 0xAD3A, 0xB7E9, 0xC589, 0xA790, 0xB898, 0xD046, 0x9E81, 0xB39E, 0xB414, 0x9A97, 0x9F07, 0xD5C3, 0x93C5, 0xC5A0, 0x952D, 0x93C8, 0xB625, 0x93C9, 0xB252, 0x87FD, 0xB1C3, 0xC4E6, 0xD144, 0x9538, 0xCC2C, 0xCC32, 0xB4F5, 0xC3E9, 0x9716, 0xD5EE, 0xBD41, 0x88E1, 0xB319, 0xC1F4, 0xCC44, 0xA5BA, 0xBD48, 0xA973, 0x971F, 0xC0BD, 0xBB7D, 0x9522, 0xC0C2, 0x9836, 0xB651, 0xAA70, 0x9417, 0xB925, 0x9255, 0x965F, 0xD3EA, 0xA0FC, 0xABF7, 0x9681, 0xACEF, 0x9530, 0x854A, 0x96D7, 0xBE1A, 0xA1BA, 0x9434, 0xB93A, 0xC89A, 0xD147, 0xD5DD, 0x96D9, 0xD4CA, 0xCE29, 0xD2EA, 0x84C2, 0x925C, 0xA519, 0xBAB8, 0xBB61, 0xB117, 0xAAD4, 0xA8C6, 0x854D, 0x925E, 0xA62D, 0x8A2E, 0xC186, 0x86E3, 0x9D1F, 0xD04C,
+		0x8B82, 0xA8C9, 0x9C55, 0xA1C3, 0xAC86, 0xD151, 0xAA7D, 0xB122, 0x9C66, 0x9C6C, 0xAA34, 0xC915, 0xAC4E, 0xB3BF, 0x8DD3, 0xABBA, 0xC925, 0xCD2E, 0xCD2C, 0x9344, 0x8529, 0x9544, 0xC959, 0x9682, 0xB3C5, 0xCFCC, 0x9545, 0xA84F, 0xCB0E, 0xD414, 0x8F11, 0xC662, 0xB3F2, 0xCA78, 0xC48C, 0xB6E7, 0xB64D, 0xB64E, 0xB3F4, 0x9A91, 0xBC9F, 0xC48F, 0xCB2E, 0xC94D, 0xB3D6, 0xB385, 0xCDA9, 0x95DE, 0x9F84, 0xBD6B, 0xCEBB, 0xC313, 0x9296, 0xB87B, 0x9356, 0xB87C, 0xA734, 0xA5B7, 0x95E0, 0xAF73, 0xB3FD, 0x89D1, 0xCDC9, 0x961D, 0xCAF6, 0x8B7A, 0xC1E2, 0x9331, 0x9962, 0x8B57, 0x8B58, 0x8D7A, 0x942C, 0xC3C1, 0xB08C, 0xCB0F, 0xA493, 0xCB10, 0xB0E8, 0xA850, 0xA8CD, 0xD2D5, 0xB5A4, 0xC337, 0xC57D, 0xC4CA, 0xC2ED, 0xC2EE, 0xA302, 0xB446, 0x9B6F, 0x9B27, 0xC50F, 0xB849, 0x9970, 0x9E7B, 0xD09F, 0x8D87, 0x9307, 0x8ED7, 0xB84B, 0x8B66, 0xD424, 0x9E0A, 0xC717, 0x8B6B, 0xB14C, 0xD55A, 0xC0A9, 0x9B36, 0x9997, 0x98ED, 0x9909, 0x985F, 0x84F1, 0xB169, 0xCB66, 0xA712, 0xB5AD, 0x84F4, 0xA7FE, 0xB08D, 0xB44F, 0x84F6, 0x9274, 0xCB67, 0x9BA0, 0xC720, 0x8EDE, 0xC9CC, 0xA57C, 0xA57B, 0xA2CF, 0xAB74, 0xD17A, 0xB287, 0x9638, 0x963B, 0x9639, 0x8FF9, 0x9004, 0x9212, 0xBAF1, 0xB75B, 0x9219, 0xAE0B, 0x90F4, 0xA5C7, 0xBEDC, 0xD52B, 0x95D4, 0x8A3A, 0x989C, 0xCF31, 0xAFE5, 0xB0E9, 0x8B27, 0xBB97, 0xB5CF, 0x9D4E, 0xA167, 0x9BCF, 0x8BDC, 0xA58F, 0xAA68, 0x9C9F, 0xBDA0, 0xAFD6, 0xA930, 0xAF77, 0x9E98, 0xB03E, 0xD023, 0xA933, 0x9C59, 0xA595, 0x9C03, 0xCCFA, 0xAD92, 0x9A6D, 0x922C, 0xCADA, 0x902A, 0xC9D8, 0x8DC1, 0xBBBF, 0xB48B, 0xCF32, 0x8B41, 0x8E2B, 0x8E2C, 0x9963, 0x95E8, 0xD265, 0xD266, 0xAEF9, 0x8E2E, 0xD1CB, 0x8A4F, 0xA851, 0xD209, 0xCD31, 0xC057, 0xA77F, 0xA8EA, 0xD26A, 0xAF30, 0xBE9A, 0x9221, 0xC81F, 0xBEC5, 0x97C2, 0xB81A, 0xA6F9, 0x98A2, 0xB9A8, 0xA7D0, 0xB1A1, 0xA03A, 0xA17B, 0x8690, 0x8F4C, 0x97DB, 0xC1BC, 0xC6F3, 0xB1C4, 0x8D00, 0x9044, 0x9045, 0xBEF8, 0xA148, 0x8D0B, 0xA3FC, 0xA70B, 0xA437, 0x8D0C, 0xA70C, 0xC1F0, 0xA438, 0xA713, 0xA718, 0x8D0F, 0x84F2, 0xB98E, 0xBBC5, 0xBEE3, 0xA43F, 0xAF13, 0xD27B, 0x8E41, 0xAF3D, 0xB456, 0x9E6B, 0xBEE4, 0xBEE6,
+		0xA907, 0xCDF2, 0xAEF2, 0xD141, 0xBB94, 0xBC02, 0xCB2F, 0xBC18, 0xBB9C, 0xD149, 0xD14A, 0xB721, 0xCAC8, 0xA5E0, 0x9C1A, 0xB53C, 0xA324, 0x88FD, 0x957D, 0x8F1E, 0x9AAC, 0x9ACE, 0x8B39, 0xB6B6, 0xC54A, 0xB743, 0xD382, 0xCECD, 0x90E7, 0x9528, 0xB6D9, 0x94EB, 0x8933, 0xC20C, 0x89E9, 0x967D, 0xD23D, 0x981F, 0x93D4, 0x92F8, 0x9342, 0xB061, 0xB02A, 0x9D9D, 0x92CC, 0xD495, 0xCD03, 0xADEA, 0x84D3, 0xA325, 0xB654, 0x95FD, 0xCDF8, 0xC1CF, 0xC96E, 0xB281, 0xB103, 0xB394, 0xC2BF, 0xC2C0, 0x9310, 0xA326, 0xA04D, 0xBD0E, 0xB963, 0xD33F, 0xD119, 0xC56C, 0xB967, 0xC16E, 0xD394, 0x8FF6, 0x8E47, 0x86A6, 0x8538, 0x8819, 0xB59D, 0xBE6A, 0x8619, 0x96BE, 0xBCF1, 0xD528, 0x92FF, 0xD243, 0xAEB0, 0xB094, 0xD542, 0x93D8, 0xB042, 0xD1C4, 0xC428, 0xD60F, 0xB063, 0x9D7A, 0xB984, 0xD244, 0x85C8, 0xADB2, 0xB57D, 0x99CF, 0xA2E2, 0x8B3F, 0xCC06, 0xC692, 0xC90B, 0xA8E5, 0x9077, 0xA265, 0xA959, 0xA066, 0xAF87, 0xC829, 0xB02B, 0xA398, 0xCA70, 0x9731, 0x97FD, 0x9583, 0x907B, 0x8AFD, 0x9EBF, 0xC651, 0xC652, 0x921C, 0x84CA, 0x992C, 0xB14F, 0xAE74, 0xD543, 0x974D, 0x8ACF, 0xBEB4, 0xD24B, 0x8D7B, 0xAB94, 0xC574, 0x8D7C, 0xC575, 0xAAEB, 0xAAEA, 0x9CC5, 0xC576, 0xAF27, 0xA3A6, 0xB7A3, 0xCFD8, 0xB288, 0xAE54, 0xAC57, 0xAF5E, 0xAA27, 0xC1DC, 0x87FE, 0xC1D2, 0xC093, 0xC664, 0x9EED, 0xA075, 0xCD32, 0x9925, 0x8DAF, 0xA7CB, 0xB868, 0xBF04, 0xAB98, 0x8C8B, 0xB96C, 0x9D6A, 0xBDE8, 0xA197, 0x9C8E, 0xC663, 0xC602, 0xBFFE, 0xB045, 0x8AD3, 0xBFC6, 0x9463, 0x90A3, 0xBFFF, 0xAEEF, 0xAE56, 0xB12C, 0x9688, 0xAA73, 0xA5F5, 0xA728, 0xD11D, 0xBC61, 0x9F46, 0xA769, 0xBB18, 0xB113, 0x8755, 0xAA5E, 0xB150, 0x97F2, 0xB5F9, 0x8C6C, 0x8E64, 0xBE86, 0xAE6C, 0xA034, 0xADFA, 0xA4A0, 0xC9FF, 0xC831, 0xB6C4, 0xB96D, 0x8CA9, 0x946B, 0x9468, 0xA107, 0x92FB, 0xB3CC, 0xC0A7, 0x9081, 0xB114, 0x97D6, 0xD550, 0x9040, 0xC218, 0xABA7, 0xB761, 0x914D, 0x8B61, 0x8D30, 0xAA77, 0xAFD4, 0x8F70, 0x84A8, 0xC613, 0xAE5B, 0xBAE0, 0xBAFF, 0xAE29, 0xCF65, 0xD347, 0xB713, 0x91EB, 0xB7B2, 0xBF81, 0xC968, 0x9E3F, 0xC14D, 0x9EF8, 0xCD97, 0x9D57, 0x9103, 0x86CA, 0x89F4, 0x9498, 0xC490, 0xAEBB, 0xACA9,
+		0xC059, 0xBDF3, 0xBFC7, 0x85B1, 0xB39A, 0xAA05, 0xC05A, 0xBA7A, 0xBD87, 0xBC1E, 0xD486, 0x95AA, 0xCB39, 0x8835, 0xA786, 0x9CA0, 0xB118, 0xC584, 0x954E, 0xAA06, 0x87B3, 0xAFC9, 0x89F9, 0xA9C5, 0x97DD, 0xB02E, 0x8C25, 0x875E, 0x9E99, 0x93EC, 0xBD8B, 0xAD45, 0xC308, 0xD43E, 0xA9C6, 0x9ECE, 0xCC99, 0xA9F0, 0xD2FF, 0xC76F, 0x891D, 0xA822, 0xBF3C, 0xB0BA, 0xAEBE, 0xD093, 0xA796, 0x8D89, 0x9761, 0xC520, 0xBDEE, 0x9291, 0xD279, 0x9ED1, 0xB89D, 0x99C8, 0x8D88, 0xCA24, 0xC4B8, 0xBC55, 0x936C, 0xCC39, 0xB879, 0xD42F, 0x8E51, 0xA3B0, 0xAF68, 0xBFE6, 0xA719, 0x86F3, 0xCCB6, 0xA20B, 0x8C20, 0xCB68, 0xA1A9, 0xA4A9, 0xA731, 0xB7EB, 0xBFE7, 0x969E, 0xB2C1, 0x8D8D, 0x87B8, 0xC793, 0xAE62, 0xC21D, 0xCFDC, 0xC4A0, 0x876A, 0xC645, 0xA460, 0xA4AC, 0xC83F, 0xA96F, 0x9C09, 0xCF82, 0xAA94, 0xB5B8, 0xCFDD, 0xAA35, 0xAE96, 0xCF83, 0x8A64, 0xB33B, 0xC20E, 0x9740, 0xCFCF, 0xA7FC, 0x973D, 0xD538, 0x9EDF, 0xAEFA, 0x8BC8, 0x9CFA, 0x9549, 0x8BC9, 0xAC90, 0xAF0E, 0xD2A5, 0xD2C5, 0x959E, 0x9E40, 0x9005, 0xD250, 0xC666, 0xBCFF, 0xD1B5, 0xA0D0, 0xC477, 0xCD73, 0x8934, 0xC9AB, 0xCD7A, 0x8B7B, 0xB630, 0xC388, 0xA0DD, 0xCDA6, 0xCDE2, 0xC618, 0xA149, 0xA0E3, 0xC607, 0xB8F9, 0xB8FE, 0xB903, 0xA0C8, 0xBA37, 0xBD1D, 0xBA9A, 0xBA51, 0xBE8A, 0xBD62, 0xB243, 0xB244, 0x96FF, 0x9A60, 0xBE93, 0x8C3D, 0xBB29, 0xC3CC, 0xC275, 0xD35D, 0x8EB3, 0x8C31, 0xB865, 0xCAA6, 0x9284, 0xAFE0, 0xBD12, 0x9286, 0x858C, 0x8C32, 0x9873, 0x92DB, 0x8577, 0x868C, 0xA95B, 0xD330, 0x9E33, 0x88D7, 0xA852, 0xD3D5, 0xD011, 0x918C, 0x8824, 0xC42D, 0x95BB, 0x8C33, 0xC3C2, 0xA95E, 0xB507, 0xB034, 0xC2EF, 0xA391, 0x943A, 0xCFF9, 0x93AA, 0xA619, 0xB8D7, 0xCDA0, 0xA547, 0xBF06, 0xBF08, 0xC14E, 0x8836, 0xAC1E, 0xAEDC, 0xA10D, 0x9AEE, 0xBA70, 0xD55B, 0x8742, 0xADD5, 0x9011, 0x908F, 0x85C0, 0x893E, 0xCB5B, 0xBBE0, 0x88DF, 0x88F4, 0xA934, 0x8E1B, 0xC770, 0xC9C8, 0x8B83, 0x8EF9, 0x8E86, 0xB3EF, 0xA993, 0xCABA, 0x9CB3, 0x9E6C, 0x9C04, 0xAC12, 0xB2CC, 0xA995, 0xA974, 0x89A8, 0xBB0D, 0x95CB, 0xA753, 0x9D9E, 0x9DCA, 0xB7FA, 0xC8C3, 0x942D, 0xB1A7, 0xC8EC, 0xC8FC, 0x88F8, 0x88F7, 0xCAC5, 0xA59E,
+		0xBED9, 0x9EB4, 0x847B, 0xAE00, 0xBE9B, 0x9EB5, 0x9F53, 0xB09F, 0xC076, 0xCAD0, 0x8931, 0xAC88, 0xAC4F, 0x94E6, 0xB332, 0xCDF3, 0xCDF4, 0xB8E0, 0xC64A, 0x90E4, 0x9A08, 0xB2D9, 0xAB2B, 0xD539, 0x9B5E, 0xBC3F, 0xD2A6, 0xC4A7, 0xB0FF, 0x93FD, 0xBFDD, 0xC12B, 0xC243, 0xD236, 0xB2F7, 0xB85C, 0xC1B0, 0xB3A5, 0xB699, 0x93FE, 0xA357, 0x9FA3, 0xA953, 0xD3FD, 0x9C1B, 0xB485, 0xB279, 0xC847, 0x932A, 0x8522, 0xB774, 0xC5DF, 0x9CDC, 0x9A2C, 0x9A2D, 0x92BE, 0xC28B, 0xA1D3, 0x9CDD, 0xD28F, 0xCF29, 0xB486, 0x84FD, 0x8BD5, 0xAB6E, 0xC7ED, 0x93FF, 0xB6D0, 0x986C, 0x8CF4, 0xCBC0, 0xCD2A, 0x8732, 0xCADB, 0xB453, 0xC12C, 0xA675, 0xB12B, 0x91C6, 0xC474, 0x89EA, 0x88D4, 0xD3FE, 0xBBF2, 0x8F58, 0xCECF, 0xB663, 0xA838, 0xBCF8, 0xB8E1, 0x8F20, 0xACB4, 0xB4E1, 0xA67C, 0xCCE5, 0xA2DD, 0xADC3, 0x8618, 0x85C7, 0x9635, 0xACEB, 0xCAA3, 0xCAA4, 0xA158, 0xB185, 0xB788, 0xA37E, 0xD402, 0xAE9D, 0x8815, 0x87CD, 0xB88A, 0xC673, 0xC9A5, 0xC3F8, 0x8646, 0x9958, 0xCC15, 0xD07B, 0x9053, 0xA0F3, 0x8FEF, 0xAEF5, 0x94EE, 0x99B7, 0xB1B0, 0xAB91, 0x940A, 0xB97F, 0x96BD, 0x86A1, 0xC16C, 0x940B, 0xD4B6, 0xAB70, 0x92A9, 0xB455, 0xAB51, 0xAB66, 0x8535, 0x8CC3, 0xAD65, 0xD4E7, 0xD292, 0x8B1F, 0x9CE8, 0xA024, 0xA8E1, 0x8CF7, 0xAEE4, 0xAEE5, 0xC761, 0xCC55, 0xB4FE, 0x9597, 0xAD20, 0xA684, 0xB6DD, 0xCC04, 0x95CC, 0xB3A7, 0x896B, 0x8A6A, 0xCDFE, 0x987B, 0xD53A, 0xA7C2, 0xC24A, 0x9159, 0x9749, 0x8CE8, 0x9DE9, 0x8CC4, 0x9644, 0x972D, 0xAB33, 0x8947, 0x9EBC, 0x9EBD, 0x9418, 0xCD7B, 0xD3AD, 0xD4EC, 0x9E75, 0x9B45, 0xCB95, 0x8948, 0x9256, 0xB6DE, 0xB728, 0x8B77, 0xAB43, 0xBF1E, 0xD351, 0xB338, 0xD3D0, 0xC7A6, 0x9A88, 0x93D9, 0xB5EA, 0x94C2, 0x8DD4, 0xA027, 0xC63B, 0x8C3E, 0x88F9, 0xA028, 0x921A, 0x84C8, 0xB420, 0x8B22, 0xB69F, 0x9E2B, 0xCA67, 0x9ACF, 0xB1B7, 0xA685, 0x9FC7, 0xA581, 0xBAD5, 0xD45F, 0x8DD5, 0x9D64, 0xB67E, 0x86A7, 0x8908, 0xB421, 0x9B5F, 0xB2FF, 0x8E98, 0xCD7C, 0xB6DF, 0x9BAE, 0xCC57, 0xAA9C, 0xCC58, 0xB371, 0x9FF8, 0xBC7F, 0xCB96, 0xAADF, 0x995E, 0xD395, 0xC9B8, 0x90EA, 0xC134, 0xA609, 0x909D, 0x93DD, 0xB6A2, 0xCBCA, 0x98D0, 0x8679, 0x887E, 0xCF4A,
+		0x8F25, 0xBF77, 0xBC44, 0xBC45, 0x8ACA, 0x8A6D, 0xC24B, 0x878A, 0xC2D3, 0x95E9, 0xA694, 0xB21F, 0xD410, 0xAD6F, 0xA60A, 0xD462, 0xD5FC, 0xBFFA, 0xB549, 0xB82F, 0xBC98, 0x8A6E, 0xB0A4, 0xA13F, 0xAB95, 0xABF8, 0xC63D, 0xA02A, 0x8A42, 0xC5E6, 0xA22F, 0xC853, 0xBEEC, 0xCD0B, 0x8DB5, 0xC08B, 0x9848, 0xCD2F, 0x8DB6, 0x9D68, 0xC0BB, 0xCD0C, 0xAF57, 0xB8D1, 0xC484, 0xA53B, 0x9421, 0x91A4, 0xAD70, 0xC1D9, 0x9F67, 0xAE1C, 0xADC5, 0xA299, 0xA4BC, 0xA6F6, 0xB9EF, 0xC1F9, 0xBF9A, 0xC3FC, 0x9BE8, 0xCD0D, 0xCC59, 0xCC19, 0xAE75, 0x8C89, 0xAB41, 0xAB42, 0x8CEA, 0xC094, 0xA031, 0x8F66, 0xB59E, 0x905D, 0x9DA7, 0xD58C, 0x9662, 0xA494, 0xA9A9, 0xA120, 0xC173, 0xA233, 0xAEFD, 0xA44D, 0x98D6, 0xB221, 0xCF93, 0x955B, 0x97E4, 0x942E, 0xAA5C, 0x89DA, 0x8BA2, 0xBBB4, 0x8C69, 0xD0E5, 0xABE2, 0x88A5, 0x853A, 0xA60F, 0xBF9C, 0x87FF, 0x984D, 0x85F2, 0xB4B5, 0xA1A7, 0xC2E0, 0xB48C, 0x8F48, 0x9F3C, 0xB220, 0x8E9A, 0x9FDC, 0xB424, 0xBCFB, 0x95D6, 0x9A3A, 0x9663, 0xA853, 0x92E9, 0xB479, 0x84A2, 0xC0C4, 0x8660, 0xD385, 0xC82E, 0xD5A0, 0x9DA8, 0xA808, 0xBD4E, 0xBA95, 0xBE6E, 0x8ED2, 0x99FA, 0xB295, 0x98D7, 0x8D81, 0xBDD1, 0x8C76, 0x86B2, 0x89F2, 0x9A8A, 0x9318, 0xA4C5, 0xB25A, 0xABF9, 0xB1BD, 0xC13C, 0x8E60, 0xB845, 0x9A8B, 0xC88B, 0xCA75, 0xB8E4, 0x9CF8, 0x96EB, 0x890F, 0xAE0C, 0xD1CC, 0xC036, 0xA0B4, 0xBEC4, 0xAA74, 0x86B3, 0xCB11, 0xABFA, 0x8602, 0xB05B, 0x903E, 0xCC5A, 0xCC5B, 0xCC5C, 0xC509, 0xB7A4, 0xB522, 0xA2EA, 0xC4E7, 0xC0A8, 0xCDD9, 0xCDDA, 0x9491, 0xA740, 0xC70D, 0xC70E, 0xAE28, 0x8914, 0xC1FE, 0x8C99, 0xC510, 0xCD85, 0x870F, 0xADC9, 0x84A5, 0x91E2, 0xAAA2, 0xCC61, 0xA47D, 0xA47E, 0x9E79, 0xACA8, 0xD5FF, 0xD614, 0xD5A1, 0x85DE, 0xB711, 0xC359, 0xCA00, 0x84A6, 0xB5A5, 0x9DAE, 0x9435, 0xAA18, 0x9DAF, 0xB08A, 0x938A, 0x996B, 0x996C, 0x9991, 0xC8D2, 0xBF69, 0xD415, 0xD4FF, 0xC4B2, 0xB6C5, 0x959F, 0xB31C, 0xB4DA, 0xAC5A, 0x9AE2, 0xB937, 0xB222, 0x94DA, 0x9065, 0xD39A, 0x9E41, 0xC144, 0x8828, 0xC0E6, 0xAFE2, 0x9CFB, 0x99DB, 0xCFDA, 0x9D53, 0x90FF, 0xC9BD, 0xBC47, 0x8CFD, 0xB5FA, 0xC0E7, 0xD19F, 0xA40B, 0xBEF2, 0x9BB6, 0xC35A, 0xB9C0,
+		0xB30A, 0xA4EA, 0xD551, 0xC966, 0xCE7E, 0xABA1, 0xCF15, 0xBADA, 0x8F6D, 0xD44D, 0x96ED, 0xB667, 0xC755, 0x89B7, 0xA278, 0xA42E, 0xA42F, 0x9E91, 0xA926, 0xC145, 0xAFFC, 0xCD35, 0xBBDA, 0xCBAC, 0xB9C4, 0x8C8F, 0xB9F6, 0xCF17, 0xD1A7, 0xA55D, 0xB72E, 0xAC1C, 0xA6AB, 0xB626, 0xBC3C, 0xCEB0, 0xA6AC, 0xA145, 0xC2F7, 0x85BC, 0x8BD8, 0xC6B6, 0xBF82, 0xA897, 0x9BF3, 0x8F9D, 0xB3B1, 0xD5A2, 0xB1F9, 0x8DAA, 0xCE80, 0x8BDD, 0xCE2A, 0xA39B, 0xB009, 0xB223, 0xB224, 0xADF7, 0xBB3E, 0xC33C, 0x9349, 0xCF39, 0xD210, 0x9DBA, 0x9C9A, 0xB791, 0x88DD, 0x9209, 0xAC35, 0x987C, 0xBC84, 0xD1A8, 0xAD29, 0xBBDB, 0xC6EB, 0xA898, 0xC33D, 0x9665, 0xA540, 0xB6EF, 0xC1ED, 0x94DE, 0x8DBB, 0xB9C5, 0xBD80, 0xAA8F, 0xB750, 0xBCA0, 0xC712, 0x862F, 0xC9B0, 0x9792, 0x86CB, 0xD572, 0x97A9, 0xD615, 0xBEA3, 0x8917, 0xB07D, 0xA4EC, 0xA4ED, 0x9D00, 0xA61A, 0xB93B, 0xA555, 0xCE2B, 0xA85A, 0xCC66, 0xAD7B, 0x8FE3, 0xBEA4, 0xAB6A, 0xBF13, 0xD3D7, 0xB0B1, 0x84AA, 0xA701, 0x8D01, 0xC35E, 0xB1C5, 0xB524, 0x9FE6, 0x8C92, 0x9F81, 0xC118, 0xBFB9, 0x88F1, 0xCE3E, 0xCB3A, 0xA129, 0xA7AB, 0x86CC, 0xA988, 0xC6FD, 0x8884, 0xD100, 0xA5AF, 0xB3E7, 0xAB1B, 0xC865, 0xA861, 0x9CFC, 0x8759, 0x97AD, 0x9839, 0x8F2D, 0xBC1F, 0xA9C1, 0xB912, 0xB8B7, 0x8F2E, 0xACD5, 0xC788, 0xAB1C, 0x885B, 0x8F78, 0x8DAB, 0xD185, 0xB93E, 0xC74C, 0xC17B, 0x99E0, 0x84EF, 0xAC5C, 0xA92C, 0x8D3F, 0xD335, 0x88A7, 0xB1E1, 0x9E95, 0xAC7B, 0x8D02, 0xAEEA, 0x9B7D, 0xA1C0, 0xCBDA, 0xB873, 0xC837, 0xBAA4, 0xCBAD, 0x97DC, 0x8A79, 0x9A1E, 0xB833, 0xA36A, 0xB3B5, 0xAAB6, 0x88E4, 0xD019, 0xB3E4, 0x908C, 0xA72D, 0xA81F, 0xA36B, 0x8472, 0x86DD, 0xA626, 0xC157, 0x9CA6, 0xB386, 0xB9AB, 0x928D, 0xAC03, 0xAFD7, 0x89BD, 0x8F9E, 0xC7D6, 0x9ECF, 0xB76D, 0xB76E, 0xA5CF, 0xCDAA, 0xB42D, 0x8BE3, 0x9A42, 0x9DC1, 0xAAE2, 0xCF3D, 0x85CD, 0xCD1F, 0xB5A7, 0xA9CE, 0xB681, 0x9B86, 0x91F1, 0xBE24, 0x9308, 0xA201, 0xD5E0, 0x931E, 0xB730, 0x934E, 0xA439, 0xBB2B, 0xB76C, 0xC806, 0xC6AB, 0x949E, 0xA202, 0x8EA2, 0xC252, 0x906A, 0xBB6C, 0xC521, 0xC398, 0xA8F0, 0xCF6A, 0xB8DA, 0xB2B6, 0x9DC2, 0xCF6B, 0xBC87, 0xA866, 0xCE47, 0xC3D0,
+		0xC1F2, 0x9CA7, 0x9979, 0xBBA2, 0xAF66, 0xA5D1, 0xC71A, 0xCF6D, 0xCE3F, 0xC344, 0x98AA, 0x883D, 0x987E, 0x848D, 0xC0F2, 0x9B8D, 0x9B8E, 0x9D18, 0x8CD6, 0xC4B5, 0xC0AB, 0xACD6, 0xCB5C, 0xA5FC, 0x9E82, 0x88CB, 0xB940, 0xB3EB, 0xA714, 0xA2F3, 0xCEBC, 0x8DE1, 0xBE52, 0xBD6D, 0xC757, 0x86ED, 0xD0B7, 0xBC88, 0xC40B, 0x9292, 0x9034, 0xC5C7, 0x969C, 0xC5C8, 0xBABD, 0x9E5A, 0x987F, 0x9946, 0xB7AA, 0xC5F2, 0xC78B, 0xC9C4, 0x856E, 0xBBED, 0xBABE, 0xC790, 0x9126, 0xB196, 0xC15C, 0xAF3B, 0x8BB5, 0xAADC, 0xB737, 0xB170, 0xBD05, 0xB317, 0xCFED, 0x8A80, 0xD1B6, 0x9238, 0xAEA9, 0xCC73, 0x9566, 0x8D26, 0xC526, 0xB2BD, 0x925F, 0x9E0D, 0xC6F8, 0xA416, 0x9FBB, 0x872B, 0x8FEA, 0xAC82, 0xA4F7, 0xA018, 0x88E5, 0xACC4, 0xB513, 0xB46E, 0xC466, 0xC461, 0xAFD8, 0xC446, 0xBC2F, 0xA9F2, 0xCB6C, 0xC6FA, 0xC599, 0xA633, 0xCB6D, 0xA49C, 0xA5D5, 0x9F2E, 0x8F34, 0xD2A2, 0x860C, 0xBDFD, 0xC9C9, 0xAD8B, 0x9AC9, 0x8767, 0x9275, 0xBBEF, 0xBD42, 0xBFBA, 0xB516, 0xB435, 0xC190, 0xA8B0, 0x9B96, 0xAFCC, 0x9568, 0xCF72, 0x85E8, 0xA6D1, 0xBAC1, 0xD3C5, 0x8477, 0xA825, 0xCFEE, 0xC721, 0xB4BB, 0xA4E0, 0x9C05, 0xC03A, 0xC2AE, 0xB771, 0xB772, 0xB2C5, 0x9933, 0xA96B, 0xBBF9, 0xBFC3, 0xAB40, 0xCF75, 0xB1D2, 0xCDEC, 0xC795, 0x9D2E, 0xA092, 0xC722, 0xAD52, 0xB52F, 0xBBFA, 0xA0A8, 0x93BC, 0xAF1A, 0x885A, 0xB66E, 0xCB7A, 0xC46D, 0xCE5C, 0xA135, 0xA735, 0xA736, 0xCBFF, 0xB3F0, 0xCC45, 0xA8F9, 0xBF5C, 0x9A35, 0xCF0B, 0xA875, 0xB5B0, 0xC99B, 0x929A, 0xABB3, 0x9867, 0xA402, 0xA504, 0x9D33, 0x8EAA, 0xAB25, 0xA5F2, 0xA5BB, 0x9A61, 0x939C, 0xD25B, 0xC195, 0xA41B, 0xD0F2, 0x9A6E, 0xA63F, 0x9D35, 0xAC47, 0xA5DB, 0x9A73, 0xAA84, 0x9C67, 0xA41C, 0xA9E7, 0x9DE1, 0xB7BD, 0xBE02, 0xA5C1, 0xB61B, 0xC538, 0xCB88, 0xAA38, 0xABE6, 0x8785, 0x99B0, 0x99F7, 0xA97E, 0xAF94, 0xAA13, 0xBB52, 0xC501, 0xB374, 0x8C10, 0x99BF, 0xC696, 0x8DA4, 0x942F, 0xC697, 0xC69D, 0xA985, 0x99FC, 0xCDA1, 0x98A3, 0x9EC7, 0xA175, 0x97DE, 0x85FB, 0xC867, 0xD0EE, 0xD0EF, 0xA3FE, 0x87A7, 0xC39E, 0xBAEE, 0x8B85, 0xB53E, 0x8FC2, 0x9CC3, 0xB540, 0x8A9F, 0xB9B2, 0xB9B3, 0xA1DB, 0xD15B, 0xB8FB, 0xCD5E, 0x9821,
+		0xD52C, 0x995F, 0x9B1E, 0xABF4, 0x95BE, 0xBC40, 0xC3A7, 0x896C, 0xB9EB, 0xCAA7, 0xAABE, 0xC4D0, 0x92C3, 0x91D3, 0x852A, 0x8B42, 0xB341, 0xC1B5, 0xC1B6, 0xB776, 0x85B3, 0xB108, 0xCEF5, 0xCEF6, 0xA230, 0xB289, 0xBF24, 0xCBEE, 0xB28A, 0x88B7, 0xCE9C, 0xA1E0, 0x9B48, 0xB48D, 0xB28B, 0xD354, 0xC7F2, 0x9881, 0xADC6, 0xC0BC, 0x9345, 0x9346, 0x9874, 0x9601, 0xCFE8, 0x9483, 0xB657, 0x9FB0, 0x9422, 0x8AFE, 0x9097, 0xB050, 0xC456, 0xAE89, 0x8E0D, 0xB520, 0xCD12, 0xD08E, 0xB1BE, 0x8B28, 0xCD50, 0x98D8, 0x9811, 0xA168, 0xA69C, 0x948B, 0xB659, 0xD072, 0x963C, 0xB10E, 0x8851, 0xC42E, 0xD416, 0x8FC7, 0x878B, 0x87D5, 0x9664, 0xD0AC, 0xB580, 0xB8FC, 0xBEC9, 0xA854, 0x9689, 0x8E61, 0x968A, 0xCC5D, 0xA69D, 0x8AA5, 0xB584, 0xAB57, 0x95A0, 0x8CEC, 0x9B4B, 0x9B4C, 0xAB3D, 0x9492, 0xC92D, 0xC049, 0xA7CC, 0xD3B7, 0xCA3B, 0xC433, 0xA47F, 0x9EC3, 0xA6A3, 0xCB20, 0xB1C6, 0x9F71, 0x95A1, 0xB8FD, 0xCA79, 0xC0C8, 0xAD75, 0xB508, 0xB465, 0x977F, 0xB509, 0xD2E1, 0xAAF7, 0xA586, 0xD167, 0x9D6D, 0xAE45, 0xBB58, 0xB2A1, 0xC614, 0x9FB5, 0xB55C, 0xB8D8, 0xCCDD, 0x9D6F, 0xBFCE, 0x8A44, 0x8630, 0x91AC, 0x9853, 0xBABB, 0xC72D, 0x938E, 0xB972, 0xD1EA, 0x946A, 0xC45E, 0xD152, 0xC251, 0x943B, 0xA61B, 0xA548, 0x86CD, 0x8BDE, 0xCE81, 0xCD37, 0x9F79, 0x8E65, 0xC85F, 0xBEF3, 0x8FB2, 0xBFCF, 0x9B4D, 0xC202, 0xD255, 0xBBB7, 0x92EF, 0xA10E, 0xAC7C, 0xB2B1, 0xB2B2, 0xCE36, 0xA253, 0xA4A4, 0xAC1F, 0xB3CF, 0xBB62, 0xC2FF, 0xAA61, 0xA6B5, 0x900F, 0xBEA7, 0xBDD5, 0xA458, 0x9831, 0x9858, 0xCE37, 0x85BD, 0x92FC, 0xB119, 0xC152, 0x9130, 0xCB3B, 0xCE85, 0xBCBB, 0xB602, 0xB39B, 0xB779, 0xAE8D, 0xB4CB, 0xC153, 0xA76C, 0x97EB, 0xC06C, 0x905F, 0x91EE, 0x858E, 0x8F79, 0xC0CB, 0x88BB, 0xAC5B, 0xCC69, 0x97CA, 0x898A, 0x898B, 0xA4A5, 0xA2F8, 0x9C9B, 0xC565, 0xBEF9, 0xAB83, 0xC6AC, 0xAC91, 0xCDAB, 0x9F4B, 0xB9FA, 0xC718, 0x9AEF, 0xC89F, 0x8715, 0xB715, 0xC0EF, 0x949F, 0xCEB6, 0xABD8, 0xC181, 0x9448, 0xB765, 0xC58A, 0xCD20, 0xB562, 0xAB5B, 0xC3AF, 0xCC2D, 0xB9CC, 0x8B8C, 0xBD6C, 0xD3BC, 0xD55C, 0xBE25, 0x94A0, 0xCEB7, 0xA413, 0xAC04, 0xA4A8, 0x90C0, 0x99E5, 0xB528, 0x9012,
+		0xC343, 0xC204, 0xCF1A, 0xB5C6, 0xAE2A, 0xA931, 0x8C7E, 0xB6F5, 0xCC6B, 0x9E7E, 0x857E, 0xA54A, 0xB037, 0xBB6F, 0xC314, 0x8CEE, 0xC315, 0xCEBD, 0xC1E5, 0xA715, 0xBBE1, 0x857F, 0xB6FA, 0xB6FB, 0x9CAE, 0xC239, 0xC686, 0x9860, 0xB4A1, 0xCA8B, 0x8673, 0x9A43, 0xBD8F, 0xCAB7, 0x90EF, 0xAE10, 0x98EE, 0x99E9, 0x9B50, 0xAAC5, 0xAD2F, 0xCB5D, 0xC1C1, 0xCC6F, 0xBDF9, 0xD337, 0x88E0, 0xBBA6, 0xB2B8, 0xAB0B, 0xBEAC, 0xB8BA, 0xBA5F, 0x88F5, 0x8B0E, 0x8F81, 0x84F3, 0xA9D4, 0xC18C, 0xD311, 0xBD92, 0x8A7E, 0xB16A, 0xB5AA, 0xB11F, 0xCDB7, 0x9E62, 0xAA7C, 0xA935, 0xB4AF, 0xD339, 0xCBFE, 0x9E85, 0xBAF8, 0xC1C4, 0xC527, 0xAB22, 0xD171, 0xD188, 0xAAB8, 0xAAB9, 0x97CD, 0xA8AB, 0xAB5E, 0xC31B, 0x8D10, 0xA62F, 0xAB0D, 0xC528, 0x89FD, 0xD106, 0xAB46, 0xC4EF, 0xAD8C, 0xD5CF, 0xBB71, 0xB52C, 0xBDED, 0xD27C, 0xB0BE, 0xB0BF, 0x9F0C, 0xB5AE, 0x878E, 0xA79F, 0xB011, 0x9784, 0xC36A, 0x9CD0, 0xD442, 0x9AF5, 0x92FD, 0xA576, 0xA20D, 0xA20E, 0x9B9D, 0xCBE4, 0x8999, 0x9ED8, 0xB8A2, 0xAE4B, 0xC52E, 0xADA3, 0x8B8D, 0xC7BC, 0xBB72, 0x8BEB, 0x8AB7, 0x8E87, 0xA662, 0xC5D2, 0xB8A3, 0xCABB, 0xA05D, 0x8DE5, 0x899D, 0xB31A, 0x8CD9, 0xC4D9, 0xB913, 0xAA93, 0xB4A3, 0xCC7D, 0x8970, 0xA6C9, 0xD038, 0xC723, 0xA71F, 0xD3D9, 0xD028, 0xC566, 0xAB47, 0xC5D3, 0xB2CD, 0xB704, 0x9E1B, 0xAC22, 0x9890, 0xB73D, 0x9C06, 0xD4DF, 0x9F92, 0x87AD, 0x98B9, 0xACDD, 0x929C, 0xA5DA, 0x9F91, 0xA4B2, 0xAD19, 0xA640, 0x8C9E, 0xA6DA, 0xB569, 0xAF20, 0xA975, 0x90F2, 0xC535, 0xD3CA, 0xA908, 0xA641, 0xA909, 0xB03C, 0xC9D6, 0xB126, 0xAE31, 0x96A4, 0xA1A1, 0xCC4D, 0xBAC6, 0xC330, 0x88BD, 0xB5BA, 0xB610, 0x90F3, 0x88BE, 0xAB05, 0xB5BD, 0x9F93, 0xD3E3, 0xD4AF, 0xC774, 0x9AD0, 0xAF8F, 0xB064, 0xAF92, 0xC603, 0xD35A, 0xAAED, 0x9244, 0xA26B, 0xACDE, 0xC3A4, 0xC621, 0xC886, 0xA2A7, 0xCED3, 0xB59F, 0xA855, 0xC745, 0xBADB, 0xC42F, 0xBF2B, 0xA91E, 0x9F47, 0xC437, 0xC7FA, 0x99E6, 0xC182, 0x8EDA, 0x8B81, 0xC187, 0x8EDC, 0xD499, 0x9930, 0xB5BB, 0xCA2C, 0xCA2A, 0x8868, 0x9501, 0xCD74, 0x8776, 0x8953, 0xCA5D, 0xB85F, 0x8A8D, 0x93DA, 0x97A3, 0x932F, 0xBAF3, 0x9F5E, 0xADEC, 0xD32E, 0x8E48, 0xCAF7,
+		0xD352, 0xD331, 0x9FAC, 0xD245, 0xC577, 0xB655, 0xD246, 0xB6BC, 0xB477, 0x9FC8, 0xA22C, 0xCE9D, 0xABCC, 0x896E, 0x84FF, 0xAEE6, 0x9B1F, 0xB656, 0x9D4F, 0xC011, 0xA87F, 0x8E0E, 0x85F3, 0xB04E, 0xC9BA, 0xD612, 0x86AC, 0xB8ED, 0xBD4C, 0xA169, 0x98FC, 0xC763, 0xC67C, 0xD1C9, 0xCA71, 0xAFED, 0x87F7, 0x8F0E, 0xC056, 0x9484, 0x97A6, 0xD2C6, 0xD2D6, 0xB658, 0xB01F, 0xCB03, 0xAB75, 0xBFFB, 0xADEF, 0x9628, 0xC741, 0xD15F, 0xB57E, 0x861F, 0xCE0F, 0xC338, 0xAC32, 0x87F8, 0x8800, 0x9430, 0x8B47, 0x8B43, 0xA386, 0xB6C2, 0x9EA6, 0x9D50, 0x9E37, 0xAD74, 0x9F6D, 0x921E, 0x9584, 0xB72A, 0xD3B4, 0x9751, 0xCB9F, 0x8898, 0xA7CD, 0xA266, 0xC62E, 0xD465, 0x8E7D, 0xB819, 0xA44E, 0xA330, 0xAEE9, 0xBADD, 0x9D54, 0xA46D, 0x8ADC, 0xA65A, 0xA198, 0xB938, 0xB202, 0x95A2, 0xCB30, 0xCE20, 0xD1CD, 0xA893, 0xB55D, 0xB64C, 0x9D01, 0xCB31, 0x886B, 0xD466, 0xBB24, 0xB834, 0xB64F, 0xA657, 0xA6FA, 0xA729, 0xA389, 0x9DFB, 0xC682, 0x8978, 0x86CE, 0xA452, 0xBDD3, 0xCEDA, 0xA85B, 0x8B48, 0x89DF, 0x8BAA, 0x915E, 0x8778, 0xA76A, 0xB8BE, 0xB06D, 0xB06E, 0x92ED, 0x97AA, 0xAA90, 0x97EC, 0xC89B, 0x90BE, 0xBE4D, 0xD2F5, 0xA10B, 0xB2E9, 0xB2A6, 0x97E7, 0x96DA, 0xA331, 0xB260, 0xBE4E, 0xBA52, 0x9444, 0x8B8A, 0xC5BB, 0x8728, 0x9010, 0xA459, 0x90F8, 0xC683, 0xC684, 0x9906, 0xCEDB, 0x85CE, 0x85E2, 0x949B, 0xCDA7, 0xBFB7, 0xC89D, 0x9B32, 0x8632, 0x8BF9, 0xAA07, 0xB053, 0x8E37, 0xCF50, 0xBCC7, 0x962E, 0x8DC5, 0x9A66, 0xB6F6, 0xCEDC, 0xB752, 0xAE0F, 0xA0A6, 0x8951, 0x8580, 0xBFD0, 0x8B4A, 0xB7DE, 0xAEEC, 0xCC33, 0x9FB9, 0xB359, 0x9A69, 0xA38C, 0xA70D, 0xA4F1, 0xA627, 0xD17D, 0xB9CE, 0xAA0A, 0xCB5E, 0x8FEE, 0xC5CB, 0xC40F, 0xC18D, 0x877A, 0x88F3, 0x9D19, 0xB3F8, 0xB81D, 0x85D0, 0xC4B9, 0x86E4, 0x9450, 0x9E0E, 0xD455, 0x9E0F, 0x8D8B, 0x9CB0, 0x8E3F, 0xCF89, 0x9294, 0x8841, 0xC4EE, 0xC7BB, 0x878D, 0xB683, 0xAB0C, 0xA4F8, 0x8503, 0xD025, 0x979D, 0x88FF, 0xBC75, 0xAF6A, 0x897C, 0xA733, 0x86FC, 0x9FD6, 0x8E84, 0xBAC2, 0xBD57, 0x86FD, 0xA4FC, 0x9453, 0xB73B, 0xD2A3, 0xBCEC, 0xBAC4, 0xC05B, 0xBA63, 0x8562, 0xC590, 0x884E, 0x8A9B, 0xA4E2, 0xA904, 0xC34E, 0xC41A, 0xA508,
+		0xD178, 0x8E21, 0xA14F, 0xCFC8, 0xB9D5, 0x9E20, 0xB025, 0xCB85, 0xA50F, 0xC80B, 0xC2B5, 0xC2B6, 0xC9B9, 0x93AD, 0xBD30, 0x9493, 0x93BE, 0x94AA, 0x854F, 0x98F4, 0x9C69, 0x9C6E, 0x9E24, 0x96E9, 0x873A, 0xC8C4, 0x9752, 0xA1E7, 0xB159, 0xAB76, 0xAC6F, 0xAC70, 0xACD2, 0xBA3C, 0xBB9A, 0x8977, 0xD1CE, 0xA1F1, 0xAC75, 0xBEBD, 0xA705, 0xC9C0, 0xD2F6, 0xA239, 0xC340, 0xB886, 0xC0F0, 0xC399, 0xBE8C, 0xCDB3, 0xA51D, 0x91F8, 0x90E1, 0xB47F, 0xB03F, 0x9D20, 0xAD30, 0xCC74, 0x9653, 0xB5D6, 0xD0F0, 0xA01B, 0x96F2, 0x8EFA, 0x9E69, 0xAA7E, 0xB5D7, 0x9DD4, 0xC122, 0xA216, 0xB5D9, 0xAA81, 0xA522, 0xB961, 0x8F37, 0x96F7, 0x9E22, 0x96E6, 0xC8BA, 0x9746, 0xAC68, 0xBA24, 0x8973, 0xA513, 0xA1DC, 0x9CED, 0xC339, 0x8F28, 0xBE87, 0xCDA2, 0x9651, 0xA00E, 0xB5D1, 0x9EE0, 0xB542, 0x9FC9, 0x8D34, 0xA1E8, 0xD2D7, 0x8A3B, 0x9C88, 0x9666, 0x8E66, 0xD504, 0x8F49, 0xB8B1, 0x9A54, 0xB5FE, 0x95A7, 0xBA4B, 0x9F98, 0x9780, 0x9608, 0x8C16, 0x9F99, 0x9BCC, 0xB55F, 0xC615, 0xBC20, 0xAE8E, 0x9C44, 0xA9C7, 0xD300, 0xD066, 0x86EE, 0xC61C, 0x99EC, 0xB8BB, 0x960E, 0xD316, 0xC76A, 0x8C22, 0xC324, 0xCA98, 0xA9E6, 0xA21B, 0xC32E, 0xC8F6, 0xC332, 0xC848, 0xC849, 0x903B, 0x9480, 0xB543, 0xB544, 0x9F19, 0x993E, 0x9CE9, 0x92AC, 0xC7CC, 0x8FDC, 0x9982, 0x8936, 0xBDE2, 0xC694, 0x9F68, 0x8E5A, 0xB6BF, 0xC7CD, 0xCBCB, 0xB88D, 0xB302, 0xBFFC, 0x9CEE, 0xC7CE, 0xCBDB, 0x91A6, 0x9333, 0xC81B, 0xC964, 0xBBCC, 0xB958, 0xCBD2, 0xC5E8, 0xA1E9, 0xC53D, 0xAE68, 0x8A72, 0xCD13, 0xD29D, 0xC653, 0x92DC, 0xC604, 0x8A1D, 0xB909, 0xC858, 0xB79C, 0xD49F, 0xC6BF, 0xCB12, 0xCB13, 0xBC0F, 0x8B05, 0x98B7, 0xB939, 0x98DC, 0xC6E6, 0xD1CF, 0xD419, 0xD22D, 0x9648, 0xD533, 0xD534, 0xD0AF, 0x968F, 0x9494, 0x9DFC, 0x9006, 0xA857, 0x8F4A, 0xC95A, 0xA6A4, 0xAE46, 0xB020, 0xD39B, 0x9519, 0xCB21, 0xA16E, 0xC9BE, 0xD08F, 0xC015, 0xCA7A, 0xB5C4, 0xD145, 0xB1C7, 0x86BF, 0xC658, 0xB585, 0x853B, 0x8DDC, 0xD5B9, 0xBDB5, 0xD3F0, 0x8CC7, 0xD168, 0xCCD8, 0xC6C2, 0xC7AF, 0xCB32, 0x9B0A, 0x98E0, 0xBA4C, 0x894E, 0xC558, 0xB9C6, 0x991F, 0x8960, 0x964C, 0x9781, 0xB5FF, 0x9B78, 0x9F7A, 0x9A55, 0x94C9, 0xC4CB,
+		0xC0ED, 0xB93C, 0x8BAB, 0x9083, 0xAC36, 0xD0B1, 0xAD38, 0x91EC, 0x9B2F, 0xC863, 0xC654, 0x96DB, 0x9246, 0x87EC, 0xBEB6, 0xCA7F, 0xA5CD, 0xD3B8, 0xA3CA, 0xA392, 0xC235, 0xCE2C, 0xBDF4, 0x9D0B, 0xD2F7, 0xB6CA, 0x8D40, 0xA557, 0x91AE, 0xA3D2, 0xC300, 0xBA53, 0xBF09, 0x8E68, 0x8633, 0x898C, 0xCD55, 0xA33C, 0xB447, 0xB47D, 0xBAF2, 0x84AB, 0xCDE3, 0xC55B, 0x8AE1, 0x9FB8, 0xC494, 0xC237, 0xC2A6, 0x9576, 0xBBCE, 0x8862, 0x9B7E, 0xA07E, 0xBB33, 0xD1EC, 0xBB34, 0x90BF, 0x9A33, 0x8A16, 0xBB19, 0x9F85, 0xA320, 0xB58B, 0x8D22, 0xC53E, 0xBC6C, 0xC0CC, 0xC43F, 0x9309, 0x8B0D, 0xBCDF, 0xCB46, 0xADDF, 0xCBDC, 0x8FE6, 0xB12F, 0xD4D0, 0x89BE, 0x8A60, 0xD498, 0x9D11, 0xB26A, 0xBD39, 0xD48B, 0xC158, 0xA10F, 0xB4E7, 0x906B, 0xD22F, 0xD01C, 0xD592, 0xB357, 0xD4D1, 0xA779, 0x9E52, 0x8C1B, 0xC3E6, 0xAA6C, 0xB95A, 0x8779, 0x9A5C, 0xB154, 0xCDAC, 0x9014, 0xC6AE, 0xB15C, 0xBA5A, 0xC71B, 0xBA5B, 0x9BCD, 0x9A5D, 0x91F9, 0xD35B, 0x8F7F, 0xC58C, 0x944B, 0xB23D, 0xBEFA, 0x9E5B, 0xB2EC, 0xCE48, 0xD511, 0xD441, 0xC366, 0x9B8F, 0xCBB4, 0x84AF, 0xC441, 0xAE23, 0x8A7F, 0x93AF, 0xD3BF, 0xC934, 0xC8E2, 0x9AF3, 0xC71C, 0x95B0, 0xAFAE, 0xB26C, 0xAD9E, 0xCA14, 0xC188, 0xC619, 0xBDFB, 0xD37A, 0xB89F, 0x9E10, 0xB1E4, 0xA43C, 0x9B0D, 0x9BFC, 0x9590, 0xCC75, 0xAC37, 0xC547, 0xAC83, 0xC369, 0xB3EE, 0x8A62, 0xC77E, 0xBE2A, 0xBC30, 0x85C1, 0x8B35, 0xB35A, 0xBA60, 0x9E9A, 0xCEBE, 0xC5CC, 0xC529, 0xBDC2, 0xC996, 0xC997, 0xD258, 0xCDBB, 0x8768, 0x8D2C, 0xB4E9, 0xA630, 0xAD4D, 0xAD4E, 0xB8BC, 0xD173, 0xAD3C, 0x9E16, 0xD026, 0xD5BC, 0xA71B, 0xA936, 0x88CE, 0xAFCD, 0x97B9, 0xC347, 0xD4DA, 0x84F5, 0x8514, 0x9FEB, 0xD174, 0xCA95, 0x99C9, 0x9EB3, 0xAB23, 0x8A31, 0x9864, 0x9A45, 0x89C5, 0xC6AF, 0xD04E, 0xB817, 0xC325, 0xD069, 0x912A, 0xD231, 0xAE4D, 0xA4FD, 0x91B6, 0xCC9C, 0xA210, 0x9C5B, 0xD5F7, 0x9F0F, 0x8859, 0x9BA1, 0xD457, 0xAD53, 0xD03D, 0xD079, 0xBA00, 0xB436, 0xBD44, 0xD03E, 0xB240, 0xB998, 0xC724, 0xCFFE, 0xBD47, 0x8E8C, 0xBC7B, 0xC417, 0x9C5E, 0x9F32, 0xA05E, 0xA720, 0xAF74, 0xCA4B, 0x8478, 0xD0BF, 0xB143, 0x9B54, 0x9BA3, 0xCB7B, 0xCB7C, 0xB8A4, 0xB67C,
+		0xAF7C, 0xB3A0, 0x912C, 0xBCEA, 0x9A26, 0xD37D, 0x9891, 0x8498, 0xCC49, 0x9DDA, 0xCE5D, 0x9E1F, 0x9BAA, 0x90CF, 0xD177, 0xC726, 0xCFC5, 0xA5DC, 0xB962, 0xC197, 0x89CA, 0xA6E6, 0xCA99, 0x874B, 0xD179, 0xC8F7, 0x9203, 0x8BBC, 0xC199, 0x8BBD, 0xC9CF, 0x89A9, 0xB67D, 0xCC02, 0xA50D, 0x8A9C, 0xC594, 0xAF22, 0x9A80, 0xCFC9, 0xCB8A, 0x8E91, 0xD0C6, 0xC901, 0x90D8, 0xC841, 0x9CD4, 0x9036, 0x9473, 0xB6AA, 0x9C10, 0x9F54, 0x9981, 0xBDE0, 0xB67A, 0xB887, 0xB2F0, 0xBFEC, 0xC7C1, 0xCAC9, 0xC7C2, 0x9CD5, 0xB6AD, 0x9E8D, 0x9B59, 0xAFBF, 0xA152, 0xC80C, 0xAE64, 0xC6B7, 0x919B, 0xAA69, 0xC64B, 0xBBC7, 0x93AC, 0xB901, 0x92D1, 0xA1CF, 0xD226, 0x967B, 0x98B2, 0xB1AB, 0xD5B1, 0xB9E1, 0xC657, 0xD086, 0xBC00, 0xD1BE, 0x8F3B, 0xD35E, 0x8CBE, 0xB573, 0xD13B, 0x869A, 0xCADC, 0xCA57, 0xA3C2, 0xA5C8, 0xBA25, 0x96D4, 0xB9B4, 0x9F5F, 0x9B18, 0x8AC2, 0xD3AA, 0xB926, 0x9A4D, 0x8E57, 0x9640, 0xB5E6, 0x9772, 0xC7A0, 0xCAEB, 0xD227, 0x94BC, 0xC4C5, 0x895B, 0x9BC3, 0xC6BA, 0xD364, 0x9FAD, 0xC22C, 0xCDCF, 0xB440, 0xC290, 0x956D, 0xCD4C, 0x9B60, 0xA3CF, 0xBB31, 0xBBC9, 0xC2C4, 0xB4E3, 0xD3B1, 0xD588, 0xAFA6, 0x90B9, 0xD4BC, 0x9301, 0xA315, 0xC135, 0xCD81, 0xBB17, 0xB954, 0x8FDD, 0x89B2, 0xA771, 0xD497, 0xBC5E, 0xBD2B, 0xB955, 0xCB04, 0xAD9C, 0x8A73, 0x8FFC, 0x9B07, 0x9E38, 0xC57A, 0xC77C, 0xC9FC, 0xC174, 0x91DA, 0xCE10, 0xC706, 0x897F, 0xD4F6, 0x84A3, 0xC8C9, 0x8F67, 0xAC34, 0xB15A, 0xC698, 0xAD40, 0x8E8A, 0xBC10, 0xC5B6, 0xC97B, 0x85B8, 0xBA42, 0xB349, 0xAC72, 0x9FDF, 0xAB12, 0xD169, 0x9E04, 0xAD39, 0xBD34, 0xD03C, 0xB429, 0xA4EE, 0xB239, 0xA1F7, 0xC8DD, 0xB39D, 0xD0B3, 0x8A99, 0x967C, 0xB342, 0x9966, 0xC3A8, 0x9C8F, 0x9971, 0x9815, 0x9945, 0xC31C, 0xC31D, 0x9BD3, 0xA7A0, 0x983A, 0x90D0, 0xA90B, 0x909A, 0x9E78, 0xB2E0, 0x8B44, 0xA65B, 0x8EF2, 0xC06E, 0x8672, 0xBAE7, 0xC49D, 0x9396, 0xD31A, 0xD320, 0xC902, 0xC903, 0xBA06, 0x8C08, 0x9B23, 0xBFDF, 0xCB14, 0xBFE0, 0xCB15, 0x9DA9, 0x852C, 0x99A9, 0x91E3, 0x8BD9, 0xC4E5, 0x9AC1, 0x9DBB, 0xA32A, 0x94CA, 0xA176, 0x93EA, 0xC301, 0x879F, 0x9887, 0xCE40, 0xD3C0, 0x9D5C, 0x9354, 0xC31E, 0x8842, 0xC1A5,
+		0x9A9A, 0x87A8, 0x8F35, 0xD560, 0x9358, 0xCB73, 0xD2AA, 0x85EF, 0x8968, 0x84CB, 0xB10F, 0xADF2, 0xB0EA, 0x963D, 0xADF4, 0xCD52, 0x8FC9, 0xAD76, 0xBB9D, 0xC667, 0x9A92, 0xA32B, 0x98E1, 0x98E2, 0xAD7C, 0x84CF, 0xAB81, 0xA622, 0xAE8F, 0x8691, 0xCDE9, 0x9D5D, 0xBFA1, 0xAB4A, 0xB120, 0xC31F, 0xCB69, 0xA18C, 0xAD8A, 0x8BD1, 0xBD45, 0x9A7F, 0xA224, 0x8613, 0xD190, 0xCE97, 0x9481, 0x8896, 0x961E, 0xBDE7, 0xCB05, 0x9876, 0xC082, 0xB190, 0x9BED, 0x92B1, 0xBD1E, 0x970D, 0xD012, 0xD2D8, 0x9243, 0xD41A, 0xBA43, 0x86C0, 0xD505, 0x9245, 0x9790, 0xB15B, 0x8724, 0xAAE0, 0x8E11, 0xB9A6, 0xA3E7, 0x931A, 0xBEC6, 0xCA7B, 0x8B2B, 0xAB79, 0x9901, 0x8642, 0xA9B8, 0xA81E, 0x9B79, 0x94CB, 0xB153, 0xD506, 0x9D82, 0xC6EC, 0xD036, 0x9EF9, 0x94CC, 0xCFCE, 0x9E45, 0xCC2E, 0xC7D4, 0xD1ED, 0xB8F6, 0x87A0, 0x87A1, 0xB560, 0xB8F7, 0x8C46, 0xCFD0, 0xD374, 0xA4D6, 0xCFB7, 0x8CEF, 0x8A61, 0xB8C3, 0xBE8D, 0xB06F, 0x95DF, 0xC440, 0xAAEF, 0x9E53, 0xBC6D, 0x94A1, 0xBD65, 0x8D23, 0x8D24, 0xD301, 0x9C45, 0xD123, 0x90E2, 0xA013, 0xACFC, 0x8C9C, 0xCF6E, 0x85E7, 0xCF97, 0xA4D8, 0x93BB, 0x88E8, 0x9C56, 0xB9AD, 0x8491, 0xD456, 0x94A6, 0x9654, 0xB7BB, 0xD027, 0xA7A1, 0xCB6E, 0x856F, 0x8A8B, 0xC0AD, 0xD313, 0xD490, 0x876B, 0xCF1E, 0xD054, 0x8E78, 0xCFBF, 0xC8ED, 0xB22D, 0xB8A5, 0xC0AF, 0xCC82, 0xA01C, 0x9621, 0xC449, 0xCFD1, 0x8704, 0x90D1, 0xBAB4, 0xC8F4, 0xC8F5, 0xC753, 0xA920, 0x951F, 0xCFD3, 0x8611, 0xD18A, 0x9476, 0xCE8F, 0x961C, 0x8895, 0xD00A, 0xC423, 0x8566, 0xD11A, 0x9BE3, 0xD2B2, 0x92AA, 0xBD19, 0xB18F, 0x8720, 0x9641, 0xD403, 0x9707, 0x9242, 0x9E2C, 0x863D, 0xBA31, 0xBEC3, 0x978C, 0xA3E0, 0x8E07, 0xAB73, 0x9312, 0x98FB, 0xCA68, 0xD035, 0xD2C7, 0x9D7C, 0x9B66, 0xD4F0, 0xA81C, 0xA9A1, 0xCFCD, 0xD4F1, 0x94C4, 0x9FFD, 0xB551, 0xD1E6, 0xA4D2, 0xB8F3, 0x9495, 0x90DF, 0x9C32, 0xBAB0, 0xB8C2, 0x8CED, 0x86C1, 0xD371, 0x95DA, 0xB06B, 0xBD5E, 0xA4D5, 0x93B7, 0xD489, 0x949C, 0xD450, 0xB7B9, 0xD01D, 0xC8E3, 0xCFB8, 0xCF1B, 0xD053, 0xB8A0, 0xCC76, 0x951E, 0x8B50, 0xC2E1, 0xB90A, 0xAE25, 0xBF2C, 0xC38F, 0x8AA9, 0x9907, 0x8AB2, 0xD17C, 0xC3D2, 0xBD93, 0xD59A,
+		0xD599, 0xA686, 0xA0B2, 0x9482, 0xD13F, 0x9503, 0xB2E1, 0xB88E, 0xB502, 0xB4A8, 0xC458, 0xD4F7, 0xA1EA, 0xB4A9, 0x8B29, 0x8CBA, 0x8A91, 0x8A92, 0x9007, 0xA108, 0x8A1F, 0x8F14, 0xC389, 0xD0B0, 0xA1F2, 0xCEFE, 0xB586, 0x9C9C, 0x8B2D, 0x8BDF, 0x96C4, 0xC77D, 0xD507, 0xBEBE, 0x9179, 0xA7D1, 0x950A, 0xBCA3, 0x8D41, 0xC341, 0xD14B, 0xBC21, 0xCBF8, 0xA12B, 0x9E54, 0xB63E, 0xBDAC, 0x8CAD, 0x8D73, 0xA9C8, 0xB5D2, 0x8EA3, 0xB52A, 0xD512, 0xBE27, 0xB590, 0x8B6F, 0x9AF9, 0xB437, 0xB41A, 0x9F2F, 0xCFFF, 0xBE5A, 0x923A, 0xCFB3, 0xCFB4, 0xD5A4, 0xAFDF, 0x85F0, 0xC22F, 0xA2E5, 0x913F, 0xA1EB, 0x93E0, 0x8A84, 0x9DF4, 0x92C5, 0xD29E, 0xBCC1, 0xCEFB, 0x854B, 0xB296, 0xCEFC, 0xB3C8, 0xC037, 0xBCFC, 0xCB22, 0x9E42, 0xA858, 0xABD1, 0x853C, 0x8FED, 0xA379, 0xC016, 0x9D55, 0x8CE1, 0xB055, 0xB31E, 0xD41B, 0xA109, 0xBEC7, 0xD26F, 0x93E4, 0xB006, 0xA172, 0xB86D, 0x8852, 0xAE8B, 0xA16F, 0xA6A5, 0x95C8, 0xCA80, 0x9D02, 0x918E, 0xC45F, 0x9EC4, 0x918F, 0xD3B9, 0xB600, 0xA399, 0xD434, 0x9793, 0xB4F8, 0xA422, 0xC4CC, 0x8B62, 0xA9B9, 0x8667, 0xD2EB, 0x9D70, 0xBEBF, 0x88A2, 0x9E43, 0x8DDE, 0xB405, 0x86CF, 0xC3FF, 0x9190, 0x9C37, 0xA12A, 0x9D0C, 0xBAA1, 0xBF88, 0x8C17, 0xA07F, 0xAF04, 0xC518, 0x8885, 0xC765, 0x8D96, 0xADB9, 0xBAA2, 0xA75F, 0xCCDF, 0x9EFD, 0x8BAF, 0xB40A, 0xAC2B, 0xBCE0, 0x9E55, 0xB2B7, 0xC205, 0xC159, 0x8C9F, 0x9F86, 0x9CA8, 0xAF08, 0xA110, 0xAF09, 0xAA62, 0xA9C9, 0xA5FB, 0x9A67, 0xA180, 0x8B32, 0xC09E, 0xB605, 0xBE0A, 0x8839, 0xD5AD, 0xB46C, 0xB26B, 0x8D44, 0xD55D, 0x88A4, 0xD55E, 0xB0CE, 0xD302, 0xD215, 0x8F9F, 0xD277, 0xCDB4, 0x916D, 0x9154, 0x8C9D, 0xCCE2, 0xD34A, 0x8F80, 0xD081, 0x8A8A, 0x8C29, 0x9E56, 0xC01C, 0xBD90, 0xA14A, 0x944C, 0xD5AE, 0x9015, 0xB156, 0xB716, 0xAFAF, 0xBE28, 0x8954, 0xBFC9, 0x9E11, 0x8ECC, 0x8DA1, 0xB26E, 0xBCE5, 0xB3DA, 0xD0D9, 0x8F8C, 0xBE2B, 0x9CB1, 0xAF11, 0xB00F, 0xA8D3, 0xCFBC, 0x86F4, 0x8B84, 0xA9D8, 0xA7A2, 0x8D49, 0xBD6F, 0x8E1E, 0xBC31, 0xC36B, 0xA425, 0x9D24, 0xD27D, 0xB3DC, 0xD27E, 0xB012, 0xD561, 0xA71A, 0x866B, 0xCFE1, 0xAED5, 0x878F, 0xBFC4, 0xA132, 0x8EFB, 0x8928, 0xC413, 0x9297,
+		0x8BEF, 0xD33C, 0x913B, 0x8809, 0x8D4B, 0x8D4C, 0xA211, 0xA212, 0xA826, 0xBCC8, 0xB41B, 0xB41C, 0xB241, 0xA77C, 0x913C, 0x8D51, 0x9702, 0xD000, 0x8DE7, 0x8701, 0x8702, 0xD3DA, 0xA18E, 0x8BEC, 0xB451, 0x9140, 0x8BBA, 0x9C62, 0xC27F, 0xCF0F, 0xAED6, 0xA827, 0xA7DA, 0xD280, 0xA6E3, 0xD31D, 0x89A6, 0x8BF1, 0x910B, 0xC198, 0xA90C, 0xA828, 0xC41B, 0xC19A, 0xD5BE, 0xA50E, 0xC5D9, 0xB672, 0xC5D7, 0xAF23, 0xBCEB, 0xB5BC, 0x9CBA, 0x8D53, 0xD5C0, 0xC370, 0xA41E, 0xA225, 0xA829, 0xB91F, 0x9602, 0x8E2F, 0x9334, 0xB581, 0xBE38, 0x917A, 0x917B, 0x9609, 0xA54B, 0xB6A7, 0xAA91, 0x8473, 0x9C46, 0xA12C, 0xBDA1, 0xA34D, 0xA34E, 0xC8A2, 0xABC4, 0xA2BA, 0xB592, 0xA937, 0xA596, 0x917E, 0xD27F, 0xC8EE, 0xBE3A, 0x8EDF, 0xCC4F, 0xCE69, 0x8A3E, 0xD070, 0x9778, 0xA22D, 0xC170, 0xCEF7, 0xC601, 0x8E09, 0xC704, 0x92AD, 0xB6C0, 0xB869, 0xA3D0, 0xBA96, 0xBFE1, 0x8A74, 0x8E0F, 0x91DB, 0xADF3, 0xB297, 0xAB54, 0xB75D, 0xA3D1, 0xB37B, 0xD447, 0x9940, 0x99C0, 0xB5A6, 0xA3E8, 0x8F4B, 0xA859, 0x8E12, 0x84DF, 0xD1D0, 0x92B2, 0xA3CB, 0xC94A, 0xB099, 0x862A, 0x9667, 0x9668, 0xB051, 0xD41C, 0xB708, 0x91E4, 0x853D, 0xD380, 0xD29F, 0xC97C, 0xA2EB, 0xCB23, 0xB4C8, 0xBA4D, 0xB1C8, 0x9222, 0x9619, 0xA126, 0x9F22, 0x9734, 0xA9BA, 0xA27C, 0xB601, 0xD372, 0xCF9B, 0xD2EC, 0xB8F5, 0xA778, 0xCE38, 0xB8D9, 0xCD1D, 0x9AA7, 0xB649, 0xD16B, 0xC09D, 0x946C, 0xB4CC, 0xD375, 0xAE90, 0xA862, 0xD16E, 0xD0EB, 0xA77A, 0xD50E, 0x9B37, 0xC0CD, 0x8CAE, 0x97ED, 0xA2A1, 0xCAB5, 0xB0B8, 0xB3B9, 0x97B0, 0xAED2, 0xB1CD, 0x96FE, 0x8635, 0xAA63, 0xAFF6, 0xA761, 0xB766, 0xB717, 0x9CAF, 0xC935, 0xC442, 0x8C3C, 0x8D32, 0x8C80, 0x95B1, 0xCD22, 0x9947, 0xBAA5, 0x94A4, 0xD513, 0x944D, 0xC1A6, 0x8650, 0xD0DA, 0xCDB8, 0xC1A7, 0xA2A2, 0x9674, 0xD1B8, 0xC3D4, 0xCEBF, 0xA9D9, 0x9ED9, 0x8A2F, 0xD449, 0xC191, 0x9BC1, 0xC771, 0xD176, 0x9F30, 0xD0F1, 0x8864, 0xA58B, 0x9359, 0x9276, 0xA800, 0x95B6, 0xB815, 0xA2A5, 0x9AA0, 0xCABD, 0x9CB5, 0xD491, 0x9228, 0xCE5E, 0x9E6D, 0x994A, 0xA5BD, 0xB082, 0xA6E4, 0xA6E7, 0xA976, 0xA82A, 0x8A3C, 0xD06F, 0x976E, 0xC6FF, 0x8DF9, 0xB6B7, 0xD445, 0x91CA, 0xAA53,
+		0xB75A, 0x993C, 0x965C, 0xA2E3, 0xA95A, 0xD37F, 0xD299, 0xCAF8, 0x99BA, 0xD1C5, 0xA687, 0xC972, 0xB4BF, 0xBA38, 0xCF99, 0xD2C8, 0x9F1C, 0xD367, 0xB5ED, 0xA9A2, 0x9F1D, 0xD164, 0x9464, 0xA772, 0xAECE, 0x862B, 0x9B28, 0x97A7, 0xC0C9, 0xA75A, 0x8CAA, 0xD500, 0x8D31, 0x943C, 0x9C9D, 0xC1A2, 0xBA9F, 0xB07E, 0xCEB3, 0xC3CD, 0xD0D4, 0xA9CA, 0xD170, 0xA7F8, 0xC5E0, 0x9669, 0x8CC8, 0x8CCB, 0x86D0, 0xD5DE, 0x8740, 0xA4A6, 0xA4A7, 0x8CD1, 0xC758, 0x859E, 0x8744, 0x8745, 0x8746, 0x8747, 0x8748, 0x8582, 0x8CDA, 0x8749, 0x874A, 0x8A67, 0xB745, 0xAF60, 0xAF62, 0xD1D7, 0x8CA2, 0x8CA1, 0xCA29, 0xB6CE, 0x870A, 0x92A1, 0xB9DD, 0xB749, 0xA77D, 0x8DCA, 0x8972, 0x94E7, 0xB333, 0xCD59, 0xCD5A, 0xB2F3, 0xC7C5, 0xCA9E, 0x97F0, 0xAAE5, 0xB249, 0xD056, 0xC0D2, 0xBF98, 0xD494, 0xCC54, 0x8DCB, 0xCF57, 0x9FF2, 0x986D, 0xC80F, 0x92A2, 0xC263, 0x8DCC, 0x919E, 0x9A82, 0xD17F, 0xD327, 0x9FF3, 0xCEC8, 0xC100, 0xA6F2, 0x8B1C, 0x8A4C, 0xAEAE, 0xBE9F, 0x8B53, 0xCA5E, 0xA096, 0x9D43, 0x8A69, 0x8E00, 0x922D, 0x8F21, 0xB1EE, 0xD404, 0x8FF0, 0xD00B, 0xBDC7, 0xBACF, 0xC00C, 0xB5CD, 0xA063, 0x9B46, 0x9078, 0xCD4D, 0xAC31, 0x8665, 0x9C20, 0xAE05, 0xCA69, 0x9F63, 0xD475, 0xA7C3, 0xC7C9, 0xBFCB, 0xBBCA, 0xBA32, 0xBDCA, 0xB032, 0x99A5, 0xAEB1, 0x9148, 0xA099, 0xC735, 0xC7CA, 0x885E, 0xCD0E, 0xC502, 0xB54A, 0xBF5D, 0xD3D3, 0xB54B, 0x8F5D, 0x8F5E, 0xBF78, 0xA06D, 0xBE43, 0x909E, 0xCAAC, 0xD182, 0xBF10, 0x973F, 0xC296, 0xBA39, 0x8AF1, 0xBC07, 0xCFF4, 0xB648, 0x93A4, 0xA6F7, 0xBCB9, 0x9B49, 0xA655, 0x9683, 0xA4C6, 0x8640, 0x8DB8, 0xA1EC, 0x8666, 0x9AB7, 0x8E10, 0xA9AA, 0xCD14, 0xD36D, 0x9FFF, 0xCE11, 0x8CA8, 0xA405, 0xC0DB, 0xBE48, 0xCB16, 0x8DD9, 0xCECA, 0xAA75, 0x8671, 0xAFA9, 0xCD98, 0x8E9D, 0xBC51, 0x914B, 0xBC62, 0xC896, 0x8C36, 0x8B2C, 0xBE1B, 0xCE21, 0xB9F2, 0xD1A0, 0xCD17, 0xCF62, 0x91E5, 0x873E, 0x97F1, 0x91E6, 0xC3C6, 0x9AE3, 0xB559, 0x8ED5, 0x8DDD, 0xC115, 0xAE1F, 0xCA7C, 0x964D, 0xC984, 0x8BCA, 0xA899, 0xC7D2, 0xBCD7, 0x8F71, 0x8B2E, 0xCECB, 0xBC19, 0xBCD8, 0xB398, 0xAAC2, 0xC985, 0x9713, 0xD101, 0x84EB, 0xBA54, 0x888F, 0x8B67, 0xBC22, 0xCFDF,
+		0xD154, 0x9150, 0x8F7A, 0xA92D, 0x8B33, 0x8D9E, 0xA7F3, 0xD5EB, 0xB68C, 0xB358, 0xC738, 0xCE41, 0xCA87, 0x91F2, 0xA791, 0xA187, 0xBA5C, 0x86EF, 0xC96A, 0xAAF0, 0xC5C9, 0xBC76, 0x9A98, 0xD0B8, 0xBDF7, 0x9239, 0xACC5, 0x8717, 0x871A, 0xA496, 0xA634, 0xCEC3, 0xCD25, 0xAA85, 0xA665, 0xCADD, 0xBEE8, 0x8EFE, 0xB2D5, 0xCCA3, 0xB856, 0x981A, 0xCD68, 0xAB27, 0xB724, 0xB4FB, 0xC084, 0xA3D7, 0x93CE, 0xA2C2, 0x8794, 0x92BC, 0xC622, 0xADE6, 0xC5DC, 0xB94B, 0x85A0, 0xCE90, 0x8D8E, 0x9BDC, 0xC56A, 0x85A7, 0xC226, 0xA159, 0xCD00, 0x981C, 0xBCF5, 0xB51A, 0x869B, 0xB0D9, 0x87C8, 0xB8E9, 0x8614, 0xC052, 0x8F3C, 0xD586, 0xC9EE, 0xA7DF, 0xA3C3, 0x9747, 0xD3AB, 0xB9B5, 0xA372, 0xCDF9, 0x94BD, 0x98C2, 0xC37B, 0xD2B3, 0x9C7C, 0x9A84, 0x99A0, 0xC626, 0x9EB7, 0xC335, 0x9756, 0xAF9F, 0xA536, 0x9D63, 0xA3A3, 0xD228, 0xA549, 0xCF59, 0xC84E, 0x8AC3, 0x909B, 0xC2C5, 0xA9F9, 0x945E, 0xC244, 0x9419, 0x956E, 0x9893, 0xA538, 0x9D65, 0x95E2, 0xA244, 0xCC85, 0x881A, 0xC380, 0xB5CE, 0xA688, 0xCF2E, 0x887F, 0xC53A, 0xB253, 0xB109, 0xB4C2, 0x97BD, 0xD368, 0xBD2C, 0xD589, 0xB1B1, 0xA4BD, 0xAE83, 0x8A5A, 0xCD0F, 0x8886, 0xC4AA, 0x8E2D, 0xA121, 0xCCB4, 0xB402, 0xCA36, 0x9089, 0xC88C, 0xAB99, 0xB78B, 0x8624, 0x91DC, 0xBA97, 0xA1B7, 0xCDD8, 0xCF5C, 0x99A7, 0xA406, 0xC4AD, 0xC4AE, 0xBBD3, 0xBD7B, 0xAD25, 0xC2F0, 0xB746, 0x8C13, 0xD501, 0xD58F, 0xCA01, 0xC233, 0xC4B3, 0xCF33, 0xB403, 0xCCA7, 0x868F, 0xAB7A, 0x8A27, 0x93E8, 0xA7AA, 0xCBD8, 0xD43A, 0x9A19, 0xB406, 0xC834, 0xD0F9, 0xAB13, 0xB407, 0xC6C3, 0x8F01, 0x86F9, 0xC7B1, 0x86DE, 0xD043, 0xC119, 0xD22E, 0xAB82, 0xB895, 0xA7ED, 0xB1E2, 0x8E38, 0xAC05, 0xCA0F, 0x88FE, 0xA3AC, 0x9393, 0xAC06, 0xD593, 0xA3EB, 0xA732, 0xCFBA, 0x8996, 0xCCF4, 0x9C57, 0xC8E7, 0x899E, 0xCFBD, 0xA872, 0x9A7A, 0xC32D, 0x9399, 0xCFC2, 0xA6DE, 0xCD2B, 0x902B, 0xB545, 0xD4BD, 0xB07B, 0xD398, 0xCA72, 0x94F2, 0xCD82, 0xA0CB, 0xC88D, 0xD5D9, 0xAB55, 0x8E30, 0xC6DF, 0x90A4, 0xD19C, 0x9335, 0xCE9F, 0x93E1, 0xCF5D, 0xBD13, 0xBE5F, 0xB31F, 0xC017, 0x8D3D, 0x9812, 0x966A, 0xBBF4, 0xB1DB, 0x8BAC, 0xCFA1, 0xAD35, 0xA590, 0x8CAB, 0x8BAD,
+		0xCD54, 0xBF2D, 0xD2A1, 0xC400, 0x9EA9, 0x8AE3, 0xCC0B, 0xBF89, 0x9F27, 0xABAA, 0xA38B, 0xBC37, 0xA5E7, 0xB226, 0xD5DF, 0x9800, 0xC8DB, 0xB9C9, 0xAEE0, 0xC11C, 0xA9CB, 0xA51A, 0xC83A, 0xBDD7, 0xB065, 0xD0D7, 0x8C93, 0xBD3A, 0xD5E1, 0xD48C, 0x8D45, 0xA45D, 0xBE29, 0xC443, 0x90B5, 0x99EA, 0xC6C5, 0xC635, 0xBD55, 0xB564, 0x8C94, 0xCF6F, 0x924F, 0xA2FA, 0xBBE2, 0xAC38, 0xB607, 0x8BC5, 0x8DA2, 0xCF70, 0xCCE7, 0x84DA, 0xD094, 0x9801, 0xBD94, 0x9EAF, 0xB175, 0x89C4, 0xCE58, 0xA631, 0xCFE2, 0xA577, 0xCA49, 0x9EB1, 0x8865, 0x9F31, 0xC326, 0xBD46, 0xB1DD, 0xAF6B, 0xCB7D, 0xA663, 0xA18F, 0xC8F0, 0xCB7E, 0xAEE1, 0xB73F, 0xC799, 0x8BBB, 0xC8F8, 0xA878, 0xAC49, 0xAC4A, 0xAEE2, 0xC609, 0x9F34, 0xB9D7, 0xAC4C, 0xC8FE, 0x8733, 0x889A, 0xBA3D, 0xCD53, 0xBA44, 0xBA64, 0xA64E, 0xD353, 0xC9E6, 0xA776, 0xA635, 0x9FC2, 0x9FC3, 0x94B3, 0xCAA8, 0xA7A9, 0x8EB4, 0xD12F, 0x902C, 0xB1D8, 0xB54C, 0x8539, 0x9460, 0xD198, 0xD267, 0x852B, 0xAA3F, 0x9461, 0xAE07, 0x8FDF, 0xB86B, 0xB43C, 0xA366, 0x8C45, 0xD52F, 0x9288, 0xCD86, 0xC232, 0x984E, 0x9533, 0xB298, 0xAB3A, 0xB6D5, 0x8F68, 0xBB98, 0xC384, 0xCB17, 0x8963, 0xB9BD, 0xBF96, 0xC2E2, 0xAF8E, 0xB347, 0xB552, 0x9E39, 0xB0EB, 0xC9DD, 0xCBF1, 0x8544, 0x92C6, 0x8A77, 0xC630, 0x9099, 0xCEFF, 0xB34A, 0x93E5, 0x8882, 0xADF5, 0xC5E9, 0x91A9, 0xA1F3, 0x914C, 0x962A, 0xCBF2, 0xB37D, 0x858D, 0xB7A5, 0xB69A, 0x8A20, 0xAF58, 0x9336, 0xCF4D, 0xCAB1, 0xB492, 0xB0EC, 0x97C3, 0x996D, 0xCBD4, 0xA234, 0x8FE1, 0xCB24, 0xD333, 0xC35B, 0x94DB, 0xB6D6, 0x9BDE, 0xBD14, 0xA2C9, 0xCEA5, 0xA960, 0xAE77, 0xC1B9, 0x916A, 0xD50B, 0xAE8C, 0xBF83, 0xBA4E, 0xACF9, 0x966C, 0xA2F1, 0xA85C, 0x87D8, 0x8CCC, 0x9693, 0x882D, 0xB0ED, 0xCE2D, 0xBB9E, 0xD602, 0x8861, 0xCD1B, 0x8FB3, 0x9D83, 0xD1A9, 0xBA0F, 0xBA4F, 0xBECA, 0xA173, 0xB37E, 0xB9C7, 0xBCC6, 0xC74A, 0xD131, 0x85F5, 0x98E3, 0x86D1, 0xB98D, 0x8BE0, 0xB9F7, 0x882E, 0xD41F, 0x8B63, 0xCF80, 0xB1EA, 0xBF2E, 0xB37F, 0x943D, 0xD0A1, 0xAB6B, 0xB34F, 0x943E, 0xA6AD, 0xCF01, 0xB0EE, 0xC90F, 0x859C, 0x8802, 0x9F7B, 0x962C, 0xBAE1, 0xD211, 0xADB8, 0xAEA3, 0xAF03, 0x8F72, 0x9D58,
+		0xADD1, 0xBD50, 0xB949, 0xCAB2, 0xBB5B, 0xA3E9, 0xA267, 0x8610, 0x9E46, 0xBF31, 0xC631, 0x9973, 0x9EFE, 0x8B49, 0x9235, 0x95C3, 0x87D9, 0xB9FB, 0xAD9D, 0x97F5, 0xCBDD, 0xA23A, 0xD373, 0x8B94, 0xC4D5, 0xBF32, 0xAD7D, 0xA5E8, 0x9C3C, 0xCE39, 0xC6F4, 0xB69C, 0xD5F9, 0xD2F8, 0xB4F9, 0xB892, 0x8B68, 0xC35F, 0xC632, 0xB603, 0xB0EF, 0xBECC, 0xD3BD, 0x9994, 0xAD2C, 0xA396, 0xC98B, 0xC405, 0xC439, 0xC66A, 0xA23B, 0x893C, 0xA587, 0x9CA1, 0xB12E, 0xB72F, 0xAC5D, 0xCA40, 0xCBB0, 0x9735, 0x84C3, 0x904D, 0xCD38, 0xB807, 0xA2D6, 0xB383, 0xAA29, 0xBB63, 0xAE78, 0x8FE4, 0x985B, 0xB77B, 0xBA56, 0xA33D, 0xB563, 0xC51D, 0xD16F, 0xC685, 0xCFDB, 0xBE0B, 0x8DBD, 0x96C7, 0x9987, 0xCCAF, 0x90A9, 0xAA08, 0xABAD, 0xA54C, 0xC0AA, 0xC5EE, 0xCF3E, 0x8636, 0xC2A8, 0xBC28, 0xCE42, 0x898D, 0xBF0A, 0x883A, 0x985C, 0x9D72, 0x9977, 0xA19B, 0x9394, 0x897A, 0xC09F, 0xD303, 0xBB6D, 0xA19C, 0x9A44, 0xCDE5, 0xBEA8, 0xA3EA, 0xD4D2, 0xAA48, 0xC633, 0xB4AE, 0xB974, 0x9833, 0xAA49, 0xC9DE, 0x8BE6, 0xD051, 0xA111, 0xC497, 0x91AF, 0xAB3E, 0xB21A, 0xA628, 0xB023, 0xB77C, 0x8AE4, 0x9577, 0xA65D, 0xBDEC, 0x87C5, 0xD426, 0xD1EE, 0xBF6B, 0xA8C7, 0xD5E4, 0xA188, 0x8A0B, 0xCE87, 0x9DC9, 0x9539, 0x8FE9, 0xBDD9, 0x89FA, 0xAA65, 0xA321, 0xA867, 0xB0F5, 0xA9CF, 0xA62E, 0xB3D9, 0xB070, 0xA1AA, 0xACFD, 0xD5E5, 0xB0BB, 0x84D1, 0xB0F6, 0xC49B, 0xC7E5, 0xD479, 0xA5EC, 0x8456, 0xA34F, 0xBCE2, 0xA45E, 0x90C2, 0xC189, 0x8C70, 0xD514, 0xD217, 0x8644, 0xAF0F, 0xD584, 0x8C95, 0xBD3E, 0x9046, 0xB2B9, 0xB388, 0xD48D, 0x9C4F, 0xCE49, 0x9FEA, 0x9700, 0xAB5D, 0x89E1, 0xBE8E, 0xC316, 0xA74A, 0xBDDA, 0x96B7, 0x8DBE, 0xBAEA, 0xD1B7, 0xA9D0, 0xAC20, 0xA9D1, 0x9A34, 0x877B, 0x94B6, 0xA4CC, 0xA32D, 0x92C7, 0xC27A, 0xAE11, 0xC0AC, 0xCFB9, 0x99FF, 0x8EEB, 0xC40C, 0xC40D, 0x9BD2, 0xA77B, 0x9263, 0xABE5, 0xA27F, 0xC912, 0x8F19, 0xA74C, 0x97CB, 0xC467, 0x90F1, 0xBF8B, 0xC11E, 0xC0A0, 0x94A7, 0xB718, 0x9CB2, 0x91FC, 0xA23E, 0xD1B9, 0xBE2C, 0xD078, 0x9A23, 0xC936, 0x9109, 0xC3D5, 0xCDB9, 0xA33E, 0xB98F, 0x9AF6, 0xC15F, 0x94A8, 0xD134, 0x8940, 0xB46F, 0xB9D1, 0x9949, 0xA417, 0xAFB1, 0xADA0,
+		0xB416, 0xB417, 0x9999, 0xBF4F, 0xD578, 0x9A9B, 0xCA1A, 0xACFF, 0x9E63, 0x90F9, 0x9E5C, 0xBB70, 0xA418, 0x99ED, 0xC71D, 0xD17E, 0x9F8D, 0xD1BA, 0x8718, 0xD33A, 0xD517, 0xC66D, 0xC9DF, 0xABC5, 0xAFFD, 0x8461, 0x94D0, 0xB38A, 0xABB0, 0x8941, 0x8DE6, 0x85C2, 0xC3D7, 0xA71C, 0xBCB4, 0xA28B, 0xA8B1, 0xC998, 0xCA1E, 0xAF7A, 0xC1E6, 0xB701, 0xBD96, 0xBCB5, 0xB3DD, 0x95B4, 0xBB44, 0x8C71, 0x8847, 0xB013, 0x8DC9, 0x8675, 0xB7E0, 0x953A, 0xD518, 0xC23B, 0xCC3C, 0x9B15, 0xBEAE, 0xA8B2, 0xA280, 0xBC92, 0xB83A, 0xBBE3, 0xC0AE, 0x9569, 0xD1F2, 0xD1F3, 0xA54D, 0xCB6F, 0xCEC1, 0xBDA3, 0xAF14, 0xC348, 0x9D5E, 0x9592, 0xAAC6, 0xA19E, 0xBBD0, 0xD5BB, 0xBCB6, 0xBCB7, 0x9397, 0xC1A9, 0xADE1, 0xA994, 0xBCB8, 0xAFCE, 0xD5B0, 0xBFAF, 0xC671, 0x9718, 0xC752, 0xA74D, 0xBA81, 0x8515, 0xAB0E, 0xAD8D, 0xAA7F, 0x86FE, 0xC192, 0xA8B5, 0x8F1B, 0xB7D4, 0xD579, 0xCA96, 0xA9DE, 0x84F8, 0xA33F, 0xB3DE, 0x8D6F, 0xB270, 0x89FF, 0xBD70, 0xAB24, 0xCCB9, 0x89C6, 0x9398, 0xA087, 0x876C, 0xBAEB, 0xA94B, 0xC689, 0x8D12, 0xA8FA, 0xCFC0, 0x9E6A, 0x88F6, 0xA639, 0xC3EB, 0xC327, 0xA2BB, 0xBB15, 0x866C, 0xD107, 0xB361, 0x8AB8, 0xA9E3, 0x9A46, 0x9CB6, 0xB22F, 0x9BA4, 0xB3DF, 0xB1DE, 0xA803, 0xB816, 0xC68A, 0xC4A3, 0x8AB9, 0xA441, 0xBB75, 0xA8B7, 0xAE4E, 0x9AFB, 0xB186, 0xBC7A, 0x929B, 0xB438, 0xB5E2, 0xC939, 0xBD71, 0xC4F0, 0xA217, 0x9EDA, 0xD5F0, 0xA7A4, 0xB471, 0xA578, 0x912B, 0xC5F8, 0xCFA8, 0x9C5F, 0x9E1C, 0xD33D, 0x8F09, 0xC7E9, 0xCC93, 0x9116, 0xA218, 0xAF7B, 0xBE59, 0xB242, 0xBECE, 0xBECF, 0xD113, 0x9035, 0xB8A6, 0xA26E, 0x9E1D, 0x9324, 0xBC7C, 0xA996, 0xA1A0, 0x9BA7, 0xCE5F, 0xA722, 0xD4DC, 0xB41D, 0xB365, 0xD4E0, 0xA5BC, 0x8705, 0xBED0, 0x9AA2, 0xC591, 0x916F, 0x97EF, 0x8ABB, 0xA191, 0x935A, 0x8DE8, 0x863A, 0xCB81, 0x8499, 0xD569, 0xC7D8, 0x8FEB, 0xD42C, 0x9934, 0xD492, 0x9C68, 0xAEAB, 0x98F7, 0x9C0C, 0xB4CF, 0x87AE, 0xCC4B, 0xA443, 0xAF3E, 0xC6B2, 0x9E6E, 0x9E6F, 0xB3A1, 0x8956, 0xD31F, 0xACDF, 0xA645, 0xA5BF, 0x9C6A, 0xD5BF, 0xA3F5, 0xB8C4, 0xB0C6, 0xA4B3, 0x90D4, 0xBB46, 0x8CB5, 0xAA21, 0x8774, 0x8601, 0xA997, 0xC44A, 0xA3B8, 0xA90D, 0x9204,
+		0xA977, 0xC5F4, 0x9E70, 0xA510, 0x8850, 0x9DE2, 0xC9D0, 0x89AA, 0xC4C1, 0x9E71, 0xC333, 0x9720, 0x88E7, 0xAF24, 0xA5DE, 0x8D54, 0xB5BE, 0xB026, 0xAA89, 0xD5C1, 0xAA39, 0xCFE4, 0xAF26, 0xA226, 0xBDA9, 0xD3E4, 0xA511, 0x9FC0, 0x94B1, 0xCA9F, 0xD18B, 0x9029, 0xD128, 0xB1D3, 0xA7A8, 0xBF95, 0xB33C, 0x8C44, 0xB860, 0xB7A1, 0x9283, 0x8FDA, 0xABDE, 0xAF8C, 0xC91F, 0x8962, 0xC62A, 0x94D6, 0x8879, 0xBD0F, 0xA161, 0x9149, 0x8A1C, 0xD32F, 0xADED, 0x861A, 0x952E, 0x858A, 0xB372, 0xCEF2, 0xB476, 0xA22E, 0xC22D, 0x9627, 0xA2C8, 0x92C1, 0x9BDD, 0x9095, 0xAF55, 0x8543, 0xCE04, 0xB375, 0xD202, 0xB376, 0x9684, 0x87F9, 0xA2E6, 0xB1E8, 0x885F, 0x8820, 0xCEF8, 0xD5C8, 0xADC7, 0xBD4D, 0x9D7D, 0x8FAA, 0xCD10, 0xBEC8, 0x8821, 0xA843, 0xBB38, 0xB343, 0xAB68, 0x85F1, 0xBA3A, 0xC742, 0xBCC2, 0x86AD, 0xAE84, 0xB0E6, 0x9162, 0xC62F, 0xA2D4, 0xA585, 0x9233, 0xAB3B, 0xC7E2, 0xCD33, 0x8AD4, 0x9D6B, 0xC9DB, 0xAE43, 0xD2D9, 0x8AA6, 0xBF27, 0xAA00, 0x8FE0, 0xCBA0, 0xA274, 0xD073, 0xD3B5, 0xC356, 0xBF05, 0x904B, 0xC3FE, 0x9A3B, 0xB5F4, 0xB830, 0x97F4, 0xBEBB, 0x95C1, 0xAD24, 0xD20A, 0xB800, 0x9EEE, 0xCA37, 0x89B5, 0x8B92, 0xBD67, 0x84BE, 0xCBD3, 0xB72B, 0xD41D, 0xA56D, 0xB216, 0xCDDB, 0xA4CA, 0xBDEA, 0xA741, 0xA33A, 0xC511, 0xBCAF, 0xA658, 0xD050, 0x8BDA, 0x97C4, 0x9572, 0x91AA, 0xC67E, 0x8DBA, 0xAA44, 0x938B, 0xC5EA, 0xA8C3, 0xA26C, 0x9DFD, 0xB777, 0xBE09, 0xA545, 0xB4AB, 0xA10A, 0x8455, 0xB3D0, 0xD17B, 0xAFAB, 0x8DBC, 0xAB58, 0x8643, 0xB2A7, 0x8EE7, 0xA31D, 0xA453, 0x89E0, 0xC2F8, 0x96B4, 0xAA78, 0x8C6E, 0xD478, 0x9FE0, 0xD2ED, 0xC401, 0xA1A8, 0x9BD0, 0xB06C, 0xBD33, 0x9042, 0x9E47, 0xA177, 0xAC1D, 0xD508, 0xB469, 0xCC25, 0xA27E, 0xB3D7, 0xBB64, 0x91EF, 0x893D, 0xB40B, 0xD336, 0x9105, 0xBBDF, 0x9AEA, 0x9A93, 0x8460, 0x90EE, 0xABC1, 0xA948, 0xD50C, 0x931C, 0xABAB, 0xAD85, 0xD1EF, 0x883B, 0x95AC, 0xAF0A, 0xBDA2, 0xA19D, 0xAF0B, 0xADE0, 0xA8A3, 0x9564, 0x85BF, 0xCB47, 0x9D5A, 0x87A2, 0xB6F7, 0x8761, 0xBD6E, 0xAB20, 0xAA7B, 0x866A, 0xCCB5, 0xA084, 0x8F17, 0xD5AF, 0xC74D, 0xA8A9, 0x8997, 0xA20C, 0xA799, 0xB22C, 0xA98F, 0x9127, 0xA4F9, 0xB23F,
+		0x8D50, 0xB3DB, 0x8F07, 0x9C00, 0xA5B4, 0x9A9C, 0xD4DB, 0xA71D, 0xCB70, 0x8955, 0x876E, 0xA4B0, 0x89A7, 0xC4BF, 0x89EB, 0x89EC, 0xA0CC, 0x8501, 0x900C, 0xA209, 0xA7AD, 0xAC48, 0x89E6, 0xABDF, 0xAACC, 0xBAFC, 0xB881, 0x9BE9, 0xABE0, 0xC859, 0x86B4, 0x984F, 0x86B5, 0xB86C, 0xA26D, 0xA2CA, 0x8657, 0x996E, 0xB781, 0xB7D7, 0xC434, 0x9E44, 0x9DB0, 0xAD0E, 0xC3AA, 0xBB1D, 0x90A6, 0xD076, 0xAE60, 0xD0B2, 0xB09A, 0xC3DB, 0xA85D, 0x873F, 0x86D2, 0xB782, 0x98E7, 0x96EF, 0x95AB, 0x958C, 0x925D, 0x8C18, 0x9983, 0x9759, 0xAD12, 0xB821, 0xA471, 0xA558, 0xA9ED, 0xBEF6, 0xB84C, 0xA12D, 0xCF02, 0xCF03, 0x89BF, 0xB5D3, 0xA823, 0x89E2, 0xB839, 0xA472, 0xC83B, 0xC1C2, 0xC868, 0x91FA, 0x9BB9, 0xCE4A, 0xC1C3, 0x9988, 0x85CF, 0x9989, 0xB5D5, 0xC9B3, 0xC1C5, 0x8590, 0x84B0, 0xC11F, 0xCBB9, 0xA487, 0xB640, 0xA4FA, 0x90B6, 0xB608, 0xB0D1, 0xBE7A, 0xAF15, 0xBCE7, 0xA281, 0x98F1, 0xB642, 0x8C58, 0x96F3, 0x90AC, 0xB2EE, 0xA3FF, 0xBD98, 0x96F5, 0xB171, 0xA2AD, 0xC3A2, 0x9BA5, 0x89C7, 0xB0FB, 0x8EAB, 0x9AA1, 0xBCE9, 0xC1C7, 0xC1C8, 0xABDB, 0xBAFA, 0xB87D, 0xC844, 0x9840, 0x8696, 0xC1D6, 0x8A8C, 0xB77F, 0xC101, 0xC420, 0x9954, 0x9D99, 0xAD03, 0xA2C6, 0xABE7, 0xD071, 0xAE5D, 0x974A, 0xC1B3, 0xBCD3, 0xAD08, 0xA9EB, 0xA273, 0x9257, 0x9598, 0x98C9, 0xA467, 0xA0B3, 0xCEF9, 0xA551, 0x90B2, 0xCE12, 0xC82F, 0x89DB, 0xC385, 0xC1B8, 0x9BB2, 0xC85A, 0x91DD, 0x89B6, 0xA4EB, 0xB5D0, 0x9B70, 0xA480, 0xB631, 0x98E4, 0xBE73, 0xBCD9, 0xB636, 0xA2AA, 0x9A94, 0x947B, 0x9471, 0xA598, 0x9117, 0xC5FB, 0xB32C, 0xC285, 0xCACA, 0xD2A4, 0xCB8F, 0xC916, 0x9092, 0x91C3, 0xB978, 0x8583, 0xB066, 0x9525, 0xCF44, 0xB758, 0xD287, 0xB0FE, 0xA047, 0x92C9, 0xC917, 0xCB90, 0xD1DB, 0x9F35, 0x8A9D, 0x91C7, 0xB574, 0x8F3D, 0xD5B2, 0xD608, 0x8FA4, 0xA87E, 0x8453, 0xC006, 0xC007, 0x8603, 0x87C9, 0x9477, 0x9CDE, 0xA99A, 0xA911, 0x8A68, 0xC624, 0x9184, 0xA944, 0xAD66, 0x9EA1, 0xBA89, 0x9185, 0xC478, 0x9216, 0x9773, 0xCD5C, 0x94BE, 0xB87E, 0xA245, 0xB441, 0xC62B, 0x8C85, 0x9485, 0x86A8, 0xC3B9, 0xB880, 0xB985, 0xD2BD, 0xB214, 0x9096, 0xCED1, 0xD1DF, 0x8BD4, 0xC47C, 0x8EBA, 0xAEF7,
+		0xCF49, 0xC451, 0xB067, 0xB068, 0xD58A, 0xCB06, 0x911F, 0xAA55, 0xCB9C, 0xA0FD, 0x8C67, 0x8A6F, 0xB10A, 0xA844, 0xBDCC, 0xC485, 0xA9A3, 0xB987, 0xC457, 0xCBA1, 0xD3EB, 0xC929, 0xB6D1, 0xC3C3, 0x8B88, 0xC8CA, 0xCBA2, 0xCD87, 0x8F12, 0xCD88, 0xA8EB, 0xC0DC, 0xC0DD, 0xAEFE, 0x9120, 0xBC4F, 0x84BF, 0x9AD7, 0x9F3D, 0xBC50, 0xCBEF, 0x94C8, 0xC897, 0x9B29, 0x95A3, 0xCF4E, 0xC29D, 0xC13D, 0x8480, 0xC38A, 0xBD81, 0x9D03, 0xD11F, 0x8EC3, 0x84E3, 0xC390, 0xCBF4, 0xB7A7, 0xB688, 0xA7EE, 0xBFBC, 0x8F02, 0xB11A, 0xBC68, 0xBC53, 0xCE3A, 0xC462, 0x934B, 0xAEA7, 0x9224, 0x9C50, 0x8EC8, 0xC345, 0xCBFB, 0xD307, 0x9B3A, 0xA916, 0xC328, 0xA67D, 0xA6BA, 0xA6C1, 0xD474, 0x98FD, 0xD24C, 0xBC8C, 0xA1B3, 0xAE1D, 0xCB18, 0xB62D, 0xC8D3, 0xB493, 0xB34B, 0xC655, 0xC804, 0x9C95, 0x96B1, 0x9A8F, 0xD2EE, 0x964E, 0xA1BB, 0x8CCE, 0xCCA8, 0xA10C, 0x8BE1, 0x99C5, 0xCF87, 0xAAA6, 0xCDAD, 0x8BB1, 0x8FCE, 0xBC4D, 0x9865, 0x9BFD, 0xBB04, 0x9717, 0x8BED, 0xCF8A, 0xCCB8, 0x9C5A, 0xC321, 0x8BB7, 0xA8D5, 0xA636, 0xAE24, 0xC76B, 0xCF8C, 0x9CBB, 0x9CBC, 0xCDCA, 0xCD89, 0xC76D, 0xADE4, 0x93CA, 0xB801, 0xADCA, 0xC1BA, 0x9337, 0xB02C, 0xCF34, 0xA6A6, 0x8B64, 0xC910, 0xA85E, 0xA5AC, 0x84B9, 0x85D4, 0xABFE, 0x8FB4, 0x8E9E, 0x99C1, 0xC2A3, 0x8FE5, 0xC6A4, 0x9D0D, 0xADD6, 0x8A7D, 0xC51E, 0xD077, 0xBEF7, 0xD1F0, 0xB081, 0xABAE, 0xA863, 0xB26D, 0xD376, 0x9BFA, 0xB84D, 0x92F0, 0x997A, 0xD0B9, 0xCBB5, 0xAE91, 0xD42A, 0xBFE5, 0xA7F9, 0xA868, 0x9085, 0xCC34, 0xC2AA, 0xA86E, 0xBB03, 0xA86F, 0xC3D6, 0x997C, 0xCBBA, 0xAAF1, 0xAAF2, 0xCF53, 0xA8D4, 0xAC29, 0x87AB, 0xC2AC, 0xC193, 0xA48B, 0xCBE5, 0xC349, 0xCB74, 0x8479, 0x8E79, 0xBE04, 0xC4A4, 0xCE60, 0xA94C, 0xA8FB, 0x8E20, 0x9CB7, 0xB02F, 0xC93B, 0x8551, 0xB0FC, 0xC124, 0xC125, 0xC3A3, 0x9D36, 0xAADD, 0xAC2A, 0xAC15, 0xA5C0, 0xA6EA, 0x9C0D, 0x847A, 0x9325, 0x8E23, 0xA90E, 0xA879, 0x849B, 0x939D, 0xA6EC, 0x85EB, 0x9910, 0x9911, 0x9913, 0x87EE, 0xB4BC, 0xB4BD, 0xA079, 0xBE60, 0xD1AA, 0xA07B, 0x8AAC, 0xB4F0, 0xA080, 0xA082, 0x8FB9, 0xA085, 0xBE62, 0x92E2, 0x92EC, 0xA2D8, 0xAC40, 0xACAD, 0xACAC, 0x85F7, 0xCA10, 0xBE8F,
+		0x9BA8, 0xCA21, 0x9599, 0x902D, 0x894C, 0x9DB1, 0xB6C6, 0x8F29, 0x90ED, 0xC29E, 0xB6C7, 0xB498, 0xA006, 0xC756, 0xAF59, 0x8545, 0xCBF5, 0xB79D, 0xADF8, 0xAD7E, 0xD5BA, 0x8DE0, 0x859D, 0xCB3C, 0xC9C1, 0xBDD6, 0x856D, 0x9F4A, 0x9974, 0xB052, 0xC911, 0x87DA, 0xCBB3, 0x95AD, 0xBDD8, 0x9CA9, 0xC58B, 0x84AC, 0x84AD, 0x991B, 0x961A, 0xB320, 0x8DE2, 0xB42E, 0xBEE2, 0xAAFF, 0xCC9A, 0xBC4C, 0xBEA9, 0xB44C, 0xC74E, 0xA354, 0x8663, 0xBCE6, 0xD112, 0x86F5, 0xA488, 0xA115, 0xA4B4, 0xC5CF, 0xB719, 0x85AC, 0x920D, 0xB52D, 0xB52E, 0x98F2, 0xB450, 0xADD8, 0xA116, 0x9DCB, 0x871B, 0x8F1A, 0x9DD0, 0xAFBE, 0xBDC3, 0x9631, 0xBCE8, 0x8639, 0xC58F, 0xB014, 0x95B7, 0x86FF, 0xA48C, 0xBD72, 0xA939, 0x979F, 0xB439, 0xC759, 0x9C63, 0x9DDE, 0x9E8B, 0x89D2, 0x8DE9, 0x9A28, 0xC46F, 0xB369, 0x90D5, 0xC05C, 0x9DE3, 0xA512, 0xC106, 0xB6C8, 0x9445, 0xABD5, 0xB622, 0x95AE, 0xC15A, 0xB44D, 0x9834, 0x8A17, 0xA489, 0xB720, 0xCF73, 0xB914, 0xC162, 0x95B5, 0x8570, 0xBDC4, 0x9632, 0xCF74, 0x9555, 0x8700, 0xC165, 0x9BA9, 0x90D2, 0xC05D, 0x90D6, 0xC0FB, 0xB6B8, 0x941A, 0x982D, 0xC14F, 0xCF66, 0xBDBD, 0xA0C9, 0xA0CD, 0xC408, 0xC5C0, 0xC410, 0x9C5C, 0xCB97, 0xCF86, 0xCF67, 0xB8E5, 0xA5A5, 0xB0BC, 0x9AFA, 0xCC3A, 0xCF76, 0xB0C2, 0x84B5, 0xCBBB, 0xC4DC, 0x9A29, 0xC9F4, 0x9030, 0xB4E5, 0xA407, 0xC4E8, 0xBB2A, 0x9813, 0xC69E, 0xCA81, 0xC6A1, 0x96B5, 0xBBCD, 0xA423, 0xB2A8, 0x9879, 0xCE2E, 0xC083, 0x857C, 0x914E, 0x8F2F, 0x8E39, 0xB022, 0xB1FA, 0xA881, 0x8A52, 0xA081, 0xA5E9, 0x98EB, 0xB40E, 0x91F3, 0x91B0, 0xC15B, 0x9F87, 0xA483, 0xB95B, 0xCA8C, 0xCA8D, 0xA2F9, 0x9114, 0x8743, 0xB1A9, 0xA5EA, 0xBEC0, 0x9D73, 0xBF55, 0x9B90, 0xA414, 0x9D74, 0xAA6E, 0xBF0B, 0x8AB3, 0xCC90, 0xCF1C, 0x99EB, 0x9861, 0xA05C, 0xBFBE, 0xBFBF, 0xB194, 0xA4D9, 0xBFC0, 0xD519, 0xD51A, 0x8C72, 0x9048, 0xA4DA, 0xBD40, 0x9862, 0xB35B, 0xA2FB, 0x8D8C, 0xA0A7, 0xB480, 0xCA91, 0xB7B6, 0xBE2F, 0x91B3, 0x91FF, 0xC86B, 0xC1E0, 0xA2A3, 0xCCC3, 0xD443, 0xC86C, 0xC469, 0xC608, 0xCABC, 0xCEDE, 0xB7E1, 0x8F8E, 0x8F8F, 0x9E9B, 0xA400, 0xA5F0, 0xA579, 0xB177, 0xC063, 0xAAF4, 0x8D4D, 0xC99C, 0x98AE,
+		0xB43A, 0x96BA, 0xC7D7, 0xC8B0, 0x9BAB, 0x89D3, 0xB740, 0xAC17, 0x87AF, 0xC470, 0xB19A, 0xA978, 0xA818, 0xAF25, 0xB612, 0xC9ED, 0x902F, 0xB4E2, 0x980F, 0xC4E3, 0xBB28, 0xC693, 0xC695, 0xC07F, 0x96A9, 0x914A, 0xB28C, 0x8578, 0xBBCB, 0x9875, 0xCE05, 0xA95F, 0xA880, 0xB1D9, 0xA050, 0x9F72, 0x9D6E, 0xBF07, 0x98DD, 0xCC88, 0xA09E, 0xA2EC, 0xCA82, 0xB193, 0xB1FD, 0xBFBB, 0x9854, 0xCC89, 0xCC8A, 0xA2F4, 0xBE26, 0xCCC2, 0x91F4, 0xD43F, 0xC866, 0x91B1, 0xAF10, 0xAAF3, 0x8F8B, 0xB7DF, 0x98AC, 0xA5EF, 0x89CF, 0xB73E, 0xB199, 0xB611, 0x937F, 0x904C, 0x96C3, 0x943F, 0xC3AD, 0xD0D5, 0x875A, 0xB7A9, 0x853E, 0xBD00, 0xA7D3, 0x96C8, 0xC74F, 0xB8DC, 0xA18A, 0x8765, 0xBB73, 0xC163, 0xC938, 0xC99A, 0xBBE5, 0xA282, 0xB7ED, 0x9277, 0xA8B6, 0xC34B, 0xA8D6, 0xB16C, 0xB16D, 0xA8B9, 0x876F, 0x8770, 0x8771, 0xA7A6, 0x8772, 0xB818, 0x939E, 0xC672, 0x9372, 0xC920, 0xD0C9, 0x8750, 0xB7A2, 0xA174, 0xBB5C, 0xBBDD, 0xC98C, 0xA89E, 0xB165, 0x8762, 0x8763, 0x92E3, 0x9279, 0x92F7, 0x92E0, 0xB9EC, 0xB9E5, 0x88AD, 0x9C27, 0x903C, 0xBB95, 0xC000, 0xD0AD, 0xBC81, 0xC4D3, 0xBFE2, 0xB6C9, 0xCE22, 0xA1BE, 0x8B5E, 0xCBF6, 0x92B3, 0x92B4, 0xBC85, 0xCBF3, 0xD420, 0xCA83, 0xD616, 0x86D3, 0x9F9A, 0xBDBE, 0x85E0, 0x8CD2, 0xBED4, 0xBB9F, 0x85E1, 0xBA50, 0x9191, 0x9807, 0xB6CB, 0xBE8B, 0x9EFF, 0x9D71, 0x87DB, 0xC98D, 0xBF33, 0x8CD3, 0xC4D6, 0xC94E, 0xA1C1, 0x9236, 0xC8DE, 0xA5AB, 0xC302, 0x88B4, 0x8807, 0xAE6D, 0x91F5, 0x8863, 0xA257, 0x90AA, 0xBC29, 0xCDAE, 0xC309, 0xC994, 0x9BBF, 0x97EE, 0xBA5D, 0x9E5D, 0xD48E, 0x87DC, 0xC49C, 0x8888, 0xCA15, 0x8E72, 0x92F2, 0xD105, 0xC18A, 0x9701, 0x91FB, 0xAF9B, 0xCF71, 0x99EE, 0x9AF7, 0xBED5, 0x9B97, 0x9DCC, 0x999A, 0x8492, 0xBD95, 0x9339, 0xC18E, 0x969F, 0x8942, 0xBBCF, 0xBD97, 0x8848, 0x9553, 0xC39F, 0xA43D, 0xA8B3, 0xBBE4, 0xBDDD, 0xCA1F, 0xC1AA, 0xAD50, 0xBD99, 0xAB00, 0x86FA, 0xCE5B, 0xC670, 0x9FEC, 0xB7D5, 0xA442, 0xD458, 0xB8A7, 0x8B70, 0x8E7A, 0xCB7F, 0x9C60, 0xB684, 0x8ABA, 0xCCBD, 0xBDC5, 0xC196, 0xC4DD, 0xD0C0, 0x933A, 0x980B, 0xAC16, 0xC8F9, 0xAD57, 0x89AB, 0xC4DE, 0xAA8A, 0xCFCA, 0xAE35, 0xB9DA, 0x9038,
+		0x9C14, 0xBFF0, 0xBD74, 0xBFDE, 0xC376, 0xB6B9, 0xCDF7, 0x8B51, 0x92A8, 0xCBED, 0x9E27, 0xBA26, 0x85D7, 0xBB8A, 0x9186, 0xCA5F, 0x922F, 0xB67F, 0xC4D1, 0x98CA, 0xA5AA, 0x9EE6, 0xC2C6, 0x87D2, 0x87FA, 0x909F, 0x91D4, 0xCD83, 0xAE6B, 0xA24B, 0x97E5, 0x9BBC, 0xC488, 0x96F9, 0x893B, 0xA430, 0x9690, 0xBBDC, 0x8984, 0xCA05, 0xAD42, 0x882F, 0xA89A, 0xC668, 0x9FE7, 0xAAFA, 0xB7CE, 0xD453, 0xAE33, 0xBA6F, 0xA408, 0x97DF, 0xC4A9, 0x934A, 0x8837, 0xAEA4, 0x86DF, 0x883E, 0xBF8A, 0x9817, 0x92F3, 0x9DCD, 0x84B1, 0x8496, 0x94AB, 0xC412, 0xCF1D, 0xC5F3, 0x935B, 0xB18C, 0xC5F5, 0xAABF, 0xCE23, 0x93A6, 0x9855, 0x8F73, 0xC019, 0xC001, 0x8B2F, 0xC7B0, 0xD421, 0xD256, 0xB07F, 0xC606, 0xB6D7, 0xB7A8, 0xCF51, 0xC1BD, 0xD273, 0x8E4D, 0xA9EE, 0xCD1E, 0x8838, 0x85E3, 0xA1F8, 0xC01B, 0xCB3D, 0xB588, 0xB217, 0xB58C, 0xA090, 0xB1EB, 0xD132, 0xCE86, 0xB09D, 0xD39F, 0xA17C, 0xD427, 0xAF82, 0xA112, 0xB0F2, 0xCFD5, 0x9D91, 0xA864, 0xD1D4, 0xBCF2, 0x94A2, 0xC94F, 0xBA10, 0x86E5, 0xC01D, 0xC01E, 0xBBA1, 0xA8A4, 0xAAD5, 0xB157, 0xBDDB, 0xD308, 0xB6FC, 0xBDFA, 0x9084, 0xC78C, 0xB5E0, 0xB941, 0xA091, 0x9237, 0x9808, 0x883F, 0xD3C1, 0xCBB6, 0xAAA7, 0xD377, 0x8E73, 0x9809, 0xA8AC, 0xA130, 0xBBC6, 0xB481, 0xAB3F, 0xA54E, 0x9863, 0xBF8C, 0xC71E, 0xBFD1, 0xA25A, 0x91BC, 0x8AF5, 0xC61D, 0xBBA4, 0xA9DA, 0xD47A, 0xD37C, 0xB915, 0xB158, 0xA461, 0xBDDE, 0xA4CD, 0xD562, 0xA323, 0xB2C2, 0xB2C3, 0xC8E8, 0x92F4, 0xB7EE, 0xC8E9, 0x95B8, 0xC9B4, 0xC2AF, 0xB15F, 0x8D13, 0xB160, 0xB38E, 0x92F5, 0x9AFC, 0xB38C, 0x9BD5, 0xCDC0, 0xBE32, 0xB5E1, 0xC3D9, 0xD564, 0xA419, 0xB71B, 0xBB74, 0x96CA, 0xC023, 0x9767, 0xBBE6, 0xB364, 0x8AED, 0xD317, 0xA8B8, 0xB0C4, 0xBDFE, 0xC34C, 0x88FC, 0x90CD, 0xC8F1, 0xCEC4, 0xD597, 0xB7EF, 0xB8A8, 0xB2CF, 0xD31B, 0xBB08, 0xA9E4, 0xC34D, 0xAA82, 0xD108, 0xAD8F, 0x8516, 0x88B5, 0x8773, 0x8D14, 0xB595, 0x8706, 0xD31C, 0xCE62, 0xC6B1, 0x9A47, 0x880A, 0xBC32, 0xC536, 0xA805, 0xD0F3, 0x913D, 0xA8BC, 0xC025, 0x8927, 0x8FBD, 0x9EDB, 0xBED1, 0xC8FA, 0xAA8B, 0xD0C2, 0xA043, 0xCB86, 0xCA22, 0xC003, 0xB18D, 0xD3A6, 0xC8FF, 0xA90F, 0xA9F5, 0xBE03,
+		0xC4C2, 0x9D3A, 0xBB0E, 0xA139, 0xC350, 0x9A81, 0xA648, 0x8775, 0xAABA, 0xCDEF, 0xC004, 0xC79E, 0x8B1A, 0xB575, 0xB6D3, 0x8810, 0xA9EA, 0xCFD4, 0xBA09, 0xBB8B, 0x947C, 0xA0F4, 0xD587, 0xD405, 0xC00D, 0xAF80, 0x9D89, 0xCAEC, 0xBCEF, 0xC4FA, 0xAACD, 0xCB98, 0x9EBE, 0x9A39, 0xAA9D, 0x9803, 0xB14E, 0x8751, 0xA60B, 0x8AF2, 0xC8C5, 0xC60E, 0xB478, 0xA24C, 0xB299, 0xB29A, 0xA318, 0xD476, 0xD549, 0xBC0C, 0x88AE, 0xB15D, 0xD2E2, 0xA40C, 0xB7E8, 0xC29F, 0x850C, 0xA89B, 0xB350, 0xB893, 0x875B, 0xAA7A, 0x8D03, 0xD0EC, 0xD3A2, 0x9D2F, 0xBB06, 0xC4BB, 0x9680, 0xC13E, 0xC146, 0xC147, 0xCD99, 0x951A, 0xCB25, 0x84D4, 0xBF53, 0x9FB6, 0x85F8, 0x8634, 0x8CBD, 0xBE39, 0x8F4D, 0xA37B, 0x97FB, 0xB40F, 0x9998, 0xA39A, 0x95AF, 0xBFCA, 0x95ED, 0xB155, 0x86F6, 0xA2FC, 0xB329, 0xCDBC, 0xBC57, 0xA938, 0x884B, 0xC52F, 0xB040, 0x884C, 0x8DA3, 0xA3B7, 0x87B9, 0xAD54, 0xA7A5, 0xA93A, 0xC533, 0x90D3, 0xCFD2, 0xBC58, 0xBE3B, 0x87BB, 0xA3B9, 0xA979, 0x9546, 0x9548, 0xB448, 0xA2CC, 0xB43E, 0xA57A, 0xB7F9, 0x8752, 0xA451, 0xA454, 0x8F2B, 0x92DD, 0xC66B, 0xB65B, 0xAB5A, 0x8E6B, 0xA45A, 0x87B4, 0x924D, 0xBEAA, 0xB0F3, 0xD515, 0x9267, 0xB65D, 0xBE51, 0x85FA, 0x86E6, 0xAB5C, 0x9449, 0x9225, 0xB6FD, 0xB58E, 0x960D, 0xC66C, 0xA484, 0x9D1A, 0xB0BD, 0xD431, 0xB8DD, 0xBCA7, 0xBE54, 0xA6C2, 0x87B6, 0xD563, 0xBE57, 0xB0C0, 0xBBBB, 0xD218, 0xB60A, 0xD565, 0xBB30, 0x9E17, 0x917F, 0x99F0, 0xA4AD, 0xA0BC, 0xB2CE, 0xA721, 0xD1D8, 0x87BA, 0xB0C5, 0xAAD7, 0xB7C7, 0xAB02, 0xAB03, 0xB820, 0xC6B3, 0xA7DB, 0xB366, 0xB367, 0xAE32, 0x9AA3, 0xA48D, 0xAF3F, 0x87BC, 0xA7DC, 0xB673, 0x90A0, 0x90A8, 0xAE61, 0x998A, 0xC3A0, 0x90AE, 0x983B, 0x90AF, 0x90B0, 0xA0BD, 0x8A10, 0xCE6A, 0xCE6B, 0x959C, 0xC8E4, 0x946E, 0xC5F7, 0x9766, 0xD566, 0xA8D7, 0x9769, 0xB8BD, 0xCE63, 0x9779, 0xABD2, 0xB2A9, 0xB2AA, 0x94BA, 0xA411, 0x9BBE, 0x853F, 0xB1FB, 0xABD6, 0xC6A5, 0xC8A0, 0xC522, 0xA76D, 0xCE51, 0xBFC1, 0xB26F, 0xC0CF, 0xA76E, 0xC18F, 0x94FD, 0x8B12, 0xB16E, 0x8703, 0xAD55, 0xB2D0, 0xC6B0, 0x8BC7, 0xC8B4, 0xD0C3, 0xCD8A, 0x8EB6, 0xB69B, 0x9F7C, 0x852E, 0x9975, 0xC002, 0x8F30, 0x9CCC,
+		0xCDAF, 0x91B2, 0xB46A, 0xB837, 0x986A, 0xBFE4, 0xAD86, 0x9FBA, 0xB95C, 0x858F, 0xCEB8, 0xB11B, 0xA98B, 0xC1BE, 0x99E7, 0xA962, 0xCF81, 0x92CF, 0x934F, 0xADF9, 0xCD21, 0xB15E, 0xAD49, 0x98EF, 0xC3D3, 0xB58F, 0x9818, 0xB0F7, 0xA869, 0xC020, 0x854E, 0xB565, 0xB1CF, 0x944E, 0x86F0, 0x9D21, 0xC18B, 0xA113, 0x8FCF, 0x8840, 0xCD23, 0x97B2, 0xB11D, 0xAEC6, 0xC61A, 0xBD02, 0x85FC, 0x94A5, 0xD082, 0xA181, 0x9672, 0xB9FE, 0x9060, 0x8E18, 0xBCCD, 0x9F8E, 0xBAA7, 0x99AC, 0xC4DA, 0x9226, 0x84B2, 0xC120, 0xD14E, 0xD3C2, 0xCC10, 0xA7D5, 0xAAA8, 0xBF3F, 0xCA92, 0xCB6A, 0x87ED, 0xC160, 0x9ED4, 0xA37C, 0x9762, 0xC411, 0x95B2, 0x9B4E, 0xA58A, 0x9451, 0xA2D9, 0xC66E, 0x916E, 0xA23F, 0xBE30, 0xACAB, 0xB8DE, 0xD083, 0xBCA8, 0xB482, 0xCDBD, 0xAE6E, 0xD175, 0x97B3, 0x95EE, 0xBBF8, 0xC255, 0xB567, 0xB87A, 0xB21C, 0x9ACA, 0xBEAD, 0xA661, 0xB83B, 0xB83C, 0xA2DA, 0xAC11, 0x8AEA, 0xA637, 0xD59B, 0xC322, 0xCCE3, 0xB943, 0xD51B, 0xB2C6, 0xB4CE, 0xC4DB, 0xAE6F, 0x8C96, 0x9D30, 0x8FD3, 0xB470, 0x96B9, 0xD389, 0x9062, 0xA4CE, 0x92F6, 0xAE93, 0xCB75, 0xA462, 0xA9DF, 0xA0E5, 0x89E4, 0xA03E, 0xAA66, 0xA873, 0xD595, 0xA63A, 0xAC23, 0xD567, 0xD318, 0xAECA, 0x9A00, 0xCDC1, 0x8F4E, 0xB9D2, 0xB945, 0x9ACB, 0xBE33, 0x999B, 0xC61F, 0xD3C9, 0xA4B1, 0xD568, 0x9D25, 0x871C, 0x871D, 0x9ACC, 0xB60D, 0xD039, 0xC0F7, 0xC0F8, 0xCDC2, 0x8C82, 0xB71C, 0x9019, 0x9AFD, 0xA74F, 0xC8A8, 0xB530, 0xB531, 0x9E18, 0x8677, 0x9201, 0xC93A, 0x94AC, 0xB7B7, 0x9DD7, 0xC3B0, 0xC032, 0x99F1, 0xBA65, 0xB795, 0xC725, 0xC1AB, 0x9DDB, 0x98AF, 0xC23C, 0xB03B, 0xB7F0, 0xA8BA, 0xAAC8, 0xBA02, 0xB9D3, 0x96F6, 0xD51E, 0xBE00, 0xBCD0, 0xC99D, 0x95B9, 0xCCC4, 0xB391, 0xB2D1, 0xC1AC, 0xB796, 0xB94A, 0xA723, 0x84F9, 0xA5A9, 0x9B3C, 0xAD1A, 0x9D37, 0xBEB0, 0xB596, 0x9DDF, 0xB946, 0xAB04, 0xC34F, 0xB56A, 0x8780, 0x9D38, 0x9D34, 0xD3DB, 0x9E8C, 0xB533, 0xD444, 0xCCBE, 0xD109, 0xA2BC, 0xC773, 0x8783, 0xCE65, 0xB597, 0xC4F1, 0x880B, 0x9F14, 0xC7BD, 0xBC33, 0x9AFE, 0xD5F2, 0xB8AB, 0xB8AC, 0x927A, 0x97A0, 0xA807, 0xC7BE, 0xACBB, 0xC36D, 0xD04F, 0xC4C0, 0x9370, 0x96F8, 0x99AD, 0xA3B3, 0xD03A,
+		0xB7F1, 0xD0C4, 0x950F, 0x97A1, 0xB999, 0xA666, 0x8A00, 0xA5C2, 0xBAC7, 0x849A, 0xB741, 0x9D39, 0xC6FC, 0x9A2A, 0xBAC9, 0xA6EB, 0xA7DD, 0xAAAC, 0xACE0, 0xD1BC, 0xC4DF, 0x9206, 0xA97A, 0x9722, 0xA649, 0xC41D, 0xCD75, 0x8EB5, 0x9CC7, 0xCD18, 0xBFE3, 0xA984, 0x92CE, 0x852D, 0x98E5, 0x854C, 0xB1C9, 0xAEC5, 0xA961, 0xCD1C, 0xD07F, 0x9499, 0x8830, 0x85F6, 0x99AA, 0xB116, 0xBCFD, 0x975A, 0x9F82, 0xA2D7, 0xC154, 0x9223, 0xBF34, 0xD037, 0x99AB, 0xA3A9, 0x9D0E, 0x9EC8, 0xC406, 0xD080, 0xC4D7, 0xC7B2, 0x95EC, 0xA629, 0xA70E, 0x9DC3, 0xA65E, 0xB9FD, 0xBEAB, 0x97B1, 0xB838, 0x9AC5, 0xA23C, 0x9D12, 0xCCE1, 0xB4CD, 0xA86A, 0xB2BA, 0xD594, 0x92F1, 0xA45F, 0x89E3, 0x96B8, 0xAE92, 0xAEC7, 0x8FD0, 0xA03C, 0xB942, 0xB9CF, 0xD516, 0x936E, 0x9016, 0x8674, 0x89FE, 0xBE2D, 0xC1A8, 0xC0F4, 0xB7B5, 0x91FD, 0xB52B, 0x94A9, 0x9AF8, 0xB609, 0x9E86, 0x8719, 0xB7EC, 0x84F7, 0xB2C4, 0xBCCE, 0x96F4, 0xC999, 0xB038, 0x9DD1, 0xA5A8, 0x877F, 0xC772, 0x8782, 0xAB01, 0xAD18, 0xCCBA, 0xC194, 0xC34A, 0x979E, 0xB8A9, 0xA804, 0xD5F1, 0x9A27, 0x950E, 0xA664, 0xD0C1, 0x9703, 0xAEE7, 0xCAB3, 0x9440, 0xA6AE, 0xA0B9, 0x886C, 0xC8D6, 0x941B, 0x8FCB, 0x9C38, 0x93B8, 0xB751, 0x94FA, 0xB9CA, 0x93B9, 0xAD2D, 0x85E4, 0xCE84, 0xD257, 0x9A21, 0xB49C, 0x93ED, 0x9350, 0xC1BF, 0x9DC4, 0xB9CD, 0xCE43, 0x93CB, 0xC969, 0xA203, 0xA204, 0xB130, 0x9A77, 0xD1F1, 0x85E6, 0xC8DF, 0xC7E4, 0xD230, 0x92C8, 0x93BA, 0xC1C0, 0xAFCA, 0x8E19, 0x958D, 0xB732, 0xA86B, 0xACD7, 0x944A, 0xC021, 0xACFE, 0xA6BB, 0x872A, 0xD309, 0x958E, 0xCE88, 0x8CD7, 0xB5AB, 0xC523, 0x8B0F, 0x8E74, 0xA114, 0xC9B2, 0x9673, 0xD338, 0xCE4B, 0xC913, 0xCE4C, 0xC7E6, 0xBECD, 0xCE4D, 0xBE78, 0xCC35, 0x9115, 0xC23A, 0x9227, 0x96C9, 0x8493, 0xD259, 0xC8E6, 0x9931, 0xC52A, 0x9D75, 0xA7D6, 0xD3C3, 0xC937, 0xBE2E, 0x997D, 0xA9D5, 0xB738, 0xADA1, 0x9591, 0xB69D, 0x9ED5, 0xC66F, 0xD37B, 0x8B10, 0xAAA9, 0x9932, 0xAEC8, 0x91BD, 0xAA2A, 0x9D76, 0x9D26, 0xBF8D, 0x9A79, 0xC031, 0x886E, 0xC254, 0xA19F, 0xCE59, 0x8849, 0xA25B, 0xA25C, 0x8676, 0xC323, 0xA25D, 0xA131, 0xBF6C, 0xA03D, 0xBE31, 0x91B4, 0x91B5, 0xA3D3, 0x96A3,
+		0xC27E, 0xB944, 0xA4DC, 0x9F0D, 0xB024, 0xA9E0, 0xB123, 0xBAAB, 0x9454, 0x84B4, 0xD4DD, 0xB0C3, 0xB568, 0xB362, 0x860D, 0x8FD4, 0xA9E1, 0xB643, 0x9DD5, 0xA133, 0xBF9D, 0xC7E8, 0xCE8A, 0xB2C7, 0xA63B, 0xCA20, 0xD47B, 0xA350, 0x9196, 0xA463, 0xB991, 0xB2C8, 0xA03F, 0xCB76, 0xCB77, 0xA040, 0xD51C, 0xA4CF, 0x9063, 0xB271, 0x8C97, 0x95E1, 0xA117, 0xA213, 0xCB78, 0xD5EF, 0x9C5D, 0xBAEC, 0xBAED, 0x8B71, 0xACBA, 0xB71D, 0x84B6, 0xB532, 0xBE34, 0x99F2, 0xBE35, 0x9202, 0x9F4E, 0xAB5F, 0x9455, 0x8C83, 0xBF8E, 0xC8A9, 0x98F5, 0xCEC5, 0xB14D, 0xA464, 0xABB4, 0x99F3, 0xCC3E, 0x8C47, 0xC2B0, 0xA136, 0x9064, 0x88E2, 0x92DE, 0x99F4, 0xCC4A, 0xCEC6, 0xC419, 0xC1E7, 0xB9D4, 0x990E, 0x8BF0, 0xBD9A, 0xC3DA, 0xB797, 0xA8BB, 0x9CB8, 0x9676, 0x9DDC, 0xBC93, 0x9868, 0x8CDB, 0x8CDC, 0xCB82, 0xC9CE, 0xC8F2, 0x9C64, 0xA6DF, 0xBE7B, 0xA36E, 0xBE0C, 0xBE0D, 0xCB83, 0xBF8F, 0xAAC7, 0xC534, 0x9556, 0xBE7C, 0x8A83, 0x9CB9, 0xBFB1, 0xD189, 0x84FA, 0xC9B6, 0xCA4C, 0xAFCF, 0x8B72, 0xD31E, 0xA8D8, 0xCCBF, 0xA9F4, 0x8707, 0xBB0B, 0xD4E2, 0xCDC3, 0xC257, 0xA220, 0xCBE8, 0xBD49, 0xBB76, 0x9EDC, 0xCB87, 0x9A48, 0x8708, 0xCC4E, 0xBC34, 0x9AFF, 0x929D, 0x9EDD, 0xA7A7, 0xC8FB, 0x9558, 0xA0E9, 0xC44B, 0xC44C, 0xBF90, 0xAAE3, 0xD5F3, 0xCE66, 0xCC50, 0xA9E8, 0xBFB2, 0xC44D, 0xC76C, 0xCB89, 0xB144, 0xBAC8, 0xAA87, 0xC331, 0xCA9A, 0x9C6D, 0xD02B, 0xCDC4, 0xD0C5, 0xCA23, 0xC93C, 0xB145, 0xAF40, 0x9A2B, 0xAC4B, 0xCC51, 0xAC18, 0x8F38, 0xCF11, 0xCE67, 0xA5DF, 0x886F, 0xA97B, 0x9912, 0xA910, 0xBB0F, 0xCF14, 0xCC52, 0x9721, 0xB5BF, 0xA64A, 0xAA3C, 0xAEE3, 0xA0B0, 0x9C1D, 0xCE70, 0xAD1D, 0xB9B6, 0xAFC3, 0xC7DE, 0x88D5, 0x85D9, 0xD1E0, 0x9660, 0x9057, 0xA95C, 0xC7E0, 0xC503, 0xC90E, 0xA845, 0x8AFF, 0xB5A0, 0xCE76, 0xC764, 0xC012, 0xBB53, 0xD2DA, 0x921F, 0x96BF, 0xC665, 0x992D, 0xD36E, 0x9585, 0xAA26, 0x9967, 0xC24E, 0x8829, 0xA613, 0xA199, 0x9691, 0x91AB, 0xCE24, 0xC435, 0xBE1C, 0xC273, 0xB637, 0xACB8, 0x84A9, 0xA455, 0x860B, 0xB0B2, 0xB351, 0x8C90, 0x95DB, 0xCE82, 0xBC1A, 0x99E1, 0x98E8, 0x91F0, 0x9675, 0xB525, 0x8CCF, 0xABAC, 0xC2A7, 0xCB48, 0xC9C3, 0xC1E4,
+		0xA8A5, 0x9CAA, 0xCB49, 0x9DC5, 0x9908, 0xCA45, 0xCC36, 0xD187, 0xA8D2, 0xA79A, 0x9ED6, 0xA0E4, 0xCE52, 0xA9DB, 0x9A9D, 0xD0BD, 0xCC3D, 0x9A25, 0xAC13, 0x971E, 0xBB0C, 0xA982, 0x9FD4, 0xA86C, 0x9E19, 0xC447, 0x8DAD, 0x9E1E, 0x9E21, 0xC87A, 0x8DAC, 0xA9AB, 0xCCF0, 0x8D35, 0x9CCB, 0x875F, 0x8D36, 0xB054, 0xD42B, 0xA23D, 0xD3F3, 0x9DCE, 0xAC3D, 0xAC3E, 0xCDEB, 0xA8B4, 0x8A81, 0xA240, 0xA7FF, 0xAE94, 0xB2C9, 0xA9E2, 0xA0E6, 0xA241, 0xA041, 0xA6D2, 0xC4BC, 0xC872, 0x9D5F, 0xAC42, 0xA6E0, 0xB919, 0xD10A, 0xA806, 0xA044, 0xB2D3, 0xA87C, 0xC87B, 0x8D37, 0xAAEC, 0xAAE8, 0x98E9, 0x8A36, 0x93EE, 0xACAF, 0xACB0, 0x93F0, 0xB05C, 0xB5D4, 0xB591, 0xADA2, 0x93F1, 0xC49E, 0xA4D0, 0xB593, 0xACB2, 0x8B15, 0x939F, 0x93E2, 0xB58D, 0xACB1, 0xAAB3, 0xAD46, 0xAD47, 0x9B38, 0xAD5A, 0xD596, 0xAF9D, 0x935C, 0x9AD8, 0x9AD9, 0x9FD5, 0x9736, 0xBE61, 0xBF5B, 0x997B, 0x9A5E, 0xA3ED, 0x997E, 0xBAB7, 0xA62A, 0xAEC9, 0x8B17, 0x9915, 0x9914, 0xCB4A, 0xB389, 0x8E50, 0xC39B, 0xBFDA, 0xAD89, 0xAD87, 0xB38B, 0x8E1C, 0x8C21, 0xCD3C, 0x8FA0, 0xCA4A, 0xC3D8, 0xC8A5, 0xB594, 0xABC6, 0xC8AA, 0xB4E0, 0xCF0C, 0xA63C, 0x8E8D, 0x90CE, 0x88C5, 0xC840, 0xC8AE, 0xC8AF, 0xD1F4, 0x84D2, 0xD1D9, 0x8E22, 0x88C6, 0xCA4D, 0xABB6, 0xD0DC, 0xC8B7, 0x90D7, 0xA97C, 0xD2A0, 0x935F, 0x9452, 0x9470, 0xAD11, 0xAC9C, 0xCEC0, 0x8D4A, 0xB5D8, 0x8A30, 0xC04E, 0xD3C8, 0xD25C, 0xAC24, 0x84FB, 0x8784, 0xC026, 0x8709, 0xCEA6, 0x8A28, 0xC024, 0x9031, 0xAC7D, 0xAE0E, 0x9033, 0xD50F, 0x9694, 0x9695, 0x9061, 0x9357, 0xBDDF, 0xCE8C, 0xB125, 0x89E5, 0x9557, 0x8900, 0xCE8D, 0xBD73, 0xBDEF, 0xBAB9, 0xBABA, 0x9355, 0x9321, 0xC1C6, 0x8541, 0x8FD2, 0xC022, 0xD33B, 0xB5AF, 0xB990, 0xB9FF, 0xCD56, 0xBA01, 0xBEFB, 0xC256, 0xA137, 0xA042, 0x9BC2, 0xA138, 0xC8B1, 0xBF6D, 0xBB77, 0xC32F, 0xC4A5, 0xC8B5, 0xA5C5, 0x867A, 0xC9C5, 0xB566, 0x9819, 0xC2AB, 0xC2AD, 0x998B, 0xC5D6, 0x990F, 0xD06A, 0xC68B, 0xC1F5, 0xD06B, 0xAF72, 0xAE3B, 0xB2B3, 0xD09D, 0x9D27, 0xD51D, 0x9C65, 0x9C6B, 0xB274, 0x9C47, 0x8B4B, 0x8A95, 0x8A96, 0x98F3, 0xC7FC, 0xCBE6, 0xC5D4, 0x85D1, 0xD029, 0xBA66, 0xD51F, 0x8B13, 0xC8AB,
+		0xA14D, 0xBEAF, 0xA876, 0xA870, 0x8BD2, 0xB60F, 0xC5D5, 0xCBE7, 0xAF1C, 0xA0E8, 0xC9B5, 0x8CB4, 0xCF54, 0xCDED, 0x8BFF, 0xCABE, 0xAE95, 0xD02A, 0x8CB6, 0xB5C8, 0xCF55, 0xC8B6, 0xAFD0, 0x9205, 0xC21F, 0xCB8B, 0x8DAE, 0xD598, 0x8F95, 0x8C00, 0xA01D, 0xC823, 0x8B3C, 0x8A90, 0x98D9, 0xCBD5, 0xA146, 0xA85F, 0x85CC, 0xBEA5, 0xD50D, 0xCBDE, 0xCDE6, 0x8CAF, 0xB5C7, 0xC21E, 0xA8F4, 0xB039, 0x960F, 0xB03A, 0xC8AC, 0xA905, 0xA90A, 0x9610, 0xA294, 0x8DEA, 0xA87D, 0x8DEB, 0xA8DD, 0x9603, 0xA290, 0x9763, 0xB534, 0x8786, 0x9745, 0xCF08, 0x8C62, 0x98F8, 0xA223, 0xC595, 0xCF13, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0xA537, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0xA756, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x9502, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0xA65C, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0xA7EF, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0xC281, 0x9BF4, 0xBB02, 0xCE06, 0x3F, 0xB2A2, 0x3F, 0x9436, 0xA147, 0x3F, 0x3F, 0x3F, 0xA64C, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8476, 0x976B, 0xB989, 0x3F, 0x9098, 0x9C7D, 0x3F, 0x3F, 0xC55C, 0xC643, 0x8EC1, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8129, 0x8108, 0x3F, 0x8152, 0x8157, 0x8119, 0x811C, 0x813D, 0x8141, 0x816E, 0x8171, 0x8169, 0x816B, 0x815D, 0x815F, 0x8159, 0x815B, 0x8161, 0x8163, 0x8165, 0x8167, 0x3F, 0x3F, 0x3F, 0x3F, 0x8153, 0x8154, 0x8155, 0x8156, 0x8136, 0x8137, 0x8138, 0x811F, 0x8121, 0x8123, 0x3F, 0x812A, 0x8127, 0x812C, 0x810C, 0x3F, 0x8117, 0x811A, 0x813B, 0x813F, 0x816C, 0x816F, 0x810F, 0x8115, 0x811D, 0x8178, 0x8102, 0x817D, 0x8181, 0x817F, 0x3F, 0x8131, 0x8111, 0x8113, 0x812E, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F, 0x810D, 0x810E, 0x8110, 0x8112, 0x8114, 0x8116, 0x8101, 0x8118, 0x811B, 0x811E, 0x8179, 0x8120, 0x8103, 0x8124, 0x8126, 0x8254, 0x8255, 0x825C, 0x8263, 0x826A, 0x8271, 0x8278, 0x827F, 0x8286, 0x828D, 0x8128, 0x812B, 0x817E, 0x8180, 0x8182, 0x812D, 0x812F, 0x82B0, 0x82B7, 0x82B9, 0x82BF, 0x82C1, 0x82C8, 0x82CB, 0x82CE, 0x82D0, 0x82D6, 0x82D8, 0x82DD, 0x82E1, 0x82E7, 0x82EC, 0x82F2, 0x82F4, 0x82F6, 0x82F8, 0x82FA, 0x82FD, 0x8308, 0x830A, 0x830C, 0x830E, 0x8310, 0x8130, 0x8132, 0x8133, 0x8134, 0x8135, 0x8139, 0x82B1, 0x82B8, 0x82BA, 0x82C0, 0x82C2, 0x82C9, 0x82CC, 0x82CF, 0x82D1, 0x82D7, 0x82D9, 0x82DE, 0x82E2, 0x82E8, 0x82ED, 0x82F3, 0x82F5, 0x82F7, 0x82F9, 0x82FB, 0x82FE, 0x8309, 0x830B, 0x830D, 0x830F, 0x8311, 0x813C, 0x813E, 0x8140, 0x8142, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x8241, 0x8242, 0x8246, 0x8145, 0x8143, 0x8244, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F, 0x3F,
+		0x3F, 0x3F,
+	}
+)
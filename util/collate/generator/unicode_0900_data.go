@@ -0,0 +1,263 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build ignore
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const header = `// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go generate in util/collate/generator; DO NOT EDIT.
+
+package collate
+
+`
+
+const allKeysURL = "http://www.unicode.org/Public/UCA/9.0.0/allkeys.txt"
+
+const (
+	// blockBits is the number of the low bits of a rune used to index inside a block of the table.
+	blockBits = 7
+	// maxWeights is the max number of the collation elements of a rune, MySQL truncates the longer ones.
+	maxWeights = 8
+
+	singleFlag    = uint64(1) << 63
+	expansionFlag = uint64(1) << 62
+)
+
+var allKeys = flag.String("allkeys", "", "the path of allkeys.txt, it's downloaded from "+allKeysURL+" if not set")
+
+func openAllKeys() (io.ReadCloser, error) {
+	if *allKeys != "" {
+		return os.Open(*allKeys)
+	}
+	resp, err := http.Get(allKeysURL)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status %s when downloading %s", resp.Status, allKeysURL)
+	}
+	return resp.Body, nil
+}
+
+// parseElements parses the collation elements like "[.1C47.0020.0002][*0209.0020.0002]".
+// Each element is packed as primary<<32 | secondary<<16 | tertiary.
+func parseElements(s string) ([]uint64, error) {
+	var ces []uint64
+	for {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			return ces, nil
+		}
+		end := strings.IndexByte(s, ']')
+		if s[0] != '[' || end < 0 {
+			return nil, fmt.Errorf("invalid collation elements %q", s)
+		}
+		fields := strings.Split(s[2:end], ".")
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("invalid collation element %q", s[:end+1])
+		}
+		ce := uint64(0)
+		for _, field := range fields {
+			w, err := strconv.ParseUint(field, 16, 16)
+			if err != nil {
+				return nil, err
+			}
+			ce = ce<<16 | w
+		}
+		ces = append(ces, ce)
+		s = s[end+1:]
+	}
+}
+
+// parseAllKeys returns the collation elements of the single runes. The contractions are ignored, MySQL doesn't use them.
+func parseAllKeys(r io.Reader) (map[rune][]uint64, error) {
+	weights := make(map[rune][]uint64)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '@' {
+			continue
+		}
+		parts := strings.SplitN(line, ";", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid line %q", line)
+		}
+		runes := strings.Fields(parts[0])
+		if len(runes) != 1 {
+			continue
+		}
+		r, err := strconv.ParseUint(runes[0], 16, 32)
+		if err != nil {
+			return nil, err
+		}
+		ces, err := parseElements(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		// The ignorable elements inside an expansion make no difference to any level.
+		nonZero := ces[:0]
+		for _, ce := range ces {
+			if ce != 0 {
+				nonZero = append(nonZero, ce)
+			}
+		}
+		if len(nonZero) == 0 {
+			nonZero = append(nonZero, 0)
+		}
+		if len(nonZero) > maxWeights {
+			nonZero = nonZero[:maxWeights]
+		}
+		weights[rune(r)] = nonZero
+	}
+	return weights, scanner.Err()
+}
+
+type tables struct {
+	stage1     []uint16
+	stage2     []uint64
+	expansions []uint64
+}
+
+// buildTables builds a two-stage table for the runes. The value of a rune in the table is 0 if it has no explicit
+// weights, the packed collation element with singleFlag if it has only one, otherwise the offset and the length of
+// its collation elements in expansions with expansionFlag.
+func buildTables(weights map[rune][]uint64) *tables {
+	maxRune := rune(0)
+	for r := range weights {
+		if r > maxRune {
+			maxRune = r
+		}
+	}
+	t := &tables{}
+	blocks := make(map[string]uint16)
+	block := make([]uint64, 1<<blockBits)
+	for start := rune(0); start <= maxRune; start += 1 << blockBits {
+		for i := range block {
+			ces, ok := weights[start+rune(i)]
+			switch {
+			case !ok:
+				block[i] = 0
+			case len(ces) == 1:
+				block[i] = singleFlag | ces[0]
+			default:
+				block[i] = expansionFlag | uint64(len(t.expansions))<<8 | uint64(len(ces))
+				t.expansions = append(t.expansions, ces...)
+			}
+		}
+		key := fmt.Sprint(block)
+		idx, ok := blocks[key]
+		if !ok {
+			idx = uint16(len(t.stage2) >> blockBits)
+			blocks[key] = idx
+			t.stage2 = append(t.stage2, block...)
+		}
+		t.stage1 = append(t.stage1, idx)
+	}
+	return t
+}
+
+func writeSlice(w *bytes.Buffer, name, typ string, values []uint64, perLine int) {
+	fmt.Fprintf(w, "\t%s = []%s{\n", name, typ)
+	for i, v := range values {
+		if i%perLine == 0 {
+			w.WriteString("\t\t")
+		}
+		if v == 0 {
+			w.WriteString("0,")
+		} else {
+			fmt.Fprintf(w, "0x%X,", v)
+		}
+		if i%perLine == perLine-1 || i == len(values)-1 {
+			w.WriteString("\n")
+		} else {
+			w.WriteString(" ")
+		}
+	}
+	w.WriteString("\t}\n")
+}
+
+func main() {
+	flag.Parse()
+	r, err := openAllKeys()
+	if err != nil {
+		log.Fatal(err)
+	}
+	weights, err := parseAllKeys(r)
+	r.Close()
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := buildTables(weights)
+
+	w := new(bytes.Buffer)
+	w.WriteString(header)
+	fmt.Fprintf(w, "/* Data from allkeys.txt(%s). Unicode version '9.0.0'. */\n", allKeysURL)
+	w.WriteString("const (\n")
+	fmt.Fprintf(w, "\tuca0900BlockBits = %d\n", blockBits)
+	fmt.Fprintf(w, "\tuca0900Single uint64 = 0x%X\n", singleFlag)
+	fmt.Fprintf(w, "\tuca0900Expansion uint64 = 0x%X\n", expansionFlag)
+	w.WriteString(")\n\n")
+	w.WriteString("var (\n")
+	stage1 := make([]uint64, len(t.stage1))
+	for i, idx := range t.stage1 {
+		stage1[i] = uint64(idx)
+	}
+	w.WriteString("\t// uca0900Stage1 maps the high bits of a rune to its block in uca0900Stage2.\n")
+	writeSlice(w, "uca0900Stage1", "uint16", stage1, 16)
+	w.WriteString("\t// uca0900Stage2 contains the weights of the runes, see buildTables in the generator for the format.\n")
+	writeSlice(w, "uca0900Stage2", "uint64", t.stage2, 8)
+	w.WriteString("\t// uca0900Expansions contains the collation elements of the runes which have more than one.\n")
+	writeSlice(w, "uca0900Expansions", "uint64", t.expansions, 8)
+	w.WriteString(")\n")
+
+	data, err := format.Source(w.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = ioutil.WriteFile("unicode_0900_data.go", data, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
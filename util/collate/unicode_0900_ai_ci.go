@@ -0,0 +1,275 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collate
+
+import (
+	"github.com/pingcap/tidb/util/stringutil"
+)
+
+//go:generate go run generator/unicode_0900_data.go
+
+const (
+	// shift of the weights of each level in a collation element, which is packed as primary<<32 | secondary<<16 | tertiary.
+	primaryShift   = 32
+	secondaryShift = 16
+	tertiaryShift  = 0
+
+	// maxWeights0900 is the max number of collation elements of a rune, the longer expansions are truncated as MySQL does.
+	maxWeights0900 = 8
+
+	hangulSyllableBase  = 0xAC00
+	hangulSyllableCount = 11172
+	hangulLeadingBase   = 0x1100
+	hangulVowelBase     = 0x1161
+	hangulTrailingBase  = 0x11A7
+	hangulVowelCount    = 21
+	hangulTrailingCount = 28
+)
+
+// unicode0900AICICollator implements the UCA 9.0.0 based utf8mb4_0900_ai_ci, which only compares the primary
+// weights. Unlike utf8mb4_unicode_ci, the tailing spaces are not ignored. see http://unicode.org/reports/tr10/
+type unicode0900AICICollator struct {
+}
+
+// Compare implements Collator interface.
+func (uc *unicode0900AICICollator) Compare(a, b string) int {
+	return compareLevel0900(a, b, primaryShift)
+}
+
+// Key implements Collator interface.
+func (uc *unicode0900AICICollator) Key(str string) []byte {
+	return appendKeyLevel0900(make([]byte, 0, len(str)*2), str, primaryShift)
+}
+
+// Pattern implements Collator interface.
+func (uc *unicode0900AICICollator) Pattern() WildcardPattern {
+	return &unicode0900Pattern{shifts: []uint{primaryShift}}
+}
+
+// appendWeights0900 appends the collation elements of the rune to ces.
+func appendWeights0900(ces []uint64, r rune) []uint64 {
+	w := lookupWeights0900(r)
+	switch {
+	case w&uca0900Single != 0:
+		return append(ces, w&^uca0900Single)
+	case w&uca0900Expansion != 0:
+		offset, length := (w&^uca0900Expansion)>>8, w&0xFF
+		return append(ces, uca0900Expansions[offset:offset+length]...)
+	case r >= hangulSyllableBase && r < hangulSyllableBase+hangulSyllableCount:
+		// The hangul syllables are decomposed into the jamos, each of which has a single collation element.
+		s := r - hangulSyllableBase
+		ces = append(ces, lookupWeights0900(hangulLeadingBase+s/(hangulVowelCount*hangulTrailingCount))&^uca0900Single,
+			lookupWeights0900(hangulVowelBase+s%(hangulVowelCount*hangulTrailingCount)/hangulTrailingCount)&^uca0900Single)
+		if t := s % hangulTrailingCount; t != 0 {
+			ces = append(ces, lookupWeights0900(hangulTrailingBase+t)&^uca0900Single)
+		}
+		return ces
+	}
+	first, second := implicitWeights0900(r)
+	return append(ces, first<<primaryShift|0x20<<secondaryShift|0x2<<tertiaryShift, second<<primaryShift)
+}
+
+// lookupWeights0900 returns the value of the rune in the table, 0 if it has no explicit weights.
+func lookupWeights0900(r rune) uint64 {
+	if int(r>>uca0900BlockBits) >= len(uca0900Stage1) {
+		return 0
+	}
+	return uca0900Stage2[int(uca0900Stage1[r>>uca0900BlockBits])<<uca0900BlockBits|int(r&(1<<uca0900BlockBits-1))]
+}
+
+// implicitWeights0900 returns the primary weights of the two collation elements of the rune without explicit
+// weights, they are derived as the section 10.1 of UCA describes.
+func implicitWeights0900(r rune) (first, second uint64) {
+	switch {
+	case r >= 0x17000 && r <= 0x18AFF:
+		// Tangut
+		return 0xFB00, uint64(r-0x17000) | 0x8000
+	case (r >= 0x4E00 && r <= 0x9FD5) || (r >= 0xFA0E && r <= 0xFA29):
+		// Core Han Unified Ideographs, the compatibility ones in the range have explicit weights.
+		return 0xFB40 + uint64(r>>15), uint64(r&0x7FFF) | 0x8000
+	case (r >= 0x3400 && r <= 0x4DB5) || (r >= 0x20000 && r <= 0x2A6D6) || (r >= 0x2A700 && r <= 0x2B734) ||
+		(r >= 0x2B740 && r <= 0x2B81D) || (r >= 0x2B820 && r <= 0x2CEA1):
+		// All other Han Unified Ideographs
+		return 0xFB80 + uint64(r>>15), uint64(r&0x7FFF) | 0x8000
+	}
+	return 0xFBC0 + uint64(r>>15), uint64(r&0x7FFF) | 0x8000
+}
+
+// weightIterator0900 iterates the non-zero weights of a level of a string, or of the collation elements in buf.
+type weightIterator0900 struct {
+	str   string
+	si    int
+	buf   [maxWeights0900]uint64
+	n     int
+	ci    int
+	shift uint
+}
+
+// next returns the next weight, 0 is returned when there are no more weights.
+func (it *weightIterator0900) next() uint16 {
+	for {
+		for it.ci < it.n {
+			w := uint16(it.buf[it.ci] >> it.shift)
+			it.ci++
+			if w != 0 {
+				return w
+			}
+		}
+		if it.si >= len(it.str) {
+			return 0
+		}
+		var r rune
+		r, it.si = decodeRune(it.str, it.si)
+		it.n, it.ci = len(appendWeights0900(it.buf[:0], r)), 0
+	}
+}
+
+// compareLevel0900 compares the weights of a level of the strings.
+func compareLevel0900(a, b string, shift uint) int {
+	ai := weightIterator0900{str: a, shift: shift}
+	bi := weightIterator0900{str: b, shift: shift}
+	for {
+		aw, bw := ai.next(), bi.next()
+		if aw != bw {
+			return sign(int(aw) - int(bw))
+		}
+		if aw == 0 {
+			return 0
+		}
+	}
+}
+
+// appendKeyLevel0900 appends the weights of a level of the string to buf.
+func appendKeyLevel0900(buf []byte, str string, shift uint) []byte {
+	it := weightIterator0900{str: str, shift: shift}
+	for w := it.next(); w != 0; w = it.next() {
+		buf = append(buf, byte(w>>8), byte(w))
+	}
+	return buf
+}
+
+type unicode0900Pattern struct {
+	patChars []rune
+	patTypes []byte
+	shifts   []uint
+}
+
+// Compile implements WildcardPattern interface.
+func (p *unicode0900Pattern) Compile(patternStr string, escape byte) {
+	p.patChars, p.patTypes = stringutil.CompilePatternInner(patternStr, escape)
+}
+
+// DoMatch implements WildcardPattern interface. The characters between the wildcards are matched by their weights as
+// Compare does, so a character matches its expansion, e.g. 'ß' matches 'ss' in utf8mb4_0900_ai_ci.
+func (p *unicode0900Pattern) DoMatch(str string) bool {
+	m := &unicode0900Matcher{pattern: p, str: []rune(str)}
+	m.failed = make([]bool, (len(m.str)+1)*(len(p.patChars)+1))
+	return m.match(0, 0)
+}
+
+// unicode0900Matcher matches a string against the pattern by backtracking.
+type unicode0900Matcher struct {
+	pattern *unicode0900Pattern
+	str     []rune
+	// failed marks the positions in the string and the pattern which are known not to match.
+	failed []bool
+	ces    [maxWeights0900]uint64
+}
+
+// match returns true if str[si:] matches the pattern from pi.
+func (m *unicode0900Matcher) match(si, pi int) bool {
+	p := m.pattern
+	if pi == len(p.patChars) {
+		return si == len(m.str)
+	}
+	state := si*(len(p.patChars)+1) + pi
+	if m.failed[state] {
+		return false
+	}
+	matched := false
+	switch p.patTypes[pi] {
+	case stringutil.PatAny:
+		for i := si; i <= len(m.str) && !matched; i++ {
+			matched = m.match(i, pi+1)
+		}
+	case stringutil.PatOne:
+		matched = si < len(m.str) && m.match(si+1, pi+1)
+	default:
+		pj := pi + 1
+		for pj < len(p.patChars) && p.patTypes[pj] == stringutil.PatMatch {
+			pj++
+		}
+		for _, end := range m.literalEnds(si, p.patChars[pi:pj]) {
+			if matched = m.match(end, pj); matched {
+				break
+			}
+		}
+	}
+	if !matched {
+		m.failed[state] = true
+	}
+	return matched
+}
+
+// literalEnds returns the ends of the substrings from si which have the same weights as the literal.
+func (m *unicode0900Matcher) literalEnds(si int, literal []rune) (ends []int) {
+	expected := make([][]uint16, len(m.pattern.shifts))
+	for _, r := range literal {
+		m.appendLevelWeights(expected, r)
+	}
+	weights := make([][]uint16, len(m.pattern.shifts))
+	for end := si; ; end++ {
+		isPrefix, equal := compareLevelWeights(weights, expected)
+		if !isPrefix {
+			break
+		}
+		if equal {
+			ends = append(ends, end)
+		}
+		if end == len(m.str) {
+			break
+		}
+		m.appendLevelWeights(weights, m.str[end])
+	}
+	return ends
+}
+
+// appendLevelWeights appends the non-zero weights of the rune to the weights of each level.
+func (m *unicode0900Matcher) appendLevelWeights(weights [][]uint16, r rune) {
+	for _, ce := range appendWeights0900(m.ces[:0], r) {
+		for i, shift := range m.pattern.shifts {
+			if w := uint16(ce >> shift); w != 0 {
+				weights[i] = append(weights[i], w)
+			}
+		}
+	}
+}
+
+// compareLevelWeights returns whether the weights of each level are a prefix of the expected ones, and whether they
+// are equal to the expected ones.
+func compareLevelWeights(weights, expected [][]uint16) (isPrefix, equal bool) {
+	equal = true
+	for i := range weights {
+		if len(weights[i]) > len(expected[i]) {
+			return false, false
+		}
+		for j, w := range weights[i] {
+			if w != expected[i][j] {
+				return false, false
+			}
+		}
+		equal = equal && len(weights[i]) == len(expected[i])
+	}
+	return true, equal
+}
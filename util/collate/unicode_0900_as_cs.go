@@ -0,0 +1,50 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collate
+
+// levelShifts0900ASCS are the levels compared by utf8mb4_0900_as_cs.
+var levelShifts0900ASCS = []uint{primaryShift, secondaryShift, tertiaryShift}
+
+// unicode0900ASCSCollator implements the UCA 9.0.0 based utf8mb4_0900_as_cs, which compares the primary, secondary
+// (accent) and tertiary (case) weights in turn.
+type unicode0900ASCSCollator struct {
+}
+
+// Compare implements Collator interface.
+func (uc *unicode0900ASCSCollator) Compare(a, b string) int {
+	for _, shift := range levelShifts0900ASCS {
+		if cmp := compareLevel0900(a, b, shift); cmp != 0 {
+			return cmp
+		}
+	}
+	return 0
+}
+
+// Key implements Collator interface. The weights of the levels are separated by 0x0000 as MySQL does, which is less
+// than any weight, so the keys are in the same order as Compare.
+func (uc *unicode0900ASCSCollator) Key(str string) []byte {
+	buf := make([]byte, 0, len(str)*6+4)
+	for i, shift := range levelShifts0900ASCS {
+		if i > 0 {
+			buf = append(buf, 0, 0)
+		}
+		buf = appendKeyLevel0900(buf, str, shift)
+	}
+	return buf
+}
+
+// Pattern implements Collator interface.
+func (uc *unicode0900ASCSCollator) Pattern() WildcardPattern {
+	return &unicode0900Pattern{shifts: levelShifts0900ASCS}
+}
@@ -0,0 +1,6042 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by go generate in util/collate/generator; DO NOT EDIT.
+
+package collate
+
+/* Data from allkeys.txt(http://www.unicode.org/Public/UCA/9.0.0/allkeys.txt). Unicode version '9.0.0'. */
+const (
+	uca0900BlockBits        = 7
+	uca0900Single    uint64 = 0x8000000000000000
+	uca0900Expansion uint64 = 0x4000000000000000
+)
+
+var (
+	// uca0900Stage1 maps the high bits of a rune to its block in uca0900Stage2.
+	uca0900Stage1 = []uint16{
+		0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xA, 0xB, 0xC, 0xD, 0xE, 0xF,
+		0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1A, 0x1B, 0x1C, 0x1D, 0x1E, 0x1F,
+		0x20, 0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
+		0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37, 0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
+		0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47, 0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
+		0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
+		0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x69, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x6A, 0x6B, 0x6C, 0x6D, 0x6E, 0x6F, 0x70, 0x71, 0x72, 0x73, 0x74, 0x75, 0x76, 0x77, 0x78, 0x79,
+		0x7A, 0x7B, 0x7C, 0x7D, 0x7E, 0x7F, 0x80, 0x81, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x82,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x83, 0x84, 0x85, 0x86, 0x87, 0x88, 0x89, 0x8A, 0x8B, 0x8C, 0x8D, 0x8E, 0x8F, 0x90,
+		0x91, 0x92, 0x93, 0x94, 0x68, 0x95, 0x96, 0x97, 0x98, 0x99, 0x9A, 0x68, 0x9B, 0x9C, 0x9D, 0x68,
+		0x9E, 0x9F, 0xA0, 0xA1, 0xA2, 0xA3, 0xA4, 0xA5, 0xA6, 0xA7, 0x68, 0x68, 0xA8, 0x68, 0x68, 0x68,
+		0xA9, 0xAA, 0xAB, 0xAC, 0xAD, 0xAE, 0xAF, 0x68, 0xB0, 0xB1, 0x68, 0xB2, 0xB3, 0xB4, 0xB5, 0x68,
+		0x68, 0xB6, 0x68, 0x68, 0x68, 0xB7, 0x68, 0x68, 0xB8, 0xB9, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0xBA, 0xBB, 0xBC, 0xBD, 0xBE, 0xBF, 0xC0, 0xC1, 0xC2, 0xC3, 0xC4, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0xC5, 0xC6, 0xC7, 0xC8, 0xC9, 0xCA, 0xCB, 0xCC, 0xCD, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0xCE, 0xCF, 0xD0, 0xD1, 0xD2, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0xD3, 0xD4, 0xD5, 0xD6, 0xD7, 0xD8, 0xD9, 0xDA, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0xDB, 0xDC,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0xDD, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0xDE, 0xDF, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0xE0, 0xE1, 0xE2, 0xE3, 0xE4, 0x68, 0xE5, 0x68, 0xE6, 0xE7, 0xE8, 0xE9, 0xEA, 0xEB, 0xEC, 0xED,
+		0xEE, 0xEF, 0xF0, 0xF1, 0xF2, 0xF3, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0xF4, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0xF5, 0xF6, 0xF7, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0xF8, 0xF9, 0x68, 0x68,
+		0xFA, 0xFB, 0xFC, 0xFD, 0xFE, 0x68, 0xFF, 0x100, 0x101, 0x102, 0x103, 0x104, 0x105, 0x106, 0x107, 0x108,
+		0x109, 0x10A, 0x10B, 0x10C, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x10D, 0x10E, 0x10F, 0x110, 0x111, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68, 0x68,
+		0x112, 0x68, 0x113, 0x114,
+	}
+	// uca0900Stage2 contains the weights of the runes, see buildTables in the generator for the format.
+	uca0900Stage2 = []uint64{
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000020100200002, 0x8000020200200002, 0x8000020300200002, 0x8000020400200002, 0x8000020500200002, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000020900200002, 0x8000026000200002, 0x8000030C00200002, 0x8000039800200002, 0x80001C1200200002, 0x8000039900200002, 0x8000039600200002, 0x8000030500200002,
+		0x8000031700200002, 0x8000031800200002, 0x8000038F00200002, 0x8000061600200002, 0x8000022200200002, 0x8000020D00200002, 0x8000027700200002, 0x8000039400200002,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0x8000023900200002, 0x8000023400200002, 0x8000061A00200002, 0x8000061B00200002, 0x8000061C00200002, 0x8000026600200002,
+		0x8000038E00200002, 0x80001C4700200008, 0x80001C6000200008, 0x80001C7A00200008, 0x80001C8F00200008, 0x80001CAA00200008, 0x80001CE500200008, 0x80001CF400200008,
+		0x80001D1800200008, 0x80001D3200200008, 0x80001D4C00200008, 0x80001D6500200008, 0x80001D7700200008, 0x80001DAA00200008, 0x80001DB900200008, 0x80001DDD00200008,
+		0x80001E0C00200008, 0x80001E2100200008, 0x80001E3300200008, 0x80001E7100200008, 0x80001E9500200008, 0x80001EB500200008, 0x80001EE300200008, 0x80001EF500200008,
+		0x80001EFF00200008, 0x80001F0B00200008, 0x80001F2100200008, 0x8000031900200002, 0x8000039500200002, 0x8000031A00200002, 0x8000048500200002, 0x8000020B00200002,
+		0x8000048200200002, 0x80001C4700200002, 0x80001C6000200002, 0x80001C7A00200002, 0x80001C8F00200002, 0x80001CAA00200002, 0x80001CE500200002, 0x80001CF400200002,
+		0x80001D1800200002, 0x80001D3200200002, 0x80001D4C00200002, 0x80001D6500200002, 0x80001D7700200002, 0x80001DAA00200002, 0x80001DB900200002, 0x80001DDD00200002,
+		0x80001E0C00200002, 0x80001E2100200002, 0x80001E3300200002, 0x80001E7100200002, 0x80001E9500200002, 0x80001EB500200002, 0x80001EE300200002, 0x80001EF500200002,
+		0x80001EFF00200002, 0x80001F0B00200002, 0x80001F2100200002, 0x8000031B00200002, 0x8000061E00200002, 0x8000031C00200002, 0x8000062000200002, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000020600200002, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x800002090020001B, 0x8000026100200002, 0x80001C1100200002, 0x80001C1300200002, 0x80001C1000200002, 0x80001C1400200002, 0x8000061F00200002, 0x8000038900200002,
+		0x8000048900200002, 0x8000058400200002, 0x80001C4700200014, 0x8000031500200002, 0x8000061D00200002, 0x8000000000000000, 0x8000058500200002, 0x8000048600200002,
+		0x800004F600200002, 0x8000061700200002, 0x80001C3F00200014, 0x80001C4000200014, 0x8000048300200002, 0x80001FCB00200004, 0x8000038B00200002, 0x8000028B00200002,
+		0x8000048C00200002, 0x80001C3E00200014, 0x80001DDD00200014, 0x8000031600200002, 0x4000000000000003, 0x4000000000000303, 0x4000000000000603, 0x8000026700200002,
+		0x4000000000000902, 0x4000000000000B02, 0x4000000000000D02, 0x4000000000000F02, 0x4000000000001102, 0x4000000000001302, 0x4000000000001503, 0x4000000000001802,
+		0x4000000000001A02, 0x4000000000001C02, 0x4000000000001E02, 0x4000000000002002, 0x4000000000002202, 0x4000000000002402, 0x4000000000002602, 0x4000000000002802,
+		0x4000000000002A02, 0x4000000000002C02, 0x4000000000002E02, 0x4000000000003002, 0x4000000000003202, 0x4000000000003402, 0x4000000000003602, 0x8000061900200002,
+		0x4000000000003802, 0x4000000000003A02, 0x4000000000003C02, 0x4000000000003E02, 0x4000000000004002, 0x4000000000004202, 0x80001F5000200008, 0x4000000000004403,
+		0x4000000000004702, 0x4000000000004902, 0x4000000000004B02, 0x4000000000004D02, 0x4000000000004F02, 0x4000000000005102, 0x4000000000005303, 0x4000000000005602,
+		0x4000000000005802, 0x4000000000005A02, 0x4000000000005C02, 0x4000000000005E02, 0x4000000000006002, 0x4000000000006202, 0x4000000000006402, 0x4000000000006602,
+		0x4000000000006802, 0x4000000000006A02, 0x4000000000006C02, 0x4000000000006E02, 0x4000000000007002, 0x4000000000007202, 0x4000000000007402, 0x8000061800200002,
+		0x4000000000007602, 0x4000000000007802, 0x4000000000007A02, 0x4000000000007C02, 0x4000000000007E02, 0x4000000000008002, 0x80001F5000200002, 0x4000000000008202,
+		0x4000000000008402, 0x4000000000008602, 0x4000000000008802, 0x4000000000008A02, 0x4000000000008C02, 0x4000000000008E02, 0x4000000000009002, 0x4000000000009202,
+		0x4000000000009402, 0x4000000000009602, 0x4000000000009802, 0x4000000000009A02, 0x4000000000009C02, 0x4000000000009E02, 0x400000000000A002, 0x400000000000A202,
+		0x400000000000A402, 0x400000000000A602, 0x400000000000A802, 0x400000000000AA02, 0x400000000000AC02, 0x400000000000AE02, 0x400000000000B002, 0x400000000000B202,
+		0x400000000000B402, 0x400000000000B602, 0x400000000000B802, 0x400000000000BA02, 0x400000000000BC02, 0x400000000000BE02, 0x400000000000C002, 0x400000000000C202,
+		0x400000000000C402, 0x400000000000C602, 0x400000000000C802, 0x400000000000CA02, 0x400000000000CC02, 0x400000000000CE02, 0x400000000000D002, 0x400000000000D202,
+		0x400000000000D402, 0x400000000000D602, 0x400000000000D802, 0x400000000000DA02, 0x400000000000DC02, 0x400000000000DE02, 0x400000000000E002, 0x400000000000E202,
+		0x400000000000E402, 0x80001D3600200002, 0x400000000000E602, 0x400000000000E802, 0x400000000000EA02, 0x400000000000EC02, 0x400000000000EE02, 0x400000000000F002,
+		0x80001E2F00200002, 0x400000000000F202, 0x400000000000F402, 0x400000000000F602, 0x400000000000F802, 0x400000000000FA02, 0x400000000000FC02, 0x400000000000FE02,
+		0x4000000000010002, 0x4000000000010202, 0x4000000000010402, 0x4000000000010602, 0x4000000000010802, 0x4000000000010A02, 0x4000000000010C02, 0x4000000000010E02,
+		0x4000000000011002, 0x4000000000011202, 0x80001DD800200008, 0x80001DD800200002, 0x4000000000011402, 0x4000000000011602, 0x4000000000011802, 0x4000000000011A02,
+		0x4000000000011C02, 0x4000000000011E02, 0x4000000000012003, 0x4000000000012303, 0x4000000000012602, 0x4000000000012802, 0x4000000000012A02, 0x4000000000012C02,
+		0x4000000000012E02, 0x4000000000013002, 0x4000000000013202, 0x4000000000013402, 0x4000000000013602, 0x4000000000013802, 0x4000000000013A02, 0x4000000000013C02,
+		0x4000000000013E02, 0x4000000000014002, 0x4000000000014202, 0x4000000000014402, 0x4000000000014602, 0x4000000000014802, 0x80001E9A00200008, 0x80001E9A00200002,
+		0x4000000000014A02, 0x4000000000014C02, 0x4000000000014E02, 0x4000000000015002, 0x4000000000015202, 0x4000000000015402, 0x4000000000015602, 0x4000000000015802,
+		0x4000000000015A02, 0x4000000000015C02, 0x4000000000015E02, 0x4000000000016002, 0x4000000000016202, 0x4000000000016402, 0x4000000000016602, 0x4000000000016802,
+		0x4000000000016A02, 0x4000000000016C02, 0x4000000000016E02, 0x4000000000017002, 0x4000000000017202, 0x4000000000017402, 0x4000000000017602, 0x4000000000017802,
+		0x80001C6800200002, 0x80001C7100200008, 0x80001C7500200008, 0x80001C7500200002, 0x80001F7100200008, 0x80001F7100200002, 0x80001DF000200008, 0x80001C8500200008,
+		0x80001C8500200002, 0x80001C9700200008, 0x80001C9B00200008, 0x80001CA000200008, 0x80001CA000200002, 0x4000000000017A02, 0x80001CB800200008, 0x80001CBD00200008,
+		0x80001CC200200008, 0x80001CEE00200008, 0x80001CEE00200002, 0x80001D0600200008, 0x80001D1000200008, 0x80001D2000200002, 0x80001D4700200008, 0x80001D4100200008,
+		0x80001D6B00200008, 0x80001D6B00200002, 0x80001D8200200002, 0x80001DA200200002, 0x80001ED400200008, 0x80001DC400200008, 0x80001DC800200002, 0x80001DFD00200008,
+		0x4000000000017C02, 0x4000000000017E02, 0x80001D1400200008, 0x80001D1400200002, 0x80001E1500200008, 0x80001E1500200002, 0x80001E3800200008, 0x80001F6900200008,
+		0x80001F6900200002, 0x80001E8200200008, 0x80001E8800200002, 0x80001EA000200002, 0x80001EA400200008, 0x80001EA400200002, 0x80001EA800200008, 0x4000000000018002,
+		0x4000000000018202, 0x80001EDE00200008, 0x80001EEA00200008, 0x80001F1700200008, 0x80001F1700200002, 0x80001F2600200008, 0x80001F2600200002, 0x80001F3E00200008,
+		0x80001F4300200008, 0x80001F4300200002, 0x80001F4800200002, 0x80001F6200200002, 0x80001F6D00200008, 0x80001F6D00200002, 0x4000000000018402, 0x80001F5600200002,
+		0x80001F9900200002, 0x80001F9D00200002, 0x80001FA100200002, 0x80001FA500200002, 0x4000000000018603, 0x4000000000018903, 0x4000000000018C03, 0x4000000000018F02,
+		0x4000000000019102, 0x4000000000019302, 0x4000000000019502, 0x4000000000019702, 0x4000000000019902, 0x4000000000019B02, 0x4000000000019D02, 0x4000000000019F02,
+		0x400000000001A102, 0x400000000001A302, 0x400000000001A502, 0x400000000001A702, 0x400000000001A902, 0x400000000001AB03, 0x400000000001AE03, 0x400000000001B103,
+		0x400000000001B403, 0x400000000001B703, 0x400000000001BA03, 0x400000000001BD03, 0x400000000001C003, 0x80001CB800200002, 0x400000000001C303, 0x400000000001C603,
+		0x400000000001C903, 0x400000000001CC03, 0x400000000001CF04, 0x400000000001D304, 0x80001D0100200008, 0x80001D0100200002, 0x400000000001D702, 0x400000000001D902,
+		0x400000000001DB02, 0x400000000001DD02, 0x400000000001DF02, 0x400000000001E102, 0x400000000001E303, 0x400000000001E603, 0x400000000001E902, 0x400000000001EB02,
+		0x400000000001ED02, 0x400000000001EF02, 0x400000000001F102, 0x400000000001F302, 0x400000000001F502, 0x400000000001F702, 0x80001D2000200008, 0x80001F5600200008,
+		0x400000000001F902, 0x400000000001FB02, 0x400000000001FD03, 0x4000000000020003, 0x4000000000020304, 0x4000000000020704, 0x4000000000020B03, 0x4000000000020E03,
+		0x4000000000021102, 0x4000000000021302, 0x4000000000021502, 0x4000000000021702, 0x4000000000021902, 0x4000000000021B02, 0x4000000000021D02, 0x4000000000021F02,
+		0x4000000000022102, 0x4000000000022302, 0x4000000000022502, 0x4000000000022702, 0x4000000000022902, 0x4000000000022B02, 0x4000000000022D02, 0x4000000000022F02,
+		0x4000000000023102, 0x4000000000023302, 0x4000000000023502, 0x4000000000023702, 0x4000000000023902, 0x4000000000023B02, 0x4000000000023D02, 0x4000000000023F02,
+		0x4000000000024102, 0x4000000000024302, 0x4000000000024502, 0x4000000000024702, 0x80001F1D00200008, 0x80001F1D00200002, 0x4000000000024902, 0x4000000000024B02,
+		0x80001DC800200008, 0x80001CA400200002, 0x80001E0700200008, 0x80001E0700200002, 0x80001F2C00200008, 0x80001F2C00200002, 0x4000000000024D02, 0x4000000000024F02,
+		0x4000000000025102, 0x4000000000025302, 0x4000000000025503, 0x4000000000025803, 0x4000000000025B03, 0x4000000000025E03, 0x4000000000026102, 0x4000000000026302,
+		0x4000000000026503, 0x4000000000026803, 0x4000000000026B02, 0x4000000000026D02, 0x80001D9800200002, 0x80001DD200200002, 0x80001EAC00200002, 0x80001D5000200002,
+		0x4000000000026F02, 0x4000000000027102, 0x80001C4C00200008, 0x80001C7F00200008, 0x80001C7F00200002, 0x80001D8200200008, 0x80001E9E00200008, 0x80001E7C00200002,
+		0x80001F3800200002, 0x80001F7900200008, 0x80001F7900200002, 0x80001C6800200008, 0x80001EC000200008, 0x80001EF100200008, 0x80001CB100200008, 0x80001CB100200002,
+		0x80001D5500200008, 0x80001D5500200002, 0x80001E2B00200008, 0x80001E2B00200002, 0x80001E3F00200008, 0x80001E3F00200002, 0x80001F1300200008, 0x80001F1300200002,
+		0x80001C5100200002, 0x80001C5500200002, 0x80001C5B00200002, 0x80001C7100200002, 0x80001DF000200002, 0x80001C8900200002, 0x80001C9700200002, 0x80001C9B00200002,
+		0x80001CC700200002, 0x80001CBD00200002, 0x80001CCB00200002, 0x80001CC200200002, 0x80001CCF00200002, 0x80001CD500200002, 0x80001CD900200002, 0x80001D5D00200002,
+		0x80001D0600200002, 0x80001CF800200002, 0x80001CFD00200002, 0x80001D1000200002, 0x80001CE100200002, 0x80001EC800200002, 0x80001D2500200002, 0x80001D2C00200002,
+		0x80001D4100200002, 0x80001D4700200002, 0x80001D3A00200002, 0x80001D8700200002, 0x80001D8D00200002, 0x80001D9300200002, 0x80001D9D00200002, 0x80001ED400200002,
+		0x80001EDA00200002, 0x80001DB100200002, 0x80001DC400200002, 0x80001DCE00200002, 0x80001DBD00200002, 0x80001DFD00200002, 0x80001DE400200002, 0x80001E0200200002,
+		0x80001E1C00200002, 0x80001E4400200002, 0x80001E4900200002, 0x80001E4E00200002, 0x80001E5300200002, 0x80001E5700200002, 0x80001E5C00200002, 0x80001E6100200002,
+		0x80001E3800200002, 0x80001E6A00200002, 0x80001E7800200002, 0x80001E8200200002, 0x80001D6100200002, 0x80001E8C00200002, 0x80001E9100200002, 0x80001EB100200002,
+		0x80001EA800200002, 0x80001EC000200002, 0x80001EDE00200002, 0x80001EEA00200002, 0x80001EF100200002, 0x80001EFB00200002, 0x80001DA600200002, 0x80001F0F00200002,
+		0x80001F3000200002, 0x80001F3400200002, 0x80001F3E00200002, 0x80001F4C00200002, 0x80001F7500200002, 0x80001F8400200002, 0x80001F9500200002, 0x80001FA900200002,
+		0x80001FAD00200002, 0x80001C6400200002, 0x80001CDD00200002, 0x80001D0A00200002, 0x80001D1C00200002, 0x80001D5900200002, 0x80001D7300200002, 0x80001D7B00200002,
+		0x80001E2700200002, 0x80001F8D00200002, 0x80001F9100200002, 0x4000000000027302, 0x4000000000027502, 0x4000000000027702, 0x4000000000027902, 0x4000000000027B02,
+		0x4000000000027D02, 0x4000000000027F02, 0x4000000000028102, 0x4000000000028302, 0x80001FB100200002, 0x80001FB500200002, 0x80001ECC00200002, 0x80001ED000200002,
+		0x80001D1800200014, 0x80001D2500200014, 0x80001D4C00200014, 0x80001E3300200014, 0x80001E4400200014, 0x80001E4E00200014, 0x80001E6A00200014, 0x80001EF500200014,
+		0x80001F0B00200014, 0x8000049300200002, 0x8000049500200002, 0x80001D3000200002, 0x80001F7E00200002, 0x80001D3100200002, 0x80001F8000200002, 0x80001F8800200002,
+		0x80001F7D00200002, 0x80001F8900200002, 0x8000049600200002, 0x8000049700200002, 0x8000049800200002, 0x8000049900200002, 0x8000049A00200002, 0x8000049B00200002,
+		0x8000049C00200002, 0x8000049D00200002, 0x8000049E00200002, 0x8000049F00200002, 0x800004A000200002, 0x800004A100200002, 0x800004A200200002, 0x800004A300200002,
+		0x80001BF800200002, 0x80001BF900200002, 0x800004A400200002, 0x800004A500200002, 0x800004A600200002, 0x800004A700200002, 0x800004A800200002, 0x800004A900200002,
+		0x8000048700200002, 0x8000048800200002, 0x8000048A00200002, 0x8000048D00200002, 0x8000048400200002, 0x8000048B00200002, 0x800004AA00200002, 0x800004AB00200002,
+		0x80001D1000200014, 0x80001D7700200014, 0x80001E7100200014, 0x80001EFF00200014, 0x80001F8400200014, 0x800004AC00200002, 0x800004AD00200002, 0x800004AE00200002,
+		0x800004AF00200002, 0x800004B000200002, 0x800004B100200002, 0x800004B200200002, 0x800004B300200002, 0x800004B400200002, 0x80001F7F00200002, 0x800004B500200002,
+		0x800004B600200002, 0x800004B700200002, 0x800004B800200002, 0x800004B900200002, 0x800004BA00200002, 0x800004BB00200002, 0x800004BC00200002, 0x800004BD00200002,
+		0x800004BE00200002, 0x800004BF00200002, 0x800004C000200002, 0x800004C100200002, 0x800004C200200002, 0x800004C300200002, 0x800004C400200002, 0x800004C500200002,
+		0x8000000000250002, 0x8000000000240002, 0x8000000000270002, 0x80000000002D0002, 0x8000000000320002, 0x80000000003A0002, 0x8000000000260002, 0x80000000002E0002,
+		0x80000000002B0002, 0x80000000003B0002, 0x8000000000290002, 0x80000000002C0002, 0x8000000000280002, 0x8000000000330002, 0x8000000000330002, 0x80000000003C0002,
+		0x80000000003D0002, 0x80000000003E0002, 0x8000000000330002, 0x8000000000220002, 0x8000000000230002, 0x8000000000330002, 0x8000000000340002, 0x8000000000340002,
+		0x8000000000340002, 0x8000000000340002, 0x8000000000330002, 0x80000000003F0002, 0x8000000000340002, 0x8000000000340002, 0x8000000000340002, 0x8000000000340002,
+		0x8000000000340002, 0x8000000000400002, 0x8000000000410002, 0x8000000000420002, 0x8000000000430002, 0x8000000000440002, 0x8000000000450002, 0x8000000000300002,
+		0x8000000000310002, 0x8000000000340002, 0x8000000000340002, 0x8000000000340002, 0x8000000000340002, 0x8000000000460002, 0x8000000000470002, 0x8000000000340002,
+		0x8000000000480002, 0x8000000000490002, 0x8000000000210002, 0x8000000000340002, 0x80000000004A0002, 0x8000000000390002, 0x8000000000350002, 0x8000000000350002,
+		0x80000000002F0002, 0x80000000004B0002, 0x8000000000340002, 0x8000000000340002, 0x8000000000340002, 0x8000000000330002, 0x8000000000330002, 0x8000000000330002,
+		0x8000000000250002, 0x8000000000240002, 0x80000000002A0002, 0x8000000000220002, 0x4000000000028502, 0x80000000004C0002, 0x8000000000330002, 0x8000000000340002,
+		0x8000000000340002, 0x8000000000340002, 0x8000000000330002, 0x8000000000330002, 0x8000000000330002, 0x8000000000340002, 0x8000000000340002, 0x8000000000000000,
+		0x8000000000330002, 0x8000000000330002, 0x8000000000330002, 0x8000000000340002, 0x8000000000340002, 0x8000000000340002, 0x8000000000340002, 0x8000000000330002,
+		0x80000000004D0002, 0x8000000000340002, 0x8000000000340002, 0x8000000000330002, 0x8000000000340002, 0x8000000000330002, 0x8000000000330002, 0x8000000000340002,
+		0x80000000004E0002, 0x80000000004F0002, 0x8000000000340002, 0x80001C4700200004, 0x80001CAA00200004, 0x80001D3200200004, 0x80001DDD00200004, 0x80001EB500200004,
+		0x80001C7A00200004, 0x80001C8F00200004, 0x80001D1800200004, 0x80001DAA00200004, 0x80001E3300200004, 0x80001E9500200004, 0x80001EE300200004, 0x80001EFF00200004,
+		0x80001FC300200008, 0x80001FC300200002, 0x80001FE400200008, 0x80001FE400200002, 0x8000049300200002, 0x8000049400200002, 0x80001FC000200008, 0x80001FC000200002,
+		0, 0, 0x80001FC600200004, 0x80001FD900200002, 0x80001FD800200002, 0x80001FDA00200002, 0x8000023400200002, 0x80001FC700200008,
+		0, 0, 0, 0, 0x8000048300200002, 0x4000000000028702, 0x4000000000028902, 0x8000028B00200002,
+		0x4000000000028B02, 0x4000000000028D02, 0x4000000000028F02, 0, 0x4000000000029102, 0, 0x4000000000029302, 0x4000000000029502,
+		0x4000000000029703, 0x80001FB900200008, 0x80001FBA00200008, 0x80001FBB00200008, 0x80001FBD00200008, 0x80001FBE00200008, 0x80001FC200200008, 0x80001FC400200008,
+		0x80001FC500200008, 0x80001FC600200008, 0x80001FC800200008, 0x80001FC900200008, 0x80001FCB00200008, 0x80001FCC00200008, 0x80001FCD00200008, 0x80001FCE00200008,
+		0x80001FCF00200008, 0x80001FD400200008, 0, 0x80001FD700200008, 0x80001FDB00200008, 0x80001FDC00200008, 0x80001FDD00200008, 0x80001FDE00200008,
+		0x80001FDF00200008, 0x80001FE100200008, 0x4000000000029A02, 0x4000000000029C02, 0x4000000000029E02, 0x400000000002A002, 0x400000000002A202, 0x400000000002A402,
+		0x400000000002A603, 0x80001FB900200002, 0x80001FBA00200002, 0x80001FBB00200002, 0x80001FBD00200002, 0x80001FBE00200002, 0x80001FC200200002, 0x80001FC400200002,
+		0x80001FC500200002, 0x80001FC600200002, 0x80001FC800200002, 0x80001FC900200002, 0x80001FCB00200002, 0x80001FCC00200002, 0x80001FCD00200002, 0x80001FCE00200002,
+		0x80001FCF00200002, 0x80001FD400200002, 0x80001FD700200019, 0x80001FD700200002, 0x80001FDB00200002, 0x80001FDC00200002, 0x80001FDD00200002, 0x80001FDE00200002,
+		0x80001FDF00200002, 0x80001FE100200002, 0x400000000002A902, 0x400000000002AB02, 0x400000000002AD02, 0x400000000002AF02, 0x400000000002B102, 0x400000000002B303,
+		0x80001FBA00200004, 0x80001FC500200004, 0x80001FDC0020000A, 0x400000000002B602, 0x400000000002B802, 0x80001FDD00200004, 0x80001FCF00200004, 0x400000000002BA03,
+		0x80001FD300200008, 0x80001FD300200002, 0x80001FC100200008, 0x80001FC100200002, 0x80001FBF00200008, 0x80001FBF00200002, 0x80001FD200200008, 0x80001FD200200002,
+		0x80001FE300200008, 0x80001FE300200002, 0x8000200500200008, 0x8000200500200002, 0x8000200A00200008, 0x8000200A00200002, 0x8000200B00200008, 0x8000200B00200002,
+		0x8000200E00200008, 0x8000200E00200002, 0x8000201500200008, 0x8000201500200002, 0x8000201800200008, 0x8000201800200002, 0x8000201C00200008, 0x8000201C00200002,
+		0x80001FC800200004, 0x80001FD400200004, 0x80001FD700200004, 0x80001FC700200002, 0x80001FC50020000A, 0x80001FBE00200004, 0x8000061100200002, 0x80001FE500200008,
+		0x80001FE500200002, 0x80001FD70020000A, 0x80001FD100200008, 0x80001FD100200002, 0x80001FD600200002, 0x80001FD900200008, 0x80001FD800200008, 0x80001FDA00200008,
+		0x400000000002BD02, 0x400000000002BF02, 0x8000205000200008, 0x400000000002C102, 0x8000205E00200008, 0x8000207400200008, 0x8000208800200008, 0x400000000002C302,
+		0x8000209100200008, 0x800020BC00200008, 0x800020E200200008, 0x8000211900200008, 0x400000000002C502, 0x400000000002C702, 0x400000000002C902, 0x8000217F00200008,
+		0x8000202200200008, 0x8000202E00200008, 0x8000203200200008, 0x8000203600200008, 0x8000204A00200008, 0x8000205A00200008, 0x8000206200200008, 0x8000206C00200008,
+		0x8000208000200008, 0x8000208D00200008, 0x8000209600200008, 0x800020B000200008, 0x800020C300200008, 0x800020CC00200008, 0x800020E700200008, 0x800020EF00200008,
+		0x800020FC00200008, 0x8000210500200008, 0x8000210E00200008, 0x8000211D00200008, 0x8000212E00200008, 0x8000213200200008, 0x8000215900200008, 0x8000216400200008,
+		0x8000218300200008, 0x8000218800200008, 0x8000218F00200008, 0x8000219400200008, 0x8000219800200008, 0x800021A500200008, 0x800021A900200008, 0x800021AF00200008,
+		0x8000202200200002, 0x8000202E00200002, 0x8000203200200002, 0x8000203600200002, 0x8000204A00200002, 0x8000205A00200002, 0x8000206200200002, 0x8000206C00200002,
+		0x8000208000200002, 0x8000208D00200002, 0x8000209600200002, 0x800020B000200002, 0x800020C300200002, 0x800020CC00200002, 0x800020E700200002, 0x800020EF00200002,
+		0x800020FC00200002, 0x8000210500200002, 0x8000210E00200002, 0x8000211D00200002, 0x8000212E00200002, 0x8000213200200002, 0x8000215900200002, 0x8000216400200002,
+		0x8000218300200002, 0x8000218800200002, 0x8000218F00200002, 0x8000219400200002, 0x8000219800200002, 0x800021A500200002, 0x800021A900200002, 0x800021AF00200002,
+		0x400000000002CB02, 0x400000000002CD02, 0x8000205000200002, 0x400000000002CF02, 0x8000205E00200002, 0x8000207400200002, 0x8000208800200002, 0x400000000002D102,
+		0x8000209100200002, 0x800020BC00200002, 0x800020E200200002, 0x8000211900200002, 0x400000000002D302, 0x400000000002D502, 0x400000000002D702, 0x8000217F00200002,
+		0x8000214800200008, 0x8000214800200002, 0x800021A000200008, 0x800021A000200002, 0x800021B400200008, 0x800021B400200002, 0x800021B800200008, 0x800021B800200002,
+		0x800021C200200008, 0x800021C200200002, 0x800021BD00200008, 0x800021BD00200002, 0x800021C700200008, 0x800021C700200002, 0x800021CB00200008, 0x800021CB00200002,
+		0x800021CF00200008, 0x800021CF00200002, 0x800021D300200008, 0x800021D300200002, 0x800021D700200008, 0x800021D700200002, 0x400000000002D902, 0x400000000002DB02,
+		0x8000212A00200008, 0x8000212A00200002, 0x8000215500200008, 0x8000215500200002, 0x8000215100200008, 0x8000215100200002, 0x8000214C00200008, 0x8000214C00200002,
+		0x800020F800200008, 0x800020F800200002, 0x800004F700200002, 0x8000000000500002, 0x8000000000330002, 0x8000000000230002, 0x8000000000220002, 0x8000000000330002,
+		0x8000000000000000, 0x8000000000000000, 0x8000208400200008, 0x8000208400200002, 0x8000219C00200008, 0x8000219C00200002, 0x8000210000200008, 0x8000210000200002,
+		0x400000000002DD02, 0x400000000002DF02, 0x8000203A00200008, 0x8000203A00200002, 0x8000204200200008, 0x8000204200200002, 0x8000206800200008, 0x8000206800200002,
+		0x8000205600200008, 0x8000205600200002, 0x8000209A00200008, 0x8000209A00200002, 0x800020AA00200008, 0x800020AA00200002, 0x800020A600200008, 0x800020A600200002,
+		0x800020A200200008, 0x800020A200200002, 0x800020D500200008, 0x800020D500200002, 0x800020DE00200008, 0x800020DE00200002, 0x800020F400200008, 0x800020F400200002,
+		0x800021DC00200008, 0x800021DC00200002, 0x8000210A00200008, 0x8000210A00200002, 0x8000211400200008, 0x8000211400200002, 0x8000212100200008, 0x8000212100200002,
+		0x8000212500200008, 0x8000212500200002, 0x8000213E00200008, 0x8000213E00200002, 0x8000215F00200008, 0x8000215F00200002, 0x8000216A00200008, 0x8000216A00200002,
+		0x8000217200200008, 0x8000217200200002, 0x8000214200200008, 0x8000214200200002, 0x8000217700200008, 0x8000217700200002, 0x8000217B00200008, 0x8000217B00200002,
+		0x800021E100200008, 0x400000000002E102, 0x400000000002E302, 0x8000209E00200008, 0x8000209E00200002, 0x800020B500200008, 0x800020B500200002, 0x800020D900200008,
+		0x800020D900200002, 0x800020D100200008, 0x800020D100200002, 0x8000216E00200008, 0x8000216E00200002, 0x800020C700200008, 0x800020C700200002, 0x800021E100200002,
+		0x400000000002E502, 0x400000000002E702, 0x400000000002E902, 0x400000000002EB02, 0x8000202A00200008, 0x8000202A00200002, 0x400000000002ED02, 0x400000000002EF02,
+		0x8000202600200008, 0x8000202600200002, 0x400000000002F102, 0x400000000002F302, 0x400000000002F502, 0x400000000002F702, 0x400000000002F902, 0x400000000002FB02,
+		0x8000207900200008, 0x8000207900200002, 0x400000000002FD02, 0x400000000002FF02, 0x4000000000030102, 0x4000000000030302, 0x4000000000030502, 0x4000000000030702,
+		0x800020EB00200008, 0x800020EB00200002, 0x4000000000030902, 0x4000000000030B02, 0x4000000000030D02, 0x4000000000030F02, 0x4000000000031102, 0x4000000000031302,
+		0x4000000000031502, 0x4000000000031702, 0x4000000000031902, 0x4000000000031B02, 0x4000000000031D02, 0x4000000000031F02, 0x8000204600200008, 0x8000204600200002,
+		0x4000000000032102, 0x4000000000032302, 0x8000203E00200008, 0x8000203E00200002, 0x8000213600200008, 0x8000213600200002, 0x8000213A00200008, 0x8000213A00200002,
+		0x8000204E00200008, 0x8000204E00200002, 0x8000205500200008, 0x8000205500200002, 0x8000207100200008, 0x8000207100200002, 0x8000207E00200008, 0x8000207E00200002,
+		0x800020C100200008, 0x800020C100200002, 0x800020E600200008, 0x800020E600200002, 0x8000210900200008, 0x8000210900200002, 0x8000211300200008, 0x8000211300200002,
+		0x8000207200200008, 0x8000207200200002, 0x800020BA00200008, 0x800020BA00200002, 0x800020C200200008, 0x800020C200200002, 0x8000210400200008, 0x8000210400200002,
+		0x800021B300200008, 0x800021B300200002, 0x800020AF00200008, 0x800020AF00200002, 0x800021E000200008, 0x800021E000200002, 0x800020AE00200008, 0x800020AE00200002,
+		0x800020BB00200008, 0x800020BB00200002, 0x800020DD00200008, 0x800020DD00200002, 0x800020F300200008, 0x800020F300200002, 0x8000214600200008, 0x8000214600200002,
+		0x800020D000200008, 0x800020D000200002, 0x8000206600200008, 0x8000206600200002, 0x8000216800200008, 0x8000216800200002, 0x800020B900200008, 0x800020B900200002,
+		0, 0x8000229000200008, 0x8000229100200008, 0x8000229200200008, 0x8000229300200008, 0x8000229400200008, 0x8000229500200008, 0x8000229600200008,
+		0x8000229700200008, 0x8000229800200008, 0x8000229900200008, 0x8000229A00200008, 0x8000229B00200008, 0x8000229C00200008, 0x8000229D00200008, 0x8000229E00200008,
+		0x8000229F00200008, 0x800022A000200008, 0x800022A100200008, 0x800022A200200008, 0x800022A300200008, 0x800022A400200008, 0x800022A500200008, 0x800022A600200008,
+		0x800022A700200008, 0x800022A800200008, 0x800022A900200008, 0x800022AA00200008, 0x800022AB00200008, 0x800022AC00200008, 0x800022AD00200008, 0x800022AE00200008,
+		0x800022AF00200008, 0x800022B000200008, 0x800022B100200008, 0x800022B200200008, 0x800022B300200008, 0x800022B400200008, 0x800022B500200008, 0,
+		0, 0x800022B600200002, 0x800003CB00200002, 0x800003CC00200002, 0x8000026200200002, 0x8000022600200002, 0x8000026900200002, 0x800003CD00200002,
+		0, 0x8000229000200002, 0x8000229100200002, 0x8000229200200002, 0x8000229300200002, 0x8000229400200002, 0x8000229500200002, 0x8000229600200002,
+		0x8000229700200002, 0x8000229800200002, 0x8000229900200002, 0x8000229A00200002, 0x8000229B00200002, 0x8000229C00200002, 0x8000229D00200002, 0x8000229E00200002,
+		0x8000229F00200002, 0x800022A000200002, 0x800022A100200002, 0x800022A200200002, 0x800022A300200002, 0x800022A400200002, 0x800022A500200002, 0x800022A600200002,
+		0x800022A700200002, 0x800022A800200002, 0x800022A900200002, 0x800022AA00200002, 0x800022AB00200002, 0x800022AC00200002, 0x800022AD00200002, 0x800022AE00200002,
+		0x800022AF00200002, 0x800022B000200002, 0x800022B100200002, 0x800022B200200002, 0x800022B300200002, 0x800022B400200002, 0x800022B500200002, 0x4000000000032502,
+		0, 0x8000023A00200002, 0x8000020E00200002, 0, 0, 0x800004F800200002, 0x800004F900200002, 0x80001C1500200002,
+		0, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000520002, 0x8000000000530002, 0x8000000000540002, 0x8000000000550002, 0x8000000000560002, 0x8000000000570002, 0x8000000000580002, 0x8000000000590002,
+		0x80000000005A0002, 0x80000000005B0002, 0x80000000005B0002, 0x80000000005C0002, 0x80000000005F0002, 0x8000000000000000, 0x800003CE00200002, 0x8000000000600002,
+		0x800003CF00200002, 0x80000000005E0002, 0x80000000005D0002, 0x800003D000200002, 0x8000000000000000, 0x8000000000000000, 0x800003D100200002, 0x80000000005A0002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800022B700200002, 0x800022B800200002, 0x800022B900200002, 0x800022BA00200002, 0x800022BB00200002, 0x800022BC00200002, 0x800022BD00200002, 0x800022BE00200002,
+		0x800022BF00200002, 0x800022C000200002, 0x800022C100200019, 0x800022C100200002, 0x800022C200200002, 0x800022C300200019, 0x800022C300200002, 0x800022C400200019,
+		0x800022C400200002, 0x800022C500200002, 0x800022C600200002, 0x800022C700200019, 0x800022C700200002, 0x800022C800200019, 0x800022C800200002, 0x800022C900200002,
+		0x800022CA00200002, 0x800022CB00200002, 0x800022CC00200002, 0, 0, 0, 0, 0,
+		0x4000000000032702, 0x4000000000032902, 0x4000000000032B02, 0x800003D200200002, 0x800003D300200002, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000062D00200002, 0x8000062F00200002,
+		0x800004FA00200002, 0x8000039C00200002, 0x8000039E00200002, 0x80001C1600200002, 0x8000022700200002, 0x8000022800200002, 0x800004FD00200002, 0x800004FE00200002,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000023500200002, 0x8000000000000000, 0, 0x8000023B00200002, 0x8000026A00200002,
+		0x800023CF00200002, 0x800022FD00200002, 0x800022FE00200002, 0x800022FF00200002, 0x8000230200200002, 0x8000230300200002, 0x8000230700200002, 0x8000230B00200002,
+		0x8000230D00200002, 0x8000231C00200002, 0x8000231D00200002, 0x8000231E00200002, 0x8000232500200002, 0x8000232C00200002, 0x8000232D00200002, 0x8000233700200002,
+		0x8000233800200002, 0x8000234600200002, 0x8000234700200002, 0x8000235900200002, 0x8000235A00200002, 0x8000236400200002, 0x8000236500200002, 0x8000236A00200002,
+		0x8000236B00200002, 0x8000236E00200002, 0x8000236F00200002, 0x8000239800200002, 0x8000239900200002, 0x800023CC00200002, 0x800023CD00200002, 0x800023CE00200002,
+		0x8000000000000000, 0x8000237600200002, 0x8000238200200002, 0x8000238700200002, 0x8000239C00200002, 0x800023A300200002, 0x800023A700200002, 0x800023B100200002,
+		0x800023B700200002, 0x800023C500200002, 0x800023C600200002, 0x80000000006D0002, 0x8000000000700002, 0x8000000000730002, 0x8000000000760002, 0x80000000007A0002,
+		0x80000000007D0002, 0x8000000000800002, 0x8000000000810002, 0x8000000000820002, 0x8000000000830002, 0x8000000000840002, 0x8000000000860002, 0x8000000000870002,
+		0x8000000000880002, 0x80000000008A0002, 0x80000000008B0002, 0x80000000008C0002, 0x80000000008D0002, 0x80000000008E0002, 0x80000000008F0002, 0x8000000000850002,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0x8000039A00200002, 0x8000022900200002, 0x8000022A00200002, 0x8000039200200002, 0x8000230C00200002, 0x8000238100200002,
+		0x8000000000980002, 0x8000230100200002, 0x8000230000200002, 0x8000230400200002, 0x800022FD00200004, 0x4000000000032D02, 0x4000000000032F02, 0x4000000000033102,
+		0x4000000000033302, 0x8000231F00200002, 0x8000232000200002, 0x8000230E00200002, 0x8000232100200002, 0x8000232200200002, 0x8000230F00200002, 0x8000232300200002,
+		0x8000231000200002, 0x8000232E00200002, 0x8000232F00200002, 0x8000232600200002, 0x8000232700200002, 0x8000233000200002, 0x8000232800200002, 0x8000232A00200002,
+		0x8000233900200002, 0x8000233A00200002, 0x8000233B00200002, 0x8000233C00200002, 0x8000233D00200002, 0x8000233E00200002, 0x8000234000200002, 0x8000234100200002,
+		0x8000234200200002, 0x8000234800200002, 0x8000234900200002, 0x8000234A00200002, 0x8000234B00200002, 0x8000234C00200002, 0x8000234D00200002, 0x8000234E00200002,
+		0x8000234F00200002, 0x8000235000200002, 0x8000235B00200002, 0x8000235C00200002, 0x8000235D00200002, 0x8000236600200002, 0x8000236800200002, 0x8000236C00200002,
+		0x8000237000200002, 0x8000237700200002, 0x8000237800200002, 0x8000237A00200002, 0x8000237B00200002, 0x8000237D00200002, 0x8000237E00200002, 0x8000238300200002,
+		0x8000238500200002, 0x8000238800200002, 0x8000238900200002, 0x8000238A00200002, 0x8000238B00200002, 0x8000238D00200002, 0x8000238E00200002, 0x8000239000200002,
+		0x8000239200200002, 0x8000239300200002, 0x8000239400200002, 0x8000239500200002, 0x8000239600200002, 0x8000239D00200002, 0x8000239E00200002, 0x8000239F00200002,
+		0x800023A000200002, 0x800023AD00200002, 0x800023A800200002, 0x800023AA00200002, 0x800023AB00200002, 0x800023AC00200002, 0x800023B200200002, 0x8000232900200002,
+		0x4000000000033502, 0x800023B300200002, 0x4000000000033702, 0x800023B400200002, 0x800023B800200002, 0x800023B900200002, 0x800023BA00200002, 0x800023BB00200002,
+		0x800023BC00200002, 0x800023BD00200002, 0x800023BE00200002, 0x800023BF00200002, 0x800023C700200002, 0x800023C800200002, 0x800023C900200002, 0x800023C100200002,
+		0x800023CA00200002, 0x800023CB00200002, 0x800023D400200002, 0x4000000000033902, 0x8000027900200002, 0x800023B600200002, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x800004FF00200002, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x800023B700200004, 0x800023C600200004, 0x8000000000000000,
+		0x8000000000000000, 0x8000050000200002, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000234300200002, 0x8000235100200002,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0x8000235E00200002, 0x8000236900200002, 0x8000237100200002, 0x4000000000033B02, 0x4000000000033D02, 0x800023B500200002,
+		0x800002C400200002, 0x8000027A00200002, 0x8000027B00200002, 0x8000023C00200002, 0x8000023D00200002, 0x8000023E00200002, 0x8000023F00200002, 0x8000024000200002,
+		0x8000024100200002, 0x8000026B00200002, 0x800003D400200002, 0x800003D500200002, 0x800003D600200002, 0x800003D700200002, 0, 0x8000000000000000,
+		0x800023D700200002, 0x8000000000990002, 0x800023D800200002, 0x800023D900200002, 0x4000000000033F02, 0x800023DB00200002, 0x800023DA00200002, 0x800023DC00200002,
+		0x800023DD00200002, 0x800023DE00200002, 0x800023E000200002, 0x800023E100200002, 0x4000000000034102, 0x800023E200200002, 0x800023E300200002, 0x800023E400200002,
+		0x800023E600200002, 0x800023E700200002, 0x800023E800200002, 0x800023E900200002, 0x800023E900200019, 0x800023EA00200002, 0x800023EB00200002, 0x4000000000034302,
+		0x800023ED00200002, 0x800023EE00200002, 0x800023EF00200002, 0x800023F000200002, 0x800023F100200002, 0x4000000000034502, 0x4000000000034702, 0x4000000000034902,
+		0x80000000009A0002, 0x80000000009B0002, 0x80000000009C0002, 0x80000000009D0002, 0x80000000009E0002, 0x80000000009F0002, 0x8000000000A00002, 0x8000000000A10002,
+		0x8000000000A20002, 0x8000000000A30002, 0x8000000000A40002, 0x8000000000A50002, 0x8000000000A60002, 0x8000000000A70002, 0x8000000000A80002, 0x8000000000A90002,
+		0x8000000000000000, 0x8000000000330002, 0x8000000000340002, 0x8000000000000000, 0x8000000000000000, 0x8000000000330002, 0x8000000000340002, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0, 0, 0x800023DF00200002, 0x800023E500200002, 0x800023EC00200002,
+		0x8000231100200002, 0x8000231200200002, 0x8000231300200002, 0x8000231400200002, 0x8000231500200002, 0x8000231600200002, 0x8000231800200002, 0x8000233100200002,
+		0x8000233200200002, 0x8000234400200002, 0x8000234500200002, 0x8000235200200002, 0x8000235F00200002, 0x8000237200200002, 0x8000237300200002, 0x8000237400200002,
+		0x8000237F00200002, 0x8000238000200002, 0x8000239700200002, 0x8000239A00200002, 0x8000239B00200002, 0x800023A400200002, 0x800023A500200002, 0x800023AE00200002,
+		0x800023AF00200002, 0x800023B000200002, 0x800023A100200002, 0x8000235300200002, 0x8000235400200002, 0x8000236000200002, 0x8000233300200002, 0x8000233400200002,
+		0x8000236100200002, 0x8000235500200002, 0x8000233500200002, 0x8000230500200002, 0x8000230600200002, 0x800023D000200002, 0x800023D100200002, 0x800023D200200002,
+		0x800023C200200002, 0x800023C300200002, 0x800023D500200002, 0x800023D600200002, 0x8000233600200002, 0x8000236200200002, 0x8000236300200002, 0x8000238C00200002,
+		0x8000240B00200002, 0x8000240E00200002, 0x8000240F00200002, 0x8000241000200002, 0x8000241200200002, 0x8000241300200002, 0x8000241400200002, 0x8000241500200002,
+		0x8000241800200002, 0x8000241A00200002, 0x8000241B00200002, 0x8000241C00200002, 0x8000241E00200002, 0x8000242200200002, 0x8000242300200002, 0x8000242500200002,
+		0x8000242600200002, 0x8000242A00200002, 0x8000242B00200002, 0x8000242C00200002, 0x8000242D00200002, 0x8000242E00200002, 0x8000242F00200002, 0x8000243000200002,
+		0x8000241F00200002, 0x8000240C00200002, 0x8000240D00200002, 0x8000241D00200002, 0x8000241100200002, 0x8000242700200002, 0x8000242800200002, 0x8000242900200002,
+		0x8000242000200002, 0x8000242100200002, 0x8000241600200002, 0x8000241700200002, 0x8000242400200002, 0x8000241900200002, 0x8000243200200002, 0x8000243300200002,
+		0x8000243400200002, 0x8000243500200002, 0x8000243600200002, 0x8000243700200002, 0x8000243800200002, 0x8000243900200002, 0x8000243A00200002, 0x8000243B00200002,
+		0x8000243C00200002, 0x8000243100200002, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0x8000243D00200002, 0x8000243E00200002, 0x8000243F00200002, 0x8000244000200002, 0x8000244100200002, 0x8000244200200002,
+		0x8000244300200002, 0x8000244400200002, 0x8000244500200002, 0x8000244600200002, 0x8000244700200002, 0x8000244800200002, 0x8000244900200002, 0x8000244A00200002,
+		0x8000244B00200002, 0x8000244C00200002, 0x8000244D00200002, 0x8000244E00200002, 0x8000244F00200002, 0x8000245000200002, 0x8000245100200002, 0x8000245200200002,
+		0x8000245300200002, 0x8000245400200002, 0x8000245500200002, 0x8000245600200002, 0x8000245700200002, 0x8000245800200002, 0x8000245900200002, 0x8000245A00200002,
+		0x4000000000034B02, 0x4000000000034D02, 0x4000000000034F02, 0x8000000000AA0002, 0x8000000000AB0002, 0x8000000000AC0002, 0x8000000000AD0002, 0x8000000000AE0002,
+		0x8000000000AF0002, 0x8000000000B00002, 0x8000000000B10002, 0x8000000000B20002, 0x8000245B00200002, 0x8000245C00200002, 0x8000051200200002, 0x800002C500200002,
+		0x8000022B00200002, 0x8000026300200002, 0x8000000000000000, 0, 0, 0, 0, 0,
+		0x800022E300200002, 0x800022E400200002, 0x800022E500200002, 0x800022E600200002, 0x800022E700200002, 0x800022E800200002, 0x800022E900200002, 0x800022EA00200002,
+		0x800022EB00200002, 0x800022EC00200002, 0x800022ED00200002, 0x800022EE00200002, 0x800022EF00200002, 0x800022F000200002, 0x800022F100200002, 0x800022F200200002,
+		0x800022F300200002, 0x800022F400200002, 0x800022F500200002, 0x800022F600200002, 0x800022F700200002, 0x800022F800200002, 0x800022F900200002, 0x800022FA00200002,
+		0x80000000006A0002, 0x80000000006B0002, 0x800022FB00200002, 0x800022FC00200002, 0x8000000000620002, 0x8000000000620002, 0x8000000000630002, 0x8000000000630002,
+		0x8000000000630002, 0x8000000000640002, 0x8000000000640002, 0x8000000000640002, 0x8000000000650002, 0x8000000000650002, 0x8000000000660002, 0x8000000000660002,
+		0x8000000000670002, 0x8000000000670002, 0x8000000000670002, 0x8000000000680002, 0x8000000000690002, 0x80000000006C0002, 0, 0,
+		0x8000024200200002, 0x8000024300200002, 0x8000024400200002, 0x8000024500200002, 0x8000024600200002, 0x8000024700200002, 0x8000024800200002, 0x8000024900200002,
+		0x8000024A00200002, 0x8000024B00200002, 0x8000024C00200002, 0x8000024D00200002, 0x8000024E00200002, 0x8000024F00200002, 0x8000025000200002, 0,
+		0x800023F200200002, 0x800023F300200002, 0x800023F400200002, 0x800023F500200002, 0x800023F600200002, 0x800023F700200002, 0x800023F800200002, 0x800023F900200002,
+		0x800023FA00200002, 0x800023FB00200002, 0x800023FC00200002, 0x800023FD00200002, 0x800023FE00200002, 0x800023FF00200002, 0x8000240000200002, 0x8000240100200002,
+		0x8000240200200002, 0x8000240300200002, 0x8000240400200002, 0x8000240500200002, 0x8000240600200002, 0x8000240700200002, 0x8000240800200002, 0x8000240900200002,
+		0x8000240A00200002, 0x8000000000340002, 0x8000000000340002, 0x8000000000340002, 0, 0, 0x800003D800200002, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000231700200002, 0x8000231900200002, 0x8000232B00200002, 0x8000236D00200002, 0x8000237C00200002, 0x8000238600200002, 0x800023A200200002, 0x800023A600200002,
+		0x8000230800200002, 0x8000230900200002, 0x8000235600200002, 0x800023C400200002, 0x8000230A00200002, 0x8000230B00200004, 0x8000233F00200002, 0x8000236700200002,
+		0x8000239100200002, 0x800023C000200002, 0x8000235700200002, 0x8000237500200002, 0x8000238F00200002, 0, 0x8000231A00200002, 0x8000231B00200002,
+		0x8000232400200002, 0x8000235800200002, 0x800023D300200002, 0x8000237900200002, 0x8000238400200002, 0x800023A900200002, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000900002, 0x8000000000770002, 0x80000000007B0002, 0x80000000007E0002, 0x80000000006F0002,
+		0x8000000000720002, 0x8000000000750002, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x80000000006E0002, 0x8000000000710002, 0x8000000000740002, 0x8000000000000000, 0x8000000000780002, 0x8000000000790002, 0x80000000007F0002, 0x8000000000910002,
+		0x8000000000920002, 0x8000000000960002, 0x8000000000970002, 0x8000000000940002, 0x8000000000950002, 0x8000000000930002, 0x80000000007C0002, 0x8000000000890002,
+		0x8000000000C30002, 0x8000000000C30002, 0x8000000000C40002, 0x8000000000C50002, 0x8000265E00200002, 0x8000265F00200002, 0x8000266000200002, 0x8000266600200002,
+		0x8000266700200002, 0x8000266800200002, 0x8000266900200002, 0x8000266A00200002, 0x8000266C00200002, 0x8000266E00200002, 0x8000266F00200002, 0x8000267000200002,
+		0x8000267100200002, 0x8000267200200002, 0x8000267300200002, 0x8000267400200002, 0x8000267500200002, 0x8000267600200002, 0x8000267700200002, 0x8000267800200002,
+		0x8000267A00200002, 0x8000267B00200002, 0x8000267C00200002, 0x8000267D00200002, 0x8000267E00200002, 0x8000268100200002, 0x8000268200200002, 0x8000268300200002,
+		0x8000268400200002, 0x8000268600200002, 0x8000268800200002, 0x8000268900200002, 0x8000268A00200002, 0x8000268B00200002, 0x8000268C00200002, 0x8000268D00200002,
+		0x8000268E00200002, 0x4000000000035102, 0x8000268F00200002, 0x8000269000200002, 0x8000269100200002, 0x8000269300200002, 0x8000269400200002, 0x8000269500200002,
+		0x8000269700200002, 0x4000000000035302, 0x8000269800200002, 0x8000269900200002, 0x4000000000035502, 0x8000269A00200002, 0x8000269B00200002, 0x8000269C00200002,
+		0x8000269D00200002, 0x8000269E00200002, 0x800026A700200002, 0x800026A800200002, 0x8000000000C20002, 0x8000269F00200002, 0x800026A600200002, 0x800026AC00200002,
+		0x800026AD00200002, 0x800026AE00200002, 0x800026AF00200002, 0x800026B000200002, 0x800026B100200002, 0x800026B400200002, 0x800026B600200002, 0x800026B700200002,
+		0x800026B900200002, 0x800026BA00200002, 0x800026BB00200002, 0x800026BC00200002, 0x800026BD00200002, 0x800026BE00200002, 0x800026B800200002, 0x800026A900200002,
+		0x8000265B00200002, 0x8000000000000000, 0x8000000000000000, 0x8000000000250002, 0x8000000000240002, 0x800026B500200002, 0x800026AA00200002, 0x800026AB00200002,
+		0x4000000000035702, 0x4000000000035902, 0x4000000000035B02, 0x4000000000035D02, 0x4000000000035F02, 0x4000000000036102, 0x4000000000036302, 0x4000000000036502,
+		0x8000266B00200002, 0x8000266D00200002, 0x800026B200200002, 0x800026B300200002, 0x8000028E00200002, 0x8000028F00200002, 0x80001C3D00200002, 0x80001C3E00200002,
+		0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002, 0x80001C4600200002,
+		0x800003E700200002, 0x80001BFA00200002, 0x8000265D00200002, 0x8000266100200002, 0x8000266200200002, 0x8000266300200002, 0x8000266400200002, 0x8000266500200002,
+		0x8000268500200002, 0x8000267F00200002, 0x8000269600200002, 0x8000267900200002, 0x8000268000200002, 0x800026A000200002, 0x8000268700200002, 0x8000269200200002,
+		0x800026BF00200002, 0x8000000000C30002, 0x8000000000C40002, 0x8000000000C50002, 0, 0x800026C000200002, 0x800026C100200002, 0x800026C200200002,
+		0x800026C300200002, 0x800026C400200002, 0x800026C500200002, 0x800026C600200002, 0x800026C800200002, 0, 0, 0x800026CA00200002,
+		0x800026CB00200002, 0, 0, 0x800026CC00200002, 0x800026CD00200002, 0x800026CE00200002, 0x800026CF00200002, 0x800026D000200002,
+		0x800026D100200002, 0x800026D200200002, 0x800026D300200002, 0x800026D400200002, 0x800026D500200002, 0x800026D600200002, 0x800026D700200002, 0x800026D800200002,
+		0x800026D900200002, 0x800026DA00200002, 0x800026DB00200002, 0x800026DC00200002, 0x800026DD00200002, 0x800026DE00200002, 0x800026DF00200002, 0x800026E000200002,
+		0x800026E100200002, 0, 0x800026E200200002, 0x800026E300200002, 0x800026E400200002, 0x800026E500200002, 0x800026E600200002, 0x800026E700200002,
+		0x800026E800200002, 0, 0x800026EA00200002, 0, 0, 0, 0x800026EC00200002, 0x800026ED00200002,
+		0x800026EE00200002, 0x800026EF00200002, 0, 0, 0x8000000000C20002, 0x800026F000200002, 0x800026F100200002, 0x800026F200200002,
+		0x800026F300200002, 0x800026F400200002, 0x800026F500200002, 0x800026F600200002, 0x800026F700200002, 0, 0, 0x800026FA00200002,
+		0x800026FB00200002, 0, 0, 0x800026FC00200002, 0x800026FD00200002, 0x800026FE00200002, 0x4000000000036702, 0,
+		0, 0, 0, 0, 0, 0, 0, 0x800026FF00200002,
+		0, 0, 0, 0, 0x4000000000036902, 0x4000000000036B02, 0, 0x4000000000036D02,
+		0x800026C700200002, 0x800026C900200002, 0x800026F800200002, 0x800026F900200002, 0, 0, 0x80001C3D00200002, 0x80001C3E00200002,
+		0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002, 0x80001C4600200002,
+		0x800026E900200002, 0x800026EB00200002, 0x80001C1700200002, 0x80001C1800200002, 0x80001A9600200002, 0x80001A9700200002, 0x80001A9800200002, 0x80001A9900200002,
+		0x80001A9A00200002, 0x80001A9B00200002, 0x8000051300200002, 0x80001C1900200002, 0, 0, 0, 0,
+		0, 0x8000000000C30002, 0x8000000000C40002, 0x8000000000C50002, 0, 0x8000270500200002, 0x8000270600200002, 0x8000270A00200002,
+		0x8000270B00200002, 0x8000270200200002, 0x8000270300200002, 0, 0, 0, 0, 0x8000270C00200002,
+		0x8000270700200002, 0, 0, 0x8000270400200002, 0x8000270800200002, 0x8000271000200002, 0x8000271100200002, 0x8000271200200002,
+		0x8000271300200002, 0x8000271400200002, 0x8000271500200002, 0x8000271600200002, 0x8000271700200002, 0x8000271800200002, 0x8000271900200002, 0x8000271A00200002,
+		0x8000271B00200002, 0x8000271C00200002, 0x8000271D00200002, 0x8000271E00200002, 0x8000271F00200002, 0x8000272000200002, 0x8000272100200002, 0x8000272200200002,
+		0x8000272300200002, 0, 0x8000272400200002, 0x8000272500200002, 0x8000272600200002, 0x8000272700200002, 0x8000272800200002, 0x8000272900200002,
+		0x8000272B00200002, 0, 0x8000272C00200002, 0x4000000000036F02, 0, 0x8000272D00200002, 0x4000000000037102, 0,
+		0x8000270D00200002, 0x8000270E00200002, 0, 0, 0x8000000000C20002, 0, 0x8000272F00200002, 0x8000273000200002,
+		0x8000273100200002, 0x8000273200200002, 0x8000273300200002, 0, 0, 0, 0, 0x8000273400200002,
+		0x8000273500200002, 0, 0, 0x8000273600200002, 0x8000273700200002, 0x8000273800200002, 0, 0,
+		0, 0x8000270F00200002, 0, 0, 0, 0, 0, 0,
+		0, 0x4000000000037302, 0x4000000000037502, 0x4000000000037702, 0x8000272E00200002, 0, 0x4000000000037902, 0,
+		0, 0, 0, 0, 0, 0, 0x80001C3D00200002, 0x80001C3E00200002,
+		0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002, 0x80001C4600200002,
+		0x8000000000C60002, 0x8000000000C70002, 0x8000270900200002, 0x8000270100200002, 0x8000270000200002, 0x8000272A00200002, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0x8000000000C30002, 0x8000000000C40002, 0x8000000000C50002, 0, 0x8000273A00200002, 0x8000273B00200002, 0x8000273C00200002,
+		0x8000273D00200002, 0x8000273E00200002, 0x8000273F00200002, 0x8000274000200002, 0x8000274200200002, 0x8000274400200002, 0, 0x8000274500200002,
+		0x8000274600200002, 0x8000274700200002, 0, 0x8000274800200002, 0x8000274900200002, 0x8000274A00200002, 0x8000274B00200002, 0x8000274C00200002,
+		0x8000274D00200002, 0x8000274E00200002, 0x8000274F00200002, 0x8000275000200002, 0x8000275100200002, 0x8000275300200002, 0x8000275400200002, 0x8000275500200002,
+		0x8000275600200002, 0x8000275700200002, 0x8000275800200002, 0x8000275900200002, 0x8000275A00200002, 0x8000275B00200002, 0x8000275C00200002, 0x8000275D00200002,
+		0x8000275E00200002, 0, 0x8000275F00200002, 0x8000276000200002, 0x8000276100200002, 0x8000276200200002, 0x8000276300200002, 0x8000276400200002,
+		0x8000276500200002, 0, 0x8000276600200002, 0x8000276C00200002, 0, 0x8000276700200002, 0x8000276800200002, 0x8000276900200002,
+		0x8000276A00200002, 0x8000276B00200002, 0, 0, 0x8000000000C20002, 0x8000276D00200002, 0x8000276E00200002, 0x8000276F00200002,
+		0x8000277000200002, 0x8000277100200002, 0x8000277200200002, 0x8000277300200002, 0x8000277400200002, 0x8000277700200002, 0, 0x8000277800200002,
+		0x8000277900200002, 0x8000277A00200002, 0, 0x8000277B00200002, 0x8000277C00200002, 0x8000277D00200002, 0, 0,
+		0x8000273900200002, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000274100200002, 0x8000274300200002, 0x8000277500200002, 0x8000277600200002, 0, 0, 0x80001C3D00200002, 0x80001C3E00200002,
+		0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002, 0x80001C4600200002,
+		0x800003EC00200002, 0x80001C1A00200002, 0, 0, 0, 0, 0, 0,
+		0, 0x8000275200200002, 0, 0, 0, 0, 0, 0,
+		0, 0x8000000000C30002, 0x8000000000C40002, 0x8000000000C50002, 0, 0x8000277E00200002, 0x8000277F00200002, 0x8000278000200002,
+		0x8000278100200002, 0x8000278200200002, 0x8000278300200002, 0x8000278400200002, 0x8000278600200002, 0, 0, 0x8000278800200002,
+		0x8000278900200002, 0, 0, 0x8000278A00200002, 0x8000278B00200002, 0x8000278C00200002, 0x8000278D00200002, 0x8000278E00200002,
+		0x8000278F00200002, 0x8000279000200002, 0x8000279100200002, 0x8000279200200002, 0x8000279300200002, 0x8000279400200002, 0x8000279500200002, 0x8000279600200002,
+		0x8000279700200002, 0x8000279800200002, 0x8000279900200002, 0x8000279A00200002, 0x8000279B00200002, 0x8000279C00200002, 0x8000279D00200002, 0x8000279E00200002,
+		0x8000279F00200002, 0, 0x800027A000200002, 0x800027A100200002, 0x800027A200200002, 0x800027A300200002, 0x800027A400200002, 0x800027A500200002,
+		0x800027A700200002, 0, 0x800027A800200002, 0x800027A900200002, 0, 0x800027AA00200002, 0x800027AC00200002, 0x800027AD00200002,
+		0x800027AE00200002, 0x800027AF00200002, 0, 0, 0x8000000000C20002, 0x800027B000200002, 0x800027B100200002, 0x800027B200200002,
+		0x800027B300200002, 0x800027B400200002, 0x800027B500200002, 0x800027B600200002, 0x800027B700200002, 0, 0, 0x800027BA00200002,
+		0x800027BB00200002, 0, 0, 0x800027BC00200002, 0x800027BD00200002, 0x800027BE00200002, 0, 0,
+		0, 0, 0, 0, 0, 0, 0x800027BF00200002, 0x800027C000200002,
+		0, 0, 0, 0, 0x4000000000037B02, 0x4000000000037D02, 0, 0x800027A600200002,
+		0x8000278500200002, 0x8000278700200002, 0x800027B800200002, 0x800027B900200002, 0, 0, 0x80001C3D00200002, 0x80001C3E00200002,
+		0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002, 0x80001C4600200002,
+		0x8000051400200002, 0x800027AB00200002, 0x80001A9C00200002, 0x80001A9D00200002, 0x80001A9E00200002, 0x80001A9F00200002, 0x80001AA000200002, 0x80001AA100200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0x8000000000C40002, 0x800027CE00200002, 0, 0x800027C200200002, 0x800027C300200002, 0x800027C400200002,
+		0x800027C500200002, 0x800027C600200002, 0x800027C700200002, 0, 0, 0, 0x800027C800200002, 0x800027C900200002,
+		0x800027CA00200002, 0, 0x800027CB00200002, 0x800027CC00200002, 0x800027CD00200002, 0x800027CF00200002, 0, 0,
+		0, 0x800027D000200002, 0x800027D100200002, 0, 0x800027E100200002, 0, 0x800027D200200002, 0x800027D300200002,
+		0, 0, 0, 0x800027D400200002, 0x800027D500200002, 0, 0, 0,
+		0x800027D600200002, 0x800027E000200002, 0x800027D700200002, 0, 0, 0, 0x800027D800200002, 0x800027D900200002,
+		0x800027DA00200002, 0x800027DF00200002, 0x800027DB00200002, 0x800027DE00200002, 0x800027DD00200002, 0x800027DC00200002, 0x800027E200200002, 0x800027E300200002,
+		0x800027E400200002, 0x800027E500200002, 0, 0, 0, 0, 0x800027E600200002, 0x800027E700200002,
+		0x800027E800200002, 0x800027E900200002, 0x800027EA00200002, 0, 0, 0, 0x800027EB00200002, 0x800027EC00200002,
+		0x800027ED00200002, 0, 0x800027EE00200002, 0x800027EF00200002, 0x800027F000200002, 0x800027F100200002, 0, 0,
+		0x800027C100200002, 0, 0, 0, 0, 0, 0, 0x800027F200200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0x80001C3D00200002, 0x80001C3E00200002,
+		0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002, 0x80001C4600200002,
+		0x80001AA800200002, 0x80001AA900200002, 0x80001AAA00200002, 0x8000051500200002, 0x8000051600200002, 0x8000051700200002, 0x8000051800200002, 0x8000051900200002,
+		0x8000051A00200002, 0x80001C1C00200002, 0x8000051B00200002, 0, 0, 0, 0, 0,
+		0x8000000000C30002, 0x8000000000C30002, 0x8000000000C40002, 0x8000000000C50002, 0, 0x800027F300200002, 0x800027F400200002, 0x800027F500200002,
+		0x800027F600200002, 0x800027F700200002, 0x800027F800200002, 0x800027F900200002, 0x800027FB00200002, 0, 0x800027FD00200002, 0x800027FE00200002,
+		0x800027FF00200002, 0, 0x8000280000200002, 0x8000280100200002, 0x8000280200200002, 0x8000280300200002, 0x8000280400200002, 0x8000280500200002,
+		0x8000280600200002, 0x8000280700200002, 0x8000280800200002, 0x8000280A00200002, 0x8000280B00200002, 0x8000280D00200002, 0x8000280E00200002, 0x8000280F00200002,
+		0x8000281000200002, 0x8000281100200002, 0x8000281200200002, 0x8000281300200002, 0x8000281400200002, 0x8000281500200002, 0x8000281600200002, 0x8000281700200002,
+		0x8000281800200002, 0, 0x8000281900200002, 0x8000281A00200002, 0x8000281B00200002, 0x8000281C00200002, 0x8000281D00200002, 0x8000281E00200002,
+		0x8000281F00200002, 0x8000282000200002, 0x8000282100200002, 0x8000282700200002, 0x8000282800200002, 0x8000282200200002, 0x8000282300200002, 0x8000282400200002,
+		0x8000282500200002, 0x8000282600200002, 0, 0, 0, 0x8000282A00200002, 0x8000282B00200002, 0x8000282C00200002,
+		0x8000282D00200002, 0x8000282E00200002, 0x8000282F00200002, 0x8000283000200002, 0x8000283100200002, 0, 0x8000283400200002, 0x8000283500200002,
+		0x8000283600200002, 0, 0x8000283700200002, 0x8000283800200002, 0x8000283900200002, 0x8000283A00200002, 0, 0,
+		0, 0, 0, 0, 0, 0x8000283B00200002, 0x8000283C00200002, 0,
+		0x8000280900200002, 0x8000280C00200002, 0x8000282900200002, 0, 0, 0, 0, 0,
+		0x800027FA00200002, 0x800027FC00200002, 0x8000283200200002, 0x8000283300200002, 0, 0, 0x80001C3D00200002, 0x80001C3E00200002,
+		0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002, 0x80001C4600200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x8000051C00200002,
+		0x8000287400200002, 0x8000000000C30002, 0x8000000000C40002, 0x8000000000C50002, 0, 0x8000283D00200002, 0x8000283E00200002, 0x8000283F00200002,
+		0x8000284000200002, 0x8000284100200002, 0x8000284200200002, 0x8000284300200002, 0x8000284500200002, 0, 0x8000284700200002, 0x8000284800200002,
+		0x8000284900200002, 0, 0x8000284A00200002, 0x8000284B00200002, 0x8000284C00200002, 0x8000284D00200002, 0x8000284E00200002, 0x8000284F00200002,
+		0x8000285000200002, 0x8000285100200002, 0x8000285200200002, 0x8000285300200002, 0x8000285400200002, 0x8000285500200002, 0x8000285600200002, 0x8000285700200002,
+		0x8000285800200002, 0x8000285900200002, 0x8000285A00200002, 0x8000285B00200002, 0x8000285C00200002, 0x8000285D00200002, 0x8000285E00200002, 0x8000285F00200002,
+		0x8000286000200002, 0, 0x8000286100200002, 0x8000286200200002, 0x8000286300200002, 0x8000286400200002, 0x8000286500200002, 0x8000286600200002,
+		0x8000286700200002, 0x8000286800200002, 0x8000286900200002, 0x8000286F00200002, 0, 0x8000286A00200002, 0x8000286B00200002, 0x8000286C00200002,
+		0x8000286D00200002, 0x8000286E00200002, 0, 0, 0x8000000000C20002, 0x8000287100200002, 0x8000287500200002, 0x8000287600200002,
+		0x8000287700200002, 0x8000287800200002, 0x8000287900200002, 0x8000287A00200002, 0x8000287B00200002, 0, 0x8000287E00200002, 0x8000287F00200002,
+		0x8000288000200002, 0, 0x8000288100200002, 0x8000288200200002, 0x8000288300200002, 0x8000288400200002, 0, 0,
+		0, 0, 0, 0, 0, 0x8000288500200002, 0x8000288600200002, 0,
+		0, 0, 0, 0, 0, 0, 0x8000287000200002, 0,
+		0x8000284400200002, 0x8000284600200002, 0x8000287C00200002, 0x8000287D00200002, 0, 0, 0x80001C3D00200002, 0x80001C3E00200002,
+		0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002, 0x80001C4600200002,
+		0, 0x8000287200200002, 0x8000287300200002, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0x8000000000C30002, 0x8000000000C40002, 0x8000000000C50002, 0, 0x8000288700200002, 0x8000288800200002, 0x8000288900200002,
+		0x8000288A00200002, 0x8000288C00200002, 0x8000288D00200002, 0x8000288E00200002, 0x8000289000200002, 0, 0x8000289200200002, 0x8000289300200002,
+		0x8000289400200002, 0, 0x8000289500200002, 0x8000289600200002, 0x8000289700200002, 0x8000289800200002, 0x8000289900200002, 0x8000289A00200002,
+		0x8000289B00200002, 0x8000289C00200002, 0x8000289D00200002, 0x8000289E00200002, 0x8000289F00200002, 0x800028A000200002, 0x800028A100200002, 0x800028A200200002,
+		0x800028A300200002, 0x800028A400200002, 0x800028A500200002, 0x800028A600200002, 0x800028A700200002, 0x800028A800200002, 0x800028A900200002, 0x800028AA00200002,
+		0x800028AB00200002, 0x800028AC00200002, 0x800028AD00200002, 0x800028AE00200002, 0x800028AF00200002, 0x800028B000200002, 0x800028B100200002, 0x800028B200200002,
+		0x800028B300200002, 0x800028BC00200002, 0x800028B400200002, 0x800028BA00200002, 0x800028BB00200002, 0x800028B500200002, 0x800028B600200002, 0x800028B700200002,
+		0x800028B800200002, 0x800028B900200002, 0x800028BD00200002, 0, 0, 0x800028BE00200002, 0x800028BF00200002, 0x800028C000200002,
+		0x800028C100200002, 0x800028C200200002, 0x800028C300200002, 0x800028C400200002, 0x800028C500200002, 0, 0x800028C800200002, 0x800028C900200002,
+		0x800028CA00200002, 0, 0x800028CB00200002, 0x800028CC00200002, 0x800028CD00200002, 0x800028CF00200002, 0x4000000000037F02, 0x8000051D00200002,
+		0, 0, 0, 0, 0x4000000000038102, 0x4000000000038302, 0x4000000000038502, 0x800028CE00200002,
+		0x80001AAB00200002, 0x80001AAC00200002, 0x80001AAD00200002, 0x80001AAE00200002, 0x80001AAF00200002, 0x80001AB000200002, 0x80001AB100200002, 0x8000288B00200002,
+		0x8000288F00200002, 0x8000289100200002, 0x800028C600200002, 0x800028C700200002, 0, 0, 0x80001C3D00200002, 0x80001C3E00200002,
+		0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002, 0x80001C4600200002,
+		0x80001AB200200002, 0x80001AB300200002, 0x80001AB400200002, 0x80001AB500200002, 0x80001AB600200002, 0x80001AB700200002, 0x80001AB800200002, 0x80001AB900200002,
+		0x80001ABA00200002, 0x8000051E00200002, 0x4000000000038702, 0x4000000000038902, 0x4000000000038B02, 0x4000000000038D02, 0x4000000000038F02, 0x4000000000039102,
+		0, 0, 0x8000000000C40002, 0x8000000000C50002, 0, 0x800028D000200002, 0x800028D100200002, 0x800028D200200002,
+		0x800028D300200002, 0x800028D400200002, 0x800028D500200002, 0x800028D600200002, 0x800028D700200002, 0x800028D800200002, 0x800028D900200002, 0x800028DA00200002,
+		0x800028DB00200002, 0x800028DC00200002, 0x800028DD00200002, 0x800028DE00200002, 0x800028DF00200002, 0x800028E000200002, 0x800028E100200002, 0,
+		0, 0, 0x800028E200200002, 0x800028E300200002, 0x800028E400200002, 0x800028E500200002, 0x800028E600200002, 0x800028E700200002,
+		0x800028E800200002, 0x800028E900200002, 0x800028EA00200002, 0x800028EB00200002, 0x800028EC00200002, 0x800028ED00200002, 0x800028EE00200002, 0x800028EF00200002,
+		0x800028F000200002, 0x800028F100200002, 0x800028F200200002, 0x800028F300200002, 0x800028F400200002, 0x800028F500200002, 0x800028F600200002, 0x800028F700200002,
+		0x800028F800200002, 0x800028F900200002, 0, 0x800028FA00200002, 0x800028FB00200002, 0x800028FC00200002, 0x800028FD00200002, 0x800028FE00200002,
+		0x800028FF00200002, 0x8000290000200002, 0x8000290100200002, 0x8000290200200002, 0, 0x8000290300200002, 0, 0,
+		0x8000290400200002, 0x8000290500200002, 0x8000290600200002, 0x8000290700200002, 0x8000290800200002, 0x8000290900200002, 0x8000290A00200002, 0,
+		0, 0, 0x8000291C00200002, 0, 0, 0, 0, 0x8000290B00200002,
+		0x8000290C00200002, 0x8000290D00200002, 0x8000290E00200002, 0x8000290F00200002, 0x8000291000200002, 0, 0x8000291100200002, 0,
+		0x8000291200200002, 0x8000291600200002, 0x8000291700200002, 0x8000291800200002, 0x8000291900200002, 0x8000291A00200002, 0x8000291B00200002, 0x8000291400200002,
+		0, 0, 0, 0, 0, 0, 0x80001C3D00200002, 0x80001C3E00200002,
+		0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002, 0x80001C4600200002,
+		0, 0, 0x8000291300200002, 0x8000291500200002, 0x800003ED00200002, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0x80002D7300200002, 0x80002D7400200002, 0x80002D7500200002, 0x80002D7600200002, 0x80002D7700200002, 0x80002D7800200002, 0x80002D7900200002,
+		0x80002D7A00200002, 0x80002D7B00200002, 0x80002D7C00200002, 0x80002D7D00200002, 0x80002D7E00200002, 0x80002D7F00200002, 0x80002D8000200002, 0x80002D8100200002,
+		0x80002D8200200002, 0x80002D8300200002, 0x80002D8400200002, 0x80002D8500200002, 0x80002D8600200002, 0x80002D8700200002, 0x80002D8800200002, 0x80002D8900200002,
+		0x80002D8A00200002, 0x80002D8B00200002, 0x80002D8C00200002, 0x80002D8D00200002, 0x80002D8E00200002, 0x80002D8F00200002, 0x80002D9000200002, 0x80002D9100200002,
+		0x80002D9200200002, 0x80002D9300200002, 0x80002D9400200002, 0x80002D9500200002, 0x80002D9600200002, 0x80002D9700200002, 0x80002D9800200002, 0x80002D9900200002,
+		0x80002D9A00200002, 0x80002D9B00200002, 0x80002D9C00200002, 0x80002D9D00200002, 0x80002D9E00200002, 0x80002D9F00200002, 0x80002DA000200002, 0x80002DA100200002,
+		0x80002DA200200002, 0x80002DA300200002, 0x80002DA400200002, 0x80002DA500200002, 0x80002DA600200002, 0x80002DA700200002, 0x80002DA800200002, 0x80002DA900200002,
+		0x80002DAA00200002, 0x80002DAB00200002, 0x80002DAC00200002, 0, 0, 0, 0, 0x80001C1D00200002,
+		0x80002DAD00200002, 0x80002DAE00200002, 0x80002DAF00200002, 0x80002DB000200002, 0x80002DB100200002, 0x80002DB200200002, 0x80001BFB00200002, 0x8000000000D20002,
+		0x8000000000D30002, 0x8000000000D40002, 0x8000000000D50002, 0x8000000000D60002, 0x8000000000D70002, 0x8000000000D80002, 0x8000000000D10002, 0x800003EE00200002,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0x800003EF00200002, 0x800003F000200002, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0x80002DB400200002, 0x80002DB500200002, 0, 0x80002DB600200002, 0, 0, 0x80002DB700200002,
+		0x80002DB800200002, 0, 0x80002DBA00200002, 0, 0, 0x80002DBC00200002, 0, 0,
+		0, 0, 0, 0, 0x80002DBD00200002, 0x80002DBE00200002, 0x80002DBF00200002, 0x80002DC000200002,
+		0, 0x80002DC100200002, 0x80002DC200200002, 0x80002DC300200002, 0x80002DC400200002, 0x80002DC500200002, 0x80002DC600200002, 0x80002DC700200002,
+		0, 0x80002DC800200002, 0x80002DC900200002, 0x80002DCA00200002, 0, 0x80002DCB00200002, 0, 0x80002DCC00200002,
+		0, 0, 0x80002DB900200002, 0x80002DCD00200002, 0, 0x80002DCE00200002, 0x80002DCF00200002, 0x80002DD000200002,
+		0x80002DD100200002, 0x80002DD200200002, 0x80002DD300200002, 0x80002DD400200002, 0x80002DD500200002, 0x80002DD600200002, 0x80002DD700200002, 0x80002DD800200002,
+		0x80002DD900200002, 0x80002DDA00200002, 0, 0x80002DDB00200002, 0x80002DDC00200002, 0x80002DDD00200002, 0, 0,
+		0x80002DDE00200002, 0x80002DDF00200002, 0x80002DE000200002, 0x80002DE100200002, 0x80002DE200200002, 0, 0x80001BFC00200002, 0,
+		0x8000000000D90002, 0x8000000000DA0002, 0x8000000000DB0002, 0x8000000000DC0002, 0x8000000000DD0002, 0x8000000000DE0002, 0, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0, 0, 0x4000000000039302, 0x4000000000039502, 0x80002DB300200002, 0x80002DBB00200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x4000000000039703, 0x8000052600200002, 0x8000052700200002, 0x8000052800200002, 0x800003F300200002, 0x800003F400200002, 0x800003F500200002, 0x800003F600200002,
+		0x800003F700200002, 0x800003F800200002, 0x800003F900200002, 0x800003FC00200002, 0x800003FC0020001B, 0x800003FD00200002, 0x800003FE00200002, 0x800003FF00200002,
+		0x8000040000200002, 0x8000040100200002, 0x8000040200200002, 0x8000052900200002, 0x8000025800200002, 0x8000052A00200002, 0x8000052B00200002, 0x8000052C00200002,
+		0x8000000000000000, 0x8000000000000000, 0x8000052D00200002, 0x8000052E00200002, 0x8000052F00200002, 0x8000053000200002, 0x8000053100200002, 0x8000053200200002,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0x80001C3E00200004, 0x80001C3F00200004, 0x80001C4000200004, 0x80001C4100200004, 0x80001C4200200004, 0x80001C4300200004,
+		0x80001C4400200004, 0x80001C4500200004, 0x80001C4600200004, 0x80001C3D00200004, 0x8000053300200002, 0x8000000000000000, 0x8000053400200002, 0x8000000000000000,
+		0x8000053500200002, 0x8000000000E10002, 0x8000031D00200002, 0x8000031E00200002, 0x8000031F00200002, 0x8000032000200002, 0x8000000000000000, 0x8000000000000000,
+		0x80002E2600200002, 0x80002E2900200002, 0x80002E2B00200002, 0x4000000000039A02, 0x80002E2D00200002, 0x80002E2F00200002, 0x80002E3100200002, 0x80002E3300200002,
+		0, 0x80002E3500200002, 0x80002E3700200002, 0x80002E3900200002, 0x80002E3B00200002, 0x4000000000039C02, 0x80002E3D00200002, 0x80002E3F00200002,
+		0x80002E4100200002, 0x80002E4300200002, 0x4000000000039E02, 0x80002E4500200002, 0x80002E4700200002, 0x80002E4900200002, 0x80002E4B00200002, 0x400000000003A002,
+		0x80002E4D00200002, 0x80002E4F00200002, 0x80002E5100200002, 0x80002E5300200002, 0x400000000003A202, 0x80002E5500200002, 0x80002E5700200002, 0x80002E5900200002,
+		0x80002E5B00200002, 0x80002E5D00200002, 0x80002E5F00200002, 0x80002E6200200002, 0x80002E6400200002, 0x80002E6600200002, 0x80002E6800200002, 0x80002E6A00200002,
+		0x80002E6C00200002, 0x400000000003A402, 0x400000000003A602, 0x80002E2800200002, 0x80002E6100200002, 0, 0, 0,
+		0, 0x80002E7600200002, 0x80002E7700200002, 0x80002E7800200002, 0x80002E7B00200002, 0x80002E7C00200002, 0x80002E7D00200002, 0x80002E7E00200002,
+		0x80002E7F00200002, 0x80002E8000200002, 0x80002E8100200002, 0x80002E8200200002, 0x80002E8300200002, 0x80002E8400200002, 0x8000000000C40002, 0x8000000000C50002,
+		0x80002E7900200002, 0x80002E7A00200002, 0x8000000000000000, 0x8000000000000000, 0x80002E8500200002, 0x8000040300200002, 0x8000000000000000, 0x8000000000000000,
+		0x80002E6E00200002, 0x80002E7000200002, 0x80002E7400200002, 0x80002E7500200002, 0x80002E7200200002, 0x80002E6F00200002, 0x80002E7100200002, 0x80002E7300200002,
+		0x80002E2700200002, 0x80002E2A00200002, 0x80002E2C00200002, 0x400000000003A802, 0x80002E2E00200002, 0x80002E3000200002, 0x80002E3200200002, 0x80002E3400200002,
+		0, 0x80002E3600200002, 0x80002E3800200002, 0x80002E3A00200002, 0x80002E3C00200002, 0x400000000003AA02, 0x80002E3E00200002, 0x80002E4000200002,
+		0x80002E4200200002, 0x80002E4400200002, 0x400000000003AC02, 0x80002E4600200002, 0x80002E4800200002, 0x80002E4A00200002, 0x80002E4C00200002, 0x400000000003AE02,
+		0x80002E4E00200002, 0x80002E5000200002, 0x80002E5200200002, 0x80002E5400200002, 0x400000000003B002, 0x80002E5600200002, 0x80002E5800200002, 0x80002E5A00200002,
+		0x80002E5C00200002, 0x80002E5E00200002, 0x80002E6000200002, 0x80002E6300200002, 0x80002E6500200002, 0x80002E6700200002, 0x80002E6900200002, 0x80002E6B00200002,
+		0x80002E6D00200002, 0x400000000003B202, 0x400000000003B402, 0x400000000003B602, 0x400000000003B802, 0, 0x8000053600200002, 0x8000053700200002,
+		0x8000053800200002, 0x8000053900200002, 0x8000053A00200002, 0x8000053B00200002, 0x8000053C00200002, 0x8000053D00200002, 0x8000000000000000, 0x8000053E00200002,
+		0x8000053F00200002, 0x8000054000200002, 0x8000054100200002, 0x8000054200200002, 0x8000054300200002, 0, 0x8000054400200002, 0x8000054500200002,
+		0x800003FA00200002, 0x800003FB00200002, 0x8000040400200002, 0x8000040500200002, 0x8000040600200002, 0x8000054600200002, 0x8000054700200002, 0x8000054800200002,
+		0x8000054900200002, 0x8000040700200002, 0x8000040800200002, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000303500200002, 0x8000303700200002, 0x8000303900200002, 0x8000303D00200002, 0x8000304000200002, 0x8000304200200002, 0x8000304500200002, 0x8000304900200002,
+		0x8000304E00200002, 0x8000305500200002, 0x8000305900200002, 0x8000305A00200002, 0x8000305C00200002, 0x8000305E00200002, 0x8000306100200002, 0x8000306400200002,
+		0x8000306800200002, 0x8000306900200002, 0x8000306A00200002, 0x8000306D00200002, 0x8000307000200002, 0x8000307400200002, 0x8000307500200002, 0x8000307B00200002,
+		0x8000307E00200002, 0x8000308100200002, 0x8000308300200002, 0x8000308500200002, 0x8000308900200002, 0x8000308B00200002, 0x8000309200200002, 0x8000309400200002,
+		0x8000309A00200002, 0x800030A100200002, 0x800030A200200002, 0x800030A300200002, 0x800030A400200002, 0x800030A500200002, 0x800030A600200002, 0x800030AB00200002,
+		0x800030AC00200002, 0x800030AD00200002, 0x800030AE00200002, 0x800030AF00200004, 0x800030AF00200002, 0x800030B300200002, 0x800030B500200002, 0x800030B700200002,
+		0x800030BA00200002, 0x800030BF00200002, 0x800030C300200002, 0x800030B600200002, 0x800030C500200002, 0x800030C100200002, 0x8000000000C40002, 0x8000000000E50002,
+		0x8000000000C50002, 0x800030CB00200002, 0x800030CC00200002, 0x8000308400200002, 0x8000308800200002, 0x8000308C00200002, 0x8000309700200002, 0x400000000003BA03,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0x8000029900200002, 0x8000029A00200002, 0x8000040E00200002, 0x8000040F00200002, 0x8000041000200002, 0x8000041100200002,
+		0x8000308F00200002, 0x8000309000200002, 0x800030A700200002, 0x800030A800200002, 0x800030A900200002, 0x800030AA00200002, 0x800030BB00200002, 0x800030BC00200002,
+		0x800030BD00200002, 0x800030BE00200002, 0x8000304100200002, 0x8000304F00200002, 0x8000309C00200002, 0x8000309D00200002, 0x8000307300200002, 0x8000308200200002,
+		0x8000308A00200002, 0x8000305300200002, 0x800030C600200002, 0x800030CD00200002, 0x800030CE00200002, 0x8000309100200002, 0x800030A000200002, 0x800030C700200002,
+		0x800030C800200002, 0x800030CF00200002, 0x800030D000200002, 0x800030D100200002, 0x800030D200200002, 0x800030D300200002, 0x8000306500200002, 0x8000309E00200002,
+		0x8000309F00200002, 0x800030B400200002, 0x800030B100200002, 0x800030B800200002, 0x800030B900200002, 0x8000303600200002, 0x8000303800200002, 0x8000303A00200002,
+		0x8000304300200002, 0x8000304C00200002, 0x8000305600200002, 0x8000306B00200002, 0x8000307100200002, 0x8000307600200002, 0x8000307700200002, 0x8000307C00200002,
+		0x8000308E00200002, 0x8000309500200002, 0x8000308D00200002, 0x800030B000200002, 0x800030C000200002, 0x800030C200200002, 0x800030CA00200002, 0x800030D400200002,
+		0x800030D600200002, 0x800030D900200002, 0x800030DA00200002, 0x800030D500200002, 0x800030D700200002, 0x800030D800200002, 0x8000307900200002, 0x800030DB00200002,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0x800030DC00200002, 0x800030DD00200002, 0x800030B200200002, 0x800030C400200002, 0x8000054B00200002, 0x8000054C00200002,
+		0x8000223B00200008, 0x8000223D00200008, 0x8000223F00200008, 0x8000224100200008, 0x8000224300200008, 0x8000224500200008, 0x8000224700200008, 0x8000224B00200008,
+		0x8000224D00200008, 0x8000224F00200008, 0x8000225100200008, 0x8000225300200008, 0x8000225500200008, 0x8000225900200008, 0x8000225B00200008, 0x8000225D00200008,
+		0x8000225F00200008, 0x8000226100200008, 0x8000226300200008, 0x8000226700200008, 0x8000226900200008, 0x8000226B00200008, 0x8000226D00200008, 0x8000226F00200008,
+		0x8000227100200008, 0x8000227300200008, 0x8000227500200008, 0x8000227700200008, 0x8000227900200008, 0x8000227B00200008, 0x8000227D00200008, 0x8000228100200008,
+		0x8000228300200008, 0x8000224900200008, 0x8000225700200008, 0x8000226500200008, 0x8000227F00200008, 0x8000228500200008, 0, 0x8000228800200008,
+		0, 0, 0, 0, 0, 0x8000228D00200008, 0, 0,
+		0x8000223A00200002, 0x8000223C00200002, 0x8000223E00200002, 0x8000224000200002, 0x8000224200200002, 0x8000224400200002, 0x8000224600200002, 0x8000224A00200002,
+		0x8000224C00200002, 0x8000224E00200002, 0x8000225000200002, 0x8000225200200002, 0x8000225400200002, 0x8000225800200002, 0x8000225A00200002, 0x8000225C00200002,
+		0x8000225E00200002, 0x8000226000200002, 0x8000226200200002, 0x8000226600200002, 0x8000226800200002, 0x8000226A00200002, 0x8000226C00200002, 0x8000226E00200002,
+		0x8000227000200002, 0x8000227200200002, 0x8000227400200002, 0x8000227600200002, 0x8000227800200002, 0x8000227A00200002, 0x8000227C00200002, 0x8000228000200002,
+		0x8000228200200002, 0x8000224800200002, 0x8000225600200002, 0x8000226400200002, 0x8000227E00200002, 0x8000228400200002, 0x8000228600200002, 0x8000228700200002,
+		0x8000228900200002, 0x8000228A00200002, 0x8000228B00200002, 0x800002C600200002, 0x8000225400200014, 0x8000228C00200002, 0x8000228E00200002, 0x8000228F00200002,
+		0x80003BF500200002, 0x80003BF600200002, 0x80003BF700200002, 0x80003BF800200002, 0x80003BF900200002, 0x80003BFA00200002, 0x80003BFB00200002, 0x80003BFC00200002,
+		0x80003BFD00200002, 0x80003BFE00200002, 0x80003BFF00200002, 0x80003C0000200002, 0x80003C0100200002, 0x80003C0200200002, 0x80003C0300200002, 0x80003C0400200002,
+		0x80003C0500200002, 0x80003C0600200002, 0x80003C0700200002, 0x80003C0800200002, 0x80003C0900200002, 0x80003C0A00200002, 0x80003C0B00200002, 0x80003C0C00200002,
+		0x80003C0D00200002, 0x80003C0E00200002, 0x80003C0F00200002, 0x80003C1000200002, 0x80003C1100200002, 0x80003C1200200002, 0x80003C1300200002, 0x80003C1400200002,
+		0x80003C1500200002, 0x80003C1600200002, 0x80003C1700200002, 0x80003C1800200002, 0x80003C1900200002, 0x80003C1A00200002, 0x80003C1B00200002, 0x80003C1C00200002,
+		0x80003C1D00200002, 0x80003C1E00200002, 0x80003C1F00200002, 0x80003C2000200002, 0x80003C2100200002, 0x80003C2200200002, 0x80003C2300200002, 0x80003C2400200002,
+		0x80003C2500200002, 0x80003C2600200002, 0x80003C2700200002, 0x80003C2800200002, 0x80003C2900200002, 0x80003C2A00200002, 0x80003C2B00200002, 0x80003C2C00200002,
+		0x80003C2D00200002, 0x80003C2E00200002, 0x80003C2F00200002, 0x80003C3000200002, 0x80003C3100200002, 0x80003C3200200002, 0x80003C3300200002, 0x80003C3400200002,
+		0x80003C3500200002, 0x80003C3600200002, 0x80003C3700200002, 0x80003C3800200002, 0x80003C3900200002, 0x80003C3A00200002, 0x80003C3B00200002, 0x80003C3C00200002,
+		0x80003C3D00200002, 0x80003C3E00200002, 0x80003C3F00200002, 0x80003C4000200002, 0x80003C4100200002, 0x80003C4200200002, 0x80003C4300200002, 0x80003C4400200002,
+		0x80003C4500200002, 0x80003C4600200002, 0x80003C4700200002, 0x80003C4800200002, 0x80003C4900200002, 0x80003C4A00200002, 0x80003C4B00200002, 0x80003C4C00200002,
+		0x80003C4D00200002, 0x80003C4E00200002, 0x80003C4F00200002, 0x80003C5000200002, 0x80003C5100200002, 0x80003C5200200002, 0x80003C5300200002, 0x80003C7100200002,
+		0x80003C7200200002, 0x80003C7300200002, 0x80003C7400200002, 0x80003C7500200002, 0x80003C7600200002, 0x80003C7700200002, 0x80003C7800200002, 0x80003C7900200002,
+		0x80003C7A00200002, 0x80003C7B00200002, 0x80003C7C00200002, 0x80003C7D00200002, 0x80003C7E00200002, 0x80003C7F00200002, 0x80003C8000200002, 0x80003C8100200002,
+		0x80003C8200200002, 0x80003C8300200002, 0x80003C8400200002, 0x80003C8500200002, 0x80003C8600200002, 0x80003C8700200002, 0x80003C8800200002, 0x80003C8900200002,
+		0x80003C8A00200002, 0x80003C8B00200002, 0x80003C8C00200002, 0x80003C8D00200002, 0x80003C8E00200002, 0x80003C8F00200002, 0x80003C9000200002, 0x80003C9100200002,
+		0x80003C9200200002, 0x80003C9300200002, 0x80003C9400200002, 0x80003C9500200002, 0x80003C9600200002, 0x80003C9700200002, 0x80003C9800200002, 0x80003C9900200002,
+		0x80003C9A00200002, 0x80003C9B00200002, 0x80003C9C00200002, 0x80003C9D00200002, 0x80003C9E00200002, 0x80003C9F00200002, 0x80003CA000200002, 0x80003CA100200002,
+		0x80003CA200200002, 0x80003CA300200002, 0x80003CA400200002, 0x80003CA500200002, 0x80003CA600200002, 0x80003CA700200002, 0x80003CA800200002, 0x80003CA900200002,
+		0x80003CAA00200002, 0x80003CAB00200002, 0x80003CAC00200002, 0x80003CAD00200002, 0x80003CAE00200002, 0x80003CAF00200002, 0x80003CB000200002, 0x80003CB100200002,
+		0x80003CB200200002, 0x80003CB300200002, 0x80003CB400200002, 0x80003CB500200002, 0x80003CB600200002, 0x80003CB700200002, 0x80003CB800200002, 0x80003CB900200002,
+		0x80003CD100200002, 0x80003CD200200002, 0x80003CD300200002, 0x80003CD400200002, 0x80003CD500200002, 0x80003CD600200002, 0x80003CD700200002, 0x80003CD800200002,
+		0x80003CD900200002, 0x80003CDA00200002, 0x80003CDB00200002, 0x80003CDC00200002, 0x80003CDD00200002, 0x80003CDE00200002, 0x80003CDF00200002, 0x80003CE000200002,
+		0x80003CE100200002, 0x80003CE200200002, 0x80003CE300200002, 0x80003CE400200002, 0x80003CE500200002, 0x80003CE600200002, 0x80003CE700200002, 0x80003CE800200002,
+		0x80003CE900200002, 0x80003CEA00200002, 0x80003CEB00200002, 0x80003CEC00200002, 0x80003CED00200002, 0x80003CEE00200002, 0x80003CEF00200002, 0x80003CF000200002,
+		0x80003CF100200002, 0x80003CF200200002, 0x80003CF300200002, 0x80003CF400200002, 0x80003CF500200002, 0x80003CF600200002, 0x80003CF700200002, 0x80003CF800200002,
+		0x80003CF900200002, 0x80003CFA00200002, 0x80003CFB00200002, 0x80003CFC00200002, 0x80003CFD00200002, 0x80003CFE00200002, 0x80003CFF00200002, 0x80003D0000200002,
+		0x80003D0100200002, 0x80003D0200200002, 0x80003D0300200002, 0x80003D0400200002, 0x80003D0500200002, 0x80003D0600200002, 0x80003D0700200002, 0x80003D0800200002,
+		0x80003D0900200002, 0x80003D0A00200002, 0x80003D0B00200002, 0x80003D0C00200002, 0x80003D0D00200002, 0x80003D0E00200002, 0x80003D0F00200002, 0x80003D1000200002,
+		0x80003D1100200002, 0x80003D1200200002, 0x80003D1300200002, 0x80003D1400200002, 0x80003D1500200002, 0x80003D1600200002, 0x80003D1700200002, 0x80003D1800200002,
+		0x80003D1900200002, 0x80003D1A00200002, 0x80003D1B00200002, 0x80003D1C00200002, 0x80003D1D00200002, 0x80003D1E00200002, 0x80003D1F00200002, 0x80003D2000200002,
+		0x80003D2100200002, 0x80003D2200200002, 0x80003D2300200002, 0x80003D2400200002, 0x80003D2500200002, 0x80003D2600200002, 0x80003D2700200002, 0x80003D2800200002,
+		0x8000249600200002, 0x8000249700200002, 0x8000249800200002, 0x8000249900200002, 0x8000249A00200002, 0x8000249B00200002, 0x8000249C00200002, 0x8000249D00200002,
+		0x8000249E00200002, 0x8000249F00200002, 0x800024A000200002, 0x800024A100200002, 0x800024A200200002, 0x800024A300200002, 0x800024A400200002, 0x800024A500200002,
+		0x800024A700200002, 0x800024A800200002, 0x800024A900200002, 0x800024AA00200002, 0x800024AB00200002, 0x800024AC00200002, 0x800024AD00200002, 0x800024AE00200002,
+		0x800024AF00200002, 0x800024B000200002, 0x800024B100200002, 0x800024B200200002, 0x800024B300200002, 0x800024B400200002, 0x800024B500200002, 0x800024B600200002,
+		0x800024BC00200002, 0x800024BD00200002, 0x800024BE00200002, 0x800024BF00200002, 0x800024C000200002, 0x800024C100200002, 0x800024C200200002, 0x800024C300200002,
+		0x800024C400200002, 0x800024C500200002, 0x800024C600200002, 0x800024C700200002, 0x800024C800200002, 0x800024C900200002, 0x800024CA00200002, 0x800024CB00200002,
+		0x800024CD00200002, 0x800024CE00200002, 0x800024CF00200002, 0x800024D000200002, 0x800024D100200002, 0x800024D200200002, 0x800024D300200002, 0x800024D400200002,
+		0x800024DC00200002, 0x800024DD00200002, 0x800024DE00200002, 0x800024DF00200002, 0x800024E000200002, 0x800024E100200002, 0x800024E200200002, 0x800024E300200002,
+		0x800024E500200002, 0x800024E600200002, 0x800024E700200002, 0x800024E800200002, 0x800024E900200002, 0x800024EA00200002, 0x800024EB00200002, 0x800024EC00200002,
+		0x800024ED00200002, 0, 0x800024EE00200002, 0x800024EF00200002, 0x800024F000200002, 0x800024F100200002, 0, 0,
+		0x800024F200200002, 0x800024F300200002, 0x800024F400200002, 0x800024F500200002, 0x800024F600200002, 0x800024F700200002, 0x800024F800200002, 0,
+		0x800024F900200002, 0, 0x800024FA00200002, 0x800024FB00200002, 0x800024FC00200002, 0x800024FD00200002, 0, 0,
+		0x800024FE00200002, 0x800024FF00200002, 0x8000250000200002, 0x8000250100200002, 0x8000250200200002, 0x8000250300200002, 0x8000250400200002, 0x8000250500200002,
+		0x8000250B00200002, 0x8000250C00200002, 0x8000250D00200002, 0x8000250E00200002, 0x8000250F00200002, 0x8000251000200002, 0x8000251100200002, 0x8000251200200002,
+		0x8000251300200002, 0x8000251400200002, 0x8000251500200002, 0x8000251600200002, 0x8000251700200002, 0x8000251800200002, 0x8000251900200002, 0x8000251A00200002,
+		0x8000251C00200002, 0x8000251D00200002, 0x8000251E00200002, 0x8000251F00200002, 0x8000252000200002, 0x8000252100200002, 0x8000252200200002, 0x8000252300200002,
+		0x8000252500200002, 0x8000252600200002, 0x8000252700200002, 0x8000252800200002, 0x8000252900200002, 0x8000252A00200002, 0x8000252B00200002, 0x8000252C00200002,
+		0x8000252D00200002, 0, 0x8000252E00200002, 0x8000252F00200002, 0x8000253000200002, 0x8000253100200002, 0, 0,
+		0x8000253200200002, 0x8000253300200002, 0x8000253400200002, 0x8000253500200002, 0x8000253600200002, 0x8000253700200002, 0x8000253800200002, 0x8000253900200002,
+		0x8000253B00200002, 0x8000253C00200002, 0x8000253D00200002, 0x8000253E00200002, 0x8000253F00200002, 0x8000254000200002, 0x8000254100200002, 0x8000254200200002,
+		0x8000254400200002, 0x8000254500200002, 0x8000254600200002, 0x8000254700200002, 0x8000254800200002, 0x8000254900200002, 0x8000254A00200002, 0x8000254B00200002,
+		0x8000254D00200002, 0x8000254E00200002, 0x8000254F00200002, 0x8000255000200002, 0x8000255100200002, 0x8000255200200002, 0x8000255300200002, 0x8000255400200002,
+		0x8000255500200002, 0, 0x8000255600200002, 0x8000255700200002, 0x8000255800200002, 0x8000255900200002, 0, 0,
+		0x8000255A00200002, 0x8000255B00200002, 0x8000255C00200002, 0x8000255D00200002, 0x8000255E00200002, 0x8000255F00200002, 0x8000256000200002, 0,
+		0x8000256100200002, 0, 0x8000256200200002, 0x8000256300200002, 0x8000256400200002, 0x8000256500200002, 0, 0,
+		0x8000256600200002, 0x8000256700200002, 0x8000256800200002, 0x8000256900200002, 0x8000256A00200002, 0x8000256B00200002, 0x8000256C00200002, 0x8000256D00200002,
+		0x8000256E00200002, 0x8000256F00200002, 0x8000257000200002, 0x8000257100200002, 0x8000257200200002, 0x8000257300200002, 0x8000257400200002, 0,
+		0x8000257500200002, 0x8000257600200002, 0x8000257700200002, 0x8000257800200002, 0x8000257900200002, 0x8000257A00200002, 0x8000257B00200002, 0x8000257C00200002,
+		0x8000258400200002, 0x8000258500200002, 0x8000258600200002, 0x8000258700200002, 0x8000258800200002, 0x8000258900200002, 0x8000258A00200002, 0x8000258B00200002,
+		0x8000258C00200002, 0x8000258D00200002, 0x8000258E00200002, 0x8000258F00200002, 0x8000259000200002, 0x8000259100200002, 0x8000259200200002, 0x8000259300200002,
+		0x8000259400200002, 0x8000259500200002, 0x8000259600200002, 0x8000259700200002, 0x8000259800200002, 0x8000259900200002, 0x8000259A00200002, 0x8000259B00200002,
+		0x800025A300200002, 0x800025A400200002, 0x800025A500200002, 0x800025A600200002, 0x800025A700200002, 0x800025A800200002, 0x800025A900200002, 0x800025AA00200002,
+		0x800025AC00200002, 0x800025AD00200002, 0x800025AE00200002, 0x800025AF00200002, 0x800025B000200002, 0x800025B100200002, 0x800025B200200002, 0x800025B300200002,
+		0x800025B500200002, 0x800025B600200002, 0x800025B700200002, 0x800025B800200002, 0x800025B900200002, 0x800025BA00200002, 0x800025BB00200002, 0x800025BC00200002,
+		0x800025BD00200002, 0, 0x800025BE00200002, 0x800025BF00200002, 0x800025C000200002, 0x800025C100200002, 0, 0,
+		0x800025C200200002, 0x800025C300200002, 0x800025C400200002, 0x800025C500200002, 0x800025C600200002, 0x800025C700200002, 0x800025C800200002, 0x800025C900200002,
+		0x800025CE00200002, 0x800025CF00200002, 0x800025D000200002, 0x800025D100200002, 0x800025D200200002, 0x800025D300200002, 0x800025D400200002, 0x800025D500200002,
+		0x800025D700200002, 0x800025D800200002, 0x800025D900200002, 0x800025DA00200002, 0x800025DB00200002, 0x800025DC00200002, 0x800025DD00200002, 0x800025DE00200002,
+		0x800025E700200002, 0x800025E800200002, 0x800025E900200002, 0x800025EA00200002, 0x800025EB00200002, 0x800025EC00200002, 0x800025ED00200002, 0x800025EE00200002,
+		0x800025F000200002, 0x800025F100200002, 0x800025F200200002, 0x800025F300200002, 0x800025F400200002, 0x800025F500200002, 0x800025F600200002, 0x800025F700200002,
+		0x800025FF00200002, 0x8000260000200002, 0x8000260100200002, 0x8000260200200002, 0x8000260300200002, 0x8000260400200002, 0x8000260500200002, 0x8000260600200002,
+		0x8000260700200002, 0x8000260800200002, 0x8000260900200002, 0x8000260A00200002, 0x8000260B00200002, 0x8000260C00200002, 0x8000260D00200002, 0x8000260E00200002,
+		0x8000261300200002, 0x8000261400200002, 0x8000261500200002, 0x8000261600200002, 0x8000261700200002, 0x8000261800200002, 0x8000261900200002, 0x8000261A00200002,
+		0x8000262000200002, 0x8000262100200002, 0x8000262200200002, 0, 0, 0x8000000000B50002, 0x8000000000B40002, 0x8000000000B30002,
+		0x800002C700200002, 0x8000025100200002, 0x8000027C00200002, 0x8000025200200002, 0x8000025300200002, 0x8000025400200002, 0x8000025500200002, 0x8000026C00200002,
+		0x800002C800200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0x80001ABB00200002, 0x80001ABC00200002, 0x80001ABD00200002, 0x80001ABE00200002, 0x80001ABF00200002, 0x80001AC000200002,
+		0x80001AC100200002, 0x80001AC200200002, 0x80001AC300200002, 0x80001AC400200002, 0x80001AC500200002, 0, 0, 0,
+		0x800024B700200002, 0x800024B800200002, 0x800024B900200002, 0x800024BA00200002, 0x8000250600200002, 0x8000250700200002, 0x8000250800200002, 0x8000250900200002,
+		0x8000260F00200002, 0x8000261000200002, 0x8000261100200002, 0x8000261200200002, 0x8000261B00200002, 0x8000261C00200002, 0x8000261D00200002, 0x8000261E00200002,
+		0x800004C600200002, 0x800004C700200002, 0x800004C800200002, 0x800004C900200002, 0x800004CA00200002, 0x800004CB00200002, 0x800004CC00200002, 0x800004CD00200002,
+		0x800004CE00200002, 0x800004CF00200002, 0, 0, 0, 0, 0, 0,
+		0x8000337F00200008, 0x8000338000200008, 0x8000338100200008, 0x8000338200200008, 0x8000338300200008, 0x8000338400200008, 0x8000338500200008, 0x8000338600200008,
+		0x8000338700200008, 0x8000338800200008, 0x8000338900200008, 0x8000338A00200008, 0x8000338B00200008, 0x8000338C00200008, 0x8000338D00200008, 0x8000338E00200008,
+		0x8000338F00200008, 0x8000339000200008, 0x8000339100200008, 0x8000339200200008, 0x8000339300200008, 0x8000339400200008, 0x8000339500200008, 0x8000339600200008,
+		0x8000339700200008, 0x8000339800200008, 0x8000339900200008, 0x8000339A00200008, 0x8000339B00200008, 0x8000339C00200008, 0x8000339D00200008, 0x8000339E00200008,
+		0x8000339F00200008, 0x800033A000200008, 0x800033A100200008, 0x800033A200200008, 0x800033A300200008, 0x800033A400200008, 0x800033A500200008, 0x800033A600200008,
+		0x800033A700200008, 0x800033A800200008, 0x800033A900200008, 0x800033AA00200008, 0x800033AB00200008, 0x800033AC00200008, 0x800033AD00200008, 0x800033AE00200008,
+		0x800033AF00200008, 0x800033B000200008, 0x800033B100200008, 0x800033B200200008, 0x800033B300200008, 0x800033B400200008, 0x800033B500200008, 0x800033B600200008,
+		0x800033B700200008, 0x800033B800200008, 0x800033B900200008, 0x800033BA00200008, 0x800033BB00200008, 0x800033BC00200008, 0x800033BD00200008, 0x800033BE00200008,
+		0x800033BF00200008, 0x800033C000200008, 0x800033C100200008, 0x800033C200200008, 0x800033C300200008, 0x800033C400200008, 0x800033C500200008, 0x800033C600200008,
+		0x800033C700200008, 0x800033C800200008, 0x800033C900200008, 0x800033CA00200008, 0x800033CB00200008, 0x800033CC00200008, 0x800033CD00200008, 0x800033CE00200008,
+		0x800033CF00200008, 0x800033D000200008, 0x800033D100200008, 0x800033D200200008, 0x800033D300200008, 0x800033D400200008, 0, 0,
+		0x800033CF00200002, 0x800033D000200002, 0x800033D100200002, 0x800033D200200002, 0x800033D300200002, 0x800033D400200002, 0, 0,
+		0x8000020F00200002, 0x800033F900200002, 0x800033FA00200002, 0x800033FB00200002, 0x800033FC00200002, 0x800033FD00200002, 0x800033FE00200002, 0x800033FF00200002,
+		0x8000340000200002, 0x8000340100200002, 0x8000340200200002, 0x8000340300200002, 0x8000340400200002, 0x8000340500200002, 0x8000340600200002, 0x8000340700200002,
+		0x8000340800200002, 0x8000340900200002, 0x8000340A00200002, 0x8000340B00200002, 0x8000340C00200002, 0x8000340D00200002, 0x8000340E00200002, 0x8000340F00200002,
+		0x8000341000200002, 0x8000341100200002, 0x8000341200200002, 0x8000341300200002, 0x8000341400200002, 0x8000341500200002, 0x8000341600200002, 0x8000341700200002,
+		0x8000341800200002, 0x8000341900200002, 0x8000341A00200002, 0x8000341B00200002, 0x8000341C00200002, 0x8000341D00200002, 0x8000341E00200002, 0x8000341F00200002,
+		0x8000342000200002, 0x8000342100200002, 0x8000342200200002, 0x8000342300200002, 0x8000342400200002, 0x8000342500200002, 0x8000342600200002, 0x8000342700200002,
+		0x8000342800200002, 0x8000342900200002, 0x8000342A00200002, 0x8000342B00200002, 0x8000342C00200002, 0x8000342D00200002, 0x8000342E00200002, 0x8000342F00200002,
+		0x8000343000200002, 0x8000343100200002, 0x8000343200200002, 0x8000343300200002, 0x8000343400200002, 0x8000343500200002, 0x8000343600200002, 0x8000343700200002,
+		0x8000343800200002, 0x8000343900200002, 0x8000343A00200002, 0x8000343B00200002, 0x8000343C00200002, 0x8000343D00200002, 0x8000343E00200002, 0x8000343F00200002,
+		0x8000344000200002, 0x8000344100200002, 0x8000344200200002, 0x8000344300200002, 0x8000344400200002, 0x8000344500200002, 0x8000344600200002, 0x8000344700200002,
+		0x8000344800200002, 0x8000344900200002, 0x8000344A00200002, 0x8000344B00200002, 0x8000344C00200002, 0x8000344D00200002, 0x8000344E00200002, 0x8000344F00200002,
+		0x8000345000200002, 0x8000345100200002, 0x8000345200200002, 0x8000345300200002, 0x8000345400200002, 0x8000345500200002, 0x8000345600200002, 0x8000345700200002,
+		0x8000345800200002, 0x8000345900200002, 0x8000345A00200002, 0x8000345B00200002, 0x8000345C00200002, 0x8000345D00200002, 0x8000345E00200002, 0x8000345F00200002,
+		0x8000346000200002, 0x8000346100200002, 0x8000346200200002, 0x8000346300200002, 0x8000346400200002, 0x8000346500200002, 0x8000346600200002, 0x8000346700200002,
+		0x8000346800200002, 0x8000346900200002, 0x8000346A00200002, 0x8000346B00200002, 0x8000346C00200002, 0x8000346D00200002, 0x8000346E00200002, 0x8000346F00200002,
+		0x8000347000200002, 0x8000347100200002, 0x8000347200200002, 0x8000347300200002, 0x8000347400200002, 0x8000347500200002, 0x8000347600200002, 0x8000347700200002,
+		0x8000347800200002, 0x8000347900200002, 0x8000347A00200002, 0x8000347B00200002, 0x8000347C00200002, 0x8000347D00200002, 0x8000347E00200002, 0x8000347F00200002,
+		0x8000348000200002, 0x8000348100200002, 0x8000348200200002, 0x8000348300200002, 0x8000348400200002, 0x8000348500200002, 0x8000348600200002, 0x8000348700200002,
+		0x8000348800200002, 0x8000348900200002, 0x8000348A00200002, 0x8000348B00200002, 0x8000348C00200002, 0x8000348D00200002, 0x8000348E00200002, 0x8000348F00200002,
+		0x8000349000200002, 0x8000349100200002, 0x8000349200200002, 0x8000349300200002, 0x8000349400200002, 0x8000349500200002, 0x8000349600200002, 0x8000349700200002,
+		0x8000349800200002, 0x8000349900200002, 0x8000349A00200002, 0x8000349B00200002, 0x8000349C00200002, 0x8000349D00200002, 0x8000349E00200002, 0x8000349F00200002,
+		0x800034A000200002, 0x800034A100200002, 0x800034A200200002, 0x800034A300200002, 0x800034A400200002, 0x800034A500200002, 0x800034A600200002, 0x800034A700200002,
+		0x800034A800200002, 0x800034A900200002, 0x800034AA00200002, 0x800034AB00200002, 0x800034AC00200002, 0x800034AD00200002, 0x800034AE00200002, 0x800034AF00200002,
+		0x800034B000200002, 0x800034B100200002, 0x800034B200200002, 0x800034B300200002, 0x800034B400200002, 0x800034B500200002, 0x800034B600200002, 0x800034B700200002,
+		0x800034B800200002, 0x800034B900200002, 0x800034BA00200002, 0x800034BB00200002, 0x800034BC00200002, 0x800034BD00200002, 0x800034BE00200002, 0x800034BF00200002,
+		0x800034C000200002, 0x800034C100200002, 0x800034C200200002, 0x800034C300200002, 0x800034C400200002, 0x800034C500200002, 0x800034C600200002, 0x800034C700200002,
+		0x800034C800200002, 0x800034C900200002, 0x800034CA00200002, 0x800034CB00200002, 0x800034CC00200002, 0x800034CD00200002, 0x800034CE00200002, 0x800034CF00200002,
+		0x800034D000200002, 0x800034D100200002, 0x800034D200200002, 0x800034D300200002, 0x800034D400200002, 0x800034D500200002, 0x800034D600200002, 0x800034D700200002,
+		0x800034D800200002, 0x800034D900200002, 0x800034DA00200002, 0x800034DB00200002, 0x800034DC00200002, 0x800034DD00200002, 0x800034DE00200002, 0x800034DF00200002,
+		0x800034E000200002, 0x800034E100200002, 0x800034E200200002, 0x800034E300200002, 0x800034E400200002, 0x800034E500200002, 0x800034E600200002, 0x800034E700200002,
+		0x800034E800200002, 0x800034E900200002, 0x800034EA00200002, 0x800034EB00200002, 0x800034EC00200002, 0x800034ED00200002, 0x800034EE00200002, 0x800034EF00200002,
+		0x800034F000200002, 0x800034F100200002, 0x800034F200200002, 0x800034F300200002, 0x800034F400200002, 0x800034F500200002, 0x800034F600200002, 0x800034F700200002,
+		0x800034F800200002, 0x800034F900200002, 0x800034FA00200002, 0x800034FB00200002, 0x800034FC00200002, 0x800034FD00200002, 0x800034FE00200002, 0x800034FF00200002,
+		0x8000350000200002, 0x8000350100200002, 0x8000350200200002, 0x8000350300200002, 0x8000350400200002, 0x8000350500200002, 0x8000350600200002, 0x8000350700200002,
+		0x8000350800200002, 0x8000350900200002, 0x8000350A00200002, 0x8000350B00200002, 0x8000350C00200002, 0x8000350D00200002, 0x8000350E00200002, 0x8000350F00200002,
+		0x8000351000200002, 0x8000351100200002, 0x8000351200200002, 0x8000351300200002, 0x8000351400200002, 0x8000351500200002, 0x8000351600200002, 0x8000351700200002,
+		0x8000351800200002, 0x8000351900200002, 0x8000351A00200002, 0x8000351B00200002, 0x8000351C00200002, 0x8000351D00200002, 0x8000351E00200002, 0x8000351F00200002,
+		0x8000352000200002, 0x8000352100200002, 0x8000352200200002, 0x8000352300200002, 0x8000352400200002, 0x8000352500200002, 0x8000352600200002, 0x8000352700200002,
+		0x8000352800200002, 0x8000352900200002, 0x8000352A00200002, 0x8000352B00200002, 0x8000352C00200002, 0x8000352D00200002, 0x8000352E00200002, 0x8000352F00200002,
+		0x8000353000200002, 0x8000353100200002, 0x8000353200200002, 0x8000353300200002, 0x8000353400200002, 0x8000353500200002, 0x8000353600200002, 0x8000353700200002,
+		0x8000353800200002, 0x8000353900200002, 0x8000353A00200002, 0x8000353B00200002, 0x8000353C00200002, 0x8000353D00200002, 0x8000353E00200002, 0x8000353F00200002,
+		0x8000354000200002, 0x8000354100200002, 0x8000354200200002, 0x8000354300200002, 0x8000354400200002, 0x8000354500200002, 0x8000354600200002, 0x8000354700200002,
+		0x8000354800200002, 0x8000354900200002, 0x8000354A00200002, 0x8000354B00200002, 0x8000354C00200002, 0x8000354D00200002, 0x8000354E00200002, 0x8000354F00200002,
+		0x8000355000200002, 0x8000355100200002, 0x8000355200200002, 0x8000355300200002, 0x8000355400200002, 0x8000355500200002, 0x8000355600200002, 0x8000355700200002,
+		0x8000355800200002, 0x8000355900200002, 0x8000355A00200002, 0x8000355B00200002, 0x8000355C00200002, 0x8000355D00200002, 0x8000355E00200002, 0x8000355F00200002,
+		0x8000356000200002, 0x8000356100200002, 0x8000356200200002, 0x8000356300200002, 0x8000356400200002, 0x8000356500200002, 0x8000356600200002, 0x8000356700200002,
+		0x8000356800200002, 0x8000356900200002, 0x8000356A00200002, 0x8000356B00200002, 0x8000356C00200002, 0x8000356D00200002, 0x8000356E00200002, 0x8000356F00200002,
+		0x8000357000200002, 0x8000357100200002, 0x8000357200200002, 0x8000357300200002, 0x800035A600200002, 0x8000357400200002, 0x8000357600200002, 0x8000357700200002,
+		0x8000357800200002, 0x8000357900200002, 0x8000357A00200002, 0x8000357B00200002, 0x8000357C00200002, 0x8000357D00200002, 0x8000357E00200002, 0x8000357F00200002,
+		0x8000358000200002, 0x8000358100200002, 0x8000358200200002, 0x8000358300200002, 0x8000358400200002, 0x8000358500200002, 0x8000358700200002, 0x8000358800200002,
+		0x8000358900200002, 0x8000358A00200002, 0x8000358B00200002, 0x8000358C00200002, 0x8000358D00200002, 0x8000358E00200002, 0x8000359500200002, 0x8000359600200002,
+		0x8000359700200002, 0x8000359800200002, 0x8000359900200002, 0x8000359A00200002, 0x8000359B00200002, 0x8000359C00200002, 0x8000359D00200002, 0x8000359E00200002,
+		0x8000359F00200002, 0x800035A000200002, 0x800035A100200002, 0x800035A200200002, 0x800035A300200002, 0x800035A400200002, 0x800035A500200002, 0x800035A700200002,
+		0x800035A800200002, 0x800035A900200002, 0x800035AA00200002, 0x800035AB00200002, 0x800035AC00200002, 0x800035AD00200002, 0x800035AE00200002, 0x800035AF00200002,
+		0x800035B000200002, 0x800035B100200002, 0x800035B200200002, 0x800035B300200002, 0x800035B400200002, 0x800035B500200002, 0x800035B600200002, 0x800035B700200002,
+		0x800035B800200002, 0x800035B900200002, 0x800035BA00200002, 0x800035BB00200002, 0x800035BC00200002, 0x800035BD00200002, 0x800035BE00200002, 0x800035BF00200002,
+		0x800035C000200002, 0x800035C100200002, 0x800035C200200002, 0x800035C300200002, 0x800035C400200002, 0x800035C500200002, 0x800035C600200002, 0x800035C700200002,
+		0x800035C800200002, 0x800035C900200002, 0x800035CA00200002, 0x800035CB00200002, 0x800035CC00200002, 0x800035CD00200002, 0x800035CE00200002, 0x800035CF00200002,
+		0x800035D000200002, 0x800035D100200002, 0x800035D200200002, 0x800035D300200002, 0x800035D400200002, 0x800035D500200002, 0x800035D600200002, 0x800035D700200002,
+		0x800035D800200002, 0x800035D900200002, 0x800035DA00200002, 0x800035DB00200002, 0x800035DC00200002, 0x800035DD00200002, 0x800035DE00200002, 0x800035DF00200002,
+		0x800035E000200002, 0x800035E100200002, 0x800035E200200002, 0x800035E300200002, 0x800035E400200002, 0x800035E500200002, 0x800035E600200002, 0x800035E700200002,
+		0x800035E800200002, 0x800035E900200002, 0x800035EA00200002, 0x800035EB00200002, 0x800035EC00200002, 0x800035ED00200002, 0x800035EE00200002, 0x800035EF00200002,
+		0x800035F000200002, 0x800035F100200002, 0x800035F200200002, 0x800035F300200002, 0x800035F400200002, 0x800035F500200002, 0x800035F600200002, 0x800035F700200002,
+		0x800035F800200002, 0x800035F900200002, 0x800035FA00200002, 0x800035FB00200002, 0x800035FC00200002, 0x800035FD00200002, 0x800035FE00200002, 0x800035FF00200002,
+		0x8000360000200002, 0x8000360100200002, 0x8000360200200002, 0x8000360300200002, 0x8000360400200002, 0x8000360500200002, 0x8000360600200002, 0x8000360700200002,
+		0x8000360800200002, 0x8000360900200002, 0x8000360A00200002, 0x8000360B00200002, 0x8000360C00200002, 0x8000360D00200002, 0x8000360E00200002, 0x8000360F00200002,
+		0x8000361000200002, 0x8000361100200002, 0x8000361200200002, 0x8000361300200002, 0x8000361400200002, 0x8000361500200002, 0x8000361600200002, 0x8000361700200002,
+		0x8000361800200002, 0x8000361900200002, 0x8000361A00200002, 0x8000361B00200002, 0x8000361C00200002, 0x8000361D00200002, 0x8000361E00200002, 0x8000361F00200002,
+		0x8000362000200002, 0x8000362100200002, 0x8000362200200002, 0x8000362300200002, 0x8000362400200002, 0x8000362500200002, 0x8000362600200002, 0x8000362700200002,
+		0x8000362800200002, 0x8000362900200002, 0x8000362A00200002, 0x8000362B00200002, 0x8000362C00200002, 0x8000362D00200002, 0x8000362E00200002, 0x8000362F00200002,
+		0x8000363000200002, 0x8000363100200002, 0x8000363200200002, 0x8000363300200002, 0x8000363400200002, 0x8000363500200002, 0x8000363600200002, 0x8000363700200002,
+		0x8000363800200002, 0x8000363900200002, 0x8000363A00200002, 0x8000363B00200002, 0x8000363C00200002, 0x8000363D00200002, 0x8000363E00200002, 0x8000363F00200002,
+		0x8000364000200002, 0x8000364100200002, 0x8000364200200002, 0x8000364300200002, 0x8000364400200002, 0x8000364500200002, 0x8000364600200002, 0x8000364700200002,
+		0x8000364800200002, 0x8000364900200002, 0x8000364A00200002, 0x8000364B00200002, 0x8000364C00200002, 0x8000364D00200002, 0x8000364E00200002, 0x8000364F00200002,
+		0x8000365000200002, 0x8000365100200002, 0x8000365200200002, 0x8000365300200002, 0x8000365400200002, 0x8000365500200002, 0x8000365600200002, 0x8000365700200002,
+		0x8000365800200002, 0x8000365900200002, 0x8000365A00200002, 0x8000365B00200002, 0x8000365C00200002, 0x8000365D00200002, 0x8000365E00200002, 0x8000365F00200002,
+		0x8000366000200002, 0x8000366100200002, 0x8000366200200002, 0x8000366300200002, 0x8000366400200002, 0x8000366500200002, 0x8000366600200002, 0x8000366700200002,
+		0x8000366800200002, 0x8000366900200002, 0x8000366A00200002, 0x8000366B00200002, 0x8000366C00200002, 0x8000041E00200002, 0x8000027F00200002, 0x8000357500200002,
+		0x8000358600200002, 0x8000358F00200002, 0x8000359000200002, 0x8000359100200002, 0x8000359200200002, 0x8000359300200002, 0x8000359400200002, 0x8000366D00200002,
+		0x8000366E00200002, 0x8000366F00200002, 0x8000367000200002, 0x8000367100200002, 0x8000367200200002, 0x8000367300200002, 0x8000367400200002, 0x8000367500200002,
+		0x8000020900200004, 0x800036BC00200002, 0x800036BD00200002, 0x800036BE00200002, 0x800036BF00200002, 0x800036C000200002, 0x800036C100200002, 0x800036C200200002,
+		0x800036C300200002, 0x800036C400200002, 0x800036C500200002, 0x800036C600200002, 0x800036C700200002, 0x800036C800200002, 0x800036C900200002, 0x800036CA00200002,
+		0x800036CB00200002, 0x800036CC00200002, 0x800036CD00200002, 0x800036CE00200002, 0x800036CF00200002, 0x800036D000200002, 0x800036D100200002, 0x800036D200200002,
+		0x800036D300200002, 0x800036D400200002, 0x800036D500200002, 0x8000032100200002, 0x8000032200200002, 0, 0, 0,
+		0x800036D600200002, 0x400000000003BD02, 0x800036D700200002, 0x800036FB00200002, 0x400000000003BF02, 0x400000000003C102, 0x800036D800200002, 0x400000000003C302,
+		0x800036D900200002, 0x400000000003C502, 0x800036F700200002, 0x800036F900200002, 0x400000000003C702, 0x400000000003C902, 0x400000000003CB02, 0x800036DB00200002,
+		0x800036DC00200002, 0x800036DD00200002, 0x800036DE00200002, 0x400000000003CD02, 0x400000000003CF02, 0x400000000003D102, 0x400000000003D302, 0x800036E000200002,
+		0x800036FE00200002, 0x800036E100200002, 0x800036E200200002, 0x400000000003D502, 0x400000000003D702, 0x400000000003D902, 0x800036E300200002, 0x400000000003DB02,
+		0x400000000003DD02, 0x800036E400200002, 0x400000000003DF02, 0x800036E600200002, 0x400000000003E102, 0x800036E700200002, 0x400000000003E302, 0x800036E800200002,
+		0x800036E900200002, 0x800036EA00200002, 0x800036EB00200002, 0x400000000003E502, 0x400000000003E702, 0x400000000003E902, 0x400000000003EB02, 0x800036ED00200002,
+		0x400000000003ED02, 0x400000000003EF02, 0x800036EE00200002, 0x400000000003F102, 0x400000000003F302, 0x400000000003F502, 0x800036EF00200002, 0x800036F100200002,
+		0x400000000003F702, 0x400000000003F902, 0x800036F200200002, 0x400000000003FB02, 0x800036F300200002, 0x400000000003FD02, 0x800036F400200002, 0x800036F500200002,
+		0x800036FC00200002, 0x8000370000200002, 0x8000370100200002, 0x800036FD00200002, 0x800036FF00200002, 0x8000370200200002, 0x8000370300200002, 0x400000000003FF02,
+		0x4000000000040102, 0x4000000000040302, 0x4000000000040502, 0x8000025C00200002, 0x8000025D00200002, 0x8000025E00200002, 0x4000000000040702, 0x4000000000040904,
+		0x4000000000040D02, 0x800036DF00200002, 0x800036EC00200002, 0x800036F600200002, 0x800036DA00200002, 0x800036E500200002, 0x800036F000200002, 0x800036F800200002,
+		0x800036FA00200002, 0, 0, 0, 0, 0, 0, 0,
+		0x80002F6600200002, 0x80002F6700200002, 0x80002F6800200002, 0x80002F6900200002, 0x80002F6A00200002, 0x80002F6B00200002, 0x80002F6C00200002, 0x80002F6D00200002,
+		0x80002F6E00200002, 0x80002F6F00200002, 0x80002F7000200002, 0x80002F7100200002, 0x80002F7200200002, 0, 0x80002F7300200002, 0x80002F7400200002,
+		0x80002F7500200002, 0x80002F7600200002, 0x80002F7700200002, 0x80002F7800200002, 0x80002F7900200002, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80002F7A00200002, 0x80002F7B00200002, 0x80002F7C00200002, 0x80002F7D00200002, 0x80002F7E00200002, 0x80002F7F00200002, 0x80002F8000200002, 0x80002F8100200002,
+		0x80002F8200200002, 0x80002F8300200002, 0x80002F8400200002, 0x80002F8500200002, 0x80002F8600200002, 0x80002F8700200002, 0x80002F8800200002, 0x80002F8900200002,
+		0x80002F8A00200002, 0x80002F8B00200002, 0x80002F8C00200002, 0x80002F8D00200002, 0x80002F8E00200002, 0x8000029600200002, 0x8000029700200002, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80002F8F00200002, 0x80002F9000200002, 0x80002F9100200002, 0x80002F9200200002, 0x80002F9300200002, 0x80002F9400200002, 0x80002F9500200002, 0x80002F9600200002,
+		0x80002F9700200002, 0x80002F9800200002, 0x80002F9900200002, 0x80002F9A00200002, 0x80002F9B00200002, 0x80002F9C00200002, 0x80002F9D00200002, 0x80002F9E00200002,
+		0x80002F9F00200002, 0x80002FA000200002, 0x80002FA100200002, 0x80002FA200200002, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80002FA300200002, 0x80002FA400200002, 0x80002FA500200002, 0x80002FA600200002, 0x80002FA700200002, 0x80002FA800200002, 0x80002FA900200002, 0x80002FAA00200002,
+		0x80002FAB00200002, 0x80002FAC00200002, 0x80002FAD00200002, 0x80002FAE00200002, 0x80002FAF00200002, 0, 0x80002FB000200002, 0x80002FB100200002,
+		0x80002FB200200002, 0, 0x80002FB300200002, 0x80002FB400200002, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000311600200002, 0x8000311700200002, 0x8000311800200002, 0x8000311900200002, 0x8000311A00200002, 0x8000311B00200002, 0x8000311C00200002, 0x8000311D00200002,
+		0x8000311E00200002, 0x8000311F00200002, 0x8000312000200002, 0x8000312100200002, 0x8000312200200002, 0x8000312300200002, 0x8000312400200002, 0x8000312500200002,
+		0x8000312600200002, 0x8000312700200002, 0x8000312800200002, 0x8000312900200002, 0x8000312A00200002, 0x8000312B00200002, 0x8000312C00200002, 0x8000312D00200002,
+		0x8000312E00200002, 0x8000312F00200002, 0x8000313000200002, 0x8000313100200002, 0x8000313200200002, 0x8000313300200002, 0x8000313400200002, 0x8000313500200002,
+		0x8000313600200002, 0x8000313700200002, 0x8000313800200002, 0x8000313A00200002, 0x8000313B00200002, 0x8000313C00200002, 0x8000313D00200002, 0x8000313E00200002,
+		0x8000313F00200002, 0x8000314000200002, 0x8000314100200002, 0x8000314200200002, 0x8000314300200002, 0x8000314400200002, 0x8000314500200002, 0x8000314600200002,
+		0x8000314700200002, 0x8000314800200002, 0x8000314900200002, 0x8000314A00200002, 0x8000000000000000, 0x8000000000000000, 0x8000314B00200002, 0x8000314C00200002,
+		0x8000314D00200002, 0x8000314E00200002, 0x8000314F00200002, 0x8000315000200002, 0x8000315100200002, 0x8000315200200002, 0x8000315300200002, 0x8000315400200002,
+		0x8000315500200002, 0x8000315600200002, 0x8000315700200002, 0x8000315800200002, 0x8000315900200002, 0x8000315A00200002, 0x8000000000C40002, 0x8000000000C50002,
+		0x8000000000E60002, 0x8000000000E70002, 0x8000000000E80002, 0x8000000000330002, 0x8000000000330002, 0x8000000000330002, 0x8000000000330002, 0x8000000000330002,
+		0x8000000000330002, 0x8000000000330002, 0x8000315B00200002, 0x8000000000000000, 0x8000029B00200002, 0x8000029C00200002, 0x8000025900200002, 0x80001BFD00200002,
+		0x8000041200200002, 0x8000041300200002, 0x8000041400200002, 0x80001C1E00200002, 0x8000313900200002, 0x8000000000330002, 0, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0, 0, 0, 0, 0, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0, 0, 0, 0, 0, 0,
+		0x800003D900200002, 0x8000027800200002, 0x8000022C00200002, 0x8000027D00200002, 0x8000025600200002, 0x8000025700200002, 0x8000021100200002, 0x8000021200200002,
+		0x8000022D00200002, 0x8000027E00200002, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0, 0, 0, 0, 0, 0,
+		0x800032E000200002, 0x800032E200200002, 0x800032E500200002, 0x800032EB00200002, 0x800032ED00200002, 0x800032F000200002, 0x800032F200200002, 0x800032F500200002,
+		0x800032F600200002, 0x800032F700200002, 0x800032FC00200002, 0x800032FE00200002, 0x8000330100200002, 0x8000330300200002, 0x8000330800200002, 0x8000330A00200002,
+		0x8000330B00200002, 0x8000330C00200002, 0x8000331300200002, 0x8000331600200002, 0x8000331900200002, 0x8000331E00200002, 0x8000332200200002, 0x8000332500200002,
+		0x8000332700200002, 0x8000332900200002, 0x8000332C00200002, 0x8000333100200002, 0x8000333200200002, 0x8000333500200002, 0x8000333900200002, 0x8000333C00200002,
+		0x8000333D00200002, 0x8000333E00200002, 0x8000333F00200002, 0x800032DF00200002, 0x800032E300200002, 0x800032E600200002, 0x800032EC00200002, 0x800032EE00200002,
+		0x800032F100200002, 0x800032F300200002, 0x800032F800200002, 0x800032FD00200002, 0x800032FF00200002, 0x8000330200200002, 0x8000330400200002, 0x8000330900200002,
+		0x8000331400200002, 0x8000331700200002, 0x8000331A00200002, 0x8000331F00200002, 0x8000333300200002, 0x8000332300200002, 0x8000332800200002, 0x8000332D00200002,
+		0x8000333700200002, 0x8000333A00200002, 0x8000334000200002, 0x8000334100200002, 0x8000331C00200002, 0x800032E400200002, 0x800032E700200002, 0x800032EA00200002,
+		0x800032F400200002, 0x800032EF00200002, 0x800032F900200002, 0x8000332E00200002, 0x8000330500200002, 0x8000330700200002, 0x8000330000200002, 0x8000330D00200002,
+		0x8000331500200002, 0x8000331800200002, 0x8000332000200002, 0x8000332A00200002, 0x8000333800200002, 0x8000333B00200002, 0x8000333400200002, 0x8000333600200002,
+		0x8000334200200002, 0x8000331B00200002, 0x8000332400200002, 0x800032E800200002, 0x8000332F00200002, 0x8000332600200002, 0x8000332B00200002, 0x8000332100200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800032D800200002, 0x800032D900200002, 0x800032DA00200002, 0x800032DB00200002, 0x800032DC00200002, 0x800032DD00200002, 0x800032DE00200002, 0x800032E100200002,
+		0x800032E900200002, 0x8000333000200002, 0x800032FA00200002, 0x8000331D00200002, 0x8000334300200002, 0x8000334500200002, 0x8000334600200002, 0x8000334800200002,
+		0x8000334900200002, 0x8000334C00200002, 0x8000334E00200002, 0x8000334F00200002, 0x8000335100200002, 0x8000335300200002, 0x8000335500200002, 0x8000335600200002,
+		0x8000334A00200002, 0x8000335400200002, 0x8000330600200002, 0x800032FB00200002, 0x8000330E00200002, 0x8000330F00200002, 0x8000334400200002, 0x8000334700200002,
+		0x8000334B00200002, 0x8000334D00200002, 0x8000331000200002, 0x8000335200200002, 0x8000331100200002, 0x8000331200200002, 0x8000335700200002, 0x8000335800200002,
+		0x8000335000200002, 0x8000335A00200002, 0x8000335900200002, 0, 0, 0, 0, 0,
+		0x8000367600200002, 0x8000367700200002, 0x8000367800200002, 0x8000367900200002, 0x8000367A00200002, 0x8000367B00200002, 0x8000367C00200002, 0x8000367D00200002,
+		0x8000367E00200002, 0x8000367F00200002, 0x8000368000200002, 0x8000368100200002, 0x8000368200200002, 0x8000368300200002, 0x8000368400200002, 0x8000368500200002,
+		0x8000368600200002, 0x8000368700200002, 0x8000368800200002, 0x8000368900200002, 0x8000368A00200002, 0x8000368B00200002, 0x8000368C00200002, 0x8000368D00200002,
+		0x8000368E00200002, 0x8000368F00200002, 0x8000369000200002, 0x8000369100200002, 0x8000369200200002, 0x8000369300200002, 0x8000369400200002, 0x8000369500200002,
+		0x8000369600200002, 0x8000369700200002, 0x8000369800200002, 0x8000369900200002, 0x8000369A00200002, 0x8000369B00200002, 0x8000369C00200002, 0x8000369D00200002,
+		0x8000369E00200002, 0x8000369F00200002, 0x800036A000200002, 0x800036A100200002, 0x800036A200200002, 0x800036A300200002, 0x800036A400200002, 0x800036A500200002,
+		0x800036A600200002, 0x800036A700200002, 0x800036A800200002, 0x800036A900200002, 0x800036AA00200002, 0x800036AB00200002, 0x800036AC00200002, 0x800036AD00200002,
+		0x800036AE00200002, 0x800036AF00200002, 0x800036B000200002, 0x800036B100200002, 0x800036B200200002, 0x800036B300200002, 0x800036B400200002, 0x800036B500200002,
+		0x800036B600200002, 0x800036B700200002, 0x800036B800200002, 0x800036B900200002, 0x800036BA00200002, 0x800036BB00200002, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80002F3400200002, 0x80002F3500200002, 0x80002F3600200002, 0x80002F3700200002, 0x80002F3800200002, 0x80002F3900200002, 0x80002F3A00200002, 0x80002F3B00200002,
+		0x80002F3C00200002, 0x80002F3D00200002, 0x80002F3E00200002, 0x80002F3F00200002, 0x80002F4000200002, 0x80002F4100200002, 0x80002F4200200002, 0x80002F4300200002,
+		0x80002F4400200002, 0x80002F4500200002, 0x80002F4600200002, 0x80002F4700200002, 0x80002F4800200002, 0x80002F4900200002, 0x80002F4A00200002, 0x80002F4B00200002,
+		0x80002F4C00200002, 0x80002F4D00200002, 0x80002F4E00200002, 0x80002F4F00200002, 0x80002F5000200002, 0x4000000000040F02, 0x4000000000041102, 0,
+		0x80002F5100200002, 0x80002F5200200002, 0x80002F5300200002, 0x80002F5400200002, 0x80002F5500200002, 0x80002F5600200002, 0x80002F5700200002, 0x80002F5800200002,
+		0x80002F5900200002, 0x80002F5A00200002, 0x80002F5B00200002, 0x80002F5C00200002, 0, 0, 0, 0,
+		0x80002F5D00200002, 0x80002F5E00200002, 0x80002F5F00200002, 0x80002F6000200002, 0x80002F6100200002, 0x80002F6200200002, 0x80002F6300200002, 0x80002F6400200002,
+		0x80002F6500200002, 0x8000000000F10002, 0x8000000000F20002, 0x8000000000F30002, 0, 0, 0, 0,
+		0x8000054A00200002, 0, 0, 0, 0x8000026400200002, 0x8000026D00200002, 0x80001C3D00200002, 0x80001C3E00200002,
+		0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002, 0x80001C4600200002,
+		0x8000315C00200002, 0x8000315D00200002, 0x8000315E00200002, 0x8000315F00200002, 0x8000316000200002, 0x8000316100200002, 0x8000316200200002, 0x8000316300200002,
+		0x8000316400200002, 0x8000316500200002, 0x8000316600200002, 0x8000316700200002, 0x8000316800200002, 0x8000316900200002, 0x8000316A00200002, 0x8000316B00200002,
+		0x8000316C00200002, 0x8000316D00200002, 0x8000316E00200002, 0x8000316F00200002, 0x8000317000200002, 0x8000317100200002, 0x8000317200200002, 0x8000317300200002,
+		0x8000317400200002, 0x8000317500200002, 0x8000317600200002, 0x8000317700200002, 0x8000317800200002, 0x8000317900200002, 0, 0,
+		0x8000317A00200002, 0x8000317B00200002, 0x8000317C00200002, 0x8000317D00200002, 0x8000317E00200002, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000317F00200002, 0x8000318000200002, 0x8000318100200002, 0x8000318200200002, 0x8000318300200002, 0x8000318400200002, 0x8000318500200002, 0x8000318600200002,
+		0x8000318700200002, 0x8000318800200002, 0x8000318900200002, 0x8000318A00200002, 0x8000318B00200002, 0x8000318C00200002, 0x8000318D00200002, 0x8000318E00200002,
+		0x8000318F00200002, 0x8000319000200002, 0x8000319100200002, 0x8000319200200002, 0x8000319300200002, 0x8000319400200002, 0x8000319500200002, 0x8000319600200002,
+		0x8000319700200002, 0x8000319800200002, 0x8000319900200002, 0x8000319A00200002, 0x8000319B00200002, 0x8000319C00200002, 0x8000319D00200002, 0x8000319E00200002,
+		0x8000319F00200002, 0x800031A000200002, 0x800031A100200002, 0x800031A200200002, 0x800031A300200002, 0x800031A400200002, 0x800031A500200002, 0x800031A600200002,
+		0x800031A700200002, 0x800031A800200002, 0x800031A900200002, 0x800031AA00200002, 0, 0, 0, 0,
+		0x800031AB00200002, 0x800031AC00200002, 0x800031AD00200002, 0x800031AE00200002, 0x800031AF00200002, 0x800031B000200002, 0x800031B100200002, 0x800031B200200002,
+		0x800031B300200002, 0x800031B400200002, 0x800031B500200002, 0x800031B600200002, 0x800031B700200002, 0x800031B800200002, 0x800031B900200002, 0x800031BA00200002,
+		0x800031BB00200002, 0x800031BC00200002, 0x800031BD00200002, 0x800031BE00200002, 0x800031BF00200002, 0x800031C000200002, 0x800031C100200002, 0x800031C200200002,
+		0x800031C300200002, 0x800031C400200002, 0, 0, 0, 0, 0, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0x80001C3E00200002, 0, 0, 0, 0x4000000000041302, 0x4000000000041503,
+		0x8000055100200002, 0x8000055200200002, 0x8000055300200002, 0x8000055400200002, 0x8000055500200002, 0x8000055600200002, 0x8000055700200002, 0x8000055800200002,
+		0x8000055900200002, 0x8000055A00200002, 0x8000055B00200002, 0x8000055C00200002, 0x8000055D00200002, 0x8000055E00200002, 0x8000055F00200002, 0x8000056000200002,
+		0x8000056100200002, 0x8000056200200002, 0x8000056300200002, 0x8000056400200002, 0x8000056500200002, 0x8000056600200002, 0x8000056700200002, 0x8000056800200002,
+		0x8000056900200002, 0x8000056A00200002, 0x8000056B00200002, 0x8000056C00200002, 0x8000056D00200002, 0x8000056E00200002, 0x8000056F00200002, 0x8000057000200002,
+		0x80002FB500200002, 0x80002FB600200002, 0x80002FB700200002, 0x80002FB800200002, 0x80002FB900200002, 0x80002FBA00200002, 0x80002FBB00200002, 0x80002FBC00200002,
+		0x80002FBD00200002, 0x80002FBE00200002, 0x80002FBF00200002, 0x80002FC000200002, 0x80002FC100200002, 0x80002FC200200002, 0x80002FC300200002, 0x80002FC400200002,
+		0x80002FC500200002, 0x80002FC600200002, 0x80002FC700200002, 0x80002FC800200002, 0x80002FC900200002, 0x80002FCA00200002, 0x80002FCB00200002, 0x80002FCC00200002,
+		0x80002FCD00200002, 0x80002FCE00200002, 0x80002FCF00200002, 0x80002FD000200002, 0, 0, 0x800002C900200002, 0x800002CA00200002,
+		0x800031C500200002, 0x800031C600200002, 0x800031C700200002, 0x800031C800200002, 0x800031C900200002, 0x800031CA00200002, 0x800031CB00200002, 0x800031CC00200002,
+		0x800031CD00200002, 0x800031CE00200002, 0x800031CF00200002, 0x800031D000200002, 0x800031D100200002, 0x800031D200200002, 0x800031D300200002, 0x800031D400200002,
+		0x800031D500200002, 0x800031D600200002, 0x800031D700200002, 0x800031D800200002, 0x800031D900200002, 0x800031DA00200002, 0x800031DB00200002, 0x800031DC00200002,
+		0x800031DD00200002, 0x800031DE00200002, 0x800031DF00200002, 0x800031E000200002, 0x800031E100200002, 0x800031E200200002, 0x800031E300200002, 0x800031E400200002,
+		0x800031E500200002, 0x800031E600200002, 0x800031E700200002, 0x800031E800200002, 0x800031E900200002, 0x800031EA00200002, 0x800031EB00200002, 0x800031EC00200002,
+		0x800031ED00200002, 0x800031EE00200002, 0x800031EF00200002, 0x800031F000200002, 0x800031F100200002, 0x800031FA00200002, 0x800031FB00200002, 0x800031FC00200002,
+		0x800031FD00200002, 0x800031FE00200002, 0x800031FF00200002, 0x800031F200200002, 0x4000000000041803, 0x800031F400200002, 0x800031F500200002, 0x800031F600200002,
+		0x800031CB00200004, 0x800031CB00200004, 0x800031E000200004, 0x800031E000200004, 0x800031F700200002, 0x800031F800200002, 0x800031F900200002, 0,
+		0x8000321100200002, 0x8000320000200002, 0x8000320200200002, 0x8000320300200002, 0x8000320300200004, 0x8000320400200002, 0x8000320500200002, 0x8000320600200002,
+		0x8000320700200002, 0x8000320800200002, 0x8000320900200002, 0x800031F300200002, 0x8000320100200002, 0x8000321000200002, 0x8000320A00200002, 0x8000320B00200002,
+		0x8000320D00200002, 0x8000320E00200002, 0x8000320F00200002, 0x8000320C00200002, 0x8000000000C40002, 0x8000000000E90002, 0x8000000000EA0002, 0x8000000000EB0002,
+		0x8000000000EC0002, 0x8000000000ED0002, 0x8000000000EE0002, 0x8000000000EF0002, 0x8000000000F00002, 0, 0, 0x8000000000000000,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0, 0, 0, 0, 0, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0, 0, 0, 0, 0, 0,
+		0x8000041500200002, 0x8000041600200002, 0x8000041700200002, 0x8000041800200002, 0x8000041900200002, 0x8000041A00200002, 0x8000041B00200002, 0x80001BFE00200002,
+		0x8000029D00200002, 0x8000029E00200002, 0x8000029F00200002, 0x800002A000200002, 0x8000041C00200002, 0x8000041D00200002, 0, 0,
+		0x8000000000330002, 0x8000000000330002, 0x8000000000330002, 0x8000000000330002, 0x8000000000330002, 0x8000000000340002, 0x8000000000340002, 0x8000000000340002,
+		0x8000000000340002, 0x8000000000340002, 0x8000000000340002, 0x8000000000330002, 0x8000000000330002, 0x8000000000340002, 0x8000000000360002, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000000000C30002, 0x8000000000C30002, 0x8000000000C40002, 0x8000000000C80002, 0x8000000000C50002, 0x8000325700200002, 0x8000325800200002, 0x8000325900200002,
+		0x8000325A00200002, 0x8000325B00200002, 0x8000325C00200002, 0x8000325D00200002, 0x8000325E00200002, 0x8000325F00200002, 0x8000326000200002, 0x8000326100200002,
+		0x8000326200200002, 0x8000326300200002, 0x8000326400200002, 0x8000326500200002, 0x8000326800200002, 0x8000326900200002, 0x8000326A00200002, 0x8000326B00200002,
+		0x8000326C00200002, 0x8000326D00200002, 0x8000326E00200002, 0x8000326F00200002, 0x8000327000200002, 0x8000327100200002, 0x8000327200200002, 0x8000327300200002,
+		0x8000327400200002, 0x8000327500200002, 0x8000327600200002, 0x8000327800200002, 0x8000327900200002, 0x8000327A00200002, 0x8000327B00200002, 0x8000327C00200002,
+		0x8000327E00200002, 0x8000327F00200002, 0x8000328000200002, 0x8000328100200002, 0x8000328200200002, 0x8000328300200002, 0x8000328400200002, 0x8000328500200002,
+		0x8000328700200002, 0x8000328800200002, 0x8000328900200002, 0x8000328C00200002, 0x8000000000C20002, 0x8000328D00200002, 0x8000328E00200002, 0x8000328F00200002,
+		0x8000329000200002, 0x8000329100200002, 0x8000329200200002, 0x8000329300200002, 0x8000329400200002, 0x8000329500200002, 0x8000329600200002, 0x8000329700200002,
+		0x8000329800200002, 0x8000329900200002, 0x8000329A00200002, 0x8000329B00200002, 0x8000329C00200002, 0x8000326600200002, 0x8000326700200002, 0x8000327700200002,
+		0x8000327D00200002, 0x8000328600200002, 0x8000328A00200002, 0x8000328B00200002, 0, 0, 0, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0x800002CB00200002, 0x800002CC00200002, 0x8000028000200002, 0x8000025A00200002, 0x800002A100200002, 0x800002A200200002,
+		0x8000021000200002, 0x8000057100200002, 0x8000057200200002, 0x8000057300200002, 0x8000057400200002, 0x8000057500200002, 0x8000057600200002, 0x8000057700200002,
+		0x8000057800200002, 0x8000057900200002, 0x8000057A00200002, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000057B00200002, 0x8000057C00200002, 0x8000057D00200002, 0x8000057E00200002,
+		0x8000057F00200002, 0x8000058000200002, 0x8000058100200002, 0x8000058200200002, 0x8000058300200002, 0, 0, 0,
+		0x8000000000C40002, 0x8000000000CA0002, 0x8000000000C50002, 0x80002C9800200002, 0x80002C9900200002, 0x80002C9A00200002, 0x80002C9B00200002, 0x80002C9C00200002,
+		0x80002C9D00200002, 0x80002C9E00200002, 0x80002C9F00200002, 0x80002CA100200002, 0x80002CA200200002, 0x80002CA300200002, 0x80002CA400200002, 0x80002CA500200002,
+		0x80002CA600200002, 0x80002CA700200002, 0x80002CA800200002, 0x80002CA900200002, 0x80002CAA00200002, 0x80002CAB00200002, 0x80002CAC00200002, 0x80002CAD00200002,
+		0x80002CAE00200002, 0x80002CB000200002, 0x80002CB200200002, 0x80002CB400200002, 0x80002CB700200002, 0x80002CBA00200002, 0x80002CBC00200002, 0x80002CBD00200002,
+		0x80002CBF00200002, 0x80002CB300200002, 0x80002CB500200002, 0x80002CB800200002, 0x80002CC000200002, 0x80002CC100200002, 0x80002CC200200002, 0x80002CC300200002,
+		0x80002CC400200002, 0x80002CC500200002, 0x80002CC600200002, 0x80002CC700200002, 0x80002CB100200002, 0x80002CBB00200002, 0x80002CA000200002, 0x80002CBE00200002,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0x80002C9800200004, 0x80002CB600200002, 0x80002CB900200002, 0x80002CAF00200002, 0x80002C9F00200019, 0x80002CB000200019,
+		0x80002FD100200002, 0x80002FD100200004, 0x80002FD200200002, 0x80002FD200200004, 0x80002FD200200004, 0x80002FD300200002, 0x80002FD300200004, 0x80002FD400200002,
+		0x80002FD400200004, 0x80002FD500200002, 0x80002FD500200004, 0x80002FD600200002, 0x80002FD600200004, 0x80002FD600200004, 0x80002FD700200002, 0x80002FD700200004,
+		0x80002FD800200002, 0x80002FD900200002, 0x80002FDA00200002, 0x80002FDA00200004, 0x80002FDB00200002, 0x80002FDB00200004, 0x80002FDC00200002, 0x80002FDC00200004,
+		0x80002FDD00200002, 0x80002FDD00200004, 0x80002FDD00200004, 0x80002FDE00200002, 0x80002FDE00200004, 0x80002FDF00200002, 0x80002FE000200002, 0x80002FE000200004,
+		0x80002FE100200002, 0x80002FE200200002, 0x80002FE300200002, 0x80002FE400200002, 0x80002FE500200002, 0x80002FE600200002, 0x8000000000C20002, 0x80002FE700200002,
+		0x80002FE700200004, 0x80002FE800200002, 0x80002FE900200002, 0x80002FE900200004, 0x80002FEA00200002, 0x80002FEA00200004, 0x80002FEB00200002, 0x80002FEB00200004,
+		0x80002FEC00200002, 0x80002FED00200002, 0x80002FEE00200002, 0x80002FEF00200002, 0, 0, 0, 0,
+		0, 0, 0, 0, 0x8000042A00200002, 0x8000042B00200002, 0x8000042C00200002, 0x8000042D00200002,
+		0x80002EC600200002, 0x80002EC700200002, 0x80002EC800200002, 0x80002EC900200002, 0x80002ECA00200002, 0x80002ECB00200002, 0x80002ECC00200002, 0x80002ECD00200002,
+		0x80002ECE00200002, 0x80002ECF00200002, 0x80002ED300200002, 0x80002ED400200002, 0x80002ED500200002, 0x80002ED600200002, 0x80002ED700200002, 0x80002ED800200002,
+		0x80002ED900200002, 0x80002EDA00200002, 0x80002EDB00200002, 0x80002EDC00200002, 0x80002EDD00200002, 0x80002EDE00200002, 0x80002EDF00200002, 0x80002EE000200002,
+		0x80002EE100200002, 0x80002EE200200002, 0x80002EE300200002, 0x80002EE500200002, 0x80002EE700200002, 0x80002EE800200002, 0x80002EE900200002, 0x80002EEA00200002,
+		0x80002EEB00200002, 0x80002EEC00200002, 0x80002EED00200002, 0x80002EEE00200002, 0x80002EE400200002, 0x80002EE600200002, 0x80002EF000200002, 0x80002EF100200002,
+		0x80002EF200200002, 0x80002EF300200002, 0x80002EF400200002, 0x80002EF500200002, 0x80002EF600200002, 0x80002EF700200002, 0x80002EF800200002, 0x80002EF900200002,
+		0x80002EFA00200002, 0x80002EFB00200002, 0x80002EFC00200002, 0x80002EFD00200002, 0x80002EFE00200002, 0x80002EFF00200002, 0x80002EEF00200002, 0x8000000000C20002,
+		0, 0, 0, 0x8000029200200002, 0x8000029300200002, 0x8000040B00200002, 0x8000040C00200002, 0x8000040D00200002,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0, 0, 0, 0x80002ED000200002, 0x80002ED100200002, 0x80002ED200200002,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0x8000335B00200002, 0x8000335C00200002, 0x8000335D00200002, 0x8000335E00200002, 0x8000335F00200002, 0x8000336000200002,
+		0x8000336100200002, 0x8000336200200002, 0x8000336300200002, 0x8000336400200002, 0x8000336500200002, 0x8000336600200002, 0x8000336700200002, 0x8000336800200002,
+		0x8000336900200002, 0x8000336A00200002, 0x8000336B00200002, 0x8000336C00200002, 0x8000336D00200002, 0x8000336E00200002, 0x8000336F00200002, 0x8000337000200002,
+		0x8000337100200002, 0x8000337200200002, 0x8000337300200002, 0x8000337400200002, 0x8000337500200002, 0x8000337600200002, 0x8000337700200002, 0x8000337800200002,
+		0x8000337900200002, 0x8000337A00200002, 0x8000337B00200002, 0x8000337C00200002, 0x8000337D00200002, 0x8000337E00200002, 0x800002C200200002, 0x800002C300200002,
+		0x8000203200200004, 0x8000204A00200004, 0x800020E700200004, 0x8000210500200004, 0x8000210E00200004, 0x8000210E00200004, 0x8000218F00200004, 0x800021A000200004,
+		0x8000212900200004, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000041F00200002, 0x8000042000200002, 0x8000042100200002, 0x8000042200200002, 0x8000042300200002, 0x8000042400200002, 0x8000042500200002, 0x8000042600200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x800026A100200002, 0x800026A100200004, 0x800026A100200004, 0x800026A100200004, 0x8000000000C40002, 0x800026A100200004, 0x800026A100200004,
+		0x800026A100200004, 0x800026A100200004, 0x8000000000C50002, 0x8000000000C50002, 0x8000000000000000, 0x800026A200200002, 0x800026A300200002, 0,
+		0x8000000000000000, 0x8000000000000000, 0, 0, 0, 0, 0, 0,
+		0x80001C4B00200002, 0x80001C4E00200002, 0x80001C4F00200002, 0x80001C6D00200002, 0x80001C7E00200002, 0x80001C9300200002, 0x80001C9400200002, 0x80001CAE00200002,
+		0x80001CD400200002, 0x80001D4000200002, 0x80001D5400200002, 0x80001D6900200002, 0x80001D8000200002, 0x80001DAE00200002, 0x80001DC200200002, 0x80001DE100200002,
+		0x80001DF400200002, 0x80001DE200200002, 0x80001DF500200002, 0x80001DEE00200002, 0x80001DE800200002, 0x80001E0B00200002, 0x80001DFA00200002, 0x80001DFB00200002,
+		0x80001E1000200002, 0x80001E3E00200002, 0x80001E4800200002, 0x80001E9900200002, 0x80001EB900200002, 0x80001EBB00200002, 0x80001EBC00200002, 0x80001ED900200002,
+		0x80001EE700200002, 0x80001EF900200002, 0x80001F2500200002, 0x80001F4200200002, 0x80001F8A00200002, 0x80001F8B00200002, 0x80001FBC00200002, 0x80001FCA00200002,
+		0x80001FD000200002, 0x80001FD500200002, 0x80001FE000200002, 0x800020B400200002, 0x80001C470020001D, 0x4000000000041B03, 0x80001C600020001D, 0x80001C6C00200002,
+		0x80001C8F0020001D, 0x80001CAA0020001D, 0x80001CB80020001D, 0x80001CF40020001D, 0x80001D180020001D, 0x80001D320020001D, 0x80001D4C0020001D, 0x80001D650020001D,
+		0x80001D770020001D, 0x80001DAA0020001D, 0x80001DB90020001D, 0x80001DC100200002, 0x80001DDD0020001D, 0x80001E070020001D, 0x80001E0C0020001D, 0x80001E330020001D,
+		0x80001E950020001D, 0x80001EB50020001D, 0x80001EF50020001D, 0x80001C4700200014, 0x80001C5100200014, 0x80001C5500200014, 0x80001C4F00200014, 0x80001C6000200014,
+		0x80001C8F00200014, 0x80001CAA00200014, 0x80001CBD00200014, 0x80001CC200200014, 0x80001CD400200014, 0x80001CF400200014, 0x80001D4000200014, 0x80001D6500200014,
+		0x80001DAA00200014, 0x80001DD800200014, 0x80001DDD00200014, 0x80001DF000200014, 0x80001DFA00200014, 0x80001DFB00200014, 0x80001E0C00200014, 0x80001E9500200014,
+		0x80001EB500200014, 0x80001EBB00200014, 0x80001ED400200014, 0x80001EE300200014, 0x80001F8B00200014, 0x80001FBA00200014, 0x80001FBB00200014, 0x80001FBD00200014,
+		0x80001FDD00200014, 0x80001FDE00200014, 0x80001D3200200015, 0x80001E3300200015, 0x80001EB500200015, 0x80001EE300200015, 0x80001FBA00200015, 0x80001FBB00200015,
+		0x80001FD400200015, 0x80001FDD00200015, 0x80001FDE00200015, 0x80001EBD00200002, 0x80001C6E00200002, 0x80001C9500200002, 0x80001CEC00200002, 0x80001DAF00200002,
+		0x80001DC300200002, 0x80001E1300200002, 0x80001E4300200002, 0x80001E6000200002, 0x80001E7600200002, 0x80001E9F00200002, 0x80001F2A00200002, 0x80001D0E00200002,
+		0x800020CC00200014, 0x4000000000041E02, 0x4000000000042003, 0x80001D4500200002, 0x80001D4B00200002, 0x80001E1100200002, 0x80001EC500200002, 0x80001EE200200002,
+		0x80001C7000200002, 0x80001C9600200002, 0x80001CED00200002, 0x80001D0500200002, 0x80001D6A00200002, 0x80001D9200200002, 0x80001DB000200002, 0x80001DCD00200002,
+		0x80001E1400200002, 0x80001E4D00200002, 0x80001E7700200002, 0x80001E8700200002, 0x80001EE900200002, 0x80001F0300200002, 0x80001F2B00200002, 0x80001C4D00200002,
+		0x80001C5A00200002, 0x80001C9F00200002, 0x80001CB500200002, 0x80001CC600200002, 0x80001CD300200002, 0x80001CC100200002, 0x80001D4600200002, 0x80001DF700200002,
+		0x80001E9000200002, 0x80001EC600200002, 0x80001F4700200002, 0x80001C5B00200014, 0x80001C7A00200014, 0x80001C8900200014, 0x4000000000042302, 0x80001CCF00200014,
+		0x80001CE500200014, 0x80001D5D00200014, 0x80001CF800200014, 0x80001EC800200014, 0x80001D4100200014, 0x80001D4700200014, 0x80001D3A00200014, 0x80001D4500200014,
+		0x80001D5900200014, 0x80001D9300200014, 0x80001D9200200014, 0x80001D7B00200014, 0x80001DB100200014, 0x80001EDA00200014, 0x80001DC400200014, 0x80001DCE00200014,
+		0x80001DBD00200014, 0x80001DFD00200014, 0x80001E1C00200014, 0x80001E7800200014, 0x80001E8200200014, 0x80001EA000200014, 0x80001EC000200014, 0x80001EDE00200014,
+		0x80001EB900200014, 0x80001EEA00200014, 0x80001EF100200014, 0x80001F2100200014, 0x80001F3000200014, 0x80001F3400200014, 0x80001F3E00200014, 0x80001FC500200014,
+		0x8000000000330002, 0x8000000000330002, 0x8000000000340002, 0x8000000000330002, 0x8000000000330002, 0x8000000000330002, 0x8000000000330002, 0x8000000000330002,
+		0x8000000000330002, 0x8000000000330002, 0x80001E3300200004, 0x8000000000330002, 0x8000000000330002, 0x8000000000330002, 0x8000000000330002, 0x8000000000340002,
+		0x8000000000340002, 0x8000000000330002, 0x80001F6000200004, 0x4000000000042502, 0x4000000000042703, 0x4000000000042A02, 0x4000000000042C02, 0x4000000000042E02,
+		0x4000000000043002, 0x4000000000043202, 0x80001CF400200004, 0x80001CFD00200004, 0x80001D6500200004, 0x80001D7700200004, 0x80001D7B00200004, 0x80001DAE00200004,
+		0x80001DB900200004, 0x80001DBD00200004, 0x80001E3800200004, 0x80001E3D00200004, 0x80001E7100200004, 0x4000000000043402, 0x80001F2100200004, 0x80001C5500200004,
+		0x80001C6000200004, 0x80001C7900200004, 0x80001CBD00200004, 0x80001CE500200004, 0x80001D8B00200004, 0x4000000000043602, 0x80001E0C00200004, 0x80001E8200200004,
+		0x4000000000043802, 0x80001EF500200004, 0x4000000000043A02, 0x4000000000043C02, 0x4000000000043E02, 0x8000000000330002, 0, 0,
+		0, 0, 0, 0x8000000000330002, 0x8000000000340002, 0x8000000000340002, 0x8000000000330002, 0x8000000000340002,
+		0x4000000000044002, 0x4000000000044202, 0x4000000000044402, 0x4000000000044602, 0x4000000000044802, 0x4000000000044A02, 0x4000000000044C02, 0x4000000000044E02,
+		0x4000000000045003, 0x4000000000045303, 0x4000000000045602, 0x4000000000045802, 0x4000000000045A02, 0x4000000000045C02, 0x4000000000045E02, 0x4000000000046002,
+		0x4000000000046202, 0x4000000000046402, 0x4000000000046602, 0x4000000000046802, 0x4000000000046A03, 0x4000000000046D03, 0x4000000000047003, 0x4000000000047303,
+		0x4000000000047602, 0x4000000000047802, 0x4000000000047A02, 0x4000000000047C02, 0x4000000000047E03, 0x4000000000048103, 0x4000000000048402, 0x4000000000048602,
+		0x4000000000048802, 0x4000000000048A02, 0x4000000000048C02, 0x4000000000048E02, 0x4000000000049002, 0x4000000000049202, 0x4000000000049402, 0x4000000000049602,
+		0x4000000000049802, 0x4000000000049A02, 0x4000000000049C02, 0x4000000000049E02, 0x400000000004A002, 0x400000000004A202, 0x400000000004A403, 0x400000000004A703,
+		0x400000000004AA02, 0x400000000004AC02, 0x400000000004AE02, 0x400000000004B002, 0x400000000004B202, 0x400000000004B402, 0x400000000004B602, 0x400000000004B802,
+		0x400000000004BA03, 0x400000000004BD03, 0x400000000004C002, 0x400000000004C202, 0x400000000004C402, 0x400000000004C602, 0x400000000004C802, 0x400000000004CA02,
+		0x400000000004CC02, 0x400000000004CE02, 0x400000000004D002, 0x400000000004D202, 0x400000000004D402, 0x400000000004D602, 0x400000000004D802, 0x400000000004DA02,
+		0x400000000004DC02, 0x400000000004DE02, 0x400000000004E002, 0x400000000004E202, 0x400000000004E403, 0x400000000004E703, 0x400000000004EA03, 0x400000000004ED03,
+		0x400000000004F003, 0x400000000004F303, 0x400000000004F603, 0x400000000004F903, 0x400000000004FC02, 0x400000000004FE02, 0x4000000000050002, 0x4000000000050202,
+		0x4000000000050402, 0x4000000000050602, 0x4000000000050802, 0x4000000000050A02, 0x4000000000050C03, 0x4000000000050F03, 0x4000000000051202, 0x4000000000051402,
+		0x4000000000051602, 0x4000000000051802, 0x4000000000051A02, 0x4000000000051C02, 0x4000000000051E03, 0x4000000000052103, 0x4000000000052403, 0x4000000000052703,
+		0x4000000000052A03, 0x4000000000052D03, 0x4000000000053002, 0x4000000000053202, 0x4000000000053402, 0x4000000000053602, 0x4000000000053802, 0x4000000000053A02,
+		0x4000000000053C02, 0x4000000000053E02, 0x4000000000054002, 0x4000000000054202, 0x4000000000054402, 0x4000000000054602, 0x4000000000054802, 0x4000000000054A02,
+		0x4000000000054C03, 0x4000000000054F03, 0x4000000000055203, 0x4000000000055503, 0x4000000000055802, 0x4000000000055A02, 0x4000000000055C02, 0x4000000000055E02,
+		0x4000000000056002, 0x4000000000056202, 0x4000000000056402, 0x4000000000056602, 0x4000000000056802, 0x4000000000056A02, 0x4000000000056C02, 0x4000000000056E02,
+		0x4000000000057002, 0x4000000000057202, 0x4000000000057402, 0x4000000000057602, 0x4000000000057802, 0x4000000000057A02, 0x4000000000057C02, 0x4000000000057E02,
+		0x4000000000058002, 0x4000000000058202, 0x4000000000058402, 0x4000000000058602, 0x4000000000058802, 0x4000000000058A02, 0x4000000000058C02, 0x4000000000058E02,
+		0x4000000000059002, 0x4000000000059202, 0x4000000000059402, 0x4000000000059603, 0x80001E8000200002, 0x80001E8100200002, 0x4000000000059903, 0x80001CA900200002,
+		0x4000000000059C02, 0x4000000000059E02, 0x400000000005A002, 0x400000000005A202, 0x400000000005A403, 0x400000000005A703, 0x400000000005AA03, 0x400000000005AD03,
+		0x400000000005B003, 0x400000000005B303, 0x400000000005B603, 0x400000000005B903, 0x400000000005BC03, 0x400000000005BF03, 0x400000000005C203, 0x400000000005C503,
+		0x400000000005C803, 0x400000000005CB03, 0x400000000005CE03, 0x400000000005D103, 0x400000000005D403, 0x400000000005D703, 0x400000000005DA03, 0x400000000005DD03,
+		0x400000000005E002, 0x400000000005E202, 0x400000000005E402, 0x400000000005E602, 0x400000000005E802, 0x400000000005EA02, 0x400000000005EC03, 0x400000000005EF03,
+		0x400000000005F203, 0x400000000005F503, 0x400000000005F803, 0x400000000005FB03, 0x400000000005FE03, 0x4000000000060103, 0x4000000000060403, 0x4000000000060703,
+		0x4000000000060A02, 0x4000000000060C02, 0x4000000000060E02, 0x4000000000061002, 0x4000000000061202, 0x4000000000061402, 0x4000000000061602, 0x4000000000061802,
+		0x4000000000061A03, 0x4000000000061D03, 0x4000000000062003, 0x4000000000062303, 0x4000000000062603, 0x4000000000062903, 0x4000000000062C03, 0x4000000000062F03,
+		0x4000000000063203, 0x4000000000063503, 0x4000000000063803, 0x4000000000063B03, 0x4000000000063E03, 0x4000000000064103, 0x4000000000064403, 0x4000000000064703,
+		0x4000000000064A03, 0x4000000000064D03, 0x4000000000065003, 0x4000000000065303, 0x4000000000065602, 0x4000000000065802, 0x4000000000065A02, 0x4000000000065C02,
+		0x4000000000065E03, 0x4000000000066103, 0x4000000000066403, 0x4000000000066703, 0x4000000000066A03, 0x4000000000066D03, 0x4000000000067003, 0x4000000000067303,
+		0x4000000000067603, 0x4000000000067903, 0x4000000000067C02, 0x4000000000067E02, 0x4000000000068002, 0x4000000000068202, 0x4000000000068402, 0x4000000000068602,
+		0x4000000000068802, 0x4000000000068A02, 0x4000000000068C02, 0x4000000000068E02, 0x80001EF000200008, 0x80001EF000200002, 0x80001F1B00200008, 0x80001F1B00200002,
+		0x4000000000069002, 0x4000000000069202, 0x4000000000069403, 0x4000000000069703, 0x4000000000069A03, 0x4000000000069D03, 0x400000000006A003, 0x400000000006A303,
+		0x400000000006A602, 0x400000000006A802, 0x400000000006AA03, 0x400000000006AD03, 0x400000000006B003, 0x400000000006B303, 0x400000000006B603, 0x400000000006B903,
+		0x400000000006BC02, 0x400000000006BE02, 0x400000000006C003, 0x400000000006C303, 0x400000000006C603, 0x400000000006C903, 0, 0,
+		0x400000000006CC02, 0x400000000006CE02, 0x400000000006D003, 0x400000000006D303, 0x400000000006D603, 0x400000000006D903, 0, 0,
+		0x400000000006DC02, 0x400000000006DE02, 0x400000000006E003, 0x400000000006E303, 0x400000000006E603, 0x400000000006E903, 0x400000000006EC03, 0x400000000006EF03,
+		0x400000000006F202, 0x400000000006F402, 0x400000000006F603, 0x400000000006F903, 0x400000000006FC03, 0x400000000006FF03, 0x4000000000070203, 0x4000000000070503,
+		0x4000000000070802, 0x4000000000070A02, 0x4000000000070C03, 0x4000000000070F03, 0x4000000000071203, 0x4000000000071503, 0x4000000000071803, 0x4000000000071B03,
+		0x4000000000071E02, 0x4000000000072002, 0x4000000000072203, 0x4000000000072503, 0x4000000000072803, 0x4000000000072B03, 0x4000000000072E03, 0x4000000000073103,
+		0x4000000000073402, 0x4000000000073602, 0x4000000000073803, 0x4000000000073B03, 0x4000000000073E03, 0x4000000000074103, 0, 0,
+		0x4000000000074402, 0x4000000000074602, 0x4000000000074803, 0x4000000000074B03, 0x4000000000074E03, 0x4000000000075103, 0, 0,
+		0x4000000000075402, 0x4000000000075602, 0x4000000000075803, 0x4000000000075B03, 0x4000000000075E03, 0x4000000000076103, 0x4000000000076403, 0x4000000000076703,
+		0, 0x4000000000076A02, 0, 0x4000000000076C03, 0, 0x4000000000076F03, 0, 0x4000000000077203,
+		0x4000000000077502, 0x4000000000077702, 0x4000000000077903, 0x4000000000077C03, 0x4000000000077F03, 0x4000000000078203, 0x4000000000078503, 0x4000000000078803,
+		0x4000000000078B02, 0x4000000000078D02, 0x4000000000078F03, 0x4000000000079203, 0x4000000000079503, 0x4000000000079803, 0x4000000000079B03, 0x4000000000079E03,
+		0x400000000007A102, 0x400000000007A302, 0x400000000007A502, 0x400000000007A702, 0x400000000007A902, 0x400000000007AB02, 0x400000000007AD02, 0x400000000007AF02,
+		0x400000000007B102, 0x400000000007B302, 0x400000000007B502, 0x400000000007B702, 0x400000000007B902, 0x400000000007BB02, 0, 0,
+		0x400000000007BD03, 0x400000000007C003, 0x400000000007C304, 0x400000000007C704, 0x400000000007CB04, 0x400000000007CF04, 0x400000000007D304, 0x400000000007D704,
+		0x400000000007DB03, 0x400000000007DE03, 0x400000000007E104, 0x400000000007E504, 0x400000000007E904, 0x400000000007ED04, 0x400000000007F104, 0x400000000007F504,
+		0x400000000007F903, 0x400000000007FC03, 0x400000000007FF04, 0x4000000000080304, 0x4000000000080704, 0x4000000000080B04, 0x4000000000080F04, 0x4000000000081304,
+		0x4000000000081703, 0x4000000000081A03, 0x4000000000081D04, 0x4000000000082104, 0x4000000000082504, 0x4000000000082904, 0x4000000000082D04, 0x4000000000083104,
+		0x4000000000083503, 0x4000000000083803, 0x4000000000083B04, 0x4000000000083F04, 0x4000000000084304, 0x4000000000084704, 0x4000000000084B04, 0x4000000000084F04,
+		0x4000000000085303, 0x4000000000085603, 0x4000000000085904, 0x4000000000085D04, 0x4000000000086104, 0x4000000000086504, 0x4000000000086904, 0x4000000000086D04,
+		0x4000000000087102, 0x4000000000087302, 0x4000000000087503, 0x4000000000087802, 0x4000000000087A03, 0, 0x4000000000087D02, 0x4000000000087F03,
+		0x4000000000088202, 0x4000000000088402, 0x4000000000088602, 0x4000000000088802, 0x4000000000088A02, 0x8000048E00200002, 0x80001FC600200002, 0x8000048E00200002,
+		0x8000049000200002, 0x4000000000088C02, 0x4000000000088E03, 0x4000000000089102, 0x4000000000089303, 0, 0x4000000000089602, 0x4000000000089803,
+		0x4000000000089B02, 0x4000000000089D02, 0x4000000000089F02, 0x400000000008A102, 0x400000000008A302, 0x400000000008A502, 0x400000000008A702, 0x400000000008A902,
+		0x400000000008AB02, 0x400000000008AD02, 0x400000000008AF03, 0x400000000008B203, 0, 0, 0x400000000008B502, 0x400000000008B703,
+		0x400000000008BA02, 0x400000000008BC02, 0x400000000008BE02, 0x400000000008C002, 0, 0x400000000008C202, 0x400000000008C402, 0x400000000008C602,
+		0x400000000008C802, 0x400000000008CA02, 0x400000000008CC03, 0x400000000008CF03, 0x400000000008D202, 0x400000000008D402, 0x400000000008D602, 0x400000000008D803,
+		0x400000000008DB02, 0x400000000008DD02, 0x400000000008DF02, 0x400000000008E102, 0x400000000008E302, 0x400000000008E502, 0x400000000008E702, 0x8000048200200002,
+		0, 0, 0x400000000008E903, 0x400000000008EC02, 0x400000000008EE03, 0, 0x400000000008F102, 0x400000000008F303,
+		0x400000000008F602, 0x400000000008F802, 0x400000000008FA02, 0x400000000008FC02, 0x400000000008FE02, 0x8000048300200002, 0x8000048F00200002, 0,
+		0x8000020900200004, 0x8000020900200004, 0x8000020900200004, 0x8000020900200004, 0x8000020900200004, 0x8000020900200004, 0x8000020900200004, 0x800002090020001B,
+		0x8000020900200004, 0x8000020900200004, 0x8000020900200004, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000021300200002, 0x800002130020001B, 0x8000021400200002, 0x8000021500200002, 0x8000021600200002, 0x8000021700200002, 0x8000038300200002, 0x8000020C00200002,
+		0x8000030600200002, 0x8000030700200002, 0x8000030800200002, 0x8000030900200002, 0x8000030D00200002, 0x8000030E00200002, 0x8000030F00200002, 0x8000031000200002,
+		0x8000039F00200002, 0x800003A000200002, 0x800003A400200002, 0x800003A500200002, 0x8000027700200004, 0x4000000000090002, 0x4000000000090203, 0x800003A600200002,
+		0x8000020700200002, 0x8000020800200002, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x800002090020001B,
+		0x8000039B00200002, 0x8000039D00200002, 0x800003AA00200002, 0x4000000000090502, 0x4000000000090703, 0x800003AB00200002, 0x4000000000090A02, 0x4000000000090C03,
+		0x800003AE00200002, 0x8000030A00200002, 0x8000030B00200002, 0x800003AF00200002, 0x4000000000090F02, 0x8000027500200002, 0x8000020A00200002, 0x800003B000200002,
+		0x800003B200200002, 0x800003B400200002, 0x800003B500200002, 0x800003A700200002, 0x8000062600200002, 0x8000032300200002, 0x8000032400200002, 0x4000000000091102,
+		0x4000000000091302, 0x4000000000091502, 0x8000039700200002, 0x8000038C00200002, 0x800003A800200002, 0x800003A900200002, 0x8000039000200002, 0x8000023600200002,
+		0x800003B300200002, 0x8000039100200002, 0x8000062200200002, 0x8000021A00200002, 0x800003B100200002, 0x800002E800200002, 0x800002E900200002, 0x4000000000091704,
+		0x800002EA00200002, 0x800002EB00200002, 0x800002EC00200002, 0x800002ED00200002, 0x800002EE00200002, 0x800002EF00200002, 0x800002F000200002, 0x8000020900200004,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x80001C3D00200014, 0x80001D3200200014, 0, 0, 0x80001C4100200014, 0x80001C4200200014, 0x80001C4300200014, 0x80001C4400200014,
+		0x80001C4500200014, 0x80001C4600200014, 0x8000061600200014, 0x8000062100200014, 0x8000061B00200014, 0x8000031700200014, 0x8000031800200014, 0x80001DB900200014,
+		0x80001C3D00200015, 0x80001C3E00200015, 0x80001C3F00200015, 0x80001C4000200015, 0x80001C4100200015, 0x80001C4200200015, 0x80001C4300200015, 0x80001C4400200015,
+		0x80001C4500200015, 0x80001C4600200015, 0x8000061600200015, 0x8000062100200015, 0x8000061B00200015, 0x8000031700200015, 0x8000031800200015, 0,
+		0x80001C4700200015, 0x80001CAA00200015, 0x80001DDD00200015, 0x80001EFF00200015, 0x80001CBD00200015, 0x80001D1800200015, 0x80001D6500200015, 0x80001D7700200015,
+		0x80001DAA00200015, 0x80001DB900200015, 0x80001E0C00200015, 0x80001E7100200015, 0x80001E9500200015, 0, 0, 0,
+		0x80001C1F00200002, 0x80001C2000200002, 0x80001C2100200002, 0x80001C2200200002, 0x80001C2300200002, 0x80001C2400200002, 0x80001C2500200002, 0x80001C2600200002,
+		0x4000000000091B02, 0x80001C2700200002, 0x80001C2800200002, 0x80001C2900200002, 0x80001C2A00200002, 0x80001C2B00200002, 0x80001C2C00200002, 0x80001C2D00200002,
+		0x80001C2E00200002, 0x80001C2F00200002, 0x80001C3000200002, 0x80001C3100200002, 0x80001C3200200002, 0x80001C3300200002, 0x80001C3400200002, 0x80001C3500200002,
+		0x80001C3600200002, 0x80001C3700200002, 0x80001C3800200002, 0x80001C3900200002, 0x80001C3A00200002, 0x80001C3B00200002, 0x80001C3C00200002, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000000001010002, 0x8000000001020002, 0x8000000001030002, 0x8000000001030002, 0x8000000001040002, 0x8000000001050002, 0x8000000001060002, 0x8000000001070002,
+		0x8000000000350002, 0x8000000000350002, 0x8000000000350002, 0x8000000001080002, 0x8000000001090002, 0x8000000000360002, 0x8000000000360002, 0x8000000000360002,
+		0x8000000000360002, 0x80000000010A0002, 0x8000000000360002, 0x8000000000360002, 0x8000000000360002, 0x8000000000350002, 0x80000000010B0002, 0x80000000010C0002,
+		0x80000000010D0002, 0x80000000010E0002, 0x8000000000350002, 0x8000000000350002, 0x8000000000340002, 0x8000000000340002, 0x8000000000340002, 0x8000000000340002,
+		0x8000000000330002, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x4000000000091D03, 0x4000000000092003, 0x80001C7A0020000B, 0x4000000000092302, 0x8000058600200002, 0x4000000000092503, 0x4000000000092803, 0x80001CC20020000A,
+		0x8000058700200002, 0x4000000000092B02, 0x80001CF400200005, 0x80001D180020000B, 0x80001D180020000B, 0x80001D180020000B, 0x80001D1800200005, 0x4000000000092D02,
+		0x80001D320020000B, 0x80001D320020000B, 0x80001D770020000B, 0x80001D7700200005, 0x8000058800200002, 0x80001DB90020000B, 0x4000000000092F02, 0x8000058900200002,
+		0x8000058A00200002, 0x80001E0C0020000B, 0x80001E210020000B, 0x80001E330020000B, 0x80001E330020000B, 0x80001E330020000B, 0x8000058B00200002, 0x8000058C00200002,
+		0x4000000000093102, 0x4000000000093303, 0x4000000000093602, 0x8000058D00200002, 0x80001F210020000B, 0x8000058E00200002, 0x80001FE100200008, 0x8000058F00200002,
+		0x80001F210020000B, 0x8000059000200002, 0x80001D6500200008, 0x4000000000093802, 0x80001C600020000B, 0x80001C7A0020000B, 0x8000059100200002, 0x80001CAA00200005,
+		0x80001CAA0020000B, 0x80001CE50020000B, 0x80001CF200200008, 0x80001DAA0020000B, 0x80001DDD00200005, 0x800022B700200004, 0x800022B800200004, 0x800022B900200004,
+		0x800022BA00200004, 0x80001D3200200005, 0x8000059200200002, 0x4000000000093A03, 0x80001FCF00200005, 0x80001FBB00200005, 0x80001FBB0020000B, 0x80001FCF0020000B,
+		0x8000061500200005, 0x8000059300200002, 0x8000059400200002, 0x8000059500200002, 0x8000059600200002, 0x80001C8F0020000B, 0x80001C8F00200005, 0x80001CAA00200005,
+		0x80001D3200200005, 0x80001D4C00200005, 0x8000059700200002, 0x800006B100200002, 0x8000059800200002, 0x4000000000093D03, 0x80001CF200200002, 0x8000059900200002,
+		0x4000000000094003, 0x4000000000094303, 0x4000000000094604, 0x4000000000094A03, 0x4000000000094D03, 0x4000000000095003, 0x4000000000095303, 0x4000000000095603,
+		0x4000000000095903, 0x4000000000095C03, 0x4000000000095F03, 0x4000000000096203, 0x4000000000096503, 0x4000000000096803, 0x4000000000096B03, 0x4000000000096E02,
+		0x80001D320020000A, 0x4000000000097002, 0x4000000000097203, 0x4000000000097502, 0x80001EE30020000A, 0x4000000000097702, 0x4000000000097903, 0x4000000000097C04,
+		0x4000000000098002, 0x80001EFF0020000A, 0x4000000000098202, 0x4000000000098403, 0x80001D770020000A, 0x80001C7A0020000A, 0x80001C8F0020000A, 0x80001DAA0020000A,
+		0x80001D3200200004, 0x4000000000098702, 0x4000000000098903, 0x4000000000098C02, 0x80001EE300200004, 0x4000000000098E02, 0x4000000000099003, 0x4000000000099304,
+		0x4000000000099702, 0x80001EFF00200004, 0x4000000000099902, 0x4000000000099B03, 0x80001D7700200004, 0x80001C7A00200004, 0x80001C8F00200004, 0x80001DAA00200004,
+		0x80001AC600200002, 0x80001AC700200002, 0x80001AC800200002, 0x80001C8D00200008, 0x80001C8D00200002, 0x80001C4300200002, 0x80001AC900200002, 0x80001ACA00200002,
+		0x80001ACB00200002, 0x4000000000099E03, 0x8000059A00200002, 0x8000059B00200002, 0, 0, 0, 0,
+		0x8000059C00200002, 0x8000059E00200002, 0x8000059D00200002, 0x8000059F00200002, 0x800005A000200002, 0x800005A100200002, 0x800005A200200002, 0x800005A300200002,
+		0x800005A400200002, 0x800005A500200002, 0x400000000009A102, 0x400000000009A302, 0x800005A600200002, 0x800005A700200002, 0x800005A800200002, 0x800005A900200002,
+		0x800005AA00200002, 0x800005AB00200002, 0x800005AC00200002, 0x800005AD00200002, 0x800005AE00200002, 0x800005AF00200002, 0x800005B000200002, 0x800005B100200002,
+		0x800005B200200002, 0x800005B300200002, 0x800005B400200002, 0x800005B500200002, 0x800005B600200002, 0x800005B700200002, 0x400000000009A502, 0x800005B800200002,
+		0x800005B900200002, 0x800005BA00200002, 0x800005BB00200002, 0x800005BC00200002, 0x800005BD00200002, 0x800005BE00200002, 0x800005BF00200002, 0x800005C000200002,
+		0x800005C100200002, 0x800005C200200002, 0x800005C300200002, 0x800005C400200002, 0x800005C500200002, 0x800005C600200002, 0x800005C700200002, 0x800005C800200002,
+		0x800005C900200002, 0x800005CA00200002, 0x800005CB00200002, 0x800005CC00200002, 0x800005CD00200002, 0x800005CE00200002, 0x800005CF00200002, 0x800005D000200002,
+		0x800005D100200002, 0x800005D200200002, 0x800005D300200002, 0x800005D400200002, 0x800005D500200002, 0x400000000009A702, 0x400000000009A902, 0x400000000009AB02,
+		0x800005D600200002, 0x800005D700200002, 0x800005D800200002, 0x800005D900200002, 0x800005DA00200002, 0x800005DB00200002, 0x800005DC00200002, 0x800005DD00200002,
+		0x800005DE00200002, 0x800005DF00200002, 0x800005E000200002, 0x800005E100200002, 0x800005E200200002, 0x800005E300200002, 0x800005E400200002, 0x800005E500200002,
+		0x800005E600200002, 0x800005E700200002, 0x800005E800200002, 0x800005E900200002, 0x800005EA00200002, 0x800005EB00200002, 0x800005EC00200002, 0x800005ED00200002,
+		0x800005EE00200002, 0x800005EF00200002, 0x800005F000200002, 0x800005F100200002, 0x800005F200200002, 0x800005F300200002, 0x800005F400200002, 0x800005F500200002,
+		0x800005F600200002, 0x800005F700200002, 0x800005F800200002, 0x800005F900200002, 0x800005FA00200002, 0x800005FB00200002, 0x800005FC00200002, 0x800005FD00200002,
+		0x800005FE00200002, 0x800005FF00200002, 0x8000060000200002, 0x8000060100200002, 0x8000060200200002, 0x8000060300200002, 0x8000060400200002, 0x8000060500200002,
+		0x8000060600200002, 0x8000060700200002, 0x8000060800200002, 0x8000060900200002, 0x400000000009AD02, 0x8000060A00200002, 0x8000060B00200002, 0x8000060C00200002,
+		0x8000060D00200002, 0x400000000009AF02, 0x8000060E00200002, 0x8000060F00200002, 0x400000000009B102, 0x8000061000200002, 0x8000061200200002, 0x8000061300200002,
+		0x8000061400200002, 0x8000061500200002, 0x8000062100200002, 0x8000062300200002, 0x8000062400200002, 0x8000062500200002, 0x8000062700200002, 0x8000062800200002,
+		0x8000062900200002, 0x8000062A00200002, 0x8000062B00200002, 0x8000062C00200002, 0x8000062E00200002, 0x8000063000200002, 0x8000063100200002, 0x8000063200200002,
+		0x8000063300200002, 0x8000063400200002, 0x8000063500200002, 0x8000063600200002, 0x400000000009B302, 0x8000063700200002, 0x400000000009B502, 0x8000063800200002,
+		0x8000063900200002, 0x8000063A00200002, 0x8000063B00200002, 0x8000063C00200002, 0x400000000009B702, 0x400000000009B903, 0x8000063D00200002, 0x400000000009BC02,
+		0x400000000009BE03, 0x8000063E00200002, 0x8000063F00200002, 0x8000064000200002, 0x8000064100200002, 0x8000064200200002, 0x8000064300200002, 0x8000064400200002,
+		0x8000064500200002, 0x8000064600200002, 0x8000064700200002, 0x8000064800200002, 0x8000064900200002, 0x8000064A00200002, 0x8000064B00200002, 0x8000064C00200002,
+		0x8000064D00200002, 0x400000000009C102, 0x8000064E00200002, 0x8000064F00200002, 0x400000000009C302, 0x8000065000200002, 0x8000065100200002, 0x400000000009C502,
+		0x8000065200200002, 0x400000000009C702, 0x8000065300200002, 0x8000065400200002, 0x8000065500200002, 0x8000065600200002, 0x8000065700200002, 0x8000065800200002,
+		0x8000065900200002, 0x8000065A00200002, 0x8000065B00200002, 0x8000065C00200002, 0x8000065D00200002, 0x8000065E00200002, 0x8000065F00200002, 0x8000066000200002,
+		0x8000066100200002, 0x8000066200200002, 0x8000066300200002, 0x8000066400200002, 0x8000066500200002, 0x8000066600200002, 0x8000066700200002, 0x8000066800200002,
+		0x400000000009C902, 0x8000066900200002, 0x400000000009CB02, 0x8000066A00200002, 0x8000066B00200002, 0x8000066C00200002, 0x8000066D00200002, 0x8000066E00200002,
+		0x8000066F00200002, 0x8000067000200002, 0x8000067100200002, 0x8000067200200002, 0x8000067300200002, 0x400000000009CD02, 0x400000000009CF02, 0x400000000009D102,
+		0x400000000009D302, 0x400000000009D502, 0x8000067400200002, 0x8000067500200002, 0x400000000009D702, 0x400000000009D902, 0x8000067600200002, 0x8000067700200002,
+		0x400000000009DB02, 0x400000000009DD02, 0x8000067800200002, 0x8000067900200002, 0x8000067A00200002, 0x8000067B00200002, 0x8000067C00200002, 0x8000067D00200002,
+		0x400000000009DF02, 0x400000000009E102, 0x8000067E00200002, 0x8000067F00200002, 0x400000000009E302, 0x400000000009E502, 0x8000068000200002, 0x8000068100200002,
+		0x400000000009E702, 0x400000000009E902, 0x8000068200200002, 0x8000068300200002, 0x8000068400200002, 0x8000068500200002, 0x8000068600200002, 0x8000068700200002,
+		0x8000068800200002, 0x8000068900200002, 0x8000068A00200002, 0x8000068B00200002, 0x8000068C00200002, 0x8000068D00200002, 0x8000068E00200002, 0x8000068F00200002,
+		0x8000069000200002, 0x8000069100200002, 0x8000069200200002, 0x8000069300200002, 0x8000069400200002, 0x8000069500200002, 0x8000069600200002, 0x8000069700200002,
+		0x8000069800200002, 0x8000069900200002, 0x8000069A00200002, 0x8000069B00200002, 0x8000069C00200002, 0x8000069D00200002, 0x8000069E00200002, 0x8000069F00200002,
+		0x800006A000200002, 0x800006A100200002, 0x800006A200200002, 0x800006A300200002, 0x400000000009EB02, 0x400000000009ED02, 0x400000000009EF02, 0x400000000009F102,
+		0x800006A400200002, 0x800006A500200002, 0x800006A600200002, 0x800006A700200002, 0x800006A800200002, 0x800006A900200002, 0x800006AA00200002, 0x800006AB00200002,
+		0x800006AC00200002, 0x800006AD00200002, 0x800006AE00200002, 0x800006AF00200002, 0x800006B000200002, 0x800006B200200002, 0x800006B300200002, 0x800006B400200002,
+		0x800006B500200002, 0x800006B600200002, 0x800006B700200002, 0x800006B800200002, 0x800006B900200002, 0x800006BA00200002, 0x800006BB00200002, 0x800006BC00200002,
+		0x800006BD00200002, 0x800006BE00200002, 0x800006BF00200002, 0x800006C000200002, 0x800006C100200002, 0x800006C200200002, 0x800006C300200002, 0x800006C400200002,
+		0x800006C500200002, 0x800006C600200002, 0x800006C700200002, 0x800006C800200002, 0x800006C900200002, 0x800006CA00200002, 0x800006CB00200002, 0x800006CC00200002,
+		0x800006CD00200002, 0x800006CE00200002, 0x800006CF00200002, 0x800006D000200002, 0x800006D100200002, 0x800006D200200002, 0x800006D300200002, 0x800006D400200002,
+		0x400000000009F302, 0x400000000009F502, 0x400000000009F702, 0x400000000009F902, 0x800006D500200002, 0x800006D600200002, 0x800006D700200002, 0x800006D800200002,
+		0x800006D900200002, 0x800006DA00200002, 0x400000000009FB02, 0x400000000009FD02, 0x400000000009FF02, 0x40000000000A0102, 0x800006DB00200002, 0x800006DC00200002,
+		0x800006DD00200002, 0x800006DE00200002, 0x800006DF00200002, 0x800006E000200002, 0x800006E100200002, 0x800006E200200002, 0x800006E300200002, 0x800006E400200002,
+		0x800006E500200002, 0x800006E600200002, 0x800006E700200002, 0x800006E800200002, 0x800006E900200002, 0x800006EA00200002, 0x800006EB00200002, 0x800006EC00200002,
+		0x800006ED00200002, 0x800006EE00200002, 0x800006EF00200002, 0x800006F000200002, 0x800006F100200002, 0x800006F200200002, 0x800006F300200002, 0x800006F400200002,
+		0x8000032500200002, 0x8000032600200002, 0x8000032700200002, 0x8000032800200002, 0x800006F500200002, 0x800006F600200002, 0x800006F700200002, 0x800006F800200002,
+		0x800006F900200002, 0x800006FA00200002, 0x800006FB00200002, 0x800006FC00200002, 0x800006FD00200002, 0x800006FE00200002, 0x800006FF00200002, 0x8000070000200002,
+		0x8000070100200002, 0x8000070200200002, 0x8000070300200002, 0x8000070400200002, 0x8000070500200002, 0x8000070600200002, 0x8000070700200002, 0x8000070800200002,
+		0x8000070900200002, 0x8000070A00200002, 0x8000070B00200002, 0x8000070C00200002, 0x8000070D00200002, 0x8000070E00200002, 0x8000070F00200002, 0x8000071000200002,
+		0x8000071100200002, 0x8000036F00200002, 0x8000037000200002, 0x8000071200200002, 0x8000071300200002, 0x8000071400200002, 0x8000071500200002, 0x8000071600200002,
+		0x8000071700200002, 0x8000071800200002, 0x8000071900200002, 0x8000071A00200002, 0x8000071B00200002, 0x8000071C00200002, 0x8000071D00200002, 0x8000071E00200002,
+		0x8000071F00200002, 0x8000072000200002, 0x8000072100200002, 0x8000072200200002, 0x8000072300200002, 0x8000072400200002, 0x8000072500200002, 0x8000072600200002,
+		0x8000072700200002, 0x8000072800200002, 0x8000072900200002, 0x8000072A00200002, 0x8000072B00200002, 0x8000072C00200002, 0x8000072D00200002, 0x8000072E00200002,
+		0x8000072F00200002, 0x8000073000200002, 0x8000073100200002, 0x8000073200200002, 0x8000073300200002, 0x8000073400200002, 0x8000073500200002, 0x8000073600200002,
+		0x8000073700200002, 0x8000073800200002, 0x8000073900200002, 0x8000073A00200002, 0x8000073B00200002, 0x8000073C00200002, 0x8000073D00200002, 0x8000073E00200002,
+		0x8000073F00200002, 0x8000074000200002, 0x8000074100200002, 0x8000074200200002, 0x8000074300200002, 0x8000074400200002, 0x8000074500200002, 0x8000074600200002,
+		0x8000074700200002, 0x8000074800200002, 0x8000074900200002, 0x8000074A00200002, 0x8000074B00200002, 0x8000074C00200002, 0x8000074D00200002, 0x8000074E00200002,
+		0x8000074F00200002, 0x8000075000200002, 0x8000075100200002, 0x8000075200200002, 0x8000075300200002, 0x8000075400200002, 0x8000075500200002, 0x8000075600200002,
+		0x8000075700200002, 0x8000075800200002, 0x8000075900200002, 0x8000075A00200002, 0x8000075B00200002, 0x8000075C00200002, 0x8000075D00200002, 0x8000075E00200002,
+		0x8000075F00200002, 0x8000076000200002, 0x8000076100200002, 0x8000076200200002, 0x8000076300200002, 0x8000076400200002, 0x8000076500200002, 0x8000076600200002,
+		0x8000076700200002, 0x8000076800200002, 0x8000076900200002, 0x8000076A00200002, 0x8000076B00200002, 0x8000076C00200002, 0x8000076D00200002, 0x8000076E00200002,
+		0x8000076F00200002, 0x8000077000200002, 0x8000077100200002, 0x8000077200200002, 0x8000077300200002, 0x8000077400200002, 0x8000077500200002, 0x8000077600200002,
+		0x8000077700200002, 0x8000077800200002, 0x8000077900200002, 0x8000077A00200002, 0x8000077B00200002, 0x8000077C00200002, 0x8000077D00200002, 0x8000077E00200002,
+		0x8000077F00200002, 0x8000078000200002, 0x8000078100200002, 0x8000078200200002, 0x8000078300200002, 0x8000078400200002, 0x8000078500200002, 0x8000078600200002,
+		0x8000078700200002, 0x8000078800200002, 0x8000078900200002, 0x8000078A00200002, 0x8000078B00200002, 0x8000078C00200002, 0x8000078D00200002, 0x8000078E00200002,
+		0x8000078F00200002, 0x8000079000200002, 0x8000079100200002, 0x8000079200200002, 0x8000079300200002, 0x8000079400200002, 0x8000079500200002, 0x8000079600200002,
+		0x8000079700200002, 0x8000079800200002, 0x8000079900200002, 0x8000079A00200002, 0x8000079B00200002, 0x8000079C00200002, 0x8000079D00200002, 0x8000079E00200002,
+		0x8000079F00200002, 0x800007A000200002, 0x800007A100200002, 0x800007A200200002, 0x800007A300200002, 0x800007A400200002, 0x800007A500200002, 0x800007A600200002,
+		0x800007A700200002, 0x800007A800200002, 0x800007A900200002, 0x800007AA00200002, 0x800007AB00200002, 0x800007AC00200002, 0x800007AD00200002, 0x800007AE00200002,
+		0x800007AF00200002, 0x800007B000200002, 0x800007B100200002, 0x800007B200200002, 0x800007B300200002, 0x800007B400200002, 0x800007B500200002, 0x800007B600200002,
+		0x800007B700200002, 0x800007B800200002, 0x800007B900200002, 0x800007BA00200002, 0x800007BB00200002, 0x800007BC00200002, 0x800007BD00200002, 0x800007BE00200002,
+		0x800007BF00200002, 0x800007C000200002, 0x800007C100200002, 0x800007C200200002, 0x800007C300200002, 0x800007C400200002, 0x800007C500200002, 0x800007C600200002,
+		0x800007C700200002, 0x800007C800200002, 0x800007C900200002, 0x800007CA00200002, 0x800007CB00200002, 0x800007CC00200002, 0x800007CD00200002, 0x800007CE00200002,
+		0x800007CF00200002, 0x800007D000200002, 0x800007D100200002, 0x800007D200200002, 0x800007D300200002, 0x800007D400200002, 0x800007D500200002, 0x800007D600200002,
+		0x800007D700200002, 0x800007D800200002, 0x800007D900200002, 0x800007DA00200002, 0x800007DB00200002, 0x800007DC00200002, 0x800007DD00200002, 0x800007DE00200002,
+		0x800007DF00200002, 0x800007E000200002, 0x800007E100200002, 0x800007E200200002, 0x800007E300200002, 0x800007E400200002, 0x800007E500200002, 0,
+		0x800007E600200002, 0x800007E700200002, 0x800007E800200002, 0x800007E900200002, 0x800007EA00200002, 0x800007EB00200002, 0x800007EC00200002, 0x800007ED00200002,
+		0x800007EE00200002, 0x800007EF00200002, 0x800007F000200002, 0x800007F100200002, 0x800007F200200002, 0x800007F300200002, 0x800007F400200002, 0x800007F500200002,
+		0x800007F600200002, 0x800007F700200002, 0x800007F800200002, 0x800007F900200002, 0x800007FA00200002, 0x800007FB00200002, 0x800007FC00200002, 0x800007FD00200002,
+		0x800007FE00200002, 0x800007FF00200002, 0x8000080000200002, 0x8000080100200002, 0x8000080200200002, 0x8000080300200002, 0x8000080400200002, 0x8000080500200002,
+		0x8000080600200002, 0x8000080700200002, 0x8000080800200002, 0x8000080900200002, 0x8000080A00200002, 0x8000080B00200002, 0x8000080C00200002, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000080D00200002, 0x8000080E00200002, 0x8000080F00200002, 0x8000081000200002, 0x8000081100200002, 0x8000081200200002, 0x8000081300200002, 0x8000081400200002,
+		0x8000081500200002, 0x8000081600200002, 0x8000081700200002, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80001C3E00200006, 0x80001C3F00200006, 0x80001C4000200006, 0x80001C4100200006, 0x80001C4200200006, 0x80001C4300200006, 0x80001C4400200006, 0x80001C4500200006,
+		0x80001C4600200006, 0x40000000000A0302, 0x40000000000A0502, 0x40000000000A0702, 0x40000000000A0902, 0x40000000000A0B02, 0x40000000000A0D02, 0x40000000000A0F02,
+		0x40000000000A1102, 0x40000000000A1302, 0x40000000000A1502, 0x40000000000A1702, 0x40000000000A1903, 0x40000000000A1C03, 0x40000000000A1F03, 0x40000000000A2203,
+		0x40000000000A2503, 0x40000000000A2803, 0x40000000000A2B03, 0x40000000000A2E03, 0x40000000000A3103, 0x40000000000A3404, 0x40000000000A3804, 0x40000000000A3C04,
+		0x40000000000A4004, 0x40000000000A4404, 0x40000000000A4804, 0x40000000000A4C04, 0x40000000000A5004, 0x40000000000A5404, 0x40000000000A5804, 0x40000000000A5C04,
+		0x40000000000A6002, 0x40000000000A6202, 0x40000000000A6402, 0x40000000000A6602, 0x40000000000A6802, 0x40000000000A6A02, 0x40000000000A6C02, 0x40000000000A6E02,
+		0x40000000000A7002, 0x40000000000A7203, 0x40000000000A7503, 0x40000000000A7803, 0x40000000000A7B03, 0x40000000000A7E03, 0x40000000000A8103, 0x40000000000A8403,
+		0x40000000000A8703, 0x40000000000A8A03, 0x40000000000A8D03, 0x40000000000A9003, 0x40000000000A9303, 0x40000000000A9603, 0x40000000000A9903, 0x40000000000A9C03,
+		0x40000000000A9F03, 0x40000000000AA203, 0x40000000000AA503, 0x40000000000AA803, 0x40000000000AAB03, 0x40000000000AAE03, 0x40000000000AB103, 0x40000000000AB403,
+		0x40000000000AB703, 0x40000000000ABA03, 0x40000000000ABD03, 0x40000000000AC003, 0x40000000000AC303, 0x40000000000AC603, 0x40000000000AC903, 0x40000000000ACC03,
+		0x40000000000ACF03, 0x40000000000AD203, 0x40000000000AD503, 0x40000000000AD803, 0x40000000000ADB03, 0x40000000000ADE03, 0x80001C470020000C, 0x80001C600020000C,
+		0x80001C7A0020000C, 0x80001C8F0020000C, 0x80001CAA0020000C, 0x80001CE50020000C, 0x80001CF40020000C, 0x80001D180020000C, 0x80001D320020000C, 0x80001D4C0020000C,
+		0x80001D650020000C, 0x80001D770020000C, 0x80001DAA0020000C, 0x80001DB90020000C, 0x80001DDD0020000C, 0x80001E0C0020000C, 0x80001E210020000C, 0x80001E330020000C,
+		0x80001E710020000C, 0x80001E950020000C, 0x80001EB50020000C, 0x80001EE30020000C, 0x80001EF50020000C, 0x80001EFF0020000C, 0x80001F0B0020000C, 0x80001F210020000C,
+		0x80001C4700200006, 0x80001C6000200006, 0x80001C7A00200006, 0x80001C8F00200006, 0x80001CAA00200006, 0x80001CE500200006, 0x80001CF400200006, 0x80001D1800200006,
+		0x80001D3200200006, 0x80001D4C00200006, 0x80001D6500200006, 0x80001D7700200006, 0x80001DAA00200006, 0x80001DB900200006, 0x80001DDD00200006, 0x80001E0C00200006,
+		0x80001E2100200006, 0x80001E3300200006, 0x80001E7100200006, 0x80001E9500200006, 0x80001EB500200006, 0x80001EE300200006, 0x80001EF500200006, 0x80001EFF00200006,
+		0x80001F0B00200006, 0x80001F2100200006, 0x80001C3D00200006, 0x40000000000AE102, 0x40000000000AE302, 0x40000000000AE502, 0x40000000000AE702, 0x40000000000AE902,
+		0x40000000000AEB02, 0x40000000000AED02, 0x40000000000AEF02, 0x40000000000AF102, 0x40000000000AF302, 0x80001C3E00200006, 0x80001C3F00200006, 0x80001C4000200006,
+		0x80001C4100200006, 0x80001C4200200006, 0x80001C4300200006, 0x80001C4400200006, 0x80001C4500200006, 0x80001C4600200006, 0x40000000000AF502, 0x80001C3D00200006,
+		0x8000081800200002, 0x8000081900200002, 0x8000081A00200002, 0x8000081B00200002, 0x8000081C00200002, 0x8000081D00200002, 0x8000081E00200002, 0x8000081F00200002,
+		0x8000082000200002, 0x8000082100200002, 0x8000082200200002, 0x8000082300200002, 0x8000082400200002, 0x8000082500200002, 0x8000082600200002, 0x8000082700200002,
+		0x8000082800200002, 0x8000082900200002, 0x8000082A00200002, 0x8000082B00200002, 0x8000082C00200002, 0x8000082D00200002, 0x8000082E00200002, 0x8000082F00200002,
+		0x8000083000200002, 0x8000083100200002, 0x8000083200200002, 0x8000083300200002, 0x8000083400200002, 0x8000083500200002, 0x8000083600200002, 0x8000083700200002,
+		0x8000083800200002, 0x8000083900200002, 0x8000083A00200002, 0x8000083B00200002, 0x8000083C00200002, 0x8000083D00200002, 0x8000083E00200002, 0x8000083F00200002,
+		0x8000084000200002, 0x8000084100200002, 0x8000084200200002, 0x8000084300200002, 0x8000084400200002, 0x8000084500200002, 0x8000084600200002, 0x8000084700200002,
+		0x8000084800200002, 0x8000084900200002, 0x8000084A00200002, 0x8000084B00200002, 0x8000084C00200002, 0x8000084D00200002, 0x8000084E00200002, 0x8000084F00200002,
+		0x8000085000200002, 0x8000085100200002, 0x8000085200200002, 0x8000085300200002, 0x8000085400200002, 0x8000085500200002, 0x8000085600200002, 0x8000085700200002,
+		0x8000085800200002, 0x8000085900200002, 0x8000085A00200002, 0x8000085B00200002, 0x8000085C00200002, 0x8000085D00200002, 0x8000085E00200002, 0x8000085F00200002,
+		0x8000086000200002, 0x8000086100200002, 0x8000086200200002, 0x8000086300200002, 0x8000086400200002, 0x8000086500200002, 0x8000086600200002, 0x8000086700200002,
+		0x8000086800200002, 0x8000086900200002, 0x8000086A00200002, 0x8000086B00200002, 0x8000086C00200002, 0x8000086D00200002, 0x8000086E00200002, 0x8000086F00200002,
+		0x8000087000200002, 0x8000087100200002, 0x8000087200200002, 0x8000087300200002, 0x8000087400200002, 0x8000087500200002, 0x8000087600200002, 0x8000087700200002,
+		0x8000087800200002, 0x8000087900200002, 0x8000087A00200002, 0x8000087B00200002, 0x8000087C00200002, 0x8000087D00200002, 0x8000087E00200002, 0x8000087F00200002,
+		0x8000088000200002, 0x8000088100200002, 0x8000088200200002, 0x8000088300200002, 0x8000088400200002, 0x8000088500200002, 0x8000088600200002, 0x8000088700200002,
+		0x8000088800200002, 0x8000088900200002, 0x8000088A00200002, 0x8000088B00200002, 0x8000088C00200002, 0x8000088D00200002, 0x8000088E00200002, 0x8000088F00200002,
+		0x8000089000200002, 0x8000089100200002, 0x8000089200200002, 0x8000089300200002, 0x8000089400200002, 0x8000089500200002, 0x8000089600200002, 0x8000089700200002,
+		0x8000089800200002, 0x8000089900200002, 0x8000089A00200002, 0x8000089B00200002, 0x8000089C00200002, 0x8000089D00200002, 0x8000089E00200002, 0x8000089F00200002,
+		0x800008A000200002, 0x800008A100200002, 0x800008A200200002, 0x800008A300200002, 0x800008A400200002, 0x800008A500200002, 0x800008A600200002, 0x800008A700200002,
+		0x800008A800200002, 0x800008A900200002, 0x800008AA00200002, 0x800008AB00200002, 0x800008AC00200002, 0x800008AD00200002, 0x800008AE00200002, 0x800008AF00200002,
+		0x800008B000200002, 0x800008B100200002, 0x800008B200200002, 0x800008B300200002, 0x800008B400200002, 0x800008B500200002, 0x800008B600200002, 0x800008B700200002,
+		0x800008B800200002, 0x800008B900200002, 0x800008BA00200002, 0x800008BB00200002, 0x800008BC00200002, 0x800008BD00200002, 0x800008BE00200002, 0x800008BF00200002,
+		0x800008C000200002, 0x800008C100200002, 0x800008C200200002, 0x800008C300200002, 0x800008C400200002, 0x800008C500200002, 0x800008C600200002, 0x800008C700200002,
+		0x800008C800200002, 0x800008C900200002, 0x800008CA00200002, 0x800008CB00200002, 0x800008CC00200002, 0x800008CD00200002, 0x800008CE00200002, 0x800008CF00200002,
+		0x800008D000200002, 0x800008D100200002, 0x800008D200200002, 0x800008D300200002, 0x800008D400200002, 0x800008D500200002, 0x800008D600200002, 0x800008D700200002,
+		0x800008D800200002, 0x800008D900200002, 0x800008DA00200002, 0x800008DB00200002, 0x800008DC00200002, 0x800008DD00200002, 0x800008DE00200002, 0x800008DF00200002,
+		0x800008E000200002, 0x800008E100200002, 0x800008E200200002, 0x800008E300200002, 0x800008E400200002, 0x800008E500200002, 0x800008E600200002, 0x800008E700200002,
+		0x800008E800200002, 0x800008E900200002, 0x800008EA00200002, 0x800008EB00200002, 0x800008EC00200002, 0x800008ED00200002, 0x800008EE00200002, 0x800008EF00200002,
+		0x800008F000200002, 0x800008F100200002, 0x800008F200200002, 0x800008F300200002, 0x800008F400200002, 0x800008F500200002, 0x800008F600200002, 0x800008F700200002,
+		0x800008F800200002, 0x800008F900200002, 0x800008FA00200002, 0x800008FB00200002, 0x800008FC00200002, 0x800008FD00200002, 0x800008FE00200002, 0x800008FF00200002,
+		0x8000090000200002, 0x8000090100200002, 0x8000090200200002, 0x8000090300200002, 0x8000090400200002, 0x8000090500200002, 0x8000090600200002, 0x8000090700200002,
+		0x8000090800200002, 0x8000090900200002, 0x8000090A00200002, 0x8000090B00200002, 0x8000090C00200002, 0x8000090D00200002, 0x8000090E00200002, 0x8000090F00200002,
+		0x8000091000200002, 0x8000091100200002, 0x8000091200200002, 0x8000091300200002, 0x8000091400200002, 0x8000091500200002, 0x8000091600200002, 0x8000091700200002,
+		0x8000091800200002, 0x8000091900200002, 0x8000091A00200002, 0x8000091B00200002, 0x8000091C00200002, 0x8000091D00200002, 0x8000091E00200002, 0x8000091F00200002,
+		0x8000092000200002, 0x8000092100200002, 0x8000092200200002, 0x8000092300200002, 0x8000092400200002, 0x8000092500200002, 0x8000092600200002, 0x8000092700200002,
+		0x8000092800200002, 0x8000092900200002, 0x8000092A00200002, 0x8000092B00200002, 0x8000092C00200002, 0x8000092D00200002, 0x8000092E00200002, 0x8000092F00200002,
+		0x8000093000200002, 0x8000093100200002, 0x8000093200200002, 0x8000093300200002, 0x8000093400200002, 0x8000093500200002, 0x8000093600200002, 0x8000093700200002,
+		0x8000093800200002, 0x8000093900200002, 0x8000093A00200002, 0x8000093B00200002, 0x8000093C00200002, 0x8000093D00200002, 0x8000093E00200002, 0x8000093F00200002,
+		0x8000094000200002, 0x8000094100200002, 0x8000094200200002, 0x8000094300200002, 0x8000094400200002, 0x8000094500200002, 0x8000094600200002, 0x8000094700200002,
+		0x80000EA200200002, 0x80000EA300200002, 0x80000EA400200002, 0x80000EA500200002, 0x80000EA600200002, 0x80000EA700200002, 0x80000EA800200002, 0x80000EA900200002,
+		0x8000094800200002, 0x8000094900200002, 0x8000094A00200002, 0x8000094B00200002, 0x8000094C00200002, 0x8000094D00200002, 0x8000094E00200002, 0x8000094F00200002,
+		0x8000095000200002, 0x8000095100200002, 0x8000095200200002, 0x8000095300200002, 0x8000095400200002, 0x8000095500200002, 0x8000095600200002, 0x8000095700200002,
+		0x8000095800200002, 0x8000095900200002, 0x8000095A00200002, 0x8000095B00200002, 0x8000095C00200002, 0x8000095D00200002, 0x8000095E00200002, 0x8000095F00200002,
+		0x8000096000200002, 0x8000096100200002, 0x8000096200200002, 0x8000096300200002, 0x8000096400200002, 0x8000096500200002, 0x8000096600200002, 0x8000096700200002,
+		0x8000096800200002, 0x8000096900200002, 0x8000096A00200002, 0x8000096B00200002, 0x8000096C00200002, 0x8000096D00200002, 0x8000096E00200002, 0x8000096F00200002,
+		0x8000097000200002, 0x8000097100200002, 0x8000097200200002, 0x8000097300200002, 0x8000097400200002, 0x8000097500200002, 0x8000097600200002, 0x8000097700200002,
+		0x8000097800200002, 0x8000097900200002, 0x8000097A00200002, 0x8000097B00200002, 0x8000097C00200002, 0x800010F300200002, 0x800010F400200002, 0x800010F500200002,
+		0x8000097D00200002, 0x8000097E00200002, 0x8000097F00200002, 0x8000098000200002, 0x8000098100200002, 0x8000098200200002, 0x8000098300200002, 0x8000098400200002,
+		0x8000098500200002, 0x8000098600200002, 0x8000098700200002, 0x8000098800200002, 0x8000098900200002, 0x8000098A00200002, 0x8000098B00200002, 0x8000098C00200002,
+		0x8000098D00200002, 0x8000098E00200002, 0x8000098F00200002, 0x8000099000200002, 0x8000099100200002, 0x8000099200200002, 0x8000099300200002, 0x8000099400200002,
+		0x8000099500200002, 0x8000099600200002, 0x80000E9C00200002, 0x80000E9D00200002, 0x80000E9E00200002, 0x80000E9F00200002, 0x80000EA000200002, 0x80000EA100200002,
+		0x8000099700200002, 0x8000099800200002, 0x8000099900200002, 0x8000099A00200002, 0x8000099B00200002, 0x8000099C00200002, 0x8000099D00200002, 0x8000099E00200002,
+		0x8000099F00200002, 0x800009A000200002, 0x800009A100200002, 0x800009A200200002, 0x800009A300200002, 0x800009A400200002, 0x800009A500200002, 0x800009A600200002,
+		0x800009A700200002, 0x800009A800200002, 0x800009A900200002, 0x800009AA00200002, 0x800009AB00200002, 0x800009AC00200002, 0x800009AD00200002, 0x800009AE00200002,
+		0x800009AF00200002, 0x800009B000200002, 0x800009B100200002, 0x800009B200200002, 0x800009B300200002, 0x800009B400200002, 0x800009B500200002, 0x800009B600200002,
+		0x800009B700200002, 0x800009B800200002, 0x800009B900200002, 0x800009BA00200002, 0x800009BB00200002, 0x800009BC00200002, 0x800009BD00200002, 0x800009BE00200002,
+		0x800009BF00200002, 0x800009C000200002, 0x800009C100200002, 0x800009C200200002, 0x800009C300200002, 0x800009C400200002, 0x800009C500200002, 0x800009C600200002,
+		0x800009C700200002, 0x800009C800200002, 0x800009C900200002, 0x800009CA00200002, 0x800009CB00200002, 0x800009CC00200002, 0x800009CD00200002, 0x800009CE00200002,
+		0x800009CF00200002, 0x800009D000200002, 0x800009D100200002, 0x800009D200200002, 0x800009D300200002, 0x800009D400200002, 0x800009D500200002, 0x800009D600200002,
+		0x800009D700200002, 0x800009D800200002, 0x800009D900200002, 0x800009DA00200002, 0x800009DB00200002, 0x800009DC00200002, 0x800009DD00200002, 0x800009DE00200002,
+		0x800009DF00200002, 0x800009E000200002, 0x800009E100200002, 0x800009E200200002, 0x800009E300200002, 0x800009E400200002, 0x800009E500200002, 0x800009E600200002,
+		0x800009E700200002, 0x800009E800200002, 0x800009E900200002, 0x800009EA00200002, 0x800009EB00200002, 0x800009EC00200002, 0x800009ED00200002, 0x800009EE00200002,
+		0x800009EF00200002, 0x800009F000200002, 0x800009F100200002, 0x800009F200200002, 0x800009F300200002, 0x800009F400200002, 0x800009F500200002, 0x800009F600200002,
+		0x800009F700200002, 0x800009F800200002, 0x800009F900200002, 0x800009FA00200002, 0x800009FB00200002, 0x800009FC00200002, 0x800009FD00200002, 0x800009FE00200002,
+		0x800009FF00200002, 0x80000A0000200002, 0x80000A0100200002, 0x80000A0200200002, 0x80000A0300200002, 0x80000A0400200002, 0x80000A0500200002, 0x80000A0600200002,
+		0x80000A2100200002, 0x80000A2200200002, 0x80000A2300200002, 0x80000A2400200002, 0x80000A2500200002, 0x80000A2600200002, 0x80000A2700200002, 0x80000A2800200002,
+		0x80000A2900200002, 0x80000A2A00200002, 0x80000A2B00200002, 0x80000A2C00200002, 0x80000A2D00200002, 0x80000A2E00200002, 0x80000A2F00200002, 0x80000A3000200002,
+		0x80000A3100200002, 0x80000A3200200002, 0x80000A3300200002, 0x80000A3400200002, 0x80000A3500200002, 0x80000A3600200002, 0x80000A3700200002, 0x80000A3800200002,
+		0x80000A3900200002, 0x80000A3A00200002, 0x80000A3B00200002, 0x80000A3C00200002, 0x80000A3D00200002, 0x80000A3E00200002, 0x80000A3F00200002, 0x80000A4000200002,
+		0x80000A4100200002, 0x80000A4200200002, 0x80000A4300200002, 0x80000A4400200002, 0x80000A4500200002, 0x80000A4600200002, 0x80000A4700200002, 0x80000A4800200002,
+		0x80000A4900200002, 0x80000A4A00200002, 0x80000A4B00200002, 0x80000A4C00200002, 0x80000A4D00200002, 0x80000A4E00200002, 0x80000A4F00200002, 0x80000A5000200002,
+		0x80000A5100200002, 0x80000A5200200002, 0x80000A5300200002, 0x80000A5400200002, 0x80000A5500200002, 0x80000A5600200002, 0x80000A5700200002, 0x80000A5800200002,
+		0x80000A5900200002, 0x80000A5A00200002, 0x80000A5B00200002, 0x80000A5C00200002, 0x80000A5D00200002, 0x80000A5E00200002, 0x80000A5F00200002, 0x80000A6000200002,
+		0x80000A6100200002, 0x80000A6200200002, 0x80000A6300200002, 0x80000A6400200002, 0x80000A6500200002, 0x80000A6600200002, 0x80000A6700200002, 0x80000A6800200002,
+		0x80000A6900200002, 0x80000A6A00200002, 0x80000A6B00200002, 0x80000A6C00200002, 0x80000A6D00200002, 0x80000A6E00200002, 0x80000A6F00200002, 0x80000A7000200002,
+		0x80000A7100200002, 0x80000A7200200002, 0x80000A7300200002, 0x80000A7400200002, 0x80000A7500200002, 0x80000A7600200002, 0x80000A7700200002, 0x80000A7800200002,
+		0x80000A7900200002, 0x80000A7A00200002, 0x80000A7B00200002, 0x80000A7C00200002, 0x80000A7D00200002, 0x80000A7E00200002, 0x80000A7F00200002, 0x80000A8000200002,
+		0x80000A8100200002, 0x80000A8200200002, 0x80000A8300200002, 0x80000A8400200002, 0x80000A8500200002, 0x80000A8600200002, 0x80000A8700200002, 0x80000A8800200002,
+		0x8000034D00200002, 0x8000034E00200002, 0x8000034F00200002, 0x8000035000200002, 0x8000035100200002, 0x8000035200200002, 0x8000035300200002, 0x8000035400200002,
+		0x8000035500200002, 0x8000035600200002, 0x8000035700200002, 0x8000035800200002, 0x8000035900200002, 0x8000035A00200002, 0x80001C3E00200006, 0x80001C3F00200006,
+		0x80001C4000200006, 0x80001C4100200006, 0x80001C4200200006, 0x80001C4300200006, 0x80001C4400200006, 0x80001C4500200006, 0x80001C4600200006, 0x40000000000AF702,
+		0x80001C3E00200006, 0x80001C3F00200006, 0x80001C4000200006, 0x80001C4100200006, 0x80001C4200200006, 0x80001C4300200006, 0x80001C4400200006, 0x80001C4500200006,
+		0x80001C4600200006, 0x40000000000AF902, 0x80001C3E00200006, 0x80001C3F00200006, 0x80001C4000200006, 0x80001C4100200006, 0x80001C4200200006, 0x80001C4300200006,
+		0x80001C4400200006, 0x80001C4500200006, 0x80001C4600200006, 0x40000000000AFB02, 0x80000A8900200002, 0x80000A8A00200002, 0x80000A8B00200002, 0x80000A8C00200002,
+		0x80000A8D00200002, 0x80000A8E00200002, 0x80000A8F00200002, 0x80000A9000200002, 0x80000A9100200002, 0x80000A9200200002, 0x80000A9300200002, 0x80000A9400200002,
+		0x80000A9500200002, 0x80000A9600200002, 0x80000A9700200002, 0x80000A9800200002, 0x80000A9900200002, 0x80000A9A00200002, 0x80000A9B00200002, 0x80000A9C00200002,
+		0x80000A9D00200002, 0x80000A9E00200002, 0x80000A9F00200002, 0x80000AA000200002, 0x80000AA100200002, 0x80000AA200200002, 0x80000AA300200002, 0x80000AA400200002,
+		0x80000AA500200002, 0x80000AA600200002, 0x80000AA700200002, 0x80000AA800200002, 0x80000AA900200002, 0x80000AAA00200002, 0x80000AAB00200002, 0x80000AAC00200002,
+		0x80000AAD00200002, 0x80000AAE00200002, 0x80000AAF00200002, 0x80000AB000200002, 0x80000AB100200002, 0x80000AB200200002, 0x80000AB300200002, 0x80000AB400200002,
+		0x80000AB500200002, 0x80000AB600200002, 0x80000AB700200002, 0x80000AB800200002, 0x80000AB900200002, 0x8000034100200002, 0x8000034200200002, 0x80000ABA00200002,
+		0x80000ABB00200002, 0x80000ABC00200002, 0x80000ABD00200002, 0x80000ABE00200002, 0x80000ABF00200002, 0x80000AC000200002, 0x80000AC100200002, 0x80000AC200200002,
+		0x80000AC300200002, 0x80000AC400200002, 0x80000AC500200002, 0x80000AC600200002, 0x80000AC700200002, 0x80000AC800200002, 0x80000AC900200002, 0x80000ACA00200002,
+		0x80000ACB00200002, 0x80000ACC00200002, 0x80000ACD00200002, 0x80000ACE00200002, 0x80000ACF00200002, 0x80000AD000200002, 0x80000AD100200002, 0x80000AD200200002,
+		0x80000AD300200002, 0x80000AD400200002, 0x80000AD500200002, 0x80000AD600200002, 0x80000AD700200002, 0x80000AD800200002, 0x8000034300200002, 0x8000034400200002,
+		0x8000034500200002, 0x8000034600200002, 0x8000034700200002, 0x8000034800200002, 0x8000034900200002, 0x8000034A00200002, 0x8000034B00200002, 0x8000034C00200002,
+		0x80000AD900200002, 0x80000ADA00200002, 0x80000ADB00200002, 0x80000ADC00200002, 0x80000ADD00200002, 0x80000ADE00200002, 0x80000ADF00200002, 0x80000AE000200002,
+		0x80000AE100200002, 0x80000AE200200002, 0x80000AE300200002, 0x80000AE400200002, 0x80000AE500200002, 0x80000AE600200002, 0x80000AE700200002, 0x80000AE800200002,
+		0x80000D9C00200002, 0x80000D9D00200002, 0x80000D9E00200002, 0x80000D9F00200002, 0x80000DA000200002, 0x80000DA100200002, 0x80000DA200200002, 0x80000DA300200002,
+		0x80000DA400200002, 0x80000DA500200002, 0x80000DA600200002, 0x80000DA700200002, 0x80000DA800200002, 0x80000DA900200002, 0x80000DAA00200002, 0x80000DAB00200002,
+		0x80000DAC00200002, 0x80000DAD00200002, 0x80000DAE00200002, 0x80000DAF00200002, 0x80000DB000200002, 0x80000DB100200002, 0x80000DB200200002, 0x80000DB300200002,
+		0x80000DB400200002, 0x80000DB500200002, 0x80000DB600200002, 0x80000DB700200002, 0x80000DB800200002, 0x80000DB900200002, 0x80000DBA00200002, 0x80000DBB00200002,
+		0x80000DBC00200002, 0x80000DBD00200002, 0x80000DBE00200002, 0x80000DBF00200002, 0x80000DC000200002, 0x80000DC100200002, 0x80000DC200200002, 0x80000DC300200002,
+		0x80000DC400200002, 0x80000DC500200002, 0x80000DC600200002, 0x80000DC700200002, 0x80000DC800200002, 0x80000DC900200002, 0x80000DCA00200002, 0x80000DCB00200002,
+		0x80000DCC00200002, 0x80000DCD00200002, 0x80000DCE00200002, 0x80000DCF00200002, 0x80000DD000200002, 0x80000DD100200002, 0x80000DD200200002, 0x80000DD300200002,
+		0x80000DD400200002, 0x80000DD500200002, 0x80000DD600200002, 0x80000DD700200002, 0x80000DD800200002, 0x80000DD900200002, 0x80000DDA00200002, 0x80000DDB00200002,
+		0x80000DDC00200002, 0x80000DDD00200002, 0x80000DDE00200002, 0x80000DDF00200002, 0x80000DE000200002, 0x80000DE100200002, 0x80000DE200200002, 0x80000DE300200002,
+		0x80000DE400200002, 0x80000DE500200002, 0x80000DE600200002, 0x80000DE700200002, 0x80000DE800200002, 0x80000DE900200002, 0x80000DEA00200002, 0x80000DEB00200002,
+		0x80000DEC00200002, 0x80000DED00200002, 0x80000DEE00200002, 0x80000DEF00200002, 0x80000DF000200002, 0x80000DF100200002, 0x80000DF200200002, 0x80000DF300200002,
+		0x80000DF400200002, 0x80000DF500200002, 0x80000DF600200002, 0x80000DF700200002, 0x80000DF800200002, 0x80000DF900200002, 0x80000DFA00200002, 0x80000DFB00200002,
+		0x80000DFC00200002, 0x80000DFD00200002, 0x80000DFE00200002, 0x80000DFF00200002, 0x80000E0000200002, 0x80000E0100200002, 0x80000E0200200002, 0x80000E0300200002,
+		0x80000E0400200002, 0x80000E0500200002, 0x80000E0600200002, 0x80000E0700200002, 0x80000E0800200002, 0x80000E0900200002, 0x80000E0A00200002, 0x80000E0B00200002,
+		0x80000E0C00200002, 0x80000E0D00200002, 0x80000E0E00200002, 0x80000E0F00200002, 0x80000E1000200002, 0x80000E1100200002, 0x80000E1200200002, 0x80000E1300200002,
+		0x80000E1400200002, 0x80000E1500200002, 0x80000E1600200002, 0x80000E1700200002, 0x80000E1800200002, 0x80000E1900200002, 0x80000E1A00200002, 0x80000E1B00200002,
+		0x80000E1C00200002, 0x80000E1D00200002, 0x80000E1E00200002, 0x80000E1F00200002, 0x80000E2000200002, 0x80000E2100200002, 0x80000E2200200002, 0x80000E2300200002,
+		0x80000E2400200002, 0x80000E2500200002, 0x80000E2600200002, 0x80000E2700200002, 0x80000E2800200002, 0x80000E2900200002, 0x80000E2A00200002, 0x80000E2B00200002,
+		0x80000E2C00200002, 0x80000E2D00200002, 0x80000E2E00200002, 0x80000E2F00200002, 0x80000E3000200002, 0x80000E3100200002, 0x80000E3200200002, 0x80000E3300200002,
+		0x80000E3400200002, 0x80000E3500200002, 0x80000E3600200002, 0x80000E3700200002, 0x80000E3800200002, 0x80000E3900200002, 0x80000E3A00200002, 0x80000E3B00200002,
+		0x80000E3C00200002, 0x80000E3D00200002, 0x80000E3E00200002, 0x80000E3F00200002, 0x80000E4000200002, 0x80000E4100200002, 0x80000E4200200002, 0x80000E4300200002,
+		0x80000E4400200002, 0x80000E4500200002, 0x80000E4600200002, 0x80000E4700200002, 0x80000E4800200002, 0x80000E4900200002, 0x80000E4A00200002, 0x80000E4B00200002,
+		0x80000E4C00200002, 0x80000E4D00200002, 0x80000E4E00200002, 0x80000E4F00200002, 0x80000E5000200002, 0x80000E5100200002, 0x80000E5200200002, 0x80000E5300200002,
+		0x80000E5400200002, 0x80000E5500200002, 0x80000E5600200002, 0x80000E5700200002, 0x80000E5800200002, 0x80000E5900200002, 0x80000E5A00200002, 0x80000E5B00200002,
+		0x80000E5C00200002, 0x80000E5D00200002, 0x80000E5E00200002, 0x80000E5F00200002, 0x80000E6000200002, 0x80000E6100200002, 0x80000E6200200002, 0x80000E6300200002,
+		0x80000E6400200002, 0x80000E6500200002, 0x80000E6600200002, 0x80000E6700200002, 0x80000E6800200002, 0x80000E6900200002, 0x80000E6A00200002, 0x80000E6B00200002,
+		0x80000E6C00200002, 0x80000E6D00200002, 0x80000E6E00200002, 0x80000E6F00200002, 0x80000E7000200002, 0x80000E7100200002, 0x80000E7200200002, 0x80000E7300200002,
+		0x80000E7400200002, 0x80000E7500200002, 0x80000E7600200002, 0x80000E7700200002, 0x80000E7800200002, 0x80000E7900200002, 0x80000E7A00200002, 0x80000E7B00200002,
+		0x80000E7C00200002, 0x80000E7D00200002, 0x80000E7E00200002, 0x80000E7F00200002, 0x80000E8000200002, 0x80000E8100200002, 0x80000E8200200002, 0x80000E8300200002,
+		0x80000E8400200002, 0x80000E8500200002, 0x80000E8600200002, 0x80000E8700200002, 0x80000E8800200002, 0x80000E8900200002, 0x80000E8A00200002, 0x80000E8B00200002,
+		0x80000E8C00200002, 0x80000E8D00200002, 0x80000E8E00200002, 0x80000E8F00200002, 0x80000E9000200002, 0x80000E9100200002, 0x80000E9200200002, 0x80000E9300200002,
+		0x80000E9400200002, 0x80000E9500200002, 0x80000E9600200002, 0x80000E9700200002, 0x80000E9800200002, 0x80000E9900200002, 0x80000E9A00200002, 0x80000E9B00200002,
+		0x80000AE900200002, 0x80000AEA00200002, 0x80000AEB00200002, 0x80000AEC00200002, 0x80000AED00200002, 0x80000AEE00200002, 0x80000AEF00200002, 0x80000AF000200002,
+		0x80000AF100200002, 0x80000AF200200002, 0x80000AF300200002, 0x80000AF400200002, 0x80000AF500200002, 0x80000AF600200002, 0x80000AF700200002, 0x80000AF800200002,
+		0x80000AF900200002, 0x80000AFA00200002, 0x80000AFB00200002, 0x80000AFC00200002, 0x80000AFD00200002, 0x80000AFE00200002, 0x80000AFF00200002, 0x80000B0000200002,
+		0x80000B0100200002, 0x80000B0200200002, 0x80000B0300200002, 0x80000B0400200002, 0x80000B0500200002, 0x80000B0600200002, 0x80000B0700200002, 0x80000B0800200002,
+		0x80000B0900200002, 0x80000B0A00200002, 0x80000B0B00200002, 0x80000B0C00200002, 0x80000B0D00200002, 0x80000B0E00200002, 0x80000B0F00200002, 0x80000B1000200002,
+		0x80000B1100200002, 0x80000B1200200002, 0x80000B1300200002, 0x80000B1400200002, 0x80000B1500200002, 0x80000B1600200002, 0x80000B1700200002, 0x80000B1800200002,
+		0x80000B1900200002, 0x80000B1A00200002, 0x80000B1B00200002, 0x80000B1C00200002, 0x80000B1D00200002, 0x80000B1E00200002, 0x80000B1F00200002, 0x80000B2000200002,
+		0x80000B2100200002, 0x80000B2200200002, 0x80000B2300200002, 0x80000B2400200002, 0x80000B2500200002, 0x80000B2600200002, 0x80000B2700200002, 0x80000B2800200002,
+		0x80000B2900200002, 0x80000B2A00200002, 0x80000B2B00200002, 0x80000B2C00200002, 0x80000B2D00200002, 0x80000B2E00200002, 0x80000B2F00200002, 0x80000B3000200002,
+		0x80000B3100200002, 0x80000B3200200002, 0x80000B3300200002, 0x80000B3400200002, 0x80000B3500200002, 0x80000B3600200002, 0x80000B3700200002, 0x80000B3800200002,
+		0x80000B3900200002, 0x80000B3A00200002, 0x80000B3B00200002, 0x80000B3C00200002, 0x80000B3D00200002, 0x80000B3E00200002, 0x80000B3F00200002, 0x80000B4000200002,
+		0x80000B4100200002, 0x80000B4200200002, 0x80000B4300200002, 0x80000B4400200002, 0x80000B4500200002, 0x80000B4600200002, 0x80000B4700200002, 0x80000B4800200002,
+		0x80000B4900200002, 0x80000B4A00200002, 0x80000B4B00200002, 0x80000B4C00200002, 0x80000B4D00200002, 0x80000B4E00200002, 0x80000B4F00200002, 0x80000B5000200002,
+		0x80000B5100200002, 0x80000B5200200002, 0x80000B5300200002, 0x80000B5400200002, 0x80000B5500200002, 0x80000B5600200002, 0x80000B5700200002, 0x80000B5800200002,
+		0x80000B5900200002, 0x80000B5A00200002, 0x80000B5B00200002, 0x80000B5C00200002, 0x80000B5D00200002, 0x80000B5E00200002, 0x80000B5F00200002, 0x80000B6000200002,
+		0x80000B6100200002, 0x80000B6200200002, 0x80000B6300200002, 0x80000B6400200002, 0x80000B6500200002, 0x80000B6600200002, 0x80000B6700200002, 0x80000B6800200002,
+		0x80000B6900200002, 0x80000B6A00200002, 0x80000B6B00200002, 0x8000032B00200002, 0x8000032C00200002, 0x8000032D00200002, 0x8000032E00200002, 0x8000032F00200002,
+		0x8000033000200002, 0x8000033100200002, 0x8000033200200002, 0x8000033300200002, 0x8000033400200002, 0x8000033500200002, 0x8000033600200002, 0x8000033700200002,
+		0x8000033800200002, 0x8000033900200002, 0x8000033A00200002, 0x8000033B00200002, 0x8000033C00200002, 0x8000033D00200002, 0x8000033E00200002, 0x8000033F00200002,
+		0x8000034000200002, 0x80000B6C00200002, 0x80000B6D00200002, 0x80000B6E00200002, 0x80000B6F00200002, 0x80000B7000200002, 0x80000B7100200002, 0x80000B7200200002,
+		0x80000B7300200002, 0x80000B7400200002, 0x80000B7500200002, 0x80000B7600200002, 0x80000B7700200002, 0x80000B7800200002, 0x80000B7900200002, 0x80000B7A00200002,
+		0x80000B7B00200002, 0x80000B7C00200002, 0x80000B7D00200002, 0x80000B7E00200002, 0x80000B7F00200002, 0x80000B8000200002, 0x80000B8100200002, 0x80000B8200200002,
+		0x80000B8300200002, 0x80000B8400200002, 0x80000B8500200002, 0x80000B8600200002, 0x80000B8700200002, 0x80000B8800200002, 0x80000B8900200002, 0x80000B8A00200002,
+		0x80000B8B00200002, 0x80000B8C00200002, 0x80000B8D00200002, 0x80000B8E00200002, 0x80000B8F00200002, 0x80000B9000200002, 0x80000B9100200002, 0x80000B9200200002,
+		0x80000B9300200002, 0x80000B9400200002, 0x80000B9500200002, 0x80000B9600200002, 0x80000B9700200002, 0x80000B9800200002, 0x80000B9900200002, 0x80000B9A00200002,
+		0x80000B9B00200002, 0x80000B9C00200002, 0x80000B9D00200002, 0x80000B9E00200002, 0x80000B9F00200002, 0x80000BA000200002, 0x80000BA100200002, 0x80000BA200200002,
+		0x80000BA300200002, 0x80000BA400200002, 0x80000BA500200002, 0x80000BA600200002, 0x80000BA700200002, 0x80000BA800200002, 0x80000BA900200002, 0x80000BAA00200002,
+		0x8000038500200002, 0x8000038600200002, 0x8000038700200002, 0x8000038800200002, 0x80000BAB00200002, 0x80000BAC00200002, 0x80000BAD00200002, 0x80000BAE00200002,
+		0x80000BAF00200002, 0x80000BB000200002, 0x80000BB100200002, 0x80000BB200200002, 0x80000BB300200002, 0x80000BB400200002, 0x80000BB500200002, 0x80000BB600200002,
+		0x80000BB700200002, 0x80000BB800200002, 0x80000BB900200002, 0x80000BBA00200002, 0x80000BBB00200002, 0x80000BBC00200002, 0x80000BBD00200002, 0x80000BBE00200002,
+		0x80000BBF00200002, 0x80000BC000200002, 0x80000BC100200002, 0x80000BC200200002, 0x80000BC300200002, 0x80000BC400200002, 0x80000BC500200002, 0x80000BC600200002,
+		0x80000BC700200002, 0x80000BC800200002, 0x80000BC900200002, 0x80000BCA00200002, 0x8000032900200002, 0x8000032A00200002, 0x80000BCB00200002, 0x80000BCC00200002,
+		0x80000BCD00200002, 0x80000BCE00200002, 0x80000BCF00200002, 0x80000BD000200002, 0x80000BD100200002, 0x80000BD200200002, 0x80000BD300200002, 0x80000BD400200002,
+		0x80000BD500200002, 0x80000BD600200002, 0x80000BD700200002, 0x80000BD800200002, 0x40000000000AFD04, 0x80000BD900200002, 0x80000BDA00200002, 0x80000BDB00200002,
+		0x80000BDC00200002, 0x80000BDD00200002, 0x80000BDE00200002, 0x80000BDF00200002, 0x80000BE000200002, 0x80000BE100200002, 0x80000BE200200002, 0x80000BE300200002,
+		0x80000BE400200002, 0x80000BE500200002, 0x80000BE600200002, 0x80000BE700200002, 0x80000BE800200002, 0x80000BE900200002, 0x80000BEA00200002, 0x80000BEB00200002,
+		0x80000BEC00200002, 0x80000BED00200002, 0x80000BEE00200002, 0x80000BEF00200002, 0x80000BF000200002, 0x80000BF100200002, 0x80000BF200200002, 0x80000BF300200002,
+		0x80000BF400200002, 0x80000BF500200002, 0x80000BF600200002, 0x80000BF700200002, 0x80000BF800200002, 0x80000BF900200002, 0x80000BFA00200002, 0x80000BFB00200002,
+		0x80000BFC00200002, 0x80000BFD00200002, 0x80000BFE00200002, 0x80000BFF00200002, 0x80000C0000200002, 0x80000C0100200002, 0x80000C0200200002, 0x80000C0300200002,
+		0x80000C0400200002, 0x80000C0500200002, 0x80000C0600200002, 0x80000C0700200002, 0x80000C0800200002, 0x80000C0900200002, 0x80000C0A00200002, 0x80000C0B00200002,
+		0x80000C0C00200002, 0x80000C0D00200002, 0x80000C0E00200002, 0x80000C0F00200002, 0x80000C1000200002, 0x80000C1100200002, 0x80000C1200200002, 0x80000C1300200002,
+		0x80000C1400200002, 0x80000C1500200002, 0x80000C1600200002, 0x80000C1700200002, 0x80000C1800200002, 0x80000C1900200002, 0x80000C1A00200002, 0x80000C1B00200002,
+		0x80000C1C00200002, 0x80000C1D00200002, 0x80000C1E00200002, 0x80000C1F00200002, 0x80000C2000200002, 0x80000C2100200002, 0x80000C2200200002, 0x80000C2300200002,
+		0x80000C2400200002, 0x80000C2500200002, 0x80000C2600200002, 0x80000C2700200002, 0x80000C2800200002, 0x80000C2900200002, 0x80000C2A00200002, 0x80000C2B00200002,
+		0x80000C2C00200002, 0x80000C2D00200002, 0x80000C2E00200002, 0x80000C2F00200002, 0x80000C3000200002, 0x80000C3100200002, 0x80000C3200200002, 0x80000C3300200002,
+		0x80000C3400200002, 0x80000C3500200002, 0x80000C3600200002, 0x80000C3700200002, 0x80000C3800200002, 0x80000C3900200002, 0x80000C3A00200002, 0x80000C3B00200002,
+		0x80000C3C00200002, 0x80000C3D00200002, 0x80000C3E00200002, 0x80000C3F00200002, 0x40000000000B0103, 0x40000000000B0402, 0x40000000000B0603, 0x80000C4000200002,
+		0x80000C4100200002, 0x80000C4200200002, 0x80000C4300200002, 0x80000C4400200002, 0x80000C4500200002, 0x80000C4600200002, 0x80000C4700200002, 0x80000C4800200002,
+		0x80000C4900200002, 0x80000C4A00200002, 0x80000C4B00200002, 0x80000C4C00200002, 0x80000C4D00200002, 0x80000C4E00200002, 0x80000C4F00200002, 0x80000C5000200002,
+		0x80000C5100200002, 0x80000C5200200002, 0x80000C5300200002, 0x80000C5400200002, 0x80000C5500200002, 0x80000C5600200002, 0x80000C5700200002, 0x80000C5800200002,
+		0x80000C5900200002, 0x80000C5A00200002, 0x80000C5B00200002, 0x80000C5C00200002, 0x80000C5D00200002, 0x80000C5E00200002, 0x80000C5F00200002, 0x80000C6000200002,
+		0x80000C6100200002, 0x80000C6200200002, 0x80000C6300200002, 0x80000C6400200002, 0x80000C6500200002, 0x80000C6600200002, 0x80000C6700200002, 0x80000C6800200002,
+		0x80000C6900200002, 0x80000C6A00200002, 0x80000C6B00200002, 0x80000C6C00200002, 0x80000C6D00200002, 0x80000C6E00200002, 0x80000C6F00200002, 0x80000C7000200002,
+		0x80000C7100200002, 0x80000C7200200002, 0x80000C7300200002, 0x80000C7400200002, 0x80000C7500200002, 0x80000C7600200002, 0x80000C7700200002, 0x80000C7800200002,
+		0x80000C7900200002, 0x80000C7A00200002, 0x80000C7B00200002, 0x80000C7C00200002, 0x80000C7D00200002, 0x80000C7E00200002, 0x80000C7F00200002, 0x80000C8000200002,
+		0x80000C8100200002, 0x80000C8200200002, 0x80000C8300200002, 0x80000C8400200002, 0x80000C8500200002, 0x80000C8600200002, 0x80000C8700200002, 0x80000C8800200002,
+		0x80000C8900200002, 0x80000C8A00200002, 0x80000C8B00200002, 0x80000C8C00200002, 0x80000C8D00200002, 0x80000C8E00200002, 0x80000C8F00200002, 0x80000C9000200002,
+		0x80000C9100200002, 0x80000C9200200002, 0x80000C9300200002, 0x80000C9400200002, 0x80000C9500200002, 0x80000C9600200002, 0x80000C9700200002, 0x80000C9800200002,
+		0x80000C9900200002, 0x80000C9A00200002, 0x80000C9B00200002, 0x80000C9C00200002, 0x80000C9D00200002, 0x80000C9E00200002, 0x80000C9F00200002, 0x80000CA000200002,
+		0x80000CA100200002, 0x80000CA200200002, 0x80000CA300200002, 0x80000CA400200002, 0x40000000000B0902, 0x80000CA500200002, 0x80000CA600200002, 0x80000CA700200002,
+		0x80000CA800200002, 0x80000CA900200002, 0x80000CAA00200002, 0x80000CAB00200002, 0x80000CAC00200002, 0x80000CAD00200002, 0x80000CAE00200002, 0x80000CAF00200002,
+		0x80000CB000200002, 0x80000CB100200002, 0x80000CB200200002, 0x80000CB300200002, 0x80000CB400200002, 0x80000CB500200002, 0x80000CB600200002, 0x80000CB700200002,
+		0x80000CB800200002, 0x80000CB900200002, 0x80000CBA00200002, 0x80000CBB00200002, 0x80000CBC00200002, 0x80000CBD00200002, 0x80000CBE00200002, 0x80000CBF00200002,
+		0x80000CC000200002, 0x80000CC100200002, 0x80000CC200200002, 0x80000CC300200002, 0x80000CC400200002, 0x80000CC500200002, 0x80000CC600200002, 0x80000CC700200002,
+		0x80000CC800200002, 0x80000CC900200002, 0x80000CCA00200002, 0x80000CCB00200002, 0x80000CCC00200002, 0x80000CCD00200002, 0x80000CCE00200002, 0x80000CCF00200002,
+		0x80000CD000200002, 0x80000CD100200002, 0x80000CD200200002, 0x80000CD300200002, 0x80000CD400200002, 0x80000CD500200002, 0x80000CD600200002, 0x80000CD700200002,
+		0x80000CD800200002, 0x80000CD900200002, 0x80000CDA00200002, 0x80000CDB00200002, 0x80000CDC00200002, 0x80000CDD00200002, 0x80000CDE00200002, 0x80000CDF00200002,
+		0x80000CE000200002, 0x80000CE100200002, 0x80000CE200200002, 0x80000CE300200002, 0x80000CE400200002, 0x80000CE500200002, 0x80000CE600200002, 0x80000CE700200002,
+		0x80000CE800200002, 0x80000CE900200002, 0x80000CEA00200002, 0x80000CEB00200002, 0x80000CEC00200002, 0x80000CED00200002, 0x80000CEE00200002, 0x80000CEF00200002,
+		0x80000CF000200002, 0x80000CF100200002, 0x80000CF200200002, 0x80000CF300200002, 0x80000CF400200002, 0x80000CF500200002, 0x80000CF600200002, 0x80000CF700200002,
+		0x80000CF800200002, 0x80000CF900200002, 0x80000CFA00200002, 0x80000CFB00200002, 0x80000CFC00200002, 0x80000CFD00200002, 0x80000CFE00200002, 0x80000CFF00200002,
+		0x80000D0000200002, 0x80000D0100200002, 0x80000D0200200002, 0x80000D0300200002, 0x80000D0400200002, 0x80000D0500200002, 0x80000D0600200002, 0x80000D0700200002,
+		0x80000D0800200002, 0x80000D0900200002, 0x80000D0A00200002, 0x80000D0B00200002, 0x80000D0C00200002, 0x80000D0D00200002, 0x80000D0E00200002, 0x80000D0F00200002,
+		0x80000D1000200002, 0x80000D1100200002, 0x80000D1200200002, 0x80000D1300200002, 0x80000D1400200002, 0x80000D1500200002, 0x80000D1600200002, 0x80000D1700200002,
+		0x80000D1800200002, 0x80000D1900200002, 0x80000D1A00200002, 0x80000D1B00200002, 0x80000D1C00200002, 0x80000D1D00200002, 0x80000D1E00200002, 0x80000D1F00200002,
+		0x80000D2000200002, 0x80000D2100200002, 0x80000D2200200002, 0x80000D2300200002, 0x80000D2400200002, 0x80000D2500200002, 0x80000D2600200002, 0x80000D2700200002,
+		0x80000D2800200002, 0x80000D2900200002, 0x80000D2A00200002, 0x80000D2B00200002, 0x80000D2C00200002, 0x80000D2D00200002, 0x80000D2E00200002, 0x80000D2F00200002,
+		0x80000D3000200002, 0x80000D3100200002, 0x80000D3200200002, 0x80000D3300200002, 0x80000D3400200002, 0x80000D3500200002, 0x80000D3600200002, 0x80000D3700200002,
+		0x80000D3800200002, 0x80000D3900200002, 0x80000D3A00200002, 0x80000D3B00200002, 0, 0, 0x80000D3C00200002, 0x80000D3D00200002,
+		0x80000D3E00200002, 0x80000D3F00200002, 0x80000D4000200002, 0x80000D4100200002, 0x80000D4200200002, 0x80000D4300200002, 0x80000D4400200002, 0x80000D4500200002,
+		0x80000D4600200002, 0x80000D4700200002, 0x80000D4800200002, 0x80000D4900200002, 0x80000D4A00200002, 0x80000D4B00200002, 0x80000D4C00200002, 0x80000D4D00200002,
+		0x80000D4E00200002, 0x80000D4F00200002, 0x80000D5000200002, 0x80000D5100200002, 0x80000D5200200002, 0x80000D5300200002, 0x80000D5400200002, 0x80000D5500200002,
+		0x80000D5600200002, 0x80000D5700200002, 0x80000D5800200002, 0x80000D5900200002, 0x80000D5A00200002, 0x80000D5B00200002, 0, 0,
+		0x80000D5C00200002, 0x80000D5D00200002, 0x80000D5E00200002, 0x80000D5F00200002, 0x80000D6000200002, 0x80000D6100200002, 0x80000D6200200002, 0x80000D6300200002,
+		0x80000D6400200002, 0x80000D6500200002, 0x80000D6600200002, 0x80000D6700200002, 0x80000D6800200002, 0x80000D6900200002, 0x80000D6A00200002, 0x80000D6B00200002,
+		0x80000D6C00200002, 0x80000D6D00200002, 0x80000D6E00200002, 0x80000D6F00200002, 0x80000D7000200002, 0x80000D7100200002, 0x80000D7200200002, 0x80000D7300200002,
+		0x80000D7400200002, 0x80000D7500200002, 0x80000D7600200002, 0x80000D7700200002, 0x80000D7800200002, 0x80000D7900200002, 0x80000D7A00200002, 0x80000D7B00200002,
+		0x80000D7C00200002, 0x80000D7D00200002, 0, 0, 0, 0x80000D7E00200002, 0x80000D7F00200002, 0x80000D8000200002,
+		0x80000D8100200002, 0x80000D8200200002, 0x80000D8300200002, 0x80000D8400200002, 0x80000D8500200002, 0x80000D8600200002, 0x80000D8700200002, 0x80000D8800200002,
+		0x80000D8900200002, 0, 0x80000D8A00200002, 0x80000D8B00200002, 0x80000D8C00200002, 0x80000D8D00200002, 0x80000D8E00200002, 0x80000D8F00200002,
+		0x80000D9000200002, 0x80000D9100200002, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0x80000D9200200002, 0x80000D9300200002, 0x80000D9400200002, 0x80000D9500200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800021E500200008, 0x800021E600200008, 0x800021E700200008, 0x800021E800200008, 0x800021E900200008, 0x800021EA00200008, 0x800021EB00200008, 0x800021EC00200008,
+		0x800021ED00200008, 0x800021EE00200008, 0x800021EF00200008, 0x800021F000200008, 0x800021F100200008, 0x800021F200200008, 0x800021F300200008, 0x800021F400200008,
+		0x800021F500200008, 0x800021F600200008, 0x800021F700200008, 0x800021F800200008, 0x800021F900200008, 0x800021FA00200008, 0x800021FB00200008, 0x800021FC00200008,
+		0x800021FD00200008, 0x800021FE00200008, 0x800021FF00200008, 0x8000220000200008, 0x8000220100200008, 0x8000220200200008, 0x8000220300200008, 0x8000220400200008,
+		0x8000220500200008, 0x8000220600200008, 0x8000220700200008, 0x8000220800200008, 0x8000220900200008, 0x8000220A00200008, 0x8000220B00200008, 0x8000220C00200008,
+		0x8000220D00200008, 0x8000220E00200008, 0x8000220F00200008, 0x8000221000200008, 0x8000221100200008, 0x8000221200200008, 0x8000221300200008, 0,
+		0x800021E500200002, 0x800021E600200002, 0x800021E700200002, 0x800021E800200002, 0x800021E900200002, 0x800021EA00200002, 0x800021EB00200002, 0x800021EC00200002,
+		0x800021ED00200002, 0x800021EE00200002, 0x800021EF00200002, 0x800021F000200002, 0x800021F100200002, 0x800021F200200002, 0x800021F300200002, 0x800021F400200002,
+		0x800021F500200002, 0x800021F600200002, 0x800021F700200002, 0x800021F800200002, 0x800021F900200002, 0x800021FA00200002, 0x800021FB00200002, 0x800021FC00200002,
+		0x800021FD00200002, 0x800021FE00200002, 0x800021FF00200002, 0x8000220000200002, 0x8000220100200002, 0x8000220200200002, 0x8000220300200002, 0x8000220400200002,
+		0x8000220500200002, 0x8000220600200002, 0x8000220700200002, 0x8000220800200002, 0x8000220900200002, 0x8000220A00200002, 0x8000220B00200002, 0x8000220C00200002,
+		0x8000220D00200002, 0x8000220E00200002, 0x8000220F00200002, 0x8000221000200002, 0x8000221100200002, 0x8000221200200002, 0x8000221300200002, 0,
+		0x80001D8600200008, 0x80001D8600200002, 0x80001D8700200008, 0x80001E1100200008, 0x80001E5700200008, 0x80001C4C00200002, 0x80001E9E00200002, 0x80001D2900200008,
+		0x80001D2900200002, 0x80001D6F00200008, 0x80001D6F00200002, 0x80001F3C00200008, 0x80001F3C00200002, 0x80001C5500200008, 0x80001DB100200008, 0x80001C5100200008,
+		0x80001C5B00200008, 0x80001EEE00200002, 0x80001EFA00200008, 0x80001EFA00200002, 0x80001EEF00200002, 0x80001D2A00200008, 0x80001D2A00200002, 0x80001E2000200002,
+		0x80001CB700200002, 0x80001E5200200002, 0x80001DFC00200002, 0x80001CBC00200002, 0x80001D4C00200015, 0x80001EE30020001D, 0x80001E7C00200008, 0x80001F3800200008,
+		0x80001FE600200008, 0x80001FE600200002, 0x80001FE700200008, 0x80001FE700200002, 0x80001FE800200008, 0x80001FE800200002, 0x80001FE900200008, 0x80001FE900200002,
+		0x80001FEA00200008, 0x80001FEA00200002, 0x80001FEC00200008, 0x80001FEC00200002, 0x80001FED00200008, 0x80001FED00200002, 0x80001FEE00200008, 0x80001FEE00200002,
+		0x80001FEF00200008, 0x80001FEF00200002, 0x80001FF000200008, 0x80001FF000200002, 0x80001FF100200008, 0x80001FF100200002, 0x80001FF300200008, 0x80001FF300200002,
+		0x80001FF400200008, 0x80001FF400200002, 0x80001FF500200008, 0x80001FF500200002, 0x80001FF800200008, 0x80001FF800200002, 0x80001FF900200008, 0x80001FF900200002,
+		0x80001FFA00200008, 0x80001FFA00200002, 0x80001FFB00200008, 0x80001FFB00200002, 0x80001FFC00200008, 0x80001FFC00200002, 0x80001FFD00200008, 0x80001FFD00200002,
+		0x80001FFE00200008, 0x80001FFE00200002, 0x80001FFF00200008, 0x80001FFF00200002, 0x8000200000200008, 0x8000200000200002, 0x8000200100200008, 0x8000200100200002,
+		0x8000200200200008, 0x8000200200200002, 0x8000201D00200008, 0x8000201D00200002, 0x8000201E00200008, 0x8000201E00200002, 0x80001FEB00200008, 0x80001FEB00200002,
+		0x80001FF200200008, 0x80001FF200200002, 0x80001FF600200008, 0x80001FF600200002, 0x80001FF700200008, 0x80001FF700200002, 0x8000200300200008, 0x8000200300200002,
+		0x8000200400200008, 0x8000200400200002, 0x8000200700200008, 0x8000200700200002, 0x8000200800200008, 0x8000200800200002, 0x8000200900200008, 0x8000200900200002,
+		0x8000200D00200008, 0x8000200D00200002, 0x8000200F00200008, 0x8000200F00200002, 0x8000201000200008, 0x8000201000200002, 0x8000201100200008, 0x8000201100200002,
+		0x8000201200200008, 0x8000201200200002, 0x8000201300200008, 0x8000201300200002, 0x8000201400200008, 0x8000201400200002, 0x8000201700200008, 0x8000201700200002,
+		0x8000201900200008, 0x8000201900200002, 0x8000201A00200008, 0x8000201A00200002, 0x8000201B00200008, 0x8000201B00200002, 0x8000201F00200008, 0x8000201F00200002,
+		0x8000202000200008, 0x8000202000200002, 0x8000202100200008, 0x8000202100200002, 0x40000000000B0B03, 0x80000D9600200002, 0x80000D9700200002, 0x80000D9800200002,
+		0x80000D9900200002, 0x80000D9A00200002, 0x80000D9B00200002, 0x8000200600200008, 0x8000200600200002, 0x8000201600200008, 0x8000201600200002, 0x8000000000330002,
+		0x8000000000230002, 0x8000000000220002, 0x8000200C00200008, 0x8000200C00200002, 0, 0, 0, 0,
+		0, 0x8000028100200002, 0x8000026E00200002, 0x8000026F00200002, 0x800002F600200002, 0x80001AE200200002, 0x8000028200200002, 0x800002F700200002,
+		0x8000223B00200002, 0x8000223D00200002, 0x8000223F00200002, 0x8000224100200002, 0x8000224300200002, 0x8000224500200002, 0x8000224700200002, 0x8000224B00200002,
+		0x8000224D00200002, 0x8000224F00200002, 0x8000225100200002, 0x8000225300200002, 0x8000225500200002, 0x8000225900200002, 0x8000225B00200002, 0x8000225D00200002,
+		0x8000225F00200002, 0x8000226100200002, 0x8000226300200002, 0x8000226700200002, 0x8000226900200002, 0x8000226B00200002, 0x8000226D00200002, 0x8000226F00200002,
+		0x8000227100200002, 0x8000227300200002, 0x8000227500200002, 0x8000227700200002, 0x8000227900200002, 0x8000227B00200002, 0x8000227D00200002, 0x8000228100200002,
+		0x8000228300200002, 0x8000224900200002, 0x8000225700200002, 0x8000226500200002, 0x8000227F00200002, 0x8000228500200002, 0, 0x8000228800200002,
+		0, 0, 0, 0, 0, 0x8000228D00200002, 0, 0,
+		0x8000245D00200002, 0x8000245E00200002, 0x8000245F00200002, 0x8000246000200002, 0x8000246100200002, 0x8000246200200002, 0x8000246300200002, 0x8000246400200002,
+		0x8000246500200002, 0x8000246600200002, 0x8000246700200002, 0x8000246800200002, 0x8000246A00200002, 0x8000246B00200002, 0x8000246C00200002, 0x8000246D00200002,
+		0x8000246E00200002, 0x8000246F00200002, 0x8000247000200002, 0x8000247100200002, 0x8000247200200002, 0x8000247300200002, 0x8000247400200002, 0x8000247500200002,
+		0x8000247600200002, 0x8000247700200002, 0x8000247800200002, 0x8000247900200002, 0x8000247A00200002, 0x8000247B00200002, 0x8000247C00200002, 0x8000247D00200002,
+		0x8000247E00200002, 0x8000247F00200002, 0x8000248000200002, 0x8000248100200002, 0x8000248300200002, 0x8000248400200002, 0x8000248500200002, 0x8000248600200002,
+		0x8000248700200002, 0x8000248800200002, 0x8000248900200002, 0x8000248A00200002, 0x8000248B00200002, 0x8000248C00200002, 0x8000248D00200002, 0x8000248E00200002,
+		0x8000248F00200002, 0x8000249000200002, 0x8000249100200002, 0x8000249200200002, 0x8000249300200002, 0x8000249400200002, 0x8000246900200002, 0x8000248200200002,
+		0, 0, 0, 0, 0, 0, 0, 0x8000249500200002,
+		0x8000042700200002, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0x8000000000000000,
+		0x800024A600200002, 0x800024BB00200002, 0x800024CC00200002, 0x800024D500200002, 0x800024E400200002, 0x8000250A00200002, 0x8000251B00200002, 0x8000252400200002,
+		0x8000253A00200002, 0x8000254300200002, 0x8000254C00200002, 0x8000257D00200002, 0x8000259C00200002, 0x800025AB00200002, 0x800025B400200002, 0x800025D600200002,
+		0x800025DF00200002, 0x800025EF00200002, 0x8000261F00200002, 0x800025CA00200002, 0x800025CB00200002, 0x800025CC00200002, 0x800025CD00200002, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000262300200002, 0x8000262400200002, 0x8000262500200002, 0x8000262600200002, 0x8000262700200002, 0x8000262800200002, 0x8000262900200002, 0,
+		0x8000262A00200002, 0x8000262B00200002, 0x8000262C00200002, 0x8000262D00200002, 0x8000262E00200002, 0x8000262F00200002, 0x8000263000200002, 0,
+		0x8000263100200002, 0x8000263200200002, 0x8000263300200002, 0x8000263400200002, 0x8000263500200002, 0x8000263600200002, 0x8000263700200002, 0,
+		0x8000263800200002, 0x8000263900200002, 0x8000263A00200002, 0x8000263B00200002, 0x8000263C00200002, 0x8000263D00200002, 0x8000263E00200002, 0,
+		0x8000263F00200002, 0x8000264000200002, 0x8000264100200002, 0x8000264200200002, 0x8000264300200002, 0x8000264400200002, 0x8000264500200002, 0,
+		0x8000264600200002, 0x8000264700200002, 0x8000264800200002, 0x8000264900200002, 0x8000264A00200002, 0x8000264B00200002, 0x8000264C00200002, 0,
+		0x8000264D00200002, 0x8000264E00200002, 0x8000264F00200002, 0x8000265000200002, 0x8000265100200002, 0x8000265200200002, 0x8000265300200002, 0,
+		0x8000265400200002, 0x8000265500200002, 0x8000265600200002, 0x8000265700200002, 0x8000265800200002, 0x8000265900200002, 0x8000265A00200002, 0,
+		0x8000202E00200004, 0x8000203200200004, 0x8000203600200004, 0x8000204A00200004, 0x8000206200200004, 0x8000206C00200004, 0x8000209600200004, 0x800020B000200004,
+		0x800020C300200004, 0x800020CC00200004, 0x800020E700200004, 0x800020EF00200004, 0x800020FC00200004, 0x8000210500200004, 0x8000210E00200004, 0x8000213200200004,
+		0x8000215900200004, 0x8000216400200004, 0x8000218300200004, 0x8000218800200004, 0x800021D300200004, 0x40000000000B0E02, 0x8000202200200004, 0x8000205A00200004,
+		0x8000209500200004, 0x8000212900200004, 0x800021A000200004, 0x800021A900200004, 0x800021AE00200004, 0x800021B800200004, 0x800021BD00200004, 0x800021C700200004,
+		0x800003B600200002, 0x800003B700200002, 0x8000035B00200002, 0x8000035C00200002, 0x8000035D00200002, 0x8000035E00200002, 0x800003B800200002, 0x800003B900200002,
+		0x800003BA00200002, 0x8000035F00200002, 0x8000036000200002, 0x800003BB00200002, 0x8000036100200002, 0x8000036200200002, 0x800003BC00200002, 0x800003BD00200002,
+		0x800003BE00200002, 0x800003BF00200002, 0x800003C000200002, 0x800003C100200002, 0x800003C200200002, 0x800003C300200002, 0x800003C400200002, 0x8000021C00200002,
+		0x8000027600200002, 0x800002F800200002, 0x800003C500200002, 0x800003C600200002, 0x8000036300200002, 0x8000036400200002, 0x800003C700200002, 0x800003C800200002,
+		0x8000036500200002, 0x8000036600200002, 0x8000036700200002, 0x8000036800200002, 0x8000036900200002, 0x8000036A00200002, 0x8000036B00200002, 0x8000036C00200002,
+		0x8000036D00200002, 0x8000036E00200002, 0x800002F100200002, 0x800002F200200002, 0x800002F300200002, 0x800002F400200002, 0x8000026800200002, 0x8000218D00200002,
+		0x8000028300200002, 0x8000028C00200002, 0x8000022400200002, 0x8000028D00200002, 0x8000022300200002, 0x8000023700200002, 0x800003A100200002, 0x800003A200200002,
+		0x800003A300200002, 0x8000038A00200002, 0x8000021800200002, 0x8000021900200002, 0x8000028400200002, 0x800002F500200002, 0x8000038400200002, 0x8000038D00200002,
+		0x8000021D00200002, 0x8000022500200002, 0x8000031100200002, 0x8000021B00200002, 0x800003C900200002, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x40000000000B1003, 0x40000000000B1303, 0x40000000000B1602, 0x40000000000B1802, 0x40000000000B1A03, 0x40000000000B1D02, 0x40000000000B1F03, 0x40000000000B2203,
+		0x40000000000B2503, 0x40000000000B2802, 0x40000000000B2A03, 0x40000000000B2D03, 0x40000000000B3003, 0x40000000000B3303, 0x40000000000B3603, 0x40000000000B3902,
+		0x40000000000B3B02, 0x40000000000B3D03, 0x40000000000B4002, 0x40000000000B4202, 0x40000000000B4402, 0x40000000000B4603, 0x40000000000B4902, 0x40000000000B4B03,
+		0x40000000000B4E02, 0x40000000000B5002, 0, 0x40000000000B5202, 0x40000000000B5403, 0x40000000000B5703, 0x40000000000B5A03, 0x40000000000B5D02,
+		0x40000000000B5F02, 0x40000000000B6102, 0x40000000000B6302, 0x40000000000B6502, 0x40000000000B6702, 0x40000000000B6903, 0x40000000000B6C02, 0x40000000000B6E03,
+		0x40000000000B7102, 0x40000000000B7303, 0x40000000000B7603, 0x40000000000B7903, 0x40000000000B7C03, 0x40000000000B7F02, 0x40000000000B8103, 0x40000000000B8402,
+		0x40000000000B8602, 0x40000000000B8802, 0x40000000000B8A02, 0x40000000000B8C03, 0x40000000000B8F03, 0x40000000000B9203, 0x40000000000B9503, 0x40000000000B9803,
+		0x40000000000B9B02, 0x40000000000B9D02, 0x40000000000B9F02, 0x40000000000BA103, 0x40000000000BA403, 0x40000000000BA703, 0x40000000000BAA02, 0x40000000000BAC03,
+		0x40000000000BAF03, 0x40000000000BB202, 0x40000000000BB402, 0x40000000000BB602, 0x40000000000BB802, 0x40000000000BBA02, 0x40000000000BBC02, 0x40000000000BBE03,
+		0x40000000000BC102, 0x40000000000BC302, 0x40000000000BC503, 0x40000000000BC802, 0x40000000000BCA02, 0x40000000000BCC03, 0x40000000000BCF03, 0x40000000000BD203,
+		0x40000000000BD502, 0x40000000000BD702, 0x40000000000BD902, 0x40000000000BDB02, 0x40000000000BDD02, 0x40000000000BDF03, 0x40000000000BE202, 0x40000000000BE403,
+		0x40000000000BE702, 0x40000000000BE902, 0x40000000000BEB02, 0x40000000000BED02, 0x40000000000BEF02, 0x40000000000BF102, 0x40000000000BF303, 0x40000000000BF602,
+		0x40000000000BF802, 0x40000000000BFA03, 0x40000000000BFD02, 0x40000000000BFF03, 0x40000000000C0203, 0x40000000000C0502, 0x40000000000C0702, 0x40000000000C0903,
+		0x40000000000C0C02, 0x40000000000C0E02, 0x40000000000C1002, 0x40000000000C1203, 0x40000000000C1502, 0x40000000000C1703, 0x40000000000C1A02, 0x40000000000C1C03,
+		0x40000000000C1F02, 0x40000000000C2103, 0x40000000000C2403, 0x40000000000C2702, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x40000000000C2902, 0x40000000000C2B02, 0x40000000000C2D02, 0x40000000000C2F02, 0x40000000000C3102, 0x40000000000C3302, 0x40000000000C3502, 0x40000000000C3702,
+		0x40000000000C3902, 0x40000000000C3B02, 0x40000000000C3D02, 0x40000000000C3F02, 0x40000000000C4102, 0x40000000000C4302, 0x40000000000C4502, 0x40000000000C4702,
+		0x40000000000C4902, 0x40000000000C4B02, 0x40000000000C4D02, 0x40000000000C4F02, 0x40000000000C5102, 0x40000000000C5302, 0x40000000000C5502, 0x40000000000C5702,
+		0x40000000000C5902, 0x40000000000C5B02, 0x40000000000C5D02, 0x40000000000C5F02, 0x40000000000C6102, 0x40000000000C6302, 0x40000000000C6502, 0x40000000000C6702,
+		0x40000000000C6902, 0x40000000000C6B02, 0x40000000000C6D02, 0x40000000000C6F02, 0x40000000000C7102, 0x40000000000C7302, 0x40000000000C7502, 0x40000000000C7702,
+		0x40000000000C7902, 0x40000000000C7B02, 0x40000000000C7D02, 0x40000000000C7F02, 0x40000000000C8102, 0x40000000000C8302, 0x40000000000C8502, 0x40000000000C8702,
+		0x40000000000C8902, 0x40000000000C8B02, 0x40000000000C8D02, 0x40000000000C8F02, 0x40000000000C9102, 0x40000000000C9302, 0x40000000000C9502, 0x40000000000C9702,
+		0x40000000000C9902, 0x40000000000C9B02, 0x40000000000C9D02, 0x40000000000C9F02, 0x40000000000CA102, 0x40000000000CA302, 0x40000000000CA502, 0x40000000000CA702,
+		0x40000000000CA902, 0x40000000000CAB02, 0x40000000000CAD02, 0x40000000000CAF02, 0x40000000000CB102, 0x40000000000CB302, 0x40000000000CB502, 0x40000000000CB702,
+		0x40000000000CB902, 0x40000000000CBB02, 0x40000000000CBD02, 0x40000000000CBF02, 0x40000000000CC102, 0x40000000000CC302, 0x40000000000CC502, 0x40000000000CC702,
+		0x40000000000CC902, 0x40000000000CCB02, 0x40000000000CCD02, 0x40000000000CCF02, 0x40000000000CD102, 0x40000000000CD302, 0x40000000000CD502, 0x40000000000CD702,
+		0x40000000000CD902, 0x40000000000CDB02, 0x40000000000CDD02, 0x40000000000CDF02, 0x40000000000CE102, 0x40000000000CE302, 0x40000000000CE502, 0x40000000000CE702,
+		0x40000000000CE902, 0x40000000000CEB02, 0x40000000000CED02, 0x40000000000CEF02, 0x40000000000CF102, 0x40000000000CF302, 0x40000000000CF502, 0x40000000000CF702,
+		0x40000000000CF902, 0x40000000000CFB02, 0x40000000000CFD02, 0x40000000000CFF02, 0x40000000000D0102, 0x40000000000D0302, 0x40000000000D0502, 0x40000000000D0702,
+		0x40000000000D0902, 0x40000000000D0B02, 0x40000000000D0D02, 0x40000000000D0F02, 0x40000000000D1102, 0x40000000000D1302, 0x40000000000D1502, 0x40000000000D1702,
+		0x40000000000D1902, 0x40000000000D1B02, 0x40000000000D1D02, 0x40000000000D1F02, 0x40000000000D2102, 0x40000000000D2302, 0x40000000000D2502, 0x40000000000D2702,
+		0x40000000000D2902, 0x40000000000D2B02, 0x40000000000D2D02, 0x40000000000D2F02, 0x40000000000D3102, 0x40000000000D3302, 0x40000000000D3502, 0x40000000000D3702,
+		0x40000000000D3902, 0x40000000000D3B02, 0x40000000000D3D02, 0x40000000000D3F02, 0x40000000000D4102, 0x40000000000D4302, 0x40000000000D4502, 0x40000000000D4702,
+		0x40000000000D4902, 0x40000000000D4B02, 0x40000000000D4D02, 0x40000000000D4F02, 0x40000000000D5102, 0x40000000000D5302, 0x40000000000D5502, 0x40000000000D5702,
+		0x40000000000D5902, 0x40000000000D5B02, 0x40000000000D5D02, 0x40000000000D5F02, 0x40000000000D6102, 0x40000000000D6302, 0x40000000000D6502, 0x40000000000D6702,
+		0x40000000000D6902, 0x40000000000D6B02, 0x40000000000D6D02, 0x40000000000D6F02, 0x40000000000D7102, 0x40000000000D7302, 0x40000000000D7502, 0x40000000000D7702,
+		0x40000000000D7902, 0x40000000000D7B02, 0x40000000000D7D02, 0x40000000000D7F02, 0x40000000000D8102, 0x40000000000D8302, 0x40000000000D8502, 0x40000000000D8702,
+		0x40000000000D8902, 0x40000000000D8B02, 0x40000000000D8D02, 0x40000000000D8F02, 0x40000000000D9102, 0x40000000000D9302, 0x40000000000D9502, 0x40000000000D9702,
+		0x40000000000D9902, 0x40000000000D9B02, 0x40000000000D9D02, 0x40000000000D9F02, 0x40000000000DA102, 0x40000000000DA302, 0x40000000000DA502, 0x40000000000DA702,
+		0x40000000000DA902, 0x40000000000DAB02, 0x40000000000DAD02, 0x40000000000DAF02, 0x40000000000DB102, 0x40000000000DB302, 0x40000000000DB502, 0x40000000000DB702,
+		0x40000000000DB902, 0x40000000000DBB02, 0x40000000000DBD02, 0x40000000000DBF02, 0x40000000000DC102, 0x40000000000DC302, 0x40000000000DC502, 0x40000000000DC702,
+		0x40000000000DC902, 0x40000000000DCB02, 0x40000000000DCD02, 0x40000000000DCF02, 0x40000000000DD102, 0x40000000000DD302, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80001A5B00200002, 0x80001A5C00200002, 0x80001A5D00200002, 0x80001A5E00200002, 0x80001A5F00200002, 0x80001A6000200002, 0x80001A6100200002, 0x80001A6200200002,
+		0x80001A6300200002, 0x80001A6400200002, 0x80001A6500200002, 0x80001A6600200002, 0, 0, 0, 0,
+		0x8000020900200003, 0x8000023100200002, 0x8000028A00200002, 0x800003AC00200002, 0x80001A8B00200002, 0x80001C0700200002, 0x40000000000DD502, 0x80001C3D00200002,
+		0x8000036F00200002, 0x8000037000200002, 0x8000037100200002, 0x8000037200200002, 0x8000037300200002, 0x8000037400200002, 0x8000037500200002, 0x8000037600200002,
+		0x8000037700200002, 0x8000037800200002, 0x80001A8C00200002, 0x80001A8D00200002, 0x8000037900200002, 0x8000037A00200002, 0x8000037B00200002, 0x8000037C00200002,
+		0x8000037D00200002, 0x8000037E00200002, 0x8000037F00200002, 0x8000038000200002, 0x8000021E00200002, 0x8000031200200002, 0x8000031300200002, 0x8000031400200002,
+		0x80001A8E00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0x8000000000FB0002, 0x8000000000FC0002, 0x8000000000FD0002, 0x8000000000FE0002, 0x8000000000FF0002, 0x8000000001000002,
+		0x8000021F00200002, 0x80001C0A00200002, 0x40000000000DD702, 0x80001C0B00200002, 0x40000000000DD902, 0x80001C0C00200002, 0x80001A8C00200004, 0x80001A8F00200002,
+		0x40000000000DDB02, 0x40000000000DDD02, 0x40000000000DDF02, 0x80001C0800200002, 0x40000000000DE102, 0x800003AD00200002, 0x80001A9000200002, 0x80001A9100200002,
+		0, 0x80003D5A0020000D, 0x80003D5A0020000E, 0x80003D5B0020000D, 0x80003D5B0020000E, 0x80003D5C0020000D, 0x80003D5C0020000E, 0x80003D5E0020000D,
+		0x80003D5E0020000E, 0x80003D5F0020000D, 0x80003D5F0020000E, 0x80003D600020000E, 0x40000000000DE302, 0x80003D610020000E, 0x40000000000DE502, 0x80003D620020000E,
+		0x40000000000DE702, 0x80003D630020000E, 0x40000000000DE902, 0x80003D640020000E, 0x40000000000DEB02, 0x80003D650020000E, 0x40000000000DED02, 0x80003D660020000E,
+		0x40000000000DEF02, 0x80003D670020000E, 0x40000000000DF102, 0x80003D680020000E, 0x40000000000DF302, 0x80003D690020000E, 0x40000000000DF502, 0x80003D6A0020000E,
+		0x40000000000DF702, 0x80003D6B0020000E, 0x40000000000DF902, 0x80003D6C0020000D, 0x80003D6C0020000E, 0x40000000000DFB02, 0x80003D6D0020000E, 0x40000000000DFD02,
+		0x80003D6E0020000E, 0x40000000000DFF02, 0x80003D6F0020000E, 0x80003D700020000E, 0x80003D710020000E, 0x80003D720020000E, 0x80003D730020000E, 0x80003D740020000E,
+		0x40000000000E0102, 0x40000000000E0302, 0x80003D750020000E, 0x40000000000E0502, 0x40000000000E0702, 0x80003D760020000E, 0x40000000000E0902, 0x40000000000E0B02,
+		0x80003D770020000E, 0x40000000000E0D02, 0x40000000000E0F02, 0x80003D780020000E, 0x40000000000E1102, 0x40000000000E1302, 0x80003D790020000E, 0x80003D7A0020000E,
+		0x80003D7B0020000E, 0x80003D7C0020000E, 0x80003D7D0020000E, 0x80003D7E0020000D, 0x80003D7E0020000E, 0x80003D7F0020000D, 0x80003D7F0020000E, 0x80003D810020000D,
+		0x80003D810020000E, 0x80003D820020000E, 0x80003D830020000E, 0x80003D840020000E, 0x80003D850020000E, 0x80003D860020000E, 0x80003D870020000D, 0x80003D870020000E,
+		0x80003D880020000E, 0x80003D890020000E, 0x80003D8A0020000E, 0x80003D8B0020000E, 0x40000000000E1502, 0x80003D600020000D, 0x80003D630020000D, 0,
+		0, 0x8000000000370002, 0x8000000000380002, 0x8000049100200002, 0x8000049200200002, 0x80001C0D00200002, 0x40000000000E1702, 0x40000000000E1902,
+		0x8000022000200002, 0x80003D5A0020000F, 0x80003D5A00200011, 0x80003D5B0020000F, 0x80003D5B00200011, 0x80003D5C0020000F, 0x80003D5C00200011, 0x80003D5E0020000F,
+		0x80003D5E00200011, 0x80003D5F0020000F, 0x80003D5F00200011, 0x80003D6000200011, 0x40000000000E1B02, 0x80003D6100200011, 0x40000000000E1D02, 0x80003D6200200011,
+		0x40000000000E1F02, 0x80003D6300200011, 0x40000000000E2102, 0x80003D6400200011, 0x40000000000E2302, 0x80003D6500200011, 0x40000000000E2502, 0x80003D6600200011,
+		0x40000000000E2702, 0x80003D6700200011, 0x40000000000E2902, 0x80003D6800200011, 0x40000000000E2B02, 0x80003D6900200011, 0x40000000000E2D02, 0x80003D6A00200011,
+		0x40000000000E2F02, 0x80003D6B00200011, 0x40000000000E3102, 0x80003D6C0020000F, 0x80003D6C00200011, 0x40000000000E3302, 0x80003D6D00200011, 0x40000000000E3502,
+		0x80003D6E00200011, 0x40000000000E3702, 0x80003D6F00200011, 0x80003D7000200011, 0x80003D7100200011, 0x80003D7200200011, 0x80003D7300200011, 0x80003D7400200011,
+		0x40000000000E3902, 0x40000000000E3B02, 0x80003D7500200011, 0x40000000000E3D02, 0x40000000000E3F02, 0x80003D7600200011, 0x40000000000E4102, 0x40000000000E4302,
+		0x80003D7700200011, 0x40000000000E4502, 0x40000000000E4702, 0x80003D7800200011, 0x40000000000E4902, 0x40000000000E4B02, 0x80003D7900200011, 0x80003D7A00200011,
+		0x80003D7B00200011, 0x80003D7C00200011, 0x80003D7D00200011, 0x80003D7E0020000F, 0x80003D7E00200011, 0x80003D7F0020000F, 0x80003D7F00200011, 0x80003D810020000F,
+		0x80003D8100200011, 0x80003D8200200011, 0x80003D8300200011, 0x80003D8400200011, 0x80003D8500200011, 0x80003D8600200011, 0x80003D870020000F, 0x80003D8700200011,
+		0x80003D8800200011, 0x80003D8900200011, 0x80003D8A00200011, 0x80003D8B00200011, 0x40000000000E4D02, 0x80003D600020000F, 0x80003D630020000F, 0x40000000000E4F02,
+		0x40000000000E5102, 0x40000000000E5302, 0x40000000000E5502, 0x8000022100200002, 0x80001C0E00200002, 0x80001C0F00200002, 0x40000000000E5702, 0x40000000000E5902,
+		0, 0, 0, 0, 0, 0x80003D8C00200002, 0x80003D8D00200002, 0x80003D8E00200002,
+		0x80003D8F00200002, 0x80003D9100200002, 0x80003D9200200002, 0x80003D9300200002, 0x80003D9400200002, 0x80003D9500200002, 0x80003D9600200002, 0x80003D9900200002,
+		0x80003D9A00200002, 0x80003D9B00200002, 0x80003D9C00200002, 0x80003D9E00200002, 0x80003D9F00200002, 0x80003DA000200002, 0x80003DA100200002, 0x80003DA200200002,
+		0x80003DA300200002, 0x80003DA400200002, 0x80003DA800200002, 0x80003DA900200002, 0x80003DAB00200002, 0x80003DAC00200002, 0x80003DAE00200002, 0x80003DAF00200002,
+		0x80003DB000200002, 0x80003DB100200002, 0x80003DB200200002, 0x80003DB300200002, 0x80003DB400200002, 0x80003DB600200002, 0x80003DBA00200002, 0x80003DBB00200002,
+		0x80003DBC00200002, 0x80003DBD00200002, 0x80003D9000200002, 0x80003D9700200002, 0x80003D9D00200002, 0x80003DBE00200002, 0, 0,
+		0, 0x80003BF500200004, 0x80003BF600200004, 0x80003CD300200004, 0x80003BF700200004, 0x80003CD500200004, 0x80003CD600200004, 0x80003BF800200004,
+		0x80003BF900200004, 0x80003BFA00200004, 0x80003CD900200004, 0x80003CDA00200004, 0x80003CDB00200004, 0x80003CDC00200004, 0x80003CDD00200004, 0x80003CDE00200004,
+		0x80003C0F00200004, 0x80003BFB00200004, 0x80003BFC00200004, 0x80003BFD00200004, 0x80003C1600200004, 0x80003BFE00200004, 0x80003BFF00200004, 0x80003C0000200004,
+		0x80003C0100200004, 0x80003C0200200004, 0x80003C0300200004, 0x80003C0400200004, 0x80003C0500200004, 0x80003C0600200004, 0x80003C0700200004, 0x80003C7300200004,
+		0x80003C7400200004, 0x80003C7500200004, 0x80003C7600200004, 0x80003C7700200004, 0x80003C7800200004, 0x80003C7900200004, 0x80003C7A00200004, 0x80003C7B00200004,
+		0x80003C7C00200004, 0x80003C7D00200004, 0x80003C7E00200004, 0x80003C7F00200004, 0x80003C8000200004, 0x80003C8100200004, 0x80003C8200200004, 0x80003C8300200004,
+		0x80003C8400200004, 0x80003C8500200004, 0x80003C8600200004, 0x80003C8700200004, 0x80003C7200200004, 0x80003C0900200004, 0x80003C0A00200004, 0x80003CF000200004,
+		0x80003CF100200004, 0x80003CF500200004, 0x80003CF700200004, 0x80003CFC00200004, 0x80003D0000200004, 0x80003D0200200004, 0x80003C1100200004, 0x80003D0600200004,
+		0x80003D0800200004, 0x80003C1200200004, 0x80003C1300200004, 0x80003C1500200004, 0x80003C1700200004, 0x80003C1800200004, 0x80003C1C00200004, 0x80003C1E00200004,
+		0x80003C2000200004, 0x80003C2100200004, 0x80003C2200200004, 0x80003C2300200004, 0x80003C2400200004, 0x80003C2700200004, 0x80003C2B00200004, 0x80003C3500200004,
+		0x80003C3C00200004, 0x80003C4100200004, 0x80003D1A00200004, 0x80003D1B00200004, 0x80003C4C00200004, 0x80003C4D00200004, 0x80003C4E00200004, 0x80003C9600200004,
+		0x80003C9700200004, 0x80003C9A00200004, 0x80003CA300200004, 0x80003CA400200004, 0x80003CA600200004, 0x80003CB000200004, 0x80003CB300200004, 0,
+		0x80001A9200200002, 0x80001A9300200002, 0x40000000000E5B02, 0x40000000000E5D02, 0x40000000000E5F02, 0x40000000000E6102, 0x40000000000E6302, 0x40000000000E6502,
+		0x40000000000E6702, 0x40000000000E6902, 0x40000000000E6B02, 0x40000000000E6D02, 0x40000000000E6F02, 0x40000000000E7102, 0x40000000000E7302, 0x40000000000E7502,
+		0x40000000000E7702, 0x40000000000E7902, 0x40000000000E7B02, 0x40000000000E7D02, 0x80003DAD00200002, 0x40000000000E7F02, 0x80003DAA00200002, 0x40000000000E8102,
+		0x40000000000E8302, 0x40000000000E8502, 0x40000000000E8702, 0x40000000000E8902, 0x80003DB900200002, 0x80003D9800200002, 0x40000000000E8B02, 0x40000000000E8D02,
+		0x80003DB700200002, 0x80003DB800200002, 0x80003DB500200002, 0x40000000000E8F02, 0x80003D8D00200019, 0x80003D9200200019, 0x80003D9600200019, 0x80003D9900200019,
+		0x80003DA500200002, 0x80003DA600200002, 0x80003DA700200002, 0, 0, 0, 0, 0,
+		0x80001A6700200002, 0x80001A6800200002, 0x80001A6900200002, 0x80001A6A00200002, 0x80001A6B00200002, 0x80001A6C00200002, 0x80001A6D00200002, 0x80001A6E00200002,
+		0x80001A6F00200002, 0x80001A7000200002, 0x80001A7100200002, 0x80001A7200200002, 0x80001A7300200002, 0x80001A7400200002, 0x80001A7500200002, 0x80001A7600200002,
+		0x80001A7700200002, 0x80001A7800200002, 0x80001A7900200002, 0x80001A7A00200002, 0x80001A7B00200002, 0x80001A7C00200002, 0x80001A7D00200002, 0x80001A7E00200002,
+		0x80001A7F00200002, 0x80001A8000200002, 0x80001A8100200002, 0x80001A8200200002, 0x80001A8300200002, 0x80001A8400200002, 0x80001A8500200002, 0x80001A8600200002,
+		0x80001A8700200002, 0x80001A8800200002, 0x80001A8900200002, 0x80001A8A00200002, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80003D620020000F, 0x80003D660020000F, 0x80003D670020000F, 0x80003D6E0020000F, 0x80003D710020000F, 0x80003D740020000F, 0x80003D750020000F, 0x80003D760020000F,
+		0x80003D770020000F, 0x80003D780020000F, 0x80003D7B0020000F, 0x80003D820020000F, 0x80003D830020000F, 0x80003D840020000F, 0x80003D850020000F, 0x80003D860020000F,
+		0x40000000000E9103, 0x40000000000E9403, 0x40000000000E9703, 0x40000000000E9A03, 0x40000000000E9D03, 0x40000000000EA003, 0x40000000000EA303, 0x40000000000EA603,
+		0x40000000000EA903, 0x40000000000EAC03, 0x40000000000EAF03, 0x40000000000EB203, 0x40000000000EB503, 0x40000000000EB803, 0x40000000000EBB04, 0x40000000000EBF04,
+		0x40000000000EC304, 0x40000000000EC704, 0x40000000000ECB04, 0x40000000000ECF04, 0x40000000000ED304, 0x40000000000ED704, 0x40000000000EDB04, 0x40000000000EDF04,
+		0x40000000000EE304, 0x40000000000EE704, 0x40000000000EEB04, 0x40000000000EEF04, 0x40000000000EF304, 0x40000000000EF707, 0x40000000000EFE06, 0,
+		0x40000000000F0404, 0x40000000000F0804, 0x40000000000F0C04, 0x40000000000F1004, 0x40000000000F1404, 0x40000000000F1804, 0x40000000000F1C04, 0x40000000000F2004,
+		0x40000000000F2404, 0x40000000000F2804, 0x40000000000F2C04, 0x40000000000F3004, 0x40000000000F3404, 0x40000000000F3804, 0x40000000000F3C04, 0x40000000000F4004,
+		0x40000000000F4404, 0x40000000000F4804, 0x40000000000F4C04, 0x40000000000F5004, 0x40000000000F5404, 0x40000000000F5804, 0x40000000000F5C04, 0x40000000000F6004,
+		0x40000000000F6404, 0x40000000000F6804, 0x40000000000F6C04, 0x40000000000F7004, 0x40000000000F7404, 0x40000000000F7804, 0x40000000000F7C04, 0x40000000000F8004,
+		0x40000000000F8404, 0x40000000000F8804, 0x40000000000F8C04, 0x40000000000F9004, 0x40000000000F9402, 0x40000000000F9602, 0x40000000000F9802, 0x40000000000F9A02,
+		0x40000000000F9C02, 0x40000000000F9E02, 0x40000000000FA002, 0x40000000000FA202, 0x40000000000FA402, 0x40000000000FA602, 0x40000000000FA802, 0x40000000000FAA02,
+		0x40000000000FAC03, 0x40000000000FAF02, 0x40000000000FB102, 0x40000000000FB302, 0x40000000000FB502, 0x40000000000FB702, 0x40000000000FB902, 0x40000000000FBB02,
+		0x40000000000FBD02, 0x40000000000FBF02, 0x40000000000FC102, 0x40000000000FC302, 0x40000000000FC502, 0x40000000000FC702, 0x40000000000FC902, 0x40000000000FCB02,
+		0x80003BF500200006, 0x80003BF700200006, 0x80003BF800200006, 0x80003BFA00200006, 0x80003BFB00200006, 0x80003BFC00200006, 0x80003BFE00200006, 0x80003C0000200006,
+		0x80003C0100200006, 0x80003C0300200006, 0x80003C0400200006, 0x80003C0500200006, 0x80003C0600200006, 0x80003C0700200006, 0x40000000000FCD02, 0x40000000000FCF02,
+		0x40000000000FD102, 0x40000000000FD302, 0x40000000000FD502, 0x40000000000FD702, 0x40000000000FD902, 0x40000000000FDB02, 0x40000000000FDD02, 0x40000000000FDF02,
+		0x40000000000FE102, 0x40000000000FE302, 0x40000000000FE502, 0x40000000000FE702, 0x40000000000FE905, 0x40000000000FEE04, 0x40000000000FF202, 0x80001A9400200002,
+		0x40000000000FF402, 0x40000000000FF602, 0x40000000000FF802, 0x40000000000FFA02, 0x40000000000FFC02, 0x40000000000FFE02, 0x4000000000100002, 0x4000000000100202,
+		0x4000000000100402, 0x4000000000100602, 0x4000000000100802, 0x4000000000100A02, 0x4000000000100C02, 0x4000000000100E02, 0x4000000000101002, 0x4000000000101202,
+		0x4000000000101402, 0x4000000000101602, 0x4000000000101802, 0x4000000000101A02, 0x4000000000101C02, 0x4000000000101E02, 0x4000000000102002, 0x4000000000102202,
+		0x4000000000102402, 0x4000000000102602, 0x4000000000102802, 0x4000000000102A02, 0x4000000000102C02, 0x4000000000102E02, 0x4000000000103002, 0x4000000000103202,
+		0x4000000000103402, 0x4000000000103602, 0x4000000000103802, 0x4000000000103A02, 0x4000000000103C02, 0x4000000000103E02, 0x4000000000104002, 0x4000000000104202,
+		0x4000000000104402, 0x4000000000104602, 0x4000000000104802, 0x4000000000104A02, 0x4000000000104C02, 0x4000000000104E02, 0x4000000000105002, 0x4000000000105202,
+		0x4000000000105402, 0x4000000000105602, 0x4000000000105802, 0x4000000000105A02, 0x4000000000105C02, 0x4000000000105E02, 0x4000000000106002, 0x4000000000106202,
+		0x4000000000106402, 0x4000000000106602, 0x4000000000106802, 0x4000000000106A02, 0x4000000000106C02, 0x4000000000106E02, 0x4000000000107002, 0x4000000000107202,
+		0x4000000000107403, 0x4000000000107703, 0x4000000000107A03, 0x4000000000107D03, 0x4000000000108003, 0x4000000000108303, 0x4000000000108603, 0x4000000000108903,
+		0x4000000000108C03, 0x4000000000108F04, 0x4000000000109304, 0x4000000000109704, 0x4000000000109B02, 0x4000000000109D03, 0x400000000010A002, 0x400000000010A203,
+		0x80003D5A00200013, 0x80003D5B00200013, 0x80003D5C00200013, 0x80003D5E00200013, 0x80003D5F00200013, 0x80003D6000200013, 0x80003D6100200013, 0x80003D6200200013,
+		0x80003D6300200013, 0x80003D6400200013, 0x80003D6500200013, 0x80003D6600200013, 0x80003D6700200013, 0x80003D6800200013, 0x80003D6900200013, 0x80003D6A00200013,
+		0x80003D6B00200013, 0x80003D6C00200013, 0x80003D6D00200013, 0x80003D6E00200013, 0x80003D6F00200013, 0x80003D7000200013, 0x80003D7100200013, 0x80003D7200200013,
+		0x80003D7300200013, 0x80003D7400200013, 0x80003D7500200013, 0x80003D7600200013, 0x80003D7700200013, 0x80003D7800200013, 0x80003D7900200013, 0x80003D7A00200013,
+		0x80003D7B00200013, 0x80003D7C00200013, 0x80003D7D00200013, 0x80003D7E00200013, 0x80003D7F00200013, 0x80003D8100200013, 0x80003D8200200013, 0x80003D8300200013,
+		0x80003D8400200013, 0x80003D8500200013, 0x80003D8600200013, 0x80003D8700200013, 0x80003D8800200013, 0x80003D8900200013, 0x80003D8A00200013, 0,
+		0x400000000010A505, 0x400000000010AA04, 0x400000000010AE05, 0x400000000010B303, 0x400000000010B605, 0x400000000010BB03, 0x400000000010BE03, 0x400000000010C106,
+		0x400000000010C704, 0x400000000010CB03, 0x400000000010CE03, 0x400000000010D103, 0x400000000010D404, 0x400000000010D804, 0x400000000010DC04, 0x400000000010E004,
+		0x400000000010E404, 0x400000000010E804, 0x400000000010EC04, 0x400000000010F006, 0x400000000010F602, 0x400000000010F806, 0x400000000010FE06, 0x4000000000110405,
+		0x4000000000110904, 0x4000000000110D06, 0x4000000000111306, 0x4000000000111904, 0x4000000000111D03, 0x4000000000112003, 0x4000000000112304, 0x4000000000112704,
+		0x4000000000112B05, 0x4000000000113005, 0x4000000000113503, 0x4000000000113803, 0x4000000000113B04, 0x4000000000113F03, 0x4000000000114203, 0x4000000000114502,
+		0x4000000000114702, 0x4000000000114903, 0x4000000000114C03, 0x4000000000114F06, 0x4000000000115504, 0x4000000000115905, 0x4000000000115E06, 0x4000000000116404,
+		0x4000000000116803, 0x4000000000116B03, 0x4000000000116E06, 0x4000000000117404, 0x4000000000117806, 0x4000000000117E03, 0x4000000000118105, 0x4000000000118603,
+		0x4000000000118904, 0x4000000000118D03, 0x4000000000119004, 0x4000000000119405, 0x4000000000119904, 0x4000000000119D05, 0x400000000011A204, 0x400000000011A602,
+		0x400000000011A805, 0x400000000011AD03, 0x400000000011B003, 0x400000000011B304, 0x400000000011B703, 0x400000000011BA03, 0x400000000011BD03, 0x400000000011C005,
+		0x400000000011C504, 0x400000000011C902, 0x400000000011CB06, 0x400000000011D103, 0x400000000011D405, 0x400000000011D904, 0x400000000011DD04, 0x400000000011E103,
+		0x400000000011E403, 0x400000000011E704, 0x400000000011EB02, 0x400000000011ED04, 0x400000000011F105, 0x400000000011F602, 0x400000000011F806, 0x400000000011FE03,
+		0x4000000000120103, 0x4000000000120403, 0x4000000000120703, 0x4000000000120A03, 0x4000000000120D03, 0x4000000000121003, 0x4000000000121303, 0x4000000000121603,
+		0x4000000000121903, 0x4000000000121C03, 0x4000000000121F04, 0x4000000000122304, 0x4000000000122704, 0x4000000000122B04, 0x4000000000122F04, 0x4000000000123304,
+		0x4000000000123704, 0x4000000000123B04, 0x4000000000123F04, 0x4000000000124304, 0x4000000000124704, 0x4000000000124B04, 0x4000000000124F04, 0x4000000000125304,
+		0x4000000000125704, 0x4000000000125B03, 0x4000000000125E02, 0x4000000000126002, 0x4000000000126203, 0x4000000000126502, 0x4000000000126702, 0x4000000000126902,
+		0x4000000000126B03, 0x4000000000126E03, 0x4000000000127102, 0x4000000000127304, 0x4000000000127704, 0x4000000000127B04, 0x4000000000127F04, 0x4000000000128308,
+		0x4000000000128B02, 0x4000000000128D02, 0x4000000000128F02, 0x4000000000129102, 0x4000000000129302, 0x4000000000129502, 0x4000000000129702, 0x4000000000129902,
+		0x4000000000129B03, 0x4000000000129E04, 0x400000000012A202, 0x400000000012A402, 0x400000000012A602, 0x400000000012A802, 0x400000000012AA02, 0x400000000012AC02,
+		0x400000000012AE02, 0x400000000012B003, 0x400000000012B303, 0x400000000012B603, 0x400000000012B903, 0x400000000012BC02, 0x400000000012BE02, 0x400000000012C002,
+		0x400000000012C202, 0x400000000012C402, 0x400000000012C602, 0x400000000012C802, 0x400000000012CA02, 0x400000000012CC02, 0x400000000012CE02, 0x400000000012D003,
+		0x400000000012D303, 0x400000000012D602, 0x400000000012D803, 0x400000000012DB03, 0x400000000012DE03, 0x400000000012E102, 0x400000000012E303, 0x400000000012E603,
+		0x400000000012E904, 0x400000000012ED02, 0x400000000012EF03, 0x400000000012F203, 0x400000000012F503, 0x400000000012F803, 0x400000000012FB05, 0x4000000000130006,
+		0x4000000000130602, 0x4000000000130802, 0x4000000000130A02, 0x4000000000130C02, 0x4000000000130E02, 0x4000000000131002, 0x4000000000131202, 0x4000000000131402,
+		0x4000000000131602, 0x4000000000131802, 0x4000000000131A02, 0x4000000000131C02, 0x4000000000131E02, 0x4000000000132002, 0x4000000000132202, 0x4000000000132402,
+		0x4000000000132602, 0x4000000000132802, 0x4000000000132A04, 0x4000000000132E02, 0x4000000000133002, 0x4000000000133202, 0x4000000000133404, 0x4000000000133803,
+		0x4000000000133B02, 0x4000000000133D02, 0x4000000000133F02, 0x4000000000134102, 0x4000000000134302, 0x4000000000134502, 0x4000000000134702, 0x4000000000134902,
+		0x4000000000134B02, 0x4000000000134D02, 0x4000000000134F03, 0x4000000000135202, 0x4000000000135402, 0x4000000000135603, 0x4000000000135903, 0x4000000000135C02,
+		0x4000000000135E04, 0x4000000000136203, 0x4000000000136502, 0x4000000000136702, 0x4000000000136902, 0x4000000000136B02, 0x4000000000136D03, 0x4000000000137003,
+		0x4000000000137303, 0x4000000000137603, 0x4000000000137903, 0x4000000000137C03, 0x4000000000137F03, 0x4000000000138203, 0x4000000000138503, 0x4000000000138803,
+		0x4000000000138B03, 0x4000000000138E04, 0x4000000000139204, 0x4000000000139604, 0x4000000000139A04, 0x4000000000139E04, 0x400000000013A204, 0x400000000013A604,
+		0x400000000013AA04, 0x400000000013AE04, 0x400000000013B204, 0x400000000013B604, 0x400000000013BA04, 0x400000000013BE04, 0x400000000013C204, 0x400000000013C604,
+		0x400000000013CA04, 0x400000000013CE04, 0x400000000013D204, 0x400000000013D604, 0x400000000013DA04, 0x400000000013DE04, 0x400000000013E204, 0x400000000013E603,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80000EAA00200002, 0x80000EAB00200002, 0x80000EAC00200002, 0x80000EAD00200002, 0x80000EAE00200002, 0x80000EAF00200002, 0x80000EB000200002, 0x80000EB100200002,
+		0x80000EB200200002, 0x80000EB300200002, 0x80000EB400200002, 0x80000EB500200002, 0x80000EB600200002, 0x80000EB700200002, 0x80000EB800200002, 0x80000EB900200002,
+		0x80000EBA00200002, 0x80000EBB00200002, 0x80000EBC00200002, 0x80000EBD00200002, 0x80000EBE00200002, 0x80000EBF00200002, 0x80000EC000200002, 0x80000EC100200002,
+		0x80000EC200200002, 0x80000EC300200002, 0x80000EC400200002, 0x80000EC500200002, 0x80000EC600200002, 0x80000EC700200002, 0x80000EC800200002, 0x80000EC900200002,
+		0x80000ECA00200002, 0x80000ECB00200002, 0x80000ECC00200002, 0x80000ECD00200002, 0x80000ECE00200002, 0x80000ECF00200002, 0x80000ED000200002, 0x80000ED100200002,
+		0x80000ED200200002, 0x80000ED300200002, 0x80000ED400200002, 0x80000ED500200002, 0x80000ED600200002, 0x80000ED700200002, 0x80000ED800200002, 0x80000ED900200002,
+		0x80000EDA00200002, 0x80000EDB00200002, 0x80000EDC00200002, 0x80000EDD00200002, 0x80000EDE00200002, 0x80000EDF00200002, 0x80000EE000200002, 0x80000EE100200002,
+		0x80000EE200200002, 0x80000EE300200002, 0x80000EE400200002, 0x80000EE500200002, 0x80000EE600200002, 0x80000EE700200002, 0x80000EE800200002, 0x80000EE900200002,
+		0x80003DBF00200002, 0x80003DC000200002, 0x80003DC100200002, 0x80003DC200200002, 0x80003DC300200002, 0x80003DC400200002, 0x80003DC500200002, 0x80003DC600200002,
+		0x80003DC700200002, 0x80003DC800200002, 0x80003DC900200002, 0x80003DCA00200002, 0x80003DCB00200002, 0x80003DCC00200002, 0x80003DCD00200002, 0x80003DCE00200002,
+		0x80003DCF00200002, 0x80003DD000200002, 0x80003DD100200002, 0x80003DD200200002, 0x80003DD300200002, 0x80003DD400200002, 0x80003DD500200002, 0x80003DD600200002,
+		0x80003DD700200002, 0x80003DD800200002, 0x80003DD900200002, 0x80003DDA00200002, 0x80003DDB00200002, 0x80003DDC00200002, 0x80003DDD00200002, 0x80003DDE00200002,
+		0x80003DDF00200002, 0x80003DE000200002, 0x80003DE100200002, 0x80003DE200200002, 0x80003DE300200002, 0x80003DE400200002, 0x80003DE500200002, 0x80003DE600200002,
+		0x80003DE700200002, 0x80003DE800200002, 0x80003DE900200002, 0x80003DEA00200002, 0x80003DEB00200002, 0x80003DEC00200002, 0x80003DED00200002, 0x80003DEE00200002,
+		0x80003DEF00200002, 0x80003DF000200002, 0x80003DF100200002, 0x80003DF200200002, 0x80003DF300200002, 0x80003DF400200002, 0x80003DF500200002, 0x80003DF600200002,
+		0x80003DF700200002, 0x80003DF800200002, 0x80003DF900200002, 0x80003DFA00200002, 0x80003DFB00200002, 0x80003DFC00200002, 0x80003DFD00200002, 0x80003DFE00200002,
+		0x80003DFF00200002, 0x80003E0000200002, 0x80003E0100200002, 0x80003E0200200002, 0x80003E0300200002, 0x80003E0400200002, 0x80003E0500200002, 0x80003E0600200002,
+		0x80003E0700200002, 0x80003E0800200002, 0x80003E0900200002, 0x80003E0A00200002, 0x80003E0B00200002, 0x80003E0C00200002, 0x80003E0D00200002, 0x80003E0E00200002,
+		0x80003E0F00200002, 0x80003E1000200002, 0x80003E1100200002, 0x80003E1200200002, 0x80003E1300200002, 0x80003E1400200002, 0x80003E1500200002, 0x80003E1600200002,
+		0x80003E1700200002, 0x80003E1800200002, 0x80003E1900200002, 0x80003E1A00200002, 0x80003E1B00200002, 0x80003E1C00200002, 0x80003E1D00200002, 0x80003E1E00200002,
+		0x80003E1F00200002, 0x80003E2000200002, 0x80003E2100200002, 0x80003E2200200002, 0x80003E2300200002, 0x80003E2400200002, 0x80003E2500200002, 0x80003E2600200002,
+		0x80003E2700200002, 0x80003E2800200002, 0x80003E2900200002, 0x80003E2A00200002, 0x80003E2B00200002, 0x80003E2C00200002, 0x80003E2D00200002, 0x80003E2E00200002,
+		0x80003E2F00200002, 0x80003E3000200002, 0x80003E3100200002, 0x80003E3200200002, 0x80003E3300200002, 0x80003E3400200002, 0x80003E3500200002, 0x80003E3600200002,
+		0x80003E3700200002, 0x80003E3800200002, 0x80003E3900200002, 0x80003E3A00200002, 0x80003E3B00200002, 0x80003E3C00200002, 0x80003E3D00200002, 0x80003E3E00200002,
+		0x80003E3F00200002, 0x80003E4000200002, 0x80003E4100200002, 0x80003E4200200002, 0x80003E4300200002, 0x80003E4400200002, 0x80003E4500200002, 0x80003E4600200002,
+		0x80003E4700200002, 0x80003E4800200002, 0x80003E4900200002, 0x80003E4A00200002, 0x80003E4B00200002, 0x80003E4C00200002, 0x80003E4D00200002, 0x80003E4E00200002,
+		0x80003E4F00200002, 0x80003E5000200002, 0x80003E5100200002, 0x80003E5200200002, 0x80003E5300200002, 0x80003E5400200002, 0x80003E5500200002, 0x80003E5600200002,
+		0x80003E5700200002, 0x80003E5800200002, 0x80003E5900200002, 0x80003E5A00200002, 0x80003E5B00200002, 0x80003E5C00200002, 0x80003E5D00200002, 0x80003E5E00200002,
+		0x80003E5F00200002, 0x80003E6000200002, 0x80003E6100200002, 0x80003E6200200002, 0x80003E6300200002, 0x80003E6400200002, 0x80003E6500200002, 0x80003E6600200002,
+		0x80003E6700200002, 0x80003E6800200002, 0x80003E6900200002, 0x80003E6A00200002, 0x80003E6B00200002, 0x80003E6C00200002, 0x80003E6D00200002, 0x80003E6E00200002,
+		0x80003E6F00200002, 0x80003E7000200002, 0x80003E7100200002, 0x80003E7200200002, 0x80003E7300200002, 0x80003E7400200002, 0x80003E7500200002, 0x80003E7600200002,
+		0x80003E7700200002, 0x80003E7800200002, 0x80003E7900200002, 0x80003E7A00200002, 0x80003E7B00200002, 0x80003E7C00200002, 0x80003E7D00200002, 0x80003E7E00200002,
+		0x80003E7F00200002, 0x80003E8000200002, 0x80003E8100200002, 0x80003E8200200002, 0x80003E8300200002, 0x80003E8400200002, 0x80003E8500200002, 0x80003E8600200002,
+		0x80003E8700200002, 0x80003E8800200002, 0x80003E8900200002, 0x80003E8A00200002, 0x80003E8B00200002, 0x80003E8C00200002, 0x80003E8D00200002, 0x80003E8E00200002,
+		0x80003E8F00200002, 0x80003E9000200002, 0x80003E9100200002, 0x80003E9200200002, 0x80003E9300200002, 0x80003E9400200002, 0x80003E9500200002, 0x80003E9600200002,
+		0x80003E9700200002, 0x80003E9800200002, 0x80003E9900200002, 0x80003E9A00200002, 0x80003E9B00200002, 0x80003E9C00200002, 0x80003E9D00200002, 0x80003E9E00200002,
+		0x80003E9F00200002, 0x80003EA000200002, 0x80003EA100200002, 0x80003EA200200002, 0x80003EA300200002, 0x80003EA400200002, 0x80003EA500200002, 0x80003EA600200002,
+		0x80003EA700200002, 0x80003EA800200002, 0x80003EA900200002, 0x80003EAA00200002, 0x80003EAB00200002, 0x80003EAC00200002, 0x80003EAD00200002, 0x80003EAE00200002,
+		0x80003EAF00200002, 0x80003EB000200002, 0x80003EB100200002, 0x80003EB200200002, 0x80003EB300200002, 0x80003EB400200002, 0x80003EB500200002, 0x80003EB600200002,
+		0x80003EB700200002, 0x80003EB800200002, 0x80003EB900200002, 0x80003EBA00200002, 0x80003EBB00200002, 0x80003EBC00200002, 0x80003EBD00200002, 0x80003EBE00200002,
+		0x80003EBF00200002, 0x80003EC000200002, 0x80003EC100200002, 0x80003EC200200002, 0x80003EC300200002, 0x80003EC400200002, 0x80003EC500200002, 0x80003EC600200002,
+		0x80003EC700200002, 0x80003EC800200002, 0x80003EC900200002, 0x80003ECA00200002, 0x80003ECB00200002, 0x80003ECC00200002, 0x80003ECD00200002, 0x80003ECE00200002,
+		0x80003ECF00200002, 0x80003ED000200002, 0x80003ED100200002, 0x80003ED200200002, 0x80003ED300200002, 0x80003ED400200002, 0x80003ED500200002, 0x80003ED600200002,
+		0x80003ED700200002, 0x80003ED800200002, 0x80003ED900200002, 0x80003EDA00200002, 0x80003EDB00200002, 0x80003EDC00200002, 0x80003EDD00200002, 0x80003EDE00200002,
+		0x80003EDF00200002, 0x80003EE000200002, 0x80003EE100200002, 0x80003EE200200002, 0x80003EE300200002, 0x80003EE400200002, 0x80003EE500200002, 0x80003EE600200002,
+		0x80003EE700200002, 0x80003EE800200002, 0x80003EE900200002, 0x80003EEA00200002, 0x80003EEB00200002, 0x80003EEC00200002, 0x80003EED00200002, 0x80003EEE00200002,
+		0x80003EEF00200002, 0x80003EF000200002, 0x80003EF100200002, 0x80003EF200200002, 0x80003EF300200002, 0x80003EF400200002, 0x80003EF500200002, 0x80003EF600200002,
+		0x80003EF700200002, 0x80003EF800200002, 0x80003EF900200002, 0x80003EFA00200002, 0x80003EFB00200002, 0x80003EFC00200002, 0x80003EFD00200002, 0x80003EFE00200002,
+		0x80003EFF00200002, 0x80003F0000200002, 0x80003F0100200002, 0x80003F0200200002, 0x80003F0300200002, 0x80003F0400200002, 0x80003F0500200002, 0x80003F0600200002,
+		0x80003F0700200002, 0x80003F0800200002, 0x80003F0900200002, 0x80003F0A00200002, 0x80003F0B00200002, 0x80003F0C00200002, 0x80003F0D00200002, 0x80003F0E00200002,
+		0x80003F0F00200002, 0x80003F1000200002, 0x80003F1100200002, 0x80003F1200200002, 0x80003F1300200002, 0x80003F1400200002, 0x80003F1500200002, 0x80003F1600200002,
+		0x80003F1700200002, 0x80003F1800200002, 0x80003F1900200002, 0x80003F1A00200002, 0x80003F1B00200002, 0x80003F1C00200002, 0x80003F1D00200002, 0x80003F1E00200002,
+		0x80003F1F00200002, 0x80003F2000200002, 0x80003F2100200002, 0x80003F2200200002, 0x80003F2300200002, 0x80003F2400200002, 0x80003F2500200002, 0x80003F2600200002,
+		0x80003F2700200002, 0x80003F2800200002, 0x80003F2900200002, 0x80003F2A00200002, 0x80003F2B00200002, 0x80003F2C00200002, 0x80003F2D00200002, 0x80003F2E00200002,
+		0x80003F2F00200002, 0x80003F3000200002, 0x80003F3100200002, 0x80003F3200200002, 0x80003F3300200002, 0x80003F3400200002, 0x80003F3500200002, 0x80003F3600200002,
+		0x80003F3700200002, 0x80003F3800200002, 0x80003F3900200002, 0x80003F3A00200002, 0x80003F3B00200002, 0x80003F3C00200002, 0x80003F3D00200002, 0x80003F3E00200002,
+		0x80003F3F00200002, 0x80003F4000200002, 0x80003F4100200002, 0x80003F4200200002, 0x80003F4300200002, 0x80003F4400200002, 0x80003F4500200002, 0x80003F4600200002,
+		0x80003F4700200002, 0x80003F4800200002, 0x80003F4900200002, 0x80003F4A00200002, 0x80003F4B00200002, 0x80003F4C00200002, 0x80003F4D00200002, 0x80003F4E00200002,
+		0x80003F4F00200002, 0x80003F5000200002, 0x80003F5100200002, 0x80003F5200200002, 0x80003F5300200002, 0x80003F5400200002, 0x80003F5500200002, 0x80003F5600200002,
+		0x80003F5700200002, 0x80003F5800200002, 0x80003F5900200002, 0x80003F5A00200002, 0x80003F5B00200002, 0x80003F5C00200002, 0x80003F5D00200002, 0x80003F5E00200002,
+		0x80003F5F00200002, 0x80003F6000200002, 0x80003F6100200002, 0x80003F6200200002, 0x80003F6300200002, 0x80003F6400200002, 0x80003F6500200002, 0x80003F6600200002,
+		0x80003F6700200002, 0x80003F6800200002, 0x80003F6900200002, 0x80003F6A00200002, 0x80003F6B00200002, 0x80003F6C00200002, 0x80003F6D00200002, 0x80003F6E00200002,
+		0x80003F6F00200002, 0x80003F7000200002, 0x80003F7100200002, 0x80003F7200200002, 0x80003F7300200002, 0x80003F7400200002, 0x80003F7500200002, 0x80003F7600200002,
+		0x80003F7700200002, 0x80003F7800200002, 0x80003F7900200002, 0x80003F7A00200002, 0x80003F7B00200002, 0x80003F7C00200002, 0x80003F7D00200002, 0x80003F7E00200002,
+		0x80003F7F00200002, 0x80003F8000200002, 0x80003F8100200002, 0x80003F8200200002, 0x80003F8300200002, 0x80003F8400200002, 0x80003F8500200002, 0x80003F8600200002,
+		0x80003F8700200002, 0x80003F8800200002, 0x80003F8900200002, 0x80003F8A00200002, 0x80003F8B00200002, 0x80003F8C00200002, 0x80003F8D00200002, 0x80003F8E00200002,
+		0x80003F8F00200002, 0x80003F9000200002, 0x80003F9100200002, 0x80003F9200200002, 0x80003F9300200002, 0x80003F9400200002, 0x80003F9500200002, 0x80003F9600200002,
+		0x80003F9700200002, 0x80003F9800200002, 0x80003F9900200002, 0x80003F9A00200002, 0x80003F9B00200002, 0x80003F9C00200002, 0x80003F9D00200002, 0x80003F9E00200002,
+		0x80003F9F00200002, 0x80003FA000200002, 0x80003FA100200002, 0x80003FA200200002, 0x80003FA300200002, 0x80003FA400200002, 0x80003FA500200002, 0x80003FA600200002,
+		0x80003FA700200002, 0x80003FA800200002, 0x80003FA900200002, 0x80003FAA00200002, 0x80003FAB00200002, 0x80003FAC00200002, 0x80003FAD00200002, 0x80003FAE00200002,
+		0x80003FAF00200002, 0x80003FB000200002, 0x80003FB100200002, 0x80003FB200200002, 0x80003FB300200002, 0x80003FB400200002, 0x80003FB500200002, 0x80003FB600200002,
+		0x80003FB700200002, 0x80003FB800200002, 0x80003FB900200002, 0x80003FBA00200002, 0x80003FBB00200002, 0x80003FBC00200002, 0x80003FBD00200002, 0x80003FBE00200002,
+		0x80003FBF00200002, 0x80003FC000200002, 0x80003FC100200002, 0x80003FC200200002, 0x80003FC300200002, 0x80003FC400200002, 0x80003FC500200002, 0x80003FC600200002,
+		0x80003FC700200002, 0x80003FC800200002, 0x80003FC900200002, 0x80003FCA00200002, 0x80003FCB00200002, 0x80003FCC00200002, 0x80003FCD00200002, 0x80003FCE00200002,
+		0x80003FCF00200002, 0x80003FD000200002, 0x80003FD100200002, 0x80003FD200200002, 0x80003FD300200002, 0x80003FD400200002, 0x80003FD500200002, 0x80003FD600200002,
+		0x80003FD700200002, 0x80003FD800200002, 0x80003FD900200002, 0x80003FDA00200002, 0x80003FDB00200002, 0x80003FDC00200002, 0x80003FDD00200002, 0x80003FDE00200002,
+		0x80003FDF00200002, 0x80003FE000200002, 0x80003FE100200002, 0x80003FE200200002, 0x80003FE300200002, 0x80003FE400200002, 0x80003FE500200002, 0x80003FE600200002,
+		0x80003FE700200002, 0x80003FE800200002, 0x80003FE900200002, 0x80003FEA00200002, 0x80003FEB00200002, 0x80003FEC00200002, 0x80003FED00200002, 0x80003FEE00200002,
+		0x80003FEF00200002, 0x80003FF000200002, 0x80003FF100200002, 0x80003FF200200002, 0x80003FF300200002, 0x80003FF400200002, 0x80003FF500200002, 0x80003FF600200002,
+		0x80003FF700200002, 0x80003FF800200002, 0x80003FF900200002, 0x80003FFA00200002, 0x80003FFB00200002, 0x80003FFC00200002, 0x80003FFD00200002, 0x80003FFE00200002,
+		0x80003FFF00200002, 0x8000400000200002, 0x8000400100200002, 0x8000400200200002, 0x8000400300200002, 0x8000400400200002, 0x8000400500200002, 0x8000400600200002,
+		0x8000400700200002, 0x8000400800200002, 0x8000400900200002, 0x8000400A00200002, 0x8000400B00200002, 0x8000400C00200002, 0x8000400D00200002, 0x8000400E00200002,
+		0x8000400F00200002, 0x8000401000200002, 0x8000401100200002, 0x8000401200200002, 0x8000401300200002, 0x8000401400200002, 0x8000401500200002, 0x8000401600200002,
+		0x8000401700200002, 0x8000401800200002, 0x8000401900200002, 0x8000401A00200002, 0x8000401B00200002, 0x8000401C00200002, 0x8000401D00200002, 0x8000401E00200002,
+		0x8000401F00200002, 0x8000402000200002, 0x8000402100200002, 0x8000402200200002, 0x8000402300200002, 0x8000402400200002, 0x8000402500200002, 0x8000402600200002,
+		0x8000402700200002, 0x8000402800200002, 0x8000402900200002, 0x8000402A00200002, 0x8000402B00200002, 0x8000402C00200002, 0x8000402D00200002, 0x8000402E00200002,
+		0x8000402F00200002, 0x8000403000200002, 0x8000403100200002, 0x8000403200200002, 0x8000403300200002, 0x8000403400200002, 0x8000403500200002, 0x8000403600200002,
+		0x8000403700200002, 0x8000403800200002, 0x8000403900200002, 0x8000403A00200002, 0x8000403B00200002, 0x8000403C00200002, 0x8000403D00200002, 0x8000403E00200002,
+		0x8000403F00200002, 0x8000404000200002, 0x8000404100200002, 0x8000404200200002, 0x8000404300200002, 0x8000404400200002, 0x8000404500200002, 0x8000404600200002,
+		0x8000404700200002, 0x8000404800200002, 0x8000404900200002, 0x8000404A00200002, 0x8000404B00200002, 0x8000404C00200002, 0x8000404D00200002, 0x8000404E00200002,
+		0x8000404F00200002, 0x8000405000200002, 0x8000405100200002, 0x8000405200200002, 0x8000405300200002, 0x8000405400200002, 0x8000405500200002, 0x8000405600200002,
+		0x8000405700200002, 0x8000405800200002, 0x8000405900200002, 0x8000405A00200002, 0x8000405B00200002, 0x8000405C00200002, 0x8000405D00200002, 0x8000405E00200002,
+		0x8000405F00200002, 0x8000406000200002, 0x8000406100200002, 0x8000406200200002, 0x8000406300200002, 0x8000406400200002, 0x8000406500200002, 0x8000406600200002,
+		0x8000406700200002, 0x8000406800200002, 0x8000406900200002, 0x8000406A00200002, 0x8000406B00200002, 0x8000406C00200002, 0x8000406D00200002, 0x8000406E00200002,
+		0x8000406F00200002, 0x8000407000200002, 0x8000407100200002, 0x8000407200200002, 0x8000407300200002, 0x8000407400200002, 0x8000407500200002, 0x8000407600200002,
+		0x8000407700200002, 0x8000407800200002, 0x8000407900200002, 0x8000407A00200002, 0x8000407B00200002, 0x8000407C00200002, 0x8000407D00200002, 0x8000407E00200002,
+		0x8000407F00200002, 0x8000408000200002, 0x8000408100200002, 0x8000408200200002, 0x8000408300200002, 0x8000408400200002, 0x8000408500200002, 0x8000408600200002,
+		0x8000408700200002, 0x8000408800200002, 0x8000408900200002, 0x8000408A00200002, 0x8000408B00200002, 0x8000408C00200002, 0x8000408D00200002, 0x8000408E00200002,
+		0x8000408F00200002, 0x8000409000200002, 0x8000409100200002, 0x8000409200200002, 0x8000409300200002, 0x8000409400200002, 0x8000409500200002, 0x8000409600200002,
+		0x8000409700200002, 0x8000409800200002, 0x8000409900200002, 0x8000409A00200002, 0x8000409B00200002, 0x8000409C00200002, 0x8000409D00200002, 0x8000409E00200002,
+		0x8000409F00200002, 0x800040A000200002, 0x800040A100200002, 0x800040A200200002, 0x800040A300200002, 0x800040A400200002, 0x800040A500200002, 0x800040A600200002,
+		0x800040A700200002, 0x800040A800200002, 0x800040A900200002, 0x800040AA00200002, 0x800040AB00200002, 0x800040AC00200002, 0x800040AD00200002, 0x800040AE00200002,
+		0x800040AF00200002, 0x800040B000200002, 0x800040B100200002, 0x800040B200200002, 0x800040B300200002, 0x800040B400200002, 0x800040B500200002, 0x800040B600200002,
+		0x800040B700200002, 0x800040B800200002, 0x800040B900200002, 0x800040BA00200002, 0x800040BB00200002, 0x800040BC00200002, 0x800040BD00200002, 0x800040BE00200002,
+		0x800040BF00200002, 0x800040C000200002, 0x800040C100200002, 0x800040C200200002, 0x800040C300200002, 0x800040C400200002, 0x800040C500200002, 0x800040C600200002,
+		0x800040C700200002, 0x800040C800200002, 0x800040C900200002, 0x800040CA00200002, 0x800040CB00200002, 0x800040CC00200002, 0x800040CD00200002, 0x800040CE00200002,
+		0x800040CF00200002, 0x800040D000200002, 0x800040D100200002, 0x800040D200200002, 0x800040D300200002, 0x800040D400200002, 0x800040D500200002, 0x800040D600200002,
+		0x800040D700200002, 0x800040D800200002, 0x800040D900200002, 0x800040DA00200002, 0x800040DB00200002, 0x800040DC00200002, 0x800040DD00200002, 0x800040DE00200002,
+		0x800040DF00200002, 0x800040E000200002, 0x800040E100200002, 0x800040E200200002, 0x800040E300200002, 0x800040E400200002, 0x800040E500200002, 0x800040E600200002,
+		0x800040E700200002, 0x800040E800200002, 0x800040E900200002, 0x800040EA00200002, 0x800040EB00200002, 0x800040EC00200002, 0x800040ED00200002, 0x800040EE00200002,
+		0x800040EF00200002, 0x800040F000200002, 0x800040F100200002, 0x800040F200200002, 0x800040F300200002, 0x800040F400200002, 0x800040F500200002, 0x800040F600200002,
+		0x800040F700200002, 0x800040F800200002, 0x800040F900200002, 0x800040FA00200002, 0x800040FB00200002, 0x800040FC00200002, 0x800040FD00200002, 0x800040FE00200002,
+		0x800040FF00200002, 0x8000410000200002, 0x8000410100200002, 0x8000410200200002, 0x8000410300200002, 0x8000410400200002, 0x8000410500200002, 0x8000410600200002,
+		0x8000410700200002, 0x8000410800200002, 0x8000410900200002, 0x8000410A00200002, 0x8000410B00200002, 0x8000410C00200002, 0x8000410D00200002, 0x8000410E00200002,
+		0x8000410F00200002, 0x8000411000200002, 0x8000411100200002, 0x8000411200200002, 0x8000411300200002, 0x8000411400200002, 0x8000411500200002, 0x8000411600200002,
+		0x8000411700200002, 0x8000411800200002, 0x8000411900200002, 0x8000411A00200002, 0x8000411B00200002, 0x8000411C00200002, 0x8000411D00200002, 0x8000411E00200002,
+		0x8000411F00200002, 0x8000412000200002, 0x8000412100200002, 0x8000412200200002, 0x8000412300200002, 0x8000412400200002, 0x8000412500200002, 0x8000412600200002,
+		0x8000412700200002, 0x8000412800200002, 0x8000412900200002, 0x8000412A00200002, 0x8000412B00200002, 0x8000412C00200002, 0x8000412D00200002, 0x8000412E00200002,
+		0x8000412F00200002, 0x8000413000200002, 0x8000413100200002, 0x8000413200200002, 0x8000413300200002, 0x8000413400200002, 0x8000413500200002, 0x8000413600200002,
+		0x8000413700200002, 0x8000413800200002, 0x8000413900200002, 0x8000413A00200002, 0x8000413B00200002, 0x8000413C00200002, 0x8000413D00200002, 0x8000413E00200002,
+		0x8000413F00200002, 0x8000414000200002, 0x8000414100200002, 0x8000414200200002, 0x8000414300200002, 0x8000414400200002, 0x8000414500200002, 0x8000414600200002,
+		0x8000414700200002, 0x8000414800200002, 0x8000414900200002, 0x8000414A00200002, 0x8000414B00200002, 0x8000414C00200002, 0x8000414D00200002, 0x8000414E00200002,
+		0x8000414F00200002, 0x8000415000200002, 0x8000415100200002, 0x8000415200200002, 0x8000415300200002, 0x8000415400200002, 0x8000415500200002, 0x8000415600200002,
+		0x8000415700200002, 0x8000415800200002, 0x8000415900200002, 0x8000415A00200002, 0x8000415B00200002, 0x8000415C00200002, 0x8000415D00200002, 0x8000415E00200002,
+		0x8000415F00200002, 0x8000416000200002, 0x8000416100200002, 0x8000416200200002, 0x8000416300200002, 0x8000416400200002, 0x8000416500200002, 0x8000416600200002,
+		0x8000416700200002, 0x8000416800200002, 0x8000416900200002, 0x8000416A00200002, 0x8000416B00200002, 0x8000416C00200002, 0x8000416D00200002, 0x8000416E00200002,
+		0x8000416F00200002, 0x8000417000200002, 0x8000417100200002, 0x8000417200200002, 0x8000417300200002, 0x8000417400200002, 0x8000417500200002, 0x8000417600200002,
+		0x8000417700200002, 0x8000417800200002, 0x8000417900200002, 0x8000417A00200002, 0x8000417B00200002, 0x8000417C00200002, 0x8000417D00200002, 0x8000417E00200002,
+		0x8000417F00200002, 0x8000418000200002, 0x8000418100200002, 0x8000418200200002, 0x8000418300200002, 0x8000418400200002, 0x8000418500200002, 0x8000418600200002,
+		0x8000418700200002, 0x8000418800200002, 0x8000418900200002, 0x8000418A00200002, 0x8000418B00200002, 0x8000418C00200002, 0x8000418D00200002, 0x8000418E00200002,
+		0x8000418F00200002, 0x8000419000200002, 0x8000419100200002, 0x8000419200200002, 0x8000419300200002, 0x8000419400200002, 0x8000419500200002, 0x8000419600200002,
+		0x8000419700200002, 0x8000419800200002, 0x8000419900200002, 0x8000419A00200002, 0x8000419B00200002, 0x8000419C00200002, 0x8000419D00200002, 0x8000419E00200002,
+		0x8000419F00200002, 0x800041A000200002, 0x800041A100200002, 0x800041A200200002, 0x800041A300200002, 0x800041A400200002, 0x800041A500200002, 0x800041A600200002,
+		0x800041A700200002, 0x800041A800200002, 0x800041A900200002, 0x800041AA00200002, 0x800041AB00200002, 0x800041AC00200002, 0x800041AD00200002, 0x800041AE00200002,
+		0x800041AF00200002, 0x800041B000200002, 0x800041B100200002, 0x800041B200200002, 0x800041B300200002, 0x800041B400200002, 0x800041B500200002, 0x800041B600200002,
+		0x800041B700200002, 0x800041B800200002, 0x800041B900200002, 0x800041BA00200002, 0x800041BB00200002, 0x800041BC00200002, 0x800041BD00200002, 0x800041BE00200002,
+		0x800041BF00200002, 0x800041C000200002, 0x800041C100200002, 0x800041C200200002, 0x800041C300200002, 0x800041C400200002, 0x800041C500200002, 0x800041C600200002,
+		0x800041C700200002, 0x800041C800200002, 0x800041C900200002, 0x800041CA00200002, 0x800041CB00200002, 0x800041CC00200002, 0x800041CD00200002, 0x800041CE00200002,
+		0x800041CF00200002, 0x800041D000200002, 0x800041D100200002, 0x800041D200200002, 0x800041D300200002, 0x800041D400200002, 0x800041D500200002, 0x800041D600200002,
+		0x800041D700200002, 0x800041D800200002, 0x800041D900200002, 0x800041DA00200002, 0x800041DB00200002, 0x800041DC00200002, 0x800041DD00200002, 0x800041DE00200002,
+		0x800041DF00200002, 0x800041E000200002, 0x800041E100200002, 0x800041E200200002, 0x800041E300200002, 0x800041E400200002, 0x800041E500200002, 0x800041E600200002,
+		0x800041E700200002, 0x800041E800200002, 0x800041E900200002, 0x800041EA00200002, 0x800041EB00200002, 0x800041EC00200002, 0x800041ED00200002, 0x800041EE00200002,
+		0x800041EF00200002, 0x800041F000200002, 0x800041F100200002, 0x800041F200200002, 0x800041F300200002, 0x800041F400200002, 0x800041F500200002, 0x800041F600200002,
+		0x800041F700200002, 0x800041F800200002, 0x800041F900200002, 0x800041FA00200002, 0x800041FB00200002, 0x800041FC00200002, 0x800041FD00200002, 0x800041FE00200002,
+		0x800041FF00200002, 0x8000420000200002, 0x8000420100200002, 0x8000420200200002, 0x8000420300200002, 0x8000420400200002, 0x8000420500200002, 0x8000420600200002,
+		0x8000420700200002, 0x8000420800200002, 0x8000420900200002, 0x8000420A00200002, 0x8000420B00200002, 0x8000420C00200002, 0x8000420D00200002, 0x8000420E00200002,
+		0x8000420F00200002, 0x8000421000200002, 0x8000421100200002, 0x8000421200200002, 0x8000421300200002, 0x8000421400200002, 0x8000421500200002, 0x8000421600200002,
+		0x8000421700200002, 0x8000421800200002, 0x8000421900200002, 0x8000421A00200002, 0x8000421B00200002, 0x8000421C00200002, 0x8000421D00200002, 0x8000421E00200002,
+		0x8000421F00200002, 0x8000422000200002, 0x8000422100200002, 0x8000422200200002, 0x8000422300200002, 0x8000422400200002, 0x8000422500200002, 0x8000422600200002,
+		0x8000422700200002, 0x8000422800200002, 0x8000422900200002, 0x8000422A00200002, 0x8000422B00200002, 0x8000422C00200002, 0x8000422D00200002, 0x8000422E00200002,
+		0x8000422F00200002, 0x8000423000200002, 0x8000423100200002, 0x8000423200200002, 0x8000423300200002, 0x8000423400200002, 0x8000423500200002, 0x8000423600200002,
+		0x8000423700200002, 0x8000423800200002, 0x8000423900200002, 0x8000423A00200002, 0x8000423B00200002, 0x8000423C00200002, 0x8000423D00200002, 0x8000423E00200002,
+		0x8000423F00200002, 0x8000424000200002, 0x8000424100200002, 0x8000424200200002, 0x8000424300200002, 0x8000424400200002, 0x8000424500200002, 0x8000424600200002,
+		0x8000424700200002, 0x8000424800200002, 0x8000424900200002, 0x8000424A00200002, 0x8000424B00200002, 0, 0, 0,
+		0x80000F4100200002, 0x80000F4200200002, 0x80000F4300200002, 0x80000F4400200002, 0x80000F4500200002, 0x80000F4600200002, 0x80000F4700200002, 0x80000F4800200002,
+		0x80000F4900200002, 0x80000F4A00200002, 0x80000F4B00200002, 0x80000F4C00200002, 0x80000F4D00200002, 0x80000F4E00200002, 0x80000F4F00200002, 0x80000F5000200002,
+		0x80000F5100200002, 0x80000F5200200002, 0x80000F5300200002, 0x80000F5400200002, 0x80000F5500200002, 0x80000F5600200002, 0x80000F5700200002, 0x80000F5800200002,
+		0x80000F5900200002, 0x80000F5A00200002, 0x80000F5B00200002, 0x80000F5C00200002, 0x80000F5D00200002, 0x80000F5E00200002, 0x80000F5F00200002, 0x80000F6000200002,
+		0x80000F6100200002, 0x80000F6200200002, 0x80000F6300200002, 0x80000F6400200002, 0x80000F6500200002, 0x80000F6600200002, 0x80000F6700200002, 0x80000F6800200002,
+		0x80000F6900200002, 0x80000F6A00200002, 0x80000F6B00200002, 0x80000F6C00200002, 0x80000F6D00200002, 0x80000F6E00200002, 0x80000F6F00200002, 0x80000F7000200002,
+		0x80000F7100200002, 0x80000F7200200002, 0x80000F7300200002, 0x80000F7400200002, 0x80000F7500200002, 0x80000F7600200002, 0x80000F7700200002, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000425200200002, 0x8000425300200002, 0x8000425400200002, 0x8000425500200002, 0x8000425600200002, 0x8000425700200002, 0x8000425800200002, 0x8000425900200002,
+		0x8000425A00200002, 0x8000425B00200002, 0x8000425C00200002, 0x8000425D00200002, 0x8000425E00200002, 0x8000425F00200002, 0x8000426000200002, 0x8000426100200002,
+		0x8000426200200002, 0x8000426300200002, 0x8000426400200002, 0x8000426500200002, 0x8000426600200002, 0x8000426700200002, 0x8000426800200002, 0x8000426900200002,
+		0x8000426A00200002, 0x8000426B00200002, 0x8000426E00200002, 0x8000426C00200002, 0x8000426F00200002, 0x8000426D00200002, 0x8000427000200002, 0x8000427100200002,
+		0x8000427200200002, 0x8000427300200002, 0x8000427400200002, 0x8000427500200002, 0x8000427600200002, 0x8000427700200002, 0x8000427800200002, 0x8000427900200002,
+		0x8000424C00200002, 0x8000424D00200002, 0x8000424E00200002, 0x8000424F00200002, 0x8000425100200002, 0x8000425000200002, 0x8000022E00200002, 0x8000028500200002,
+		0x8000375A00200002, 0x8000375B00200002, 0x8000375C00200002, 0x8000375D00200002, 0x8000375E00200002, 0x8000375F00200002, 0x8000376000200002, 0x8000376100200002,
+		0x8000376200200002, 0x8000376300200002, 0x8000376400200002, 0x8000376500200002, 0x8000376600200002, 0x8000376700200002, 0x8000376800200002, 0x8000376900200002,
+		0x8000376A00200002, 0x8000376B00200002, 0x8000376C00200002, 0x8000376D00200002, 0x8000376E00200002, 0x8000376F00200002, 0x8000377000200002, 0x8000377100200002,
+		0x8000377200200002, 0x8000377300200002, 0x8000377400200002, 0x8000377500200002, 0x8000377600200002, 0x8000377700200002, 0x8000377800200002, 0x8000377900200002,
+		0x8000377A00200002, 0x8000377B00200002, 0x8000377C00200002, 0x8000377D00200002, 0x8000377E00200002, 0x8000377F00200002, 0x8000378000200002, 0x8000378100200002,
+		0x8000378200200002, 0x8000378300200002, 0x8000378400200002, 0x8000378500200002, 0x8000378600200002, 0x8000378700200002, 0x8000378800200002, 0x8000378900200002,
+		0x8000378A00200002, 0x8000378B00200002, 0x8000378C00200002, 0x8000378D00200002, 0x8000378E00200002, 0x8000378F00200002, 0x8000379000200002, 0x8000379100200002,
+		0x8000379200200002, 0x8000379300200002, 0x8000379400200002, 0x8000379500200002, 0x8000379600200002, 0x8000379700200002, 0x8000379800200002, 0x8000379900200002,
+		0x8000379A00200002, 0x8000379B00200002, 0x8000379C00200002, 0x8000379D00200002, 0x8000379E00200002, 0x8000379F00200002, 0x800037A000200002, 0x800037A100200002,
+		0x800037A200200002, 0x800037A300200002, 0x800037A400200002, 0x800037A500200002, 0x800037A600200002, 0x800037A700200002, 0x800037A800200002, 0x800037A900200002,
+		0x800037AA00200002, 0x800037AB00200002, 0x800037AC00200002, 0x800037AD00200002, 0x800037AE00200002, 0x800037AF00200002, 0x800037B000200002, 0x800037B100200002,
+		0x800037B200200002, 0x800037B300200002, 0x800037B400200002, 0x800037B500200002, 0x800037B600200002, 0x800037B700200002, 0x800037B800200002, 0x800037B900200002,
+		0x800037BA00200002, 0x800037BB00200002, 0x800037BC00200002, 0x800037BD00200002, 0x800037BE00200002, 0x800037BF00200002, 0x800037C000200002, 0x800037C100200002,
+		0x800037C200200002, 0x800037C300200002, 0x800037C400200002, 0x800037C500200002, 0x800037C600200002, 0x800037C700200002, 0x800037C800200002, 0x800037C900200002,
+		0x800037CA00200002, 0x800037CB00200002, 0x800037CC00200002, 0x800037CD00200002, 0x800037CE00200002, 0x800037CF00200002, 0x800037D000200002, 0x800037D100200002,
+		0x800037D200200002, 0x800037D300200002, 0x800037D400200002, 0x800037D500200002, 0x800037D600200002, 0x800037D700200002, 0x800037D800200002, 0x800037D900200002,
+		0x800037DA00200002, 0x800037DB00200002, 0x800037DC00200002, 0x800037DD00200002, 0x800037DE00200002, 0x800037DF00200002, 0x800037E000200002, 0x800037E100200002,
+		0x800037E200200002, 0x800037E300200002, 0x800037E400200002, 0x800037E500200002, 0x800037E600200002, 0x800037E700200002, 0x800037E800200002, 0x800037E900200002,
+		0x800037EA00200002, 0x800037EB00200002, 0x800037EC00200002, 0x800037ED00200002, 0x800037EE00200002, 0x800037EF00200002, 0x800037F000200002, 0x800037F100200002,
+		0x800037F200200002, 0x800037F300200002, 0x800037F400200002, 0x800037F500200002, 0x800037F600200002, 0x800037F700200002, 0x800037F800200002, 0x800037F900200002,
+		0x800037FA00200002, 0x800037FB00200002, 0x800037FC00200002, 0x800037FD00200002, 0x800037FE00200002, 0x800037FF00200002, 0x8000380000200002, 0x8000380100200002,
+		0x8000380200200002, 0x8000380300200002, 0x8000380400200002, 0x8000380500200002, 0x8000380600200002, 0x8000380700200002, 0x8000380800200002, 0x8000380900200002,
+		0x8000380A00200002, 0x8000380B00200002, 0x8000380C00200002, 0x8000380D00200002, 0x8000380E00200002, 0x8000380F00200002, 0x8000381000200002, 0x8000381100200002,
+		0x8000381200200002, 0x8000381300200002, 0x8000381400200002, 0x8000381500200002, 0x8000381600200002, 0x8000381700200002, 0x8000381800200002, 0x8000381900200002,
+		0x8000381A00200002, 0x8000381B00200002, 0x8000381C00200002, 0x8000381D00200002, 0x8000381E00200002, 0x8000381F00200002, 0x8000382000200002, 0x8000382100200002,
+		0x8000382200200002, 0x8000382300200002, 0x8000382400200002, 0x8000382500200002, 0x8000382600200002, 0x8000382700200002, 0x8000382800200002, 0x8000382900200002,
+		0x8000382A00200002, 0x8000382B00200002, 0x8000382C00200002, 0x8000382D00200002, 0x8000382E00200002, 0x8000382F00200002, 0x8000383000200002, 0x8000383100200002,
+		0x8000383200200002, 0x8000383300200002, 0x8000383400200002, 0x8000383500200002, 0x8000383600200002, 0x8000383700200002, 0x8000383800200002, 0x8000383900200002,
+		0x8000383A00200002, 0x8000383B00200002, 0x8000383C00200002, 0x8000383D00200002, 0x8000383E00200002, 0x8000383F00200002, 0x8000384000200002, 0x8000384100200002,
+		0x8000384200200002, 0x8000384300200002, 0x8000384400200002, 0x8000384500200002, 0x8000384600200002, 0x8000384700200002, 0x8000384800200002, 0x8000384900200002,
+		0x8000384A00200002, 0x8000384B00200002, 0x8000384C00200002, 0x8000384D00200002, 0x8000384E00200002, 0x8000384F00200002, 0x8000385000200002, 0x8000385100200002,
+		0x8000385200200002, 0x8000385300200002, 0x8000385400200002, 0x8000385500200002, 0x8000385600200002, 0x8000385700200002, 0x8000385800200002, 0x8000385900200002,
+		0x8000385A00200002, 0x8000385B00200002, 0x8000385C00200002, 0x8000385D00200002, 0x8000385E00200002, 0x8000385F00200002, 0x8000386000200002, 0x8000386100200002,
+		0x8000386200200002, 0x8000386300200002, 0x8000386400200002, 0x8000386500200002, 0x8000386600200002, 0x8000022F00200002, 0x8000028600200002, 0x8000027000200002,
+		0x800037B200200004, 0x800037C400200004, 0x800037E100200004, 0x400000000013E902, 0x400000000013EB02, 0x400000000013ED02, 0x400000000013EF02, 0x400000000013F102,
+		0x400000000013F302, 0x400000000013F502, 0x400000000013F702, 0x400000000013F902, 0x400000000013FB02, 0x400000000013FD02, 0x400000000013FF02, 0x4000000000140102,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0x800037C800200004, 0x8000382B00200004, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000207000200008, 0x8000207000200002, 0x8000207300200008, 0x8000207300200002, 0x8000207800200008, 0x8000207800200002, 0x8000208C00200008, 0x8000208C00200002,
+		0x8000209500200008, 0x8000209500200002, 0x8000212900200008, 0x8000212900200002, 0x8000215000200008, 0x8000215000200002, 0x8000218C00200008, 0x8000218C00200002,
+		0x8000219300200008, 0x8000219300200002, 0x800021A400200008, 0x800021A400200002, 0x800021AD00200008, 0x800021AD00200002, 0x800021AE00200008, 0x800021AE00200002,
+		0x800021BC00200008, 0x800021BC00200002, 0x800021C100200008, 0x800021C100200002, 0x800021C600200008, 0x800021C600200002, 0x800021DB00200008, 0x800021DB00200002,
+		0x8000215D00200008, 0x8000215D00200002, 0x8000205400200008, 0x8000205400200002, 0x800020C000200008, 0x800020C000200002, 0x800020CB00200008, 0x800020CB00200002,
+		0x800020E70020000A, 0x800020E700200004, 0x800020E70020000A, 0x800020E700200004, 0x800020E70020000A, 0x800020E700200004, 0x800020E700200004, 0x8000000000510002,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000039300200002, 0x8000205E00200004, 0x8000208000200004, 0x4000000000140302, 0x8000211D00200004,
+		0x8000218F00200004, 0x8000219400200004, 0x8000219800200004, 0x8000214800200004, 0x8000000000330002, 0x8000000000330002, 0x800003CA00200002, 0x8000218E00200002,
+		0x8000204F00200008, 0x8000204F00200002, 0x8000207F00200008, 0x8000207F00200002, 0x8000206700200008, 0x8000206700200002, 0x8000217600200008, 0x8000217600200002,
+		0x8000207D00200008, 0x8000207D00200002, 0x8000211800200008, 0x8000211800200002, 0x8000211200200008, 0x8000211200200002, 0x8000215E00200008, 0x8000215E00200002,
+		0x8000216300200008, 0x8000216300200002, 0x8000216900200008, 0x8000216900200002, 0x8000214700200008, 0x8000214700200002, 0x8000218700200008, 0x8000218700200002,
+		0x800020E70020000A, 0x800020E700200004, 0x800020E70020000A, 0x800020E700200004, 0x8000218F00200014, 0x8000219800200014, 0x8000212E00200004, 0x800021B400200004,
+		0x8000386700200002, 0x8000386800200002, 0x8000386900200002, 0x8000386A00200002, 0x8000386B00200002, 0x8000386C00200002, 0x8000386D00200002, 0x8000386E00200002,
+		0x8000386F00200002, 0x8000387000200002, 0x8000387100200002, 0x8000387200200002, 0x8000387300200002, 0x8000387400200002, 0x8000387500200002, 0x8000387600200002,
+		0x8000387700200002, 0x8000387800200002, 0x8000387900200002, 0x8000387A00200002, 0x8000387B00200002, 0x8000387C00200002, 0x8000387D00200002, 0x8000387E00200002,
+		0x8000387F00200002, 0x8000388000200002, 0x8000388100200002, 0x8000388200200002, 0x8000388300200002, 0x8000388400200002, 0x8000388500200002, 0x8000388600200002,
+		0x8000388700200002, 0x8000388800200002, 0x8000388900200002, 0x8000388A00200002, 0x8000388B00200002, 0x8000388C00200002, 0x8000388D00200002, 0x8000388E00200002,
+		0x8000388F00200002, 0x8000389000200002, 0x8000389100200002, 0x8000389200200002, 0x8000389300200002, 0x8000389400200002, 0x8000389500200002, 0x8000389600200002,
+		0x8000389700200002, 0x8000389800200002, 0x8000389900200002, 0x8000389A00200002, 0x8000389B00200002, 0x8000389C00200002, 0x8000389D00200002, 0x8000389E00200002,
+		0x8000389F00200002, 0x800038A000200002, 0x800038A100200002, 0x800038A200200002, 0x800038A300200002, 0x800038A400200002, 0x800038A500200002, 0x800038A600200002,
+		0x800038A700200002, 0x800038A800200002, 0x800038A900200002, 0x800038AA00200002, 0x800038AB00200002, 0x800038AC00200002, 0x800038AD00200002, 0x800038AE00200002,
+		0x800038AF00200002, 0x800038B000200002, 0x800038B100200002, 0x800038B200200002, 0x800038B300200002, 0x800038B400200002, 0x800038B500200002, 0x800038B600200002,
+		0x8000000000B60002, 0x8000000000B70002, 0x800002D700200002, 0x8000028700200002, 0x8000025F00200002, 0x8000023000200002, 0x8000023800200002, 0x8000027100200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800004D000200002, 0x800004D100200002, 0x800004D200200002, 0x800004D300200002, 0x800004D400200002, 0x800004D500200002, 0x800004D600200002, 0x800004D700200002,
+		0x800004D800200002, 0x800004D900200002, 0x800004DA00200002, 0x800004DB00200002, 0x800004DC00200002, 0x800004DD00200002, 0x800004DE00200002, 0x800004DF00200002,
+		0x800004E000200002, 0x800004E100200002, 0x800004E200200002, 0x800004E300200002, 0x800004E400200002, 0x800004E500200002, 0x800004E600200002, 0x800004E700200002,
+		0x800004E800200002, 0x800004E900200002, 0x800004EA00200002, 0x800004EB00200002, 0x800004EC00200002, 0x800004ED00200002, 0x800004EE00200002, 0x800004EF00200002,
+		0x800004F000200002, 0x800004F100200002, 0x80001F8100200008, 0x80001F8100200002, 0x80001F8C00200008, 0x80001F8C00200002, 0x80001D2B00200008, 0x80001D2B00200002,
+		0x4000000000140502, 0x4000000000140702, 0x80001F6600200008, 0x80001F6600200002, 0x80001F6700200008, 0x80001F6700200002, 0x80001F6800200008, 0x80001F6800200002,
+		0x80001CE900200002, 0x80001E7500200002, 0x4000000000140902, 0x4000000000140B02, 0x4000000000140D02, 0x4000000000140F02, 0x4000000000141102, 0x4000000000141302,
+		0x4000000000141502, 0x4000000000141702, 0x4000000000141903, 0x4000000000141C03, 0x4000000000141F02, 0x4000000000142102, 0x80001C8E00200008, 0x80001C8E00200002,
+		0x80001D7000200008, 0x80001D7000200002, 0x80001D7100200008, 0x80001D7100200002, 0x80001D7200200008, 0x80001D7200200002, 0x80001D7F00200008, 0x80001D7F00200002,
+		0x80001D8100200008, 0x80001D8100200002, 0x80001E0100200008, 0x80001E0100200002, 0x80001DF900200008, 0x80001DF900200002, 0x4000000000142302, 0x4000000000142502,
+		0x80001E1200200008, 0x80001E1200200002, 0x80001E1900200008, 0x80001E1900200002, 0x80001E1A00200008, 0x80001E1A00200002, 0x80001E2500200008, 0x80001E2500200002,
+		0x80001E2600200008, 0x80001E2600200002, 0x80001E3D00200008, 0x80001E3D00200002, 0x80001E7000200008, 0x80001E7000200002, 0x80001EE800200008, 0x80001EE800200002,
+		0x4000000000142702, 0x4000000000142902, 0x80001F3D00200008, 0x80001F3D00200002, 0x80001F5400200008, 0x80001F5400200002, 0x80001F5500200008, 0x80001F5500200002,
+		0x80001F5A00200008, 0x80001F5A00200002, 0x80001F5E00200008, 0x80001F5E00200002, 0x80001F5F00200008, 0x80001F5F00200002, 0x80001F6000200008, 0x80001F6000200002,
+		0x80001F6000200014, 0x80001CA800200002, 0x80001D9C00200002, 0x80001DB800200002, 0x80001DD700200002, 0x80001E6E00200002, 0x80001E6F00200002, 0x80001EB000200002,
+		0x80001F6100200002, 0x4000000000142B02, 0x4000000000142D02, 0x4000000000142F02, 0x4000000000143102, 0x4000000000143302, 0x80001D0F00200008, 0x80001D0F00200002,
+		0x80001DA100200008, 0x80001DA100200002, 0x4000000000143502, 0x4000000000143702, 0x4000000000143902, 0x4000000000143B02, 0x4000000000143D02, 0x4000000000143F02,
+		0x800004F200200002, 0x800004F300200002, 0x800004F400200002, 0x80001F8200200008, 0x80001F8200200002, 0x80001EC800200008, 0x80001D9700200002, 0x80001F8300200002,
+		0x80001DCC00200008, 0x80001DCC00200002, 0x80001C8300200008, 0x80001C8300200002, 0x80001C8400200002, 0x80001D2400200002, 0x80001C6F00200008, 0x80001C6F00200002,
+		0x80001CEB00200008, 0x80001CEB00200002, 0x4000000000144102, 0x4000000000144302, 0x4000000000144502, 0x4000000000144702, 0x4000000000144902, 0x4000000000144B02,
+		0x4000000000144D02, 0x4000000000144F02, 0x4000000000145102, 0x4000000000145302, 0x4000000000145502, 0x4000000000145702, 0x4000000000145902, 0x4000000000145B02,
+		0x4000000000145D02, 0x4000000000145F02, 0x80001D2500200008, 0x80001CCF00200008, 0x80001CF800200008, 0x80001D8D00200008, 0x80001D3A00200008, 0,
+		0x80001D7300200008, 0x80001EB100200008, 0x80001D5900200008, 0x80001F0800200008, 0x80001C7900200008, 0x80001C7900200002, 0x80001E0600200008, 0x80001E0600200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0x80001D3F00200002,
+		0x4000000000146102, 0x4000000000146303, 0x80001ED800200002, 0x80001CF300200002, 0x80001E1B00200002, 0x80001DB600200002, 0x80001D3E00200002, 0x80001DB700200002,
+		0x8000295C00200002, 0x8000295D00200002, 0x8000295E00200002, 0x8000295F00200002, 0x8000296000200002, 0x8000296100200002, 0x8000296200200002, 0x8000296300200002,
+		0x8000296400200002, 0x8000296500200002, 0x8000296600200002, 0x8000000000C40002, 0x8000296700200002, 0x8000296800200002, 0x8000296900200002, 0x8000296A00200002,
+		0x8000296B00200002, 0x8000296C00200002, 0x8000296D00200002, 0x8000296E00200002, 0x8000296F00200002, 0x8000297000200002, 0x8000297100200002, 0x8000297200200002,
+		0x8000297300200002, 0x8000297400200002, 0x8000297500200002, 0x8000297600200002, 0x8000297700200002, 0x8000297800200002, 0x8000297900200002, 0x8000297A00200002,
+		0x8000297B00200002, 0x8000297C00200002, 0x8000297D00200002, 0x8000297E00200002, 0x8000297F00200002, 0x8000298000200002, 0x8000298100200002, 0x8000298200200002,
+		0x8000051F00200002, 0x8000052000200002, 0x8000052100200002, 0x8000052200200002, 0, 0, 0, 0,
+		0x80001AA200200002, 0x80001AA300200002, 0x80001AA400200002, 0x80001AA500200002, 0x80001AA600200002, 0x80001AA700200002, 0x8000052300200002, 0x8000052400200002,
+		0x80001C1B00200002, 0x8000052500200002, 0, 0, 0, 0, 0, 0,
+		0x80002F0000200002, 0x80002F0100200002, 0x80002F0200200002, 0x80002F0300200002, 0x80002F0400200002, 0x80002F0500200002, 0x80002F0600200002, 0x80002F0700200002,
+		0x80002F0C00200002, 0x80002F0D00200002, 0x80002F0E00200002, 0x80002F0F00200002, 0x80002F1000200002, 0x80002F1100200002, 0x80002F1200200002, 0x80002F1300200002,
+		0x80002F1400200002, 0x80002F1500200002, 0x80002F1600200002, 0x80002F1700200002, 0x80002F1900200002, 0x80002F1A00200002, 0x80002F1B00200002, 0x80002F1C00200002,
+		0x80002F1F00200002, 0x80002F2200200002, 0x80002F2300200002, 0x80002F2500200002, 0x80002F2600200002, 0x80002F2900200002, 0x80002F2E00200002, 0x80002F2F00200002,
+		0x80002F3000200002, 0x80002F3100200002, 0x80002F2A00200002, 0x80002F2B00200002, 0x80002F2C00200002, 0x80002F2D00200002, 0x80002F3200200002, 0x80002F1800200002,
+		0x80002F1D00200002, 0x80002F0800200002, 0x80002F0900200002, 0x80002F0A00200002, 0x80002F0B00200002, 0x80002F1E00200002, 0x80002F2400200002, 0x80002F2700200002,
+		0x80002F2800200002, 0x80002F2000200002, 0x80002F2100200002, 0x80002F3300200002, 0x8000042800200002, 0x8000042900200002, 0x8000029400200002, 0x8000029500200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000000000C40002, 0x8000000000C50002, 0x8000298300200002, 0x8000298400200002, 0x8000298500200002, 0x8000298600200002, 0x8000298700200002, 0x8000298800200002,
+		0x8000298900200002, 0x8000298A00200002, 0x8000298B00200002, 0x8000298C00200002, 0x8000298D00200002, 0x8000298E00200002, 0x8000298F00200002, 0x8000299000200002,
+		0x8000299100200002, 0x8000299200200002, 0x8000299300200002, 0x8000299400200002, 0x8000299500200002, 0x8000299600200002, 0x8000299700200002, 0x8000299800200002,
+		0x8000299900200002, 0x8000299A00200002, 0x8000299B00200002, 0x8000299C00200002, 0x8000299D00200002, 0x8000299E00200002, 0x8000299F00200002, 0x800029A000200002,
+		0x800029A100200002, 0x800029A200200002, 0x800029A300200002, 0x800029A400200002, 0x800029A500200002, 0x800029A600200002, 0x800029A700200002, 0x800029A800200002,
+		0x800029A900200002, 0x800029AA00200002, 0x800029AB00200002, 0x800029AC00200002, 0x800029AD00200002, 0x800029AE00200002, 0x800029AF00200002, 0x800029B000200002,
+		0x800029B100200002, 0x800029B200200002, 0x800029B300200002, 0x800029B400200002, 0x800029B500200002, 0x800029B600200002, 0x800029B700200002, 0x800029B800200002,
+		0x800029B900200002, 0x800029BA00200002, 0x800029BB00200002, 0x800029BC00200002, 0x800029BD00200002, 0x800029BE00200002, 0x800029BF00200002, 0x800029C000200002,
+		0x800029C100200002, 0x800029C200200002, 0x800029C300200002, 0x800029C400200002, 0x800029C500200002, 0x8000000000C30002, 0, 0,
+		0, 0, 0, 0, 0, 0, 0x8000029000200002, 0x8000029100200002,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0, 0, 0, 0, 0, 0,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x800026A400200002, 0x800026A400200004, 0x800026A400200004, 0x800026A400200004, 0x800026A400200004, 0x800026A400200004,
+		0x800003E800200002, 0x800003E900200002, 0x800003EA00200002, 0x800026A500200002, 0x800003EB00200002, 0x8000265C00200002, 0, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0x8000301400200002, 0x8000301500200002, 0x8000301600200002, 0x8000301700200002, 0x8000301800200002, 0x8000301900200002,
+		0x8000301A00200002, 0x8000301B00200002, 0x8000301C00200002, 0x8000301D00200002, 0x8000301E00200002, 0x8000301F00200002, 0x8000302000200002, 0x8000302100200002,
+		0x8000302200200002, 0x8000302300200002, 0x8000302400200002, 0x8000302500200002, 0x8000302600200002, 0x8000302700200002, 0x8000302800200002, 0x8000302900200002,
+		0x8000302A00200002, 0x8000302B00200002, 0x8000302C00200002, 0x8000302D00200002, 0x8000302E00200002, 0x8000302F00200002, 0x8000303000200002, 0x8000303100200002,
+		0x8000303200200002, 0x8000303300200002, 0x8000303400200002, 0x8000000000E20002, 0x8000000000E30002, 0x8000000000E40002, 0x8000042E00200002, 0x8000029800200002,
+		0x80002FF000200002, 0x80002FF100200002, 0x80002FF200200002, 0x80002FF300200002, 0x80002FF400200002, 0x80002FF500200002, 0x80002FF600200002, 0x80002FF700200002,
+		0x80002FF800200002, 0x80002FF900200002, 0x80002FFA00200002, 0x80002FFB00200002, 0x80002FFC00200002, 0x80002FFD00200002, 0x80002FFE00200002, 0x80002FFF00200002,
+		0x8000300000200002, 0x8000300100200002, 0x8000300200200002, 0x8000300300200002, 0x8000300400200002, 0x8000300500200002, 0x8000300600200002, 0x8000300700200002,
+		0x8000300800200002, 0x8000300900200002, 0x8000300A00200002, 0x8000300B00200002, 0x8000300C00200002, 0x8000300D00200002, 0x8000300E00200002, 0x8000300F00200002,
+		0x8000301000200002, 0x8000301100200002, 0x8000301200200002, 0x8000301300200002, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0x800002D800200002,
+		0x80003C5400200002, 0x80003C5500200002, 0x80003C5600200002, 0x80003C5700200002, 0x80003C5800200002, 0x80003C5900200002, 0x80003C5A00200002, 0x80003C5B00200002,
+		0x80003C5C00200002, 0x80003C5D00200002, 0x80003C5E00200002, 0x80003C5F00200002, 0x80003C6000200002, 0x80003C6100200002, 0x80003C6200200002, 0x80003C6300200002,
+		0x80003C6400200002, 0x80003C6500200002, 0x80003C6600200002, 0x80003C6700200002, 0x80003C6800200002, 0x80003C6900200002, 0x80003C6A00200002, 0x80003C6B00200002,
+		0x80003C6C00200002, 0x80003C6D00200002, 0x80003C6E00200002, 0x80003C6F00200002, 0x80003C7000200002, 0, 0, 0,
+		0x8000000000C30002, 0x8000000000C40002, 0x8000000000C90002, 0x8000000000C50002, 0x8000329D00200002, 0x8000329E00200002, 0x8000329F00200002, 0x800032A000200002,
+		0x800032A100200002, 0x800032A200200002, 0x800032A300200002, 0x800032A400200002, 0x800032A500200002, 0x800032A600200002, 0x800032A700200002, 0x800032A800200002,
+		0x800032A900200002, 0x800032AA00200002, 0x800032AB00200002, 0x800032AC00200002, 0x800032AD00200002, 0x800032AE00200002, 0x800032AF00200002, 0x800032B000200002,
+		0x800032B100200002, 0x800032B200200002, 0x800032B300200002, 0x800032B400200002, 0x800032B500200002, 0x800032B600200002, 0x800032B700200002, 0x800032B800200002,
+		0x800032B900200002, 0x800032BA00200002, 0x800032BB00200002, 0x800032BC00200002, 0x800032BD00200002, 0x800032BE00200002, 0x800032BF00200002, 0x800032C000200002,
+		0x800032C100200002, 0x800032C200200002, 0x800032C300200002, 0x800032C500200002, 0x800032C500200004, 0x800032C700200002, 0x800032C800200002, 0x800032C900200002,
+		0x800032CA00200002, 0x800032CB00200002, 0x800032CC00200002, 0x8000000000C20002, 0x800032CD00200002, 0x800032D600200002, 0x800032CF00200002, 0x800032D000200002,
+		0x800032D100200002, 0x800032D200200002, 0x800032D400200002, 0x800032D500200002, 0x800032CE00200002, 0x800032D300200002, 0x800032C400200002, 0x800032C600200002,
+		0x800032D700200002, 0x800002CD00200002, 0x800002CE00200002, 0x800002CF00200002, 0x800002D000200002, 0x800002D100200002, 0x800002D200200002, 0x8000025B00200002,
+		0x800002A300200002, 0x800002A400200002, 0x800002D300200002, 0x800002D400200002, 0x800002D500200002, 0x800002D600200002, 0, 0x80001BFF00200002,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0, 0, 0, 0, 0x8000042F00200002, 0x8000043000200002,
+		0x8000303E00200002, 0x8000304600200002, 0x8000305000200002, 0x8000306600200002, 0x8000307F00200002, 0x800030C900200002, 0x80001C0000200002, 0x8000305800200002,
+		0x8000307A00200002, 0x8000303C00200002, 0x8000303F00200002, 0x8000304B00200002, 0x8000305200200002, 0x8000306000200002, 0x8000306300200002, 0x8000306700200002,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0x8000309B00200002, 0x8000306C00200002, 0x8000306F00200002, 0x8000307D00200002, 0x8000308000200002, 0,
+		0x8000321200200002, 0x8000321300200002, 0x8000321400200002, 0x8000321500200002, 0x8000321600200002, 0x8000321700200002, 0x8000321800200002, 0x8000321900200002,
+		0x8000321A00200002, 0x8000321B00200002, 0x8000321C00200002, 0x8000321D00200002, 0x8000321E00200002, 0x8000321F00200002, 0x8000322000200002, 0x8000322100200002,
+		0x8000322200200002, 0x8000322300200002, 0x8000322400200002, 0x8000322500200002, 0x8000322600200002, 0x8000322700200002, 0x8000322800200002, 0x8000322900200002,
+		0x8000322A00200002, 0x8000322B00200002, 0x8000322C00200002, 0x8000322D00200002, 0x8000322E00200002, 0x8000322F00200002, 0x8000323000200002, 0x8000323100200002,
+		0x8000323200200002, 0x8000323300200002, 0x8000323400200002, 0x8000323500200002, 0x8000323600200002, 0x8000323700200002, 0x8000323800200002, 0x8000323900200002,
+		0x8000323A00200002, 0x8000323F00200002, 0x8000324000200002, 0x8000324100200002, 0x8000324200200002, 0x8000324300200002, 0x8000324400200002, 0x8000324500200002,
+		0x8000324600200002, 0x8000324700200002, 0x8000324800200002, 0x8000323B00200002, 0x8000323C00200002, 0x8000323D00200002, 0x8000323E00200002, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000324900200002, 0x8000324A00200002, 0x8000324B00200002, 0x8000324C00200002, 0x8000324D00200002, 0x8000324E00200002, 0x8000324F00200002, 0x8000325000200002,
+		0x8000325100200002, 0x8000325200200002, 0x8000325300200002, 0x8000325400200002, 0x8000325500200002, 0x8000325600200002, 0, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0, 0, 0x8000043100200002, 0x800002A500200002, 0x800002A600200002, 0x800002A700200002,
+		0x8000303B00200002, 0x8000304400200002, 0x8000304700200002, 0x8000304A00200002, 0x8000305100200002, 0x8000305700200002, 0x8000305B00200002, 0x8000305D00200002,
+		0x8000305F00200002, 0x8000306200200002, 0x8000306E00200002, 0x8000307200200002, 0x8000309300200002, 0x8000309600200002, 0x8000309800200002, 0x8000307800200002,
+		0x80001C0100200002, 0x8000309900200002, 0x8000304D00200002, 0x8000308600200002, 0x800030E100200002, 0x800030E200200002, 0x800030E300200002, 0x8000054D00200002,
+		0x8000054E00200002, 0x8000054F00200002, 0x8000308700200002, 0x800030DE00200002, 0x800030DF00200002, 0x800030E000200002, 0x8000304800200002, 0x8000305400200002,
+		0x80002DE300200002, 0x80002DE400200002, 0x80002DE500200002, 0x80002DE600200002, 0x80002DE700200002, 0x80002DE800200002, 0x80002DE900200002, 0x80002DEA00200002,
+		0x80002DEB00200002, 0x80002DEC00200002, 0x80002DED00200002, 0x80002DEE00200002, 0x80002DEF00200002, 0x80002DF000200002, 0x80002DF100200002, 0x80002DF200200002,
+		0x80002DF300200002, 0x80002DF400200002, 0x80002DF500200002, 0x80002DF600200002, 0x80002DF700200002, 0x80002DF800200002, 0x80002DF900200002, 0x80002DFA00200002,
+		0x80002DFB00200002, 0x80002DFC00200002, 0x80002DFD00200002, 0x80002DFE00200002, 0x80002DFF00200002, 0x80002E0000200002, 0x80002E0100200002, 0x80002E0200200002,
+		0x80002E0300200002, 0x80002E0400200002, 0x80002E0500200002, 0x80002E0600200002, 0x80002E0700200002, 0x80002E0800200002, 0x80002E0900200002, 0x80002E0A00200002,
+		0x80002E0B00200002, 0x80002E0C00200002, 0x80002E0D00200002, 0x80002E0E00200002, 0x80002E0F00200002, 0x80002E1000200002, 0x80002E1100200002, 0x80002E1200200002,
+		0x80002E1300200002, 0x80002E1400200002, 0x80002E1500200002, 0x80002E1600200002, 0x80002E1700200002, 0x80002E1800200002, 0x80002E1900200002, 0x80002E1A00200002,
+		0x80002E1B00200002, 0x80002E1C00200002, 0x80002E1D00200002, 0x80002E1E00200002, 0x80002E1F00200002, 0x80002E2000200002, 0x80002E2100200002, 0x8000000000DF0002,
+		0x80002E2200200002, 0x8000000000E00002, 0x80002E2300200002, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0x80002E2400200002, 0x80002E2500200002, 0x80001C0200200002, 0x800003F100200002, 0x800003F200200002,
+		0x8000293900200002, 0x8000293A00200002, 0x8000293B00200002, 0x8000293C00200002, 0x8000293D00200002, 0x8000293E00200002, 0x8000293F00200002, 0x8000294000200002,
+		0x8000294100200002, 0x8000294200200002, 0x8000294300200002, 0x8000294C00200002, 0x8000294D00200002, 0x8000294E00200002, 0x8000294F00200002, 0x8000295000200002,
+		0x800002A800200002, 0x8000027200200002, 0x8000291D00200002, 0x80001C0300200002, 0x80001C0400200002, 0x8000295100200002, 0x8000295B00200002, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0x800024D600200002, 0x800024D700200002, 0x800024D800200002, 0x800024D900200002, 0x800024DA00200002, 0x800024DB00200002, 0,
+		0, 0x8000259D00200002, 0x8000259E00200002, 0x8000259F00200002, 0x800025A000200002, 0x800025A100200002, 0x800025A200200002, 0,
+		0, 0x8000257E00200002, 0x8000257F00200002, 0x8000258000200002, 0x8000258100200002, 0x8000258200200002, 0x8000258300200002, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800025E000200002, 0x800025E100200002, 0x800025E200200002, 0x800025E300200002, 0x800025E400200002, 0x800025E500200002, 0x800025E600200002, 0,
+		0x800025F800200002, 0x800025F900200002, 0x800025FA00200002, 0x800025FB00200002, 0x800025FC00200002, 0x800025FD00200002, 0x800025FE00200002, 0,
+		0x80001C5900200002, 0x80001C5000200002, 0x80001CAF00200002, 0x80001CB000200002, 0x80001CB600200002, 0x80001CEA00200002, 0x80001CFC00200002, 0x80001D9100200002,
+		0x80001D8B00200002, 0x80001D8C00200002, 0x80001DB500200002, 0x80001DD600200002, 0x80001DDC00200002, 0x80001DE300200002, 0x80001DEF00200002, 0x80001DF600200002,
+		0x80001DEB00200002, 0x80001DE900200002, 0x80001DEA00200002, 0x80001DEC00200002, 0x80001DED00200002, 0x80001E3700200002, 0x80001E3C00200002, 0x80001E6500200002,
+		0x80001E6600200002, 0x80001E5B00200002, 0x80001E6700200002, 0x80001E6800200002, 0x80001E6900200002, 0x80001E8600200002, 0x80001EBA00200002, 0x80001EC400200002,
+		0x80001EBE00200002, 0x80001EBF00200002, 0x80001EC700200002, 0x80001F0800200002, 0x80001F0900200002, 0x80001F0A00200002, 0x80001F0400200002, 0x80001F0500200002,
+		0x80001F0600200002, 0x80001F0700200002, 0x80001F1C00200002, 0x800004F500200002, 0x80001D2B00200014, 0x80001D9100200014, 0x80001D8700200014, 0x80001EC700200014,
+		0x80001F5B00200002, 0x80001F5C00200002, 0x80001DF800200002, 0x80001F5D00200002, 0x80001C5F00200002, 0x80001FE200200002, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000337F00200002, 0x8000338000200002, 0x8000338100200002, 0x8000338200200002, 0x8000338300200002, 0x8000338400200002, 0x8000338500200002, 0x8000338600200002,
+		0x8000338700200002, 0x8000338800200002, 0x8000338900200002, 0x8000338A00200002, 0x8000338B00200002, 0x8000338C00200002, 0x8000338D00200002, 0x8000338E00200002,
+		0x8000338F00200002, 0x8000339000200002, 0x8000339100200002, 0x8000339200200002, 0x8000339300200002, 0x8000339400200002, 0x8000339500200002, 0x8000339600200002,
+		0x8000339700200002, 0x8000339800200002, 0x8000339900200002, 0x8000339A00200002, 0x8000339B00200002, 0x8000339C00200002, 0x8000339D00200002, 0x8000339E00200002,
+		0x8000339F00200002, 0x800033A000200002, 0x800033A100200002, 0x800033A200200002, 0x800033A300200002, 0x800033A400200002, 0x800033A500200002, 0x800033A600200002,
+		0x800033A700200002, 0x800033A800200002, 0x800033A900200002, 0x800033AA00200002, 0x800033AB00200002, 0x800033AC00200002, 0x800033AD00200002, 0x800033AE00200002,
+		0x800033AF00200002, 0x800033B000200002, 0x800033B100200002, 0x800033B200200002, 0x800033B300200002, 0x800033B400200002, 0x800033B500200002, 0x800033B600200002,
+		0x800033B700200002, 0x800033B800200002, 0x800033B900200002, 0x800033BA00200002, 0x800033BB00200002, 0x800033BC00200002, 0x800033BD00200002, 0x800033BE00200002,
+		0x800033BF00200002, 0x800033C000200002, 0x800033C100200002, 0x800033C200200002, 0x800033C300200002, 0x800033C400200002, 0x800033C500200002, 0x800033C600200002,
+		0x800033C700200002, 0x800033C800200002, 0x800033C900200002, 0x800033CA00200002, 0x800033CB00200002, 0x800033CC00200002, 0x800033CD00200002, 0x800033CE00200002,
+		0x8000291E00200002, 0x8000291F00200002, 0x8000292000200002, 0x8000292100200002, 0x8000292200200002, 0x8000292300200002, 0x8000292400200002, 0x8000292500200002,
+		0x8000292600200002, 0x8000292700200002, 0x8000292800200002, 0x8000292900200002, 0x8000292A00200002, 0x8000292B00200002, 0x8000292C00200002, 0x8000292D00200002,
+		0x8000292E00200002, 0x8000292F00200002, 0x8000293000200002, 0x8000293100200002, 0x8000293200200002, 0x8000293300200002, 0x8000293400200002, 0x8000293500200002,
+		0x8000293600200002, 0x8000293700200002, 0x8000293800200002, 0x8000295200200002, 0x8000295300200002, 0x8000295400200002, 0x8000295500200002, 0x8000295600200002,
+		0x8000295700200002, 0x8000295800200002, 0x8000295900200002, 0x8000294400200002, 0x8000294500200002, 0x8000294600200002, 0x8000294700200002, 0x8000294800200002,
+		0x8000294900200002, 0x8000294A00200002, 0x8000294B00200002, 0x800002A900200002, 0x8000000000CB0002, 0x8000295A00200002, 0, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80003CBA00200002, 0x80003CBB00200002, 0x80003CBC00200002, 0x80003CBD00200002, 0x80003CBE00200002, 0x80003CBF00200002, 0x80003CC000200002, 0x80003CC100200002,
+		0x80003CC200200002, 0x80003CC300200002, 0x80003CC400200002, 0x80003CC500200002, 0x80003CC600200002, 0x80003CC700200002, 0x80003CC800200002, 0x80003CC900200002,
+		0x80003CCA00200002, 0x80003CCB00200002, 0x80003CCC00200002, 0x80003CCD00200002, 0x80003CCE00200002, 0x80003CCF00200002, 0x80003CD000200002, 0,
+		0, 0, 0, 0x80003D2900200002, 0x80003D2A00200002, 0x80003D2B00200002, 0x80003D2C00200002, 0x80003D2D00200002,
+		0x80003D2E00200002, 0x80003D2F00200002, 0x80003D3000200002, 0x80003D3100200002, 0x80003D3200200002, 0x80003D3300200002, 0x80003D3400200002, 0x80003D3500200002,
+		0x80003D3600200002, 0x80003D3700200002, 0x80003D3800200002, 0x80003D3900200002, 0x80003D3A00200002, 0x80003D3B00200002, 0x80003D3C00200002, 0x80003D3D00200002,
+		0x80003D3E00200002, 0x80003D3F00200002, 0x80003D4000200002, 0x80003D4100200002, 0x80003D4200200002, 0x80003D4300200002, 0x80003D4400200002, 0x80003D4500200002,
+		0x80003D4600200002, 0x80003D4700200002, 0x80003D4800200002, 0x80003D4900200002, 0x80003D4A00200002, 0x80003D4B00200002, 0x80003D4C00200002, 0x80003D4D00200002,
+		0x80003D4E00200002, 0x80003D4F00200002, 0x80003D5000200002, 0x80003D5100200002, 0x80003D5200200002, 0x80003D5300200002, 0x80003D5400200002, 0x80003D5500200002,
+		0x80003D5600200002, 0x80003D5700200002, 0x80003D5800200002, 0x80003D5900200002, 0, 0, 0, 0,
+		0x4000000000146602, 0x4000000000146802, 0x4000000000146A02, 0x4000000000146C02, 0x4000000000146E02, 0x4000000000147002, 0x4000000000147202, 0x4000000000147402,
+		0x4000000000147602, 0x4000000000147802, 0x4000000000147A02, 0x4000000000147C02, 0x4000000000147E02, 0x4000000000148002, 0x4000000000148202, 0x4000000000148402,
+		0x4000000000148602, 0x4000000000148802, 0x4000000000148A02, 0x4000000000148C02, 0x4000000000148E02, 0x4000000000149002, 0x4000000000149202, 0x4000000000149402,
+		0x4000000000149602, 0x4000000000149802, 0x4000000000149A02, 0x4000000000149C02, 0x4000000000149E02, 0x400000000014A002, 0x400000000014A202, 0x400000000014A402,
+		0x400000000014A602, 0x400000000014A802, 0x400000000014AA02, 0x400000000014AC02, 0x400000000014AE02, 0x400000000014B002, 0x400000000014B202, 0x400000000014B402,
+		0x400000000014B602, 0x400000000014B802, 0x400000000014BA02, 0x400000000014BC02, 0x400000000014BE02, 0x400000000014C002, 0x400000000014C202, 0x400000000014C402,
+		0x400000000014C602, 0x400000000014C802, 0x400000000014CA02, 0x400000000014CC02, 0x400000000014CE02, 0x400000000014D002, 0x400000000014D202, 0x400000000014D402,
+		0x400000000014D602, 0x400000000014D802, 0x400000000014DA02, 0x400000000014DC02, 0x400000000014DE02, 0x400000000014E002, 0x400000000014E202, 0x400000000014E402,
+		0x400000000014E602, 0x400000000014E802, 0x400000000014EA02, 0x400000000014EC02, 0x400000000014EE02, 0x400000000014F002, 0x400000000014F202, 0x400000000014F402,
+		0x400000000014F602, 0x400000000014F802, 0x400000000014FA02, 0x400000000014FC02, 0x400000000014FE02, 0x4000000000150002, 0x4000000000150202, 0x4000000000150402,
+		0x4000000000150602, 0x4000000000150802, 0x4000000000150A02, 0x4000000000150C02, 0x4000000000150E02, 0x4000000000151002, 0x4000000000151202, 0x4000000000151402,
+		0x4000000000151602, 0x4000000000151802, 0x4000000000151A02, 0x4000000000151C02, 0x4000000000151E02, 0x4000000000152002, 0x4000000000152202, 0x4000000000152402,
+		0x4000000000152602, 0x4000000000152802, 0x4000000000152A02, 0x4000000000152C02, 0x4000000000152E02, 0x4000000000153002, 0x4000000000153202, 0x4000000000153402,
+		0x4000000000153602, 0x4000000000153802, 0x4000000000153A02, 0x4000000000153C02, 0x4000000000153E02, 0x4000000000154002, 0x4000000000154202, 0x4000000000154402,
+		0x4000000000154602, 0x4000000000154802, 0x4000000000154A02, 0x4000000000154C02, 0x4000000000154E02, 0x4000000000155002, 0x4000000000155202, 0x4000000000155402,
+		0x4000000000155602, 0x4000000000155802, 0x4000000000155A02, 0x4000000000155C02, 0x4000000000155E02, 0x4000000000156002, 0x4000000000156202, 0x4000000000156402,
+		0x4000000000156602, 0x4000000000156802, 0x4000000000156A02, 0x4000000000156C02, 0x4000000000156E02, 0x4000000000157002, 0x4000000000157202, 0x4000000000157402,
+		0x4000000000157602, 0x4000000000157802, 0x4000000000157A02, 0x4000000000157C02, 0x4000000000157E02, 0x4000000000158002, 0x4000000000158202, 0x4000000000158402,
+		0x4000000000158602, 0x4000000000158802, 0x4000000000158A02, 0x4000000000158C02, 0x4000000000158E02, 0x4000000000159002, 0x4000000000159202, 0x4000000000159402,
+		0x4000000000159602, 0x4000000000159802, 0x4000000000159A02, 0x4000000000159C02, 0x4000000000159E02, 0x400000000015A002, 0x400000000015A202, 0x400000000015A402,
+		0x400000000015A602, 0x400000000015A802, 0x400000000015AA02, 0x400000000015AC02, 0x400000000015AE02, 0x400000000015B002, 0x400000000015B202, 0x400000000015B402,
+		0x400000000015B602, 0x400000000015B802, 0x400000000015BA02, 0x400000000015BC02, 0x400000000015BE02, 0x400000000015C002, 0x400000000015C202, 0x400000000015C402,
+		0x400000000015C602, 0x400000000015C802, 0x400000000015CA02, 0x400000000015CC02, 0x400000000015CE02, 0x400000000015D002, 0x400000000015D202, 0x400000000015D402,
+		0x400000000015D602, 0x400000000015D802, 0x400000000015DA02, 0x400000000015DC02, 0x400000000015DE02, 0x400000000015E002, 0x400000000015E202, 0x400000000015E402,
+		0x400000000015E602, 0x400000000015E802, 0x400000000015EA02, 0x400000000015EC02, 0x400000000015EE02, 0x400000000015F002, 0x400000000015F202, 0x400000000015F402,
+		0x400000000015F602, 0x400000000015F802, 0x400000000015FA02, 0x400000000015FC02, 0x400000000015FE02, 0x4000000000160002, 0x4000000000160202, 0x4000000000160402,
+		0x4000000000160602, 0x4000000000160802, 0x4000000000160A02, 0x4000000000160C02, 0x4000000000160E02, 0x4000000000161002, 0x4000000000161202, 0x4000000000161402,
+		0x4000000000161602, 0x4000000000161802, 0x4000000000161A02, 0x4000000000161C02, 0x4000000000161E02, 0x4000000000162002, 0x4000000000162202, 0x4000000000162402,
+		0x4000000000162602, 0x4000000000162802, 0x4000000000162A02, 0x4000000000162C02, 0x4000000000162E02, 0x4000000000163002, 0x4000000000163202, 0x4000000000163402,
+		0x4000000000163602, 0x4000000000163802, 0x4000000000163A02, 0x4000000000163C02, 0x4000000000163E02, 0x4000000000164002, 0x4000000000164202, 0x4000000000164402,
+		0x4000000000164602, 0x4000000000164802, 0x4000000000164A02, 0x4000000000164C02, 0x4000000000164E02, 0x4000000000165002, 0x4000000000165202, 0x4000000000165402,
+		0x4000000000165602, 0x4000000000165802, 0x4000000000165A02, 0x4000000000165C02, 0x4000000000165E02, 0x4000000000166002, 0x4000000000166202, 0x4000000000166402,
+		0x4000000000166602, 0x4000000000166802, 0x4000000000166A02, 0x4000000000166C02, 0x4000000000166E02, 0x4000000000167002, 0x4000000000167202, 0x4000000000167402,
+		0x4000000000167602, 0x4000000000167802, 0x4000000000167A02, 0x4000000000167C02, 0x4000000000167E02, 0x4000000000168002, 0x4000000000168202, 0x4000000000168402,
+		0x4000000000168602, 0x4000000000168802, 0x4000000000168A02, 0x4000000000168C02, 0x4000000000168E02, 0x4000000000169002, 0x4000000000169202, 0x4000000000169402,
+		0x4000000000169602, 0x4000000000169802, 0x4000000000169A02, 0x4000000000169C02, 0x4000000000169E02, 0x400000000016A002, 0x400000000016A202, 0x400000000016A402,
+		0x400000000016A602, 0x400000000016A802, 0x400000000016AA02, 0x400000000016AC02, 0x400000000016AE02, 0x400000000016B002, 0x400000000016B202, 0x400000000016B402,
+		0x400000000016B602, 0x400000000016B802, 0x400000000016BA02, 0x400000000016BC02, 0x400000000016BE02, 0x400000000016C002, 0x400000000016C202, 0x400000000016C402,
+		0x400000000016C602, 0x400000000016C802, 0x400000000016CA02, 0x400000000016CC02, 0x400000000016CE02, 0x400000000016D002, 0x400000000016D202, 0x400000000016D402,
+		0x400000000016D602, 0x400000000016D802, 0x400000000016DA02, 0x400000000016DC02, 0x400000000016DE02, 0x400000000016E002, 0x400000000016E202, 0x400000000016E402,
+		0x400000000016E602, 0x400000000016E802, 0x400000000016EA02, 0x400000000016EC02, 0x400000000016EE02, 0x400000000016F002, 0x400000000016F202, 0x400000000016F402,
+		0x400000000016F602, 0x400000000016F802, 0x400000000016FA02, 0x400000000016FC02, 0x400000000016FE02, 0x4000000000170002, 0x4000000000170202, 0x4000000000170402,
+		0x4000000000170602, 0x4000000000170802, 0x4000000000170A02, 0x4000000000170C02, 0x4000000000170E02, 0x4000000000171002, 0x4000000000171202, 0x4000000000171402,
+		0x4000000000171602, 0x4000000000171802, 0x4000000000171A02, 0x4000000000171C02, 0x4000000000171E02, 0x4000000000172002, 0x4000000000172202, 0x4000000000172402,
+		0x4000000000172602, 0x4000000000172802, 0x4000000000172A02, 0x4000000000172C02, 0x4000000000172E02, 0x4000000000173002, 0x4000000000173202, 0x4000000000173402,
+		0x4000000000173602, 0x4000000000173802, 0x4000000000173A02, 0x4000000000173C02, 0x4000000000173E02, 0x4000000000174002, 0, 0,
+		0x4000000000174202, 0x4000000000174402, 0x4000000000174602, 0x4000000000174802, 0x4000000000174A02, 0x4000000000174C02, 0x4000000000174E02, 0x4000000000175002,
+		0x4000000000175202, 0x4000000000175402, 0x4000000000175602, 0x4000000000175802, 0x4000000000175A02, 0x4000000000175C02, 0x4000000000175E02, 0x4000000000176002,
+		0x4000000000176202, 0x4000000000176402, 0x4000000000176602, 0x4000000000176802, 0x4000000000176A02, 0x4000000000176C02, 0x4000000000176E02, 0x4000000000177002,
+		0x4000000000177202, 0x4000000000177402, 0x4000000000177602, 0x4000000000177802, 0x4000000000177A02, 0x4000000000177C02, 0x4000000000177E02, 0x4000000000178002,
+		0x4000000000178202, 0x4000000000178402, 0x4000000000178602, 0x4000000000178802, 0x4000000000178A02, 0x4000000000178C02, 0x4000000000178E02, 0x4000000000179002,
+		0x4000000000179202, 0x4000000000179402, 0x4000000000179602, 0x4000000000179802, 0x4000000000179A02, 0x4000000000179C02, 0x4000000000179E02, 0x400000000017A002,
+		0x400000000017A202, 0x400000000017A402, 0x400000000017A602, 0x400000000017A802, 0x400000000017AA02, 0x400000000017AC02, 0x400000000017AE02, 0x400000000017B002,
+		0x400000000017B202, 0x400000000017B402, 0x400000000017B602, 0x400000000017B802, 0x400000000017BA02, 0x400000000017BC02, 0x400000000017BE02, 0x400000000017C002,
+		0x400000000017C202, 0x400000000017C402, 0x400000000017C602, 0x400000000017C802, 0x400000000017CA02, 0x400000000017CC02, 0x400000000017CE02, 0x400000000017D002,
+		0x400000000017D202, 0x400000000017D402, 0x400000000017D602, 0x400000000017D802, 0x400000000017DA02, 0x400000000017DC02, 0x400000000017DE02, 0x400000000017E002,
+		0x400000000017E202, 0x400000000017E402, 0x400000000017E602, 0x400000000017E802, 0x400000000017EA02, 0x400000000017EC02, 0x400000000017EE02, 0x400000000017F002,
+		0x400000000017F202, 0x400000000017F402, 0x400000000017F602, 0x400000000017F802, 0x400000000017FA02, 0x400000000017FC02, 0x400000000017FE02, 0x4000000000180002,
+		0x4000000000180202, 0x4000000000180402, 0x4000000000180602, 0x4000000000180802, 0x4000000000180A02, 0x4000000000180C02, 0x4000000000180E02, 0x4000000000181002,
+		0x4000000000181202, 0x4000000000181402, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x4000000000181602, 0x4000000000181802, 0x4000000000181A02, 0x4000000000181C03, 0x4000000000181F03, 0x4000000000182203, 0x4000000000182502, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0x4000000000182702, 0x4000000000182902, 0x4000000000182B02, 0x4000000000182D02, 0x4000000000182F02,
+		0, 0, 0, 0, 0, 0x4000000000183102, 0x8000000000610002, 0x4000000000183303,
+		0x800022C600200005, 0x800022B700200005, 0x800022BA00200005, 0x800022BB00200005, 0x800022C100200005, 0x800022C200200005, 0x800022C300200005, 0x800022CA00200005,
+		0x800022CC00200005, 0x8000061600200005, 0x4000000000183602, 0x4000000000183802, 0x4000000000183A03, 0x4000000000183D03, 0x4000000000184002, 0x4000000000184202,
+		0x4000000000184402, 0x4000000000184602, 0x4000000000184802, 0x4000000000184A02, 0x4000000000184C02, 0x4000000000184E02, 0x4000000000185002, 0,
+		0x4000000000185202, 0x4000000000185402, 0x4000000000185602, 0x4000000000185802, 0x4000000000185A02, 0, 0x4000000000185C02, 0,
+		0x4000000000185E02, 0x4000000000186002, 0, 0x4000000000186202, 0x4000000000186402, 0, 0x4000000000186602, 0x4000000000186802,
+		0x4000000000186A02, 0x4000000000186C02, 0x4000000000186E02, 0x4000000000187002, 0x4000000000187202, 0x4000000000187402, 0x4000000000187602, 0x4000000000187802,
+		0x800023010020001A, 0x8000230100200019, 0x8000230E0020001A, 0x8000230E00200019, 0x8000230E00200017, 0x8000230E00200018, 0x8000230F0020001A, 0x8000230F00200019,
+		0x8000230F00200017, 0x8000230F00200018, 0x800023100020001A, 0x8000231000200019, 0x8000231000200017, 0x8000231000200018, 0x800023200020001A, 0x8000232000200019,
+		0x8000232000200017, 0x8000232000200018, 0x800023230020001A, 0x8000232300200019, 0x8000232300200017, 0x8000232300200018, 0x8000231F0020001A, 0x8000231F00200019,
+		0x8000231F00200017, 0x8000231F00200018, 0x8000237B0020001A, 0x8000237B00200019, 0x8000237B00200017, 0x8000237B00200018, 0x8000237E0020001A, 0x8000237E00200019,
+		0x8000237E00200017, 0x8000237E00200018, 0x800023270020001A, 0x8000232700200019, 0x8000232700200017, 0x8000232700200018, 0x800023260020001A, 0x8000232600200019,
+		0x8000232600200017, 0x8000232600200018, 0x800023280020001A, 0x8000232800200019, 0x8000232800200017, 0x8000232800200018, 0x8000232A0020001A, 0x8000232A00200019,
+		0x8000232A00200017, 0x8000232A00200018, 0x8000233E0020001A, 0x8000233E00200019, 0x8000233D0020001A, 0x8000233D00200019, 0x800023400020001A, 0x8000234000200019,
+		0x800023390020001A, 0x8000233900200019, 0x8000234F0020001A, 0x8000234F00200019, 0x800023480020001A, 0x8000234800200019, 0x800023880020001A, 0x8000238800200019,
+		0x8000238800200017, 0x8000238800200018, 0x800023900020001A, 0x8000239000200019, 0x8000239000200017, 0x8000239000200018, 0x800023950020001A, 0x8000239500200019,
+		0x8000239500200017, 0x8000239500200018, 0x800023930020001A, 0x8000239300200019, 0x8000239300200017, 0x8000239300200018, 0x800023A80020001A, 0x800023A800200019,
+		0x800023AA0020001A, 0x800023AA00200019, 0x800023AA00200017, 0x800023AA00200018, 0x4000000000187A02, 0x4000000000187C02, 0x800023B30020001A, 0x800023B300200019,
+		0x800023B300200017, 0x800023B300200018, 0x800023B20020001A, 0x800023B200200019, 0x800023B200200017, 0x800023B200200018, 0x800023D40020001A, 0x800023D400200019,
+		0x4000000000187E02, 0x4000000000188002, 0x8000050200200002, 0x8000050300200002, 0x8000050400200002, 0x8000050500200002, 0x8000050600200002, 0x8000050700200002,
+		0x8000050800200002, 0x8000050900200002, 0x8000050A00200002, 0x8000050B00200002, 0x8000050C00200002, 0x8000050D00200002, 0x8000050E00200002, 0x8000050F00200002,
+		0x8000051000200002, 0x8000051100200002, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0x8000238D0020001A, 0x8000238D00200019, 0x8000238D00200017, 0x8000238D00200018, 0x800023BB0020001A,
+		0x800023BB00200019, 0x800023BA0020001A, 0x800023BA00200019, 0x800023BC0020001A, 0x800023BC00200019, 0x4000000000188202, 0x800023BF0020001A, 0x800023BF00200019,
+		0x800023B90020001A, 0x800023B900200019, 0x800023BD0020001A, 0x800023BD00200019, 0x800023CA0020001A, 0x800023CA00200019, 0x800023CA00200017, 0x800023CA00200018,
+		0x800023C500200017, 0x800023C500200018, 0x4000000000188402, 0x4000000000188602, 0x4000000000188802, 0x4000000000188A02, 0x4000000000188C02, 0x4000000000188E02,
+		0x4000000000189002, 0x4000000000189202, 0x4000000000189402, 0x4000000000189602, 0x4000000000189802, 0x4000000000189A02, 0x4000000000189C02, 0x4000000000189E02,
+		0x400000000018A002, 0x400000000018A202, 0x400000000018A402, 0x400000000018A602, 0x800023C70020001A, 0x800023C700200019, 0x800023C700200017, 0x800023C700200018,
+		0x400000000018A802, 0x400000000018AA02, 0x400000000018AC02, 0x400000000018AE02, 0x400000000018B002, 0x400000000018B202, 0x400000000018B402, 0x400000000018B602,
+		0x400000000018B802, 0x400000000018BA02, 0x400000000018BC02, 0x400000000018BE02, 0x400000000018C002, 0x400000000018C202, 0x400000000018C402, 0x400000000018C602,
+		0x400000000018C802, 0x400000000018CA02, 0x400000000018CC02, 0x400000000018CE02, 0x400000000018D002, 0x400000000018D202, 0x400000000018D402, 0x400000000018D602,
+		0x400000000018D802, 0x400000000018DA02, 0x400000000018DC02, 0x400000000018DE02, 0x400000000018E002, 0x400000000018E202, 0x400000000018E402, 0x400000000018E602,
+		0x400000000018E802, 0x400000000018EA02, 0x400000000018EC02, 0x400000000018EE02, 0x400000000018F002, 0x400000000018F202, 0x400000000018F402, 0x400000000018F602,
+		0x400000000018F802, 0x400000000018FA02, 0x400000000018FC02, 0x400000000018FE02, 0x4000000000190002, 0x4000000000190202, 0x4000000000190402, 0x4000000000190602,
+		0x4000000000190802, 0x4000000000190A02, 0x4000000000190C02, 0x4000000000190E02, 0x4000000000191002, 0x4000000000191202, 0x4000000000191402, 0x4000000000191602,
+		0x4000000000191802, 0x4000000000191A02, 0x4000000000191C02, 0x4000000000191E02, 0x4000000000192002, 0x4000000000192202, 0x4000000000192402, 0x4000000000192602,
+		0x4000000000192802, 0x4000000000192A02, 0x4000000000192C02, 0x4000000000192E02, 0x4000000000193002, 0x4000000000193202, 0x4000000000193402, 0x4000000000193602,
+		0x4000000000193802, 0x4000000000193A02, 0x4000000000193C02, 0x4000000000193E02, 0x4000000000194002, 0x4000000000194202, 0x4000000000194402, 0x4000000000194602,
+		0x4000000000194802, 0x4000000000194A02, 0x4000000000194C02, 0x4000000000194E02, 0x4000000000195002, 0x4000000000195202, 0x4000000000195402, 0x4000000000195602,
+		0x4000000000195802, 0x4000000000195A02, 0x4000000000195C02, 0x4000000000195E02, 0x4000000000196002, 0x4000000000196202, 0x4000000000196402, 0x4000000000196602,
+		0x4000000000196802, 0x4000000000196A02, 0x4000000000196C02, 0x4000000000196E02, 0x4000000000197002, 0x4000000000197202, 0x4000000000197402, 0x4000000000197602,
+		0x4000000000197802, 0x4000000000197A02, 0x4000000000197C02, 0x4000000000197E02, 0x4000000000198002, 0x4000000000198202, 0x4000000000198402, 0x4000000000198602,
+		0x4000000000198802, 0x4000000000198A02, 0x4000000000198C02, 0x4000000000198E02, 0x4000000000199002, 0x4000000000199202, 0x4000000000199402, 0x4000000000199602,
+		0x4000000000199802, 0x4000000000199A02, 0x4000000000199C02, 0x4000000000199E02, 0x400000000019A002, 0x400000000019A202, 0x400000000019A402, 0x400000000019A602,
+		0x400000000019A802, 0x400000000019AA02, 0x400000000019AC02, 0x400000000019AE02, 0x400000000019B002, 0x400000000019B202, 0x400000000019B402, 0x400000000019B602,
+		0x400000000019B802, 0x400000000019BA02, 0x400000000019BC02, 0x400000000019BE02, 0x400000000019C002, 0x400000000019C202, 0x400000000019C402, 0x400000000019C602,
+		0x400000000019C802, 0x400000000019CA02, 0x400000000019CC02, 0x400000000019CE02, 0x400000000019D002, 0x400000000019D202, 0x400000000019D402, 0x400000000019D602,
+		0x400000000019D802, 0x400000000019DA02, 0x400000000019DC02, 0x400000000019DE02, 0x400000000019E002, 0x400000000019E202, 0x400000000019E402, 0x400000000019E602,
+		0x400000000019E802, 0x400000000019EA02, 0x400000000019EC02, 0x400000000019EE02, 0x400000000019F002, 0x400000000019F202, 0x400000000019F402, 0x400000000019F602,
+		0x400000000019F802, 0x400000000019FA02, 0x400000000019FC02, 0x400000000019FE02, 0x40000000001A0002, 0x40000000001A0202, 0x40000000001A0402, 0x40000000001A0602,
+		0x40000000001A0802, 0x40000000001A0A02, 0x40000000001A0C02, 0x40000000001A0E02, 0x40000000001A1002, 0x40000000001A1202, 0x40000000001A1402, 0x40000000001A1602,
+		0x40000000001A1802, 0x40000000001A1A02, 0x40000000001A1C02, 0x40000000001A1E02, 0x40000000001A2002, 0x40000000001A2202, 0x40000000001A2402, 0x40000000001A2602,
+		0x40000000001A2802, 0x40000000001A2A02, 0x40000000001A2C02, 0x40000000001A2E02, 0x40000000001A3002, 0x40000000001A3202, 0x40000000001A3402, 0x40000000001A3602,
+		0x40000000001A3802, 0x40000000001A3A02, 0x40000000001A3C02, 0x40000000001A3E02, 0x40000000001A4002, 0x40000000001A4202, 0x40000000001A4402, 0x40000000001A4602,
+		0x40000000001A4802, 0x40000000001A4A02, 0x40000000001A4C02, 0x40000000001A4E02, 0x40000000001A5002, 0x40000000001A5202, 0x40000000001A5402, 0x40000000001A5602,
+		0x40000000001A5802, 0x40000000001A5A02, 0x40000000001A5C02, 0x40000000001A5E02, 0x40000000001A6002, 0x40000000001A6202, 0x40000000001A6402, 0x40000000001A6602,
+		0x40000000001A6802, 0x40000000001A6A02, 0x40000000001A6C02, 0x40000000001A6E02, 0x40000000001A7002, 0x40000000001A7202, 0x40000000001A7402, 0x40000000001A7602,
+		0x40000000001A7802, 0x40000000001A7A02, 0x40000000001A7C02, 0x40000000001A7E02, 0x40000000001A8002, 0x40000000001A8202, 0x40000000001A8402, 0x40000000001A8602,
+		0x40000000001A8802, 0x40000000001A8A02, 0x40000000001A8C02, 0x40000000001A8E02, 0x40000000001A9002, 0x40000000001A9202, 0x40000000001A9402, 0x40000000001A9602,
+		0x40000000001A9802, 0x40000000001A9A02, 0x40000000001A9C02, 0x40000000001A9E02, 0x40000000001AA002, 0x40000000001AA202, 0x40000000001AA402, 0x40000000001AA602,
+		0x40000000001AA802, 0x40000000001AAA02, 0x40000000001AAC02, 0x40000000001AAE02, 0x40000000001AB002, 0x40000000001AB202, 0x40000000001AB402, 0x40000000001AB602,
+		0x40000000001AB802, 0x40000000001ABA02, 0x40000000001ABC02, 0x40000000001ABE02, 0x40000000001AC002, 0x40000000001AC202, 0x40000000001AC402, 0x40000000001AC602,
+		0x40000000001AC802, 0x40000000001ACA02, 0x40000000001ACC02, 0x40000000001ACE02, 0x40000000001AD002, 0x40000000001AD202, 0x40000000001AD402, 0x40000000001AD602,
+		0x40000000001AD802, 0x40000000001ADA02, 0x40000000001ADC02, 0x40000000001ADE02, 0x40000000001AE002, 0x40000000001AE202, 0x40000000001AE402, 0x40000000001AE602,
+		0x40000000001AE802, 0x40000000001AEA02, 0x40000000001AEC02, 0x40000000001AEE02, 0x40000000001AF002, 0x40000000001AF202, 0x40000000001AF402, 0x40000000001AF602,
+		0x40000000001AF802, 0x40000000001AFA02, 0x40000000001AFC02, 0x40000000001AFE02, 0x40000000001B0002, 0x40000000001B0202, 0x40000000001B0402, 0x40000000001B0602,
+		0x40000000001B0802, 0x40000000001B0A02, 0x40000000001B0C02, 0x40000000001B0E02, 0x40000000001B1002, 0x40000000001B1202, 0x40000000001B1402, 0x40000000001B1602,
+		0x40000000001B1802, 0x40000000001B1A02, 0x40000000001B1C02, 0x40000000001B1E02, 0x40000000001B2002, 0x40000000001B2202, 0x8000038100200002, 0x8000038200200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x40000000001B2403, 0x40000000001B2703, 0x40000000001B2A03, 0x40000000001B2D03, 0x40000000001B3003, 0x40000000001B3303, 0x40000000001B3603, 0x40000000001B3903,
+		0x40000000001B3C03, 0x40000000001B3F03, 0x40000000001B4203, 0x40000000001B4503, 0x40000000001B4803, 0x40000000001B4B03, 0x40000000001B4E03, 0x40000000001B5103,
+		0x40000000001B5403, 0x40000000001B5703, 0x40000000001B5A03, 0x40000000001B5D03, 0x40000000001B6003, 0x40000000001B6303, 0x40000000001B6603, 0x40000000001B6903,
+		0x40000000001B6C03, 0x40000000001B6F03, 0x40000000001B7203, 0x40000000001B7503, 0x40000000001B7803, 0x40000000001B7B03, 0x40000000001B7E03, 0x40000000001B8103,
+		0x40000000001B8403, 0x40000000001B8703, 0x40000000001B8A03, 0x40000000001B8D03, 0x40000000001B9003, 0x40000000001B9303, 0x40000000001B9603, 0x40000000001B9903,
+		0x40000000001B9C03, 0x40000000001B9F03, 0x40000000001BA203, 0x40000000001BA503, 0x40000000001BA803, 0x40000000001BAB03, 0x40000000001BAE03, 0x40000000001BB103,
+		0x40000000001BB403, 0x40000000001BB703, 0x40000000001BBA03, 0x40000000001BBD03, 0x40000000001BC003, 0x40000000001BC303, 0x40000000001BC603, 0x40000000001BC903,
+		0x40000000001BCC03, 0x40000000001BCF03, 0x40000000001BD203, 0x40000000001BD503, 0x40000000001BD803, 0x40000000001BDB03, 0x40000000001BDE03, 0x40000000001BE103,
+		0, 0, 0x40000000001BE403, 0x40000000001BE703, 0x40000000001BEA03, 0x40000000001BED03, 0x40000000001BF003, 0x40000000001BF303,
+		0x40000000001BF603, 0x40000000001BF903, 0x40000000001BFC03, 0x40000000001BFF03, 0x40000000001C0203, 0x40000000001C0503, 0x40000000001C0803, 0x40000000001C0B03,
+		0x40000000001C0E03, 0x40000000001C1103, 0x40000000001C1403, 0x40000000001C1703, 0x40000000001C1A03, 0x40000000001C1D03, 0x40000000001C2003, 0x40000000001C2303,
+		0x40000000001C2603, 0x40000000001C2903, 0x40000000001C2C03, 0x40000000001C2F03, 0x40000000001C3203, 0x40000000001C3503, 0x40000000001C3803, 0x40000000001C3B03,
+		0x40000000001C3E03, 0x40000000001C4103, 0x40000000001C4403, 0x40000000001C4703, 0x40000000001C4A03, 0x40000000001C4D03, 0x40000000001C5003, 0x40000000001C5303,
+		0x40000000001C5603, 0x40000000001C5903, 0x40000000001C5C03, 0x40000000001C5F03, 0x40000000001C6203, 0x40000000001C6503, 0x40000000001C6803, 0x40000000001C6B03,
+		0x40000000001C6E03, 0x40000000001C7103, 0x40000000001C7403, 0x40000000001C7703, 0x40000000001C7A03, 0x40000000001C7D03, 0x40000000001C8003, 0x40000000001C8303,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x40000000001C8603, 0x40000000001C8903, 0x40000000001C8C04, 0x40000000001C9004, 0x40000000001C9404, 0x40000000001C9804, 0x40000000001C9C04, 0x40000000001CA004,
+		0x40000000001CA404, 0x40000000001CA803, 0x40000000001CAB08, 0x40000000001CB308, 0x40000000001CBB04, 0x8000050100200002, 0, 0,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000022200200016, 0x8000023100200016, 0x8000028A00200016, 0x8000023900200016, 0x8000023400200016, 0x8000026000200016, 0x8000026600200016, 0x8000037B00200016,
+		0x8000037C00200016, 0x40000000001CBF03, 0, 0, 0, 0, 0, 0,
+		0x80000000004F0002, 0x8000000000000000, 0x80000000004E0002, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000340002,
+		0x8000000000000000, 0x80000000004E0002, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000500002, 0x8000000000000000,
+		0x40000000001CC202, 0x8000021600200016, 0x8000021500200016, 0x8000020B00200016, 0x8000020B00200016, 0x8000031700200016, 0x8000031800200016, 0x8000031B00200016,
+		0x8000031C00200016, 0x8000037900200016, 0x8000037A00200016, 0x8000037700200016, 0x8000037800200016, 0x8000037100200016, 0x8000037200200016, 0x8000036F00200016,
+		0x8000037000200016, 0x8000037300200016, 0x8000037400200016, 0x8000037500200016, 0x8000037600200016, 0x8000023200200002, 0x8000023300200002, 0x8000031900200016,
+		0x8000031A00200016, 0x8000020A00200004, 0x8000020A00200004, 0x8000020A00200004, 0x8000020A00200004, 0x8000020B00200004, 0x8000020B00200004, 0x8000020B00200004,
+		0x800002220020000F, 0x800002310020000F, 0x800002770020000F, 0, 0x800002340020000F, 0x800002390020000F, 0x800002660020000F, 0x800002600020000F,
+		0x800002160020000F, 0x800003170020000F, 0x800003180020000F, 0x8000031B0020000F, 0x8000031C0020000F, 0x800003790020000F, 0x8000037A0020000F, 0x800003980020000F,
+		0x800003960020000F, 0x8000038F0020000F, 0x800006160020000F, 0x8000020D0020000F, 0x8000061A0020000F, 0x8000061C0020000F, 0x8000061B0020000F, 0,
+		0x800003950020000F, 0x80001C120020000F, 0x800003990020000F, 0x8000038E0020000F, 0, 0, 0, 0,
+		0x80000000006D001A, 0x80000000006D0018, 0x800000000070001A, 0x8000000000000000, 0x800000000073001A, 0, 0x800000000076001A, 0x8000000000760018,
+		0x80000000007A001A, 0x80000000007A0018, 0x80000000007D001A, 0x80000000007D0018, 0x800000000080001A, 0x8000000000800018, 0x800000000081001A, 0x8000000000810018,
+		0x800022FD0020001A, 0x800022FE0020001A, 0x800022FE00200019, 0x800022FF0020001A, 0x800022FF00200019, 0x800023020020001A, 0x8000230200200019, 0x800023030020001A,
+		0x8000230300200019, 0x800023070020001A, 0x8000230700200019, 0x8000230700200017, 0x8000230700200018, 0x8000230B0020001A, 0x8000230B00200019, 0x8000230D0020001A,
+		0x8000230D00200019, 0x8000230D00200017, 0x8000230D00200018, 0x8000231C0020001A, 0x8000231C00200019, 0x8000231D0020001A, 0x8000231D00200019, 0x8000231D00200017,
+		0x8000231D00200018, 0x8000231E0020001A, 0x8000231E00200019, 0x8000231E00200017, 0x8000231E00200018, 0x800023250020001A, 0x8000232500200019, 0x8000232500200017,
+		0x8000232500200018, 0x8000232C0020001A, 0x8000232C00200019, 0x8000232C00200017, 0x8000232C00200018, 0x8000232D0020001A, 0x8000232D00200019, 0x8000232D00200017,
+		0x8000232D00200018, 0x800023370020001A, 0x8000233700200019, 0x800023380020001A, 0x8000233800200019, 0x800023460020001A, 0x8000234600200019, 0x800023470020001A,
+		0x8000234700200019, 0x800023590020001A, 0x8000235900200019, 0x8000235900200017, 0x8000235900200018, 0x8000235A0020001A, 0x8000235A00200019, 0x8000235A00200017,
+		0x8000235A00200018, 0x800023640020001A, 0x8000236400200019, 0x8000236400200017, 0x8000236400200018, 0x800023650020001A, 0x8000236500200019, 0x8000236500200017,
+		0x8000236500200018, 0x8000236A0020001A, 0x8000236A00200019, 0x8000236A00200017, 0x8000236A00200018, 0x8000236B0020001A, 0x8000236B00200019, 0x8000236B00200017,
+		0x8000236B00200018, 0x8000236E0020001A, 0x8000236E00200019, 0x8000236E00200017, 0x8000236E00200018, 0x8000236F0020001A, 0x8000236F00200019, 0x8000236F00200017,
+		0x8000236F00200018, 0x800023760020001A, 0x8000237600200019, 0x8000237600200017, 0x8000237600200018, 0x800023820020001A, 0x8000238200200019, 0x8000238200200017,
+		0x8000238200200018, 0x800023870020001A, 0x8000238700200019, 0x8000238700200017, 0x8000238700200018, 0x8000239C0020001A, 0x8000239C00200019, 0x8000239C00200017,
+		0x8000239C00200018, 0x800023A30020001A, 0x800023A300200019, 0x800023A300200017, 0x800023A300200018, 0x800023A70020001A, 0x800023A700200019, 0x800023A700200017,
+		0x800023A700200018, 0x800023B10020001A, 0x800023B100200019, 0x800023B100200017, 0x800023B100200018, 0x800023B70020001A, 0x800023B700200019, 0x800023C50020001A,
+		0x800023C500200019, 0x800023C60020001A, 0x800023C600200019, 0x800023C600200017, 0x800023C600200018, 0x40000000001CC402, 0x40000000001CC602, 0x40000000001CC802,
+		0x40000000001CCA02, 0x40000000001CCC02, 0x40000000001CCE02, 0x40000000001CD002, 0x40000000001CD202, 0, 0, 0x8000000000000000,
+		0, 0x8000026000200003, 0x8000030C00200003, 0x8000039800200003, 0x80001C1200200003, 0x8000039900200003, 0x8000039600200003, 0x8000030500200003,
+		0x8000031700200003, 0x8000031800200003, 0x8000038F00200003, 0x8000061600200003, 0x8000022200200003, 0x8000020D00200003, 0x8000027700200003, 0x8000039400200003,
+		0x80001C3D00200003, 0x80001C3E00200003, 0x80001C3F00200003, 0x80001C4000200003, 0x80001C4100200003, 0x80001C4200200003, 0x80001C4300200003, 0x80001C4400200003,
+		0x80001C4500200003, 0x80001C4600200003, 0x8000023900200003, 0x8000023400200003, 0x8000061A00200003, 0x8000061B00200003, 0x8000061C00200003, 0x8000026600200003,
+		0x8000038E00200003, 0x80001C4700200009, 0x80001C6000200009, 0x80001C7A00200009, 0x80001C8F00200009, 0x80001CAA00200009, 0x80001CE500200009, 0x80001CF400200009,
+		0x80001D1800200009, 0x80001D3200200009, 0x80001D4C00200009, 0x80001D6500200009, 0x80001D7700200009, 0x80001DAA00200009, 0x80001DB900200009, 0x80001DDD00200009,
+		0x80001E0C00200009, 0x80001E2100200009, 0x80001E3300200009, 0x80001E7100200009, 0x80001E9500200009, 0x80001EB500200009, 0x80001EE300200009, 0x80001EF500200009,
+		0x80001EFF00200009, 0x80001F0B00200009, 0x80001F2100200009, 0x8000031900200003, 0x8000039500200003, 0x8000031A00200003, 0x8000048500200003, 0x8000020B00200003,
+		0x8000048200200003, 0x80001C4700200003, 0x80001C6000200003, 0x80001C7A00200003, 0x80001C8F00200003, 0x80001CAA00200003, 0x80001CE500200003, 0x80001CF400200003,
+		0x80001D1800200003, 0x80001D3200200003, 0x80001D4C00200003, 0x80001D6500200003, 0x80001D7700200003, 0x80001DAA00200003, 0x80001DB900200003, 0x80001DDD00200003,
+		0x80001E0C00200003, 0x80001E2100200003, 0x80001E3300200003, 0x80001E7100200003, 0x80001E9500200003, 0x80001EB500200003, 0x80001EE300200003, 0x80001EF500200003,
+		0x80001EFF00200003, 0x80001F0B00200003, 0x80001F2100200003, 0x8000031B00200003, 0x8000061E00200003, 0x8000031C00200003, 0x8000062000200003, 0x8000032D00200003,
+		0x8000032E00200003, 0x8000028A00200012, 0x8000037300200012, 0x8000037400200012, 0x8000023100200012, 0x8000022100200012, 0x80003D8A00200012, 0x80003D5A00200010,
+		0x80003D5B00200010, 0x80003D5C00200010, 0x80003D5E00200010, 0x80003D5F00200010, 0x80003D7E00200010, 0x80003D7F00200010, 0x80003D8100200010, 0x80003D6C00200010,
+		0x80001C0E00200012, 0x80003D5A00200012, 0x80003D5B00200012, 0x80003D5C00200012, 0x80003D5E00200012, 0x80003D5F00200012, 0x80003D6000200012, 0x80003D6100200012,
+		0x80003D6200200012, 0x80003D6300200012, 0x80003D6400200012, 0x80003D6500200012, 0x80003D6600200012, 0x80003D6700200012, 0x80003D6800200012, 0x80003D6900200012,
+		0x80003D6A00200012, 0x80003D6B00200012, 0x80003D6C00200012, 0x80003D6D00200012, 0x80003D6E00200012, 0x80003D6F00200012, 0x80003D7000200012, 0x80003D7100200012,
+		0x80003D7200200012, 0x80003D7300200012, 0x80003D7400200012, 0x80003D7500200012, 0x80003D7600200012, 0x80003D7700200012, 0x80003D7800200012, 0x80003D7900200012,
+		0x80003D7A00200012, 0x80003D7B00200012, 0x80003D7C00200012, 0x80003D7D00200012, 0x80003D7E00200012, 0x80003D7F00200012, 0x80003D8100200012, 0x80003D8200200012,
+		0x80003D8300200012, 0x80003D8400200012, 0x80003D8500200012, 0x80003D8600200012, 0x80003D8700200012, 0x80003D8B00200012, 0x8000000000370012, 0x8000000000380012,
+		0x80003C7200200012, 0x80003BF500200012, 0x80003BF600200012, 0x80003CD300200012, 0x80003BF700200012, 0x80003CD500200012, 0x80003CD600200012, 0x80003BF800200012,
+		0x80003BF900200012, 0x80003BFA00200012, 0x80003CD900200012, 0x80003CDA00200012, 0x80003CDB00200012, 0x80003CDC00200012, 0x80003CDD00200012, 0x80003CDE00200012,
+		0x80003C0F00200012, 0x80003BFB00200012, 0x80003BFC00200012, 0x80003BFD00200012, 0x80003C1600200012, 0x80003BFE00200012, 0x80003BFF00200012, 0x80003C0000200012,
+		0x80003C0100200012, 0x80003C0200200012, 0x80003C0300200012, 0x80003C0400200012, 0x80003C0500200012, 0x80003C0600200012, 0x80003C0700200012, 0,
+		0, 0, 0x80003C7300200012, 0x80003C7400200012, 0x80003C7500200012, 0x80003C7600200012, 0x80003C7700200012, 0x80003C7800200012,
+		0, 0, 0x80003C7900200012, 0x80003C7A00200012, 0x80003C7B00200012, 0x80003C7C00200012, 0x80003C7D00200012, 0x80003C7E00200012,
+		0, 0, 0x80003C7F00200012, 0x80003C8000200012, 0x80003C8100200012, 0x80003C8200200012, 0x80003C8300200012, 0x80003C8400200012,
+		0, 0, 0x80003C8500200012, 0x80003C8600200012, 0x80003C8700200012, 0, 0, 0,
+		0x80001C1100200003, 0x80001C1300200003, 0x8000061D00200003, 0x8000048600200003, 0x8000061F00200003, 0x80001C1400200003, 0x80001C2700200003, 0,
+		0x8000081A00200012, 0x8000059C00200012, 0x8000059E00200012, 0x8000059D00200012, 0x8000059F00200012, 0x800008B800200012, 0x800008E300200012, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x80001A9500200002, 0x8000FFFD00200002, 0, 0,
+		0x800045E700200002, 0x800045E800200002, 0x800045E900200002, 0x800045EA00200002, 0x800045EB00200002, 0x800045EC00200002, 0x800045ED00200002, 0x800045EE00200002,
+		0x800045EF00200002, 0x800045F000200002, 0x800045F100200002, 0x800045F200200002, 0, 0x800045F300200002, 0x800045F400200002, 0x800045F500200002,
+		0x800045F600200002, 0x800045F700200002, 0x800045F800200002, 0x800045F900200002, 0x800045FA00200002, 0x800045FB00200002, 0x800045FC00200002, 0x800045FD00200002,
+		0x800045FE00200002, 0x800045FF00200002, 0x8000460000200002, 0x8000460100200002, 0x8000460200200002, 0x8000460300200002, 0x8000460400200002, 0x8000460500200002,
+		0x8000460600200002, 0x8000460700200002, 0x8000460800200002, 0x8000460900200002, 0x8000460A00200002, 0x8000460B00200002, 0x8000460C00200002, 0,
+		0x8000460D00200002, 0x8000460E00200002, 0x8000460F00200002, 0x8000461000200002, 0x8000461100200002, 0x8000461200200002, 0x8000461300200002, 0x8000461400200002,
+		0x8000461500200002, 0x8000461600200002, 0x8000461700200002, 0x8000461800200002, 0x8000461900200002, 0x8000461A00200002, 0x8000461B00200002, 0x8000461C00200002,
+		0x8000461D00200002, 0x8000461E00200002, 0x8000461F00200002, 0, 0x8000462000200002, 0x8000462100200002, 0, 0x8000462200200002,
+		0x8000462300200002, 0x8000462400200002, 0x8000462500200002, 0x8000462600200002, 0x8000462700200002, 0x8000462800200002, 0x8000462900200002, 0x8000462A00200002,
+		0x8000462B00200002, 0x8000462C00200002, 0x8000462D00200002, 0x8000462E00200002, 0x8000462F00200002, 0x8000463000200002, 0, 0,
+		0x8000463100200002, 0x8000463200200002, 0x8000463300200002, 0x8000463400200002, 0x8000463500200002, 0x8000463600200002, 0x8000463700200002, 0x8000463800200002,
+		0x8000463900200002, 0x8000463A00200002, 0x8000463B00200002, 0x8000463C00200002, 0x8000463D00200002, 0x8000463E00200002, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000463F00200002, 0x8000464000200002, 0x8000464100200002, 0x8000464200200002, 0x8000464300200002, 0x8000464400200002, 0x8000464500200002, 0x8000464600200002,
+		0x8000464700200002, 0x8000464800200002, 0x8000464900200002, 0x8000464A00200002, 0x8000464B00200002, 0x8000464C00200002, 0x8000464D00200002, 0x8000464E00200002,
+		0x8000464F00200002, 0x8000465000200002, 0x8000465100200002, 0x8000465200200002, 0x8000465300200002, 0x8000465400200002, 0x8000465500200002, 0x8000465600200002,
+		0x8000465700200002, 0x8000465800200002, 0x8000465900200002, 0x8000465A00200002, 0x8000465B00200002, 0x8000465C00200002, 0x8000465D00200002, 0x8000465E00200002,
+		0x8000465F00200002, 0x8000466000200002, 0x8000466100200002, 0x8000466200200002, 0x8000466300200002, 0x8000466400200002, 0x8000466500200002, 0x8000466600200002,
+		0x8000466700200002, 0x8000466800200002, 0x8000466900200002, 0x8000466A00200002, 0x8000466B00200002, 0x8000466C00200002, 0x8000466D00200002, 0x8000466E00200002,
+		0x8000466F00200002, 0x8000467000200002, 0x8000467100200002, 0x8000467200200002, 0x8000467300200002, 0x8000467400200002, 0x8000467500200002, 0x8000467600200002,
+		0x8000467700200002, 0x8000467800200002, 0x8000467900200002, 0x8000467A00200002, 0x8000467B00200002, 0x8000467C00200002, 0x8000467D00200002, 0x8000467E00200002,
+		0x8000467F00200002, 0x8000468000200002, 0x8000468100200002, 0x8000468200200002, 0x8000468300200002, 0x8000468400200002, 0x8000468500200002, 0x8000468600200002,
+		0x8000468700200002, 0x8000468800200002, 0x8000468900200002, 0x8000468A00200002, 0x8000468B00200002, 0x8000468C00200002, 0x8000468D00200002, 0x8000468E00200002,
+		0x8000468F00200002, 0x8000469000200002, 0x8000469100200002, 0x8000469200200002, 0x8000469300200002, 0x8000469400200002, 0x8000469500200002, 0x8000469600200002,
+		0x8000469700200002, 0x8000469800200002, 0x8000469900200002, 0x8000469A00200002, 0x8000469B00200002, 0x8000469C00200002, 0x8000469D00200002, 0x8000469E00200002,
+		0x8000469F00200002, 0x800046A000200002, 0x800046A100200002, 0x800046A200200002, 0x800046A300200002, 0x800046A400200002, 0x800046A500200002, 0x800046A600200002,
+		0x800046A700200002, 0x800046A800200002, 0x800046A900200002, 0x800046AA00200002, 0x800046AB00200002, 0x800046AC00200002, 0x800046AD00200002, 0x800046AE00200002,
+		0x800046AF00200002, 0x800046B000200002, 0x800046B100200002, 0x800046B200200002, 0x800046B300200002, 0x800046B400200002, 0x800046B500200002, 0x800046B600200002,
+		0x800046B700200002, 0x800046B800200002, 0x800046B900200002, 0, 0, 0, 0, 0,
+		0x800002FA00200002, 0x800002FB00200002, 0x800002FC00200002, 0, 0, 0, 0, 0x80001C3E00200002,
+		0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002, 0x80001C4600200002,
+		0x80001AE900200002, 0x80001AEA00200002, 0x80001AEB00200002, 0x80001AEC00200002, 0x80001AED00200002, 0x80001AEE00200002, 0x80001AEF00200002, 0x80001AF000200002,
+		0x80001AF100200002, 0x80001AF200200002, 0x80001AF300200002, 0x80001AF400200002, 0x80001AF500200002, 0x80001AF600200002, 0x80001AF700200002, 0x80001AF800200002,
+		0x80001AF900200002, 0x80001AFA00200002, 0x80001AFB00200002, 0x80001AFC00200002, 0x80001AFD00200002, 0x80001AFE00200002, 0x80001AFF00200002, 0x80001B0000200002,
+		0x80001B0100200002, 0x80001B0200200002, 0x80001B0300200002, 0x80001B0400200002, 0x80001B0500200002, 0x80001B0600200002, 0x80001B0700200002, 0x80001B0800200002,
+		0x80001B0900200002, 0x80001B0A00200002, 0x80001B0B00200002, 0x80001B0C00200002, 0, 0, 0, 0x80000F7800200002,
+		0x80000F7900200002, 0x80000F7A00200002, 0x80000F7B00200002, 0x80000F7C00200002, 0x80000F7D00200002, 0x80000F7E00200002, 0x80000F7F00200002, 0x80000F8000200002,
+		0x80001B0D00200002, 0x80001B0E00200002, 0x80001C3E00200002, 0x80001C4200200002, 0x80001B0F00200002, 0x80001B1000200002, 0x80001B1100200002, 0x80001B1200200002,
+		0x80001C4200200002, 0x80001B1300200002, 0x80001B1400200002, 0x80001B1500200002, 0x80001B1600200002, 0x80001B1700200002, 0x80001B1800200002, 0x80001C4200200002,
+		0x80001B1900200002, 0x80001B1A00200002, 0x80001B1B00200002, 0x80001B1C00200002, 0x80001B1D00200002, 0x80001B1E00200002, 0x80001B1F00200002, 0x80001B2000200002,
+		0x80001C3E00200002, 0x80001C3E00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C3F00200002, 0x80001C3F00200002, 0x80001C3F00200002, 0x80001C4200200002,
+		0x80001B2100200002, 0x80001B2200200002, 0x80001B2300200002, 0x80001B2400200002, 0x80001B2500200002, 0x80001B2600200002, 0x80001B2700200002, 0x80001B2800200002,
+		0x80001B2900200002, 0x80001B2A00200002, 0x80001B2B00200002, 0x80001B2C00200002, 0x80001B2D00200002, 0x80001B2E00200002, 0x80001B2F00200002, 0x80001B3000200002,
+		0x80001B3100200002, 0x80001B3200200002, 0x80001B3300200002, 0x80001C4200200002, 0x80001B3400200002, 0x80001B3500200002, 0x80001B3600200002, 0x80001B3700200002,
+		0x80001B3800200002, 0x80000F8100200002, 0x80000F8200200002, 0x80000F8300200002, 0x80000F8400200002, 0x80000F8500200002, 0x80000F8600200002, 0x80000F8700200002,
+		0x80000F8800200002, 0x80000F8900200002, 0x80000F8A00200002, 0x80000F8B00200002, 0x80000F8C00200002, 0x80000F8D00200002, 0x80000F8E00200002, 0x80000F8F00200002,
+		0x80000F9000200002, 0x80000F9100200002, 0x80001C3D00200002, 0x80001B3900200002, 0x80000F9200200002, 0x80000F9300200002, 0x80000F9400200002, 0,
+		0x80000F9500200002, 0x80000F9600200002, 0x80000F9700200002, 0x80000F9800200002, 0x80000F9900200002, 0x80000F9A00200002, 0x80000F9B00200002, 0x80000F9C00200002,
+		0x80000F9D00200002, 0x80000F9E00200002, 0x80000F9F00200002, 0x80000FA000200002, 0, 0, 0, 0,
+		0x80000FA100200002, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80000FA200200002, 0x80000FA300200002, 0x80000FA400200002, 0x80000FA500200002, 0x80000FA600200002, 0x80000FA700200002, 0x80000FA800200002, 0x80000FA900200002,
+		0x80000FAA00200002, 0x80000FAB00200002, 0x80000FAC00200002, 0x80000FAD00200002, 0x80000FAE00200002, 0x80000FAF00200002, 0x80000FB000200002, 0x80000FB100200002,
+		0x80000FB200200002, 0x80000FB300200002, 0x80000FB400200002, 0x80000FB500200002, 0x80000FB600200002, 0x80000FB700200002, 0x80000FB800200002, 0x80000FB900200002,
+		0x80000FBA00200002, 0x80000FBB00200002, 0x80000FBC00200002, 0x80000FBD00200002, 0x80000FBE00200002, 0x80000FBF00200002, 0x80000FC000200002, 0x80000FC100200002,
+		0x80000FC200200002, 0x80000FC300200002, 0x80000FC400200002, 0x80000FC500200002, 0x80000FC600200002, 0x80000FC700200002, 0x80000FC800200002, 0x80000FC900200002,
+		0x80000FCA00200002, 0x80000FCB00200002, 0x80000FCC00200002, 0x80000FCD00200002, 0x80000FCE00200002, 0x80000000010F0002, 0, 0,
+		0x800043AF00200002, 0x800043B000200002, 0x800043B100200002, 0x800043B200200002, 0x800043B300200002, 0x800043B400200002, 0x800043B500200002, 0x800043B600200002,
+		0x800043B700200002, 0x800043B800200002, 0x800043B900200002, 0x800043BA00200002, 0x800043BB00200002, 0x800043BC00200002, 0x800043BD00200002, 0x800043BE00200002,
+		0x800043BF00200002, 0x800043C000200002, 0x800043C100200002, 0x800043C200200002, 0x800043C300200002, 0x800043C400200002, 0x800043C500200002, 0x800043C600200002,
+		0x800043C700200002, 0x800043C800200002, 0x800043C900200002, 0x800043CA00200002, 0x800043CB00200002, 0, 0, 0,
+		0x800043CC00200002, 0x800043CD00200002, 0x800043CE00200002, 0x800043CF00200002, 0x800043D000200002, 0x800043D100200002, 0x800043D200200002, 0x800043D300200002,
+		0x800043D400200002, 0x800043D500200002, 0x800043D600200002, 0x800043D700200002, 0x800043D800200002, 0x800043D900200002, 0x800043DA00200002, 0x800043DB00200002,
+		0x800043DC00200002, 0x800043DD00200002, 0x800043DE00200002, 0x800043DF00200002, 0x800043E000200002, 0x800043E100200002, 0x800043E200200002, 0x800043E300200002,
+		0x800043E400200002, 0x800043E500200002, 0x800043E600200002, 0x800043E700200002, 0x800043E800200002, 0x800043E900200002, 0x800043EA00200002, 0x800043EB00200002,
+		0x800043EC00200002, 0x800043ED00200002, 0x800043EE00200002, 0x800043EF00200002, 0x800043F000200002, 0x800043F100200002, 0x800043F200200002, 0x800043F300200002,
+		0x800043F400200002, 0x800043F500200002, 0x800043F600200002, 0x800043F700200002, 0x800043F800200002, 0x800043F900200002, 0x800043FA00200002, 0x800043FB00200002,
+		0x800043FC00200002, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000000000000000, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0x80001B3A00200002, 0x80001B3B00200002, 0x80001B3C00200002, 0x80001B3D00200002, 0x80001B3E00200002, 0x80001B3F00200002,
+		0x80001B4000200002, 0x80001B4100200002, 0x80001B4200200002, 0x80001B4300200002, 0x80001B4400200002, 0x80001B4500200002, 0x80001B4600200002, 0x80001B4700200002,
+		0x80001B4800200002, 0x80001B4900200002, 0x80001B4A00200002, 0x80001B4B00200002, 0, 0, 0, 0,
+		0x8000441700200002, 0x8000441800200002, 0x8000441900200002, 0x8000441A00200002, 0x8000441B00200002, 0x8000441C00200002, 0x8000441D00200002, 0x8000441E00200002,
+		0x8000441F00200002, 0x8000442000200002, 0x8000442100200002, 0x8000442200200002, 0x8000442300200002, 0x8000442400200002, 0x8000442500200002, 0x8000442700200002,
+		0x8000442800200002, 0x8000442900200002, 0x8000442A00200002, 0x8000442B00200002, 0x8000442C00200002, 0x8000442D00200002, 0x8000442E00200002, 0x8000442F00200002,
+		0x8000443000200002, 0x8000443100200002, 0x8000443200200002, 0x8000443300200002, 0x8000443400200002, 0x8000443500200002, 0x8000443600200002, 0x8000442600200002,
+		0x80001C3E00200002, 0x80001C4200200002, 0x80001AE300200002, 0x80001AE400200002, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000443700200002, 0x8000443800200002, 0x8000443900200002, 0x8000443A00200002, 0x8000443B00200002, 0x8000443C00200002, 0x8000443D00200002, 0x8000443E00200002,
+		0x8000443F00200002, 0x8000444000200002, 0x8000444100200002, 0x8000444200200002, 0x8000444300200002, 0x8000444400200002, 0x8000444500200002, 0x8000444600200002,
+		0x8000444700200002, 0x8000444800200002, 0x8000444900200002, 0x8000444A00200002, 0x8000444B00200002, 0x8000444C00200002, 0x8000444D00200002, 0x8000444E00200002,
+		0x8000444F00200002, 0x8000445000200002, 0x8000445100200002, 0, 0, 0, 0, 0,
+		0x8000221400200002, 0x8000221500200002, 0x8000221600200002, 0x8000221700200002, 0x8000221800200002, 0x8000221900200002, 0x8000221A00200002, 0x8000221B00200002,
+		0x8000221C00200002, 0x8000221D00200002, 0x8000221E00200002, 0x8000221F00200002, 0x8000222000200002, 0x8000222100200002, 0x8000222200200002, 0x8000222300200002,
+		0x8000222400200002, 0x8000222500200002, 0x8000222600200002, 0x8000222700200002, 0x8000222800200002, 0x8000222900200002, 0x8000222A00200002, 0x8000222B00200002,
+		0x8000222C00200002, 0x8000222D00200002, 0x8000222E00200002, 0x8000222F00200002, 0x8000223000200002, 0x8000223100200002, 0x8000223200200002, 0x8000223300200002,
+		0x8000223400200002, 0x8000223500200002, 0x8000223600200002, 0x8000223700200002, 0x8000223800200002, 0x8000223900200002, 0x8000221400200004, 0x8000221700200004,
+		0x8000221B00200004, 0x8000222100200004, 0x8000222500200004, 0, 0, 0, 0, 0,
+		0x8000496B00200002, 0x8000496C00200002, 0x8000496D00200002, 0x8000496E00200002, 0x8000496F00200002, 0x8000497000200002, 0x8000497100200002, 0x8000497200200002,
+		0x8000497300200002, 0x8000497400200002, 0x8000497500200002, 0x8000497600200002, 0x8000497700200002, 0x8000497800200002, 0x8000497900200002, 0x8000497A00200002,
+		0x8000497B00200002, 0x8000497C00200002, 0x8000497D00200002, 0x8000497E00200002, 0x8000497F00200002, 0x8000498000200002, 0x8000498100200002, 0x8000498200200002,
+		0x8000498300200002, 0x8000498400200002, 0x8000498500200002, 0x8000498600200002, 0x8000498700200002, 0x8000498800200002, 0, 0x800002FD00200002,
+		0x8000498900200002, 0x8000498A00200002, 0x8000498B00200002, 0x8000498C00200002, 0x8000498D00200002, 0x8000498E00200002, 0x8000498F00200002, 0x8000499000200002,
+		0x8000499100200002, 0x8000499200200002, 0x8000499300200002, 0x8000499400200002, 0x8000499500200002, 0x8000499600200002, 0x8000499700200002, 0x8000499800200002,
+		0x8000499900200002, 0x8000499A00200002, 0x8000499B00200002, 0x8000499C00200002, 0x8000499D00200002, 0x8000499E00200002, 0x8000499F00200002, 0x800049A000200002,
+		0x800049A100200002, 0x800049A200200002, 0x800049A300200002, 0x800049A400200002, 0x800049A500200002, 0x800049A600200002, 0x800049A700200002, 0x800049A800200002,
+		0x800049A900200002, 0x800049AA00200002, 0x800049AB00200002, 0x800049AC00200002, 0, 0, 0, 0,
+		0x800049AD00200002, 0x800049AE00200002, 0x800049AF00200002, 0x800049B000200002, 0x800049B100200002, 0x800049B200200002, 0x800049B300200002, 0x800049B400200002,
+		0x800002FE00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001B4C00200002, 0x80001B4D00200002, 0x80001B4E00200002, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000445200200008, 0x8000445300200008, 0x8000445400200008, 0x8000445500200008, 0x8000445600200008, 0x8000445700200008, 0x8000445800200008, 0x8000445900200008,
+		0x8000445A00200008, 0x8000445B00200008, 0x8000445C00200008, 0x8000445D00200008, 0x8000445E00200008, 0x8000445F00200008, 0x8000446000200008, 0x8000446100200008,
+		0x8000446200200008, 0x8000446300200008, 0x8000446400200008, 0x8000446500200008, 0x8000446600200008, 0x8000446700200008, 0x8000446800200008, 0x8000446900200008,
+		0x8000446A00200008, 0x8000446B00200008, 0x8000446C00200008, 0x8000446D00200008, 0x8000446E00200008, 0x8000446F00200008, 0x8000447000200008, 0x8000447100200008,
+		0x8000447200200008, 0x8000447300200008, 0x8000447400200008, 0x8000447500200008, 0x8000447600200008, 0x8000447700200008, 0x8000447800200008, 0x8000447900200008,
+		0x8000445200200002, 0x8000445300200002, 0x8000445400200002, 0x8000445500200002, 0x8000445600200002, 0x8000445700200002, 0x8000445800200002, 0x8000445900200002,
+		0x8000445A00200002, 0x8000445B00200002, 0x8000445C00200002, 0x8000445D00200002, 0x8000445E00200002, 0x8000445F00200002, 0x8000446000200002, 0x8000446100200002,
+		0x8000446200200002, 0x8000446300200002, 0x8000446400200002, 0x8000446500200002, 0x8000446600200002, 0x8000446700200002, 0x8000446800200002, 0x8000446900200002,
+		0x8000446A00200002, 0x8000446B00200002, 0x8000446C00200002, 0x8000446D00200002, 0x8000446E00200002, 0x8000446F00200002, 0x8000447000200002, 0x8000447100200002,
+		0x8000447200200002, 0x8000447300200002, 0x8000447400200002, 0x8000447500200002, 0x8000447600200002, 0x8000447700200002, 0x8000447800200002, 0x8000447900200002,
+		0x8000447A00200002, 0x8000447B00200002, 0x8000447C00200002, 0x8000447D00200002, 0x8000447E00200002, 0x8000447F00200002, 0x8000448000200002, 0x8000448100200002,
+		0x8000448200200002, 0x8000448300200002, 0x8000448400200002, 0x8000448500200002, 0x8000448600200002, 0x8000448700200002, 0x8000448800200002, 0x8000448900200002,
+		0x8000448A00200002, 0x8000448B00200002, 0x8000448C00200002, 0x8000448D00200002, 0x8000448E00200002, 0x8000448F00200002, 0x8000449000200002, 0x8000449100200002,
+		0x8000449200200002, 0x8000449300200002, 0x8000449400200002, 0x8000449500200002, 0x8000449600200002, 0x8000449700200002, 0x8000449800200002, 0x8000449900200002,
+		0x8000449A00200002, 0x8000449B00200002, 0x8000449C00200002, 0x8000449D00200002, 0x8000449E00200002, 0x8000449F00200002, 0x800044A000200002, 0x800044A100200002,
+		0x800044A200200002, 0x800044A300200002, 0x800044A400200002, 0x800044A500200002, 0x800044A600200002, 0x800044A700200002, 0x800044A800200002, 0x800044A900200002,
+		0x8000453500200002, 0x8000453600200002, 0x8000453700200002, 0x8000453800200002, 0x8000453900200002, 0x8000453A00200002, 0x8000453B00200002, 0x8000453C00200002,
+		0x8000453D00200002, 0x8000453E00200002, 0x8000453F00200002, 0x8000454000200002, 0x8000454100200002, 0x8000454200200002, 0x8000454300200002, 0x8000454400200002,
+		0x8000454500200002, 0x8000454600200002, 0x8000454700200002, 0x8000454800200002, 0x8000454900200002, 0x8000454A00200002, 0x8000454B00200002, 0x8000454C00200002,
+		0x8000454D00200002, 0x8000454E00200002, 0x8000454F00200002, 0x8000455000200002, 0x8000455100200002, 0x8000455200200002, 0, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0, 0, 0, 0, 0, 0,
+		0x800033D500200008, 0x800033D600200008, 0x800033D700200008, 0x800033D800200008, 0x800033D900200008, 0x800033DA00200008, 0x800033DB00200008, 0x800033DC00200008,
+		0x800033DD00200008, 0x800033DE00200008, 0x800033DF00200008, 0x800033E000200008, 0x800033E100200008, 0x800033E200200008, 0x800033E300200008, 0x800033E400200008,
+		0x800033E500200008, 0x800033E600200008, 0x800033E700200008, 0x800033E800200008, 0x800033E900200008, 0x800033EA00200008, 0x800033EB00200008, 0x800033EC00200008,
+		0x800033ED00200008, 0x800033EE00200008, 0x800033EF00200008, 0x800033F000200008, 0x800033F100200008, 0x800033F200200008, 0x800033F300200008, 0x800033F400200008,
+		0x800033F500200008, 0x800033F600200008, 0x800033F700200008, 0x800033F800200008, 0, 0, 0, 0,
+		0x800033D500200002, 0x800033D600200002, 0x800033D700200002, 0x800033D800200002, 0x800033D900200002, 0x800033DA00200002, 0x800033DB00200002, 0x800033DC00200002,
+		0x800033DD00200002, 0x800033DE00200002, 0x800033DF00200002, 0x800033E000200002, 0x800033E100200002, 0x800033E200200002, 0x800033E300200002, 0x800033E400200002,
+		0x800033E500200002, 0x800033E600200002, 0x800033E700200002, 0x800033E800200002, 0x800033E900200002, 0x800033EA00200002, 0x800033EB00200002, 0x800033EC00200002,
+		0x800033ED00200002, 0x800033EE00200002, 0x800033EF00200002, 0x800033F000200002, 0x800033F100200002, 0x800033F200200002, 0x800033F300200002, 0x800033F400200002,
+		0x800033F500200002, 0x800033F600200002, 0x800033F700200002, 0x800033F800200002, 0, 0, 0, 0,
+		0x8000455300200002, 0x8000455400200002, 0x8000455500200002, 0x8000455600200002, 0x8000455700200002, 0x8000455800200002, 0x8000455900200002, 0x8000455A00200002,
+		0x8000455B00200002, 0x8000455C00200002, 0x8000455D00200002, 0x8000455E00200002, 0x8000455F00200002, 0x8000456000200002, 0x8000456100200002, 0x8000456200200002,
+		0x8000456300200002, 0x8000456400200002, 0x8000456500200002, 0x8000456600200002, 0x8000456700200002, 0x8000456800200002, 0x8000456900200002, 0x8000456A00200002,
+		0x8000456B00200002, 0x8000456C00200002, 0x8000456D00200002, 0x8000456E00200002, 0x8000456F00200002, 0x8000457000200002, 0x8000457100200002, 0x8000457200200002,
+		0x8000457300200002, 0x8000457400200002, 0x8000457500200002, 0x8000457600200002, 0x8000457700200002, 0x8000457800200002, 0x8000457900200002, 0x8000457A00200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000457B00200002, 0x8000457C00200002, 0x8000457D00200002, 0x8000457E00200002, 0x8000457F00200002, 0x8000458000200002, 0x8000458100200002, 0x8000458200200002,
+		0x8000458300200002, 0x8000458400200002, 0x8000458500200002, 0x8000458600200002, 0x8000458700200002, 0x8000458800200002, 0x8000458900200002, 0x8000458A00200002,
+		0x8000458B00200002, 0x8000458C00200002, 0x8000458D00200002, 0x8000458E00200002, 0x8000458F00200002, 0x8000459000200002, 0x8000459100200002, 0x8000459200200002,
+		0x8000459300200002, 0x8000459400200002, 0x8000459500200002, 0x8000459600200002, 0x8000459700200002, 0x8000459800200002, 0x8000459900200002, 0x8000459A00200002,
+		0x8000459B00200002, 0x8000459C00200002, 0x8000459D00200002, 0x8000459E00200002, 0x8000459F00200002, 0x800045A000200002, 0x800045A100200002, 0x800045A200200002,
+		0x800045A300200002, 0x800045A400200002, 0x800045A500200002, 0x800045A600200002, 0x800045A700200002, 0x800045A800200002, 0x800045A900200002, 0x800045AA00200002,
+		0x800045AB00200002, 0x800045AC00200002, 0x800045AD00200002, 0x800045AE00200002, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0x8000043200200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800046BA00200002, 0x800046BB00200002, 0x800046BC00200002, 0x800046BD00200002, 0x800046BE00200002, 0x800046BF00200002, 0x800046C000200002, 0x800046C100200002,
+		0x800046C200200002, 0x800046C300200002, 0x800046C400200002, 0x800046C500200002, 0x800046C600200002, 0x800046C700200002, 0x800046C800200002, 0x800046C900200002,
+		0x800046CA00200002, 0x800046CB00200002, 0x800046CC00200002, 0x800046CD00200002, 0x800046CE00200002, 0x800046CF00200002, 0x800046D000200002, 0x800046D100200002,
+		0x800046D200200002, 0x800046D300200002, 0x800046D400200002, 0x800046D500200002, 0x800046D600200002, 0x800046D700200002, 0x800046D800200002, 0x800046D900200002,
+		0x800046DA00200002, 0x800046DB00200002, 0x800046DC00200002, 0x800046DD00200002, 0x800046DE00200002, 0x800046DF00200002, 0x800046E000200002, 0x800046E100200002,
+		0x800046E200200002, 0x800046E300200002, 0x800046E400200002, 0x800046E500200002, 0x800046E600200002, 0x800046E700200002, 0x800046E800200002, 0x800046E900200002,
+		0x800046EA00200002, 0x800046EB00200002, 0x800046EC00200002, 0x800046ED00200002, 0x800046EE00200002, 0x800046EF00200002, 0x800046F000200002, 0x800046F100200002,
+		0x800046F200200002, 0x800046F300200002, 0x800046F400200002, 0x800046F500200002, 0x800046F600200002, 0x800046F700200002, 0x800046F800200002, 0x800046F900200002,
+		0x800046FA00200002, 0x800046FB00200002, 0x800046FC00200002, 0x800046FD00200002, 0x800046FE00200002, 0x800046FF00200002, 0x8000470000200002, 0x8000470100200002,
+		0x8000470200200002, 0x8000470300200002, 0x8000470400200002, 0x8000470500200002, 0x8000470600200002, 0x8000470700200002, 0x8000470800200002, 0x8000470900200002,
+		0x8000470A00200002, 0x8000470B00200002, 0x8000470C00200002, 0x8000470D00200002, 0x8000470E00200002, 0x8000470F00200002, 0x8000471000200002, 0x8000471100200002,
+		0x8000471200200002, 0x8000471300200002, 0x8000471400200002, 0x8000471500200002, 0x8000471600200002, 0x8000471700200002, 0x8000471800200002, 0x8000471900200002,
+		0x8000471A00200002, 0x8000471B00200002, 0x8000471C00200002, 0x8000471D00200002, 0x8000471E00200002, 0x8000471F00200002, 0x8000472000200002, 0x8000472100200002,
+		0x8000472200200002, 0x8000472300200002, 0x8000472400200002, 0x8000472500200002, 0x8000472600200002, 0x8000472700200002, 0x8000472800200002, 0x8000472900200002,
+		0x8000472A00200002, 0x8000472B00200002, 0x8000472C00200002, 0x8000472D00200002, 0x8000472E00200002, 0x8000472F00200002, 0x8000473000200002, 0x8000473100200002,
+		0x8000473200200002, 0x8000473300200002, 0x8000473400200002, 0x8000473500200002, 0x8000473600200002, 0x8000473700200002, 0x8000473800200002, 0x8000473900200002,
+		0x8000473A00200002, 0x8000473B00200002, 0x8000473C00200002, 0x8000473D00200002, 0x8000473E00200002, 0x8000473F00200002, 0x8000474000200002, 0x8000474100200002,
+		0x8000474200200002, 0x8000474300200002, 0x8000474400200002, 0x8000474500200002, 0x8000474600200002, 0x8000474700200002, 0x8000474800200002, 0x8000474900200002,
+		0x8000474A00200002, 0x8000474B00200002, 0x8000474C00200002, 0x8000474D00200002, 0x8000474E00200002, 0x8000474F00200002, 0x8000475000200002, 0x8000475100200002,
+		0x8000475200200002, 0x8000475300200002, 0x8000475400200002, 0x8000475500200002, 0x8000475600200002, 0x8000475700200002, 0x8000475800200002, 0x8000475900200002,
+		0x8000475A00200002, 0x8000475B00200002, 0x8000475C00200002, 0x8000475D00200002, 0x8000475E00200002, 0x8000475F00200002, 0x8000476000200002, 0x8000476100200002,
+		0x8000476200200002, 0x8000476300200002, 0x8000476400200002, 0x8000476500200002, 0x8000476600200002, 0x8000476700200002, 0x8000476800200002, 0x8000476900200002,
+		0x8000476A00200002, 0x8000476B00200002, 0x8000476C00200002, 0x8000476D00200002, 0x8000476E00200002, 0x8000476F00200002, 0x8000477000200002, 0x8000477100200002,
+		0x8000477200200002, 0x8000477300200002, 0x8000477400200002, 0x8000477500200002, 0x8000477600200002, 0x8000477700200002, 0x8000477800200002, 0x8000477900200002,
+		0x8000477A00200002, 0x8000477B00200002, 0x8000477C00200002, 0x8000477D00200002, 0x8000477E00200002, 0x8000477F00200002, 0x8000478000200002, 0x8000478100200002,
+		0x8000478200200002, 0x8000478300200002, 0x8000478400200002, 0x8000478500200002, 0x8000478600200002, 0x8000478700200002, 0x8000478800200002, 0x8000478900200002,
+		0x8000478A00200002, 0x8000478B00200002, 0x8000478C00200002, 0x8000478D00200002, 0x8000478E00200002, 0x8000478F00200002, 0x8000479000200002, 0x8000479100200002,
+		0x8000479200200002, 0x8000479300200002, 0x8000479400200002, 0x8000479500200002, 0x8000479600200002, 0x8000479700200002, 0x8000479800200002, 0x8000479900200002,
+		0x8000479A00200002, 0x8000479B00200002, 0x8000479C00200002, 0x8000479D00200002, 0x8000479E00200002, 0x8000479F00200002, 0x800047A000200002, 0x800047A100200002,
+		0x800047A200200002, 0x800047A300200002, 0x800047A400200002, 0x800047A500200002, 0x800047A600200002, 0x800047A700200002, 0x800047A800200002, 0x800047A900200002,
+		0x800047AA00200002, 0x800047AB00200002, 0x800047AC00200002, 0x800047AD00200002, 0x800047AE00200002, 0x800047AF00200002, 0x800047B000200002, 0x800047B100200002,
+		0x800047B200200002, 0x800047B300200002, 0x800047B400200002, 0x800047B500200002, 0x800047B600200002, 0x800047B700200002, 0x800047B800200002, 0x800047B900200002,
+		0x800047BA00200002, 0x800047BB00200002, 0x800047BC00200002, 0x800047BD00200002, 0x800047BE00200002, 0x800047BF00200002, 0x800047C000200002, 0x800047C100200002,
+		0x800047C200200002, 0x800047C300200002, 0x800047C400200002, 0x800047C500200002, 0x800047C600200002, 0x800047C700200002, 0x800047C800200002, 0x800047C900200002,
+		0x800047CA00200002, 0x800047CB00200002, 0x800047CC00200002, 0x800047CD00200002, 0x800047CE00200002, 0x800047CF00200002, 0x800047D000200002, 0x800047D100200002,
+		0x800047D200200002, 0x800047D300200002, 0x800047D400200002, 0x800047D500200002, 0x800047D600200002, 0x800047D700200002, 0x800047D800200002, 0x800047D900200002,
+		0x800047DA00200002, 0x800047DB00200002, 0x800047DC00200002, 0x800047DD00200002, 0x800047DE00200002, 0x800047DF00200002, 0x800047E000200002, 0x800047E100200002,
+		0x800047E200200002, 0x800047E300200002, 0x800047E400200002, 0x800047E500200002, 0x800047E600200002, 0x800047E700200002, 0x800047E800200002, 0x800047E900200002,
+		0x800047EA00200002, 0x800047EB00200002, 0x800047EC00200002, 0x800047ED00200002, 0x800047EE00200002, 0x800047EF00200002, 0x800047F000200002, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800047F100200002, 0x800047F200200002, 0x800047F300200002, 0x800047F400200002, 0x800047F500200002, 0x800047F600200002, 0x800047F700200002, 0x800047F800200002,
+		0x800047F900200002, 0x800047FA00200002, 0x800047FB00200002, 0x800047FC00200002, 0x800047FD00200002, 0x800047FE00200002, 0x800047FF00200002, 0x8000480000200002,
+		0x8000480100200002, 0x8000480200200002, 0x8000480300200002, 0x8000480400200002, 0x8000480500200002, 0x8000480600200002, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000480700200002, 0x8000480800200002, 0x8000480900200002, 0x8000480A00200002, 0x8000480B00200002, 0x8000480C00200002, 0x8000480D00200002, 0x8000480E00200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000480F00200002, 0x8000481000200002, 0x8000481100200002, 0x8000481200200002, 0x8000481300200002, 0x8000481400200002, 0, 0,
+		0x8000481500200002, 0, 0x8000481600200002, 0x8000481700200002, 0x8000481800200002, 0x8000481900200002, 0x8000481A00200002, 0x8000481B00200002,
+		0x8000481C00200002, 0x8000481D00200002, 0x8000481E00200002, 0x8000481F00200002, 0x8000482000200002, 0x8000482100200002, 0x8000482200200002, 0x8000482300200002,
+		0x8000482400200002, 0x8000482500200002, 0x8000482600200002, 0x8000482700200002, 0x8000482800200002, 0x8000482900200002, 0x8000482A00200002, 0x8000482B00200002,
+		0x8000482C00200002, 0x8000482D00200002, 0x8000482E00200002, 0x8000482F00200002, 0x8000483000200002, 0x8000483100200002, 0x8000483200200002, 0x8000483300200002,
+		0x8000483400200002, 0x8000483500200002, 0x8000483600200002, 0x8000483700200002, 0x8000483800200002, 0x8000483900200002, 0x8000483A00200002, 0x8000483B00200002,
+		0x8000483C00200002, 0x8000483D00200002, 0x8000483E00200002, 0x8000483F00200002, 0x8000484000200002, 0x8000484100200002, 0, 0x8000484200200002,
+		0x8000484300200002, 0, 0, 0, 0x8000484400200002, 0, 0, 0x8000484500200002,
+		0x800048F600200002, 0x800048F700200002, 0x800048F800200002, 0x800048F900200002, 0x800048FA00200002, 0x800048FB00200002, 0x800048FC00200002, 0x800048FD00200002,
+		0x800048FE00200002, 0x800048FF00200002, 0x8000490000200002, 0x8000490100200002, 0x8000490200200002, 0x8000490300200002, 0x8000490400200002, 0x8000490500200002,
+		0x8000490600200002, 0x8000490700200002, 0x8000490800200002, 0x8000490900200002, 0x8000490A00200002, 0x8000490B00200002, 0, 0x800002D900200002,
+		0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001B5E00200002, 0x80001B5F00200002, 0x80001B6000200002, 0x80001B6100200002, 0x80001B6200200002,
+		0x800048B500200002, 0x800048B600200002, 0x800048B700200002, 0x800048B800200002, 0x800048B900200002, 0x800048BA00200002, 0x800048BB00200002, 0x800048BC00200002,
+		0x800048BD00200002, 0x800048BE00200002, 0x800048BF00200002, 0x800048C000200002, 0x800048C100200002, 0x800048C200200019, 0x800048C200200002, 0x800048C300200002,
+		0x800048C400200002, 0x800048C500200002, 0x800048C600200002, 0x800048C700200002, 0x800048C800200002, 0x800048C900200002, 0x800048CA00200002, 0x80000FCF00200002,
+		0x80000FD000200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001B4F00200002, 0x80001B5000200002,
+		0x800048CB00200019, 0x800048CB00200002, 0x800048CC00200019, 0x800048CC00200002, 0x800048CD00200002, 0x800048CE00200002, 0x800048CF00200019, 0x800048CF00200002,
+		0x800048D000200002, 0x800048D100200002, 0x800048D200200002, 0x800048D300200002, 0x800048D400200019, 0x800048D400200002, 0x800048D500200019, 0x800048D500200002,
+		0x800048D600200019, 0x800048D600200002, 0x800048D700200019, 0x800048D700200002, 0x800048D800200019, 0x800048D800200002, 0x800048D900200002, 0x800048DA00200002,
+		0x800048DB00200002, 0x800048DC00200002, 0x800048DD00200002, 0x800048DE00200002, 0x800048DF00200019, 0x800048DF00200002, 0x800048E000200002, 0,
+		0, 0, 0, 0, 0, 0, 0, 0x80001C3E00200002,
+		0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001B5100200002, 0x80001B5200200002, 0x80001B5300200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800048E100200002, 0x800048E200200002, 0x800048E300200002, 0x800048E400200002, 0x800048E500200002, 0x800048E600200002, 0x800048E700200002, 0x800048E800200002,
+		0x800048E900200002, 0x800048EA00200002, 0x800048EB00200002, 0x800048EC00200002, 0x800048ED00200002, 0x800048EE00200002, 0x800048EF00200002, 0x800048F000200002,
+		0x800048F100200002, 0x800048F200200002, 0x800048F300200002, 0, 0x800048F400200002, 0x800048F500200002, 0, 0,
+		0, 0, 0, 0x80001C3E00200002, 0x80001C4200200002, 0x80001B5400200002, 0x80001B5500200002, 0x80001B5600200002,
+		0x800022CD00200002, 0x800022CE00200002, 0x800022CF00200002, 0x800022D000200002, 0x800022D100200002, 0x800022D200200002, 0x800022D300200002, 0x800022D400200002,
+		0x800022D500200002, 0x800022D600200002, 0x800022D700200002, 0x800022D800200002, 0x800022D900200002, 0x800022DA00200002, 0x800022DB00200002, 0x800022DC00200002,
+		0x800022DD00200002, 0x800022DE00200002, 0x800022DF00200002, 0x800022E000200002, 0x800022E100200002, 0x800022E200200002, 0x80001C3E00200002, 0x80001B5B00200002,
+		0x80001B5C00200002, 0x80001B5D00200002, 0x80001C3F00200002, 0x80001C4000200002, 0, 0, 0, 0x800002FF00200002,
+		0x800043FD00200002, 0x800043FE00200002, 0x800043FF00200002, 0x8000440000200002, 0x8000440100200002, 0x8000440200200002, 0x8000440300200002, 0x8000440400200002,
+		0x8000440500200002, 0x8000440600200002, 0x8000440700200002, 0x8000440800200002, 0x8000440900200002, 0x8000440A00200002, 0x8000440B00200002, 0x8000440C00200002,
+		0x8000440D00200002, 0x8000440E00200002, 0x8000440F00200002, 0x8000441000200002, 0x8000441100200002, 0x8000441200200002, 0x8000441300200002, 0x8000441400200002,
+		0x8000441500200002, 0x8000441600200002, 0, 0, 0, 0, 0, 0x800002F900200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x40000000001CD402, 0x40000000001CD602, 0x40000000001CD802, 0x40000000001CDA02, 0x40000000001CDC02, 0x40000000001CDE02, 0x40000000001CE002, 0x40000000001CE202,
+		0x40000000001CE402, 0x40000000001CE602, 0x40000000001CE802, 0x40000000001CEA02, 0x40000000001CEC02, 0x40000000001CEE02, 0x40000000001CF002, 0x40000000001CF202,
+		0x40000000001CF402, 0x40000000001CF602, 0x40000000001CF802, 0x40000000001CFA02, 0x40000000001CFC02, 0x40000000001CFE02, 0x40000000001D0002, 0x40000000001D0202,
+		0x40000000001D0402, 0x40000000001D0602, 0x40000000001D0802, 0x40000000001D0A02, 0x40000000001D0C02, 0x40000000001D0E02, 0x8000525B00200002, 0x8000525C00200002,
+		0x8000524200200002, 0x8000524300200002, 0x8000524400200002, 0x8000524500200002, 0x8000524600200002, 0x8000524700200002, 0x8000524800200002, 0x8000524900200002,
+		0x8000524A00200002, 0x8000524B00200002, 0x8000524C00200002, 0x8000524D00200002, 0x8000524E00200002, 0x8000524F00200002, 0x8000525000200002, 0x8000525100200002,
+		0x40000000001D1002, 0x8000525200200002, 0x8000525300200002, 0x8000525400200002, 0x8000525500200002, 0x8000525600200002, 0x8000525700200002, 0x8000525800200002,
+		0, 0, 0, 0, 0x80001BDD00200002, 0x80001BD200200002, 0x8000525900200002, 0x8000525A00200002,
+		0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002,
+		0x80001C4600200002, 0x80001BA700200002, 0x80001BA800200002, 0x80001BA900200002, 0x80001BAA00200002, 0x80001BAB00200002, 0x80001BAC00200002, 0x80001BAD00200002,
+		0, 0, 0x80001BAE00200002, 0x80001BAF00200002, 0x80001BB000200002, 0x80001BB100200002, 0x80001BB200200002, 0x80001BB300200002,
+		0x80001BB400200002, 0x80001BB500200002, 0x80001BB600200002, 0x80001BB700200002, 0x80001BB800200002, 0x80001BB900200002, 0x80001BBA00200002, 0x80001BBB00200002,
+		0x80001BBC00200002, 0x80001BBD00200002, 0x80001BBE00200002, 0x80001BBF00200002, 0x80001BC000200002, 0x80001BC100200002, 0x80001BC200200002, 0x80001BC300200002,
+		0x80001BC400200002, 0x80001BC500200002, 0x80001BC600200002, 0x80001BC700200002, 0x80001BC800200002, 0x80001BC900200002, 0x80001BCA00200002, 0x80001BCB00200002,
+		0x80001BCC00200002, 0x80001BCD00200002, 0x80001BCE00200002, 0x80001BCF00200002, 0x80001BD000200002, 0x80001BD100200002, 0x80001BD300200002, 0x80001BD400200002,
+		0x80001BD500200002, 0x80001BD600200002, 0x80001BD700200002, 0x80001BD800200002, 0x80001BD900200002, 0x80001BDA00200002, 0x80001BDB00200002, 0x80001BDC00200002,
+		0x80002D0D00200002, 0x80002D0E00200002, 0x80002D0F00200002, 0x80002D1000200002, 0, 0x80002D1100200002, 0x80002D1200200002, 0,
+		0, 0, 0, 0, 0x80002D1300200002, 0x8000000000340002, 0x8000000000C40002, 0x8000000000C50002,
+		0x80002D1400200002, 0x80002D1500200002, 0x80002D1600200002, 0x80002D1700200002, 0, 0x80002D1800200002, 0x80002D1900200002, 0x80002D1A00200002,
+		0, 0x80002D1B00200002, 0x80002D1C00200002, 0x80002D1D00200002, 0x80002D1E00200002, 0x80002D1F00200002, 0x80002D2000200002, 0x80002D2100200002,
+		0x80002D2200200002, 0x80002D2300200002, 0x80002D2400200002, 0x80002D2500200002, 0x80002D2600200002, 0x80002D2700200002, 0x80002D2800200002, 0x80002D2900200002,
+		0x80002D2A00200002, 0x80002D2B00200002, 0x80002D2C00200002, 0x80002D2D00200002, 0x80002D2E00200002, 0x80002D2F00200002, 0x80002D3000200002, 0x80002D3100200002,
+		0x80002D3200200002, 0x80002D3300200002, 0x80002D3400200002, 0x80002D3500200002, 0, 0, 0, 0,
+		0x8000000000CC0002, 0x8000000000CD0002, 0x8000000000CE0002, 0, 0, 0, 0, 0x80002D3600200002,
+		0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001B7E00200002, 0x80001B7F00200002, 0x80001B8000200002, 0x80001B8100200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000043800200002, 0x8000043900200002, 0x8000043A00200002, 0x8000043B00200002, 0x8000043C00200002, 0x8000043D00200002, 0x800002AA00200002, 0x800002AB00200002,
+		0x8000043E00200002, 0, 0, 0, 0, 0, 0, 0,
+		0x8000484600200002, 0x8000484700200002, 0x8000484800200002, 0x8000484900200002, 0x8000484A00200002, 0x8000484B00200002, 0x8000484C00200002, 0x8000484D00200002,
+		0x8000484E00200002, 0x8000484F00200002, 0x8000485000200002, 0x8000485100200002, 0x8000485200200002, 0x8000485300200002, 0x8000485400200002, 0x8000485500200002,
+		0x8000485600200002, 0x8000485700200002, 0x8000485800200002, 0x8000485900200002, 0x8000485A00200002, 0x8000485B00200002, 0x8000485C00200002, 0x8000485D00200002,
+		0x8000485E00200002, 0x8000485F00200002, 0x8000486000200002, 0x8000486100200002, 0x8000486200200002, 0x80001C3E00200002, 0x80001B5700200002, 0x80001B5800200002,
+		0x8000486300200002, 0x8000486400200002, 0x8000486500200002, 0x8000486600200002, 0x8000486700200002, 0x8000486800200002, 0x8000486900200002, 0x8000486A00200002,
+		0x8000486B00200002, 0x8000486C00200002, 0x8000486D00200002, 0x8000486E00200002, 0x8000486F00200002, 0x8000487000200002, 0x8000487100200002, 0x8000487200200002,
+		0x8000487300200002, 0x8000487400200002, 0x8000487500200002, 0x8000487600200002, 0x8000487700200002, 0x8000487800200002, 0x8000487900200002, 0x8000487A00200002,
+		0x8000487B00200002, 0x8000487C00200002, 0x8000487D00200002, 0x8000487E00200002, 0x8000487F00200002, 0x80001C3E00200002, 0x80001B5900200002, 0x80001B5A00200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000494700200002, 0x8000494800200002, 0x8000494900200002, 0x8000494A00200002, 0x8000494B00200002, 0x8000494C00200002, 0x8000494D00200002, 0x8000494E00200002,
+		0x40000000001D1202, 0x8000494F00200002, 0x8000495000200002, 0x8000495100200002, 0x8000495200200002, 0x8000495300200002, 0x8000495400200002, 0x8000495500200002,
+		0x8000495600200002, 0x8000495700200002, 0x8000495800200002, 0x8000495900200002, 0x8000495A00200002, 0x8000495B00200002, 0x8000495C00200002, 0x8000495D00200002,
+		0x8000495E00200002, 0x8000495F00200002, 0x8000496000200002, 0x8000496100200002, 0x8000496200200002, 0x8000496300200002, 0x8000496400200002, 0x8000496500200002,
+		0x8000496600200002, 0x8000496700200002, 0x8000496800200002, 0x8000496900200002, 0x8000496A00200002, 0x8000000000330002, 0x8000000000340002, 0,
+		0, 0, 0, 0x80001C3E00200002, 0x80001C4200200002, 0x80001B6300200002, 0x80001B6400200002, 0x80001B6500200002,
+		0x8000044300200002, 0x8000044400200002, 0x8000044500200002, 0x8000044600200002, 0x8000044700200002, 0x8000044800200002, 0x8000044900200002, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000488000200002, 0x8000488100200002, 0x8000488200200002, 0x8000488300200002, 0x8000488400200002, 0x8000488500200002, 0x8000488600200002, 0x8000488700200002,
+		0x8000488800200002, 0x8000488900200002, 0x8000488A00200002, 0x8000488B00200002, 0x8000488C00200002, 0x8000488D00200002, 0x8000488E00200002, 0x8000488F00200002,
+		0x8000489000200002, 0x8000489100200002, 0x8000489200200002, 0x8000489300200002, 0x8000489400200002, 0x8000489500200002, 0x8000489600200002, 0x8000489700200002,
+		0x8000489800200002, 0x8000489900200002, 0x8000489A00200002, 0x8000489B00200002, 0x8000489C00200002, 0x8000489D00200002, 0x8000489E00200002, 0x8000489F00200002,
+		0x800048A000200002, 0x800048A100200002, 0x800048A200200002, 0x800048A300200002, 0x800048A400200002, 0x800048A500200002, 0x800048A600200002, 0x800048A700200002,
+		0x800048A800200002, 0x800048A900200002, 0x800048AA00200002, 0x800048AB00200002, 0x800048AC00200002, 0x800048AD00200002, 0x40000000001D1402, 0x800048AE00200002,
+		0x800048AF00200002, 0x800048B000200002, 0x800048B100200002, 0x800048B200200002, 0x800048B300200002, 0x800048B400200002, 0, 0,
+		0, 0x8000044200200002, 0x800002DA00200002, 0x800002DB00200002, 0x800002DC00200002, 0x800002DD00200002, 0x800002DE00200002, 0x800002DF00200002,
+		0x8000490C00200002, 0x8000490D00200002, 0x8000490E00200002, 0x8000490F00200002, 0x8000491000200002, 0x8000491100200002, 0x8000491200200002, 0x8000491300200002,
+		0x8000491400200002, 0x8000491500200002, 0x8000491600200002, 0x8000491700200002, 0x8000491800200002, 0x8000491900200002, 0x8000491A00200002, 0x8000491B00200002,
+		0x8000491C00200002, 0x8000491D00200002, 0x8000491E00200002, 0x8000491F00200002, 0x8000492000200002, 0x8000492100200002, 0, 0,
+		0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001B6600200002, 0x80001B6700200002, 0x80001B6800200002, 0x80001B6900200002,
+		0x8000492200200002, 0x8000492300200002, 0x8000492400200002, 0x8000492500200002, 0x8000492600200002, 0x8000492700200002, 0x8000492800200002, 0x8000492900200002,
+		0x8000492A00200002, 0x8000492B00200002, 0x8000492C00200002, 0x8000492D00200002, 0x8000492E00200002, 0x8000492F00200002, 0x8000493000200002, 0x8000493100200002,
+		0x8000493200200002, 0x8000493300200002, 0x8000493400200002, 0, 0, 0, 0, 0,
+		0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001B6A00200002, 0x80001B6B00200002, 0x80001B6C00200002, 0x80001B6D00200002,
+		0x8000493500200002, 0x8000493600200002, 0x8000493700200002, 0x8000493800200002, 0x8000493900200002, 0x8000493A00200002, 0x8000493B00200002, 0x8000493C00200002,
+		0x8000493D00200002, 0x8000493E00200002, 0x8000493F00200002, 0x8000494000200002, 0x8000494100200002, 0x8000494200200002, 0x8000494300200002, 0x8000494400200002,
+		0x8000494500200002, 0x8000494600200002, 0, 0, 0, 0, 0, 0,
+		0, 0x8000044A00200002, 0x8000044B00200002, 0x8000044C00200002, 0x8000044D00200002, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001B6E00200002, 0x80001B6F00200002, 0x80001B7000200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000372D00200002, 0x40000000001D1602, 0x8000372E00200002, 0x8000372F00200002, 0x40000000001D1802, 0x8000373000200002, 0x8000373100200002, 0x8000373200200002,
+		0x40000000001D1A02, 0x8000373300200002, 0x40000000001D1C02, 0x8000373400200002, 0x40000000001D1E02, 0x8000373500200002, 0x40000000001D2002, 0x8000373600200002,
+		0x40000000001D2202, 0x8000373700200002, 0x40000000001D2402, 0x8000373800200002, 0x8000373900200002, 0x40000000001D2602, 0x8000373A00200002, 0x40000000001D2802,
+		0x8000373B00200002, 0x40000000001D2A02, 0x8000373C00200002, 0x40000000001D2C02, 0x8000373D00200002, 0x40000000001D2E02, 0x8000373E00200002, 0x40000000001D3002,
+		0x8000373F00200002, 0x8000374000200002, 0x8000374100200002, 0x8000374200200002, 0x8000374300200002, 0x40000000001D3202, 0x8000374400200002, 0x40000000001D3402,
+		0x8000374500200002, 0x40000000001D3602, 0x8000374600200002, 0x40000000001D3802, 0x8000374700200002, 0x8000374800200002, 0x40000000001D3A02, 0x8000374900200002,
+		0x8000374A00200002, 0x8000374B00200002, 0x8000374C00200002, 0x40000000001D3C02, 0x8000374D00200002, 0x40000000001D3E02, 0x8000374E00200002, 0x40000000001D4002,
+		0x8000374F00200002, 0x40000000001D4202, 0x8000375000200002, 0x40000000001D4402, 0x8000375100200002, 0x8000375200200002, 0x8000375300200002, 0x8000375400200002,
+		0x40000000001D4602, 0x8000375500200002, 0x40000000001D4802, 0x8000375600200002, 0x40000000001D4A02, 0x8000375700200002, 0x40000000001D4C02, 0x8000375800200002,
+		0x8000375900200002, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000370400200008, 0x40000000001D4E02, 0x8000370500200008, 0x8000370600200008, 0x8000370700200008, 0x8000370800200008, 0x8000370900200008, 0x8000370A00200008,
+		0x8000370B00200008, 0x8000370C00200008, 0x40000000001D5002, 0x40000000001D5202, 0x8000370D00200008, 0x8000370E00200008, 0x8000370F00200008, 0x8000371000200008,
+		0x8000371100200008, 0x40000000001D5402, 0x8000371200200008, 0x8000371300200008, 0x8000371400200008, 0x8000371500200008, 0x8000371600200008, 0x8000371700200008,
+		0x8000371800200008, 0x8000371900200008, 0x8000371A00200008, 0x8000371B00200008, 0x40000000001D5602, 0x8000371C00200008, 0x40000000001D5802, 0x40000000001D5A02,
+		0x8000371D00200008, 0x8000371E00200008, 0x8000371F00200008, 0x40000000001D5C02, 0x8000372000200008, 0x8000372100200008, 0x8000372200200008, 0x8000372300200008,
+		0x8000372400200008, 0x8000372500200008, 0x8000372600200008, 0x40000000001D5E02, 0x8000372700200008, 0x40000000001D6002, 0x8000372800200008, 0x8000372900200008,
+		0x8000372A00200008, 0x8000372B00200008, 0x8000372C00200008, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000370400200002, 0x40000000001D6202, 0x8000370500200002, 0x8000370600200002, 0x8000370700200002, 0x8000370800200002, 0x8000370900200002, 0x8000370A00200002,
+		0x8000370B00200002, 0x8000370C00200002, 0x40000000001D6402, 0x40000000001D6602, 0x8000370D00200002, 0x8000370E00200002, 0x8000370F00200002, 0x8000371000200002,
+		0x8000371100200002, 0x40000000001D6802, 0x8000371200200002, 0x8000371300200002, 0x8000371400200002, 0x8000371500200002, 0x8000371600200002, 0x8000371700200002,
+		0x8000371800200002, 0x8000371900200002, 0x8000371A00200002, 0x8000371B00200002, 0x40000000001D6A02, 0x8000371C00200002, 0x40000000001D6C02, 0x40000000001D6E02,
+		0x8000371D00200002, 0x8000371E00200002, 0x8000371F00200002, 0x40000000001D7002, 0x8000372000200002, 0x8000372100200002, 0x8000372200200002, 0x8000372300200002,
+		0x8000372400200002, 0x8000372500200002, 0x8000372600200002, 0x40000000001D7202, 0x8000372700200002, 0x40000000001D7402, 0x8000372800200002, 0x8000372900200002,
+		0x8000372A00200002, 0x8000372B00200002, 0x8000372C00200002, 0, 0, 0, 0, 0,
+		0, 0, 0x80001C3E00200002, 0x80001C4200200002, 0x80001AE500200002, 0x80001AE600200002, 0x80001AE700200002, 0x80001AE800200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002,
+		0x80001C4600200002, 0x80001ACC00200002, 0x80001ACD00200002, 0x80001ACE00200002, 0x80001ACF00200002, 0x80001AD000200002, 0x80001AD100200002, 0x80001AD200200002,
+		0x80001AD300200002, 0x80001AD400200002, 0x80001AD500200002, 0x80001AD600200002, 0x80001AD700200002, 0x80001AD800200002, 0x80001AD900200002, 0x80001ADA00200002,
+		0x80001ADB00200002, 0x80001ADC00200002, 0x80001ADD00200002, 0x80001ADE00200002, 0x80001ADF00200002, 0x80001AE000200002, 0x80001AE100200002, 0,
+		0x8000000000C30002, 0x8000000000C40002, 0x8000000000C50002, 0x80002CF700200002, 0x80002CF800200002, 0x80002CC800200002, 0x80002CC900200002, 0x80002CCA00200002,
+		0x80002CCB00200002, 0x80002CCC00200002, 0x80002CCD00200002, 0x80002CCE00200002, 0x80002CCF00200002, 0x80002CD000200002, 0x80002CD100200002, 0x80002CD200200002,
+		0x80002CD300200002, 0x80002CD400200002, 0x80002CD500200002, 0x80002CD600200002, 0x80002CD700200002, 0x80002CD800200002, 0x80002CD900200002, 0x80002CDA00200002,
+		0x80002CDB00200002, 0x80002CDC00200002, 0x80002CDD00200002, 0x80002CDE00200002, 0x80002CDF00200002, 0x80002CE000200002, 0x80002CE100200002, 0x80002CE200200002,
+		0x80002CE300200002, 0x80002CE400200002, 0x80002CE500200002, 0x80002CE600200002, 0x80002CE700200002, 0x80002CE800200002, 0x80002CE900200002, 0x80002CEA00200002,
+		0x80002CEB00200002, 0x80002CEC00200002, 0x80002CED00200002, 0x80002CEE00200002, 0x80002CEF00200002, 0x80002CF000200002, 0x80002CF100200002, 0x80002CF200200002,
+		0x80002CF300200002, 0x80002CF400200002, 0x80002CF500200002, 0x80002CF600200002, 0x80002CF900200002, 0x80002CFA00200002, 0x80002CFB00200002, 0x80002CFC00200002,
+		0x80002CFD00200002, 0x80002CFE00200002, 0x80002CFF00200002, 0x80002D0000200002, 0x80002D0100200002, 0x80002D0200200002, 0x80002D0300200002, 0x80002D0400200002,
+		0x80002D0500200002, 0x80002D0600200002, 0x80002D0700200002, 0x80002D0800200002, 0x80002D0900200002, 0x80002D0A00200002, 0x80002D0B00200002, 0x800002AC00200002,
+		0x800002AD00200002, 0x8000043300200002, 0x8000043400200002, 0x8000043500200002, 0x8000043600200002, 0x8000043700200002, 0, 0,
+		0, 0, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002,
+		0x80001C4400200002, 0x80001C4500200002, 0x80001C4600200002, 0x80001B7300200002, 0x80001B7400200002, 0x80001B7500200002, 0x80001B7600200002, 0x80001B7700200002,
+		0x80001B7800200002, 0x80001B7900200002, 0x80001B7A00200002, 0x80001B7B00200002, 0x80001B7C00200002, 0x80001B7D00200002, 0x80001C3D00200002, 0x80001C3E00200002,
+		0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002, 0x80001C4600200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0x80002D0C00200002,
+		0x8000000000C30002, 0x8000000000C40002, 0x8000000000C50002, 0x800029C600200002, 0x800029C700200002, 0x800029C800200002, 0x800029C900200002, 0x800029CA00200002,
+		0x800029CB00200002, 0x800029CC00200002, 0x800029CD00200002, 0x800029CE00200002, 0x800029CF00200002, 0x800029D000200002, 0x800029D100200002, 0x800029D200200002,
+		0x800029D300200002, 0x800029D400200002, 0x800029D500200002, 0x800029D600200002, 0x800029D700200002, 0x800029D800200002, 0x800029D900200002, 0x800029DA00200002,
+		0x800029DB00200002, 0x800029DC00200002, 0x40000000001D7602, 0x800029DD00200002, 0x40000000001D7802, 0x800029DE00200002, 0x800029DF00200002, 0x800029E000200002,
+		0x800029E100200002, 0x800029E200200002, 0x800029E300200002, 0x800029E400200002, 0x800029E500200002, 0x800029E600200002, 0x800029E700200002, 0x800029E800200002,
+		0x800029E900200002, 0x800029EA00200002, 0x800029EB00200002, 0x40000000001D7A02, 0x800029EC00200002, 0x800029ED00200002, 0x800029EE00200002, 0x800029EF00200002,
+		0x800029F000200002, 0x800029F100200002, 0x800029F200200002, 0x800029F300200002, 0x800029F400200002, 0x800029F500200002, 0x800029F600200002, 0x800029F700200002,
+		0x800029F800200002, 0x800029F900200002, 0x8000000000C20002, 0x8000044E00200002, 0x8000044F00200002, 0x8000000000000000, 0x800002E000200002, 0x800002E100200002,
+		0x800002AE00200002, 0x800002AF00200002, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800045AF00200002, 0x800045B000200002, 0x800045B100200002, 0x800045B200200002, 0x800045B300200002, 0x800045B400200002, 0x800045B500200002, 0x800045B600200002,
+		0x800045B700200002, 0x800045B800200002, 0x800045B900200002, 0x800045BA00200002, 0x800045BB00200002, 0x800045BC00200002, 0x800045BD00200002, 0x800045BE00200002,
+		0x800045BF00200002, 0x800045C000200002, 0x800045C100200002, 0x800045C200200002, 0x800045C300200002, 0x800045C400200002, 0x800045C500200002, 0x800045C600200002,
+		0x800045C700200002, 0, 0, 0, 0, 0, 0, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0, 0, 0, 0, 0, 0,
+		0x8000000000C30002, 0x8000000000C40002, 0x8000000000C50002, 0x800030E400200002, 0x800030E500200002, 0x800030E600200002, 0x800030E700200002, 0x800030E800200002,
+		0x800030E900200002, 0x800030EA00200002, 0x800030EB00200002, 0x800030EC00200002, 0x800030ED00200002, 0x800030EE00200002, 0x800030EF00200002, 0x800030F000200002,
+		0x800030F100200002, 0x800030F200200002, 0x800030F300200002, 0x800030F400200002, 0x800030F500200002, 0x800030F600200002, 0x800030F700200002, 0x800030F800200002,
+		0x800030F900200002, 0x800030FA00200002, 0x800030FB00200002, 0x800030FC00200002, 0x800030FD00200002, 0x800030FE00200002, 0x800030FF00200002, 0x8000310000200002,
+		0x8000310100200002, 0x8000310200200002, 0x8000310300200002, 0x8000310400200002, 0x8000310500200002, 0x8000310600200002, 0x8000310700200002, 0x8000310800200002,
+		0x8000310900200002, 0x8000310A00200002, 0x8000310B00200002, 0x8000310C00200002, 0x8000310D00200002, 0x8000310E00200002, 0x8000310F00200002, 0x8000311000200002,
+		0x8000311100200002, 0x8000311200200002, 0x8000311300200002, 0x8000311400200002, 0x8000311500200002, 0, 0x80001C3D00200002, 0x80001C3E00200002,
+		0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002, 0x80001C4600200002,
+		0x800002E200200002, 0x800002B000200002, 0x800002B100200002, 0x8000027300200002, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800029FA00200002, 0x800029FB00200002, 0x800029FC00200002, 0x800029FD00200002, 0x800029FE00200002, 0x800029FF00200002, 0x80002A0000200002, 0x80002A0100200002,
+		0x80002A0200200002, 0x80002A0300200002, 0x80002A0400200002, 0x80002A0500200002, 0x80002A0600200002, 0x80002A0700200002, 0x80002A0800200002, 0x80002A0900200002,
+		0x80002A0A00200002, 0x80002A0B00200002, 0x80002A0C00200002, 0x80002A0D00200002, 0x80002A0E00200002, 0x80002A0F00200002, 0x80002A1000200002, 0x80002A1100200002,
+		0x80002A1200200002, 0x80002A1300200002, 0x80002A1400200002, 0x80002A1500200002, 0x80002A1600200002, 0x80002A1700200002, 0x80002A1800200002, 0x80002A1900200002,
+		0x80002A1B00200002, 0x80002A1C00200002, 0x80002A1D00200002, 0x8000000000C20002, 0x8000045000200002, 0x8000045100200002, 0x80002A1A00200002, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000000000C30002, 0x8000000000C40002, 0x8000000000C50002, 0x80002A2000200002, 0x80002A2100200002, 0x80002A2200200002, 0x80002A2300200002, 0x80002A2400200002,
+		0x80002A2500200002, 0x80002A2600200002, 0x80002A2700200002, 0x80002A2800200002, 0x80002A2900200002, 0x80002A2A00200002, 0x80002A2B00200002, 0x80002A2C00200002,
+		0x80002A2D00200002, 0x80002A2E00200002, 0x80002A2F00200002, 0x80002A3000200002, 0x80002A3100200002, 0x80002A3200200002, 0x80002A3300200002, 0x80002A3400200002,
+		0x80002A3500200002, 0x80002A3600200002, 0x80002A3700200002, 0x80002A3800200002, 0x80002A3900200002, 0x80002A3A00200002, 0x80002A3B00200002, 0x80002A3C00200002,
+		0x80002A3D00200002, 0x80002A3E00200002, 0x80002A3F00200002, 0x80002A4000200002, 0x80002A4100200002, 0x80002A4200200002, 0x80002A4300200002, 0x80002A4400200002,
+		0x80002A4500200002, 0x80002A4600200002, 0x80002A4700200002, 0x80002A4800200002, 0x80002A4900200002, 0x80002A4A00200002, 0x80002A4B00200002, 0x80002A4C00200002,
+		0x80002A4D00200002, 0x80002A4E00200002, 0x80002A4F00200002, 0x80002A5400200002, 0x80002A5500200002, 0x80002A5600200002, 0x80002A5700200002, 0x80002A5800200002,
+		0x80002A5900200002, 0x80002A5A00200002, 0x80002A5B00200002, 0x80002A5C00200002, 0x80002A5D00200002, 0x80002A5E00200002, 0x80002A5F00200002, 0x80002A6000200002,
+		0x80002A6100200002, 0x80002A5000200002, 0x80002A5100200002, 0x80002A5200200002, 0x80002A1E00200002, 0x800002B200200002, 0x800002B300200002, 0x8000045300200002,
+		0x800002E300200002, 0x8000045400200002, 0x8000000000C20002, 0x8000000000CF0002, 0x8000000000D00002, 0x8000045200200002, 0, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0x80002A1F00200002, 0x8000045500200002, 0x80002A5300200002, 0x8000045600200002, 0x800002E400200002, 0x800002E500200002,
+		0, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0x80001B8C00200002, 0x80001B8D00200002, 0x80001B8E00200002, 0x80001B8F00200002, 0x80001B9000200002, 0x80001B9100200002,
+		0x80001B9200200002, 0x80001B9300200002, 0x80001B9400200002, 0x80001B9500200002, 0x80001B9600200002, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80002A6200200002, 0x80002A6300200002, 0x80002A6400200002, 0x80002A6500200002, 0x80002A6600200002, 0x80002A6700200002, 0x80002A6800200002, 0x80002A6900200002,
+		0x80002A6A00200002, 0x80002A6B00200002, 0x80002A6C00200002, 0x80002A6D00200002, 0x80002A6E00200002, 0x80002A6F00200002, 0x80002A7000200002, 0x80002A7100200002,
+		0x80002A7200200002, 0x80002A7300200002, 0, 0x80002A7400200002, 0x80002A7500200002, 0x80002A7600200002, 0x80002A7700200002, 0x80002A7800200002,
+		0x80002A7900200002, 0x80002A7A00200002, 0x80002A7B00200002, 0x80002A7C00200002, 0x80002A7D00200002, 0x80002A7E00200002, 0x80002A7F00200002, 0x80002A8000200002,
+		0x80002A8100200002, 0x80002A8200200002, 0x80002A8300200002, 0x80002A8400200002, 0x80002A8500200002, 0x80002A8600200002, 0x80002A8700200002, 0x80002A8800200002,
+		0x80002A8900200002, 0x80002A8A00200002, 0x80002A8B00200002, 0x80002A8C00200002, 0x80002A8D00200002, 0x80002A8E00200002, 0x80002A8F00200002, 0x80002A9000200002,
+		0x80002A9100200002, 0x80002A9200200002, 0x80002A9300200002, 0x80002A9400200002, 0x8000000000C40002, 0x80002A9500200002, 0x8000000000C20002, 0x8000000000800002,
+		0x800002B400200002, 0x800002B500200002, 0x8000045700200002, 0x8000045800200002, 0x8000045900200002, 0x8000045A00200002, 0x8000000000810002, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80002ACF00200002, 0x80002AD000200002, 0x80002AD100200002, 0x80002AD200200002, 0x80002AD500200002, 0x80002AD600200002, 0x80002AD700200002, 0,
+		0x80002AD800200002, 0, 0x80002AD900200002, 0x80002ADA00200002, 0x80002ADB00200002, 0x80002ADC00200002, 0, 0x80002ADD00200002,
+		0x80002ADE00200002, 0x80002ADF00200002, 0x80002AE000200002, 0x80002AE100200002, 0x80002AE200200002, 0x80002AE300200002, 0x80002AE400200002, 0x80002AE500200002,
+		0x80002AE600200002, 0x80002AE700200002, 0x80002AE800200002, 0x80002AE900200002, 0x80002AEA00200002, 0x80002AEB00200002, 0, 0x80002AEC00200002,
+		0x80002AED00200002, 0x80002AEE00200002, 0x80002AEF00200002, 0x80002AF000200002, 0x80002AF100200002, 0x80002AD300200002, 0x80002AD400200002, 0x80002AF200200002,
+		0x80002AF300200002, 0x800002E600200002, 0, 0, 0, 0, 0, 0,
+		0x80002A9600200002, 0x80002A9700200002, 0x80002A9800200002, 0x80002A9900200002, 0x80002A9A00200002, 0x80002A9B00200002, 0x80002A9C00200002, 0x80002A9D00200002,
+		0x80002A9E00200002, 0x80002A9F00200002, 0x80002AA000200002, 0x80002AA100200002, 0x80002AA200200002, 0x80002AA300200002, 0x80002AA400200002, 0x80002AA500200002,
+		0x80002AA600200002, 0x80002AA700200002, 0x80002AA800200002, 0x80002AA900200002, 0x80002AAA00200002, 0x80002AAB00200002, 0x80002AAC00200002, 0x80002AAD00200002,
+		0x80002AAE00200002, 0x80002AAF00200002, 0x80002AB000200002, 0x80002AB100200002, 0x80002AB200200002, 0x80002AB300200002, 0x80002AB400200002, 0x80002AB500200002,
+		0x80002AB600200002, 0x80002AB700200002, 0x80002AB800200002, 0x80002AB900200002, 0x80002ABA00200002, 0x80002ABB00200002, 0x80002ABC00200002, 0x80002ABD00200002,
+		0x80002ABE00200002, 0x80002ABF00200002, 0x80002AC000200002, 0x80002AC100200002, 0x80002AC200200002, 0x80002AC300200002, 0x80002AC400200002, 0x8000000000C40002,
+		0x80002AC500200002, 0x80002AC600200002, 0x80002AC700200002, 0x80002AC800200002, 0x80002AC900200002, 0x80002ACA00200002, 0x80002ACB00200002, 0x80002ACC00200002,
+		0x80002ACD00200002, 0x8000000000C20002, 0x80002ACE00200002, 0, 0, 0, 0, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0, 0, 0, 0, 0, 0,
+		0x8000000000C40002, 0x8000000000C30002, 0x8000000000C40002, 0x8000000000C50002, 0, 0x80002AF500200002, 0x80002AF600200002, 0x80002AF700200002,
+		0x80002AF800200002, 0x80002AF900200002, 0x80002AFA00200002, 0x80002AFB00200002, 0x80002AFD00200002, 0, 0, 0x80002AFF00200002,
+		0x80002B0000200002, 0, 0, 0x80002B0100200002, 0x80002B0200200002, 0x80002B0300200002, 0x80002B0400200002, 0x80002B0500200002,
+		0x80002B0600200002, 0x80002B0700200002, 0x80002B0800200002, 0x80002B0900200002, 0x80002B0A00200002, 0x80002B0B00200002, 0x80002B0C00200002, 0x80002B0D00200002,
+		0x80002B0E00200002, 0x80002B0F00200002, 0x80002B1000200002, 0x80002B1100200002, 0x80002B1200200002, 0x80002B1300200002, 0x80002B1400200002, 0x80002B1500200002,
+		0x80002B1600200002, 0, 0x80002B1700200002, 0x80002B1800200002, 0x80002B1900200002, 0x80002B1A00200002, 0x80002B1B00200002, 0x80002B1C00200002,
+		0x80002B1D00200002, 0, 0x80002B1E00200002, 0x80002B1F00200002, 0, 0x80002B2000200002, 0x80002B2100200002, 0x80002B2200200002,
+		0x80002B2300200002, 0x80002B2400200002, 0, 0, 0x8000000000C20002, 0x80002B2500200002, 0x80002B2800200002, 0x80002B2900200002,
+		0x80002B2A00200002, 0x80002B2B00200002, 0x80002B2C00200002, 0x80002B2D00200002, 0x80002B2E00200002, 0, 0, 0x80002B3100200002,
+		0x80002B3200200002, 0, 0, 0x80002B3300200002, 0x80002B3400200002, 0x80002B3500200002, 0, 0,
+		0x80002AF400200002, 0, 0, 0, 0, 0, 0, 0x80002B3600200002,
+		0, 0, 0, 0, 0, 0x80002B3700200002, 0x80002B2600200002, 0x80002B2700200002,
+		0x80002AFC00200002, 0x80002AFE00200002, 0x80002B2F00200002, 0x80002B3000200002, 0, 0, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0, 0, 0,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80002B3A00200002, 0x80002B3B00200002, 0x80002B3C00200002, 0x80002B3D00200002, 0x80002B3E00200002, 0x80002B3F00200002, 0x80002B4000200002, 0x80002B4100200002,
+		0x80002B4200200002, 0x80002B4300200002, 0x80002B4400200002, 0x80002B4500200002, 0x80002B4600200002, 0x80002B4700200002, 0x80002B4800200002, 0x80002B4900200002,
+		0x80002B4A00200002, 0x80002B4B00200002, 0x80002B4C00200002, 0x80002B4D00200002, 0x80002B4E00200002, 0x80002B4F00200002, 0x80002B5000200002, 0x80002B5100200002,
+		0x80002B5200200002, 0x80002B5300200002, 0x80002B5400200002, 0x80002B5500200002, 0x80002B5600200002, 0x80002B5700200002, 0x80002B5800200002, 0x80002B5900200002,
+		0x80002B5A00200002, 0x80002B5B00200002, 0x80002B5C00200002, 0x80002B5D00200002, 0x80002B5E00200002, 0x80002B5F00200002, 0x80002B6000200002, 0x80002B6100200002,
+		0x80002B6200200002, 0x80002B6300200002, 0x80002B6400200002, 0x80002B6500200002, 0x80002B6600200002, 0x80002B6700200002, 0x80002B6800200002, 0x80002B6900200002,
+		0x80002B6A00200002, 0x80002B6B00200002, 0x80002B6C00200002, 0x80002B6D00200002, 0x80002B6E00200002, 0x80002B7100200002, 0x80002B7200200002, 0x80002B7300200002,
+		0x80002B7400200002, 0x80002B7500200002, 0x80002B7600200002, 0x80002B7700200002, 0x80002B7800200002, 0x80002B7900200002, 0x80002B7A00200002, 0x80002B7B00200002,
+		0x80002B7C00200002, 0x80002B7D00200002, 0x80002B7E00200002, 0x8000000000C30002, 0x8000000000C40002, 0x8000000000C50002, 0x8000000000C20002, 0x80002B6F00200002,
+		0x80002B7000200002, 0x80002B3800200002, 0x80002B3900200002, 0x800002B600200002, 0x800002B700200002, 0x8000045B00200002, 0x8000045C00200002, 0x8000045D00200002,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0, 0x8000045E00200002, 0, 0x8000045F00200002, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80002B8000200002, 0x80002B8100200002, 0x80002B8200200002, 0x80002B8300200002, 0x80002B8400200002, 0x80002B8500200002, 0x80002B8600200002, 0x80002B8700200002,
+		0x80002B8800200002, 0x80002B8900200002, 0x80002B8A00200002, 0x80002B8B00200002, 0x80002B8C00200002, 0x80002B8D00200002, 0x80002B8E00200002, 0x80002B8F00200002,
+		0x80002B9000200002, 0x80002B9100200002, 0x80002B9200200002, 0x80002B9300200002, 0x80002B9400200002, 0x80002B9500200002, 0x80002B9600200002, 0x80002B9700200002,
+		0x80002B9800200002, 0x80002B9900200002, 0x80002B9A00200002, 0x80002B9B00200002, 0x80002B9C00200002, 0x80002B9D00200002, 0x80002B9E00200002, 0x80002B9F00200002,
+		0x80002BA000200002, 0x80002BA100200002, 0x80002BA200200002, 0x80002BA300200002, 0x80002BA400200002, 0x80002BA500200002, 0x80002BA600200002, 0x80002BA700200002,
+		0x80002BA800200002, 0x80002BA900200002, 0x80002BAA00200002, 0x80002BAB00200002, 0x80002BAC00200002, 0x80002BAD00200002, 0x80002BAE00200002, 0x80002BAF00200002,
+		0x80002BB200200002, 0x80002BB300200002, 0x80002BB400200002, 0x80002BB500200002, 0x80002BB600200002, 0x80002BB700200002, 0x80002BB800200002, 0x80002BB900200002,
+		0x80002BBA00200002, 0x80002BBB00200002, 0x80002BBC00200002, 0x80002BBD00200002, 0x80002BBE00200002, 0x80002BBF00200002, 0x80002BC000200002, 0x8000000000C30002,
+		0x8000000000C40002, 0x8000000000C50002, 0x80002BC100200002, 0x8000000000C20002, 0x80002BB000200002, 0x80002BB100200002, 0x8000046000200002, 0x80002B7F00200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80002BC200200002, 0x80002BC300200002, 0x80002BC400200002, 0x80002BC500200002, 0x80002BC600200002, 0x80002BC700200002, 0x80002BC800200002, 0x80002BC900200002,
+		0x80002BCA00200002, 0x80002BCB00200002, 0x80002BCC00200002, 0x80002BCD00200002, 0x80002BCE00200002, 0x80002BCF00200002, 0x80002BD000200002, 0x80002BD100200002,
+		0x80002BD200200002, 0x80002BD300200002, 0x80002BD400200002, 0x80002BD500200002, 0x80002BD600200002, 0x80002BD700200002, 0x80002BD800200002, 0x80002BD900200002,
+		0x80002BDA00200002, 0x80002BDB00200002, 0x80002BDC00200002, 0x80002BDD00200002, 0x80002BDE00200002, 0x80002BDF00200002, 0x80002BE000200002, 0x80002BE100200002,
+		0x80002BE200200002, 0x80002BE300200002, 0x80002BE400200002, 0x80002BE500200002, 0x80002BE600200002, 0x80002BE700200002, 0x80002BE800200002, 0x80002BE900200002,
+		0x80002BEA00200002, 0x80002BEB00200002, 0x80002BEC00200002, 0x80002BED00200002, 0x80002BEE00200002, 0x80002BEF00200002, 0x80002BF000200002, 0x80002BF100200002,
+		0x80002BF200200002, 0x80002BF300200002, 0x80002BF400200002, 0x80002BF500200002, 0x80002BF600200002, 0x80002BF700200002, 0, 0,
+		0x80002BF800200002, 0x80002BF900200002, 0x80002BFA00200002, 0x80002BFB00200002, 0x8000000000C30002, 0x8000000000C40002, 0x8000000000C50002, 0x80002BFC00200002,
+		0x8000000000C20002, 0x8000046100200002, 0x800002B800200002, 0x800002B900200002, 0x8000046200200002, 0x8000046300200002, 0x8000046400200002, 0x8000046500200002,
+		0x8000046600200002, 0x8000046700200002, 0x8000046800200002, 0x8000046900200002, 0x8000046A00200002, 0x8000046B00200002, 0x8000046C00200002, 0x8000046D00200002,
+		0x8000046E00200002, 0x8000046F00200002, 0x8000047000200002, 0x8000047100200002, 0x8000047200200002, 0x8000047300200002, 0x8000047400200002, 0x8000047500200002,
+		0x40000000001D7C02, 0x40000000001D7E02, 0x40000000001D8002, 0x40000000001D8202, 0x40000000001D8402, 0x40000000001D8602, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80002BFD00200002, 0x80002BFE00200002, 0x80002BFF00200002, 0x80002C0000200002, 0x80002C0100200002, 0x80002C0200200002, 0x80002C0300200002, 0x80002C0400200002,
+		0x80002C0500200002, 0x80002C0600200002, 0x80002C0700200002, 0x80002C0800200002, 0x80002C0900200002, 0x80002C0A00200002, 0x80002C0B00200002, 0x80002C0C00200002,
+		0x80002C0D00200002, 0x80002C0E00200002, 0x80002C0F00200002, 0x80002C1000200002, 0x80002C1100200002, 0x80002C1200200002, 0x80002C1300200002, 0x80002C1400200002,
+		0x80002C1500200002, 0x80002C1600200002, 0x80002C1700200002, 0x80002C1800200002, 0x80002C1900200002, 0x80002C1A00200002, 0x80002C1B00200002, 0x80002C1C00200002,
+		0x80002C1D00200002, 0x80002C1E00200002, 0x80002C1F00200002, 0x80002C2000200002, 0x80002C2100200002, 0x80002C2200200002, 0x80002C2300200002, 0x80002C2400200002,
+		0x80002C2500200002, 0x80002C2600200002, 0x80002C2700200002, 0x80002C2800200002, 0x80002C2900200002, 0x80002C2A00200002, 0x80002C2B00200002, 0x80002C2C00200002,
+		0x80002C2D00200002, 0x80002C2E00200002, 0x80002C2F00200002, 0x80002C3000200002, 0x80002C3100200002, 0x80002C3200200002, 0x80002C3300200002, 0x80002C3400200002,
+		0x80002C3500200002, 0x80002C3600200002, 0x80002C3700200002, 0x80002C3800200002, 0x80002C3900200002, 0x8000000000C40002, 0x8000000000C50002, 0x80002C3A00200002,
+		0x8000000000C30002, 0x800002BA00200002, 0x800002BB00200002, 0x8000047600200002, 0x80002C3B00200002, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0, 0, 0, 0, 0, 0,
+		0x800003DA00200002, 0x800003DB00200002, 0x800003DC00200002, 0x800003DD00200002, 0x800003DE00200002, 0x800003DF00200002, 0x800003E000200002, 0x800003E100200002,
+		0x800003E200200002, 0x800003E300200002, 0x800003E400200002, 0x800003E500200002, 0x800003E600200002, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80002C3C00200002, 0x80002C3D00200002, 0x80002C3E00200002, 0x80002C3F00200002, 0x80002C4000200002, 0x80002C4100200002, 0x80002C4200200002, 0x80002C4300200002,
+		0x80002C4400200002, 0x80002C4500200002, 0x80002C4900200002, 0x80002C4A00200002, 0x80002C4B00200002, 0x80002C4C00200002, 0x80002C4D00200002, 0x80002C4E00200002,
+		0x80002C4F00200002, 0x80002C5000200002, 0x80002C5100200002, 0x80002C5200200002, 0x80002C5300200002, 0x80002C5400200002, 0x80002C5500200002, 0x80002C5600200002,
+		0x80002C5700200002, 0x80002C5800200002, 0x80002C5900200002, 0x80002C5A00200002, 0x80002C5B00200002, 0x80002C5C00200002, 0x80002C5D00200002, 0x80002C5E00200002,
+		0x80002C5F00200002, 0x80002C6000200002, 0x80002C6100200002, 0x80002C6200200002, 0x80002C6300200002, 0x80002C6400200002, 0x80002C6500200002, 0x80002C4700200002,
+		0x80002C4600200002, 0x80002C4800200002, 0x80002C6600200002, 0x8000000000C40002, 0x8000000000C50002, 0x80002C6700200002, 0x80002C6800200002, 0x80002C6900200002,
+		0x80002C6A00200002, 0x80002C6B00200002, 0x80002C6C00200002, 0x80002C6D00200002, 0x80002C6E00200002, 0x80002C6F00200002, 0x80002C7000200002, 0x8000000000C20002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80002C7100200002, 0x80002C7200200002, 0x80002C7300200002, 0x80002C7400200002, 0x80002C7500200002, 0x40000000001D8802, 0x80002C7600200002, 0x80002C7700200002,
+		0x80002C7800200002, 0x80002C7900200002, 0x80002C7A00200002, 0x80002C7B00200002, 0x80002C7C00200002, 0x80002C7D00200002, 0x80002C7E00200002, 0x80002C7F00200002,
+		0x80002C8000200002, 0x80002C8100200002, 0x80002C8200200002, 0x80002C8300200002, 0x80002C8400200002, 0x80002C8500200002, 0x40000000001D8A02, 0x80002C8600200002,
+		0x80002C8700200002, 0x80002C8800200002, 0, 0, 0, 0x80002C9500200002, 0x80002C9600200002, 0x80002C9700200002,
+		0x80002C8900200002, 0x80002C8A00200002, 0x80002C8B00200002, 0x80002C8C00200002, 0x80002C8D00200002, 0x80002C8E00200002, 0x80002C8F00200002, 0x80002C9000200002,
+		0x80002C9100200002, 0x80002C9200200002, 0x80002C9300200002, 0x80002C9400200002, 0, 0, 0, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0x80001B7100200002, 0x80001B7200200002, 0x800002BC00200002, 0x800002BD00200002, 0x800002E700200002, 0x8000055000200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800042FC00200008, 0x800042FD00200008, 0x800042FE00200008, 0x800042FF00200008, 0x8000430000200008, 0x8000430100200008, 0x8000430200200008, 0x8000430300200008,
+		0x8000430400200008, 0x8000430500200008, 0x8000430600200008, 0x8000430700200008, 0x8000430800200008, 0x8000430900200008, 0x8000430A00200008, 0x8000430B00200008,
+		0x8000430C00200008, 0x8000430D00200008, 0x8000430E00200008, 0x8000430F00200008, 0x8000431000200008, 0x8000431100200008, 0x8000431200200008, 0x8000431300200008,
+		0x8000431400200008, 0x8000431500200008, 0x8000431600200008, 0x8000431700200008, 0x8000431800200008, 0x8000431900200008, 0x8000431A00200008, 0x8000431B00200008,
+		0x800042FC00200002, 0x800042FD00200002, 0x800042FE00200002, 0x800042FF00200002, 0x8000430000200002, 0x8000430100200002, 0x8000430200200002, 0x8000430300200002,
+		0x8000430400200002, 0x8000430500200002, 0x8000430600200002, 0x8000430700200002, 0x8000430800200002, 0x8000430900200002, 0x8000430A00200002, 0x8000430B00200002,
+		0x8000430C00200002, 0x8000430D00200002, 0x8000430E00200002, 0x8000430F00200002, 0x8000431000200002, 0x8000431100200002, 0x8000431200200002, 0x8000431300200002,
+		0x8000431400200002, 0x8000431500200002, 0x8000431600200002, 0x8000431700200002, 0x8000431800200002, 0x8000431900200002, 0x8000431A00200002, 0x8000431B00200002,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0x80001B9700200002, 0x80001B9800200002, 0x80001B9900200002, 0x80001B9A00200002, 0x80001B9B00200002, 0x80001B9C00200002,
+		0x80001B9D00200002, 0x80001B9E00200002, 0x80001B9F00200002, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0x800042FB00200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000432300200002, 0x8000432400200002, 0x8000432500200002, 0x8000432600200002, 0x8000432700200002, 0x8000432800200002, 0x8000432900200002, 0x8000432A00200002,
+		0x8000432B00200002, 0x8000432C00200002, 0x8000432D00200002, 0x8000432E00200002, 0x8000432F00200002, 0x8000433000200002, 0x8000433100200002, 0x8000433200200002,
+		0x8000433300200002, 0x8000433400200002, 0x8000433500200002, 0x8000433600200002, 0x8000433700200002, 0x8000431C00200002, 0x8000431D00200002, 0x8000431E00200002,
+		0x8000431F00200002, 0x8000432000200002, 0x8000432100200002, 0x8000432200200002, 0x8000433800200002, 0x8000433900200002, 0x8000433F00200002, 0x8000433A00200002,
+		0x8000433B00200002, 0x8000433C00200002, 0x8000433D00200002, 0x8000433E00200002, 0x8000434000200002, 0x8000434600200002, 0x8000434400200002, 0x8000435200200002,
+		0x8000434700200002, 0x8000434500200002, 0x8000435300200002, 0x8000434E00200002, 0x8000434C00200002, 0x8000434F00200002, 0x8000434D00200002, 0x8000434100200002,
+		0x8000435400200002, 0x8000434300200002, 0x8000434200200002, 0x8000434A00200002, 0x8000434800200002, 0x8000435000200002, 0x8000434B00200002, 0x8000434900200002,
+		0x8000435100200002, 0, 0, 0, 0, 0, 0, 0,
+		0x80002D3700200002, 0x80002D3800200002, 0x80002D3900200002, 0x80002D3A00200002, 0x80002D3B00200002, 0x80002D3C00200002, 0x80002D3D00200002, 0x80002D3E00200002,
+		0x80002D3F00200002, 0, 0x80002D4000200002, 0x80002D4100200002, 0x80002D4200200002, 0x80002D4300200002, 0x80002D4400200002, 0x80002D4500200002,
+		0x80002D4600200002, 0x80002D4700200002, 0x80002D4800200002, 0x80002D4900200002, 0x80002D4A00200002, 0x80002D4B00200002, 0x80002D4C00200002, 0x80002D4D00200002,
+		0x80002D4E00200002, 0x80002D4F00200002, 0x80002D5000200002, 0x80002D5100200002, 0x80002D5200200002, 0x80002D5300200002, 0x80002D5400200002, 0x80002D5500200002,
+		0x80002D5600200002, 0x80002D5700200002, 0x80002D5800200002, 0x80002D5900200002, 0x80002D5A00200002, 0x80002D5B00200002, 0x80002D5C00200002, 0x80002D5D00200002,
+		0x80002D5E00200002, 0x80002D5F00200002, 0x80002D6000200002, 0x80002D6100200002, 0x80002D6200200002, 0x80002D6300200002, 0x80002D6400200002, 0x80002D6600200002,
+		0x80002D6700200002, 0x80002D6800200002, 0x80002D6900200002, 0x80002D6A00200002, 0x80002D6B00200002, 0x80002D6C00200002, 0x80002D6D00200002, 0,
+		0x80002D6E00200002, 0x80002D6F00200002, 0x80002D7000200002, 0x80002D7100200002, 0x8000000000C30002, 0x8000000000C40002, 0x8000000000C50002, 0x80002D7200200002,
+		0x80002D6500200002, 0x800002BE00200002, 0x800002BF00200002, 0x8000043F00200002, 0x8000044000200002, 0x8000044100200002, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002,
+		0x80001C4400200002, 0x80001C4500200002, 0x80001C4600200002, 0x80001B8200200002, 0x80001B8300200002, 0x80001B8400200002, 0x80001B8500200002, 0x80001B8600200002,
+		0x80001B8700200002, 0x80001B8800200002, 0x80001B8900200002, 0x80001B8A00200002, 0x80001B8B00200002, 0, 0, 0,
+		0x8000040900200002, 0x8000040A00200002, 0x80002E8600200002, 0x80002E8800200002, 0x80002E8A00200002, 0x80002E8C00200002, 0x80002E8E00200002, 0x80002E9000200002,
+		0x80002E9200200002, 0x80002E9400200002, 0x80002E9600200002, 0x80002E9800200002, 0x80002E9A00200002, 0x80002E9C00200002, 0x80002E9E00200002, 0x80002EA000200002,
+		0x80002EA200200002, 0x80002EA400200002, 0x80002EA600200002, 0x80002EA800200002, 0x80002EAA00200002, 0x80002EAC00200002, 0x80002EAE00200002, 0x80002EB000200002,
+		0x80002EB200200002, 0x80002EB300200002, 0x80002EB500200002, 0x80002EB700200002, 0x80002EB900200002, 0x80002EBB00200002, 0x80002EBD00200002, 0x80002EBF00200002,
+		0, 0, 0x80002E8700200002, 0x80002E8900200002, 0x80002E8B00200002, 0x80002E8D00200002, 0x80002E8F00200002, 0x80002E9100200002,
+		0x80002E9300200002, 0x80002E9500200002, 0x80002E9700200002, 0x80002E9900200002, 0x80002E9B00200002, 0x80002E9D00200002, 0x80002E9F00200002, 0x80002EA100200002,
+		0x80002EA300200002, 0x80002EA500200002, 0x80002EA700200002, 0x80002EA900200002, 0x80002EAB00200002, 0x80002EAD00200002, 0x80002EAF00200002, 0x80002EB100200002,
+		0, 0x80002EB400200002, 0x80002EB600200002, 0x80002EB800200002, 0x80002EBA00200002, 0x80002EBC00200002, 0x80002EBE00200002, 0x80002EC000200002,
+		0x80002EC100200002, 0x80002EC200200002, 0x80002EC300200002, 0x80002EC400200002, 0x80002EC500200002, 0x8000000000C40002, 0x8000000000C30002, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800049B500200002, 0x800049B600200002, 0x800049B700200002, 0x800049B800200002, 0x800049B900200002, 0x800049BA00200002, 0x800049BB00200002, 0x800049BC00200002,
+		0x800049BD00200002, 0x800049BE00200002, 0x800049BF00200002, 0x800049C000200002, 0x800049C100200002, 0x800049C200200002, 0x800049C300200002, 0x800049C400200002,
+		0x800049C500200002, 0x800049C600200002, 0x800049C700200002, 0x800049C800200002, 0x800049C900200002, 0x800049CA00200002, 0x800049CB00200002, 0x800049CC00200002,
+		0x800049CD00200002, 0x800049CE00200002, 0x800049CF00200002, 0x800049D000200002, 0x800049D100200002, 0x800049D200200002, 0x800049D300200002, 0x800049D400200002,
+		0x800049D500200002, 0x800049D600200002, 0x800049D700200002, 0x800049D800200002, 0x800049D900200002, 0x800049DA00200002, 0x800049DB00200002, 0x800049DC00200002,
+		0x800049DD00200002, 0x800049DE00200002, 0x800049DF00200002, 0x800049E000200002, 0x800049E100200002, 0x800049E200200002, 0x800049E300200002, 0x800049E400200002,
+		0x800049E500200002, 0x800049E600200002, 0x800049E700200002, 0x800049E800200002, 0x800049E900200002, 0x800049EA00200002, 0x800049EB00200002, 0x800049EC00200002,
+		0x800049ED00200002, 0x800049EE00200002, 0x800049EF00200002, 0x800049F000200002, 0x800049F100200002, 0x800049F200200002, 0x800049F300200002, 0x800049F400200002,
+		0x800049F500200002, 0x800049F600200002, 0x800049F700200002, 0x800049F800200002, 0x800049F900200002, 0x800049FA00200002, 0x800049FB00200002, 0x800049FC00200002,
+		0x800049FD00200002, 0x800049FE00200002, 0x800049FF00200002, 0x80004A0000200002, 0x80004A0100200002, 0x80004A0200200002, 0x80004A0300200002, 0x80004A0400200002,
+		0x80004A0500200002, 0x80004A0600200002, 0x80004A0700200002, 0x80004A0800200002, 0x80004A0900200002, 0x80004A0A00200002, 0x80004A0B00200002, 0x80004A0C00200002,
+		0x80004A0D00200002, 0x80004A0E00200002, 0x80004A0F00200002, 0x80004A1000200002, 0x80004A1100200002, 0x80004A1200200002, 0x80004A1300200002, 0x80004A1400200002,
+		0x80004A1500200002, 0x80004A1600200002, 0x80004A1700200002, 0x80004A1800200002, 0x80004A1900200002, 0x80004A1A00200002, 0x80004A1B00200002, 0x80004A1C00200002,
+		0x80004A1D00200002, 0x80004A1E00200002, 0x80004A1F00200002, 0x80004A2000200002, 0x80004A2100200002, 0x80004A2200200002, 0x80004A2300200002, 0x80004A2400200002,
+		0x80004A2500200002, 0x80004A2600200002, 0x80004A2700200002, 0x80004A2800200002, 0x80004A2900200002, 0x80004A2A00200002, 0x80004A2B00200002, 0x80004A2C00200002,
+		0x80004A2D00200002, 0x80004A2E00200002, 0x80004A2F00200002, 0x80004A3000200002, 0x80004A3100200002, 0x80004A3200200002, 0x80004A3300200002, 0x80004A3400200002,
+		0x80004A3500200002, 0x80004A3600200002, 0x80004A3700200002, 0x80004A3800200002, 0x80004A3900200002, 0x80004A3A00200002, 0x80004A3B00200002, 0x80004A3C00200002,
+		0x80004A3D00200002, 0x80004A3E00200002, 0x80004A3F00200002, 0x80004A4000200002, 0x80004A4100200002, 0x80004A4200200002, 0x80004A4300200002, 0x80004A4400200002,
+		0x80004A4500200002, 0x80004A4600200002, 0x80004A4700200002, 0x80004A4800200002, 0x80004A4900200002, 0x80004A4A00200002, 0x80004A4B00200002, 0x80004A4C00200002,
+		0x80004A4D00200002, 0x80004A4E00200002, 0x80004A4F00200002, 0x80004A5000200002, 0x80004A5100200002, 0x80004A5200200002, 0x80004A5300200002, 0x80004A5400200002,
+		0x80004A5500200002, 0x80004A5600200002, 0x80004A5700200002, 0x80004A5800200002, 0x80004A5900200002, 0x80004A5A00200002, 0x80004A5B00200002, 0x80004A5C00200002,
+		0x80004A5D00200002, 0x80004A5E00200002, 0x80004A5F00200002, 0x80004A6000200002, 0x80004A6100200002, 0x80004A6200200002, 0x80004A6300200002, 0x80004A6400200002,
+		0x80004A6500200002, 0x80004A6600200002, 0x80004A6700200002, 0x80004A6800200002, 0x80004A6900200002, 0x80004A6A00200002, 0x80004A6B00200002, 0x80004A6C00200002,
+		0x80004A6D00200002, 0x80004A6E00200002, 0x80004A6F00200002, 0x80004A7000200002, 0x80004A7100200002, 0x80004A7200200002, 0x80004A7300200002, 0x80004A7400200002,
+		0x80004A7500200002, 0x80004A7600200002, 0x80004A7700200002, 0x80004A7800200002, 0x80004A7900200002, 0x80004A7A00200002, 0x80004A7B00200002, 0x80004A7C00200002,
+		0x80004A7D00200002, 0x80004A7E00200002, 0x80004A7F00200002, 0x80004A8000200002, 0x80004A8100200002, 0x80004A8200200002, 0x80004A8300200002, 0x80004A8400200002,
+		0x80004A8500200002, 0x80004A8600200002, 0x80004A8700200002, 0x80004A8800200002, 0x80004A8900200002, 0x80004A8A00200002, 0x80004A8B00200002, 0x80004A8C00200002,
+		0x80004A8D00200002, 0x80004A8E00200002, 0x80004A8F00200002, 0x80004A9000200002, 0x80004A9100200002, 0x80004A9200200002, 0x80004A9300200002, 0x80004A9400200002,
+		0x80004A9500200002, 0x80004A9600200002, 0x80004A9700200002, 0x80004A9800200002, 0x80004A9900200002, 0x80004A9A00200002, 0x80004A9B00200002, 0x80004A9C00200002,
+		0x80004A9D00200002, 0x80004A9E00200002, 0x80004A9F00200002, 0x80004AA000200002, 0x80004AA100200002, 0x80004AA200200002, 0x80004AA300200002, 0x80004AA400200002,
+		0x80004AA500200002, 0x80004AA600200002, 0x80004AA700200002, 0x80004AA800200002, 0x80004AA900200002, 0x80004AAA00200002, 0x80004AAB00200002, 0x80004AAC00200002,
+		0x80004AAD00200002, 0x80004AAE00200002, 0x80004AAF00200002, 0x80004AB000200002, 0x80004AB100200002, 0x80004AB200200002, 0x80004AB300200002, 0x80004AB400200002,
+		0x80004AB500200002, 0x80004AB600200002, 0x80004AB700200002, 0x80004AB800200002, 0x80004AB900200002, 0x80004ABA00200002, 0x80004ABB00200002, 0x80004ABC00200002,
+		0x80004ABD00200002, 0x80004ABE00200002, 0x80004ABF00200002, 0x80004AC000200002, 0x80004AC100200002, 0x80004AC200200002, 0x80004AC300200002, 0x80004AC400200002,
+		0x80004AC500200002, 0x80004AC600200002, 0x80004AC700200002, 0x80004AC800200002, 0x80004AC900200002, 0x80004ACA00200002, 0x80004ACB00200002, 0x80004ACC00200002,
+		0x80004ACD00200002, 0x80004ACE00200002, 0x80004ACF00200002, 0x80004AD000200002, 0x80004AD100200002, 0x80004AD200200002, 0x80004AD300200002, 0x80004AD400200002,
+		0x80004AD500200002, 0x80004AD600200002, 0x80004AD700200002, 0x80004AD800200002, 0x80004AD900200002, 0x80004ADA00200002, 0x80004ADB00200002, 0x80004ADC00200002,
+		0x80004ADD00200002, 0x80004ADE00200002, 0x80004ADF00200002, 0x80004AE000200002, 0x80004AE100200002, 0x80004AE200200002, 0x80004AE300200002, 0x80004AE400200002,
+		0x80004AE500200002, 0x80004AE600200002, 0x80004AE700200002, 0x80004AE800200002, 0x80004AE900200002, 0x80004AEA00200002, 0x80004AEB00200002, 0x80004AEC00200002,
+		0x80004AED00200002, 0x80004AEE00200002, 0x80004AEF00200002, 0x80004AF000200002, 0x80004AF100200002, 0x80004AF200200002, 0x80004AF300200002, 0x80004AF400200002,
+		0x80004AF500200002, 0x80004AF600200002, 0x80004AF700200002, 0x80004AF800200002, 0x80004AF900200002, 0x80004AFA00200002, 0x80004AFB00200002, 0x80004AFC00200002,
+		0x80004AFD00200002, 0x80004AFE00200002, 0x80004AFF00200002, 0x80004B0000200002, 0x80004B0100200002, 0x80004B0200200002, 0x80004B0300200002, 0x80004B0400200002,
+		0x80004B0500200002, 0x80004B0600200002, 0x80004B0700200002, 0x80004B0800200002, 0x80004B0900200002, 0x80004B0A00200002, 0x80004B0B00200002, 0x80004B0C00200002,
+		0x80004B0D00200002, 0x80004B0E00200002, 0x80004B0F00200002, 0x80004B1000200002, 0x80004B1100200002, 0x80004B1200200002, 0x80004B1300200002, 0x80004B1400200002,
+		0x80004B1500200002, 0x80004B1600200002, 0x80004B1700200002, 0x80004B1800200002, 0x80004B1900200002, 0x80004B1A00200002, 0x80004B1B00200002, 0x80004B1C00200002,
+		0x80004B1D00200002, 0x80004B1E00200002, 0x80004B1F00200002, 0x80004B2000200002, 0x80004B2100200002, 0x80004B2200200002, 0x80004B2300200002, 0x80004B2400200002,
+		0x80004B2500200002, 0x80004B2600200002, 0x80004B2700200002, 0x80004B2800200002, 0x80004B2900200002, 0x80004B2A00200002, 0x80004B2B00200002, 0x80004B2C00200002,
+		0x80004B2D00200002, 0x80004B2E00200002, 0x80004B2F00200002, 0x80004B3000200002, 0x80004B3100200002, 0x80004B3200200002, 0x80004B3300200002, 0x80004B3400200002,
+		0x80004B3500200002, 0x80004B3600200002, 0x80004B3700200002, 0x80004B3800200002, 0x80004B3900200002, 0x80004B3A00200002, 0x80004B3B00200002, 0x80004B3C00200002,
+		0x80004B3D00200002, 0x80004B3E00200002, 0x80004B3F00200002, 0x80004B4000200002, 0x80004B4100200002, 0x80004B4200200002, 0x80004B4300200002, 0x80004B4400200002,
+		0x80004B4500200002, 0x80004B4600200002, 0x80004B4700200002, 0x80004B4800200002, 0x80004B4900200002, 0x80004B4A00200002, 0x80004B4B00200002, 0x80004B4C00200002,
+		0x80004B4D00200002, 0x80004B4E00200002, 0x80004B4F00200002, 0x80004B5000200002, 0x80004B5100200002, 0x80004B5200200002, 0x80004B5300200002, 0x80004B5400200002,
+		0x80004B5500200002, 0x80004B5600200002, 0x80004B5700200002, 0x80004B5800200002, 0x80004B5900200002, 0x80004B5A00200002, 0x80004B5B00200002, 0x80004B5C00200002,
+		0x80004B5D00200002, 0x80004B5E00200002, 0x80004B5F00200002, 0x80004B6000200002, 0x80004B6100200002, 0x80004B6200200002, 0x80004B6300200002, 0x80004B6400200002,
+		0x80004B6500200002, 0x80004B6600200002, 0x80004B6700200002, 0x80004B6800200002, 0x80004B6900200002, 0x80004B6A00200002, 0x80004B6B00200002, 0x80004B6C00200002,
+		0x80004B6D00200002, 0x80004B6E00200002, 0x80004B6F00200002, 0x80004B7000200002, 0x80004B7100200002, 0x80004B7200200002, 0x80004B7300200002, 0x80004B7400200002,
+		0x80004B7500200002, 0x80004B7600200002, 0x80004B7700200002, 0x80004B7800200002, 0x80004B7900200002, 0x80004B7A00200002, 0x80004B7B00200002, 0x80004B7C00200002,
+		0x80004B7D00200002, 0x80004B7E00200002, 0x80004B7F00200002, 0x80004B8000200002, 0x80004B8100200002, 0x80004B8200200002, 0x80004B8300200002, 0x80004B8400200002,
+		0x80004B8500200002, 0x80004B8600200002, 0x80004B8700200002, 0x80004B8800200002, 0x80004B8900200002, 0x80004B8A00200002, 0x80004B8B00200002, 0x80004B8C00200002,
+		0x80004B8D00200002, 0x80004B8E00200002, 0x80004B8F00200002, 0x80004B9000200002, 0x80004B9100200002, 0x80004B9200200002, 0x80004B9300200002, 0x80004B9400200002,
+		0x80004B9500200002, 0x80004B9600200002, 0x80004B9700200002, 0x80004B9800200002, 0x80004B9900200002, 0x80004B9A00200002, 0x80004B9B00200002, 0x80004B9C00200002,
+		0x80004B9D00200002, 0x80004B9E00200002, 0x80004B9F00200002, 0x80004BA000200002, 0x80004BA100200002, 0x80004BA200200002, 0x80004BA300200002, 0x80004BA400200002,
+		0x80004BA500200002, 0x80004BA600200002, 0x80004BA700200002, 0x80004BA800200002, 0x80004BA900200002, 0x80004BAA00200002, 0x80004BAB00200002, 0x80004BAC00200002,
+		0x80004BAD00200002, 0x80004BAE00200002, 0x80004BAF00200002, 0x80004BB000200002, 0x80004BB100200002, 0x80004BB200200002, 0x80004BB300200002, 0x80004BB400200002,
+		0x80004BB500200002, 0x80004BB600200002, 0x80004BB700200002, 0x80004BB800200002, 0x80004BB900200002, 0x80004BBA00200002, 0x80004BBB00200002, 0x80004BBC00200002,
+		0x80004BBD00200002, 0x80004BBE00200002, 0x80004BBF00200002, 0x80004BC000200002, 0x80004BC100200002, 0x80004BC200200002, 0x80004BC300200002, 0x80004BC400200002,
+		0x80004BC500200002, 0x80004BC600200002, 0x80004BC700200002, 0x80004BC800200002, 0x80004BC900200002, 0x80004BCA00200002, 0x80004BCB00200002, 0x80004BCC00200002,
+		0x80004BCD00200002, 0x80004BCE00200002, 0x80004BCF00200002, 0x80004BD000200002, 0x80004BD100200002, 0x80004BD200200002, 0x80004BD300200002, 0x80004BD400200002,
+		0x80004BD500200002, 0x80004BD600200002, 0x80004BD700200002, 0x80004BD800200002, 0x80004BD900200002, 0x80004BDA00200002, 0x80004BDB00200002, 0x80004BDC00200002,
+		0x80004BDD00200002, 0x80004BDE00200002, 0x80004BDF00200002, 0x80004BE000200002, 0x80004BE100200002, 0x80004BE200200002, 0x80004BE300200002, 0x80004BE400200002,
+		0x80004BE500200002, 0x80004BE600200002, 0x80004BE700200002, 0x80004BE800200002, 0x80004BE900200002, 0x80004BEA00200002, 0x80004BEB00200002, 0x80004BEC00200002,
+		0x80004BED00200002, 0x80004BEE00200002, 0x80004BEF00200002, 0x80004BF000200002, 0x80004BF100200002, 0x80004BF200200002, 0x80004BF300200002, 0x80004BF400200002,
+		0x80004BF500200002, 0x80004BF600200002, 0x80004BF700200002, 0x80004BF800200002, 0x80004BF900200002, 0x80004BFA00200002, 0x80004BFB00200002, 0x80004BFC00200002,
+		0x80004BFD00200002, 0x80004BFE00200002, 0x80004BFF00200002, 0x80004C0000200002, 0x80004C0100200002, 0x80004C0200200002, 0x80004C0300200002, 0x80004C0400200002,
+		0x80004C0500200002, 0x80004C0600200002, 0x80004C0700200002, 0x80004C0800200002, 0x80004C0900200002, 0x80004C0A00200002, 0x80004C0B00200002, 0x80004C0C00200002,
+		0x80004C0D00200002, 0x80004C0E00200002, 0x80004C0F00200002, 0x80004C1000200002, 0x80004C1100200002, 0x80004C1200200002, 0x80004C1300200002, 0x80004C1400200002,
+		0x80004C1500200002, 0x80004C1600200002, 0x80004C1700200002, 0x80004C1A00200002, 0x80004C1B00200002, 0x80004C1C00200002, 0x80004C1D00200002, 0x80004C1E00200002,
+		0x80004C1F00200002, 0x80004C2000200002, 0x80004C2100200002, 0x80004C2200200002, 0x80004C2300200002, 0x80004C2400200002, 0x80004C2500200002, 0x80004C2600200002,
+		0x80004C2700200002, 0x80004C2800200002, 0x80004C2900200002, 0x80004C2A00200002, 0x80004C2B00200002, 0x80004C2C00200002, 0x80004C2D00200002, 0x80004C2E00200002,
+		0x80004C2F00200002, 0x80004C3000200002, 0x80004C3100200002, 0x80004C3200200002, 0x80004C3300200002, 0x80004C3400200002, 0x80004C3500200002, 0x80004C3600200002,
+		0x80004C3700200002, 0x80004C3800200002, 0x80004C3900200002, 0x80004C3A00200002, 0x80004C3B00200002, 0x80004C3C00200002, 0x80004C3D00200002, 0x80004C3E00200002,
+		0x80004C3F00200002, 0x80004C4000200002, 0x80004C4100200002, 0x80004C4200200002, 0x80004C4300200002, 0x80004C4400200002, 0x80004C4500200002, 0x80004C4600200002,
+		0x80004C4700200002, 0x80004C4800200002, 0x80004C4900200002, 0x80004C4A00200002, 0x80004C4B00200002, 0x80004C4C00200002, 0x80004C4D00200002, 0x80004C4E00200002,
+		0x80004C4F00200002, 0x80004C5000200002, 0x80004C5100200002, 0x80004C5200200002, 0x80004C5300200002, 0x80004C5400200002, 0x80004C5500200002, 0x80004C5600200002,
+		0x80004C5700200002, 0x80004C5800200002, 0x80004C5900200002, 0x80004C5A00200002, 0x80004C5B00200002, 0x80004C5C00200002, 0x80004C5D00200002, 0x80004C5E00200002,
+		0x80004C5F00200002, 0x80004C6000200002, 0x80004C6100200002, 0x80004C6200200002, 0x80004C6300200002, 0x80004C6400200002, 0x80004C6500200002, 0x80004C6600200002,
+		0x80004C6700200002, 0x80004C6800200002, 0x80004C6900200002, 0x80004C6A00200002, 0x80004C6B00200002, 0x80004C6C00200002, 0x80004C6D00200002, 0x80004C6E00200002,
+		0x80004C6F00200002, 0x80004C7000200002, 0x80004C7100200002, 0x80004C7200200002, 0x80004C7300200002, 0x80004C7400200002, 0x80004C7500200002, 0x80004C7600200002,
+		0x80004C7700200002, 0x80004C7800200002, 0x80004C7900200002, 0x80004C7A00200002, 0x80004C7B00200002, 0x80004C7C00200002, 0x80004C7D00200002, 0x80004C7E00200002,
+		0x80004C7F00200002, 0x80004C8000200002, 0x80004C8100200002, 0x80004C8200200002, 0x80004C8300200002, 0x80004C8400200002, 0x80004C8500200002, 0x80004C8600200002,
+		0x80004C8700200002, 0x80004C8800200002, 0x80004C8900200002, 0x80004C8A00200002, 0x80004C1800200002, 0x80004C1900200002, 0x80004C8B00200002, 0x80004C8C00200002,
+		0x80004C8D00200002, 0x80004C8E00200002, 0x80004C8F00200002, 0x80004C9000200002, 0x80004C9100200002, 0x80004C9200200002, 0x80004C9300200002, 0x80004C9400200002,
+		0x80004C9500200002, 0x80004C9600200002, 0x80004C9700200002, 0x80004C9800200002, 0x80004C9900200002, 0x80004C9A00200002, 0x80004C9B00200002, 0x80004C9C00200002,
+		0x80004C9D00200002, 0x80004C9E00200002, 0x80004C9F00200002, 0x80004CA000200002, 0x80004CA100200002, 0x80004CA200200002, 0x80004CA300200002, 0x80004CA400200002,
+		0x80004CA500200002, 0x80004CA600200002, 0x80004CA700200002, 0x80004CA800200002, 0x80004CA900200002, 0x80004CAA00200002, 0x80004CAB00200002, 0x80004CAC00200002,
+		0x80004CAD00200002, 0x80004CAE00200002, 0x80004CAF00200002, 0x80004CB000200002, 0x80004CB100200002, 0x80004CB200200002, 0x80004CB300200002, 0x80004CB400200002,
+		0x80004CB500200002, 0x80004CB600200002, 0x80004CB700200002, 0x80004CB800200002, 0x80004CB900200002, 0x80004CBA00200002, 0x80004CBB00200002, 0x80004CBC00200002,
+		0x80004CBD00200002, 0x80004CBE00200002, 0x80004CBF00200002, 0x80004CC000200002, 0x80004CC100200002, 0x80004CC200200002, 0x80004CC300200002, 0x80004CC400200002,
+		0x80004CC500200002, 0x80004CC600200002, 0x80004CC700200002, 0x80004CC800200002, 0x80004CC900200002, 0x80004CCA00200002, 0x80004CCB00200002, 0x80004CCC00200002,
+		0x80004CCD00200002, 0x80004CCE00200002, 0x80004CCF00200002, 0x80004CD000200002, 0x80004CD100200002, 0x80004CD200200002, 0x80004CD300200002, 0x80004CD400200002,
+		0x80004CD500200002, 0x80004CD600200002, 0x80004CD700200002, 0x80004CD800200002, 0x80004CD900200002, 0x80004CDA00200002, 0x80004CDB00200002, 0x80004CDC00200002,
+		0x80004CDD00200002, 0x80004CDE00200002, 0x80004CDF00200002, 0x80004CE000200002, 0x80004CE100200002, 0x80004CE200200002, 0x80004CE300200002, 0x80004CE400200002,
+		0x80004CE500200002, 0x80004CE600200002, 0x80004CE700200002, 0x80004CE800200002, 0x80004CE900200002, 0x80004CEA00200002, 0x80004CEB00200002, 0x80004CEC00200002,
+		0x80004CED00200002, 0x80004CEE00200002, 0x80004CEF00200002, 0x80004CF000200002, 0x80004CF100200002, 0x80004CF200200002, 0x80004CF300200002, 0x80004CF400200002,
+		0x80004CF500200002, 0x80004CF600200002, 0x80004CF700200002, 0x80004CF800200002, 0x80004CF900200002, 0x80004CFA00200002, 0x80004CFB00200002, 0x80004CFC00200002,
+		0x80004CFD00200002, 0x80004CFE00200002, 0x80004CFF00200002, 0x80004D0000200002, 0x80004D0100200002, 0x80004D0200200002, 0x80004D0300200002, 0x80004D0400200002,
+		0x80004D0500200002, 0x80004D0600200002, 0x80004D0700200002, 0x80004D0800200002, 0x80004D0900200002, 0x80004D0A00200002, 0x80004D0B00200002, 0x80004D0C00200002,
+		0x80004D0D00200002, 0x80004D0E00200002, 0x80004D0F00200002, 0x80004D1000200002, 0x80004D1100200002, 0x80004D1200200002, 0x80004D1300200002, 0x80004D1400200002,
+		0x80004D1500200002, 0x80004D1600200002, 0x80004D1700200002, 0x80004D1800200002, 0x80004D1900200002, 0x80004D1A00200002, 0x80004D1B00200002, 0x80004D1C00200002,
+		0x80004D1D00200002, 0x80004D1E00200002, 0x80004D1F00200002, 0x80004D2000200002, 0x80004D2100200002, 0x80004D2200200002, 0x80004D2300200002, 0x80004D2400200002,
+		0x80004D2500200002, 0x80004D2600200002, 0x80004D2700200002, 0x80004D2800200002, 0x80004D2900200002, 0x80004D2A00200002, 0x80004D2B00200002, 0x80004D2C00200002,
+		0x80004D2D00200002, 0x80004D2E00200002, 0x80004D2F00200002, 0x80004D3000200002, 0x80004D3100200002, 0x80004D3200200002, 0x80004D3300200002, 0x80004D3400200002,
+		0x80004D3500200002, 0x80004D3600200002, 0x80004D3700200002, 0x80004D3800200002, 0x80004D3900200002, 0x80004D3A00200002, 0x80004D3B00200002, 0x80004D3C00200002,
+		0x80004D3D00200002, 0x80004D3E00200002, 0x80004D3F00200002, 0x80004D4000200002, 0x80004D4100200002, 0x80004D4200200002, 0x80004D4300200002, 0x80004D4400200002,
+		0x80004D4500200002, 0x80004D4600200002, 0x80004D4700200002, 0x80004D4800200002, 0x80004D4900200002, 0x80004D4A00200002, 0x80004D4B00200002, 0x80004D4C00200002,
+		0x80004D4D00200002, 0x80004D4E00200002, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002, 0x80001C4600200002,
+		0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002, 0x80001C4600200002, 0x80001C4100200002,
+		0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002, 0x80001C4600200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002,
+		0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002, 0x80001C4600200002, 0x80001C3E00200002, 0x80001C3F00200002,
+		0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002,
+		0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002, 0x80001C4600200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4000200002,
+		0x80001C4100200002, 0x80001C4200200002, 0x80001BDE00200002, 0x80001BDF00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4000200002,
+		0x80001C4100200002, 0x80001C4200200002, 0x80001C4000200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4100200002, 0x80001C4100200002, 0x80001C4100200002,
+		0x80001C4300200002, 0x80001C4400200002, 0x80001C4400200002, 0x80001C4400200002, 0x80001C4500200002, 0x80001C4500200002, 0x80001C4600200002, 0x80001C4600200002,
+		0x80001C4600200002, 0x80001C4600200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C3E00200002,
+		0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4200200002, 0x80001C3F00200002, 0x80001C4000200002,
+		0x80001C3E00200002, 0x80001C3F00200002, 0x80001BE000200002, 0x80001BE100200002, 0x80001BE200200002, 0x80001BE300200002, 0x80001BE400200002, 0x80001BE500200002,
+		0x80001BE600200002, 0x80001BE700200002, 0x80001BE800200002, 0x80001BE900200002, 0x80001BEA00200002, 0x80001BEB00200002, 0x80001BEC00200002, 0x80001BED00200002,
+		0x80001BEE00200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002, 0x80001C4600200002, 0,
+		0x8000030000200002, 0x8000030100200002, 0x8000030200200002, 0x8000030300200002, 0x8000030400200002, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80004D4F00200002, 0x80004D5000200002, 0x80004D5100200002, 0x80004D5200200002, 0x80004D5300200002, 0x80004D5400200002, 0x80004D5500200002, 0x80004D5600200002,
+		0x80004D5700200002, 0x80004D5800200002, 0x80004D5900200002, 0x80004D5A00200002, 0x80004D5B00200002, 0x80004D5C00200002, 0x80004D5D00200002, 0x80004D5E00200002,
+		0x80004D5F00200002, 0x80004D6000200002, 0x80004D6100200002, 0x80004D6200200002, 0x80004D6300200002, 0x80004D6400200002, 0x80004D6500200002, 0x80004D6600200002,
+		0x80004D6700200002, 0x80004D6800200002, 0x80004D6900200002, 0x80004D6A00200002, 0x80004D6B00200002, 0x80004D6C00200002, 0x80004D6D00200002, 0x80004D6E00200002,
+		0x80004D6F00200002, 0x80004D7000200002, 0x80004D7100200002, 0x80004D7200200002, 0x80004D7300200002, 0x80004D7400200002, 0x80004D7500200002, 0x80004D7600200002,
+		0x80004D7700200002, 0x80004D7800200002, 0x80004D7900200002, 0x80004D7A00200002, 0x80004D7B00200002, 0x80004D7C00200002, 0x80004D7D00200002, 0x80004D7E00200002,
+		0x80004D7F00200002, 0x80004D8000200002, 0x80004D8100200002, 0x80004D8200200002, 0x80004D8300200002, 0x80004D8400200002, 0x80004D8500200002, 0x80004D8600200002,
+		0x80004D8700200002, 0x80004D8800200002, 0x80004D8900200002, 0x80004D8A00200002, 0x80004D8B00200002, 0x80004D8C00200002, 0x80004D8D00200002, 0x80004D8E00200002,
+		0x80004D8F00200002, 0x80004D9000200002, 0x80004D9100200002, 0x80004D9200200002, 0x80004D9300200002, 0x80004D9400200002, 0x80004D9500200002, 0x80004D9600200002,
+		0x80004D9700200002, 0x80004D9800200002, 0x80004D9900200002, 0x80004D9A00200002, 0x80004D9B00200002, 0x80004D9C00200002, 0x80004D9D00200002, 0x80004D9E00200002,
+		0x80004D9F00200002, 0x80004DA000200002, 0x80004DA100200002, 0x80004DA200200002, 0x80004DA300200002, 0x80004DA400200002, 0x80004DA500200002, 0x80004DA600200002,
+		0x80004DA700200002, 0x80004DA800200002, 0x80004DA900200002, 0x80004DAA00200002, 0x80004DAB00200002, 0x80004DAC00200002, 0x80004DAD00200002, 0x80004DAE00200002,
+		0x80004DAF00200002, 0x80004DB000200002, 0x80004DB100200002, 0x80004DB200200002, 0x80004DB300200002, 0x80004DB400200002, 0x80004DB500200002, 0x80004DB600200002,
+		0x80004DB700200002, 0x80004DB800200002, 0x80004DB900200002, 0x80004DBA00200002, 0x80004DBB00200002, 0x80004DBC00200002, 0x80004DBD00200002, 0x80004DBE00200002,
+		0x80004DBF00200002, 0x80004DC000200002, 0x80004DC100200002, 0x80004DC200200002, 0x80004DC300200002, 0x80004DC400200002, 0x80004DC500200002, 0x80004DC600200002,
+		0x80004DC700200002, 0x80004DC800200002, 0x80004DC900200002, 0x80004DCA00200002, 0x80004DCB00200002, 0x80004DCC00200002, 0x80004DCD00200002, 0x80004DCE00200002,
+		0x80004DCF00200002, 0x80004DD000200002, 0x80004DD100200002, 0x80004DD200200002, 0x80004DD300200002, 0x80004DD400200002, 0x80004DD500200002, 0x80004DD600200002,
+		0x80004DD700200002, 0x80004DD800200002, 0x80004DD900200002, 0x80004DDA00200002, 0x80004DDB00200002, 0x80004DDC00200002, 0x80004DDD00200002, 0x80004DDE00200002,
+		0x80004DDF00200002, 0x80004DE000200002, 0x80004DE100200002, 0x80004DE200200002, 0x80004DE300200002, 0x80004DE400200002, 0x80004DE500200002, 0x80004DE600200002,
+		0x80004DE700200002, 0x80004DE800200002, 0x80004DE900200002, 0x80004DEA00200002, 0x80004DEB00200002, 0x80004DEC00200002, 0x80004DED00200002, 0x80004DEE00200002,
+		0x80004DEF00200002, 0x80004DF000200002, 0x80004DF100200002, 0x80004DF200200002, 0x80004DF300200002, 0x80004DF400200002, 0x80004DF500200002, 0x80004DF600200002,
+		0x80004DF700200002, 0x80004DF800200002, 0x80004DF900200002, 0x80004DFA00200002, 0x80004DFB00200002, 0x80004DFC00200002, 0x80004DFD00200002, 0x80004DFE00200002,
+		0x80004DFF00200002, 0x80004E0000200002, 0x80004E0100200002, 0x80004E0200200002, 0x80004E0300200002, 0x80004E0400200002, 0x80004E0500200002, 0x80004E0600200002,
+		0x80004E0700200002, 0x80004E0800200002, 0x80004E0900200002, 0x80004E0A00200002, 0x80004E0B00200002, 0x80004E0C00200002, 0x80004E0D00200002, 0x80004E0E00200002,
+		0x80004E0F00200002, 0x80004E1000200002, 0x80004E1100200002, 0x80004E1200200002, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80004E1300200002, 0x80004E1400200002, 0x80004E1500200002, 0x80004E1600200002, 0x80004E1700200002, 0x80004E1800200002, 0x80004E1900200002, 0x80004E1A00200002,
+		0x80004E1B00200002, 0x80004E1C00200002, 0x80004E1D00200002, 0x80004E1E00200002, 0x80004E1F00200002, 0x80004E2000200002, 0x80004E2100200002, 0x80004E2200200002,
+		0x80004E2300200002, 0x80004E2400200002, 0x80004E2500200002, 0x80004E2600200002, 0x80004E2700200002, 0x80004E2800200002, 0x80004E2900200002, 0x80004E2A00200002,
+		0x80004E2B00200002, 0x80004E2C00200002, 0x80004E2D00200002, 0x80004E2E00200002, 0x80004E2F00200002, 0x80004E3000200002, 0x80004E3100200002, 0x80004E3200200002,
+		0x80004E3300200002, 0x80004E3400200002, 0x80004E3500200002, 0x80004E3600200002, 0x80004E3700200002, 0x80004E3800200002, 0x80004E3900200002, 0x80004E3A00200002,
+		0x80004E3B00200002, 0x80004E3C00200002, 0x80004E3D00200002, 0x80004E3E00200002, 0x80004E3F00200002, 0x80004E4000200002, 0x80004E4100200002, 0x80004E4200200002,
+		0x80004E4300200002, 0x80004E4400200002, 0x80004E4500200002, 0x80004E4600200002, 0x80004E4700200002, 0x80004E4800200002, 0x80004E4900200002, 0x80004E4A00200002,
+		0x80004E4B00200002, 0x80004E4C00200002, 0x80004E4D00200002, 0x80004E4E00200002, 0x80004E4F00200002, 0x80004E5000200002, 0x80004E5100200002, 0x80004E5200200002,
+		0x80004E5300200002, 0x80004E5400200002, 0x80004E5500200002, 0x80004E5600200002, 0x80004E5700200002, 0x80004E5800200002, 0x80004E5900200002, 0x80004E5A00200002,
+		0x80004E5B00200002, 0x80004E5C00200002, 0x80004E5D00200002, 0x80004E5E00200002, 0x80004E5F00200002, 0x80004E6000200002, 0x80004E6100200002, 0x80004E6200200002,
+		0x80004E6300200002, 0x80004E6400200002, 0x80004E6500200002, 0x80004E6600200002, 0x80004E6700200002, 0x80004E6800200002, 0x80004E6900200002, 0x80004E6A00200002,
+		0x80004E6B00200002, 0x80004E6C00200002, 0x80004E6D00200002, 0x80004E6E00200002, 0x80004E6F00200002, 0x80004E7000200002, 0x80004E7100200002, 0x80004E7200200002,
+		0x80004E7300200002, 0x80004E7400200002, 0x80004E7500200002, 0x80004E7600200002, 0x80004E7700200002, 0x80004E7800200002, 0x80004E7900200002, 0x80004E7A00200002,
+		0x80004E7B00200002, 0x80004E7C00200002, 0x80004E7D00200002, 0x80004E7E00200002, 0x80004E7F00200002, 0x80004E8000200002, 0x80004E8100200002, 0x80004E8200200002,
+		0x80004E8300200002, 0x80004E8400200002, 0x80004E8500200002, 0x80004E8600200002, 0x80004E8700200002, 0x80004E8800200002, 0x80004E8900200002, 0x80004E8A00200002,
+		0x80004E8B00200002, 0x80004E8C00200002, 0x80004E8D00200002, 0x80004E8E00200002, 0x80004E8F00200002, 0x80004E9000200002, 0x80004E9100200002, 0x80004E9200200002,
+		0x80004E9300200002, 0x80004E9400200002, 0x80004E9500200002, 0x80004E9600200002, 0x80004E9700200002, 0x80004E9800200002, 0x80004E9900200002, 0x80004E9A00200002,
+		0x80004E9B00200002, 0x80004E9C00200002, 0x80004E9D00200002, 0x80004E9E00200002, 0x80004E9F00200002, 0x80004EA000200002, 0x80004EA100200002, 0x80004EA200200002,
+		0x80004EA300200002, 0x80004EA400200002, 0x80004EA500200002, 0x80004EA600200002, 0x80004EA700200002, 0x80004EA800200002, 0x80004EA900200002, 0x80004EAA00200002,
+		0x80004EAB00200002, 0x80004EAC00200002, 0x80004EAD00200002, 0x80004EAE00200002, 0x80004EAF00200002, 0x80004EB000200002, 0x80004EB100200002, 0x80004EB200200002,
+		0x80004EB300200002, 0x80004EB400200002, 0x80004EB500200002, 0x80004EB600200002, 0x80004EB700200002, 0x80004EB800200002, 0x80004EB900200002, 0x80004EBA00200002,
+		0x80004EBB00200002, 0x80004EBC00200002, 0x80004EBD00200002, 0x80004EBE00200002, 0x80004EBF00200002, 0x80004EC000200002, 0x80004EC100200002, 0x80004EC200200002,
+		0x80004EC300200002, 0x80004EC400200002, 0x80004EC500200002, 0x80004EC600200002, 0x80004EC700200002, 0x80004EC800200002, 0x80004EC900200002, 0x80004ECA00200002,
+		0x80004ECB00200002, 0x80004ECC00200002, 0x80004ECD00200002, 0x80004ECE00200002, 0x80004ECF00200002, 0x80004ED000200002, 0x80004ED100200002, 0x80004ED200200002,
+		0x80004ED300200002, 0x80004ED400200002, 0x80004ED500200002, 0x80004ED600200002, 0x80004ED700200002, 0x80004ED800200002, 0x80004ED900200002, 0x80004EDA00200002,
+		0x80004EDB00200002, 0x80004EDC00200002, 0x80004EDD00200002, 0x80004EDE00200002, 0x80004EDF00200002, 0x80004EE000200002, 0x80004EE100200002, 0x80004EE200200002,
+		0x80004EE300200002, 0x80004EE400200002, 0x80004EE500200002, 0x80004EE600200002, 0x80004EE700200002, 0x80004EE800200002, 0x80004EE900200002, 0x80004EEA00200002,
+		0x80004EEB00200002, 0x80004EEC00200002, 0x80004EED00200002, 0x80004EEE00200002, 0x80004EEF00200002, 0x80004EF000200002, 0x80004EF100200002, 0x80004EF200200002,
+		0x80004EF300200002, 0x80004EF400200002, 0x80004EF500200002, 0x80004EF600200002, 0x80004EF700200002, 0x80004EF800200002, 0x80004EF900200002, 0x80004EFA00200002,
+		0x80004EFB00200002, 0x80004EFC00200002, 0x80004EFD00200002, 0x80004EFE00200002, 0x80004EFF00200002, 0x80004F0000200002, 0x80004F0100200002, 0x80004F0200200002,
+		0x80004F0300200002, 0x80004F0400200002, 0x80004F0500200002, 0x80004F0600200002, 0x80004F0700200002, 0x80004F0800200002, 0x80004F0900200002, 0x80004F0A00200002,
+		0x80004F0B00200002, 0x80004F0C00200002, 0x80004F0D00200002, 0x80004F0E00200002, 0x80004F0F00200002, 0x80004F1000200002, 0x80004F1100200002, 0x80004F1200200002,
+		0x80004F1300200002, 0x80004F1400200002, 0x80004F1500200002, 0x80004F1600200002, 0x80004F1700200002, 0x80004F1800200002, 0x80004F1900200002, 0x80004F1A00200002,
+		0x80004F1B00200002, 0x80004F1C00200002, 0x80004F1D00200002, 0x80004F1E00200002, 0x80004F1F00200002, 0x80004F2000200002, 0x80004F2100200002, 0x80004F2200200002,
+		0x80004F2300200002, 0x80004F2400200002, 0x80004F2500200002, 0x80004F2600200002, 0x80004F2700200002, 0x80004F2800200002, 0x80004F2900200002, 0x80004F2A00200002,
+		0x80004F2B00200002, 0x80004F2C00200002, 0x80004F2D00200002, 0x80004F2E00200002, 0x80004F2F00200002, 0x80004F3000200002, 0x80004F3100200002, 0x80004F3200200002,
+		0x80004F3300200002, 0x80004F3400200002, 0x80004F3500200002, 0x80004F3600200002, 0x80004F3700200002, 0x80004F3800200002, 0x80004F3900200002, 0x80004F3A00200002,
+		0x80004F3B00200002, 0x80004F3C00200002, 0x80004F3D00200002, 0x80004F3E00200002, 0x80004F3F00200002, 0x80004F4000200002, 0x80004F4100200002, 0x80004F4200200002,
+		0x80004F4300200002, 0x80004F4400200002, 0x80004F4500200002, 0x80004F4600200002, 0x80004F4700200002, 0x80004F4800200002, 0x80004F4900200002, 0x80004F4A00200002,
+		0x80004F4B00200002, 0x80004F4C00200002, 0x80004F4D00200002, 0x80004F4E00200002, 0x80004F4F00200002, 0x80004F5000200002, 0x80004F5100200002, 0x80004F5200200002,
+		0x80004F5300200002, 0x80004F5400200002, 0x80004F5500200002, 0x80004F5600200002, 0x80004F5700200002, 0x80004F5800200002, 0x80004F5900200002, 0x80004F5A00200002,
+		0x80004F5B00200002, 0x80004F5C00200002, 0x80004F5D00200002, 0x80004F5E00200002, 0x80004F5F00200002, 0x80004F6000200002, 0x80004F6100200002, 0x80004F6200200002,
+		0x80004F6300200002, 0x80004F6400200002, 0x80004F6500200002, 0x80004F6600200002, 0x80004F6700200002, 0x80004F6800200002, 0x80004F6900200002, 0x80004F6A00200002,
+		0x80004F6B00200002, 0x80004F6C00200002, 0x80004F6D00200002, 0x80004F6E00200002, 0x80004F6F00200002, 0x80004F7000200002, 0x80004F7100200002, 0x80004F7200200002,
+		0x80004F7300200002, 0x80004F7400200002, 0x80004F7500200002, 0x80004F7600200002, 0x80004F7700200002, 0x80004F7800200002, 0x80004F7900200002, 0x80004F7A00200002,
+		0x80004F7B00200002, 0x80004F7C00200002, 0x80004F7D00200002, 0x80004F7E00200002, 0x80004F7F00200002, 0x80004F8000200002, 0x80004F8100200002, 0x80004F8200200002,
+		0x80004F8300200002, 0x80004F8400200002, 0x80004F8500200002, 0x80004F8600200002, 0x80004F8700200002, 0x80004F8800200002, 0x80004F8900200002, 0x80004F8A00200002,
+		0x80004F8B00200002, 0x80004F8C00200002, 0x80004F8D00200002, 0x80004F8E00200002, 0x80004F8F00200002, 0x80004F9000200002, 0x80004F9100200002, 0x80004F9200200002,
+		0x80004F9300200002, 0x80004F9400200002, 0x80004F9500200002, 0x80004F9600200002, 0x80004F9700200002, 0x80004F9800200002, 0x80004F9900200002, 0x80004F9A00200002,
+		0x80004F9B00200002, 0x80004F9C00200002, 0x80004F9D00200002, 0x80004F9E00200002, 0x80004F9F00200002, 0x80004FA000200002, 0x80004FA100200002, 0x80004FA200200002,
+		0x80004FA300200002, 0x80004FA400200002, 0x80004FA500200002, 0x80004FA600200002, 0x80004FA700200002, 0x80004FA800200002, 0x80004FA900200002, 0x80004FAA00200002,
+		0x80004FAB00200002, 0x80004FAC00200002, 0x80004FAD00200002, 0x80004FAE00200002, 0x80004FAF00200002, 0x80004FB000200002, 0x80004FB100200002, 0x80004FB200200002,
+		0x80004FB300200002, 0x80004FB400200002, 0x80004FB500200002, 0x80004FB600200002, 0x80004FB700200002, 0x80004FB800200002, 0x80004FB900200002, 0x80004FBA00200002,
+		0x80004FBB00200002, 0x80004FBC00200002, 0x80004FBD00200002, 0x80004FBE00200002, 0x80004FBF00200002, 0x80004FC000200002, 0x80004FC100200002, 0x80004FC200200002,
+		0x80004FC300200002, 0x80004FC400200002, 0x80004FC500200002, 0x80004FC600200002, 0x80004FC700200002, 0x80004FC800200002, 0x80004FC900200002, 0x80004FCA00200002,
+		0x80004FCB00200002, 0x80004FCC00200002, 0x80004FCD00200002, 0x80004FCE00200002, 0x80004FCF00200002, 0x80004FD000200002, 0x80004FD100200002, 0x80004FD200200002,
+		0x80004FD300200002, 0x80004FD400200002, 0x80004FD500200002, 0x80004FD600200002, 0x80004FD700200002, 0x80004FD800200002, 0x80004FD900200002, 0x80004FDA00200002,
+		0x80004FDB00200002, 0x80004FDC00200002, 0x80004FDD00200002, 0x80004FDE00200002, 0x80004FDF00200002, 0x80004FE000200002, 0x80004FE100200002, 0x80004FE200200002,
+		0x80004FE300200002, 0x80004FE400200002, 0x80004FE500200002, 0x80004FE600200002, 0x80004FE700200002, 0x80004FE800200002, 0x80004FE900200002, 0x80004FEA00200002,
+		0x80004FEB00200002, 0x80004FEC00200002, 0x80004FED00200002, 0x80004FEE00200002, 0x80004FEF00200002, 0x80004FF000200002, 0x80004FF100200002, 0x80004FF200200002,
+		0x80004FF300200002, 0x80004FF400200002, 0x80004FF500200002, 0x80004FF600200002, 0x80004FF700200002, 0x80004FF800200002, 0x80004FF900200002, 0x80004FFA00200002,
+		0x80004FFB00200002, 0x80004FFC00200002, 0x80004FFD00200002, 0x80004FFE00200002, 0x80004FFF00200002, 0x8000500000200002, 0x8000500100200002, 0x8000500200200002,
+		0x8000500300200002, 0x8000500400200002, 0x8000500500200002, 0x8000500600200002, 0x8000500700200002, 0x8000500800200002, 0x8000500900200002, 0x8000500A00200002,
+		0x8000500B00200002, 0x8000500C00200002, 0x8000500D00200002, 0x8000500E00200002, 0x8000500F00200002, 0x8000501000200002, 0x8000501100200002, 0x8000501200200002,
+		0x8000501300200002, 0x8000501400200002, 0x8000501500200002, 0x8000501600200002, 0x8000501700200002, 0x8000501800200002, 0x8000501900200002, 0x8000501A00200002,
+		0x8000501B00200002, 0x8000501C00200002, 0x8000501D00200002, 0x8000501E00200002, 0x8000501F00200002, 0x8000502000200002, 0x8000502100200002, 0x8000502200200002,
+		0x8000502300200002, 0x8000502400200002, 0x8000502500200002, 0x8000502600200002, 0x8000502700200002, 0x8000502800200002, 0x8000502900200002, 0x8000502A00200002,
+		0x8000502B00200002, 0x8000502C00200002, 0x8000502D00200002, 0x8000502E00200002, 0x8000502F00200002, 0x8000503000200002, 0x8000503100200002, 0x8000503200200002,
+		0x8000503300200002, 0x8000503400200002, 0x8000503500200002, 0x8000503600200002, 0x8000503700200002, 0x8000503800200002, 0x8000503900200002, 0x8000503A00200002,
+		0x8000503B00200002, 0x8000503C00200002, 0x8000503D00200002, 0x8000503E00200002, 0x8000503F00200002, 0x8000504000200002, 0x8000504100200002, 0x8000504200200002,
+		0x8000504300200002, 0x8000504400200002, 0x8000504500200002, 0x8000504600200002, 0x8000504700200002, 0x8000504800200002, 0x8000504900200002, 0x8000504A00200002,
+		0x8000504B00200002, 0x8000504C00200002, 0x8000504D00200002, 0x8000504E00200002, 0x8000504F00200002, 0x8000505000200002, 0x8000505100200002, 0x8000505200200002,
+		0x8000505300200002, 0x8000505400200002, 0x8000505500200002, 0x8000505600200002, 0x8000505700200002, 0x8000505800200002, 0x8000505900200002, 0x8000505A00200002,
+		0x8000505B00200002, 0x8000505C00200002, 0x8000505D00200002, 0x8000505E00200002, 0x8000505F00200002, 0x8000506000200002, 0x8000506100200002, 0x8000506200200002,
+		0x8000506300200002, 0x8000506400200002, 0x8000506500200002, 0x8000506600200002, 0x8000506700200002, 0x8000506800200002, 0x8000506900200002, 0x8000506A00200002,
+		0x8000506B00200002, 0x8000506C00200002, 0x8000506D00200002, 0x8000506E00200002, 0x8000506F00200002, 0x8000507000200002, 0x8000507100200002, 0x8000507200200002,
+		0x8000507300200002, 0x8000507400200002, 0x8000507500200002, 0x8000507600200002, 0x8000507700200002, 0x8000507800200002, 0x8000507900200002, 0x8000507A00200002,
+		0x8000507B00200002, 0x8000507C00200002, 0x8000507D00200002, 0x8000507E00200002, 0x8000507F00200002, 0x8000508000200002, 0x8000508100200002, 0x8000508200200002,
+		0x8000508300200002, 0x8000508400200002, 0x8000508500200002, 0x8000508600200002, 0x8000508700200002, 0x8000508800200002, 0x8000508900200002, 0x8000508A00200002,
+		0x8000508B00200002, 0x8000508C00200002, 0x8000508D00200002, 0x8000508E00200002, 0x8000508F00200002, 0x8000509000200002, 0x8000509100200002, 0x8000509200200002,
+		0x8000509300200002, 0x8000509400200002, 0x8000509500200002, 0x8000509600200002, 0x8000509700200002, 0x8000509800200002, 0x8000509900200002, 0x8000509A00200002,
+		0x8000509B00200002, 0x8000509C00200002, 0x8000509D00200002, 0x8000509E00200002, 0x8000509F00200002, 0x800050A000200002, 0x800050A100200002, 0x800050A200200002,
+		0x800050A300200002, 0x800050A400200002, 0x800050A500200002, 0x800050A600200002, 0x800050A700200002, 0x800050A800200002, 0x800050A900200002, 0x800050AA00200002,
+		0x800050AB00200002, 0x800050AC00200002, 0x800050AD00200002, 0x800050AE00200002, 0x800050AF00200002, 0x800050B000200002, 0x800050B100200002, 0x800050B200200002,
+		0x800050B300200002, 0x800050B400200002, 0x800050B500200002, 0x800050B600200002, 0x800050B700200002, 0x800050B800200002, 0x800050B900200002, 0x800050BA00200002,
+		0x800050BB00200002, 0x800050BC00200002, 0x800050BD00200002, 0x800050BE00200002, 0x800050BF00200002, 0x800050C000200002, 0x800050C100200002, 0x800050C200200002,
+		0x800050C300200002, 0x800050C400200002, 0x800050C500200002, 0x800050C600200002, 0x800050C700200002, 0x800050C800200002, 0x800050C900200002, 0x800050CA00200002,
+		0x800050CB00200002, 0x800050CC00200002, 0x800050CD00200002, 0x800050CE00200002, 0x800050CF00200002, 0x800050D000200002, 0x800050D100200002, 0x800050D200200002,
+		0x800050D300200002, 0x800050D400200002, 0x800050D500200002, 0x800050D600200002, 0x800050D700200002, 0x800050D800200002, 0x800050D900200002, 0x800050DA00200002,
+		0x800050DB00200002, 0x800050DC00200002, 0x800050DD00200002, 0x800050DE00200002, 0x800050DF00200002, 0x800050E000200002, 0x800050E100200002, 0x800050E200200002,
+		0x800050E300200002, 0x800050E400200002, 0x800050E500200002, 0x800050E600200002, 0x800050E700200002, 0x800050E800200002, 0x800050E900200002, 0x800050EA00200002,
+		0x800050EB00200002, 0x800050EC00200002, 0x800050ED00200002, 0x800050EE00200002, 0x800050EF00200002, 0x800050F000200002, 0x800050F100200002, 0x800050F200200002,
+		0x800050F300200002, 0x800050F400200002, 0x800050F500200002, 0x800050F600200002, 0x800050F700200002, 0x800050F800200002, 0x800050F900200002, 0x800050FA00200002,
+		0x800050FB00200002, 0x800050FC00200002, 0x800050FD00200002, 0x800050FE00200002, 0x800050FF00200002, 0x8000510000200002, 0x8000510100200002, 0x8000510200200002,
+		0x8000510300200002, 0x8000510400200002, 0x8000510500200002, 0x8000510600200002, 0x8000510700200002, 0x8000510800200002, 0x8000510900200002, 0x8000510A00200002,
+		0x8000510B00200002, 0x8000510C00200002, 0x8000510D00200002, 0x8000510E00200002, 0x8000510F00200002, 0x8000511000200002, 0x8000511100200002, 0x8000511200200002,
+		0x8000511300200002, 0x8000511400200002, 0x8000511500200002, 0x8000511600200002, 0x8000511700200002, 0x8000511800200002, 0x8000511900200002, 0x8000511A00200002,
+		0x8000511B00200002, 0x8000511C00200002, 0x8000511D00200002, 0x8000511E00200002, 0x8000511F00200002, 0x8000512000200002, 0x8000512100200002, 0x8000512200200002,
+		0x8000512300200002, 0x8000512400200002, 0x8000512500200002, 0x8000512600200002, 0x8000512700200002, 0x8000512800200002, 0x8000512900200002, 0x8000512A00200002,
+		0x8000512B00200002, 0x8000512C00200002, 0x8000512D00200002, 0x8000512E00200002, 0x8000512F00200002, 0x8000513000200002, 0x8000513100200002, 0x8000513200200002,
+		0x8000513300200002, 0x8000513400200002, 0x8000513500200002, 0x8000513600200002, 0x8000513700200002, 0x8000513800200002, 0x8000513900200002, 0x8000513A00200002,
+		0x8000513B00200002, 0x8000513C00200002, 0x8000513D00200002, 0x8000513E00200002, 0x8000513F00200002, 0x8000514000200002, 0x8000514100200002, 0x8000514200200002,
+		0x8000514300200002, 0x8000514400200002, 0x8000514500200002, 0x8000514600200002, 0x8000514700200002, 0x8000514800200002, 0x8000514900200002, 0x8000514A00200002,
+		0x8000514B00200002, 0x8000514C00200002, 0x8000514D00200002, 0x8000514E00200002, 0x8000514F00200002, 0x8000515000200002, 0x8000515100200002, 0x8000515200200002,
+		0x8000515300200002, 0x8000515400200002, 0x8000515500200002, 0x8000515600200002, 0x8000515700200002, 0x8000515800200002, 0x8000515900200002, 0x8000515A00200002,
+		0x8000515B00200002, 0x8000515C00200002, 0x8000515D00200002, 0x8000515E00200002, 0x8000515F00200002, 0x8000516000200002, 0x8000516100200002, 0x8000516200200002,
+		0x8000516300200002, 0x8000516400200002, 0x8000516500200002, 0x8000516600200002, 0x8000516700200002, 0x8000516800200002, 0x8000516900200002, 0x8000516A00200002,
+		0x8000516B00200002, 0x8000516C00200002, 0x8000516D00200002, 0x8000516E00200002, 0x8000516F00200002, 0x8000517000200002, 0x8000517100200002, 0x8000517200200002,
+		0x8000517300200002, 0x8000517400200002, 0x8000517500200002, 0x8000517600200002, 0x8000517700200002, 0x8000517800200002, 0x8000517900200002, 0x8000517A00200002,
+		0x8000517B00200002, 0x8000517C00200002, 0x8000517D00200002, 0x8000517E00200002, 0x8000517F00200002, 0x8000518000200002, 0x8000518100200002, 0x8000518200200002,
+		0x8000518300200002, 0x8000518400200002, 0x8000518500200002, 0x8000518600200002, 0x8000518700200002, 0x8000518800200002, 0x8000518900200002, 0x8000518A00200002,
+		0x8000518B00200002, 0x8000518C00200002, 0x8000518D00200002, 0x8000518E00200002, 0x8000518F00200002, 0x8000519000200002, 0x8000519100200002, 0x8000519200200002,
+		0x8000519300200002, 0x8000519400200002, 0x8000519500200002, 0x8000519600200002, 0x8000519700200002, 0x8000519800200002, 0x8000519900200002, 0x8000519A00200002,
+		0x8000519B00200002, 0x8000519C00200002, 0x8000519D00200002, 0x8000519E00200002, 0x8000519F00200002, 0x800051A000200002, 0x800051A100200002, 0x800051A200200002,
+		0x800051A300200002, 0x800051A400200002, 0x800051A500200002, 0x800051A600200002, 0x800051A700200002, 0x800051A800200002, 0x800051A900200002, 0x800051AA00200002,
+		0x800051AB00200002, 0x800051AC00200002, 0x800051AD00200002, 0x800051AE00200002, 0x800051AF00200002, 0x800051B000200002, 0x800051B100200002, 0x800051B200200002,
+		0x800051B300200002, 0x800051B400200002, 0x800051B500200002, 0x800051B600200002, 0x800051B700200002, 0x800051B800200002, 0x800051B900200002, 0x800051BA00200002,
+		0x800051BB00200002, 0x800051BC00200002, 0x800051BD00200002, 0x800051BE00200002, 0x800051BF00200002, 0x800051C000200002, 0x800051C100200002, 0x800051C200200002,
+		0x800051C300200002, 0x800051C400200002, 0x800051C500200002, 0x800051C600200002, 0x800051C700200002, 0x800051C800200002, 0x800051C900200002, 0x800051CA00200002,
+		0x800051CB00200002, 0x800051CC00200002, 0x800051CD00200002, 0x800051CE00200002, 0x800051CF00200002, 0x800051D000200002, 0x800051D100200002, 0x800051D200200002,
+		0x800051D300200002, 0x800051D400200002, 0x800051D500200002, 0x800051D600200002, 0x800051D700200002, 0x800051D800200002, 0x800051D900200002, 0x800051DA00200002,
+		0x800051DB00200002, 0x800051DC00200002, 0x800051DD00200002, 0x800051DE00200002, 0x800051DF00200002, 0x800051E000200002, 0x800051E100200002, 0x800051E200200002,
+		0x800051E300200002, 0x800051E400200002, 0x800051E500200002, 0x800051E600200002, 0x800051E700200002, 0x800051E800200002, 0x800051E900200002, 0x800051EA00200002,
+		0x800051EB00200002, 0x800051EC00200002, 0x800051ED00200002, 0x800051EE00200002, 0x800051EF00200002, 0x800051F000200002, 0x800051F100200002, 0x800051F200200002,
+		0x800051F300200002, 0x800051F400200002, 0x800051F500200002, 0x800051F600200002, 0x800051F700200002, 0x800051F800200002, 0x800051F900200002, 0x800051FA00200002,
+		0x800051FB00200002, 0x800051FC00200002, 0x800051FD00200002, 0x800051FE00200002, 0x800051FF00200002, 0x8000520000200002, 0x8000520100200002, 0x8000520200200002,
+		0x8000520300200002, 0x8000520400200002, 0x8000520500200002, 0x8000520600200002, 0x8000520700200002, 0x8000520800200002, 0x8000520900200002, 0x8000520A00200002,
+		0x8000520B00200002, 0x8000520C00200002, 0x8000520D00200002, 0x8000520E00200002, 0x8000520F00200002, 0x8000521000200002, 0x8000521100200002, 0x8000521200200002,
+		0x8000521300200002, 0x8000521400200002, 0x8000521500200002, 0x8000521600200002, 0x8000521700200002, 0x8000521800200002, 0x8000521900200002, 0x8000521A00200002,
+		0x8000521B00200002, 0x8000521C00200002, 0x8000521D00200002, 0x8000521E00200002, 0x8000521F00200002, 0x8000522000200002, 0x8000522100200002, 0x8000522200200002,
+		0x8000522300200002, 0x8000522400200002, 0x8000522500200002, 0x8000522600200002, 0x8000522700200002, 0x8000522800200002, 0x8000522900200002, 0x8000522A00200002,
+		0x8000522B00200002, 0x8000522C00200002, 0x8000522D00200002, 0x8000522E00200002, 0x8000522F00200002, 0x8000523000200002, 0x8000523100200002, 0x8000523200200002,
+		0x8000523300200002, 0x8000523400200002, 0x8000523500200002, 0x8000523600200002, 0x8000523700200002, 0x8000523800200002, 0x8000523900200002, 0x8000523A00200002,
+		0x8000523B00200002, 0x8000523C00200002, 0x8000523D00200002, 0x8000523E00200002, 0x8000523F00200002, 0x8000524000200002, 0x8000524100200002, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000525D00200002, 0x8000525E00200002, 0x8000525F00200002, 0x8000526000200002, 0x8000526100200002, 0x8000526200200002, 0x8000526300200002, 0x8000526400200002,
+		0x8000526500200002, 0x8000526600200002, 0x8000526700200002, 0x8000526800200002, 0x8000526900200002, 0x8000526A00200002, 0x8000526B00200002, 0x8000526C00200002,
+		0x8000526D00200002, 0x8000526E00200002, 0x8000526F00200002, 0x8000527000200002, 0x8000527100200002, 0x8000527200200002, 0x8000527300200002, 0x8000527400200002,
+		0x8000527500200002, 0x8000527600200002, 0x8000527700200002, 0x8000527800200002, 0x8000527900200002, 0x8000527A00200002, 0x8000527B00200002, 0x8000527C00200002,
+		0x8000527D00200002, 0x8000527E00200002, 0x8000527F00200002, 0x8000528000200002, 0x8000528100200002, 0x8000528200200002, 0x8000528300200002, 0x8000528400200002,
+		0x8000528500200002, 0x8000528600200002, 0x8000528700200002, 0x8000528800200002, 0x8000528900200002, 0x8000528A00200002, 0x8000528B00200002, 0x8000528C00200002,
+		0x8000528D00200002, 0x8000528E00200002, 0x8000528F00200002, 0x8000529000200002, 0x8000529100200002, 0x8000529200200002, 0x8000529300200002, 0x8000529400200002,
+		0x8000529500200002, 0x8000529600200002, 0x8000529700200002, 0x8000529800200002, 0x8000529900200002, 0x8000529A00200002, 0x8000529B00200002, 0x8000529C00200002,
+		0x8000529D00200002, 0x8000529E00200002, 0x8000529F00200002, 0x800052A000200002, 0x800052A100200002, 0x800052A200200002, 0x800052A300200002, 0x800052A400200002,
+		0x800052A500200002, 0x800052A600200002, 0x800052A700200002, 0x800052A800200002, 0x800052A900200002, 0x800052AA00200002, 0x800052AB00200002, 0x800052AC00200002,
+		0x800052AD00200002, 0x800052AE00200002, 0x800052AF00200002, 0x800052B000200002, 0x800052B100200002, 0x800052B200200002, 0x800052B300200002, 0x800052B400200002,
+		0x800052B500200002, 0x800052B600200002, 0x800052B700200002, 0x800052B800200002, 0x800052B900200002, 0x800052BA00200002, 0x800052BB00200002, 0x800052BC00200002,
+		0x800052BD00200002, 0x800052BE00200002, 0x800052BF00200002, 0x800052C000200002, 0x800052C100200002, 0x800052C200200002, 0x800052C300200002, 0x800052C400200002,
+		0x800052C500200002, 0x800052C600200002, 0x800052C700200002, 0x800052C800200002, 0x800052C900200002, 0x800052CA00200002, 0x800052CB00200002, 0x800052CC00200002,
+		0x800052CD00200002, 0x800052CE00200002, 0x800052CF00200002, 0x800052D000200002, 0x800052D100200002, 0x800052D200200002, 0x800052D300200002, 0x800052D400200002,
+		0x800052D500200002, 0x800052D600200002, 0x800052D700200002, 0x800052D800200002, 0x800052D900200002, 0x800052DA00200002, 0x800052DB00200002, 0x800052DC00200002,
+		0x800052DD00200002, 0x800052DE00200002, 0x800052DF00200002, 0x800052E000200002, 0x800052E100200002, 0x800052E200200002, 0x800052E300200002, 0x800052E400200002,
+		0x800052E500200002, 0x800052E600200002, 0x800052E700200002, 0x800052E800200002, 0x800052E900200002, 0x800052EA00200002, 0x800052EB00200002, 0x800052EC00200002,
+		0x800052ED00200002, 0x800052EE00200002, 0x800052EF00200002, 0x800052F000200002, 0x800052F100200002, 0x800052F200200002, 0x800052F300200002, 0x800052F400200002,
+		0x800052F500200002, 0x800052F600200002, 0x800052F700200002, 0x800052F800200002, 0x800052F900200002, 0x800052FA00200002, 0x800052FB00200002, 0x800052FC00200002,
+		0x800052FD00200002, 0x800052FE00200002, 0x800052FF00200002, 0x8000530000200002, 0x8000530100200002, 0x8000530200200002, 0x8000530300200002, 0x8000530400200002,
+		0x8000530500200002, 0x8000530600200002, 0x8000530700200002, 0x8000530800200002, 0x8000530900200002, 0x8000530A00200002, 0x8000530B00200002, 0x8000530C00200002,
+		0x8000530D00200002, 0x8000530E00200002, 0x8000530F00200002, 0x8000531000200002, 0x8000531100200002, 0x8000531200200002, 0x8000531300200002, 0x8000531400200002,
+		0x8000531500200002, 0x8000531600200002, 0x8000531700200002, 0x8000531800200002, 0x8000531900200002, 0x8000531A00200002, 0x8000531B00200002, 0x8000531C00200002,
+		0x8000531D00200002, 0x8000531E00200002, 0x8000531F00200002, 0x8000532000200002, 0x8000532100200002, 0x8000532200200002, 0x8000532300200002, 0x8000532400200002,
+		0x8000532500200002, 0x8000532600200002, 0x8000532700200002, 0x8000532800200002, 0x8000532900200002, 0x8000532A00200002, 0x8000532B00200002, 0x8000532C00200002,
+		0x8000532D00200002, 0x8000532E00200002, 0x8000532F00200002, 0x8000533000200002, 0x8000533100200002, 0x8000533200200002, 0x8000533300200002, 0x8000533400200002,
+		0x8000533500200002, 0x8000533600200002, 0x8000533700200002, 0x8000533800200002, 0x8000533900200002, 0x8000533A00200002, 0x8000533B00200002, 0x8000533C00200002,
+		0x8000533D00200002, 0x8000533E00200002, 0x8000533F00200002, 0x8000534000200002, 0x8000534100200002, 0x8000534200200002, 0x8000534300200002, 0x8000534400200002,
+		0x8000534500200002, 0x8000534600200002, 0x8000534700200002, 0x8000534800200002, 0x8000534900200002, 0x8000534A00200002, 0x8000534B00200002, 0x8000534C00200002,
+		0x8000534D00200002, 0x8000534E00200002, 0x8000534F00200002, 0x8000535000200002, 0x8000535100200002, 0x8000535200200002, 0x8000535300200002, 0x8000535400200002,
+		0x8000535500200002, 0x8000535600200002, 0x8000535700200002, 0x8000535800200002, 0x8000535900200002, 0x8000535A00200002, 0x8000535B00200002, 0x8000535C00200002,
+		0x8000535D00200002, 0x8000535E00200002, 0x8000535F00200002, 0x8000536000200002, 0x8000536100200002, 0x8000536200200002, 0x8000536300200002, 0x8000536400200002,
+		0x8000536500200002, 0x8000536600200002, 0x8000536700200002, 0x8000536800200002, 0x8000536900200002, 0x8000536A00200002, 0x8000536B00200002, 0x8000536C00200002,
+		0x8000536D00200002, 0x8000536E00200002, 0x8000536F00200002, 0x8000537000200002, 0x8000537100200002, 0x8000537200200002, 0x8000537300200002, 0x8000537400200002,
+		0x8000537500200002, 0x8000537600200002, 0x8000537700200002, 0x8000537800200002, 0x8000537900200002, 0x8000537A00200002, 0x8000537B00200002, 0x8000537C00200002,
+		0x8000537D00200002, 0x8000537E00200002, 0x8000537F00200002, 0x8000538000200002, 0x8000538100200002, 0x8000538200200002, 0x8000538300200002, 0x8000538400200002,
+		0x8000538500200002, 0x8000538600200002, 0x8000538700200002, 0x8000538800200002, 0x8000538900200002, 0x8000538A00200002, 0x8000538B00200002, 0x8000538C00200002,
+		0x8000538D00200002, 0x8000538E00200002, 0x8000538F00200002, 0x8000539000200002, 0x8000539100200002, 0x8000539200200002, 0x8000539300200002, 0x8000539400200002,
+		0x8000539500200002, 0x8000539600200002, 0x8000539700200002, 0x8000539800200002, 0x8000539900200002, 0x8000539A00200002, 0x8000539B00200002, 0x8000539C00200002,
+		0x8000539D00200002, 0x8000539E00200002, 0x8000539F00200002, 0x800053A000200002, 0x800053A100200002, 0x800053A200200002, 0x800053A300200002, 0x800053A400200002,
+		0x800053A500200002, 0x800053A600200002, 0x800053A700200002, 0x800053A800200002, 0x800053A900200002, 0x800053AA00200002, 0x800053AB00200002, 0x800053AC00200002,
+		0x800053AD00200002, 0x800053AE00200002, 0x800053AF00200002, 0x800053B000200002, 0x800053B100200002, 0x800053B200200002, 0x800053B300200002, 0x800053B400200002,
+		0x800053B500200002, 0x800053B600200002, 0x800053B700200002, 0x800053B800200002, 0x800053B900200002, 0x800053BA00200002, 0x800053BB00200002, 0x800053BC00200002,
+		0x800053BD00200002, 0x800053BE00200002, 0x800053BF00200002, 0x800053C000200002, 0x800053C100200002, 0x800053C200200002, 0x800053C300200002, 0x800053C400200002,
+		0x800053C500200002, 0x800053C600200002, 0x800053C700200002, 0x800053C800200002, 0x800053C900200002, 0x800053CA00200002, 0x800053CB00200002, 0x800053CC00200002,
+		0x800053CD00200002, 0x800053CE00200002, 0x800053CF00200002, 0x800053D000200002, 0x800053D100200002, 0x800053D200200002, 0x800053D300200002, 0x800053D400200002,
+		0x800053D500200002, 0x800053D600200002, 0x800053D700200002, 0x800053D800200002, 0x800053D900200002, 0x800053DA00200002, 0x800053DB00200002, 0x800053DC00200002,
+		0x800053DD00200002, 0x800053DE00200002, 0x800053DF00200002, 0x800053E000200002, 0x800053E100200002, 0x800053E200200002, 0x800053E300200002, 0x800053E400200002,
+		0x800053E500200002, 0x800053E600200002, 0x800053E700200002, 0x800053E800200002, 0x800053E900200002, 0x800053EA00200002, 0x800053EB00200002, 0x800053EC00200002,
+		0x800053ED00200002, 0x800053EE00200002, 0x800053EF00200002, 0x800053F000200002, 0x800053F100200002, 0x800053F200200002, 0x800053F300200002, 0x800053F400200002,
+		0x800053F500200002, 0x800053F600200002, 0x800053F700200002, 0x800053F800200002, 0x800053F900200002, 0x800053FA00200002, 0x800053FB00200002, 0x800053FC00200002,
+		0x800053FD00200002, 0x800053FE00200002, 0x800053FF00200002, 0x8000540000200002, 0x8000540100200002, 0x8000540200200002, 0x8000540300200002, 0x8000540400200002,
+		0x8000540500200002, 0x8000540600200002, 0x8000540700200002, 0x8000540800200002, 0x8000540900200002, 0x8000540A00200002, 0x8000540B00200002, 0x8000540C00200002,
+		0x8000540D00200002, 0x8000540E00200002, 0x8000540F00200002, 0x8000541000200002, 0x8000541100200002, 0x8000541200200002, 0x8000541300200002, 0x8000541400200002,
+		0x8000541500200002, 0x8000541600200002, 0x8000541700200002, 0x8000541800200002, 0x8000541900200002, 0x8000541A00200002, 0x8000541B00200002, 0x8000541C00200002,
+		0x8000541D00200002, 0x8000541E00200002, 0x8000541F00200002, 0x8000542000200002, 0x8000542100200002, 0x8000542200200002, 0x8000542300200002, 0x8000542400200002,
+		0x8000542500200002, 0x8000542600200002, 0x8000542700200002, 0x8000542800200002, 0x8000542900200002, 0x8000542A00200002, 0x8000542B00200002, 0x8000542C00200002,
+		0x8000542D00200002, 0x8000542E00200002, 0x8000542F00200002, 0x8000543000200002, 0x8000543100200002, 0x8000543200200002, 0x8000543300200002, 0x8000543400200002,
+		0x8000543500200002, 0x8000543600200002, 0x8000543700200002, 0x8000543800200002, 0x8000543900200002, 0x8000543A00200002, 0x8000543B00200002, 0x8000543C00200002,
+		0x8000543D00200002, 0x8000543E00200002, 0x8000543F00200002, 0x8000544000200002, 0x8000544100200002, 0x8000544200200002, 0x8000544300200002, 0x8000544400200002,
+		0x8000544500200002, 0x8000544600200002, 0x8000544700200002, 0x8000544800200002, 0x8000544900200002, 0x8000544A00200002, 0x8000544B00200002, 0x8000544C00200002,
+		0x8000544D00200002, 0x8000544E00200002, 0x8000544F00200002, 0x8000545000200002, 0x8000545100200002, 0x8000545200200002, 0x8000545300200002, 0x8000545400200002,
+		0x8000545500200002, 0x8000545600200002, 0x8000545700200002, 0x8000545800200002, 0x8000545900200002, 0x8000545A00200002, 0x8000545B00200002, 0x8000545C00200002,
+		0x8000545D00200002, 0x8000545E00200002, 0x8000545F00200002, 0x8000546000200002, 0x8000546100200002, 0x8000546200200002, 0x8000546300200002, 0x8000546400200002,
+		0x8000546500200002, 0x8000546600200002, 0x8000546700200002, 0x8000546800200002, 0x8000546900200002, 0x8000546A00200002, 0x8000546B00200002, 0x8000546C00200002,
+		0x8000546D00200002, 0x8000546E00200002, 0x8000546F00200002, 0x8000547000200002, 0x8000547100200002, 0x8000547200200002, 0x8000547300200002, 0x8000547400200002,
+		0x8000547500200002, 0x8000547600200002, 0x8000547700200002, 0x8000547800200002, 0x8000547900200002, 0x8000547A00200002, 0x8000547B00200002, 0x8000547C00200002,
+		0x8000547D00200002, 0x8000547E00200002, 0x8000547F00200002, 0x8000548000200002, 0x8000548100200002, 0x8000548200200002, 0x8000548300200002, 0x8000548400200002,
+		0x8000548500200002, 0x8000548600200002, 0x8000548700200002, 0x8000548800200002, 0x8000548900200002, 0x8000548A00200002, 0x8000548B00200002, 0x8000548C00200002,
+		0x8000548D00200002, 0x8000548E00200002, 0x8000548F00200002, 0x8000549000200002, 0x8000549100200002, 0x8000549200200002, 0x8000549300200002, 0x8000549400200002,
+		0x8000549500200002, 0x8000549600200002, 0x8000549700200002, 0x8000549800200002, 0x8000549900200002, 0x8000549A00200002, 0x8000549B00200002, 0x8000549C00200002,
+		0x8000549D00200002, 0x8000549E00200002, 0x8000549F00200002, 0x800054A000200002, 0x800054A100200002, 0x800054A200200002, 0x800054A300200002, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800038B700200002, 0x800038B800200002, 0x800038B900200002, 0x800038BA00200002, 0x800038BB00200002, 0x800038BC00200002, 0x800038BD00200002, 0x800038BE00200002,
+		0x800038BF00200002, 0x800038C000200002, 0x800038C100200002, 0x800038C200200002, 0x800038C300200002, 0x800038C400200002, 0x800038C500200002, 0x800038C600200002,
+		0x800038C700200002, 0x800038C800200002, 0x800038C900200002, 0x800038CA00200002, 0x800038CB00200002, 0x800038CC00200002, 0x800038CD00200002, 0x800038CE00200002,
+		0x800038CF00200002, 0x800038D000200002, 0x800038D100200002, 0x800038D200200002, 0x800038D300200002, 0x800038D400200002, 0x800038D500200002, 0x800038D600200002,
+		0x800038D700200002, 0x800038D800200002, 0x800038D900200002, 0x800038DA00200002, 0x800038DB00200002, 0x800038DC00200002, 0x800038DD00200002, 0x800038DE00200002,
+		0x800038DF00200002, 0x800038E000200002, 0x800038E100200002, 0x800038E200200002, 0x800038E300200002, 0x800038E400200002, 0x800038E500200002, 0x800038E600200002,
+		0x800038E700200002, 0x800038E800200002, 0x800038E900200002, 0x800038EA00200002, 0x800038EB00200002, 0x800038EC00200002, 0x800038ED00200002, 0x800038EE00200002,
+		0x800038EF00200002, 0x800038F000200002, 0x800038F100200002, 0x800038F200200002, 0x800038F300200002, 0x800038F400200002, 0x800038F500200002, 0x800038F600200002,
+		0x800038F700200002, 0x800038F800200002, 0x800038F900200002, 0x800038FA00200002, 0x800038FB00200002, 0x800038FC00200002, 0x800038FD00200002, 0x800038FE00200002,
+		0x800038FF00200002, 0x8000390000200002, 0x8000390100200002, 0x8000390200200002, 0x8000390300200002, 0x8000390400200002, 0x8000390500200002, 0x8000390600200002,
+		0x8000390700200002, 0x8000390800200002, 0x8000390900200002, 0x8000390A00200002, 0x8000390B00200002, 0x8000390C00200002, 0x8000390D00200002, 0x8000390E00200002,
+		0x8000390F00200002, 0x8000391000200002, 0x8000391100200002, 0x8000391200200002, 0x8000391300200002, 0x8000391400200002, 0x8000391500200002, 0x8000391600200002,
+		0x8000391700200002, 0x8000391800200002, 0x8000391900200002, 0x8000391A00200002, 0x8000391B00200002, 0x8000391C00200002, 0x8000391D00200002, 0x8000391E00200002,
+		0x8000391F00200002, 0x8000392000200002, 0x8000392100200002, 0x8000392200200002, 0x8000392300200002, 0x8000392400200002, 0x8000392500200002, 0x8000392600200002,
+		0x8000392700200002, 0x8000392800200002, 0x8000392900200002, 0x8000392A00200002, 0x8000392B00200002, 0x8000392C00200002, 0x8000392D00200002, 0x8000392E00200002,
+		0x8000392F00200002, 0x8000393000200002, 0x8000393100200002, 0x8000393200200002, 0x8000393300200002, 0x8000393400200002, 0x8000393500200002, 0x8000393600200002,
+		0x8000393700200002, 0x8000393800200002, 0x8000393900200002, 0x8000393A00200002, 0x8000393B00200002, 0x8000393C00200002, 0x8000393D00200002, 0x8000393E00200002,
+		0x8000393F00200002, 0x8000394000200002, 0x8000394100200002, 0x8000394200200002, 0x8000394300200002, 0x8000394400200002, 0x8000394500200002, 0x8000394600200002,
+		0x8000394700200002, 0x8000394800200002, 0x8000394900200002, 0x8000394A00200002, 0x8000394B00200002, 0x8000394C00200002, 0x8000394D00200002, 0x8000394E00200002,
+		0x8000394F00200002, 0x8000395000200002, 0x8000395100200002, 0x8000395200200002, 0x8000395300200002, 0x8000395400200002, 0x8000395500200002, 0x8000395600200002,
+		0x8000395700200002, 0x8000395800200002, 0x8000395900200002, 0x8000395A00200002, 0x8000395B00200002, 0x8000395C00200002, 0x8000395D00200002, 0x8000395E00200002,
+		0x8000395F00200002, 0x8000396000200002, 0x8000396100200002, 0x8000396200200002, 0x8000396300200002, 0x8000396400200002, 0x8000396500200002, 0x8000396600200002,
+		0x8000396700200002, 0x8000396800200002, 0x8000396900200002, 0x8000396A00200002, 0x8000396B00200002, 0x8000396C00200002, 0x8000396D00200002, 0x8000396E00200002,
+		0x8000396F00200002, 0x8000397000200002, 0x8000397100200002, 0x8000397200200002, 0x8000397300200002, 0x8000397400200002, 0x8000397500200002, 0x8000397600200002,
+		0x8000397700200002, 0x8000397800200002, 0x8000397900200002, 0x8000397A00200002, 0x8000397B00200002, 0x8000397C00200002, 0x8000397D00200002, 0x8000397E00200002,
+		0x8000397F00200002, 0x8000398000200002, 0x8000398100200002, 0x8000398200200002, 0x8000398300200002, 0x8000398400200002, 0x8000398500200002, 0x8000398600200002,
+		0x8000398700200002, 0x8000398800200002, 0x8000398900200002, 0x8000398A00200002, 0x8000398B00200002, 0x8000398C00200002, 0x8000398D00200002, 0x8000398E00200002,
+		0x8000398F00200002, 0x8000399000200002, 0x8000399100200002, 0x8000399200200002, 0x8000399300200002, 0x8000399400200002, 0x8000399500200002, 0x8000399600200002,
+		0x8000399700200002, 0x8000399800200002, 0x8000399900200002, 0x8000399A00200002, 0x8000399B00200002, 0x8000399C00200002, 0x8000399D00200002, 0x8000399E00200002,
+		0x8000399F00200002, 0x800039A000200002, 0x800039A100200002, 0x800039A200200002, 0x800039A300200002, 0x800039A400200002, 0x800039A500200002, 0x800039A600200002,
+		0x800039A700200002, 0x800039A800200002, 0x800039A900200002, 0x800039AA00200002, 0x800039AB00200002, 0x800039AC00200002, 0x800039AD00200002, 0x800039AE00200002,
+		0x800039AF00200002, 0x800039B000200002, 0x800039B100200002, 0x800039B200200002, 0x800039B300200002, 0x800039B400200002, 0x800039B500200002, 0x800039B600200002,
+		0x800039B700200002, 0x800039B800200002, 0x800039B900200002, 0x800039BA00200002, 0x800039BB00200002, 0x800039BC00200002, 0x800039BD00200002, 0x800039BE00200002,
+		0x800039BF00200002, 0x800039C000200002, 0x800039C100200002, 0x800039C200200002, 0x800039C300200002, 0x800039C400200002, 0x800039C500200002, 0x800039C600200002,
+		0x800039C700200002, 0x800039C800200002, 0x800039C900200002, 0x800039CA00200002, 0x800039CB00200002, 0x800039CC00200002, 0x800039CD00200002, 0x800039CE00200002,
+		0x800039CF00200002, 0x800039D000200002, 0x800039D100200002, 0x800039D200200002, 0x800039D300200002, 0x800039D400200002, 0x800039D500200002, 0x800039D600200002,
+		0x800039D700200002, 0x800039D800200002, 0x800039D900200002, 0x800039DA00200002, 0x800039DB00200002, 0x800039DC00200002, 0x800039DD00200002, 0x800039DE00200002,
+		0x800039DF00200002, 0x800039E000200002, 0x800039E100200002, 0x800039E200200002, 0x800039E300200002, 0x800039E400200002, 0x800039E500200002, 0x800039E600200002,
+		0x800039E700200002, 0x800039E800200002, 0x800039E900200002, 0x800039EA00200002, 0x800039EB00200002, 0x800039EC00200002, 0x800039ED00200002, 0x800039EE00200002,
+		0x800039EF00200002, 0x800039F000200002, 0x800039F100200002, 0x800039F200200002, 0x800039F300200002, 0x800039F400200002, 0x800039F500200002, 0x800039F600200002,
+		0x800039F700200002, 0x800039F800200002, 0x800039F900200002, 0x800039FA00200002, 0x800039FB00200002, 0x800039FC00200002, 0x800039FD00200002, 0x800039FE00200002,
+		0x800039FF00200002, 0x80003A0000200002, 0x80003A0100200002, 0x80003A0200200002, 0x80003A0300200002, 0x80003A0400200002, 0x80003A0500200002, 0x80003A0600200002,
+		0x80003A0700200002, 0x80003A0800200002, 0x80003A0900200002, 0x80003A0A00200002, 0x80003A0B00200002, 0x80003A0C00200002, 0x80003A0D00200002, 0x80003A0E00200002,
+		0x80003A0F00200002, 0x80003A1000200002, 0x80003A1100200002, 0x80003A1200200002, 0x80003A1300200002, 0x80003A1400200002, 0x80003A1500200002, 0x80003A1600200002,
+		0x80003A1700200002, 0x80003A1800200002, 0x80003A1900200002, 0x80003A1A00200002, 0x80003A1B00200002, 0x80003A1C00200002, 0x80003A1D00200002, 0x80003A1E00200002,
+		0x80003A1F00200002, 0x80003A2000200002, 0x80003A2100200002, 0x80003A2200200002, 0x80003A2300200002, 0x80003A2400200002, 0x80003A2500200002, 0x80003A2600200002,
+		0x80003A2700200002, 0x80003A2800200002, 0x80003A2900200002, 0x80003A2A00200002, 0x80003A2B00200002, 0x80003A2C00200002, 0x80003A2D00200002, 0x80003A2E00200002,
+		0x80003A2F00200002, 0x80003A3000200002, 0x80003A3100200002, 0x80003A3200200002, 0x80003A3300200002, 0x80003A3400200002, 0x80003A3500200002, 0x80003A3600200002,
+		0x80003A3700200002, 0x80003A3800200002, 0x80003A3900200002, 0x80003A3A00200002, 0x80003A3B00200002, 0x80003A3C00200002, 0x80003A3D00200002, 0x80003A3E00200002,
+		0x80003A3F00200002, 0x80003A4000200002, 0x80003A4100200002, 0x80003A4200200002, 0x80003A4300200002, 0x80003A4400200002, 0x80003A4500200002, 0x80003A4600200002,
+		0x80003A4700200002, 0x80003A4800200002, 0x80003A4900200002, 0x80003A4A00200002, 0x80003A4B00200002, 0x80003A4C00200002, 0x80003A4D00200002, 0x80003A4E00200002,
+		0x80003A4F00200002, 0x80003A5000200002, 0x80003A5100200002, 0x80003A5200200002, 0x80003A5300200002, 0x80003A5400200002, 0x80003A5500200002, 0x80003A5600200002,
+		0x80003A5700200002, 0x80003A5800200002, 0x80003A5900200002, 0x80003A5A00200002, 0x80003A5B00200002, 0x80003A5C00200002, 0x80003A5D00200002, 0x80003A5E00200002,
+		0x80003A5F00200002, 0x80003A6000200002, 0x80003A6100200002, 0x80003A6200200002, 0x80003A6300200002, 0x80003A6400200002, 0x80003A6500200002, 0x80003A6600200002,
+		0x80003A6700200002, 0x80003A6800200002, 0x80003A6900200002, 0x80003A6A00200002, 0x80003A6B00200002, 0x80003A6C00200002, 0x80003A6D00200002, 0x80003A6E00200002,
+		0x80003A6F00200002, 0x80003A7000200002, 0x80003A7100200002, 0x80003A7200200002, 0x80003A7300200002, 0x80003A7400200002, 0x80003A7500200002, 0x80003A7600200002,
+		0x80003A7700200002, 0x80003A7800200002, 0x80003A7900200002, 0x80003A7A00200002, 0x80003A7B00200002, 0x80003A7C00200002, 0x80003A7D00200002, 0x80003A7E00200002,
+		0x80003A7F00200002, 0x80003A8000200002, 0x80003A8100200002, 0x80003A8200200002, 0x80003A8300200002, 0x80003A8400200002, 0x80003A8500200002, 0x80003A8600200002,
+		0x80003A8700200002, 0x80003A8800200002, 0x80003A8900200002, 0x80003A8A00200002, 0x80003A8B00200002, 0x80003A8C00200002, 0x80003A8D00200002, 0x80003A8E00200002,
+		0x80003A8F00200002, 0x80003A9000200002, 0x80003A9100200002, 0x80003A9200200002, 0x80003A9300200002, 0x80003A9400200002, 0x80003A9500200002, 0x80003A9600200002,
+		0x80003A9700200002, 0x80003A9800200002, 0x80003A9900200002, 0x80003A9A00200002, 0x80003A9B00200002, 0x80003A9C00200002, 0x80003A9D00200002, 0x80003A9E00200002,
+		0x80003A9F00200002, 0x80003AA000200002, 0x80003AA100200002, 0x80003AA200200002, 0x80003AA300200002, 0x80003AA400200002, 0x80003AA500200002, 0x80003AA600200002,
+		0x80003AA700200002, 0x80003AA800200002, 0x80003AA900200002, 0x80003AAA00200002, 0x80003AAB00200002, 0x80003AAC00200002, 0x80003AAD00200002, 0x80003AAE00200002,
+		0x80003AAF00200002, 0x80003AB000200002, 0x80003AB100200002, 0x80003AB200200002, 0x80003AB300200002, 0x80003AB400200002, 0x80003AB500200002, 0x80003AB600200002,
+		0x80003AB700200002, 0x80003AB800200002, 0x80003AB900200002, 0x80003ABA00200002, 0x80003ABB00200002, 0x80003ABC00200002, 0x80003ABD00200002, 0x80003ABE00200002,
+		0x80003ABF00200002, 0x80003AC000200002, 0x80003AC100200002, 0x80003AC200200002, 0x80003AC300200002, 0x80003AC400200002, 0x80003AC500200002, 0x80003AC600200002,
+		0x80003AC700200002, 0x80003AC800200002, 0x80003AC900200002, 0x80003ACA00200002, 0x80003ACB00200002, 0x80003ACC00200002, 0x80003ACD00200002, 0x80003ACE00200002,
+		0x80003ACF00200002, 0x80003AD000200002, 0x80003AD100200002, 0x80003AD200200002, 0x80003AD300200002, 0x80003AD400200002, 0x80003AD500200002, 0x80003AD600200002,
+		0x80003AD700200002, 0x80003AD800200002, 0x80003AD900200002, 0x80003ADA00200002, 0x80003ADB00200002, 0x80003ADC00200002, 0x80003ADD00200002, 0x80003ADE00200002,
+		0x80003ADF00200002, 0x80003AE000200002, 0x80003AE100200002, 0x80003AE200200002, 0x80003AE300200002, 0x80003AE400200002, 0x80003AE500200002, 0x80003AE600200002,
+		0x80003AE700200002, 0x80003AE800200002, 0x80003AE900200002, 0x80003AEA00200002, 0x80003AEB00200002, 0x80003AEC00200002, 0x80003AED00200002, 0x80003AEE00200002,
+		0x80003AEF00200002, 0, 0, 0, 0, 0, 0, 0,
+		0x800045C800200002, 0x800045C900200002, 0x800045CA00200002, 0x800045CB00200002, 0x800045CC00200002, 0x800045CD00200002, 0x800045CE00200002, 0x800045CF00200002,
+		0x800045D000200002, 0x800045D100200002, 0x800045D200200002, 0x800045D300200002, 0x800045D400200002, 0x800045D500200002, 0x800045D600200002, 0x800045D700200002,
+		0x800045D800200002, 0x800045D900200002, 0x800045DA00200002, 0x800045DB00200002, 0x800045DC00200002, 0x800045DD00200002, 0x800045DE00200002, 0x800045DF00200002,
+		0x800045E000200002, 0x800045E100200002, 0x800045E200200002, 0x800045E300200002, 0x800045E400200002, 0x800045E500200002, 0x800045E600200002, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0, 0, 0, 0, 0x800002C000200002, 0x800002C100200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80003AF000200002, 0x80003AF100200002, 0x80003AF200200002, 0x80003AF300200002, 0x80003AF400200002, 0x80003AF500200002, 0x80003AF600200002, 0x80003AF700200002,
+		0x80003AF800200002, 0x80003AF900200002, 0x80003AFA00200002, 0x80003AFB00200002, 0x80003AFC00200002, 0x80003AFD00200002, 0x80003AFE00200002, 0x80003AFF00200002,
+		0x80003B0000200002, 0x80003B0100200002, 0x80003B0200200002, 0x80003B0300200002, 0x80003B0400200002, 0x80003B0500200002, 0x80003B0600200002, 0x80003B0700200002,
+		0x80003B0800200002, 0x80003B0900200002, 0x80003B0A00200002, 0x80003B0B00200002, 0x80003B0C00200002, 0x80003B0D00200002, 0, 0,
+		0x8000000000B80002, 0x8000000000B90002, 0x8000000000BA0002, 0x8000000000BB0002, 0x8000000000BC0002, 0x8000028800200002, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000435500200002, 0x8000435600200002, 0x8000435700200002, 0x8000435800200002, 0x8000435900200002, 0x8000435A00200002, 0x8000435B00200002, 0x8000435C00200002,
+		0x8000435D00200002, 0x8000435E00200002, 0x8000435F00200002, 0x8000436000200002, 0x8000436100200002, 0x8000436200200002, 0x8000436300200002, 0x8000436400200002,
+		0x8000436500200002, 0x8000436600200002, 0x8000436700200002, 0x8000436800200002, 0x8000436900200002, 0x8000436A00200002, 0x8000436B00200002, 0x8000436C00200002,
+		0x8000436D00200002, 0x8000436E00200002, 0x8000436F00200002, 0x8000437000200002, 0x8000437100200002, 0x8000437200200002, 0x8000437300200002, 0x8000437400200002,
+		0x8000437500200002, 0x8000437600200002, 0x8000437700200002, 0x8000437800200002, 0x8000437900200002, 0x8000437A00200002, 0x8000437B00200002, 0x8000437C00200002,
+		0x8000437D00200002, 0x8000437E00200002, 0x8000437F00200002, 0x8000438000200002, 0x8000438100200002, 0x8000438200200002, 0x8000438300200002, 0x8000438400200002,
+		0x8000000000F40002, 0x8000000000F50002, 0x8000000000F60002, 0x8000000000F70002, 0x8000000000F80002, 0x8000000000F90002, 0x8000000000FA0002, 0x8000047700200002,
+		0x8000047800200002, 0x8000047900200002, 0x8000047A00200002, 0x8000047B00200002, 0x80000FD100200002, 0x80000FD200200002, 0x80000FD300200002, 0x80000FD400200002,
+		0x8000438500200002, 0x8000438600200002, 0x80001C0500200002, 0x80001C0600200002, 0x8000047C00200002, 0x80000FD500200002, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0, 0x80001BA000200002, 0x80001BA100200002, 0x80001BA200200002, 0x80001BA300200002, 0x80001BA400200002,
+		0x80001BA500200002, 0x80001BA600200002, 0, 0x8000438700200002, 0x8000438800200002, 0x8000438900200002, 0x8000438A00200002, 0x8000438B00200002,
+		0x8000438C00200002, 0x8000438D00200002, 0x8000438E00200002, 0x8000438F00200002, 0x8000439000200002, 0x8000439100200002, 0x8000439200200002, 0x8000439300200002,
+		0x8000439400200002, 0x8000439500200002, 0x8000439600200002, 0x8000439700200002, 0x8000439800200002, 0x8000439900200002, 0x8000439A00200002, 0x8000439B00200002,
+		0, 0, 0, 0, 0, 0x8000439C00200002, 0x8000439D00200002, 0x8000439E00200002,
+		0x8000439F00200002, 0x800043A000200002, 0x800043A100200002, 0x800043A200200002, 0x800043A300200002, 0x800043A400200002, 0x800043A500200002, 0x800043A600200002,
+		0x800043A700200002, 0x800043A800200002, 0x800043A900200002, 0x800043AA00200002, 0x800043AB00200002, 0x800043AC00200002, 0x800043AD00200002, 0x800043AE00200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000427A00200002, 0x8000427B00200002, 0x8000427C00200002, 0x8000427D00200002, 0x8000427E00200002, 0x8000427F00200002, 0x8000427E00200004, 0x8000428000200002,
+		0x8000428100200002, 0x8000428200200002, 0x8000428300200002, 0x8000428400200002, 0x8000428500200002, 0x8000428600200002, 0x8000428700200002, 0x8000428800200002,
+		0x8000428900200002, 0x8000428A00200002, 0x8000428B00200002, 0x8000428900200004, 0x8000428C00200002, 0x8000428D00200002, 0x8000428E00200002, 0x8000428F00200002,
+		0x8000429000200002, 0x8000429100200002, 0x8000429200200002, 0x8000429300200002, 0x8000429400200002, 0x8000429500200002, 0x8000429600200002, 0x8000429700200002,
+		0x8000429800200002, 0x8000429900200002, 0x8000429A00200002, 0x8000429B00200002, 0x8000429C00200002, 0x8000429B00200004, 0x8000429D00200002, 0x8000429E00200002,
+		0x8000429F00200002, 0x800042A000200002, 0x800042A100200002, 0x800042A200200002, 0x800042A300200002, 0x800042A400200002, 0x800042A500200002, 0x800042A600200002,
+		0x800042A700200002, 0x800042A800200002, 0x800042A900200002, 0x800042AA00200002, 0x800042AB00200002, 0x800042AC00200002, 0x800042AD00200002, 0x800042AE00200002,
+		0x800042AF00200002, 0x800042B000200002, 0x800042B100200002, 0x800042B200200002, 0x800042B300200002, 0x800042B400200002, 0x800042B500200002, 0x800042B400200004,
+		0x800042B600200002, 0x800042B700200002, 0x800042B800200002, 0x800042B900200002, 0x800042BA00200002, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800042BB00200002, 0x800042BC00200002, 0x800042BD00200002, 0x800042BE00200002, 0x800042BF00200002, 0x800042C000200002, 0x800042C100200002, 0x800042C200200002,
+		0x800042C300200002, 0x800042C400200002, 0x800042C500200002, 0x800042C600200002, 0x800042C700200002, 0x800042C800200002, 0x800042C900200002, 0x800042CA00200002,
+		0x800042CB00200002, 0x800042CC00200002, 0x800042CD00200002, 0x800042CE00200002, 0x800042CF00200002, 0x800042D000200002, 0x800042D100200002, 0x800042D200200002,
+		0x800042D300200002, 0x800042D400200002, 0x800042D500200002, 0x800042D600200002, 0x800042D700200002, 0x800042D800200002, 0x800042D900200002, 0x800042DA00200002,
+		0x800042DB00200002, 0x800042DC00200002, 0x800042DD00200002, 0x800042DE00200002, 0x800042DF00200002, 0x800042E000200002, 0x800042E100200002, 0x800042E200200002,
+		0x800042E300200002, 0x800042E400200002, 0x800042E500200002, 0x800042E600200002, 0x800042E700200002, 0x800042E800200002, 0x800042E900200002, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0x800042EA00200002,
+		0x800042EB00200002, 0x800042EC00200002, 0x800042ED00200002, 0x800042EE00200002, 0x800042EF00200002, 0x800042F000200002, 0x800042F100200002, 0x800042F200200002,
+		0x800042F300200002, 0x800042F400200002, 0x800042F500200002, 0x800042F600200002, 0x800042F700200002, 0x800042F800200002, 0x800042F900200002, 0x800042FA00200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80001C0900200002, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80003D5D00200011, 0x80003D800020000E, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800044AA00200002, 0x800044AB00200002, 0x800044AC00200002, 0x800044AD00200002, 0x800044AE00200002, 0x800044AF00200002, 0x800044B000200002, 0x800044B100200002,
+		0x800044B200200002, 0x800044B300200002, 0x800044B400200002, 0x800044B500200002, 0x800044B600200002, 0x800044B700200002, 0x800044B800200002, 0x800044B900200002,
+		0x800044BA00200002, 0x800044BB00200002, 0x800044BC00200002, 0x800044BD00200002, 0x800044BE00200002, 0x800044BF00200002, 0x800044C000200002, 0x800044C100200002,
+		0x800044C200200002, 0x800044C300200002, 0x800044C400200002, 0x800044C500200002, 0x800044C600200002, 0x800044C700200002, 0x800044C800200002, 0x800044C900200002,
+		0x800044CA00200002, 0x800044CB00200002, 0x800044CC00200002, 0x800044CD00200002, 0x800044CE00200002, 0x800044CF00200002, 0x800044D000200002, 0x800044D100200002,
+		0x800044D200200002, 0x800044D300200002, 0x800044D400200002, 0x800044D500200002, 0x800044D600200002, 0x800044D700200002, 0x800044D800200002, 0x800044D900200002,
+		0x800044DA00200002, 0x800044DB00200002, 0x800044DC00200002, 0x800044DD00200002, 0x800044DE00200002, 0x800044DF00200002, 0x800044E000200002, 0x800044E100200002,
+		0x800044E200200002, 0x800044E300200002, 0x800044E400200002, 0x800044E500200002, 0x800044E600200002, 0x800044E700200002, 0x800044E800200002, 0x800044E900200002,
+		0x800044EA00200002, 0x800044EB00200002, 0x800044EC00200002, 0x800044ED00200002, 0x800044EE00200002, 0x800044EF00200002, 0x800044F000200002, 0x800044F100200002,
+		0x800044F200200002, 0x800044F300200002, 0x800044F400200002, 0x800044F500200002, 0x800044F600200002, 0x800044F700200002, 0x800044F800200002, 0x800044F900200002,
+		0x800044FA00200002, 0x800044FB00200002, 0x800044FC00200002, 0x800044FD00200002, 0x800044FE00200002, 0x800044FF00200002, 0x8000450000200002, 0x8000450100200002,
+		0x8000450200200002, 0x8000450300200002, 0x8000450400200002, 0x8000450500200002, 0x8000450600200002, 0x8000450700200002, 0x8000450800200002, 0x8000450900200002,
+		0x8000450A00200002, 0x8000450B00200002, 0x8000450C00200002, 0x8000450D00200002, 0x8000450E00200002, 0x8000450F00200002, 0x8000451000200002, 0x8000451100200002,
+		0x8000451200200002, 0x8000451300200002, 0x8000451400200002, 0, 0, 0, 0, 0,
+		0x8000451500200002, 0x8000451600200002, 0x8000451700200002, 0x8000451800200002, 0x8000451900200002, 0x8000451A00200002, 0x8000451B00200002, 0x8000451C00200002,
+		0x8000451D00200002, 0x8000451E00200002, 0x8000451F00200002, 0x8000452000200002, 0x8000452100200002, 0, 0, 0,
+		0x8000452200200002, 0x8000452300200002, 0x8000452400200002, 0x8000452500200002, 0x8000452600200002, 0x8000452700200002, 0x8000452800200002, 0x8000452900200002,
+		0x8000452A00200002, 0, 0, 0, 0, 0, 0, 0,
+		0x8000452B00200002, 0x8000452C00200002, 0x8000452D00200002, 0x8000452E00200002, 0x8000452F00200002, 0x8000453000200002, 0x8000453100200002, 0x8000453200200002,
+		0x8000453300200002, 0x8000453400200002, 0, 0, 0x800011C600200002, 0x8000000000330002, 0x8000000000350002, 0x8000028900200002,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80000FD600200002, 0x80000FD700200002, 0x80000FD800200002, 0x80000FD900200002, 0x80000FDA00200002, 0x80000FDB00200002, 0x80000FDC00200002, 0x80000FDD00200002,
+		0x80000FDE00200002, 0x80000FDF00200002, 0x80000FE000200002, 0x80000FE100200002, 0x80000FE200200002, 0x80000FE300200002, 0x80000FE400200002, 0x80000FE500200002,
+		0x80000FE600200002, 0x80000FE700200002, 0x80000FE800200002, 0x80000FE900200002, 0x80000FEA00200002, 0x80000FEB00200002, 0x80000FEC00200002, 0x80000FED00200002,
+		0x80000FEE00200002, 0x80000FEF00200002, 0x80000FF000200002, 0x80000FF100200002, 0x80000FF200200002, 0x80000FF300200002, 0x80000FF400200002, 0x80000FF500200002,
+		0x80000FF600200002, 0x80000FF700200002, 0x80000FF800200002, 0x80000FF900200002, 0x80000FFA00200002, 0x80000FFB00200002, 0x80000FFC00200002, 0x80000FFD00200002,
+		0x80000FFE00200002, 0x80000FFF00200002, 0x8000100000200002, 0x8000100100200002, 0x8000100200200002, 0x8000100300200002, 0x8000100400200002, 0x8000100500200002,
+		0x8000100600200002, 0x8000100700200002, 0x8000100800200002, 0x8000100900200002, 0x8000100A00200002, 0x8000100B00200002, 0x8000100C00200002, 0x8000100D00200002,
+		0x8000100E00200002, 0x8000100F00200002, 0x8000101000200002, 0x8000101100200002, 0x8000101200200002, 0x8000101300200002, 0x8000101400200002, 0x8000101500200002,
+		0x8000101600200002, 0x8000101700200002, 0x8000101800200002, 0x8000101900200002, 0x8000101A00200002, 0x8000101B00200002, 0x8000101C00200002, 0x8000101D00200002,
+		0x8000101E00200002, 0x8000101F00200002, 0x8000102000200002, 0x8000102100200002, 0x8000102200200002, 0x8000102300200002, 0x8000102400200002, 0x8000102500200002,
+		0x8000102600200002, 0x8000102700200002, 0x8000102800200002, 0x8000102900200002, 0x8000102A00200002, 0x8000102B00200002, 0x8000102C00200002, 0x8000102D00200002,
+		0x8000102E00200002, 0x8000102F00200002, 0x8000103000200002, 0x8000103100200002, 0x8000103200200002, 0x8000103300200002, 0x8000103400200002, 0x8000103500200002,
+		0x8000103600200002, 0x8000103700200002, 0x8000103800200002, 0x8000103900200002, 0x8000103A00200002, 0x8000103B00200002, 0x8000103C00200002, 0x8000103D00200002,
+		0x8000103E00200002, 0x8000103F00200002, 0x8000104000200002, 0x8000104100200002, 0x8000104200200002, 0x8000104300200002, 0x8000104400200002, 0x8000104500200002,
+		0x8000104600200002, 0x8000104700200002, 0x8000104800200002, 0x8000104900200002, 0x8000104A00200002, 0x8000104B00200002, 0x8000104C00200002, 0x8000104D00200002,
+		0x8000104E00200002, 0x8000104F00200002, 0x8000105000200002, 0x8000105100200002, 0x8000105200200002, 0x8000105300200002, 0x8000105400200002, 0x8000105500200002,
+		0x8000105600200002, 0x8000105700200002, 0x8000105800200002, 0x8000105900200002, 0x8000105A00200002, 0x8000105B00200002, 0x8000105C00200002, 0x8000105D00200002,
+		0x8000105E00200002, 0x8000105F00200002, 0x8000106000200002, 0x8000106100200002, 0x8000106200200002, 0x8000106300200002, 0x8000106400200002, 0x8000106500200002,
+		0x8000106600200002, 0x8000106700200002, 0x8000106800200002, 0x8000106900200002, 0x8000106A00200002, 0x8000106B00200002, 0x8000106C00200002, 0x8000106D00200002,
+		0x8000106E00200002, 0x8000106F00200002, 0x8000107000200002, 0x8000107100200002, 0x8000107200200002, 0x8000107300200002, 0x8000107400200002, 0x8000107500200002,
+		0x8000107600200002, 0x8000107700200002, 0x8000107800200002, 0x8000107900200002, 0x8000107A00200002, 0x8000107B00200002, 0x8000107C00200002, 0x8000107D00200002,
+		0x8000107E00200002, 0x8000107F00200002, 0x8000108000200002, 0x8000108100200002, 0x8000108200200002, 0x8000108300200002, 0x8000108400200002, 0x8000108500200002,
+		0x8000108600200002, 0x8000108700200002, 0x8000108800200002, 0x8000108900200002, 0x8000108A00200002, 0x8000108B00200002, 0x8000108C00200002, 0x8000108D00200002,
+		0x8000108E00200002, 0x8000108F00200002, 0x8000109000200002, 0x8000109100200002, 0x8000109200200002, 0x8000109300200002, 0x8000109400200002, 0x8000109500200002,
+		0x8000109600200002, 0x8000109700200002, 0x8000109800200002, 0x8000109900200002, 0x8000109A00200002, 0x8000109B00200002, 0x8000109C00200002, 0x8000109D00200002,
+		0x8000109E00200002, 0x8000109F00200002, 0x800010A000200002, 0x800010A100200002, 0x800010A200200002, 0x800010A300200002, 0x800010A400200002, 0x800010A500200002,
+		0x800010A600200002, 0x800010A700200002, 0x800010A800200002, 0x800010A900200002, 0x800010AA00200002, 0x800010AB00200002, 0x800010AC00200002, 0x800010AD00200002,
+		0x800010AE00200002, 0x800010AF00200002, 0x800010B000200002, 0x800010B100200002, 0x800010B200200002, 0x800010B300200002, 0x800010B400200002, 0x800010B500200002,
+		0x800010B600200002, 0x800010B700200002, 0x800010B800200002, 0x800010B900200002, 0x800010BA00200002, 0x800010BB00200002, 0x800010BC00200002, 0x800010BD00200002,
+		0x800010BE00200002, 0x800010BF00200002, 0x800010C000200002, 0x800010C100200002, 0x800010C200200002, 0x800010C300200002, 0x800010C400200002, 0x800010C500200002,
+		0x800010C600200002, 0x800010C700200002, 0x800010C800200002, 0x800010C900200002, 0x800010CA00200002, 0x800010CB00200002, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800010CC00200002, 0x800010CD00200002, 0x800010CE00200002, 0x800010CF00200002, 0x800010D000200002, 0x800010D100200002, 0x800010D200200002, 0x800010D300200002,
+		0x800010D400200002, 0x800010D500200002, 0x800010D600200002, 0x800010D700200002, 0x800010D800200002, 0x800010D900200002, 0x800010DA00200002, 0x800010DB00200002,
+		0x800010DC00200002, 0x800010DD00200002, 0x800010DE00200002, 0x800010DF00200002, 0x800010E000200002, 0x800010E100200002, 0x800010E200200002, 0x800010E300200002,
+		0x800010E400200002, 0x800010E500200002, 0x800010E600200002, 0x800010E700200002, 0x800010E800200002, 0x800010E900200002, 0x800010EA00200002, 0x800010EB00200002,
+		0x800010EC00200002, 0x800010ED00200002, 0x800010EE00200002, 0x800010EF00200002, 0x800010F000200002, 0x800010F100200002, 0x800010F200200002, 0,
+		0, 0x8000110600200002, 0x800010F600200002, 0x800010F700200002, 0x800010F800200002, 0x800010F900200002, 0x800010FA00200002, 0x800010FB00200002,
+		0x800010FC00200002, 0x800010FD00200002, 0x800010FE00200002, 0x800010FF00200002, 0x8000110000200002, 0x8000110100200002, 0x8000110200200002, 0x8000110300200002,
+		0x8000110400200002, 0x8000110500200002, 0x8000110700200002, 0x8000110800200002, 0x8000110900200002, 0x8000110A00200002, 0x8000110B00200002, 0x8000110C00200002,
+		0x8000110D00200002, 0x8000110E00200002, 0x8000110F00200002, 0x8000111000200002, 0x8000111100200002, 0x8000111200200002, 0x8000111300200002, 0x8000111400200002,
+		0x8000111500200002, 0x8000111600200002, 0x8000111700200002, 0x8000111800200002, 0x8000111900200002, 0x8000111A00200002, 0x8000111B00200002, 0x8000111C00200002,
+		0x8000111D00200002, 0x8000111E00200002, 0x8000111F00200002, 0x8000112000200002, 0x8000112100200002, 0x8000112200200002, 0x8000112300200002, 0x8000112400200002,
+		0x8000112500200002, 0x8000112600200002, 0x8000112700200002, 0x8000112800200002, 0x8000112900200002, 0x8000112A00200002, 0x8000112400200002, 0x8000112500200002,
+		0x8000112500200002, 0x8000112500200002, 0x8000112500200002, 0x8000112500200002, 0x8000112500200002, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000112B00200002, 0x8000112C00200002, 0x8000112D00200002, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000112E00200002, 0x8000112F00200002, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000113000200002, 0x8000113100200002, 0x8000113200200002, 0x8000113300200002,
+		0x8000113400200002, 0x8000113500200002, 0x8000113600200002, 0x8000113700200002, 0x8000113800200002, 0x8000113900200002, 0x8000113A00200002, 0x8000113B00200002,
+		0x8000113C00200002, 0x8000113D00200002, 0x8000113E00200002, 0x8000113F00200002, 0x8000114000200002, 0x8000114100200002, 0x8000114200200002, 0x8000114300200002,
+		0x8000114400200002, 0x8000114500200002, 0x8000114600200002, 0x8000114700200002, 0x8000114800200002, 0x8000114900200002, 0x8000114A00200002, 0x8000114B00200002,
+		0x8000114C00200002, 0x8000114D00200002, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000114E00200002, 0x8000114F00200002,
+		0x8000115000200002, 0x8000115100200002, 0x8000115200200002, 0x8000115300200002, 0x8000115400200002, 0x8000115500200002, 0x8000115600200002, 0x8000115700200002,
+		0x8000115800200002, 0x8000115900200002, 0x8000115A00200002, 0x8000115900200002, 0x8000115A00200002, 0x8000115900200002, 0x8000115A00200002, 0x8000115900200002,
+		0x8000115A00200002, 0x8000115B00200002, 0x8000115C00200002, 0x8000115D00200002, 0x8000115E00200002, 0x8000115F00200002, 0x8000116000200002, 0x8000116100200002,
+		0x8000116200200002, 0x8000116300200002, 0x8000116400200002, 0x8000116500200002, 0x8000116600200002, 0x8000116700200002, 0x8000116800200002, 0x8000116900200002,
+		0x8000116A00200002, 0x8000116B00200002, 0x8000116C00200002, 0x8000116D00200002, 0x8000116E00200002, 0x8000116F00200002, 0x8000117000200002, 0x8000117100200002,
+		0x8000117200200002, 0x8000117300200002, 0x8000117400200002, 0x8000117500200002, 0x8000117600200002, 0x8000117700200002, 0x8000117800200002, 0x8000117900200002,
+		0x8000117A00200002, 0x8000117B00200002, 0x8000117C00200002, 0x8000117D00200002, 0x8000117E00200002, 0x8000117F00200002, 0x8000118000200002, 0x8000118100200002,
+		0x8000118200200002, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000118300200002, 0x8000118400200002, 0x8000118500200002, 0x8000118600200002, 0x8000118700200002, 0x8000118800200002, 0x8000118900200002, 0x8000118A00200002,
+		0x8000118B00200002, 0x8000118C00200002, 0x8000118D00200002, 0x8000118E00200002, 0x8000118F00200002, 0x8000119000200002, 0x8000119100200002, 0x8000119200200002,
+		0x8000119300200002, 0x8000119400200002, 0x8000119500200002, 0x8000119600200002, 0x8000119700200002, 0x8000119800200002, 0x8000119900200002, 0x8000119A00200002,
+		0x8000119B00200002, 0x8000119C00200002, 0x8000119D00200002, 0x8000119E00200002, 0x8000119F00200002, 0x800011A000200002, 0x800011A100200002, 0x800011A200200002,
+		0x800011A300200002, 0x800011A400200002, 0x800011A500200002, 0x800011A600200002, 0x800011A700200002, 0x800011A800200002, 0x800011A900200002, 0x800011AA00200002,
+		0x800011AB00200002, 0x800011AC00200002, 0x800011AD00200002, 0x800011AE00200002, 0x800011AF00200002, 0x800011B000200002, 0x800011B100200002, 0x800011B200200002,
+		0x800011B300200002, 0x800011B400200002, 0x800011B500200002, 0x800011B600200002, 0x800011B700200002, 0x800011B800200002, 0x800011B900200002, 0x800011BA00200002,
+		0x800011BB00200002, 0x800011BC00200002, 0x800011BD00200002, 0x800011BE00200002, 0x800011BF00200002, 0x800011C000200002, 0x800011C100200002, 0x800011C200200002,
+		0x800011C300200002, 0x800011C400200002, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x800011C500200002, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80000EEA00200002, 0x80000EEB00200002, 0x80000EEC00200002, 0x80000EED00200002, 0x80000EEE00200002, 0x80000EEF00200002, 0x80000EF000200002, 0x80000EF100200002,
+		0x80000EF200200002, 0x80000EF300200002, 0x80000EF400200002, 0x80000EF500200002, 0x80000EF600200002, 0x80000EF700200002, 0x80000EF800200002, 0x80000EF900200002,
+		0x80000EFA00200002, 0x80000EFB00200002, 0x80000EFC00200002, 0x80000EFD00200002, 0x80000EFE00200002, 0x80000EFF00200002, 0x80000F0000200002, 0x80000F0100200002,
+		0x80000F0200200002, 0x80000F0300200002, 0x80000F0400200002, 0x80000F0500200002, 0x80000F0600200002, 0x80000F0700200002, 0x80000F0800200002, 0x80000F0900200002,
+		0x80000F0A00200002, 0x80000F0B00200002, 0x80000F0C00200002, 0x80000F0D00200002, 0x80000F0E00200002, 0x80000F0F00200002, 0x80000F1000200002, 0x80000F1100200002,
+		0x80000F1200200002, 0x80000F1300200002, 0x80000F1400200002, 0x80000F1500200002, 0x80000F1600200002, 0x80000F1700200002, 0x80000F1800200002, 0x80000F1900200002,
+		0x80000F1A00200002, 0x80000F1B00200002, 0x80000F1C00200002, 0x80000F1D00200002, 0x80000F1E00200002, 0x80000F1F00200002, 0x80000F2000200002, 0x80000F2100200002,
+		0x80000F2200200002, 0x80000F2300200002, 0x80000F2400200002, 0x80000F2500200002, 0x80000F2600200002, 0x80000F2700200002, 0x80000F2800200002, 0x80000F2900200002,
+		0x80000F2A00200002, 0x80000F2B00200002, 0x80000F2C00200002, 0x80000F2D00200002, 0x80000F2E00200002, 0x80000F2F00200002, 0x80000F3000200002, 0x80000F3100200002,
+		0x80000F3200200002, 0x80000F3300200002, 0x80000F3400200002, 0x80000F3500200002, 0x80000F3600200002, 0x80000F3700200002, 0x80000F3800200002, 0x80000F3900200002,
+		0x80000F3A00200002, 0x80000F3B00200002, 0x80000F3C00200002, 0x80000F3D00200002, 0x80000F3E00200002, 0x80000F3F00200002, 0x80000F4000200002, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002,
+		0x80001C4600200002, 0x80001BEF00200002, 0x80001BF000200002, 0x80001BF100200002, 0x80001BF200200002, 0x80001BF300200002, 0x80001BF400200002, 0x80001BF500200002,
+		0x80001BF600200002, 0x80001BF700200002, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80001C470020000B, 0x80001C600020000B, 0x80001C7A0020000B, 0x80001C8F0020000B, 0x80001CAA0020000B, 0x80001CE50020000B, 0x80001CF40020000B, 0x80001D180020000B,
+		0x80001D320020000B, 0x80001D4C0020000B, 0x80001D650020000B, 0x80001D770020000B, 0x80001DAA0020000B, 0x80001DB90020000B, 0x80001DDD0020000B, 0x80001E0C0020000B,
+		0x80001E210020000B, 0x80001E330020000B, 0x80001E710020000B, 0x80001E950020000B, 0x80001EB50020000B, 0x80001EE30020000B, 0x80001EF50020000B, 0x80001EFF0020000B,
+		0x80001F0B0020000B, 0x80001F210020000B, 0x80001C4700200005, 0x80001C6000200005, 0x80001C7A00200005, 0x80001C8F00200005, 0x80001CAA00200005, 0x80001CE500200005,
+		0x80001CF400200005, 0x80001D1800200005, 0x80001D3200200005, 0x80001D4C00200005, 0x80001D6500200005, 0x80001D7700200005, 0x80001DAA00200005, 0x80001DB900200005,
+		0x80001DDD00200005, 0x80001E0C00200005, 0x80001E2100200005, 0x80001E3300200005, 0x80001E7100200005, 0x80001E9500200005, 0x80001EB500200005, 0x80001EE300200005,
+		0x80001EF500200005, 0x80001EFF00200005, 0x80001F0B00200005, 0x80001F2100200005, 0x80001C470020000B, 0x80001C600020000B, 0x80001C7A0020000B, 0x80001C8F0020000B,
+		0x80001CAA0020000B, 0x80001CE50020000B, 0x80001CF40020000B, 0x80001D180020000B, 0x80001D320020000B, 0x80001D4C0020000B, 0x80001D650020000B, 0x80001D770020000B,
+		0x80001DAA0020000B, 0x80001DB90020000B, 0x80001DDD0020000B, 0x80001E0C0020000B, 0x80001E210020000B, 0x80001E330020000B, 0x80001E710020000B, 0x80001E950020000B,
+		0x80001EB50020000B, 0x80001EE30020000B, 0x80001EF50020000B, 0x80001EFF0020000B, 0x80001F0B0020000B, 0x80001F210020000B, 0x80001C4700200005, 0x80001C6000200005,
+		0x80001C7A00200005, 0x80001C8F00200005, 0x80001CAA00200005, 0x80001CE500200005, 0x80001CF400200005, 0, 0x80001D3200200005, 0x80001D4C00200005,
+		0x80001D6500200005, 0x80001D7700200005, 0x80001DAA00200005, 0x80001DB900200005, 0x80001DDD00200005, 0x80001E0C00200005, 0x80001E2100200005, 0x80001E3300200005,
+		0x80001E7100200005, 0x80001E9500200005, 0x80001EB500200005, 0x80001EE300200005, 0x80001EF500200005, 0x80001EFF00200005, 0x80001F0B00200005, 0x80001F2100200005,
+		0x80001C470020000B, 0x80001C600020000B, 0x80001C7A0020000B, 0x80001C8F0020000B, 0x80001CAA0020000B, 0x80001CE50020000B, 0x80001CF40020000B, 0x80001D180020000B,
+		0x80001D320020000B, 0x80001D4C0020000B, 0x80001D650020000B, 0x80001D770020000B, 0x80001DAA0020000B, 0x80001DB90020000B, 0x80001DDD0020000B, 0x80001E0C0020000B,
+		0x80001E210020000B, 0x80001E330020000B, 0x80001E710020000B, 0x80001E950020000B, 0x80001EB50020000B, 0x80001EE30020000B, 0x80001EF50020000B, 0x80001EFF0020000B,
+		0x80001F0B0020000B, 0x80001F210020000B, 0x80001C4700200005, 0x80001C6000200005, 0x80001C7A00200005, 0x80001C8F00200005, 0x80001CAA00200005, 0x80001CE500200005,
+		0x80001CF400200005, 0x80001D1800200005, 0x80001D3200200005, 0x80001D4C00200005, 0x80001D6500200005, 0x80001D7700200005, 0x80001DAA00200005, 0x80001DB900200005,
+		0x80001DDD00200005, 0x80001E0C00200005, 0x80001E2100200005, 0x80001E3300200005, 0x80001E7100200005, 0x80001E9500200005, 0x80001EB500200005, 0x80001EE300200005,
+		0x80001EF500200005, 0x80001EFF00200005, 0x80001F0B00200005, 0x80001F2100200005, 0x80001C470020000B, 0, 0x80001C7A0020000B, 0x80001C8F0020000B,
+		0, 0, 0x80001CF40020000B, 0, 0, 0x80001D4C0020000B, 0x80001D650020000B, 0,
+		0, 0x80001DB90020000B, 0x80001DDD0020000B, 0x80001E0C0020000B, 0x80001E210020000B, 0, 0x80001E710020000B, 0x80001E950020000B,
+		0x80001EB50020000B, 0x80001EE30020000B, 0x80001EF50020000B, 0x80001EFF0020000B, 0x80001F0B0020000B, 0x80001F210020000B, 0x80001C4700200005, 0x80001C6000200005,
+		0x80001C7A00200005, 0x80001C8F00200005, 0, 0x80001CE500200005, 0, 0x80001D1800200005, 0x80001D3200200005, 0x80001D4C00200005,
+		0x80001D6500200005, 0x80001D7700200005, 0x80001DAA00200005, 0x80001DB900200005, 0, 0x80001E0C00200005, 0x80001E2100200005, 0x80001E3300200005,
+		0x80001E7100200005, 0x80001E9500200005, 0x80001EB500200005, 0x80001EE300200005, 0x80001EF500200005, 0x80001EFF00200005, 0x80001F0B00200005, 0x80001F2100200005,
+		0x80001C470020000B, 0x80001C600020000B, 0x80001C7A0020000B, 0x80001C8F0020000B, 0x80001CAA0020000B, 0x80001CE50020000B, 0x80001CF40020000B, 0x80001D180020000B,
+		0x80001D320020000B, 0x80001D4C0020000B, 0x80001D650020000B, 0x80001D770020000B, 0x80001DAA0020000B, 0x80001DB90020000B, 0x80001DDD0020000B, 0x80001E0C0020000B,
+		0x80001E210020000B, 0x80001E330020000B, 0x80001E710020000B, 0x80001E950020000B, 0x80001EB50020000B, 0x80001EE30020000B, 0x80001EF50020000B, 0x80001EFF0020000B,
+		0x80001F0B0020000B, 0x80001F210020000B, 0x80001C4700200005, 0x80001C6000200005, 0x80001C7A00200005, 0x80001C8F00200005, 0x80001CAA00200005, 0x80001CE500200005,
+		0x80001CF400200005, 0x80001D1800200005, 0x80001D3200200005, 0x80001D4C00200005, 0x80001D6500200005, 0x80001D7700200005, 0x80001DAA00200005, 0x80001DB900200005,
+		0x80001DDD00200005, 0x80001E0C00200005, 0x80001E2100200005, 0x80001E3300200005, 0x80001E7100200005, 0x80001E9500200005, 0x80001EB500200005, 0x80001EE300200005,
+		0x80001EF500200005, 0x80001EFF00200005, 0x80001F0B00200005, 0x80001F2100200005, 0x80001C470020000B, 0x80001C600020000B, 0, 0x80001C8F0020000B,
+		0x80001CAA0020000B, 0x80001CE50020000B, 0x80001CF40020000B, 0, 0, 0x80001D4C0020000B, 0x80001D650020000B, 0x80001D770020000B,
+		0x80001DAA0020000B, 0x80001DB90020000B, 0x80001DDD0020000B, 0x80001E0C0020000B, 0x80001E210020000B, 0, 0x80001E710020000B, 0x80001E950020000B,
+		0x80001EB50020000B, 0x80001EE30020000B, 0x80001EF50020000B, 0x80001EFF0020000B, 0x80001F0B0020000B, 0, 0x80001C4700200005, 0x80001C6000200005,
+		0x80001C7A00200005, 0x80001C8F00200005, 0x80001CAA00200005, 0x80001CE500200005, 0x80001CF400200005, 0x80001D1800200005, 0x80001D3200200005, 0x80001D4C00200005,
+		0x80001D6500200005, 0x80001D7700200005, 0x80001DAA00200005, 0x80001DB900200005, 0x80001DDD00200005, 0x80001E0C00200005, 0x80001E2100200005, 0x80001E3300200005,
+		0x80001E7100200005, 0x80001E9500200005, 0x80001EB500200005, 0x80001EE300200005, 0x80001EF500200005, 0x80001EFF00200005, 0x80001F0B00200005, 0x80001F2100200005,
+		0x80001C470020000B, 0x80001C600020000B, 0, 0x80001C8F0020000B, 0x80001CAA0020000B, 0x80001CE50020000B, 0x80001CF40020000B, 0,
+		0x80001D320020000B, 0x80001D4C0020000B, 0x80001D650020000B, 0x80001D770020000B, 0x80001DAA0020000B, 0, 0x80001DDD0020000B, 0,
+		0, 0, 0x80001E710020000B, 0x80001E950020000B, 0x80001EB50020000B, 0x80001EE30020000B, 0x80001EF50020000B, 0x80001EFF0020000B,
+		0x80001F0B0020000B, 0, 0x80001C4700200005, 0x80001C6000200005, 0x80001C7A00200005, 0x80001C8F00200005, 0x80001CAA00200005, 0x80001CE500200005,
+		0x80001CF400200005, 0x80001D1800200005, 0x80001D3200200005, 0x80001D4C00200005, 0x80001D6500200005, 0x80001D7700200005, 0x80001DAA00200005, 0x80001DB900200005,
+		0x80001DDD00200005, 0x80001E0C00200005, 0x80001E2100200005, 0x80001E3300200005, 0x80001E7100200005, 0x80001E9500200005, 0x80001EB500200005, 0x80001EE300200005,
+		0x80001EF500200005, 0x80001EFF00200005, 0x80001F0B00200005, 0x80001F2100200005, 0x80001C470020000B, 0x80001C600020000B, 0x80001C7A0020000B, 0x80001C8F0020000B,
+		0x80001CAA0020000B, 0x80001CE50020000B, 0x80001CF40020000B, 0x80001D180020000B, 0x80001D320020000B, 0x80001D4C0020000B, 0x80001D650020000B, 0x80001D770020000B,
+		0x80001DAA0020000B, 0x80001DB90020000B, 0x80001DDD0020000B, 0x80001E0C0020000B, 0x80001E210020000B, 0x80001E330020000B, 0x80001E710020000B, 0x80001E950020000B,
+		0x80001EB50020000B, 0x80001EE30020000B, 0x80001EF50020000B, 0x80001EFF0020000B, 0x80001F0B0020000B, 0x80001F210020000B, 0x80001C4700200005, 0x80001C6000200005,
+		0x80001C7A00200005, 0x80001C8F00200005, 0x80001CAA00200005, 0x80001CE500200005, 0x80001CF400200005, 0x80001D1800200005, 0x80001D3200200005, 0x80001D4C00200005,
+		0x80001D6500200005, 0x80001D7700200005, 0x80001DAA00200005, 0x80001DB900200005, 0x80001DDD00200005, 0x80001E0C00200005, 0x80001E2100200005, 0x80001E3300200005,
+		0x80001E7100200005, 0x80001E9500200005, 0x80001EB500200005, 0x80001EE300200005, 0x80001EF500200005, 0x80001EFF00200005, 0x80001F0B00200005, 0x80001F2100200005,
+		0x80001C470020000B, 0x80001C600020000B, 0x80001C7A0020000B, 0x80001C8F0020000B, 0x80001CAA0020000B, 0x80001CE50020000B, 0x80001CF40020000B, 0x80001D180020000B,
+		0x80001D320020000B, 0x80001D4C0020000B, 0x80001D650020000B, 0x80001D770020000B, 0x80001DAA0020000B, 0x80001DB90020000B, 0x80001DDD0020000B, 0x80001E0C0020000B,
+		0x80001E210020000B, 0x80001E330020000B, 0x80001E710020000B, 0x80001E950020000B, 0x80001EB50020000B, 0x80001EE30020000B, 0x80001EF50020000B, 0x80001EFF0020000B,
+		0x80001F0B0020000B, 0x80001F210020000B, 0x80001C4700200005, 0x80001C6000200005, 0x80001C7A00200005, 0x80001C8F00200005, 0x80001CAA00200005, 0x80001CE500200005,
+		0x80001CF400200005, 0x80001D1800200005, 0x80001D3200200005, 0x80001D4C00200005, 0x80001D6500200005, 0x80001D7700200005, 0x80001DAA00200005, 0x80001DB900200005,
+		0x80001DDD00200005, 0x80001E0C00200005, 0x80001E2100200005, 0x80001E3300200005, 0x80001E7100200005, 0x80001E9500200005, 0x80001EB500200005, 0x80001EE300200005,
+		0x80001EF500200005, 0x80001EFF00200005, 0x80001F0B00200005, 0x80001F2100200005, 0x80001C470020000B, 0x80001C600020000B, 0x80001C7A0020000B, 0x80001C8F0020000B,
+		0x80001CAA0020000B, 0x80001CE50020000B, 0x80001CF40020000B, 0x80001D180020000B, 0x80001D320020000B, 0x80001D4C0020000B, 0x80001D650020000B, 0x80001D770020000B,
+		0x80001DAA0020000B, 0x80001DB90020000B, 0x80001DDD0020000B, 0x80001E0C0020000B, 0x80001E210020000B, 0x80001E330020000B, 0x80001E710020000B, 0x80001E950020000B,
+		0x80001EB50020000B, 0x80001EE30020000B, 0x80001EF50020000B, 0x80001EFF0020000B, 0x80001F0B0020000B, 0x80001F210020000B, 0x80001C4700200005, 0x80001C6000200005,
+		0x80001C7A00200005, 0x80001C8F00200005, 0x80001CAA00200005, 0x80001CE500200005, 0x80001CF400200005, 0x80001D1800200005, 0x80001D3200200005, 0x80001D4C00200005,
+		0x80001D6500200005, 0x80001D7700200005, 0x80001DAA00200005, 0x80001DB900200005, 0x80001DDD00200005, 0x80001E0C00200005, 0x80001E2100200005, 0x80001E3300200005,
+		0x80001E7100200005, 0x80001E9500200005, 0x80001EB500200005, 0x80001EE300200005, 0x80001EF500200005, 0x80001EFF00200005, 0x80001F0B00200005, 0x80001F2100200005,
+		0x80001C470020000B, 0x80001C600020000B, 0x80001C7A0020000B, 0x80001C8F0020000B, 0x80001CAA0020000B, 0x80001CE50020000B, 0x80001CF40020000B, 0x80001D180020000B,
+		0x80001D320020000B, 0x80001D4C0020000B, 0x80001D650020000B, 0x80001D770020000B, 0x80001DAA0020000B, 0x80001DB90020000B, 0x80001DDD0020000B, 0x80001E0C0020000B,
+		0x80001E210020000B, 0x80001E330020000B, 0x80001E710020000B, 0x80001E950020000B, 0x80001EB50020000B, 0x80001EE30020000B, 0x80001EF50020000B, 0x80001EFF0020000B,
+		0x80001F0B0020000B, 0x80001F210020000B, 0x80001C4700200005, 0x80001C6000200005, 0x80001C7A00200005, 0x80001C8F00200005, 0x80001CAA00200005, 0x80001CE500200005,
+		0x80001CF400200005, 0x80001D1800200005, 0x80001D3200200005, 0x80001D4C00200005, 0x80001D6500200005, 0x80001D7700200005, 0x80001DAA00200005, 0x80001DB900200005,
+		0x80001DDD00200005, 0x80001E0C00200005, 0x80001E2100200005, 0x80001E3300200005, 0x80001E7100200005, 0x80001E9500200005, 0x80001EB500200005, 0x80001EE300200005,
+		0x80001EF500200005, 0x80001EFF00200005, 0x80001F0B00200005, 0x80001F2100200005, 0x80001C470020000B, 0x80001C600020000B, 0x80001C7A0020000B, 0x80001C8F0020000B,
+		0x80001CAA0020000B, 0x80001CE50020000B, 0x80001CF40020000B, 0x80001D180020000B, 0x80001D320020000B, 0x80001D4C0020000B, 0x80001D650020000B, 0x80001D770020000B,
+		0x80001DAA0020000B, 0x80001DB90020000B, 0x80001DDD0020000B, 0x80001E0C0020000B, 0x80001E210020000B, 0x80001E330020000B, 0x80001E710020000B, 0x80001E950020000B,
+		0x80001EB50020000B, 0x80001EE30020000B, 0x80001EF50020000B, 0x80001EFF0020000B, 0x80001F0B0020000B, 0x80001F210020000B, 0x80001C4700200005, 0x80001C6000200005,
+		0x80001C7A00200005, 0x80001C8F00200005, 0x80001CAA00200005, 0x80001CE500200005, 0x80001CF400200005, 0x80001D1800200005, 0x80001D3200200005, 0x80001D4C00200005,
+		0x80001D6500200005, 0x80001D7700200005, 0x80001DAA00200005, 0x80001DB900200005, 0x80001DDD00200005, 0x80001E0C00200005, 0x80001E2100200005, 0x80001E3300200005,
+		0x80001E7100200005, 0x80001E9500200005, 0x80001EB500200005, 0x80001EE300200005, 0x80001EF500200005, 0x80001EFF00200005, 0x80001F0B00200005, 0x80001F2100200005,
+		0x80001C470020000B, 0x80001C600020000B, 0x80001C7A0020000B, 0x80001C8F0020000B, 0x80001CAA0020000B, 0x80001CE50020000B, 0x80001CF40020000B, 0x80001D180020000B,
+		0x80001D320020000B, 0x80001D4C0020000B, 0x80001D650020000B, 0x80001D770020000B, 0x80001DAA0020000B, 0x80001DB90020000B, 0x80001DDD0020000B, 0x80001E0C0020000B,
+		0x80001E210020000B, 0x80001E330020000B, 0x80001E710020000B, 0x80001E950020000B, 0x80001EB50020000B, 0x80001EE30020000B, 0x80001EF50020000B, 0x80001EFF0020000B,
+		0x80001F0B0020000B, 0x80001F210020000B, 0x80001C4700200005, 0x80001C6000200005, 0x80001C7A00200005, 0x80001C8F00200005, 0x80001CAA00200005, 0x80001CE500200005,
+		0x80001CF400200005, 0x80001D1800200005, 0x80001D3200200005, 0x80001D4C00200005, 0x80001D6500200005, 0x80001D7700200005, 0x80001DAA00200005, 0x80001DB900200005,
+		0x80001DDD00200005, 0x80001E0C00200005, 0x80001E2100200005, 0x80001E3300200005, 0x80001E7100200005, 0x80001E9500200005, 0x80001EB500200005, 0x80001EE300200005,
+		0x80001EF500200005, 0x80001EFF00200005, 0x80001F0B00200005, 0x80001F2100200005, 0x80001D3600200005, 0x80001D5000200005, 0, 0,
+		0x80001FB90020000B, 0x80001FBA0020000B, 0x80001FBB0020000B, 0x80001FBD0020000B, 0x80001FBE0020000B, 0x80001FC20020000B, 0x80001FC40020000B, 0x80001FC50020000B,
+		0x80001FC60020000B, 0x80001FC80020000B, 0x80001FC90020000B, 0x80001FCB0020000B, 0x80001FCC0020000B, 0x80001FCD0020000B, 0x80001FCE0020000B, 0x80001FCF0020000B,
+		0x80001FD40020000B, 0x80001FC50020000B, 0x80001FD70020000B, 0x80001FDB0020000B, 0x80001FDC0020000B, 0x80001FDD0020000B, 0x80001FDE0020000B, 0x80001FDF0020000B,
+		0x80001FE10020000B, 0x8000060C00200005, 0x80001FB900200005, 0x80001FBA00200005, 0x80001FBB00200005, 0x80001FBD00200005, 0x80001FBE00200005, 0x80001FC200200005,
+		0x80001FC400200005, 0x80001FC500200005, 0x80001FC600200005, 0x80001FC800200005, 0x80001FC900200005, 0x80001FCB00200005, 0x80001FCC00200005, 0x80001FCD00200005,
+		0x80001FCE00200005, 0x80001FCF00200005, 0x80001FD400200005, 0x80001FD700200005, 0x80001FD700200005, 0x80001FDB00200005, 0x80001FDC00200005, 0x80001FDD00200005,
+		0x80001FDE00200005, 0x80001FDF00200005, 0x80001FE100200005, 0x8000060800200005, 0x80001FBE00200005, 0x80001FC500200005, 0x80001FC800200005, 0x80001FDD00200005,
+		0x80001FD400200005, 0x80001FCF00200005, 0x80001FB90020000B, 0x80001FBA0020000B, 0x80001FBB0020000B, 0x80001FBD0020000B, 0x80001FBE0020000B, 0x80001FC20020000B,
+		0x80001FC40020000B, 0x80001FC50020000B, 0x80001FC60020000B, 0x80001FC80020000B, 0x80001FC90020000B, 0x80001FCB0020000B, 0x80001FCC0020000B, 0x80001FCD0020000B,
+		0x80001FCE0020000B, 0x80001FCF0020000B, 0x80001FD40020000B, 0x80001FC50020000B, 0x80001FD70020000B, 0x80001FDB0020000B, 0x80001FDC0020000B, 0x80001FDD0020000B,
+		0x80001FDE0020000B, 0x80001FDF0020000B, 0x80001FE10020000B, 0x8000060C00200005, 0x80001FB900200005, 0x80001FBA00200005, 0x80001FBB00200005, 0x80001FBD00200005,
+		0x80001FBE00200005, 0x80001FC200200005, 0x80001FC400200005, 0x80001FC500200005, 0x80001FC600200005, 0x80001FC800200005, 0x80001FC900200005, 0x80001FCB00200005,
+		0x80001FCC00200005, 0x80001FCD00200005, 0x80001FCE00200005, 0x80001FCF00200005, 0x80001FD400200005, 0x80001FD700200005, 0x80001FD700200005, 0x80001FDB00200005,
+		0x80001FDC00200005, 0x80001FDD00200005, 0x80001FDE00200005, 0x80001FDF00200005, 0x80001FE100200005, 0x8000060800200005, 0x80001FBE00200005, 0x80001FC500200005,
+		0x80001FC800200005, 0x80001FDD00200005, 0x80001FD400200005, 0x80001FCF00200005, 0x80001FB90020000B, 0x80001FBA0020000B, 0x80001FBB0020000B, 0x80001FBD0020000B,
+		0x80001FBE0020000B, 0x80001FC20020000B, 0x80001FC40020000B, 0x80001FC50020000B, 0x80001FC60020000B, 0x80001FC80020000B, 0x80001FC90020000B, 0x80001FCB0020000B,
+		0x80001FCC0020000B, 0x80001FCD0020000B, 0x80001FCE0020000B, 0x80001FCF0020000B, 0x80001FD40020000B, 0x80001FC50020000B, 0x80001FD70020000B, 0x80001FDB0020000B,
+		0x80001FDC0020000B, 0x80001FDD0020000B, 0x80001FDE0020000B, 0x80001FDF0020000B, 0x80001FE10020000B, 0x8000060C00200005, 0x80001FB900200005, 0x80001FBA00200005,
+		0x80001FBB00200005, 0x80001FBD00200005, 0x80001FBE00200005, 0x80001FC200200005, 0x80001FC400200005, 0x80001FC500200005, 0x80001FC600200005, 0x80001FC800200005,
+		0x80001FC900200005, 0x80001FCB00200005, 0x80001FCC00200005, 0x80001FCD00200005, 0x80001FCE00200005, 0x80001FCF00200005, 0x80001FD400200005, 0x80001FD700200005,
+		0x80001FD700200005, 0x80001FDB00200005, 0x80001FDC00200005, 0x80001FDD00200005, 0x80001FDE00200005, 0x80001FDF00200005, 0x80001FE100200005, 0x8000060800200005,
+		0x80001FBE00200005, 0x80001FC500200005, 0x80001FC800200005, 0x80001FDD00200005, 0x80001FD400200005, 0x80001FCF00200005, 0x80001FB90020000B, 0x80001FBA0020000B,
+		0x80001FBB0020000B, 0x80001FBD0020000B, 0x80001FBE0020000B, 0x80001FC20020000B, 0x80001FC40020000B, 0x80001FC50020000B, 0x80001FC60020000B, 0x80001FC80020000B,
+		0x80001FC90020000B, 0x80001FCB0020000B, 0x80001FCC0020000B, 0x80001FCD0020000B, 0x80001FCE0020000B, 0x80001FCF0020000B, 0x80001FD40020000B, 0x80001FC50020000B,
+		0x80001FD70020000B, 0x80001FDB0020000B, 0x80001FDC0020000B, 0x80001FDD0020000B, 0x80001FDE0020000B, 0x80001FDF0020000B, 0x80001FE10020000B, 0x8000060C00200005,
+		0x80001FB900200005, 0x80001FBA00200005, 0x80001FBB00200005, 0x80001FBD00200005, 0x80001FBE00200005, 0x80001FC200200005, 0x80001FC400200005, 0x80001FC500200005,
+		0x80001FC600200005, 0x80001FC800200005, 0x80001FC900200005, 0x80001FCB00200005, 0x80001FCC00200005, 0x80001FCD00200005, 0x80001FCE00200005, 0x80001FCF00200005,
+		0x80001FD400200005, 0x80001FD700200005, 0x80001FD700200005, 0x80001FDB00200005, 0x80001FDC00200005, 0x80001FDD00200005, 0x80001FDE00200005, 0x80001FDF00200005,
+		0x80001FE100200005, 0x8000060800200005, 0x80001FBE00200005, 0x80001FC500200005, 0x80001FC800200005, 0x80001FDD00200005, 0x80001FD400200005, 0x80001FCF00200005,
+		0x80001FB90020000B, 0x80001FBA0020000B, 0x80001FBB0020000B, 0x80001FBD0020000B, 0x80001FBE0020000B, 0x80001FC20020000B, 0x80001FC40020000B, 0x80001FC50020000B,
+		0x80001FC60020000B, 0x80001FC80020000B, 0x80001FC90020000B, 0x80001FCB0020000B, 0x80001FCC0020000B, 0x80001FCD0020000B, 0x80001FCE0020000B, 0x80001FCF0020000B,
+		0x80001FD40020000B, 0x80001FC50020000B, 0x80001FD70020000B, 0x80001FDB0020000B, 0x80001FDC0020000B, 0x80001FDD0020000B, 0x80001FDE0020000B, 0x80001FDF0020000B,
+		0x80001FE10020000B, 0x8000060C00200005, 0x80001FB900200005, 0x80001FBA00200005, 0x80001FBB00200005, 0x80001FBD00200005, 0x80001FBE00200005, 0x80001FC200200005,
+		0x80001FC400200005, 0x80001FC500200005, 0x80001FC600200005, 0x80001FC800200005, 0x80001FC900200005, 0x80001FCB00200005, 0x80001FCC00200005, 0x80001FCD00200005,
+		0x80001FCE00200005, 0x80001FCF00200005, 0x80001FD400200005, 0x80001FD700200005, 0x80001FD700200005, 0x80001FDB00200005, 0x80001FDC00200005, 0x80001FDD00200005,
+		0x80001FDE00200005, 0x80001FDF00200005, 0x80001FE100200005, 0x8000060800200005, 0x80001FBE00200005, 0x80001FC500200005, 0x80001FC800200005, 0x80001FDD00200005,
+		0x80001FD400200005, 0x80001FCF00200005, 0x80001FBF0020000B, 0x80001FBF00200005, 0, 0, 0x80001C3D00200005, 0x80001C3E00200005,
+		0x80001C3F00200005, 0x80001C4000200005, 0x80001C4100200005, 0x80001C4200200005, 0x80001C4300200005, 0x80001C4400200005, 0x80001C4500200005, 0x80001C4600200005,
+		0x80001C3D00200005, 0x80001C3E00200005, 0x80001C3F00200005, 0x80001C4000200005, 0x80001C4100200005, 0x80001C4200200005, 0x80001C4300200005, 0x80001C4400200005,
+		0x80001C4500200005, 0x80001C4600200005, 0x80001C3D00200005, 0x80001C3E00200005, 0x80001C3F00200005, 0x80001C4000200005, 0x80001C4100200005, 0x80001C4200200005,
+		0x80001C4300200005, 0x80001C4400200005, 0x80001C4500200005, 0x80001C4600200005, 0x80001C3D00200005, 0x80001C3E00200005, 0x80001C3F00200005, 0x80001C4000200005,
+		0x80001C4100200005, 0x80001C4200200005, 0x80001C4300200005, 0x80001C4400200005, 0x80001C4500200005, 0x80001C4600200005, 0x80001C3D00200005, 0x80001C3E00200005,
+		0x80001C3F00200005, 0x80001C4000200005, 0x80001C4100200005, 0x80001C4200200005, 0x80001C4300200005, 0x80001C4400200005, 0x80001C4500200005, 0x80001C4600200005,
+		0x8000183F00200002, 0x8000184000200002, 0x8000184100200002, 0x8000184200200002, 0x8000184300200002, 0x8000184400200002, 0x8000184500200002, 0x8000184600200002,
+		0x8000184700200002, 0x8000184800200002, 0x8000184900200002, 0x8000184A00200002, 0x8000184B00200002, 0x8000184C00200002, 0x8000184D00200002, 0x8000184E00200002,
+		0x8000184F00200002, 0x8000185000200002, 0x8000185100200002, 0x8000185200200002, 0x8000185300200002, 0x8000185400200002, 0x8000185500200002, 0x8000185600200002,
+		0x8000185700200002, 0x8000185800200002, 0x8000185900200002, 0x8000185A00200002, 0x8000185B00200002, 0x8000185C00200002, 0x8000185D00200002, 0x8000185E00200002,
+		0x8000185F00200002, 0x8000186000200002, 0x8000186100200002, 0x8000186200200002, 0x8000186300200002, 0x8000186400200002, 0x8000186500200002, 0x8000186600200002,
+		0x8000186700200002, 0x8000186800200002, 0x8000186900200002, 0x8000186A00200002, 0x8000186B00200002, 0x8000186C00200002, 0x8000186D00200002, 0x8000186E00200002,
+		0x8000186F00200002, 0x8000187000200002, 0x8000187100200002, 0x8000187200200002, 0x8000187300200002, 0x8000187400200002, 0x8000187500200002, 0x8000187600200002,
+		0x8000187700200002, 0x8000187800200002, 0x8000187900200002, 0x8000187A00200002, 0x8000187B00200002, 0x8000187C00200002, 0x8000187D00200002, 0x8000187E00200002,
+		0x8000187F00200002, 0x8000188000200002, 0x8000188100200002, 0x8000188200200002, 0x8000188300200002, 0x8000188400200002, 0x8000188500200002, 0x8000188600200002,
+		0x8000188700200002, 0x8000188800200002, 0x8000188900200002, 0x8000188A00200002, 0x8000188B00200002, 0x8000188C00200002, 0x8000188D00200002, 0x8000188E00200002,
+		0x8000188F00200002, 0x8000189000200002, 0x8000189100200002, 0x8000189200200002, 0x8000189300200002, 0x8000189400200002, 0x8000189500200002, 0x8000189600200002,
+		0x8000189700200002, 0x8000189800200002, 0x8000189900200002, 0x8000189A00200002, 0x8000189B00200002, 0x8000189C00200002, 0x8000189D00200002, 0x8000189E00200002,
+		0x8000189F00200002, 0x800018A000200002, 0x800018A100200002, 0x800018A200200002, 0x800018A300200002, 0x800018A400200002, 0x800018A500200002, 0x800018A600200002,
+		0x800018A700200002, 0x800018A800200002, 0x800018A900200002, 0x800018AA00200002, 0x800018AB00200002, 0x800018AC00200002, 0x800018AD00200002, 0x800018AE00200002,
+		0x800018AF00200002, 0x800018B000200002, 0x800018B100200002, 0x800018B200200002, 0x800018B300200002, 0x800018B400200002, 0x800018B500200002, 0x800018B600200002,
+		0x800018B700200002, 0x800018B800200002, 0x800018B900200002, 0x800018BA00200002, 0x800018BB00200002, 0x800018BC00200002, 0x800018BD00200002, 0x800018BE00200002,
+		0x800018BF00200002, 0x800018C000200002, 0x800018C100200002, 0x800018C200200002, 0x800018C300200002, 0x800018C400200002, 0x800018C500200002, 0x800018C600200002,
+		0x800018C700200002, 0x800018C800200002, 0x800018C900200002, 0x800018CA00200002, 0x800018CB00200002, 0x800018CC00200002, 0x800018CD00200002, 0x800018CE00200002,
+		0x800018CF00200002, 0x800018D000200002, 0x800018D100200002, 0x800018D200200002, 0x800018D300200002, 0x800018D400200002, 0x800018D500200002, 0x800018D600200002,
+		0x800018D700200002, 0x800018D800200002, 0x800018D900200002, 0x800018DA00200002, 0x800018DB00200002, 0x800018DC00200002, 0x800018DD00200002, 0x800018DE00200002,
+		0x800018DF00200002, 0x800018E000200002, 0x800018E100200002, 0x800018E200200002, 0x800018E300200002, 0x800018E400200002, 0x800018E500200002, 0x800018E600200002,
+		0x800018E700200002, 0x800018E800200002, 0x800018E900200002, 0x800018EA00200002, 0x800018EB00200002, 0x800018EC00200002, 0x800018ED00200002, 0x800018EE00200002,
+		0x800018EF00200002, 0x800018F000200002, 0x800018F100200002, 0x800018F200200002, 0x800018F300200002, 0x800018F400200002, 0x800018F500200002, 0x800018F600200002,
+		0x800018F700200002, 0x800018F800200002, 0x800018F900200002, 0x800018FA00200002, 0x800018FB00200002, 0x800018FC00200002, 0x800018FD00200002, 0x800018FE00200002,
+		0x800018FF00200002, 0x8000190000200002, 0x8000190100200002, 0x8000190200200002, 0x8000190300200002, 0x8000190400200002, 0x8000190500200002, 0x8000190600200002,
+		0x8000190700200002, 0x8000190800200002, 0x8000190900200002, 0x8000190A00200002, 0x8000190B00200002, 0x8000190C00200002, 0x8000190D00200002, 0x8000190E00200002,
+		0x8000190F00200002, 0x8000191000200002, 0x8000191100200002, 0x8000191200200002, 0x8000191300200002, 0x8000191400200002, 0x8000191500200002, 0x8000191600200002,
+		0x8000191700200002, 0x8000191800200002, 0x8000191900200002, 0x8000191A00200002, 0x8000191B00200002, 0x8000191C00200002, 0x8000191D00200002, 0x8000191E00200002,
+		0x8000191F00200002, 0x8000192000200002, 0x8000192100200002, 0x8000192200200002, 0x8000192300200002, 0x8000192400200002, 0x8000192500200002, 0x8000192600200002,
+		0x8000192700200002, 0x8000192800200002, 0x8000192900200002, 0x8000192A00200002, 0x8000192B00200002, 0x8000192C00200002, 0x8000192D00200002, 0x8000192E00200002,
+		0x8000192F00200002, 0x8000193000200002, 0x8000193100200002, 0x8000193200200002, 0x8000193300200002, 0x8000193400200002, 0x8000193500200002, 0x8000193600200002,
+		0x8000193700200002, 0x8000193800200002, 0x8000193900200002, 0x8000193A00200002, 0x8000193B00200002, 0x8000193C00200002, 0x8000193D00200002, 0x8000193E00200002,
+		0x8000193F00200002, 0x8000194000200002, 0x8000194100200002, 0x8000194200200002, 0x8000194300200002, 0x8000194400200002, 0x8000194500200002, 0x8000194600200002,
+		0x8000194700200002, 0x8000194800200002, 0x8000194900200002, 0x8000194A00200002, 0x8000194B00200002, 0x8000194C00200002, 0x8000194D00200002, 0x8000194E00200002,
+		0x8000194F00200002, 0x8000195000200002, 0x8000195100200002, 0x8000195200200002, 0x8000195300200002, 0x8000195400200002, 0x8000195500200002, 0x8000195600200002,
+		0x8000195700200002, 0x8000195800200002, 0x8000195900200002, 0x8000195A00200002, 0x8000195B00200002, 0x8000195C00200002, 0x8000195D00200002, 0x8000195E00200002,
+		0x8000195F00200002, 0x8000196000200002, 0x8000196100200002, 0x8000196200200002, 0x8000196300200002, 0x8000196400200002, 0x8000196500200002, 0x8000196600200002,
+		0x8000196700200002, 0x8000196800200002, 0x8000196900200002, 0x8000196A00200002, 0x8000196B00200002, 0x8000196C00200002, 0x8000196D00200002, 0x8000196E00200002,
+		0x8000196F00200002, 0x8000197000200002, 0x8000197100200002, 0x8000197200200002, 0x8000197300200002, 0x8000197400200002, 0x8000197500200002, 0x8000197600200002,
+		0x8000197700200002, 0x8000197800200002, 0x8000197900200002, 0x8000197A00200002, 0x8000197B00200002, 0x8000197C00200002, 0x8000197D00200002, 0x8000197E00200002,
+		0x8000197F00200002, 0x8000198000200002, 0x8000198100200002, 0x8000198200200002, 0x8000198300200002, 0x8000198400200002, 0x8000198500200002, 0x8000198600200002,
+		0x8000198700200002, 0x8000198800200002, 0x8000198900200002, 0x8000198A00200002, 0x8000198B00200002, 0x8000198C00200002, 0x8000198D00200002, 0x8000198E00200002,
+		0x8000198F00200002, 0x8000199000200002, 0x8000199100200002, 0x8000199200200002, 0x8000199300200002, 0x8000199400200002, 0x8000199500200002, 0x8000199600200002,
+		0x8000199700200002, 0x8000199800200002, 0x8000199900200002, 0x8000199A00200002, 0x8000199B00200002, 0x8000199C00200002, 0x8000199D00200002, 0x8000199E00200002,
+		0x8000199F00200002, 0x800019A000200002, 0x800019A100200002, 0x800019A200200002, 0x800019A300200002, 0x800019A400200002, 0x800019A500200002, 0x800019A600200002,
+		0x800019A700200002, 0x800019A800200002, 0x800019A900200002, 0x800019AA00200002, 0x800019AB00200002, 0x800019AC00200002, 0x800019AD00200002, 0x800019AE00200002,
+		0x800019AF00200002, 0x800019B000200002, 0x800019B100200002, 0x800019B200200002, 0x800019B300200002, 0x800019B400200002, 0x800019B500200002, 0x800019B600200002,
+		0x800019B700200002, 0x800019B800200002, 0x800019B900200002, 0x800019BA00200002, 0x800019BB00200002, 0x800019BC00200002, 0x800019BD00200002, 0x800019BE00200002,
+		0x800019BF00200002, 0x800019C000200002, 0x800019C100200002, 0x800019C200200002, 0x800019C300200002, 0x800019C400200002, 0x800019C500200002, 0x800019C600200002,
+		0x800019C700200002, 0x800019C800200002, 0x800019C900200002, 0x800019CA00200002, 0x800019CB00200002, 0x800019CC00200002, 0x800019CD00200002, 0x800019CE00200002,
+		0x800019CF00200002, 0x800019D000200002, 0x800019D100200002, 0x800019D200200002, 0x800019D300200002, 0x800019D400200002, 0x800019D500200002, 0x800019D600200002,
+		0x800019D700200002, 0x800019D800200002, 0x800019D900200002, 0x800019DA00200002, 0x800019DB00200002, 0x800019DC00200002, 0x800019DD00200002, 0x800019DE00200002,
+		0x800019DF00200002, 0x800019E000200002, 0x800019E100200002, 0x800019E200200002, 0x800019E300200002, 0x800019E400200002, 0x800019E500200002, 0x800019E600200002,
+		0x800019E700200002, 0x800019E800200002, 0x800019E900200002, 0x800019EA00200002, 0x800019EB00200002, 0x800019EC00200002, 0x800019ED00200002, 0x800019EE00200002,
+		0x800019EF00200002, 0x800019F000200002, 0x800019F100200002, 0x800019F200200002, 0x800019F300200002, 0x800019F400200002, 0x800019F500200002, 0x800019F600200002,
+		0x800019F700200002, 0x800019F800200002, 0x800019F900200002, 0x800019FA00200002, 0x800019FB00200002, 0x800019FC00200002, 0x800019FD00200002, 0x800019FE00200002,
+		0x800019FF00200002, 0x80001A0000200002, 0x80001A0100200002, 0x80001A0200200002, 0x80001A0300200002, 0x80001A0400200002, 0x80001A0500200002, 0x80001A0600200002,
+		0x80001A0700200002, 0x80001A0800200002, 0x80001A0900200002, 0x80001A0A00200002, 0x80001A0B00200002, 0x80001A0C00200002, 0x80001A0D00200002, 0x80001A0E00200002,
+		0x80001A0F00200002, 0x80001A1000200002, 0x80001A1100200002, 0x80001A1200200002, 0x80001A1300200002, 0x80001A1400200002, 0x80001A1500200002, 0x80001A1600200002,
+		0x80001A1700200002, 0x80001A1800200002, 0x80001A1900200002, 0x80001A1A00200002, 0x80001A1B00200002, 0x80001A1C00200002, 0x80001A1D00200002, 0x80001A1E00200002,
+		0x80001A1F00200002, 0x80001A2000200002, 0x80001A2100200002, 0x80001A2200200002, 0x80001A2300200002, 0x80001A2400200002, 0x80001A2500200002, 0x80001A2600200002,
+		0x80001A2700200002, 0x80001A2800200002, 0x80001A2900200002, 0x80001A2A00200002, 0x80001A2B00200002, 0x80001A2C00200002, 0x80001A2D00200002, 0x80001A2E00200002,
+		0x80001A2F00200002, 0x80001A3000200002, 0x80001A3100200002, 0x80001A3200200002, 0x80001A3300200002, 0x80001A3400200002, 0x80001A3500200002, 0x80001A3600200002,
+		0x80001A3700200002, 0x80001A3800200002, 0x80001A3900200002, 0x80001A3A00200002, 0x80001A3B00200002, 0x80001A3C00200002, 0x80001A3D00200002, 0x80001A3E00200002,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x80001A3F00200002,
+		0x80001A4000200002, 0x80001A4100200002, 0x80001A4200200002, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x80001A4300200002, 0x80001A4400200002, 0x80001A4500200002,
+		0x80001A4600200002, 0x80001A4700200002, 0x80001A4800200002, 0x80001A4900200002, 0x80001A4A00200002, 0x8000000000000000, 0x80001A4B00200002, 0x80001A4C00200002,
+		0x80001A4D00200002, 0x80001A4E00200002, 0x80001A4F00200002, 0x80001A5000200002, 0x80001A5100200002, 0x80001A5200200002, 0x80001A5300200002, 0x80001A5400200002,
+		0x80001A5500200002, 0x80001A5600200002, 0x80001A5700200002, 0x80001A5800200002, 0x8000000000000000, 0x80001A5900200002, 0x80001A5A00200002, 0x8000047D00200002,
+		0x8000047E00200002, 0x8000047F00200002, 0x8000048000200002, 0x8000048100200002, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800021E500200004, 0x800021E600200004, 0x800021E700200004, 0x800021E800200004, 0x800021E900200004, 0x800021EA00200004, 0x800021EB00200004, 0,
+		0x800021ED00200004, 0x800021EE00200004, 0x800021EF00200004, 0x800021F000200004, 0x800021F100200004, 0x800021F200200004, 0x800021F300200004, 0x800021F400200004,
+		0x800021F500200004, 0x800021F600200004, 0x800021F700200004, 0x800021F800200004, 0x800021F900200004, 0x800021FA00200004, 0x800021FB00200004, 0x800021FC00200004,
+		0x800021FD00200004, 0, 0, 0x8000220000200004, 0x8000220100200004, 0x8000220200200004, 0x8000220300200004, 0x8000220400200004,
+		0x8000220500200004, 0x8000220600200004, 0, 0x8000220800200004, 0x8000220900200004, 0, 0x8000220B00200004, 0x8000220C00200004,
+		0x8000220D00200004, 0x8000220E00200004, 0x8000220F00200004, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80003B0E00200002, 0x80003B0F00200002, 0x80003B1000200002, 0x80003B1100200002, 0x80003B1200200002, 0x80003B1300200002, 0x80003B1400200002, 0x80003B1500200002,
+		0x80003B1600200002, 0x80003B1700200002, 0x80003B1800200002, 0x80003B1900200002, 0x80003B1A00200002, 0x80003B1B00200002, 0x80003B1C00200002, 0x80003B1D00200002,
+		0x80003B1E00200002, 0x80003B1F00200002, 0x80003B2000200002, 0x80003B2100200002, 0x80003B2200200002, 0x80003B2300200002, 0x80003B2400200002, 0x80003B2500200002,
+		0x80003B2600200002, 0x80003B2700200002, 0x80003B2800200002, 0x80003B2900200002, 0x80003B2A00200002, 0x80003B2B00200002, 0x80003B2C00200002, 0x80003B2D00200002,
+		0x80003B2E00200002, 0x80003B2F00200002, 0x80003B3000200002, 0x80003B3100200002, 0x80003B3200200002, 0x80003B3300200002, 0x80003B3400200002, 0x80003B3500200002,
+		0x80003B3600200002, 0x80003B3700200002, 0x80003B3800200002, 0x80003B3900200002, 0x80003B3A00200002, 0x80003B3B00200002, 0x80003B3C00200002, 0x80003B3D00200002,
+		0x80003B3E00200002, 0x80003B3F00200002, 0x80003B4000200002, 0x80003B4100200002, 0x80003B4200200002, 0x80003B4300200002, 0x80003B4400200002, 0x80003B4500200002,
+		0x80003B4600200002, 0x80003B4700200002, 0x80003B4800200002, 0x80003B4900200002, 0x80003B4A00200002, 0x80003B4B00200002, 0x80003B4C00200002, 0x80003B4D00200002,
+		0x80003B4E00200002, 0x80003B4F00200002, 0x80003B5000200002, 0x80003B5100200002, 0x80003B5200200002, 0x80003B5300200002, 0x80003B5400200002, 0x80003B5500200002,
+		0x80003B5600200002, 0x80003B5700200002, 0x80003B5800200002, 0x80003B5900200002, 0x80003B5A00200002, 0x80003B5B00200002, 0x80003B5C00200002, 0x80003B5D00200002,
+		0x80003B5E00200002, 0x80003B5F00200002, 0x80003B6000200002, 0x80003B6100200002, 0x80003B6200200002, 0x80003B6300200002, 0x80003B6400200002, 0x80003B6500200002,
+		0x80003B6600200002, 0x80003B6700200002, 0x80003B6800200002, 0x80003B6900200002, 0x80003B6A00200002, 0x80003B6B00200002, 0x80003B6C00200002, 0x80003B6D00200002,
+		0x80003B6E00200002, 0x80003B6F00200002, 0x80003B7000200002, 0x80003B7100200002, 0x80003B7200200002, 0x80003B7300200002, 0x80003B7400200002, 0x80003B7500200002,
+		0x80003B7600200002, 0x80003B7700200002, 0x80003B7800200002, 0x80003B7900200002, 0x80003B7A00200002, 0x80003B7B00200002, 0x80003B7C00200002, 0x80003B7D00200002,
+		0x80003B7E00200002, 0x80003B7F00200002, 0x80003B8000200002, 0x80003B8100200002, 0x80003B8200200002, 0x80003B8300200002, 0x80003B8400200002, 0x80003B8500200002,
+		0x80003B8600200002, 0x80003B8700200002, 0x80003B8800200002, 0x80003B8900200002, 0x80003B8A00200002, 0x80003B8B00200002, 0x80003B8C00200002, 0x80003B8D00200002,
+		0x80003B8E00200002, 0x80003B8F00200002, 0x80003B9000200002, 0x80003B9100200002, 0x80003B9200200002, 0x80003B9300200002, 0x80003B9400200002, 0x80003B9500200002,
+		0x80003B9600200002, 0x80003B9700200002, 0x80003B9800200002, 0x80003B9900200002, 0x80003B9A00200002, 0x80003B9B00200002, 0x80003B9C00200002, 0x80003B9D00200002,
+		0x80003B9E00200002, 0x80003B9F00200002, 0x80003BA000200002, 0x80003BA100200002, 0x80003BA200200002, 0x80003BA300200002, 0x80003BA400200002, 0x80003BA500200002,
+		0x80003BA600200002, 0x80003BA700200002, 0x80003BA800200002, 0x80003BA900200002, 0x80003BAA00200002, 0x80003BAB00200002, 0x80003BAC00200002, 0x80003BAD00200002,
+		0x80003BAE00200002, 0x80003BAF00200002, 0x80003BB000200002, 0x80003BB100200002, 0x80003BB200200002, 0x80003BB300200002, 0x80003BB400200002, 0x80003BB500200002,
+		0x80003BB600200002, 0x80003BB700200002, 0x80003BB800200002, 0x80003BB900200002, 0x80003BBA00200002, 0x80003BBB00200002, 0x80003BBC00200002, 0x80003BBD00200002,
+		0x80003BBE00200002, 0x80003BBF00200002, 0x80003BC000200002, 0x80003BC100200002, 0x80003BC200200002, 0x80003BC300200002, 0x80003BC400200002, 0x80003BC500200002,
+		0x80003BC600200002, 0x80003BC700200002, 0x80003BC800200002, 0x80003BC900200002, 0x80003BCA00200002, 0x80003BCB00200002, 0x80003BCC00200002, 0x80003BCD00200002,
+		0x80003BCE00200002, 0x80003BCF00200002, 0x80003BD000200002, 0x80003BD100200002, 0x80003BD200200002, 0, 0, 0x80001C3E00200002,
+		0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002, 0x80001C4500200002, 0x80001C4600200002,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x80003BD300200008, 0x80003BD400200008, 0x80003BD500200008, 0x80003BD600200008, 0x80003BD700200008, 0x80003BD800200008, 0x80003BD900200008, 0x80003BDA00200008,
+		0x80003BDB00200008, 0x80003BDC00200008, 0x80003BDD00200008, 0x80003BDE00200008, 0x80003BDF00200008, 0x80003BE000200008, 0x80003BE100200008, 0x80003BE200200008,
+		0x80003BE300200008, 0x80003BE400200008, 0x80003BE500200008, 0x80003BE600200008, 0x80003BE700200008, 0x80003BE800200008, 0x80003BE900200008, 0x80003BEA00200008,
+		0x80003BEB00200008, 0x80003BEC00200008, 0x80003BED00200008, 0x80003BEE00200008, 0x80003BEF00200008, 0x80003BF000200008, 0x80003BF100200008, 0x80003BF200200008,
+		0x80003BF300200008, 0x80003BF400200008, 0x80003BD300200002, 0x80003BD400200002, 0x80003BD500200002, 0x80003BD600200002, 0x80003BD700200002, 0x80003BD800200002,
+		0x80003BD900200002, 0x80003BDA00200002, 0x80003BDB00200002, 0x80003BDC00200002, 0x80003BDD00200002, 0x80003BDE00200002, 0x80003BDF00200002, 0x80003BE000200002,
+		0x80003BE100200002, 0x80003BE200200002, 0x80003BE300200002, 0x80003BE400200002, 0x80003BE500200002, 0x80003BE600200002, 0x80003BE700200002, 0x80003BE800200002,
+		0x80003BE900200002, 0x80003BEA00200002, 0x80003BEB00200002, 0x80003BEC00200002, 0x80003BED00200002, 0x80003BEE00200002, 0x80003BEF00200002, 0x80003BF000200002,
+		0x80003BF100200002, 0x80003BF200200002, 0x80003BF300200002, 0x80003BF400200002, 0x8000000000BD0002, 0x8000000000BD0002, 0x8000000000BD0002, 0x8000000000BF0002,
+		0x8000000000C00002, 0x8000000000C10002, 0x8000000000BE0002, 0, 0, 0, 0, 0,
+		0x80001C3D00200002, 0x80001C3E00200002, 0x80001C3F00200002, 0x80001C4000200002, 0x80001C4100200002, 0x80001C4200200002, 0x80001C4300200002, 0x80001C4400200002,
+		0x80001C4500200002, 0x80001C4600200002, 0, 0, 0, 0, 0x8000026500200002, 0x8000027400200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000230B00200005, 0x8000230D00200005, 0x8000232500200005, 0x8000233700200005, 0, 0x800023B700200005, 0x8000234700200005, 0x8000232C00200005,
+		0x8000236A00200005, 0x800023C600200005, 0x8000238700200005, 0x8000239C00200005, 0x800023A300200005, 0x800023A700200005, 0x8000235900200005, 0x8000236E00200005,
+		0x8000237600200005, 0x8000236400200005, 0x8000238200200005, 0x8000234600200005, 0x8000235A00200005, 0x8000231D00200005, 0x8000231E00200005, 0x8000232D00200005,
+		0x8000233800200005, 0x8000236500200005, 0x8000236B00200005, 0x8000236F00200005, 0x8000230C00200005, 0x800023A800200005, 0x8000237700200005, 0x8000238100200005,
+		0, 0x8000230D00200005, 0x8000232500200005, 0, 0x800023B100200005, 0, 0, 0x8000232C00200005,
+		0, 0x800023C600200005, 0x8000238700200005, 0x8000239C00200005, 0x800023A300200005, 0x800023A700200005, 0x8000235900200005, 0x8000236E00200005,
+		0x8000237600200005, 0x8000236400200005, 0x8000238200200005, 0, 0x8000235A00200005, 0x8000231D00200005, 0x8000231E00200005, 0x8000232D00200005,
+		0, 0x8000236500200005, 0, 0x8000236F00200005, 0, 0, 0, 0,
+		0, 0, 0x8000232500200005, 0, 0, 0, 0, 0x8000232C00200005,
+		0, 0x800023C600200005, 0, 0x8000239C00200005, 0, 0x800023A700200005, 0x8000235900200005, 0x8000236E00200005,
+		0, 0x8000236400200005, 0x8000238200200005, 0, 0x8000235A00200005, 0, 0, 0x8000232D00200005,
+		0, 0x8000236500200005, 0, 0x8000236F00200005, 0, 0x800023A800200005, 0, 0x8000238100200005,
+		0, 0x8000230D00200005, 0x8000232500200005, 0, 0x800023B100200005, 0, 0, 0x8000232C00200005,
+		0x8000236A00200005, 0x800023C600200005, 0x8000238700200005, 0, 0x800023A300200005, 0x800023A700200005, 0x8000235900200005, 0x8000236E00200005,
+		0x8000237600200005, 0x8000236400200005, 0x8000238200200005, 0, 0x8000235A00200005, 0x8000231D00200005, 0x8000231E00200005, 0x8000232D00200005,
+		0, 0x8000236500200005, 0x8000236B00200005, 0x8000236F00200005, 0x8000230C00200005, 0, 0x8000237700200005, 0,
+		0x8000230B00200005, 0x8000230D00200005, 0x8000232500200005, 0x8000233700200005, 0x800023B100200005, 0x800023B700200005, 0x8000234700200005, 0x8000232C00200005,
+		0x8000236A00200005, 0x800023C600200005, 0, 0x8000239C00200005, 0x800023A300200005, 0x800023A700200005, 0x8000235900200005, 0x8000236E00200005,
+		0x8000237600200005, 0x8000236400200005, 0x8000238200200005, 0x8000234600200005, 0x8000235A00200005, 0x8000231D00200005, 0x8000231E00200005, 0x8000232D00200005,
+		0x8000233800200005, 0x8000236500200005, 0x8000236B00200005, 0x8000236F00200005, 0, 0, 0, 0,
+		0, 0x8000230D00200005, 0x8000232500200005, 0x8000233700200005, 0, 0x800023B700200005, 0x8000234700200005, 0x8000232C00200005,
+		0x8000236A00200005, 0x800023C600200005, 0, 0x8000239C00200005, 0x800023A300200005, 0x800023A700200005, 0x8000235900200005, 0x8000236E00200005,
+		0x8000237600200005, 0x8000236400200005, 0x8000238200200005, 0x8000234600200005, 0x8000235A00200005, 0x8000231D00200005, 0x8000231E00200005, 0x8000232D00200005,
+		0x8000233800200005, 0x8000236500200005, 0x8000236B00200005, 0x8000236F00200005, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800004FB00200002, 0x800004FC00200002, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800011C700200002, 0x800011C800200002, 0x800011C900200002, 0x800011CA00200002, 0x800011CB00200002, 0x800011CC00200002, 0x800011CD00200002, 0x800011CE00200002,
+		0x800011CF00200002, 0x800011D000200002, 0x800011D100200002, 0x800011D200200002, 0x800011D300200002, 0x800011D400200002, 0x800011D500200002, 0x800011D600200002,
+		0x800011D700200002, 0x800011D800200002, 0x800011D900200002, 0x800011DA00200002, 0x800011DB00200002, 0x800011DC00200002, 0x800011DD00200002, 0x800011DE00200002,
+		0x800011DF00200002, 0x800011E000200002, 0x800011E100200002, 0x800011E200200002, 0x800011E300200002, 0x800011E400200002, 0x800011E500200002, 0x800011E600200002,
+		0x800011E700200002, 0x800011E800200002, 0x800011E900200002, 0x800011EA00200002, 0x800011EB00200002, 0x800011EC00200002, 0x800011ED00200002, 0x800011EE00200002,
+		0x800011EF00200002, 0x800011F000200002, 0x800011F100200002, 0x800011F200200002, 0, 0, 0, 0,
+		0x800011F300200002, 0x800011F400200002, 0x800011F500200002, 0x800011F600200002, 0x800011F700200002, 0x800011F800200002, 0x800011F900200002, 0x800011FA00200002,
+		0x800011FB00200002, 0x800011FC00200002, 0x800011FD00200002, 0x800011FE00200002, 0x800011FF00200002, 0x8000120000200002, 0x8000120100200002, 0x8000120200200002,
+		0x8000120300200002, 0x8000120400200002, 0x8000120500200002, 0x8000120600200002, 0x8000120700200002, 0x8000120800200002, 0x8000120900200002, 0x8000120A00200002,
+		0x8000120B00200002, 0x8000120C00200002, 0x8000120D00200002, 0x8000120E00200002, 0x8000120F00200002, 0x8000121000200002, 0x8000121100200002, 0x8000121200200002,
+		0x8000121300200002, 0x8000121400200002, 0x8000121500200002, 0x8000121600200002, 0x8000121700200002, 0x8000121800200002, 0x8000121900200002, 0x8000121A00200002,
+		0x8000121B00200002, 0x8000121C00200002, 0x8000121D00200002, 0x8000121E00200002, 0x8000121F00200002, 0x8000122000200002, 0x8000122100200002, 0x8000122200200002,
+		0x8000122300200002, 0x8000122400200002, 0x8000122500200002, 0x8000122600200002, 0x8000122700200002, 0x8000122800200002, 0x8000122900200002, 0x8000122A00200002,
+		0x8000122B00200002, 0x8000122C00200002, 0x8000122D00200002, 0x8000122E00200002, 0x8000122F00200002, 0x8000123000200002, 0x8000123100200002, 0x8000123200200002,
+		0x8000123300200002, 0x8000123400200002, 0x8000123500200002, 0x8000123600200002, 0x8000123700200002, 0x8000123800200002, 0x8000123900200002, 0x8000123A00200002,
+		0x8000123B00200002, 0x8000123C00200002, 0x8000123D00200002, 0x8000123E00200002, 0x8000123F00200002, 0x8000124000200002, 0x8000124100200002, 0x8000124200200002,
+		0x8000124300200002, 0x8000124400200002, 0x8000124500200002, 0x8000124600200002, 0x8000124700200002, 0x8000124800200002, 0x8000124900200002, 0x8000124A00200002,
+		0x8000124B00200002, 0x8000124C00200002, 0x8000124D00200002, 0x8000124E00200002, 0x8000124F00200002, 0x8000125000200002, 0x8000125100200002, 0x8000125200200002,
+		0x8000125300200002, 0x8000125400200002, 0x8000125500200002, 0x8000125600200002, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000125700200002, 0x8000125800200002, 0x8000125900200002, 0x8000125A00200002, 0x8000125B00200002, 0x8000125C00200002, 0x8000125D00200002, 0x8000125E00200002,
+		0x8000125F00200002, 0x8000126000200002, 0x8000126100200002, 0x8000126200200002, 0x8000126300200002, 0x8000126400200002, 0x8000126500200002, 0,
+		0, 0x8000126600200002, 0x8000126700200002, 0x8000126800200002, 0x8000126900200002, 0x8000126A00200002, 0x8000126B00200002, 0x8000126C00200002,
+		0x8000126D00200002, 0x8000126E00200002, 0x8000126F00200002, 0x8000127000200002, 0x8000127100200002, 0x8000127200200002, 0x8000127300200002, 0x8000127400200002,
+		0, 0x8000127500200002, 0x8000127600200002, 0x8000127700200002, 0x8000127800200002, 0x8000127900200002, 0x8000127A00200002, 0x8000127B00200002,
+		0x8000127C00200002, 0x8000127D00200002, 0x8000127E00200002, 0x8000127F00200002, 0x8000128000200002, 0x8000128100200002, 0x8000128200200002, 0x8000128300200002,
+		0, 0x8000128400200002, 0x8000128500200002, 0x8000128600200002, 0x8000128700200002, 0x8000128800200002, 0x8000128900200002, 0x8000128A00200002,
+		0x8000128B00200002, 0x8000128C00200002, 0x8000128D00200002, 0x8000128E00200002, 0x8000128F00200002, 0x8000129000200002, 0x8000129100200002, 0x8000129200200002,
+		0x8000129300200002, 0x8000129400200002, 0x8000129500200002, 0x8000129600200002, 0x8000129700200002, 0x8000129800200002, 0x8000129900200002, 0x8000129A00200002,
+		0x8000129B00200002, 0x8000129C00200002, 0x8000129D00200002, 0x8000129E00200002, 0x8000129F00200002, 0x800012A000200002, 0x800012A100200002, 0x800012A200200002,
+		0x800012A300200002, 0x800012A400200002, 0x800012A500200002, 0x800012A600200002, 0x800012A700200002, 0x800012A800200002, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x40000000001D8C02, 0x40000000001D8E02, 0x40000000001D9002, 0x40000000001D9202, 0x40000000001D9402, 0x40000000001D9602, 0x40000000001D9802, 0x40000000001D9A02,
+		0x40000000001D9C02, 0x40000000001D9E02, 0x40000000001DA002, 0x80001C3D00200006, 0x80001C3D00200006, 0, 0, 0,
+		0x40000000001DA203, 0x40000000001DA503, 0x40000000001DA803, 0x40000000001DAB03, 0x40000000001DAE03, 0x40000000001DB103, 0x40000000001DB403, 0x40000000001DB703,
+		0x40000000001DBA03, 0x40000000001DBD03, 0x40000000001DC003, 0x40000000001DC303, 0x40000000001DC603, 0x40000000001DC903, 0x40000000001DCC03, 0x40000000001DCF03,
+		0x40000000001DD203, 0x40000000001DD503, 0x40000000001DD803, 0x40000000001DDB03, 0x40000000001DDE03, 0x40000000001DE103, 0x40000000001DE403, 0x40000000001DE703,
+		0x40000000001DEA03, 0x40000000001DED03, 0x40000000001DF003, 0x80001C7A0020000C, 0x80001E330020000C, 0x40000000001DF302, 0x40000000001DF502, 0,
+		0x80001C470020001D, 0x80001C600020001D, 0x80001C7A0020001D, 0x80001C8F0020001D, 0x80001CAA0020001D, 0x80001CE50020001D, 0x80001CF40020001D, 0x80001D180020001D,
+		0x80001D320020001D, 0x80001D4C0020001D, 0x80001D650020001D, 0x80001D770020001D, 0x80001DAA0020001D, 0x80001DB90020001D, 0x80001DDD0020001D, 0x80001E0C0020001D,
+		0x80001E210020001D, 0x80001E330020001D, 0x80001E710020001D, 0x80001E950020001D, 0x80001EB50020001D, 0x80001EE30020001D, 0x80001EF50020001D, 0x80001EFF0020001D,
+		0x80001F0B0020001D, 0x80001F210020001D, 0x40000000001DF702, 0x40000000001DF902, 0x40000000001DFB02, 0x40000000001DFD02, 0x40000000001DFF03, 0x40000000001E0202,
+		0x80001C470020000C, 0x80001C600020000C, 0x80001C7A0020000C, 0x80001C8F0020000C, 0x80001CAA0020000C, 0x80001CE50020000C, 0x80001CF40020000C, 0x80001D180020000C,
+		0x80001D320020000C, 0x80001D4C0020000C, 0x80001D650020000C, 0x80001D770020000C, 0x80001DAA0020000C, 0x80001DB90020000C, 0x80001DDD0020000C, 0x80001E0C0020000C,
+		0x80001E210020000C, 0x80001E330020000C, 0x80001E710020000C, 0x80001E950020000C, 0x80001EB50020000C, 0x80001EE30020000C, 0x80001EF50020000C, 0x80001EFF0020000C,
+		0x80001F0B0020000C, 0x80001F210020000C, 0x40000000001E0402, 0x40000000001E0602, 0, 0, 0, 0,
+		0x80001C470020001D, 0x80001C600020001D, 0x80001C7A0020001D, 0x80001C8F0020001D, 0x80001CAA0020001D, 0x80001CE50020001D, 0x80001CF40020001D, 0x80001D180020001D,
+		0x80001D320020001D, 0x80001D4C0020001D, 0x80001D650020001D, 0x80001D770020001D, 0x80001DAA0020001D, 0x80001DB90020001D, 0x80001DDD0020001D, 0x80001E0C0020001D,
+		0x80001E210020001D, 0x80001E330020001D, 0x80001E710020001D, 0x80001E950020001D, 0x80001EB50020001D, 0x80001EE30020001D, 0x80001EF50020001D, 0x80001EFF0020001D,
+		0x80001F0B0020001D, 0x80001F210020001D, 0x80001E0C0020001D, 0x40000000001E0802, 0x40000000001E0A02, 0x40000000001E0C02, 0x40000000001E0E02, 0x40000000001E1002,
+		0x40000000001E1202, 0x40000000001E1402, 0x40000000001E1604, 0x40000000001E1A04, 0x40000000001E1E02, 0x40000000001E2003, 0x40000000001E2302, 0x40000000001E2502,
+		0x40000000001E2703, 0x40000000001E2A03, 0x40000000001E2D02, 0x40000000001E2F02, 0x40000000001E3107, 0x40000000001E3802, 0x40000000001E3A02, 0x40000000001E3C02,
+		0x40000000001E3E03, 0x40000000001E4103, 0x40000000001E4404, 0x40000000001E4803, 0x40000000001E4B04, 0x80001C8F0020001C, 0x40000000001E4F02, 0x40000000001E5103,
+		0x40000000001E5406, 0x40000000001E5A08, 0x40000000001E6203, 0x40000000001E6503, 0x40000000001E6803, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0x80000A0700200002, 0x80000A0800200002,
+		0x80000A0900200002, 0x80000A0A00200002, 0x80000A0B00200002, 0x80000A0C00200002, 0x80000A0D00200002, 0x80000A0E00200002, 0x80000A0F00200002, 0x80000A1000200002,
+		0x80000A1100200002, 0x80000A1200200002, 0x80000A1300200002, 0x80000A1400200002, 0x80000A1500200002, 0x80000A1600200002, 0x80000A1700200002, 0x80000A1800200002,
+		0x80000A1900200002, 0x80000A1A00200002, 0x80000A1B00200002, 0x80000A1C00200002, 0x80000A1D00200002, 0x80000A1E00200002, 0x80000A1F00200002, 0x80000A2000200002,
+		0x40000000001E6B02, 0x40000000001E6D02, 0x80003D650020001C, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x40000000001E6F02, 0x40000000001E7102, 0x40000000001E7302, 0x40000000001E7502, 0x40000000001E7702, 0x40000000001E7902, 0x40000000001E7B02, 0x40000000001E7D02,
+		0x40000000001E7F02, 0x40000000001E8102, 0x40000000001E8302, 0x40000000001E8502, 0x40000000001E8702, 0x40000000001E8902, 0x40000000001E8B02, 0x40000000001E8D02,
+		0x40000000001E8F02, 0x40000000001E9102, 0x40000000001E9302, 0x40000000001E9502, 0x40000000001E9702, 0x40000000001E9902, 0x40000000001E9B02, 0x40000000001E9D02,
+		0x40000000001E9F02, 0x40000000001EA102, 0x40000000001EA302, 0x40000000001EA502, 0x40000000001EA702, 0x40000000001EA902, 0x40000000001EAB02, 0x40000000001EAD02,
+		0x40000000001EAF02, 0x40000000001EB102, 0x40000000001EB302, 0x40000000001EB502, 0x40000000001EB702, 0x40000000001EB902, 0x40000000001EBB02, 0x40000000001EBD02,
+		0x40000000001EBF02, 0x40000000001EC102, 0x40000000001EC302, 0x40000000001EC502, 0, 0, 0, 0,
+		0x40000000001EC704, 0x40000000001ECB04, 0x40000000001ECF04, 0x40000000001ED304, 0x40000000001ED704, 0x40000000001EDB04, 0x40000000001EDF04, 0x40000000001EE304,
+		0x40000000001EE704, 0, 0, 0, 0, 0, 0, 0,
+		0x40000000001EEB02, 0x40000000001EED02, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800012A900200002, 0x800012AA00200002, 0x800012AB00200002, 0x800012AC00200002, 0x800012AD00200002, 0x800012AE00200002, 0x800012AF00200002, 0x800012B000200002,
+		0x800012B100200002, 0x800012B200200002, 0x800012B300200002, 0x800012B400200002, 0x800012B500200002, 0x800012B600200002, 0x800012B700200002, 0x800012B800200002,
+		0x800012B900200002, 0x800012BA00200002, 0x800012BB00200002, 0x800012BC00200002, 0x800012BD00200002, 0x800012BE00200002, 0x800012BF00200002, 0x800012C000200002,
+		0x800012C100200002, 0x800012C200200002, 0x800012C300200002, 0x800012C400200002, 0x800012C500200002, 0x800012C600200002, 0x800012C700200002, 0x800012C800200002,
+		0x800012C900200002, 0x800012CA00200002, 0x800012CB00200002, 0x800012CC00200002, 0x800012CD00200002, 0x800012CE00200002, 0x800012CF00200002, 0x800012D000200002,
+		0x800012D100200002, 0x800012D200200002, 0x800012D300200002, 0x800012D400200002, 0x800012D500200002, 0x800012D600200002, 0x800012D700200002, 0x800012D800200002,
+		0x800012D900200002, 0x800012DA00200002, 0x800012DB00200002, 0x800012DC00200002, 0x800012DD00200002, 0x800012DE00200002, 0x800012DF00200002, 0x800012E000200002,
+		0x800012E100200002, 0x800012E200200002, 0x800012E300200002, 0x800012E400200002, 0x800012E500200002, 0x800012E600200002, 0x800012E700200002, 0x800012E800200002,
+		0x800012E900200002, 0x800012EA00200002, 0x800012EB00200002, 0x800012EC00200002, 0x800012ED00200002, 0x800012EE00200002, 0x800012EF00200002, 0x800012F000200002,
+		0x800012F100200002, 0x800012F200200002, 0x800012F300200002, 0x800012F400200002, 0x800012F500200002, 0x800012F600200002, 0x800012F700200002, 0x800012F800200002,
+		0x800012F900200002, 0x800012FA00200002, 0x800012FB00200002, 0x800012FC00200002, 0x800012FD00200002, 0x800012FE00200002, 0x800012FF00200002, 0x8000130000200002,
+		0x8000130100200002, 0x8000130200200002, 0x8000130300200002, 0x8000130400200002, 0x8000130500200002, 0x8000130600200002, 0x8000130700200002, 0x8000130800200002,
+		0x8000130900200002, 0x8000130A00200002, 0x8000130B00200002, 0x8000130C00200002, 0x8000130D00200002, 0x8000130E00200002, 0x8000130F00200002, 0x8000131000200002,
+		0x8000131100200002, 0x8000131200200002, 0x8000131300200002, 0x8000131400200002, 0x8000131500200002, 0x8000131600200002, 0x8000131700200002, 0x8000131800200002,
+		0x8000131900200002, 0x8000131A00200002, 0x8000131B00200002, 0x8000131C00200002, 0x8000131D00200002, 0x8000131E00200002, 0x8000131F00200002, 0x8000132000200002,
+		0x8000132100200002, 0x8000132200200002, 0x8000132300200002, 0x8000132400200002, 0x8000132500200002, 0x8000132600200002, 0x8000132700200002, 0x8000132800200002,
+		0x8000132900200002, 0x8000132A00200002, 0x8000132B00200002, 0x8000132C00200002, 0x8000132D00200002, 0x8000132E00200002, 0x8000132F00200002, 0x8000133000200002,
+		0x8000133100200002, 0x8000133200200002, 0x8000133300200002, 0x8000133400200002, 0x8000133500200002, 0x8000133600200002, 0x8000133700200002, 0x8000133800200002,
+		0x8000133900200002, 0x8000133A00200002, 0x8000133B00200002, 0x8000133C00200002, 0x8000133D00200002, 0x8000133E00200002, 0x8000133F00200002, 0x8000134000200002,
+		0x8000134100200002, 0x8000134200200002, 0x8000134300200002, 0x8000134400200002, 0x8000134500200002, 0x8000134600200002, 0x8000134700200002, 0x8000134800200002,
+		0x8000134900200002, 0x8000134A00200002, 0x8000134B00200002, 0x8000134C00200002, 0x8000134D00200002, 0x8000134E00200002, 0x8000134F00200002, 0x8000135000200002,
+		0x8000135100200002, 0x8000135200200002, 0x8000135300200002, 0x8000135400200002, 0x8000135500200002, 0x8000135600200002, 0x8000135700200002, 0x8000135800200002,
+		0x8000135900200002, 0x8000135A00200002, 0x8000135B00200002, 0x8000135C00200002, 0x8000135D00200002, 0x8000135E00200002, 0x8000135F00200002, 0x8000136000200002,
+		0x8000136100200002, 0x8000136200200002, 0x8000136300200002, 0x8000136400200002, 0x8000136500200002, 0x8000136600200002, 0x8000136700200002, 0x8000136800200002,
+		0x8000136900200002, 0x8000136A00200002, 0x8000136B00200002, 0x8000136C00200002, 0x8000136D00200002, 0x8000136E00200002, 0x8000136F00200002, 0x8000137000200002,
+		0x8000137100200002, 0x8000137200200002, 0x8000137300200002, 0x8000137400200002, 0x8000137500200002, 0x8000137600200002, 0x8000137700200002, 0x8000137800200002,
+		0x8000137900200002, 0x8000137A00200002, 0x8000137B00200002, 0x8000137C00200002, 0x8000137D00200002, 0x8000137E00200002, 0x8000137F00200002, 0x8000138000200002,
+		0x8000138100200002, 0x8000138200200002, 0x8000138300200002, 0x8000138400200002, 0x8000138500200002, 0x8000138600200002, 0x8000138700200002, 0x8000138800200002,
+		0x8000138900200002, 0x8000138A00200002, 0x8000138B00200002, 0x8000138C00200002, 0x8000138D00200002, 0x8000138E00200002, 0x8000138F00200002, 0x8000139000200002,
+		0x8000139100200002, 0x8000139200200002, 0x8000139300200002, 0x8000139400200002, 0x8000139500200002, 0x8000139600200002, 0x8000139700200002, 0x8000139800200002,
+		0x8000139900200002, 0x8000139A00200002, 0x8000139B00200002, 0x8000139C00200002, 0x8000139D00200002, 0x8000139E00200002, 0x8000139F00200002, 0x800013A000200002,
+		0x800013A100200002, 0x800013A200200002, 0x800013A300200002, 0x800013A400200002, 0x800013A500200002, 0x800013A600200002, 0x800013A700200002, 0x800013A800200002,
+		0x800013A900200002, 0x800013AA00200002, 0x800013AB00200002, 0x800013AC00200002, 0x800013AD00200002, 0x800013AE00200002, 0x800013AF00200002, 0x800013B000200002,
+		0x800013B100200002, 0x800013B200200002, 0x800013B300200002, 0x800013B400200002, 0x800013B500200002, 0x800013B600200002, 0x800013B700200002, 0x800013B800200002,
+		0x800013B900200002, 0x800013BA00200002, 0x800013BB00200002, 0x800013BC00200002, 0x800013BD00200002, 0x800013BE00200002, 0x800013BF00200002, 0x800013C000200002,
+		0x800013C100200002, 0x800013C200200002, 0x800013C300200002, 0x800013C400200002, 0x800013C500200002, 0x800013C600200002, 0x800013C700200002, 0x800013C800200002,
+		0x800013C900200002, 0x800013CA00200002, 0x800013CB00200002, 0x800013CC00200002, 0x800013CD00200002, 0x800013CE00200002, 0x800013CF00200002, 0x800013D000200002,
+		0x800013D100200002, 0x800013D200200002, 0x800013D300200002, 0x800013D400200002, 0x800013D500200002, 0x800013D600200002, 0x800013D700200002, 0x800013D800200002,
+		0x800013D900200002, 0x800013DA00200002, 0x800013DB00200002, 0x800013DC00200002, 0x800013DD00200002, 0x800013DE00200002, 0x800013DF00200002, 0x800013E000200002,
+		0x800013E100200002, 0x800013E200200002, 0x800013E300200002, 0x800013E400200002, 0x800013E500200002, 0x800013E600200002, 0x800013E700200002, 0x800013E800200002,
+		0x800013E900200002, 0x800013EA00200002, 0x800013EB00200002, 0x800013EC00200002, 0x800013ED00200002, 0x800013EE00200002, 0x800013EF00200002, 0x800013F000200002,
+		0x800013F100200002, 0x800013F200200002, 0x800013F300200002, 0x800013F400200002, 0x800013F500200002, 0x800013F600200002, 0x800013F700200002, 0x800013F800200002,
+		0x800013F900200002, 0x800013FA00200002, 0x800013FB00200002, 0x800013FC00200002, 0x800013FD00200002, 0x800013FE00200002, 0x800013FF00200002, 0x8000140000200002,
+		0x8000140100200002, 0x8000140200200002, 0x8000140300200002, 0x8000140400200002, 0x8000140500200002, 0x8000140600200002, 0x8000140700200002, 0x8000140800200002,
+		0x8000140900200002, 0x8000140A00200002, 0x8000140B00200002, 0x8000140C00200002, 0x8000140D00200002, 0x8000140E00200002, 0x8000140F00200002, 0x8000141000200002,
+		0x8000141100200002, 0x8000141200200002, 0x8000141300200002, 0x8000141400200002, 0x8000141500200002, 0x8000141600200002, 0x8000141700200002, 0x8000141800200002,
+		0x8000141900200002, 0x8000141A00200002, 0x8000141B00200002, 0x8000141C00200002, 0x8000141D00200002, 0x8000141E00200002, 0x8000141F00200002, 0x8000142000200002,
+		0x8000142100200002, 0x8000142200200002, 0x8000142300200002, 0x8000142400200002, 0x8000142500200002, 0x8000142600200002, 0x8000142700200002, 0x8000142800200002,
+		0x8000142900200002, 0x8000142A00200002, 0x8000142B00200002, 0x8000142C00200002, 0x8000142D00200002, 0x8000142E00200002, 0x8000142F00200002, 0x8000143000200002,
+		0x8000143100200002, 0x8000143200200002, 0x8000143300200002, 0x8000143400200002, 0x8000143500200002, 0x8000143600200002, 0x8000143700200002, 0x8000143800200002,
+		0x8000143900200002, 0x8000143A00200002, 0x8000143B00200002, 0x8000143C00200002, 0x8000143D00200002, 0x8000143E00200002, 0x8000143F00200002, 0x8000144000200002,
+		0x8000144100200002, 0x8000144200200002, 0x8000144300200002, 0x8000144400200002, 0x8000144500200002, 0x8000144600200002, 0x8000144700200002, 0x8000144800200002,
+		0x8000144900200002, 0x8000144A00200002, 0x8000144B00200002, 0x8000144C00200002, 0x8000144D00200002, 0x8000144E00200002, 0x8000144F00200002, 0x8000145000200002,
+		0x8000145100200002, 0x8000145200200002, 0x8000145300200002, 0x8000145400200002, 0x8000145500200002, 0x8000145600200002, 0x8000145700200002, 0x8000145800200002,
+		0x8000145900200002, 0x8000145A00200002, 0x8000145B00200002, 0x8000145C00200002, 0x8000145D00200002, 0x8000145E00200002, 0x8000145F00200002, 0x8000146000200002,
+		0x8000146100200002, 0x8000146200200002, 0x8000146300200002, 0x8000146400200002, 0x8000146500200002, 0x8000146600200002, 0x8000146700200002, 0x8000146800200002,
+		0x8000146900200002, 0x8000146A00200002, 0x8000146B00200002, 0x8000146C00200002, 0x8000146D00200002, 0x8000146E00200002, 0x8000146F00200002, 0x8000147000200002,
+		0x8000147100200002, 0x8000147200200002, 0x8000147300200002, 0x8000147400200002, 0x8000147500200002, 0x8000147600200002, 0x8000147700200002, 0x8000147800200002,
+		0x8000147900200002, 0x8000147A00200002, 0x8000147B00200002, 0x8000147C00200002, 0x8000147D00200002, 0x8000147E00200002, 0x8000147F00200002, 0x8000148000200002,
+		0x8000148100200002, 0x8000148200200002, 0x8000148300200002, 0x8000148400200002, 0x8000148500200002, 0x8000148600200002, 0x8000148700200002, 0x8000148800200002,
+		0x8000148900200002, 0x8000148A00200002, 0x8000148B00200002, 0x8000148C00200002, 0x8000148D00200002, 0x8000148E00200002, 0x8000148F00200002, 0x8000149000200002,
+		0x8000149100200002, 0x8000149200200002, 0x8000149300200002, 0x8000149400200002, 0x8000149500200002, 0x8000149600200002, 0x8000149700200002, 0x8000149800200002,
+		0x8000149900200002, 0x8000149A00200002, 0x8000149B00200002, 0x8000149C00200002, 0x8000149D00200002, 0x8000149E00200002, 0x8000149F00200002, 0x800014A000200002,
+		0x800014A100200002, 0x800014A200200002, 0x800014A300200002, 0x800014A400200002, 0x800014A500200002, 0x800014A600200002, 0x800014A700200002, 0x800014A800200002,
+		0x800014A900200002, 0x800014AA00200002, 0x800014AB00200002, 0x800014AC00200002, 0x800014AD00200002, 0x800014AE00200002, 0x800014AF00200002, 0x800014B000200002,
+		0x800014B100200002, 0x800014B200200002, 0x800014B300200002, 0x800014B400200002, 0x800014B500200002, 0x800014B600200002, 0x800014B700200002, 0x800014B800200002,
+		0x800014B900200002, 0x800014BA00200002, 0x800014BB00200002, 0x800014BC00200002, 0x800014BD00200002, 0x800014BE00200002, 0x800014BF00200002, 0x800014C000200002,
+		0x800014C100200002, 0x800014C200200002, 0x800014C300200002, 0x800014C400200002, 0x800014C500200002, 0x800014C600200002, 0x800014C700200002, 0x800014C800200002,
+		0x800014C900200002, 0x800014CA00200002, 0x800014CB00200002, 0x800014CC00200002, 0x800014CD00200002, 0x800014CE00200002, 0x800014CF00200002, 0x800014D000200002,
+		0x800014D100200002, 0x800014D200200002, 0x800014D300200002, 0x800014D400200002, 0x800014D500200002, 0x800014D600200002, 0x800014D700200002, 0x800014D800200002,
+		0x800014D900200002, 0x800014DA00200002, 0x800014DB00200002, 0x800014DC00200002, 0x800014DD00200002, 0x800014DE00200002, 0x800014DF00200002, 0x800014E000200002,
+		0x800014E100200002, 0x800014E200200002, 0x800014E300200002, 0x800014E400200002, 0x800014E500200002, 0x800014E600200002, 0x800014E700200002, 0x800014E800200002,
+		0x800014E900200002, 0x800014EA00200002, 0x800014EB00200002, 0x800014EC00200002, 0x800014ED00200002, 0x800014EE00200002, 0x800014EF00200002, 0x800014F000200002,
+		0x800014F100200002, 0x800014F200200002, 0x800014F300200002, 0x800014F400200002, 0x800014F500200002, 0x800014F600200002, 0x800014F700200002, 0x800014F800200002,
+		0x800014F900200002, 0x800014FA00200002, 0x800014FB00200002, 0x800014FC00200002, 0x800014FD00200002, 0x800014FE00200002, 0x800014FF00200002, 0x8000150000200002,
+		0x8000150100200002, 0x8000150200200002, 0x8000150300200002, 0x8000150400200002, 0x8000150500200002, 0x8000150600200002, 0x8000150700200002, 0x8000150800200002,
+		0x8000150900200002, 0x8000150A00200002, 0x8000150B00200002, 0x8000150C00200002, 0x8000150D00200002, 0x8000150E00200002, 0x8000150F00200002, 0x8000151000200002,
+		0x8000151100200002, 0x8000151200200002, 0x8000151300200002, 0x8000151400200002, 0x8000151500200002, 0x8000151600200002, 0x8000151700200002, 0x8000151800200002,
+		0x8000151900200002, 0x8000151A00200002, 0x8000151B00200002, 0x8000151C00200002, 0x8000151D00200002, 0x8000151E00200002, 0x8000151F00200002, 0x8000152000200002,
+		0x8000152100200002, 0x8000152200200002, 0x8000152300200002, 0x8000152400200002, 0x8000152500200002, 0x8000152600200002, 0x8000152700200002, 0x8000152800200002,
+		0x8000152900200002, 0x8000152A00200002, 0x8000152B00200002, 0x8000152C00200002, 0x8000152D00200002, 0x8000152E00200002, 0x8000152F00200002, 0x8000153000200002,
+		0x8000153100200002, 0x8000153200200002, 0x8000153300200002, 0x8000153400200002, 0x8000153500200002, 0x8000153600200002, 0x8000153700200002, 0x8000153800200002,
+		0x8000153900200002, 0x8000153A00200002, 0x8000153B00200002, 0x8000153C00200002, 0x8000153D00200002, 0x8000153E00200002, 0x8000153F00200002, 0x8000154000200002,
+		0x8000154100200002, 0x8000154200200002, 0x8000154300200002, 0x8000154400200002, 0x8000154500200002, 0x8000154600200002, 0x8000154700200002, 0x8000154800200002,
+		0x8000154900200002, 0x8000154A00200002, 0x8000154B00200002, 0x8000154C00200002, 0x8000154D00200002, 0x8000154E00200002, 0x8000154F00200002, 0x8000155000200002,
+		0x8000155100200002, 0x8000155200200002, 0x8000155300200002, 0x8000155400200002, 0x8000155500200002, 0x8000155600200002, 0x8000155700200002, 0x8000155800200002,
+		0x8000155900200002, 0x8000155A00200002, 0x8000155B00200002, 0x8000155C00200002, 0x8000155D00200002, 0x8000155E00200002, 0x8000155F00200002, 0x8000156000200002,
+		0x8000156100200002, 0x8000156200200002, 0x8000156300200002, 0x8000156400200002, 0x8000156500200002, 0x8000156600200002, 0x8000156700200002, 0x8000156800200002,
+		0x8000156900200002, 0x8000156A00200002, 0x8000156B00200002, 0x8000156C00200002, 0x8000156D00200002, 0x8000156E00200002, 0x8000156F00200002, 0x8000157000200002,
+		0x8000157100200002, 0x8000157200200002, 0x8000157300200002, 0x8000157400200002, 0x8000157500200002, 0x8000157600200002, 0x8000157700200002, 0x8000157800200002,
+		0x8000157900200002, 0x8000157A00200002, 0x8000157B00200002, 0x8000157C00200002, 0x8000157D00200002, 0x8000157E00200002, 0x8000157F00200002, 0x8000158000200002,
+		0x8000158100200002, 0x8000158200200002, 0x8000158300200002, 0x8000158400200002, 0x8000158500200002, 0x8000158600200002, 0x8000158700200002, 0x8000158800200002,
+		0x8000158900200002, 0x8000158A00200002, 0x8000158B00200002, 0x8000158C00200002, 0x8000158D00200002, 0x8000158E00200002, 0x8000158F00200002, 0x8000159000200002,
+		0x8000159100200002, 0x8000159200200002, 0x8000159300200002, 0x8000159400200002, 0x8000159500200002, 0x8000159600200002, 0x8000159700200002, 0x8000159800200002,
+		0x8000159900200002, 0x8000159A00200002, 0x8000159B00200002, 0x8000159C00200002, 0x8000159D00200002, 0x8000159E00200002, 0x8000159F00200002, 0x800015A000200002,
+		0x800015A100200002, 0x800015A200200002, 0x800015A300200002, 0x800015A400200002, 0x800015A500200002, 0x800015A600200002, 0x800015A700200002, 0x800015A800200002,
+		0x800015FB00200002, 0x800015FC00200002, 0x800015FD00200002, 0x800015FE00200002, 0x800015FF00200002, 0x8000160000200002, 0x8000160100200002, 0x8000160200200002,
+		0x8000160300200002, 0x8000160400200002, 0x8000160500200002, 0x8000160600200002, 0x8000160700200002, 0x8000160800200002, 0x8000160900200002, 0x8000160A00200002,
+		0x8000160B00200002, 0x8000160C00200002, 0x8000160D00200002, 0x8000160E00200002, 0x8000160F00200002, 0x8000161000200002, 0x8000161100200002, 0x8000161200200002,
+		0x8000161300200002, 0x8000161400200002, 0x8000161500200002, 0x8000161600200002, 0x8000161700200002, 0x8000161800200002, 0x8000161900200002, 0x8000161A00200002,
+		0x8000161B00200002, 0x8000161C00200002, 0x8000161D00200002, 0x8000161E00200002, 0x8000161F00200002, 0x8000162000200002, 0x8000162100200002, 0x8000162200200002,
+		0x8000162300200002, 0x8000162400200002, 0x8000162500200002, 0x8000162600200002, 0x8000162700200002, 0x8000162800200002, 0x8000162900200002, 0x8000162A00200002,
+		0x8000162B00200002, 0x8000162C00200002, 0x8000162D00200002, 0x8000162E00200002, 0x8000162F00200002, 0x8000163000200002, 0x8000163100200002, 0x8000163200200002,
+		0x8000163300200002, 0x8000163400200002, 0x8000163500200002, 0x8000163600200002, 0x8000163700200002, 0x8000163800200002, 0x8000163900200002, 0x8000163A00200002,
+		0x8000163B00200002, 0x8000163C00200002, 0x8000163D00200002, 0x8000163E00200002, 0x8000163F00200002, 0x8000164000200002, 0x8000164100200002, 0x8000164200200002,
+		0x8000164300200002, 0x8000164400200002, 0x8000164500200002, 0x8000164600200002, 0x8000164700200002, 0x8000164800200002, 0x8000164900200002, 0x8000164A00200002,
+		0x8000164B00200002, 0x8000164C00200002, 0x8000164D00200002, 0x8000164E00200002, 0x8000164F00200002, 0x8000165000200002, 0x8000165100200002, 0x8000165200200002,
+		0x8000165300200002, 0x8000165400200002, 0x8000165500200002, 0x8000165600200002, 0x8000165700200002, 0x8000165800200002, 0x8000165900200002, 0x8000165A00200002,
+		0x8000165B00200002, 0x8000165C00200002, 0x8000165D00200002, 0x8000165E00200002, 0x8000165F00200002, 0x8000166000200002, 0x8000166100200002, 0x8000166200200002,
+		0x8000166300200002, 0x8000166400200002, 0x8000166500200002, 0x8000166600200002, 0x8000166700200002, 0x8000166800200002, 0x8000166900200002, 0x8000166A00200002,
+		0x8000166B00200002, 0x8000166C00200002, 0x8000166D00200002, 0x8000166E00200002, 0x8000166F00200002, 0x8000167000200002, 0x8000167100200002, 0x8000167200200002,
+		0x8000167300200002, 0x8000167400200002, 0x8000167500200002, 0x8000167600200002, 0x8000167700200002, 0x8000167800200002, 0x8000167900200002, 0x8000167A00200002,
+		0x8000167B00200002, 0x8000167C00200002, 0x8000167D00200002, 0x8000167E00200002, 0x8000167F00200002, 0x8000168000200002, 0x8000168100200002, 0x8000168200200002,
+		0x8000168300200002, 0x8000168400200002, 0x8000168500200002, 0x8000168600200002, 0x8000168700200002, 0x8000168800200002, 0x8000168900200002, 0x8000168A00200002,
+		0x8000168B00200002, 0x8000168C00200002, 0x8000168D00200002, 0x8000168E00200002, 0x8000168F00200002, 0x8000169000200002, 0x8000169100200002, 0x8000169200200002,
+		0x8000169300200002, 0x8000169400200002, 0x8000169500200002, 0x8000169600200002, 0x8000169700200002, 0x8000169800200002, 0x8000169900200002, 0x8000169A00200002,
+		0x8000169B00200002, 0x8000169C00200002, 0x8000169D00200002, 0x8000169E00200002, 0x8000169F00200002, 0x800016A000200002, 0x800016A100200002, 0x800016A200200002,
+		0x800016A300200002, 0x800016A400200002, 0x800016A500200002, 0x800016A600200002, 0x800016A700200002, 0x800016A800200002, 0x800016A900200002, 0x800016AA00200002,
+		0x800016AB00200002, 0x800016AC00200002, 0x800016AD00200002, 0x800016AE00200002, 0x800016AF00200002, 0x800016B000200002, 0x800016B100200002, 0x800016B200200002,
+		0x800016B300200002, 0x800016B400200002, 0x800016B500200002, 0x800016B600200002, 0x800016B700200002, 0x800016B800200002, 0x800016B900200002, 0x800016BA00200002,
+		0x800016BB00200002, 0x800016BC00200002, 0x800016BD00200002, 0x800016BE00200002, 0x800016BF00200002, 0x800016C000200002, 0x800016C100200002, 0x800016C200200002,
+		0x800016C300200002, 0x800016C400200002, 0x800016C500200002, 0x800016C600200002, 0x800016C700200002, 0x800016C800200002, 0x800016C900200002, 0x800016CA00200002,
+		0x800016CB00200002, 0x800016CC00200002, 0x800016CD00200002, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800016CE00200002, 0x800016CF00200002, 0x800016D000200002, 0x800016D100200002, 0x800016D200200002, 0x800016D300200002, 0x800016D400200002, 0x800016D500200002,
+		0x800016D600200002, 0x800016D700200002, 0x800016D800200002, 0x800016D900200002, 0x800016DA00200002, 0, 0, 0,
+		0x800016DB00200002, 0x800016DC00200002, 0x800016DD00200002, 0x800016DE00200002, 0x800016DF00200002, 0x800016E000200002, 0x800016E100200002, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800016E200200002, 0x800016E300200002, 0x800016E400200002, 0x800016E500200002, 0x800016E600200002, 0x800016E700200002, 0x800016E800200002, 0x800016E900200002,
+		0x800016EA00200002, 0x800016EB00200002, 0x800016EC00200002, 0x800016ED00200002, 0x800016EE00200002, 0x800016EF00200002, 0x800016F000200002, 0x800016F100200002,
+		0x800016F200200002, 0x800016F300200002, 0x800016F400200002, 0x800016F500200002, 0x800016F600200002, 0x800016F700200002, 0x800016F800200002, 0x800016F900200002,
+		0x800016FA00200002, 0x800016FB00200002, 0x800016FC00200002, 0x800016FD00200002, 0x800016FE00200002, 0x800016FF00200002, 0x8000170000200002, 0x8000170100200002,
+		0x8000170200200002, 0x8000170300200002, 0x8000170400200002, 0x8000170500200002, 0x8000170600200002, 0x8000170700200002, 0x8000170800200002, 0x8000170900200002,
+		0x8000170A00200002, 0x8000170B00200002, 0x8000170C00200002, 0x8000170D00200002, 0x8000170E00200002, 0x8000170F00200002, 0x8000171000200002, 0x8000171100200002,
+		0x8000171200200002, 0x8000171300200002, 0x8000171400200002, 0x8000171500200002, 0x8000171600200002, 0x8000171700200002, 0x8000171800200002, 0x8000171900200002,
+		0x8000171A00200002, 0x8000171B00200002, 0x8000171C00200002, 0x8000171D00200002, 0x8000171E00200002, 0x8000171F00200002, 0x8000172000200002, 0x8000172100200002,
+		0x8000172200200002, 0x8000172300200002, 0x8000172400200002, 0x8000172500200002, 0x8000172600200002, 0x8000172700200002, 0x8000172800200002, 0x8000172900200002,
+		0x8000172A00200002, 0x8000172B00200002, 0x8000172C00200002, 0x8000172D00200002, 0x8000172E00200002, 0x8000172F00200002, 0x8000173000200002, 0x8000173100200002,
+		0x8000173200200002, 0x8000173300200002, 0x8000173400200002, 0x8000173500200002, 0x8000173600200002, 0x8000173700200002, 0x8000173800200002, 0x8000173900200002,
+		0x8000173A00200002, 0x8000173B00200002, 0x8000173C00200002, 0x8000173D00200002, 0x8000173E00200002, 0x8000173F00200002, 0x8000174000200002, 0x8000174100200002,
+		0x8000174200200002, 0x8000174300200002, 0x8000174400200002, 0x8000174500200002, 0x8000174600200002, 0x8000174700200002, 0x8000174800200002, 0x8000174900200002,
+		0x8000174A00200002, 0x8000174B00200002, 0x8000174C00200002, 0x8000174D00200002, 0x8000174E00200002, 0x8000174F00200002, 0x8000175000200002, 0x8000175100200002,
+		0x8000175200200002, 0x8000175300200002, 0x8000175400200002, 0x8000175500200002, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000175600200002, 0x8000175700200002, 0x8000175800200002, 0x8000175900200002, 0x8000175A00200002, 0x8000175B00200002, 0x8000175C00200002, 0x8000175D00200002,
+		0x8000175E00200002, 0x8000175F00200002, 0x8000176000200002, 0x8000176100200002, 0x8000176200200002, 0x8000176300200002, 0x8000176400200002, 0x8000176500200002,
+		0x8000176600200002, 0x8000176700200002, 0x8000176800200002, 0x8000176900200002, 0x8000176A00200002, 0x8000176B00200002, 0x8000176C00200002, 0x8000176D00200002,
+		0x8000176E00200002, 0x8000176F00200002, 0x8000177000200002, 0x8000177100200002, 0x8000177200200002, 0x8000177300200002, 0x8000177400200002, 0x8000177500200002,
+		0x8000177600200002, 0x8000177700200002, 0x8000177800200002, 0x8000177900200002, 0x8000177A00200002, 0x8000177B00200002, 0x8000177C00200002, 0x8000177D00200002,
+		0x8000177E00200002, 0x8000177F00200002, 0x8000178000200002, 0x8000178100200002, 0x8000178200200002, 0x8000178300200002, 0x8000178400200002, 0x8000178500200002,
+		0x8000178600200002, 0x8000178700200002, 0x8000178800200002, 0x8000178900200002, 0x8000178A00200002, 0x8000178B00200002, 0x8000178C00200002, 0x8000178D00200002,
+		0x8000178E00200002, 0x8000178F00200002, 0x8000179000200002, 0x8000179100200002, 0x8000179200200002, 0x8000179300200002, 0x8000179400200002, 0x8000179500200002,
+		0x8000179600200002, 0x8000179700200002, 0x8000179800200002, 0x8000179900200002, 0x8000179A00200002, 0x8000179B00200002, 0x8000179C00200002, 0x8000179D00200002,
+		0x8000179E00200002, 0x8000179F00200002, 0x800017A000200002, 0x800017A100200002, 0x800017A200200002, 0x800017A300200002, 0x800017A400200002, 0x800017A500200002,
+		0x800017A600200002, 0x800017A700200002, 0x800017A800200002, 0x800017A900200002, 0x800017AA00200002, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800017AB00200002, 0x800017AC00200002, 0x800017AD00200002, 0x800017AE00200002, 0x800017AF00200002, 0x800017B000200002, 0x800017B100200002, 0x800017B200200002,
+		0x800017B300200002, 0x800017B400200002, 0x800017B500200002, 0x800017B600200002, 0, 0, 0, 0,
+		0x800017B700200002, 0x800017B800200002, 0x800017B900200002, 0x800017BA00200002, 0x800017BB00200002, 0x800017BC00200002, 0x800017BD00200002, 0x800017BE00200002,
+		0x800017BF00200002, 0x800017C000200002, 0x800017C100200002, 0x800017C200200002, 0x800017C300200002, 0x800017C400200002, 0x800017C500200002, 0x800017C600200002,
+		0x800017C700200002, 0x800017C800200002, 0x800017C900200002, 0x800017CA00200002, 0x800017CB00200002, 0x800017CC00200002, 0x800017CD00200002, 0x800017CE00200002,
+		0x800017CF00200002, 0x800017D000200002, 0x800017D100200002, 0x800017D200200002, 0x800017D300200002, 0x800017D400200002, 0x800017D500200002, 0x800017D600200002,
+		0x800017D700200002, 0x800017D800200002, 0x800017D900200002, 0x800017DA00200002, 0x800017DB00200002, 0x800017DC00200002, 0x800017DD00200002, 0x800017DE00200002,
+		0x800017DF00200002, 0x800017E000200002, 0x800017E100200002, 0x800017E200200002, 0x800017E300200002, 0x800017E400200002, 0x800017E500200002, 0x800017E600200002,
+		0x800017E700200002, 0x800017E800200002, 0x800017E900200002, 0x800017EA00200002, 0x800017EB00200002, 0x800017EC00200002, 0x800017ED00200002, 0x800017EE00200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800017EF00200002, 0x800017F000200002, 0x800017F100200002, 0x800017F200200002, 0x800017F300200002, 0x800017F400200002, 0x800017F500200002, 0x800017F600200002,
+		0x800017F700200002, 0x800017F800200002, 0, 0, 0, 0, 0, 0,
+		0x800017F900200002, 0x800017FA00200002, 0x800017FB00200002, 0x800017FC00200002, 0x800017FD00200002, 0x800017FE00200002, 0x800017FF00200002, 0x8000180000200002,
+		0x8000180100200002, 0x8000180200200002, 0x8000180300200002, 0x8000180400200002, 0x8000180500200002, 0x8000180600200002, 0x8000180700200002, 0x8000180800200002,
+		0x8000180900200002, 0x8000180A00200002, 0x8000180B00200002, 0x8000180C00200002, 0x8000180D00200002, 0x8000180E00200002, 0x8000180F00200002, 0x8000181000200002,
+		0x8000181100200002, 0x8000181200200002, 0x8000181300200002, 0x8000181400200002, 0x8000181500200002, 0x8000181600200002, 0x8000181700200002, 0x8000181800200002,
+		0x8000181900200002, 0x8000181A00200002, 0x8000181B00200002, 0x8000181C00200002, 0x8000181D00200002, 0x8000181E00200002, 0x8000181F00200002, 0x8000182000200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000182100200002, 0x8000182200200002, 0x8000182300200002, 0x8000182400200002, 0x8000182500200002, 0x8000182600200002, 0x8000182700200002, 0x8000182800200002,
+		0x8000182900200002, 0x8000182A00200002, 0x8000182B00200002, 0x8000182C00200002, 0x8000182D00200002, 0x8000182E00200002, 0x8000182F00200002, 0x8000183000200002,
+		0x8000183100200002, 0x8000183200200002, 0x8000183300200002, 0x8000183400200002, 0x8000183500200002, 0x8000183600200002, 0x8000183700200002, 0x8000183800200002,
+		0x8000183900200002, 0x8000183A00200002, 0x8000183B00200002, 0x8000183C00200002, 0x8000183D00200002, 0x8000183E00200002, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800015A900200002, 0x800015AA00200002, 0x800015AB00200002, 0x800015AC00200002, 0x800015AD00200002, 0x800015AE00200002, 0x800015AF00200002, 0x800015B000200002,
+		0x800015B100200002, 0x800015B200200002, 0x800015B300200002, 0x800015B400200002, 0x800015B500200002, 0x800015B600200002, 0x800015B700200002, 0,
+		0x800015B800200002, 0x800015B900200002, 0x800015BA00200002, 0x800015BB00200002, 0x800015BC00200002, 0x800015BD00200002, 0x800015BE00200002, 0x800015BF00200002,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800015C000200002, 0, 0, 0x800015C100200002, 0x800015C200200002, 0x800015C300200002, 0x800015C400200002, 0x800015C500200002,
+		0x800015C600200002, 0x800015C700200002, 0x800015C800200002, 0x800015C900200002, 0x800015CA00200002, 0x800015CB00200002, 0x800015CC00200002, 0,
+		0x800015CD00200002, 0x800015CE00200002, 0x800015CF00200002, 0x800015D000200002, 0x800015D100200002, 0x800015D200200002, 0x800015D300200002, 0x800015D400200002,
+		0x800015D500200002, 0x800015D600200002, 0x800015D700200002, 0x800015D800200002, 0, 0, 0, 0,
+		0x800015D900200002, 0x800015DA00200002, 0x800015DB00200002, 0x800015DC00200002, 0x800015DD00200002, 0x800015DE00200002, 0x800015DF00200002, 0x800015E000200002,
+		0x800015E100200002, 0x800015E200200002, 0x800015E300200002, 0x800015E400200002, 0x800015E500200002, 0x800015E600200002, 0x800015E700200002, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800015E800200002, 0x800015E900200002, 0x800015EA00200002, 0x800015EB00200002, 0x800015EC00200002, 0x800015ED00200002, 0x800015EE00200002, 0x800015EF00200002,
+		0x800015F000200002, 0x800015F100200002, 0x800015F200200002, 0x800015F300200002, 0x800015F400200002, 0x800015F500200002, 0x800015F600200002, 0x800015F700200002,
+		0x800015F800200002, 0x800015F900200002, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x800015FA00200002, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x40000000001EEF02, 0x40000000001EF102, 0x40000000001EF302, 0x40000000001EF502, 0x40000000001EF702, 0x40000000001EF902, 0x40000000001EFB02, 0x40000000001EFD02,
+		0x40000000001EFF02, 0x40000000001F0102, 0x40000000001F0302, 0x40000000001F0502, 0x40000000001F0702, 0x40000000001F0902, 0x40000000001F0B02, 0x40000000001F0D02,
+		0x40000000001F0F02, 0x40000000001F1102, 0x40000000001F1302, 0x40000000001F1502, 0x40000000001F1702, 0x40000000001F1902, 0x40000000001F1B02, 0x40000000001F1D02,
+		0x40000000001F1F02, 0x40000000001F2102, 0x40000000001F2302, 0x40000000001F2502, 0x40000000001F2702, 0x40000000001F2902, 0x40000000001F2B02, 0x40000000001F2D02,
+		0x40000000001F2F02, 0x40000000001F3102, 0x40000000001F3302, 0x40000000001F3502, 0x40000000001F3702, 0x40000000001F3902, 0x40000000001F3B02, 0x40000000001F3D02,
+		0x40000000001F3F02, 0x40000000001F4102, 0x40000000001F4302, 0x40000000001F4502, 0x40000000001F4702, 0x40000000001F4902, 0x40000000001F4B02, 0x40000000001F4D02,
+		0x40000000001F4F02, 0x40000000001F5102, 0x40000000001F5302, 0x40000000001F5502, 0x40000000001F5702, 0x40000000001F5902, 0x40000000001F5B02, 0x40000000001F5D02,
+		0x40000000001F5F02, 0x40000000001F6102, 0x40000000001F6302, 0x40000000001F6502, 0x40000000001F6702, 0x40000000001F6902, 0x40000000001F6B02, 0x40000000001F6D02,
+		0x40000000001F6F02, 0x40000000001F7102, 0x40000000001F7302, 0x40000000001F7502, 0x40000000001F7702, 0x40000000001F7902, 0x40000000001F7B02, 0x40000000001F7D02,
+		0x40000000001F7F02, 0x40000000001F8102, 0x40000000001F8302, 0x40000000001F8502, 0x40000000001F8702, 0x40000000001F8902, 0x40000000001F8B02, 0x40000000001F8D02,
+		0x40000000001F8F02, 0x40000000001F9102, 0x40000000001F9302, 0x40000000001F9502, 0x40000000001F9702, 0x40000000001F9902, 0x40000000001F9B02, 0x40000000001F9D02,
+		0x40000000001F9F02, 0x40000000001FA102, 0x40000000001FA302, 0x40000000001FA502, 0x40000000001FA702, 0x40000000001FA902, 0x40000000001FAB02, 0x40000000001FAD02,
+		0x40000000001FAF02, 0x40000000001FB102, 0x40000000001FB302, 0x40000000001FB502, 0x40000000001FB702, 0x40000000001FB902, 0x40000000001FBB02, 0x40000000001FBD02,
+		0x40000000001FBF02, 0x40000000001FC102, 0x40000000001FC302, 0x40000000001FC502, 0x40000000001FC702, 0x40000000001FC902, 0x40000000001FCB02, 0x40000000001FCD02,
+		0x40000000001FCF02, 0x40000000001FD102, 0x40000000001FD302, 0x40000000001FD502, 0x40000000001FD702, 0x40000000001FD902, 0x40000000001FDB02, 0x40000000001FDD02,
+		0x40000000001FDF02, 0x40000000001FE102, 0x40000000001FE302, 0x40000000001FE502, 0x40000000001FE702, 0x40000000001FE902, 0x40000000001FEB02, 0x40000000001FED02,
+		0x40000000001FEF02, 0x40000000001FF102, 0x40000000001FF302, 0x40000000001FF502, 0x40000000001FF702, 0x40000000001FF902, 0x40000000001FFB02, 0x40000000001FFD02,
+		0x40000000001FFF02, 0x4000000000200102, 0x4000000000200302, 0x4000000000200502, 0x4000000000200702, 0x4000000000200902, 0x4000000000200B02, 0x4000000000200D02,
+		0x4000000000200F02, 0x4000000000201102, 0x4000000000201302, 0x4000000000201502, 0x4000000000201702, 0x4000000000201902, 0x4000000000201B02, 0x4000000000201D02,
+		0x4000000000201F02, 0x4000000000202102, 0x4000000000202302, 0x4000000000202502, 0x4000000000202702, 0x4000000000202902, 0x4000000000202B02, 0x4000000000202D02,
+		0x4000000000202F02, 0x4000000000203102, 0x4000000000203302, 0x4000000000203502, 0x4000000000203702, 0x4000000000203902, 0x4000000000203B02, 0x4000000000203D02,
+		0x4000000000203F02, 0x4000000000204102, 0x4000000000204302, 0x4000000000204502, 0x4000000000204702, 0x4000000000204902, 0x4000000000204B02, 0x4000000000204D02,
+		0x4000000000204F02, 0x4000000000205102, 0x4000000000205302, 0x4000000000205502, 0x4000000000205702, 0x4000000000205902, 0x4000000000205B02, 0x4000000000205D02,
+		0x4000000000205F02, 0x4000000000206102, 0x4000000000206302, 0x4000000000206502, 0x4000000000206702, 0x4000000000206902, 0x4000000000206B02, 0x4000000000206D02,
+		0x4000000000206F02, 0x4000000000207102, 0x4000000000207302, 0x4000000000207502, 0x4000000000207702, 0x4000000000207902, 0x4000000000207B02, 0x4000000000207D02,
+		0x4000000000207F02, 0x4000000000208102, 0x4000000000208302, 0x4000000000208502, 0x4000000000208702, 0x4000000000208902, 0x4000000000208B02, 0x4000000000208D02,
+		0x4000000000208F02, 0x4000000000209102, 0x4000000000209302, 0x4000000000209502, 0x4000000000209702, 0x4000000000209902, 0x4000000000209B02, 0x4000000000209D02,
+		0x4000000000209F02, 0x400000000020A102, 0x400000000020A302, 0x400000000020A502, 0x400000000020A702, 0x400000000020A902, 0x400000000020AB02, 0x400000000020AD02,
+		0x400000000020AF02, 0x400000000020B102, 0x400000000020B302, 0x400000000020B502, 0x400000000020B702, 0x400000000020B902, 0x400000000020BB02, 0x400000000020BD02,
+		0x400000000020BF02, 0x400000000020C102, 0x400000000020C302, 0x400000000020C502, 0x400000000020C702, 0x400000000020C902, 0x400000000020CB02, 0x400000000020CD02,
+		0x400000000020CF02, 0x400000000020D102, 0x400000000020D302, 0x400000000020D502, 0x400000000020D702, 0x400000000020D902, 0x400000000020DB02, 0x400000000020DD02,
+		0x400000000020DF02, 0x400000000020E102, 0x400000000020E302, 0x400000000020E502, 0x400000000020E702, 0x400000000020E902, 0x400000000020EB02, 0x400000000020ED02,
+		0x400000000020EF02, 0x400000000020F102, 0x400000000020F302, 0x400000000020F502, 0x400000000020F702, 0x400000000020F902, 0x400000000020FB02, 0x400000000020FD02,
+		0x400000000020FF02, 0x4000000000210102, 0x4000000000210302, 0x4000000000210502, 0x4000000000210702, 0x4000000000210902, 0x4000000000210B02, 0x4000000000210D02,
+		0x4000000000210F02, 0x4000000000211102, 0x4000000000211302, 0x4000000000211502, 0x4000000000211702, 0x4000000000211902, 0x4000000000211B02, 0x4000000000211D02,
+		0x4000000000211F02, 0x4000000000212102, 0x4000000000212302, 0x4000000000212502, 0x4000000000212702, 0x4000000000212902, 0x4000000000212B02, 0x4000000000212D02,
+		0x4000000000212F02, 0x4000000000213102, 0x4000000000213302, 0x4000000000213502, 0x4000000000213702, 0x4000000000213902, 0x4000000000213B02, 0x4000000000213D02,
+		0x4000000000213F02, 0x4000000000214102, 0x4000000000214302, 0x4000000000214502, 0x4000000000214702, 0x4000000000214902, 0x4000000000214B02, 0x4000000000214D02,
+		0x4000000000214F02, 0x4000000000215102, 0x4000000000215302, 0x4000000000215502, 0x4000000000215702, 0x4000000000215902, 0x4000000000215B02, 0x4000000000215D02,
+		0x4000000000215F02, 0x4000000000216102, 0x4000000000216302, 0x4000000000216502, 0x4000000000216702, 0x4000000000216902, 0x4000000000216B02, 0x4000000000216D02,
+		0x4000000000216F02, 0x4000000000217102, 0x4000000000217302, 0x4000000000217502, 0x4000000000217702, 0x4000000000217902, 0x4000000000217B02, 0x4000000000217D02,
+		0x4000000000217F02, 0x4000000000218102, 0x4000000000218302, 0x4000000000218502, 0x4000000000218702, 0x4000000000218902, 0x4000000000218B02, 0x4000000000218D02,
+		0x4000000000218F02, 0x4000000000219102, 0x4000000000219302, 0x4000000000219502, 0x4000000000219702, 0x4000000000219902, 0x4000000000219B02, 0x4000000000219D02,
+		0x4000000000219F02, 0x400000000021A102, 0x400000000021A302, 0x400000000021A502, 0x400000000021A702, 0x400000000021A902, 0x400000000021AB02, 0x400000000021AD02,
+		0x400000000021AF02, 0x400000000021B102, 0x400000000021B302, 0x400000000021B502, 0x400000000021B702, 0x400000000021B902, 0x400000000021BB02, 0x400000000021BD02,
+		0x400000000021BF02, 0x400000000021C102, 0x400000000021C302, 0x400000000021C502, 0x400000000021C702, 0x400000000021C902, 0x400000000021CB02, 0x400000000021CD02,
+		0x400000000021CF02, 0x400000000021D102, 0x400000000021D302, 0x400000000021D502, 0x400000000021D702, 0x400000000021D902, 0x400000000021DB02, 0x400000000021DD02,
+		0x400000000021DF02, 0x400000000021E102, 0x400000000021E302, 0x400000000021E502, 0x400000000021E702, 0x400000000021E902, 0x400000000021EB02, 0x400000000021ED02,
+		0x400000000021EF02, 0x400000000021F102, 0x400000000021F302, 0x400000000021F502, 0x400000000021F702, 0x400000000021F902, 0x400000000021FB02, 0x400000000021FD02,
+		0x400000000021FF02, 0x4000000000220102, 0x4000000000220302, 0x4000000000220502, 0x4000000000220702, 0x4000000000220902, 0x4000000000220B02, 0x4000000000220D02,
+		0x4000000000220F02, 0x4000000000221102, 0x4000000000221302, 0x4000000000221502, 0x4000000000221702, 0x4000000000221902, 0x4000000000221B02, 0x4000000000221D02,
+		0x4000000000221F02, 0x4000000000222102, 0x4000000000222302, 0x4000000000222502, 0x4000000000222702, 0x4000000000222902, 0x4000000000222B02, 0x4000000000222D02,
+		0x4000000000222F02, 0x4000000000223102, 0x4000000000223302, 0x4000000000223502, 0x4000000000223702, 0x4000000000223902, 0x4000000000223B02, 0x4000000000223D02,
+		0x4000000000223F02, 0x4000000000224102, 0x4000000000224302, 0x4000000000224502, 0x4000000000224702, 0x4000000000224902, 0x4000000000224B02, 0x4000000000224D02,
+		0x4000000000224F02, 0x4000000000225102, 0x4000000000225302, 0x4000000000225502, 0x4000000000225702, 0x4000000000225902, 0x4000000000225B02, 0x4000000000225D02,
+		0x4000000000225F02, 0x4000000000226102, 0x4000000000226302, 0x4000000000226502, 0x4000000000226702, 0x4000000000226902, 0x4000000000226B02, 0x4000000000226D02,
+		0x4000000000226F02, 0x4000000000227102, 0x4000000000227302, 0x4000000000227502, 0x4000000000227702, 0x4000000000227902, 0x4000000000227B02, 0x4000000000227D02,
+		0x4000000000227F02, 0x4000000000228102, 0x4000000000228302, 0x4000000000228502, 0x4000000000228702, 0x4000000000228902, 0x4000000000228B02, 0x4000000000228D02,
+		0x4000000000228F02, 0x4000000000229102, 0x4000000000229302, 0x4000000000229502, 0x4000000000229702, 0x4000000000229902, 0x4000000000229B02, 0x4000000000229D02,
+		0x4000000000229F02, 0x400000000022A102, 0x400000000022A302, 0x400000000022A502, 0x400000000022A702, 0x400000000022A902, 0x400000000022AB02, 0x400000000022AD02,
+		0x400000000022AF02, 0x400000000022B102, 0x400000000022B302, 0x400000000022B502, 0x400000000022B702, 0x400000000022B902, 0x400000000022BB02, 0x400000000022BD02,
+		0x400000000022BF02, 0x400000000022C102, 0x400000000022C302, 0x400000000022C502, 0x400000000022C702, 0x400000000022C902, 0x400000000022CB02, 0x400000000022CD02,
+		0x400000000022CF02, 0x400000000022D102, 0x400000000022D302, 0x400000000022D502, 0x400000000022D702, 0x400000000022D902, 0x400000000022DB02, 0x400000000022DD02,
+		0x400000000022DF02, 0x400000000022E102, 0x400000000022E302, 0x400000000022E502, 0x400000000022E702, 0x400000000022E902, 0x400000000022EB02, 0x400000000022ED02,
+		0x400000000022EF02, 0x400000000022F102, 0x400000000022F302, 0x400000000022F502, 0x400000000022F702, 0x400000000022F902, 0x400000000022FB02, 0x400000000022FD02,
+		0x400000000022FF02, 0x4000000000230102, 0x4000000000230302, 0x4000000000230502, 0x4000000000230702, 0x4000000000230902, 0x4000000000230B02, 0x4000000000230D02,
+		0x4000000000230F02, 0x4000000000231102, 0x4000000000231302, 0x4000000000231502, 0x4000000000231702, 0x4000000000231902, 0x4000000000231B02, 0x4000000000231D02,
+		0x4000000000231F02, 0x4000000000232102, 0x4000000000232302, 0x4000000000232502, 0x4000000000232702, 0x4000000000232902, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0x8000000000000000, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000, 0x8000000000000000,
+		0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0,
+	}
+	// uca0900Expansions contains the collation elements of the runes which have more than one.
+	uca0900Expansions = []uint64{
+		0x1C3E0020001E, 0x6260020001E, 0x1C410020001E, 0x1C3E0020001E, 0x6260020001E, 0x1C3F0020001E, 0x1C400020001E, 0x6260020001E,
+		0x1C410020001E, 0x1C4700200008, 0x250002, 0x1C4700200008, 0x240002, 0x1C4700200008, 0x270002, 0x1C4700200008,
+		0x2D0002, 0x1C4700200008, 0x2B0002, 0x1C4700200008, 0x290002, 0x1C470020000A, 0x1100004, 0x1CAA0020000A,
+		0x1C7A00200008, 0x300002, 0x1CAA00200008, 0x250002, 0x1CAA00200008, 0x240002, 0x1CAA00200008, 0x270002,
+		0x1CAA00200008, 0x2B0002, 0x1D3200200008, 0x250002, 0x1D3200200008, 0x240002, 0x1D3200200008, 0x270002,
+		0x1D3200200008, 0x2B0002, 0x1C8F0020000A, 0x1100004, 0x1DB900200008, 0x2D0002, 0x1DDD00200008, 0x250002,
+		0x1DDD00200008, 0x240002, 0x1DDD00200008, 0x270002, 0x1DDD00200008, 0x2D0002, 0x1DDD00200008, 0x2B0002,
+		0x1DDD00200008, 0x2F0002, 0x1EB500200008, 0x250002, 0x1EB500200008, 0x240002, 0x1EB500200008, 0x270002,
+		0x1EB500200008, 0x2B0002, 0x1F0B00200008, 0x240002, 0x1E7100200004, 0x1100004, 0x1E7100200004, 0x1C4700200002,
+		0x250002, 0x1C4700200002, 0x240002, 0x1C4700200002, 0x270002, 0x1C4700200002, 0x2D0002, 0x1C4700200002,
+		0x2B0002, 0x1C4700200002, 0x290002, 0x1C4700200004, 0x1100004, 0x1CAA00200004, 0x1C7A00200002, 0x300002,
+		0x1CAA00200002, 0x250002, 0x1CAA00200002, 0x240002, 0x1CAA00200002, 0x270002, 0x1CAA00200002, 0x2B0002,
+		0x1D3200200002, 0x250002, 0x1D3200200002, 0x240002, 0x1D3200200002, 0x270002, 0x1D3200200002, 0x2B0002,
+		0x1C8F00200004, 0x1100004, 0x1DB900200002, 0x2D0002, 0x1DDD00200002, 0x250002, 0x1DDD00200002, 0x240002,
+		0x1DDD00200002, 0x270002, 0x1DDD00200002, 0x2D0002, 0x1DDD00200002, 0x2B0002, 0x1DDD00200002, 0x2F0002,
+		0x1EB500200002, 0x250002, 0x1EB500200002, 0x240002, 0x1EB500200002, 0x270002, 0x1EB500200002, 0x2B0002,
+		0x1F0B00200002, 0x240002, 0x1F0B00200002, 0x2B0002, 0x1C4700200008, 0x320002, 0x1C4700200002, 0x320002,
+		0x1C4700200008, 0x260002, 0x1C4700200002, 0x260002, 0x1C4700200008, 0x310002, 0x1C4700200002, 0x310002,
+		0x1C7A00200008, 0x240002, 0x1C7A00200002, 0x240002, 0x1C7A00200008, 0x270002, 0x1C7A00200002, 0x270002,
+		0x1C7A00200008, 0x2E0002, 0x1C7A00200002, 0x2E0002, 0x1C7A00200008, 0x280002, 0x1C7A00200002, 0x280002,
+		0x1C8F00200008, 0x280002, 0x1C8F00200002, 0x280002, 0x1C8F00200008, 0x390002, 0x1C8F00200002, 0x390002,
+		0x1CAA00200008, 0x320002, 0x1CAA00200002, 0x320002, 0x1CAA00200008, 0x260002, 0x1CAA00200002, 0x260002,
+		0x1CAA00200008, 0x2E0002, 0x1CAA00200002, 0x2E0002, 0x1CAA00200008, 0x310002, 0x1CAA00200002, 0x310002,
+		0x1CAA00200008, 0x280002, 0x1CAA00200002, 0x280002, 0x1CF400200008, 0x270002, 0x1CF400200002, 0x270002,
+		0x1CF400200008, 0x260002, 0x1CF400200002, 0x260002, 0x1CF400200008, 0x2E0002, 0x1CF400200002, 0x2E0002,
+		0x1CF400200008, 0x300002, 0x1CF400200002, 0x300002, 0x1D1800200008, 0x270002, 0x1D1800200002, 0x270002,
+		0x1D1800200008, 0x390002, 0x1D1800200002, 0x390002, 0x1D3200200008, 0x2D0002, 0x1D3200200002, 0x2D0002,
+		0x1D3200200008, 0x320002, 0x1D3200200002, 0x320002, 0x1D3200200008, 0x260002, 0x1D3200200002, 0x260002,
+		0x1D3200200008, 0x310002, 0x1D3200200002, 0x310002, 0x1D3200200008, 0x2E0002, 0x1D320020000A, 0x1D4C0020000A,
+		0x1D3200200004, 0x1D4C00200004, 0x1D4C00200008, 0x270002, 0x1D4C00200002, 0x270002, 0x1D6500200008, 0x300002,
+		0x1D6500200002, 0x300002, 0x1D7700200008, 0x240002, 0x1D7700200002, 0x240002, 0x1D7700200008, 0x300002,
+		0x1D7700200002, 0x300002, 0x1D7700200008, 0x280002, 0x1D7700200002, 0x280002, 0x1D7700200008, 0x1100002,
+		0x1D7700200002, 0x1100002, 0x1D7700200008, 0x390002, 0x1D7700200002, 0x390002, 0x1DB900200008, 0x240002,
+		0x1DB900200002, 0x240002, 0x1DB900200008, 0x300002, 0x1DB900200002, 0x300002, 0x1DB900200008, 0x280002,
+		0x1DB900200002, 0x280002, 0x1F7E00200004, 0x1DB900200004, 0x1DDD00200008, 0x320002, 0x1DDD00200002, 0x320002,
+		0x1DDD00200008, 0x260002, 0x1DDD00200002, 0x260002, 0x1DDD00200008, 0x2C0002, 0x1DDD00200002, 0x2C0002,
+		0x1DDD0020000A, 0x1100004, 0x1CAA0020000A, 0x1DDD00200004, 0x1100004, 0x1CAA00200004, 0x1E3300200008, 0x240002,
+		0x1E3300200002, 0x240002, 0x1E3300200008, 0x300002, 0x1E3300200002, 0x300002, 0x1E3300200008, 0x280002,
+		0x1E3300200002, 0x280002, 0x1E7100200008, 0x240002, 0x1E7100200002, 0x240002, 0x1E7100200008, 0x270002,
+		0x1E7100200002, 0x270002, 0x1E7100200008, 0x300002, 0x1E7100200002, 0x300002, 0x1E7100200008, 0x280002,
+		0x1E7100200002, 0x280002, 0x1E9500200008, 0x300002, 0x1E9500200002, 0x300002, 0x1E9500200008, 0x280002,
+		0x1E9500200002, 0x280002, 0x1EB500200008, 0x2D0002, 0x1EB500200002, 0x2D0002, 0x1EB500200008, 0x320002,
+		0x1EB500200002, 0x320002, 0x1EB500200008, 0x260002, 0x1EB500200002, 0x260002, 0x1EB500200008, 0x290002,
+		0x1EB500200002, 0x290002, 0x1EB500200008, 0x2C0002, 0x1EB500200002, 0x2C0002, 0x1EB500200008, 0x310002,
+		0x1EB500200002, 0x310002, 0x1EF500200008, 0x270002, 0x1EF500200002, 0x270002, 0x1F0B00200008, 0x270002,
+		0x1F0B00200002, 0x270002, 0x1F0B00200008, 0x2B0002, 0x1F2100200008, 0x240002, 0x1F2100200002, 0x240002,
+		0x1F2100200008, 0x2E0002, 0x1F2100200002, 0x2E0002, 0x1F2100200008, 0x280002, 0x1F2100200002, 0x280002,
+		0x1E7100200004, 0x1110004, 0x1F2100200004, 0x1EF500200004, 0x1DDD00200008, 0x3F0002, 0x1DDD00200002, 0x3F0002,
+		0x1EB500200008, 0x3F0002, 0x1EB500200002, 0x3F0002, 0x1E9500200004, 0x1E7100200004, 0x1C8F0020000A, 0x1F210020000A,
+		0x280004, 0x1C8F0020000A, 0x1F2100200004, 0x280004, 0x1C8F00200004, 0x1F2100200004, 0x280004, 0x1D770020000A,
+		0x1D4C0020000A, 0x1D770020000A, 0x1D4C00200004, 0x1D7700200004, 0x1D4C00200004, 0x1DB90020000A, 0x1D4C0020000A, 0x1DB90020000A,
+		0x1D4C00200004, 0x1DB900200004, 0x1D4C00200004, 0x1C4700200008, 0x280002, 0x1C4700200002, 0x280002, 0x1D3200200008,
+		0x280002, 0x1D3200200002, 0x280002, 0x1DDD00200008, 0x280002, 0x1DDD00200002, 0x280002, 0x1EB500200008,
+		0x280002, 0x1EB500200002, 0x280002, 0x1EB500200008, 0x2B0002, 0x320002, 0x1EB500200002, 0x2B0002,
+		0x320002, 0x1EB500200008, 0x2B0002, 0x240002, 0x1EB500200002, 0x2B0002, 0x240002, 0x1EB500200008,
+		0x2B0002, 0x280002, 0x1EB500200002, 0x2B0002, 0x280002, 0x1EB500200008, 0x2B0002, 0x250002,
+		0x1EB500200002, 0x2B0002, 0x250002, 0x1C4700200008, 0x2B0002, 0x320002, 0x1C4700200002, 0x2B0002,
+		0x320002, 0x1C4700200008, 0x2E0002, 0x320002, 0x1C4700200002, 0x2E0002, 0x320002, 0x1C470020000A,
+		0x1100004, 0x1CAA0020000A, 0x320002, 0x1C4700200004, 0x1100004, 0x1CAA00200004, 0x320002, 0x1CF400200008,
+		0x280002, 0x1CF400200002, 0x280002, 0x1D6500200008, 0x280002, 0x1D6500200002, 0x280002, 0x1DDD00200008,
+		0x310002, 0x1DDD00200002, 0x310002, 0x1DDD00200008, 0x310002, 0x320002, 0x1DDD00200002, 0x310002,
+		0x320002, 0x1F3E00200008, 0x280002, 0x1F3E00200002, 0x280002, 0x1D4C00200002, 0x280002, 0x1C8F0020000A,
+		0x1F210020000A, 0x1C8F0020000A, 0x1F2100200004, 0x1C8F00200004, 0x1F2100200004, 0x1CF400200008, 0x240002, 0x1CF400200002,
+		0x240002, 0x1DB900200008, 0x250002, 0x1DB900200002, 0x250002, 0x1C4700200008, 0x290002, 0x240002,
+		0x1C4700200002, 0x290002, 0x240002, 0x1C470020000A, 0x1100004, 0x1CAA0020000A, 0x240002, 0x1C4700200004,
+		0x1100004, 0x1CAA00200004, 0x240002, 0x1DDD00200008, 0x2F0002, 0x240002, 0x1DDD00200002, 0x2F0002,
+		0x240002, 0x1C4700200008, 0x3C0002, 0x1C4700200002, 0x3C0002, 0x1C4700200008, 0x3E0002, 0x1C4700200002,
+		0x3E0002, 0x1CAA00200008, 0x3C0002, 0x1CAA00200002, 0x3C0002, 0x1CAA00200008, 0x3E0002, 0x1CAA00200002,
+		0x3E0002, 0x1D3200200008, 0x3C0002, 0x1D3200200002, 0x3C0002, 0x1D3200200008, 0x3E0002, 0x1D3200200002,
+		0x3E0002, 0x1DDD00200008, 0x3C0002, 0x1DDD00200002, 0x3C0002, 0x1DDD00200008, 0x3E0002, 0x1DDD00200002,
+		0x3E0002, 0x1E3300200008, 0x3C0002, 0x1E3300200002, 0x3C0002, 0x1E3300200008, 0x3E0002, 0x1E3300200002,
+		0x3E0002, 0x1EB500200008, 0x3C0002, 0x1EB500200002, 0x3C0002, 0x1EB500200008, 0x3E0002, 0x1EB500200002,
+		0x3E0002, 0x1E7100200008, 0x450002, 0x1E7100200002, 0x450002, 0x1E9500200008, 0x450002, 0x1E9500200002,
+		0x450002, 0x1D1800200008, 0x280002, 0x1D1800200002, 0x280002, 0x1C4700200008, 0x2E0002, 0x1C4700200002,
+		0x2E0002, 0x1CAA00200008, 0x300002, 0x1CAA00200002, 0x300002, 0x1DDD00200008, 0x2B0002, 0x320002,
+		0x1DDD00200002, 0x2B0002, 0x320002, 0x1DDD00200008, 0x2D0002, 0x320002, 0x1DDD00200002, 0x2D0002,
+		0x320002, 0x1DDD00200008, 0x2E0002, 0x1DDD00200002, 0x2E0002, 0x1DDD00200008, 0x2E0002, 0x320002,
+		0x1DDD00200002, 0x2E0002, 0x320002, 0x1F0B00200008, 0x320002, 0x1F0B00200002, 0x320002, 0x1C8F00200004,
+		0x1C6000200004, 0x1E2100200004, 0x1E0C00200004, 0x1C8F00200004, 0x1F2100200004, 0x1C8F00200004, 0x1F3E00200004, 0x1C8F00200004,
+		0x1F3400200004, 0x1E9500200004, 0x1E7100200004, 0x1E9500200004, 0x1E8200200004, 0x1E9500200004, 0x1C8900200004, 0x1CE500200004,
+		0x1DD800200004, 0x1D7700200004, 0x1E7100200004, 0x1D7700200004, 0x1F2100200004, 0x2B0002, 0x240002, 0x48900200002,
+		0x240002, 0x1FB900200008, 0x240002, 0x1FBE00200008, 0x240002, 0x1FC400200008, 0x240002, 0x1FC600200008,
+		0x240002, 0x1FCE00200008, 0x240002, 0x1FDC00200008, 0x240002, 0x1FE100200008, 0x240002, 0x1FC600200002,
+		0x2B0002, 0x240002, 0x1FC600200008, 0x2B0002, 0x1FDC00200008, 0x2B0002, 0x1FB900200002, 0x240002,
+		0x1FBE00200002, 0x240002, 0x1FC400200002, 0x240002, 0x1FC600200002, 0x240002, 0x1FDC00200002, 0x2B0002,
+		0x240002, 0x1FC600200002, 0x2B0002, 0x1FDC00200002, 0x2B0002, 0x1FCE00200002, 0x240002, 0x1FDC00200002,
+		0x240002, 0x1FE100200002, 0x240002, 0x1FC80020000A, 0x1FB900200004, 0x1FC600200004, 0x1FDC0020000A, 0x240002,
+		0x1FDC0020000A, 0x2B0002, 0x1FC800200004, 0x1FB900200004, 0x1FC600200004, 0x205A00200008, 0x250002, 0x205A00200008,
+		0x2B0002, 0x203600200008, 0x240002, 0x208800200008, 0x2B0002, 0x209600200008, 0x240002, 0x208000200008,
+		0x250002, 0x211D00200008, 0x260002, 0x205A00200002, 0x250002, 0x205A00200002, 0x2B0002, 0x203600200002,
+		0x240002, 0x208800200002, 0x2B0002, 0x209600200002, 0x240002, 0x208000200002, 0x250002, 0x211D00200002,
+		0x260002, 0x21D700200008, 0x3C0002, 0x21D700200002, 0x3C0002, 0x20360020000A, 0x1110004, 0x203600200004,
+		0x1110004, 0x206200200008, 0x260002, 0x206200200002, 0x260002, 0x202200200008, 0x260002, 0x202200200002,
+		0x260002, 0x202200200008, 0x2B0002, 0x202200200002, 0x2B0002, 0x205A00200008, 0x260002, 0x205A00200002,
+		0x260002, 0x202600200008, 0x2B0002, 0x202600200002, 0x2B0002, 0x206200200008, 0x2B0002, 0x206200200002,
+		0x2B0002, 0x206C00200008, 0x2B0002, 0x206C00200002, 0x2B0002, 0x208000200008, 0x320002, 0x208000200002,
+		0x320002, 0x208000200008, 0x2B0002, 0x208000200002, 0x2B0002, 0x20E700200008, 0x2B0002, 0x20E700200002,
+		0x2B0002, 0x20EB00200008, 0x2B0002, 0x20EB00200002, 0x2B0002, 0x21A500200008, 0x2B0002, 0x21A500200002,
+		0x2B0002, 0x211D00200008, 0x320002, 0x211D00200002, 0x320002, 0x211D00200008, 0x2B0002, 0x211D00200002,
+		0x2B0002, 0x211D00200008, 0x2C0002, 0x211D00200002, 0x2C0002, 0x216400200008, 0x2B0002, 0x216400200002,
+		0x2B0002, 0x219400200008, 0x2B0002, 0x219400200002, 0x2B0002, 0x229400200004, 0x22B100200004, 0x22BC00200004,
+		0x22BC00200004, 0x22BC00200004, 0x22C000200004, 0x22C000200004, 0x22C000200004, 0x230B00200004, 0x22FD00200004, 0x23B700200004,
+		0x22FD00200004, 0x23BB00200004, 0x22FD00200004, 0x23C600200004, 0x22FD00200004, 0x23B600200002, 0x830002, 0x23B300200002,
+		0x830002, 0x23D400200002, 0x830002, 0x22FD00200004, 0x1110004, 0x23A300200004, 0x1110004, 0x23D900200004,
+		0x1110004, 0x23E100200004, 0x1110004, 0x23EB00200004, 0x1110004, 0x23D800200004, 0x1120004, 0x23D900200004,
+		0x1120004, 0x23DB00200004, 0x1120004, 0x244900200004, 0x1100004, 0x244A00200004, 0x1100004, 0x244C00200004,
+		0x1100004, 0x268E00200002, 0xC20002, 0x269700200002, 0xC20002, 0x269900200002, 0xC20002, 0x267600200002,
+		0xC20002, 0x267700200002, 0xC20002, 0x267800200002, 0xC20002, 0x267E00200002, 0xC20002, 0x268600200002,
+		0xC20002, 0x268800200002, 0xC20002, 0x269000200002, 0xC20002, 0x269500200002, 0xC20002, 0x26DD00200004,
+		0x26FE00200004, 0x26DA00200002, 0xC20002, 0x26DB00200002, 0xC20002, 0x26E700200002, 0xC20002, 0x272C00200002,
+		0xC20002, 0x270D00200002, 0xC20002, 0x271100200002, 0xC20002, 0x271200200002, 0xC20002, 0x271700200002,
+		0xC20002, 0x272500200002, 0xC20002, 0x279800200002, 0xC20002, 0x279900200002, 0xC20002, 0x28B300200004,
+		0x28CF00200004, 0x28B100200004, 0x28CF00200004, 0x28B200200004, 0x28CF00200004, 0x28BB00200004, 0x28CF00200004, 0x28A600200004,
+		0x28CF00200004, 0x28AB00200004, 0x28CF00200004, 0x28B300200004, 0x28CF00200004, 0x28B400200004, 0x28CF00200004, 0x28BA00200004,
+		0x28CF00200004, 0x289800200004, 0x28CF00200004, 0x2DCD00200004, 0x2DC100200004, 0x2DCD00200004, 0x2DC800200004, 0x2E6C00200004,
+		0x2E8300200004, 0xC40004, 0x2E2B00200002, 0x2E6B00200002, 0x2E3B00200002, 0x2E6B00200002, 0x2E4300200002, 0x2E6B00200002,
+		0x2E4B00200002, 0x2E6B00200002, 0x2E5300200002, 0x2E6B00200002, 0x2E2600200002, 0x2E6700200002, 0x2E5F00200004, 0x1110004,
+		0x2E2C00200002, 0x2E6B00200002, 0x2E3C00200002, 0x2E6B00200002, 0x2E4400200002, 0x2E6B00200002, 0x2E4C00200002, 0x2E6B00200002,
+		0x2E5400200002, 0x2E6B00200002, 0x2E2700200002, 0x2E6700200002, 0x2E5600200004, 0x1110004, 0x2E5E00200004, 0x1110004,
+		0x2E6000200004, 0x1110004, 0x309200200004, 0x30CB00200004, 0x309200200004, 0x36D600200004, 0x1100004, 0x36D700200004,
+		0x1100004, 0x36D700200004, 0x1110004, 0x36D800200004, 0x1100004, 0x36D900200004, 0x1100004, 0x36D900200004,
+		0x1110004, 0x36D900200004, 0x1120004, 0x36D900200004, 0x1130004, 0x36DE00200004, 0x1100004, 0x36DE00200004,
+		0x1110004, 0x36DE00200004, 0x1120004, 0x36DE00200004, 0x1130004, 0x36E200200004, 0x1100004, 0x36E200200004,
+		0x1110004, 0x36E200200004, 0x1120004, 0x36E300200004, 0x1100004, 0x36E300200004, 0x1110004, 0x36E400200004,
+		0x1100004, 0x36E600200004, 0x1100004, 0x36E700200004, 0x1100004, 0x36EB00200004, 0x1100004, 0x36EB00200004,
+		0x1120004, 0x36EB00200004, 0x1130004, 0x36EB00200004, 0x1140004, 0x36ED00200004, 0x1100004, 0x36ED00200004,
+		0x1110004, 0x36EE00200004, 0x1100004, 0x36EE00200004, 0x1110004, 0x36E900200004, 0x1100004, 0x36F100200004,
+		0x1100004, 0x36F100200004, 0x1110004, 0x36F200200004, 0x1100004, 0x36F300200004, 0x1100004, 0x370300200004,
+		0x1100004, 0x370300200004, 0x1110004, 0x36E100200004, 0x1100004, 0x36EB00200004, 0x1110004, 0x36E700200004,
+		0x36F200200004, 0x36F100200004, 0x1100004, 0x36F100200004, 0x1100004, 0x36D800200004, 0x36D800200004, 0x2F3C00200004,
+		0x2F5A00200004, 0x2F3F00200004, 0x2F5B00200004, 0x319B00200004, 0x31B100200004, 0x319B00200004, 0x31B100200004, 0x31BC00200004,
+		0x31EB00200004, 0x321100200004, 0x31EB00200004, 0x1C4700200014, 0x1100014, 0x1CAA00200014, 0x1CF400200004, 0x1110004,
+		0x1E9500200004, 0x1100004, 0x1D1800200004, 0x1C8F00200014, 0x1100014, 0x1C4700200004, 0x1100004, 0x1C4700200004,
+		0x1100004, 0x1CAA00200004, 0x1C4700200004, 0x1DDD00200004, 0x1C4700200004, 0x1EE300200004, 0x1C7A00200004, 0x300004,
+		0x1C8F00200004, 0x1110004, 0x1C8F00200004, 0x1100004, 0x1E7100200004, 0x1110004, 0x1DDD00200004, 0x340004,
+		0x1EB500200004, 0x340004, 0x1C4700200004, 0x2B0004, 0x1DDD00200004, 0x2B0004, 0x1EB500200004, 0x2B0004,
+		0x1C4700200008, 0x440002, 0x1C4700200002, 0x440002, 0x1C6000200008, 0x2E0002, 0x1C6000200002, 0x2E0002,
+		0x1C6000200008, 0x420002, 0x1C6000200002, 0x420002, 0x1C6000200008, 0x490002, 0x1C6000200002, 0x490002,
+		0x1C7A00200008, 0x300002, 0x240002, 0x1C7A00200002, 0x300002, 0x240002, 0x1C8F00200008, 0x2E0002,
+		0x1C8F00200002, 0x2E0002, 0x1C8F00200008, 0x420002, 0x1C8F00200002, 0x420002, 0x1C8F00200008, 0x490002,
+		0x1C8F00200002, 0x490002, 0x1C8F00200008, 0x300002, 0x1C8F00200002, 0x300002, 0x1C8F00200008, 0x460002,
+		0x1C8F00200002, 0x460002, 0x1CAA00200008, 0x320002, 0x250002, 0x1CAA00200002, 0x320002, 0x250002,
+		0x1CAA00200008, 0x320002, 0x240002, 0x1CAA00200002, 0x320002, 0x240002, 0x1CAA00200008, 0x460002,
+		0x1CAA00200002, 0x460002, 0x1CAA00200008, 0x480002, 0x1CAA00200002, 0x480002, 0x1CAA00200008, 0x300002,
+		0x260002, 0x1CAA00200002, 0x300002, 0x260002, 0x1CE500200008, 0x2E0002, 0x1CE500200002, 0x2E0002,
+		0x1CF400200008, 0x320002, 0x1CF400200002, 0x320002, 0x1D1800200008, 0x2E0002, 0x1D1800200002, 0x2E0002,
+		0x1D1800200008, 0x420002, 0x1D1800200002, 0x420002, 0x1D1800200008, 0x2B0002, 0x1D1800200002, 0x2B0002,
+		0x1D1800200008, 0x300002, 0x1D1800200002, 0x300002, 0x1D1800200008, 0x470002, 0x1D1800200002, 0x470002,
+		0x1D3200200008, 0x480002, 0x1D3200200002, 0x480002, 0x1D3200200008, 0x2B0002, 0x240002, 0x1D3200200002,
+		0x2B0002, 0x240002, 0x1D6500200008, 0x240002, 0x1D6500200002, 0x240002, 0x1D6500200008, 0x420002,
+		0x1D6500200002, 0x420002, 0x1D6500200008, 0x490002, 0x1D6500200002, 0x490002, 0x1D7700200008, 0x420002,
+		0x1D7700200002, 0x420002, 0x1D7700200008, 0x420002, 0x320002, 0x1D7700200002, 0x420002, 0x320002,
+		0x1D7700200008, 0x490002, 0x1D7700200002, 0x490002, 0x1D7700200008, 0x460002, 0x1D7700200002, 0x460002,
+		0x1DAA00200008, 0x240002, 0x1DAA00200002, 0x240002, 0x1DAA00200008, 0x2E0002, 0x1DAA00200002, 0x2E0002,
+		0x1DAA00200008, 0x420002, 0x1DAA00200002, 0x420002, 0x1DB900200008, 0x2E0002, 0x1DB900200002, 0x2E0002,
+		0x1DB900200008, 0x420002, 0x1DB900200002, 0x420002, 0x1DB900200008, 0x490002, 0x1DB900200002, 0x490002,
+		0x1DB900200008, 0x460002, 0x1DB900200002, 0x460002, 0x1DDD00200008, 0x2D0002, 0x240002, 0x1DDD00200002,
+		0x2D0002, 0x240002, 0x1DDD00200008, 0x2D0002, 0x2B0002, 0x1DDD00200002, 0x2D0002, 0x2B0002,
+		0x1DDD00200008, 0x320002, 0x250002, 0x1DDD00200002, 0x320002, 0x250002, 0x1DDD00200008, 0x320002,
+		0x240002, 0x1DDD00200002, 0x320002, 0x240002, 0x1E0C00200008, 0x240002, 0x1E0C00200002, 0x240002,
+		0x1E0C00200008, 0x2E0002, 0x1E0C00200002, 0x2E0002, 0x1E3300200008, 0x2E0002, 0x1E3300200002, 0x2E0002,
+		0x1E3300200008, 0x420002, 0x1E3300200002, 0x420002, 0x1E3300200008, 0x420002, 0x320002, 0x1E3300200002,
+		0x420002, 0x320002, 0x1E3300200008, 0x490002, 0x1E3300200002, 0x490002, 0x1E7100200008, 0x2E0002,
+		0x1E7100200002, 0x2E0002, 0x1E7100200008, 0x420002, 0x1E7100200002, 0x420002, 0x1E7100200008, 0x240002,
+		0x2E0002, 0x1E7100200002, 0x240002, 0x2E0002, 0x1E7100200008, 0x280002, 0x2E0002, 0x1E7100200002,
+		0x280002, 0x2E0002, 0x1E7100200008, 0x420002, 0x2E0002, 0x1E7100200002, 0x420002, 0x2E0002,
+		0x1E9500200008, 0x2E0002, 0x1E9500200002, 0x2E0002, 0x1E9500200008, 0x420002, 0x1E9500200002, 0x420002,
+		0x1E9500200008, 0x490002, 0x1E9500200002, 0x490002, 0x1E9500200008, 0x460002, 0x1E9500200002, 0x460002,
+		0x1EB500200008, 0x430002, 0x1EB500200002, 0x430002, 0x1EB500200008, 0x480002, 0x1EB500200002, 0x480002,
+		0x1EB500200008, 0x460002, 0x1EB500200002, 0x460002, 0x1EB500200008, 0x2D0002, 0x240002, 0x1EB500200002,
+		0x2D0002, 0x240002, 0x1EB500200008, 0x320002, 0x2B0002, 0x1EB500200002, 0x320002, 0x2B0002,
+		0x1EE300200008, 0x2D0002, 0x1EE300200002, 0x2D0002, 0x1EE300200008, 0x420002, 0x1EE300200002, 0x420002,
+		0x1EF500200008, 0x250002, 0x1EF500200002, 0x250002, 0x1EF500200008, 0x240002, 0x1EF500200002, 0x240002,
+		0x1EF500200008, 0x2B0002, 0x1EF500200002, 0x2B0002, 0x1EF500200008, 0x2E0002, 0x1EF500200002, 0x2E0002,
+		0x1EF500200008, 0x420002, 0x1EF500200002, 0x420002, 0x1EFF00200008, 0x2E0002, 0x1EFF00200002, 0x2E0002,
+		0x1EFF00200008, 0x2B0002, 0x1EFF00200002, 0x2B0002, 0x1F0B00200008, 0x2E0002, 0x1F0B00200002, 0x2E0002,
+		0x1F2100200008, 0x270002, 0x1F2100200002, 0x270002, 0x1F2100200008, 0x420002, 0x1F2100200002, 0x420002,
+		0x1F2100200008, 0x490002, 0x1F2100200002, 0x490002, 0x1D1800200002, 0x490002, 0x1E9500200002, 0x2B0002,
+		0x1EF500200002, 0x290002, 0x1F0B00200002, 0x290002, 0x1C4700200004, 0x1F8000200004, 0x1E7100200004, 0x1110004,
+		0x2E0002, 0x1E710020000A, 0x1100004, 0x1E710020000A, 0x1C4700200008, 0x420002, 0x1C4700200002, 0x420002,
+		0x1C4700200008, 0x3B0002, 0x1C4700200002, 0x3B0002, 0x1C4700200008, 0x270002, 0x240002, 0x1C4700200002,
+		0x270002, 0x240002, 0x1C4700200008, 0x270002, 0x250002, 0x1C4700200002, 0x270002, 0x250002,
+		0x1C4700200008, 0x270002, 0x3B0002, 0x1C4700200002, 0x270002, 0x3B0002, 0x1C4700200008, 0x270002,
+		0x2D0002, 0x1C4700200002, 0x270002, 0x2D0002, 0x1C4700200008, 0x420002, 0x270002, 0x1C4700200002,
+		0x420002, 0x270002, 0x1C4700200008, 0x260002, 0x240002, 0x1C4700200002, 0x260002, 0x240002,
+		0x1C4700200008, 0x260002, 0x250002, 0x1C4700200002, 0x260002, 0x250002, 0x1C4700200008, 0x260002,
+		0x3B0002, 0x1C4700200002, 0x260002, 0x3B0002, 0x1C4700200008, 0x260002, 0x2D0002, 0x1C4700200002,
+		0x260002, 0x2D0002, 0x1C4700200008, 0x420002, 0x260002, 0x1C4700200002, 0x420002, 0x260002,
+		0x1CAA00200008, 0x420002, 0x1CAA00200002, 0x420002, 0x1CAA00200008, 0x3B0002, 0x1CAA00200002, 0x3B0002,
+		0x1CAA00200008, 0x2D0002, 0x1CAA00200002, 0x2D0002, 0x1CAA00200008, 0x270002, 0x240002, 0x1CAA00200002,
+		0x270002, 0x240002, 0x1CAA00200008, 0x270002, 0x250002, 0x1CAA00200002, 0x270002, 0x250002,
+		0x1CAA00200008, 0x270002, 0x3B0002, 0x1CAA00200002, 0x270002, 0x3B0002, 0x1CAA00200008, 0x270002,
+		0x2D0002, 0x1CAA00200002, 0x270002, 0x2D0002, 0x1CAA00200008, 0x420002, 0x270002, 0x1CAA00200002,
+		0x420002, 0x270002, 0x1D3200200008, 0x3B0002, 0x1D3200200002, 0x3B0002, 0x1D3200200008, 0x420002,
+		0x1D3200200002, 0x420002, 0x1DDD00200008, 0x420002, 0x1DDD00200002, 0x420002, 0x1DDD00200008, 0x3B0002,
+		0x1DDD00200002, 0x3B0002, 0x1DDD00200008, 0x270002, 0x240002, 0x1DDD00200002, 0x270002, 0x240002,
+		0x1DDD00200008, 0x270002, 0x250002, 0x1DDD00200002, 0x270002, 0x250002, 0x1DDD00200008, 0x270002,
+		0x3B0002, 0x1DDD00200002, 0x270002, 0x3B0002, 0x1DDD00200008, 0x270002, 0x2D0002, 0x1DDD00200002,
+		0x270002, 0x2D0002, 0x1DDD00200008, 0x420002, 0x270002, 0x1DDD00200002, 0x420002, 0x270002,
+		0x1DDD00200008, 0x3F0002, 0x240002, 0x1DDD00200002, 0x3F0002, 0x240002, 0x1DDD00200008, 0x3F0002,
+		0x250002, 0x1DDD00200002, 0x3F0002, 0x250002, 0x1DDD00200008, 0x3F0002, 0x3B0002, 0x1DDD00200002,
+		0x3F0002, 0x3B0002, 0x1DDD00200008, 0x3F0002, 0x2D0002, 0x1DDD00200002, 0x3F0002, 0x2D0002,
+		0x1DDD00200008, 0x3F0002, 0x420002, 0x1DDD00200002, 0x3F0002, 0x420002, 0x1EB500200008, 0x420002,
+		0x1EB500200002, 0x420002, 0x1EB500200008, 0x3B0002, 0x1EB500200002, 0x3B0002, 0x1EB500200008, 0x3F0002,
+		0x240002, 0x1EB500200002, 0x3F0002, 0x240002, 0x1EB500200008, 0x3F0002, 0x250002, 0x1EB500200002,
+		0x3F0002, 0x250002, 0x1EB500200008, 0x3F0002, 0x3B0002, 0x1EB500200002, 0x3F0002, 0x3B0002,
+		0x1EB500200008, 0x3F0002, 0x2D0002, 0x1EB500200002, 0x3F0002, 0x2D0002, 0x1EB500200008, 0x3F0002,
+		0x420002, 0x1EB500200002, 0x3F0002, 0x420002, 0x1F0B00200008, 0x250002, 0x1F0B00200002, 0x250002,
+		0x1F0B00200008, 0x420002, 0x1F0B00200002, 0x420002, 0x1F0B00200008, 0x3B0002, 0x1F0B00200002, 0x3B0002,
+		0x1F0B00200008, 0x2D0002, 0x1F0B00200002, 0x2D0002, 0x1D770020000A, 0x1D770020000A, 0x1D7700200004, 0x1D7700200004,
+		0x1FB900200002, 0x220002, 0x1FB900200002, 0x230002, 0x1FB900200002, 0x220002, 0x250002, 0x1FB900200002,
+		0x230002, 0x250002, 0x1FB900200002, 0x220002, 0x240002, 0x1FB900200002, 0x230002, 0x240002,
+		0x1FB900200002, 0x220002, 0x2A0002, 0x1FB900200002, 0x230002, 0x2A0002, 0x1FB900200008, 0x220002,
+		0x1FB900200008, 0x230002, 0x1FB900200008, 0x220002, 0x250002, 0x1FB900200008, 0x230002, 0x250002,
+		0x1FB900200008, 0x220002, 0x240002, 0x1FB900200008, 0x230002, 0x240002, 0x1FB900200008, 0x220002,
+		0x2A0002, 0x1FB900200008, 0x230002, 0x2A0002, 0x1FBE00200002, 0x220002, 0x1FBE00200002, 0x230002,
+		0x1FBE00200002, 0x220002, 0x250002, 0x1FBE00200002, 0x230002, 0x250002, 0x1FBE00200002, 0x220002,
+		0x240002, 0x1FBE00200002, 0x230002, 0x240002, 0x1FBE00200008, 0x220002, 0x1FBE00200008, 0x230002,
+		0x1FBE00200008, 0x220002, 0x250002, 0x1FBE00200008, 0x230002, 0x250002, 0x1FBE00200008, 0x220002,
+		0x240002, 0x1FBE00200008, 0x230002, 0x240002, 0x1FC400200002, 0x220002, 0x1FC400200002, 0x230002,
+		0x1FC400200002, 0x220002, 0x250002, 0x1FC400200002, 0x230002, 0x250002, 0x1FC400200002, 0x220002,
+		0x240002, 0x1FC400200002, 0x230002, 0x240002, 0x1FC400200002, 0x220002, 0x2A0002, 0x1FC400200002,
+		0x230002, 0x2A0002, 0x1FC400200008, 0x220002, 0x1FC400200008, 0x230002, 0x1FC400200008, 0x220002,
+		0x250002, 0x1FC400200008, 0x230002, 0x250002, 0x1FC400200008, 0x220002, 0x240002, 0x1FC400200008,
+		0x230002, 0x240002, 0x1FC400200008, 0x220002, 0x2A0002, 0x1FC400200008, 0x230002, 0x2A0002,
+		0x1FC600200002, 0x220002, 0x1FC600200002, 0x230002, 0x1FC600200002, 0x220002, 0x250002, 0x1FC600200002,
+		0x230002, 0x250002, 0x1FC600200002, 0x220002, 0x240002, 0x1FC600200002, 0x230002, 0x240002,
+		0x1FC600200002, 0x220002, 0x2A0002, 0x1FC600200002, 0x230002, 0x2A0002, 0x1FC600200008, 0x220002,
+		0x1FC600200008, 0x230002, 0x1FC600200008, 0x220002, 0x250002, 0x1FC600200008, 0x230002, 0x250002,
+		0x1FC600200008, 0x220002, 0x240002, 0x1FC600200008, 0x230002, 0x240002, 0x1FC600200008, 0x220002,
+		0x2A0002, 0x1FC600200008, 0x230002, 0x2A0002, 0x1FCE00200002, 0x220002, 0x1FCE00200002, 0x230002,
+		0x1FCE00200002, 0x220002, 0x250002, 0x1FCE00200002, 0x230002, 0x250002, 0x1FCE00200002, 0x220002,
+		0x240002, 0x1FCE00200002, 0x230002, 0x240002, 0x1FCE00200008, 0x220002, 0x1FCE00200008, 0x230002,
+		0x1FCE00200008, 0x220002, 0x250002, 0x1FCE00200008, 0x230002, 0x250002, 0x1FCE00200008, 0x220002,
+		0x240002, 0x1FCE00200008, 0x230002, 0x240002, 0x1FDC00200002, 0x220002, 0x1FDC00200002, 0x230002,
+		0x1FDC00200002, 0x220002, 0x250002, 0x1FDC00200002, 0x230002, 0x250002, 0x1FDC00200002, 0x220002,
+		0x240002, 0x1FDC00200002, 0x230002, 0x240002, 0x1FDC00200002, 0x220002, 0x2A0002, 0x1FDC00200002,
+		0x230002, 0x2A0002, 0x1FDC00200008, 0x230002, 0x1FDC00200008, 0x230002, 0x250002, 0x1FDC00200008,
+		0x230002, 0x240002, 0x1FDC00200008, 0x230002, 0x2A0002, 0x1FE100200002, 0x220002, 0x1FE100200002,
+		0x230002, 0x1FE100200002, 0x220002, 0x250002, 0x1FE100200002, 0x230002, 0x250002, 0x1FE100200002,
+		0x220002, 0x240002, 0x1FE100200002, 0x230002, 0x240002, 0x1FE100200002, 0x220002, 0x2A0002,
+		0x1FE100200002, 0x230002, 0x2A0002, 0x1FE100200008, 0x220002, 0x1FE100200008, 0x230002, 0x1FE100200008,
+		0x220002, 0x250002, 0x1FE100200008, 0x230002, 0x250002, 0x1FE100200008, 0x220002, 0x240002,
+		0x1FE100200008, 0x230002, 0x240002, 0x1FE100200008, 0x220002, 0x2A0002, 0x1FE100200008, 0x230002,
+		0x2A0002, 0x1FB900200002, 0x250002, 0x1FB900200002, 0x240002, 0x1FBE00200002, 0x250002, 0x1FBE00200002,
+		0x240002, 0x1FC400200002, 0x250002, 0x1FC400200002, 0x240002, 0x1FC600200002, 0x250002, 0x1FC600200002,
+		0x240002, 0x1FCE00200002, 0x250002, 0x1FCE00200002, 0x240002, 0x1FDC00200002, 0x250002, 0x1FDC00200002,
+		0x240002, 0x1FE100200002, 0x250002, 0x1FE100200002, 0x240002, 0x1FB900200002, 0x220002, 0x4C0002,
+		0x1FB900200002, 0x230002, 0x4C0002, 0x1FB900200002, 0x220002, 0x250002, 0x4C0002, 0x1FB900200002,
+		0x230002, 0x250002, 0x4C0002, 0x1FB900200002, 0x220002, 0x240002, 0x4C0002, 0x1FB900200002,
+		0x230002, 0x240002, 0x4C0002, 0x1FB900200002, 0x220002, 0x2A0002, 0x4C0002, 0x1FB900200002,
+		0x230002, 0x2A0002, 0x4C0002, 0x1FB900200008, 0x220002, 0x4C0002, 0x1FB900200008, 0x230002,
+		0x4C0002, 0x1FB900200008, 0x220002, 0x250002, 0x4C0002, 0x1FB900200008, 0x230002, 0x250002,
+		0x4C0002, 0x1FB900200008, 0x220002, 0x240002, 0x4C0002, 0x1FB900200008, 0x230002, 0x240002,
+		0x4C0002, 0x1FB900200008, 0x220002, 0x2A0002, 0x4C0002, 0x1FB900200008, 0x230002, 0x2A0002,
+		0x4C0002, 0x1FC400200002, 0x220002, 0x4C0002, 0x1FC400200002, 0x230002, 0x4C0002, 0x1FC400200002,
+		0x220002, 0x250002, 0x4C0002, 0x1FC400200002, 0x230002, 0x250002, 0x4C0002, 0x1FC400200002,
+		0x220002, 0x240002, 0x4C0002, 0x1FC400200002, 0x230002, 0x240002, 0x4C0002, 0x1FC400200002,
+		0x220002, 0x2A0002, 0x4C0002, 0x1FC400200002, 0x230002, 0x2A0002, 0x4C0002, 0x1FC400200008,
+		0x220002, 0x4C0002, 0x1FC400200008, 0x230002, 0x4C0002, 0x1FC400200008, 0x220002, 0x250002,
+		0x4C0002, 0x1FC400200008, 0x230002, 0x250002, 0x4C0002, 0x1FC400200008, 0x220002, 0x240002,
+		0x4C0002, 0x1FC400200008, 0x230002, 0x240002, 0x4C0002, 0x1FC400200008, 0x220002, 0x2A0002,
+		0x4C0002, 0x1FC400200008, 0x230002, 0x2A0002, 0x4C0002, 0x1FE100200002, 0x220002, 0x4C0002,
+		0x1FE100200002, 0x230002, 0x4C0002, 0x1FE100200002, 0x220002, 0x250002, 0x4C0002, 0x1FE100200002,
+		0x230002, 0x250002, 0x4C0002, 0x1FE100200002, 0x220002, 0x240002, 0x4C0002, 0x1FE100200002,
+		0x230002, 0x240002, 0x4C0002, 0x1FE100200002, 0x220002, 0x2A0002, 0x4C0002, 0x1FE100200002,
+		0x230002, 0x2A0002, 0x4C0002, 0x1FE100200008, 0x220002, 0x4C0002, 0x1FE100200008, 0x230002,
+		0x4C0002, 0x1FE100200008, 0x220002, 0x250002, 0x4C0002, 0x1FE100200008, 0x230002, 0x250002,
+		0x4C0002, 0x1FE100200008, 0x220002, 0x240002, 0x4C0002, 0x1FE100200008, 0x230002, 0x240002,
+		0x4C0002, 0x1FE100200008, 0x220002, 0x2A0002, 0x4C0002, 0x1FE100200008, 0x230002, 0x2A0002,
+		0x4C0002, 0x1FB900200002, 0x260002, 0x1FB900200002, 0x320002, 0x1FB900200002, 0x250002, 0x4C0002,
+		0x1FB900200002, 0x4C0002, 0x1FB900200002, 0x240002, 0x4C0002, 0x1FB900200002, 0x2A0002, 0x1FB900200002,
+		0x2A0002, 0x4C0002, 0x1FB900200008, 0x260002, 0x1FB900200008, 0x320002, 0x1FB900200008, 0x250002,
+		0x1FB900200008, 0x240002, 0x1FB900200008, 0x4C0002, 0x48900200002, 0x2A0002, 0x1FC400200002, 0x250002,
+		0x4C0002, 0x1FC400200002, 0x4C0002, 0x1FC400200002, 0x240002, 0x4C0002, 0x1FC400200002, 0x2A0002,
+		0x1FC400200002, 0x2A0002, 0x4C0002, 0x1FBE00200008, 0x250002, 0x1FBE00200008, 0x240002, 0x1FC400200008,
+		0x250002, 0x1FC400200008, 0x240002, 0x1FC400200008, 0x4C0002, 0x48E00200002, 0x250002, 0x48E00200002,
+		0x240002, 0x48E00200002, 0x2A0002, 0x1FC600200002, 0x260002, 0x1FC600200002, 0x320002, 0x1FC600200002,
+		0x2B0002, 0x250002, 0x1FC600200002, 0x2B0002, 0x240002, 0x1FC600200002, 0x2A0002, 0x1FC600200002,
+		0x2B0002, 0x2A0002, 0x1FC600200008, 0x260002, 0x1FC600200008, 0x320002, 0x1FC600200008, 0x250002,
+		0x1FC600200008, 0x240002, 0x48F00200002, 0x250002, 0x48F00200002, 0x240002, 0x48F00200002, 0x2A0002,
+		0x1FDC00200002, 0x260002, 0x1FDC00200002, 0x320002, 0x1FDC00200002, 0x2B0002, 0x250002, 0x1FDC00200002,
+		0x2B0002, 0x240002, 0x1FD400200002, 0x220002, 0x1FD400200002, 0x230002, 0x1FDC00200002, 0x2A0002,
+		0x1FDC00200002, 0x2B0002, 0x2A0002, 0x1FDC00200008, 0x260002, 0x1FDC00200008, 0x320002, 0x1FDC00200008,
+		0x250002, 0x1FDC00200008, 0x240002, 0x1FD400200008, 0x230002, 0x48900200002, 0x250002, 0x48900200002,
+		0x240002, 0x1FE100200002, 0x250002, 0x4C0002, 0x1FE100200002, 0x4C0002, 0x1FE100200002, 0x240002,
+		0x4C0002, 0x1FE100200002, 0x2A0002, 0x1FE100200002, 0x2A0002, 0x4C0002, 0x1FCE00200008, 0x250002,
+		0x1FCE00200008, 0x240002, 0x1FE100200008, 0x250002, 0x1FE100200008, 0x240002, 0x1FE100200008, 0x4C0002,
+		0x27700200004, 0x27700200004, 0x27700200004, 0x27700200004, 0x27700200004, 0x3AA00200004, 0x3AA00200004, 0x3AA00200004,
+		0x3AA00200004, 0x3AA00200004, 0x3AB00200004, 0x3AB00200004, 0x3AB00200004, 0x3AB00200004, 0x3AB00200004, 0x26000200004,
+		0x26000200004, 0x26600200004, 0x26600200004, 0x26600200004, 0x26000200004, 0x26000200004, 0x26600200004, 0x3AA00200004,
+		0x3AA00200004, 0x3AA00200004, 0x3AA00200004, 0x1E330020000A, 0x1E7100200004, 0x1C4700200004, 0x39400200004, 0x1C7A00200004,
+		0x1C4700200004, 0x39400200004, 0x1E7100200004, 0x4F600200004, 0x1C7A0020000A, 0x1C7A00200004, 0x39400200004, 0x1DDD00200004,
+		0x1C7A00200004, 0x39400200004, 0x1EB500200004, 0x4F600200004, 0x1CE50020000A, 0x1D1800200002, 0x390002, 0x1DB90020000A,
+		0x1DDD00200004, 0x1E7100200014, 0x1DAA00200014, 0x1E950020000A, 0x1CAA0020000A, 0x1D770020000A, 0x1E9500200014, 0x1DAA00200014,
+		0x1C4700200008, 0x290002, 0x1CE50020000A, 0x1C470020000A, 0x1EFF0020000A, 0x1C470020000A, 0x39400200004, 0x1E710020000A,
+		0x1C3E0020001E, 0x6260020001E, 0x1C440020001E, 0x1C3E0020001E, 0x6260020001E, 0x1C460020001E, 0x1C3E0020001E, 0x6260020001E,
+		0x1C3E0020001E, 0x1C3D0020001E, 0x1C3E0020001E, 0x6260020001E, 0x1C400020001E, 0x1C3F0020001E, 0x6260020001E, 0x1C400020001E,
+		0x1C3E0020001E, 0x6260020001E, 0x1C420020001E, 0x1C3F0020001E, 0x6260020001E, 0x1C420020001E, 0x1C400020001E, 0x6260020001E,
+		0x1C420020001E, 0x1C410020001E, 0x6260020001E, 0x1C420020001E, 0x1C3E0020001E, 0x6260020001E, 0x1C430020001E, 0x1C420020001E,
+		0x6260020001E, 0x1C430020001E, 0x1C3E0020001E, 0x6260020001E, 0x1C450020001E, 0x1C400020001E, 0x6260020001E, 0x1C450020001E,
+		0x1C420020001E, 0x6260020001E, 0x1C450020001E, 0x1C440020001E, 0x6260020001E, 0x1C450020001E, 0x1C3E0020001E, 0x6260020001E,
+		0x1D320020000A, 0x1D320020000A, 0x1D320020000A, 0x1D320020000A, 0x1D320020000A, 0x1D320020000A, 0x1EE30020000A, 0x1EE30020000A,
+		0x1D320020000A, 0x1EE30020000A, 0x1D320020000A, 0x1D320020000A, 0x1EE30020000A, 0x1D320020000A, 0x1D320020000A, 0x1D320020000A,
+		0x1D320020000A, 0x1EFF0020000A, 0x1EFF0020000A, 0x1D320020000A, 0x1EFF0020000A, 0x1D320020000A, 0x1D320020000A, 0x1D3200200004,
+		0x1D3200200004, 0x1D3200200004, 0x1D3200200004, 0x1D3200200004, 0x1D3200200004, 0x1EE300200004, 0x1EE300200004, 0x1D3200200004,
+		0x1EE300200004, 0x1D3200200004, 0x1D3200200004, 0x1EE300200004, 0x1D3200200004, 0x1D3200200004, 0x1D3200200004, 0x1D3200200004,
+		0x1EFF00200004, 0x1EFF00200004, 0x1D3200200004, 0x1EFF00200004, 0x1D3200200004, 0x1D3200200004, 0x1C3D0020001E, 0x6260020001E,
+		0x1C400020001E, 0x59C00200002, 0x2F0002, 0x59D00200002, 0x2F0002, 0x5A000200002, 0x2F0002, 0x5D600200002,
+		0x2F0002, 0x5DA00200002, 0x2F0002, 0x5D800200002, 0x2F0002, 0x60900200002, 0x2F0002, 0x60D00200002,
+		0x2F0002, 0x60F00200002, 0x2F0002, 0x63600200002, 0x2F0002, 0x63700200002, 0x2F0002, 0x63C00200004,
+		0x63C00200004, 0x63C00200004, 0x63C00200004, 0x63C00200004, 0x63D00200004, 0x63D00200004, 0x63D00200004, 0x63D00200004,
+		0x63D00200004, 0x64900200002, 0x2F0002, 0x64F00200002, 0x2F0002, 0x65000200002, 0x2F0002, 0x65200200002,
+		0x2F0002, 0x61B00200002, 0x2F0002, 0x66900200002, 0x2F0002, 0x65600200002, 0x2F0002, 0x61A00200002,
+		0x2F0002, 0x61C00200002, 0x2F0002, 0x66B00200002, 0x2F0002, 0x66C00200002, 0x2F0002, 0x67400200002,
+		0x2F0002, 0x67500200002, 0x2F0002, 0x67600200002, 0x2F0002, 0x67700200002, 0x2F0002, 0x67800200002,
+		0x2F0002, 0x67900200002, 0x2F0002, 0x67E00200002, 0x2F0002, 0x67F00200002, 0x2F0002, 0x68000200002,
+		0x2F0002, 0x68100200002, 0x2F0002, 0x69A00200002, 0x2F0002, 0x6A000200002, 0x2F0002, 0x6A100200002,
+		0x2F0002, 0x6A300200002, 0x2F0002, 0x67A00200002, 0x2F0002, 0x67B00200002, 0x2F0002, 0x68900200002,
+		0x2F0002, 0x68A00200002, 0x2F0002, 0x6A600200002, 0x2F0002, 0x6A700200002, 0x2F0002, 0x6A800200002,
+		0x2F0002, 0x6A900200002, 0x2F0002, 0x1C3E00200006, 0x1C3D00200006, 0x1C3E00200006, 0x1C3E00200006, 0x1C3E00200006,
+		0x1C3F00200006, 0x1C3E00200006, 0x1C4000200006, 0x1C3E00200006, 0x1C4100200006, 0x1C3E00200006, 0x1C4200200006, 0x1C3E00200006,
+		0x1C4300200006, 0x1C3E00200006, 0x1C4400200006, 0x1C3E00200006, 0x1C4500200006, 0x1C3E00200006, 0x1C4600200006, 0x1C3F00200006,
+		0x1C3D00200006, 0x31700200004, 0x1C3E00200004, 0x31800200004, 0x31700200004, 0x1C3F00200004, 0x31800200004, 0x31700200004,
+		0x1C4000200004, 0x31800200004, 0x31700200004, 0x1C4100200004, 0x31800200004, 0x31700200004, 0x1C4200200004, 0x31800200004,
+		0x31700200004, 0x1C4300200004, 0x31800200004, 0x31700200004, 0x1C4400200004, 0x31800200004, 0x31700200004, 0x1C4500200004,
+		0x31800200004, 0x31700200004, 0x1C4600200004, 0x31800200004, 0x31700200004, 0x1C3E00200004, 0x1C3D00200004, 0x31800200004,
+		0x31700200004, 0x1C3E00200004, 0x1C3E00200004, 0x31800200004, 0x31700200004, 0x1C3E00200004, 0x1C3F00200004, 0x31800200004,
+		0x31700200004, 0x1C3E00200004, 0x1C4000200004, 0x31800200004, 0x31700200004, 0x1C3E00200004, 0x1C4100200004, 0x31800200004,
+		0x31700200004, 0x1C3E00200004, 0x1C4200200004, 0x31800200004, 0x31700200004, 0x1C3E00200004, 0x1C4300200004, 0x31800200004,
+		0x31700200004, 0x1C3E00200004, 0x1C4400200004, 0x31800200004, 0x31700200004, 0x1C3E00200004, 0x1C4500200004, 0x31800200004,
+		0x31700200004, 0x1C3E00200004, 0x1C4600200004, 0x31800200004, 0x31700200004, 0x1C3F00200004, 0x1C3D00200004, 0x31800200004,
+		0x1C3E00200004, 0x27700200004, 0x1C3F00200004, 0x27700200004, 0x1C4000200004, 0x27700200004, 0x1C4100200004, 0x27700200004,
+		0x1C4200200004, 0x27700200004, 0x1C4300200004, 0x27700200004, 0x1C4400200004, 0x27700200004, 0x1C4500200004, 0x27700200004,
+		0x1C4600200004, 0x27700200004, 0x1C3E00200004, 0x1C3D00200004, 0x27700200004, 0x1C3E00200004, 0x1C3E00200004, 0x27700200004,
+		0x1C3E00200004, 0x1C3F00200004, 0x27700200004, 0x1C3E00200004, 0x1C4000200004, 0x27700200004, 0x1C3E00200004, 0x1C4100200004,
+		0x27700200004, 0x1C3E00200004, 0x1C4200200004, 0x27700200004, 0x1C3E00200004, 0x1C4300200004, 0x27700200004, 0x1C3E00200004,
+		0x1C4400200004, 0x27700200004, 0x1C3E00200004, 0x1C4500200004, 0x27700200004, 0x1C3E00200004, 0x1C4600200004, 0x27700200004,
+		0x1C3F00200004, 0x1C3D00200004, 0x27700200004, 0x31700200004, 0x1C4700200004, 0x31800200004, 0x31700200004, 0x1C6000200004,
+		0x31800200004, 0x31700200004, 0x1C7A00200004, 0x31800200004, 0x31700200004, 0x1C8F00200004, 0x31800200004, 0x31700200004,
+		0x1CAA00200004, 0x31800200004, 0x31700200004, 0x1CE500200004, 0x31800200004, 0x31700200004, 0x1CF400200004, 0x31800200004,
+		0x31700200004, 0x1D1800200004, 0x31800200004, 0x31700200004, 0x1D3200200004, 0x31800200004, 0x31700200004, 0x1D4C00200004,
+		0x31800200004, 0x31700200004, 0x1D6500200004, 0x31800200004, 0x31700200004, 0x1D7700200004, 0x31800200004, 0x31700200004,
+		0x1DAA00200004, 0x31800200004, 0x31700200004, 0x1DB900200004, 0x31800200004, 0x31700200004, 0x1DDD00200004, 0x31800200004,
+		0x31700200004, 0x1E0C00200004, 0x31800200004, 0x31700200004, 0x1E2100200004, 0x31800200004, 0x31700200004, 0x1E3300200004,
+		0x31800200004, 0x31700200004, 0x1E7100200004, 0x31800200004, 0x31700200004, 0x1E9500200004, 0x31800200004, 0x31700200004,
+		0x1EB500200004, 0x31800200004, 0x31700200004, 0x1EE300200004, 0x31800200004, 0x31700200004, 0x1EF500200004, 0x31800200004,
+		0x31700200004, 0x1EFF00200004, 0x31800200004, 0x31700200004, 0x1F0B00200004, 0x31800200004, 0x31700200004, 0x1F2100200004,
+		0x31800200004, 0x1C3E00200006, 0x1C3E00200006, 0x1C3E00200006, 0x1C3F00200006, 0x1C3E00200006, 0x1C4000200006, 0x1C3E00200006,
+		0x1C4100200006, 0x1C3E00200006, 0x1C4200200006, 0x1C3E00200006, 0x1C4300200006, 0x1C3E00200006, 0x1C4400200006, 0x1C3E00200006,
+		0x1C4500200006, 0x1C3E00200006, 0x1C4600200006, 0x1C3F00200006, 0x1C3D00200006, 0x1C3E00200006, 0x1C3D00200006, 0x1C3E00200006,
+		0x1C3D00200006, 0x1C3E00200006, 0x1C3D00200006, 0x1C3E00200006, 0x1C3D00200006, 0x63C00200004, 0x63C00200004, 0x63C00200004,
+		0x63C00200004, 0x23900200004, 0x23900200004, 0x61B00200004, 0x61B00200004, 0x61B00200004, 0x61B00200004, 0x61B00200004,
+		0x61B00200004, 0xCA500200002, 0x2F0002, 0x1FF100200004, 0x1FE600200004, 0x1FF000200004, 0x210500200004, 0x210E00200004,
+		0xFB4000200004, 0xCE3600000000, 0x1100004, 0xFB4000200004, 0xD38200000000, 0x1100004, 0xFB4000200004, 0xCE5B00000000,
+		0xFB4000200004, 0xCE5A00000000, 0xFB4000200004, 0xCE5900000000, 0x1100004, 0xFB4000200004, 0xCEBB00000000, 0xFB4000200004,
+		0xD18200000000, 0x1100004, 0xFB4000200004, 0xD1E000000000, 0x1100004, 0xFB4000200004, 0xD20000000000, 0x1100004,
+		0xFB4000200004, 0xD20200000000, 0xFB4000200004, 0xD35C00000000, 0x1100004, 0xFB4000200004, 0xD36900000000, 0x1100004,
+		0xFB4000200004, 0xDC0F00000000, 0x1100004, 0xFB4000200004, 0xDC0F00000000, 0x1110004, 0xFB4000200004, 0xDC2200000000,
+		0x1100004, 0xFB4000200004, 0xDC2300000000, 0xFB4000200004, 0xDC2200000000, 0xFB4000200004, 0xDC2300000000, 0x1100004,
+		0xFB4000200004, 0xDDF300000000, 0xFB4000200004, 0xDE7A00000000, 0xFB4000200004, 0xDF5100000000, 0xFB4000200004, 0xDF5000000000,
+		0x1100004, 0xFB4000200004, 0xDFC400000000, 0xFB4000200004, 0xDFC300000000, 0x1100004, 0xFB4000200004, 0xE24C00000000,
+		0xFB4000200004, 0xE53500000000, 0xFB4000200004, 0xE5E100000000, 0xFB4000200004, 0xE5E500000000, 0x1100004, 0xFB4000200004,
+		0xE70800000000, 0x1100004, 0xFB4000200004, 0xEB7A00000000, 0x1100004, 0xFB4000200004, 0xEBCD00000000, 0xFB4000200004,
+		0xEC1100000000, 0xFB4000200004, 0xEC3500000000, 0xFB4000200004, 0xEC3A00000000, 0xFB4000200004, 0xF06C00000000, 0xFB4000200004,
+		0xF22B00000000, 0xFB4000200004, 0xF22B00000000, 0x1100004, 0xFB4000200004, 0xCE2C00000000, 0xFB4000200004, 0xF25B00000000,
+		0x1100004, 0xFB4000200004, 0xF2AD00000000, 0xFB4000200004, 0xF38B00000000, 0x1100004, 0xFB4000200004, 0xF58B00000000,
+		0x1100004, 0xFB4000200004, 0xF6EE00000000, 0x1100004, 0xFB4000200004, 0xF93A00000000, 0x1100004, 0xFB4000200004,
+		0xF93B00000000, 0xFB4000200004, 0xFAF900000000, 0x1100004, 0xFB4000200004, 0xFCF900000000, 0xFB4000200004, 0xFE9F00000000,
+		0xFB4000200004, 0xFF5300000000, 0xFB4000200004, 0xFF5200000000, 0xFB4000200004, 0xFF5300000000, 0x1100004, 0xFB4000200004,
+		0xFF5300000000, 0x1110004, 0xFB4000200004, 0xFF5200000000, 0x1100004, 0xFB4000200004, 0xFF8A00000000, 0x1100004,
+		0xFB4000200004, 0xFF8A00000000, 0x1110004, 0xFB4000200004, 0xFF8B00000000, 0xFB4100200004, 0x800200000000, 0xFB4100200004,
+		0x808000000000, 0xFB4100200004, 0x807F00000000, 0x1100004, 0xFB4100200004, 0x808900000000, 0x1100004, 0xFB4100200004,
+		0x81FC00000000, 0x1100004, 0xFB4100200004, 0x827900000000, 0xFB4100200004, 0x827900000000, 0x1100004, 0xFB4100200004,
+		0x827900000000, 0x1110004, 0xFB4100200004, 0x864E00000000, 0xFB4100200004, 0x886400000000, 0xFB4100200004, 0x898000000000,
+		0xFB4100200004, 0x897F00000000, 0xFB4100200004, 0x89C100000000, 0xFB4100200004, 0x89D200000000, 0xFB4100200004, 0x89D200000000,
+		0x1100004, 0xFB4100200004, 0x8BA000000000, 0xFB4100200004, 0x8D1D00000000, 0xFB4100200004, 0x8DB300000000, 0x1100004,
+		0xFB4100200004, 0x8F6600000000, 0xFB4100200004, 0x8FB600000000, 0xFB4100200004, 0x8FB600000000, 0x1100004, 0xFB4100200004,
+		0x8FB600000000, 0x1110004, 0xFB4100200004, 0x909100000000, 0x1100004, 0xFB4100200004, 0x948500000000, 0xFB4100200004,
+		0x957700000000, 0xFB4100200004, 0x957800000000, 0xFB4100200004, 0x957F00000000, 0xFB4100200004, 0x95E800000000, 0xFB4100200004,
+		0x961C00000000, 0x1100004, 0xFB4100200004, 0x961D00000000, 0xFB4100200004, 0x96E800000000, 0x1100004, 0xFB4100200004,
+		0x975200000000, 0xFB4100200004, 0x97E600000000, 0xFB4100200004, 0x987500000000, 0xFB4100200004, 0x98CE00000000, 0xFB4100200004,
+		0x98DE00000000, 0xFB4100200004, 0x98DF00000000, 0xFB4100200004, 0x98E000000000, 0x1100004, 0xFB4100200004, 0x98E000000000,
+		0xFB4100200004, 0x996300000000, 0xFB4100200004, 0x999600000000, 0x1100004, 0xFB4100200004, 0x9A6C00000000, 0xFB4100200004,
+		0x9AA800000000, 0x1100004, 0xFB4100200004, 0x9B3C00000000, 0x1100004, 0xFB4100200004, 0x9C7C00000000, 0xFB4100200004,
+		0x9E1F00000000, 0xFB4100200004, 0x9E7500000000, 0x1100004, 0xFB4100200004, 0x9EA600000000, 0xFB4100200004, 0x9EC400000000,
+		0xFB4100200004, 0x9EFE00000000, 0xFB4100200004, 0x9F4A00000000, 0x1100004, 0xFB4100200004, 0x9F5000000000, 0xFB4100200004,
+		0x9F5200000000, 0x1100004, 0xFB4100200004, 0x9F7F00000000, 0xFB4100200004, 0x9F8D00000000, 0x1100004, 0xFB4100200004,
+		0x9F9900000000, 0xFB4100200004, 0x9F9C00000000, 0x1100004, 0xFB4100200004, 0x9F9C00000000, 0x1110004, 0xFB4100200004,
+		0x9F9F00000000, 0xFB4000200004, 0xCE0000000000, 0xFB4000200004, 0xCE2800000000, 0xFB4000200004, 0xCE3600000000, 0xFB4000200004,
+		0xCE3F00000000, 0xFB4000200004, 0xCE5900000000, 0xFB4000200004, 0xCE8500000000, 0xFB4000200004, 0xCE8C00000000, 0xFB4000200004,
+		0xCEA000000000, 0xFB4000200004, 0xCEBA00000000, 0xFB4000200004, 0xD13F00000000, 0xFB4000200004, 0xD16500000000, 0xFB4000200004,
+		0xD16B00000000, 0xFB4000200004, 0xD18200000000, 0xFB4000200004, 0xD19600000000, 0xFB4000200004, 0xD1AB00000000, 0xFB4000200004,
+		0xD1E000000000, 0xFB4000200004, 0xD1F500000000, 0xFB4000200004, 0xD20000000000, 0xFB4000200004, 0xD29B00000000, 0xFB4000200004,
+		0xD2F900000000, 0xFB4000200004, 0xD31500000000, 0xFB4000200004, 0xD31A00000000, 0xFB4000200004, 0xD33800000000, 0xFB4000200004,
+		0xD34100000000, 0xFB4000200004, 0xD35C00000000, 0xFB4000200004, 0xD36900000000, 0xFB4000200004, 0xD38200000000, 0xFB4000200004,
+		0xD3B600000000, 0xFB4000200004, 0xD3C800000000, 0xFB4000200004, 0xD3E300000000, 0xFB4000200004, 0xD6D700000000, 0xFB4000200004,
+		0xD71F00000000, 0xFB4000200004, 0xD8EB00000000, 0xFB4000200004, 0xD90200000000, 0xFB4000200004, 0xD90A00000000, 0xFB4000200004,
+		0xD91500000000, 0xFB4000200004, 0xD92700000000, 0xFB4000200004, 0xD97300000000, 0xFB4000200004, 0xDB5000000000, 0xFB4000200004,
+		0xDB8000000000, 0xFB4000200004, 0xDBF800000000, 0xFB4000200004, 0xDC0F00000000, 0xFB4000200004, 0xDC2200000000, 0xFB4000200004,
+		0xDC3800000000, 0xFB4000200004, 0xDC6E00000000, 0xFB4000200004, 0xDC7100000000, 0xFB4000200004, 0xDDDB00000000, 0xFB4000200004,
+		0xDDE500000000, 0xFB4000200004, 0xDDF100000000, 0xFB4000200004, 0xDDFE00000000, 0xFB4000200004, 0xDE7200000000, 0xFB4000200004,
+		0xDE7A00000000, 0xFB4000200004, 0xDE7F00000000, 0xFB4000200004, 0xDEF400000000, 0xFB4000200004, 0xDEFE00000000, 0xFB4000200004,
+		0xDF0B00000000, 0xFB4000200004, 0xDF1300000000, 0xFB4000200004, 0xDF5000000000, 0xFB4000200004, 0xDF6100000000, 0xFB4000200004,
+		0xDF7300000000, 0xFB4000200004, 0xDFC300000000, 0xFB4000200004, 0xE20800000000, 0xFB4000200004, 0xE23600000000, 0xFB4000200004,
+		0xE24B00000000, 0xFB4000200004, 0xE52F00000000, 0xFB4000200004, 0xE53400000000, 0xFB4000200004, 0xE58700000000, 0xFB4000200004,
+		0xE59700000000, 0xFB4000200004, 0xE5A400000000, 0xFB4000200004, 0xE5B900000000, 0xFB4000200004, 0xE5E000000000, 0xFB4000200004,
+		0xE5E500000000, 0xFB4000200004, 0xE6F000000000, 0xFB4000200004, 0xE70800000000, 0xFB4000200004, 0xE72800000000, 0xFB4000200004,
+		0xEB2000000000, 0xFB4000200004, 0xEB6200000000, 0xFB4000200004, 0xEB7900000000, 0xFB4000200004, 0xEBB300000000, 0xFB4000200004,
+		0xEBCB00000000, 0xFB4000200004, 0xEBD400000000, 0xFB4000200004, 0xEBDB00000000, 0xFB4000200004, 0xEC0F00000000, 0xFB4000200004,
+		0xEC1400000000, 0xFB4000200004, 0xEC3400000000, 0xFB4000200004, 0xF06B00000000, 0xFB4000200004, 0xF22A00000000, 0xFB4000200004,
+		0xF23600000000, 0xFB4000200004, 0xF23B00000000, 0xFB4000200004, 0xF23F00000000, 0xFB4000200004, 0xF24700000000, 0xFB4000200004,
+		0xF25900000000, 0xFB4000200004, 0xF25B00000000, 0xFB4000200004, 0xF2AC00000000, 0xFB4000200004, 0xF38400000000, 0xFB4000200004,
+		0xF38900000000, 0xFB4000200004, 0xF4DC00000000, 0xFB4000200004, 0xF4E600000000, 0xFB4000200004, 0xF51800000000, 0xFB4000200004,
+		0xF51F00000000, 0xFB4000200004, 0xF52800000000, 0xFB4000200004, 0xF53000000000, 0xFB4000200004, 0xF58B00000000, 0xFB4000200004,
+		0xF59200000000, 0xFB4000200004, 0xF67600000000, 0xFB4000200004, 0xF67D00000000, 0xFB4000200004, 0xF6AE00000000, 0xFB4000200004,
+		0xF6BF00000000, 0xFB4000200004, 0xF6EE00000000, 0xFB4000200004, 0xF7DB00000000, 0xFB4000200004, 0xF7E200000000, 0xFB4000200004,
+		0xF7F300000000, 0xFB4000200004, 0xF93A00000000, 0xFB4000200004, 0xF9B800000000, 0xFB4000200004, 0xF9BE00000000, 0xFB4000200004,
+		0xFA7400000000, 0xFB4000200004, 0xFACB00000000, 0xFB4000200004, 0xFAF900000000, 0xFB4000200004, 0xFC7300000000, 0xFB4000200004,
+		0xFCF800000000, 0xFB4000200004, 0xFF3600000000, 0xFB4000200004, 0xFF5100000000, 0xFB4000200004, 0xFF8A00000000, 0xFB4000200004,
+		0xFFBD00000000, 0xFB4100200004, 0x800100000000, 0xFB4100200004, 0x800C00000000, 0xFB4100200004, 0x801200000000, 0xFB4100200004,
+		0x803300000000, 0xFB4100200004, 0x807F00000000, 0xFB4100200004, 0x808900000000, 0xFB4100200004, 0x81E300000000, 0xFB4100200004,
+		0x81EA00000000, 0xFB4100200004, 0x81F300000000, 0xFB4100200004, 0x81FC00000000, 0xFB4100200004, 0x820C00000000, 0xFB4100200004,
+		0x821B00000000, 0xFB4100200004, 0x821F00000000, 0xFB4100200004, 0x826E00000000, 0xFB4100200004, 0x827200000000, 0xFB4100200004,
+		0x827800000000, 0xFB4100200004, 0x864D00000000, 0xFB4100200004, 0x866B00000000, 0xFB4100200004, 0x884000000000, 0xFB4100200004,
+		0x884C00000000, 0xFB4100200004, 0x886300000000, 0xFB4100200004, 0x897E00000000, 0xFB4100200004, 0x898B00000000, 0xFB4100200004,
+		0x89D200000000, 0xFB4100200004, 0x8A0000000000, 0xFB4100200004, 0x8C3700000000, 0xFB4100200004, 0x8C4600000000, 0xFB4100200004,
+		0x8C5500000000, 0xFB4100200004, 0x8C7800000000, 0xFB4100200004, 0x8C9D00000000, 0xFB4100200004, 0x8D6400000000, 0xFB4100200004,
+		0x8D7000000000, 0xFB4100200004, 0x8DB300000000, 0xFB4100200004, 0x8EAB00000000, 0xFB4100200004, 0x8ECA00000000, 0xFB4100200004,
+		0x8F9B00000000, 0xFB4100200004, 0x8FB000000000, 0xFB4100200004, 0x8FB500000000, 0xFB4100200004, 0x909100000000, 0xFB4100200004,
+		0x914900000000, 0xFB4100200004, 0x91C600000000, 0xFB4100200004, 0x91CC00000000, 0xFB4100200004, 0x91D100000000, 0xFB4100200004,
+		0x957700000000, 0xFB4100200004, 0x958000000000, 0xFB4100200004, 0x961C00000000, 0xFB4100200004, 0x96B600000000, 0xFB4100200004,
+		0x96B900000000, 0xFB4100200004, 0x96E800000000, 0xFB4100200004, 0x975100000000, 0xFB4100200004, 0x975E00000000, 0xFB4100200004,
+		0x976200000000, 0xFB4100200004, 0x976900000000, 0xFB4100200004, 0x97CB00000000, 0xFB4100200004, 0x97ED00000000, 0xFB4100200004,
+		0x97F300000000, 0xFB4100200004, 0x980100000000, 0xFB4100200004, 0x98A800000000, 0xFB4100200004, 0x98DB00000000, 0xFB4100200004,
+		0x98DF00000000, 0xFB4100200004, 0x999600000000, 0xFB4100200004, 0x999900000000, 0xFB4100200004, 0x99AC00000000, 0xFB4100200004,
+		0x9AA800000000, 0xFB4100200004, 0x9AD800000000, 0xFB4100200004, 0x9ADF00000000, 0xFB4100200004, 0x9B2500000000, 0xFB4100200004,
+		0x9B2F00000000, 0xFB4100200004, 0x9B3200000000, 0xFB4100200004, 0x9B3C00000000, 0xFB4100200004, 0x9B5A00000000, 0xFB4100200004,
+		0x9CE500000000, 0xFB4100200004, 0x9E7500000000, 0xFB4100200004, 0x9E7F00000000, 0xFB4100200004, 0x9EA500000000, 0xFB4100200004,
+		0x9EBB00000000, 0xFB4100200004, 0x9EC300000000, 0xFB4100200004, 0x9ECD00000000, 0xFB4100200004, 0x9ED100000000, 0xFB4100200004,
+		0x9EF900000000, 0xFB4100200004, 0x9EFD00000000, 0xFB4100200004, 0x9F0E00000000, 0xFB4100200004, 0x9F1300000000, 0xFB4100200004,
+		0x9F2000000000, 0xFB4100200004, 0x9F3B00000000, 0xFB4100200004, 0x9F4A00000000, 0xFB4100200004, 0x9F5200000000, 0xFB4100200004,
+		0x9F8D00000000, 0xFB4100200004, 0x9F9C00000000, 0xFB4100200004, 0x9FA000000000, 0x3D6600200004, 0x3D7C00200004, 0x1C0A00200002,
+		0x370002, 0x1C0B00200002, 0x370002, 0xFB4000200004, 0xD34100000000, 0xFB4000200004, 0xD34400000000, 0xFB4000200004,
+		0xD34500000000, 0x3D7900200004, 0x3D6700200004, 0x3D600020000E, 0x370002, 0x3D610020000E, 0x370002, 0x3D620020000E,
+		0x370002, 0x3D630020000E, 0x370002, 0x3D640020000E, 0x370002, 0x3D650020000E, 0x370002, 0x3D660020000E,
+		0x370002, 0x3D670020000E, 0x370002, 0x3D680020000E, 0x370002, 0x3D690020000E, 0x370002, 0x3D6A0020000E,
+		0x370002, 0x3D6B0020000E, 0x370002, 0x3D6C0020000E, 0x370002, 0x3D6D0020000E, 0x370002, 0x3D6E0020000E,
+		0x370002, 0x3D740020000E, 0x370002, 0x3D740020000E, 0x380002, 0x3D750020000E, 0x370002, 0x3D750020000E,
+		0x380002, 0x3D760020000E, 0x370002, 0x3D760020000E, 0x380002, 0x3D770020000E, 0x370002, 0x3D770020000E,
+		0x380002, 0x3D780020000E, 0x370002, 0x3D780020000E, 0x380002, 0x3D5C0020000E, 0x370002, 0x1C0D00200002,
+		0x370002, 0x3D8100200016, 0x3D8300200016, 0x3D6000200011, 0x370002, 0x3D6100200011, 0x370002, 0x3D6200200011,
+		0x370002, 0x3D6300200011, 0x370002, 0x3D6400200011, 0x370002, 0x3D6500200011, 0x370002, 0x3D6600200011,
+		0x370002, 0x3D6700200011, 0x370002, 0x3D6800200011, 0x370002, 0x3D6900200011, 0x370002, 0x3D6A00200011,
+		0x370002, 0x3D6B00200011, 0x370002, 0x3D6C00200011, 0x370002, 0x3D6D00200011, 0x370002, 0x3D6E00200011,
+		0x370002, 0x3D7400200011, 0x370002, 0x3D7400200011, 0x380002, 0x3D7500200011, 0x370002, 0x3D7500200011,
+		0x380002, 0x3D7600200011, 0x370002, 0x3D7600200011, 0x380002, 0x3D7700200011, 0x370002, 0x3D7700200011,
+		0x380002, 0x3D7800200011, 0x370002, 0x3D7800200011, 0x380002, 0x3D5C00200011, 0x370002, 0x3D8700200011,
+		0x370002, 0x3D8800200011, 0x370002, 0x3D8900200011, 0x370002, 0x3D8A00200011, 0x370002, 0x1C0F00200002,
+		0x370002, 0x3D6400200016, 0x3D6E00200016, 0xFB4000200014, 0xCE0000000000, 0xFB4000200014, 0xCE8C00000000, 0xFB4000200014,
+		0xCE0900000000, 0xFB4000200014, 0xD6DB00000000, 0xFB4000200014, 0xCE0A00000000, 0xFB4000200014, 0xCE2D00000000, 0xFB4000200014,
+		0xCE0B00000000, 0xFB4000200014, 0xF53200000000, 0xFB4000200014, 0xCE5900000000, 0xFB4000200014, 0xCE1900000000, 0xFB4000200014,
+		0xCE0100000000, 0xFB4000200014, 0xD92900000000, 0xFB4000200014, 0xD73000000000, 0xFB4000200014, 0xCEBA00000000, 0x3D8C00200004,
+		0x1110004, 0x3DA200200004, 0x1110004, 0x3D9A00200004, 0x1110004, 0x3D9500200004, 0x1110004, 0x3DAD00200004,
+		0x1110004, 0x3DA900200004, 0x1110004, 0x3DBC00200004, 0x1120004, 0x3DA800200004, 0x1110004, 0x3DBB00200004,
+		0x1110004, 0x3DBC00200004, 0x1110004, 0x3DAE00200004, 0x1110004, 0x3DB000200004, 0x1110004, 0x3DBB00200016,
+		0x1110016, 0x31700200004, 0x3BF500200004, 0x31800200004, 0x31700200004, 0x3BF700200004, 0x31800200004, 0x31700200004,
+		0x3BF800200004, 0x31800200004, 0x31700200004, 0x3BFA00200004, 0x31800200004, 0x31700200004, 0x3BFB00200004, 0x31800200004,
+		0x31700200004, 0x3BFC00200004, 0x31800200004, 0x31700200004, 0x3BFE00200004, 0x31800200004, 0x31700200004, 0x3C0000200004,
+		0x31800200004, 0x31700200004, 0x3C0100200004, 0x31800200004, 0x31700200004, 0x3C0300200004, 0x31800200004, 0x31700200004,
+		0x3C0400200004, 0x31800200004, 0x31700200004, 0x3C0500200004, 0x31800200004, 0x31700200004, 0x3C0600200004, 0x31800200004,
+		0x31700200004, 0x3C0700200004, 0x31800200004, 0x31700200004, 0x3BF500200004, 0x3C7300200004, 0x31800200004, 0x31700200004,
+		0x3BF700200004, 0x3C7300200004, 0x31800200004, 0x31700200004, 0x3BF800200004, 0x3C7300200004, 0x31800200004, 0x31700200004,
+		0x3BFA00200004, 0x3C7300200004, 0x31800200004, 0x31700200004, 0x3BFB00200004, 0x3C7300200004, 0x31800200004, 0x31700200004,
+		0x3BFC00200004, 0x3C7300200004, 0x31800200004, 0x31700200004, 0x3BFE00200004, 0x3C7300200004, 0x31800200004, 0x31700200004,
+		0x3C0000200004, 0x3C7300200004, 0x31800200004, 0x31700200004, 0x3C0100200004, 0x3C7300200004, 0x31800200004, 0x31700200004,
+		0x3C0300200004, 0x3C7300200004, 0x31800200004, 0x31700200004, 0x3C0400200004, 0x3C7300200004, 0x31800200004, 0x31700200004,
+		0x3C0500200004, 0x3C7300200004, 0x31800200004, 0x31700200004, 0x3C0600200004, 0x3C7300200004, 0x31800200004, 0x31700200004,
+		0x3C0700200004, 0x3C7300200004, 0x31800200004, 0x31700200004, 0x3C0100200004, 0x3C8000200004, 0x31800200004, 0x31700200004,
+		0x3C0000200004, 0x3C7B00200004, 0x3C0100200004, 0x3C7700200004, 0x3CD400200004, 0x31800200004, 0x31700200004, 0x3C0000200004,
+		0x3C7B00200004, 0x3C0700200004, 0x3C8000200004, 0x31800200004, 0x31700200004, 0xFB4000200004, 0xCE0000000000, 0x31800200004,
+		0x31700200004, 0xFB4000200004, 0xCE8C00000000, 0x31800200004, 0x31700200004, 0xFB4000200004, 0xCE0900000000, 0x31800200004,
+		0x31700200004, 0xFB4000200004, 0xD6DB00000000, 0x31800200004, 0x31700200004, 0xFB4000200004, 0xCE9400000000, 0x31800200004,
+		0x31700200004, 0xFB4000200004, 0xD16D00000000, 0x31800200004, 0x31700200004, 0xFB4000200004, 0xCE0300000000, 0x31800200004,
+		0x31700200004, 0xFB4000200004, 0xD16B00000000, 0x31800200004, 0x31700200004, 0xFB4000200004, 0xCE5D00000000, 0x31800200004,
+		0x31700200004, 0xFB4000200004, 0xD34100000000, 0x31800200004, 0x31700200004, 0xFB4000200004, 0xE70800000000, 0x31800200004,
+		0x31700200004, 0xFB4000200004, 0xF06B00000000, 0x31800200004, 0x31700200004, 0xFB4000200004, 0xEC3400000000, 0x31800200004,
+		0x31700200004, 0xFB4000200004, 0xE72800000000, 0x31800200004, 0x31700200004, 0xFB4100200004, 0x91D100000000, 0x31800200004,
+		0x31700200004, 0xFB4000200004, 0xD71F00000000, 0x31800200004, 0x31700200004, 0xFB4000200004, 0xE5E500000000, 0x31800200004,
+		0x31700200004, 0xFB4000200004, 0xE82A00000000, 0x31800200004, 0x31700200004, 0xFB4000200004, 0xE70900000000, 0x31800200004,
+		0x31700200004, 0xFB4000200004, 0xF93E00000000, 0x31800200004, 0x31700200004, 0xFB4000200004, 0xD40D00000000, 0x31800200004,
+		0x31700200004, 0xFB4000200004, 0xF27900000000, 0x31800200004, 0x31700200004, 0xFB4100200004, 0x8CA100000000, 0x31800200004,
+		0x31700200004, 0xFB4000200004, 0xF95D00000000, 0x31800200004, 0x31700200004, 0xFB4000200004, 0xD2B400000000, 0x31800200004,
+		0x31700200004, 0xFB4000200004, 0xCEE300000000, 0x31800200004, 0x31700200004, 0xFB4000200004, 0xD47C00000000, 0x31800200004,
+		0x31700200004, 0xFB4000200004, 0xDB6600000000, 0x31800200004, 0x31700200004, 0xFB4000200004, 0xF6E300000000, 0x31800200004,
+		0x31700200004, 0xFB4000200004, 0xCF0100000000, 0x31800200004, 0x31700200004, 0xFB4100200004, 0x8CC700000000, 0x31800200004,
+		0x31700200004, 0xFB4000200004, 0xD35400000000, 0x31800200004, 0x31700200004, 0xFB4000200004, 0xF96D00000000, 0x31800200004,
+		0x31700200004, 0xFB4000200004, 0xCF1100000000, 0x31800200004, 0x31700200004, 0xFB4100200004, 0x81EA00000000, 0x31800200004,
+		0x31700200004, 0xFB4100200004, 0x81F300000000, 0x31800200004, 0xFB4000200006, 0xD54F00000000, 0xFB4000200006, 0xDE7C00000000,
+		0xFB4000200006, 0xE58700000000, 0xFB4000200006, 0xFB8F00000000, 0x1C3E00200006, 0x1C3D00200006, 0x1C3F00200006, 0x1C3D00200006,
+		0x1C4000200006, 0x1C3D00200006, 0x1C4100200006, 0x1C3D00200006, 0x1C4200200006, 0x1C3D00200006, 0x1C4300200006, 0x1C3D00200006,
+		0x1C4400200006, 0x1C3D00200006, 0x1C4500200006, 0x1C3D00200006, 0x1E0C0020001D, 0x1E950020001D, 0x1CAA0020001D, 0x1C3F00200006,
+		0x1C3E00200006, 0x1C3F00200006, 0x1C3F00200006, 0x1C3F00200006, 0x1C4000200006, 0x1C3F00200006, 0x1C4100200006, 0x1C3F00200006,
+		0x1C4200200006, 0x1C3F00200006, 0x1C4300200006, 0x1C3F00200006, 0x1C4400200006, 0x1C3F00200006, 0x1C4500200006, 0x1C3F00200006,
+		0x1C4600200006, 0x1C4000200006, 0x1C3D00200006, 0x1C4000200006, 0x1C3E00200006, 0x1C4000200006, 0x1C3F00200006, 0x1C4000200006,
+		0x1C4000200006, 0x1C4000200006, 0x1C4100200006, 0x1C4000200006, 0x1C4200200006, 0x3BF500200006, 0x3C7300200006, 0x3BF700200006,
+		0x3C7300200006, 0x3BF800200006, 0x3C7300200006, 0x3BFA00200006, 0x3C7300200006, 0x3BFB00200006, 0x3C7300200006, 0x3BFC00200006,
+		0x3C7300200006, 0x3BFE00200006, 0x3C7300200006, 0x3C0000200006, 0x3C7300200006, 0x3C0100200006, 0x3C7300200006, 0x3C0300200006,
+		0x3C7300200006, 0x3C0400200006, 0x3C7300200006, 0x3C0500200006, 0x3C7300200006, 0x3C0600200006, 0x3C7300200006, 0x3C0700200006,
+		0x3C7300200006, 0x3C0300200006, 0x3C7300200006, 0x3CE000200006, 0x3BF500200006, 0x3C7B00200006, 0x3C0100200006, 0x3C8000200006,
+		0x3C0000200006, 0x3C8600200006, 0x3C0000200006, 0x3C8000200006, 0xFB4000200006, 0xCE0000000000, 0xFB4000200006, 0xCE8C00000000,
+		0xFB4000200006, 0xCE0900000000, 0xFB4000200006, 0xD6DB00000000, 0xFB4000200006, 0xCE9400000000, 0xFB4000200006, 0xD16D00000000,
+		0xFB4000200006, 0xCE0300000000, 0xFB4000200006, 0xD16B00000000, 0xFB4000200006, 0xCE5D00000000, 0xFB4000200006, 0xD34100000000,
+		0xFB4000200006, 0xE70800000000, 0xFB4000200006, 0xF06B00000000, 0xFB4000200006, 0xEC3400000000, 0xFB4000200006, 0xE72800000000,
+		0xFB4100200006, 0x91D100000000, 0xFB4000200006, 0xD71F00000000, 0xFB4000200006, 0xE5E500000000, 0xFB4000200006, 0xE82A00000000,
+		0xFB4000200006, 0xE70900000000, 0xFB4000200006, 0xF93E00000000, 0xFB4000200006, 0xD40D00000000, 0xFB4000200006, 0xF27900000000,
+		0xFB4100200006, 0x8CA100000000, 0xFB4000200006, 0xF95D00000000, 0xFB4000200006, 0xD2B400000000, 0xFB4000200006, 0xF9D800000000,
+		0xFB4000200006, 0xF53700000000, 0xFB4000200006, 0xD97300000000, 0xFB4100200006, 0x906900000000, 0xFB4000200006, 0xD12A00000000,
+		0xFB4000200006, 0xD37000000000, 0xFB4000200006, 0xECE800000000, 0xFB4100200006, 0x980500000000, 0xFB4000200006, 0xCF1100000000,
+		0xFB4000200006, 0xD19900000000, 0xFB4000200006, 0xEB6300000000, 0xFB4000200006, 0xCE0A00000000, 0xFB4000200006, 0xCE2D00000000,
+		0xFB4000200006, 0xCE0B00000000, 0xFB4000200006, 0xDDE600000000, 0xFB4000200006, 0xD3F300000000, 0xFB4000200006, 0xD33B00000000,
+		0xFB4000200006, 0xDB9700000000, 0xFB4000200006, 0xDB6600000000, 0xFB4000200006, 0xF6E300000000, 0xFB4000200006, 0xCF0100000000,
+		0xFB4100200006, 0x8CC700000000, 0xFB4000200006, 0xD35400000000, 0xFB4000200006, 0xD91C00000000, 0x1C4000200006, 0x1C4300200006,
+		0x1C4000200006, 0x1C4400200006, 0x1C4000200006, 0x1C4500200006, 0x1C4000200006, 0x1C4600200006, 0x1C4100200006, 0x1C3D00200006,
+		0x1C4100200006, 0x1C3E00200006, 0x1C4100200006, 0x1C3F00200006, 0x1C4100200006, 0x1C4000200006, 0x1C4100200006, 0x1C4100200006,
+		0x1C4100200006, 0x1C4200200006, 0x1C4100200006, 0x1C4300200006, 0x1C4100200006, 0x1C4400200006, 0x1C4100200006, 0x1C4500200006,
+		0x1C4100200006, 0x1C4600200006, 0x1C4200200006, 0x1C3D00200006, 0x1C3E00200004, 0xFB4000200004, 0xE70800000000, 0x1C3F00200004,
+		0xFB4000200004, 0xE70800000000, 0x1C4000200004, 0xFB4000200004, 0xE70800000000, 0x1C4100200004, 0xFB4000200004, 0xE70800000000,
+		0x1C4200200004, 0xFB4000200004, 0xE70800000000, 0x1C4300200004, 0xFB4000200004, 0xE70800000000, 0x1C4400200004, 0xFB4000200004,
+		0xE70800000000, 0x1C4500200004, 0xFB4000200004, 0xE70800000000, 0x1C4600200004, 0xFB4000200004, 0xE70800000000, 0x1C3E00200004,
+		0x1C3D00200004, 0xFB4000200004, 0xE70800000000, 0x1C3E00200004, 0x1C3E00200004, 0xFB4000200004, 0xE70800000000, 0x1C3E00200004,
+		0x1C3F00200004, 0xFB4000200004, 0xE70800000000, 0x1D180020001D, 0x1CF40020001C, 0x1CAA0020001C, 0x1E330020001C, 0x1CF40020001C,
+		0x1CAA0020001C, 0x1EE30020001D, 0x1D770020001D, 0x1E950020001D, 0x1C8F0020001D, 0x3D5A0020001C, 0x3D740020001C, 0x38001C,
+		0x1C0E0020001C, 0x3D6E0020001C, 0x3D5A0020001C, 0x3D840020001C, 0x3D760020001C, 0x3D5A0020001C, 0x3D5A0020001C, 0x3D8B0020001C,
+		0x3D770020001C, 0x38001C, 0x3D5A0020001C, 0x3D5A0020001C, 0x1C0E0020001C, 0x3D840020001C, 0x3D5B0020001C, 0x3D700020001C,
+		0x3D8B0020001C, 0x3D620020001C, 0x37001C, 0x3D5B0020001C, 0x3D8B0020001C, 0x3D6B0020001C, 0x3D5C0020001C, 0x3D5F0020001C,
+		0x3D8B0020001C, 0x3D5E0020001C, 0x3D670020001C, 0x3D620020001C, 0x1C0E0020001C, 0x3D6E0020001C, 0x37001C, 0x3D5E0020001C,
+		0x1C0E0020001C, 0x3D600020001C, 0x1C0E0020001C, 0x3D5F0020001C, 0x3D8B0020001C, 0x3D670020001C, 0x3D5F0020001C, 0x1C0E0020001C,
+		0x3D7B0020001C, 0x3D600020001C, 0x3D5B0020001C, 0x3D830020001C, 0x3D600020001C, 0x3D820020001C, 0x3D6C0020001C, 0x3D6E0020001C,
+		0x3D600020001C, 0x3D860020001C, 0x3D830020001C, 0x1C0E0020001C, 0x3D600020001C, 0x37001C, 0x3D860020001C, 0x3D8B0020001C,
+		0x3D600020001C, 0x37001C, 0x3D8B0020001C, 0x3D790020001C, 0x3D610020001C, 0x37001C, 0x3D600020001C, 0x37001C,
+		0x3D610020001C, 0x37001C, 0x3D700020001C, 0x1C0E0020001C, 0x3D610020001C, 0x3D7F0020001C, 0x3D830020001C, 0x1C0E0020001C,
+		0x3D610020001C, 0x37001C, 0x3D840020001C, 0x3D6A0020001C, 0x37001C, 0x1C0E0020001C, 0x3D610020001C, 0x3D860020001C,
+		0x3D610020001C, 0x3D860020001C, 0x3D620020001C, 0x37001C, 0x3D820020001C, 0x3D7B0020001C, 0x3D610020001C, 0x3D860020001C,
+		0x3D7C0020001C, 0x1C0E0020001C, 0x3D6E0020001C, 0x3D840020001C, 0x3D610020001C, 0x3D860020001C, 0x3D870020001C, 0x3D6C0020001C,
+		0x3D6E0020001C, 0x3D620020001C, 0x37001C, 0x3D820020001C, 0x3D7B0020001C, 0x3D620020001C, 0x37001C, 0x3D820020001C,
+		0x3D7B0020001C, 0x3D6E0020001C, 0x3D8B0020001C, 0x3D620020001C, 0x3D840020001C, 0x3D680020001C, 0x37001C, 0x3D5B0020001C,
+		0x3D860020001C, 0x3D620020001C, 0x3D860020001C, 0x1C0E0020001C, 0x3D720020001C, 0x3D630020001C, 0x1C0E0020001C, 0x3D670020001C,
+		0x3D640020001C, 0x3D840020001C, 0x3D6F0020001C, 0x3D640020001C, 0x1C0E0020001C, 0x3D780020001C, 0x38001C, 0x3D650020001C,
+		0x3D5B0020001C, 0x3D620020001C, 0x3D840020001C, 0x3D650020001C, 0x3D8B0020001C, 0x3D6B0020001C, 0x1C0E0020001C, 0x3D7B0020001C,
+		0x3D660020001C, 0x3D830020001C, 0x3D8B0020001C, 0x3D620020001C, 0x37001C, 0x3D680020001C, 0x3D8B0020001C, 0x3D6B0020001C,
+		0x3D680020001C, 0x3D8B0020001C, 0x3D6E0020001C, 0x3D6A0020001C, 0x37001C, 0x1C0E0020001C, 0x3D670020001C, 0x3D6D0020001C,
+		0x37001C, 0x3D660020001C, 0x3D6E0020001C, 0x37001C, 0x3D840020001C, 0x3D6E0020001C, 0x3D8B0020001C, 0x3D6F0020001C,
+		0x3D730020001C, 0x3D730020001C, 0x3D6C0020001C, 0x3D6E0020001C, 0x3D740020001C, 0x3D5B0020001C, 0x3D6C0020001C, 0x3D740020001C,
+		0x38001C, 0x1C0E0020001C, 0x3D680020001C, 0x3D8B0020001C, 0x3D6E0020001C, 0x3D740020001C, 0x38001C, 0x1C0E0020001C,
+		0x3D6C0020001C, 0x3D740020001C, 0x37001C, 0x1C0E0020001C, 0x3D850020001C, 0x3D840020001C, 0x3D750020001C, 0x38001C,
+		0x3D5A0020001C, 0x3D670020001C, 0x3D6E0020001C, 0x3D840020001C, 0x3D750020001C, 0x38001C, 0x3D620020001C, 0x3D840020001C,
+		0x3D750020001C, 0x38001C, 0x3D640020001C, 0x3D750020001C, 0x37001C, 0x3D840020001C, 0x3D760020001C, 0x3D5A0020001C,
+		0x3D820020001C, 0x3D6C0020001C, 0x3D6E0020001C, 0x37001C, 0x3D760020001C, 0x3D5B0020001C, 0x1C0E0020001C, 0x3D6E0020001C,
+		0x3D760020001C, 0x37001C, 0x3D6C0020001C, 0x3D660020001C, 0x3D5E0020001C, 0x3D840020001C, 0x3D760020001C, 0x3D820020001C,
+		0x3D8B0020001C, 0x3D770020001C, 0x3D620020001C, 0x3D6A0020001C, 0x1C0E0020001C, 0x3D840020001C, 0x3D770020001C, 0x38001C,
+		0x3D690020001C, 0x3D770020001C, 0x38001C, 0x3D700020001C, 0x3D750020001C, 0x3D770020001C, 0x3D840020001C, 0x3D6C0020001C,
+		0x3D770020001C, 0x38001C, 0x3D8B0020001C, 0x3D670020001C, 0x3D770020001C, 0x38001C, 0x1C0E0020001C, 0x3D660020001C,
+		0x37001C, 0x3D770020001C, 0x37001C, 0x1C0E0020001C, 0x3D6A0020001C, 0x3D780020001C, 0x38001C, 0x3D5B0020001C,
+		0x3D8B0020001C, 0x3D6E0020001C, 0x3D780020001C, 0x37001C, 0x3D840020001C, 0x3D6E0020001C, 0x3D780020001C, 0x3D8B0020001C,
+		0x3D780020001C, 0x38001C, 0x3D8B0020001C, 0x3D6E0020001C, 0x37001C, 0x3D780020001C, 0x1C0E0020001C, 0x3D840020001C,
+		0x3D780020001C, 0x1C0E0020001C, 0x3D8B0020001C, 0x3D790020001C, 0x3D5B0020001C, 0x3D620020001C, 0x3D860020001C, 0x3D790020001C,
+		0x3D5B0020001C, 0x3D840020001C, 0x3D790020001C, 0x3D6C0020001C, 0x3D740020001C, 0x3D790020001C, 0x3D840020001C, 0x3D620020001C,
+		0x3D790020001C, 0x3D8B0020001C, 0x3D660020001C, 0x3D810020001C, 0x3D8B0020001C, 0x3D7A0020001C, 0x3D620020001C, 0x3D860020001C,
+		0x3D8B0020001C, 0x3D7A0020001C, 0x3D830020001C, 0x3D7A0020001C, 0x3D830020001C, 0x3D740020001C, 0x37001C, 0x1C0E0020001C,
+		0x3D840020001C, 0x3D7C0020001C, 0x3D600020001C, 0x37001C, 0x3D7C0020001C, 0x3D600020001C, 0x37001C, 0x3D6E0020001C,
+		0x3D8B0020001C, 0x3D7C0020001C, 0x1C0E0020001C, 0x3D6E0020001C, 0x3D840020001C, 0x3D7E0020001C, 0x1C0E0020001C, 0x3D6E0020001C,
+		0x37001C, 0x3D7E0020001C, 0x1C0E0020001C, 0x3D840020001C, 0x3D7F0020001C, 0x3D5A0020001C, 0x3D8B0020001C, 0x3D830020001C,
+		0x3D6C0020001C, 0x3D6E0020001C, 0x3D840020001C, 0x3D830020001C, 0x3D820020001C, 0x3D840020001C, 0x3D750020001C, 0x38001C,
+		0x1C0E0020001C, 0x3D840020001C, 0x1C0E0020001C, 0x3D760020001C, 0x37001C, 0x3D840020001C, 0x3D850020001C, 0x3D7B0020001C,
+		0x3D850020001C, 0x3D8B0020001C, 0x3D6E0020001C, 0x3D630020001C, 0x37001C, 0x3D8B0020001C, 0x3D870020001C, 0x3D6C0020001C,
+		0x3D6E0020001C, 0x1C3D00200004, 0xFB4000200004, 0xF0B900000000, 0x1C3E00200004, 0xFB4000200004, 0xF0B900000000, 0x1C3F00200004,
+		0xFB4000200004, 0xF0B900000000, 0x1C4000200004, 0xFB4000200004, 0xF0B900000000, 0x1C4100200004, 0xFB4000200004, 0xF0B900000000,
+		0x1C4200200004, 0xFB4000200004, 0xF0B900000000, 0x1C4300200004, 0xFB4000200004, 0xF0B900000000, 0x1C4400200004, 0xFB4000200004,
+		0xF0B900000000, 0x1C4500200004, 0xFB4000200004, 0xF0B900000000, 0x1C4600200004, 0xFB4000200004, 0xF0B900000000, 0x1C3E00200004,
+		0x1C3D00200004, 0xFB4000200004, 0xF0B900000000, 0x1C3E00200004, 0x1C3E00200004, 0xFB4000200004, 0xF0B900000000, 0x1C3E00200004,
+		0x1C3F00200004, 0xFB4000200004, 0xF0B900000000, 0x1C3E00200004, 0x1C4000200004, 0xFB4000200004, 0xF0B900000000, 0x1C3E00200004,
+		0x1C4100200004, 0xFB4000200004, 0xF0B900000000, 0x1C3E00200004, 0x1C4200200004, 0xFB4000200004, 0xF0B900000000, 0x1C3E00200004,
+		0x1C4300200004, 0xFB4000200004, 0xF0B900000000, 0x1C3E00200004, 0x1C4400200004, 0xFB4000200004, 0xF0B900000000, 0x1C3E00200004,
+		0x1C4500200004, 0xFB4000200004, 0xF0B900000000, 0x1C3E00200004, 0x1C4600200004, 0xFB4000200004, 0xF0B900000000, 0x1C3F00200004,
+		0x1C3D00200004, 0xFB4000200004, 0xF0B900000000, 0x1C3F00200004, 0x1C3E00200004, 0xFB4000200004, 0xF0B900000000, 0x1C3F00200004,
+		0x1C3F00200004, 0xFB4000200004, 0xF0B900000000, 0x1C3F00200004, 0x1C4000200004, 0xFB4000200004, 0xF0B900000000, 0x1C3F00200004,
+		0x1C4100200004, 0xFB4000200004, 0xF0B900000000, 0x1D180020001C, 0x1E0C0020001D, 0x1C470020001C, 0x1C8F0020001C, 0x1C470020001C,
+		0x1C470020001D, 0x1EB50020001D, 0x1C600020001C, 0x1C470020001C, 0x1E330020001C, 0x1DDD0020001C, 0x1EE30020001D, 0x1E0C0020001C,
+		0x1C7A0020001C, 0x1C8F0020001C, 0x1DAA0020001C, 0x1C8F0020001C, 0x1DAA0020001C, 0x1C3F0020001C, 0x1C8F0020001C, 0x1DAA0020001C,
+		0x1C400020001C, 0x1D320020001D, 0x1EB50020001D, 0xFB400020001C, 0xDE7300000000, 0xFB400020001C, 0xE21000000000, 0xFB400020001C,
+		0xE62D00000000, 0xFB400020001C, 0xD48C00000000, 0xFB400020001C, 0xD92700000000, 0xFB400020001C, 0xEB6300000000, 0xFB400020001C,
+		0xE60E00000000, 0xFB400020001C, 0xECBB00000000, 0xFB400020001C, 0xE82A00000000, 0xFB400020001C, 0xDF0F00000000, 0xFB400020001C,
+		0xCF1A00000000, 0xFB400020001C, 0xF93E00000000, 0x1E0C0020001C, 0x1C470020001D, 0x1DB90020001C, 0x1C470020001D, 0x1FCB0020001C,
+		0x1C470020001D, 0x1DAA0020001C, 0x1C470020001D, 0x1D650020001C, 0x1C470020001D, 0x1D650020001D, 0x1C600020001D, 0x1DAA0020001D,
+		0x1C600020001D, 0x1CF40020001D, 0x1C600020001D, 0x1C7A0020001C, 0x1C470020001C, 0x1D770020001C, 0x1D650020001C, 0x1C7A0020001C,
+		0x1C470020001C, 0x1D770020001C, 0x1E0C0020001C, 0x1CE50020001D, 0x1DB90020001C, 0x1CE50020001D, 0x1FCB0020001C, 0x1CE50020001D,
+		0x1FCB0020001C, 0x1CF40020001C, 0x1DAA0020001C, 0x1CF40020001C, 0x1D650020001C, 0x1CF40020001C, 0x1D180020001D, 0x1F210020001C,
+		0x1D650020001C, 0x1D180020001D, 0x1F210020001C, 0x1DAA0020001D, 0x1D180020001D, 0x1F210020001C, 0x1CF40020001D, 0x1D180020001D,
+		0x1F210020001C, 0x1E950020001D, 0x1D180020001D, 0x1F210020001C, 0x1FCB0020001C, 0x1D770020001C, 0x1DAA0020001C, 0x1D770020001C,
+		0x1C8F0020001C, 0x1D770020001C, 0x1D650020001C, 0x1D770020001C, 0x1CE50020001C, 0x1DAA0020001C, 0x1DB90020001C, 0x1DAA0020001C,
+		0x1FCB0020001C, 0x1DAA0020001C, 0x1DAA0020001C, 0x1DAA0020001C, 0x1C7A0020001C, 0x1DAA0020001C, 0x1D650020001C, 0x1DAA0020001C,
+		0x1DAA0020001C, 0x1DAA0020001C, 0x1C3F0020001C, 0x1C7A0020001C, 0x1DAA0020001C, 0x1C3F0020001C, 0x1DAA0020001C, 0x1C3F0020001C,
+		0x1D650020001C, 0x1DAA0020001C, 0x1C3F0020001C, 0x1DAA0020001C, 0x1DAA0020001C, 0x1C400020001C, 0x1C7A0020001C, 0x1DAA0020001C,
+		0x1C400020001C, 0x1DAA0020001C, 0x1C400020001C, 0x1D650020001C, 0x1DAA0020001C, 0x1C400020001C, 0x1DAA0020001C, 0x6250020001C,
+		0x1E710020001C, 0x1DAA0020001C, 0x6250020001C, 0x1E710020001C, 0x1C3F0020001C, 0x1E0C0020001D, 0x1C470020001C, 0x1D650020001C,
+		0x1E0C0020001D, 0x1C470020001C, 0x1DAA0020001D, 0x1E0C0020001D, 0x1C470020001C, 0x1CF40020001D, 0x1E0C0020001D, 0x1C470020001C,
+		0x1E330020001C, 0x1C470020001C, 0x1C8F0020001C, 0x1E330020001C, 0x1C470020001C, 0x1C8F0020001C, 0x6250020001C, 0x1E710020001C,
+		0x1E330020001C, 0x1C470020001C, 0x1C8F0020001C, 0x6250020001C, 0x1E710020001C, 0x1C3F0020001C, 0x1E0C0020001C, 0x1E710020001C,
+		0x1DB90020001C, 0x1E710020001C, 0x1FCB0020001C, 0x1E710020001C, 0x1DAA0020001C, 0x1E710020001C, 0x1E0C0020001C, 0x1EE30020001D,
+		0x1DB90020001C, 0x1EE30020001D, 0x1FCB0020001C, 0x1EE30020001D, 0x1DAA0020001C, 0x1EE30020001D, 0x1D650020001C, 0x1EE30020001D,
+		0x1DAA0020001D, 0x1EE30020001D, 0x1E0C0020001C, 0x1EF50020001D, 0x1DB90020001C, 0x1EF50020001D, 0x1FCB0020001C, 0x1EF50020001D,
+		0x1DAA0020001C, 0x1EF50020001D, 0x1D650020001C, 0x1EF50020001D, 0x1DAA0020001D, 0x1EF50020001D, 0x1D650020001C, 0x1FE10020001D,
+		0x1DAA0020001D, 0x1FE10020001D, 0x1C470020001C, 0x2770020001C, 0x1DAA0020001C, 0x2770020001C, 0x1C600020001D, 0x1E210020001C,
+		0x1C7A0020001C, 0x1C7A0020001C, 0x1C7A0020001C, 0x1C8F0020001C, 0x1C7A0020001D, 0x6250020001C, 0x1D650020001C, 0x1CF40020001C,
+		0x1C7A0020001D, 0x1DDD0020001C, 0x2770020001C, 0x1C8F0020001C, 0x1C600020001D, 0x1CF40020001D, 0x1F0B0020001C, 0x1D180020001C,
+		0x1C470020001C, 0x1D180020001D, 0x1E0C0020001D, 0x1D320020001C, 0x1DB90020001C, 0x1D650020001D, 0x1D650020001D, 0x1D650020001D,
+		0x1DAA0020001D, 0x1D650020001C, 0x1E950020001C, 0x1D770020001C, 0x1DAA0020001C, 0x1D770020001C, 0x1DB90020001C, 0x1D770020001C,
+		0x1DDD0020001C, 0x1CF40020001C, 0x1D770020001C, 0x1EFF0020001C, 0x1DAA0020001C, 0x1C600020001C, 0x1DAA0020001C, 0x1D320020001C,
+		0x1D770020001C, 0x1DAA0020001C, 0x1DDD0020001C, 0x1D770020001C, 0x1E0C0020001D, 0x1D180020001D, 0x1E0C0020001C, 0x2770020001C,
+		0x1DAA0020001C, 0x2770020001C, 0x1E0C0020001D, 0x1E0C0020001D, 0x1DAA0020001D, 0x1E0C0020001D, 0x1E330020001D, 0x1E710020001C,
+		0x1E330020001C, 0x1E710020001D, 0x1EE30020001C, 0x1EF50020001D, 0x1C600020001C, 0x1EE30020001D, 0x6250020001C, 0x1DAA0020001C,
+		0x1C470020001D, 0x6250020001C, 0x1DAA0020001C, 0x1C3E00200004, 0xFB4000200004, 0xE5E500000000, 0x1C3F00200004, 0xFB4000200004,
+		0xE5E500000000, 0x1C4000200004, 0xFB4000200004, 0xE5E500000000, 0x1C4100200004, 0xFB4000200004, 0xE5E500000000, 0x1C4200200004,
+		0xFB4000200004, 0xE5E500000000, 0x1C4300200004, 0xFB4000200004, 0xE5E500000000, 0x1C4400200004, 0xFB4000200004, 0xE5E500000000,
+		0x1C4500200004, 0xFB4000200004, 0xE5E500000000, 0x1C4600200004, 0xFB4000200004, 0xE5E500000000, 0x1C3E00200004, 0x1C3D00200004,
+		0xFB4000200004, 0xE5E500000000, 0x1C3E00200004, 0x1C3E00200004, 0xFB4000200004, 0xE5E500000000, 0x1C3E00200004, 0x1C3F00200004,
+		0xFB4000200004, 0xE5E500000000, 0x1C3E00200004, 0x1C4000200004, 0xFB4000200004, 0xE5E500000000, 0x1C3E00200004, 0x1C4100200004,
+		0xFB4000200004, 0xE5E500000000, 0x1C3E00200004, 0x1C4200200004, 0xFB4000200004, 0xE5E500000000, 0x1C3E00200004, 0x1C4300200004,
+		0xFB4000200004, 0xE5E500000000, 0x1C3E00200004, 0x1C4400200004, 0xFB4000200004, 0xE5E500000000, 0x1C3E00200004, 0x1C4500200004,
+		0xFB4000200004, 0xE5E500000000, 0x1C3E00200004, 0x1C4600200004, 0xFB4000200004, 0xE5E500000000, 0x1C3F00200004, 0x1C3D00200004,
+		0xFB4000200004, 0xE5E500000000, 0x1C3F00200004, 0x1C3E00200004, 0xFB4000200004, 0xE5E500000000, 0x1C3F00200004, 0x1C3F00200004,
+		0xFB4000200004, 0xE5E500000000, 0x1C3F00200004, 0x1C4000200004, 0xFB4000200004, 0xE5E500000000, 0x1C3F00200004, 0x1C4100200004,
+		0xFB4000200004, 0xE5E500000000, 0x1C3F00200004, 0x1C4200200004, 0xFB4000200004, 0xE5E500000000, 0x1C3F00200004, 0x1C4300200004,
+		0xFB4000200004, 0xE5E500000000, 0x1C3F00200004, 0x1C4400200004, 0xFB4000200004, 0xE5E500000000, 0x1C3F00200004, 0x1C4500200004,
+		0xFB4000200004, 0xE5E500000000, 0x1C3F00200004, 0x1C4600200004, 0xFB4000200004, 0xE5E500000000, 0x1C4000200004, 0x1C3D00200004,
+		0xFB4000200004, 0xE5E500000000, 0x1C4000200004, 0x1C3E00200004, 0xFB4000200004, 0xE5E500000000, 0x1CF40020001C, 0x1C470020001C,
+		0x1D770020001C, 0x376600200004, 0x386500200004, 0x377800200004, 0x386500200004, 0x378D00200004, 0x386500200004, 0x37A100200004,
+		0x386600200004, 0x37AC00200004, 0x386500200004, 0x37B200200004, 0x386600200004, 0x37B400200004, 0x386600200004, 0x37BA00200004,
+		0x386500200004, 0x37DF00200004, 0x386500200004, 0x380E00200004, 0x386500200004, 0x382500200004, 0x386500200004, 0x382B00200004,
+		0x386600200004, 0x383200200004, 0x386500200004, 0x208800200004, 0x2B0004, 0x1E950020000A, 0x1F2100200004, 0x1E9500200004,
+		0x1F2100200004, 0x1C470020000A, 0x1C470020000A, 0x1C4700200004, 0x1C4700200004, 0x1C470020000A, 0x1DDD0020000A, 0x1C4700200004,
+		0x1DDD00200004, 0x1C470020000A, 0x1EB50020000A, 0x1C4700200004, 0x1EB500200004, 0x1C470020000A, 0x1EE30020000A, 0x1C4700200004,
+		0x1EE300200004, 0x1C470020000A, 0x1100004, 0x1EE30020000A, 0x1C4700200004, 0x1100004, 0x1EE300200004, 0x1C470020000A,
+		0x1F0B0020000A, 0x1C4700200004, 0x1F0B00200004, 0x1DDD0020000A, 0x1DDD0020000A, 0x1DDD00200004, 0x1DDD00200004, 0x1EE30020000A,
+		0x1F0B0020000A, 0x1EE300200004, 0x1F0B00200004, 0x1C8F0020000A, 0x1110004, 0x1C8F00200004, 0x1110004, 0x1CE50020000A,
+		0x1110004, 0x1CE500200004, 0x1110004, 0x1CF40020000A, 0x1110004, 0x1E330020000A, 0x1110004, 0x1E3300200004,
+		0x1110004, 0x1E710020000A, 0x1110004, 0x1E7100200004, 0x1110004, 0x1E950020000A, 0x1110004, 0x1E9500200004,
+		0x1110004, 0x1C470020000A, 0x2B0004, 0x1C4700200004, 0x2B0004, 0x1DDD0020000A, 0x2B0004, 0x1DDD00200004,
+		0x2B0004, 0x1EB50020000A, 0x2B0004, 0x1EB500200004, 0x2B0004, 0x1CF40020000A, 0x350004, 0x1CF400200004,
+		0x350004, 0x1D650020000A, 0x350004, 0x1D6500200004, 0x350004, 0x1DB90020000A, 0x350004, 0x1DB900200004,
+		0x350004, 0x1E330020000A, 0x350004, 0x1E3300200004, 0x350004, 0x1E710020000A, 0x350004, 0x1E7100200004,
+		0x350004, 0x1D1800200014, 0x390014, 0x1DDD00200014, 0x1100014, 0x1CAA00200014, 0xFB4100200002, 0x8C4800000000,
+		0xFB4000200002, 0xE6F400000000, 0xFB4100200002, 0x8ECA00000000, 0xFB4100200002, 0x8CC800000000, 0xFB4000200002, 0xEED100000000,
+		0xFB4000200002, 0xCE3200000000, 0xFB4000200002, 0xD3E500000000, 0xFB4100200002, 0x9F9C00000000, 0xFB4100200002, 0x9F9C00000000,
+		0xFB4000200002, 0xD95100000000, 0xFB4100200002, 0x91D100000000, 0xFB4000200002, 0xD58700000000, 0xFB4000200002, 0xD94800000000,
+		0xFB4000200002, 0xE1F600000000, 0xFB4000200002, 0xF66900000000, 0xFB4000200002, 0xFF8500000000, 0xFB4100200002, 0x863F00000000,
+		0xFB4100200002, 0x87BA00000000, 0xFB4100200002, 0x88F800000000, 0xFB4100200002, 0x908F00000000, 0xFB4000200002, 0xEA0200000000,
+		0xFB4000200002, 0xED1B00000000, 0xFB4000200002, 0xF0D900000000, 0xFB4000200002, 0xF3DE00000000, 0xFB4100200002, 0x843D00000000,
+		0xFB4100200002, 0x916A00000000, 0xFB4100200002, 0x99F100000000, 0xFB4000200002, 0xCE8200000000, 0xFB4000200002, 0xD37500000000,
+		0xFB4000200002, 0xEB0400000000, 0xFB4000200002, 0xF21B00000000, 0xFB4100200002, 0x862D00000000, 0xFB4100200002, 0x9E1E00000000,
+		0xFB4000200002, 0xDD5000000000, 0xFB4000200002, 0xEFEB00000000, 0xFB4100200002, 0x85CD00000000, 0xFB4100200002, 0x896400000000,
+		0xFB4000200002, 0xE2C900000000, 0xFB4100200002, 0x81D800000000, 0xFB4100200002, 0x881F00000000, 0xFB4000200002, 0xDECA00000000,
+		0xFB4000200002, 0xE71700000000, 0xFB4000200002, 0xED6A00000000, 0xFB4000200002, 0xF2FC00000000, 0xFB4100200002, 0x90CE00000000,
+		0xFB4000200002, 0xCF8600000000, 0xFB4000200002, 0xD1B700000000, 0xFB4000200002, 0xD2DE00000000, 0xFB4000200002, 0xE4C400000000,
+		0xFB4000200002, 0xEAD300000000, 0xFB4000200002, 0xF21000000000, 0xFB4000200002, 0xF6E700000000, 0xFB4100200002, 0x800100000000,
+		0xFB4100200002, 0x860600000000, 0xFB4100200002, 0x865C00000000, 0xFB4100200002, 0x8DEF00000000, 0xFB4100200002, 0x973200000000,
+		0xFB4100200002, 0x9B6F00000000, 0xFB4100200002, 0x9DFA00000000, 0xFB4000200002, 0xF88C00000000, 0xFB4000200002, 0xF97F00000000,
+		0xFB4000200002, 0xFDA000000000, 0xFB4100200002, 0x83C900000000, 0xFB4100200002, 0x930400000000, 0xFB4100200002, 0x9E7F00000000,
+		0xFB4100200002, 0x8AD600000000, 0xFB4000200002, 0xD8DF00000000, 0xFB4000200002, 0xDF0400000000, 0xFB4000200002, 0xFC6000000000,
+		0xFB4100200002, 0x807E00000000, 0xFB4000200002, 0xF26200000000, 0xFB4000200002, 0xF8CA00000000, 0xFB4100200002, 0x8CC200000000,
+		0xFB4100200002, 0x96F700000000, 0xFB4000200002, 0xD8D800000000, 0xFB4000200002, 0xDC6200000000, 0xFB4000200002, 0xEA1300000000,
+		0xFB4000200002, 0xEDDA00000000, 0xFB4000200002, 0xEF0F00000000, 0xFB4000200002, 0xFD2F00000000, 0xFB4000200002, 0xFE3700000000,
+		0xFB4100200002, 0x964B00000000, 0xFB4000200002, 0xD2D200000000, 0xFB4100200002, 0x808B00000000, 0xFB4000200002, 0xD1DC00000000,
+		0xFB4000200002, 0xD1CC00000000, 0xFB4000200002, 0xFA1C00000000, 0xFB4000200002, 0xFDBE00000000, 0xFB4100200002, 0x83F100000000,
+		0xFB4100200002, 0x967500000000, 0xFB4100200002, 0x8B8000000000, 0xFB4000200002, 0xE2CF00000000, 0xFB4000200002, 0xEA0200000000,
+		0xFB4100200002, 0x8AFE00000000, 0xFB4000200002, 0xCE3900000000, 0xFB4000200002, 0xDBE700000000, 0xFB4000200002, 0xE01200000000,
+		0xFB4000200002, 0xF38700000000, 0xFB4000200002, 0xF57000000000, 0xFB4000200002, 0xD31700000000, 0xFB4000200002, 0xF8FB00000000,
+		0xFB4000200002, 0xCFBF00000000, 0xFB4000200002, 0xDFA900000000, 0xFB4000200002, 0xCE0D00000000, 0xFB4000200002, 0xECCC00000000,
+		0xFB4000200002, 0xE57800000000, 0xFB4000200002, 0xFD2200000000, 0xFB4000200002, 0xD3C300000000, 0xFB4000200002, 0xD85E00000000,
+		0xFB4000200002, 0xF70100000000, 0xFB4100200002, 0x844900000000, 0xFB4100200002, 0x8AAA00000000, 0xFB4000200002, 0xEBBA00000000,
+		0xFB4100200002, 0x8FB000000000, 0xFB4000200002, 0xEC8800000000, 0xFB4000200002, 0xE2FE00000000, 0xFB4100200002, 0x82E500000000,
+		0xFB4000200002, 0xE3A000000000, 0xFB4000200002, 0xF56500000000, 0xFB4000200002, 0xCEAE00000000, 0xFB4000200002, 0xD16900000000,
+		0xFB4000200002, 0xD1C900000000, 0xFB4000200002, 0xE88100000000, 0xFB4000200002, 0xFCE700000000, 0xFB4100200002, 0x826F00000000,
+		0xFB4100200002, 0x8AD200000000, 0xFB4100200002, 0x91CF00000000, 0xFB4000200002, 0xD2F500000000, 0xFB4000200002, 0xD44200000000,
+		0xFB4000200002, 0xD97300000000, 0xFB4000200002, 0xDEEC00000000, 0xFB4000200002, 0xE5C500000000, 0xFB4000200002, 0xEFFE00000000,
+		0xFB4000200002, 0xF92A00000000, 0xFB4100200002, 0x95AD00000000, 0xFB4100200002, 0x9A6A00000000, 0xFB4100200002, 0x9E9700000000,
+		0xFB4100200002, 0x9ECE00000000, 0xFB4000200002, 0xD29B00000000, 0xFB4000200002, 0xE6C600000000, 0xFB4000200002, 0xEB7700000000,
+		0xFB4100200002, 0x8F6200000000, 0xFB4000200002, 0xDE7400000000, 0xFB4000200002, 0xE19000000000, 0xFB4000200002, 0xE20000000000,
+		0xFB4000200002, 0xE49A00000000, 0xFB4000200002, 0xEF2300000000, 0xFB4000200002, 0xF14900000000, 0xFB4000200002, 0xF48900000000,
+		0xFB4000200002, 0xF9CA00000000, 0xFB4000200002, 0xFDF400000000, 0xFB4100200002, 0x806F00000000, 0xFB4100200002, 0x8F2600000000,
+		0xFB4100200002, 0x84EE00000000, 0xFB4100200002, 0x902300000000, 0xFB4100200002, 0x934A00000000, 0xFB4000200002, 0xD21700000000,
+		0xFB4000200002, 0xD2A300000000, 0xFB4000200002, 0xD4BD00000000, 0xFB4000200002, 0xF0C800000000, 0xFB4100200002, 0x88C200000000,
+		0xFB4100200002, 0x8AAA00000000, 0xFB4000200002, 0xDEC900000000, 0xFB4000200002, 0xDFF500000000, 0xFB4000200002, 0xE37B00000000,
+		0xFB4000200002, 0xEBAE00000000, 0xFB4000200002, 0xFC3E00000000, 0xFB4000200002, 0xF37500000000, 0xFB4000200002, 0xCEE400000000,
+		0xFB4000200002, 0xD6F900000000, 0xFB4000200002, 0xDBE700000000, 0xFB4000200002, 0xDDBA00000000, 0xFB4000200002, 0xE01C00000000,
+		0xFB4000200002, 0xF3B200000000, 0xFB4000200002, 0xF46900000000, 0xFB4000200002, 0xFF9A00000000, 0xFB4100200002, 0x804600000000,
+		0xFB4100200002, 0x923400000000, 0xFB4100200002, 0x96F600000000, 0xFB4100200002, 0x974800000000, 0xFB4100200002, 0x981800000000,
+		0xFB4000200002, 0xCF8B00000000, 0xFB4000200002, 0xF9AE00000000, 0xFB4100200002, 0x91B400000000, 0xFB4100200002, 0x96B800000000,
+		0xFB4000200002, 0xE0E100000000, 0xFB4000200002, 0xCE8600000000, 0xFB4000200002, 0xD0DA00000000, 0xFB4000200002, 0xDBEE00000000,
+		0xFB4000200002, 0xDC3F00000000, 0xFB4000200002, 0xE59900000000, 0xFB4000200002, 0xEA0200000000, 0xFB4000200002, 0xF1CE00000000,
+		0xFB4000200002, 0xF64200000000, 0xFB4100200002, 0x84FC00000000, 0xFB4100200002, 0x907C00000000, 0xFB4100200002, 0x9F8D00000000,
+		0xFB4000200002, 0xE68800000000, 0xFB4100200002, 0x962E00000000, 0xFB4000200002, 0xD28900000000, 0xFB4000200002, 0xE77B00000000,
+		0xFB4000200002, 0xE7F300000000, 0xFB4000200002, 0xED4100000000, 0xFB4000200002, 0xEE9C00000000, 0xFB4000200002, 0xF40900000000,
+		0xFB4000200002, 0xF55900000000, 0xFB4000200002, 0xF86B00000000, 0xFB4000200002, 0xFD1000000000, 0xFB4100200002, 0x985E00000000,
+		0xFB4000200002, 0xD16D00000000, 0xFB4000200002, 0xE22E00000000, 0xFB4100200002, 0x967800000000, 0xFB4000200002, 0xD02B00000000,
+		0xFB4000200002, 0xDD1900000000, 0xFB4000200002, 0xEDEA00000000, 0xFB4100200002, 0x8F2A00000000, 0xFB4000200002, 0xDF8B00000000,
+		0xFB4000200002, 0xE14400000000, 0xFB4000200002, 0xE81700000000, 0xFB4000200002, 0xF38700000000, 0xFB4100200002, 0x968600000000,
+		0xFB4000200002, 0xD22900000000, 0xFB4000200002, 0xD40F00000000, 0xFB4000200002, 0xDC6500000000, 0xFB4000200002, 0xE61300000000,
+		0xFB4000200002, 0xE74E00000000, 0xFB4000200002, 0xE8A800000000, 0xFB4000200002, 0xECE500000000, 0xFB4000200002, 0xF40600000000,
+		0xFB4000200002, 0xF5E200000000, 0xFB4000200002, 0xFF7900000000, 0xFB4100200002, 0x88CF00000000, 0xFB4100200002, 0x88E100000000,
+		0xFB4100200002, 0x91CC00000000, 0xFB4100200002, 0x96E200000000, 0xFB4000200002, 0xD33F00000000, 0xFB4000200002, 0xEEBA00000000,
+		0xFB4000200002, 0xD41D00000000, 0xFB4000200002, 0xF1D000000000, 0xFB4000200002, 0xF49800000000, 0xFB4100200002, 0x85FA00000000,
+		0xFB4100200002, 0x96A300000000, 0xFB4100200002, 0x9C5700000000, 0xFB4100200002, 0x9E9F00000000, 0xFB4000200002, 0xE79700000000,
+		0xFB4000200002, 0xEDCB00000000, 0xFB4100200002, 0x81E800000000, 0xFB4000200002, 0xFACB00000000, 0xFB4000200002, 0xFB2000000000,
+		0xFB4000200002, 0xFC9200000000, 0xFB4000200002, 0xF2C000000000, 0xFB4000200002, 0xF09900000000, 0xFB4100200002, 0x8B5800000000,
+		0xFB4000200002, 0xCEC000000000, 0xFB4100200002, 0x833600000000, 0xFB4000200002, 0xD23A00000000, 0xFB4000200002, 0xD20700000000,
+		0xFB4000200002, 0xDEA600000000, 0xFB4000200002, 0xE2D300000000, 0xFB4000200002, 0xFCD600000000, 0xFB4000200002, 0xDB8500000000,
+		0xFB4000200002, 0xED1E00000000, 0xFB4000200002, 0xE6B400000000, 0xFB4100200002, 0x8F3B00000000, 0xFB4100200002, 0x884C00000000,
+		0xFB4100200002, 0x964D00000000, 0xFB4100200002, 0x898B00000000, 0xFB4000200002, 0xDED300000000, 0xFB4000200002, 0xD14000000000,
+		0xFB4000200002, 0xD5C000000000, 0xFB4100200002, 0xFA0E00000000, 0xFB4100200002, 0xFA0F00000000, 0xFB4000200002, 0xD85A00000000,
+		0xFB4100200002, 0xFA1100000000, 0xFB4000200002, 0xE67400000000, 0xFB4100200002, 0xFA1300000000, 0xFB4100200002, 0xFA1400000000,
+		0xFB4000200002, 0xD1DE00000000, 0xFB4000200002, 0xF32A00000000, 0xFB4000200002, 0xF6CA00000000, 0xFB4000200002, 0xF93C00000000,
+		0xFB4000200002, 0xF95E00000000, 0xFB4000200002, 0xF96500000000, 0xFB4000200002, 0xF98F00000000, 0xFB4100200002, 0x975600000000,
+		0xFB4000200002, 0xFCBE00000000, 0xFB4000200002, 0xFFBD00000000, 0xFB4100200002, 0xFA1F00000000, 0xFB4100200002, 0x861200000000,
+		0xFB4100200002, 0xFA2100000000, 0xFB4100200002, 0x8AF800000000, 0xFB4100200002, 0xFA2300000000, 0xFB4100200002, 0xFA2400000000,
+		0xFB4100200002, 0x903800000000, 0xFB4100200002, 0x90FD00000000, 0xFB4100200002, 0xFA2700000000, 0xFB4100200002, 0xFA2800000000,
+		0xFB4100200002, 0xFA2900000000, 0xFB4100200002, 0x98EF00000000, 0xFB4100200002, 0x98FC00000000, 0xFB4100200002, 0x992800000000,
+		0xFB4100200002, 0x9DB400000000, 0xFB4100200002, 0x90DE00000000, 0xFB4100200002, 0x96B700000000, 0xFB4000200002, 0xCFAE00000000,
+		0xFB4000200002, 0xD0E700000000, 0xFB4000200002, 0xD14D00000000, 0xFB4000200002, 0xD2C900000000, 0xFB4000200002, 0xD2E400000000,
+		0xFB4000200002, 0xD35100000000, 0xFB4000200002, 0xD59D00000000, 0xFB4000200002, 0xD60600000000, 0xFB4000200002, 0xD66800000000,
+		0xFB4000200002, 0xD84000000000, 0xFB4000200002, 0xD8A800000000, 0xFB4000200002, 0xDC6400000000, 0xFB4000200002, 0xDC6E00000000,
+		0xFB4000200002, 0xE09400000000, 0xFB4000200002, 0xE16800000000, 0xFB4000200002, 0xE18E00000000, 0xFB4000200002, 0xE1F200000000,
+		0xFB4000200002, 0xE54F00000000, 0xFB4000200002, 0xE5E200000000, 0xFB4000200002, 0xE69100000000, 0xFB4000200002, 0xE88500000000,
+		0xFB4000200002, 0xED7700000000, 0xFB4000200002, 0xEE1A00000000, 0xFB4000200002, 0xEF2200000000, 0xFB4000200002, 0xF16E00000000,
+		0xFB4000200002, 0xF22B00000000, 0xFB4000200002, 0xF42200000000, 0xFB4000200002, 0xF89100000000, 0xFB4000200002, 0xF93E00000000,
+		0xFB4000200002, 0xF94900000000, 0xFB4000200002, 0xF94800000000, 0xFB4000200002, 0xF95000000000, 0xFB4000200002, 0xF95600000000,
+		0xFB4000200002, 0xF95D00000000, 0xFB4000200002, 0xF98D00000000, 0xFB4000200002, 0xF98E00000000, 0xFB4000200002, 0xFA4000000000,
+		0xFB4000200002, 0xFA8100000000, 0xFB4000200002, 0xFBC000000000, 0xFB4000200002, 0xFDF400000000, 0xFB4000200002, 0xFE0900000000,
+		0xFB4000200002, 0xFE4100000000, 0xFB4000200002, 0xFF7200000000, 0xFB4100200002, 0x800500000000, 0xFB4100200002, 0x81ED00000000,
+		0xFB4100200002, 0x827900000000, 0xFB4100200002, 0x827900000000, 0xFB4100200002, 0x845700000000, 0xFB4100200002, 0x891000000000,
+		0xFB4100200002, 0x899600000000, 0xFB4100200002, 0x8B0100000000, 0xFB4100200002, 0x8B3900000000, 0xFB4100200002, 0x8CD300000000,
+		0xFB4100200002, 0x8D0800000000, 0xFB4100200002, 0x8FB600000000, 0xFB4100200002, 0x903800000000, 0xFB4100200002, 0x96E300000000,
+		0xFB4100200002, 0x97FF00000000, 0xFB4100200002, 0x983B00000000, 0xFB4000200002, 0xE07500000000, 0xFB8400200002, 0xC2EE00000000,
+		0xFB4100200002, 0x821800000000, 0xFB4000200002, 0xCE2600000000, 0xFB4000200002, 0xD1B500000000, 0xFB4000200002, 0xD16800000000,
+		0xFB4000200002, 0xCF8000000000, 0xFB4000200002, 0xD14500000000, 0xFB4000200002, 0xD18000000000, 0xFB4000200002, 0xD2C700000000,
+		0xFB4000200002, 0xD2FA00000000, 0xFB4000200002, 0xD59D00000000, 0xFB4000200002, 0xD55500000000, 0xFB4000200002, 0xD59900000000,
+		0xFB4000200002, 0xD5E200000000, 0xFB4000200002, 0xD85A00000000, 0xFB4000200002, 0xD8B300000000, 0xFB4000200002, 0xD94400000000,
+		0xFB4000200002, 0xD95400000000, 0xFB4000200002, 0xDA6200000000, 0xFB4000200002, 0xDB2800000000, 0xFB4000200002, 0xDED200000000,
+		0xFB4000200002, 0xDED900000000, 0xFB4000200002, 0xDF6900000000, 0xFB4000200002, 0xDFAD00000000, 0xFB4000200002, 0xE0D800000000,
+		0xFB4000200002, 0xE14E00000000, 0xFB4000200002, 0xE10800000000, 0xFB4000200002, 0xE18E00000000, 0xFB4000200002, 0xE16000000000,
+		0xFB4000200002, 0xE1F200000000, 0xFB4000200002, 0xE23400000000, 0xFB4000200002, 0xE3C400000000, 0xFB4000200002, 0xE41C00000000,
+		0xFB4000200002, 0xE45200000000, 0xFB4000200002, 0xE55600000000, 0xFB4000200002, 0xE67400000000, 0xFB4000200002, 0xE71700000000,
+		0xFB4000200002, 0xE71B00000000, 0xFB4000200002, 0xE75600000000, 0xFB4000200002, 0xEB7900000000, 0xFB4000200002, 0xEBBA00000000,
+		0xFB4000200002, 0xED4100000000, 0xFB4000200002, 0xEEDB00000000, 0xFB4000200002, 0xEECB00000000, 0xFB4000200002, 0xEF2200000000,
+		0xFB4000200002, 0xF01E00000000, 0xFB4000200002, 0xF16E00000000, 0xFB4000200002, 0xF7A700000000, 0xFB4000200002, 0xF23500000000,
+		0xFB4000200002, 0xF2AF00000000, 0xFB4000200002, 0xF32A00000000, 0xFB4000200002, 0xF47100000000, 0xFB4000200002, 0xF50600000000,
+		0xFB4000200002, 0xF53B00000000, 0xFB4000200002, 0xF61D00000000, 0xFB4000200002, 0xF61F00000000, 0xFB4000200002, 0xF6CA00000000,
+		0xFB4000200002, 0xF6DB00000000, 0xFB4000200002, 0xF6F400000000, 0xFB4000200002, 0xF74A00000000, 0xFB4000200002, 0xF74000000000,
+		0xFB4000200002, 0xF8CC00000000, 0xFB4000200002, 0xFAB100000000, 0xFB4000200002, 0xFBC000000000, 0xFB4000200002, 0xFC7B00000000,
+		0xFB4000200002, 0xFD5B00000000, 0xFB4000200002, 0xFDF400000000, 0xFB4000200002, 0xFF3E00000000, 0xFB4100200002, 0x800500000000,
+		0xFB4100200002, 0x835200000000, 0xFB4100200002, 0x83EF00000000, 0xFB4100200002, 0x877900000000, 0xFB4100200002, 0x894100000000,
+		0xFB4100200002, 0x898600000000, 0xFB4100200002, 0x899600000000, 0xFB4100200002, 0x8ABF00000000, 0xFB4100200002, 0x8AF800000000,
+		0xFB4100200002, 0x8ACB00000000, 0xFB4100200002, 0x8B0100000000, 0xFB4100200002, 0x8AFE00000000, 0xFB4100200002, 0x8AED00000000,
+		0xFB4100200002, 0x8B3900000000, 0xFB4100200002, 0x8B8A00000000, 0xFB4100200002, 0x8D0800000000, 0xFB4100200002, 0x8F3800000000,
+		0xFB4100200002, 0x907200000000, 0xFB4100200002, 0x919900000000, 0xFB4100200002, 0x927600000000, 0xFB4100200002, 0x967C00000000,
+		0xFB4100200002, 0x96E300000000, 0xFB4100200002, 0x975600000000, 0xFB4100200002, 0x97DB00000000, 0xFB4100200002, 0x97FF00000000,
+		0xFB4100200002, 0x980B00000000, 0xFB4100200002, 0x983B00000000, 0xFB4100200002, 0x9B1200000000, 0xFB4100200002, 0x9F9C00000000,
+		0xFB8400200002, 0xA84A00000000, 0xFB8400200002, 0xA84400000000, 0xFB8400200002, 0xB3D500000000, 0xFB8000200002, 0xBB9D00000000,
+		0xFB8000200002, 0xC01800000000, 0xFB8000200002, 0xC03900000000, 0xFB8400200002, 0xD24900000000, 0xFB8400200002, 0xDCD000000000,
+		0xFB8400200002, 0xFED300000000, 0xFB4100200002, 0x9F4300000000, 0xFB4100200002, 0x9F8E00000000, 0x1CE500200004, 0x1CE500200004,
+		0x1CE500200004, 0x1D3200200004, 0x1CE500200004, 0x1D7700200004, 0x1CE500200004, 0x1CE500200004, 0x1D3200200004, 0x1CE500200004,
+		0x1CE500200004, 0x1D7700200004, 0x1E7100200004, 0x1110004, 0x1E9500200004, 0x1E7100200004, 0x1E9500200004, 0x22A300200004,
+		0x22A500200004, 0x22A300200004, 0x229400200004, 0x22A300200004, 0x229A00200004, 0x22AD00200004, 0x22A500200004, 0x22A300200004,
+		0x229C00200004, 0x22C000200002, 0x560002, 0x22C000200004, 0x22C000200004, 0x590002, 0x22CB00200002, 0x5E0002,
+		0x22CB00200002, 0x5D0002, 0x22CB00200002, 0x5F0002, 0x5E0002, 0x22CB00200002, 0x5F0002, 0x5D0002,
+		0x22B700200002, 0x590002, 0x22B700200002, 0x5A0002, 0x22B700200002, 0x5F0002, 0x22B800200002, 0x5F0002,
+		0x22B900200002, 0x5F0002, 0x22BA00200002, 0x5F0002, 0x22BB00200002, 0x5F0002, 0x22BC00200002, 0x5F0002,
+		0x22BD00200002, 0x5F0002, 0x22BF00200002, 0x5F0002, 0x22C000200002, 0x5F0002, 0x22C100200019, 0x5F0002,
+		0x22C100200002, 0x5F0002, 0x22C200200002, 0x5F0002, 0x22C300200002, 0x5F0002, 0x22C400200002, 0x5F0002,
+		0x22C500200002, 0x5F0002, 0x22C700200019, 0x5F0002, 0x22C700200002, 0x5F0002, 0x22C800200002, 0x5F0002,
+		0x22C900200002, 0x5F0002, 0x22CA00200002, 0x5F0002, 0x22CB00200002, 0x5F0002, 0x22CC00200002, 0x5F0002,
+		0x22BC00200002, 0x5B0002, 0x22B800200002, 0x600002, 0x22C100200002, 0x600002, 0x22C700200002, 0x600002,
+		0x22B700200004, 0x22C200200004, 0x23B60020001A, 0x83001A, 0x23B600200019, 0x830019, 0x23D40020001A, 0x83001A,
+		0x23D400200019, 0x830019, 0x23BB0020001A, 0x22FD0020001A, 0x23070020001A, 0x230B0020001A, 0x230700200019, 0x230B00200019,
+		0x23070020001A, 0x23B60020001A, 0x230700200019, 0x23B600200019, 0x23070020001A, 0x23B70020001A, 0x230700200019, 0x23B700200019,
+		0x23070020001A, 0x23BB0020001A, 0x230700200019, 0x23BB00200019, 0x23070020001A, 0x23BA0020001A, 0x230700200019, 0x23BA00200019,
+		0x23070020001A, 0x23BC0020001A, 0x230700200019, 0x23BC00200019, 0x23070020001A, 0x23CA0020001A, 0x230700200019, 0x23CA00200019,
+		0x230700200017, 0x23CA00200017, 0x23070020001A, 0x23C50020001A, 0x230700200019, 0x23C500200019, 0x230700200017, 0x23C500200017,
+		0x23070020001A, 0x23250020001A, 0x23070020001A, 0x232C0020001A, 0x23070020001A, 0x23A30020001A, 0x23070020001A, 0x23C50020001A,
+		0x23070020001A, 0x23C60020001A, 0x230D0020001A, 0x23250020001A, 0x230D0020001A, 0x232C0020001A, 0x230D0020001A, 0x232D0020001A,
+		0x230D0020001A, 0x23A30020001A, 0x230D0020001A, 0x23C50020001A, 0x230D0020001A, 0x23C60020001A, 0x231D0020001A, 0x23250020001A,
+		0x231D0020001A, 0x232C0020001A, 0x231D0020001A, 0x232D0020001A, 0x231D0020001A, 0x23A30020001A, 0x231D0020001A, 0x23C50020001A,
+		0x231D0020001A, 0x23C60020001A, 0x231E0020001A, 0x23250020001A, 0x231E0020001A, 0x23A30020001A, 0x231E0020001A, 0x23C50020001A,
+		0x231E0020001A, 0x23C60020001A, 0x23250020001A, 0x232C0020001A, 0x23250020001A, 0x23A30020001A, 0x232C0020001A, 0x23250020001A,
+		0x232C0020001A, 0x23A30020001A, 0x232D0020001A, 0x23250020001A, 0x232D0020001A, 0x232C0020001A, 0x232D0020001A, 0x23A30020001A,
+		0x23590020001A, 0x23250020001A, 0x23590020001A, 0x232C0020001A, 0x23590020001A, 0x232D0020001A, 0x23590020001A, 0x23A30020001A,
+		0x23640020001A, 0x232C0020001A, 0x23640020001A, 0x23A30020001A, 0x23650020001A, 0x23250020001A, 0x23650020001A, 0x232C0020001A,
+		0x23650020001A, 0x232D0020001A, 0x23650020001A, 0x23A30020001A, 0x236A0020001A, 0x232C0020001A, 0x236A0020001A, 0x23A30020001A,
+		0x236B0020001A, 0x23A30020001A, 0x236E0020001A, 0x23250020001A, 0x236E0020001A, 0x23A30020001A, 0x236F0020001A, 0x23250020001A,
+		0x236F0020001A, 0x23A30020001A, 0x23760020001A, 0x23250020001A, 0x23760020001A, 0x232C0020001A, 0x23760020001A, 0x232D0020001A,
+		0x23760020001A, 0x23A30020001A, 0x23760020001A, 0x23C50020001A, 0x23760020001A, 0x23C60020001A, 0x23820020001A, 0x232C0020001A,
+		0x23820020001A, 0x23A30020001A, 0x23820020001A, 0x23C50020001A, 0x23820020001A, 0x23C60020001A, 0x23870020001A, 0x230B0020001A,
+		0x23870020001A, 0x23250020001A, 0x23870020001A, 0x232C0020001A, 0x23870020001A, 0x232D0020001A, 0x23870020001A, 0x239C0020001A,
+		0x23870020001A, 0x23A30020001A, 0x23870020001A, 0x23C50020001A, 0x23870020001A, 0x23C60020001A, 0x239C0020001A, 0x23250020001A,
+		0x239C0020001A, 0x232C0020001A, 0x239C0020001A, 0x232D0020001A, 0x239C0020001A, 0x23A30020001A, 0x239C0020001A, 0x23C50020001A,
+		0x239C0020001A, 0x23C60020001A, 0x23A30020001A, 0x23250020001A, 0x23A30020001A, 0x232C0020001A, 0x23A30020001A, 0x232D0020001A,
+		0x23A30020001A, 0x23A30020001A, 0x23A30020001A, 0x23C50020001A, 0x23A30020001A, 0x23C60020001A, 0x23A70020001A, 0x23250020001A,
+		0x23A70020001A, 0x232C0020001A, 0x23A70020001A, 0x232D0020001A, 0x23A70020001A, 0x23A30020001A, 0x23A70020001A, 0x23C50020001A,
+		0x23A70020001A, 0x23C60020001A, 0x23B10020001A, 0x23250020001A, 0x23B10020001A, 0x23A30020001A, 0x23B10020001A, 0x23C50020001A,
+		0x23B10020001A, 0x23C60020001A, 0x23C60020001A, 0x23250020001A, 0x23C60020001A, 0x232C0020001A, 0x23C60020001A, 0x232D0020001A,
+		0x23C60020001A, 0x23A30020001A, 0x23C60020001A, 0x23C50020001A, 0x23C60020001A, 0x23C60020001A, 0x23380020001A, 0x98001A,
+		0x23460020001A, 0x98001A, 0x23C50020001A, 0x98001A, 0x70001A, 0x80001A, 0x73001A, 0x80001A,
+		0x76001A, 0x80001A, 0x7A001A, 0x80001A, 0x7D001A, 0x80001A, 0x80001A, 0x98001A,
+		0x230700200019, 0x234600200019, 0x230700200019, 0x234700200019, 0x230700200019, 0x23A300200019, 0x230700200019, 0x23A700200019,
+		0x230700200019, 0x23C500200019, 0x230700200019, 0x23C600200019, 0x230D00200019, 0x234600200019, 0x230D00200019, 0x234700200019,
+		0x230D00200019, 0x23A300200019, 0x230D00200019, 0x23A700200019, 0x230D00200019, 0x23C500200019, 0x230D00200019, 0x23C600200019,
+		0x231D00200019, 0x234600200019, 0x231D00200019, 0x234700200019, 0x231D00200019, 0x23A300200019, 0x231D00200019, 0x23A700200019,
+		0x231D00200019, 0x23C500200019, 0x231D00200019, 0x23C600200019, 0x231E00200019, 0x234600200019, 0x231E00200019, 0x234700200019,
+		0x231E00200019, 0x23A300200019, 0x231E00200019, 0x23A700200019, 0x231E00200019, 0x23C500200019, 0x231E00200019, 0x23C600200019,
+		0x237600200019, 0x23C500200019, 0x237600200019, 0x23C600200019, 0x238200200019, 0x23C500200019, 0x238200200019, 0x23C600200019,
+		0x238700200019, 0x230B00200019, 0x238700200019, 0x239C00200019, 0x238700200019, 0x23A300200019, 0x238700200019, 0x23C500200019,
+		0x238700200019, 0x23C600200019, 0x239C00200019, 0x23A300200019, 0x239C00200019, 0x23C500200019, 0x239C00200019, 0x23C600200019,
+		0x23A300200019, 0x230B00200019, 0x23A300200019, 0x23A300200019, 0x23A700200019, 0x234600200019, 0x23A700200019, 0x234700200019,
+		0x23A700200019, 0x23A300200019, 0x23A700200019, 0x23A700200019, 0x23A700200019, 0x23C500200019, 0x23A700200019, 0x23C600200019,
+		0x23C500200019, 0x980019, 0x23C600200019, 0x234600200019, 0x23C600200019, 0x234700200019, 0x23C600200019, 0x23A300200019,
+		0x23C600200019, 0x23A700200019, 0x23C600200019, 0x23C500200019, 0x23C600200019, 0x23C600200019, 0x230700200017, 0x232500200017,
+		0x230700200017, 0x232C00200017, 0x230700200017, 0x232D00200017, 0x230700200017, 0x23A300200017, 0x230700200017, 0x23B100200017,
+		0x230D00200017, 0x232500200017, 0x230D00200017, 0x232C00200017, 0x230D00200017, 0x232D00200017, 0x230D00200017, 0x23A300200017,
+		0x230D00200017, 0x23B100200017, 0x231D00200017, 0x232500200017, 0x231D00200017, 0x232C00200017, 0x231D00200017, 0x232D00200017,
+		0x231D00200017, 0x23A300200017, 0x231D00200017, 0x23B100200017, 0x231E00200017, 0x23A300200017, 0x232500200017, 0x232C00200017,
+		0x232500200017, 0x23A300200017, 0x232C00200017, 0x232500200017, 0x232C00200017, 0x23A300200017, 0x232D00200017, 0x232500200017,
+		0x232D00200017, 0x23A300200017, 0x235900200017, 0x232500200017, 0x235900200017, 0x232C00200017, 0x235900200017, 0x232D00200017,
+		0x235900200017, 0x23A300200017, 0x236400200017, 0x232C00200017, 0x236400200017, 0x232D00200017, 0x236400200017, 0x23A300200017,
+		0x236500200017, 0x232500200017, 0x236500200017, 0x232C00200017, 0x236500200017, 0x232D00200017, 0x236500200017, 0x23A300200017,
+		0x236A00200017, 0x232C00200017, 0x236B00200017, 0x23A300200017, 0x236E00200017, 0x232500200017, 0x236E00200017, 0x23A300200017,
+		0x236F00200017, 0x232500200017, 0x236F00200017, 0x23A300200017, 0x237600200017, 0x232500200017, 0x237600200017, 0x232C00200017,
+		0x237600200017, 0x232D00200017, 0x237600200017, 0x23A300200017, 0x238200200017, 0x232C00200017, 0x238200200017, 0x23A300200017,
+		0x238700200017, 0x232500200017, 0x238700200017, 0x232C00200017, 0x238700200017, 0x232D00200017, 0x238700200017, 0x239C00200017,
+		0x238700200017, 0x23A300200017, 0x239C00200017, 0x232500200017, 0x239C00200017, 0x232C00200017, 0x239C00200017, 0x232D00200017,
+		0x239C00200017, 0x23A300200017, 0x239C00200017, 0x23B100200017, 0x23A300200017, 0x232500200017, 0x23A300200017, 0x232C00200017,
+		0x23A300200017, 0x232D00200017, 0x23A300200017, 0x23A300200017, 0x23A700200017, 0x232500200017, 0x23A700200017, 0x232C00200017,
+		0x23A700200017, 0x232D00200017, 0x23A700200017, 0x23A300200017, 0x23A700200017, 0x23B100200017, 0x23B100200017, 0x232500200017,
+		0x23B100200017, 0x23A300200017, 0x23B100200017, 0x980017, 0x23C600200017, 0x232500200017, 0x23C600200017, 0x232C00200017,
+		0x23C600200017, 0x232D00200017, 0x23C600200017, 0x23A300200017, 0x23C600200017, 0x23B100200017, 0x230700200018, 0x23A300200018,
+		0x230700200018, 0x23B100200018, 0x230D00200018, 0x23A300200018, 0x230D00200018, 0x23B100200018, 0x231D00200018, 0x23A300200018,
+		0x231D00200018, 0x23B100200018, 0x231E00200018, 0x23A300200018, 0x231E00200018, 0x23B100200018, 0x235900200018, 0x23A300200018,
+		0x235900200018, 0x23B100200018, 0x235A00200018, 0x23A300200018, 0x235A00200018, 0x23B100200018, 0x238700200018, 0x239C00200018,
+		0x238700200018, 0x23A300200018, 0x239C00200018, 0x23A300200018, 0x23A700200018, 0x23A300200018, 0x23A700200018, 0x23B100200018,
+		0x23C600200018, 0x23A300200018, 0x23C600200018, 0x23B100200018, 0x760018, 0x800018, 0x7A0018, 0x800018,
+		0x7D0018, 0x800018, 0x236A0020001A, 0x23C50020001A, 0x236A0020001A, 0x23C60020001A, 0x236E0020001A, 0x23C50020001A,
+		0x236E0020001A, 0x23C60020001A, 0x236F0020001A, 0x23C50020001A, 0x236F0020001A, 0x23C60020001A, 0x23590020001A, 0x23C50020001A,
+		0x23590020001A, 0x23C60020001A, 0x235A0020001A, 0x23C50020001A, 0x235A0020001A, 0x23C60020001A, 0x232C0020001A, 0x23C50020001A,
+		0x232C0020001A, 0x23C60020001A, 0x23250020001A, 0x23C50020001A, 0x23250020001A, 0x23C60020001A, 0x232D0020001A, 0x23C50020001A,
+		0x232D0020001A, 0x23C60020001A, 0x23640020001A, 0x23C50020001A, 0x23640020001A, 0x23C60020001A, 0x23650020001A, 0x23C50020001A,
+		0x23650020001A, 0x23C60020001A, 0x235A0020001A, 0x23250020001A, 0x235A0020001A, 0x232C0020001A, 0x235A0020001A, 0x232D0020001A,
+		0x235A0020001A, 0x23A30020001A, 0x235A0020001A, 0x23460020001A, 0x23590020001A, 0x23460020001A, 0x23640020001A, 0x23460020001A,
+		0x23650020001A, 0x23460020001A, 0x236A00200019, 0x23C500200019, 0x236A00200019, 0x23C600200019, 0x236E00200019, 0x23C500200019,
+		0x236E00200019, 0x23C600200019, 0x236F00200019, 0x23C500200019, 0x236F00200019, 0x23C600200019, 0x235900200019, 0x23C500200019,
+		0x235900200019, 0x23C600200019, 0x235A00200019, 0x23C500200019, 0x235A00200019, 0x23C600200019, 0x232C00200019, 0x23C500200019,
+		0x232C00200019, 0x23C600200019, 0x232500200019, 0x23C500200019, 0x232500200019, 0x23C600200019, 0x232D00200019, 0x23C500200019,
+		0x232D00200019, 0x23C600200019, 0x236400200019, 0x23C500200019, 0x236400200019, 0x23C600200019, 0x236500200019, 0x23C500200019,
+		0x236500200019, 0x23C600200019, 0x235A00200019, 0x232500200019, 0x235A00200019, 0x232C00200019, 0x235A00200019, 0x232D00200019,
+		0x235A00200019, 0x23A300200019, 0x235A00200019, 0x234600200019, 0x235900200019, 0x234600200019, 0x236400200019, 0x234600200019,
+		0x236500200019, 0x234600200019, 0x235A00200017, 0x232500200017, 0x235A00200017, 0x232C00200017, 0x235A00200017, 0x232D00200017,
+		0x235A00200017, 0x23A300200017, 0x235900200017, 0x23B100200017, 0x235A00200017, 0x23B100200017, 0x236A00200017, 0x23A300200017,
+		0x235900200018, 0x232500200018, 0x235900200018, 0x232C00200018, 0x235900200018, 0x232D00200018, 0x235A00200018, 0x232500200018,
+		0x235A00200018, 0x232C00200018, 0x235A00200018, 0x232D00200018, 0x236A00200018, 0x23A300200018, 0x236B00200018, 0x23A300200018,
+		0x230B00200019, 0x6D0019, 0x230B0020001A, 0x6D001A, 0x231D00200017, 0x232500200017, 0x23A300200017, 0x231D00200019,
+		0x232C00200019, 0x232500200019, 0x231D00200017, 0x232C00200017, 0x232500200017, 0x231D00200017, 0x232C00200017, 0x23A300200017,
+		0x231D00200017, 0x232D00200017, 0x23A300200017, 0x231D00200017, 0x23A300200017, 0x232500200017, 0x231D00200017, 0x23A300200017,
+		0x232C00200017, 0x231D00200017, 0x23A300200017, 0x232D00200017, 0x232500200019, 0x23A300200019, 0x232C00200019, 0x232500200017,
+		0x23A300200017, 0x232C00200017, 0x232C00200019, 0x23A300200019, 0x23C600200019, 0x232C00200019, 0x23A300200019, 0x23C500200019,
+		0x235900200017, 0x232C00200017, 0x232500200017, 0x235900200017, 0x232500200017, 0x232C00200017, 0x235900200019, 0x232500200019,
+		0x23C500200019, 0x235900200019, 0x23A300200019, 0x232C00200019, 0x235900200017, 0x23A300200017, 0x232C00200017, 0x235900200017,
+		0x23A300200017, 0x232500200017, 0x235900200019, 0x23A300200019, 0x23A300200019, 0x235900200017, 0x23A300200017, 0x23A300200017,
+		0x236400200019, 0x232C00200019, 0x232C00200019, 0x236400200017, 0x232C00200017, 0x232C00200017, 0x236400200019, 0x23A300200019,
+		0x23A300200019, 0x235A00200019, 0x232C00200019, 0x23A300200019, 0x235A00200017, 0x232C00200017, 0x23A300200017, 0x235A00200019,
+		0x232500200019, 0x23C600200019, 0x235A00200019, 0x23A300200019, 0x232D00200019, 0x235A00200017, 0x23A300200017, 0x232D00200017,
+		0x235A00200019, 0x23A300200019, 0x23A300200019, 0x235A00200017, 0x23A300200017, 0x23A300200017, 0x236500200019, 0x232C00200019,
+		0x23C500200019, 0x236500200019, 0x232D00200019, 0x23A300200019, 0x236500200017, 0x232D00200017, 0x23A300200017, 0x236A00200019,
+		0x23A300200019, 0x232C00200019, 0x236A00200017, 0x23A300200017, 0x232C00200017, 0x236A00200017, 0x23A300200017, 0x23A300200017,
+		0x236A00200019, 0x23A300200019, 0x23C600200019, 0x236E00200019, 0x232500200019, 0x23A300200019, 0x236E00200019, 0x23A300200019,
+		0x23A300200019, 0x236E00200017, 0x23A300200017, 0x23A300200017, 0x236E00200019, 0x23A300200019, 0x23C500200019, 0x236F00200019,
+		0x23A300200019, 0x23A300200019, 0x236F00200019, 0x23A300200019, 0x23C600200019, 0x236F00200019, 0x23A300200019, 0x23C500200019,
+		0x237600200019, 0x232D00200019, 0x23A300200019, 0x237600200017, 0x232D00200017, 0x23A300200017, 0x238200200019, 0x23A300200019,
+		0x232C00200019, 0x238200200019, 0x23A300200019, 0x23A300200019, 0x239C00200019, 0x232C00200019, 0x23A300200019, 0x239C00200019,
+		0x232C00200019, 0x23C600200019, 0x239C00200019, 0x232C00200019, 0x23C500200019, 0x239C00200017, 0x232500200017, 0x232500200017,
+		0x239C00200019, 0x232500200019, 0x232500200019, 0x239C00200019, 0x232D00200019, 0x23A300200019, 0x239C00200017, 0x232D00200017,
+		0x23A300200017, 0x239C00200019, 0x23A300200019, 0x232C00200019, 0x239C00200017, 0x23A300200017, 0x232C00200017, 0x23A300200017,
+		0x232C00200017, 0x232500200017, 0x23A300200017, 0x232C00200017, 0x23A300200017, 0x23A300200019, 0x232C00200019, 0x23C600200019,
+		0x23A300200017, 0x232500200017, 0x232C00200017, 0x23A300200017, 0x232500200017, 0x23A300200017, 0x23A300200017, 0x232D00200017,
+		0x232500200017, 0x23A300200017, 0x232D00200017, 0x23A300200017, 0x23A300200017, 0x232500200017, 0x232D00200017, 0x23B100200017,
+		0x23A300200017, 0x232500200017, 0x23B100200017, 0x23A300200017, 0x23A300200017, 0x23A700200017, 0x232C00200017, 0x23A300200017,
+		0x23A700200019, 0x232C00200019, 0x23C500200019, 0x23A700200019, 0x232500200019, 0x23A300200019, 0x23A700200017, 0x232500200017,
+		0x23A300200017, 0x23A700200019, 0x232500200019, 0x23C500200019, 0x23A700200019, 0x23A300200019, 0x23C600200019, 0x23A700200019,
+		0x23A300200019, 0x23C500200019, 0x23C600200019, 0x23A300200019, 0x23A300200019, 0x23C600200017, 0x23A300200017, 0x23A300200017,
+		0x230D00200019, 0x232D00200019, 0x23C600200019, 0x231D00200019, 0x232500200019, 0x23C600200019, 0x231D00200019, 0x232500200019,
+		0x23C500200019, 0x231D00200019, 0x232D00200019, 0x23C600200019, 0x231D00200019, 0x232D00200019, 0x23C500200019, 0x231D00200019,
+		0x23A300200019, 0x23C600200019, 0x231D00200019, 0x23A300200019, 0x23C500200019, 0x232500200019, 0x23A300200019, 0x23C600200019,
+		0x232500200019, 0x232C00200019, 0x23C500200019, 0x232500200019, 0x23A300200019, 0x23C500200019, 0x235900200019, 0x232D00200019,
+		0x23C500200019, 0x236400200019, 0x232C00200019, 0x23C600200019, 0x235A00200019, 0x232C00200019, 0x23C600200019, 0x236500200019,
+		0x232C00200019, 0x23C600200019, 0x239C00200019, 0x232500200019, 0x23C600200019, 0x239C00200019, 0x23A300200019, 0x23C600200019,
+		0x23C600200019, 0x232C00200019, 0x23C600200019, 0x23C600200019, 0x232500200019, 0x23C600200019, 0x23C600200019, 0x23A300200019,
+		0x23C600200019, 0x23A300200019, 0x23A300200019, 0x23C600200019, 0x238200200019, 0x23A300200019, 0x23C600200019, 0x23A700200019,
+		0x232C00200019, 0x23C600200019, 0x238200200017, 0x23A300200017, 0x232C00200017, 0x239C00200017, 0x232C00200017, 0x23A300200017,
+		0x236E00200019, 0x23A300200019, 0x23C600200019, 0x238700200019, 0x23A300200019, 0x23C600200019, 0x23A700200017, 0x232500200017,
+		0x232C00200017, 0x23A300200019, 0x232D00200019, 0x23C600200019, 0x239C00200017, 0x232500200017, 0x23A300200017, 0x238700200019,
+		0x23A300200019, 0x23A300200019, 0x239C00200019, 0x232500200019, 0x23A300200019, 0x23A700200019, 0x232500200019, 0x232C00200019,
+		0x232500200019, 0x232C00200019, 0x23C600200019, 0x232C00200019, 0x232500200019, 0x23C600200019, 0x23A300200019, 0x232500200019,
+		0x23C600200019, 0x237600200019, 0x23A300200019, 0x23C600200019, 0x230D00200019, 0x232C00200019, 0x23C600200019, 0x238700200017,
+		0x23A300200017, 0x23A300200017, 0x236E00200017, 0x232500200017, 0x23A300200017, 0x236400200017, 0x23A300200017, 0x23A300200017,
+		0x235900200019, 0x232D00200019, 0x23C600200019, 0x23A700200019, 0x232500200019, 0x23C600200019, 0x23640020001A, 0x239C0020001A,
+		0x23D40020001A, 0x23820020001A, 0x239C0020001A, 0x23D40020001A, 0x230B0020001A, 0x239C0020001A, 0x239C0020001A, 0x23B10020001A,
+		0x230B0020001A, 0x23870020001A, 0x230D0020001A, 0x23460020001A, 0x23A30020001A, 0x232C0020001A, 0x23A30020001A, 0x23370020001A,
+		0x23640020001A, 0x239C0020001A, 0x236E0020001A, 0x23A30020001A, 0x23460020001A, 0x23590020001A, 0x23B70020001A, 0x239C0020001A,
+		0x236E0020001A, 0x239C0020001A, 0x23C60020001A, 0x23B10020001A, 0x23B70020001A, 0x23590020001A, 0x239C0020001A, 0x23A30020001A,
+		0x23640020001A, 0x239C0020001A, 0x23C50020001A, 0x23640020001A, 0x239C0020001A, 0x23C50020001A, 0x2090020001A, 0x230B0020001A,
+		0x239C0020001A, 0x239C0020001A, 0x23B10020001A, 0x23250020001A, 0x239C0020001A, 0x2090020001A, 0x23250020001A, 0x239C0020001A,
+		0x230B0020001A, 0x239C0020001A, 0x23B10020001A, 0x23460020001A, 0x23C70020001A, 0x230B0020001A, 0x239C0020001A, 0x27700200016,
+		0x27700200016, 0x27700200016, 0x27700200016, 0x27700200016, 0x239C0020001A, 0x22FE0020001A, 0x239C00200019, 0x22FE00200019,
+		0x239C0020001A, 0x22FF0020001A, 0x239C00200019, 0x22FF00200019, 0x239C0020001A, 0x23030020001A, 0x239C00200019, 0x230300200019,
+		0x239C0020001A, 0x230B0020001A, 0x239C00200019, 0x230B00200019, 0x524200200004, 0x1100004, 0x524300200004, 0x1100004,
+		0x524400200004, 0x1100004, 0x524500200004, 0x1100004, 0x524600200004, 0x1100004, 0x524700200004, 0x1100004,
+		0x524800200004, 0x1100004, 0x524800200004, 0x1110004, 0x524900200004, 0x1100004, 0x524A00200004, 0x1100004,
+		0x524B00200004, 0x1100004, 0x524B00200004, 0x1110004, 0x524C00200004, 0x1100004, 0x524C00200004, 0x1110004,
+		0x524D00200004, 0x1100004, 0x524D00200004, 0x1110004, 0x524E00200004, 0x1100004, 0x524F00200004, 0x1100004,
+		0x525000200004, 0x1100004, 0x525100200004, 0x1110004, 0x525100200004, 0x1120004, 0x525200200004, 0x1100004,
+		0x525300200004, 0x1100004, 0x525400200004, 0x1100004, 0x525500200004, 0x1100004, 0x525500200004, 0x1110004,
+		0x525600200004, 0x1100004, 0x525600200004, 0x1110004, 0x525700200004, 0x1100004, 0x525800200004, 0x1100004,
+		0x525100200004, 0x1100004, 0x494E00200004, 0x1110004, 0x48AD00200004, 0x1100004, 0x372D00200004, 0x1100004,
+		0x372F00200004, 0x1100004, 0x373200200004, 0x1100004, 0x373300200004, 0x1100004, 0x373400200004, 0x1100004,
+		0x373500200004, 0x1100004, 0x373600200004, 0x1100004, 0x373700200004, 0x1100004, 0x373900200004, 0x1100004,
+		0x373A00200004, 0x1100004, 0x373B00200004, 0x1100004, 0x373C00200004, 0x1100004, 0x373D00200004, 0x1100004,
+		0x373E00200004, 0x1100004, 0x374300200004, 0x1100004, 0x374400200004, 0x1100004, 0x374500200004, 0x1100004,
+		0x374600200004, 0x1100004, 0x374800200004, 0x1100004, 0x374C00200004, 0x1100004, 0x374D00200004, 0x1100004,
+		0x374E00200004, 0x1100004, 0x374F00200004, 0x1100004, 0x375000200004, 0x1100004, 0x375400200004, 0x1100004,
+		0x375500200004, 0x1100004, 0x375600200004, 0x1100004, 0x375700200004, 0x1100004, 0x37040020000A, 0x1100004,
+		0x370C0020000A, 0x1100004, 0x370C0020000A, 0x1110004, 0x37110020000A, 0x1100004, 0x371B0020000A, 0x1100004,
+		0x371C0020000A, 0x1100004, 0x371C0020000A, 0x1110004, 0x371F0020000A, 0x1100004, 0x37260020000A, 0x1100004,
+		0x37270020000A, 0x1100004, 0x370400200004, 0x1100004, 0x370C00200004, 0x1100004, 0x370C00200004, 0x1110004,
+		0x371100200004, 0x1100004, 0x371B00200004, 0x1100004, 0x371C00200004, 0x1100004, 0x371C00200004, 0x1110004,
+		0x371F00200004, 0x1100004, 0x372600200004, 0x1100004, 0x372700200004, 0x1100004, 0x29DC00200002, 0xC20002,
+		0x29DD00200002, 0xC20002, 0x29E600200002, 0xC20002, 0x2BC400200004, 0x1100004, 0x2BC400200004, 0x1110004,
+		0x2BC500200004, 0x1100004, 0x2BC600200004, 0x1100004, 0x2BF400200004, 0x1100004, 0x2BF500200004, 0x1100004,
+		0x2C7500200004, 0x1100004, 0x2C8500200004, 0x1100004, 0x1C3D00200004, 0x27700200004, 0x1C3D00200004, 0x22200200004,
+		0x1C3E00200004, 0x22200200004, 0x1C3F00200004, 0x22200200004, 0x1C4000200004, 0x22200200004, 0x1C4100200004, 0x22200200004,
+		0x1C4200200004, 0x22200200004, 0x1C4300200004, 0x22200200004, 0x1C4400200004, 0x22200200004, 0x1C4500200004, 0x22200200004,
+		0x1C4600200004, 0x22200200004, 0x31700200004, 0x1C470020000A, 0x31800200004, 0x31700200004, 0x1C600020000A, 0x31800200004,
+		0x31700200004, 0x1C7A0020000A, 0x31800200004, 0x31700200004, 0x1C8F0020000A, 0x31800200004, 0x31700200004, 0x1CAA0020000A,
+		0x31800200004, 0x31700200004, 0x1CE50020000A, 0x31800200004, 0x31700200004, 0x1CF40020000A, 0x31800200004, 0x31700200004,
+		0x1D180020000A, 0x31800200004, 0x31700200004, 0x1D320020000A, 0x31800200004, 0x31700200004, 0x1D4C0020000A, 0x31800200004,
+		0x31700200004, 0x1D650020000A, 0x31800200004, 0x31700200004, 0x1D770020000A, 0x31800200004, 0x31700200004, 0x1DAA0020000A,
+		0x31800200004, 0x31700200004, 0x1DB90020000A, 0x31800200004, 0x31700200004, 0x1DDD0020000A, 0x31800200004, 0x31700200004,
+		0x1E0C0020000A, 0x31800200004, 0x31700200004, 0x1E210020000A, 0x31800200004, 0x31700200004, 0x1E330020000A, 0x31800200004,
+		0x31700200004, 0x1E710020000A, 0x31800200004, 0x31700200004, 0x1E950020000A, 0x31800200004, 0x31700200004, 0x1EB50020000A,
+		0x31800200004, 0x31700200004, 0x1EE30020000A, 0x31800200004, 0x31700200004, 0x1EF50020000A, 0x31800200004, 0x31700200004,
+		0x1EFF0020000A, 0x31800200004, 0x31700200004, 0x1F0B0020000A, 0x31800200004, 0x31700200004, 0x1F210020000A, 0x31800200004,
+		0x37900200004, 0x1E710020000A, 0x37A00200004, 0x1C7A0020000C, 0x1C8F0020000C, 0x1EF50020000C, 0x1F210020000C, 0x1D180020001D,
+		0x1EE30020001D, 0x1DAA0020001D, 0x1EE30020001D, 0x1E710020001D, 0x1C8F0020001D, 0x1E710020001D, 0x1E710020001D, 0x1E0C0020001D,
+		0x1E0C0020001D, 0x1EE30020001D, 0x1EF50020001D, 0x1C7A0020001D, 0x1DAA00200014, 0x1C7A00200014, 0x1DAA00200014, 0x1C8F00200014,
+		0x1D320020001D, 0x1C7A0020001D, 0x1E0C0020001D, 0x1C470020001D, 0x1E710020001D, 0x1C470020001D, 0x1C470020001D, 0x1C600020001D,
+		0x1EF50020001D, 0x1C7A0020001D, 0x1C8F0020001D, 0x1D4C0020001D, 0x1C7A0020001D, 0x1D770020001D, 0x1C7A0020001D, 0x1DDD0020001D,
+		0x1DDD0020001D, 0x1D770020001D, 0x1CE50020001D, 0x1E330020001D, 0x1CAA0020001D, 0x1CAA0020001D, 0x1D320020001D, 0x1C8F0020001D,
+		0x1DB90020001D, 0x1CAA0020001D, 0x1EF50020001D, 0x1DB90020001D, 0x1CF40020001D, 0x1DDD0020001D, 0x1D650020001D, 0x1E710020001D,
+		0x1DDD0020001D, 0x1E710020001D, 0x1EB50020001D, 0x1E0C0020001D, 0x2600020001C, 0x1EE30020001D, 0x1E710020001D, 0x1C400020001C,
+		0x1C8F0020001D, 0x1C3F0020001C, 0x1DB90020001C, 0x1C8F0020001C, 0x2090020001C, 0x1E710020001D, 0x1C7A0020001C, 0x1E330020001C,
+		0x1C3F0020001C, 0x1D650020001D, 0x1C410020001C, 0x1D650020001D, 0x1C450020001C, 0x1D650020001D, 0x1C420020001C, 0x2770020001C,
+		0x1C3E0020001C, 0x1C440020001C, 0x2770020001C, 0x1C3E0020001C, 0x1C3F0020001C, 0x1C3F0020001C, 0x2770020001C, 0x1C3F0020001C,
+		0x1C430020001C, 0x1C3D0020001C, 0x1E0C0020001D, 0x1C3E0020001C, 0x1C3F0020001C, 0x1C3D0020001C, 0x1E0C0020001D, 0x1D180020001D,
+		0x1C7A0020001C, 0x1D180020001D, 0x1C8F0020001C, 0x1E330020001D, 0x1D180020001D, 0x1D320020001C, 0x2090020001C, 0x1E330020001D,
+		0x1CAA0020001C, 0x1E710020001C, 0x1D770020001D, 0x1DDD0020001C, 0x1E710020001C, 0x1E710020001C, 0x1D770020001C, 0x1CAA0020001C,
+		0x1E710020001C, 0x1E710020001C, 0x1E710020001D, 0x1D180020001D, 0x1EE30020001D, 0x1EB50020001D, 0x1D180020001D, 0x1C8F0020001D,
+		0x1EE30020001D, 0x1DDD0020001D, 0x1C8F0020001D, 0x3D780020001C, 0x3D600020001C, 0x3D640020001C, 0x3D640020001C, 0xFB400020001C,
+		0xE24B00000000, 0xFB400020001C, 0xDB5700000000, 0xFB400020001C, 0xD3CC00000000, 0x3D6D0020001C, 0x37001C, 0xFB400020001C,
+		0xCE8C00000000, 0xFB400020001C, 0xD91A00000000, 0xFB410020001C, 0x89E300000000, 0xFB400020001C, 0xD92900000000, 0xFB400020001C,
+		0xCEA400000000, 0xFB400020001C, 0xE62000000000, 0xFB400020001C, 0xF12100000000, 0xFB400020001C, 0xE59900000000, 0xFB400020001C,
+		0xD24D00000000, 0xFB400020001C, 0xDF8C00000000, 0xFB400020001C, 0xD18D00000000, 0xFB400020001C, 0xE5B000000000, 0xFB400020001C,
+		0xD21D00000000, 0xFB400020001C, 0xFD4200000000, 0xFB400020001C, 0xF51F00000000, 0xFB410020001C, 0x8CA900000000, 0xFB400020001C,
+		0xD8F000000000, 0xFB400020001C, 0xD43900000000, 0xFB400020001C, 0xEF1400000000, 0xFB400020001C, 0xE29500000000, 0xFB400020001C,
+		0xE35500000000, 0xFB400020001C, 0xCE0000000000, 0xFB400020001C, 0xCE0900000000, 0xFB410020001C, 0x904A00000000, 0xFB400020001C,
+		0xDDE600000000, 0xFB400020001C, 0xCE2D00000000, 0xFB400020001C, 0xD3F300000000, 0xFB400020001C, 0xE30700000000, 0xFB410020001C,
+		0x8D7000000000, 0xFB400020001C, 0xE25300000000, 0xFB400020001C, 0xF98100000000, 0xFB400020001C, 0xFA7A00000000, 0xFB400020001C,
+		0xD40800000000, 0xFB400020001C, 0xEE8000000000, 0xFB400020001C, 0xE70900000000, 0xFB400020001C, 0xE70800000000, 0xFB400020001C,
+		0xF53300000000, 0xFB400020001C, 0xD27200000000, 0xFB400020001C, 0xD5B600000000, 0xFB410020001C, 0x914D00000000, 0x37900200004,
+		0xFB4000200004, 0xE72C00000000, 0x37A00200004, 0x37900200004, 0xFB4000200004, 0xCE0900000000, 0x37A00200004, 0x37900200004,
+		0xFB4000200004, 0xCE8C00000000, 0x37A00200004, 0x37900200004, 0xFB4000200004, 0xDB8900000000, 0x37A00200004, 0x37900200004,
+		0xFB4000200004, 0xF0B900000000, 0x37A00200004, 0x37900200004, 0xFB4000200004, 0xE25300000000, 0x37A00200004, 0x37900200004,
+		0xFB4000200004, 0xF6D700000000, 0x37A00200004, 0x37900200004, 0xFB4000200004, 0xD2DD00000000, 0x37A00200004, 0x37900200004,
+		0xFB4000200004, 0xE55700000000, 0x37A00200004, 0xFB4000200006, 0xDF9700000000, 0xFB4000200006, 0xD3EF00000000, 0xFB4000200002,
+		0xCE3D00000000, 0xFB4000200002, 0xCE3800000000, 0xFB4000200002, 0xCE4100000000, 0xFB8400200002, 0x812200000000, 0xFB4000200002,
+		0xCF6000000000, 0xFB4000200002, 0xCFAE00000000, 0xFB4000200002, 0xCFBB00000000, 0xFB4000200002, 0xD00200000000, 0xFB4000200002,
+		0xD07A00000000, 0xFB4000200002, 0xD09900000000, 0xFB4000200002, 0xD0E700000000, 0xFB4000200002, 0xD0CF00000000, 0xFB8000200002,
+		0xB49E00000000, 0xFB8400200002, 0x863A00000000, 0xFB4000200002, 0xD14D00000000, 0xFB4000200002, 0xD15400000000, 0xFB4000200002,
+		0xD16400000000, 0xFB4000200002, 0xD17700000000, 0xFB8400200002, 0x851C00000000, 0xFB8000200002, 0xB4B900000000, 0xFB4000200002,
+		0xD16700000000, 0xFB4000200002, 0xD18D00000000, 0xFB8400200002, 0x854B00000000, 0xFB4000200002, 0xD19700000000, 0xFB4000200002,
+		0xD1A400000000, 0xFB4000200002, 0xCECC00000000, 0xFB4000200002, 0xD1AC00000000, 0xFB4000200002, 0xD1B500000000, 0xFB8500200002,
+		0x91DF00000000, 0xFB4000200002, 0xD1F500000000, 0xFB4000200002, 0xD20300000000, 0xFB8000200002, 0xB4DF00000000, 0xFB4000200002,
+		0xD23B00000000, 0xFB4000200002, 0xD24600000000, 0xFB4000200002, 0xD27200000000, 0xFB4000200002, 0xD27700000000, 0xFB8000200002,
+		0xB51500000000, 0xFB4000200002, 0xD2C700000000, 0xFB4000200002, 0xD2C900000000, 0xFB4000200002, 0xD2E400000000, 0xFB4000200002,
+		0xD2FA00000000, 0xFB4000200002, 0xD30500000000, 0xFB4000200002, 0xD30600000000, 0xFB4000200002, 0xD31700000000, 0xFB4000200002,
+		0xD34900000000, 0xFB4000200002, 0xD35100000000, 0xFB4000200002, 0xD35A00000000, 0xFB4000200002, 0xD37300000000, 0xFB4000200002,
+		0xD37D00000000, 0xFB4000200002, 0xD37F00000000, 0xFB4000200002, 0xD37F00000000, 0xFB4000200002, 0xD37F00000000, 0xFB8400200002,
+		0x8A2C00000000, 0xFB4000200002, 0xF07000000000, 0xFB4000200002, 0xD3CA00000000, 0xFB4000200002, 0xD3DF00000000, 0xFB8400200002,
+		0x8B6300000000, 0xFB4000200002, 0xD3EB00000000, 0xFB4000200002, 0xD3F100000000, 0xFB4000200002, 0xD40600000000, 0xFB4000200002,
+		0xD49E00000000, 0xFB4000200002, 0xD43800000000, 0xFB4000200002, 0xD44800000000, 0xFB4000200002, 0xD46800000000, 0xFB4000200002,
+		0xD4A200000000, 0xFB4000200002, 0xD4F600000000, 0xFB4000200002, 0xD51000000000, 0xFB4000200002, 0xD55300000000, 0xFB4000200002,
+		0xD56300000000, 0xFB4000200002, 0xD58400000000, 0xFB4000200002, 0xD58400000000, 0xFB4000200002, 0xD59900000000, 0xFB4000200002,
+		0xD5AB00000000, 0xFB4000200002, 0xD5B300000000, 0xFB4000200002, 0xD5C200000000, 0xFB4000200002, 0xD71600000000, 0xFB4000200002,
+		0xD60600000000, 0xFB4000200002, 0xD71700000000, 0xFB4000200002, 0xD65100000000, 0xFB4000200002, 0xD67400000000, 0xFB4000200002,
+		0xD20700000000, 0xFB4000200002, 0xD8EE00000000, 0xFB4000200002, 0xD7CE00000000, 0xFB4000200002, 0xD7F400000000, 0xFB4000200002,
+		0xD80D00000000, 0xFB4000200002, 0xD78B00000000, 0xFB4000200002, 0xD83200000000, 0xFB4000200002, 0xD83100000000, 0xFB4000200002,
+		0xD8AC00000000, 0xFB8400200002, 0x94E400000000, 0xFB4000200002, 0xD8F200000000, 0xFB4000200002, 0xD8F700000000, 0xFB4000200002,
+		0xD90600000000, 0xFB4000200002, 0xD91A00000000, 0xFB4000200002, 0xD92200000000, 0xFB4000200002, 0xD96200000000, 0xFB8400200002,
+		0x96A800000000, 0xFB8400200002, 0x96EA00000000, 0xFB4000200002, 0xD9EC00000000, 0xFB4000200002, 0xDA1B00000000, 0xFB4000200002,
+		0xDA2700000000, 0xFB4000200002, 0xD9D800000000, 0xFB4000200002, 0xDA6600000000, 0xFB8000200002, 0xB6EE00000000, 0xFB8000200002,
+		0xB6FC00000000, 0xFB4000200002, 0xDB0800000000, 0xFB4000200002, 0xDB3E00000000, 0xFB4000200002, 0xDB3E00000000, 0xFB8400200002,
+		0x99C800000000, 0xFB4000200002, 0xDBC300000000, 0xFB4000200002, 0xDBD800000000, 0xFB4000200002, 0xDBE700000000, 0xFB4000200002,
+		0xDBF300000000, 0xFB8400200002, 0x9B1800000000, 0xFB4000200002, 0xDBFF00000000, 0xFB4000200002, 0xDC0600000000, 0xFB4000200002,
+		0xDF5300000000, 0xFB4000200002, 0xDC2200000000, 0xFB8000200002, 0xB78100000000, 0xFB4000200002, 0xDC6000000000, 0xFB4000200002,
+		0xDC6E00000000, 0xFB4000200002, 0xDCC000000000, 0xFB4000200002, 0xDC8D00000000, 0xFB8400200002, 0x9DE400000000, 0xFB4000200002,
+		0xDD4300000000, 0xFB8400200002, 0x9DE600000000, 0xFB4000200002, 0xDD6E00000000, 0xFB4000200002, 0xDD6B00000000, 0xFB4000200002,
+		0xDD7C00000000, 0xFB4000200002, 0xDDE100000000, 0xFB4000200002, 0xDDE200000000, 0xFB8000200002, 0xB82F00000000, 0xFB4000200002,
+		0xDDFD00000000, 0xFB4000200002, 0xDE2800000000, 0xFB4000200002, 0xDE3D00000000, 0xFB4000200002, 0xDE6900000000, 0xFB8000200002,
+		0xB86200000000, 0xFB8400200002, 0xA18300000000, 0xFB8000200002, 0xB87C00000000, 0xFB4000200002, 0xDEB000000000, 0xFB4000200002,
+		0xDEB300000000, 0xFB4000200002, 0xDEB600000000, 0xFB4000200002, 0xDECA00000000, 0xFB8500200002, 0xA39200000000, 0xFB4000200002,
+		0xDEFE00000000, 0xFB8400200002, 0xA33100000000, 0xFB8400200002, 0xA33100000000, 0xFB4100200002, 0x820100000000, 0xFB4000200002,
+		0xDF2200000000, 0xFB4000200002, 0xDF2200000000, 0xFB8000200002, 0xB8C700000000, 0xFB8400200002, 0xB2B800000000, 0xFB8400200002,
+		0xE1DA00000000, 0xFB4000200002, 0xDF6200000000, 0xFB4000200002, 0xDF6B00000000, 0xFB8000200002, 0xB8E300000000, 0xFB4000200002,
+		0xDF9A00000000, 0xFB4000200002, 0xDFCD00000000, 0xFB4000200002, 0xDFD700000000, 0xFB4000200002, 0xDFF900000000, 0xFB4000200002,
+		0xE08100000000, 0xFB8000200002, 0xB93A00000000, 0xFB8000200002, 0xB91C00000000, 0xFB4000200002, 0xE09400000000, 0xFB8400200002,
+		0xA6D400000000, 0xFB4000200002, 0xE0C700000000, 0xFB4000200002, 0xE14800000000, 0xFB4000200002, 0xE14C00000000, 0xFB4000200002,
+		0xE14E00000000, 0xFB4000200002, 0xE14C00000000, 0xFB4000200002, 0xE17A00000000, 0xFB4000200002, 0xE18E00000000, 0xFB4000200002,
+		0xE1B200000000, 0xFB4000200002, 0xE1A400000000, 0xFB4000200002, 0xE1AF00000000, 0xFB4000200002, 0xE1DE00000000, 0xFB4000200002,
+		0xE1F200000000, 0xFB4000200002, 0xE1F600000000, 0xFB4000200002, 0xE21000000000, 0xFB4000200002, 0xE21B00000000, 0xFB4000200002,
+		0xE25D00000000, 0xFB4000200002, 0xE2B100000000, 0xFB4000200002, 0xE2D400000000, 0xFB4000200002, 0xE35000000000, 0xFB8400200002,
+		0xAB0C00000000, 0xFB4000200002, 0xE33D00000000, 0xFB4000200002, 0xE2FC00000000, 0xFB4000200002, 0xE36800000000, 0xFB4000200002,
+		0xE38300000000, 0xFB4000200002, 0xE3E400000000, 0xFB8400200002, 0xABF100000000, 0xFB4000200002, 0xE42200000000, 0xFB4000200002,
+		0xE3C500000000, 0xFB4000200002, 0xE3A900000000, 0xFB8000200002, 0xBA2E00000000, 0xFB4000200002, 0xE46900000000, 0xFB4000200002,
+		0xE47E00000000, 0xFB4000200002, 0xE49D00000000, 0xFB4000200002, 0xE47700000000, 0xFB8000200002, 0xBA6C00000000, 0xFB4000200002,
+		0xE54F00000000, 0xFB4000200002, 0xE56C00000000, 0xFB8400200002, 0xB00A00000000, 0xFB4000200002, 0xE5E300000000, 0xFB4000200002,
+		0xE6F800000000, 0xFB4000200002, 0xE64900000000, 0xFB8000200002, 0xBB1900000000, 0xFB4000200002, 0xE69100000000, 0xFB8000200002,
+		0xBB0800000000, 0xFB8000200002, 0xBAE400000000, 0xFB4000200002, 0xD19200000000, 0xFB4000200002, 0xD19500000000, 0xFB4000200002,
+		0xE70000000000, 0xFB4000200002, 0xE69C00000000, 0xFB4100200002, 0x80AD00000000, 0xFB8000200002, 0xC3D900000000, 0xFB4000200002,
+		0xE71700000000, 0xFB4000200002, 0xE71B00000000, 0xFB4000200002, 0xE72100000000, 0xFB4000200002, 0xE75E00000000, 0xFB4000200002,
+		0xE75300000000, 0xFB8400200002, 0xB3C300000000, 0xFB8000200002, 0xBB4900000000, 0xFB4000200002, 0xE7FA00000000, 0xFB4000200002,
+		0xE78500000000, 0xFB4000200002, 0xE85200000000, 0xFB4000200002, 0xE88500000000, 0xFB8400200002, 0xB46D00000000, 0xFB4000200002,
+		0xE88E00000000, 0xFB4000200002, 0xE81F00000000, 0xFB4000200002, 0xE91400000000, 0xFB8000200002, 0xBB9D00000000, 0xFB4000200002,
+		0xE94200000000, 0xFB4000200002, 0xE9A300000000, 0xFB4000200002, 0xE9EA00000000, 0xFB4000200002, 0xEAA800000000, 0xFB8400200002,
+		0xB6A300000000, 0xFB4000200002, 0xEADB00000000, 0xFB8000200002, 0xBC1800000000, 0xFB4000200002, 0xEB2100000000, 0xFB8400200002,
+		0xB8A700000000, 0xFB4000200002, 0xEB5400000000, 0xFB8000200002, 0xBC4E00000000, 0xFB4000200002, 0xEB7200000000, 0xFB4000200002,
+		0xEB9F00000000, 0xFB4000200002, 0xEBBA00000000, 0xFB4000200002, 0xEBBB00000000, 0xFB8400200002, 0xBA8D00000000, 0xFB8400200002,
+		0x9D0B00000000, 0xFB8400200002, 0xBAFA00000000, 0xFB4000200002, 0xEC4E00000000, 0xFB8400200002, 0xBCBC00000000, 0xFB4000200002,
+		0xECBF00000000, 0xFB4000200002, 0xECCD00000000, 0xFB4000200002, 0xEC6700000000, 0xFB4000200002, 0xED1600000000, 0xFB4000200002,
+		0xED3E00000000, 0xFB4000200002, 0xED7700000000, 0xFB4000200002, 0xED4100000000, 0xFB4000200002, 0xED6900000000, 0xFB4000200002,
+		0xED7800000000, 0xFB4000200002, 0xED8500000000, 0xFB8400200002, 0xBD1E00000000, 0xFB4000200002, 0xED3400000000, 0xFB4000200002,
+		0xEE2F00000000, 0xFB4000200002, 0xEE6E00000000, 0xFB8000200002, 0xBD3300000000, 0xFB4000200002, 0xEECB00000000, 0xFB4000200002,
+		0xEEC700000000, 0xFB8400200002, 0xBED100000000, 0xFB4000200002, 0xEDF900000000, 0xFB4000200002, 0xEF6E00000000, 0xFB8400200002,
+		0xBF5E00000000, 0xFB8400200002, 0xBF8E00000000, 0xFB4000200002, 0xEFC600000000, 0xFB4000200002, 0xF03900000000, 0xFB4000200002,
+		0xF01E00000000, 0xFB4000200002, 0xF01B00000000, 0xFB8000200002, 0xBD9600000000, 0xFB4000200002, 0xF04A00000000, 0xFB4000200002,
+		0xF07D00000000, 0xFB4000200002, 0xF07700000000, 0xFB4000200002, 0xF0AD00000000, 0xFB8400200002, 0x852500000000, 0xFB4000200002,
+		0xF14500000000, 0xFB8400200002, 0xC26300000000, 0xFB4000200002, 0xF19C00000000, 0xFB8400200002, 0xC3AB00000000, 0xFB4000200002,
+		0xF22800000000, 0xFB4000200002, 0xF23500000000, 0xFB4000200002, 0xF25000000000, 0xFB8400200002, 0xC60800000000, 0xFB4000200002,
+		0xF28000000000, 0xFB4000200002, 0xF29500000000, 0xFB8400200002, 0xC73500000000, 0xFB8400200002, 0xC81400000000, 0xFB4000200002,
+		0xF37A00000000, 0xFB4000200002, 0xF38B00000000, 0xFB8000200002, 0xBEAC00000000, 0xFB4000200002, 0xF3A500000000, 0xFB8000200002,
+		0xBEB800000000, 0xFB8000200002, 0xBEB800000000, 0xFB4000200002, 0xF44700000000, 0xFB4000200002, 0xF45C00000000, 0xFB4000200002,
+		0xF47100000000, 0xFB4000200002, 0xF48500000000, 0xFB4000200002, 0xF4CA00000000, 0xFB8000200002, 0xBF1B00000000, 0xFB4000200002,
+		0xF52400000000, 0xFB8400200002, 0xCC3600000000, 0xFB4000200002, 0xF53E00000000, 0xFB8400200002, 0xCC9200000000, 0xFB4000200002,
+		0xF57000000000, 0xFB8400200002, 0xA19F00000000, 0xFB4000200002, 0xF61000000000, 0xFB8400200002, 0xCFA100000000, 0xFB8400200002,
+		0xCFB800000000, 0xFB8400200002, 0xD04400000000, 0xFB8000200002, 0xBFFC00000000, 0xFB8000200002, 0xC00800000000, 0xFB4000200002,
+		0xF6F400000000, 0xFB8400200002, 0xD0F300000000, 0xFB8400200002, 0xD0F200000000, 0xFB8400200002, 0xD11900000000, 0xFB8400200002,
+		0xD13300000000, 0xFB4000200002, 0xF71E00000000, 0xFB4000200002, 0xF71F00000000, 0xFB4000200002, 0xF71F00000000, 0xFB4000200002,
+		0xF74A00000000, 0xFB8000200002, 0xC03900000000, 0xFB4000200002, 0xF78B00000000, 0xFB8000200002, 0xC04600000000, 0xFB8000200002,
+		0xC09600000000, 0xFB8400200002, 0xD41D00000000, 0xFB4000200002, 0xF84E00000000, 0xFB4000200002, 0xF88C00000000, 0xFB4000200002,
+		0xF8CC00000000, 0xFB8000200002, 0xC0E300000000, 0xFB8400200002, 0xD62600000000, 0xFB4000200002, 0xF95600000000, 0xFB8400200002,
+		0xD69A00000000, 0xFB8400200002, 0xD6C500000000, 0xFB4000200002, 0xF98F00000000, 0xFB4000200002, 0xF9EB00000000, 0xFB8000200002,
+		0xC12F00000000, 0xFB4000200002, 0xFA4000000000, 0xFB4000200002, 0xFA4A00000000, 0xFB4000200002, 0xFA4F00000000, 0xFB8400200002,
+		0xD97C00000000, 0xFB8400200002, 0xDAA700000000, 0xFB8400200002, 0xDAA700000000, 0xFB4000200002, 0xFAEE00000000, 0xFB8000200002,
+		0xC20200000000, 0xFB8400200002, 0xDBAB00000000, 0xFB4000200002, 0xFBC600000000, 0xFB4000200002, 0xFBC900000000, 0xFB8000200002,
+		0xC22700000000, 0xFB8400200002, 0xDC8000000000, 0xFB4000200002, 0xFCD200000000, 0xFB8000200002, 0xC2A000000000, 0xFB4000200002,
+		0xFCE800000000, 0xFB4000200002, 0xFCE300000000, 0xFB4000200002, 0xFD0000000000, 0xFB8400200002, 0xDF8600000000, 0xFB4000200002,
+		0xFD6300000000, 0xFB8000200002, 0xC30100000000, 0xFB4000200002, 0xFDC700000000, 0xFB4000200002, 0xFE0200000000, 0xFB4000200002,
+		0xFE4500000000, 0xFB8000200002, 0xC33400000000, 0xFB8400200002, 0xE22800000000, 0xFB8400200002, 0xE24700000000, 0xFB8000200002,
+		0xC35900000000, 0xFB8400200002, 0xE2D900000000, 0xFB4000200002, 0xFF7A00000000, 0xFB8400200002, 0xE33E00000000, 0xFB4000200002,
+		0xFF9500000000, 0xFB4000200002, 0xFFFA00000000, 0xFB4100200002, 0x800500000000, 0xFB8400200002, 0xE4DA00000000, 0xFB8400200002,
+		0xE52300000000, 0xFB4100200002, 0x806000000000, 0xFB8400200002, 0xE5A800000000, 0xFB4100200002, 0x807000000000, 0xFB8400200002,
+		0xB35F00000000, 0xFB8000200002, 0xC3D500000000, 0xFB4100200002, 0x80B200000000, 0xFB4100200002, 0x810300000000, 0xFB8000200002,
+		0xC40B00000000, 0xFB4100200002, 0x813E00000000, 0xFB4000200002, 0xDAB500000000, 0xFB8400200002, 0xE7A700000000, 0xFB8400200002,
+		0xE7B500000000, 0xFB8400200002, 0xB39300000000, 0xFB8400200002, 0xB39C00000000, 0xFB4100200002, 0x820100000000, 0xFB4100200002,
+		0x820400000000, 0xFB4100200002, 0x8F9E00000000, 0xFB8000200002, 0xC46B00000000, 0xFB4100200002, 0x829100000000, 0xFB4100200002,
+		0x828B00000000, 0xFB4100200002, 0x829D00000000, 0xFB4000200002, 0xD2B300000000, 0xFB4100200002, 0x82B100000000, 0xFB4100200002,
+		0x82B300000000, 0xFB4100200002, 0x82BD00000000, 0xFB4100200002, 0x82E600000000, 0xFB8400200002, 0xEB3C00000000, 0xFB4100200002,
+		0x82E500000000, 0xFB4100200002, 0x831D00000000, 0xFB4100200002, 0x836300000000, 0xFB4100200002, 0x83AD00000000, 0xFB4100200002,
+		0x832300000000, 0xFB4100200002, 0x83BD00000000, 0xFB4100200002, 0x83E700000000, 0xFB4100200002, 0x845700000000, 0xFB4100200002,
+		0x835300000000, 0xFB4100200002, 0x83CA00000000, 0xFB4100200002, 0x83CC00000000, 0xFB4100200002, 0x83DC00000000, 0xFB8400200002,
+		0xEC3600000000, 0xFB8400200002, 0xED6B00000000, 0xFB8400200002, 0xECD500000000, 0xFB8000200002, 0xC52B00000000, 0xFB4100200002,
+		0x84F100000000, 0xFB4100200002, 0x84F300000000, 0xFB4100200002, 0x851600000000, 0xFB8400200002, 0xF3CA00000000, 0xFB4100200002,
+		0x856400000000, 0xFB8400200002, 0xEF2C00000000, 0xFB8000200002, 0xC55D00000000, 0xFB8000200002, 0xC56100000000, 0xFB8400200002,
+		0xEFB100000000, 0xFB8400200002, 0xF0D200000000, 0xFB8000200002, 0xC56B00000000, 0xFB4100200002, 0x865000000000, 0xFB4100200002,
+		0x865C00000000, 0xFB4100200002, 0x866700000000, 0xFB4100200002, 0x866900000000, 0xFB4100200002, 0x86A900000000, 0xFB4100200002,
+		0x868800000000, 0xFB4100200002, 0x870E00000000, 0xFB4100200002, 0x86E200000000, 0xFB4100200002, 0x877900000000, 0xFB4100200002,
+		0x872800000000, 0xFB4100200002, 0x876B00000000, 0xFB4100200002, 0x878600000000, 0xFB8000200002, 0xC5D700000000, 0xFB4100200002,
+		0x87E100000000, 0xFB4100200002, 0x880100000000, 0xFB8000200002, 0xC5F900000000, 0xFB4100200002, 0x886000000000, 0xFB4100200002,
+		0x886300000000, 0xFB8400200002, 0xF66700000000, 0xFB4100200002, 0x88D700000000, 0xFB4100200002, 0x88DE00000000, 0xFB8000200002,
+		0xC63500000000, 0xFB4100200002, 0x88FA00000000, 0xFB8000200002, 0xB4BB00000000, 0xFB8400200002, 0xF8AE00000000, 0xFB8400200002,
+		0xF96600000000, 0xFB8000200002, 0xC6BE00000000, 0xFB8000200002, 0xC6C700000000, 0xFB4100200002, 0x8AA000000000, 0xFB4100200002,
+		0x8AED00000000, 0xFB4100200002, 0x8B8A00000000, 0xFB4100200002, 0x8C5500000000, 0xFB8400200002, 0xFCA800000000, 0xFB4100200002,
+		0x8CAB00000000, 0xFB4100200002, 0x8CC100000000, 0xFB4100200002, 0x8D1B00000000, 0xFB4100200002, 0x8D7700000000, 0xFB8400200002,
+		0xFF2F00000000, 0xFB8400200002, 0x880400000000, 0xFB4100200002, 0x8DCB00000000, 0xFB4100200002, 0x8DBC00000000, 0xFB4100200002,
+		0x8DF000000000, 0xFB8400200002, 0x88DE00000000, 0xFB4100200002, 0x8ED400000000, 0xFB4100200002, 0x8F3800000000, 0xFB8500200002,
+		0x85D200000000, 0xFB8500200002, 0x85ED00000000, 0xFB4100200002, 0x909400000000, 0xFB4100200002, 0x90F100000000, 0xFB4100200002,
+		0x911100000000, 0xFB8500200002, 0x872E00000000, 0xFB4100200002, 0x911B00000000, 0xFB4100200002, 0x923800000000, 0xFB4100200002,
+		0x92D700000000, 0xFB4100200002, 0x92D800000000, 0xFB4100200002, 0x927C00000000, 0xFB4100200002, 0x93F900000000, 0xFB4100200002,
+		0x941500000000, 0xFB8500200002, 0x8BFA00000000, 0xFB4100200002, 0x958B00000000, 0xFB8000200002, 0xC99500000000, 0xFB4100200002,
+		0x95B700000000, 0xFB8500200002, 0x8D7700000000, 0xFB8000200002, 0xC9E600000000, 0xFB4100200002, 0x96C300000000, 0xFB4000200002,
+		0xDDB200000000, 0xFB4100200002, 0x972300000000, 0xFB8500200002, 0x914500000000, 0xFB8500200002, 0x921A00000000, 0xFB8000200002,
+		0xCA6E00000000, 0xFB8000200002, 0xCA7600000000, 0xFB4100200002, 0x97E000000000, 0xFB8500200002, 0x940A00000000, 0xFB8000200002,
+		0xCAB200000000, 0xFB8500200002, 0x949600000000, 0xFB4100200002, 0x980B00000000, 0xFB4100200002, 0x980B00000000, 0xFB4100200002,
+		0x982900000000, 0xFB8500200002, 0x95B600000000, 0xFB4100200002, 0x98E200000000, 0xFB8000200002, 0xCB3300000000, 0xFB4100200002,
+		0x992900000000, 0xFB4100200002, 0x99A700000000, 0xFB4100200002, 0x99C200000000, 0xFB4100200002, 0x99FE00000000, 0xFB8000200002,
+		0xCBCE00000000, 0xFB8500200002, 0x9B3000000000, 0xFB4100200002, 0x9B1200000000, 0xFB4100200002, 0x9C4000000000, 0xFB4100200002,
+		0x9CFD00000000, 0xFB8000200002, 0xCCCE00000000, 0xFB8000200002, 0xCCED00000000, 0xFB4100200002, 0x9D6700000000, 0xFB8500200002,
+		0xA0CE00000000, 0xFB8000200002, 0xCCF800000000, 0xFB8500200002, 0xA10500000000, 0xFB8500200002, 0xA20E00000000, 0xFB8500200002,
+		0xA29100000000, 0xFB4100200002, 0x9EBB00000000, 0xFB8000200002, 0xCD5600000000, 0xFB4100200002, 0x9EF900000000, 0xFB4100200002,
+		0x9EFE00000000, 0xFB4100200002, 0x9F0500000000, 0xFB4100200002, 0x9F0F00000000, 0xFB4100200002, 0x9F1600000000, 0xFB4100200002,
+		0x9F3B00000000, 0xFB8500200002, 0xA60000000000,
+	}
+)
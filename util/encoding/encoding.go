@@ -0,0 +1,123 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encoding
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pingcap/parser/charset"
+	"github.com/pingcap/tidb/util/hack"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/transform"
+)
+
+// Encoding converts the strings between UTF-8, in which TiDB keeps all the strings, and the character set
+// they are sent or compared in. Only the character sets whose strings are not kept as they are, e.g. gbk,
+// have an Encoding. It's safe for concurrent use.
+type Encoding struct {
+	name    string
+	encoder transform.Transformer
+	decoder transform.Transformer
+}
+
+var encodings = map[string]*Encoding{
+	charset.CharsetGBK: {
+		name:    charset.CharsetGBK,
+		encoder: simplifiedchinese.GBK.NewEncoder(),
+		decoder: simplifiedchinese.GBK.NewDecoder(),
+	},
+}
+
+// GetEncoding returns the Encoding of the character set, nil is returned if the strings in the character set
+// need no conversion.
+func GetEncoding(chs string) *Encoding {
+	return encodings[strings.ToLower(chs)]
+}
+
+// Name returns the name of the character set.
+func (e *Encoding) Name() string {
+	return e.name
+}
+
+// EncodeRune appends the rune encoded in the character set to dst. The rune which can't be represented in the
+// character set is replaced by '?' as MySQL does, ok is false in that case.
+func (e *Encoding) EncodeRune(dst []byte, r rune) (_ []byte, ok bool) {
+	if r < utf8.RuneSelf {
+		return append(dst, byte(r)), true
+	}
+	var src, buf [utf8.UTFMax]byte
+	n := utf8.EncodeRune(src[:], r)
+	nDst, _, err := e.encoder.Transform(buf[:], src[:n], true)
+	if err != nil {
+		return append(dst, '?'), false
+	}
+	return append(dst, buf[:nDst]...), true
+}
+
+// Encode appends the UTF-8 string encoded in the character set to dst. The characters which can't be represented
+// in the character set and the invalid UTF-8 bytes are replaced by '?', ok is false if there are any.
+func (e *Encoding) Encode(dst []byte, src string) (_ []byte, ok bool) {
+	ok = true
+	for i := 0; i < len(src); {
+		if src[i] < utf8.RuneSelf {
+			dst = append(dst, src[i])
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(src[i:])
+		var valid bool
+		if r == utf8.RuneError && size == 1 {
+			dst, valid = append(dst, '?'), false
+		} else {
+			dst, valid = e.EncodeRune(dst, r)
+		}
+		ok = ok && valid
+		i += size
+	}
+	return dst, ok
+}
+
+// EncodeString returns the UTF-8 string encoded in the character set, see Encode.
+func (e *Encoding) EncodeString(src string) (string, bool) {
+	dst, ok := e.Encode(make([]byte, 0, len(src)), src)
+	return string(hack.String(dst)), ok
+}
+
+// Decode appends the UTF-8 string decoded from src in the character set to dst. The invalid bytes are replaced by
+// '?', ok is false if there are any.
+func (e *Encoding) Decode(dst, src []byte) (_ []byte, ok bool) {
+	// The decoder replaces the invalid bytes with utf8.RuneError, which is never decoded from the valid ones.
+	decoded, _, err := transform.Bytes(e.decoder, src)
+	if err != nil {
+		return append(dst, '?'), false
+	}
+	ok = true
+	for i := 0; i < len(decoded); {
+		r, size := utf8.DecodeRune(decoded[i:])
+		if r == utf8.RuneError {
+			dst, ok = append(dst, '?'), false
+		} else {
+			dst = append(dst, decoded[i:i+size]...)
+		}
+		i += size
+	}
+	return dst, ok
+}
+
+// DecodeString returns the UTF-8 string decoded from src in the character set, see Decode.
+func (e *Encoding) DecodeString(src string) (string, bool) {
+	dst, ok := e.Decode(make([]byte, 0, len(src)), hack.Slice(src))
+	return string(hack.String(dst)), ok
+}
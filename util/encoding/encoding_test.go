@@ -0,0 +1,93 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encoding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEncoding(t *testing.T) {
+	t.Parallel()
+	require.Equal(t, "gbk", GetEncoding("gbk").Name())
+	require.Equal(t, "gbk", GetEncoding("GBK").Name())
+	require.Nil(t, GetEncoding("utf8mb4"))
+	require.Nil(t, GetEncoding("binary"))
+	require.Nil(t, GetEncoding(""))
+}
+
+func TestGBKEncode(t *testing.T) {
+	t.Parallel()
+	enc := GetEncoding("gbk")
+	table := []struct {
+		str    string
+		expect string
+		ok     bool
+	}{
+		{"", "", true},
+		{"abc", "abc", true},
+		{"你好", "\xc4\xe3\xba\xc3", true},
+		{"a中b", "a\xd6\xd0b", true},
+		{"·", "\xa1\xa4", true},
+		{"😀", "?", false},
+		{"a😀b中", "a?b\xd6\xd0", false},
+		{"a\xffb", "a?b", false},
+	}
+	for _, v := range table {
+		res, ok := enc.Encode([]byte("x"), v.str)
+		require.Equal(t, "x"+v.expect, string(res), v.str)
+		require.Equal(t, v.ok, ok, v.str)
+		str, ok := enc.EncodeString(v.str)
+		require.Equal(t, v.expect, str, v.str)
+		require.Equal(t, v.ok, ok, v.str)
+	}
+
+	res, ok := enc.EncodeRune(nil, '中')
+	require.Equal(t, "\xd6\xd0", string(res))
+	require.True(t, ok)
+	res, ok = enc.EncodeRune(nil, 'ア')
+	require.Equal(t, "\xa5\xa2", string(res))
+	require.True(t, ok)
+	res, ok = enc.EncodeRune(nil, '가')
+	require.Equal(t, "?", string(res))
+	require.False(t, ok)
+}
+
+func TestGBKDecode(t *testing.T) {
+	t.Parallel()
+	enc := GetEncoding("gbk")
+	table := []struct {
+		str    string
+		expect string
+		ok     bool
+	}{
+		{"", "", true},
+		{"abc", "abc", true},
+		{"\xc4\xe3\xba\xc3", "你好", true},
+		{"a\xd6\xd0b", "a中b", true},
+		{"\xa1\xa4", "·", true},
+		{"\xd6", "?", false},
+		{"\xd6\x20", "? ", false},
+		{"\xff\xffa", "??a", false},
+	}
+	for _, v := range table {
+		res, ok := enc.Decode([]byte("x"), []byte(v.str))
+		require.Equal(t, "x"+v.expect, string(res), v.str)
+		require.Equal(t, v.ok, ok, v.str)
+		str, ok := enc.DecodeString(v.str)
+		require.Equal(t, v.expect, str, v.str)
+		require.Equal(t, v.ok, ok, v.str)
+	}
+}
@@ -551,4 +551,6 @@ const (
 	LabelForSimpleTask int = -18
 	// LabelForCTEStorage represents the label of CTE storage
 	LabelForCTEStorage int = -19
+	// LabelForInstancePlanCache represents the label of the instance plan cache
+	LabelForInstancePlanCache int = -20
 )
@@ -50,6 +50,8 @@ type Context struct {
 	cancel      context.CancelFunc
 	sm          util.SessionManager
 	pcache      *kvcache.SimpleLRUCache
+	// advisoryLocks records the acquired times of each advisory lock.
+	advisoryLocks map[string]int
 }
 
 type wrapTxn struct {
@@ -327,6 +329,50 @@ func (c *Context) HasLockedTables() bool {
 	return false
 }
 
+// GetAdvisoryLock implements the sessionctx.Context interface.
+func (c *Context) GetAdvisoryLock(lockName string, _ int64) (bool, error) {
+	if c.advisoryLocks == nil {
+		c.advisoryLocks = make(map[string]int)
+	}
+	c.advisoryLocks[lockName]++
+	return true, nil
+}
+
+// ReleaseAdvisoryLock implements the sessionctx.Context interface.
+func (c *Context) ReleaseAdvisoryLock(lockName string) bool {
+	count, ok := c.advisoryLocks[lockName]
+	if !ok {
+		return false
+	}
+	if count > 1 {
+		c.advisoryLocks[lockName]--
+	} else {
+		delete(c.advisoryLocks, lockName)
+	}
+	return true
+}
+
+// ReleaseAllAdvisoryLocks implements the sessionctx.Context interface.
+func (c *Context) ReleaseAllAdvisoryLocks() int {
+	count := 0
+	for _, n := range c.advisoryLocks {
+		count += n
+	}
+	c.advisoryLocks = make(map[string]int)
+	return count
+}
+
+// IsUsedAdvisoryLock implements the sessionctx.Context interface.
+func (c *Context) IsUsedAdvisoryLock(lockName string) (uint64, error) {
+	if _, ok := c.advisoryLocks[lockName]; ok {
+		if c.sessionVars.ConnectionID == 0 {
+			return 1, nil
+		}
+		return c.sessionVars.ConnectionID, nil
+	}
+	return 0, nil
+}
+
 // PrepareTSFuture implements the sessionctx.Context interface.
 func (c *Context) PrepareTSFuture(ctx context.Context) {
 }
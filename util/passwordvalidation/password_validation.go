@@ -0,0 +1,288 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package passwordvalidation
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/sessionctx/variable"
+)
+
+// The password policies of validate_password_policy, a higher policy includes all the checks of the lower ones.
+const (
+	// LowPolicy only checks the length of the password.
+	LowPolicy = iota
+	// MediumPolicy also requires numeric, lowercase, uppercase and special characters.
+	MediumPolicy
+	// StrongPolicy also requires that no substring of 4 or more characters matches a word in the dictionary file.
+	StrongPolicy
+)
+
+const (
+	// minDictionaryWordLength is the length of the shortest substring checked against the dictionary.
+	minDictionaryWordLength = 4
+	// maxDictionaryWordLength is the length of the longest substring checked against the dictionary.
+	maxDictionaryWordLength = 100
+	// minStrengthLength is the length below which VALIDATE_PASSWORD_STRENGTH always returns 0.
+	minStrengthLength = 4
+)
+
+// options are the validate_password_* global system variables.
+type options struct {
+	policy           int
+	checkUserName    bool
+	length           int
+	mixedCaseCount   int
+	numberCount      int
+	specialCharCount int
+	dictionaryFile   string
+}
+
+func loadOptions(sessionVars *variable.SessionVars) (*options, error) {
+	getInt := func(name string) (int, error) {
+		val, err := variable.GetGlobalSystemVar(sessionVars, name)
+		if err != nil {
+			return 0, err
+		}
+		n, err := strconv.ParseUint(val, 10, 31)
+		if err != nil {
+			// Clamp the out of range values, MySQL also limits the counts to an int.
+			return int(^uint32(0) >> 1), nil
+		}
+		return int(n), nil
+	}
+	opts := &options{}
+	policy, err := variable.GetGlobalSystemVar(sessionVars, variable.ValidatePasswordPolicy)
+	if err != nil {
+		return nil, err
+	}
+	switch strings.ToUpper(policy) {
+	case "LOW":
+		opts.policy = LowPolicy
+	case "STRONG":
+		opts.policy = StrongPolicy
+	default:
+		opts.policy = MediumPolicy
+	}
+	checkUserName, err := variable.GetGlobalSystemVar(sessionVars, variable.ValidatePasswordCheckUserName)
+	if err != nil {
+		return nil, err
+	}
+	opts.checkUserName = variable.TiDBOptOn(checkUserName)
+	if opts.length, err = getInt(variable.ValidatePasswordLength); err != nil {
+		return nil, err
+	}
+	if opts.mixedCaseCount, err = getInt(variable.ValidatePasswordMixedCaseCount); err != nil {
+		return nil, err
+	}
+	if opts.numberCount, err = getInt(variable.ValidatePasswordNumberCount); err != nil {
+		return nil, err
+	}
+	if opts.specialCharCount, err = getInt(variable.ValidatePasswordSpecialCharCount); err != nil {
+		return nil, err
+	}
+	if opts.dictionaryFile, err = variable.GetGlobalSystemVar(sessionVars, variable.ValidatePasswordDictionaryFile); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
+// minLength returns the effective minimum length of the passwords. Like MySQL, validate_password_length
+// can not be less than the number of the characters required by the other options.
+func (opts *options) minLength() int {
+	minLength := opts.numberCount + opts.specialCharCount + 2*opts.mixedCaseCount
+	if opts.length > minLength {
+		return opts.length
+	}
+	return minLength
+}
+
+// checkCharacters returns the unmet requirement of MediumPolicy, or "" if pwd satisfies it.
+func (opts *options) checkCharacters(pwd string) string {
+	var lowerCount, upperCount, numberCount, specialCharCount int
+	for _, r := range pwd {
+		switch {
+		case unicode.IsUpper(r):
+			upperCount++
+		case unicode.IsLower(r):
+			lowerCount++
+		case unicode.IsDigit(r):
+			numberCount++
+		default:
+			specialCharCount++
+		}
+	}
+	if lowerCount < opts.mixedCaseCount {
+		return fmt.Sprintf("Require Password Lowercase Count: %d", opts.mixedCaseCount)
+	}
+	if upperCount < opts.mixedCaseCount {
+		return fmt.Sprintf("Require Password Uppercase Count: %d", opts.mixedCaseCount)
+	}
+	if numberCount < opts.numberCount {
+		return fmt.Sprintf("Require Password Digit Count: %d", opts.numberCount)
+	}
+	if specialCharCount < opts.specialCharCount {
+		return fmt.Sprintf("Require Password Non-alphanumeric Count: %d", opts.specialCharCount)
+	}
+	return ""
+}
+
+// checkDictionary returns the unmet requirement of StrongPolicy, or "" if pwd satisfies it.
+func (opts *options) checkDictionary(pwd string) (string, error) {
+	if opts.dictionaryFile == "" {
+		return "", nil
+	}
+	words, err := globalDictionary.load(opts.dictionaryFile)
+	if err != nil {
+		return "", err
+	}
+	if len(words) == 0 {
+		return "", nil
+	}
+	runes := []rune(strings.ToLower(pwd))
+	for l := minDictionaryWordLength; l <= len(runes) && l <= maxDictionaryWordLength; l++ {
+		for i := 0; i+l <= len(runes); i++ {
+			substr := string(runes[i : i+l])
+			if _, ok := words[substr]; ok {
+				return "Password contains word in the dictionary: " + substr, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// matchUserName checks whether pwd is one of the user names or the reverse of it.
+func matchUserName(pwd string, userNames []string) bool {
+	for _, name := range userNames {
+		if len(name) == 0 {
+			continue
+		}
+		if strings.EqualFold(pwd, name) || strings.EqualFold(pwd, reverse(name)) {
+			return true
+		}
+	}
+	return false
+}
+
+func reverse(s string) string {
+	runes := []rune(s)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes)
+}
+
+// ValidatePassword checks the plaintext password pwd against the password policy when validate_password_enable
+// is ON. userNames are the user names which the password must not match when validate_password_check_user_name
+// is ON. It returns the description of the first unmet requirement, or "" if the password is acceptable.
+func ValidatePassword(sessionVars *variable.SessionVars, pwd string, userNames ...string) (string, error) {
+	enable, err := variable.GetGlobalSystemVar(sessionVars, variable.ValidatePasswordEnable)
+	if err != nil || !variable.TiDBOptOn(enable) {
+		return "", err
+	}
+	opts, err := loadOptions(sessionVars)
+	if err != nil {
+		return "", err
+	}
+	if opts.checkUserName && matchUserName(pwd, userNames) {
+		return "Password Contains User Name", nil
+	}
+	if minLength := opts.minLength(); len([]rune(pwd)) < minLength {
+		return fmt.Sprintf("Require Password Length: %d", minLength), nil
+	}
+	if opts.policy >= MediumPolicy {
+		if violation := opts.checkCharacters(pwd); violation != "" {
+			return violation, nil
+		}
+	}
+	if opts.policy >= StrongPolicy {
+		return opts.checkDictionary(pwd)
+	}
+	return "", nil
+}
+
+// PasswordStrength returns the strength of the plaintext password pwd in the range of [0, 100], see
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_validate-password-strength.
+func PasswordStrength(sessionVars *variable.SessionVars, pwd string, userNames ...string) (int64, error) {
+	opts, err := loadOptions(sessionVars)
+	if err != nil {
+		return 0, err
+	}
+	if len([]rune(pwd)) < minStrengthLength || (opts.checkUserName && matchUserName(pwd, userNames)) {
+		return 0, nil
+	}
+	if len([]rune(pwd)) < opts.minLength() {
+		return 25, nil
+	}
+	if violation := opts.checkCharacters(pwd); violation != "" {
+		return 50, nil
+	}
+	violation, err := opts.checkDictionary(pwd)
+	if err != nil {
+		return 0, err
+	}
+	if violation != "" {
+		return 75, nil
+	}
+	return 100, nil
+}
+
+// dictionary caches the words of validate_password_dictionary_file, it is reloaded when the file changes.
+type dictionary struct {
+	sync.Mutex
+	path    string
+	modTime time.Time
+	size    int64
+	words   map[string]struct{}
+}
+
+var globalDictionary = &dictionary{}
+
+func (d *dictionary) load(path string) (map[string]struct{}, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.Annotatef(err, "load password dictionary file %s", path)
+	}
+	d.Lock()
+	defer d.Unlock()
+	if d.words != nil && d.path == path && d.modTime.Equal(info.ModTime()) && d.size == info.Size() {
+		return d.words, nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Annotatef(err, "load password dictionary file %s", path)
+	}
+	defer f.Close()
+	words := make(map[string]struct{})
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		word := strings.ToLower(strings.TrimSpace(scanner.Text()))
+		if len(word) > 0 {
+			words[word] = struct{}{}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Annotatef(err, "load password dictionary file %s", path)
+	}
+	d.path, d.modTime, d.size, d.words = path, info.ModTime(), info.Size(), words
+	return words, nil
+}
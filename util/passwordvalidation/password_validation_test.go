@@ -0,0 +1,117 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package passwordvalidation
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pingcap/tidb/sessionctx/variable"
+	"github.com/stretchr/testify/require"
+)
+
+func setGlobalVars(t *testing.T, vars map[string]string) {
+	for name, value := range vars {
+		name, old := name, variable.GetSysVar(name).Value
+		variable.SetSysVar(name, value)
+		t.Cleanup(func() {
+			variable.SetSysVar(name, old)
+		})
+	}
+}
+
+func TestValidatePassword(t *testing.T) {
+	sessionVars := variable.NewSessionVars()
+	sessionVars.GlobalVarsAccessor = variable.NewMockGlobalAccessor()
+
+	// The validation is disabled by default.
+	violation, err := ValidatePassword(sessionVars, "")
+	require.NoError(t, err)
+	require.Equal(t, "", violation)
+
+	dictFile := filepath.Join(t.TempDir(), "dictionary.txt")
+	require.NoError(t, os.WriteFile(dictFile, []byte("secret\nPASSWORD\n"), 0600))
+	setGlobalVars(t, map[string]string{
+		variable.ValidatePasswordEnable:         variable.On,
+		variable.ValidatePasswordCheckUserName:  variable.On,
+		variable.ValidatePasswordDictionaryFile: dictFile,
+	})
+
+	tests := []struct {
+		policy    string
+		pwd       string
+		violation string
+	}{
+		{"LOW", "abc", "Require Password Length: 8"},
+		{"LOW", "abcdefgh", ""},
+		{"LOW", "tsetrepus", "Password Contains User Name"},
+		{"MEDIUM", "abcdefgh", "Require Password Uppercase Count: 1"},
+		{"MEDIUM", "ABCDEFGH", "Require Password Lowercase Count: 1"},
+		{"MEDIUM", "Abcdefgh", "Require Password Digit Count: 1"},
+		{"MEDIUM", "Abcdefg1", "Require Password Non-alphanumeric Count: 1"},
+		{"MEDIUM", "Secret#1", ""},
+		{"STRONG", "Secret#1", "Password contains word in the dictionary: secret"},
+		{"STRONG", "myPassword#1", "Password contains word in the dictionary: password"},
+		{"STRONG", "Abcdef#1", ""},
+	}
+	for _, tt := range tests {
+		setGlobalVars(t, map[string]string{variable.ValidatePasswordPolicy: tt.policy})
+		violation, err := ValidatePassword(sessionVars, tt.pwd, "superTest")
+		require.NoError(t, err)
+		require.Equal(t, tt.violation, violation, "%s %s", tt.policy, tt.pwd)
+	}
+
+	// validate_password_length can not be less than the sum of the required characters.
+	setGlobalVars(t, map[string]string{
+		variable.ValidatePasswordLength:         "2",
+		variable.ValidatePasswordMixedCaseCount: "2",
+	})
+	violation, err = ValidatePassword(sessionVars, "aaBB#", "")
+	require.NoError(t, err)
+	require.Equal(t, "Require Password Length: 6", violation)
+
+	setGlobalVars(t, map[string]string{variable.ValidatePasswordDictionaryFile: filepath.Join(t.TempDir(), "non-exist")})
+	_, err = ValidatePassword(sessionVars, "aaBB#1xx", "")
+	require.Error(t, err)
+}
+
+func TestPasswordStrength(t *testing.T) {
+	sessionVars := variable.NewSessionVars()
+	sessionVars.GlobalVarsAccessor = variable.NewMockGlobalAccessor()
+	dictFile := filepath.Join(t.TempDir(), "dictionary.txt")
+	require.NoError(t, os.WriteFile(dictFile, []byte("secret\n"), 0600))
+	setGlobalVars(t, map[string]string{
+		variable.ValidatePasswordCheckUserName:  variable.On,
+		variable.ValidatePasswordDictionaryFile: dictFile,
+	})
+
+	tests := []struct {
+		pwd      string
+		strength int64
+	}{
+		{"abc", 0},
+		{"root", 0},
+		{"toor", 0},
+		{"abcd", 25},
+		{"abcdefgh", 50},
+		{"Secret#1", 75},
+		{"Abcdef#1", 100},
+	}
+	for _, tt := range tests {
+		strength, err := PasswordStrength(sessionVars, tt.pwd, "root")
+		require.NoError(t, err)
+		require.Equal(t, tt.strength, strength, tt.pwd)
+	}
+}
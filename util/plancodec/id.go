@@ -126,6 +126,10 @@ const (
 	TypeCTE = "CTEFullScan"
 	// TypeCTEDefinition is the type of CTE definition
 	TypeCTEDefinition = "CTE"
+	// TypeExpand is the type of Expand.
+	TypeExpand = "Expand"
+	// TypeJSONTable is the type of JSONTable.
+	TypeJSONTable = "JSONTable"
 )
 
 // plan id.
@@ -183,6 +187,8 @@ const (
 	typeCTE                   int = 50
 	typeCTEDefinition         int = 51
 	typeCTETable              int = 52
+	typeExpand                int = 53
+	typeJSONTable             int = 54
 )
 
 // TypeStringToPhysicalID converts the plan type string to plan id.
@@ -292,6 +298,10 @@ func TypeStringToPhysicalID(tp string) int {
 		return typeCTEDefinition
 	case TypeCTETable:
 		return typeCTETable
+	case TypeExpand:
+		return typeExpand
+	case TypeJSONTable:
+		return typeJSONTable
 	}
 	// Should never reach here.
 	return 0
@@ -402,6 +412,10 @@ func PhysicalIDToTypeString(id int) string {
 		return TypeCTEDefinition
 	case typeCTETable:
 		return TypeCTETable
+	case typeExpand:
+		return TypeExpand
+	case typeJSONTable:
+		return TypeJSONTable
 	}
 
 	// Should never reach here.
@@ -116,6 +116,16 @@ func (c *conditionChecker) checkLikeFunc(scalar *expression.ScalarFunction) bool
 	if !c.checkColumn(scalar.GetArgs()[0]) {
 		return false
 	}
+	// The prefix range of utf8mb4_0900_as_cs also contains the strings whose prefix only differs in case or accent,
+	// so the like function is still needed to filter them out.
+	if collate.NewCollationEnabled() && collation == "utf8mb4_0900_as_cs" {
+		c.shouldReserve = true
+	}
+	// The order of the gbk collations is not the order of the UTF-8 bytes, the prefix range built by increasing the
+	// last byte doesn't cover the strings with the prefix.
+	if collate.NewCollationEnabled() && (collation == "gbk_chinese_ci" || collation == "gbk_bin") {
+		return false
+	}
 	pattern, ok := scalar.GetArgs()[1].(*expression.Constant)
 	if !ok {
 		return false
@@ -358,6 +358,7 @@ func addInfo(addTo *stmtSummaryByDigestElement, addWith *stmtSummaryByDigestElem
 
 	// plan cache
 	addTo.planCacheHits += addWith.planCacheHits
+	addTo.planCacheMisses += addWith.planCacheMisses
 
 	// other
 	addTo.sumAffectedRows += addWith.sumAffectedRows
@@ -270,6 +270,7 @@ const (
 	LastSeenStr                     = "LAST_SEEN"
 	PlanInCacheStr                  = "PLAN_IN_CACHE"
 	PlanCacheHitsStr                = "PLAN_CACHE_HITS"
+	PlanCacheMissesStr              = "PLAN_CACHE_MISSES"
 	PlanInBindingStr                = "PLAN_IN_BINDING"
 	QuerySampleTextStr              = "QUERY_SAMPLE_TEXT"
 	PrevSampleTextStr               = "PREV_SAMPLE_TEXT"
@@ -534,6 +535,9 @@ var columnValueFactoryMap = map[string]columnValueFactory{
 	PlanCacheHitsStr: func(ssElement *stmtSummaryByDigestElement, _ *stmtSummaryByDigest) interface{} {
 		return ssElement.planCacheHits
 	},
+	PlanCacheMissesStr: func(ssElement *stmtSummaryByDigestElement, _ *stmtSummaryByDigest) interface{} {
+		return ssElement.planCacheMisses
+	},
 	PlanInBindingStr: func(ssElement *stmtSummaryByDigestElement, _ *stmtSummaryByDigest) interface{} {
 		return ssElement.planInBinding
 	},
@@ -0,0 +1,98 @@
+// Copyright 2021 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"encoding/json"
+)
+
+// OptimizeTracer records the decisions made by the optimizer for a statement,
+// it is shown in information_schema.OPTIMIZER_TRACE when optimizer_trace is enabled.
+type OptimizeTracer struct {
+	LogicalRules  []*LogicalRuleTrace  `json:"logical_rules"`
+	JoinReorders  []*JoinReorderTrace  `json:"join_reorders,omitempty"`
+	PhysicalPlans []*PhysicalPlanTrace `json:"physical_plans"`
+	FinalPlan     string               `json:"final_plan"`
+	FinalCost     float64              `json:"final_cost"`
+}
+
+// LogicalRuleTrace records an application of a logical optimization rule.
+type LogicalRuleTrace struct {
+	Rule    string `json:"rule"`
+	Changed bool   `json:"changed"`
+	// Plan is the logical plan after applying the rule.
+	Plan string `json:"plan"`
+}
+
+// CandidateTrace records a candidate plan and its cost.
+type CandidateTrace struct {
+	Plan string  `json:"plan"`
+	Cost float64 `json:"cost"`
+}
+
+// JoinReorderTrace records the join orders considered for a join group.
+type JoinReorderTrace struct {
+	Algorithm  string            `json:"algorithm"`
+	Nodes      []string          `json:"nodes"`
+	Candidates []*CandidateTrace `json:"candidates"`
+	Result     string            `json:"result"`
+}
+
+// PhysicalPlanTrace records the physical plans considered for a logical operator under a required property.
+type PhysicalPlanTrace struct {
+	LogicalPlan string `json:"logical_plan"`
+	Property    string `json:"property"`
+	// PrunedPaths are the access paths of a DataSource pruned before costing.
+	PrunedPaths []string          `json:"pruned_paths,omitempty"`
+	Candidates  []*CandidateTrace `json:"candidates"`
+	Best        *CandidateTrace   `json:"best,omitempty"`
+}
+
+// AppendLogicalRule records that rule has been applied and the plan becomes after.
+func (t *OptimizeTracer) AppendLogicalRule(rule, before, after string) {
+	t.LogicalRules = append(t.LogicalRules, &LogicalRuleTrace{Rule: rule, Changed: before != after, Plan: after})
+}
+
+// AppendJoinReorder records the join reorder of a join group.
+func (t *OptimizeTracer) AppendJoinReorder(trace *JoinReorderTrace) {
+	t.JoinReorders = append(t.JoinReorders, trace)
+}
+
+// AppendPhysicalPlan records the physical plans of a logical operator.
+func (t *OptimizeTracer) AppendPhysicalPlan(trace *PhysicalPlanTrace) {
+	t.PhysicalPlans = append(t.PhysicalPlans, trace)
+}
+
+// SetFinalPlan records the plan chosen by the optimizer.
+func (t *OptimizeTracer) SetFinalPlan(plan string, cost float64) {
+	t.FinalPlan, t.FinalCost = plan, cost
+}
+
+// Marshal encodes the trace as json, the json is indented unless oneLine is true.
+func (t *OptimizeTracer) Marshal(oneLine bool) ([]byte, error) {
+	if oneLine {
+		return json.Marshal(t)
+	}
+	return json.MarshalIndent(t, "", "  ")
+}
+
+// AppendCandidate records a candidate plan.
+func (t *PhysicalPlanTrace) AppendCandidate(plan string, cost float64) {
+	t.Candidates = append(t.Candidates, &CandidateTrace{Plan: plan, Cost: cost})
+}
+
+// AppendCandidate records a candidate join order.
+func (t *JoinReorderTrace) AppendCandidate(plan string, cost float64) {
+	t.Candidates = append(t.Candidates, &CandidateTrace{Plan: plan, Cost: cost})
+}